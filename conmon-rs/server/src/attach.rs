@@ -1,43 +1,177 @@
-use crate::{container_io::Pipe, listener};
-use anyhow::{bail, Context, Result};
-use nix::sys::socket::{bind, listen, socket, AddressFamily, SockFlag, SockType, UnixAddr};
+use crate::{container_io::Pipe, listener, pty_shim::PtyShim};
+use anyhow::{bail, format_err, Context, Result};
+use futures::future::join_all;
+use getset::{CopyGetters, Getters};
+use nix::{
+    errno::Errno,
+    sys::socket::{
+        bind, listen, recv, socket, AddressFamily, MsgFlags, SockFlag, SockType, UnixAddr,
+    },
+};
 use std::{
     os::unix::{
         fs::PermissionsExt,
-        io::{FromRawFd, RawFd},
+        io::{AsRawFd, FromRawFd, RawFd},
         net,
     },
     path::{Path, PathBuf},
-    sync::Arc,
+    sync::{
+        atomic::{AtomicBool, Ordering},
+        Arc,
+    },
+    time::{SystemTime, UNIX_EPOCH},
 };
 use tokio::{
     io::{ErrorKind, Interest, Ready},
     net::{UnixListener, UnixStream},
-    sync::RwLock,
+    sync::{
+        mpsc::{self, Sender, UnboundedReceiver, UnboundedSender},
+        Mutex, RwLock,
+    },
     task,
-    time::{timeout, Duration},
+    time::{self, timeout, Duration},
 };
+use tokio_util::sync::CancellationToken;
 use tracing::{debug, debug_span, error, Instrument};
+use uuid::Uuid;
 
-#[derive(Debug, Clone, Default)]
-/// A shared container attach abstraction.
-pub struct SharedContainerAttach(Arc<RwLock<Vec<Attach>>>);
+#[derive(Debug, Clone)]
+/// A shared container attach abstraction. Multiple sessions can be connected
+/// concurrently, the container output is written to all of them and their
+/// standard input gets merged.
+pub struct SharedContainerAttach {
+    attaches: Arc<RwLock<Vec<Attach>>>,
+    policy: Arc<RwLock<SessionPolicy>>,
+    stdin_tx: UnboundedSender<Vec<u8>>,
+    stdin_rx: Arc<Mutex<UnboundedReceiver<Vec<u8>>>>,
 
-impl SharedContainerAttach {
-    /// Add a new attach endpoint to this shared container attach instance.
-    pub async fn add(&self, attach: Attach) {
-        self.0.write().await.push(attach);
+    /// The next sequence number of the output, which is locked while writing
+    /// to deliver the output of all pipes in the same order to every session.
+    sequence: Sequence,
+
+    /// Whether the process is being stopped on request, which is reported as
+    /// the reason once the sessions end.
+    stopping: Arc<AtomicBool>,
+}
+
+impl Default for SharedContainerAttach {
+    fn default() -> Self {
+        let (stdin_tx, stdin_rx) = mpsc::unbounded_channel();
+        Self {
+            attaches: Default::default(),
+            policy: Default::default(),
+            stdin_tx,
+            stdin_rx: Arc::new(Mutex::new(stdin_rx)),
+            sequence: Default::default(),
+            stopping: Default::default(),
+        }
     }
+}
 
-    /// Try to read from all attach endpoints standard input and return the first result.
-    pub async fn try_read(&self) -> Result<Option<Vec<u8>>> {
+impl SharedContainerAttach {
+    /// Create a new attach endpoint for the socket path, or reuse the
+    /// existing one if the path is already in use by this container. The
+    /// sessions of the endpoint get a pseudo terminal shim in front of the
+    /// process `pty_shim` if set. The standard input of the sessions is
+    /// never read if `output_only` is set. The output packets of the sessions
+    /// carry a sequence number if `sequenced` is set. The sessions receive an
+    /// end packet with the reason before getting disconnected if `report_end`
+    /// is set. The queued standard error of the sessions is delivered before
+    /// their queued standard output if `stderr_priority` is set.
+    pub async fn attach(
+        &self,
+        socket_path: &Path,
+        pty_shim: Option<u32>,
+        output_only: bool,
+        sequenced: bool,
+        report_end: bool,
+        stderr_priority: bool,
+    ) -> Result<()> {
+        if pty_shim.is_some() && output_only {
+            bail!("cannot simulate a terminal for output only sessions")
+        }
+        if pty_shim.is_some() && sequenced {
+            bail!("cannot sequence the output of a simulated terminal")
+        }
+        if pty_shim.is_some() && stderr_priority {
+            bail!("cannot prioritize the standard error of a simulated terminal")
+        }
+        if sequenced && stderr_priority {
+            bail!("cannot prioritize the standard error of sequenced output")
+        }
+        let options = SessionOptions {
+            report_end,
+            stderr_priority,
+        };
         self.cleanup().await;
-        for attach in self.0.read().await.iter() {
-            if let Some(data) = attach.try_read().await? {
-                return Ok(data.into());
+        let mut attaches = self.attaches.write().await;
+        if let Some(attach) = attaches.iter().find(|x| x.path == socket_path) {
+            if attach.pty_shim.is_some() != pty_shim.is_some() {
+                bail!(
+                    "attach endpoint {} exists with a different terminal simulation",
+                    socket_path.display()
+                )
+            }
+            if attach.output_only != output_only {
+                bail!(
+                    "attach endpoint {} exists with a different standard input mode",
+                    socket_path.display()
+                )
+            }
+            if attach.sequence.is_some() != sequenced {
+                bail!(
+                    "attach endpoint {} exists with a different output sequencing",
+                    socket_path.display()
+                )
+            }
+            if attach.options.report_end != report_end {
+                bail!(
+                    "attach endpoint {} exists with a different session end reporting",
+                    socket_path.display()
+                )
             }
+            if attach.options.stderr_priority != stderr_priority {
+                bail!(
+                    "attach endpoint {} exists with a different stream priority",
+                    socket_path.display()
+                )
+            }
+            debug!("Reusing attach endpoint {}", socket_path.display());
+            return Ok(());
         }
-        Ok(None)
+        let policy = self.policy().await;
+        let stdin = (!output_only).then(|| self.stdin_tx.clone());
+        let sequence = sequenced.then(|| self.sequence.clone());
+        attaches.push(Attach::new(
+            socket_path,
+            policy,
+            stdin,
+            pty_shim,
+            sequence,
+            options,
+        )?);
+        Ok(())
+    }
+
+    /// Retrieve the session policy applied to new attach endpoints.
+    pub async fn policy(&self) -> SessionPolicy {
+        *self.policy.read().await
+    }
+
+    /// Set the session policy applied to new attach endpoints.
+    pub async fn set_policy(&self, policy: SessionPolicy) {
+        *self.policy.write().await = policy;
+    }
+
+    /// Read the next standard input of any session connected to the attach
+    /// endpoints.
+    pub async fn read(&self) -> Result<Vec<u8>> {
+        self.stdin_rx
+            .lock()
+            .await
+            .recv()
+            .await
+            .context("attach stdin channel closed")
     }
 
     /// Write a buffer to all attach endpoints.
@@ -46,18 +180,65 @@ impl SharedContainerAttach {
         T: AsRef<[u8]>,
     {
         self.cleanup().await;
-        for attach in self.0.read().await.iter() {
+        let mut sequence = self.sequence.lock().await;
+        for attach in self.attaches.read().await.iter() {
             attach
-                .write(pipe, &buf)
+                .write(pipe, *sequence, &buf)
                 .await
                 .context("write to attach endpoint")?;
         }
+        *sequence += 1;
         Ok(())
     }
 
+    /// Retrieve all sessions connected to the attach endpoints.
+    pub async fn sessions(&self) -> Vec<SessionInfo> {
+        self.cleanup().await;
+        let mut sessions = vec![];
+        for attach in self.attaches.read().await.iter() {
+            sessions.extend(attach.sessions().await);
+        }
+        sessions
+    }
+
+    /// Disconnect the session for the provided ID. Returns `false` if no
+    /// session has been found.
+    pub async fn kill_session(&self, id: &str) -> bool {
+        for attach in self.attaches.read().await.iter() {
+            if attach.kill_session(id).await {
+                return true;
+            }
+        }
+        false
+    }
+
+    /// Mark the process as being stopped on request, which is reported to
+    /// the sessions once they end after its exit.
+    pub fn stop(&self) {
+        self.stopping.store(true, Ordering::SeqCst);
+    }
+
+    /// End all sessions after the exit of the process with the exit code.
+    pub async fn exited(&self, exit_code: i32) {
+        let (reason, message) = if self.stopping.load(Ordering::SeqCst) {
+            (
+                SessionEndReason::Stopped,
+                format!("container stopped with exit code {}", exit_code),
+            )
+        } else {
+            (
+                SessionEndReason::Exited,
+                format!("process exited with code {}", exit_code),
+            )
+        };
+        for attach in self.attaches.read().await.iter() {
+            attach.end_sessions(reason, &message).await;
+        }
+    }
+
     /// Remove attach endpoints which do not exist any more.
     async fn cleanup(&self) {
-        self.0.write().await.retain(|x| {
+        self.attaches.write().await.retain(|x| {
             let exists = x.path.exists();
             if !exists {
                 debug!("Cleanup attach endpoint: {}", x.path.display())
@@ -68,20 +249,217 @@ impl SharedContainerAttach {
 }
 
 /// The size of an attach packet.
-const ATTACH_PACKET_BUF_SIZE: usize = 8192;
+pub(crate) const ATTACH_PACKET_BUF_SIZE: usize = 8192;
+
+/// The size of the sequence number following the type of sequenced attach
+/// packets.
+const SEQUENCE_SIZE: usize = 8;
+
+/// The sequence number of the next output of a container.
+type Sequence = Arc<Mutex<u64>>;
+
+/// The number of buffers which can be queued per pipe for a session with
+/// prioritized standard error.
+const OUTPUT_QUEUE_SIZE: usize = 64;
+
+/// The type of the packet announcing the end of a session, which carries
+/// the reason followed by a message.
+const END_PACKET_TYPE: u8 = 4;
+
+#[derive(Clone, Copy, Debug, Default, Eq, PartialEq)]
+/// The options of the sessions connected to an attach endpoint.
+struct SessionOptions {
+    /// Whether the sessions receive an end packet before getting
+    /// disconnected.
+    report_end: bool,
+
+    /// Whether the output of the sessions is queued per pipe, delivering the
+    /// standard error first.
+    stderr_priority: bool,
+}
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// The reason why the server ended an attach session.
+pub enum SessionEndReason {
+    /// The process exited and got removed from the server.
+    Exited = 1,
+
+    /// The container exited after getting stopped on request.
+    Stopped = 2,
+
+    /// The session got killed on request.
+    Killed = 3,
+
+    /// The session reached the maximum duration of the policy.
+    Expired = 4,
+}
+
+#[derive(Clone, Copy, CopyGetters, Debug, Default, Eq, PartialEq)]
+#[getset(get_copy = "pub")]
+/// The policy applied to every session connected to an attach endpoint.
+pub struct SessionPolicy {
+    /// Maximum duration of a session, unlimited if not set.
+    max_duration: Option<Duration>,
+
+    /// Period before the termination of a session in which the client gets
+    /// notified about the upcoming termination.
+    warning_period: Duration,
+}
+
+impl SessionPolicy {
+    /// Create a new session policy from the provided durations in seconds.
+    pub fn new(max_duration: Option<u64>, warning_period: u64) -> Self {
+        let max_duration = max_duration.filter(|x| *x > 0).map(Duration::from_secs);
+        Self {
+            max_duration,
+            // The warning cannot be sent before the session got connected.
+            warning_period: max_duration
+                .unwrap_or_default()
+                .min(Duration::from_secs(warning_period)),
+        }
+    }
+}
+
+type Clients = Arc<RwLock<Vec<Session>>>;
+
+#[derive(Clone, CopyGetters, Debug, Getters)]
+/// Information about a client connected to an attach endpoint.
+pub struct SessionInfo {
+    #[getset(get = "pub")]
+    /// Unique identifier of the session.
+    id: String,
+
+    #[getset(get = "pub")]
+    /// Path of the attach socket the session is connected to.
+    socket_path: PathBuf,
+
+    #[getset(get_copy = "pub")]
+    /// Connection time in seconds since the unix epoch.
+    connected_at: u64,
+
+    #[getset(get_copy = "pub")]
+    /// User ID of the connected peer.
+    uid: u32,
+
+    #[getset(get_copy = "pub")]
+    /// Group ID of the connected peer.
+    gid: u32,
+
+    #[getset(get_copy = "pub")]
+    /// Process ID of the connected peer, if available.
+    pid: Option<i32>,
+}
+
+#[derive(Debug)]
+/// A single client connected to an attach endpoint.
+struct Session {
+    info: SessionInfo,
+    stream: Arc<UnixStream>,
+
+    /// The pseudo terminal simulated for the session, if any.
+    shim: Option<Arc<PtyShim>>,
+
+    /// Stops reading the standard input of the session.
+    token: CancellationToken,
 
-type Clients = Arc<RwLock<Vec<UnixStream>>>;
+    /// Whether the session receives an end packet before getting
+    /// disconnected.
+    report_end: bool,
+
+    /// The output queues of the session if its standard error is
+    /// prioritized, otherwise the output is written directly.
+    output: Option<OutputQueue>,
+}
+
+impl Drop for Session {
+    fn drop(&mut self) {
+        self.token.cancel();
+    }
+}
 
 #[derive(Clone, Debug)]
 /// Attach handles the attach socket IO of a container.
 pub struct Attach {
     clients: Clients,
     path: PathBuf,
+    pty_shim: Option<u32>,
+
+    /// Whether the standard input of the sessions is ignored.
+    output_only: bool,
+
+    /// The sequence of the container output if the packets are sequenced.
+    sequence: Option<Sequence>,
+
+    /// The options of the sessions.
+    options: SessionOptions,
+}
+
+#[derive(Clone, Debug)]
+/// The output queues of a session, which deliver the standard error before
+/// the standard output.
+struct OutputQueue {
+    stdout: Sender<Vec<Vec<u8>>>,
+    stderr: Sender<Vec<Vec<u8>>>,
+}
+
+impl OutputQueue {
+    /// Start writing the queued output to the stream of the session, which
+    /// gets removed if the stream fails. The stream is kept open until all
+    /// queued output has been written, even if the session got removed.
+    fn new(id: String, stream: Arc<UnixStream>, clients: Clients) -> Self {
+        let (stdout, mut stdout_rx) = mpsc::channel::<Vec<Vec<u8>>>(OUTPUT_QUEUE_SIZE);
+        let (stderr, mut stderr_rx) = mpsc::channel::<Vec<Vec<u8>>>(OUTPUT_QUEUE_SIZE);
+        task::spawn(
+            async move {
+                loop {
+                    let packets = tokio::select! {
+                        biased;
+                        Some(packets) = stderr_rx.recv() => packets,
+                        Some(packets) = stdout_rx.recv() => packets,
+                        else => return,
+                    };
+                    if let Err(e) = Attach::write_packets(&id, &stream, &packets).await {
+                        debug!("Cleanup stale attach session {}: {:#}", id, e);
+                        Attach::remove_session(&clients, &id).await;
+                        return;
+                    }
+                }
+            }
+            .instrument(debug_span!("attach_output")),
+        );
+        Self { stdout, stderr }
+    }
+
+    /// Queue the packets of a pipe. They get dropped if the queue does not
+    /// drain in time, to not block the output of other sessions.
+    async fn push(&self, id: &str, pipe: Pipe, packets: Vec<Vec<u8>>) -> Result<()> {
+        let queue = match pipe {
+            Pipe::StdOut => &self.stdout,
+            Pipe::StdErr => &self.stderr,
+        };
+        match timeout(Duration::from_millis(100), queue.send(packets)).await {
+            Ok(res) => res.map_err(|_| format_err!("attach session closed")),
+            Err(_) => {
+                debug!("Attach session {} {} queue is full", id, pipe.as_ref());
+                Ok(())
+            }
+        }
+    }
 }
 
 impl Attach {
-    /// Create a new attach instance.
-    pub fn new(socket_path: &Path) -> Result<Self> {
+    /// Create a new attach instance, which forwards the standard input of
+    /// all sessions into the provided channel. The sessions are output only
+    /// if there is no channel. The output packets carry the numbers of the
+    /// sequence if provided.
+    fn new(
+        socket_path: &Path,
+        policy: SessionPolicy,
+        stdin: Option<UnboundedSender<Vec<u8>>>,
+        pty_shim: Option<u32>,
+        sequence: Option<Sequence>,
+        options: SessionOptions,
+    ) -> Result<Self> {
         debug!("Creating attach socket: {}", socket_path.display());
 
         if socket_path.exists() {
@@ -112,9 +490,23 @@ impl Attach {
 
         let clients = Arc::new(RwLock::new(vec![]));
         let clients_clone = clients.clone();
+        let path = socket_path.to_path_buf();
+        let output_only = stdin.is_none();
+        let sequence_clone = sequence.clone();
         task::spawn(
             async move {
-                if let Err(e) = Self::start_listening(fd, clients_clone).await {
+                if let Err(e) = Self::start_listening(
+                    fd,
+                    clients_clone,
+                    path,
+                    policy,
+                    stdin,
+                    pty_shim,
+                    sequence_clone,
+                    options,
+                )
+                .await
+                {
                     error!("Attach failure: {:#}", e);
                 }
             }
@@ -124,113 +516,510 @@ impl Attach {
         Ok(Self {
             clients,
             path: socket_path.into(),
+            pty_shim,
+            output_only,
+            sequence,
+            options,
         })
     }
 
-    async fn start_listening(fd: RawFd, clients: Clients) -> Result<()> {
+    async fn start_listening(
+        fd: RawFd,
+        clients: Clients,
+        socket_path: PathBuf,
+        policy: SessionPolicy,
+        stdin: Option<UnboundedSender<Vec<u8>>>,
+        pty_shim: Option<u32>,
+        sequence: Option<Sequence>,
+        options: SessionOptions,
+    ) -> Result<()> {
         debug!("Start listening on attach socket");
         let listener = UnixListener::from_std(unsafe { net::UnixListener::from_raw_fd(fd) })?;
         loop {
             match listener.accept().await {
                 Ok((stream, _)) => {
-                    debug!("Got new attach stream connection");
-                    clients.write().await.push(stream);
+                    match Self::session(stream, &socket_path, pty_shim, options, &clients) {
+                        Ok(session) => {
+                            debug!(
+                                "Got new attach stream connection for session {}",
+                                session.info.id
+                            );
+                            Self::enforce_policy(
+                                clients.clone(),
+                                session.info.id.clone(),
+                                policy,
+                                sequence.clone(),
+                            );
+                            // Output only sessions keep their input unread.
+                            if let Some(stdin) = &stdin {
+                                Self::read_stdin(&session, stdin.clone());
+                            }
+                            clients.write().await.push(session);
+                        }
+                        Err(e) => error!("Unable to create attach session: {:#}", e),
+                    }
                 }
                 Err(e) => error!("Unable to accept attach stream: {}", e),
             }
         }
     }
 
-    /// Try to read from all streams standard input and return the first result.
-    pub async fn try_read(&self) -> Result<Option<Vec<u8>>> {
-        for stream in self.clients.read().await.iter() {
-            let ready = if let Some(ready) =
-                Self::default_readiness_timeout(Interest::READABLE, stream).await?
-            {
-                ready
-            } else {
-                continue;
-            };
+    fn session(
+        stream: UnixStream,
+        socket_path: &Path,
+        pty_shim: Option<u32>,
+        options: SessionOptions,
+        clients: &Clients,
+    ) -> Result<Session> {
+        let shim = match pty_shim {
+            Some(pid) => Some(Arc::new(PtyShim::new(pid).context("create pty shim")?)),
+            None => None,
+        };
+        let info = Self::session_info(&stream, socket_path)?;
+        let stream = Arc::new(stream);
+        let output = options
+            .stderr_priority
+            .then(|| OutputQueue::new(info.id.clone(), stream.clone(), clients.clone()));
+        Ok(Session {
+            info,
+            stream,
+            shim,
+            token: CancellationToken::new(),
+            report_end: options.report_end,
+            output,
+        })
+    }
+
+    fn session_info(stream: &UnixStream, socket_path: &Path) -> Result<SessionInfo> {
+        let cred = stream.peer_cred().context("get peer credentials")?;
+        Ok(SessionInfo {
+            id: Uuid::new_v4().to_string(),
+            socket_path: socket_path.into(),
+            connected_at: SystemTime::now()
+                .duration_since(UNIX_EPOCH)
+                .context("get connection time")?
+                .as_secs(),
+            uid: cred.uid(),
+            gid: cred.gid(),
+            pid: cred.pid(),
+        })
+    }
+
+    /// Terminate the session for the provided ID once it reaches the maximum
+    /// duration of the policy. The client gets warned before the termination
+    /// if the policy contains a warning period, which takes the next number of
+    /// the sequence if provided.
+    fn enforce_policy(
+        clients: Clients,
+        id: String,
+        policy: SessionPolicy,
+        sequence: Option<Sequence>,
+    ) {
+        let max_duration = match policy.max_duration() {
+            Some(max_duration) => max_duration,
+            None => return,
+        };
+        let warning_period = policy.warning_period();
+
+        task::spawn(
+            async move {
+                time::sleep(max_duration - warning_period).await;
+                if !warning_period.is_zero() {
+                    let message = format!(
+                        "\r\nconmon: attach session will be terminated in {} seconds\r\n",
+                        warning_period.as_secs()
+                    );
+                    if let Err(e) =
+                        Self::write_session(&clients, &id, Pipe::StdOut, sequence, message).await
+                    {
+                        debug!("Unable to send termination warning: {:#}", e);
+                    }
+                    time::sleep(warning_period).await;
+                }
+                let message = format!(
+                    "attach session reached the maximum duration of {} seconds",
+                    max_duration.as_secs()
+                );
+                if Self::end_session(&clients, &id, SessionEndReason::Expired, &message).await {
+                    debug!("Terminated attach session after {:?}", max_duration);
+                }
+            }
+            .instrument(debug_span!("session_policy", session_id = id.as_str())),
+        );
+    }
 
-            if ready.is_readable() {
+    /// Forward the standard input of the session into the channel until the
+    /// session disconnects or gets removed. The input passes the pseudo
+    /// terminal of the session if it has one.
+    fn read_stdin(session: &Session, stdin: UnboundedSender<Vec<u8>>) {
+        let (stream, token, shim) = (
+            session.stream.clone(),
+            session.token.clone(),
+            session.shim.clone(),
+        );
+        if let Some(shim) = &shim {
+            Self::run_shim(session, shim.clone(), stdin.clone());
+        }
+        task::spawn(
+            async move {
                 let mut buf = vec![0; ATTACH_PACKET_BUF_SIZE];
-                match stream.try_read(&mut buf) {
-                    Ok(n) if n > 0 => {
-                        if let Some(first_zero_idx) = buf.iter().position(|&x| x == 0) {
-                            buf.resize(first_zero_idx, 0);
+                loop {
+                    tokio::select! {
+                        _ = token.cancelled() => return,
+                        ready = stream.readable() => {
+                            if let Err(e) = ready {
+                                debug!("Unable to wait for attach stdin: {}", e);
+                                return;
+                            }
                         }
-                        debug!("Read {} stdin bytes from client", buf.len());
-                        return Ok(buf.into());
                     }
-                    Err(ref e) if e.kind() == ErrorKind::WouldBlock => continue,
-                    Err(e) => {
-                        return Err(e.into());
+                    match stream.try_read(&mut buf) {
+                        Ok(0) => {
+                            // The session may still receive output after
+                            // closing its standard input.
+                            debug!("Attach session closed stdin");
+                            return;
+                        }
+                        Ok(n) => {
+                            debug!("Read {} stdin bytes from client", n);
+                            let res = match &shim {
+                                Some(shim) => shim.input(&buf[..n]).await,
+                                None => stdin.send(buf[..n].to_vec()).map_err(Into::into),
+                            };
+                            if let Err(e) = res {
+                                debug!("Unable to forward attach stdin: {:#}", e);
+                                return;
+                            }
+                        }
+                        Err(ref e) if e.kind() == ErrorKind::WouldBlock => continue,
+                        Err(e) => {
+                            debug!("Unable to read attach stdin: {}", e);
+                            return;
+                        }
+                    }
+                }
+            }
+            .instrument(debug_span!(
+                "attach_stdin",
+                session_id = session.info.id.as_str()
+            )),
+        );
+    }
+
+    /// Forward the terminal output of the shim to the session and its
+    /// completed input lines into the channel, until the session gets removed.
+    fn run_shim(session: &Session, shim: Arc<PtyShim>, stdin: UnboundedSender<Vec<u8>>) {
+        let (id, stream, token) = (
+            session.info.id.clone(),
+            session.stream.clone(),
+            session.token.clone(),
+        );
+        task::spawn(
+            async move {
+                let mut output = vec![0; ATTACH_PACKET_BUF_SIZE - 1];
+                let mut input = vec![0; ATTACH_PACKET_BUF_SIZE];
+                let res: Result<()> = async {
+                    loop {
+                        tokio::select! {
+                            _ = token.cancelled() => return Ok(()),
+                            n = shim.read_output(&mut output) => {
+                                let packets = Self::packets(Pipe::StdOut, None, &output[..n?]);
+                                Self::write_packets(&id, &stream, &packets).await?;
+                            }
+                            n = shim.read_input(&mut input) => match n? {
+                                // The end of file character cannot close the
+                                // input shared with other sessions.
+                                0 => debug!("Ignoring end of file of attach session"),
+                                n => stdin.send(input[..n].to_vec())?,
+                            },
+                        }
+                    }
+                }
+                .await;
+                if let Err(e) = res {
+                    debug!("Stopping pty shim: {:#}", e);
+                }
+            }
+            .instrument(debug_span!(
+                "pty_shim",
+                session_id = session.info.id.as_str()
+            )),
+        );
+    }
+
+    /// Write a buffer to a single session, using the next number of the
+    /// sequence if provided.
+    async fn write_session<T>(
+        clients: &Clients,
+        id: &str,
+        pipe: Pipe,
+        sequence: Option<Sequence>,
+        buf: T,
+    ) -> Result<()>
+    where
+        T: AsRef<[u8]>,
+    {
+        // Do not hold the lock while waiting for the client, which would
+        // block the output of all other sessions.
+        let stream = match clients.read().await.iter().find(|x| x.info.id == id) {
+            Some(session) => session.stream.clone(),
+            None => return Ok(()),
+        };
+        match sequence {
+            Some(sequence) => {
+                let mut sequence = sequence.lock().await;
+                let packets = Self::packets(pipe, Some(*sequence), buf);
+                Self::write_packets(id, &stream, &packets).await?;
+                *sequence += 1;
+                Ok(())
+            }
+            None => Self::write_packets(id, &stream, &Self::packets(pipe, None, buf)).await,
+        }
+    }
+
+    /// Write the packets in order to the stream. The remaining packets get
+    /// dropped if the stream does not become writable in time, to not block
+    /// the output of other sessions.
+    async fn write_packets(id: &str, stream: &UnixStream, packets: &[Vec<u8>]) -> Result<()> {
+        for packet in packets {
+            loop {
+                match Self::default_readiness_timeout(Interest::WRITABLE, stream).await? {
+                    Some(ready) if ready.is_write_closed() => bail!("attach session closed"),
+                    Some(ready) if ready.is_writable() => {}
+                    _ => {
+                        debug!("Attach session {} is not writable", id);
+                        return Ok(());
                     }
-                    _ => {}
+                }
+                match stream.try_write(packet) {
+                    Ok(_) => break,
+                    Err(ref e) if e.kind() == ErrorKind::WouldBlock => continue,
+                    Err(e) => return Err(e).context("write packet"),
                 }
             }
         }
+        Ok(())
+    }
 
-        Ok(None)
+    /// Write the output to the pseudo terminal of a session. The output gets
+    /// dropped if the terminal does not become writable in time, to not block
+    /// the output of other sessions.
+    async fn write_shim(id: &str, shim: &PtyShim, buf: &[u8]) -> Result<()> {
+        match timeout(Duration::from_millis(100), shim.output(buf)).await {
+            Ok(res) => res,
+            Err(_) => {
+                debug!("Attach session {} terminal is not writable", id);
+                Ok(())
+            }
+        }
     }
 
-    /// Write a buffer to all attached clients.
-    pub async fn write<T>(&self, pipe: Pipe, buf: T) -> Result<()>
+    async fn remove_session(clients: &Clients, id: &str) -> bool {
+        let mut clients = clients.write().await;
+        match clients.iter().position(|x| x.info.id == id) {
+            Some(idx) => {
+                // Dropping the stream closes the connection.
+                clients.remove(idx);
+                true
+            }
+            None => false,
+        }
+    }
+
+    /// Disconnect the session for the provided ID, after sending the end
+    /// packet with the reason if the session reports it. Returns `false` if
+    /// the session is not connected.
+    async fn end_session(
+        clients: &Clients,
+        id: &str,
+        reason: SessionEndReason,
+        message: &str,
+    ) -> bool {
+        let session = {
+            let mut clients = clients.write().await;
+            match clients.iter().position(|x| x.info.id == id) {
+                Some(idx) => clients.remove(idx),
+                None => return false,
+            }
+        };
+        Self::write_end(&session, reason, message).await;
+        true
+    }
+
+    /// Disconnect all sessions of this attach endpoint, after sending the
+    /// end packet with the reason to them if they report it.
+    pub async fn end_sessions(&self, reason: SessionEndReason, message: &str) {
+        let sessions: Vec<_> = self.clients.write().await.drain(..).collect();
+        if !sessions.is_empty() {
+            debug!(
+                "Ending {} attach sessions of {}: {}",
+                sessions.len(),
+                self.path.display(),
+                message
+            );
+        }
+        join_all(
+            sessions
+                .iter()
+                .map(|session| Self::write_end(session, reason, message)),
+        )
+        .await;
+    }
+
+    /// Send the end packet to a session which reports the end.
+    async fn write_end(session: &Session, reason: SessionEndReason, message: &str) {
+        if !session.report_end {
+            return;
+        }
+        let packet = Self::end_packet(reason, message);
+        // The end packet follows the queued output of the session.
+        let res = match &session.output {
+            Some(output) => {
+                output
+                    .push(&session.info.id, Pipe::StdOut, vec![packet])
+                    .await
+            }
+            None => Self::write_packets(&session.info.id, &session.stream, &[packet]).await,
+        };
+        if let Err(e) = res {
+            debug!(
+                "Unable to send end of attach session {}: {:#}",
+                session.info.id, e
+            );
+        }
+    }
+
+    /// Create the packet announcing the end of a session, truncating the
+    /// message to fit into a single packet.
+    fn end_packet(reason: SessionEndReason, message: &str) -> Vec<u8> {
+        let mut packet = Vec::with_capacity(ATTACH_PACKET_BUF_SIZE.min(2 + message.len()));
+        packet.push(END_PACKET_TYPE);
+        packet.push(reason as u8);
+        let message = message.as_bytes();
+        packet.extend_from_slice(&message[..message.len().min(ATTACH_PACKET_BUF_SIZE - 2)]);
+        packet
+    }
+
+    /// Retrieve all sessions connected to this attach endpoint.
+    pub async fn sessions(&self) -> Vec<SessionInfo> {
+        let mut clients = self.clients.write().await;
+        // Disconnected clients are otherwise only removed on write.
+        clients.retain(|x| {
+            let closed = Self::is_closed(&x.stream);
+            if closed {
+                debug!("Cleanup disconnected attach session {}", x.info.id);
+            }
+            !closed
+        });
+        clients.iter().map(|x| x.info.clone()).collect()
+    }
+
+    /// Check if the peer of the stream disconnected without consuming any
+    /// pending data.
+    fn is_closed(stream: &UnixStream) -> bool {
+        let mut buf = [0; 1];
+        match recv(
+            stream.as_raw_fd(),
+            &mut buf,
+            MsgFlags::MSG_PEEK | MsgFlags::MSG_DONTWAIT,
+        ) {
+            Ok(n) => n == 0,
+            Err(Errno::EAGAIN) | Err(Errno::EINTR) => false,
+            Err(_) => true,
+        }
+    }
+
+    /// Disconnect the session for the provided ID. Returns `false` if the
+    /// session is not connected to this attach endpoint.
+    pub async fn kill_session(&self, id: &str) -> bool {
+        debug!("Killing attach session {}", id);
+        Self::end_session(
+            &self.clients,
+            id,
+            SessionEndReason::Killed,
+            "attach session killed",
+        )
+        .await
+    }
+
+    /// Write a buffer to all attached clients concurrently. Clients which
+    /// cannot be written to get disconnected. The sequence number is only
+    /// used if the packets are sequenced.
+    pub async fn write<T>(&self, pipe: Pipe, sequence: u64, buf: T) -> Result<()>
     where
         T: AsRef<[u8]>,
     {
-        let packets = buf
-            .as_ref()
-            .chunks(ATTACH_PACKET_BUF_SIZE - 1)
+        let sequence = self.sequence.is_some().then(|| sequence);
+        let packets = Self::packets(pipe, sequence, &buf);
+
+        // Do not hold the lock while waiting for the clients, which would
+        // block new sessions.
+        let sessions: Vec<_> = self
+            .clients
+            .read()
+            .await
+            .iter()
             .map(|x| {
-                let mut y = x.to_vec();
-                let p = match pipe {
-                    Pipe::StdOut => 2,
-                    Pipe::StdErr => 3,
-                };
-                y.insert(0, p);
-                y.resize(ATTACH_PACKET_BUF_SIZE, 0);
-                y
+                (
+                    x.info.id.clone(),
+                    x.stream.clone(),
+                    x.shim.clone(),
+                    x.output.clone(),
+                )
             })
-            .collect::<Vec<_>>();
+            .collect();
 
-        let mut cleanup_idxs = vec![];
-        let mut clients = self.clients.write().await;
-
-        for (idx, stream) in clients.iter().enumerate() {
-            let ready = if let Some(ready) =
-                Self::default_readiness_timeout(Interest::WRITABLE, stream).await?
-            {
-                ready
-            } else {
-                continue;
+        let results = join_all(sessions.iter().map(|(id, stream, shim, output)| async {
+            // The terminal merges all pipes like a real one would do.
+            let res = match (shim, output) {
+                (Some(shim), _) => Self::write_shim(id, shim, buf.as_ref()).await,
+                (None, Some(output)) => output.push(id, pipe, packets.clone()).await,
+                (None, None) => Self::write_packets(id, stream, &packets).await,
             };
-
-            if ready.is_write_closed() {
-                cleanup_idxs.push(idx);
-                continue;
+            if res.is_ok() {
+                debug!("Wrote {} packets to client {}", pipe.as_ref(), id);
             }
+            (id, res)
+        }))
+        .await;
 
-            if ready.is_writable() {
-                for packet in &packets {
-                    match stream.try_write(packet) {
-                        Ok(_) => {
-                            debug!("Wrote {} packet to client", &pipe.as_ref());
-                        }
-                        Err(ref e) if e.kind() == ErrorKind::WouldBlock => continue,
-                        Err(e) => {
-                            Self::cleanup_clients(&mut clients, &cleanup_idxs).await;
-                            return Err(e.into());
-                        }
-                    }
-                }
+        for (id, res) in results {
+            if let Err(e) = res {
+                debug!("Cleanup stale attach session {}: {:#}", id, e);
+                Self::remove_session(&self.clients, id).await;
             }
         }
-
-        Self::cleanup_clients(&mut clients, &cleanup_idxs).await;
         Ok(())
     }
 
+    /// Split a buffer into attach packets for the provided pipe. The packets
+    /// of sequenced sessions carry the sequence number after their type, which
+    /// is the same for all packets of the buffer.
+    fn packets<T>(pipe: Pipe, sequence: Option<u64>, buf: T) -> Vec<Vec<u8>>
+    where
+        T: AsRef<[u8]>,
+    {
+        let header_size = match sequence {
+            Some(_) => 1 + SEQUENCE_SIZE,
+            None => 1,
+        };
+        buf.as_ref()
+            .chunks(ATTACH_PACKET_BUF_SIZE - header_size)
+            .map(|x| {
+                let mut y = Vec::with_capacity(header_size + x.len());
+                y.push(match pipe {
+                    Pipe::StdOut => 2,
+                    Pipe::StdErr => 3,
+                });
+                if let Some(sequence) = sequence {
+                    y.extend_from_slice(&sequence.to_be_bytes());
+                }
+                y.extend_from_slice(x);
+                y
+            })
+            .collect()
+    }
+
     async fn default_readiness_timeout(
         interest: Interest,
         stream: &UnixStream,
@@ -240,11 +1029,298 @@ impl Attach {
             _ => Ok(None),
         }
     }
+}
 
-    async fn cleanup_clients(clients: &mut Vec<UnixStream>, idxs: &[usize]) {
-        for i in idxs.iter().rev() {
-            debug!("Cleanup stale attach client with index: {}", i);
-            clients.remove(*i);
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn session_policy_new() {
+        let policy = SessionPolicy::new(Some(60), 30);
+        assert_eq!(policy.max_duration(), Some(Duration::from_secs(60)));
+        assert_eq!(policy.warning_period(), Duration::from_secs(30));
+    }
+
+    #[test]
+    fn session_policy_new_unlimited() {
+        assert_eq!(SessionPolicy::new(None, 30), SessionPolicy::default());
+        assert_eq!(SessionPolicy::new(Some(0), 30), SessionPolicy::default());
+    }
+
+    #[test]
+    fn session_policy_new_warning_exceeds_max_duration() {
+        let policy = SessionPolicy::new(Some(10), 30);
+        assert_eq!(policy.warning_period(), Duration::from_secs(10));
+    }
+
+    #[tokio::test]
+    async fn is_closed() -> Result<()> {
+        let (stream, peer) = UnixStream::pair()?;
+        assert!(!Attach::is_closed(&stream));
+
+        peer.try_write(b"data")?;
+        assert!(!Attach::is_closed(&stream));
+
+        drop(peer);
+        let mut buf = [0; 4];
+        stream.try_read(&mut buf)?;
+        assert!(Attach::is_closed(&stream));
+        Ok(())
+    }
+
+    fn connect(socket_path: &Path) -> Result<UnixStream> {
+        let fd = socket(
+            AddressFamily::Unix,
+            SockType::SeqPacket,
+            SockFlag::SOCK_NONBLOCK | SockFlag::SOCK_CLOEXEC,
+            None,
+        )?;
+        nix::sys::socket::connect(fd, &UnixAddr::new(socket_path)?)?;
+        Ok(UnixStream::from_std(unsafe {
+            net::UnixStream::from_raw_fd(fd)
+        })?)
+    }
+
+    #[tokio::test]
+    async fn multiple_sessions() -> Result<()> {
+        let dir = tempfile::tempdir()?;
+        let socket_path = dir.path().join("attach");
+        let sut = SharedContainerAttach::default();
+        sut.attach(&socket_path, None, false, false, false, false)
+            .await?;
+        sut.attach(&socket_path, None, false, false, false, false)
+            .await?;
+        assert!(sut
+            .attach(&socket_path, Some(1), false, false, false, false)
+            .await
+            .is_err());
+        assert!(sut
+            .attach(&socket_path, None, true, false, false, false)
+            .await
+            .is_err());
+        assert!(sut
+            .attach(&socket_path, None, false, true, false, false)
+            .await
+            .is_err());
+        assert!(sut
+            .attach(&socket_path, None, false, false, true, false)
+            .await
+            .is_err());
+
+        let clients = [connect(&socket_path)?, connect(&socket_path)?];
+        while sut.sessions().await.len() < clients.len() {
+            time::sleep(Duration::from_millis(10)).await;
+        }
+
+        sut.write(Pipe::StdOut, b"output").await?;
+        for client in &clients {
+            let mut buf = [0; ATTACH_PACKET_BUF_SIZE];
+            client.readable().await?;
+            let n = client.try_read(&mut buf)?;
+            assert_eq!(&buf[..n], b"\x02output");
+        }
+
+        for (client, input) in clients.iter().zip(["first", "second"]) {
+            client.writable().await?;
+            client.try_write(input.as_bytes())?;
+            assert_eq!(sut.read().await?, input.as_bytes());
+        }
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn output_only_sessions() -> Result<()> {
+        let dir = tempfile::tempdir()?;
+        let socket_path = dir.path().join("attach");
+        let sut = SharedContainerAttach::default();
+        assert!(sut
+            .attach(&socket_path, Some(1), true, false, false, false)
+            .await
+            .is_err());
+        sut.attach(&socket_path, None, true, false, false, false)
+            .await?;
+
+        let client = connect(&socket_path)?;
+        while sut.sessions().await.is_empty() {
+            time::sleep(Duration::from_millis(10)).await;
+        }
+
+        sut.write(Pipe::StdOut, b"output").await?;
+        let mut buf = [0; ATTACH_PACKET_BUF_SIZE];
+        client.readable().await?;
+        let n = client.try_read(&mut buf)?;
+        assert_eq!(&buf[..n], b"\x02output");
+
+        client.writable().await?;
+        client.try_write(b"input")?;
+        assert!(timeout(Duration::from_millis(100), sut.read())
+            .await
+            .is_err());
+        assert_eq!(sut.sessions().await.len(), 1);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn sequenced_sessions() -> Result<()> {
+        let dir = tempfile::tempdir()?;
+        let socket_path = dir.path().join("attach");
+        let sut = SharedContainerAttach::default();
+        assert!(sut
+            .attach(&socket_path, Some(1), false, true, false, false)
+            .await
+            .is_err());
+        sut.attach(&socket_path, None, false, true, false, false)
+            .await?;
+
+        let client = connect(&socket_path)?;
+        while sut.sessions().await.is_empty() {
+            time::sleep(Duration::from_millis(10)).await;
+        }
+
+        sut.write(Pipe::StdOut, b"out").await?;
+        sut.write(Pipe::StdErr, b"err").await?;
+        for expected in [b"\x02\0\0\0\0\0\0\0\0out", b"\x03\0\0\0\0\0\0\0\x01err"] {
+            let mut buf = [0; ATTACH_PACKET_BUF_SIZE];
+            client.readable().await?;
+            let n = client.try_read(&mut buf)?;
+            assert_eq!(&buf[..n], expected);
+        }
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn reported_session_end() -> Result<()> {
+        let dir = tempfile::tempdir()?;
+        let socket_path = dir.path().join("attach");
+        let sut = SharedContainerAttach::default();
+        sut.attach(&socket_path, None, false, true, true, false)
+            .await?;
+
+        let client = connect(&socket_path)?;
+        while sut.sessions().await.is_empty() {
+            time::sleep(Duration::from_millis(10)).await;
+        }
+
+        sut.stop();
+        sut.exited(143).await;
+        let mut buf = [0; ATTACH_PACKET_BUF_SIZE];
+        client.readable().await?;
+        let n = client.try_read(&mut buf)?;
+        assert_eq!(buf[0], END_PACKET_TYPE);
+        assert_eq!(buf[1], SessionEndReason::Stopped as u8);
+        assert_eq!(&buf[2..n], b"container stopped with exit code 143");
+        assert!(sut.sessions().await.is_empty());
+
+        client.readable().await?;
+        assert_eq!(client.try_read(&mut buf)?, 0);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn unreported_session_end() -> Result<()> {
+        let dir = tempfile::tempdir()?;
+        let socket_path = dir.path().join("attach");
+        let sut = SharedContainerAttach::default();
+        sut.attach(&socket_path, None, false, false, false, false)
+            .await?;
+
+        let client = connect(&socket_path)?;
+        while sut.sessions().await.is_empty() {
+            time::sleep(Duration::from_millis(10)).await;
         }
+
+        let id = sut.sessions().await[0].id().clone();
+        assert!(sut.kill_session(&id).await);
+        let mut buf = [0; ATTACH_PACKET_BUF_SIZE];
+        client.readable().await?;
+        assert_eq!(client.try_read(&mut buf)?, 0);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn prioritized_stderr() -> Result<()> {
+        let dir = tempfile::tempdir()?;
+        let socket_path = dir.path().join("attach");
+        let sut = SharedContainerAttach::default();
+        assert!(sut
+            .attach(&socket_path, Some(1), false, false, false, true)
+            .await
+            .is_err());
+        assert!(sut
+            .attach(&socket_path, None, false, true, false, true)
+            .await
+            .is_err());
+        sut.attach(&socket_path, None, false, false, false, true)
+            .await?;
+        assert!(sut
+            .attach(&socket_path, None, false, false, false, false)
+            .await
+            .is_err());
+
+        let client = connect(&socket_path)?;
+        while sut.sessions().await.is_empty() {
+            time::sleep(Duration::from_millis(10)).await;
+        }
+
+        // Congest the session until its stdout queue is full.
+        let attach = sut.attaches.read().await[0].clone();
+        let output = attach.clients.read().await[0].output.clone().unwrap();
+        for _ in 0..OUTPUT_QUEUE_SIZE * 4 {
+            if output.stdout.capacity() == 0 {
+                break;
+            }
+            sut.write(Pipe::StdOut, vec![b'o'; ATTACH_PACKET_BUF_SIZE - 1])
+                .await?;
+        }
+        sut.write(Pipe::StdErr, b"err").await?;
+
+        // The error overtakes the queued output.
+        let mut buf = [0; ATTACH_PACKET_BUF_SIZE];
+        let mut stdout_packets = 0;
+        loop {
+            client.readable().await?;
+            let n = match client.try_read(&mut buf) {
+                Ok(n) => n,
+                Err(ref e) if e.kind() == ErrorKind::WouldBlock => continue,
+                Err(e) => return Err(e.into()),
+            };
+            if buf[0] == 3 {
+                assert_eq!(&buf[..n], b"\x03err");
+                break;
+            }
+            stdout_packets += 1;
+        }
+        assert!(stdout_packets < OUTPUT_QUEUE_SIZE * 4);
+        Ok(())
+    }
+
+    #[test]
+    fn end_packet() {
+        let packet = Attach::end_packet(SessionEndReason::Killed, "killed");
+        assert_eq!(packet, b"\x04\x03killed");
+
+        let message = "x".repeat(ATTACH_PACKET_BUF_SIZE);
+        let packet = Attach::end_packet(SessionEndReason::Exited, &message);
+        assert_eq!(packet.len(), ATTACH_PACKET_BUF_SIZE);
+    }
+
+    #[test]
+    fn packets() {
+        let packets = Attach::packets(Pipe::StdErr, None, vec![1; ATTACH_PACKET_BUF_SIZE]);
+        assert_eq!(packets.len(), 2);
+        assert_eq!(packets[0].len(), ATTACH_PACKET_BUF_SIZE);
+        assert_eq!(packets[0][0], 3);
+        assert_eq!(packets[1], [3, 1]);
+    }
+
+    #[test]
+    fn sequenced_packets() {
+        let packets = Attach::packets(Pipe::StdOut, Some(7), vec![1; ATTACH_PACKET_BUF_SIZE]);
+        assert_eq!(packets.len(), 2);
+        assert_eq!(packets[0].len(), ATTACH_PACKET_BUF_SIZE);
+        assert_eq!(packets[0][..1 + SEQUENCE_SIZE], [2, 0, 0, 0, 0, 0, 0, 0, 7]);
+        assert_eq!(packets[1].len(), 2 * (1 + SEQUENCE_SIZE));
+        assert_eq!(packets[1][..1 + SEQUENCE_SIZE], [2, 0, 0, 0, 0, 0, 0, 0, 7]);
     }
 }
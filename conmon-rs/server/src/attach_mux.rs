@@ -0,0 +1,645 @@
+//! Multiplexing of attach sessions over a single connection, which saves
+//! clients from connecting to the attach socket of every container.
+use crate::{attach::ATTACH_PACKET_BUF_SIZE, container_io::SharedContainerIO, listener};
+use anyhow::{bail, format_err, Context, Result};
+use nix::{
+    sys::{
+        signal::{kill, Signal},
+        socket::{
+            connect, shutdown, socket, AddressFamily, Shutdown, SockFlag, SockType, UnixAddr,
+        },
+    },
+    unistd::Pid,
+};
+use std::{
+    collections::HashMap,
+    convert::TryFrom,
+    os::unix::{
+        io::{AsRawFd, FromRawFd},
+        net,
+    },
+    path::{Path, PathBuf},
+    sync::Arc,
+};
+use tokio::{
+    io::{AsyncRead, AsyncReadExt, AsyncWrite, AsyncWriteExt, ErrorKind},
+    net::{UnixListener, UnixStream},
+    sync::{
+        mpsc::{self, Sender, UnboundedReceiver, UnboundedSender},
+        RwLock,
+    },
+    task,
+};
+use tracing::{debug, debug_span, error, Instrument};
+
+/// The size of a frame header, which consists of the little endian `u32`
+/// session ID, the frame kind and the little endian `u32` payload length.
+const HEADER_SIZE: usize = 9;
+
+/// The maximum payload size of a frame.
+const MAX_PAYLOAD_SIZE: usize = ATTACH_PACKET_BUF_SIZE;
+
+/// The number of frames which can be queued for writing per connection.
+const FRAME_QUEUE_SIZE: usize = 64;
+
+/// Opens a session for the attach socket path in the payload. The server
+/// acknowledges it with an empty frame of the same kind.
+const KIND_OPEN: u8 = 1;
+
+/// Standard input from the client or an attach packet from the server.
+const KIND_DATA: u8 = 2;
+
+/// Closes the standard input of the session.
+const KIND_CLOSE_WRITE: u8 = 3;
+
+/// Closes the session. Sent by the server with an optional error message
+/// if the session could not be opened or has ended.
+const KIND_CLOSE: u8 = 4;
+
+/// Resizes the terminal of the session to the little endian `u16` width and
+/// height in the payload.
+const KIND_RESIZE: u8 = 5;
+
+/// Sends the little endian `i32` signal in the payload to the process of the
+/// session.
+const KIND_SIGNAL: u8 = 6;
+
+/// Set in the first payload byte of the open acknowledgement if the server
+/// supports the resize and signal frames.
+const FEATURE_CONTROL: u8 = 1;
+
+/// The type of the attach packets of the standard error.
+const STDERR_PACKET_TYPE: u8 = 3;
+
+#[derive(Debug, Default)]
+/// The multiplexed attach endpoint of the server.
+///
+/// Clients open sessions by referencing attach sockets which have been
+/// created using the attach container RPC. Every session is bridged to its
+/// attach socket, which means that session policies and listing or killing
+/// sessions work like for clients connecting directly.
+///
+/// Control frames travel in a separate lane, which is written before any
+/// queued output and handled without waiting for queued input. This keeps
+/// resizing, signaling and closing sessions responsive while the output of
+/// other sessions saturates the connection. The standard error of endpoints
+/// with stream priorities travels in a lane between both.
+pub struct AttachMux {
+    endpoints: RwLock<HashMap<PathBuf, Endpoint>>,
+}
+
+#[derive(Clone, Debug)]
+/// An attach endpoint which sessions can be opened for.
+struct Endpoint {
+    control: Option<SessionControl>,
+
+    /// Whether the standard error packets of the sessions are written before
+    /// their queued standard output.
+    stderr_priority: bool,
+}
+
+#[derive(Clone, Debug)]
+/// The process behind an attach endpoint, which is controlled by the resize
+/// and signal frames.
+pub struct SessionControl {
+    io: SharedContainerIO,
+    pid: u32,
+}
+
+impl SessionControl {
+    /// Create a new session control for the IO and process of a container or
+    /// exec session.
+    pub fn new(io: SharedContainerIO, pid: u32) -> Self {
+        Self { io, pid }
+    }
+
+    /// Handle a control frame of the client.
+    fn handle(&self, frame: &Frame) -> Result<()> {
+        match frame.kind {
+            KIND_RESIZE => {
+                let payload = <[u8; 4]>::try_from(frame.payload.as_slice())
+                    .context("invalid resize payload")?;
+                let width = u16::from_le_bytes([payload[0], payload[1]]);
+                let height = u16::from_le_bytes([payload[2], payload[3]]);
+                let io = self.io.clone();
+                let id = frame.session;
+                task::spawn(async move {
+                    if let Err(e) = io.resize(width, height).await {
+                        debug!("Unable to resize attach mux session {}: {:#}", id, e);
+                    }
+                });
+            }
+            KIND_SIGNAL => {
+                let payload = <[u8; 4]>::try_from(frame.payload.as_slice())
+                    .context("invalid signal payload")?;
+                let signal =
+                    Signal::try_from(i32::from_le_bytes(payload)).context("invalid signal")?;
+                kill(Pid::from_raw(self.pid as i32), signal).context("send signal")?;
+            }
+            kind => bail!("no control frame kind {}", kind),
+        }
+        Ok(())
+    }
+}
+
+/// A session of a client connection.
+struct Session {
+    input: UnboundedSender<Input>,
+    control: Option<SessionControl>,
+}
+
+#[derive(Clone)]
+/// The sender of the frames to the client, which writes control frames
+/// before any queued data frames, and prioritized data frames before the
+/// other ones.
+struct FrameSender {
+    control: UnboundedSender<Frame>,
+    priority: Sender<Frame>,
+    data: Sender<Frame>,
+}
+
+impl FrameSender {
+    /// Queue a control frame, which does not wait for queued data frames.
+    fn control(&self, frame: Frame) -> Result<()> {
+        self.control
+            .send(frame)
+            .map_err(|_| format_err!("attach mux connection closed"))
+    }
+
+    /// Queue a data frame, waiting if the queue is full.
+    async fn data(&self, frame: Frame) -> Result<()> {
+        self.data
+            .send(frame)
+            .await
+            .map_err(|_| format_err!("attach mux connection closed"))
+    }
+
+    /// Queue a data frame ahead of the other data frames, waiting if the
+    /// queue is full.
+    async fn priority(&self, frame: Frame) -> Result<()> {
+        self.priority
+            .send(frame)
+            .await
+            .map_err(|_| format_err!("attach mux connection closed"))
+    }
+}
+
+#[derive(Debug)]
+/// The input of a session received from the client.
+enum Input {
+    Data(Vec<u8>),
+    CloseWrite,
+}
+
+impl AttachMux {
+    /// Allow sessions to be opened for the attach socket path, which can be
+    /// resized and signaled by the client if a control is provided. Their
+    /// standard error gets written before their queued standard output if
+    /// `stderr_priority` is set.
+    pub async fn register(
+        &self,
+        socket_path: &Path,
+        control: Option<SessionControl>,
+        stderr_priority: bool,
+    ) {
+        self.endpoints.write().await.insert(
+            socket_path.into(),
+            Endpoint {
+                control,
+                stderr_priority,
+            },
+        );
+    }
+
+    /// Serve the clients connecting to the listener.
+    pub async fn serve(self: Arc<Self>, listener: UnixListener) {
+        loop {
+            let stream = match listener.accept().await {
+                Ok((stream, _)) => stream,
+                Err(e) => {
+                    error!("Unable to accept attach mux connection: {}", e);
+                    return;
+                }
+            };
+            let mux = self.clone();
+            task::spawn(
+                async move {
+                    if let Err(e) = mux.handle(stream).await {
+                        debug!("Attach mux connection failed: {:#}", e);
+                    }
+                }
+                .instrument(debug_span!("attach_mux")),
+            );
+        }
+    }
+
+    /// Handle the frames of a single client connection. All sessions of the
+    /// connection get closed if it disconnects.
+    async fn handle(&self, stream: UnixStream) -> Result<()> {
+        let (mut reader, mut writer) = stream.into_split();
+        let (control_tx, mut control_rx) = mpsc::unbounded_channel::<Frame>();
+        let (priority_tx, mut priority_rx) = mpsc::channel::<Frame>(FRAME_QUEUE_SIZE);
+        let (data_tx, mut data_rx) = mpsc::channel::<Frame>(FRAME_QUEUE_SIZE);
+        task::spawn(async move {
+            loop {
+                let frame = tokio::select! {
+                    biased;
+                    Some(frame) = control_rx.recv() => frame,
+                    Some(frame) = priority_rx.recv() => frame,
+                    Some(frame) = data_rx.recv() => frame,
+                    else => return,
+                };
+                if let Err(e) = frame.write(&mut writer).await {
+                    debug!("Unable to write attach mux frame: {:#}", e);
+                    return;
+                }
+            }
+        });
+        let frames = FrameSender {
+            control: control_tx,
+            priority: priority_tx,
+            data: data_tx,
+        };
+
+        let mut sessions: HashMap<u32, Session> = HashMap::new();
+        while let Some(frame) = Frame::read(&mut reader).await? {
+            match frame.kind {
+                KIND_OPEN => {
+                    let id = frame.session;
+                    let path = PathBuf::from(String::from_utf8_lossy(&frame.payload).as_ref());
+                    let res = if sessions.contains_key(&id) {
+                        Err(format_err!("session {} is already open", id))
+                    } else {
+                        self.open(&path).await
+                    };
+                    match res {
+                        Ok((stream, endpoint)) => {
+                            debug!("Opened attach mux session {} for {}", id, path.display());
+                            let (input_tx, input_rx) = mpsc::unbounded_channel();
+                            sessions.insert(
+                                id,
+                                Session {
+                                    input: input_tx,
+                                    control: endpoint.control,
+                                },
+                            );
+                            // The acknowledgement precedes all output of
+                            // the session, because control frames are
+                            // written first.
+                            frames.control(Frame::new(id, KIND_OPEN, vec![FEATURE_CONTROL]))?;
+                            Self::bridge(
+                                id,
+                                stream,
+                                input_rx,
+                                frames.clone(),
+                                endpoint.stderr_priority,
+                            );
+                        }
+                        Err(e) => {
+                            let message = format!("open session: {:#}", e);
+                            frames.control(Frame::close(id, &message))?;
+                        }
+                    }
+                }
+                KIND_DATA => Self::forward(&sessions, frame.session, Input::Data(frame.payload)),
+                KIND_CLOSE_WRITE => Self::forward(&sessions, frame.session, Input::CloseWrite),
+                KIND_CLOSE => {
+                    // Dropping the input closes the bridge.
+                    sessions.remove(&frame.session);
+                }
+                KIND_RESIZE | KIND_SIGNAL => {
+                    let res = match sessions.get(&frame.session).map(|x| &x.control) {
+                        Some(Some(control)) => control.handle(&frame),
+                        Some(None) => Err(format_err!("session cannot be controlled")),
+                        None => Err(format_err!("session is not open")),
+                    };
+                    if let Err(e) = res {
+                        debug!(
+                            "Unable to handle control frame of attach mux session {}: {:#}",
+                            frame.session, e
+                        );
+                    }
+                }
+                kind => debug!("Ignoring attach mux frame of unknown kind {}", kind),
+            }
+        }
+        debug!("Attach mux connection closed");
+        Ok(())
+    }
+
+    /// Connect to the attach socket for a new session.
+    async fn open(&self, socket_path: &Path) -> Result<(UnixStream, Endpoint)> {
+        let endpoint = {
+            let mut endpoints = self.endpoints.write().await;
+            // Attach sockets get removed together with their containers.
+            endpoints.retain(|x, _| x.exists());
+            match endpoints.get(socket_path) {
+                Some(endpoint) => endpoint.clone(),
+                None => bail!("no attach endpoint for {}", socket_path.display()),
+            }
+        };
+
+        let fd = socket(
+            AddressFamily::Unix,
+            SockType::SeqPacket,
+            SockFlag::SOCK_NONBLOCK | SockFlag::SOCK_CLOEXEC,
+            None,
+        )
+        .context("create socket")?;
+        // Take the ownership of the fd to close it on failure.
+        let stream = unsafe { net::UnixStream::from_raw_fd(fd) };
+
+        // keep parent_fd in scope until the connect, or else the path cannot be resolved
+        let (shortened_path, _parent_dir) = listener::shorten_socket_path(socket_path)?;
+        let addr = UnixAddr::new(&shortened_path).context("create socket addr")?;
+        connect(fd, &addr).context("connect to attach socket")?;
+
+        let stream = UnixStream::from_std(stream).context("register attach stream")?;
+        Ok((stream, endpoint))
+    }
+
+    /// Forward the input to the session, which is ignored if the session does
+    /// not exist any more.
+    fn forward(sessions: &HashMap<u32, Session>, id: u32, input: Input) {
+        let sent = sessions
+            .get(&id)
+            .map(|x| x.input.send(input).is_ok())
+            .unwrap_or_default();
+        if !sent {
+            debug!("Dropping input for closed attach mux session {}", id);
+        }
+    }
+
+    /// Bridge the session to its attach socket until either side closes it.
+    /// The standard error packets take the priority lane if
+    /// `stderr_priority` is set.
+    fn bridge(
+        id: u32,
+        stream: UnixStream,
+        mut input: UnboundedReceiver<Input>,
+        frames: FrameSender,
+        stderr_priority: bool,
+    ) {
+        task::spawn(
+            async move {
+                let mut buf = vec![0; ATTACH_PACKET_BUF_SIZE];
+                // Returns whether the client has to be notified about the
+                // closed session.
+                let res: Result<bool> = async {
+                    loop {
+                        tokio::select! {
+                            ready = stream.readable() => {
+                                ready.context("wait for attach socket")?;
+                                match stream.try_read(&mut buf) {
+                                    Ok(0) => return Ok(true),
+                                    Ok(n) => {
+                                        let frame = Frame::new(id, KIND_DATA, buf[..n].to_vec());
+                                        if stderr_priority && buf[0] == STDERR_PACKET_TYPE {
+                                            frames.priority(frame).await
+                                        } else {
+                                            frames.data(frame).await
+                                        }
+                                        .context("queue output")?
+                                    }
+                                    Err(ref e) if e.kind() == ErrorKind::WouldBlock => {}
+                                    Err(e) => return Err(e).context("read attach socket"),
+                                }
+                            }
+                            input = input.recv() => match input {
+                                Some(Input::Data(data)) => Self::write_packet(&stream, &data).await?,
+                                Some(Input::CloseWrite) => shutdown(stream.as_raw_fd(), Shutdown::Write)
+                                    .context("close attach socket stdin")?,
+                                // The client closed the session.
+                                None => return Ok(false),
+                            },
+                        }
+                    }
+                }
+                .await;
+
+                let message = match res {
+                    Ok(false) => return,
+                    Ok(true) => String::new(),
+                    Err(e) => format!("{:#}", e),
+                };
+                debug!("Closing attach mux session {}", id);
+                // The close frame uses the data lane to follow the output of
+                // the session.
+                if frames.data(Frame::close(id, &message)).await.is_err() {
+                    debug!("Attach mux connection already closed");
+                }
+            }
+            .instrument(debug_span!("attach_mux_session", session = id)),
+        );
+    }
+
+    async fn write_packet(stream: &UnixStream, packet: &[u8]) -> Result<()> {
+        loop {
+            stream.writable().await.context("wait for attach socket")?;
+            match stream.try_write(packet) {
+                Ok(_) => return Ok(()),
+                Err(ref e) if e.kind() == ErrorKind::WouldBlock => continue,
+                Err(e) => return Err(e).context("write attach socket"),
+            }
+        }
+    }
+}
+
+#[derive(Debug, PartialEq)]
+/// A single frame of the multiplexed connection.
+struct Frame {
+    session: u32,
+    kind: u8,
+    payload: Vec<u8>,
+}
+
+impl Frame {
+    fn new(session: u32, kind: u8, payload: Vec<u8>) -> Self {
+        Self {
+            session,
+            kind,
+            payload,
+        }
+    }
+
+    /// Create a close frame, with the message truncated to fit the payload.
+    fn close(session: u32, message: &str) -> Self {
+        let mut payload = message.as_bytes().to_vec();
+        payload.truncate(MAX_PAYLOAD_SIZE);
+        Self::new(session, KIND_CLOSE, payload)
+    }
+
+    /// Read the next frame, `None` if the connection has been closed.
+    async fn read<R: AsyncRead + Unpin>(reader: &mut R) -> Result<Option<Self>> {
+        let mut header = [0; HEADER_SIZE];
+        match reader.read_exact(&mut header).await {
+            Ok(_) => {}
+            Err(e) if e.kind() == ErrorKind::UnexpectedEof => return Ok(None),
+            Err(e) => return Err(e).context("read frame header"),
+        }
+
+        let mut session = [0; 4];
+        session.copy_from_slice(&header[..4]);
+        let mut len = [0; 4];
+        len.copy_from_slice(&header[5..]);
+        let len = u32::from_le_bytes(len) as usize;
+        if len > MAX_PAYLOAD_SIZE {
+            bail!("frame payload of {} bytes exceeds the maximum", len)
+        }
+
+        let mut payload = vec![0; len];
+        reader
+            .read_exact(&mut payload)
+            .await
+            .context("read frame payload")?;
+        Ok(Some(Self::new(
+            u32::from_le_bytes(session),
+            header[4],
+            payload,
+        )))
+    }
+
+    async fn write<W: AsyncWrite + Unpin>(&self, writer: &mut W) -> Result<()> {
+        let mut buf = Vec::with_capacity(HEADER_SIZE + self.payload.len());
+        buf.extend_from_slice(&self.session.to_le_bytes());
+        buf.push(self.kind);
+        buf.extend_from_slice(&(self.payload.len() as u32).to_le_bytes());
+        buf.extend_from_slice(&self.payload);
+        writer.write_all(&buf).await.context("write frame")
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use crate::{
+        attach::SharedContainerAttach,
+        container_io::{ContainerIO, Pipe},
+        container_log::ContainerLog,
+    };
+    use std::os::unix::process::ExitStatusExt;
+    use tokio::time::{self, Duration};
+
+    #[tokio::test]
+    async fn frame_roundtrip() -> Result<()> {
+        let (mut reader, mut writer) = UnixStream::pair()?;
+        let frame = Frame::new(7, KIND_DATA, b"data".to_vec());
+        frame.write(&mut writer).await?;
+        Frame::close(7, "").write(&mut writer).await?;
+        drop(writer);
+
+        assert_eq!(Frame::read(&mut reader).await?, Some(frame));
+        assert_eq!(
+            Frame::read(&mut reader).await?,
+            Some(Frame::new(7, KIND_CLOSE, vec![]))
+        );
+        assert_eq!(Frame::read(&mut reader).await?, None);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn frame_exceeding_max_payload() -> Result<()> {
+        let (mut reader, mut writer) = UnixStream::pair()?;
+        let frame = Frame::new(1, KIND_DATA, vec![0; MAX_PAYLOAD_SIZE + 1]);
+        task::spawn(async move { frame.write(&mut writer).await });
+        assert!(Frame::read(&mut reader).await.is_err());
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn sessions() -> Result<()> {
+        let dir = tempfile::tempdir()?;
+        let socket_path = dir.path().join("attach");
+        let attach = SharedContainerAttach::default();
+        attach
+            .attach(&socket_path, None, false, false, false)
+            .await?;
+
+        let sut = Arc::new(AttachMux::default());
+        sut.register(&socket_path, None, false).await;
+        let (mut client, server) = UnixStream::pair()?;
+        let mux = sut.clone();
+        task::spawn(async move { mux.handle(server).await });
+
+        let path = socket_path.to_string_lossy().as_bytes().to_vec();
+        Frame::new(1, KIND_OPEN, path.clone())
+            .write(&mut client)
+            .await?;
+        Frame::new(2, KIND_OPEN, b"/unknown".to_vec())
+            .write(&mut client)
+            .await?;
+        Frame::new(3, KIND_OPEN, path).write(&mut client).await?;
+
+        let mut opened = vec![];
+        for _ in 0..3 {
+            let frame = Frame::read(&mut client).await?.unwrap();
+            match frame.kind {
+                KIND_OPEN => {
+                    assert_eq!(frame.payload, [FEATURE_CONTROL]);
+                    opened.push(frame.session)
+                }
+                KIND_CLOSE => {
+                    assert_eq!(frame.session, 2);
+                    assert!(String::from_utf8_lossy(&frame.payload).contains("no attach endpoint"));
+                }
+                kind => panic!("unexpected frame kind {}", kind),
+            }
+        }
+        assert_eq!(opened, [1, 3]);
+
+        while attach.sessions().await.len() < 2 {
+            time::sleep(Duration::from_millis(10)).await;
+        }
+
+        attach.write(Pipe::StdOut, b"output").await?;
+        for _ in 0..2 {
+            let frame = Frame::read(&mut client).await?.unwrap();
+            assert_eq!(frame.kind, KIND_DATA);
+            assert_eq!(frame.payload, b"\x02output");
+        }
+
+        Frame::new(3, KIND_DATA, b"input".to_vec())
+            .write(&mut client)
+            .await?;
+        assert_eq!(attach.read().await?, b"input");
+
+        Frame::new(1, KIND_CLOSE, vec![]).write(&mut client).await?;
+        while attach.sessions().await.len() > 1 {
+            time::sleep(Duration::from_millis(10)).await;
+        }
+        for session in attach.sessions().await {
+            assert!(attach.kill_session(session.id()).await);
+        }
+        let frame = Frame::read(&mut client).await?.unwrap();
+        assert_eq!(frame, Frame::new(3, KIND_CLOSE, vec![]));
+        Ok(())
+    }
+
+    #[test]
+    fn session_control_signal() -> Result<()> {
+        let mut child = std::process::Command::new("sleep").arg("10").spawn()?;
+        let io = SharedContainerIO::new(ContainerIO::new(false, ContainerLog::new())?);
+        let sut = SessionControl::new(io, child.id());
+
+        assert!(sut.handle(&Frame::new(1, KIND_SIGNAL, vec![0; 2])).is_err());
+        sut.handle(&Frame::new(
+            1,
+            KIND_SIGNAL,
+            (Signal::SIGKILL as i32).to_le_bytes().to_vec(),
+        ))?;
+        assert_eq!(child.wait()?.signal(), Some(Signal::SIGKILL as i32));
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn session_control_resize() -> Result<()> {
+        let io = SharedContainerIO::new(ContainerIO::new(false, ContainerLog::new())?);
+        let sut = SessionControl::new(io, std::process::id());
+
+        // Resizing a container without terminal fails asynchronously.
+        sut.handle(&Frame::new(1, KIND_RESIZE, vec![80, 0, 24, 0]))?;
+        assert!(sut.handle(&Frame::new(1, KIND_RESIZE, vec![80])).is_err());
+        assert!(sut.handle(&Frame::new(1, KIND_DATA, vec![])).is_err());
+        Ok(())
+    }
+}
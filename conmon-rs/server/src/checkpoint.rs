@@ -0,0 +1,369 @@
+//! Checkpointing and restoring of containers using the OCI runtime.
+use crate::{orphan_reaper::SpawnedGuard, rpc_error::RpcError};
+use anyhow::{bail, Context, Result};
+use capnp::capability::Promise;
+use capnp_rpc::pry;
+use conmon_common::conmon_capnp::conmon::checkpoint_image_writer;
+use std::{
+    ffi::OsStr,
+    fs,
+    io::Write,
+    os::unix::fs::{OpenOptionsExt, PermissionsExt},
+    path::{Component, Path, PathBuf},
+    process::Stdio,
+};
+use tempfile::TempDir;
+use tokio::{fs::File, io::AsyncReadExt, process::Command};
+use tracing::debug;
+
+/// The size of the chunks a checkpoint image gets streamed in.
+const CHUNK_SIZE: usize = 64 * 1024;
+
+#[derive(Clone, Debug)]
+/// The options of a container checkpoint, which have to match between
+/// checkpointing and restoring.
+pub struct CheckpointOptions {
+    /// Directory the checkpoint image gets written to or read from.
+    image_path: PathBuf,
+
+    /// Directory for the CRIU logs and work files, the runtime default if
+    /// not set.
+    work_path: Option<PathBuf>,
+
+    /// Whether established TCP connections get checkpointed.
+    tcp_established: bool,
+}
+
+impl CheckpointOptions {
+    /// Create new checkpoint options.
+    pub fn new<T: AsRef<Path>>(image_path: T, work_path: Option<T>, tcp_established: bool) -> Self {
+        Self {
+            image_path: image_path.as_ref().into(),
+            work_path: work_path.map(|x| x.as_ref().into()),
+            tcp_established,
+        }
+    }
+
+    /// The directory of the checkpoint image.
+    pub fn image_path(&self) -> &Path {
+        &self.image_path
+    }
+
+    /// The runtime arguments shared by checkpointing and restoring.
+    pub fn args(&self) -> Vec<String> {
+        let mut args = vec![format!("--image-path={}", self.image_path.display())];
+        if let Some(work_path) = &self.work_path {
+            args.push(format!("--work-path={}", work_path.display()));
+        }
+        if self.tcp_established {
+            args.push("--tcp-established".into());
+        }
+        args
+    }
+}
+
+/// Run the runtime until it exits, failing with its error output if it was
+/// not successful.
+pub async fn run_runtime<P, I, S>(runtime: P, args: I) -> Result<()>
+where
+    P: AsRef<OsStr>,
+    I: IntoIterator<Item = S>,
+    S: AsRef<OsStr>,
+{
+    let child = Command::new(runtime)
+        .args(args)
+        .stdin(Stdio::null())
+        .stdout(Stdio::piped())
+        .stderr(Stdio::piped())
+        .spawn()
+        .context("spawn runtime")?;
+    let _spawned = SpawnedGuard::new(&child);
+    let output = child.wait_with_output().await.context("run runtime")?;
+    if !output.status.success() {
+        return Err(RpcError::runtime_output(
+            format!("runtime exited with {}", output.status),
+            &output.stderr,
+        )
+        .into());
+    }
+    debug!("Runtime exited successfully");
+    Ok(())
+}
+
+/// Stream the regular files of a checkpoint image to a client.
+pub async fn stream_image(
+    image_path: &Path,
+    writer: &checkpoint_image_writer::Client,
+) -> Result<()> {
+    let mut buf = vec![0; CHUNK_SIZE];
+    for path in image_files(image_path)? {
+        let full_path = image_path.join(&path);
+        let mut file = File::open(&full_path)
+            .await
+            .with_context(|| format!("open {}", full_path.display()))?;
+        let metadata = file.metadata().await.context("get image file metadata")?;
+
+        let mut request = writer.file_request();
+        let mut params = request.get();
+        params.set_path(&path.to_string_lossy());
+        params.set_size(metadata.len());
+        params.set_mode(metadata.permissions().mode() & 0o7777);
+        request.send().promise.await.context("send image file")?;
+
+        // Only the content present when starting is streamed, which matches
+        // the announced size.
+        let mut remaining = metadata.len();
+        while remaining > 0 {
+            let limit = buf.len().min(remaining as usize);
+            let read = file
+                .read(&mut buf[..limit])
+                .await
+                .with_context(|| format!("read {}", full_path.display()))?;
+            if read == 0 {
+                bail!("{} got truncated while streaming", full_path.display());
+            }
+            remaining -= read as u64;
+
+            let mut request = writer.write_request();
+            request.get().set_data(&buf[..read]);
+            request.send().promise.await.context("send image data")?;
+        }
+    }
+    debug!("Streamed checkpoint image {}", image_path.display());
+    Ok(())
+}
+
+/// The receiving side of a streamed checkpoint image, which writes the files
+/// below the image path. A temporary image path gets removed once the upload
+/// is dropped, which happens when the client releases it.
+pub struct ImageUpload {
+    image_path: PathBuf,
+    _temp_dir: Option<TempDir>,
+    current: Option<(fs::File, u64)>,
+}
+
+impl ImageUpload {
+    /// Create a new upload to the image path, which is a temporary directory
+    /// below the parent if not specified.
+    pub fn new(image_path: Option<&Path>, parent: &Path) -> Result<Self> {
+        let (image_path, temp_dir) = match image_path {
+            Some(path) => {
+                fs::create_dir_all(path)
+                    .with_context(|| format!("create image path {}", path.display()))?;
+                (path.into(), None)
+            }
+            None => {
+                let temp_dir = tempfile::Builder::new()
+                    .prefix("checkpoint")
+                    .tempdir_in(parent)
+                    .context("create temporary image path")?;
+                (temp_dir.path().into(), Some(temp_dir))
+            }
+        };
+        Ok(Self {
+            image_path,
+            _temp_dir: temp_dir,
+            current: None,
+        })
+    }
+
+    /// The directory the image gets written to.
+    pub fn image_path(&self) -> &Path {
+        &self.image_path
+    }
+
+    /// Start writing the next file, failing if the previous one is
+    /// incomplete.
+    fn start_file(&mut self, path: &str, size: u64, mode: u32) -> Result<()> {
+        self.finish_file()?;
+
+        let relative = Path::new(path);
+        if relative
+            .components()
+            .any(|x| !matches!(x, Component::Normal(_)))
+        {
+            bail!("invalid image file path {}", path);
+        }
+        let full_path = self.image_path.join(relative);
+        if let Some(parent) = full_path.parent() {
+            fs::create_dir_all(parent)
+                .with_context(|| format!("create directory {}", parent.display()))?;
+        }
+        let file = fs::OpenOptions::new()
+            .write(true)
+            .create_new(true)
+            .mode(mode & 0o777)
+            .open(&full_path)
+            .with_context(|| format!("create {}", full_path.display()))?;
+        self.current = Some((file, size));
+        Ok(())
+    }
+
+    /// Write the next chunk of the current file.
+    fn write_data(&mut self, data: &[u8]) -> Result<()> {
+        let (file, remaining) = self.current.as_mut().context("no image file started")?;
+        if data.len() as u64 > *remaining {
+            bail!("image file exceeds its size");
+        }
+        file.write_all(data).context("write image file")?;
+        *remaining -= data.len() as u64;
+        Ok(())
+    }
+
+    /// Check that the current file is complete.
+    fn finish_file(&mut self) -> Result<()> {
+        if let Some((_, remaining)) = self.current.take() {
+            if remaining > 0 {
+                bail!("image file is missing {} bytes", remaining);
+            }
+        }
+        Ok(())
+    }
+}
+
+impl checkpoint_image_writer::Server for ImageUpload {
+    fn file(
+        &mut self,
+        params: checkpoint_image_writer::FileParams,
+        _: checkpoint_image_writer::FileResults,
+    ) -> Promise<(), capnp::Error> {
+        let params = pry!(params.get());
+        upload_result(self.start_file(
+            pry!(params.get_path()),
+            params.get_size(),
+            params.get_mode(),
+        ))
+    }
+
+    fn write(
+        &mut self,
+        params: checkpoint_image_writer::WriteParams,
+        _: checkpoint_image_writer::WriteResults,
+    ) -> Promise<(), capnp::Error> {
+        upload_result(self.write_data(pry!(pry!(params.get()).get_data())))
+    }
+}
+
+/// Convert the result of an upload call.
+fn upload_result(res: Result<()>) -> Promise<(), capnp::Error> {
+    match res {
+        Ok(()) => Promise::ok(()),
+        Err(e) => Promise::err(capnp::Error::failed(format!("{:#}", e))),
+    }
+}
+
+/// Collect the regular files below the directory by their paths relative to
+/// it, sorted by path.
+fn image_files(dir: &Path) -> Result<Vec<PathBuf>> {
+    let mut files = vec![];
+    let mut dirs = vec![PathBuf::new()];
+    while let Some(relative) = dirs.pop() {
+        let path = dir.join(&relative);
+        for entry in fs::read_dir(&path).with_context(|| format!("read {}", path.display()))? {
+            let entry = entry.context("read directory entry")?;
+            let file_type = entry.file_type().context("get file type")?;
+            if file_type.is_dir() {
+                dirs.push(relative.join(entry.file_name()));
+            } else if file_type.is_file() {
+                files.push(relative.join(entry.file_name()));
+            }
+        }
+    }
+    files.sort();
+    Ok(files)
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn checkpoint_args() {
+        let sut = CheckpointOptions::new("/image", None, false);
+        assert_eq!(sut.args(), ["--image-path=/image"]);
+
+        let sut = CheckpointOptions::new("/image", Some("/work"), true);
+        assert_eq!(
+            sut.args(),
+            [
+                "--image-path=/image",
+                "--work-path=/work",
+                "--tcp-established"
+            ]
+        );
+    }
+
+    #[test]
+    fn image_files_recursive() -> Result<()> {
+        let dir = tempfile::tempdir()?;
+        fs::write(dir.path().join("pages-1.img"), "pages")?;
+        fs::write(dir.path().join("inventory.img"), "inventory")?;
+        fs::create_dir(dir.path().join("sub"))?;
+        fs::write(dir.path().join("sub").join("file"), "")?;
+        std::os::unix::fs::symlink("inventory.img", dir.path().join("link"))?;
+
+        assert_eq!(
+            image_files(dir.path())?,
+            [
+                PathBuf::from("inventory.img"),
+                PathBuf::from("pages-1.img"),
+                PathBuf::from("sub/file"),
+            ]
+        );
+        Ok(())
+    }
+
+    #[test]
+    fn image_upload_roundtrip() -> Result<()> {
+        let dir = tempfile::tempdir()?;
+        let mut sut = ImageUpload::new(None, dir.path())?;
+        let image_path = sut.image_path().to_owned();
+        assert!(image_path.starts_with(dir.path()));
+
+        sut.start_file("sub/file", 4, 0o600)?;
+        sut.write_data(b"da")?;
+        sut.write_data(b"ta")?;
+        assert!(sut.write_data(b"x").is_err());
+        sut.start_file("empty", 0, 0o644)?;
+        sut.finish_file()?;
+        assert_eq!(fs::read(image_path.join("sub/file"))?, b"data");
+        assert_eq!(
+            fs::metadata(image_path.join("sub/file"))?
+                .permissions()
+                .mode()
+                & 0o777,
+            0o600
+        );
+
+        drop(sut);
+        assert!(!image_path.exists());
+        Ok(())
+    }
+
+    #[test]
+    fn image_upload_failure() -> Result<()> {
+        let dir = tempfile::tempdir()?;
+        let mut sut = ImageUpload::new(Some(dir.path()), dir.path())?;
+        assert!(sut.write_data(b"data").is_err());
+        assert!(sut.start_file("../file", 0, 0o644).is_err());
+        assert!(sut.start_file("/file", 0, 0o644).is_err());
+
+        sut.start_file("file", 4, 0o644)?;
+        assert!(sut.start_file("other", 0, 0o644).is_err());
+
+        drop(sut);
+        assert!(dir.path().exists());
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn run_runtime_failure() -> Result<()> {
+        run_runtime("true", &[] as &[&str]).await?;
+
+        let err = run_runtime("sh", &["-c", "echo failed >&2; exit 1"])
+            .await
+            .unwrap_err();
+        assert!(err.to_string().contains("failed"));
+        Ok(())
+    }
+}
@@ -1,4 +1,4 @@
-use crate::container_io::SharedContainerIO;
+use crate::{container_io::SharedContainerIO, runtime_options::RuntimeOptions};
 use getset::{CopyGetters, Getters};
 use std::path::PathBuf;
 use tokio::time::Instant;
@@ -22,6 +22,12 @@ pub struct Child {
 
     #[getset(get = "pub")]
     io: SharedContainerIO,
+
+    #[getset(get = "pub")]
+    tenant: String,
+
+    #[getset(get = "pub")]
+    runtime: Option<RuntimeOptions>,
 }
 
 impl Child {
@@ -32,6 +38,7 @@ impl Child {
         oom_exit_paths: Vec<PathBuf>,
         timeout: Option<Instant>,
         io: SharedContainerIO,
+        tenant: String,
     ) -> Self {
         Self {
             id,
@@ -40,6 +47,25 @@ impl Child {
             oom_exit_paths,
             timeout,
             io,
+            tenant,
+            runtime: None,
         }
     }
+
+    /// Set the runtime options of a container, which override the ones of
+    /// the server.
+    pub fn with_runtime(mut self, runtime: RuntimeOptions) -> Self {
+        self.runtime = Some(runtime);
+        self
+    }
+
+    /// Generate the identifier of an exec session for the provided container.
+    pub fn exec_session_id(container_id: &str, exec_session_id: &str) -> String {
+        format!("{}/exec/{}", container_id, exec_session_id)
+    }
+
+    /// Returns true if the provided child ID belongs to an exec session.
+    pub fn is_exec_session(id: &str) -> bool {
+        id.contains("/exec/")
+    }
 }
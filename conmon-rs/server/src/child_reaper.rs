@@ -2,7 +2,12 @@
 use crate::{
     child::Child,
     container_io::{ContainerIO, ContainerIOType, SharedContainerIO},
+    fd_mapping::MappedFds,
+    labels::Labels,
     oom_watcher::OOMWatcher,
+    orphan_reaper::SpawnedGuard,
+    pidfd::PidFd,
+    runtime_options::RuntimeOptions,
 };
 use anyhow::{anyhow, format_err, Context, Result};
 use getset::{CopyGetters, Getters, Setters};
@@ -18,9 +23,12 @@ use nix::{
 };
 use std::{
     ffi::OsStr,
+    io,
+    os::unix::io::{AsRawFd, RawFd},
     path::{Path, PathBuf},
     process::Stdio,
     sync::{Arc, Mutex},
+    time::Duration,
 };
 use tokio::{
     fs::{self, File},
@@ -54,12 +62,33 @@ impl ChildReaper {
         Ok(r)
     }
 
+    /// Retrieve all currently watched children.
+    pub fn children(&self) -> Result<Vec<ReapableChild>> {
+        let locked_grandchildren = &self.grandchildren().clone();
+        let lock = lock!(locked_grandchildren);
+        Ok(lock
+            .iter_all()
+            .flat_map(|(_, children)| children.iter().cloned())
+            .collect())
+    }
+
+    /// Retrieve the IDs of all children without blocking, which is required
+    /// while panicking. Returns `None` if the children are currently locked.
+    pub fn try_ids(&self) -> Option<Vec<String>> {
+        let lock = self.grandchildren().try_lock().ok()?;
+        Some(lock.keys().cloned().collect())
+    }
+
+    /// Run the runtime command and return the PID of the grandchild. The
+    /// mapped fds get passed to the command as fd 3 and onwards, together
+    /// with their socket activation environment.
     pub async fn create_child<P, I, S>(
         &self,
         cmd: P,
         args: I,
         container_io: &mut ContainerIO,
         pidfile: &Path,
+        mapped_fds: &MappedFds,
     ) -> Result<u32>
     where
         P: AsRef<OsStr>,
@@ -68,12 +97,23 @@ impl ChildReaper {
     {
         let mut cmd = Command::new(cmd);
         cmd.args(args);
+        cmd.envs(mapped_fds.env());
+        if !mapped_fds.is_empty() {
+            let mut fds: Vec<RawFd> = mapped_fds.fds().iter().map(|x| x.as_raw_fd()).collect();
+            // The closure only uses async-signal-safe functions and does not
+            // allocate.
+            unsafe { cmd.pre_exec(move || preserve_fds(&mut fds)) };
+        }
+        // The runtime gets killed if the request gets cancelled while waiting
+        // for it.
         let mut child = cmd
             .stdin(Stdio::piped())
             .stdout(Stdio::piped())
             .stderr(Stdio::piped())
+            .kill_on_drop(true)
             .spawn()
             .context("spawn child process: {}")?;
+        let _spawned = SpawnedGuard::new(&child);
 
         let stdout = child.stdout.take();
         let stderr = child.stderr.take();
@@ -121,11 +161,24 @@ impl ChildReaper {
         map.insert(child.id().clone(), reapable_grandchild);
         let cleanup_grandchildren = locked_grandchildren.clone();
         let pid = child.pid();
+        let io = child.io().clone();
+        let mut cleanup_rx = exit_tx.subscribe();
+        drop(exit_tx);
 
         task::spawn(
             async move {
-                exit_tx.subscribe().recv().await?;
-                Self::forget_grandchild(&cleanup_grandchildren, pid)
+                // The channel is closed if the exit data has been sent before
+                // subscribing, which means that the child exited as well.
+                let exit_code = match cleanup_rx.recv().await {
+                    Ok(exit) => exit.exit_code,
+                    Err(e) => {
+                        debug!("Exit channel closed: {}", e);
+                        -1
+                    }
+                };
+                let res = Self::forget_grandchild(&cleanup_grandchildren, pid);
+                io.end_attach_sessions(exit_code).await;
+                res
             }
             .instrument(debug_span!("watch_grandchild", pid)),
         );
@@ -137,7 +190,7 @@ impl ChildReaper {
         grandchild_pid: u32,
     ) -> Result<()> {
         let mut map = lock!(locked_grandchildren);
-        map.retain(|_, v| v.pid == grandchild_pid);
+        map.retain(|_, v| v.pid != grandchild_pid);
         Ok(())
     }
 
@@ -160,6 +213,27 @@ impl ChildReaper {
     }
 }
 
+/// Move the fds to the consecutive numbers starting at 3 without the
+/// close-on-exec flag, as expected by the `--preserve-fds` runtime option.
+fn preserve_fds(fds: &mut [RawFd]) -> io::Result<()> {
+    const FIRST: RawFd = 3;
+    let end = FIRST + fds.len() as RawFd;
+
+    // Move all fds out of the target range first to not overwrite any of them.
+    for fd in fds.iter_mut() {
+        *fd = match unsafe { libc::fcntl(*fd, libc::F_DUPFD_CLOEXEC, end) } {
+            -1 => return Err(io::Error::last_os_error()),
+            x => x,
+        };
+    }
+    for (target, fd) in (FIRST..end).zip(fds.iter()) {
+        if unsafe { libc::dup2(*fd, target) } == -1 {
+            return Err(io::Error::last_os_error());
+        }
+    }
+    Ok(())
+}
+
 pub fn kill_grandchild(raw_pid: u32, s: Signal) {
     let pid = Pid::from_raw(raw_pid as pid_t);
     if let Ok(pgid) = getpgid(Some(pid)) {
@@ -180,17 +254,23 @@ pub fn kill_grandchild(raw_pid: u32, s: Signal) {
     }
 }
 
+/// The exit code of children which could not be waited for.
+const FAILED_EXIT_CODE: i32 = -3;
+
 type TaskHandle = Arc<Mutex<Option<Vec<JoinHandle<()>>>>>;
 
 #[derive(Clone, CopyGetters, Debug, Getters, Setters)]
 pub struct ReapableChild {
+    #[getset(get = "pub")]
+    id: String,
+
     #[getset(get)]
     exit_paths: Vec<PathBuf>,
 
     #[getset(get)]
     oom_exit_paths: Vec<PathBuf>,
 
-    #[getset(get_copy)]
+    #[getset(get_copy = "pub")]
     pid: u32,
 
     #[getset(get = "pub")]
@@ -202,6 +282,15 @@ pub struct ReapableChild {
     #[getset(get = "pub")]
     token: CancellationToken,
 
+    #[getset(get = "pub")]
+    tenant: String,
+
+    #[getset(get = "pub")]
+    labels: Labels,
+
+    #[getset(get = "pub")]
+    runtime: Option<RuntimeOptions>,
+
     task: Option<TaskHandle>,
 }
 
@@ -220,12 +309,16 @@ pub struct ExitChannelData {
 impl ReapableChild {
     pub fn from_child(child: &Child) -> Self {
         Self {
+            id: child.id().clone(),
             exit_paths: child.exit_paths().clone(),
             oom_exit_paths: child.oom_exit_paths().clone(),
             pid: child.pid(),
             io: child.io().clone(),
             timeout: *child.timeout(),
             token: CancellationToken::new(),
+            tenant: child.tenant().clone(),
+            labels: Labels::default(),
+            runtime: child.runtime().clone(),
             task: None,
         }
     }
@@ -251,6 +344,7 @@ impl ReapableChild {
         let exit_tx_clone = exit_tx.clone();
         let timeout = *self.timeout();
         let stop_token = self.token().clone();
+        let exit_token = self.token().clone();
 
         let task = task::spawn(
             async move {
@@ -260,17 +354,11 @@ impl ReapableChild {
                 let (oom_tx, mut oom_rx) = tokio::sync::mpsc::channel(1);
                 let oom_watcher = OOMWatcher::new(&stop_token, pid, &oom_exit_paths, oom_tx).await;
 
-                let span = debug_span!("wait_for_exit_code");
-                let wait_for_exit_code = task::spawn_blocking(move || {
-                    let _enter = span.enter();
-                    Self::wait_for_exit_code(&stop_token, pid)
-                });
-
+                let wait_token = stop_token.clone();
                 let closure = async {
-                    let (code, oom) = tokio::join!(wait_for_exit_code, oom_rx.recv());
-                    if let Ok(code) = code {
-                        exit_code = code;
-                    }
+                    let (code, oom) =
+                        tokio::join!(Self::wait_for_exit(wait_token, pid), oom_rx.recv());
+                    exit_code = code;
                     if let Some(event) = oom {
                         oomed = event.oom;
                     }
@@ -280,6 +368,8 @@ impl ReapableChild {
                         timed_out = true;
                         exit_code = -3;
                         kill_grandchild(pid, Signal::SIGKILL);
+                        // The killed child still has to be reaped.
+                        task::spawn(Self::wait_for_exit(stop_token.clone(), pid));
                     }
                 } else {
                     closure.await;
@@ -294,6 +384,13 @@ impl ReapableChild {
                 if exit_tx_clone.send(exit_channel_data).is_err() {
                     debug!("Unable to send exit status");
                 }
+                // Signal everyone depending on the child that it is gone.
+                exit_token.cancel();
+                // Exit files are only written if requested, exec sessions
+                // receive their exit via the exit channel.
+                if exit_paths.is_empty() {
+                    return;
+                }
                 debug!(
                     "Write to exit paths: {}",
                     exit_paths
@@ -319,19 +416,57 @@ impl ReapableChild {
         Ok((exit_tx, exit_rx))
     }
 
-    fn wait_for_exit_code(token: &CancellationToken, pid: u32) -> i32 {
-        const FAILED_EXIT_CODE: i32 = -3;
+    /// Wait for the exit of the child and reap it. The exit is awaited via a
+    /// pidfd, which delivers the exit code without blocking a thread per
+    /// child. Kernels without pidfd support fall back to a blocking waitpid.
+    async fn wait_for_exit(token: CancellationToken, pid: u32) -> i32 {
+        if let Some(exit_code) = Self::wait_for_child(&token, pid).await {
+            return exit_code;
+        }
+
+        // Without the child subreaper, the process got reparented and its
+        // exit code is not available.
+        debug!(pid, "Process is no child, waiting for it to vanish");
+        while kill(Pid::from_raw(pid as pid_t), None).is_ok() {
+            time::sleep(Duration::from_millis(100)).await;
+        }
+        token.cancel();
+        FAILED_EXIT_CODE
+    }
+
+    /// Reap the child, returns `None` if the process is no child of the server.
+    async fn wait_for_child(token: &CancellationToken, pid: u32) -> Option<i32> {
+        match PidFd::open(pid) {
+            Ok(pidfd) => match pidfd.exited().await {
+                // The child is a zombie now, which is reaped without blocking.
+                Ok(()) => return Self::wait_for_exit_code(token, pid),
+                Err(e) => debug!(pid, "Unable to wait for pidfd: {:#}", e),
+            },
+            Err(e) => debug!(pid, "Falling back to waitpid: {:#}", e),
+        }
+
+        let span = debug_span!("wait_for_exit_code");
+        let token = token.clone();
+        task::spawn_blocking(move || {
+            let _enter = span.enter();
+            Self::wait_for_exit_code(&token, pid)
+        })
+        .await
+        .unwrap_or(Some(FAILED_EXIT_CODE))
+    }
+
+    fn wait_for_exit_code(token: &CancellationToken, pid: u32) -> Option<i32> {
         loop {
             match waitpid(Pid::from_raw(pid as pid_t), None) {
                 Ok(WaitStatus::Exited(_, exit_code)) => {
                     debug!(pid, "Exited {}", exit_code);
                     token.cancel();
-                    return exit_code;
+                    return Some(exit_code);
                 }
                 Ok(WaitStatus::Signaled(_, sig, _)) => {
                     debug!("Signaled");
                     token.cancel();
-                    return (sig as i32) + 128;
+                    return Some((sig as i32) + 128);
                 }
                 Ok(_) => {
                     continue;
@@ -340,10 +475,11 @@ impl ReapableChild {
                     debug!(pid, "Failed to wait for pid on EINTR, retrying");
                     continue;
                 }
+                Err(Errno::ECHILD) => return None,
                 Err(err) => {
                     error!(pid, "Unable to waitpid on {}", err);
                     token.cancel();
-                    return FAILED_EXIT_CODE;
+                    return Some(FAILED_EXIT_CODE);
                 }
             };
         }
@@ -382,3 +518,33 @@ impl ReapableChild {
         Ok(())
     }
 }
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use crate::container_log::ContainerLog;
+
+    fn new_child(id: &str, pid: u32) -> Result<ReapableChild> {
+        let io = SharedContainerIO::new(ContainerIO::new(false, ContainerLog::new())?);
+        let child = Child::new(id.into(), pid, vec![], vec![], None, io, String::new());
+        Ok(ReapableChild::from_child(&child))
+    }
+
+    #[test]
+    fn forget_grandchild() -> Result<()> {
+        let grandchildren = Arc::new(Mutex::new(MultiMap::new()));
+        {
+            let mut map = lock!(grandchildren);
+            map.insert("container".into(), new_child("container", 1)?);
+            map.insert("container/exec/1".into(), new_child("container/exec/1", 2)?);
+        }
+
+        ChildReaper::forget_grandchild(&grandchildren, 2)?;
+
+        let map = lock!(grandchildren);
+        assert_eq!(map.len(), 1);
+        assert_eq!(map.get("container").context("no container")?.pid(), 1);
+        assert!(map.get("container/exec/1").is_none());
+        Ok(())
+    }
+}
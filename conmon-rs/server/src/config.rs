@@ -3,7 +3,7 @@ use anyhow::{bail, Result};
 use clap::{AppSettings, Parser};
 use getset::{CopyGetters, Getters, Setters};
 use serde::{Deserialize, Serialize};
-use std::{fs, path::PathBuf};
+use std::{fs, path::PathBuf, time::Duration};
 use strum::{EnumIter, EnumString, IntoEnumIterator, IntoStaticStr};
 
 macro_rules! prefix {
@@ -80,6 +80,17 @@ pub struct Config {
     /// Root directory used by the OCI runtime to operate on containers.
     runtime_root: Option<PathBuf>,
 
+    #[get_copy = "pub"]
+    #[clap(
+        default_value(CgroupManager::Cgroupfs.into()),
+        env(concat!(prefix!(), "CGROUP_MANAGER")),
+        long("cgroup-manager"),
+        possible_values(CgroupManager::iter().map(|x| x.into()).collect::<Vec<&str>>()),
+        value_name("MANAGER")
+    )]
+    /// The cgroup manager used by the OCI runtime.
+    cgroup_manager: CgroupManager,
+
     #[get = "pub"]
     #[clap(
         env(concat!(prefix!(), "SKIP_FORK")),
@@ -88,6 +99,149 @@ pub struct Config {
     )]
     /// Do not fork if true
     skip_fork: bool,
+
+    #[get_copy = "pub"]
+    #[clap(
+        env(concat!(prefix!(), "MAX_SESSION_DURATION")),
+        long("max-session-duration"),
+        value_name("SECONDS")
+    )]
+    /// Maximum duration of attach sessions in seconds, unlimited if not set.
+    max_session_duration: Option<u64>,
+
+    #[get_copy = "pub"]
+    #[clap(
+        default_value("30"),
+        env(concat!(prefix!(), "SESSION_WARNING_PERIOD")),
+        long("session-warning-period"),
+        value_name("SECONDS")
+    )]
+    /// Time in seconds before an attach session reaches its maximum duration
+    /// at which the client gets warned about the termination.
+    session_warning_period: u64,
+
+    #[get_copy = "pub"]
+    #[clap(
+        env(concat!(prefix!(), "TENANT_MAX_CONTAINERS")),
+        long("tenant-max-containers"),
+        value_name("COUNT")
+    )]
+    /// Maximum number of containers per tenant, unlimited if not set or zero.
+    tenant_max_containers: Option<u64>,
+
+    #[get_copy = "pub"]
+    #[clap(
+        env(concat!(prefix!(), "TENANT_MAX_EXEC_SESSIONS")),
+        long("tenant-max-exec-sessions"),
+        value_name("COUNT")
+    )]
+    /// Maximum number of exec sessions per tenant, unlimited if not set or
+    /// zero.
+    tenant_max_exec_sessions: Option<u64>,
+
+    #[get_copy = "pub"]
+    #[clap(
+        env(concat!(prefix!(), "TENANT_MAX_LOG_BYTES")),
+        long("tenant-max-log-bytes"),
+        value_name("BYTES")
+    )]
+    /// Maximum number of container log bytes written per tenant, unlimited if
+    /// not set or zero. Further container output is not logged once the
+    /// quota is exhausted.
+    tenant_max_log_bytes: Option<u64>,
+
+    #[clap(
+        env(concat!(prefix!(), "TOMBSTONE_RETENTION")),
+        long("tombstone-retention"),
+        value_name("SECONDS")
+    )]
+    /// Time in seconds to keep a tombstone of removed containers with their
+    /// exit information, disabled if not set or zero.
+    tombstone_retention: Option<u64>,
+
+    #[get_copy = "pub"]
+    #[clap(
+        env(concat!(prefix!(), "OPERATION_LOCKS")),
+        long("operation-locks"),
+        value_name("OPERATION_LOCKS")
+    )]
+    /// Reject operations on containers which are busy with a conflicting
+    /// operation, like stopping a container while commands get executed in
+    /// it.
+    operation_locks: bool,
+
+    #[clap(
+        env(concat!(prefix!(), "CRASH_REPORT_PATH")),
+        long("crash-report-path"),
+        value_name("PATH")
+    )]
+    /// Path of the crash report written if the server panics, defaults to
+    /// "crash-report" within the runtime directory.
+    crash_report_path: Option<PathBuf>,
+
+    #[get_copy = "pub"]
+    #[clap(
+        default_value(StateBackend::Memory.into()),
+        env(concat!(prefix!(), "STATE_BACKEND")),
+        long("state-backend"),
+        possible_values(StateBackend::iter().map(|x| x.into()).collect::<Vec<&str>>()),
+        value_name("BACKEND")
+    )]
+    /// The backend persisting the server state, like the tombstones of removed
+    /// containers, across restarts of the server.
+    state_backend: StateBackend,
+
+    #[clap(
+        env(concat!(prefix!(), "STATE_PATH")),
+        long("state-path"),
+        value_name("PATH")
+    )]
+    /// Path of the file or database of the state backend, defaults to "state"
+    /// or "state.db" within the runtime directory.
+    state_path: Option<PathBuf>,
+
+    #[get_copy = "pub"]
+    #[clap(
+        env(concat!(prefix!(), "NO_SUBREAPER")),
+        long("no-subreaper"),
+        value_name("NO_SUBREAPER")
+    )]
+    /// Do not become the child subreaper, which leaves orphaned processes,
+    /// including the container processes, to the supervisor of the server.
+    /// The exit codes of containers are unknown then and reported as -3.
+    no_subreaper: bool,
+
+    #[get_copy = "pub"]
+    #[clap(
+        env(concat!(prefix!(), "REAP_EVENTS")),
+        long("reap-events"),
+        value_name("REAP_EVENTS")
+    )]
+    /// Publish an event whenever an orphaned process, which is no container,
+    /// got reaped by the server as child subreaper.
+    reap_events: bool,
+
+    #[get = "pub"]
+    #[clap(
+        env(concat!(prefix!(), "REAP_IGNORE_PIDS")),
+        long("reap-ignore-pid"),
+        multiple_occurrences(true),
+        use_value_delimiter(true),
+        value_name("PID")
+    )]
+    /// PIDs of orphaned processes which are never reaped by the server, for
+    /// example because another supervisor waits for them.
+    reap_ignore_pids: Vec<u32>,
+
+    #[clap(
+        default_value("300"),
+        env(concat!(prefix!(), "STATE_RETENTION")),
+        long("state-retention"),
+        value_name("SECONDS")
+    )]
+    /// Time in seconds to keep the state of exited containers, before they
+    /// are considered to be removed.
+    state_retention: u64,
 }
 
 #[derive(
@@ -113,6 +267,55 @@ pub enum LogDriver {
     Systemd,
 }
 
+#[derive(
+    Clone,
+    Copy,
+    Debug,
+    Deserialize,
+    EnumIter,
+    EnumString,
+    Eq,
+    IntoStaticStr,
+    Hash,
+    PartialEq,
+    Serialize,
+)]
+#[strum(serialize_all = "lowercase")]
+/// Available cgroup managers.
+pub enum CgroupManager {
+    /// Let the runtime use systemd to manage the cgroups.
+    Systemd,
+
+    /// Let the runtime write the cgroup filesystem directly.
+    Cgroupfs,
+}
+
+#[derive(
+    Clone,
+    Copy,
+    Debug,
+    Deserialize,
+    EnumIter,
+    EnumString,
+    Eq,
+    IntoStaticStr,
+    Hash,
+    PartialEq,
+    Serialize,
+)]
+#[strum(serialize_all = "lowercase")]
+/// Available state backends.
+pub enum StateBackend {
+    /// Keep the state in memory only, which gets lost if the server exits.
+    Memory,
+
+    /// Append the changes to a file, which gets compacted from time to time.
+    File,
+
+    /// Store the state in a sqlite database.
+    Sqlite,
+}
+
 impl Default for Config {
     fn default() -> Self {
         Self::parse()
@@ -121,7 +324,12 @@ impl Default for Config {
 
 // Sync with `pkg/client/client.go`
 const SOCKET: &str = "conmon.sock";
+const FD_SOCKET: &str = "conmon-fd.sock";
+const ATTACH_SOCKET: &str = "conmon-attach.sock";
 const PIDFILE: &str = "pidfile";
+const CRASH_REPORT: &str = "crash-report";
+const STATE_FILE: &str = "state";
+const STATE_DATABASE: &str = "state.db";
 
 impl Config {
     /// Validate the configuration integrity.
@@ -142,8 +350,14 @@ impl Config {
             }
         }
 
-        if self.socket().exists() {
-            fs::remove_file(self.socket())?;
+        if self.no_subreaper() && (self.reap_events() || !self.reap_ignore_pids().is_empty()) {
+            bail!("reap events and ignored PIDs require the child subreaper")
+        }
+
+        for socket in [self.socket(), self.fd_socket(), self.attach_socket()] {
+            if socket.exists() {
+                fs::remove_file(socket)?;
+            }
         }
 
         Ok(())
@@ -151,7 +365,42 @@ impl Config {
     pub fn socket(&self) -> PathBuf {
         self.runtime_dir().join(SOCKET)
     }
+    pub fn fd_socket(&self) -> PathBuf {
+        self.runtime_dir().join(FD_SOCKET)
+    }
+    pub fn attach_socket(&self) -> PathBuf {
+        self.runtime_dir().join(ATTACH_SOCKET)
+    }
     pub fn conmon_pidfile(&self) -> PathBuf {
         self.runtime_dir().join(PIDFILE)
     }
+
+    pub fn crash_report_path(&self) -> PathBuf {
+        self.crash_report_path
+            .clone()
+            .unwrap_or_else(|| self.runtime_dir().join(CRASH_REPORT))
+    }
+
+    /// Path of the file or database of the state backend, which is not used
+    /// for the memory backend.
+    pub fn state_path(&self) -> PathBuf {
+        self.state_path.clone().unwrap_or_else(|| {
+            self.runtime_dir().join(match self.state_backend {
+                StateBackend::Sqlite => STATE_DATABASE,
+                _ => STATE_FILE,
+            })
+        })
+    }
+
+    /// The retention of the state of exited containers.
+    pub fn state_retention(&self) -> Duration {
+        Duration::from_secs(self.state_retention)
+    }
+
+    /// The retention of tombstones, if they are enabled.
+    pub fn tombstone_retention(&self) -> Option<Duration> {
+        self.tombstone_retention
+            .filter(|x| *x > 0)
+            .map(Duration::from_secs)
+    }
 }
@@ -0,0 +1,712 @@
+//! Lifecycle events of containers.
+use crate::{child_reaper::ExitChannelData, container_state::State, freezer};
+use anyhow::{bail, format_err, Context, Result};
+use conmon_common::conmon_capnp::conmon::{container_event, container_event_watcher};
+use getset::{CopyGetters, Getters};
+use std::{
+    collections::VecDeque,
+    path::PathBuf,
+    sync::{Arc, Mutex},
+    time::{Duration, SystemTime, UNIX_EPOCH},
+};
+use tokio::{
+    sync::broadcast::{self, error::RecvError, Receiver, Sender},
+    task, time,
+};
+use tokio_util::sync::CancellationToken;
+use tracing::{debug, debug_span, Instrument};
+
+/// The number of events which can be buffered per watcher before it lags.
+const EVENT_CAPACITY: usize = 1024;
+
+/// The number of recent events kept for support bundles.
+const HISTORY_CAPACITY: usize = 100;
+
+/// The number of exit events kept for waiting on already exited containers,
+/// and the number kept for exec sessions.
+const EXITS_CAPACITY: usize = 1000;
+
+/// The number of events retained for polling via get_events.
+const JOURNAL_CAPACITY: usize = 10000;
+
+/// The interval of checking the freezer state of containers.
+const FREEZER_INTERVAL: Duration = Duration::from_secs(1);
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// The type of a container event.
+pub enum EventType {
+    OomKilled,
+    Exited,
+    Paused,
+    Resumed,
+    WatchdogExpired,
+    Reaped,
+    StateChanged,
+    ExecExited,
+}
+
+#[derive(Clone, CopyGetters, Debug, Getters)]
+/// A lifecycle event of a container.
+pub struct ContainerEvent {
+    #[getset(get_copy = "pub")]
+    typ: EventType,
+
+    #[getset(get = "pub")]
+    id: String,
+
+    #[getset(get = "pub")]
+    tenant: String,
+
+    /// The PID of the container process, which distinguishes containers
+    /// reusing the same ID.
+    #[getset(get_copy = "pub")]
+    pid: u32,
+
+    #[getset(get_copy = "pub")]
+    exit_code: i32,
+
+    /// Nanoseconds since the unix epoch.
+    #[getset(get_copy = "pub")]
+    timestamp: u64,
+
+    /// The log files of the container, only set for exit events.
+    #[getset(get = "pub")]
+    log_paths: Vec<PathBuf>,
+
+    /// The new and the previous lifecycle state, only set for state changed
+    /// events.
+    #[getset(get_copy = "pub")]
+    state: State,
+
+    #[getset(get_copy = "pub")]
+    previous_state: State,
+
+    /// The exec session, only set for exec exited events.
+    #[getset(get = "pub")]
+    exec_session_id: String,
+}
+
+impl ContainerEvent {
+    /// Set the fields of the capnp event.
+    pub fn build(&self, mut event: container_event::Builder) {
+        event.set_type(match self.typ() {
+            EventType::OomKilled => container_event::Type::OomKilled,
+            EventType::Exited => container_event::Type::Exited,
+            EventType::Paused => container_event::Type::Paused,
+            EventType::Resumed => container_event::Type::Resumed,
+            EventType::WatchdogExpired => container_event::Type::WatchdogExpired,
+            EventType::Reaped => container_event::Type::Reaped,
+            EventType::StateChanged => container_event::Type::StateChanged,
+            EventType::ExecExited => container_event::Type::ExecExited,
+        });
+        event.set_id(self.id());
+        event.set_exit_code(self.exit_code());
+        event.set_timestamp(self.timestamp());
+        event.set_pid(self.pid());
+        event.set_state(self.state().to_capnp());
+        event.set_previous_state(self.previous_state().to_capnp());
+        event.set_exec_session_id(self.exec_session_id());
+    }
+
+    fn new(typ: EventType, id: &str, tenant: &str, pid: u32, exit_code: i32) -> Self {
+        Self {
+            typ,
+            id: id.into(),
+            tenant: tenant.into(),
+            pid,
+            exit_code,
+            timestamp: SystemTime::now()
+                .duration_since(UNIX_EPOCH)
+                .unwrap_or_default()
+                .as_nanos() as u64,
+            log_paths: vec![],
+            state: Default::default(),
+            previous_state: Default::default(),
+            exec_session_id: Default::default(),
+        }
+    }
+}
+
+#[derive(Debug, Default, Getters, CopyGetters)]
+/// A batch of events polled from the journal.
+pub struct EventBatch {
+    #[getset(get = "pub")]
+    events: Vec<ContainerEvent>,
+
+    /// The sequence number of the last considered event.
+    #[getset(get_copy = "pub")]
+    next_cursor: u64,
+
+    /// The number of events after the cursor which are not retained anymore.
+    #[getset(get_copy = "pub")]
+    missed: u64,
+}
+
+#[derive(Debug, Default)]
+/// The most recent events of all containers with their sequence numbers.
+struct Journal {
+    /// The sequence number of the last published event.
+    last: u64,
+
+    events: VecDeque<(u64, ContainerEvent)>,
+}
+
+#[derive(Clone, Debug)]
+/// The event bus of all containers.
+pub struct ContainerEvents {
+    tx: Sender<ContainerEvent>,
+
+    /// The most recent events of all containers.
+    history: Arc<Mutex<VecDeque<ContainerEvent>>>,
+
+    /// The most recent exit events of all containers.
+    exits: Arc<Mutex<VecDeque<ContainerEvent>>>,
+
+    /// The most recent exit events of all exec sessions, which are kept
+    /// apart to not evict the exits of containers.
+    exec_exits: Arc<Mutex<VecDeque<ContainerEvent>>>,
+
+    /// The events retained for polling.
+    journal: Arc<Mutex<Journal>>,
+}
+
+impl Default for ContainerEvents {
+    fn default() -> Self {
+        let (tx, _) = broadcast::channel(EVENT_CAPACITY);
+        Self {
+            tx,
+            history: Default::default(),
+            exits: Default::default(),
+            exec_exits: Default::default(),
+            journal: Default::default(),
+        }
+    }
+}
+
+impl ContainerEvents {
+    /// Publish the events of a container until it exits. The log paths are
+    /// retained with the exit event, which is passed to `on_exit` after
+    /// publishing it.
+    pub fn watch<F>(
+        &self,
+        id: String,
+        tenant: String,
+        pid: u32,
+        log_paths: Vec<PathBuf>,
+        mut exit_rx: Receiver<ExitChannelData>,
+        on_exit: F,
+    ) where
+        F: FnOnce(&ContainerEvent, &ExitChannelData) + Send + 'static,
+    {
+        let events = self.clone();
+        task::spawn(
+            async move {
+                let mut interval = time::interval(FREEZER_INTERVAL);
+                let mut frozen = false;
+                loop {
+                    tokio::select! {
+                        exit = exit_rx.recv() => {
+                            match exit {
+                                Ok(exit) => {
+                                    let event = events.publish_exit(&id, &tenant, pid, log_paths, &exit);
+                                    on_exit(&event, &exit);
+                                }
+                                Err(e) => debug!("Exit channel closed: {}", e),
+                            }
+                            return;
+                        }
+                        _ = interval.tick() => events.check_frozen(&id, &tenant, pid, &mut frozen),
+                    }
+                }
+            }
+            .instrument(debug_span!("container_events", pid)),
+        );
+    }
+
+    fn publish_exit(
+        &self,
+        id: &str,
+        tenant: &str,
+        pid: u32,
+        log_paths: Vec<PathBuf>,
+        exit: &ExitChannelData,
+    ) -> ContainerEvent {
+        if *exit.oomed() {
+            self.publish(EventType::OomKilled, id, tenant, pid, 0);
+        }
+        let mut event = ContainerEvent::new(EventType::Exited, id, tenant, pid, *exit.exit_code());
+        event.log_paths = log_paths;
+        self.publish_event(event.clone());
+        event
+    }
+
+    /// Publish the exit of the exec session of a container once the exec
+    /// process exits, which delivers its exit code without an exit file.
+    pub fn watch_exec(
+        &self,
+        id: String,
+        tenant: String,
+        exec_session_id: String,
+        pid: u32,
+        mut exit_rx: Receiver<ExitChannelData>,
+    ) {
+        let events = self.clone();
+        task::spawn(
+            async move {
+                match exit_rx.recv().await {
+                    Ok(exit) => {
+                        let mut event = ContainerEvent::new(
+                            EventType::ExecExited,
+                            &id,
+                            &tenant,
+                            pid,
+                            *exit.exit_code(),
+                        );
+                        event.exec_session_id = exec_session_id;
+                        events.publish_event(event);
+                    }
+                    Err(e) => debug!("Exit channel closed: {}", e),
+                }
+            }
+            .instrument(debug_span!("exec_events", pid)),
+        );
+    }
+
+    /// Publish a paused or resumed event if the freezer state changed.
+    fn check_frozen(&self, id: &str, tenant: &str, pid: u32, frozen: &mut bool) {
+        // Avoid reading the cgroup if nobody is interested.
+        if self.tx.receiver_count() == 0 {
+            return;
+        }
+        match freezer::is_frozen(pid) {
+            Ok(x) if x != *frozen => {
+                *frozen = x;
+                let typ = if x {
+                    EventType::Paused
+                } else {
+                    EventType::Resumed
+                };
+                self.publish(typ, id, tenant, pid, 0);
+            }
+            Ok(_) => {}
+            Err(e) => debug!("Unable to get freezer state: {:#}", e),
+        }
+    }
+
+    /// Publish a watchdog expired event of the container.
+    pub fn publish_watchdog_expired(&self, id: &str, tenant: &str, pid: u32) {
+        self.publish(EventType::WatchdogExpired, id, tenant, pid, 0);
+    }
+
+    /// Publish a reaped event of an orphaned process of the default tenant.
+    pub fn publish_reaped(&self, pid: u32, exit_code: i32) {
+        self.publish(EventType::Reaped, "", "", pid, exit_code);
+    }
+
+    /// Publish a state changed event of the container.
+    pub fn publish_state_changed(
+        &self,
+        id: &str,
+        tenant: &str,
+        pid: u32,
+        previous_state: State,
+        state: State,
+    ) {
+        let mut event = ContainerEvent::new(EventType::StateChanged, id, tenant, pid, 0);
+        event.state = state;
+        event.previous_state = previous_state;
+        self.publish_event(event);
+    }
+
+    fn publish(&self, typ: EventType, id: &str, tenant: &str, pid: u32, exit_code: i32) {
+        self.publish_event(ContainerEvent::new(typ, id, tenant, pid, exit_code));
+    }
+
+    fn publish_event(&self, event: ContainerEvent) {
+        debug!(
+            "Publishing {:?} event of container {}",
+            event.typ(),
+            event.id()
+        );
+        push_bounded(&self.history, HISTORY_CAPACITY, &event);
+        match event.typ() {
+            EventType::Exited => push_bounded(&self.exits, EXITS_CAPACITY, &event),
+            EventType::ExecExited => push_bounded(&self.exec_exits, EXITS_CAPACITY, &event),
+            _ => {}
+        }
+        if let Ok(mut journal) = self.journal.lock() {
+            journal.last += 1;
+            let seq = journal.last;
+            if journal.events.len() == JOURNAL_CAPACITY {
+                journal.events.pop_front();
+            }
+            journal.events.push_back((seq, event.clone()));
+        }
+        // Sending only fails if there are no watchers.
+        let _ = self.tx.send(event);
+    }
+
+    /// Subscribe to the events of all containers.
+    pub fn subscribe(&self) -> Receiver<ContainerEvent> {
+        self.tx.subscribe()
+    }
+
+    /// Retrieve the most recent exit event of the container, or of its exec
+    /// session if `exec_session_id` is not empty. Only the exit of the process
+    /// `pid` is considered if it is set.
+    pub fn exit(
+        &self,
+        tenant: &str,
+        id: &str,
+        exec_session_id: &str,
+        pid: Option<u32>,
+    ) -> Option<ContainerEvent> {
+        let exits = if exec_session_id.is_empty() {
+            &self.exits
+        } else {
+            &self.exec_exits
+        };
+        let exits = exits.lock().ok()?;
+        exits
+            .iter()
+            .rev()
+            .find(|event| {
+                event.tenant() == tenant
+                    && event.id() == id
+                    && event.exec_session_id() == exec_session_id
+                    && pid.map_or(true, |pid| event.pid() == pid)
+            })
+            .cloned()
+    }
+
+    /// Wait for the exit of the process `pid` of the container or its exec
+    /// session. The receiver has to be subscribed before retrieving the
+    /// process to not miss the exit.
+    pub async fn wait_exit(
+        &self,
+        rx: &mut Receiver<ContainerEvent>,
+        tenant: &str,
+        id: &str,
+        exec_session_id: &str,
+        pid: u32,
+    ) -> Result<ContainerEvent> {
+        loop {
+            if let Some(event) = self.exit(tenant, id, exec_session_id, Some(pid)) {
+                return Ok(event);
+            }
+            // Exit events are added to the exits before they are sent, and
+            // are still available if the receiver lagged.
+            match rx.recv().await {
+                Ok(_) | Err(RecvError::Lagged(_)) => {}
+                Err(RecvError::Closed) => bail!("event channel closed"),
+            }
+        }
+    }
+
+    /// Retrieve the most recent events, starting with the oldest one.
+    pub fn history(&self) -> Vec<ContainerEvent> {
+        match self.history.lock() {
+            Ok(history) => history.iter().cloned().collect(),
+            Err(_) => vec![],
+        }
+    }
+
+    /// Retrieve up to `max` events of the tenant after the cursor, which is
+    /// the sequence number of the last polled event. The number of events is
+    /// not limited if `max` is zero.
+    pub fn poll(&self, tenant: &str, cursor: u64, max: usize) -> Result<EventBatch> {
+        let journal = self
+            .journal
+            .lock()
+            .map_err(|e| format_err!("lock event journal: {}", e))?;
+
+        // A cursor ahead of the journal stems from a previous server instance.
+        let cursor = if cursor > journal.last { 0 } else { cursor };
+        let oldest = journal
+            .events
+            .front()
+            .map_or(journal.last + 1, |(seq, _)| *seq);
+
+        let mut batch = EventBatch {
+            events: vec![],
+            next_cursor: journal.last,
+            missed: oldest.saturating_sub(cursor + 1),
+        };
+        for (seq, event) in journal.events.iter().filter(|(seq, _)| *seq > cursor) {
+            if max > 0 && batch.events.len() == max {
+                batch.next_cursor = seq - 1;
+                break;
+            }
+            if event.tenant() == tenant {
+                batch.events.push(event.clone());
+            }
+        }
+        Ok(batch)
+    }
+
+    /// Poll the events like `poll`, but wait up to the timeout for new events
+    /// if there are none.
+    pub async fn wait_poll(
+        &self,
+        tenant: &str,
+        cursor: u64,
+        max: usize,
+        timeout: Duration,
+    ) -> Result<EventBatch> {
+        // Subscribe before polling to not miss any event.
+        let mut rx = self.subscribe();
+        let deadline = time::Instant::now() + timeout;
+        let mut cursor = cursor;
+        let mut missed = 0;
+        loop {
+            let mut batch = self.poll(tenant, cursor, max)?;
+            missed += batch.missed;
+            batch.missed = missed;
+            if !batch.events.is_empty() || time::Instant::now() >= deadline {
+                return Ok(batch);
+            }
+
+            cursor = batch.next_cursor;
+            tokio::select! {
+                event = rx.recv() => match event {
+                    Ok(_) | Err(RecvError::Lagged(_)) => {}
+                    Err(RecvError::Closed) => return Ok(batch),
+                },
+                _ = time::sleep_until(deadline) => {}
+            }
+        }
+    }
+
+    /// Forward the events of the tenant to the watcher on the local task
+    /// set. Only events of the container `id` are forwarded if it is not
+    /// empty.
+    pub fn spawn_watcher(
+        &self,
+        id: &str,
+        tenant: &str,
+        token: CancellationToken,
+        watcher: container_event_watcher::Client,
+    ) {
+        let (id, tenant) = (id.to_string(), tenant.to_string());
+        let mut rx = self.tx.subscribe();
+        task::spawn_local(
+            async move {
+                let mut request = watcher.done_request();
+                if let Err(e) = forward(&mut rx, &id, &tenant, &token, &watcher).await {
+                    debug!("Stopping container event watcher: {:#}", e);
+                    request.get().set_error(&format!("{:#}", e));
+                }
+                // The client is likely gone if this fails.
+                if let Err(e) = request.send().promise.await {
+                    debug!("Unable to notify container event watcher: {}", e);
+                }
+            }
+            .instrument(debug_span!("container_event_watcher")),
+        );
+    }
+}
+
+async fn forward(
+    rx: &mut Receiver<ContainerEvent>,
+    id: &str,
+    tenant: &str,
+    token: &CancellationToken,
+    watcher: &container_event_watcher::Client,
+) -> Result<()> {
+    loop {
+        let event = tokio::select! {
+            event = rx.recv() => match event {
+                Ok(event) => event,
+                Err(RecvError::Lagged(n)) => bail!("watcher lagged behind by {} events", n),
+                Err(RecvError::Closed) => return Ok(()),
+            },
+            _ = token.cancelled() => return Ok(()),
+        };
+        if event.tenant() != tenant || (!id.is_empty() && event.id() != id) {
+            continue;
+        }
+
+        let mut request = watcher.event_request();
+        event.build(request.get().init_event());
+        request
+            .send()
+            .promise
+            .await
+            .context("send container event")?;
+    }
+}
+
+fn push_bounded(events: &Mutex<VecDeque<ContainerEvent>>, capacity: usize, event: &ContainerEvent) {
+    if let Ok(mut events) = events.lock() {
+        if events.len() == capacity {
+            events.pop_front();
+        }
+        events.push_back(event.clone());
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[tokio::test]
+    async fn publish_exit_events() -> Result<()> {
+        let events = ContainerEvents::default();
+        let mut rx = events.tx.subscribe();
+        let (exit_tx, exit_rx) = broadcast::channel(1);
+        events.watch(
+            "id".into(),
+            "tenant".into(),
+            std::process::id(),
+            vec![],
+            exit_rx,
+            |_, _| {},
+        );
+
+        exit_tx.send(ExitChannelData {
+            exit_code: 137,
+            oomed: true,
+            timed_out: false,
+        })?;
+
+        let event = rx.recv().await?;
+        assert_eq!(event.typ(), EventType::OomKilled);
+        assert_eq!(event.id(), "id");
+        assert_eq!(event.tenant(), "tenant");
+
+        let event = rx.recv().await?;
+        assert_eq!(event.typ(), EventType::Exited);
+        assert_eq!(event.exit_code(), 137);
+        assert!(event.timestamp() > 0);
+
+        let history = events.history();
+        assert_eq!(history.len(), 2);
+        assert_eq!(history[0].typ(), EventType::OomKilled);
+
+        let exit = events
+            .exit("tenant", "id", "", None)
+            .context("no exit event")?;
+        assert_eq!(exit.exit_code(), 137);
+        assert!(events.exit("other", "id", "", None).is_none());
+        assert!(events.exit("tenant", "id", "", Some(1)).is_none());
+        assert!(events.exit("tenant", "id", "exec", None).is_none());
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn wait_exit() -> Result<()> {
+        let events = ContainerEvents::default();
+        let mut rx = events.subscribe();
+        let (exit_tx, exit_rx) = broadcast::channel(1);
+        events.watch(
+            "id".into(),
+            "tenant".into(),
+            1,
+            vec!["log".into()],
+            exit_rx,
+            |_, _| {},
+        );
+
+        let (_, other_exit_rx) = broadcast::channel(1);
+        events.watch(
+            "other".into(),
+            "tenant".into(),
+            2,
+            vec![],
+            other_exit_rx,
+            |_, _| {},
+        );
+
+        exit_tx.send(ExitChannelData {
+            exit_code: 1,
+            oomed: false,
+            timed_out: false,
+        })?;
+        let exit = events.wait_exit(&mut rx, "tenant", "id", "", 1).await?;
+        assert_eq!(exit.exit_code(), 1);
+        assert_eq!(exit.log_paths(), &[PathBuf::from("log")]);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn wait_exec_exit() -> Result<()> {
+        let events = ContainerEvents::default();
+        let mut rx = events.subscribe();
+        let (exit_tx, exit_rx) = broadcast::channel(1);
+        events.watch_exec("id".into(), "tenant".into(), "exec".into(), 2, exit_rx);
+
+        exit_tx.send(ExitChannelData {
+            exit_code: 5,
+            oomed: false,
+            timed_out: false,
+        })?;
+        let exit = events.wait_exit(&mut rx, "tenant", "id", "exec", 2).await?;
+        assert_eq!(exit.typ(), EventType::ExecExited);
+        assert_eq!(exit.exit_code(), 5);
+        assert_eq!(exit.exec_session_id(), "exec");
+        // The exit of the exec session is no exit of the container.
+        assert!(events.exit("tenant", "id", "", None).is_none());
+        Ok(())
+    }
+
+    #[test]
+    fn poll_events() -> Result<()> {
+        let events = ContainerEvents::default();
+        events.publish_watchdog_expired("a", "tenant", 1);
+        events.publish_watchdog_expired("b", "other", 2);
+        events.publish_watchdog_expired("c", "tenant", 3);
+
+        let batch = events.poll("tenant", 0, 1)?;
+        assert_eq!(batch.events().len(), 1);
+        assert_eq!(batch.events()[0].id(), "a");
+        assert_eq!(batch.next_cursor(), 1);
+        assert_eq!(batch.missed(), 0);
+
+        let batch = events.poll("tenant", batch.next_cursor(), 0)?;
+        assert_eq!(batch.events().len(), 1);
+        assert_eq!(batch.events()[0].id(), "c");
+        assert_eq!(batch.next_cursor(), 3);
+
+        let batch = events.poll("tenant", batch.next_cursor(), 0)?;
+        assert!(batch.events().is_empty());
+        assert_eq!(batch.next_cursor(), 3);
+
+        // Cursors of a previous server instance start from the beginning.
+        assert_eq!(events.poll("tenant", 42, 0)?.events().len(), 2);
+        Ok(())
+    }
+
+    #[test]
+    fn poll_missed_events() -> Result<()> {
+        let events = ContainerEvents::default();
+        for pid in 0..JOURNAL_CAPACITY as u32 + 2 {
+            events.publish_watchdog_expired("id", "tenant", pid);
+        }
+
+        let batch = events.poll("tenant", 1, 0)?;
+        assert_eq!(batch.missed(), 1);
+        assert_eq!(batch.events().len(), JOURNAL_CAPACITY);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn wait_poll_events() -> Result<()> {
+        let events = ContainerEvents::default();
+        let batch = events
+            .wait_poll("tenant", 0, 0, Duration::from_millis(10))
+            .await?;
+        assert!(batch.events().is_empty());
+
+        let publisher = events.clone();
+        task::spawn(async move {
+            time::sleep(Duration::from_millis(10)).await;
+            publisher.publish_watchdog_expired("id", "tenant", 1);
+        });
+        let batch = events
+            .wait_poll("tenant", 0, 0, Duration::from_secs(10))
+            .await?;
+        assert_eq!(batch.events().len(), 1);
+        assert_eq!(batch.next_cursor(), 1);
+        Ok(())
+    }
+}
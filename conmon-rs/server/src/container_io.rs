@@ -19,10 +19,14 @@ use tokio::{
         mpsc::{UnboundedReceiver, UnboundedSender},
         RwLock,
     },
-    time::{self, Instant},
+    time::{self, Duration, Instant},
 };
 use tracing::{debug, error};
 
+/// The maximum time to wait for the remaining output of an exited process
+/// before ending its attach sessions.
+const ATTACH_DRAIN_TIMEOUT: Duration = Duration::from_secs(5);
+
 /// A shared container IO abstraction.
 #[derive(Debug, Clone)]
 pub struct SharedContainerIO(Arc<RwLock<ContainerIO>>);
@@ -36,8 +40,13 @@ impl SharedContainerIO {
     pub async fn read_all_with_timeout(
         &self,
         timeout: Option<Instant>,
-    ) -> (Vec<u8>, Vec<u8>, bool) {
-        self.0.write().await.read_all_with_timeout(timeout).await
+        max_size: Option<usize>,
+    ) -> (StreamOutput, StreamOutput, bool) {
+        self.0
+            .write()
+            .await
+            .read_all_with_timeout(timeout, max_size)
+            .await
     }
 
     /// Resize the shared container IO to the provided with and height.
@@ -49,6 +58,11 @@ impl SharedContainerIO {
         }
     }
 
+    /// Returns true if the container IO is a terminal.
+    pub async fn has_terminal(&self) -> bool {
+        matches!(&*self.0.read().await.typ(), ContainerIOType::Terminal(_))
+    }
+
     /// Retrieve the underlying SharedContainerLog instance.
     pub async fn logger(&self) -> SharedContainerLog {
         self.0.read().await.logger().clone()
@@ -58,6 +72,18 @@ impl SharedContainerIO {
     pub async fn attach(&self) -> SharedContainerAttach {
         self.0.read().await.attach().clone()
     }
+
+    /// End the attach sessions after the exit of the process with the exit
+    /// code, once its remaining output has been written to them.
+    pub async fn end_attach_sessions(&self, exit_code: i32) {
+        let sources = self.logger().await.read().await.sources().clone();
+        match time::timeout(ATTACH_DRAIN_TIMEOUT, sources.drained()).await {
+            Ok(Ok(())) => {}
+            Ok(Err(e)) => debug!("Unable to wait for pending output: {:#}", e),
+            Err(_) => debug!("Timeout waiting for pending output"),
+        }
+        self.attach().await.exited(exit_code).await;
+    }
 }
 
 #[derive(Debug, Getters, MutGetters)]
@@ -86,6 +112,31 @@ pub enum Message {
     Done,
 }
 
+#[derive(Debug, Default)]
+/// The output of a stream which has been read until its end.
+pub struct StreamOutput {
+    /// The read data, limited to the maximum output size.
+    pub data: Vec<u8>,
+
+    /// Indicates that data has been dropped because it exceeded the maximum
+    /// output size.
+    pub truncated: bool,
+}
+
+impl StreamOutput {
+    /// Append data while respecting the maximum output size.
+    fn extend(&mut self, data: Vec<u8>, max_size: Option<usize>) {
+        match max_size {
+            Some(max_size) if self.data.len() + data.len() > max_size => {
+                let remaining = max_size.saturating_sub(self.data.len());
+                self.data.extend(&data[..remaining]);
+                self.truncated = true;
+            }
+            _ => self.data.extend(data),
+        }
+    }
+}
+
 #[derive(AsRefStr, Clone, Copy, Debug)]
 #[strum(serialize_all = "lowercase")]
 /// Available pipe types.
@@ -149,19 +200,21 @@ impl ContainerIO {
     pub async fn read_all_with_timeout(
         &mut self,
         time_to_timeout: Option<Instant>,
-    ) -> (Vec<u8>, Vec<u8>, bool) {
+        max_size: Option<usize>,
+    ) -> (StreamOutput, StreamOutput, bool) {
         match self.typ_mut() {
             ContainerIOType::Terminal(t) => {
                 let (stdout, timed_out) =
-                    Self::read_stream_with_timeout(time_to_timeout, t.message_rx_mut()).await;
-                (stdout, vec![], timed_out)
+                    Self::read_stream_with_timeout(time_to_timeout, max_size, t.message_rx_mut())
+                        .await;
+                (stdout, StreamOutput::default(), timed_out)
             }
             ContainerIOType::Streams(s) => {
                 let stdout_rx = &mut s.message_rx_stdout;
                 let stderr_rx = &mut s.message_rx_stderr;
                 let (stdout, stderr) = tokio::join!(
-                    Self::read_stream_with_timeout(time_to_timeout, stdout_rx),
-                    Self::read_stream_with_timeout(time_to_timeout, stderr_rx),
+                    Self::read_stream_with_timeout(time_to_timeout, max_size, stdout_rx),
+                    Self::read_stream_with_timeout(time_to_timeout, max_size, stderr_rx),
                 );
                 let timed_out = stdout.1 || stderr.1;
                 (stdout.0, stderr.0, timed_out)
@@ -171,9 +224,10 @@ impl ContainerIO {
 
     async fn read_stream_with_timeout(
         time_to_timeout: Option<Instant>,
+        max_size: Option<usize>,
         receiver: &mut UnboundedReceiver<Message>,
-    ) -> (Vec<u8>, bool) {
-        let mut stdio = vec![];
+    ) -> (StreamOutput, bool) {
+        let mut stdio = StreamOutput::default();
         let mut timed_out = false;
         loop {
             let msg = if let Some(time_to_timeout) = time_to_timeout {
@@ -193,7 +247,7 @@ impl ContainerIO {
             };
 
             match msg {
-                Message::Data(s) => stdio.extend(s),
+                Message::Data(s) => stdio.extend(s, max_size),
                 Message::Done => break,
             }
         }
@@ -210,12 +264,15 @@ impl ContainerIO {
         let stream = unsafe { File::from_raw_fd(fd) };
         let mut reader = BufReader::new(stream);
         let mut buf = vec![0; 1024];
+        let sources = logger.read().await.sources().clone();
+        let _source = sources.add(fd);
 
         loop {
             match reader.read(&mut buf).await {
                 Ok(n) if n > 0 => {
                     debug!("fd:{}:read {} bytes", fd, n);
                     let data = &buf[..n];
+                    let _in_flight = sources.in_flight();
 
                     let mut locked_logger = logger.write().await;
                     locked_logger
@@ -234,6 +291,7 @@ impl ContainerIO {
                 }
                 Ok(n) if n == 0 => {
                     debug!("fd:{}:No more to read", fd);
+                    logger.write().await.close(pipe);
 
                     message_tx
                         .send(Message::Done)
@@ -243,6 +301,7 @@ impl ContainerIO {
                 Err(e) => match Errno::from_i32(e.raw_os_error().context("get OS error")?) {
                     Errno::EIO => {
                         debug!("Stopping read loop");
+                        logger.write().await.close(pipe);
 
                         message_tx
                             .send(Message::Done)
@@ -269,16 +328,45 @@ impl ContainerIO {
     pub async fn read_loop_stdin(fd: RawFd, attach: SharedContainerAttach) -> Result<()> {
         let mut writer = unsafe { File::from_raw_fd(fd) };
         loop {
-            if let Some(data) = attach
-                .try_read()
+            let data = attach
+                .read()
                 .await
-                .context("read from stdin attach endpoints")?
-            {
-                writer
-                    .write_all(&data)
-                    .await
-                    .context("write attach stdin to stream")?;
-            }
+                .context("read from stdin attach endpoints")?;
+            writer
+                .write_all(&data)
+                .await
+                .context("write attach stdin to stream")?;
         }
     }
 }
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use tokio::sync::mpsc;
+
+    #[tokio::test]
+    async fn read_stream_with_timeout_max_size() {
+        let (tx, mut rx) = mpsc::unbounded_channel();
+        tx.send(Message::Data(vec![1, 2, 3])).unwrap();
+        tx.send(Message::Data(vec![4, 5, 6])).unwrap();
+        tx.send(Message::Done).unwrap();
+
+        let (output, timed_out) =
+            ContainerIO::read_stream_with_timeout(None, Some(4), &mut rx).await;
+        assert_eq!(output.data, [1, 2, 3, 4]);
+        assert!(output.truncated);
+        assert!(!timed_out);
+    }
+
+    #[tokio::test]
+    async fn read_stream_with_timeout_unlimited() {
+        let (tx, mut rx) = mpsc::unbounded_channel();
+        tx.send(Message::Data(vec![1, 2, 3])).unwrap();
+        tx.send(Message::Done).unwrap();
+
+        let (output, _) = ContainerIO::read_stream_with_timeout(None, None, &mut rx).await;
+        assert_eq!(output.data, [1, 2, 3]);
+        assert!(!output.truncated);
+    }
+}
@@ -1,21 +1,63 @@
-use crate::{container_io::Pipe, cri_logger::CriLogger};
-use anyhow::Result;
+use crate::{
+    container_io::Pipe,
+    cri_logger::CriLogger,
+    journald_logger::JournaldLogger,
+    json_logger::JsonLogger,
+    log_filter::{LogFilter, LogFilterConfig, LogFilterHealth},
+    log_rotation::LogRotation,
+    log_sources::LogSources,
+    log_writer::{FsyncPolicy, LogWriteConfig},
+    tenant_quota::LogQuota,
+};
+use anyhow::{bail, Context, Result};
 use capnp::struct_list::Reader;
-use conmon_common::conmon_capnp::conmon::log_driver::{Owned, Type};
+use conmon_common::conmon_capnp::conmon::log_driver::{self, Owned, Type};
 use futures::future::join_all;
-use std::sync::Arc;
-use tokio::{io::AsyncBufRead, sync::RwLock};
+use getset::Getters;
+use std::{
+    path::{Path, PathBuf},
+    sync::Arc,
+    time::Duration,
+};
+use tokio::{sync::RwLock, time};
+use tracing::{debug, warn};
 
 pub type SharedContainerLog = Arc<RwLock<ContainerLog>>;
 
-#[derive(Debug, Default)]
+#[derive(Debug, Default, Getters)]
 pub struct ContainerLog {
     drivers: Vec<LogDriver>,
+
+    /// Log quota of the tenant, the output is not logged if it is exhausted.
+    quota: Option<LogQuota>,
+
+    /// Indicates that the quota got exhausted.
+    quota_exceeded: bool,
+
+    /// External filter the output gets passed through before being logged.
+    filter: Option<LogFilter>,
+
+    #[getset(get = "pub")]
+    /// Sources the output gets read from.
+    sources: LogSources,
 }
 
 #[derive(Debug)]
 enum LogDriver {
     ContainerRuntimeInterface(CriLogger),
+    Json(JsonLogger),
+    Journald(JournaldLogger),
+}
+
+impl LogDriver {
+    /// Retrieve the path of the driver, which is `None` for the journal.
+    fn path(&self) -> Option<&Path> {
+        match self {
+            LogDriver::ContainerRuntimeInterface(cri_logger) => Some(cri_logger.path()),
+            LogDriver::Json(json_logger) => Some(json_logger.path()),
+            LogDriver::Journald(_) => None,
+        }
+    }
 }
 
 impl ContainerLog {
@@ -24,19 +66,80 @@ impl ContainerLog {
         Arc::new(RwLock::new(Self::default()))
     }
 
-    /// Create a new SharedContainerLog from an capnp owned reader.
-    pub fn from(reader: Reader<Owned>) -> Result<SharedContainerLog> {
+    /// Create a new SharedContainerLog for the container ID from an capnp
+    /// owned reader.
+    pub fn from(id: &str, reader: Reader<Owned>) -> Result<SharedContainerLog> {
         let drivers = reader
             .iter()
             .flat_map(|x| -> Result<_> {
+                let max_log_size = match x.get_max_size() {
+                    0 => None,
+                    size => Some(size as usize),
+                };
+                let rotation = LogRotation::new(x.get_max_files(), x.get_compress());
                 Ok(match x.get_type()? {
                     Type::ContainerRuntimeInterface => {
-                        LogDriver::ContainerRuntimeInterface(CriLogger::new(x.get_path()?, None)?)
+                        let fsync_policy = match x.get_fsync_policy()? {
+                            log_driver::FsyncPolicy::Never => FsyncPolicy::Never,
+                            log_driver::FsyncPolicy::Interval => FsyncPolicy::Interval(
+                                Duration::from_millis(x.get_fsync_interval_ms()),
+                            ),
+                            log_driver::FsyncPolicy::EveryLine => FsyncPolicy::EveryLine,
+                        };
+                        let mut cri_logger = CriLogger::new(x.get_path()?, max_log_size)?;
+                        cri_logger.set_rotation(rotation);
+                        cri_logger.set_write_config(LogWriteConfig::new(
+                            x.get_buffer_size() as usize,
+                            fsync_policy,
+                            x.get_direct_io(),
+                        ));
+                        Some(LogDriver::ContainerRuntimeInterface(cri_logger))
+                    }
+                    Type::Json => {
+                        let mut json_logger =
+                            JsonLogger::new(x.get_path()?, max_log_size, x.get_tag()?)?;
+                        json_logger.set_rotation(rotation);
+                        Some(LogDriver::Json(json_logger))
                     }
+                    Type::Journald => {
+                        Some(LogDriver::Journald(JournaldLogger::new(id, x.get_tag()?)?))
+                    }
+                    Type::None => None,
                 })
             })
+            .flatten()
             .collect();
-        Ok(Arc::new(RwLock::new(Self { drivers })))
+        Ok(Arc::new(RwLock::new(Self {
+            drivers,
+            ..Default::default()
+        })))
+    }
+
+    /// Set the log quota of the tenant.
+    pub fn set_quota(&mut self, quota: LogQuota) {
+        self.quota = Some(quota);
+    }
+
+    /// Start the external filter of the log, the output gets logged
+    /// unfiltered before.
+    pub async fn start_filter(log: &SharedContainerLog, config: LogFilterConfig) {
+        debug!("Starting log filter {}", config.path().display());
+        let filter = LogFilter::start(config, Arc::downgrade(log));
+        log.write().await.filter = Some(filter);
+    }
+
+    /// Retrieve the health of the log filter, which is `None` if no filter
+    /// is configured.
+    pub fn filter_health(&self) -> Result<Option<LogFilterHealth>> {
+        self.filter.as_ref().map(LogFilter::health).transpose()
+    }
+
+    /// Close the log filter of the pipe after the container output got
+    /// closed.
+    pub fn close(&mut self, pipe: Pipe) {
+        if let Some(filter) = &mut self.filter {
+            filter.close(pipe);
+        }
     }
 
     /// Asynchronously initialize all loggers.
@@ -44,8 +147,16 @@ impl ContainerLog {
         join_all(
             self.drivers
                 .iter_mut()
-                .map(|x| match x {
-                    LogDriver::ContainerRuntimeInterface(ref mut cri_logger) => cri_logger.init(),
+                .map(|x| async move {
+                    match x {
+                        LogDriver::ContainerRuntimeInterface(ref mut cri_logger) => {
+                            cri_logger.init().await
+                        }
+                        LogDriver::Json(ref mut json_logger) => json_logger.init().await,
+                        LogDriver::Journald(ref mut journald_logger) => {
+                            journald_logger.init().await
+                        }
+                    }
                 })
                 .collect::<Vec<_>>(),
         )
@@ -55,13 +166,24 @@ impl ContainerLog {
         Ok(())
     }
 
-    /// Reopen the container logs.
-    pub async fn reopen(&mut self) -> Result<()> {
+    /// Reopen the container logs, or only the one of the provided path.
+    pub async fn reopen(&mut self, path: Option<&Path>) -> Result<()> {
+        self.check_path(path)?;
         join_all(
             self.drivers
                 .iter_mut()
-                .map(|x| match x {
-                    LogDriver::ContainerRuntimeInterface(ref mut cri_logger) => cri_logger.reopen(),
+                .filter(|x| path.is_none() || x.path() == path)
+                .map(|x| async move {
+                    debug!("Reopen log driver {:?}", x.path());
+                    match x {
+                        LogDriver::ContainerRuntimeInterface(ref mut cri_logger) => {
+                            cri_logger.reopen().await
+                        }
+                        LogDriver::Json(ref mut json_logger) => json_logger.reopen().await,
+                        LogDriver::Journald(ref mut journald_logger) => {
+                            journald_logger.reopen().await
+                        }
+                    }
                 })
                 .collect::<Vec<_>>(),
         )
@@ -71,17 +193,141 @@ impl ContainerLog {
         Ok(())
     }
 
-    /// Write the contents of the provided reader into all loggers.
-    pub async fn write<T>(&mut self, pipe: Pipe, bytes: T) -> Result<()>
-    where
-        T: AsyncBufRead + Unpin + Copy,
-    {
+    /// Rotate the container logs, or only the one of the provided path.
+    pub async fn rotate(&mut self, path: Option<&Path>) -> Result<()> {
+        self.check_path(path)?;
+        join_all(
+            self.drivers
+                .iter_mut()
+                .filter(|x| path.is_none() || x.path() == path)
+                .map(|x| async move {
+                    debug!("Rotate log driver {:?}", x.path());
+                    match x {
+                        LogDriver::ContainerRuntimeInterface(ref mut cri_logger) => {
+                            cri_logger.rotate().await
+                        }
+                        LogDriver::Json(ref mut json_logger) => json_logger.rotate().await,
+                        LogDriver::Journald(ref mut journald_logger) => {
+                            journald_logger.rotate().await
+                        }
+                    }
+                })
+                .collect::<Vec<_>>(),
+        )
+        .await
+        .into_iter()
+        .collect::<Result<Vec<_>>>()?;
+        Ok(())
+    }
+
+    /// Retrieve the paths of the log files of all file based drivers.
+    pub fn paths(&self) -> Vec<PathBuf> {
+        self.drivers
+            .iter()
+            .filter_map(|x| x.path().map(PathBuf::from))
+            .collect()
+    }
+
+    /// Resolve the path of the CRI log file to be read, which is the
+    /// provided one or the one of the first CRI log driver.
+    pub fn cri_path(&self, path: Option<&Path>) -> Result<PathBuf> {
+        self.check_path(path)?;
+        if let Some(path) = path {
+            return Ok(path.into());
+        }
+        self.drivers
+            .iter()
+            .find_map(|x| match x {
+                LogDriver::ContainerRuntimeInterface(cri_logger) => Some(cri_logger.path().clone()),
+                _ => None,
+            })
+            .context("no CRI log driver configured")
+    }
+
+    /// Wait until all output produced so far has been read from the sources
+    /// and synchronize all log drivers to disk. Output still being processed
+    /// by the log filter is not waited for.
+    pub async fn flush(log: &SharedContainerLog, timeout: Duration) -> Result<()> {
+        let sources = log.read().await.sources().clone();
+        time::timeout(timeout, sources.drained())
+            .await
+            .context("timeout waiting for pending output")??;
+        log.write().await.sync().await
+    }
+
+    /// Flush all log drivers and synchronize them to disk.
+    async fn sync(&mut self) -> Result<()> {
+        join_all(
+            self.drivers
+                .iter_mut()
+                .map(|x| async move {
+                    match x {
+                        LogDriver::ContainerRuntimeInterface(ref mut cri_logger) => {
+                            cri_logger.sync().await
+                        }
+                        LogDriver::Json(ref mut json_logger) => json_logger.sync().await,
+                        LogDriver::Journald(ref mut journald_logger) => {
+                            journald_logger.sync().await
+                        }
+                    }
+                })
+                .collect::<Vec<_>>(),
+        )
+        .await
+        .into_iter()
+        .collect::<Result<Vec<_>>>()?;
+        Ok(())
+    }
+
+    /// Ensure that there is a log driver for the path if provided.
+    fn check_path(&self, path: Option<&Path>) -> Result<()> {
+        if let Some(path) = path {
+            if !self.drivers.iter().any(|x| x.path() == Some(path)) {
+                bail!("no log driver for path {}", path.display())
+            }
+        }
+        Ok(())
+    }
+
+    /// Write the contents of the provided reader into all loggers, or pass
+    /// them to the filter if configured.
+    pub async fn write(&mut self, pipe: Pipe, bytes: &[u8]) -> Result<()> {
+        if self.drivers.is_empty() {
+            return Ok(());
+        }
+
+        if let Some(quota) = &self.quota {
+            if let Err(e) = quota.acquire(bytes.len() as u64) {
+                if !self.quota_exceeded {
+                    warn!("Dropping container output: {:#}", e);
+                    self.quota_exceeded = true;
+                }
+                return Ok(());
+            }
+        }
+
+        if let Some(filter) = &self.filter {
+            return filter.send(pipe, bytes);
+        }
+        self.write_filtered(pipe, bytes).await
+    }
+
+    /// Write the already filtered output into all loggers.
+    pub async fn write_filtered(&mut self, pipe: Pipe, bytes: &[u8]) -> Result<()> {
         join_all(
             self.drivers
                 .iter_mut()
-                .map(|x| match x {
-                    LogDriver::ContainerRuntimeInterface(ref mut cri_logger) => {
-                        cri_logger.write(pipe, bytes)
+                .map(|x| async move {
+                    match x {
+                        LogDriver::ContainerRuntimeInterface(ref mut cri_logger) => {
+                            cri_logger.write(pipe, bytes).await
+                        }
+                        LogDriver::Json(ref mut json_logger) => {
+                            json_logger.write(pipe, bytes).await
+                        }
+                        LogDriver::Journald(ref mut journald_logger) => {
+                            journald_logger.write(pipe, bytes).await
+                        }
                     }
                 })
                 .collect::<Vec<_>>(),
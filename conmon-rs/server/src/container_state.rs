@@ -0,0 +1,456 @@
+//! The lifecycle states of the containers, which the server tracks explicitly
+//! to answer state queries and to publish their transitions.
+use crate::{container_events::ContainerEvents, freezer};
+use anyhow::{format_err, Result};
+use conmon_common::conmon_capnp::conmon;
+use getset::CopyGetters;
+use std::{
+    collections::HashMap,
+    fs,
+    path::{Path, PathBuf},
+    sync::{Arc, Mutex, MutexGuard},
+    time::{Duration, SystemTime, UNIX_EPOCH},
+};
+use tokio::{task, time};
+use tokio_util::sync::CancellationToken;
+use tracing::{debug, debug_span, Instrument};
+
+/// The interval of checking whether containers got started or paused.
+const CHECK_INTERVAL: Duration = Duration::from_secs(1);
+
+/// The number of removed containers whose state is kept.
+const REMOVED_CAPACITY: usize = 1000;
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// The lifecycle state of a container.
+pub enum State {
+    Unknown,
+    Created,
+    Running,
+    Paused,
+    Stopping,
+    Exited,
+    Removed,
+}
+
+impl Default for State {
+    fn default() -> Self {
+        Self::Unknown
+    }
+}
+
+impl State {
+    /// Whether the container may change from this state to the next one.
+    fn can_become(self, next: State) -> bool {
+        use State::*;
+        matches!(
+            (self, next),
+            (Unknown | Removed, Created | Running)
+                | (Created, Running | Stopping | Exited)
+                | (Running, Paused | Stopping | Exited)
+                | (Paused, Running | Stopping | Exited)
+                | (Stopping, Exited)
+                | (Exited, Removed)
+        )
+    }
+
+    /// The capnp representation of the state.
+    pub fn to_capnp(self) -> conmon::ContainerState {
+        match self {
+            Self::Unknown => conmon::ContainerState::Unknown,
+            Self::Created => conmon::ContainerState::Created,
+            Self::Running => conmon::ContainerState::Running,
+            Self::Paused => conmon::ContainerState::Paused,
+            Self::Stopping => conmon::ContainerState::Stopping,
+            Self::Exited => conmon::ContainerState::Exited,
+            Self::Removed => conmon::ContainerState::Removed,
+        }
+    }
+}
+
+#[derive(Clone, Copy, CopyGetters, Debug, Eq, PartialEq)]
+#[getset(get_copy = "pub")]
+/// The current state of a container.
+pub struct StateEntry {
+    state: State,
+
+    /// The PID of the container process.
+    pid: u32,
+
+    /// The exit code, only set once the container exited.
+    exit_code: i32,
+
+    /// Nanoseconds since the unix epoch of the last transition.
+    updated_at: u64,
+}
+
+#[derive(Clone, Debug)]
+/// The states of all containers.
+pub struct ContainerStates {
+    entries: Arc<Mutex<HashMap<(String, String), StateEntry>>>,
+
+    /// The event bus for the transitions.
+    events: ContainerEvents,
+}
+
+impl ContainerStates {
+    /// Create the states, which publish their transitions to the events.
+    pub fn new(events: ContainerEvents) -> Self {
+        Self {
+            entries: Default::default(),
+            events,
+        }
+    }
+
+    /// Retrieve the state of the container of the tenant.
+    pub fn get(&self, tenant: &str, id: &str) -> Result<Option<StateEntry>> {
+        Ok(self
+            .lock()?
+            .get(&(tenant.to_string(), id.to_string()))
+            .copied())
+    }
+
+    /// Track the newly created process `pid` of the container, which replaces
+    /// a removed one. Restored containers are running right away, otherwise
+    /// the container is running once the process executed the entrypoint.
+    /// The state gets checked until the token is cancelled.
+    pub fn watch(
+        &self,
+        tenant: &str,
+        id: &str,
+        pid: u32,
+        running: bool,
+        token: CancellationToken,
+    ) -> Result<()> {
+        let state = if running {
+            State::Running
+        } else {
+            State::Created
+        };
+        self.transition(tenant, id, None, pid, state, 0)?;
+
+        // The runtime binary gets replaced by the entrypoint on start.
+        let exe = fs::read_link(exe_path(pid)).ok();
+        let states = self.clone();
+        let tenant = tenant.to_string();
+        let id = id.to_string();
+        task::spawn(
+            async move {
+                let mut interval = time::interval(CHECK_INTERVAL);
+                loop {
+                    tokio::select! {
+                        biased;
+                        _ = token.cancelled() => return,
+                        _ = interval.tick() => {
+                            if let Err(e) = states.check(&tenant, &id, pid, exe.as_deref()) {
+                                debug!("Unable to check state: {:#}", e);
+                            }
+                        }
+                    }
+                }
+            }
+            .instrument(debug_span!("container_state", pid)),
+        );
+        Ok(())
+    }
+
+    /// Detect the start and the pausing of the container by other means than
+    /// the server, like the runtime.
+    fn check(&self, tenant: &str, id: &str, pid: u32, exe: Option<&Path>) -> Result<()> {
+        let current = match self.get(tenant, id)? {
+            Some(entry) if entry.pid == pid => entry.state,
+            _ => return Ok(()),
+        };
+        let next = match current {
+            State::Created if started(pid, exe) => State::Running,
+            State::Running if freezer::is_frozen(pid)? => State::Paused,
+            State::Paused if !freezer::is_frozen(pid)? => State::Running,
+            _ => return Ok(()),
+        };
+        self.transition(tenant, id, Some(pid), pid, next, 0)
+    }
+
+    /// Mark the container as paused or running after pausing or resuming it.
+    pub fn set_paused(&self, tenant: &str, id: &str, pid: u32, paused: bool) -> Result<()> {
+        let next = if paused {
+            State::Paused
+        } else {
+            State::Running
+        };
+        self.transition(tenant, id, Some(pid), pid, next, 0)
+    }
+
+    /// Mark the container as stopping while a stop request is in progress.
+    pub fn set_stopping(&self, tenant: &str, id: &str, pid: u32) -> Result<()> {
+        self.transition(tenant, id, Some(pid), pid, State::Stopping, 0)
+    }
+
+    /// Mark the container as exited, which is considered to be removed after
+    /// the retention.
+    pub fn set_exited(
+        &self,
+        tenant: &str,
+        id: &str,
+        pid: u32,
+        exit_code: i32,
+        retention: Duration,
+    ) -> Result<()> {
+        self.transition(tenant, id, Some(pid), pid, State::Exited, exit_code)?;
+
+        let states = self.clone();
+        let tenant = tenant.to_string();
+        let id = id.to_string();
+        task::spawn(
+            async move {
+                time::sleep(retention).await;
+                if let Err(e) = states.transition(&tenant, &id, Some(pid), pid, State::Removed, 0) {
+                    debug!("Unable to remove state: {:#}", e);
+                }
+            }
+            .instrument(debug_span!("container_state", pid)),
+        );
+        Ok(())
+    }
+
+    /// Change the state of the container and publish the transition. Only the
+    /// state of the process `expected_pid` is changed if it is set, otherwise
+    /// a new process may replace an exited one. Invalid transitions are
+    /// ignored, like pausing a stopping container.
+    fn transition(
+        &self,
+        tenant: &str,
+        id: &str,
+        expected_pid: Option<u32>,
+        pid: u32,
+        next: State,
+        exit_code: i32,
+    ) -> Result<()> {
+        let key = (tenant.to_string(), id.to_string());
+        let mut entries = self.lock()?;
+        let previous = entries.get(&key).copied().unwrap_or_default();
+        if expected_pid.map_or(false, |expected| expected != previous.pid) {
+            return Ok(());
+        }
+        let replaced = expected_pid.is_none() && previous.pid != pid;
+        if !replaced && !previous.state.can_become(next) {
+            debug!(
+                "Ignoring transition of container {} from {:?} to {:?}",
+                id, previous.state, next
+            );
+            return Ok(());
+        }
+
+        let exit_code = match next {
+            State::Exited => exit_code,
+            State::Removed => previous.exit_code,
+            _ => 0,
+        };
+        entries.insert(
+            key,
+            StateEntry {
+                state: next,
+                pid,
+                exit_code,
+                updated_at: now(),
+            },
+        );
+        if next == State::Removed {
+            prune_removed(&mut entries);
+        }
+        drop(entries);
+
+        self.events
+            .publish_state_changed(id, tenant, pid, previous.state, next);
+        Ok(())
+    }
+
+    fn lock(&self) -> Result<MutexGuard<HashMap<(String, String), StateEntry>>> {
+        self.entries
+            .lock()
+            .map_err(|e| format_err!("lock container states: {}", e))
+    }
+}
+
+/// Drop the oldest removed containers beyond the capacity.
+fn prune_removed(entries: &mut HashMap<(String, String), StateEntry>) {
+    let mut removed: Vec<_> = entries
+        .iter()
+        .filter(|(_, entry)| entry.state == State::Removed)
+        .map(|(key, entry)| (entry.updated_at, key.clone()))
+        .collect();
+    let excess = removed.len().saturating_sub(REMOVED_CAPACITY);
+    if excess == 0 {
+        return;
+    }
+    removed.sort();
+    for (_, key) in removed.drain(..excess) {
+        entries.remove(&key);
+    }
+}
+
+/// Whether the process executed the entrypoint of the container, which
+/// replaced the executable of the runtime.
+fn started(pid: u32, exe: Option<&Path>) -> bool {
+    match (exe, fs::read_link(exe_path(pid))) {
+        (_, Err(_)) => false,
+        (Some(exe), Ok(current)) => exe != current,
+        // The executable is unknown if the process already started.
+        (None, Ok(_)) => true,
+    }
+}
+
+fn exe_path(pid: u32) -> PathBuf {
+    PathBuf::from(format!("/proc/{}/exe", pid))
+}
+
+fn now() -> u64 {
+    SystemTime::now()
+        .duration_since(UNIX_EPOCH)
+        .unwrap_or_default()
+        .as_nanos() as u64
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use crate::container_events::EventType;
+    use std::process;
+
+    fn sut() -> (ContainerStates, ContainerEvents) {
+        let events = ContainerEvents::default();
+        (ContainerStates::new(events.clone()), events)
+    }
+
+    #[test]
+    fn can_become() {
+        assert!(State::Unknown.can_become(State::Created));
+        assert!(State::Created.can_become(State::Running));
+        assert!(State::Running.can_become(State::Paused));
+        assert!(State::Paused.can_become(State::Stopping));
+        assert!(State::Stopping.can_become(State::Exited));
+        assert!(State::Exited.can_become(State::Removed));
+        assert!(State::Removed.can_become(State::Created));
+
+        assert!(!State::Created.can_become(State::Paused));
+        assert!(!State::Stopping.can_become(State::Running));
+        assert!(!State::Exited.can_become(State::Running));
+        assert!(!State::Removed.can_become(State::Exited));
+    }
+
+    #[tokio::test]
+    async fn lifecycle() -> Result<()> {
+        let (sut, events) = sut();
+        let mut rx = events.subscribe();
+        // The fake processes are not checked.
+        let token = CancellationToken::new();
+        token.cancel();
+
+        sut.watch("", "ctr", 42, false, token)?;
+        assert_eq!(sut.get("", "ctr")?.map(|x| x.state()), Some(State::Created));
+        assert!(sut.get("other", "ctr")?.is_none());
+
+        let event = rx.recv().await?;
+        assert_eq!(event.typ(), EventType::StateChanged);
+        assert_eq!(event.previous_state(), State::Unknown);
+        assert_eq!(event.state(), State::Created);
+
+        sut.set_stopping("", "ctr", 42)?;
+        // Pausing a stopping container is invalid.
+        sut.set_paused("", "ctr", 42, true)?;
+        // Transitions of other processes are ignored.
+        sut.set_exited("", "ctr", 43, 1, Duration::from_secs(60))?;
+        assert_eq!(
+            sut.get("", "ctr")?.map(|x| x.state()),
+            Some(State::Stopping)
+        );
+
+        sut.set_exited("", "ctr", 42, 137, Duration::from_millis(10))?;
+        let entry = sut.get("", "ctr")?.expect("state");
+        assert_eq!(entry.state(), State::Exited);
+        assert_eq!(entry.exit_code(), 137);
+
+        time::sleep(Duration::from_millis(100)).await;
+        let entry = sut.get("", "ctr")?.expect("state");
+        assert_eq!(entry.state(), State::Removed);
+        assert_eq!(entry.exit_code(), 137);
+
+        let states: Vec<_> = events.history().iter().map(|event| event.state()).collect();
+        assert_eq!(
+            states,
+            vec![
+                State::Created,
+                State::Stopping,
+                State::Exited,
+                State::Removed
+            ]
+        );
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn replace_exited() -> Result<()> {
+        let (sut, _) = sut();
+        // The fake processes are not checked.
+        let token = CancellationToken::new();
+        token.cancel();
+        sut.watch("", "ctr", 42, false, token.clone())?;
+        sut.set_exited("", "ctr", 42, 0, Duration::from_secs(60))?;
+
+        sut.watch("", "ctr", 43, false, token)?;
+        let entry = sut.get("", "ctr")?.expect("state");
+        assert_eq!(entry.state(), State::Created);
+        assert_eq!(entry.pid(), 43);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn restored_running() -> Result<()> {
+        let (sut, _) = sut();
+        // The fake processes are not checked.
+        let token = CancellationToken::new();
+        token.cancel();
+        sut.watch("", "ctr", 42, true, token)?;
+        assert_eq!(sut.get("", "ctr")?.map(|x| x.state()), Some(State::Running));
+        Ok(())
+    }
+
+    #[test]
+    fn started_by_exe() {
+        let pid = process::id();
+        let exe = fs::read_link(exe_path(pid)).ok();
+        assert!(!started(pid, exe.as_deref()));
+        assert!(started(pid, Some(Path::new("/runtime"))));
+        assert!(started(pid, None));
+    }
+
+    #[test]
+    fn prune_removed_capacity() {
+        let mut entries = HashMap::new();
+        for i in 0..REMOVED_CAPACITY + 2 {
+            entries.insert(
+                (String::new(), i.to_string()),
+                StateEntry {
+                    state: State::Removed,
+                    pid: i as u32,
+                    exit_code: 0,
+                    updated_at: i as u64,
+                },
+            );
+        }
+        entries.insert(
+            (String::new(), "running".into()),
+            StateEntry {
+                state: State::Running,
+                pid: 1,
+                exit_code: 0,
+                updated_at: 0,
+            },
+        );
+
+        prune_removed(&mut entries);
+        assert_eq!(entries.len(), REMOVED_CAPACITY + 1);
+        assert!(!entries.contains_key(&(String::new(), "0".into())));
+        assert!(!entries.contains_key(&(String::new(), "1".into())));
+        assert!(entries.contains_key(&(String::new(), "running".into())));
+    }
+}
@@ -0,0 +1,132 @@
+//! Structured crash reports, which get written if the server panics.
+use crate::child_reaper::ChildReaper;
+use anyhow::{Context, Result};
+use capnp::{message, serialize};
+use conmon_common::conmon_capnp::conmon::crash_report;
+use lazy_static::lazy_static;
+use std::{
+    backtrace::Backtrace,
+    collections::VecDeque,
+    fs::{self, File},
+    panic::{self, PanicInfo},
+    path::{Path, PathBuf},
+    process,
+    sync::{Arc, Mutex},
+    time::{SystemTime, UNIX_EPOCH},
+};
+
+/// The maximum number of recorded RPCs.
+const RPC_CAPACITY: usize = 50;
+
+lazy_static! {
+    static ref RECENT_RPCS: Mutex<VecDeque<Rpc>> =
+        Mutex::new(VecDeque::with_capacity(RPC_CAPACITY));
+}
+
+#[derive(Clone, Debug)]
+/// A single RPC received by the server.
+struct Rpc {
+    method: String,
+    container_id: String,
+    timestamp: u64,
+}
+
+/// Record an RPC to be included in crash reports.
+pub fn record_rpc(method: &str, container_id: &str) {
+    if let Ok(mut rpcs) = RECENT_RPCS.lock() {
+        if rpcs.len() == RPC_CAPACITY {
+            rpcs.pop_front();
+        }
+        rpcs.push_back(Rpc {
+            method: method.into(),
+            container_id: container_id.into(),
+            timestamp: now(),
+        });
+    }
+}
+
+/// Install a panic hook, which writes a crash report to the provided path
+/// before calling the previous hook.
+pub fn install(path: PathBuf, reaper: Arc<ChildReaper>) {
+    let previous = panic::take_hook();
+    panic::set_hook(Box::new(move |info| {
+        if let Err(e) = write(&path, info, &reaper) {
+            eprintln!("Unable to write crash report: {:#}", e);
+        }
+        previous(info);
+    }));
+}
+
+fn write(path: &Path, info: &PanicInfo, reaper: &ChildReaper) -> Result<()> {
+    let mut message = message::Builder::new_default();
+    let mut report = message.init_root::<crash_report::Builder>();
+    report.set_timestamp(now());
+    report.set_pid(process::id());
+    report.set_message(&info.to_string());
+    report.set_backtrace(&Backtrace::force_capture().to_string());
+
+    // Do not block on locks which may be held by the panicking thread.
+    let rpcs = RECENT_RPCS
+        .try_lock()
+        .map(|rpcs| rpcs.iter().cloned().collect())
+        .unwrap_or_else(|_| vec![]);
+    let mut list = report.reborrow().init_recent_rpcs(rpcs.len() as u32);
+    for (i, rpc) in rpcs.iter().enumerate() {
+        let mut entry = list.reborrow().get(i as u32);
+        entry.set_method(&rpc.method);
+        entry.set_container_id(&rpc.container_id);
+        entry.set_timestamp(rpc.timestamp);
+    }
+
+    let ids = reaper.try_ids().unwrap_or_default();
+    let mut list = report.init_container_ids(ids.len() as u32);
+    for (i, id) in ids.iter().enumerate() {
+        list.set(i as u32, id);
+    }
+
+    // Write atomically to never expose partial reports to clients.
+    let tmp = path.with_extension("tmp");
+    serialize::write_message(File::create(&tmp).context("create crash report")?, &message)
+        .context("write crash report")?;
+    fs::rename(&tmp, path).context("rename crash report")
+}
+
+fn now() -> u64 {
+    SystemTime::now()
+        .duration_since(UNIX_EPOCH)
+        .map(|d| d.as_nanos() as u64)
+        .unwrap_or_default()
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use tempfile::tempdir;
+
+    #[test]
+    fn write_crash_report() -> Result<()> {
+        record_rpc("create_container", "foo");
+        let dir = tempdir()?;
+        let path = dir.path().join("crash-report");
+
+        let result = panic::catch_unwind(|| {
+            let reaper = Arc::new(ChildReaper::default());
+            install(path.clone(), reaper);
+            panic!("boom");
+        });
+        let _ = panic::take_hook();
+        assert!(result.is_err());
+
+        let message = serialize::read_message(File::open(&path)?, Default::default())?;
+        let report = message.get_root::<crash_report::Reader>()?;
+        assert_eq!(report.get_pid(), process::id());
+        assert!(report.get_message()?.contains("boom"));
+        assert!(!report.get_backtrace()?.is_empty());
+        assert!(report
+            .get_recent_rpcs()?
+            .iter()
+            .any(|rpc| rpc.get_method().ok() == Some("create_container")
+                && rpc.get_container_id().ok() == Some("foo")));
+        Ok(())
+    }
+}
@@ -1,6 +1,10 @@
 //! File logging functionalities.
 
-use crate::container_io::Pipe;
+use crate::{
+    container_io::Pipe,
+    log_rotation::LogRotation,
+    log_writer::{LogWriteConfig, LogWriter},
+};
 use anyhow::{Context, Result};
 use chrono::offset::Local;
 use getset::{CopyGetters, Getters, Setters};
@@ -9,10 +13,7 @@ use std::{
     marker::Unpin,
     path::{Path, PathBuf},
 };
-use tokio::{
-    fs::{File, OpenOptions},
-    io::{AsyncBufRead, AsyncBufReadExt, AsyncWriteExt, BufReader, BufWriter},
-};
+use tokio::io::{AsyncBufRead, AsyncBufReadExt, BufReader};
 use tracing::{debug, trace};
 
 #[derive(Debug, CopyGetters, Getters, Setters)]
@@ -23,12 +24,20 @@ pub struct CriLogger {
     path: PathBuf,
 
     #[getset(set)]
-    /// Open file handle of the `path`.
-    file: Option<BufWriter<File>>,
+    /// Open file writer of the `path`.
+    file: Option<LogWriter>,
 
     #[getset(get_copy)]
     /// Maximum allowed log size in bytes.
     max_log_size: Option<usize>,
+
+    #[getset(set = "pub")]
+    /// Rotation policy applied if the maximum log size is exceeded.
+    rotation: LogRotation,
+
+    #[getset(set = "pub")]
+    /// Configuration of the file writer.
+    write_config: LogWriteConfig,
 }
 
 impl CriLogger {
@@ -40,13 +49,19 @@ impl CriLogger {
             path: path.as_ref().into(),
             file: None,
             max_log_size,
+            rotation: Default::default(),
+            write_config: Default::default(),
         })
     }
 
     /// Asynchronously initialize the CRI logger.
     pub async fn init(&mut self) -> Result<()> {
         debug!("Initializing CRI logger in path {}", self.path().display());
-        self.set_file(Self::open(self.path()).await?.into());
+        self.set_file(
+            LogWriter::open(self.path(), self.write_config)
+                .await?
+                .into(),
+        );
         Ok(())
     }
 
@@ -86,9 +101,9 @@ impl CriLogger {
                 );
                 if (bytes_written + bytes_to_be_written) > max_log_size {
                     bytes_written = 0;
-                    self.reopen()
+                    self.rotate()
                         .await
-                        .context("reopen logs because of exceeded size")?;
+                        .context("rotate logs because of exceeded size")?;
                 }
             }
 
@@ -121,19 +136,36 @@ impl CriLogger {
             trace!("Wrote log line of length {}", bytes_to_be_written);
         }
 
-        self.flush().await
+        self.file
+            .as_mut()
+            .context(Self::ERR_UNINITIALIZED)?
+            .commit()
+            .await
+            .context("commit file writer")
     }
 
     /// Reopen the container log file.
     pub async fn reopen(&mut self) -> Result<()> {
         debug!("Reopen container log {}", self.path().display());
+        self.sync().await?;
+        self.init().await
+    }
+
+    /// Rotate the container log file according to the rotation policy.
+    pub async fn rotate(&mut self) -> Result<()> {
+        debug!("Rotate container log {}", self.path().display());
+        self.flush().await?;
+        self.rotation.rotate(self.path()).await?;
+        self.init().await
+    }
+
+    /// Flush the file writer and synchronize the file to disk.
+    pub async fn sync(&mut self) -> Result<()> {
         self.file
             .as_mut()
             .context(Self::ERR_UNINITIALIZED)?
-            .get_ref()
-            .sync_all()
-            .await?;
-        self.init().await
+            .sync()
+            .await
     }
 
     /// Ensures that all content is written to disk.
@@ -146,21 +178,6 @@ impl CriLogger {
             .context("flush file writer")
     }
 
-    /// Open the provided path with the default options.
-    async fn open<T: AsRef<Path>>(path: T) -> Result<BufWriter<File>> {
-        Ok(BufWriter::new(
-            OpenOptions::new()
-                .create(true)
-                .read(true)
-                .truncate(true)
-                .write(true)
-                .mode(0o600)
-                .open(&path)
-                .await
-                .context(format!("open log file path '{}'", path.as_ref().display()))?,
-        ))
-    }
-
     async fn read_line<T>(r: &mut BufReader<T>, buf: &mut Vec<u8>) -> Result<(usize, bool)>
     where
         T: AsyncBufRead + Unpin,
@@ -188,7 +205,7 @@ mod tests {
     use super::*;
     use chrono::DateTime;
     use std::fs;
-    use tempfile::NamedTempFile;
+    use tempfile::{tempdir, NamedTempFile};
 
     #[tokio::test]
     async fn write_stdout_success() -> Result<()> {
@@ -257,6 +274,25 @@ mod tests {
         Ok(())
     }
 
+    #[tokio::test]
+    async fn write_rotate() -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("log");
+        let mut sut = CriLogger::new(&path, Some(150))?;
+        sut.set_rotation(LogRotation::new(1, false));
+        sut.init().await?;
+
+        sut.write(Pipe::StdOut, "a\nb\nc\nd\ne\nf\n".as_bytes())
+            .await?;
+
+        let rotated = fs::read_to_string(dir.path().join("log.1"))?;
+        assert!(rotated.contains(" stdout F a"));
+        let res = fs::read_to_string(&path)?;
+        assert!(!res.contains(" stdout F a"));
+        assert!(res.contains(" stdout F f"));
+        Ok(())
+    }
+
     #[tokio::test]
     async fn init_failure() -> Result<()> {
         let mut sut = CriLogger::new("/file/does/not/exist", None)?;
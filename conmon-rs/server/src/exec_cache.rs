@@ -0,0 +1,186 @@
+//! Caching of exec sync results, to answer identical probes without running
+//! the command again.
+use anyhow::{format_err, Result};
+use conmon_common::conmon_capnp::conmon::exec_sync_container_response;
+use std::{
+    collections::HashMap,
+    sync::{Arc, Mutex, MutexGuard},
+    time::{Duration, Instant},
+};
+
+#[derive(Clone, Debug, Eq, Hash, PartialEq)]
+/// The identity of an exec sync request, which has to match for returning a
+/// cached result.
+pub struct ExecCacheKey {
+    tenant: String,
+    id: String,
+    command: Vec<String>,
+    terminal: bool,
+    max_output_bytes: u64,
+}
+
+impl ExecCacheKey {
+    /// Create a new cache key.
+    pub fn new(
+        tenant: &str,
+        id: &str,
+        command: Vec<String>,
+        terminal: bool,
+        max_output_bytes: u64,
+    ) -> Self {
+        Self {
+            tenant: tenant.into(),
+            id: id.into(),
+            command,
+            terminal,
+            max_output_bytes,
+        }
+    }
+}
+
+#[derive(Clone, Debug, Default)]
+/// The result of an exec sync request.
+pub struct ExecResult {
+    exit_code: i32,
+    stdout: Vec<u8>,
+    stderr: Vec<u8>,
+    timed_out: bool,
+    stdout_truncated: bool,
+    stderr_truncated: bool,
+}
+
+impl ExecResult {
+    /// Create a new result of a command which ran.
+    pub fn new(
+        exit_code: i32,
+        (stdout, stdout_truncated): (Vec<u8>, bool),
+        (stderr, stderr_truncated): (Vec<u8>, bool),
+        timed_out: bool,
+    ) -> Self {
+        Self {
+            exit_code,
+            stdout,
+            stderr,
+            timed_out,
+            stdout_truncated,
+            stderr_truncated,
+        }
+    }
+
+    /// Create a new result of a command which could not be run.
+    pub fn failed() -> Self {
+        Self {
+            exit_code: -2,
+            ..Default::default()
+        }
+    }
+
+    /// Whether the command could not be run, which should not be cached.
+    pub fn is_failed(&self) -> bool {
+        self.exit_code == -2
+    }
+
+    /// Write the result into the response, with the age of the result if it
+    /// has been cached.
+    pub fn write(&self, mut resp: exec_sync_container_response::Builder, age: Option<Duration>) {
+        resp.set_exit_code(self.exit_code);
+        resp.set_stdout(&self.stdout);
+        resp.set_stderr(&self.stderr);
+        resp.set_timed_out(self.timed_out);
+        resp.set_stdout_truncated(self.stdout_truncated);
+        resp.set_stderr_truncated(self.stderr_truncated);
+        if let Some(age) = age {
+            resp.set_cached(true);
+            resp.set_cache_age_ms(age.as_millis() as u64);
+        }
+    }
+}
+
+#[derive(Clone, Debug, Default)]
+/// The cached exec sync results of all containers.
+pub struct ExecCache(Arc<Mutex<HashMap<ExecCacheKey, Entry>>>);
+
+#[derive(Debug)]
+struct Entry {
+    result: ExecResult,
+    created: Instant,
+    expires: Instant,
+}
+
+impl ExecCache {
+    /// Retrieve the result of the key and its age, if it is not older than
+    /// the TTL.
+    pub fn get(&self, key: &ExecCacheKey, ttl: Duration) -> Result<Option<(ExecResult, Duration)>> {
+        let entries = self.lock()?;
+        Ok(entries.get(key).and_then(|entry| {
+            let age = entry.created.elapsed();
+            if age <= ttl {
+                Some((entry.result.clone(), age))
+            } else {
+                None
+            }
+        }))
+    }
+
+    /// Cache the result of the key for the TTL and remove expired results.
+    pub fn insert(&self, key: ExecCacheKey, result: ExecResult, ttl: Duration) -> Result<()> {
+        let mut entries = self.lock()?;
+        let now = Instant::now();
+        entries.retain(|_, entry| entry.expires > now);
+        entries.insert(
+            key,
+            Entry {
+                result,
+                created: now,
+                expires: now + ttl,
+            },
+        );
+        Ok(())
+    }
+
+    fn lock(&self) -> Result<MutexGuard<HashMap<ExecCacheKey, Entry>>> {
+        self.0
+            .lock()
+            .map_err(|e| format_err!("lock exec cache: {}", e))
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::thread;
+
+    fn key(command: &str) -> ExecCacheKey {
+        ExecCacheKey::new("", "id", vec![command.into()], false, 0)
+    }
+
+    #[test]
+    fn get_results_within_ttl() -> Result<()> {
+        let sut = ExecCache::default();
+        let result = ExecResult::new(1, (b"out".to_vec(), false), (vec![], false), false);
+        sut.insert(key("a"), result, Duration::from_secs(60))?;
+
+        let (cached, age) = sut.get(&key("a"), Duration::from_secs(60))?.unwrap();
+        assert_eq!(cached.exit_code, 1);
+        assert_eq!(cached.stdout, b"out");
+        assert!(age < Duration::from_secs(60));
+
+        assert!(sut.get(&key("b"), Duration::from_secs(60))?.is_none());
+
+        thread::sleep(Duration::from_millis(10));
+        assert!(sut.get(&key("a"), Duration::from_millis(5))?.is_none());
+        Ok(())
+    }
+
+    #[test]
+    fn remove_expired_results() -> Result<()> {
+        let sut = ExecCache::default();
+        sut.insert(key("a"), ExecResult::failed(), Duration::from_millis(5))?;
+        thread::sleep(Duration::from_millis(10));
+        sut.insert(key("b"), ExecResult::failed(), Duration::from_secs(60))?;
+
+        assert!(!sut.lock()?.contains_key(&key("a")));
+        assert!(sut.lock()?.contains_key(&key("b")));
+        Ok(())
+    }
+}
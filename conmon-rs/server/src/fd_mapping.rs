@@ -0,0 +1,176 @@
+//! File descriptors passed into containers and exec processes at explicit
+//! numbers.
+use crate::fd_socket::{Fd, FdSocket};
+use anyhow::{bail, Context, Result};
+use capnp::struct_list;
+use conmon_common::conmon_capnp::conmon::fd_mapping;
+use std::{fs::File, os::unix::io::IntoRawFd};
+
+/// The number of the first file descriptor passed to the runtime.
+const FIRST_FD: u32 = 3;
+
+/// The highest number of a mapped file descriptor.
+const MAX_TARGET: u32 = 1023;
+
+#[derive(Debug, Default)]
+/// The file descriptors passed to the runtime as fd 3 and onwards, which
+/// keeps them open via `--preserve-fds`.
+pub struct MappedFds {
+    fds: Vec<Fd>,
+
+    /// The names of the leading socket activation file descriptors.
+    listen_fd_names: Vec<String>,
+}
+
+impl MappedFds {
+    /// Pass the file descriptors consecutively starting at fd 3.
+    pub fn sequential(fds: Vec<Fd>) -> Self {
+        Self {
+            fds,
+            listen_fd_names: vec![],
+        }
+    }
+
+    /// Take the file descriptors of the mappings from the fd socket. Gaps
+    /// between the targets get filled with `/dev/null`.
+    pub fn from_mappings(
+        mappings: struct_list::Reader<fd_mapping::Owned>,
+        fd_socket: &FdSocket,
+    ) -> Result<Self> {
+        let mut entries = Vec::with_capacity(mappings.len() as usize);
+        for mapping in mappings.iter() {
+            let target = mapping.get_target();
+            if !(FIRST_FD..=MAX_TARGET).contains(&target) {
+                bail!(
+                    "fd target {} is not within {} and {}",
+                    target,
+                    FIRST_FD,
+                    MAX_TARGET
+                )
+            }
+            let name = match mapping.get_kind()? {
+                fd_mapping::Kind::Generic => None,
+                fd_mapping::Kind::ListenSocket => Some(mapping.get_name()?.to_string()),
+            };
+            entries.push((target, mapping.get_slot(), name));
+        }
+        entries.sort_by_key(|(target, _, _)| *target);
+        if let Some(w) = entries.windows(2).find(|w| w[0].0 == w[1].0) {
+            bail!("fd target {} is mapped more than once", w[0].0)
+        }
+
+        let listen_fd_names: Vec<String> = entries
+            .iter()
+            .filter_map(|(_, _, name)| name.clone())
+            .collect();
+        // Socket activation expects the listen sockets at fd 3 and onwards.
+        for (i, (target, _, name)) in entries.iter().enumerate() {
+            let after_listen = entries[..i].iter().all(|(_, _, name)| name.is_some());
+            if name.is_some() && (*target != FIRST_FD + i as u32 || !after_listen) {
+                bail!("listen socket fd {} does not precede all other fds", target)
+            }
+        }
+
+        let slots: Vec<u64> = entries.iter().map(|(_, slot, _)| *slot).collect();
+        let mut received = fd_socket.take(&slots)?.into_iter();
+
+        let mut fds = vec![];
+        for (target, _, _) in &entries {
+            while FIRST_FD + (fds.len() as u32) < *target {
+                let null = File::open("/dev/null").context("open /dev/null")?;
+                fds.push(Fd::from(null.into_raw_fd()));
+            }
+            fds.push(received.next().context("missing received fd")?);
+        }
+
+        Ok(Self {
+            fds,
+            listen_fd_names,
+        })
+    }
+
+    /// The file descriptors in the order of their numbers.
+    pub fn fds(&self) -> &[Fd] {
+        &self.fds
+    }
+
+    /// The number of file descriptors passed to the runtime.
+    pub fn len(&self) -> usize {
+        self.fds.len()
+    }
+
+    /// Whether there are no file descriptors to pass.
+    pub fn is_empty(&self) -> bool {
+        self.fds.is_empty()
+    }
+
+    /// The socket activation environment of the runtime, which passes it into
+    /// the process.
+    pub fn env(&self) -> Vec<(&'static str, String)> {
+        if self.listen_fd_names.is_empty() {
+            return vec![];
+        }
+        vec![
+            ("LISTEN_FDS", self.listen_fd_names.len().to_string()),
+            ("LISTEN_FDNAMES", self.listen_fd_names.join(":")),
+        ]
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use capnp::message;
+    use std::os::unix::io::AsRawFd;
+
+    fn mappings(
+        fd_socket: &FdSocket,
+        entries: &[(u32, Option<&str>)],
+    ) -> Result<message::Builder<message::HeapAllocator>> {
+        let mut message = message::Builder::new_default();
+        let mut list =
+            message.initn_root::<struct_list::Builder<fd_mapping::Owned>>(entries.len() as u32);
+        for (i, (target, name)) in entries.iter().enumerate() {
+            let fd = File::open("/dev/null")?.into_raw_fd();
+            let slot = fd_socket.add(&[fd])?[0];
+            let mut mapping = list.reborrow().get(i as u32);
+            mapping.set_slot(slot);
+            mapping.set_target(*target);
+            if let Some(name) = name {
+                mapping.set_kind(fd_mapping::Kind::ListenSocket);
+                mapping.set_name(name);
+            }
+        }
+        Ok(message)
+    }
+
+    fn from_mappings(entries: &[(u32, Option<&str>)]) -> Result<MappedFds> {
+        let fd_socket = FdSocket::default();
+        let message = mappings(&fd_socket, entries)?;
+        let list = message.get_root_as_reader::<struct_list::Reader<fd_mapping::Owned>>()?;
+        MappedFds::from_mappings(list, &fd_socket)
+    }
+
+    #[test]
+    fn fill_gaps() -> Result<()> {
+        let sut = from_mappings(&[(6, None), (3, Some("http")), (4, Some("https"))])?;
+        assert_eq!(sut.len(), 4);
+        assert!(sut.fds().iter().all(|fd| fd.as_raw_fd() >= 0));
+        assert_eq!(
+            sut.env(),
+            vec![
+                ("LISTEN_FDS", "2".to_string()),
+                ("LISTEN_FDNAMES", "http:https".to_string())
+            ]
+        );
+        Ok(())
+    }
+
+    #[test]
+    fn invalid_mappings() {
+        assert!(from_mappings(&[(2, None)]).is_err());
+        assert!(from_mappings(&[(3, None), (3, None)]).is_err());
+        assert!(from_mappings(&[(3, None), (4, Some("http"))]).is_err());
+        assert!(from_mappings(&[(MAX_TARGET + 1, None)]).is_err());
+    }
+}
@@ -0,0 +1,202 @@
+//! Receiving of file descriptors from clients, which can be passed into
+//! containers by referencing them in later requests.
+use anyhow::{bail, format_err, Context, Result};
+use nix::unistd::close;
+use sendfd::RecvWithFd;
+use std::{
+    collections::HashMap,
+    io::ErrorKind,
+    os::unix::io::{AsRawFd, RawFd},
+    sync::{Arc, Mutex, MutexGuard},
+};
+use tokio::{
+    io::AsyncWriteExt,
+    net::{UnixListener, UnixStream},
+    task,
+};
+use tracing::{debug, debug_span, error, Instrument};
+
+/// The maximum number of file descriptors per message, which is `SCM_MAX_FD`
+/// on Linux.
+const MAX_FDS: usize = 253;
+
+#[derive(Debug)]
+/// A received file descriptor, which gets closed when dropped.
+pub struct Fd(RawFd);
+
+impl From<RawFd> for Fd {
+    fn from(fd: RawFd) -> Self {
+        Self(fd)
+    }
+}
+
+impl AsRawFd for Fd {
+    fn as_raw_fd(&self) -> RawFd {
+        self.0
+    }
+}
+
+impl Drop for Fd {
+    fn drop(&mut self) {
+        if let Err(e) = close(self.0) {
+            debug!("Unable to close received fd {}: {}", self.0, e);
+        }
+    }
+}
+
+#[derive(Debug, Default)]
+/// The file descriptors received from clients, referenced by slots.
+///
+/// Clients send the file descriptors in a message of a single byte and
+/// receive a little endian `u64` slot per file descriptor in return. The
+/// file descriptors which have not been taken get closed if the client
+/// closes its connection.
+pub struct FdSocket {
+    state: Mutex<State>,
+}
+
+#[derive(Debug, Default)]
+struct State {
+    next: u64,
+    fds: HashMap<u64, Fd>,
+}
+
+impl FdSocket {
+    /// Serve the clients connecting to the listener.
+    pub async fn serve(self: Arc<Self>, listener: UnixListener) {
+        loop {
+            let stream = match listener.accept().await {
+                Ok((stream, _)) => stream,
+                Err(e) => {
+                    error!("Unable to accept fd socket connection: {}", e);
+                    return;
+                }
+            };
+            let fd_socket = self.clone();
+            task::spawn(
+                async move {
+                    if let Err(e) = fd_socket.handle(stream).await {
+                        error!("Unable to receive fds: {:#}", e);
+                    }
+                }
+                .instrument(debug_span!("fd_socket")),
+            );
+        }
+    }
+
+    /// Take the file descriptors of the slots, which fails if any of them
+    /// does not exist.
+    pub fn take(&self, slots: &[u64]) -> Result<Vec<Fd>> {
+        let mut state = self.lock()?;
+        if let Some(slot) = slots.iter().find(|x| !state.fds.contains_key(*x)) {
+            bail!("no fd in slot {}", slot)
+        }
+        Ok(slots.iter().filter_map(|x| state.fds.remove(x)).collect())
+    }
+
+    async fn handle(&self, mut stream: UnixStream) -> Result<()> {
+        let mut slots = vec![];
+        let res = self.receive(&mut stream, &mut slots).await;
+
+        // Close the fds which have not been taken.
+        let mut state = self.lock()?;
+        for slot in slots {
+            state.fds.remove(&slot);
+        }
+        res
+    }
+
+    async fn receive(&self, stream: &mut UnixStream, slots: &mut Vec<u64>) -> Result<()> {
+        loop {
+            stream.readable().await.context("wait for fd socket")?;
+
+            let mut data = [0; 1];
+            let mut fds = [0; MAX_FDS];
+            let (read, fds_read) = match stream.recv_with_fd(&mut data, &mut fds) {
+                Ok(x) => x,
+                Err(ref e) if e.kind() == ErrorKind::WouldBlock => continue,
+                Err(e) => return Err(e).context("receive fds"),
+            };
+            if read == 0 && fds_read == 0 {
+                debug!("Fd socket connection closed");
+                return Ok(());
+            }
+
+            let received = self.add(&fds[..fds_read])?;
+            debug!("Received fds into slots {:?}", received);
+
+            let response: Vec<u8> = received.iter().flat_map(|x| x.to_le_bytes()).collect();
+            slots.extend(received);
+            stream
+                .write_all(&response)
+                .await
+                .context("write fd slots")?;
+        }
+    }
+
+    /// Take ownership of the fds and put them into new slots.
+    pub(crate) fn add(&self, fds: &[RawFd]) -> Result<Vec<u64>> {
+        let mut state = self.lock()?;
+        let mut slots = vec![];
+        for fd in fds {
+            state.next += 1;
+            let slot = state.next;
+            state.fds.insert(slot, Fd(*fd));
+            slots.push(slot);
+        }
+        Ok(slots)
+    }
+
+    fn lock(&self) -> Result<MutexGuard<State>> {
+        self.state
+            .lock()
+            .map_err(|e| format_err!("lock fd socket: {}", e))
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use sendfd::SendWithFd;
+    use std::{convert::TryInto, fs::File, time::Duration};
+    use tempfile::tempdir;
+    use tokio::{io::AsyncReadExt, io::Interest, time};
+
+    #[tokio::test(flavor = "multi_thread")]
+    async fn receive_and_take_fds() -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("fd.sock");
+        let sut = Arc::new(FdSocket::default());
+        task::spawn(sut.clone().serve(UnixListener::bind(&path)?));
+
+        let mut stream = UnixStream::connect(&path).await?;
+        let file = File::open("/dev/null")?;
+        loop {
+            stream.ready(Interest::WRITABLE).await?;
+            match stream.send_with_fd(&[0], &[file.as_raw_fd(), file.as_raw_fd()]) {
+                Ok(_) => break,
+                Err(ref e) if e.kind() == ErrorKind::WouldBlock => continue,
+                Err(e) => bail!(e),
+            }
+        }
+        let mut response = [0; 16];
+        stream.read_exact(&mut response).await?;
+        let first = u64::from_le_bytes(response[..8].try_into()?);
+        let second = u64::from_le_bytes(response[8..].try_into()?);
+
+        assert_eq!(sut.take(&[first])?.len(), 1);
+        assert!(sut.take(&[first]).is_err());
+        assert!(sut.take(&[first, second]).is_err());
+
+        // The remaining fd gets closed with the connection.
+        drop(stream);
+        time::timeout(Duration::from_secs(1), async {
+            while !sut.lock()?.fds.is_empty() {
+                time::sleep(Duration::from_millis(10)).await;
+            }
+            Ok::<_, anyhow::Error>(())
+        })
+        .await??;
+        Ok(())
+    }
+}
@@ -0,0 +1,158 @@
+//! Freezing of cgroups, which pauses all processes of a container.
+use crate::{rpc_error::RpcError, stats};
+use anyhow::{Context, Result};
+use std::{
+    fs,
+    path::{Path, PathBuf},
+};
+use tokio::time::{self, Duration, Instant};
+
+/// The maximum duration to wait for a cgroup to reach the requested state.
+const STATE_TIMEOUT: Duration = Duration::from_secs(10);
+
+/// The interval of checking whether a cgroup reached the requested state.
+const STATE_INTERVAL: Duration = Duration::from_millis(10);
+
+#[derive(Debug)]
+/// The freezer of a cgroup.
+pub enum Freezer {
+    /// The freezer of the unified hierarchy of cgroup v2.
+    V2(PathBuf),
+
+    /// The freezer controller of cgroup v1.
+    V1(PathBuf),
+}
+
+impl Freezer {
+    /// Find the freezer of the cgroup of the process `pid`, `None` if the
+    /// cgroup has none.
+    pub fn for_pid(pid: u32) -> Result<Option<Self>> {
+        let path = format!("/proc/{}/cgroup", pid);
+        let cgroups = fs::read_to_string(&path).with_context(|| format!("read {}", path))?;
+        for (controllers, cgroup) in cgroups.lines().filter_map(stats::parse_cgroup_line) {
+            let cgroup = cgroup.trim_start_matches('/');
+            if controllers.is_empty() {
+                let dir = Path::new(stats::CGROUP_ROOT).join(cgroup);
+                if dir.join("cgroup.freeze").exists() {
+                    return Ok(Some(Self::V2(dir)));
+                }
+            } else if controllers.split(',').any(|x| x == "freezer") {
+                let dir = Path::new(stats::CGROUP_ROOT).join("freezer").join(cgroup);
+                return Ok(Some(Self::V1(dir)));
+            }
+        }
+        Ok(None)
+    }
+
+    /// Returns true if all processes of the cgroup are frozen.
+    pub fn is_frozen(&self) -> Result<bool> {
+        match self {
+            Self::V2(dir) => {
+                let content =
+                    fs::read_to_string(dir.join("cgroup.events")).context("read cgroup events")?;
+                Ok(parse_frozen_v2(&content))
+            }
+            Self::V1(dir) => Ok(Self::state_v1(dir)? == "FROZEN"),
+        }
+    }
+
+    /// Returns true if the cgroup is requested to be frozen, which is the
+    /// case while it is still freezing.
+    pub fn is_freezing(&self) -> Result<bool> {
+        match self {
+            Self::V2(dir) => Ok(fs::read_to_string(dir.join("cgroup.freeze"))
+                .context("read cgroup freeze")?
+                .trim()
+                == "1"),
+            Self::V1(dir) => Ok(Self::state_v1(dir)? != "THAWED"),
+        }
+    }
+
+    fn state_v1(dir: &Path) -> Result<String> {
+        Ok(fs::read_to_string(dir.join("freezer.state"))
+            .context("read freezer state")?
+            .trim()
+            .into())
+    }
+
+    /// Freeze all processes of the cgroup and wait until they are frozen.
+    pub async fn freeze(&self) -> Result<()> {
+        self.set(true).await
+    }
+
+    /// Thaw all processes of the cgroup and wait until they are thawed.
+    pub async fn thaw(&self) -> Result<()> {
+        self.set(false).await
+    }
+
+    async fn set(&self, frozen: bool) -> Result<()> {
+        let (file, content) = match (self, frozen) {
+            (Self::V2(dir), _) => (dir.join("cgroup.freeze"), if frozen { "1" } else { "0" }),
+            (Self::V1(dir), true) => (dir.join("freezer.state"), "FROZEN"),
+            (Self::V1(dir), false) => (dir.join("freezer.state"), "THAWED"),
+        };
+        let deadline = Instant::now() + STATE_TIMEOUT;
+        loop {
+            // Writing the state again is required for cgroup v1, where
+            // freezing may get stuck in the freezing state.
+            fs::write(&file, content).with_context(|| format!("write {}", file.display()))?;
+            if self.is_frozen()? == frozen {
+                return Ok(());
+            }
+            if Instant::now() >= deadline {
+                return Err(RpcError::timeout(
+                    "cgroup did not reach the requested state in time".into(),
+                )
+                .into());
+            }
+            time::sleep(STATE_INTERVAL).await;
+        }
+    }
+}
+
+/// Returns true if the cgroup of the process `pid` is frozen.
+pub fn is_frozen(pid: u32) -> Result<bool> {
+    match Freezer::for_pid(pid)? {
+        Some(freezer) => freezer.is_frozen(),
+        None => Ok(false),
+    }
+}
+
+/// Parse the frozen state of the cgroup v2 `cgroup.events` file.
+fn parse_frozen_v2(content: &str) -> bool {
+    content
+        .lines()
+        .any(|line| line.split_once(' ') == Some(("frozen", "1")))
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use tempfile::tempdir;
+
+    #[test]
+    fn parse_frozen_v2_success() {
+        assert!(parse_frozen_v2("populated 1\nfrozen 1\n"));
+        assert!(!parse_frozen_v2("populated 1\nfrozen 0\n"));
+        assert!(!parse_frozen_v2("populated 1\n"));
+    }
+
+    #[tokio::test]
+    async fn freeze_v1() -> Result<()> {
+        // The kernel reports the written state, which is simulated by a
+        // plain file.
+        let dir = tempdir()?;
+        fs::write(dir.path().join("freezer.state"), "THAWED\n")?;
+        let sut = Freezer::V1(dir.path().into());
+        assert!(!sut.is_frozen()?);
+        assert!(!sut.is_freezing()?);
+
+        sut.freeze().await?;
+        assert!(sut.is_frozen()?);
+        assert!(sut.is_freezing()?);
+
+        sut.thaw().await?;
+        assert!(!sut.is_frozen()?);
+        Ok(())
+    }
+}
@@ -0,0 +1,52 @@
+//! Self-report of the server process for health checks.
+use anyhow::{Context, Result};
+use std::{fs, path::Path};
+
+/// Count the open file descriptors of the server process.
+pub fn open_fds() -> Result<u64> {
+    count_entries(Path::new("/proc/self/fd"))
+}
+
+/// Retrieve the resident set size of the server process in bytes.
+pub fn memory_rss_bytes() -> Result<u64> {
+    let status = fs::read_to_string("/proc/self/status").context("read process status")?;
+    parse_rss_bytes(&status).context("no resident set size in process status")
+}
+
+fn count_entries(dir: &Path) -> Result<u64> {
+    // The directory itself is open while reading it.
+    let entries = fs::read_dir(dir)
+        .with_context(|| format!("read {}", dir.display()))?
+        .count() as u64;
+    Ok(entries.saturating_sub(1))
+}
+
+/// Parse the `VmRSS` line of `/proc/PID/status`, which is reported in KiB.
+fn parse_rss_bytes(status: &str) -> Option<u64> {
+    status
+        .lines()
+        .find_map(|line| line.strip_prefix("VmRSS:"))
+        .and_then(|value| value.trim().strip_suffix("kB"))
+        .and_then(|value| value.trim().parse::<u64>().ok())
+        .map(|kib| kib * 1024)
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn parse_rss_bytes_success() {
+        let status = "Name:\tconmonrs\nVmPeak:\t  2048 kB\nVmRSS:\t    1024 kB\nThreads:\t4\n";
+        assert_eq!(parse_rss_bytes(status), Some(1024 * 1024));
+        assert_eq!(parse_rss_bytes("Name:\tconmonrs\n"), None);
+        assert_eq!(parse_rss_bytes("VmRSS:\tinvalid kB\n"), None);
+    }
+
+    #[test]
+    fn open_fds_success() -> Result<()> {
+        assert!(open_fds()? > 0);
+        assert!(memory_rss_bytes()? > 0);
+        Ok(())
+    }
+}
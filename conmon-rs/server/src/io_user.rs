@@ -0,0 +1,37 @@
+//! The unprivileged user the helper processes of the container IO run as.
+//!
+//! The forwarding of the container IO itself runs on the shared runtime of
+//! the server, which cannot drop its credentials per container. Processes
+//! spawned for a container, like its log filter, run as the IO user instead
+//! to limit the impact if they get compromised.
+use conmon_common::conmon_capnp::conmon::create_container_request;
+use getset::CopyGetters;
+use tokio::process::Command;
+
+#[derive(Clone, Copy, CopyGetters, Debug, Eq, PartialEq)]
+#[getset(get_copy = "pub")]
+/// The user and group ID of the helper processes of a container.
+pub struct IoUser {
+    uid: u32,
+    gid: u32,
+}
+
+impl IoUser {
+    /// Read the IO user of a create request, `None` if unset.
+    pub fn from_request(req: create_container_request::Reader) -> capnp::Result<Option<Self>> {
+        if !req.has_io_user() {
+            return Ok(None);
+        }
+        let user = req.get_io_user()?;
+        Ok(Some(Self {
+            uid: user.get_uid(),
+            gid: user.get_gid(),
+        }))
+    }
+
+    /// Run the command as the user. The supplementary groups of the server
+    /// are dropped as well if it is privileged.
+    pub fn apply(&self, cmd: &mut Command) {
+        cmd.uid(self.uid).gid(self.gid);
+    }
+}
@@ -0,0 +1,160 @@
+//! Journald logging functionalities using the native journal protocol.
+
+use crate::container_io::Pipe;
+use anyhow::{Context, Result};
+use getset::Getters;
+use memchr::memchr;
+use std::path::PathBuf;
+use tokio::net::UnixDatagram;
+use tracing::debug;
+
+/// The socket of the native journal protocol.
+const JOURNALD_SOCKET: &str = "/run/systemd/journal/socket";
+
+#[derive(Debug, Getters)]
+/// The journald logger, which sends a journal entry per line.
+pub struct JournaldLogger {
+    #[getset(get)]
+    /// ID of the logged container.
+    container_id: String,
+
+    #[getset(get)]
+    /// Tag used as syslog identifier if not empty.
+    tag: String,
+
+    /// Path to the journal socket.
+    socket_path: PathBuf,
+
+    /// Connected journal socket.
+    socket: Option<UnixDatagram>,
+}
+
+impl JournaldLogger {
+    const ERR_UNINITIALIZED: &'static str = "logger not initialized";
+
+    /// Create a new journald logger instance.
+    pub fn new(container_id: &str, tag: &str) -> Result<Self> {
+        Ok(Self {
+            container_id: container_id.into(),
+            tag: tag.into(),
+            socket_path: JOURNALD_SOCKET.into(),
+            socket: None,
+        })
+    }
+
+    /// Asynchronously initialize the journald logger.
+    pub async fn init(&mut self) -> Result<()> {
+        debug!(
+            "Initializing journald logger for container {}",
+            self.container_id()
+        );
+        let socket = UnixDatagram::unbound().context("create journal socket")?;
+        socket
+            .connect(&self.socket_path)
+            .context("connect to journal socket")?;
+        self.socket = Some(socket);
+        Ok(())
+    }
+
+    /// Write the provided bytes into the journal, one entry per line.
+    pub async fn write(&mut self, pipe: Pipe, mut bytes: &[u8]) -> Result<()> {
+        let priority: &[u8] = match pipe {
+            Pipe::StdOut => b"6",
+            Pipe::StdErr => b"3",
+        };
+
+        while !bytes.is_empty() {
+            let (line, rest) = match memchr(b'\n', bytes) {
+                Some(i) => (&bytes[..i], &bytes[i + 1..]),
+                None => (bytes, &[][..]),
+            };
+            bytes = rest;
+
+            let mut entry = vec![];
+            append_field(&mut entry, "MESSAGE", line);
+            append_field(&mut entry, "PRIORITY", priority);
+            append_field(
+                &mut entry,
+                "CONTAINER_ID_FULL",
+                self.container_id().as_bytes(),
+            );
+            if !self.tag().is_empty() {
+                append_field(&mut entry, "CONTAINER_TAG", self.tag().as_bytes());
+                append_field(&mut entry, "SYSLOG_IDENTIFIER", self.tag().as_bytes());
+            }
+
+            self.socket
+                .as_ref()
+                .context(Self::ERR_UNINITIALIZED)?
+                .send(&entry)
+                .await
+                .context("send journal entry")?;
+        }
+        Ok(())
+    }
+
+    /// The journal does not have to be reopened.
+    pub async fn reopen(&mut self) -> Result<()> {
+        Ok(())
+    }
+
+    /// The journal does not have to be rotated.
+    pub async fn rotate(&mut self) -> Result<()> {
+        Ok(())
+    }
+
+    /// Entries are sent to the journal synchronously.
+    pub async fn sync(&mut self) -> Result<()> {
+        Ok(())
+    }
+}
+
+/// Append a field to the journal entry, using the binary format if the value
+/// contains newlines.
+fn append_field(entry: &mut Vec<u8>, key: &str, value: &[u8]) {
+    entry.extend_from_slice(key.as_bytes());
+    if memchr(b'\n', value).is_some() {
+        entry.push(b'\n');
+        entry.extend_from_slice(&(value.len() as u64).to_le_bytes());
+    } else {
+        entry.push(b'=');
+    }
+    entry.extend_from_slice(value);
+    entry.push(b'\n');
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use tempfile::tempdir;
+
+    #[test]
+    fn append_binary_field() {
+        let mut entry = vec![];
+        append_field(&mut entry, "KEY", b"a\nb");
+        assert_eq!(entry, b"KEY\n\x03\0\0\0\0\0\0\0a\nb\n");
+    }
+
+    #[tokio::test]
+    async fn write_entries() -> Result<()> {
+        let dir = tempdir()?;
+        let socket_path = dir.path().join("socket");
+        let journal = UnixDatagram::bind(&socket_path)?;
+
+        let mut sut = JournaldLogger::new("id", "web")?;
+        sut.socket_path = socket_path;
+        sut.init().await?;
+        sut.write(Pipe::StdErr, b"first\nsecond").await?;
+
+        let mut buf = [0; 1024];
+        for message in ["first", "second"] {
+            let len = journal.recv(&mut buf).await?;
+            let entry = String::from_utf8_lossy(&buf[..len]);
+            assert!(entry.contains(&format!("MESSAGE={}\n", message)));
+            assert!(entry.contains("PRIORITY=3\n"));
+            assert!(entry.contains("CONTAINER_ID_FULL=id\n"));
+            assert!(entry.contains("SYSLOG_IDENTIFIER=web\n"));
+        }
+        Ok(())
+    }
+}
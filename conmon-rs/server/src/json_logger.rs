@@ -0,0 +1,212 @@
+//! JSON file logging functionalities, compatible with the docker json-file
+//! log driver.
+
+use crate::{container_io::Pipe, log_rotation::LogRotation};
+use anyhow::{Context, Result};
+use chrono::{SecondsFormat, Utc};
+use getset::{CopyGetters, Getters, Setters};
+use memchr::memchr;
+use std::path::{Path, PathBuf};
+use tokio::{
+    fs::{File, OpenOptions},
+    io::{AsyncWriteExt, BufWriter},
+};
+use tracing::debug;
+
+#[derive(Debug, CopyGetters, Getters, Setters)]
+/// The JSON file logger, which writes a JSON object per line.
+pub struct JsonLogger {
+    #[getset(get)]
+    /// Path to the file on disk.
+    path: PathBuf,
+
+    /// Open file handle of the `path`.
+    file: Option<BufWriter<File>>,
+
+    #[getset(get_copy)]
+    /// Maximum allowed log size in bytes.
+    max_log_size: Option<usize>,
+
+    /// Bytes written since the file got opened.
+    bytes_written: usize,
+
+    #[getset(get)]
+    /// Tag added to every log line if not empty.
+    tag: String,
+
+    #[getset(set = "pub")]
+    /// Rotation policy applied if the maximum log size is exceeded.
+    rotation: LogRotation,
+}
+
+impl JsonLogger {
+    const ERR_UNINITIALIZED: &'static str = "logger not initialized";
+
+    /// Create a new JSON file logger instance.
+    pub fn new<T: AsRef<Path>>(path: T, max_log_size: Option<usize>, tag: &str) -> Result<Self> {
+        Ok(Self {
+            path: path.as_ref().into(),
+            file: None,
+            max_log_size,
+            bytes_written: 0,
+            tag: tag.into(),
+            rotation: Default::default(),
+        })
+    }
+
+    /// Asynchronously initialize the JSON file logger.
+    pub async fn init(&mut self) -> Result<()> {
+        debug!("Initializing JSON logger in path {}", self.path().display());
+        self.file = Some(BufWriter::new(
+            OpenOptions::new()
+                .create(true)
+                .truncate(true)
+                .write(true)
+                .mode(0o600)
+                .open(self.path())
+                .await
+                .context(format!("open log file path '{}'", self.path().display()))?,
+        ));
+        self.bytes_written = 0;
+        Ok(())
+    }
+
+    /// Write the provided bytes into the file logger, one object per line.
+    pub async fn write(&mut self, pipe: Pipe, mut bytes: &[u8]) -> Result<()> {
+        let time = Utc::now().to_rfc3339_opts(SecondsFormat::Nanos, true);
+        let stream = match pipe {
+            Pipe::StdOut => "stdout",
+            Pipe::StdErr => "stderr",
+        };
+
+        while !bytes.is_empty() {
+            let end = memchr(b'\n', bytes).map_or(bytes.len(), |i| i + 1);
+            let (line, rest) = bytes.split_at(end);
+            bytes = rest;
+
+            let mut entry = format!(
+                r#"{{"log":"{}","stream":"{}","time":"{}""#,
+                escape(&String::from_utf8_lossy(line)),
+                stream,
+                time
+            );
+            if !self.tag().is_empty() {
+                entry.push_str(&format!(r#","attrs":{{"tag":"{}"}}"#, escape(self.tag())));
+            }
+            entry.push_str("}\n");
+
+            if let Some(max_log_size) = self.max_log_size() {
+                if self.bytes_written + entry.len() > max_log_size {
+                    self.rotate()
+                        .await
+                        .context("rotate logs because of exceeded size")?;
+                }
+            }
+
+            self.file
+                .as_mut()
+                .context(Self::ERR_UNINITIALIZED)?
+                .write_all(entry.as_bytes())
+                .await?;
+            self.bytes_written += entry.len();
+        }
+
+        self.file
+            .as_mut()
+            .context(Self::ERR_UNINITIALIZED)?
+            .flush()
+            .await
+            .context("flush file writer")
+    }
+
+    /// Reopen the container log file.
+    pub async fn reopen(&mut self) -> Result<()> {
+        debug!("Reopen JSON container log {}", self.path().display());
+        let file = self.file.as_mut().context(Self::ERR_UNINITIALIZED)?;
+        file.flush().await?;
+        file.get_ref().sync_all().await?;
+        self.init().await
+    }
+
+    /// Flush the file writer and synchronize the file to disk.
+    pub async fn sync(&mut self) -> Result<()> {
+        let file = self.file.as_mut().context(Self::ERR_UNINITIALIZED)?;
+        file.flush().await.context("flush file writer")?;
+        file.get_ref().sync_data().await.context("sync log file")
+    }
+
+    /// Rotate the container log file according to the rotation policy.
+    pub async fn rotate(&mut self) -> Result<()> {
+        debug!("Rotate JSON container log {}", self.path().display());
+        self.file
+            .as_mut()
+            .context(Self::ERR_UNINITIALIZED)?
+            .flush()
+            .await?;
+        self.rotation.rotate(self.path()).await?;
+        self.init().await
+    }
+}
+
+/// Escape a string to be used as JSON string value.
+fn escape(s: &str) -> String {
+    let mut escaped = String::with_capacity(s.len());
+    for c in s.chars() {
+        match c {
+            '"' => escaped.push_str("\\\""),
+            '\\' => escaped.push_str("\\\\"),
+            '\n' => escaped.push_str("\\n"),
+            '\r' => escaped.push_str("\\r"),
+            '\t' => escaped.push_str("\\t"),
+            c if (c as u32) < 0x20 => escaped.push_str(&format!("\\u{:04x}", c as u32)),
+            c => escaped.push(c),
+        }
+    }
+    escaped
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::fs;
+    use tempfile::NamedTempFile;
+
+    #[test]
+    fn escape_special_characters() {
+        assert_eq!(escape("a\"b\\c\n\t\u{1}"), r#"a\"b\\c\n\t\u0001"#);
+    }
+
+    #[tokio::test]
+    async fn write_lines() -> Result<()> {
+        let file = NamedTempFile::new()?;
+        let mut sut = JsonLogger::new(file.path(), None, "web")?;
+        sut.init().await?;
+
+        sut.write(Pipe::StdOut, b"first \"line\"\nsecond").await?;
+        sut.write(Pipe::StdErr, b"error\n").await?;
+
+        let res = fs::read_to_string(file.path())?;
+        let lines: Vec<&str> = res.lines().collect();
+        assert_eq!(lines.len(), 3);
+        assert!(lines[0].starts_with(r#"{"log":"first \"line\"\n","stream":"stdout","time":""#));
+        assert!(lines[0].ends_with(r#","attrs":{"tag":"web"}}"#));
+        assert!(lines[1].starts_with(r#"{"log":"second","stream":"stdout""#));
+        assert!(lines[2].starts_with(r#"{"log":"error\n","stream":"stderr""#));
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn reopen_if_max_size_exceeded() -> Result<()> {
+        let file = NamedTempFile::new()?;
+        let mut sut = JsonLogger::new(file.path(), Some(100), "")?;
+        sut.init().await?;
+
+        sut.write(Pipe::StdOut, b"first\n").await?;
+        sut.write(Pipe::StdOut, b"second\n").await?;
+
+        let res = fs::read_to_string(file.path())?;
+        assert_eq!(res.lines().count(), 1);
+        assert!(res.contains("second"));
+        Ok(())
+    }
+}
@@ -0,0 +1,112 @@
+//! Labels attached to containers, which allow clients to store small metadata
+//! with the server and query containers by it.
+use anyhow::{bail, format_err, Result};
+use capnp::struct_list::{Builder, Reader};
+use conmon_common::conmon_capnp::conmon::label::Owned;
+use std::{
+    collections::BTreeMap,
+    sync::{Arc, Mutex, MutexGuard},
+};
+
+/// The maximum accumulated size of the keys and values of a container.
+const MAX_SIZE: usize = 64 * 1024;
+
+#[derive(Clone, Debug, Default)]
+/// The labels of a container, which are shared between all clones of its
+/// child.
+pub struct Labels(Arc<Mutex<BTreeMap<String, String>>>);
+
+impl Labels {
+    /// Retrieve a copy of the labels.
+    pub fn get(&self) -> Result<BTreeMap<String, String>> {
+        Ok(self.lock()?.clone())
+    }
+
+    /// Replace all labels.
+    pub fn set(&self, labels: BTreeMap<String, String>) -> Result<()> {
+        if labels.keys().any(|key| key.is_empty()) {
+            bail!("label keys must not be empty")
+        }
+
+        let size: usize = labels.iter().map(|(k, v)| k.len() + v.len()).sum();
+        if size > MAX_SIZE {
+            bail!(
+                "labels exceed the maximum size of {} bytes: {}",
+                MAX_SIZE,
+                size
+            )
+        }
+
+        *self.lock()? = labels;
+        Ok(())
+    }
+
+    fn lock(&self) -> Result<MutexGuard<BTreeMap<String, String>>> {
+        self.0.lock().map_err(|e| format_err!("lock labels: {}", e))
+    }
+}
+
+/// Whether the labels contain all labels of the selector.
+pub fn matches(labels: &BTreeMap<String, String>, selector: &BTreeMap<String, String>) -> bool {
+    selector
+        .iter()
+        .all(|(key, value)| labels.get(key) == Some(value))
+}
+
+/// Read the labels of a request.
+pub fn read(reader: Reader<Owned>) -> capnp::Result<BTreeMap<String, String>> {
+    reader
+        .iter()
+        .map(|label| Ok((label.get_key()?.to_string(), label.get_value()?.to_string())))
+        .collect()
+}
+
+/// Write the labels into the list of a response.
+pub fn write(labels: &BTreeMap<String, String>, mut builder: Builder<Owned>) {
+    for (i, (key, value)) in labels.iter().enumerate() {
+        let mut label = builder.reborrow().get(i as u32);
+        label.set_key(key);
+        label.set_value(value);
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    fn labels(pairs: &[(&str, &str)]) -> BTreeMap<String, String> {
+        pairs
+            .iter()
+            .map(|(k, v)| (k.to_string(), v.to_string()))
+            .collect()
+    }
+
+    #[test]
+    fn set_replaces_labels() -> Result<()> {
+        let sut = Labels::default();
+        sut.set(labels(&[("owner", "a"), ("job", "1")]))?;
+        sut.set(labels(&[("owner", "b")]))?;
+        assert_eq!(sut.get()?, labels(&[("owner", "b")]));
+
+        // Clones share the labels.
+        assert_eq!(sut.clone().get()?, labels(&[("owner", "b")]));
+        Ok(())
+    }
+
+    #[test]
+    fn set_rejects_invalid_labels() {
+        let sut = Labels::default();
+        assert!(sut.set(labels(&[("", "a")])).is_err());
+        assert!(sut.set(labels(&[("key", &"a".repeat(MAX_SIZE))])).is_err());
+    }
+
+    #[test]
+    fn matches_selector() {
+        let sut = labels(&[("owner", "a"), ("job", "1")]);
+        assert!(matches(&sut, &labels(&[])));
+        assert!(matches(&sut, &labels(&[("owner", "a")])));
+        assert!(matches(&sut, &labels(&[("owner", "a"), ("job", "1")])));
+        assert!(!matches(&sut, &labels(&[("owner", "b")])));
+        assert!(!matches(&sut, &labels(&[("other", "a")])));
+    }
+}
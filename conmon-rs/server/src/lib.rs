@@ -2,17 +2,59 @@ pub use server::Server;
 pub use version::Version;
 
 mod attach;
+mod attach_mux;
+mod checkpoint;
 mod child;
 mod child_reaper;
 mod config;
+mod container_events;
 mod container_io;
 mod container_log;
+mod container_state;
+mod crash_report;
 mod cri_logger;
+mod exec_cache;
+mod fd_mapping;
+mod fd_socket;
+mod freezer;
+mod health;
 mod init;
+mod io_user;
+mod journald_logger;
+mod json_logger;
+mod labels;
 mod listener;
+mod log_buffer;
+mod log_filter;
+mod log_reader;
+mod log_rotation;
+mod log_sources;
+mod log_writer;
+mod mount_watcher;
+mod network_stats;
 mod oom_watcher;
+mod operation_locks;
+mod orphan_reaper;
+mod pidfd;
+mod port_forward;
+mod pty_shim;
+mod quota_watcher;
+mod rejection;
+mod request_scope;
+mod resources;
 mod rpc;
+mod rpc_error;
+mod runtime_options;
+mod scratch_dirs;
+mod seccomp_notify;
 mod server;
+mod state_store;
+mod stats;
 mod streams;
+mod sysctl;
+mod tenant_quota;
 mod terminal;
+mod tombstones;
+mod trace_context;
 mod version;
+mod watchdog;
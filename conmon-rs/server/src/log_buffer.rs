@@ -0,0 +1,81 @@
+//! In-memory buffer of the most recent server log lines.
+use std::{
+    collections::VecDeque,
+    io,
+    sync::{Arc, Mutex},
+};
+use tracing_subscriber::fmt::MakeWriter;
+
+/// The maximum number of buffered log lines.
+const CAPACITY: usize = 1000;
+
+#[derive(Clone, Debug, Default)]
+/// A ring buffer of log lines, which can be used as writer for the tracing
+/// fmt layer.
+pub struct LogBuffer {
+    lines: Arc<Mutex<VecDeque<String>>>,
+}
+
+impl LogBuffer {
+    /// Retrieve the buffered log lines, starting with the oldest one.
+    pub fn lines(&self) -> Vec<String> {
+        match self.lines.lock() {
+            Ok(lines) => lines.iter().cloned().collect(),
+            Err(_) => vec![],
+        }
+    }
+
+    fn push(&self, line: String) {
+        if let Ok(mut lines) = self.lines.lock() {
+            if lines.len() == CAPACITY {
+                lines.pop_front();
+            }
+            lines.push_back(line);
+        }
+    }
+}
+
+impl<'a> MakeWriter<'a> for LogBuffer {
+    type Writer = LogBufferWriter;
+
+    fn make_writer(&'a self) -> Self::Writer {
+        LogBufferWriter(self.clone())
+    }
+}
+
+/// The writer of a single formatted log event.
+pub struct LogBufferWriter(LogBuffer);
+
+impl io::Write for LogBufferWriter {
+    fn write(&mut self, buf: &[u8]) -> io::Result<usize> {
+        let line = String::from_utf8_lossy(buf);
+        self.0.push(line.trim_end().into());
+        Ok(buf.len())
+    }
+
+    fn flush(&mut self) -> io::Result<()> {
+        Ok(())
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::io::Write;
+
+    #[test]
+    fn keep_most_recent_lines() -> io::Result<()> {
+        let buffer = LogBuffer::default();
+        for i in 0..CAPACITY + 2 {
+            buffer
+                .make_writer()
+                .write_all(format!("line {}\n", i).as_bytes())?;
+        }
+
+        let lines = buffer.lines();
+        assert_eq!(lines.len(), CAPACITY);
+        assert_eq!(lines[0], "line 2");
+        assert_eq!(lines[CAPACITY - 1], format!("line {}", CAPACITY + 1));
+        Ok(())
+    }
+}
@@ -0,0 +1,346 @@
+//! External filter binaries post-processing the container output before it
+//! gets logged.
+use crate::{
+    container_io::Pipe, container_log::ContainerLog, io_user::IoUser, orphan_reaper::SpawnedGuard,
+};
+use anyhow::{format_err, Context, Result};
+use getset::{CopyGetters, Getters, Setters};
+use std::{
+    path::{Path, PathBuf},
+    process::Stdio,
+    sync::{Arc, Mutex, MutexGuard, Weak},
+    time::Duration,
+};
+use tokio::{
+    io::{AsyncReadExt, AsyncWriteExt},
+    process::{ChildStdin, ChildStdout, Command},
+    sync::{
+        mpsc::{self, UnboundedReceiver, UnboundedSender},
+        RwLock,
+    },
+    task, time,
+};
+use tracing::{debug, debug_span, error, warn, Instrument};
+
+/// The duration to wait before restarting an exited filter.
+const RESTART_DELAY: Duration = Duration::from_secs(1);
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// The policy applied if a filter exits.
+pub enum RestartPolicy {
+    /// Drop the output after the filter exited.
+    Never,
+
+    /// Restart the filter if it exited with a non-zero code.
+    OnFailure,
+
+    /// Restart the filter whenever it exited.
+    Always,
+}
+
+#[derive(Clone, Debug, CopyGetters, Getters, Setters)]
+/// The configuration of a log filter.
+pub struct LogFilterConfig {
+    #[getset(get = "pub")]
+    /// Path to the filter binary.
+    path: PathBuf,
+
+    #[getset(get = "pub")]
+    /// Arguments passed to the filter binary.
+    args: Vec<String>,
+
+    #[getset(get_copy = "pub")]
+    /// Policy applied if the filter exits.
+    restart_policy: RestartPolicy,
+
+    #[getset(get_copy = "pub")]
+    /// Maximum number of restarts, unlimited if zero.
+    max_restarts: u32,
+
+    #[getset(get_copy = "pub", set = "pub")]
+    /// User the filter runs as, the one of the server if `None`.
+    user: Option<IoUser>,
+}
+
+impl LogFilterConfig {
+    /// Create a new log filter configuration.
+    pub fn new<T: AsRef<Path>>(
+        path: T,
+        args: Vec<String>,
+        restart_policy: RestartPolicy,
+        max_restarts: u32,
+    ) -> Self {
+        Self {
+            path: path.as_ref().into(),
+            args,
+            restart_policy,
+            max_restarts,
+            user: None,
+        }
+    }
+
+    /// Whether the filter should be restarted after it exited with the
+    /// provided code and has been restarted `restarts` times.
+    fn should_restart(&self, code: Option<i32>, restarts: u32) -> bool {
+        if self.max_restarts > 0 && restarts >= self.max_restarts {
+            return false;
+        }
+        match self.restart_policy {
+            RestartPolicy::Never => false,
+            RestartPolicy::OnFailure => code != Some(0),
+            RestartPolicy::Always => true,
+        }
+    }
+}
+
+#[derive(Clone, Copy, Debug, Default, CopyGetters)]
+#[getset(get_copy = "pub")]
+/// The health of a log filter.
+pub struct LogFilterHealth {
+    /// Whether the filter processes of all pipes are running.
+    running: bool,
+
+    /// Number of filter restarts.
+    restarts: u32,
+
+    /// Exit code of the most recently exited filter process.
+    last_exit_code: Option<i32>,
+
+    /// Bytes of output dropped because the filter was not running.
+    dropped_bytes: u64,
+}
+
+#[derive(Debug)]
+/// A log filter, running a filter process per pipe. The filtered output gets
+/// written to the log drivers of the container log.
+pub struct LogFilter {
+    stdout: Option<UnboundedSender<Vec<u8>>>,
+    stderr: Option<UnboundedSender<Vec<u8>>>,
+    health: Arc<Mutex<Health>>,
+}
+
+#[derive(Debug, Default)]
+struct Health {
+    running: [bool; 2],
+    restarts: u32,
+    last_exit_code: Option<i32>,
+    dropped_bytes: u64,
+}
+
+impl LogFilter {
+    /// Start the filter processes, which write their output into the log.
+    pub fn start(config: LogFilterConfig, log: Weak<RwLock<ContainerLog>>) -> Self {
+        let health = Arc::new(Mutex::new(Health::default()));
+        let start_pipe = |pipe| {
+            let (tx, rx) = mpsc::unbounded_channel();
+            let supervisor = Supervisor {
+                config: config.clone(),
+                pipe,
+                log: log.clone(),
+                health: health.clone(),
+            };
+            task::spawn(
+                supervisor
+                    .run(rx)
+                    .instrument(debug_span!("log_filter", pipe = ?pipe)),
+            );
+            Some(tx)
+        };
+        Self {
+            stdout: start_pipe(Pipe::StdOut),
+            stderr: start_pipe(Pipe::StdErr),
+            health,
+        }
+    }
+
+    /// Pass the bytes to the filter of the pipe.
+    pub fn send(&self, pipe: Pipe, bytes: &[u8]) -> Result<()> {
+        let sent = self
+            .sender(pipe)
+            .map(|tx| tx.send(bytes.to_vec()).is_ok())
+            .unwrap_or_default();
+        if !sent {
+            lock(&self.health)?.dropped_bytes += bytes.len() as u64;
+        }
+        Ok(())
+    }
+
+    /// Close the filter of the pipe, which exits after processing the
+    /// remaining output.
+    pub fn close(&mut self, pipe: Pipe) {
+        match pipe {
+            Pipe::StdOut => self.stdout = None,
+            Pipe::StdErr => self.stderr = None,
+        }
+    }
+
+    /// Retrieve the current health of the filter.
+    pub fn health(&self) -> Result<LogFilterHealth> {
+        let health = lock(&self.health)?;
+        Ok(LogFilterHealth {
+            running: health.running.iter().all(|x| *x),
+            restarts: health.restarts,
+            last_exit_code: health.last_exit_code,
+            dropped_bytes: health.dropped_bytes,
+        })
+    }
+
+    fn sender(&self, pipe: Pipe) -> Option<&UnboundedSender<Vec<u8>>> {
+        match pipe {
+            Pipe::StdOut => self.stdout.as_ref(),
+            Pipe::StdErr => self.stderr.as_ref(),
+        }
+    }
+}
+
+fn lock(health: &Mutex<Health>) -> Result<MutexGuard<Health>> {
+    health
+        .lock()
+        .map_err(|e| format_err!("lock log filter health: {}", e))
+}
+
+/// Runs and restarts the filter process of a single pipe.
+struct Supervisor {
+    config: LogFilterConfig,
+    pipe: Pipe,
+    log: Weak<RwLock<ContainerLog>>,
+    health: Arc<Mutex<Health>>,
+}
+
+impl Supervisor {
+    /// Run the filter until the input gets closed or it should not be
+    /// restarted any more. Input received afterwards gets dropped.
+    async fn run(self, mut rx: UnboundedReceiver<Vec<u8>>) {
+        let mut restarts = 0;
+        loop {
+            let (code, closed) = match self.run_once(&mut rx).await {
+                Ok(x) => x,
+                Err(e) => {
+                    error!("Unable to run log filter: {:#}", e);
+                    (None, false)
+                }
+            };
+            self.update(|h| {
+                h.running[self.index()] = false;
+                h.last_exit_code = code;
+            });
+            if closed {
+                debug!("Log filter input closed");
+                return;
+            }
+
+            if !self.config.should_restart(code, restarts) {
+                warn!("Log filter exited with {:?}, dropping output", code);
+                break;
+            }
+            restarts += 1;
+            self.update(|h| h.restarts += 1);
+            debug!("Restarting log filter exited with {:?}", code);
+            time::sleep(RESTART_DELAY).await;
+        }
+
+        while let Some(bytes) = rx.recv().await {
+            self.update(|h| h.dropped_bytes += bytes.len() as u64);
+        }
+    }
+
+    /// Run the filter process once, returning its exit code and whether the
+    /// input got closed.
+    async fn run_once(&self, rx: &mut UnboundedReceiver<Vec<u8>>) -> Result<(Option<i32>, bool)> {
+        let mut cmd = Command::new(self.config.path());
+        if let Some(user) = self.config.user() {
+            user.apply(&mut cmd);
+        }
+        let mut child = cmd
+            .args(self.config.args())
+            .stdin(Stdio::piped())
+            .stdout(Stdio::piped())
+            .stderr(Stdio::null())
+            .kill_on_drop(true)
+            .spawn()
+            .context(format!("spawn {}", self.config.path().display()))?;
+        let _spawned = SpawnedGuard::new(&child);
+        self.update(|h| h.running[self.index()] = true);
+
+        let stdin = child.stdin.take().context("no filter stdin")?;
+        let stdout = child.stdout.take().context("no filter stdout")?;
+
+        let output = self.forward_output(stdout);
+        tokio::pin!(output);
+        let closed = tokio::select! {
+            res = &mut output => {
+                res?;
+                false
+            }
+            closed = forward_input(stdin, rx) => {
+                output.await?;
+                closed
+            }
+        };
+
+        let status = child.wait().await.context("wait for filter")?;
+        Ok((status.code(), closed))
+    }
+
+    /// Write the output of the filter into the log drivers until the filter
+    /// closes it.
+    async fn forward_output(&self, mut stdout: ChildStdout) -> Result<()> {
+        let mut buf = vec![0; 1024];
+        loop {
+            let n = stdout.read(&mut buf).await.context("read filter output")?;
+            if n == 0 {
+                return Ok(());
+            }
+            if let Some(log) = self.log.upgrade() {
+                log.write()
+                    .await
+                    .write_filtered(self.pipe, &buf[..n])
+                    .await
+                    .context("write filtered output")?;
+            }
+        }
+    }
+
+    fn update<F: FnOnce(&mut Health)>(&self, f: F) {
+        if let Ok(mut health) = lock(&self.health) {
+            f(&mut health)
+        }
+    }
+
+    fn index(&self) -> usize {
+        match self.pipe {
+            Pipe::StdOut => 0,
+            Pipe::StdErr => 1,
+        }
+    }
+}
+
+/// Write the input into the filter until either the input gets closed or
+/// the filter does not accept it any more. Returns true if the input got
+/// closed.
+async fn forward_input(mut stdin: ChildStdin, rx: &mut UnboundedReceiver<Vec<u8>>) -> bool {
+    while let Some(bytes) = rx.recv().await {
+        if let Err(e) = stdin.write_all(&bytes).await {
+            debug!("Unable to write to log filter: {}", e);
+            return false;
+        }
+    }
+    true
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn restart_according_to_policy() {
+        let config = |policy, max| LogFilterConfig::new("", vec![], policy, max);
+
+        assert!(!config(RestartPolicy::Never, 0).should_restart(Some(1), 0));
+        assert!(config(RestartPolicy::OnFailure, 0).should_restart(Some(1), 10));
+        assert!(config(RestartPolicy::OnFailure, 0).should_restart(None, 0));
+        assert!(!config(RestartPolicy::OnFailure, 0).should_restart(Some(0), 0));
+        assert!(config(RestartPolicy::Always, 2).should_restart(Some(0), 1));
+        assert!(!config(RestartPolicy::Always, 2).should_restart(Some(0), 2));
+    }
+}
@@ -0,0 +1,288 @@
+//! Cursor based reading of CRI container log files.
+use anyhow::{Context, Result};
+use chrono::DateTime;
+use getset::{CopyGetters, Getters};
+use memchr::{memchr, memrchr};
+use std::{fs::File, os::unix::fs::FileExt, path::Path};
+
+/// The size of the chunks read while searching for line boundaries.
+const CHUNK_SIZE: usize = 64 * 1024;
+
+#[derive(Debug, CopyGetters, Getters)]
+/// A single entry of a CRI log file.
+pub struct LogEntry {
+    #[getset(get_copy = "pub")]
+    /// Offset of the entry in the file.
+    offset: u64,
+
+    #[getset(get_copy = "pub")]
+    /// Timestamp in nanoseconds since the unix epoch, zero if unparsable.
+    timestamp: i64,
+
+    #[getset(get = "pub")]
+    /// Stream of the entry, for example "stdout".
+    stream: String,
+
+    #[getset(get_copy = "pub")]
+    /// Whether the entry is a partial line.
+    partial: bool,
+
+    #[getset(get = "pub")]
+    /// The logged message without the trailing newline.
+    message: Vec<u8>,
+}
+
+impl LogEntry {
+    /// Parse a line of the CRI log format, which is
+    /// `<timestamp> <stream> <P|F> <message>`. Unparsable lines are returned
+    /// as message only.
+    fn parse(offset: u64, line: &[u8]) -> Self {
+        let mut fields = line.splitn(4, |x| *x == b' ');
+        let (timestamp, stream, tag, message) =
+            match (fields.next(), fields.next(), fields.next(), fields.next()) {
+                (Some(t), Some(s), Some(p), Some(m)) => (t, s, p, m),
+                _ => (&[][..], &[][..], &[][..], line),
+            };
+        Self {
+            offset,
+            timestamp: parse_timestamp(timestamp).unwrap_or_default(),
+            stream: String::from_utf8_lossy(stream).into(),
+            partial: tag == b"P",
+            message: message.to_vec(),
+        }
+    }
+}
+
+fn parse_timestamp(timestamp: &[u8]) -> Option<i64> {
+    DateTime::parse_from_rfc3339(std::str::from_utf8(timestamp).ok()?)
+        .ok()
+        .map(|x| x.timestamp_nanos())
+}
+
+#[derive(Debug)]
+/// A reader of CRI log files, which only considers complete lines.
+pub struct LogReader {
+    file: File,
+    size: u64,
+}
+
+impl LogReader {
+    /// Open the log file of the provided path.
+    pub fn open(path: &Path) -> Result<Self> {
+        let file = File::open(path).context(format!("open log file {}", path.display()))?;
+        let size = file.metadata().context("get log file metadata")?.len();
+        Ok(Self { file, size })
+    }
+
+    /// The size of the log file when it got opened.
+    pub fn size(&self) -> u64 {
+        self.size
+    }
+
+    /// Read up to `count` entries starting at the first line beginning at or
+    /// after the offset. Returns the entries and the offset after the last
+    /// one.
+    pub fn read_forward(&self, offset: u64, count: usize) -> Result<(Vec<LogEntry>, u64)> {
+        let mut offset = self.align(offset)?;
+        let mut entries = vec![];
+        while entries.len() < count {
+            match self.line_at(offset)? {
+                Some((line, next)) => {
+                    entries.push(LogEntry::parse(offset, &line));
+                    offset = next;
+                }
+                None => break,
+            }
+        }
+        Ok((entries, offset))
+    }
+
+    /// Read up to `count` entries before the first line beginning at or after
+    /// the offset. Returns the entries in file order and the offset of the
+    /// first one.
+    pub fn read_backward(&self, offset: u64, count: usize) -> Result<(Vec<LogEntry>, u64)> {
+        let mut offset = self.align(offset)?;
+        let mut entries = vec![];
+        while entries.len() < count && offset > 0 {
+            let start = self.line_start_before(offset)?;
+            // Skip a trailing incomplete line.
+            if let Some((line, _)) = self.line_at(start)? {
+                entries.push(LogEntry::parse(start, &line));
+            }
+            offset = start;
+        }
+        entries.reverse();
+        Ok((entries, offset))
+    }
+
+    /// Find the offset of the first entry with a timestamp not before the
+    /// provided one, assuming that the entries are ordered by time.
+    pub fn seek_time(&self, timestamp: i64) -> Result<u64> {
+        let (mut low, mut high) = (0, self.size);
+        while low < high {
+            let mid = low + (high - low) / 2;
+            let start = self.align(mid)?;
+            let after = match self.line_at(start)? {
+                Some((line, _)) => LogEntry::parse(start, &line).timestamp() >= timestamp,
+                None => true,
+            };
+            if after {
+                high = mid;
+            } else {
+                low = mid + 1;
+            }
+        }
+        self.align(low)
+    }
+
+    /// Align the offset to the next line start, or the end of the file.
+    fn align(&self, offset: u64) -> Result<u64> {
+        if offset == 0 || offset >= self.size {
+            return Ok(offset.min(self.size));
+        }
+        let mut byte = [0; 1];
+        self.file
+            .read_exact_at(&mut byte, offset - 1)
+            .context("read log file")?;
+        if byte[0] == b'\n' {
+            return Ok(offset);
+        }
+        match self.line_at(offset)? {
+            Some((_, next)) => Ok(next),
+            None => Ok(self.size),
+        }
+    }
+
+    /// Read the complete line at the offset, returning it without the
+    /// trailing newline and the offset of the next line.
+    fn line_at(&self, offset: u64) -> Result<Option<(Vec<u8>, u64)>> {
+        let mut line = vec![];
+        let mut buf = vec![0; CHUNK_SIZE];
+        let mut pos = offset;
+        while pos < self.size {
+            let len = CHUNK_SIZE.min((self.size - pos) as usize);
+            self.file
+                .read_exact_at(&mut buf[..len], pos)
+                .context("read log file")?;
+            if let Some(i) = memchr(b'\n', &buf[..len]) {
+                line.extend_from_slice(&buf[..i]);
+                return Ok(Some((line, pos + i as u64 + 1)));
+            }
+            line.extend_from_slice(&buf[..len]);
+            pos += len as u64;
+        }
+        Ok(None)
+    }
+
+    /// Find the start of the line ending right before the provided line
+    /// start.
+    fn line_start_before(&self, offset: u64) -> Result<u64> {
+        // Skip the newline terminating the previous line.
+        let mut end = offset.saturating_sub(1);
+        let mut buf = vec![0; CHUNK_SIZE];
+        while end > 0 {
+            let len = CHUNK_SIZE.min(end as usize);
+            let pos = end - len as u64;
+            self.file
+                .read_exact_at(&mut buf[..len], pos)
+                .context("read log file")?;
+            if let Some(i) = memrchr(b'\n', &buf[..len]) {
+                return Ok(pos + i as u64 + 1);
+            }
+            end = pos;
+        }
+        Ok(0)
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::fs;
+    use tempfile::NamedTempFile;
+
+    const LOG: &str = "2022-01-01T00:00:01.000000000+00:00 stdout F first\n\
+                       2022-01-01T00:00:02.000000000+00:00 stderr P second\n\
+                       2022-01-01T00:00:03.000000000+00:00 stdout F third\n\
+                       2022-01-01T00:00:04.000000000+00:00 stdout F incomplete";
+
+    fn messages(entries: &[LogEntry]) -> Vec<String> {
+        entries
+            .iter()
+            .map(|x| String::from_utf8_lossy(x.message()).into())
+            .collect()
+    }
+
+    fn new_reader() -> Result<(NamedTempFile, LogReader)> {
+        let file = NamedTempFile::new()?;
+        fs::write(file.path(), LOG)?;
+        let sut = LogReader::open(file.path())?;
+        Ok((file, sut))
+    }
+
+    #[test]
+    fn parse_entry() {
+        let entry = LogEntry::parse(10, b"2022-01-01T00:00:01+00:00 stderr P a b");
+        assert_eq!(entry.offset(), 10);
+        assert_eq!(entry.timestamp(), 1_640_995_201_000_000_000);
+        assert_eq!(entry.stream(), "stderr");
+        assert!(entry.partial());
+        assert_eq!(entry.message(), b"a b");
+
+        let entry = LogEntry::parse(0, b"invalid");
+        assert_eq!(entry.timestamp(), 0);
+        assert_eq!(entry.message(), b"invalid");
+    }
+
+    #[test]
+    fn read_forward_and_backward() -> Result<()> {
+        let (_file, sut) = new_reader()?;
+
+        let (entries, offset) = sut.read_forward(0, 2)?;
+        assert_eq!(messages(&entries), ["first", "second"]);
+        assert_eq!(entries[1].stream(), "stderr");
+
+        let (entries, end) = sut.read_forward(offset, 10)?;
+        assert_eq!(messages(&entries), ["third"]);
+
+        let (entries, offset) = sut.read_backward(end, 2)?;
+        assert_eq!(messages(&entries), ["second", "third"]);
+
+        let (entries, offset) = sut.read_backward(offset, 10)?;
+        assert_eq!(messages(&entries), ["first"]);
+        assert_eq!(offset, 0);
+
+        let (entries, _) = sut.read_backward(sut.size(), 1)?;
+        assert_eq!(messages(&entries), ["third"]);
+        Ok(())
+    }
+
+    #[test]
+    fn align_offset_to_line_start() -> Result<()> {
+        let (_file, sut) = new_reader()?;
+
+        let (entries, _) = sut.read_forward(1, 1)?;
+        assert_eq!(messages(&entries), ["second"]);
+
+        let (entries, _) = sut.read_backward(1, 10)?;
+        assert_eq!(messages(&entries), ["first"]);
+        Ok(())
+    }
+
+    #[test]
+    fn seek_time() -> Result<()> {
+        let (_file, sut) = new_reader()?;
+        let second = 1_640_995_202_000_000_000;
+
+        let (entries, _) = sut.read_forward(sut.seek_time(second)?, 1)?;
+        assert_eq!(messages(&entries), ["second"]);
+
+        let (entries, _) = sut.read_forward(sut.seek_time(second + 1)?, 1)?;
+        assert_eq!(messages(&entries), ["third"]);
+
+        assert_eq!(sut.seek_time(0)?, 0);
+        let (entries, _) = sut.read_forward(sut.seek_time(i64::MAX)?, 1)?;
+        assert!(entries.is_empty());
+        Ok(())
+    }
+}
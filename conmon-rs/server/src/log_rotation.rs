@@ -0,0 +1,156 @@
+//! Rotation of container log files.
+use anyhow::{Context, Result};
+use flate2::{write::GzEncoder, Compression};
+use std::{
+    ffi::OsString,
+    fs::{self, File},
+    io,
+    path::{Path, PathBuf},
+};
+use tokio::task;
+use tracing::debug;
+
+#[derive(Clone, Copy, Debug, Default, Eq, PartialEq)]
+/// The rotation policy of a log file.
+pub struct LogRotation {
+    /// Number of rotated files to keep, the log file gets truncated instead
+    /// of rotated if zero.
+    max_files: u32,
+
+    /// Compress rotated files using gzip.
+    compress: bool,
+}
+
+impl LogRotation {
+    /// Create a new log rotation policy.
+    pub fn new(max_files: u32, compress: bool) -> Self {
+        Self {
+            max_files,
+            compress,
+        }
+    }
+
+    /// Rotate the log file, the most recent rotated file gets the suffix
+    /// `.1`. This is a no-op if no rotated files should be kept, because
+    /// the log file gets truncated when being reopened.
+    pub async fn rotate(&self, path: &Path) -> Result<()> {
+        if self.max_files == 0 {
+            return Ok(());
+        }
+        let (path, rotation) = (path.to_path_buf(), *self);
+        task::spawn_blocking(move || rotation.rotate_blocking(&path)).await?
+    }
+
+    fn rotate_blocking(&self, path: &Path) -> Result<()> {
+        debug!("Rotating log file {}", path.display());
+        remove_if_exists(&self.rotated(path, self.max_files))?;
+        for i in (1..self.max_files).rev() {
+            rename_if_exists(&self.rotated(path, i), &self.rotated(path, i + 1))?;
+        }
+
+        let first = with_suffix(path, "1");
+        fs::rename(path, &first).context("rename log file")?;
+        if self.compress {
+            let mut encoder = GzEncoder::new(
+                File::create(self.rotated(path, 1)).context("create compressed file")?,
+                Compression::default(),
+            );
+            io::copy(
+                &mut File::open(&first).context("open rotated file")?,
+                &mut encoder,
+            )
+            .context("compress rotated file")?;
+            encoder.finish().context("finish compressed file")?;
+            fs::remove_file(&first).context("remove uncompressed file")?;
+        }
+        Ok(())
+    }
+
+    /// The path of the rotated file `i`.
+    fn rotated(&self, path: &Path, i: u32) -> PathBuf {
+        if self.compress {
+            with_suffix(path, &format!("{}.gz", i))
+        } else {
+            with_suffix(path, &i.to_string())
+        }
+    }
+}
+
+fn with_suffix(path: &Path, suffix: &str) -> PathBuf {
+    let mut name = OsString::from(path.as_os_str());
+    name.push(".");
+    name.push(suffix);
+    name.into()
+}
+
+fn remove_if_exists(path: &Path) -> Result<()> {
+    match fs::remove_file(path) {
+        Err(e) if e.kind() != io::ErrorKind::NotFound => {
+            Err(e).context(format!("remove {}", path.display()))
+        }
+        _ => Ok(()),
+    }
+}
+
+fn rename_if_exists(from: &Path, to: &Path) -> Result<()> {
+    match fs::rename(from, to) {
+        Err(e) if e.kind() != io::ErrorKind::NotFound => {
+            Err(e).context(format!("rename {}", from.display()))
+        }
+        _ => Ok(()),
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use flate2::read::GzDecoder;
+    use std::io::Read;
+    use tempfile::tempdir;
+
+    #[tokio::test]
+    async fn rotate_and_keep_max_files() -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("log");
+        let sut = LogRotation::new(2, false);
+
+        for content in ["a", "b", "c"] {
+            fs::write(&path, content)?;
+            sut.rotate(&path).await?;
+        }
+
+        assert!(!path.exists());
+        assert_eq!(fs::read_to_string(dir.path().join("log.1"))?, "c");
+        assert_eq!(fs::read_to_string(dir.path().join("log.2"))?, "b");
+        assert!(!dir.path().join("log.3").exists());
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn rotate_compressed() -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("log");
+        fs::write(&path, "content")?;
+
+        LogRotation::new(1, true).rotate(&path).await?;
+
+        assert!(!dir.path().join("log.1").exists());
+        let mut content = String::new();
+        GzDecoder::new(File::open(dir.path().join("log.1.gz"))?).read_to_string(&mut content)?;
+        assert_eq!(content, "content");
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn truncate_without_max_files() -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("log");
+        fs::write(&path, "content")?;
+
+        LogRotation::default().rotate(&path).await?;
+
+        assert!(path.exists());
+        assert!(!dir.path().join("log.1").exists());
+        Ok(())
+    }
+}
@@ -0,0 +1,134 @@
+//! Tracking of the output sources of a container log, to be able to wait
+//! until all produced output has been logged.
+use anyhow::{format_err, Result};
+use libc::{c_int, FIONREAD};
+use nix::errno::Errno;
+use std::{
+    os::unix::io::RawFd,
+    sync::{
+        atomic::{AtomicUsize, Ordering},
+        Arc, Mutex,
+    },
+    time::Duration,
+};
+use tokio::time;
+
+/// The interval in which pending output gets checked.
+const POLL_INTERVAL: Duration = Duration::from_millis(10);
+
+#[derive(Clone, Debug, Default)]
+/// The file descriptors the output of a container log gets read from.
+pub struct LogSources {
+    fds: Arc<Mutex<Vec<RawFd>>>,
+
+    /// Number of reads which have not been written to the log yet.
+    in_flight: Arc<AtomicUsize>,
+}
+
+/// Unregisters a source when dropped.
+pub struct Source {
+    fd: RawFd,
+    fds: Arc<Mutex<Vec<RawFd>>>,
+}
+
+impl Drop for Source {
+    fn drop(&mut self) {
+        if let Ok(mut fds) = self.fds.lock() {
+            fds.retain(|x| *x != self.fd);
+        }
+    }
+}
+
+/// Marks output as being in flight until dropped.
+pub struct InFlight(Arc<AtomicUsize>);
+
+impl Drop for InFlight {
+    fn drop(&mut self) {
+        self.0.fetch_sub(1, Ordering::SeqCst);
+    }
+}
+
+impl LogSources {
+    /// Register a file descriptor the output gets read from, until the
+    /// returned guard gets dropped.
+    pub fn add(&self, fd: RawFd) -> Source {
+        if let Ok(mut fds) = self.fds.lock() {
+            fds.push(fd);
+        }
+        Source {
+            fd,
+            fds: self.fds.clone(),
+        }
+    }
+
+    /// Mark read output as in flight until the returned guard gets dropped.
+    pub fn in_flight(&self) -> InFlight {
+        self.in_flight.fetch_add(1, Ordering::SeqCst);
+        InFlight(self.in_flight.clone())
+    }
+
+    /// Wait until no output is pending in any source and all read output
+    /// has been written.
+    pub async fn drained(&self) -> Result<()> {
+        while !self.is_drained()? {
+            time::sleep(POLL_INTERVAL).await;
+        }
+        Ok(())
+    }
+
+    fn is_drained(&self) -> Result<bool> {
+        let fds = self
+            .fds
+            .lock()
+            .map_err(|e| format_err!("lock log sources: {}", e))?
+            .clone();
+        for fd in fds {
+            if pending_bytes(fd)? > 0 {
+                return Ok(false);
+            }
+        }
+        Ok(self.in_flight.load(Ordering::SeqCst) == 0)
+    }
+}
+
+/// Retrieve the number of bytes which can be read from the file descriptor.
+fn pending_bytes(fd: RawFd) -> Result<c_int> {
+    let mut pending: c_int = 0;
+    match unsafe { libc::ioctl(fd, FIONREAD, &mut pending) } {
+        0 => Ok(pending),
+        // The source got closed in the meantime.
+        _ if Errno::last() == Errno::EBADF => Ok(0),
+        _ => Err(format_err!("get pending bytes: {}", Errno::last())),
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use nix::unistd::{close, pipe, write};
+
+    #[tokio::test]
+    async fn wait_for_pending_output() -> Result<()> {
+        let (read_fd, write_fd) = pipe()?;
+        let sut = LogSources::default();
+        let source = sut.add(read_fd);
+        assert!(sut.is_drained()?);
+
+        write(write_fd, b"data")?;
+        assert!(!sut.is_drained()?);
+
+        let mut buf = [0; 4];
+        let guard = sut.in_flight();
+        nix::unistd::read(read_fd, &mut buf)?;
+        assert!(!sut.is_drained()?);
+
+        drop(guard);
+        time::timeout(Duration::from_secs(1), sut.drained()).await??;
+
+        drop(source);
+        assert!(sut.fds.lock().unwrap().is_empty());
+        close(read_fd)?;
+        close(write_fd)?;
+        Ok(())
+    }
+}
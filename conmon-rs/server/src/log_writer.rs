@@ -0,0 +1,324 @@
+//! Configurable writing of log files, trading durability for throughput.
+use anyhow::{Context, Result};
+use getset::CopyGetters;
+use nix::errno::Errno;
+use std::{
+    fs::{File, OpenOptions},
+    mem,
+    os::unix::fs::{FileExt, OpenOptionsExt},
+    path::Path,
+    sync::Arc,
+    time::{Duration, Instant},
+};
+use tokio::task;
+use tracing::warn;
+
+/// The default size of the write buffer.
+const DEFAULT_BUFFER_SIZE: usize = 8 * 1024;
+
+/// The alignment of the buffer, file offsets and write sizes required for
+/// direct I/O.
+const ALIGNMENT: usize = 4096;
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// The policy of synchronizing written log data to disk.
+pub enum FsyncPolicy {
+    /// Leave it to the operating system.
+    Never,
+
+    /// Synchronize on write if the last synchronization is older than the
+    /// interval.
+    Interval(Duration),
+
+    /// Synchronize after every write, which makes every logged line durable.
+    EveryLine,
+}
+
+#[derive(Clone, Copy, Debug, CopyGetters)]
+#[getset(get_copy = "pub")]
+/// The configuration of a log writer.
+pub struct LogWriteConfig {
+    /// Size of the write buffer.
+    buffer_size: usize,
+
+    /// Policy of synchronizing the log to disk.
+    fsync_policy: FsyncPolicy,
+
+    /// Whether the log gets written using direct I/O, bypassing the page
+    /// cache.
+    direct_io: bool,
+}
+
+impl Default for LogWriteConfig {
+    fn default() -> Self {
+        Self {
+            buffer_size: DEFAULT_BUFFER_SIZE,
+            fsync_policy: FsyncPolicy::Never,
+            direct_io: false,
+        }
+    }
+}
+
+impl LogWriteConfig {
+    /// Create a new log writer configuration, the default buffer size is used
+    /// if it is zero.
+    pub fn new(buffer_size: usize, fsync_policy: FsyncPolicy, direct_io: bool) -> Self {
+        Self {
+            buffer_size: match buffer_size {
+                0 => DEFAULT_BUFFER_SIZE,
+                x => x,
+            },
+            fsync_policy,
+            direct_io,
+        }
+    }
+}
+
+#[derive(Debug)]
+/// A buffered writer of a log file, which gets truncated when opened.
+pub struct LogWriter {
+    file: Arc<File>,
+    direct: Option<Arc<File>>,
+    buf: Vec<u8>,
+
+    /// Start of the data in `buf`, which is aligned for direct I/O.
+    start: usize,
+
+    /// Length of the data in `buf`.
+    len: usize,
+
+    /// Capacity of the data in `buf`.
+    capacity: usize,
+
+    /// File offset of the data in `buf`.
+    offset: u64,
+
+    fsync_policy: FsyncPolicy,
+    last_sync: Instant,
+}
+
+impl LogWriter {
+    /// Open the log file of the path using the configuration.
+    pub async fn open(path: &Path, config: LogWriteConfig) -> Result<Self> {
+        let file = Arc::new(
+            Self::open_options(0)
+                .open(path)
+                .context(format!("open log file path '{}'", path.display()))?,
+        );
+
+        let direct = if config.direct_io() {
+            match Self::open_options(libc::O_DIRECT)
+                .truncate(false)
+                .open(path)
+            {
+                Ok(direct) => Some(Arc::new(direct)),
+                Err(e) if e.raw_os_error() == Some(Errno::EINVAL as i32) => {
+                    warn!(
+                        "Direct I/O is not supported for {}, using buffered I/O",
+                        path.display()
+                    );
+                    None
+                }
+                Err(e) => {
+                    return Err(e)
+                        .context(format!("open log file path '{}' directly", path.display()))
+                }
+            }
+        } else {
+            None
+        };
+
+        // Direct I/O requires writing full aligned blocks.
+        let capacity = if direct.is_some() {
+            ((config.buffer_size() + ALIGNMENT - 1) / ALIGNMENT).max(1) * ALIGNMENT
+        } else {
+            config.buffer_size()
+        };
+        let buf = vec![0; capacity + ALIGNMENT];
+        let start = buf.as_ptr().align_offset(ALIGNMENT);
+
+        Ok(Self {
+            file,
+            direct,
+            buf,
+            start,
+            len: 0,
+            capacity,
+            offset: 0,
+            fsync_policy: config.fsync_policy(),
+            last_sync: Instant::now(),
+        })
+    }
+
+    fn open_options(flags: i32) -> OpenOptions {
+        let mut options = OpenOptions::new();
+        options
+            .create(true)
+            .read(true)
+            .truncate(true)
+            .write(true)
+            .mode(0o600)
+            .custom_flags(flags);
+        options
+    }
+
+    /// Write the data into the buffer, which gets written to the file once
+    /// it is full.
+    pub async fn write_all(&mut self, mut data: &[u8]) -> Result<()> {
+        while !data.is_empty() {
+            let n = data.len().min(self.capacity - self.len);
+            let pos = self.start + self.len;
+            self.buf[pos..pos + n].copy_from_slice(&data[..n]);
+            self.len += n;
+            data = &data[n..];
+            if self.len == self.capacity {
+                self.write_buffer().await?;
+            }
+        }
+        Ok(())
+    }
+
+    /// Write all buffered data to the file and synchronize it according to
+    /// the fsync policy.
+    pub async fn commit(&mut self) -> Result<()> {
+        self.flush().await?;
+        let sync = match self.fsync_policy {
+            FsyncPolicy::Never => false,
+            FsyncPolicy::Interval(interval) => self.last_sync.elapsed() >= interval,
+            FsyncPolicy::EveryLine => true,
+        };
+        if sync {
+            self.sync_data().await?;
+        }
+        Ok(())
+    }
+
+    /// Write all buffered data to the file.
+    pub async fn flush(&mut self) -> Result<()> {
+        self.write_buffer().await?;
+        if self.len == 0 || self.direct.is_none() {
+            return Ok(());
+        }
+
+        // The incomplete block gets written without direct I/O and kept
+        // buffered to be rewritten once it is complete.
+        let file = self.file.clone();
+        let (start, len, offset) = (self.start, self.len, self.offset);
+        self.blocking(move |buf| file.write_all_at(&buf[start..start + len], offset))
+            .await
+            .context("write log file")
+    }
+
+    /// Write all buffered data to the file and synchronize it to disk.
+    pub async fn sync(&mut self) -> Result<()> {
+        self.flush().await?;
+        self.sync_data().await
+    }
+
+    async fn sync_data(&mut self) -> Result<()> {
+        let file = self.file.clone();
+        task::spawn_blocking(move || file.sync_data())
+            .await?
+            .context("sync log file")?;
+        self.last_sync = Instant::now();
+        Ok(())
+    }
+
+    /// Write the buffered data into the file. Only full blocks are written
+    /// when using direct I/O.
+    async fn write_buffer(&mut self) -> Result<()> {
+        let (file, len) = match &self.direct {
+            Some(direct) => (direct.clone(), self.len / ALIGNMENT * ALIGNMENT),
+            None => (self.file.clone(), self.len),
+        };
+        if len == 0 {
+            return Ok(());
+        }
+
+        let (start, offset) = (self.start, self.offset);
+        self.blocking(move |buf| file.write_all_at(&buf[start..start + len], offset))
+            .await
+            .context("write log file")?;
+
+        self.buf
+            .copy_within(self.start + len..self.start + self.len, self.start);
+        self.len -= len;
+        self.offset += len as u64;
+        Ok(())
+    }
+
+    /// Run the blocking function with the buffer.
+    async fn blocking<F>(&mut self, f: F) -> Result<()>
+    where
+        F: FnOnce(&[u8]) -> std::io::Result<()> + Send + 'static,
+    {
+        let buf = mem::take(&mut self.buf);
+        let (buf, res) = task::spawn_blocking(move || {
+            let res = f(&buf);
+            (buf, res)
+        })
+        .await?;
+        self.buf = buf;
+        Ok(res?)
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::fs;
+    use tempfile::tempdir;
+
+    async fn write_and_read(config: LogWriteConfig) -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("log");
+        let mut sut = LogWriter::open(&path, config).await?;
+        let line = b"0123456789abcdefghijklmnopqrstuvwxyz\n";
+        let mut expected = vec![];
+        for _ in 0..500 {
+            sut.write_all(line).await?;
+            expected.extend_from_slice(line);
+        }
+        sut.commit().await?;
+        assert_eq!(fs::read(&path)?, expected);
+
+        sut.write_all(b"tail").await?;
+        sut.sync().await?;
+        expected.extend_from_slice(b"tail");
+        assert_eq!(fs::read(&path)?, expected);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn write_buffered() -> Result<()> {
+        write_and_read(LogWriteConfig::default()).await?;
+        write_and_read(LogWriteConfig::new(10, FsyncPolicy::EveryLine, false)).await
+    }
+
+    #[tokio::test]
+    async fn write_direct() -> Result<()> {
+        write_and_read(LogWriteConfig::new(0, FsyncPolicy::Never, true)).await?;
+        write_and_read(LogWriteConfig::new(
+            100,
+            FsyncPolicy::Interval(Duration::from_millis(1)),
+            true,
+        ))
+        .await
+    }
+
+    #[tokio::test]
+    async fn buffer_until_full() -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("log");
+        let mut sut =
+            LogWriter::open(&path, LogWriteConfig::new(4, FsyncPolicy::Never, false)).await?;
+
+        sut.write_all(b"abc").await?;
+        assert!(fs::read(&path)?.is_empty());
+        sut.write_all(b"def").await?;
+        assert_eq!(fs::read(&path)?, b"abcd");
+        sut.flush().await?;
+        assert_eq!(fs::read(&path)?, b"abcdef");
+        Ok(())
+    }
+}
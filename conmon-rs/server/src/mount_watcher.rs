@@ -0,0 +1,291 @@
+//! Mount and unmount event watching for running containers.
+use anyhow::{Context, Result};
+use conmon_common::conmon_capnp::conmon::{mount_event, mount_watcher};
+use getset::Getters;
+use nix::poll::{poll, PollFd, PollFlags};
+use std::{
+    collections::HashMap,
+    fs::File,
+    io::{Read, Seek, SeekFrom},
+    os::unix::io::AsRawFd,
+};
+use tokio::{sync::mpsc, task};
+use tokio_util::sync::CancellationToken;
+use tracing::{debug, debug_span, Instrument};
+
+/// The poll timeout in milliseconds, which is used to periodically check if
+/// the watcher should be stopped.
+const POLL_TIMEOUT_MS: i32 = 1000;
+
+#[derive(Clone, Debug, Default, Eq, Getters, PartialEq)]
+/// A single entry of the `mountinfo` file.
+pub struct Mount {
+    #[getset(get = "pub")]
+    mount_point: String,
+
+    #[getset(get = "pub")]
+    source: String,
+
+    #[getset(get = "pub")]
+    fs_type: String,
+
+    #[getset(get = "pub")]
+    options: String,
+}
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// The type of a mount event.
+pub enum MountEventType {
+    Mount,
+    Unmount,
+}
+
+#[derive(Clone, Debug, Eq, PartialEq)]
+/// A mount or unmount event.
+pub struct MountEvent {
+    typ: MountEventType,
+    mount: Mount,
+}
+
+/// Watches the mount namespace of a process and forwards events to a client.
+pub struct MountWatcher {
+    pid: u32,
+    token: CancellationToken,
+    watcher: mount_watcher::Client,
+}
+
+impl MountWatcher {
+    /// Create a new mount watcher for the provided process ID. The watcher
+    /// stops if the `token` gets cancelled.
+    pub fn new(pid: u32, token: CancellationToken, watcher: mount_watcher::Client) -> Self {
+        Self {
+            pid,
+            token,
+            watcher,
+        }
+    }
+
+    /// Run the watcher on the local task set.
+    pub fn spawn(self) {
+        let pid = self.pid;
+        task::spawn_local(
+            async move {
+                let watcher = self.watcher.clone();
+                let mut request = watcher.done_request();
+                if let Err(e) = self.run().await {
+                    debug!("Stopping mount watcher: {:#}", e);
+                    request.get().set_error(&format!("{:#}", e));
+                }
+                // The client is likely gone if this fails.
+                if let Err(e) = request.send().promise.await {
+                    debug!("Unable to notify mount watcher: {}", e);
+                }
+            }
+            .instrument(debug_span!("mount_watcher", pid)),
+        );
+    }
+
+    async fn run(self) -> Result<()> {
+        let (tx, mut rx) = mpsc::channel(1);
+        let pid = self.pid;
+        let token = self.token.clone();
+        let span = debug_span!("watch_mountinfo");
+        let watch = task::spawn_blocking(move || {
+            let _enter = span.enter();
+            Self::watch_mountinfo(pid, &token, tx)
+        });
+
+        while let Some(events) = rx.recv().await {
+            for event in events {
+                self.send(&event).await?;
+            }
+        }
+
+        watch.await?
+    }
+
+    async fn send(&self, event: &MountEvent) -> Result<()> {
+        let mut request = self.watcher.event_request();
+        let mut e = request.get().init_event();
+        e.set_type(match event.typ {
+            MountEventType::Mount => mount_event::Type::Mount,
+            MountEventType::Unmount => mount_event::Type::Unmount,
+        });
+        e.set_mount_point(event.mount.mount_point());
+        e.set_source(event.mount.source());
+        e.set_fs_type(event.mount.fs_type());
+        e.set_options(event.mount.options());
+        request.send().promise.await.context("send mount event")?;
+        Ok(())
+    }
+
+    fn watch_mountinfo(
+        pid: u32,
+        token: &CancellationToken,
+        tx: mpsc::Sender<Vec<MountEvent>>,
+    ) -> Result<()> {
+        let path = format!("/proc/{}/mountinfo", pid);
+        let mut file = File::open(&path).with_context(|| format!("open {}", path))?;
+        let mut mounts = Self::read_mounts(&mut file)?;
+
+        // The kernel signals a change of the mount table via POLLPRI.
+        let mut fds = [PollFd::new(
+            file.as_raw_fd(),
+            PollFlags::POLLPRI | PollFlags::POLLERR,
+        )];
+        while !token.is_cancelled() && !tx.is_closed() {
+            if poll(&mut fds, POLL_TIMEOUT_MS).context("poll mountinfo")? == 0 {
+                continue;
+            }
+
+            let new_mounts = Self::read_mounts(&mut file)?;
+            let events = diff(&mounts, &new_mounts);
+            mounts = new_mounts;
+            if events.is_empty() {
+                continue;
+            }
+
+            debug!("Got {} mount events", events.len());
+            if tx.blocking_send(events).is_err() {
+                break;
+            }
+        }
+
+        Ok(())
+    }
+
+    fn read_mounts(file: &mut File) -> Result<HashMap<String, Mount>> {
+        let mut content = String::new();
+        file.seek(SeekFrom::Start(0)).context("seek mountinfo")?;
+        file.read_to_string(&mut content)
+            .context("read mountinfo")?;
+        Ok(parse_mountinfo(&content))
+    }
+}
+
+/// Parse the content of a `mountinfo` file into a map of mount IDs to mounts.
+fn parse_mountinfo(content: &str) -> HashMap<String, Mount> {
+    let mut mounts = HashMap::new();
+    for line in content.lines() {
+        let fields: Vec<&str> = line.split_whitespace().collect();
+        let separator = match fields.iter().skip(6).position(|x| *x == "-") {
+            Some(pos) => pos + 6,
+            None => continue,
+        };
+        if fields.len() < separator + 3 {
+            continue;
+        }
+        mounts.insert(
+            fields[0].to_string(),
+            Mount {
+                mount_point: unescape(fields[4]),
+                source: unescape(fields[separator + 2]),
+                fs_type: fields[separator + 1].to_string(),
+                options: fields[5].to_string(),
+            },
+        );
+    }
+    mounts
+}
+
+/// Unescape the octal sequences of a `mountinfo` field, like `\040` for a space.
+fn unescape(field: &str) -> String {
+    let bytes = field.as_bytes();
+    let mut res = Vec::with_capacity(bytes.len());
+    let mut i = 0;
+    while i < bytes.len() {
+        if bytes[i] == b'\\' && i + 4 <= bytes.len() {
+            let octal = std::str::from_utf8(&bytes[i + 1..i + 4]).unwrap_or_default();
+            if let Ok(c) = u8::from_str_radix(octal, 8) {
+                res.push(c);
+                i += 4;
+                continue;
+            }
+        }
+        res.push(bytes[i]);
+        i += 1;
+    }
+    String::from_utf8_lossy(&res).into()
+}
+
+/// Calculate the mount events between two mount tables.
+fn diff(old: &HashMap<String, Mount>, new: &HashMap<String, Mount>) -> Vec<MountEvent> {
+    let mut events = vec![];
+    for (id, mount) in old.iter() {
+        if !new.contains_key(id) {
+            events.push(MountEvent {
+                typ: MountEventType::Unmount,
+                mount: mount.clone(),
+            });
+        }
+    }
+    for (id, mount) in new.iter() {
+        if !old.contains_key(id) {
+            events.push(MountEvent {
+                typ: MountEventType::Mount,
+                mount: mount.clone(),
+            });
+        }
+    }
+    events
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    const MOUNTINFO: &str = "\
+22 1 0:21 / / rw,relatime - overlay overlay rw,lowerdir=/a
+23 22 0:22 / /proc rw,nosuid,nodev,noexec,relatime shared:1 - proc proc rw
+24 22 0:23 / /my\\040volume rw,relatime master:2 - tmpfs tmpfs rw,size=1024k
+";
+
+    #[test]
+    fn parse_mountinfo_success() {
+        let mounts = parse_mountinfo(MOUNTINFO);
+        assert_eq!(mounts.len(), 3);
+        assert_eq!(
+            mounts.get("24"),
+            Some(&Mount {
+                mount_point: "/my volume".into(),
+                source: "tmpfs".into(),
+                fs_type: "tmpfs".into(),
+                options: "rw,relatime".into(),
+            })
+        );
+        assert_eq!(mounts.get("22").unwrap().fs_type(), "overlay");
+    }
+
+    #[test]
+    fn parse_mountinfo_invalid() {
+        assert!(parse_mountinfo("invalid line\n").is_empty());
+    }
+
+    #[test]
+    fn diff_success() {
+        let old = parse_mountinfo(MOUNTINFO);
+        let mut new = old.clone();
+        let removed = new.remove("24").unwrap();
+        let added = Mount {
+            mount_point: "/data".into(),
+            ..Default::default()
+        };
+        new.insert("25".into(), added.clone());
+
+        let mut events = diff(&old, &new);
+        events.sort_by_key(|x| x.mount.mount_point.clone());
+        assert_eq!(
+            events,
+            vec![
+                MountEvent {
+                    typ: MountEventType::Mount,
+                    mount: added,
+                },
+                MountEvent {
+                    typ: MountEventType::Unmount,
+                    mount: removed,
+                },
+            ]
+        );
+    }
+}
@@ -0,0 +1,305 @@
+//! Network statistics of the namespace of running containers.
+use anyhow::{Context, Result};
+use conmon_common::conmon_capnp::conmon::{ip_stats, network_stats};
+use std::{collections::HashMap, fs, path::Path};
+
+#[derive(Clone, Debug, Default, Eq, PartialEq)]
+/// Network statistics of a container, split by interface and address family.
+pub struct NetworkStats {
+    /// Counters of the interfaces, excluding the loopback interface.
+    interfaces: Vec<InterfaceStats>,
+
+    /// IPv4 counters of the namespace.
+    ipv4: IpStats,
+
+    /// IPv6 counters of the namespace.
+    ipv6: IpStats,
+}
+
+#[derive(Clone, Debug, Default, Eq, PartialEq)]
+/// Counters of a single network interface.
+pub struct InterfaceStats {
+    name: String,
+    rx_bytes: u64,
+    rx_packets: u64,
+    rx_errors: u64,
+    rx_dropped: u64,
+    tx_bytes: u64,
+    tx_packets: u64,
+    tx_errors: u64,
+    tx_dropped: u64,
+}
+
+#[derive(Clone, Copy, Debug, Default, Eq, PartialEq)]
+/// Counters of a single address family.
+pub struct IpStats {
+    /// Whether the family is enabled in the namespace.
+    available: bool,
+
+    /// Received datagrams, including the discarded ones.
+    in_packets: u64,
+
+    /// Datagrams handed to the IP layer for sending.
+    out_packets: u64,
+
+    /// Received bytes.
+    in_octets: u64,
+
+    /// Sent bytes.
+    out_octets: u64,
+
+    /// Received datagrams discarded without an error, for example due to a
+    /// lack of buffer space.
+    in_discards: u64,
+
+    /// Outgoing datagrams discarded without an error.
+    out_discards: u64,
+}
+
+impl NetworkStats {
+    /// Collect the statistics of the network namespace of the process `pid`.
+    pub fn collect(pid: u32) -> Result<Self> {
+        let dir = Path::new("/proc").join(pid.to_string()).join("net");
+        Self::collect_dir(&dir)
+    }
+
+    fn collect_dir(dir: &Path) -> Result<Self> {
+        let path = dir.join("dev");
+        let dev = fs::read_to_string(&path).with_context(|| format!("read {}", path.display()))?;
+
+        let mut stats = Self {
+            interfaces: parse_dev(&dev),
+            ..Default::default()
+        };
+
+        // The files are missing if the family is disabled in the namespace.
+        if let Some(snmp) = read(dir, "snmp") {
+            let ip = parse_table(&snmp, "Ip");
+            let ip_ext = read(dir, "netstat")
+                .map(|netstat| parse_table(&netstat, "IpExt"))
+                .unwrap_or_default();
+            stats.ipv4 = IpStats {
+                available: true,
+                in_packets: value(&ip, "InReceives"),
+                out_packets: value(&ip, "OutRequests"),
+                in_octets: value(&ip_ext, "InOctets"),
+                out_octets: value(&ip_ext, "OutOctets"),
+                in_discards: value(&ip, "InDiscards"),
+                out_discards: value(&ip, "OutDiscards"),
+            };
+        }
+        if let Some(snmp6) = read(dir, "snmp6") {
+            let ip6 = parse_flat(&snmp6);
+            stats.ipv6 = IpStats {
+                available: true,
+                in_packets: value(&ip6, "Ip6InReceives"),
+                out_packets: value(&ip6, "Ip6OutRequests"),
+                in_octets: value(&ip6, "Ip6InOctets"),
+                out_octets: value(&ip6, "Ip6OutOctets"),
+                in_discards: value(&ip6, "Ip6InDiscards"),
+                out_discards: value(&ip6, "Ip6OutDiscards"),
+            };
+        }
+
+        Ok(stats)
+    }
+
+    /// Set the fields of the capnp network stats.
+    pub fn build(&self, mut network: network_stats::Builder) {
+        let mut interfaces = network
+            .reborrow()
+            .init_interfaces(self.interfaces.len() as u32);
+        for (i, interface) in self.interfaces.iter().enumerate() {
+            let mut builder = interfaces.reborrow().get(i as u32);
+            builder.set_name(&interface.name);
+            builder.set_rx_bytes(interface.rx_bytes);
+            builder.set_rx_packets(interface.rx_packets);
+            builder.set_rx_errors(interface.rx_errors);
+            builder.set_rx_dropped(interface.rx_dropped);
+            builder.set_tx_bytes(interface.tx_bytes);
+            builder.set_tx_packets(interface.tx_packets);
+            builder.set_tx_errors(interface.tx_errors);
+            builder.set_tx_dropped(interface.tx_dropped);
+        }
+
+        self.ipv4.build(network.reborrow().init_ipv4());
+        self.ipv6.build(network.init_ipv6());
+    }
+}
+
+impl IpStats {
+    fn build(&self, mut ip: ip_stats::Builder) {
+        ip.set_available(self.available);
+        ip.set_in_packets(self.in_packets);
+        ip.set_out_packets(self.out_packets);
+        ip.set_in_octets(self.in_octets);
+        ip.set_out_octets(self.out_octets);
+        ip.set_in_discards(self.in_discards);
+        ip.set_out_discards(self.out_discards);
+    }
+}
+
+/// Parse the interface counters of `/proc/PID/net/dev`, skipping loopback.
+fn parse_dev(content: &str) -> Vec<InterfaceStats> {
+    content
+        .lines()
+        .filter_map(|line| {
+            // Format: `  eth0: rx_bytes rx_packets rx_errs rx_drop ... tx_bytes ...`
+            let (name, counters) = line.split_once(':')?;
+            let name = name.trim();
+            if name == "lo" {
+                return None;
+            }
+            let counters: Vec<u64> = counters
+                .split_whitespace()
+                .map(|value| value.parse().ok())
+                .collect::<Option<_>>()?;
+            if counters.len() < 12 {
+                return None;
+            }
+            Some(InterfaceStats {
+                name: name.into(),
+                rx_bytes: counters[0],
+                rx_packets: counters[1],
+                rx_errors: counters[2],
+                rx_dropped: counters[3],
+                tx_bytes: counters[8],
+                tx_packets: counters[9],
+                tx_errors: counters[10],
+                tx_dropped: counters[11],
+            })
+        })
+        .collect()
+}
+
+/// Parse the table `name` of `/proc/PID/net/snmp` or `/proc/PID/net/netstat`,
+/// which consists of a header line followed by a line of values.
+fn parse_table<'a>(content: &'a str, name: &str) -> HashMap<&'a str, u64> {
+    let prefix = format!("{}:", name);
+    let mut lines = content
+        .lines()
+        .filter_map(|line| line.strip_prefix(prefix.as_str()));
+    let (keys, values) = match (lines.next(), lines.next()) {
+        (Some(keys), Some(values)) => (keys, values),
+        _ => return HashMap::new(),
+    };
+    keys.split_whitespace()
+        .zip(values.split_whitespace())
+        .filter_map(|(key, value)| Some((key, value.parse().ok()?)))
+        .collect()
+}
+
+/// Parse `/proc/PID/net/snmp6`, which contains a `key value` pair per line.
+fn parse_flat(content: &str) -> HashMap<&str, u64> {
+    content
+        .lines()
+        .filter_map(|line| {
+            let mut fields = line.split_whitespace();
+            Some((fields.next()?, fields.next()?.parse().ok()?))
+        })
+        .collect()
+}
+
+fn value(table: &HashMap<&str, u64>, key: &str) -> u64 {
+    table.get(key).copied().unwrap_or_default()
+}
+
+fn read(dir: &Path, file: &str) -> Option<String> {
+    fs::read_to_string(dir.join(file)).ok()
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use tempfile::tempdir;
+
+    const DEV: &str = "Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:     100       1    0    0    0     0          0         0      100       1    0    0    0     0       0          0
+  eth0:    2048      20    1    2    0     0          0         0     1024      10    3    4    0     0       0          0
+";
+
+    #[test]
+    fn parse_dev_success() {
+        assert_eq!(
+            parse_dev(DEV),
+            vec![InterfaceStats {
+                name: "eth0".into(),
+                rx_bytes: 2048,
+                rx_packets: 20,
+                rx_errors: 1,
+                rx_dropped: 2,
+                tx_bytes: 1024,
+                tx_packets: 10,
+                tx_errors: 3,
+                tx_dropped: 4,
+            }]
+        );
+    }
+
+    #[test]
+    fn parse_table_success() {
+        let content = "Ip: Forwarding DefaultTTL InReceives\nIp: 1 64 42\nIcmp: InMsgs\nIcmp: 3\n";
+        let table = parse_table(content, "Ip");
+        assert_eq!(value(&table, "InReceives"), 42);
+        assert_eq!(value(&table, "InMsgs"), 0);
+        assert!(parse_table(content, "IpExt").is_empty());
+    }
+
+    #[test]
+    fn collect_dir_ipv6_only() -> Result<()> {
+        let dir = tempdir()?;
+        fs::write(dir.path().join("dev"), DEV)?;
+        fs::write(
+            dir.path().join("snmp6"),
+            "Ip6InReceives\t7\nIp6OutRequests\t5\nIp6InOctets\t700\nIp6OutOctets\t500\n",
+        )?;
+
+        let stats = NetworkStats::collect_dir(dir.path())?;
+        assert_eq!(stats.interfaces.len(), 1);
+        assert!(!stats.ipv4.available);
+        assert!(stats.ipv6.available);
+        assert_eq!(stats.ipv6.in_packets, 7);
+        assert_eq!(stats.ipv6.out_packets, 5);
+        assert_eq!(stats.ipv6.in_octets, 700);
+        assert_eq!(stats.ipv6.out_octets, 500);
+        assert_eq!(stats.ipv6.in_discards, 0);
+        Ok(())
+    }
+
+    #[test]
+    fn collect_dir_ipv4() -> Result<()> {
+        let dir = tempdir()?;
+        fs::write(dir.path().join("dev"), DEV)?;
+        fs::write(
+            dir.path().join("snmp"),
+            "Ip: InReceives InDiscards OutRequests OutDiscards\nIp: 10 1 8 2\n",
+        )?;
+        fs::write(
+            dir.path().join("netstat"),
+            "IpExt: InNoRoutes InOctets OutOctets\nIpExt: 0 1000 800\n",
+        )?;
+
+        let stats = NetworkStats::collect_dir(dir.path())?;
+        assert!(!stats.ipv6.available);
+        assert_eq!(
+            stats.ipv4,
+            IpStats {
+                available: true,
+                in_packets: 10,
+                out_packets: 8,
+                in_octets: 1000,
+                out_octets: 800,
+                in_discards: 1,
+                out_discards: 2,
+            }
+        );
+        Ok(())
+    }
+
+    #[test]
+    fn collect_self() -> Result<()> {
+        NetworkStats::collect(std::process::id())?;
+        Ok(())
+    }
+}
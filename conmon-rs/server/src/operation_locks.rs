@@ -0,0 +1,149 @@
+//! Mutual exclusion of concurrent operations on the same container.
+use crate::rpc_error::RpcError;
+use anyhow::{format_err, Result};
+use std::{
+    collections::HashMap,
+    fmt,
+    sync::{Arc, Mutex},
+};
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// An operation on a container, which excludes other operations.
+pub enum Operation {
+    Stop,
+    Exec,
+    Pause,
+    Unpause,
+    Checkpoint,
+    UpdateResources,
+}
+
+impl Operation {
+    /// Whether the operation can run concurrently with operations of the
+    /// same type.
+    fn shared(self) -> bool {
+        self == Operation::Exec
+    }
+}
+
+impl fmt::Display for Operation {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        f.write_str(match self {
+            Operation::Stop => "stop",
+            Operation::Exec => "exec",
+            Operation::Pause => "pause",
+            Operation::Unpause => "unpause",
+            Operation::Checkpoint => "checkpoint",
+            Operation::UpdateResources => "updateResources",
+        })
+    }
+}
+
+#[derive(Debug)]
+struct Lock {
+    operation: Operation,
+    holders: usize,
+}
+
+type Key = (String, String);
+
+#[derive(Clone, Debug, Default)]
+/// The operations in progress of all containers by their tenant and ID.
+pub struct OperationLocks(Arc<Mutex<HashMap<Key, Lock>>>);
+
+impl OperationLocks {
+    /// Lock the container for the operation, which fails with an operation
+    /// in progress error if it is locked by another operation. The lock is
+    /// released once the returned guard gets dropped.
+    pub fn acquire(&self, tenant: &str, id: &str, operation: Operation) -> Result<OperationGuard> {
+        let mut locks = self
+            .0
+            .lock()
+            .map_err(|e| format_err!("lock operation locks: {}", e))?;
+        let key = (tenant.to_string(), id.to_string());
+        match locks.get_mut(&key) {
+            Some(lock) if lock.operation == operation && operation.shared() => lock.holders += 1,
+            Some(lock) => {
+                return Err(RpcError::operation_in_progress(
+                    lock.operation.to_string(),
+                    format!(
+                        "container {} is locked by a {} operation",
+                        id, lock.operation
+                    ),
+                )
+                .into())
+            }
+            None => {
+                locks.insert(
+                    key.clone(),
+                    Lock {
+                        operation,
+                        holders: 1,
+                    },
+                );
+            }
+        }
+        Ok(OperationGuard {
+            locks: self.clone(),
+            key,
+        })
+    }
+}
+
+#[derive(Debug)]
+/// The lock of a container operation, which gets released on drop.
+pub struct OperationGuard {
+    locks: OperationLocks,
+    key: Key,
+}
+
+impl Drop for OperationGuard {
+    fn drop(&mut self) {
+        if let Ok(mut locks) = self.locks.0.lock() {
+            if let Some(lock) = locks.get_mut(&self.key) {
+                lock.holders -= 1;
+                if lock.holders == 0 {
+                    locks.remove(&self.key);
+                }
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn exclusive_operations() -> Result<()> {
+        let sut = OperationLocks::default();
+        let guard = sut.acquire("tenant", "id", Operation::Stop)?;
+
+        let err = sut
+            .acquire("tenant", "id", Operation::Exec)
+            .unwrap_err()
+            .to_string();
+        assert_eq!(err, "container id is locked by a stop operation");
+        assert!(sut.acquire("tenant", "id", Operation::Stop).is_err());
+        assert!(sut.acquire("tenant", "other", Operation::Exec).is_ok());
+        assert!(sut.acquire("other", "id", Operation::Exec).is_ok());
+
+        drop(guard);
+        assert!(sut.acquire("tenant", "id", Operation::Exec).is_ok());
+        Ok(())
+    }
+
+    #[test]
+    fn shared_operations() -> Result<()> {
+        let sut = OperationLocks::default();
+        let first = sut.acquire("tenant", "id", Operation::Exec)?;
+        let second = sut.acquire("tenant", "id", Operation::Exec)?;
+        assert!(sut.acquire("tenant", "id", Operation::Pause).is_err());
+
+        drop(first);
+        assert!(sut.acquire("tenant", "id", Operation::Pause).is_err());
+        drop(second);
+        assert!(sut.acquire("tenant", "id", Operation::Pause).is_ok());
+        Ok(())
+    }
+}
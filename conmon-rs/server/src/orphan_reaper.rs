@@ -0,0 +1,282 @@
+//! Reaping of orphaned processes, which got reparented to the server as the
+//! child subreaper.
+use crate::{child_reaper::ChildReaper, container_events::ContainerEvents};
+use anyhow::{Context, Result};
+use lazy_static::lazy_static;
+use nix::{
+    errno::Errno,
+    sys::wait::{waitpid, WaitPidFlag, WaitStatus},
+    unistd::Pid,
+};
+use std::{
+    collections::HashSet,
+    fs,
+    path::{Path, PathBuf},
+    process,
+    sync::{Arc, Mutex, MutexGuard, PoisonError},
+    time::Duration,
+};
+use tokio::{
+    process::Child,
+    signal::unix::{signal, SignalKind},
+    task, time,
+};
+use tracing::{debug, debug_span, Instrument};
+
+/// The time a zombie has to remain unreaped before it is considered to be an
+/// orphan. Containers exiting before they are watched and children exiting
+/// before they are marked as spawned get reaped by their owners within this
+/// period.
+const GRACE_PERIOD: Duration = Duration::from_secs(1);
+
+lazy_static! {
+    static ref SPAWNED: Mutex<HashSet<u32>> = Mutex::new(HashSet::new());
+}
+
+#[derive(Debug)]
+/// Keeps a child spawned by the server itself from being reaped as an orphan,
+/// which would steal its exit status from the owner. The guard has to live
+/// until the child has been awaited.
+pub struct SpawnedGuard(Option<u32>);
+
+impl SpawnedGuard {
+    /// Mark the child as spawned by the server until the guard gets dropped.
+    pub fn new(child: &Child) -> Self {
+        let pid = child.id();
+        if let Some(pid) = pid {
+            spawned().insert(pid);
+        }
+        Self(pid)
+    }
+}
+
+impl Drop for SpawnedGuard {
+    fn drop(&mut self) {
+        if let Some(pid) = self.0 {
+            spawned().remove(&pid);
+        }
+    }
+}
+
+/// The PIDs of the children spawned by the server itself.
+fn spawned() -> MutexGuard<'static, HashSet<u32>> {
+    // The set stays consistent even if a holder panicked.
+    SPAWNED.lock().unwrap_or_else(PoisonError::into_inner)
+}
+
+#[derive(Debug)]
+/// Reaps the zombies among the children of the server, which are neither
+/// watched containers nor spawned by the server, see `SpawnedGuard`.
+pub struct OrphanReaper {
+    /// The reaper of the watched containers and exec sessions.
+    reaper: Arc<ChildReaper>,
+
+    /// The event bus for the reaped events, if enabled.
+    events: Option<ContainerEvents>,
+
+    /// PIDs which are never reaped.
+    ignored: HashSet<u32>,
+
+    /// The root of the proc file system.
+    proc_root: PathBuf,
+}
+
+impl OrphanReaper {
+    /// Create a new orphan reaper, which publishes reaped events if `events`
+    /// is set.
+    pub fn new(reaper: Arc<ChildReaper>, events: Option<ContainerEvents>, ignored: &[u32]) -> Self {
+        Self {
+            reaper,
+            events,
+            ignored: ignored.iter().copied().collect(),
+            proc_root: PathBuf::from("/proc"),
+        }
+    }
+
+    /// Reap orphans whenever a child exited, for the lifetime of the server.
+    pub fn spawn(self) -> Result<()> {
+        let mut sigchld = signal(SignalKind::child()).context("watch SIGCHLD")?;
+        task::spawn(
+            async move {
+                let mut candidates = HashSet::new();
+                loop {
+                    if candidates.is_empty() {
+                        if sigchld.recv().await.is_none() {
+                            return;
+                        }
+                    } else {
+                        time::sleep(GRACE_PERIOD).await;
+                    }
+                    candidates = self.reap(&candidates);
+                }
+            }
+            .instrument(debug_span!("orphan_reaper")),
+        );
+        Ok(())
+    }
+
+    /// Reap the zombies which already have been candidates before and return
+    /// the new candidates.
+    fn reap(&self, candidates: &HashSet<u32>) -> HashSet<u32> {
+        let zombies = match zombie_children(&self.proc_root, process::id()) {
+            Ok(zombies) => zombies,
+            Err(e) => {
+                debug!("Unable to find zombie children: {:#}", e);
+                return HashSet::new();
+            }
+        };
+        let watched: HashSet<u32> = match self.reaper.children() {
+            Ok(children) => children.iter().map(|child| child.pid()).collect(),
+            Err(e) => {
+                debug!("Unable to get watched children: {:#}", e);
+                return HashSet::new();
+            }
+        };
+
+        let mut next = HashSet::new();
+        for pid in zombies {
+            if self.ignored.contains(&pid) || watched.contains(&pid) || spawned().contains(&pid) {
+                continue;
+            }
+            if !candidates.contains(&pid) {
+                next.insert(pid);
+                continue;
+            }
+            if let Some(exit_code) = reap(pid) {
+                debug!(pid, "Reaped orphan with exit code {}", exit_code);
+                if let Some(events) = &self.events {
+                    events.publish_reaped(pid, exit_code);
+                }
+            }
+        }
+        next
+    }
+}
+
+/// Reap the zombie `pid` and return its exit code, or `None` if it has been
+/// reaped by someone else.
+fn reap(pid: u32) -> Option<i32> {
+    match waitpid(Pid::from_raw(pid as i32), Some(WaitPidFlag::WNOHANG)) {
+        Ok(WaitStatus::Exited(_, exit_code)) => Some(exit_code),
+        Ok(WaitStatus::Signaled(_, signal, _)) => Some(signal as i32 + 128),
+        Ok(_) | Err(Errno::ECHILD) => None,
+        Err(e) => {
+            debug!(pid, "Unable to reap orphan: {}", e);
+            None
+        }
+    }
+}
+
+/// Find the zombie processes among the children of `ppid`.
+fn zombie_children(proc_root: &Path, ppid: u32) -> Result<Vec<u32>> {
+    let entries =
+        fs::read_dir(proc_root).with_context(|| format!("read {}", proc_root.display()))?;
+    Ok(entries
+        .filter_map(|entry| {
+            let pid = entry.ok()?.file_name().to_str()?.parse::<u32>().ok()?;
+            let stat = fs::read_to_string(proc_root.join(pid.to_string()).join("stat")).ok()?;
+            match parse_stat(&stat)? {
+                ('Z', parent) if parent == ppid => Some(pid),
+                _ => None,
+            }
+        })
+        .collect())
+}
+
+/// Parse the state and the parent PID of `/proc/PID/stat`.
+fn parse_stat(stat: &str) -> Option<(char, u32)> {
+    // The command name may contain spaces and parentheses itself.
+    let (_, rest) = stat.rsplit_once(')')?;
+    let mut fields = rest.split_whitespace();
+    let state = fields.next()?.chars().next()?;
+    let ppid = fields.next()?.parse().ok()?;
+    Some((state, ppid))
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::process::Command;
+    use tempfile::tempdir;
+
+    #[test]
+    fn parse_stat_success() {
+        assert_eq!(
+            parse_stat("42 (sleep) S 1 42 42 0 -1 4194560"),
+            Some(('S', 1))
+        );
+        assert_eq!(
+            parse_stat("43 (my (odd) cmd) Z 42 43 43 0 -1"),
+            Some(('Z', 42))
+        );
+        assert_eq!(parse_stat("invalid"), None);
+    }
+
+    #[test]
+    fn zombie_children_filtered() -> Result<()> {
+        let dir = tempdir()?;
+        for (pid, stat) in [
+            (10, "10 (a) Z 5 0"),
+            (11, "11 (b) S 5 0"),
+            (12, "12 (c) Z 6 0"),
+        ] {
+            let path = dir.path().join(pid.to_string());
+            fs::create_dir(&path)?;
+            fs::write(path.join("stat"), stat)?;
+        }
+        fs::create_dir(dir.path().join("self"))?;
+
+        assert_eq!(zombie_children(dir.path(), 5)?, vec![10]);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn reap_after_grace() -> Result<()> {
+        let child = Command::new("true").spawn()?;
+        let pid = child.id();
+        // The child is not awaited, like an orphan.
+        std::mem::forget(child);
+        time::sleep(Duration::from_millis(200)).await;
+
+        let sut = OrphanReaper::new(Arc::new(ChildReaper::default()), None, &[]);
+        let candidates = sut.reap(&HashSet::new());
+        assert!(candidates.contains(&pid));
+
+        // Only reap the own child, not the ones of other tests.
+        assert!(!sut.reap(&HashSet::from([pid])).contains(&pid));
+        assert_eq!(reap(pid), None);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn spawned_child() -> Result<()> {
+        let mut child = tokio::process::Command::new("true").spawn()?;
+        let pid = child.id().context("no child PID")?;
+        let guard = SpawnedGuard::new(&child);
+        time::sleep(Duration::from_millis(200)).await;
+
+        let sut = OrphanReaper::new(Arc::new(ChildReaper::default()), None, &[]);
+        assert!(!sut.reap(&HashSet::from([pid])).contains(&pid));
+
+        // The exit status is left to the owner.
+        assert!(child.wait().await?.success());
+        drop(guard);
+        assert!(!spawned().contains(&pid));
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn ignored_pid() -> Result<()> {
+        let mut child = Command::new("true").spawn()?;
+        let pid = child.id();
+        time::sleep(Duration::from_millis(200)).await;
+
+        let sut = OrphanReaper::new(Arc::new(ChildReaper::default()), None, &[pid]);
+        let candidates = sut.reap(&HashSet::from([pid]));
+        assert!(!candidates.contains(&pid));
+
+        // The ignored child is still a zombie.
+        assert!(child.try_wait()?.is_some());
+        Ok(())
+    }
+}
@@ -0,0 +1,46 @@
+//! Process file descriptors, which allow waiting for the exit of a process
+//! without blocking a thread.
+use crate::fd_socket::Fd;
+use anyhow::{Context, Result};
+use libc::pid_t;
+use std::{io, os::unix::io::RawFd};
+use tokio::io::unix::AsyncFd;
+
+/// A pidfd, which becomes readable once its process exited.
+pub struct PidFd(AsyncFd<Fd>);
+
+impl PidFd {
+    /// Open a pidfd for the process, which fails on kernels without pidfd
+    /// support (before 5.3).
+    pub fn open(pid: u32) -> Result<Self> {
+        let fd = match unsafe { libc::syscall(libc::SYS_pidfd_open, pid as pid_t, 0) } {
+            -1 => return Err(io::Error::last_os_error()).context("open pidfd"),
+            fd => Fd::from(fd as RawFd),
+        };
+        Ok(Self(AsyncFd::new(fd).context("register pidfd")?))
+    }
+
+    /// Wait until the process exited. It remains a zombie until it gets
+    /// reaped by `waitpid`.
+    pub async fn exited(&self) -> Result<()> {
+        self.0.readable().await.context("wait for pidfd")?;
+        Ok(())
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::process::Command;
+    use tokio::time::{self, Duration};
+
+    #[tokio::test]
+    async fn wait_for_exit() -> Result<()> {
+        let mut child = Command::new("sleep").arg("0.1").spawn()?;
+        let pidfd = PidFd::open(child.id())?;
+
+        time::timeout(Duration::from_secs(5), pidfd.exited()).await??;
+        assert!(child.try_wait()?.is_some());
+        Ok(())
+    }
+}
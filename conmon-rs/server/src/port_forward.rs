@@ -0,0 +1,299 @@
+//! Forwarding of TCP connections into the network namespace of containers.
+use crate::listener;
+use anyhow::{bail, format_err, Context, Result};
+use conmon_common::conmon_capnp::conmon::AddressFamily;
+use getset::CopyGetters;
+use nix::sched::{setns, CloneFlags};
+use std::{
+    fs::{self, File},
+    net::{IpAddr, Ipv4Addr, Ipv6Addr, SocketAddr, TcpStream as StdTcpStream},
+    os::unix::io::AsRawFd,
+    path::{Path, PathBuf},
+    thread,
+};
+use tokio::{
+    io,
+    net::{TcpStream, UnixListener},
+    task,
+};
+use tokio_util::sync::CancellationToken;
+use tracing::{debug, debug_span, error, Instrument};
+
+/// A single forwarded connection between a unix socket and a TCP port inside
+/// the network namespace of a container.
+#[derive(CopyGetters, Debug)]
+pub struct PortForward {
+    /// The forwarded port.
+    port: u16,
+
+    /// The connected address inside of the container.
+    #[getset(get_copy = "pub")]
+    address: SocketAddr,
+
+    /// The connection to the port inside of the container.
+    stream: TcpStream,
+
+    /// The listener for the client connection.
+    listener: UnixListener,
+
+    /// Path of the listener socket.
+    socket_path: PathBuf,
+
+    /// Token which gets cancelled if the container exits.
+    token: CancellationToken,
+}
+
+impl PortForward {
+    /// Connect to the port of `host` inside the network namespace of the
+    /// process `pid` and create the socket for the client at `socket_path`.
+    /// An empty `host` selects the loopback address of the `family`.
+    pub async fn new(
+        pid: u32,
+        target: Target<'_>,
+        socket_path: &Path,
+        token: CancellationToken,
+    ) -> Result<Self> {
+        debug!("Creating port forward socket: {}", socket_path.display());
+        if socket_path.exists() {
+            bail!(
+                "Port forward socket path already exists: {}",
+                socket_path.display()
+            )
+        }
+
+        let port = target.port;
+        let addrs = target.addrs()?;
+        let stream = connect(pid, addrs).await?;
+        let address = stream.peer_addr().context("get connected address")?;
+        let listener = listener::bind_long_path(socket_path)?;
+
+        Ok(Self {
+            port,
+            address,
+            stream,
+            listener,
+            socket_path: socket_path.into(),
+            token,
+        })
+    }
+
+    /// Run the forwarding on a new task until either side closes the
+    /// connection or the container exits.
+    pub fn spawn(self) {
+        let port = self.port;
+        task::spawn(
+            async move {
+                if let Err(e) = self.run().await {
+                    error!("Port forward failure: {:#}", e);
+                }
+            }
+            .instrument(debug_span!("port_forward", port)),
+        );
+    }
+
+    async fn run(mut self) -> Result<()> {
+        let accepted = tokio::select! {
+            accepted = self.listener.accept() => accepted,
+            _ = self.token.cancelled() => {
+                self.remove_socket();
+                return Ok(());
+            }
+        };
+        // Only a single client is allowed per forwarded connection.
+        self.remove_socket();
+        let (mut client, _) = accepted.context("accept port forward client")?;
+
+        tokio::select! {
+            result = io::copy_bidirectional(&mut client, &mut self.stream) => {
+                let (sent, received) = result.context("forward connection")?;
+                debug!("Forwarded {} bytes and received {} bytes", sent, received);
+            }
+            _ = self.token.cancelled() => debug!("Container exited"),
+        }
+        Ok(())
+    }
+
+    fn remove_socket(&self) {
+        if let Err(e) = fs::remove_file(&self.socket_path) {
+            debug!(
+                "Unable to remove port forward socket {}: {}",
+                self.socket_path.display(),
+                e
+            );
+        }
+    }
+}
+
+/// The target of a port forward inside of the container.
+#[derive(Clone, Copy, Debug)]
+pub struct Target<'a> {
+    /// The address family to connect with.
+    pub family: AddressFamily,
+
+    /// The IP address to connect to, the loopback address if empty.
+    pub host: &'a str,
+
+    /// The port to connect to.
+    pub port: u16,
+}
+
+impl Target<'_> {
+    /// The addresses to try in order, where `any` family prefers IPv4 for
+    /// an empty host.
+    fn addrs(&self) -> Result<Vec<SocketAddr>> {
+        let host = self.host.trim_start_matches('[').trim_end_matches(']');
+        let ips: Vec<IpAddr> = if host.is_empty() {
+            match self.family {
+                AddressFamily::Any => vec![Ipv4Addr::LOCALHOST.into(), Ipv6Addr::LOCALHOST.into()],
+                AddressFamily::Ipv4 => vec![Ipv4Addr::LOCALHOST.into()],
+                AddressFamily::Ipv6 => vec![Ipv6Addr::LOCALHOST.into()],
+            }
+        } else {
+            let ip: IpAddr = host
+                .parse()
+                .with_context(|| format!("invalid port forward host {}", self.host))?;
+            match (self.family, ip) {
+                (AddressFamily::Ipv4, IpAddr::V6(_)) | (AddressFamily::Ipv6, IpAddr::V4(_)) => {
+                    bail!(
+                        "host {} does not match address family {:?}",
+                        ip,
+                        self.family
+                    )
+                }
+                _ => vec![ip],
+            }
+        };
+        Ok(ips
+            .into_iter()
+            .map(|ip| SocketAddr::new(ip, self.port))
+            .collect())
+    }
+}
+
+/// Convert the address family of `addr` to its capnp representation.
+pub fn address_family(addr: &SocketAddr) -> AddressFamily {
+    match addr {
+        SocketAddr::V4(_) => AddressFamily::Ipv4,
+        SocketAddr::V6(_) => AddressFamily::Ipv6,
+    }
+}
+
+/// Connect to the first reachable of `addrs` inside the network namespace of
+/// the process `pid`.
+async fn connect(pid: u32, addrs: Vec<SocketAddr>) -> Result<TcpStream> {
+    // Joining the namespace taints the calling thread, which is why we use a
+    // dedicated thread rather than one of the runtime's blocking pool. The
+    // socket stays in the namespace it has been created in.
+    let stream = task::spawn_blocking(move || {
+        thread::spawn(move || connect_in_namespace(pid, &addrs))
+            .join()
+            .map_err(|_| format_err!("port forward thread panicked"))?
+    })
+    .await??;
+
+    stream
+        .set_nonblocking(true)
+        .context("set stream to non blocking")?;
+    TcpStream::from_std(stream).context("convert stream")
+}
+
+fn connect_in_namespace(pid: u32, addrs: &[SocketAddr]) -> Result<StdTcpStream> {
+    let path = format!("/proc/{}/ns/net", pid);
+    let file = File::open(&path).with_context(|| format!("open namespace {}", path))?;
+    setns(file.as_raw_fd(), CloneFlags::CLONE_NEWNET)
+        .with_context(|| format!("join namespace {}", path))?;
+
+    let mut last_err = None;
+    for addr in addrs {
+        match StdTcpStream::connect(addr) {
+            Ok(stream) => return Ok(stream),
+            Err(e) => {
+                debug!("Unable to connect to {}: {}", addr, e);
+                last_err = Some(format_err!(e).context(format!("connect to {}", addr)));
+            }
+        }
+    }
+    Err(last_err.unwrap_or_else(|| format_err!("no address to connect to")))
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::net::TcpListener;
+    use tempfile::tempdir;
+    use tokio::{
+        io::{AsyncReadExt, AsyncWriteExt},
+        net::UnixStream,
+    };
+
+    async fn echo_through(ip: IpAddr, family: AddressFamily, host: &str) -> Result<()> {
+        let server = TcpListener::bind((ip, 0))?;
+        let port = server.local_addr()?.port();
+        let echo = thread::spawn(move || -> Result<()> {
+            let (mut stream, _) = server.accept()?;
+            let mut reader = stream.try_clone()?;
+            std::io::copy(&mut reader, &mut stream)?;
+            Ok(())
+        });
+
+        let dir = tempdir()?;
+        let socket_path = dir.path().join("port_forward");
+        let port_forward = PortForward::new(
+            std::process::id(),
+            Target { family, host, port },
+            &socket_path,
+            CancellationToken::new(),
+        )
+        .await?;
+        assert_eq!(port_forward.address(), SocketAddr::new(ip, port));
+        port_forward.spawn();
+
+        let mut client = UnixStream::connect(&socket_path).await?;
+        client.write_all(b"hello").await?;
+        let mut buf = [0; 5];
+        client.read_exact(&mut buf).await?;
+        assert_eq!(&buf, b"hello");
+
+        client.shutdown().await?;
+        drop(client);
+        echo.join()
+            .map_err(|_| format_err!("echo thread panicked"))??;
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn port_forward() -> Result<()> {
+        echo_through(Ipv4Addr::LOCALHOST.into(), AddressFamily::Any, "").await
+    }
+
+    #[tokio::test]
+    async fn port_forward_ipv6() -> Result<()> {
+        echo_through(Ipv6Addr::LOCALHOST.into(), AddressFamily::Ipv6, "").await?;
+        echo_through(Ipv6Addr::LOCALHOST.into(), AddressFamily::Any, "[::1]").await
+    }
+
+    #[test]
+    fn target_addrs() -> Result<()> {
+        let target = |family, host| Target {
+            family,
+            host,
+            port: 80,
+        };
+        assert_eq!(
+            target(AddressFamily::Any, "").addrs()?,
+            vec!["127.0.0.1:80".parse::<SocketAddr>()?, "[::1]:80".parse()?]
+        );
+        assert_eq!(
+            target(AddressFamily::Ipv6, "").addrs()?,
+            vec!["[::1]:80".parse::<SocketAddr>()?]
+        );
+        assert_eq!(
+            target(AddressFamily::Any, "fd00::2").addrs()?,
+            vec!["[fd00::2]:80".parse::<SocketAddr>()?]
+        );
+        assert!(target(AddressFamily::Ipv4, "::1").addrs().is_err());
+        assert!(target(AddressFamily::Ipv6, "10.0.0.1").addrs().is_err());
+        assert!(target(AddressFamily::Any, "localhost").addrs().is_err());
+        Ok(())
+    }
+}
@@ -0,0 +1,197 @@
+//! Pseudo terminal shim, which simulates a terminal for attach sessions to
+//! processes without one.
+use anyhow::{Context, Result};
+use nix::{
+    fcntl::{fcntl, FcntlArg, OFlag},
+    pty,
+    sys::{
+        signal::{kill, Signal},
+        termios::{self, FlushArg, LocalFlags, SetArg},
+    },
+    unistd::Pid,
+};
+use std::{
+    fs::File,
+    io::{Read, Write},
+    os::unix::io::{AsRawFd, FromRawFd},
+};
+use tokio::io::unix::AsyncFd;
+
+/// The default interrupt character (Ctrl-C).
+const INTR: u8 = 0x03;
+
+/// The default quit character (Ctrl-\).
+const QUIT: u8 = 0x1c;
+
+#[derive(Debug)]
+/// A pseudo terminal in front of the standard streams of a process.
+///
+/// The input of the session is written to the terminal, which provides echo
+/// and line editing, while the completed lines are forwarded to the process.
+/// The interrupt and quit characters are delivered as signals to the process
+/// and discard the pending line. The output of the process is written to the
+/// terminal, which converts the line endings for the session.
+pub struct PtyShim {
+    pid: u32,
+
+    /// The side of the attach session.
+    master: AsyncFd<File>,
+
+    /// The side of the process.
+    slave: AsyncFd<File>,
+}
+
+impl PtyShim {
+    /// Create a new shim for the process `pid`.
+    pub fn new(pid: u32) -> Result<Self> {
+        let pty = pty::openpty(None, None).context("open pty")?;
+        // Take the ownership of the fds to close them on failure.
+        let (master, slave) =
+            unsafe { (File::from_raw_fd(pty.master), File::from_raw_fd(pty.slave)) };
+
+        // The signals are delivered by the shim, because the process is not
+        // running on the terminal.
+        let mut term = termios::tcgetattr(slave.as_raw_fd()).context("get terminal attributes")?;
+        term.local_flags.remove(LocalFlags::ISIG);
+        termios::tcsetattr(slave.as_raw_fd(), SetArg::TCSANOW, &term)
+            .context("set terminal attributes")?;
+
+        Ok(Self {
+            pid,
+            master: Self::async_fd(master)?,
+            slave: Self::async_fd(slave)?,
+        })
+    }
+
+    fn async_fd(file: File) -> Result<AsyncFd<File>> {
+        let fd = file.as_raw_fd();
+        let flags = OFlag::from_bits_truncate(fcntl(fd, FcntlArg::F_GETFL)?);
+        fcntl(fd, FcntlArg::F_SETFL(flags | OFlag::O_NONBLOCK)).context("set nonblocking")?;
+        AsyncFd::new(file).context("register pty fd")
+    }
+
+    /// Process the input of the attach session.
+    pub async fn input(&self, data: &[u8]) -> Result<()> {
+        let mut rest = data;
+        while let Some(pos) = rest.iter().position(|x| *x == INTR || *x == QUIT) {
+            Self::write_all(&self.master, &rest[..pos]).await?;
+            let signal = if rest[pos] == INTR {
+                Signal::SIGINT
+            } else {
+                Signal::SIGQUIT
+            };
+            self.signal(signal).await?;
+            rest = &rest[pos + 1..];
+        }
+        Self::write_all(&self.master, rest).await
+    }
+
+    /// Deliver the signal to the process and discard the pending line, like
+    /// the terminal would do for a process running on it.
+    async fn signal(&self, signal: Signal) -> Result<()> {
+        kill(Pid::from_raw(self.pid as i32), signal)
+            .with_context(|| format!("send {} to process {}", signal.as_str(), self.pid))?;
+        termios::tcflush(self.slave.as_raw_fd(), FlushArg::TCIFLUSH).context("flush input")?;
+        let echo = if signal == Signal::SIGINT {
+            "^C\n"
+        } else {
+            "^\\\n"
+        };
+        self.output(echo.as_bytes()).await
+    }
+
+    /// Write the output of the process to the terminal.
+    pub async fn output(&self, data: &[u8]) -> Result<()> {
+        Self::write_all(&self.slave, data).await
+    }
+
+    /// Read the data to be sent to the attach session.
+    pub async fn read_output(&self, buf: &mut [u8]) -> Result<usize> {
+        Self::read(&self.master, buf).await
+    }
+
+    /// Read the completed input lines for the process. Returns zero if the
+    /// end of file character has been received.
+    pub async fn read_input(&self, buf: &mut [u8]) -> Result<usize> {
+        Self::read(&self.slave, buf).await
+    }
+
+    async fn read(fd: &AsyncFd<File>, buf: &mut [u8]) -> Result<usize> {
+        loop {
+            let mut guard = fd.readable().await.context("wait for pty")?;
+            match guard.try_io(|x| x.get_ref().read(buf)) {
+                Ok(res) => return res.context("read pty"),
+                Err(_would_block) => continue,
+            }
+        }
+    }
+
+    async fn write_all(fd: &AsyncFd<File>, mut data: &[u8]) -> Result<()> {
+        while !data.is_empty() {
+            let mut guard = fd.writable().await.context("wait for pty")?;
+            match guard.try_io(|x| x.get_ref().write(data)) {
+                Ok(res) => data = &data[res.context("write pty")?..],
+                Err(_would_block) => continue,
+            }
+        }
+        Ok(())
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use nix::sys::wait::{waitpid, WaitStatus};
+    use std::process::Command;
+    use tokio::time::{timeout, Duration};
+
+    async fn read_output(sut: &PtyShim) -> Result<String> {
+        let mut buf = vec![0; 1024];
+        let n = timeout(Duration::from_secs(5), sut.read_output(&mut buf)).await??;
+        Ok(String::from_utf8_lossy(&buf[..n]).into())
+    }
+
+    #[tokio::test]
+    async fn line_editing() -> Result<()> {
+        let sut = PtyShim::new(0)?;
+        sut.input(b"helo\x7flo\r").await?;
+
+        let mut buf = vec![0; 1024];
+        let n = timeout(Duration::from_secs(5), sut.read_input(&mut buf)).await??;
+        assert_eq!(&buf[..n], b"hello\n");
+
+        let mut echo = String::new();
+        while !echo.ends_with("\r\n") {
+            echo.push_str(&read_output(&sut).await?);
+        }
+        assert!(echo.starts_with("helo"));
+
+        sut.output(b"output\n").await?;
+        assert_eq!(read_output(&sut).await?, "output\r\n");
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn signals() -> Result<()> {
+        let child = Command::new("sleep").arg("10").spawn()?;
+        let sut = PtyShim::new(child.id())?;
+
+        sut.input(b"discarded\x03").await?;
+        let mut output = String::new();
+        while !output.ends_with("^C\r\n") {
+            output.push_str(&read_output(&sut).await?);
+        }
+
+        sut.input(b"line\r").await?;
+        let mut buf = vec![0; 1024];
+        let n = timeout(Duration::from_secs(5), sut.read_input(&mut buf)).await??;
+        assert_eq!(&buf[..n], b"line\n");
+
+        let pid = Pid::from_raw(child.id() as i32);
+        assert_eq!(
+            waitpid(pid, None)?,
+            WaitStatus::Signaled(pid, Signal::SIGINT, false)
+        );
+        Ok(())
+    }
+}
@@ -0,0 +1,287 @@
+//! Usage threshold watching for paths inside of running containers.
+use anyhow::{bail, Context, Result};
+use conmon_common::conmon_capnp::conmon::quota_watcher;
+use std::{
+    collections::HashSet,
+    ffi::CString,
+    fs::{self, File},
+    io, mem,
+    os::unix::{
+        ffi::OsStrExt,
+        fs::MetadataExt,
+        io::{AsRawFd, FromRawFd, RawFd},
+    },
+    path::{Component, Path, PathBuf},
+    time::Duration,
+};
+use tokio::{task, time};
+use tokio_util::sync::CancellationToken;
+use tracing::{debug, debug_span, Instrument};
+
+/// The default interval between two usage measurements.
+const DEFAULT_INTERVAL: Duration = Duration::from_secs(10);
+
+/// The size of a block as reported by `stat(2)`.
+const BLOCK_SIZE: u64 = 512;
+
+/// Do not resolve magic links like `/proc/self/fd/*`, see `openat2(2)`.
+const RESOLVE_NO_MAGICLINKS: u64 = 0x02;
+
+/// Treat the directory as the root directory during resolution, see `openat2(2)`.
+const RESOLVE_IN_ROOT: u64 = 0x10;
+
+#[repr(C)]
+/// The `struct open_how` argument of `openat2(2)`.
+struct OpenHow {
+    flags: u64,
+    mode: u64,
+    resolve: u64,
+}
+
+/// Watches the usage of a path inside of a container and notifies a client if
+/// it crosses any of the thresholds.
+pub struct QuotaWatcher {
+    pid: u32,
+    path: String,
+    relative_path: PathBuf,
+    thresholds: Vec<u64>,
+    interval: Duration,
+    token: CancellationToken,
+    watcher: quota_watcher::Client,
+}
+
+impl QuotaWatcher {
+    /// Create a new quota watcher for the `path` inside of the container
+    /// process `pid`. The watcher stops if the `token` gets cancelled.
+    pub fn new(
+        pid: u32,
+        path: &str,
+        thresholds: Vec<u64>,
+        interval: u64,
+        token: CancellationToken,
+        watcher: quota_watcher::Client,
+    ) -> Result<Self> {
+        if thresholds.is_empty() {
+            bail!("no thresholds provided")
+        }
+        Ok(Self {
+            pid,
+            path: path.into(),
+            relative_path: Self::relative_path(path)?,
+            thresholds,
+            interval: if interval > 0 {
+                Duration::from_secs(interval)
+            } else {
+                DEFAULT_INTERVAL
+            },
+            token,
+            watcher,
+        })
+    }
+
+    /// Validate the container `path` and return it relative to the root of
+    /// the container.
+    fn relative_path(path: &str) -> Result<PathBuf> {
+        let path = Path::new(path);
+        if !path.is_absolute() {
+            bail!("path {} is not absolute", path.display())
+        }
+        if path.components().any(|x| x == Component::ParentDir) {
+            bail!(
+                "path {} must not contain parent directories",
+                path.display()
+            )
+        }
+        Ok(path.strip_prefix("/").context("strip root prefix")?.into())
+    }
+
+    /// Run the watcher on the local task set.
+    pub fn spawn(self) {
+        let span = debug_span!("quota_watcher", path = self.path.as_str());
+        task::spawn_local(
+            async move {
+                let watcher = self.watcher.clone();
+                let mut request = watcher.done_request();
+                if let Err(e) = self.run().await {
+                    debug!("Stopping quota watcher: {:#}", e);
+                    request.get().set_error(&format!("{:#}", e));
+                }
+                // The client is likely gone if this fails.
+                if let Err(e) = request.send().promise.await {
+                    debug!("Unable to notify quota watcher: {}", e);
+                }
+            }
+            .instrument(span),
+        );
+    }
+
+    async fn run(self) -> Result<()> {
+        let mut exceeded = vec![false; self.thresholds.len()];
+        let mut interval = time::interval(self.interval);
+
+        loop {
+            tokio::select! {
+                _ = self.token.cancelled() => return Ok(()),
+                _ = interval.tick() => {}
+            }
+
+            let root = PathBuf::from(format!("/proc/{}/root", self.pid));
+            let path = self.relative_path.clone();
+            let usage = task::spawn_blocking(move || {
+                // Symbolic links have to be resolved inside of the container
+                // and not on the host.
+                let file = open_in_root(&root, &path)?;
+                disk_usage(Path::new(&format!("/proc/self/fd/{}", file.as_raw_fd())))
+            })
+            .await??;
+            debug!("Usage is {} bytes", usage);
+
+            for (threshold, exceeded) in self.thresholds.iter().zip(exceeded.iter_mut()) {
+                let now_exceeded = usage >= *threshold;
+                if now_exceeded == *exceeded {
+                    continue;
+                }
+                *exceeded = now_exceeded;
+                self.send(*threshold, usage, now_exceeded).await?;
+            }
+        }
+    }
+
+    async fn send(&self, threshold: u64, usage: u64, exceeded: bool) -> Result<()> {
+        let mut request = self.watcher.event_request();
+        let mut event = request.get().init_event();
+        event.set_path(&self.path);
+        event.set_threshold_bytes(threshold);
+        event.set_usage_bytes(usage);
+        event.set_exceeded(exceeded);
+        request.send().promise.await.context("send quota event")?;
+        Ok(())
+    }
+}
+
+/// Open `path` by resolving it as if `root` would be the root directory, which
+/// means that symbolic links cannot escape it.
+fn open_in_root(root: &Path, path: &Path) -> Result<File> {
+    let root = File::open(root).with_context(|| format!("open {}", root.display()))?;
+    let c_path = CString::new(path.as_os_str().as_bytes()).context("convert path")?;
+    let how = OpenHow {
+        flags: (libc::O_PATH | libc::O_CLOEXEC) as u64,
+        mode: 0,
+        resolve: RESOLVE_IN_ROOT | RESOLVE_NO_MAGICLINKS,
+    };
+    let fd = unsafe {
+        libc::syscall(
+            libc::SYS_openat2,
+            root.as_raw_fd(),
+            c_path.as_ptr(),
+            &how as *const OpenHow,
+            mem::size_of::<OpenHow>(),
+        )
+    };
+    if fd < 0 {
+        return Err(io::Error::last_os_error())
+            .with_context(|| format!("open {} in root", path.display()));
+    }
+    Ok(unsafe { File::from_raw_fd(fd as RawFd) })
+}
+
+/// Calculate the allocated disk space of `path` in bytes, similar to `du`.
+/// Symbolic links below `path` are not followed and hard links are only
+/// counted once.
+fn disk_usage(path: &Path) -> Result<u64> {
+    let metadata = fs::metadata(path).with_context(|| format!("stat {}", path.display()))?;
+    let mut seen = HashSet::new();
+    let mut usage = 0;
+    let mut entries = vec![(path.to_path_buf(), metadata)];
+
+    while let Some((path, metadata)) = entries.pop() {
+        if metadata.nlink() > 1
+            && !metadata.is_dir()
+            && !seen.insert((metadata.dev(), metadata.ino()))
+        {
+            continue;
+        }
+        usage += metadata.blocks() * BLOCK_SIZE;
+
+        if metadata.is_dir() {
+            let dir = match fs::read_dir(&path) {
+                Ok(dir) => dir,
+                // The entry got removed in the meantime.
+                Err(e) if e.kind() == io::ErrorKind::NotFound => continue,
+                Err(e) => return Err(e).with_context(|| format!("read dir {}", path.display())),
+            };
+            for entry in dir.flatten() {
+                match entry.metadata() {
+                    Ok(metadata) => entries.push((entry.path(), metadata)),
+                    Err(e) if e.kind() == io::ErrorKind::NotFound => continue,
+                    Err(e) => {
+                        return Err(e).with_context(|| format!("stat {}", entry.path().display()))
+                    }
+                }
+            }
+        }
+    }
+
+    Ok(usage)
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use tempfile::tempdir;
+
+    struct Watcher;
+
+    impl quota_watcher::Server for Watcher {}
+
+    #[test]
+    fn new_failure_no_thresholds() {
+        let client: quota_watcher::Client = capnp_rpc::new_client(Watcher);
+        assert!(
+            QuotaWatcher::new(1, "/data", vec![], 0, CancellationToken::new(), client).is_err()
+        );
+    }
+
+    #[test]
+    fn relative_path_success() {
+        assert_eq!(
+            QuotaWatcher::relative_path("/data/volume").unwrap(),
+            PathBuf::from("data/volume")
+        );
+    }
+
+    #[test]
+    fn relative_path_failure() {
+        assert!(QuotaWatcher::relative_path("data").is_err());
+        assert!(QuotaWatcher::relative_path("/data/../etc").is_err());
+    }
+
+    #[test]
+    fn open_in_root_success() -> Result<()> {
+        let dir = tempdir()?;
+        fs::create_dir(dir.path().join("data"))?;
+        std::os::unix::fs::symlink("/", dir.path().join("link"))?;
+
+        let file = open_in_root(dir.path(), Path::new("link/data"))?;
+        assert_eq!(
+            file.metadata()?.ino(),
+            fs::metadata(dir.path().join("data"))?.ino()
+        );
+        Ok(())
+    }
+
+    #[test]
+    fn disk_usage_success() -> Result<()> {
+        let dir = tempdir()?;
+        let empty = disk_usage(dir.path())?;
+
+        fs::create_dir(dir.path().join("sub"))?;
+        fs::write(dir.path().join("sub").join("file"), vec![1; 64 * 1024])?;
+        fs::hard_link(dir.path().join("sub").join("file"), dir.path().join("link"))?;
+
+        let usage = disk_usage(dir.path())?;
+        assert!(usage >= empty + 64 * 1024);
+        assert!(usage < empty + 2 * 64 * 1024);
+        Ok(())
+    }
+}
@@ -0,0 +1,266 @@
+//! Classification of runtime failures into machine readable reasons with
+//! remediation hints, mainly for rootless setups.
+use getset::{CopyGetters, Getters};
+use lazy_static::lazy_static;
+use regex::Regex;
+use std::{fs, path::Path};
+
+/// The file name of the runtime log within the bundle.
+pub const RUNTIME_LOG: &str = "runtime.log";
+
+/// The maximum size of the debug log returned to the client, which keeps the
+/// end of longer logs.
+const MAX_RUNTIME_DEBUG_LOG: usize = 64 * 1024;
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// The reason why the runtime rejected a request.
+pub enum Reason {
+    /// A cgroup controller is not delegated to the user.
+    CgroupControllerNotDelegated,
+
+    /// The user namespace ID mappings cannot be set up.
+    IdMappingUnavailable,
+
+    /// The process of the container cannot be executed.
+    ExecutableNotFound,
+
+    /// The bundle does not contain a valid configuration.
+    BundleInvalid,
+
+    /// The runtime lacks the permission for an operation.
+    PermissionDenied,
+}
+
+#[derive(Clone, CopyGetters, Debug, Eq, Getters, PartialEq)]
+/// A classified runtime failure.
+pub struct Rejection {
+    #[getset(get_copy = "pub")]
+    reason: Reason,
+
+    #[getset(get = "pub")]
+    /// Human readable remediation of the failure.
+    hint: String,
+}
+
+lazy_static! {
+    static ref CONTROLLER_NOT_AVAILABLE: Regex =
+        Regex::new(r#"controllers? [`'"]?(\w+)[`'"]? (?:is )?not (?:available|delegated|enabled)"#)
+            .unwrap();
+    static ref CONTROLLER_FILE_MISSING: Regex =
+        Regex::new(r"/(\w+)\.\w+: (?:no such file or directory|permission denied)").unwrap();
+    static ref ID_MAPPING: Regex =
+        Regex::new(r"newuidmap|newgidmap|/etc/sub[ug]id|[ug]id_map").unwrap();
+    static ref EXECUTABLE_NOT_FOUND: Regex = Regex::new(
+        r#"executable file not found|exec(?:ve)?:? "?[^":]*"?: (?:stat [^:]*: )?no such file"#
+    )
+    .unwrap();
+    static ref BUNDLE_INVALID: Regex = Regex::new(r"config\.json").unwrap();
+    static ref PERMISSION_DENIED: Regex =
+        Regex::new(r"(?i)permission denied|operation not permitted").unwrap();
+    static ref LOG_MESSAGE: Regex = Regex::new(r#""msg"\s*:\s*"((?:[^"\\]|\\.)*)""#).unwrap();
+    static ref LOG_LEVEL: Regex = Regex::new(r#""level"\s*:\s*"(?:error|fatal|panic)""#).unwrap();
+}
+
+/// The cgroup controllers, which distinguish controller interface files from
+/// other missing files.
+const CONTROLLERS: &[&str] = &["cpu", "cpuset", "hugetlb", "io", "memory", "pids", "rdma"];
+
+impl Rejection {
+    fn new(reason: Reason, hint: String) -> Self {
+        Self { reason, hint }
+    }
+
+    /// Classify the error output of the runtime, `None` if the failure is
+    /// unknown.
+    pub fn classify(output: &str) -> Option<Self> {
+        let controller = CONTROLLER_NOT_AVAILABLE
+            .captures(output)
+            .or_else(|| CONTROLLER_FILE_MISSING.captures(output))
+            .map(|x| x[1].to_string())
+            .filter(|x| CONTROLLERS.contains(&x.as_str()));
+        if let Some(controller) = controller {
+            return Some(Self::new(
+                Reason::CgroupControllerNotDelegated,
+                format!(
+                    "cgroup controller '{}' not delegated — enable delegation in user@.service",
+                    controller
+                ),
+            ));
+        }
+        if ID_MAPPING.is_match(output) {
+            return Some(Self::new(
+                Reason::IdMappingUnavailable,
+                "configure the ranges of the user in /etc/subuid and /etc/subgid and install \
+                 newuidmap and newgidmap"
+                    .into(),
+            ));
+        }
+        if EXECUTABLE_NOT_FOUND.is_match(output) {
+            return Some(Self::new(
+                Reason::ExecutableNotFound,
+                "the process args do not refer to an executable within the root filesystem".into(),
+            ));
+        }
+        if BUNDLE_INVALID.is_match(output) {
+            return Some(Self::new(
+                Reason::BundleInvalid,
+                "the bundle path has to contain a valid OCI config.json".into(),
+            ));
+        }
+        if PERMISSION_DENIED.is_match(output) {
+            return Some(Self::new(
+                Reason::PermissionDenied,
+                "check the ownership, mount options and security labels of the bundle and root \
+                 filesystem"
+                    .into(),
+            ));
+        }
+        None
+    }
+}
+
+/// Retrieve the error messages of the JSON formatted runtime log, or its
+/// whole content if it contains no JSON entries. The result is empty if the
+/// log cannot be read.
+pub fn runtime_log_errors(path: &Path) -> String {
+    let content = match fs::read_to_string(path) {
+        Ok(content) => content,
+        Err(_) => return String::new(),
+    };
+    let errors: Vec<_> = content
+        .lines()
+        .filter(|line| LOG_LEVEL.is_match(line))
+        .filter_map(|line| LOG_MESSAGE.captures(line))
+        .map(|x| unescape(&x[1]))
+        .collect();
+    if errors.is_empty() && !content.contains("\"msg\"") {
+        return content.trim().into();
+    }
+    errors.join("\n")
+}
+
+/// Retrieve the runtime log written with the `--debug` flag, which is limited
+/// to its last lines of up to 64 KiB. The result is empty if the log cannot be
+/// read.
+pub fn runtime_debug_log(path: &Path) -> String {
+    let content = match fs::read(path) {
+        Ok(content) => content,
+        Err(_) => return String::new(),
+    };
+    let mut start = content.len().saturating_sub(MAX_RUNTIME_DEBUG_LOG);
+    if start > 0 {
+        // Do not return a partial first line.
+        start += content[start..]
+            .iter()
+            .position(|&x| x == b'\n')
+            .map_or(0, |x| x + 1);
+    }
+    String::from_utf8_lossy(&content[start..]).trim().into()
+}
+
+/// Unescape the quotes, backslashes and newlines of a JSON string.
+fn unescape(s: &str) -> String {
+    let mut result = String::with_capacity(s.len());
+    let mut chars = s.chars();
+    while let Some(c) = chars.next() {
+        if c != '\\' {
+            result.push(c);
+            continue;
+        }
+        match chars.next() {
+            Some('n') => result.push('\n'),
+            Some('t') => result.push('\t'),
+            Some(c) => result.push(c),
+            None => {}
+        }
+    }
+    result
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use tempfile::tempdir;
+
+    #[test]
+    fn classify_success() {
+        for (output, reason) in [
+            (
+                "the requested cgroup controller `pids` is not available",
+                Reason::CgroupControllerNotDelegated,
+            ),
+            (
+                "open /sys/fs/cgroup/user.slice/pids.max: no such file or directory",
+                Reason::CgroupControllerNotDelegated,
+            ),
+            (
+                "newuidmap: write to uid_map failed: Operation not permitted",
+                Reason::IdMappingUnavailable,
+            ),
+            (
+                "exec: \"/bin/missing\": stat /bin/missing: no such file or directory",
+                Reason::ExecutableNotFound,
+            ),
+            (
+                "executable file not found in $PATH",
+                Reason::ExecutableNotFound,
+            ),
+            (
+                "open config.json: no such file or directory",
+                Reason::BundleInvalid,
+            ),
+            ("mount proc: Permission denied", Reason::PermissionDenied),
+        ] {
+            let rejection = Rejection::classify(output).unwrap();
+            assert_eq!(rejection.reason(), reason, "{}", output);
+        }
+
+        let rejection =
+            Rejection::classify("the requested cgroup controller `pids` is not available").unwrap();
+        assert!(rejection.hint().contains("'pids'"));
+        assert!(Rejection::classify("unknown failure").is_none());
+        assert!(Rejection::classify("/tmp/other.file: no such file or directory").is_none());
+    }
+
+    #[test]
+    fn runtime_log_errors_json() -> anyhow::Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join(RUNTIME_LOG);
+        assert_eq!(runtime_log_errors(&path), "");
+
+        fs::write(
+            &path,
+            concat!(
+                r#"{"level":"warning","msg":"ignored","time":"2022-01-01T00:00:00Z"}"#,
+                "\n",
+                r#"{"level":"error","msg":"container_linux.go: \"pids\" failed","time":"2022-01-01T00:00:00Z"}"#,
+                "\n",
+            ),
+        )?;
+        assert_eq!(
+            runtime_log_errors(&path),
+            "container_linux.go: \"pids\" failed"
+        );
+
+        fs::write(&path, "plain error\n")?;
+        assert_eq!(runtime_log_errors(&path), "plain error");
+        Ok(())
+    }
+
+    #[test]
+    fn runtime_debug_log_truncated() -> anyhow::Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join(RUNTIME_LOG);
+        assert_eq!(runtime_debug_log(&path), "");
+
+        fs::write(&path, "first\nsecond\n")?;
+        assert_eq!(runtime_debug_log(&path), "first\nsecond");
+
+        let line = format!("{}\n", "x".repeat(99));
+        fs::write(&path, line.repeat(1000))?;
+        let log = runtime_debug_log(&path);
+        assert!(log.len() <= MAX_RUNTIME_DEBUG_LOG);
+        assert!(log.lines().all(|x| x.len() == 99));
+        Ok(())
+    }
+}
@@ -0,0 +1,252 @@
+//! Deadlines and cancellation of in-flight requests, which abort the work of
+//! the server instead of only the waiting of the client.
+use crate::rpc_error::RpcError;
+use anyhow::{format_err, Result};
+use conmon_common::conmon_capnp::conmon::request_scope;
+use std::{
+    collections::HashMap,
+    future::{self, Future},
+    sync::{Arc, Mutex, MutexGuard},
+    time::{Duration, SystemTime, UNIX_EPOCH},
+};
+use tokio::time;
+use tokio_util::sync::CancellationToken;
+use tracing::debug;
+
+type RequestKey = (String, String);
+
+#[derive(Clone, Debug, Default)]
+/// The cancellable in-flight requests of all tenants.
+pub struct Requests(Arc<Mutex<HashMap<RequestKey, CancellationToken>>>);
+
+#[derive(Clone, Debug, Default, Eq, PartialEq)]
+/// The scope of a single request.
+pub struct RequestScope {
+    id: String,
+    deadline: Option<SystemTime>,
+}
+
+impl RequestScope {
+    /// Create a new scope from the request field, which has neither an ID
+    /// nor a deadline if not set.
+    pub fn new(reader: request_scope::Reader) -> capnp::Result<Self> {
+        let deadline = match reader.get_deadline_unix_nano() {
+            x if x > 0 => Some(UNIX_EPOCH + Duration::from_nanos(x as u64)),
+            _ => None,
+        };
+        Ok(Self {
+            id: reader.get_request_id()?.into(),
+            deadline,
+        })
+    }
+
+    /// The time left until the deadline, if any.
+    fn remaining(&self) -> Option<Duration> {
+        self.deadline.map(|deadline| {
+            deadline
+                .duration_since(SystemTime::now())
+                .unwrap_or_default()
+        })
+    }
+}
+
+impl Requests {
+    /// Run the work of a request until it completes, the client cancels it or
+    /// its deadline expires. The work gets dropped in the latter cases, which
+    /// is where it has to clean up, see `AbortGuard`. Requests without an ID
+    /// can not be cancelled but still expire.
+    pub async fn run<F, T>(&self, tenant: &str, scope: &RequestScope, work: F) -> Result<T>
+    where
+        F: Future<Output = Result<T>>,
+    {
+        let token = CancellationToken::new();
+        let _registration = if scope.id.is_empty() {
+            None
+        } else {
+            Some(self.register(tenant, &scope.id, token.clone())?)
+        };
+        let remaining = scope.remaining();
+
+        tokio::select! {
+            result = work => result,
+            _ = token.cancelled() => {
+                debug!("Request {} cancelled by the client", scope.id);
+                Err(RpcError::cancelled(format!("request {} cancelled", scope.id)).into())
+            }
+            _ = expired(remaining) => {
+                debug!("Deadline of request {} expired", scope.id);
+                Err(RpcError::cancelled(format!("deadline of request {} exceeded", scope.id)).into())
+            }
+        }
+    }
+
+    /// Cancel the in-flight request of the tenant. Returns false if there is
+    /// no such request.
+    pub fn cancel(&self, tenant: &str, id: &str) -> Result<bool> {
+        let requests = self.lock()?;
+        match requests.get(&(tenant.into(), id.into())) {
+            Some(token) => {
+                token.cancel();
+                Ok(true)
+            }
+            None => Ok(false),
+        }
+    }
+
+    fn register(&self, tenant: &str, id: &str, token: CancellationToken) -> Result<Registration> {
+        let key = (tenant.to_string(), id.to_string());
+        let mut requests = self.lock()?;
+        if requests.contains_key(&key) {
+            return Err(
+                RpcError::already_exists(format!("request {} is already in flight", id)).into(),
+            );
+        }
+        requests.insert(key.clone(), token);
+        Ok(Registration {
+            requests: self.clone(),
+            key,
+        })
+    }
+
+    fn lock(&self) -> Result<MutexGuard<HashMap<RequestKey, CancellationToken>>> {
+        self.0
+            .lock()
+            .map_err(|e| format_err!("lock requests: {}", e))
+    }
+}
+
+/// Removes a request once it is no longer in flight.
+struct Registration {
+    requests: Requests,
+    key: RequestKey,
+}
+
+impl Drop for Registration {
+    fn drop(&mut self) {
+        if let Ok(mut requests) = self.requests.lock() {
+            requests.remove(&self.key);
+        }
+    }
+}
+
+/// Wait until the remaining time elapsed, or forever if there is no deadline.
+async fn expired(remaining: Option<Duration>) {
+    match remaining {
+        Some(remaining) => time::sleep(remaining).await,
+        None => future::pending().await,
+    }
+}
+
+/// Runs the cleanup of aborted work when it gets dropped before being
+/// disarmed.
+pub struct AbortGuard<F: FnOnce()> {
+    cleanup: Option<F>,
+}
+
+impl<F: FnOnce()> AbortGuard<F> {
+    /// Create a new armed guard.
+    pub fn new(cleanup: F) -> Self {
+        Self {
+            cleanup: Some(cleanup),
+        }
+    }
+
+    /// Disarm the guard once the work completed.
+    pub fn disarm(mut self) {
+        self.cleanup = None;
+    }
+}
+
+impl<F: FnOnce()> Drop for AbortGuard<F> {
+    fn drop(&mut self) {
+        if let Some(cleanup) = self.cleanup.take() {
+            cleanup();
+        }
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::sync::atomic::{AtomicBool, Ordering};
+
+    fn scope(id: &str, deadline: Option<SystemTime>) -> RequestScope {
+        RequestScope {
+            id: id.into(),
+            deadline,
+        }
+    }
+
+    #[tokio::test]
+    async fn run_to_completion() -> Result<()> {
+        let requests = Requests::default();
+        let result = requests
+            .run("", &scope("a", None), async { Ok(42) })
+            .await?;
+        assert_eq!(result, 42);
+        assert!(!requests.cancel("", "a")?);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn cancel_in_flight() -> Result<()> {
+        let requests = Requests::default();
+        let cleaned_up = Arc::new(AtomicBool::new(false));
+        let cleaned_up_clone = cleaned_up.clone();
+        let run = requests.run("tenant", &scope("a", None), async move {
+            let _guard = AbortGuard::new(move || cleaned_up_clone.store(true, Ordering::SeqCst));
+            future::pending::<Result<()>>().await
+        });
+        let cancel = async {
+            time::sleep(Duration::from_millis(10)).await;
+            assert!(!requests.cancel("other", "a")?);
+            requests.cancel("tenant", "a")
+        };
+
+        let (result, cancelled) = tokio::join!(run, cancel);
+        assert!(cancelled?);
+        let err = result.unwrap_err();
+        assert!(err.chain().any(|x| x.downcast_ref::<RpcError>().is_some()));
+        assert!(cleaned_up.load(Ordering::SeqCst));
+        assert!(!requests.cancel("tenant", "a")?);
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn deadline_expired() -> Result<()> {
+        let requests = Requests::default();
+        let deadline = SystemTime::now() + Duration::from_millis(10);
+        let result = requests
+            .run(
+                "",
+                &scope("", Some(deadline)),
+                future::pending::<Result<()>>(),
+            )
+            .await;
+        assert!(format!("{:#}", result.unwrap_err()).contains("deadline"));
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn duplicate_id() -> Result<()> {
+        let requests = Requests::default();
+        let first = requests.run("", &scope("a", None), future::pending::<Result<()>>());
+        let second = async {
+            time::sleep(Duration::from_millis(10)).await;
+            let result = requests.run("", &scope("a", None), async { Ok(()) }).await;
+            assert!(result.is_err());
+            requests.cancel("", "a")
+        };
+
+        let (_, cancelled) = tokio::join!(first, second);
+        assert!(cancelled?);
+        Ok(())
+    }
+
+    #[test]
+    fn disarmed_guard() {
+        let cleaned_up = AtomicBool::new(false);
+        AbortGuard::new(|| cleaned_up.store(true, Ordering::SeqCst)).disarm();
+        assert!(!cleaned_up.load(Ordering::SeqCst));
+    }
+}
@@ -0,0 +1,161 @@
+//! Updating the cgroup resources of running containers.
+use crate::stats;
+use anyhow::{bail, Context, Result};
+use std::{
+    fs,
+    path::{Path, PathBuf},
+};
+use tracing::debug;
+
+#[derive(Clone, Debug, Eq, PartialEq)]
+/// A value of a cgroup interface file.
+pub struct CgroupValue {
+    /// The cgroup v1 controller, empty for the unified hierarchy of cgroup v2.
+    controller: String,
+
+    /// The interface file, like `memory.max`.
+    file: String,
+
+    /// The value written to the file.
+    value: String,
+}
+
+impl CgroupValue {
+    /// Create a new cgroup value, failing if the file is not a resource
+    /// interface file of the controller.
+    pub fn new(controller: &str, file: &str, value: &str) -> Result<Self> {
+        if controller.contains(['/', ',']) || controller.starts_with("name=") {
+            bail!("invalid cgroup controller {}", controller)
+        }
+        // The core interface files, like `cgroup.procs`, must not be
+        // changed by clients.
+        if file.is_empty()
+            || file.contains('/')
+            || file.starts_with('.')
+            || file.starts_with("cgroup.")
+        {
+            bail!("invalid cgroup file {}", file)
+        }
+        if !controller.is_empty() && !file.starts_with(&format!("{}.", controller)) {
+            bail!("cgroup file {} does not belong to {}", file, controller)
+        }
+        Ok(Self {
+            controller: controller.into(),
+            file: file.into(),
+            value: value.into(),
+        })
+    }
+}
+
+/// Write the values to the cgroups of the process `pid`.
+pub fn update(pid: u32, values: &[CgroupValue]) -> Result<()> {
+    let path = format!("/proc/{}/cgroup", pid);
+    let cgroups = fs::read_to_string(&path).with_context(|| format!("read {}", path))?;
+    update_in(Path::new(stats::CGROUP_ROOT), &cgroups, values)
+}
+
+/// Write the values to the cgroups below the root, which are listed in the
+/// format of `/proc/PID/cgroup`.
+fn update_in(root: &Path, cgroups: &str, values: &[CgroupValue]) -> Result<()> {
+    let paths = values
+        .iter()
+        .map(|value| Ok(cgroup_dir(root, cgroups, &value.controller)?.join(&value.file)))
+        .collect::<Result<Vec<_>>>()?;
+
+    // Limits which depend on each other, like the cgroup v1 memory and swap
+    // limits, may only be written in a certain order. Failed writes get
+    // retried once after all others.
+    let mut failed = vec![];
+    for (path, value) in paths.iter().zip(values) {
+        if let Err(e) = fs::write(path, &value.value) {
+            debug!("Retrying to write {}: {}", path.display(), e);
+            failed.push((path, value));
+        }
+    }
+    for (path, value) in failed {
+        fs::write(path, &value.value)
+            .with_context(|| format!("write {} to {}", value.value, path.display()))?;
+    }
+    Ok(())
+}
+
+/// Find the directory of the cgroup of the controller.
+fn cgroup_dir(root: &Path, cgroups: &str, controller: &str) -> Result<PathBuf> {
+    for (controllers, cgroup) in cgroups.lines().filter_map(stats::parse_cgroup_line) {
+        let cgroup = cgroup.trim_start_matches('/');
+        if controller.is_empty() && controllers.is_empty() {
+            return Ok(root.join(cgroup));
+        }
+        if !controller.is_empty() && controllers.split(',').any(|x| x == controller) {
+            return Ok(root.join(controller).join(cgroup));
+        }
+    }
+    match controller {
+        "" => bail!("container is not in a cgroup v2 hierarchy"),
+        x => bail!("container has no {} cgroup", x),
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use tempfile::tempdir;
+
+    #[test]
+    fn cgroup_value_new_success() {
+        assert!(CgroupValue::new("", "memory.max", "max").is_ok());
+        assert!(CgroupValue::new("memory", "memory.limit_in_bytes", "1").is_ok());
+    }
+
+    #[test]
+    fn cgroup_value_new_failure() {
+        assert!(CgroupValue::new("", "cgroup.procs", "1").is_err());
+        assert!(CgroupValue::new("", "../memory.max", "1").is_err());
+        assert!(CgroupValue::new("", "", "1").is_err());
+        assert!(CgroupValue::new("memory", "cpu.shares", "1").is_err());
+        assert!(CgroupValue::new("cpu,cpuacct", "cpu.shares", "1").is_err());
+        assert!(CgroupValue::new("name=systemd", "name=systemd.x", "1").is_err());
+    }
+
+    #[test]
+    fn cgroup_dir_success() -> Result<()> {
+        let cgroups = "4:cpu,cpuacct:/ctr\n1:name=systemd:/ctr\n0::/unified\n";
+        let root = Path::new("/cg");
+        assert_eq!(cgroup_dir(root, cgroups, "")?, Path::new("/cg/unified"));
+        assert_eq!(cgroup_dir(root, cgroups, "cpu")?, Path::new("/cg/cpu/ctr"));
+        assert!(cgroup_dir(root, cgroups, "memory").is_err());
+        assert!(cgroup_dir(root, "4:cpu:/ctr\n", "").is_err());
+        Ok(())
+    }
+
+    #[test]
+    fn update_in_success() -> Result<()> {
+        let root = tempdir()?;
+        let dir = root.path().join("ctr");
+        fs::create_dir(&dir)?;
+        let values = [
+            CgroupValue::new("", "memory.max", "1024")?,
+            CgroupValue::new("", "cpu.weight", "100")?,
+        ];
+        update_in(root.path(), "0::/ctr\n", &values)?;
+        assert_eq!(fs::read_to_string(dir.join("memory.max"))?, "1024");
+        assert_eq!(fs::read_to_string(dir.join("cpu.weight"))?, "100");
+        Ok(())
+    }
+
+    #[test]
+    fn update_in_failure() -> Result<()> {
+        let root = tempdir()?;
+        let dir = root.path().join("ctr");
+        fs::create_dir_all(dir.join("memory.max"))?;
+        let values = [
+            CgroupValue::new("", "memory.max", "1024")?,
+            CgroupValue::new("", "cpu.weight", "100")?,
+        ];
+        assert!(update_in(root.path(), "0::/ctr\n", &values).is_err());
+
+        // The failed write does not prevent the others.
+        assert_eq!(fs::read_to_string(dir.join("cpu.weight"))?, "100");
+        Ok(())
+    }
+}
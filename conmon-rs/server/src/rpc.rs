@@ -1,23 +1,69 @@
 use crate::{
-    attach::Attach,
+    attach::SessionPolicy,
+    attach_mux::SessionControl,
+    checkpoint::{self, CheckpointOptions, ImageUpload},
     child::Child,
+    child_reaper::{kill_grandchild, ReapableChild},
+    container_events::{ContainerEvent, ContainerEvents},
     container_io::{ContainerIO, SharedContainerIO},
     container_log::ContainerLog,
+    crash_report,
+    exec_cache::{ExecCacheKey, ExecResult},
+    fd_mapping::MappedFds,
+    health,
+    io_user::IoUser,
+    labels,
+    log_filter::{LogFilterConfig, RestartPolicy},
+    log_reader::LogReader,
+    mount_watcher::MountWatcher,
+    network_stats::NetworkStats,
+    operation_locks::Operation,
+    port_forward::{self, PortForward},
+    quota_watcher::QuotaWatcher,
+    rejection::{runtime_debug_log, runtime_log_errors, RUNTIME_LOG},
+    request_scope::{AbortGuard, RequestScope},
+    resources::{self, CgroupValue},
+    rpc_error::RpcError,
+    runtime_options::RuntimeOptions,
+    scratch_dirs::ScratchDirs,
+    seccomp_notify::SeccompListener,
     server::Server,
+    stats::Stats,
+    sysctl::{self, Rejection, Sysctl},
+    tenant_quota::Resource,
+    tombstones::Tombstone,
+    trace_context::TraceContext,
     version::Version,
+    watchdog::Policy,
 };
-use anyhow::Context;
+use anyhow::{bail, Context};
 use capnp::{capability::Promise, Error};
 use capnp_rpc::pry;
-use conmon_common::conmon_capnp::conmon;
+use conmon_common::conmon_capnp::conmon::{
+    self, create_container_request, create_progress, log_filter, set_watchdog_request,
+    sysctl_rejection::Reason,
+};
+use nix::{
+    sys::signal::{kill, Signal},
+    unistd::Pid,
+};
 use std::{
+    convert::TryFrom,
+    future::Future,
     path::{Path, PathBuf},
-    time::Duration,
+    time::{Duration, SystemTime, UNIX_EPOCH},
+};
+use tokio::{
+    task,
+    time::{self, Instant},
 };
-use tokio::time::Instant;
 use tracing::{debug, debug_span, error, Instrument};
 use uuid::Uuid;
 
+/// The maximum duration to wait for pending container output when flushing
+/// the logs.
+const FLUSH_LOGS_TIMEOUT: Duration = Duration::from_secs(10);
+
 macro_rules! pry_err {
     ($x:expr) => {
         pry!(capnp_err!($x))
@@ -30,14 +76,41 @@ macro_rules! capnp_err {
     };
 }
 
+/// Evaluate the result, reporting a failure in the structured error field of
+/// the response.
+macro_rules! pry_response {
+    ($results:expr, $x:expr) => {
+        match $x {
+            Ok(x) => x,
+            Err(e) => {
+                RpcError::write(&e, $results.get().init_response().init_error());
+                return Promise::ok(());
+            }
+        }
+    };
+}
+
 macro_rules! new_root_span {
-    ($name:expr, $container_id:expr) => {
+    ($name:expr, $container_id:expr) => {{
+        crash_report::record_rpc($name, $container_id);
         debug_span!(
             $name,
             container_id = $container_id,
             uuid = Uuid::new_v4().to_string().as_str()
         )
-    };
+    }};
+    ($name:expr, $container_id:expr, $trace_context:expr) => {{
+        crash_report::record_rpc($name, $container_id);
+        let trace_context = TraceContext::from_reader($trace_context);
+        debug_span!(
+            $name,
+            container_id = $container_id,
+            uuid = Uuid::new_v4().to_string().as_str(),
+            trace_id = trace_context.trace_id().as_str(),
+            parent_id = trace_context.parent_id().as_str(),
+            tracestate = trace_context.tracestate().as_str()
+        )
+    }};
 }
 
 impl conmon::Server for Server {
@@ -56,6 +129,26 @@ impl conmon::Server for Server {
         response.set_build_date(version.build_date());
         response.set_rust_version(version.rust_version());
         response.set_process_id(std::process::id());
+        response.set_protocol_version(version.protocol_version());
+        response.set_min_client_protocol_version(version.min_client_protocol_version());
+        Promise::ok(())
+    }
+
+    /// Report the resource usage of the server process.
+    fn health_check(
+        &mut self,
+        _: conmon::HealthCheckParams,
+        mut results: conmon::HealthCheckResults,
+    ) -> Promise<(), capnp::Error> {
+        debug!("Got a health check request");
+        let open_fds = pry_err!(health::open_fds());
+        let memory_rss_bytes = pry_err!(health::memory_rss_bytes());
+        let containers = pry_err!(self.containers()).len();
+
+        let mut response = results.get().init_response();
+        response.set_open_fds(open_fds);
+        response.set_memory_rss_bytes(memory_rss_bytes);
+        response.set_containers(containers as u32);
         Promise::ok(())
     }
 
@@ -66,53 +159,36 @@ impl conmon::Server for Server {
         mut results: conmon::CreateContainerResults,
     ) -> Promise<(), capnp::Error> {
         let req = pry!(pry!(params.get()).get_request());
-        let id = pry!(req.get_id()).to_string();
+        let id = pry!(req.get_id());
 
-        let span = new_root_span!("create_container", id.as_str());
+        let span = new_root_span!("create_container", id, req.get_trace_context());
         let _enter = span.enter();
 
         debug!("Got a create container request");
 
-        let log_drivers = pry!(req.get_log_drivers());
-        let container_log = pry_err!(ContainerLog::from(log_drivers));
-        let mut container_io =
-            pry_err!(ContainerIO::new(req.get_terminal(), container_log.clone()));
-
-        let bundle_path = Path::new(pry!(req.get_bundle_path()));
-        let pidfile = bundle_path.join("pidfile");
-        debug!("PID file is {}", pidfile.display());
-
-        let child_reaper = self.reaper().clone();
-        let args = pry_err!(self.generate_runtime_args(&id, bundle_path, &container_io, &pidfile));
-        let runtime = self.config().runtime().clone();
-        let exit_paths: Vec<PathBuf> = pry!(pry!(req.get_exit_paths())
-            .iter()
-            .map(|r| r.map(PathBuf::from))
-            .collect());
-        let oom_exit_paths: Vec<PathBuf> = pry!(pry!(req.get_oom_exit_paths())
-            .iter()
-            .map(|r| r.map(PathBuf::from))
-            .collect());
-
+        let scratch_paths = pry_response!(
+            results,
+            ScratchDirs::from_request(
+                Path::new(pry!(req.get_bundle_path())),
+                pry!(req.get_scratch_dirs())
+            )
+            .map(|dirs| dirs.paths())
+        );
+        let container_pid = pry_response!(results, self.create(req, None));
         Promise::from_future(
             async move {
-                capnp_err!(container_log.write().await.init().await)?;
-
-                let grandchild_pid = capnp_err!(
-                    child_reaper
-                        .create_child(runtime, args, &mut container_io, &pidfile)
-                        .await
-                )?;
-
-                // register grandchild with server
-                let io = SharedContainerIO::new(container_io);
-                let child = Child::new(id, grandchild_pid, exit_paths, oom_exit_paths, None, io);
-                capnp_err!(child_reaper.watch_grandchild(child))?;
-
-                results
-                    .get()
-                    .init_response()
-                    .set_container_pid(grandchild_pid);
+                let container_pid = container_pid.await;
+                let mut response = results.get().init_response();
+                match container_pid {
+                    Ok(pid) => {
+                        response.set_container_pid(pid);
+                        let mut paths = response.init_scratch_dir_paths(scratch_paths.len() as u32);
+                        for (i, path) in scratch_paths.iter().enumerate() {
+                            paths.set(i as u32, &path.to_string_lossy());
+                        }
+                    }
+                    Err(e) => RpcError::write(&e, response.init_error()),
+                }
                 Ok(())
             }
             .instrument(debug_span!("promise")),
@@ -128,6 +204,10 @@ impl conmon::Server for Server {
         let req = pry!(pry!(params.get()).get_request());
         let id = pry!(req.get_id()).to_string();
         let timeout = req.get_timeout_sec();
+        let max_output_size = match req.get_max_output_bytes() {
+            0 => None,
+            x => Some(x as usize),
+        };
 
         let pidfile = pry_err!(ContainerIO::temp_file_name(
             Some(self.config().runtime_dir()),
@@ -135,62 +215,161 @@ impl conmon::Server for Server {
             "pid"
         ));
 
-        let span = new_root_span!("exec_sync_container", id.as_str());
+        let span = new_root_span!("exec_sync_container", id.as_str(), req.get_trace_context());
         let _enter = span.enter();
 
         debug!("Got exec sync container request with timeout {}", timeout);
 
-        let runtime = self.config().runtime().clone();
+        // The debug log is only kept if the runtime fails.
+        let runtime_debug = req.get_runtime_debug();
+        let debug_log = if runtime_debug {
+            Some(pry_err!(ContainerIO::temp_file_name(
+                Some(self.config().runtime_dir()),
+                "exec_sync",
+                "log"
+            )))
+        } else {
+            None
+        };
+
+        let runtime_options = self.runtime_options(&id).with_debug(runtime_debug);
+        let runtime = runtime_options.runtime().clone();
         let child_reaper = self.reaper().clone();
+        let tenant = self.tenant().clone();
 
         let logger = ContainerLog::new();
         let mut container_io = pry_err!(ContainerIO::new(req.get_terminal(), logger));
 
+        let mapped_fds = pry_response!(
+            results,
+            MappedFds::from_mappings(pry!(req.get_fd_mappings()), self.fd_socket())
+        );
         let command = pry!(req.get_command());
-        let args = pry_err!(self.generate_exec_sync_args(&id, &pidfile, &container_io, &command));
+        let args = pry_err!(runtime_options.generate_exec_sync_args(
+            &id,
+            &pidfile,
+            &container_io,
+            &command,
+            debug_log.as_deref(),
+            mapped_fds.len()
+        ));
+
+        // Exec sessions can be attached to and are therefore never cached,
+        // like commands using passed file descriptors.
+        let exec_session_id = pry!(req.get_exec_session_id());
+        let cache_ttl = Duration::from_millis(req.get_cache_ttl_ms());
+        let cache_key =
+            if exec_session_id.is_empty() && mapped_fds.is_empty() && !cache_ttl.is_zero() {
+                let command: Vec<String> =
+                    pry!(command.iter().map(|r| r.map(String::from)).collect());
+                Some(ExecCacheKey::new(
+                    &tenant,
+                    &id,
+                    command,
+                    req.get_terminal(),
+                    req.get_max_output_bytes(),
+                ))
+            } else {
+                None
+            };
+        if let Some(key) = &cache_key {
+            if let Some((result, age)) = pry_err!(self.exec_cache().get(key, cache_ttl)) {
+                debug!("Using cached result of age {:?}", age);
+                result.write(results.get().init_response(), Some(age));
+                return Promise::ok(());
+            }
+        }
+        let exec_cache = self.exec_cache().clone();
+        let lock = pry_response!(results, self.lock_operation(&id, Operation::Exec));
+
+        let session_policy = self.exec_session_policy(&id);
+        let scope = pry!(RequestScope::new(pry!(req.get_scope())));
+        let requests = self.requests().clone();
+
+        // Exec sessions are registered separately to be able to attach to them.
+        let (child_id, reservation) = if exec_session_id.is_empty() {
+            (id, None)
+        } else {
+            debug!("Using exec session id {}", exec_session_id);
+            (
+                pry_err!(self.new_exec_session_id(&id, exec_session_id)),
+                Some(pry_err!(self.reserve_quota(Resource::ExecSessions))),
+            )
+        };
 
         Promise::from_future(
             async move {
-                match child_reaper
-                    .create_child(&runtime, &args, &mut container_io, &pidfile)
-                    .await
-                {
-                    Ok(grandchild_pid) => {
-                        let time_to_timeout = if timeout > 0 {
-                            Some(Instant::now() + Duration::from_secs(timeout))
-                        } else {
-                            None
-                        };
-                        let mut resp = results.get().init_response();
-                        // register grandchild with server
-                        let io = SharedContainerIO::new(container_io);
-                        let io_clone = io.clone();
-                        let child = Child::new(
-                            id,
-                            grandchild_pid,
-                            vec![],
-                            vec![],
-                            time_to_timeout,
-                            io_clone,
-                        );
-
-                        let mut exit_rx = capnp_err!(child_reaper.watch_grandchild(child))?;
-
-                        let (stdout, stderr, timed_out) =
-                            io.read_all_with_timeout(time_to_timeout).await;
-
-                        let exit_data = capnp_err!(exit_rx.recv().await)?;
-                        resp.set_stdout(&stdout);
-                        resp.set_stderr(&stderr);
-                        resp.set_exit_code(*exit_data.exit_code());
-                        if timed_out || exit_data.timed_out {
-                            resp.set_timed_out(true);
-                        }
+                let _reservation = reservation;
+                let _lock = lock;
+                container_io.attach().set_policy(session_policy.await).await;
+
+                let scope_tenant = tenant.clone();
+                let work = async move {
+                    let created = child_reaper
+                        .create_child(&runtime, &args, &mut container_io, &pidfile, &mapped_fds)
+                        .await
+                        .map_err(|e| {
+                            error!("Unable to create child: {:#}", e);
+                            let err = RpcError::runtime_failure(e);
+                            match &debug_log {
+                                Some(path) => err.with_runtime_log(runtime_debug_log(path)),
+                                None => err,
+                            }
+                        });
+                    if let Some(path) = &debug_log {
+                        let _ = std::fs::remove_file(path);
                     }
-                    Err(e) => {
-                        error!("Unable to create child: {:#}", e);
-                        let mut resp = results.get().init_response();
-                        resp.set_exit_code(-2);
+                    let grandchild_pid = created?;
+
+                    // The command must not outlive a cancelled request.
+                    let abort_guard =
+                        AbortGuard::new(move || kill_grandchild(grandchild_pid, Signal::SIGKILL));
+                    let time_to_timeout = if timeout > 0 {
+                        Some(Instant::now() + Duration::from_secs(timeout))
+                    } else {
+                        None
+                    };
+                    // register grandchild with server
+                    let io = SharedContainerIO::new(container_io);
+                    let io_clone = io.clone();
+                    let child = Child::new(
+                        child_id,
+                        grandchild_pid,
+                        vec![],
+                        vec![],
+                        time_to_timeout,
+                        io_clone,
+                        tenant,
+                    );
+
+                    let mut exit_rx = child_reaper.watch_grandchild(child)?;
+
+                    let (stdout, stderr, timed_out) = io
+                        .read_all_with_timeout(time_to_timeout, max_output_size)
+                        .await;
+
+                    let exit_data = exit_rx.recv().await?;
+                    abort_guard.disarm();
+                    Ok::<_, anyhow::Error>(ExecResult::new(
+                        *exit_data.exit_code(),
+                        (stdout.data, stdout.truncated),
+                        (stderr.data, stderr.truncated),
+                        timed_out || exit_data.timed_out,
+                    ))
+                };
+
+                let (result, failure) = match requests.run(&scope_tenant, &scope, work).await {
+                    Ok(result) => (result, None),
+                    Err(e) => (ExecResult::failed(), Some(e)),
+                };
+
+                result.write(results.get().init_response(), None);
+                if let Some(e) = failure {
+                    RpcError::write(&e, results.get().get_response()?.init_error());
+                }
+                if let Some(key) = cache_key {
+                    if !result.is_failed() {
+                        capnp_err!(exec_cache.insert(key, result, cache_ttl))?;
                     }
                 }
                 Ok(())
@@ -203,28 +382,82 @@ impl conmon::Server for Server {
     fn attach_container(
         &mut self,
         params: conmon::AttachContainerParams,
-        _: conmon::AttachContainerResults,
+        mut results: conmon::AttachContainerResults,
     ) -> Promise<(), capnp::Error> {
         let req = pry!(pry!(params.get()).get_request());
         let container_id = pry_err!(req.get_id());
 
-        let span = new_root_span!("attach_container", container_id);
+        let span = new_root_span!("attach_container", container_id, req.get_trace_context());
         let _enter = span.enter();
 
         debug!("Got a attach container request",);
 
         let exec_session_id = pry_err!(req.get_exec_session_id());
-        if !exec_session_id.is_empty() {
-            debug!("Using exec session id {}", exec_session_id);
+        let child = pry_err!(self.child(container_id, exec_session_id));
+
+        if child.token().is_cancelled() {
+            return Promise::err(Error::failed(if exec_session_id.is_empty() {
+                format!("container {} is not running", container_id)
+            } else {
+                format!("exec session {} has finished", exec_session_id)
+            }));
         }
 
-        let socket_path = Path::new(pry!(req.get_socket_path()));
-        let attach = pry_err!(Attach::new(socket_path).context("create attach endpoint"));
-        let child = pry_err!(self.reaper().get(container_id));
+        let socket_path = PathBuf::from(pry!(req.get_socket_path()));
+        let attach_mux = self.attach_mux().clone();
+        let output_only = req.get_output_only();
+        let control = (!output_only).then(|| SessionControl::new(child.io().clone(), child.pid()));
+        let pty_shim = req.get_simulate_terminal().then(|| child.pid());
+        let sequenced = req.get_sequenced();
+        let report_end = req.get_report_end();
+        let stderr_priority = req.get_stderr_priority();
+        let strict_terminal = req.get_strict_terminal().then(|| req.get_terminal());
+        let session = if exec_session_id.is_empty() {
+            format!("container {}", container_id)
+        } else {
+            format!("exec session {}", exec_session_id)
+        };
 
         Promise::from_future(
             async move {
-                child.io().attach().await.add(attach).await;
+                let has_terminal = child.io().has_terminal().await;
+                if pty_shim.is_some() && has_terminal {
+                    return Err(Error::failed(
+                        "cannot simulate a terminal for a process which has one".into(),
+                    ));
+                }
+                if let Some(terminal) = strict_terminal.filter(|x| *x != has_terminal) {
+                    let err: anyhow::Error = RpcError::terminal_mismatch(if terminal {
+                        format!("{} has no terminal, but a terminal was requested", session)
+                    } else {
+                        format!("{} has a terminal, but no terminal was requested", session)
+                    })
+                    .into();
+                    RpcError::write(&err, results.get().init_response().init_error());
+                    return Ok(());
+                }
+                capnp_err!(child
+                    .io()
+                    .attach()
+                    .await
+                    .attach(
+                        &socket_path,
+                        pty_shim,
+                        output_only,
+                        sequenced,
+                        report_end,
+                        stderr_priority,
+                    )
+                    .await
+                    .context("create attach endpoint"))?;
+                attach_mux
+                    .register(&socket_path, control, stderr_priority)
+                    .await;
+                let mut response = results.get().init_response();
+                response.set_sequenced(sequenced);
+                response.set_strict_terminal(strict_terminal.is_some());
+                response.set_report_end(report_end);
+                response.set_stderr_priority(stderr_priority);
                 Ok(())
             }
             .instrument(debug_span!("promise")),
@@ -245,11 +478,19 @@ impl conmon::Server for Server {
 
         debug!("Got a reopen container log request");
 
-        let child = pry_err!(self.reaper().get(container_id));
+        let child = pry_err!(self.child(container_id, pry!(req.get_exec_session_id())));
+        let path = match pry!(req.get_path()) {
+            "" => None,
+            x => Some(PathBuf::from(x)),
+        };
 
         Promise::from_future(
-            async move { capnp_err!(child.io().logger().await.write().await.reopen().await) }
-                .instrument(debug_span!("promise")),
+            async move {
+                let logger = child.io().logger().await;
+                let mut logger = logger.write().await;
+                capnp_err!(logger.reopen(path.as_deref()).await)
+            }
+            .instrument(debug_span!("promise")),
         )
     }
 
@@ -267,7 +508,8 @@ impl conmon::Server for Server {
 
         debug!("Got a set window size container request");
 
-        let child = pry_err!(self.reaper().get(container_id));
+        let exec_session_id = pry_err!(req.get_exec_session_id());
+        let child = pry_err!(self.child(container_id, exec_session_id));
         let width = req.get_width();
         let height = req.get_height();
 
@@ -276,4 +518,1716 @@ impl conmon::Server for Server {
                 .instrument(debug_span!("promise")),
         )
     }
+
+    /// Update namespaced sysctls of a running container.
+    fn update_sysctls(
+        &mut self,
+        params: conmon::UpdateSysctlsParams,
+        mut results: conmon::UpdateSysctlsResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("update_sysctls", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a update sysctls request");
+
+        let child = pry_err!(self.child(container_id, ""));
+        if child.token().is_cancelled() {
+            return Promise::err(Error::failed(format!(
+                "container {} is not running",
+                container_id
+            )));
+        }
+        let pid = child.pid();
+
+        let mut sysctls = vec![];
+        let mut rejections = vec![];
+        for sysctl in pry!(req.get_sysctls()).iter() {
+            let key = pry!(sysctl.get_key());
+            match Sysctl::new(key, pry!(sysctl.get_value())) {
+                // Writing a sysctl of a shared namespace would modify the host.
+                Ok(sysctl) if pry_err!(sysctl::shares_host_namespace(pid, sysctl.namespace())) => {
+                    rejections.push((key, Rejection::HostNamespace))
+                }
+                Ok(sysctl) => sysctls.push(sysctl),
+                Err(rejection) => rejections.push((key, rejection)),
+            }
+        }
+
+        // Nothing gets applied if a single sysctl got rejected.
+        if !rejections.is_empty() {
+            let mut list = results
+                .get()
+                .init_response()
+                .init_rejections(rejections.len() as u32);
+            for (i, (key, rejection)) in rejections.into_iter().enumerate() {
+                debug!("Rejecting sysctl {}: {:?}", key, rejection);
+                let mut item = list.reborrow().get(i as u32);
+                item.set_key(key);
+                item.set_reason(match rejection {
+                    Rejection::InvalidKey => Reason::InvalidKey,
+                    Rejection::NotAllowed => Reason::NotAllowed,
+                    Rejection::InvalidValue => Reason::InvalidValue,
+                    Rejection::HostNamespace => Reason::HostNamespace,
+                });
+            }
+            return Promise::ok(());
+        }
+
+        Promise::from_future(
+            async move { capnp_err!(sysctl::apply(pid, sysctls).await) }
+                .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Watch for mount and unmount events inside of a running container.
+    fn watch_mounts(
+        &mut self,
+        params: conmon::WatchMountsParams,
+        _: conmon::WatchMountsResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("watch_mounts", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a watch mounts request");
+
+        let child = pry_err!(self.child(container_id, ""));
+        let watcher = pry!(req.get_watcher());
+        let token = self.watcher_token(child.token());
+        MountWatcher::new(child.pid(), token, watcher).spawn();
+
+        Promise::ok(())
+    }
+
+    /// Watch the usage of a path inside of a running container.
+    fn watch_quota(
+        &mut self,
+        params: conmon::WatchQuotaParams,
+        _: conmon::WatchQuotaResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("watch_quota", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a watch quota request");
+
+        let child = pry_err!(self.child(container_id, ""));
+        let thresholds = pry!(req.get_thresholds())
+            .iter()
+            .map(|x| x.get_bytes())
+            .collect();
+        let watcher = pry_err!(QuotaWatcher::new(
+            child.pid(),
+            pry!(req.get_path()),
+            thresholds,
+            req.get_interval_sec(),
+            self.watcher_token(child.token()),
+            pry!(req.get_watcher()),
+        ));
+        watcher.spawn();
+
+        Promise::ok(())
+    }
+
+    /// List all sessions attached to a running container.
+    fn list_attach_sessions(
+        &mut self,
+        params: conmon::ListAttachSessionsParams,
+        mut results: conmon::ListAttachSessionsResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("list_attach_sessions", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a list attach sessions request");
+
+        let child = pry_err!(self.child(container_id, ""));
+
+        Promise::from_future(
+            async move {
+                let sessions = child.io().attach().await.sessions().await;
+                let mut list = results
+                    .get()
+                    .init_response()
+                    .init_sessions(sessions.len() as u32);
+                for (i, session) in sessions.iter().enumerate() {
+                    let mut item = list.reborrow().get(i as u32);
+                    item.set_id(session.id());
+                    item.set_socket_path(&session.socket_path().to_string_lossy());
+                    item.set_connected_at(session.connected_at());
+                    item.set_uid(session.uid());
+                    item.set_gid(session.gid());
+                    item.set_pid(session.pid().unwrap_or_default());
+                }
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Forcibly disconnect an attach session.
+    fn kill_attach_session(
+        &mut self,
+        params: conmon::KillAttachSessionParams,
+        _: conmon::KillAttachSessionResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let session_id = pry!(req.get_session_id()).to_string();
+
+        let span = debug_span!(
+            "kill_attach_session",
+            session_id = session_id.as_str(),
+            uuid = Uuid::new_v4().to_string().as_str()
+        );
+        let _enter = span.enter();
+
+        debug!("Got a kill attach session request");
+
+        let children = pry_err!(self.children());
+
+        Promise::from_future(
+            async move {
+                for child in children {
+                    if child.io().attach().await.kill_session(&session_id).await {
+                        return Ok(());
+                    }
+                }
+                Err(Error::failed(format!(
+                    "attach session {} not found",
+                    session_id
+                )))
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Start a command inside of a running container, which can be attached
+    /// to via its exec session ID.
+    fn exec_container(
+        &mut self,
+        params: conmon::ExecContainerParams,
+        mut results: conmon::ExecContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id()).to_string();
+
+        let pidfile = pry_err!(ContainerIO::temp_file_name(
+            Some(self.config().runtime_dir()),
+            "exec",
+            "pid"
+        ));
+
+        let span = new_root_span!("exec_container", id.as_str());
+        let _enter = span.enter();
+
+        debug!("Got exec container request");
+
+        let exec_session_id = match pry!(req.get_exec_session_id()) {
+            "" => Uuid::new_v4().to_string(),
+            x => x.to_string(),
+        };
+        let child_id = pry_err!(self.new_exec_session_id(&id, &exec_session_id));
+        let reservation = pry_err!(self.reserve_quota(Resource::ExecSessions));
+
+        let runtime_options = self.runtime_options(&id);
+        let runtime = runtime_options.runtime().clone();
+        let child_reaper = self.reaper().clone();
+        let tenant = self.tenant().clone();
+        let events = self.events().clone();
+
+        let log_quota = pry_err!(self.log_quota());
+        let logger = pry_err!(ContainerLog::from(&id, pry!(req.get_log_drivers())));
+        let mut container_io = pry_err!(ContainerIO::new(req.get_terminal(), logger.clone()));
+
+        let command = pry!(req.get_command());
+        let args = pry_err!(runtime_options.generate_exec_sync_args(
+            &id,
+            &pidfile,
+            &container_io,
+            &command,
+            None,
+            0
+        ));
+        let session_policy = self.exec_session_policy(&id);
+
+        Promise::from_future(
+            async move {
+                let _reservation = reservation;
+                logger.write().await.set_quota(log_quota);
+                capnp_err!(logger.write().await.init().await)?;
+                container_io.attach().set_policy(session_policy.await).await;
+
+                let grandchild_pid = capnp_err!(
+                    child_reaper
+                        .create_child(
+                            &runtime,
+                            &args,
+                            &mut container_io,
+                            &pidfile,
+                            &MappedFds::default()
+                        )
+                        .await
+                )?;
+
+                // register grandchild with server
+                let io = SharedContainerIO::new(container_io);
+                let child = Child::new(
+                    child_id,
+                    grandchild_pid,
+                    vec![],
+                    vec![],
+                    None,
+                    io,
+                    tenant.clone(),
+                );
+                let exit_rx = capnp_err!(child_reaper.watch_grandchild(child))?;
+                // The exit is delivered via the events, see wait_container.
+                events.watch_exec(id, tenant, exec_session_id.clone(), grandchild_pid, exit_rx);
+
+                let mut resp = results.get().init_response();
+                resp.set_exec_session_id(&exec_session_id);
+                resp.set_pid(grandchild_pid);
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Retrieve an instance of the server scoped to a tenant.
+    fn with_tenant(
+        &mut self,
+        params: conmon::WithTenantParams,
+        mut results: conmon::WithTenantResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let tenant = pry!(req.get_tenant());
+
+        let span = debug_span!(
+            "with_tenant",
+            tenant,
+            uuid = Uuid::new_v4().to_string().as_str()
+        );
+        let _enter = span.enter();
+
+        debug!("Got a with tenant request");
+
+        let client: conmon::Client = capnp_rpc::new_client(self.for_tenant(tenant));
+        results.get().init_response().set_conmon(client);
+        Promise::ok(())
+    }
+
+    /// Forward a single connection to a port inside of a running container.
+    fn port_forward_container(
+        &mut self,
+        params: conmon::PortForwardContainerParams,
+        mut results: conmon::PortForwardContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("port_forward_container", container_id);
+        let _enter = span.enter();
+
+        let port = req.get_port();
+        let family = pry!(req.get_family());
+        debug!(
+            "Got a port forward container request for port {} ({:?})",
+            port, family
+        );
+
+        let child = pry_err!(self.child(container_id, ""));
+        if child.token().is_cancelled() {
+            return Promise::err(Error::failed(format!(
+                "container {} is not running",
+                container_id
+            )));
+        }
+
+        let socket_path = PathBuf::from(pry!(req.get_socket_path()));
+        let host = pry!(req.get_host()).to_string();
+
+        Promise::from_future(
+            async move {
+                let target = port_forward::Target {
+                    family,
+                    host: &host,
+                    port,
+                };
+                let port_forward = capnp_err!(
+                    PortForward::new(child.pid(), target, &socket_path, child.token().clone())
+                        .await
+                )?;
+
+                let address = port_forward.address();
+                let mut response = results.get().init_response();
+                response.set_address(&address.to_string());
+                response.set_family(port_forward::address_family(&address));
+                port_forward.spawn();
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Retrieve the resource usage statistics of a running container.
+    fn container_stats(
+        &mut self,
+        params: conmon::ContainerStatsParams,
+        mut results: conmon::ContainerStatsResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("container_stats", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a container stats request");
+
+        let child = pry_err!(self.child(container_id, ""));
+        if child.token().is_cancelled() {
+            return Promise::err(Error::failed(format!(
+                "container {} is not running",
+                container_id
+            )));
+        }
+
+        let stats = pry_err!(Stats::collect(child.pid()));
+        let timestamp = pry_err!(SystemTime::now().duration_since(UNIX_EPOCH)).as_nanos() as u64;
+        if self.config().tombstone_retention().is_some() {
+            pry_err!(self
+                .tombstones()
+                .observe_stats(child.pid(), timestamp, stats));
+        }
+        let network = pry_err!(NetworkStats::collect(child.pid()));
+        let mut response_stats = results.get().init_response().init_stats();
+        stats.build(timestamp, response_stats.reborrow());
+        network.build(response_stats.init_network());
+
+        Promise::from_future(
+            async move {
+                let logger = child.io().logger().await;
+                let health = capnp_err!(logger.read().await.filter_health())?;
+                if let Some(health) = health {
+                    let mut log_filter =
+                        results.get().get_response()?.get_stats()?.init_log_filter();
+                    log_filter.set_configured(true);
+                    log_filter.set_running(health.running());
+                    log_filter.set_restarts(health.restarts());
+                    log_filter.set_last_exit_code(health.last_exit_code().unwrap_or(-1));
+                    log_filter.set_dropped_bytes(health.dropped_bytes());
+                }
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Retrieve the quota limits and resource usage of the tenant.
+    fn tenant_quota(
+        &mut self,
+        _: conmon::TenantQuotaParams,
+        mut results: conmon::TenantQuotaResults,
+    ) -> Promise<(), capnp::Error> {
+        let span = debug_span!(
+            "tenant_quota",
+            tenant = self.tenant().as_str(),
+            uuid = Uuid::new_v4().to_string().as_str()
+        );
+        let _enter = span.enter();
+
+        debug!("Got a tenant quota request");
+
+        let mut response = results.get().init_response();
+        for resource in [
+            Resource::Containers,
+            Resource::ExecSessions,
+            Resource::LogBytes,
+        ] {
+            let mut usage = match resource {
+                Resource::Containers => response.reborrow().init_containers(),
+                Resource::ExecSessions => response.reborrow().init_exec_sessions(),
+                Resource::LogBytes => response.reborrow().init_log_bytes(),
+            };
+            usage.set_used(pry_err!(self.quota_usage(resource)));
+            usage.set_limit(self.quota_limit(resource).unwrap_or_default());
+        }
+        Promise::ok(())
+    }
+
+    /// List all containers of the tenant.
+    fn list_containers(
+        &mut self,
+        params: conmon::ListContainersParams,
+        mut results: conmon::ListContainersResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let selector = pry!(labels::read(pry!(req.get_label_selector())));
+
+        let span = debug_span!(
+            "list_containers",
+            tenant = self.tenant().as_str(),
+            uuid = Uuid::new_v4().to_string().as_str()
+        );
+        let _enter = span.enter();
+
+        debug!("Got a list containers request");
+
+        let mut containers = vec![];
+        for (id, child) in pry_err!(self.containers()) {
+            let container_labels = pry_err!(child.labels().get());
+            if labels::matches(&container_labels, &selector) {
+                containers.push((id, child.pid(), container_labels));
+            }
+        }
+
+        let mut list = results
+            .get()
+            .init_response()
+            .init_containers(containers.len() as u32);
+        for (i, (id, pid, container_labels)) in containers.iter().enumerate() {
+            let mut item = list.reborrow().get(i as u32);
+            item.set_id(id);
+            item.set_pid(*pid);
+            labels::write(
+                container_labels,
+                item.init_labels(container_labels.len() as u32),
+            );
+        }
+        Promise::ok(())
+    }
+
+    /// Stop a running container by sending SIGTERM, followed by SIGKILL if it
+    /// did not exit within the timeout.
+    fn stop_container(
+        &mut self,
+        params: conmon::StopContainerParams,
+        mut results: conmon::StopContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+        let timeout = Duration::from_secs(req.get_timeout_sec());
+
+        let span = new_root_span!("stop_container", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a stop container request");
+
+        let child = pry_response!(results, self.running_child(container_id));
+        let lock = pry_response!(results, self.lock_operation(container_id, Operation::Stop));
+        let states = self.container_states();
+        if let Err(e) = states.set_stopping(self.tenant(), container_id, child.pid()) {
+            debug!("Unable to set stopping state: {:#}", e);
+        }
+
+        Promise::from_future(
+            async move {
+                let _lock = lock;
+                let token = child.token().clone();
+                child.io().attach().await.stop();
+                kill_grandchild(child.pid(), Signal::SIGTERM);
+                if time::timeout(timeout, token.cancelled()).await.is_err() {
+                    debug!("Container did not exit within timeout, killing it");
+                    kill_grandchild(child.pid(), Signal::SIGKILL);
+                    token.cancelled().await;
+                }
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Watch the lifecycle events of the containers of the tenant.
+    fn watch_container_events(
+        &mut self,
+        params: conmon::WatchContainerEventsParams,
+        _: conmon::WatchContainerEventsResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("watch_container_events", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a watch container events request");
+
+        let watcher = pry!(req.get_watcher());
+        self.events().spawn_watcher(
+            container_id,
+            self.tenant(),
+            self.connection().clone(),
+            watcher,
+        );
+        Promise::ok(())
+    }
+
+    /// Collect the server state for support bundles.
+    fn support_bundle(
+        &mut self,
+        _: conmon::SupportBundleParams,
+        mut results: conmon::SupportBundleResults,
+    ) -> Promise<(), capnp::Error> {
+        let span = debug_span!(
+            "support_bundle",
+            tenant = self.tenant().as_str(),
+            uuid = Uuid::new_v4().to_string().as_str()
+        );
+        let _enter = span.enter();
+
+        debug!("Got a support bundle request");
+
+        // The server logs and configuration are not scoped to a tenant.
+        if !self.tenant().is_empty() {
+            return Promise::err(Error::failed(
+                "support bundles are only available to the default tenant".into(),
+            ));
+        }
+
+        let mut response = results.get().init_response();
+        response.set_config(&format!("{:#?}", self.config()));
+
+        let logs = self.logs().lines();
+        let mut list = response.reborrow().init_logs(logs.len() as u32);
+        for (i, line) in logs.iter().enumerate() {
+            list.set(i as u32, line);
+        }
+
+        let events = self.events().history();
+        let mut list = response.init_events(events.len() as u32);
+        for (i, event) in events.iter().enumerate() {
+            event.build(list.reborrow().get(i as u32));
+        }
+        Promise::ok(())
+    }
+
+    /// Wait for a container to exit.
+    fn wait_container(
+        &mut self,
+        params: conmon::WaitContainerParams,
+        mut results: conmon::WaitContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id()).to_string();
+        let exec_session_id = pry!(req.get_exec_session_id()).to_string();
+
+        let span = new_root_span!("wait_container", id.as_str());
+        let _enter = span.enter();
+
+        debug!("Got a wait container request");
+
+        // Subscribe before retrieving the child to not miss its exit.
+        let mut rx = self.events().subscribe();
+        let events = self.events().clone();
+        let tenant = self.tenant().clone();
+        let connection = self.connection().clone();
+        let pid = self
+            .child(&id, &exec_session_id)
+            .ok()
+            .map(|child| child.pid());
+
+        Promise::from_future(
+            async move {
+                let exit = match pid {
+                    Some(pid) => {
+                        let wait = events.wait_exit(&mut rx, &tenant, &id, &exec_session_id, pid);
+                        tokio::select! {
+                            exit = wait => capnp_err!(exit)?,
+                            _ = connection.cancelled() => return Ok(()),
+                        }
+                    }
+                    // The process exited already and has been forgotten.
+                    None => events
+                        .exit(&tenant, &id, &exec_session_id, None)
+                        .ok_or_else(|| Error::failed(format!("container {} not found", id)))?,
+                };
+                let mut response = results.get().init_response();
+                response.set_exit_code(exit.exit_code());
+                response.set_timestamp(exit.timestamp());
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Arm or disarm the watchdog of a container.
+    fn set_watchdog(
+        &mut self,
+        params: conmon::SetWatchdogParams,
+        _: conmon::SetWatchdogResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("set_watchdog", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a set watchdog request");
+
+        if req.get_timeout_sec() == 0 {
+            pry_err!(self.watchdogs().disarm(self.tenant(), container_id));
+            return Promise::ok(());
+        }
+
+        let child = pry_err!(self.child(container_id, ""));
+        if child.token().is_cancelled() {
+            return Promise::err(Error::failed(format!(
+                "container {} is not running",
+                container_id
+            )));
+        }
+
+        let policy = match pry!(req.get_policy()) {
+            set_watchdog_request::Policy::Event => Policy::Event,
+            set_watchdog_request::Policy::Stop => Policy::Stop,
+        };
+        pry_err!(self.watchdogs().arm(
+            &child,
+            Duration::from_secs(req.get_timeout_sec()),
+            policy,
+            self.events().clone(),
+        ));
+        Promise::ok(())
+    }
+
+    /// Reset the watchdogs of containers.
+    fn heartbeat(
+        &mut self,
+        params: conmon::HeartbeatParams,
+        _: conmon::HeartbeatResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let ids: Vec<String> = pry!(pry!(req.get_ids())
+            .iter()
+            .map(|r| r.map(String::from))
+            .collect());
+
+        let span = debug_span!(
+            "heartbeat",
+            tenant = self.tenant().as_str(),
+            uuid = Uuid::new_v4().to_string().as_str()
+        );
+        let _enter = span.enter();
+
+        debug!("Got a heartbeat request");
+
+        pry_err!(self.watchdogs().heartbeat(self.tenant(), &ids));
+        Promise::ok(())
+    }
+
+    /// Rotate all log drivers for a running container.
+    fn rotate_log_container(
+        &mut self,
+        params: conmon::RotateLogContainerParams,
+        _: conmon::RotateLogContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("rotate_log_container", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a rotate container log request");
+
+        let child = pry_err!(self.child(container_id, pry!(req.get_exec_session_id())));
+        let path = match pry!(req.get_path()) {
+            "" => None,
+            x => Some(PathBuf::from(x)),
+        };
+
+        Promise::from_future(
+            async move {
+                let logger = child.io().logger().await;
+                let mut logger = logger.write().await;
+                capnp_err!(logger.rotate(path.as_deref()).await)
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Read the entries of the CRI log file of a container from an offset.
+    fn read_log_container(
+        &mut self,
+        params: conmon::ReadLogContainerParams,
+        mut results: conmon::ReadLogContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("read_log_container", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a read container log request");
+
+        let child = pry_err!(self.child(container_id, ""));
+        let path = match pry!(req.get_path()) {
+            "" => None,
+            x => Some(PathBuf::from(x)),
+        };
+        let (offset, count, backward) = (req.get_offset(), req.get_count(), req.get_backward());
+
+        Promise::from_future(
+            async move {
+                let logger = child.io().logger().await;
+                let path = capnp_err!(logger.read().await.cri_path(path.as_deref()))?;
+                let (entries, offset, size) = capnp_err!(capnp_err!(
+                    task::spawn_blocking(move || -> anyhow::Result<_> {
+                        let reader = LogReader::open(&path)?;
+                        let (entries, offset) = if backward {
+                            reader.read_backward(offset, count as usize)?
+                        } else {
+                            reader.read_forward(offset, count as usize)?
+                        };
+                        Ok((entries, offset, reader.size()))
+                    })
+                    .await
+                )?)?;
+
+                let mut response = results.get().init_response();
+                response.set_offset(offset);
+                response.set_size(size);
+                let mut list = response.init_entries(entries.len() as u32);
+                for (i, entry) in entries.iter().enumerate() {
+                    let mut e = list.reborrow().get(i as u32);
+                    e.set_offset(entry.offset());
+                    e.set_timestamp(entry.timestamp());
+                    e.set_stream(entry.stream());
+                    e.set_partial(entry.partial());
+                    e.set_message(entry.message());
+                }
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Find the offset of the first CRI log entry of a container not before
+    /// a timestamp.
+    fn seek_log_container(
+        &mut self,
+        params: conmon::SeekLogContainerParams,
+        mut results: conmon::SeekLogContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("seek_log_container", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a seek container log request");
+
+        let child = pry_err!(self.child(container_id, ""));
+        let path = match pry!(req.get_path()) {
+            "" => None,
+            x => Some(PathBuf::from(x)),
+        };
+        let timestamp = req.get_timestamp();
+
+        Promise::from_future(
+            async move {
+                let logger = child.io().logger().await;
+                let path = capnp_err!(logger.read().await.cri_path(path.as_deref()))?;
+                let offset = capnp_err!(capnp_err!(
+                    task::spawn_blocking(move || LogReader::open(&path)?.seek_time(timestamp))
+                        .await
+                )?)?;
+                results.get().init_response().set_offset(offset);
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Wait until all output of a container produced so far has been written
+    /// durably to its log drivers.
+    fn flush_logs(
+        &mut self,
+        params: conmon::FlushLogsParams,
+        _: conmon::FlushLogsResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container_id = pry_err!(req.get_id());
+
+        let span = new_root_span!("flush_logs", container_id);
+        let _enter = span.enter();
+
+        debug!("Got a flush logs request");
+
+        let child = pry_err!(self.child(container_id, ""));
+
+        Promise::from_future(
+            async move {
+                let logger = child.io().logger().await;
+                capnp_err!(ContainerLog::flush(&logger, FLUSH_LOGS_TIMEOUT).await)
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Checkpoint a running container using the runtime.
+    fn checkpoint_container(
+        &mut self,
+        params: conmon::CheckpointContainerParams,
+        mut results: conmon::CheckpointContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id());
+
+        let span = new_root_span!("checkpoint_container", id);
+        let _enter = span.enter();
+
+        debug!("Got a checkpoint container request");
+
+        // Ensure that the container is managed by this server.
+        pry_response!(results, self.child(id, ""));
+        let lock = pry_response!(results, self.lock_operation(id, Operation::Checkpoint));
+
+        // Streamed images are written to a temporary directory if no image
+        // path is specified, which gets removed afterwards.
+        let options = pry!(req.get_options());
+        let writer = if req.has_image_writer() {
+            Some(pry!(req.get_image_writer()))
+        } else {
+            None
+        };
+        let temp_dir = if writer.is_some() && pry!(options.get_image_path()).is_empty() {
+            Some(pry_err!(tempfile::Builder::new()
+                .prefix("checkpoint")
+                .tempdir_in(self.config().runtime_dir())))
+        } else {
+            None
+        };
+        let checkpoint = pry!(checkpoint_options(
+            options,
+            temp_dir.as_ref().map(|x| x.path())
+        ));
+        let runtime_options = self.runtime_options(id);
+        let args =
+            runtime_options.generate_checkpoint_args(id, &checkpoint, req.get_leave_running());
+        let runtime = runtime_options.runtime().clone();
+
+        Promise::from_future(
+            async move {
+                let _temp_dir = temp_dir;
+                let _lock = lock;
+                let mut res = checkpoint::run_runtime(runtime, args).await;
+                if let Some(writer) = writer {
+                    if res.is_ok() {
+                        res = checkpoint::stream_image(checkpoint.image_path(), &writer).await;
+                    }
+                }
+                if let Err(e) = res {
+                    RpcError::write(&e, results.get().init_response().init_error());
+                }
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Restore a container from a checkpoint using the runtime.
+    fn restore_container(
+        &mut self,
+        params: conmon::RestoreContainerParams,
+        mut results: conmon::RestoreContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let container = pry!(req.get_container());
+        let id = pry!(container.get_id());
+
+        let span = new_root_span!("restore_container", id);
+        let _enter = span.enter();
+
+        debug!("Got a restore container request");
+
+        let checkpoint = pry!(checkpoint_options(pry!(req.get_options()), None));
+        let container_pid = pry_response!(results, self.create(container, Some(checkpoint)));
+        Promise::from_future(
+            async move {
+                let container_pid = container_pid.await;
+                let mut response = results.get().init_response();
+                match container_pid {
+                    Ok(pid) => response.set_container_pid(pid),
+                    Err(e) => RpcError::write(&e, response.init_error()),
+                }
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Receive a streamed checkpoint image for restoring a container.
+    fn upload_checkpoint_image(
+        &mut self,
+        params: conmon::UploadCheckpointImageParams,
+        mut results: conmon::UploadCheckpointImageResults,
+    ) -> Promise<(), capnp::Error> {
+        debug!("Got an upload checkpoint image request");
+        let req = pry!(pry!(params.get()).get_request());
+        let image_path = match pry!(req.get_image_path()) {
+            "" => None,
+            x => Some(Path::new(x)),
+        };
+
+        let upload = pry_response!(
+            results,
+            ImageUpload::new(image_path, self.config().runtime_dir())
+        );
+        let mut response = results.get().init_response();
+        response.set_image_path(&upload.image_path().to_string_lossy());
+        response.set_writer(capnp_rpc::new_client(upload));
+        Promise::ok(())
+    }
+
+    /// Send a signal to the process of a running container, or to all
+    /// processes of its cgroup using the runtime.
+    fn kill_container(
+        &mut self,
+        params: conmon::KillContainerParams,
+        mut results: conmon::KillContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id());
+
+        let span = new_root_span!("kill_container", id);
+        let _enter = span.enter();
+
+        debug!("Got a kill container request");
+
+        let child = pry_response!(results, self.running_child(id));
+        let signal = pry_err!(Signal::try_from(req.get_signal() as i32)
+            .with_context(|| format!("invalid signal {}", req.get_signal())));
+
+        if req.get_all() {
+            let runtime_options = self.runtime_options(id);
+            let args = runtime_options.generate_kill_all_args(id, signal);
+            let runtime = runtime_options.runtime().clone();
+            return Promise::from_future(
+                async move {
+                    if let Err(e) = checkpoint::run_runtime(runtime, args).await {
+                        RpcError::write(&e, results.get().init_response().init_error());
+                    }
+                    Ok(())
+                }
+                .instrument(debug_span!("promise")),
+            );
+        }
+
+        // The container process gets reaped by the server, which means that
+        // its PID cannot be reused while the container is running.
+        pry_response!(
+            results,
+            kill(Pid::from_raw(child.pid() as i32), signal).context("send signal")
+        );
+        Promise::ok(())
+    }
+
+    fn pause_container(
+        &mut self,
+        params: conmon::PauseContainerParams,
+        mut results: conmon::PauseContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id());
+
+        let span = new_root_span!("pause_container", id);
+        let _enter = span.enter();
+
+        debug!("Got a pause container request");
+
+        let freezer = pry_response!(
+            results,
+            self.freezer(id).and_then(|freezer| {
+                // Sync with `pkg/client/pause.go`
+                if freezer.is_frozen()? {
+                    bail!("container {} is already paused", id)
+                }
+                Ok(freezer)
+            })
+        );
+        let lock = pry_response!(results, self.lock_operation(id, Operation::Pause));
+        let pid = pry_response!(results, self.running_child(id)).pid();
+        let states = self.container_states().clone();
+        let tenant = self.tenant().clone();
+        let id = id.to_string();
+
+        Promise::from_future(
+            async move {
+                let _lock = lock;
+                if let Err(e) = freezer.freeze().await.context("freeze cgroup") {
+                    RpcError::write(&e, results.get().init_response().init_error());
+                } else if let Err(e) = states.set_paused(&tenant, &id, pid, true) {
+                    debug!("Unable to set paused state: {:#}", e);
+                }
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    fn unpause_container(
+        &mut self,
+        params: conmon::UnpauseContainerParams,
+        mut results: conmon::UnpauseContainerResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id());
+
+        let span = new_root_span!("unpause_container", id);
+        let _enter = span.enter();
+
+        debug!("Got an unpause container request");
+
+        let freezer = pry_response!(
+            results,
+            self.freezer(id).and_then(|freezer| {
+                // Sync with `pkg/client/pause.go`
+                if !freezer.is_freezing()? {
+                    bail!("container {} is not paused", id)
+                }
+                Ok(freezer)
+            })
+        );
+        let lock = pry_response!(results, self.lock_operation(id, Operation::Unpause));
+        let pid = pry_response!(results, self.running_child(id)).pid();
+        let states = self.container_states().clone();
+        let tenant = self.tenant().clone();
+        let id = id.to_string();
+
+        Promise::from_future(
+            async move {
+                let _lock = lock;
+                if let Err(e) = freezer.thaw().await.context("thaw cgroup") {
+                    RpcError::write(&e, results.get().init_response().init_error());
+                } else if let Err(e) = states.set_paused(&tenant, &id, pid, false) {
+                    debug!("Unable to set paused state: {:#}", e);
+                }
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Retrieve the log files and exit information of a set of containers.
+    /// The logs of running containers are flushed before.
+    fn archive_logs(
+        &mut self,
+        params: conmon::ArchiveLogsParams,
+        mut results: conmon::ArchiveLogsResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let ids: Vec<String> = pry!(pry!(req.get_ids())
+            .iter()
+            .map(|r| r.map(String::from))
+            .collect());
+
+        let span = debug_span!(
+            "archive_logs",
+            tenant = self.tenant().as_str(),
+            uuid = Uuid::new_v4().to_string().as_str()
+        );
+        let _enter = span.enter();
+
+        debug!("Got an archive logs request for {} containers", ids.len());
+
+        let events = self.events().clone();
+        let tenant = self.tenant().clone();
+        let children: Vec<_> = ids.iter().map(|id| self.child(id, "").ok()).collect();
+
+        Promise::from_future(
+            async move {
+                let mut archived = Vec::with_capacity(ids.len());
+                for (id, child) in ids.iter().zip(children) {
+                    archived.push(archived_container(&events, &tenant, id, child).await);
+                }
+
+                let mut list = results
+                    .get()
+                    .init_response()
+                    .init_containers(archived.len() as u32);
+                for (i, (id, result)) in ids.iter().zip(archived).enumerate() {
+                    let mut item = list.reborrow().get(i as u32);
+                    item.set_id(id);
+                    match result {
+                        Ok((paths, exit)) => {
+                            let mut log_paths = item.reborrow().init_log_paths(paths.len() as u32);
+                            for (j, path) in paths.iter().enumerate() {
+                                log_paths.set(j as u32, &path.to_string_lossy());
+                            }
+                            if let Some(exit) = exit {
+                                item.set_exited(true);
+                                item.set_exit_code(exit.exit_code());
+                                item.set_timestamp(exit.timestamp());
+                            }
+                        }
+                        Err(e) => RpcError::write(&e, item.init_error()),
+                    }
+                }
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Replace the labels of a container.
+    fn set_labels(
+        &mut self,
+        params: conmon::SetLabelsParams,
+        mut results: conmon::SetLabelsResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id());
+
+        let span = new_root_span!("set_labels", id);
+        let _enter = span.enter();
+
+        debug!("Got a set labels request");
+
+        let new_labels = pry!(labels::read(pry!(req.get_labels())));
+        let child = pry_response!(results, self.child(id, ""));
+        pry_response!(results, child.labels().set(new_labels));
+        Promise::ok(())
+    }
+
+    /// Retrieve the labels of a container.
+    fn get_labels(
+        &mut self,
+        params: conmon::GetLabelsParams,
+        mut results: conmon::GetLabelsResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id());
+
+        let span = new_root_span!("get_labels", id);
+        let _enter = span.enter();
+
+        debug!("Got a get labels request");
+
+        let child = pry_response!(results, self.child(id, ""));
+        let container_labels = pry_response!(results, child.labels().get());
+        labels::write(
+            &container_labels,
+            results
+                .get()
+                .init_response()
+                .init_labels(container_labels.len() as u32),
+        );
+        Promise::ok(())
+    }
+
+    /// Update the cgroup resources of a running container.
+    fn update_container_resources(
+        &mut self,
+        params: conmon::UpdateContainerResourcesParams,
+        mut results: conmon::UpdateContainerResourcesResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id());
+
+        let span = new_root_span!("update_container_resources", id);
+        let _enter = span.enter();
+
+        debug!("Got an update container resources request");
+
+        let mut values = vec![];
+        for value in pry!(req.get_values()) {
+            values.push(pry_response!(
+                results,
+                CgroupValue::new(
+                    pry!(value.get_controller()),
+                    pry!(value.get_file()),
+                    pry!(value.get_value()),
+                )
+            ));
+        }
+        let child = pry_response!(results, self.running_child(id));
+        let _lock = pry_response!(results, self.lock_operation(id, Operation::UpdateResources));
+        pry_response!(
+            results,
+            resources::update(child.pid(), &values).context("update cgroup resources")
+        );
+        Promise::ok(())
+    }
+
+    /// Serve the seccomp notifications of a container by a client handler.
+    fn serve_seccomp_notify(
+        &mut self,
+        params: conmon::ServeSeccompNotifyParams,
+        mut results: conmon::ServeSeccompNotifyResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id());
+
+        let span = new_root_span!("serve_seccomp_notify", id);
+        let _enter = span.enter();
+
+        debug!("Got a serve seccomp notify request");
+
+        let child = pry_response!(results, self.child(id, ""));
+        let token = self.watcher_token(child.token());
+        pry_response!(
+            results,
+            self.seccomp_notify()
+                .spawn_handler(id, token, pry!(req.get_handler()))
+        );
+        Promise::ok(())
+    }
+
+    /// Cancel an in-flight request of the tenant.
+    fn cancel_request(
+        &mut self,
+        params: conmon::CancelRequestParams,
+        mut results: conmon::CancelRequestResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let request_id = pry!(req.get_request_id());
+        debug!("Got a cancel request for request {}", request_id);
+
+        let cancelled = pry_err!(self.requests().cancel(self.tenant(), request_id));
+        results.get().init_response().set_cancelled(cancelled);
+        Promise::ok(())
+    }
+
+    /// Retrieve the tombstone of a removed container.
+    fn get_tombstone(
+        &mut self,
+        params: conmon::GetTombstoneParams,
+        mut results: conmon::GetTombstoneResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id());
+
+        let span = new_root_span!("get_tombstone", id);
+        let _enter = span.enter();
+
+        debug!("Got a get tombstone request");
+
+        let tombstone = match self.config().tombstone_retention() {
+            Some(retention) => pry_err!(self.tombstones().get(self.tenant(), id, retention)),
+            None => None,
+        };
+        let tombstone = pry_response!(
+            results,
+            tombstone.ok_or_else(|| anyhow::Error::from(RpcError::not_found(format!(
+                "no tombstone of container {}",
+                id
+            ))))
+        );
+        tombstone.build(results.get().init_response().init_tombstone());
+        Promise::ok(())
+    }
+
+    /// Retrieve the lifecycle state of a container.
+    fn get_state(
+        &mut self,
+        params: conmon::GetStateParams,
+        mut results: conmon::GetStateResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let id = pry!(req.get_id());
+
+        let span = new_root_span!("get_state", id);
+        let _enter = span.enter();
+
+        debug!("Got a get state request");
+
+        let entry = pry_err!(self.container_states().get(self.tenant(), id));
+        let entry = pry_response!(
+            results,
+            entry.ok_or_else(|| anyhow::Error::from(RpcError::not_found(format!(
+                "container {} not found",
+                id
+            ))))
+        );
+        let mut response = results.get().init_response();
+        response.set_state(entry.state().to_capnp());
+        response.set_pid(entry.pid());
+        response.set_exit_code(entry.exit_code());
+        response.set_updated_at(entry.updated_at());
+        Promise::ok(())
+    }
+
+    /// Poll the events of the containers of the tenant after a cursor.
+    fn get_events(
+        &mut self,
+        params: conmon::GetEventsParams,
+        mut results: conmon::GetEventsResults,
+    ) -> Promise<(), capnp::Error> {
+        let req = pry!(pry!(params.get()).get_request());
+        let cursor = req.get_cursor();
+        let max = req.get_max() as usize;
+        let timeout = Duration::from_millis(req.get_timeout_ms());
+
+        let span = debug_span!(
+            "get_events",
+            tenant = self.tenant().as_str(),
+            uuid = Uuid::new_v4().to_string().as_str()
+        );
+        let _enter = span.enter();
+
+        debug!("Got a get events request after cursor {}", cursor);
+
+        let events = self.events().clone();
+        let tenant = self.tenant().clone();
+        let connection = self.connection().clone();
+
+        Promise::from_future(
+            async move {
+                let batch = tokio::select! {
+                    batch = events.wait_poll(&tenant, cursor, max, timeout) => capnp_err!(batch)?,
+                    _ = connection.cancelled() => return Ok(()),
+                };
+                let mut response = results.get().init_response();
+                response.set_next_cursor(batch.next_cursor());
+                response.set_missed(batch.missed());
+                let mut list = response.init_events(batch.events().len() as u32);
+                for (i, event) in batch.events().iter().enumerate() {
+                    event.build(list.reborrow().get(i as u32));
+                }
+                Ok(())
+            }
+            .instrument(debug_span!("promise")),
+        )
+    }
+
+    /// Retrieve information about the persisted state of the server.
+    fn inspect_state(
+        &mut self,
+        _: conmon::InspectStateParams,
+        mut results: conmon::InspectStateResults,
+    ) -> Promise<(), capnp::Error> {
+        let span = debug_span!(
+            "inspect_state",
+            tenant = self.tenant().as_str(),
+            uuid = Uuid::new_v4().to_string().as_str()
+        );
+        let _enter = span.enter();
+
+        debug!("Got an inspect state request");
+
+        let info = pry_response!(results, self.state().info(self.tenant()));
+        info.build(results.get().init_response().init_info());
+        Promise::ok(())
+    }
+
+    /// Reclaim the space of overwritten and deleted records of the persisted
+    /// state of the server.
+    fn compact_state(
+        &mut self,
+        _: conmon::CompactStateParams,
+        mut results: conmon::CompactStateResults,
+    ) -> Promise<(), capnp::Error> {
+        let span = debug_span!(
+            "compact_state",
+            tenant = self.tenant().as_str(),
+            uuid = Uuid::new_v4().to_string().as_str()
+        );
+        let _enter = span.enter();
+
+        debug!("Got a compact state request");
+
+        let compaction = pry_response!(results, self.state().compact());
+        let mut response = results.get().init_response();
+        response.set_size_before(compaction.size_before());
+        response.set_size_after(compaction.size_after());
+        response.set_entries(compaction.entries());
+        Promise::ok(())
+    }
+}
+
+impl Server {
+    /// Create a new container, or restore it from the checkpoint if
+    /// provided. The returned future resolves to the PID of the container.
+    fn create(
+        &self,
+        req: create_container_request::Reader,
+        checkpoint: Option<CheckpointOptions>,
+    ) -> anyhow::Result<impl Future<Output = anyhow::Result<u32>>> {
+        let id = req.get_id()?.to_string();
+        if self.reaper().get(&id).is_ok() {
+            return Err(
+                RpcError::already_exists(format!("container {} already exists", id)).into(),
+            );
+        }
+        let reservation = self.reserve_quota(Resource::Containers)?;
+        let log_quota = self.log_quota()?;
+        let log_drivers = req.get_log_drivers()?;
+        let container_log = ContainerLog::from(&id, log_drivers)?;
+        let log_filter = req.get_log_filter()?;
+        let filter_path = log_filter.get_path()?;
+        let filter_config = if filter_path.is_empty() {
+            None
+        } else {
+            let args = log_filter
+                .get_args()?
+                .iter()
+                .map(|r| r.map(String::from))
+                .collect::<capnp::Result<Vec<_>>>()?;
+            let restart_policy = match log_filter.get_restart_policy()? {
+                log_filter::RestartPolicy::Never => RestartPolicy::Never,
+                log_filter::RestartPolicy::OnFailure => RestartPolicy::OnFailure,
+                log_filter::RestartPolicy::Always => RestartPolicy::Always,
+            };
+            let mut config = LogFilterConfig::new(
+                filter_path,
+                args,
+                restart_policy,
+                log_filter.get_max_restarts(),
+            );
+            config.set_user(IoUser::from_request(req)?);
+            Some(config)
+        };
+        let mut container_io = ContainerIO::new(req.get_terminal(), container_log.clone())?;
+
+        let bundle_path = Path::new(req.get_bundle_path()?);
+        let scratch_dirs = ScratchDirs::from_request(bundle_path, req.get_scratch_dirs()?)?;
+        let pidfile = bundle_path.join("pidfile");
+        let runtime_log = bundle_path.join(RUNTIME_LOG);
+        debug!("PID file is {}", pidfile.display());
+
+        let child_reaper = self.reaper().clone();
+        let tenant = self.tenant().clone();
+        let events = self.events().clone();
+        let tombstones = self.tombstones().clone();
+        let tombstone_retention = self.config().tombstone_retention();
+        let container_states = self.container_states().clone();
+        let state_retention = self.config().state_retention();
+        // Restored containers are running right after the runtime returns.
+        let restored = checkpoint.is_some();
+        let fd_slots: Vec<u64> = req.get_additional_fds()?.iter().collect();
+        let fd_mappings = req.get_fd_mappings()?;
+        let mapped_fds = if fd_slots.is_empty() {
+            MappedFds::from_mappings(fd_mappings, self.fd_socket())?
+        } else if fd_mappings.len() == 0 {
+            MappedFds::sequential(self.fd_socket().take(&fd_slots)?)
+        } else {
+            bail!("additional fds and fd mappings are mutually exclusive")
+        };
+        let runtime_options = RuntimeOptions::from_config(self.config())
+            .with_overrides(req.get_runtime_options()?)
+            .context("runtime options")?;
+        // Only the runtime invocation of the request writes debug messages.
+        let runtime_debug = req.get_runtime_debug();
+        let args = runtime_options
+            .clone()
+            .with_debug(runtime_debug)
+            .with_runtime_bundle(scratch_dirs.runtime_bundle())
+            .generate_runtime_args(
+                &id,
+                bundle_path,
+                &container_io,
+                &pidfile,
+                mapped_fds.len(),
+                checkpoint.as_ref(),
+            )?;
+        let runtime = runtime_options.runtime().clone();
+        let exit_paths = req
+            .get_exit_paths()?
+            .iter()
+            .map(|r| r.map(PathBuf::from))
+            .collect::<capnp::Result<Vec<_>>>()?;
+        let oom_exit_paths = req
+            .get_oom_exit_paths()?
+            .iter()
+            .map(|r| r.map(PathBuf::from))
+            .collect::<capnp::Result<Vec<_>>>()?;
+        let session_policy = SessionPolicy::new(
+            match req.get_max_session_duration_sec() {
+                0 => self.config().max_session_duration(),
+                x => Some(x),
+            },
+            self.config().session_warning_period(),
+        );
+        let seccomp_notify = self.seccomp_notify().clone();
+        let seccomp_listener = match req.get_seccomp_listener_path()? {
+            "" => None,
+            path => Some(SeccompListener::bind(Path::new(path))?),
+        };
+        let scope = RequestScope::new(req.get_scope()?)?;
+        let requests = self.requests().clone();
+        let delete_args = runtime_options.generate_delete_args(&id);
+        let progress = if req.has_progress() {
+            Some(req.get_progress()?)
+        } else {
+            None
+        };
+
+        let work = async move {
+            let _reservation = reservation;
+            report_progress(&progress, create_progress::Phase::Preparing).await;
+            scratch_dirs.create()?;
+            // The scratch dirs are owned by the container once it runs.
+            let scratch_clone = scratch_dirs.clone();
+            let scratch_guard = AbortGuard::new(move || scratch_clone.remove());
+            container_log.write().await.set_quota(log_quota);
+            container_log.write().await.init().await?;
+            if let Some(filter_config) = filter_config {
+                ContainerLog::start_filter(&container_log, filter_config).await;
+            }
+            container_io.attach().set_policy(session_policy).await;
+
+            // The runtime may have created the container partially if the
+            // request gets cancelled.
+            let runtime_clone = runtime.clone();
+            let abort_guard = AbortGuard::new(move || {
+                task::spawn(async move {
+                    if let Err(e) = checkpoint::run_runtime(runtime_clone, delete_args).await {
+                        debug!("Unable to delete cancelled container: {:#}", e);
+                    }
+                });
+            });
+
+            // Do not report errors of previous runs of the bundle.
+            let _ = std::fs::remove_file(&runtime_log);
+            report_progress(&progress, create_progress::Phase::Runtime).await;
+            let created = child_reaper
+                .create_child(&runtime, args, &mut container_io, &pidfile, &mapped_fds)
+                .await;
+            abort_guard.disarm();
+            let grandchild_pid = created.map_err(|e| {
+                let err = match runtime_log_errors(&runtime_log) {
+                    errors if errors.is_empty() => RpcError::runtime_failure(e),
+                    errors => RpcError::runtime_output(format!("{:#}", e), errors.as_bytes()),
+                };
+                if runtime_debug {
+                    err.with_runtime_log(runtime_debug_log(&runtime_log))
+                } else {
+                    err
+                }
+            })?;
+            report_progress(&progress, create_progress::Phase::Monitoring).await;
+
+            // register grandchild with server
+            let io = SharedContainerIO::new(container_io);
+            let child = Child::new(
+                id.clone(),
+                grandchild_pid,
+                exit_paths,
+                oom_exit_paths,
+                None,
+                io,
+                tenant.clone(),
+            )
+            .with_runtime(runtime_options);
+            let log_paths = container_log.read().await.paths();
+            let exit_rx = child_reaper.watch_grandchild(child)?;
+            container_states.watch(
+                &tenant,
+                &id,
+                grandchild_pid,
+                restored,
+                child_reaper.get(&id)?.token().clone(),
+            )?;
+            if let Some(listener) = seccomp_listener {
+                let token = child_reaper.get(&id)?.token().clone();
+                seccomp_notify.serve(&id, listener, token).await?;
+            }
+            if !scratch_dirs.is_empty() {
+                let token = child_reaper.get(&id)?.token().clone();
+                task::spawn(async move {
+                    token.cancelled().await;
+                    scratch_dirs.remove();
+                });
+            }
+            scratch_guard.disarm();
+            events.watch(
+                id,
+                tenant,
+                grandchild_pid,
+                log_paths,
+                exit_rx,
+                move |event, exit| {
+                    if let Err(e) = container_states.set_exited(
+                        event.tenant(),
+                        event.id(),
+                        event.pid(),
+                        event.exit_code(),
+                        state_retention,
+                    ) {
+                        debug!("Unable to set exited state: {:#}", e);
+                    }
+                    if let Some(retention) = tombstone_retention {
+                        if let Err(e) = tombstones.record(Tombstone::new(event, exit), retention) {
+                            debug!("Unable to record tombstone: {:#}", e);
+                        }
+                    }
+                },
+            );
+            Ok::<_, anyhow::Error>(grandchild_pid)
+        };
+
+        let tenant = self.tenant().clone();
+        Ok(async move { requests.run(&tenant, &scope, work).await })
+    }
+}
+
+/// Notify the client about the phase of a create request. Failures are only
+/// logged, because the progress is informational.
+async fn report_progress(
+    progress: &Option<create_progress::Client>,
+    phase: create_progress::Phase,
+) {
+    if let Some(progress) = progress {
+        let mut request = progress.update_request();
+        request.get().set_phase(phase);
+        if let Err(e) = request.send().promise.await {
+            debug!("Unable to report create progress: {}", e);
+        }
+    }
+}
+
+/// Resolve the log paths and the exit event of a container, which is either
+/// still known to the reaper or already exited and forgotten.
+async fn archived_container(
+    events: &ContainerEvents,
+    tenant: &str,
+    id: &str,
+    child: Option<ReapableChild>,
+) -> anyhow::Result<(Vec<PathBuf>, Option<ContainerEvent>)> {
+    let child = match child {
+        Some(child) => child,
+        None => {
+            let exit = events
+                .exit(tenant, id, "", None)
+                .ok_or_else(|| RpcError::not_found(format!("container {} not found", id)))?;
+            return Ok((exit.log_paths().clone(), Some(exit)));
+        }
+    };
+
+    let logger = child.io().logger().await;
+    if let Err(e) = ContainerLog::flush(&logger, FLUSH_LOGS_TIMEOUT).await {
+        // The logs written so far are still worth archiving.
+        debug!("Unable to flush logs of container {}: {:#}", id, e);
+    }
+    let paths = logger.read().await.paths();
+    Ok((paths, events.exit(tenant, id, "", Some(child.pid()))))
+}
+
+/// Convert the checkpoint options of a request, using the default image path
+/// if the request does not specify one.
+fn checkpoint_options(
+    options: conmon::checkpoint_options::Reader,
+    default_image_path: Option<&Path>,
+) -> capnp::Result<CheckpointOptions> {
+    let image_path = match (options.get_image_path()?, default_image_path) {
+        ("", Some(default)) => default,
+        ("", None) => return Err(Error::failed("no checkpoint image path specified".into())),
+        (x, _) => Path::new(x),
+    };
+    let work_path = match options.get_work_path()? {
+        "" => None,
+        x => Some(Path::new(x)),
+    };
+    Ok(CheckpointOptions::new(
+        image_path,
+        work_path,
+        options.get_tcp_established(),
+    ))
 }
@@ -0,0 +1,199 @@
+//! Typed errors of requests, which are reported to the client in the
+//! structured error field of the response.
+use crate::rejection::{Reason, Rejection};
+use conmon_common::conmon_capnp::conmon::error_info;
+use std::{error, fmt};
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// The kind of a request error.
+pub enum Kind {
+    /// The container or exec session does not exist.
+    NotFound,
+
+    /// The container already exists.
+    AlreadyExists,
+
+    /// The runtime failed.
+    RuntimeFailure,
+
+    /// The operation did not complete in time.
+    Timeout,
+
+    /// The request got cancelled by the client or its deadline.
+    Cancelled,
+
+    /// Another operation holds the lock of the container.
+    OperationInProgress,
+
+    /// The terminal of the process differs from the request.
+    TerminalMismatch,
+}
+
+#[derive(Debug)]
+/// A request error of a known kind. It can be part of any error chain, the
+/// outermost one decides about the kind reported to the client.
+pub struct RpcError {
+    kind: Kind,
+    message: String,
+    runtime_stderr: String,
+    runtime_log: String,
+    operation: String,
+    rejection: Option<Rejection>,
+}
+
+impl RpcError {
+    fn new(kind: Kind, message: String) -> Self {
+        Self {
+            kind,
+            message,
+            runtime_stderr: String::new(),
+            runtime_log: String::new(),
+            operation: String::new(),
+            rejection: None,
+        }
+    }
+
+    /// The container or exec session does not exist.
+    pub fn not_found(message: String) -> Self {
+        Self::new(Kind::NotFound, message)
+    }
+
+    /// The container already exists.
+    pub fn already_exists(message: String) -> Self {
+        Self::new(Kind::AlreadyExists, message)
+    }
+
+    /// The operation did not complete in time.
+    pub fn timeout(message: String) -> Self {
+        Self::new(Kind::Timeout, message)
+    }
+
+    /// The request got cancelled by the client or its deadline.
+    pub fn cancelled(message: String) -> Self {
+        Self::new(Kind::Cancelled, message)
+    }
+
+    /// Another operation holds the lock of the container.
+    pub fn operation_in_progress(operation: String, message: String) -> Self {
+        Self {
+            operation,
+            ..Self::new(Kind::OperationInProgress, message)
+        }
+    }
+
+    /// The terminal of the process differs from the request.
+    pub fn terminal_mismatch(message: String) -> Self {
+        Self::new(Kind::TerminalMismatch, message)
+    }
+
+    /// The runtime failed without captured error output.
+    pub fn runtime_failure(err: anyhow::Error) -> Self {
+        let message = format!("{:#}", err);
+        Self {
+            rejection: Rejection::classify(&message),
+            ..Self::new(Kind::RuntimeFailure, message)
+        }
+    }
+
+    /// The runtime failed with the captured error output, which gets
+    /// classified if possible.
+    pub fn runtime_output(message: String, stderr: &[u8]) -> Self {
+        let runtime_stderr: String = String::from_utf8_lossy(stderr).trim().into();
+        Self {
+            rejection: Rejection::classify(&runtime_stderr),
+            runtime_stderr,
+            ..Self::new(Kind::RuntimeFailure, message)
+        }
+    }
+
+    /// Attach the debug log of the runtime, which is reported to the client
+    /// but not part of the message.
+    pub fn with_runtime_log(mut self, runtime_log: String) -> Self {
+        self.runtime_log = runtime_log;
+        self
+    }
+
+    /// Write the error into the structured error field of a response. The
+    /// kind is unknown if the error chain does not contain an RpcError.
+    pub fn write(err: &anyhow::Error, mut builder: error_info::Builder) {
+        builder.set_message(&format!("{:#}", err));
+        let rpc_error = match err.chain().find_map(|x| x.downcast_ref::<Self>()) {
+            Some(rpc_error) => rpc_error,
+            None => return,
+        };
+        builder.set_kind(match rpc_error.kind {
+            Kind::NotFound => error_info::Kind::NotFound,
+            Kind::AlreadyExists => error_info::Kind::AlreadyExists,
+            Kind::RuntimeFailure => error_info::Kind::RuntimeFailure,
+            Kind::Timeout => error_info::Kind::Timeout,
+            Kind::Cancelled => error_info::Kind::Cancelled,
+            Kind::OperationInProgress => error_info::Kind::OperationInProgress,
+            Kind::TerminalMismatch => error_info::Kind::TerminalMismatch,
+        });
+        builder.set_runtime_stderr(&rpc_error.runtime_stderr);
+        builder.set_runtime_log(&rpc_error.runtime_log);
+        builder.set_operation(&rpc_error.operation);
+        if let Some(rejection) = &rpc_error.rejection {
+            builder.set_reason(match rejection.reason() {
+                Reason::CgroupControllerNotDelegated => {
+                    error_info::Reason::CgroupControllerNotDelegated
+                }
+                Reason::IdMappingUnavailable => error_info::Reason::IdMappingUnavailable,
+                Reason::ExecutableNotFound => error_info::Reason::ExecutableNotFound,
+                Reason::BundleInvalid => error_info::Reason::BundleInvalid,
+                Reason::PermissionDenied => error_info::Reason::PermissionDenied,
+            });
+            builder.set_hint(rejection.hint());
+        }
+    }
+}
+
+impl fmt::Display for RpcError {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        if self.runtime_stderr.is_empty() {
+            write!(f, "{}", self.message)
+        } else {
+            write!(f, "{}: {}", self.message, self.runtime_stderr)
+        }
+    }
+}
+
+impl error::Error for RpcError {}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use anyhow::Context;
+
+    #[test]
+    fn find_in_chain() {
+        let err: anyhow::Result<()> = Err(RpcError::runtime_output(
+            "runtime exited with status 1".into(),
+            b"error output\n",
+        )
+        .into());
+        let err = err.context("checkpoint").unwrap_err();
+        assert_eq!(
+            format!("{:#}", err),
+            "checkpoint: runtime exited with status 1: error output"
+        );
+
+        let rpc_error = err
+            .chain()
+            .find_map(|x| x.downcast_ref::<RpcError>())
+            .unwrap();
+        assert_eq!(rpc_error.kind, Kind::RuntimeFailure);
+        assert_eq!(rpc_error.runtime_stderr, "error output");
+        assert!(rpc_error.rejection.is_none());
+    }
+
+    #[test]
+    fn classify_runtime_output() {
+        let rpc_error = RpcError::runtime_output(
+            "runtime exited with status 1".into(),
+            b"the requested cgroup controller `pids` is not available",
+        );
+        let rejection = rpc_error.rejection.unwrap();
+        assert_eq!(rejection.reason(), Reason::CgroupControllerNotDelegated);
+    }
+}
@@ -0,0 +1,283 @@
+//! Settings of the OCI runtime, which can be overridden per container to
+//! operate different runtimes with a single server.
+use crate::{
+    checkpoint::CheckpointOptions,
+    config::{CgroupManager, Config},
+    container_io::{ContainerIO, ContainerIOType},
+    rejection::RUNTIME_LOG,
+};
+use anyhow::{bail, Result};
+use capnp::text_list::Reader;
+use conmon_common::conmon_capnp::conmon::runtime_options::{self, CgroupManager as Override};
+use getset::{CopyGetters, Getters};
+use nix::sys::signal::Signal;
+use std::path::{Path, PathBuf};
+use tracing::debug;
+
+#[derive(Clone, CopyGetters, Debug, Eq, Getters, PartialEq)]
+/// The OCI runtime of a container and its global options.
+pub struct RuntimeOptions {
+    /// Path of the runtime binary.
+    #[getset(get = "pub")]
+    runtime: PathBuf,
+
+    /// Root directory of the runtime state, the runtime default if not set.
+    #[getset(get = "pub")]
+    runtime_root: Option<PathBuf>,
+
+    /// Cgroup manager used by the runtime.
+    #[getset(get_copy = "pub")]
+    cgroup_manager: CgroupManager,
+
+    /// Whether the runtime writes debug messages into its log.
+    #[getset(get_copy = "pub")]
+    debug: bool,
+
+    /// Bundle passed to the runtime instead of the one of the container.
+    #[getset(get = "pub")]
+    runtime_bundle: Option<PathBuf>,
+}
+
+impl RuntimeOptions {
+    /// Create the runtime options of the server configuration.
+    pub fn from_config(config: &Config) -> Self {
+        Self {
+            runtime: config.runtime().clone(),
+            runtime_root: config.runtime_root().clone(),
+            cgroup_manager: config.cgroup_manager(),
+            debug: false,
+            runtime_bundle: None,
+        }
+    }
+
+    /// Apply the overrides of a request to the options.
+    pub fn with_overrides(mut self, overrides: runtime_options::Reader) -> Result<Self> {
+        match overrides.get_runtime()? {
+            "" => {}
+            runtime => {
+                let runtime = PathBuf::from(runtime);
+                if !runtime.is_absolute() || !runtime.exists() {
+                    bail!("runtime path '{}' does not exist", runtime.display())
+                }
+                self.runtime = runtime;
+            }
+        }
+        match overrides.get_runtime_root()? {
+            "" => {}
+            root => self.runtime_root = Some(root.into()),
+        }
+        match overrides.get_cgroup_manager()? {
+            Override::Default => {}
+            Override::Systemd => self.cgroup_manager = CgroupManager::Systemd,
+            Override::Cgroupfs => self.cgroup_manager = CgroupManager::Cgroupfs,
+        }
+        Ok(self)
+    }
+
+    /// Enable or disable the debug messages of the runtime.
+    pub fn with_debug(mut self, debug: bool) -> Self {
+        self.debug = debug;
+        self
+    }
+
+    /// Pass a different bundle to the runtime, while the runtime log stays
+    /// within the bundle of the container.
+    pub fn with_runtime_bundle(mut self, runtime_bundle: Option<PathBuf>) -> Self {
+        self.runtime_bundle = runtime_bundle;
+        self
+    }
+
+    /// Generate the global OCI runtime CLI arguments, which precede the
+    /// command.
+    fn global_runtime_args(&self) -> Vec<String> {
+        let mut args = vec![];
+
+        if let Some(rr) = self.runtime_root() {
+            args.push(format!("--root={}", rr.display()));
+        }
+
+        if self.cgroup_manager() == CgroupManager::Systemd {
+            args.push("--systemd-cgroup".to_string());
+        }
+
+        if self.debug() {
+            args.push("--debug".to_string());
+        }
+
+        args
+    }
+
+    /// Generate the OCI runtime CLI arguments from the provided parameters.
+    /// The container gets restored from the checkpoint if provided instead of
+    /// being created.
+    pub fn generate_runtime_args(
+        &self,
+        id: &str,
+        bundle_path: &Path,
+        container_io: &ContainerIO,
+        pidfile: &Path,
+        preserve_fds: usize,
+        checkpoint: Option<&CheckpointOptions>,
+    ) -> Result<Vec<String>> {
+        let mut args = self.global_runtime_args();
+
+        // The errors of the runtime log are reported if it fails.
+        args.extend([
+            format!("--log={}", bundle_path.join(RUNTIME_LOG).display()),
+            "--log-format=json".to_string(),
+        ]);
+
+        match checkpoint {
+            Some(checkpoint) => {
+                args.extend(["restore".to_string(), "--detach".to_string()]);
+                args.extend(checkpoint.args());
+            }
+            None => args.push("create".to_string()),
+        }
+
+        let runtime_bundle = self.runtime_bundle().as_deref().unwrap_or(bundle_path);
+        args.extend([
+            "--bundle".to_string(),
+            runtime_bundle.display().to_string(),
+            "--pid-file".to_string(),
+            pidfile.display().to_string(),
+        ]);
+
+        if preserve_fds > 0 {
+            args.push(format!("--preserve-fds={}", preserve_fds));
+        }
+
+        if let ContainerIOType::Terminal(terminal) = container_io.typ() {
+            args.push(format!("--console-socket={}", terminal.path().display()));
+        }
+        args.push(id.into());
+        debug!("Runtime args {:?}", args.join(" "));
+        Ok(args)
+    }
+
+    /// Generate the OCI runtime CLI arguments for checkpointing a container.
+    pub fn generate_checkpoint_args(
+        &self,
+        id: &str,
+        checkpoint: &CheckpointOptions,
+        leave_running: bool,
+    ) -> Vec<String> {
+        let mut args = self.global_runtime_args();
+
+        args.push("checkpoint".to_string());
+        args.extend(checkpoint.args());
+        if leave_running {
+            args.push("--leave-running".to_string());
+        }
+        args.push(id.into());
+        debug!("Checkpoint args {:?}", args.join(" "));
+        args
+    }
+
+    /// Generate the OCI runtime CLI arguments for sending a signal to all
+    /// processes of a container.
+    pub fn generate_kill_all_args(&self, id: &str, signal: Signal) -> Vec<String> {
+        let mut args = self.global_runtime_args();
+
+        args.push("kill".to_string());
+        args.push("--all".to_string());
+        args.push(id.into());
+        args.push(signal.as_str().into());
+        debug!("Kill args {:?}", args.join(" "));
+        args
+    }
+
+    /// Generate the OCI runtime CLI arguments for forcibly deleting a
+    /// container, which may be created only partially.
+    pub fn generate_delete_args(&self, id: &str) -> Vec<String> {
+        let mut args = self.global_runtime_args();
+
+        args.push("delete".to_string());
+        args.push("--force".to_string());
+        args.push(id.into());
+        debug!("Delete args {:?}", args.join(" "));
+        args
+    }
+
+    /// Generate the OCI runtime CLI arguments from the provided parameters.
+    /// The runtime writes its log to the log path if provided.
+    pub fn generate_exec_sync_args(
+        &self,
+        id: &str,
+        pidfile: &Path,
+        container_io: &ContainerIO,
+        command: &Reader,
+        log: Option<&Path>,
+        preserve_fds: usize,
+    ) -> Result<Vec<String>> {
+        let mut args = self.global_runtime_args();
+
+        if let Some(log) = log {
+            args.extend([
+                format!("--log={}", log.display()),
+                "--log-format=json".to_string(),
+            ]);
+        }
+
+        args.push("exec".to_string());
+        args.push("-d".to_string());
+
+        if let ContainerIOType::Terminal(terminal) = container_io.typ() {
+            args.push(format!("--console-socket={}", terminal.path().display()));
+            args.push("--tty".to_string());
+        }
+
+        args.push(format!("--pid-file={}", pidfile.display()));
+        if preserve_fds > 0 {
+            args.push(format!("--preserve-fds={}", preserve_fds));
+        }
+        args.push(id.into());
+
+        for value in command.iter() {
+            args.push(value?.to_string());
+        }
+
+        debug!("Exec args {:?}", args.join(" "));
+        Ok(args)
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    fn options(runtime_root: Option<&str>, cgroup_manager: CgroupManager) -> RuntimeOptions {
+        RuntimeOptions {
+            runtime: "/usr/bin/runc".into(),
+            runtime_root: runtime_root.map(PathBuf::from),
+            cgroup_manager,
+            debug: false,
+            runtime_bundle: None,
+        }
+    }
+
+    #[test]
+    fn global_args() {
+        assert!(options(None, CgroupManager::Cgroupfs)
+            .global_runtime_args()
+            .is_empty());
+        assert_eq!(
+            options(Some("/run/crun"), CgroupManager::Systemd).global_runtime_args(),
+            vec!["--root=/run/crun", "--systemd-cgroup"]
+        );
+        assert_eq!(
+            options(None, CgroupManager::Cgroupfs)
+                .with_debug(true)
+                .global_runtime_args(),
+            vec!["--debug"]
+        );
+    }
+
+    #[test]
+    fn delete_args() {
+        assert_eq!(
+            options(Some("/run/kata"), CgroupManager::Cgroupfs).generate_delete_args("id"),
+            vec!["--root=/run/kata", "delete", "--force", "id"]
+        );
+    }
+}
@@ -0,0 +1,573 @@
+//! Size limited scratch directories, which the server creates for a container
+//! and removes once it exits.
+use anyhow::{bail, Context, Result};
+use capnp::struct_list;
+use conmon_common::conmon_capnp::conmon::scratch_dir;
+use nix::{
+    errno::Errno,
+    mount::{mount, umount2, MntFlags, MsFlags},
+};
+use std::{
+    collections::HashSet,
+    fs,
+    path::{Path, PathBuf},
+};
+use tracing::debug;
+
+/// The directory within the bundle holding the scratch directories.
+pub const SCRATCH_DIR: &str = "conmon-scratch";
+
+/// The directory within the bundle holding the config passed to the runtime,
+/// which contains the bind mounts of the scratch directories. The config of
+/// the bundle itself is never modified.
+const SCRATCH_BUNDLE: &str = "conmon-scratch-bundle";
+
+/// The file name of the OCI runtime config.
+const CONFIG: &str = "config.json";
+
+#[derive(Clone, Debug, Default)]
+/// The scratch directories of a container.
+pub struct ScratchDirs {
+    /// The bundle of the container.
+    bundle: PathBuf,
+
+    /// The directory holding the scratch directories.
+    root: PathBuf,
+
+    /// The requested directories.
+    dirs: Vec<ScratchDir>,
+}
+
+#[derive(Clone, Debug)]
+/// A single scratch directory.
+struct ScratchDir {
+    name: String,
+    size: u64,
+
+    /// The path inside the container, which is not mounted if empty.
+    destination: String,
+}
+
+impl ScratchDirs {
+    /// Validate the scratch directories requested for the bundle.
+    pub fn from_request(
+        bundle_path: &Path,
+        dirs: struct_list::Reader<scratch_dir::Owned>,
+    ) -> Result<Self> {
+        let mut names = HashSet::new();
+        let mut scratch_dirs = Self {
+            bundle: bundle_path.into(),
+            root: bundle_path.join(SCRATCH_DIR),
+            dirs: Vec::with_capacity(dirs.len() as usize),
+        };
+        for dir in dirs.iter() {
+            let name = dir.get_name()?;
+            validate_name(name)?;
+            if !names.insert(name) {
+                bail!("duplicate scratch dir {}", name)
+            }
+            if dir.get_size_bytes() == 0 {
+                bail!("size of scratch dir {} has to be positive", name)
+            }
+            let destination = dir.get_destination()?;
+            if !destination.is_empty() && !Path::new(destination).is_absolute() {
+                bail!("destination of scratch dir {} has to be absolute", name)
+            }
+            scratch_dirs.dirs.push(ScratchDir {
+                name: name.to_string(),
+                size: dir.get_size_bytes(),
+                destination: destination.to_string(),
+            });
+        }
+        Ok(scratch_dirs)
+    }
+
+    /// Returns true if no scratch directories are requested.
+    pub fn is_empty(&self) -> bool {
+        self.dirs.is_empty()
+    }
+
+    /// The host paths of the directories, in the order of the request.
+    pub fn paths(&self) -> Vec<PathBuf> {
+        self.dirs
+            .iter()
+            .map(|dir| self.root.join(&dir.name))
+            .collect()
+    }
+
+    /// The bundle to pass to the runtime, which differs from the bundle of
+    /// the container if any directory gets mounted into the container.
+    pub fn runtime_bundle(&self) -> Option<PathBuf> {
+        if self.dirs.iter().all(|dir| dir.destination.is_empty()) {
+            return None;
+        }
+        Some(self.bundle.join(SCRATCH_BUNDLE))
+    }
+
+    /// Create the directories and mount a tmpfs of the requested size on each
+    /// of them. Already created directories get removed on failure.
+    pub fn create(&self) -> Result<()> {
+        if let Err(e) = self.try_create() {
+            self.remove();
+            return Err(e);
+        }
+        Ok(())
+    }
+
+    fn try_create(&self) -> Result<()> {
+        for dir in &self.dirs {
+            let path = self.root.join(&dir.name);
+            debug!("Creating scratch dir {}", path.display());
+            fs::create_dir_all(&path).with_context(|| format!("create {}", path.display()))?;
+            let options = format!("size={},mode=1777", dir.size);
+            match mount(
+                Some("tmpfs"),
+                &path,
+                Some("tmpfs"),
+                MsFlags::MS_NOSUID | MsFlags::MS_NODEV,
+                Some(options.as_str()),
+            ) {
+                Ok(()) => {}
+                Err(Errno::EPERM) => bail!(
+                    "mount tmpfs on {}: scratch dirs require CAP_SYS_ADMIN, \
+                     which rootless servers lack outside of a user and mount namespace",
+                    path.display()
+                ),
+                Err(e) => {
+                    return Err(e).with_context(|| format!("mount tmpfs on {}", path.display()))
+                }
+            }
+        }
+        if let Some(runtime_bundle) = self.runtime_bundle() {
+            self.write_runtime_config(&runtime_bundle)?;
+        }
+        Ok(())
+    }
+
+    /// Write the config of the bundle with the bind mounts of the scratch
+    /// directories to the runtime bundle.
+    fn write_runtime_config(&self, runtime_bundle: &Path) -> Result<()> {
+        let path = self.bundle.join(CONFIG);
+        let config =
+            fs::read_to_string(&path).with_context(|| format!("read {}", path.display()))?;
+        let mounts: Vec<Mount> = self
+            .dirs
+            .iter()
+            .filter(|dir| !dir.destination.is_empty())
+            .map(|dir| Mount {
+                destination: dir.destination.clone(),
+                source: self.root.join(&dir.name),
+            })
+            .collect();
+        let config = add_mounts(&config, &self.bundle, &mounts)
+            .with_context(|| format!("add scratch dir mounts to {}", path.display()))?;
+        fs::create_dir_all(runtime_bundle)
+            .with_context(|| format!("create {}", runtime_bundle.display()))?;
+        let path = runtime_bundle.join(CONFIG);
+        fs::write(&path, config).with_context(|| format!("write {}", path.display()))
+    }
+
+    /// Unmount and remove the directories. Failures are only logged, because
+    /// the container is gone at this point.
+    pub fn remove(&self) {
+        if self.is_empty() {
+            return;
+        }
+        for path in self.paths() {
+            if let Err(e) = umount2(&path, MntFlags::MNT_DETACH) {
+                debug!("Unable to unmount scratch dir {}: {}", path.display(), e);
+            }
+        }
+        if let Err(e) = fs::remove_dir_all(&self.root) {
+            debug!(
+                "Unable to remove scratch dirs {}: {}",
+                self.root.display(),
+                e
+            );
+        }
+        if let Some(runtime_bundle) = self.runtime_bundle() {
+            if let Err(e) = fs::remove_dir_all(&runtime_bundle) {
+                debug!(
+                    "Unable to remove runtime bundle {}: {}",
+                    runtime_bundle.display(),
+                    e
+                );
+            }
+        }
+    }
+}
+
+#[derive(Debug)]
+/// A bind mount of a scratch directory.
+struct Mount {
+    destination: String,
+    source: PathBuf,
+}
+
+impl Mount {
+    fn to_json(&self) -> String {
+        format!(
+            r#"{{"destination":{},"type":"bind","source":{},"options":["rbind","rw","nosuid","nodev"]}}"#,
+            json_string(&self.destination),
+            json_string(&self.source.to_string_lossy()),
+        )
+    }
+}
+
+/// Append the mounts to the OCI config and resolve a relative root path
+/// against the bundle, because the config gets moved out of the bundle. All
+/// other content of the config is kept as is, including fields unknown to
+/// the server.
+fn add_mounts(config: &str, bundle: &Path, mounts: &[Mount]) -> Result<String> {
+    let mut scanner = Scanner::new(config);
+    scanner.skip_whitespace();
+    let start = scanner.pos;
+    let members = scanner.object()?;
+    scanner.skip_whitespace();
+    if scanner.pos != config.len() {
+        bail!("trailing content after the config object")
+    }
+
+    // The edits as range and replacement, which do not overlap.
+    let mut edits = vec![];
+    let added = mounts
+        .iter()
+        .map(Mount::to_json)
+        .collect::<Vec<_>>()
+        .join(",");
+    match members.iter().find(|member| member.key == "mounts") {
+        Some(member) if config.as_bytes()[member.start] == b'[' => {
+            let end = member.end - 1;
+            let separator = if config[member.start + 1..end].trim().is_empty() {
+                ""
+            } else {
+                ","
+            };
+            edits.push((end, end, format!("{}{}", separator, added)));
+        }
+        Some(_) => bail!("mounts is no array"),
+        None => {
+            let separator = if members.is_empty() { "" } else { "," };
+            edits.push((
+                start + 1,
+                start + 1,
+                format!(r#""mounts":[{}]{}"#, added, separator),
+            ));
+        }
+    }
+
+    if let Some(root) = members.iter().find(|member| member.key == "root") {
+        let mut scanner = Scanner::at(config, root.start);
+        let root_members = scanner.object()?;
+        if let Some(path) = root_members.iter().find(|member| member.key == "path") {
+            let value = Scanner::at(config, path.start).string()?;
+            if Path::new(&value).is_relative() {
+                let absolute = bundle.join(value);
+                edits.push((
+                    path.start,
+                    path.end,
+                    json_string(&absolute.to_string_lossy()),
+                ));
+            }
+        }
+    }
+
+    let mut result = config.to_string();
+    edits.sort_by_key(|(start, _, _)| *start);
+    for (start, end, replacement) in edits.into_iter().rev() {
+        result.replace_range(start..end, &replacement);
+    }
+    Ok(result)
+}
+
+/// Encode the value as JSON string.
+fn json_string(value: &str) -> String {
+    let mut result = String::with_capacity(value.len() + 2);
+    result.push('"');
+    for c in value.chars() {
+        match c {
+            '"' => result.push_str("\\\""),
+            '\\' => result.push_str("\\\\"),
+            c if (c as u32) < 0x20 => result.push_str(&format!("\\u{:04x}", c as u32)),
+            c => result.push(c),
+        }
+    }
+    result.push('"');
+    result
+}
+
+#[derive(Debug)]
+/// A member of a JSON object and the byte range of its value.
+struct Member {
+    key: String,
+    start: usize,
+    end: usize,
+}
+
+/// Minimal JSON scanner, which locates the values within a document without
+/// rebuilding it.
+struct Scanner<'a> {
+    input: &'a str,
+    pos: usize,
+}
+
+impl<'a> Scanner<'a> {
+    fn new(input: &'a str) -> Self {
+        Self::at(input, 0)
+    }
+
+    fn at(input: &'a str, pos: usize) -> Self {
+        Self { input, pos }
+    }
+
+    fn peek(&self) -> Option<u8> {
+        self.input.as_bytes().get(self.pos).copied()
+    }
+
+    fn skip_whitespace(&mut self) {
+        while let Some(b' ' | b'\t' | b'\n' | b'\r') = self.peek() {
+            self.pos += 1;
+        }
+    }
+
+    fn expect(&mut self, expected: u8) -> Result<()> {
+        self.skip_whitespace();
+        match self.peek() {
+            Some(c) if c == expected => {
+                self.pos += 1;
+                Ok(())
+            }
+            _ => bail!("expected {:?} at offset {}", expected as char, self.pos),
+        }
+    }
+
+    /// Skip a value of any type.
+    fn value(&mut self) -> Result<()> {
+        self.skip_whitespace();
+        match self.peek() {
+            Some(b'{') => self.object().map(|_| ()),
+            Some(b'[') => self.array(),
+            Some(b'"') => self.string().map(|_| ()),
+            Some(_) => {
+                let start = self.pos;
+                while let Some(c) = self.peek() {
+                    if matches!(c, b',' | b']' | b'}' | b' ' | b'\t' | b'\n' | b'\r') {
+                        break;
+                    }
+                    self.pos += 1;
+                }
+                if self.pos == start {
+                    bail!("expected a value at offset {}", start)
+                }
+                Ok(())
+            }
+            None => bail!("unexpected end of the config"),
+        }
+    }
+
+    /// Scan an object and return its members.
+    fn object(&mut self) -> Result<Vec<Member>> {
+        self.expect(b'{')?;
+        let mut members = vec![];
+        self.skip_whitespace();
+        if self.peek() == Some(b'}') {
+            self.pos += 1;
+            return Ok(members);
+        }
+        loop {
+            self.skip_whitespace();
+            let key = self.string()?;
+            self.expect(b':')?;
+            self.skip_whitespace();
+            let start = self.pos;
+            self.value()?;
+            members.push(Member {
+                key,
+                start,
+                end: self.pos,
+            });
+            self.skip_whitespace();
+            match self.peek() {
+                Some(b',') => self.pos += 1,
+                Some(b'}') => {
+                    self.pos += 1;
+                    return Ok(members);
+                }
+                _ => bail!("expected ',' or '}}' at offset {}", self.pos),
+            }
+        }
+    }
+
+    fn array(&mut self) -> Result<()> {
+        self.expect(b'[')?;
+        self.skip_whitespace();
+        if self.peek() == Some(b']') {
+            self.pos += 1;
+            return Ok(());
+        }
+        loop {
+            self.value()?;
+            self.skip_whitespace();
+            match self.peek() {
+                Some(b',') => self.pos += 1,
+                Some(b']') => {
+                    self.pos += 1;
+                    return Ok(());
+                }
+                _ => bail!("expected ',' or ']' at offset {}", self.pos),
+            }
+        }
+    }
+
+    /// Scan and decode a string.
+    fn string(&mut self) -> Result<String> {
+        self.expect(b'"')?;
+        let mut result = String::new();
+        let mut chars = self.input[self.pos..].char_indices();
+        while let Some((offset, c)) = chars.next() {
+            match c {
+                '"' => {
+                    self.pos += offset + 1;
+                    return Ok(result);
+                }
+                '\\' => match chars.next().map(|(_, c)| c) {
+                    Some('"') => result.push('"'),
+                    Some('\\') => result.push('\\'),
+                    Some('/') => result.push('/'),
+                    Some('b') => result.push('\u{8}'),
+                    Some('f') => result.push('\u{c}'),
+                    Some('n') => result.push('\n'),
+                    Some('r') => result.push('\r'),
+                    Some('t') => result.push('\t'),
+                    Some('u') => {
+                        let mut hex = String::with_capacity(4);
+                        for _ in 0..4 {
+                            hex.push(chars.next().context("truncated unicode escape")?.1);
+                        }
+                        let code = u32::from_str_radix(&hex, 16).context("parse unicode escape")?;
+                        // Surrogate pairs are not needed for paths and
+                        // replaced.
+                        result.push(char::from_u32(code).unwrap_or(char::REPLACEMENT_CHARACTER));
+                    }
+                    _ => bail!("invalid escape in string at offset {}", self.pos + offset),
+                },
+                c => result.push(c),
+            }
+        }
+        bail!("unterminated string at offset {}", self.pos)
+    }
+}
+
+/// Ensure that the name is a single path component.
+fn validate_name(name: &str) -> Result<()> {
+    if name.is_empty() || name == "." || name == ".." || name.contains('/') {
+        bail!("invalid scratch dir name {:?}", name)
+    }
+    Ok(())
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use capnp::message;
+
+    fn scratch_dirs(dirs: &[(&str, u64)]) -> Result<ScratchDirs> {
+        let mut message = message::Builder::new_default();
+        let mut list =
+            message.initn_root::<struct_list::Builder<scratch_dir::Owned>>(dirs.len() as u32);
+        for (i, (name, size)) in dirs.iter().enumerate() {
+            let mut dir = list.reborrow().get(i as u32);
+            dir.set_name(name);
+            dir.set_size_bytes(*size);
+        }
+        let list = message.get_root_as_reader::<struct_list::Reader<scratch_dir::Owned>>()?;
+        ScratchDirs::from_request(Path::new("/bundle"), list)
+    }
+
+    #[test]
+    fn from_request_success() -> Result<()> {
+        let dirs = scratch_dirs(&[("tmp", 1024), ("cache", 2048)])?;
+        assert_eq!(
+            dirs.paths(),
+            vec![
+                PathBuf::from("/bundle/conmon-scratch/tmp"),
+                PathBuf::from("/bundle/conmon-scratch/cache")
+            ]
+        );
+        assert!(scratch_dirs(&[])?.is_empty());
+        Ok(())
+    }
+
+    #[test]
+    fn from_request_failure() {
+        assert!(scratch_dirs(&[("", 1024)]).is_err());
+        assert!(scratch_dirs(&[("..", 1024)]).is_err());
+        assert!(scratch_dirs(&[("a/b", 1024)]).is_err());
+        assert!(scratch_dirs(&[("tmp", 0)]).is_err());
+        assert!(scratch_dirs(&[("tmp", 1024), ("tmp", 1024)]).is_err());
+    }
+
+    #[test]
+    fn remove_without_dirs() {
+        ScratchDirs::default().remove();
+    }
+
+    #[test]
+    fn runtime_bundle_only_with_destination() -> Result<()> {
+        assert_eq!(scratch_dirs(&[("tmp", 1024)])?.runtime_bundle(), None);
+        Ok(())
+    }
+
+    fn mount(destination: &str) -> Mount {
+        Mount {
+            destination: destination.into(),
+            source: PathBuf::from("/bundle/conmon-scratch/tmp"),
+        }
+    }
+
+    #[test]
+    fn add_mounts_to_existing() -> Result<()> {
+        let config = r#"{
+            "ociVersion": "1.0.2",
+            "unknown": {"nested": ["a", "b\"}"]},
+            "root": {"path": "rootfs", "readonly": true},
+            "mounts": [{"destination": "/proc", "type": "proc", "source": "proc"}]
+        }"#;
+        let result = add_mounts(config, Path::new("/bundle"), &[mount("/scratch")])?;
+        assert_eq!(
+            result,
+            r#"{
+            "ociVersion": "1.0.2",
+            "unknown": {"nested": ["a", "b\"}"]},
+            "root": {"path": "/bundle/rootfs", "readonly": true},
+            "mounts": [{"destination": "/proc", "type": "proc", "source": "proc"},{"destination":"/scratch","type":"bind","source":"/bundle/conmon-scratch/tmp","options":["rbind","rw","nosuid","nodev"]}]
+        }"#
+        );
+        Ok(())
+    }
+
+    #[test]
+    fn add_mounts_without_mounts() -> Result<()> {
+        let result = add_mounts(
+            r#"{"root":{"path":"/rootfs"}}"#,
+            Path::new("/bundle"),
+            &[mount("/a\"b")],
+        )?;
+        assert_eq!(
+            result,
+            r#"{"mounts":[{"destination":"/a\"b","type":"bind","source":"/bundle/conmon-scratch/tmp","options":["rbind","rw","nosuid","nodev"]}],"root":{"path":"/rootfs"}}"#
+        );
+        assert_eq!(
+            add_mounts("{}", Path::new("/bundle"), &[mount("/a")])?,
+            r#"{"mounts":[{"destination":"/a","type":"bind","source":"/bundle/conmon-scratch/tmp","options":["rbind","rw","nosuid","nodev"]}]}"#
+        );
+        Ok(())
+    }
+
+    #[test]
+    fn add_mounts_invalid_config() {
+        for config in ["", "[]", r#"{"mounts": {}}"#, r#"{"a": 1"#, r#"{"a": 1} x"#] {
+            assert!(add_mounts(config, Path::new("/bundle"), &[mount("/a")]).is_err());
+        }
+    }
+}
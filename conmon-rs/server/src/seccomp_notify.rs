@@ -0,0 +1,435 @@
+//! Proxying of seccomp notifications of containers to client handlers.
+//!
+//! The runtime sends the seccomp notify fd of a container to the listener
+//! path of its seccomp profile, which the server binds if requested on
+//! creation. The notifications received on the fd are forwarded to the
+//! handler a client registered for the container, which decides how the
+//! syscall continues. Syscalls fail with `EPERM` while no handler is
+//! registered.
+use crate::fd_socket::Fd;
+use anyhow::{bail, format_err, Context, Result};
+use conmon_common::conmon_capnp::conmon::{
+    seccomp_notification, seccomp_notification_response, seccomp_notify_handler,
+};
+use nix::{
+    errno::Errno,
+    ioctl_readwrite,
+    poll::{poll, PollFd, PollFlags},
+};
+use sendfd::RecvWithFd;
+use std::{
+    collections::HashMap,
+    fs,
+    io::ErrorKind,
+    os::unix::io::AsRawFd,
+    path::{Path, PathBuf},
+    sync::{Arc, Mutex, MutexGuard},
+    time::Duration,
+};
+use tokio::{
+    io::unix::AsyncFd,
+    net::UnixListener,
+    sync::{mpsc, oneshot},
+    task, time,
+};
+use tokio_util::sync::CancellationToken;
+use tracing::{debug, debug_span, Instrument};
+
+/// The time to wait for the runtime to send the notify fd after the
+/// container has been created.
+const RECEIVE_TIMEOUT: Duration = Duration::from_secs(5);
+
+/// The time a handler may take to decide about a syscall before it fails.
+const HANDLER_TIMEOUT: Duration = Duration::from_secs(10);
+
+/// The number of notifications which can be queued per handler.
+const HANDLER_CAPACITY: usize = 64;
+
+/// The maximum size of the container state the runtime sends along with the
+/// notify fd.
+const MAX_STATE_SIZE: usize = 64 * 1024;
+
+/// The flag of a response which lets the kernel continue the syscall.
+const SECCOMP_USER_NOTIF_FLAG_CONTINUE: u32 = 1;
+
+#[repr(C)]
+#[derive(Clone, Copy, Debug, Default)]
+/// The `struct seccomp_data` of the kernel.
+pub struct SeccompData {
+    nr: i32,
+    arch: u32,
+    instruction_pointer: u64,
+    args: [u64; 6],
+}
+
+#[repr(C)]
+#[derive(Clone, Copy, Debug, Default)]
+/// The `struct seccomp_notif` of the kernel.
+pub struct SeccompNotif {
+    id: u64,
+    pid: u32,
+    flags: u32,
+    data: SeccompData,
+}
+
+#[repr(C)]
+#[derive(Clone, Copy, Debug, Default, Eq, PartialEq)]
+/// The `struct seccomp_notif_resp` of the kernel.
+pub struct SeccompNotifResp {
+    id: u64,
+    val: i64,
+    error: i32,
+    flags: u32,
+}
+
+ioctl_readwrite!(notif_recv, b'!', 0, SeccompNotif);
+ioctl_readwrite!(notif_send, b'!', 1, SeccompNotifResp);
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// The decision of a handler about a syscall.
+pub enum Decision {
+    /// Let the kernel execute the syscall.
+    Continue,
+
+    /// Fail the syscall with the errno.
+    Error(i32),
+
+    /// Skip the syscall and return the value.
+    Value(i64),
+}
+
+impl Default for Decision {
+    fn default() -> Self {
+        Self::Error(libc::EPERM)
+    }
+}
+
+impl Decision {
+    /// Read the decision of a handler response.
+    fn from_response(response: seccomp_notification_response::Reader) -> capnp::Result<Self> {
+        Ok(match response.get_action()? {
+            seccomp_notification_response::Action::ContinueSyscall => Self::Continue,
+            seccomp_notification_response::Action::ReturnError => match response.get_errno() {
+                errno if errno > 0 => Self::Error(errno),
+                _ => Self::default(),
+            },
+            seccomp_notification_response::Action::ReturnValue => Self::Value(response.get_value()),
+        })
+    }
+
+    /// The kernel response to the notification with the provided ID.
+    fn response(self, id: u64) -> SeccompNotifResp {
+        let mut resp = SeccompNotifResp {
+            id,
+            ..Default::default()
+        };
+        match self {
+            Self::Continue => resp.flags = SECCOMP_USER_NOTIF_FLAG_CONTINUE,
+            Self::Error(errno) => resp.error = -errno,
+            Self::Value(val) => resp.val = val,
+        }
+        resp
+    }
+}
+
+/// A notification forwarded to a handler along with the channel for its
+/// decision.
+type Request = (SeccompNotif, oneshot::Sender<Decision>);
+
+#[derive(Clone, Debug, Default)]
+/// The seccomp notify fds served for all containers, by container ID. The
+/// handler of a container is not set until a client registered one.
+pub struct SeccompNotify(Arc<Mutex<HashMap<String, Option<mpsc::Sender<Request>>>>>);
+
+impl SeccompNotify {
+    /// Receive the notify fd of a created container from the listener and
+    /// serve it until the container exits or the token gets cancelled.
+    pub async fn serve(
+        &self,
+        id: &str,
+        listener: SeccompListener,
+        token: CancellationToken,
+    ) -> Result<()> {
+        let fd = time::timeout(RECEIVE_TIMEOUT, listener.receive())
+            .await
+            .context("timed out waiting for the seccomp notify fd")??;
+        let fd = Arc::new(AsyncFd::new(fd).context("register seccomp notify fd")?);
+        debug!("Received seccomp notify fd {}", fd.as_raw_fd());
+
+        self.lock()?.insert(id.into(), None);
+        let notify = self.clone();
+        let id = id.to_string();
+        task::spawn(
+            async move {
+                if let Err(e) = notify.run(&id, fd, &token).await {
+                    debug!("Stopping seccomp notify: {:#}", e);
+                }
+                if let Ok(mut handlers) = notify.lock() {
+                    handlers.remove(&id);
+                }
+            }
+            .instrument(debug_span!("seccomp_notify")),
+        );
+        Ok(())
+    }
+
+    /// Register the handler of a container on the local task set, which
+    /// replaces the previous one. The handler gets dropped if the token gets
+    /// cancelled.
+    pub fn spawn_handler(
+        &self,
+        id: &str,
+        token: CancellationToken,
+        handler: seccomp_notify_handler::Client,
+    ) -> Result<()> {
+        let (tx, rx) = mpsc::channel(HANDLER_CAPACITY);
+        match self.lock()?.get_mut(id) {
+            Some(current) => *current = Some(tx),
+            None => bail!("seccomp notifications of container {} are not served", id),
+        }
+
+        task::spawn_local(
+            async move {
+                let mut request = handler.done_request();
+                if let Err(e) = forward(rx, &token, &handler).await {
+                    debug!("Stopping seccomp notify handler: {:#}", e);
+                    request.get().set_error(&format!("{:#}", e));
+                }
+                // The client is likely gone if this fails.
+                if let Err(e) = request.send().promise.await {
+                    debug!("Unable to notify seccomp notify handler: {}", e);
+                }
+            }
+            .instrument(debug_span!("seccomp_notify_handler")),
+        );
+        Ok(())
+    }
+
+    async fn run(&self, id: &str, fd: Arc<AsyncFd<Fd>>, token: &CancellationToken) -> Result<()> {
+        loop {
+            let mut guard = tokio::select! {
+                guard = fd.readable() => guard.context("wait for seccomp notify fd")?,
+                _ = token.cancelled() => return Ok(()),
+            };
+
+            // The readiness is edge triggered, so check for pending
+            // notifications before receiving, which blocks otherwise.
+            let mut fds = [PollFd::new(fd.as_raw_fd(), PollFlags::POLLIN)];
+            if poll(&mut fds, 0).context("poll seccomp notify fd")? == 0 {
+                guard.clear_ready();
+                continue;
+            }
+            let revents = fds[0].revents().unwrap_or_else(PollFlags::empty);
+            if !revents.contains(PollFlags::POLLIN) {
+                // All processes of the container exited.
+                return Ok(());
+            }
+
+            let mut notif = SeccompNotif::default();
+            match unsafe { notif_recv(fd.as_raw_fd(), &mut notif) } {
+                Ok(_) => (),
+                // The process got killed before the notification got received.
+                Err(Errno::ENOENT) | Err(Errno::EINTR) => continue,
+                Err(e) => return Err(e).context("receive seccomp notification"),
+            }
+            debug!(
+                "Got seccomp notification {} for syscall {} of PID {}",
+                notif.id, notif.data.nr, notif.pid
+            );
+
+            let handler = self.handler(id)?;
+            let fd = fd.clone();
+            task::spawn(async move {
+                let decision = time::timeout(HANDLER_TIMEOUT, decide(handler, notif))
+                    .await
+                    .unwrap_or_default();
+                let mut resp = decision.response(notif.id);
+                if let Err(e) = unsafe { notif_send(fd.as_raw_fd(), &mut resp) } {
+                    // The process got killed while the handler decided.
+                    debug!(
+                        "Unable to respond to seccomp notification {}: {}",
+                        notif.id, e
+                    );
+                }
+            });
+        }
+    }
+
+    fn handler(&self, id: &str) -> Result<Option<mpsc::Sender<Request>>> {
+        Ok(self.lock()?.get(id).cloned().flatten())
+    }
+
+    fn lock(&self) -> Result<MutexGuard<HashMap<String, Option<mpsc::Sender<Request>>>>> {
+        self.0
+            .lock()
+            .map_err(|e| format_err!("lock seccomp notify: {}", e))
+    }
+}
+
+/// Let the handler decide about the notification, which fails the syscall if
+/// there is no handler or it failed.
+async fn decide(handler: Option<mpsc::Sender<Request>>, notif: SeccompNotif) -> Decision {
+    let handler = match handler {
+        Some(handler) => handler,
+        None => return Decision::default(),
+    };
+    let (tx, rx) = oneshot::channel();
+    if handler.send((notif, tx)).await.is_err() {
+        return Decision::default();
+    }
+    rx.await.unwrap_or_default()
+}
+
+async fn forward(
+    mut rx: mpsc::Receiver<Request>,
+    token: &CancellationToken,
+    handler: &seccomp_notify_handler::Client,
+) -> Result<()> {
+    loop {
+        let (notif, tx) = tokio::select! {
+            request = rx.recv() => match request {
+                Some(request) => request,
+                // Another handler got registered.
+                None => return Ok(()),
+            },
+            _ = token.cancelled() => return Ok(()),
+        };
+
+        let mut request = handler.handle_request();
+        build(&notif, request.get().init_notification());
+        let response = request
+            .send()
+            .promise
+            .await
+            .context("send seccomp notification")?;
+        let decision = Decision::from_response(response.get()?.get_response()?)?;
+        // The notification may have timed out already.
+        let _ = tx.send(decision);
+    }
+}
+
+/// Set the fields of the capnp notification.
+fn build(notif: &SeccompNotif, mut builder: seccomp_notification::Builder) {
+    builder.set_id(notif.id);
+    builder.set_pid(notif.pid);
+    builder.set_syscall(notif.data.nr);
+    builder.set_arch(notif.data.arch);
+    builder.set_instruction_pointer(notif.data.instruction_pointer);
+    let mut args = builder.init_args(notif.data.args.len() as u32);
+    for (i, arg) in notif.data.args.iter().enumerate() {
+        args.set(i as u32, *arg);
+    }
+}
+
+#[derive(Debug)]
+/// The socket the runtime sends the seccomp notify fd of a container to,
+/// which gets removed when dropped.
+pub struct SeccompListener {
+    path: PathBuf,
+    listener: UnixListener,
+}
+
+impl SeccompListener {
+    /// Bind the listener, which has to be done before the runtime creates
+    /// the container.
+    pub fn bind(path: &Path) -> Result<Self> {
+        // A socket of a previous run of the bundle may still exist.
+        let _ = fs::remove_file(path);
+        let listener = crate::listener::bind_long_path(path)
+            .with_context(|| format!("bind seccomp listener {}", path.display()))?;
+        Ok(Self {
+            path: path.into(),
+            listener,
+        })
+    }
+
+    /// Receive the notify fd, which the runtime sends along with the state of
+    /// the container.
+    async fn receive(&self) -> Result<Fd> {
+        let (stream, _) = self
+            .listener
+            .accept()
+            .await
+            .context("accept seccomp listener connection")?;
+
+        let mut state = vec![0; MAX_STATE_SIZE];
+        let mut fds = [0; 1];
+        loop {
+            stream
+                .readable()
+                .await
+                .context("wait for seccomp listener")?;
+            match stream.recv_with_fd(&mut state, &mut fds) {
+                Ok((_, 0)) => bail!("runtime sent no seccomp notify fd"),
+                Ok(_) => return Ok(Fd::from(fds[0])),
+                Err(ref e) if e.kind() == ErrorKind::WouldBlock => continue,
+                Err(e) => return Err(e).context("receive seccomp notify fd"),
+            }
+        }
+    }
+}
+
+impl Drop for SeccompListener {
+    fn drop(&mut self) {
+        if let Err(e) = fs::remove_file(&self.path) {
+            debug!(
+                "Unable to remove seccomp listener {}: {}",
+                self.path.display(),
+                e
+            );
+        }
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::mem::size_of;
+
+    #[test]
+    fn kernel_struct_sizes() {
+        assert_eq!(size_of::<SeccompData>(), 64);
+        assert_eq!(size_of::<SeccompNotif>(), 80);
+        assert_eq!(size_of::<SeccompNotifResp>(), 24);
+    }
+
+    #[test]
+    fn decision_response() {
+        assert_eq!(
+            Decision::Continue.response(1),
+            SeccompNotifResp {
+                id: 1,
+                flags: SECCOMP_USER_NOTIF_FLAG_CONTINUE,
+                ..Default::default()
+            }
+        );
+        assert_eq!(
+            Decision::Error(libc::ENOSYS).response(2),
+            SeccompNotifResp {
+                id: 2,
+                error: -libc::ENOSYS,
+                ..Default::default()
+            }
+        );
+        assert_eq!(
+            Decision::Value(42).response(3),
+            SeccompNotifResp {
+                id: 3,
+                val: 42,
+                ..Default::default()
+            }
+        );
+        assert_eq!(Decision::default(), Decision::Error(libc::EPERM));
+    }
+
+    #[tokio::test]
+    async fn decide_without_handler() {
+        let notify = SeccompNotify::default();
+        let handler = notify.handler("id").unwrap();
+        assert!(handler.is_none());
+        assert_eq!(
+            decide(handler, SeccompNotif::default()).await,
+            Decision::default()
+        );
+    }
+}
@@ -1,17 +1,35 @@
 #![deny(missing_docs)]
 
 use crate::{
-    child_reaper::ChildReaper,
+    attach::SessionPolicy,
+    attach_mux::AttachMux,
+    child::Child,
+    child_reaper::{ChildReaper, ReapableChild},
     config::{Config, LogDriver},
-    container_io::{ContainerIO, ContainerIOType},
+    container_events::ContainerEvents,
+    container_state::ContainerStates,
+    crash_report,
+    exec_cache::ExecCache,
+    fd_socket::FdSocket,
+    freezer::Freezer,
     init::{DefaultInit, Init},
+    log_buffer::LogBuffer,
+    operation_locks::{Operation, OperationGuard, OperationLocks},
+    orphan_reaper::OrphanReaper,
+    request_scope::Requests,
+    rpc_error::RpcError,
+    runtime_options::RuntimeOptions,
+    seccomp_notify::SeccompNotify,
+    state_store::StateStore,
+    tenant_quota::{self, LogQuota, Reservation, Resource, TenantQuotas},
+    tombstones::Tombstones,
     version::Version,
+    watchdog::Watchdogs,
 };
-use anyhow::{format_err, Context, Result};
-use capnp::text_list::Reader;
+use anyhow::{bail, format_err, Context, Result};
 use capnp_rpc::{rpc_twoparty_capnp::Side, twoparty, RpcSystem};
 use conmon_common::conmon_capnp::conmon;
-use futures::{AsyncReadExt, FutureExt};
+use futures::{AsyncReadExt, Future, FutureExt};
 use getset::Getters;
 use nix::{
     errno,
@@ -19,7 +37,15 @@ use nix::{
     sys::signal::Signal,
     unistd::{fork, ForkResult},
 };
-use std::{fs::File, io::Write, path::Path, process, str::FromStr, sync::Arc};
+use std::{
+    collections::{BTreeMap, HashSet},
+    fs::File,
+    io::Write,
+    path::Path,
+    process,
+    str::FromStr,
+    sync::{atomic::Ordering, Arc},
+};
 use tokio::{
     fs,
     runtime::{Builder, Handle},
@@ -27,7 +53,7 @@ use tokio::{
     sync::oneshot,
     task::{self, LocalSet},
 };
-use tokio_util::compat::TokioAsyncReadCompatExt;
+use tokio_util::{compat::TokioAsyncReadCompatExt, sync::CancellationToken};
 use tracing::{debug, error, info};
 use tracing_subscriber::{filter::LevelFilter, prelude::*};
 use twoparty::VatNetwork;
@@ -37,19 +63,96 @@ use twoparty::VatNetwork;
 pub struct Server {
     /// Server configuration.
     #[getset(get = "pub(crate)")]
-    config: Config,
+    config: Arc<Config>,
 
     /// Child reaper instance.
     #[getset(get = "pub(crate)")]
     reaper: Arc<ChildReaper>,
+
+    /// Token which gets cancelled if the RPC connection served by this
+    /// instance gets closed.
+    #[getset(get = "pub(crate)")]
+    connection: CancellationToken,
+
+    /// Tenant this instance is scoped to, empty for the default tenant.
+    #[getset(get = "pub(crate)")]
+    tenant: String,
+
+    /// Resource usage of all tenants.
+    #[getset(get = "pub(crate)")]
+    quotas: Arc<TenantQuotas>,
+
+    /// Lifecycle events of all containers.
+    #[getset(get = "pub(crate)")]
+    events: ContainerEvents,
+
+    /// Lifecycle states of the containers of all tenants.
+    #[getset(get = "pub(crate)")]
+    container_states: ContainerStates,
+
+    /// Most recent log lines of the server.
+    #[getset(get = "pub(crate)")]
+    logs: LogBuffer,
+
+    /// Watchdogs of all containers.
+    #[getset(get = "pub(crate)")]
+    watchdogs: Watchdogs,
+
+    /// File descriptors received from clients.
+    #[getset(get = "pub(crate)")]
+    fd_socket: Arc<FdSocket>,
+
+    /// Cached exec sync results of all containers.
+    #[getset(get = "pub(crate)")]
+    exec_cache: ExecCache,
+
+    /// Multiplexed attach endpoint of all containers.
+    #[getset(get = "pub(crate)")]
+    attach_mux: Arc<AttachMux>,
+
+    /// Seccomp notify fds and handlers of all containers.
+    #[getset(get = "pub(crate)")]
+    seccomp_notify: SeccompNotify,
+
+    /// Cancellable in-flight requests of all tenants.
+    #[getset(get = "pub(crate)")]
+    requests: Requests,
+
+    /// Tombstones of the removed containers of all tenants.
+    #[getset(get = "pub(crate)")]
+    tombstones: Tombstones,
+
+    /// Operations in progress of the containers of all tenants.
+    #[getset(get = "pub(crate)")]
+    operation_locks: OperationLocks,
+
+    /// Persisted state of all tenants.
+    #[getset(get = "pub(crate)")]
+    state: StateStore,
 }
 
 impl Server {
     /// Create a new `Server` instance.
     pub fn new() -> Result<Self> {
+        let events = ContainerEvents::default();
         let server = Self {
             config: Default::default(),
             reaper: Default::default(),
+            connection: Default::default(),
+            tenant: Default::default(),
+            quotas: Default::default(),
+            container_states: ContainerStates::new(events.clone()),
+            events,
+            logs: Default::default(),
+            watchdogs: Default::default(),
+            fd_socket: Default::default(),
+            exec_cache: Default::default(),
+            attach_mux: Default::default(),
+            seccomp_notify: Default::default(),
+            requests: Default::default(),
+            tombstones: Default::default(),
+            operation_locks: Default::default(),
+            state: Default::default(),
         };
 
         if server.config().version() {
@@ -83,10 +186,17 @@ impl Server {
             }
         }
 
+        // Install the hook after forking to report the PID of the server.
+        crash_report::install(self.config().crash_report_path(), self.reaper().clone());
+
         // now that we've forked, set self to childreaper
-        prctl::set_child_subreaper(true)
-            .map_err(errno::from_i32)
-            .context("set child subreaper")?;
+        if self.config().no_subreaper() {
+            info!("Not becoming the child subreaper");
+        } else {
+            prctl::set_child_subreaper(true)
+                .map_err(errno::from_i32)
+                .context("set child subreaper")?;
+        }
 
         let rt = Builder::new_multi_thread()
             .enable_io()
@@ -108,7 +218,12 @@ impl Server {
     fn init_logging(&self) -> Result<()> {
         let level =
             LevelFilter::from_str(self.config().log_level()).context("convert log level filter")?;
-        let registry = tracing_subscriber::registry();
+        // Keep the recent log lines for support bundles.
+        let buffer = tracing_subscriber::fmt::layer()
+            .with_ansi(false)
+            .with_writer(self.logs().clone())
+            .with_filter(level);
+        let registry = tracing_subscriber::registry().with(buffer);
 
         match self.config().log_driver() {
             LogDriver::Stdout => {
@@ -134,9 +249,22 @@ impl Server {
     /// Spwans all required tokio tasks.
     async fn spawn_tasks(self) -> Result<()> {
         let (shutdown_tx, shutdown_rx) = oneshot::channel();
-        let socket = self.config().socket();
+        let sockets = [
+            self.config().socket(),
+            self.config().fd_socket(),
+            self.config().attach_socket(),
+        ];
         let reaper = self.reaper.clone();
-        task::spawn(Self::start_signal_handler(reaper, socket, shutdown_tx));
+        task::spawn(Self::start_signal_handler(reaper, sockets, shutdown_tx));
+
+        if !self.config().no_subreaper() {
+            OrphanReaper::new(
+                self.reaper.clone(),
+                self.config().reap_events().then(|| self.events.clone()),
+                self.config().reap_ignore_pids(),
+            )
+            .spawn()?;
+        }
 
         task::spawn_blocking(move || {
             Handle::current().block_on(async {
@@ -150,7 +278,7 @@ impl Server {
 
     async fn start_signal_handler<T: AsRef<Path>>(
         reaper: Arc<ChildReaper>,
-        socket: T,
+        sockets: [T; 3],
         shutdown_tx: oneshot::Sender<()>,
     ) -> Result<()> {
         let mut sigterm = signal(SignalKind::terminate())?;
@@ -180,15 +308,34 @@ impl Server {
             Err(e) => error!("could not kill grandchildren: {}", e),
         }
 
-        debug!("Removing socket file {}", socket.as_ref().display());
-        fs::remove_file(socket)
-            .await
-            .context("remove existing socket file")
+        for socket in sockets {
+            debug!("Removing socket file {}", socket.as_ref().display());
+            fs::remove_file(socket)
+                .await
+                .context("remove existing socket file")?;
+        }
+        Ok(())
     }
 
     async fn start_backend(self, mut shutdown_rx: oneshot::Receiver<()>) -> Result<()> {
+        // Restore the state before serving any request, which may refer to it.
+        self.state()
+            .open(self.config().state_backend(), &self.config().state_path())
+            .context("open state")?;
+        if let Some(retention) = self.config().tombstone_retention() {
+            self.tombstones()
+                .restore(self.state().clone(), retention)
+                .context("restore tombstones")?;
+        }
+
+        // Bind the fd and attach sockets first, because clients consider the
+        // server to be ready once the main socket exists.
+        let fd_listener = crate::listener::bind_long_path(&self.config().fd_socket())?;
+        task::spawn(self.fd_socket().clone().serve(fd_listener));
+        let attach_listener = crate::listener::bind_long_path(&self.config().attach_socket())?;
+        task::spawn(self.attach_mux().clone().serve(attach_listener));
+
         let listener = crate::listener::bind_long_path(&self.config().socket())?;
-        let client: conmon::Client = capnp_rpc::new_client(self);
 
         loop {
             let stream = tokio::select! {
@@ -206,71 +353,248 @@ impl Server {
                 Side::Server,
                 Default::default(),
             ));
-            let rpc_system = RpcSystem::new(network, Some(client.clone().client));
-            task::spawn_local(Box::pin(rpc_system.map(|_| ())));
+            let server = self.for_connection();
+            let connection = server.connection().clone();
+            let client: conmon::Client = capnp_rpc::new_client(server);
+            let rpc_system = RpcSystem::new(network, Some(client.client));
+            task::spawn_local(Box::pin(rpc_system.map(move |_| connection.cancel())));
         }
     }
 
-    /// Generate the OCI runtime CLI arguments from the provided parameters.
-    pub(crate) fn generate_runtime_args(
-        &self,
-        id: &str,
-        bundle_path: &Path,
-        container_io: &ContainerIO,
-        pidfile: &Path,
-    ) -> Result<Vec<String>> {
-        let mut args = vec![];
-
-        if let Some(rr) = self.config().runtime_root() {
-            args.push(format!("--root={}", rr.display()));
+    /// Create a new server instance for serving a single RPC connection.
+    fn for_connection(&self) -> Self {
+        Self {
+            config: self.config.clone(),
+            reaper: self.reaper.clone(),
+            connection: CancellationToken::new(),
+            tenant: Default::default(),
+            quotas: self.quotas.clone(),
+            events: self.events.clone(),
+            container_states: self.container_states.clone(),
+            logs: self.logs.clone(),
+            watchdogs: self.watchdogs.clone(),
+            fd_socket: self.fd_socket.clone(),
+            exec_cache: self.exec_cache.clone(),
+            attach_mux: self.attach_mux.clone(),
+            seccomp_notify: self.seccomp_notify.clone(),
+            requests: self.requests.clone(),
+            tombstones: self.tombstones.clone(),
+            operation_locks: self.operation_locks.clone(),
+            state: self.state.clone(),
+        }
+    }
+
+    /// Create a new server instance scoped to the provided tenant, which
+    /// serves the same RPC connection.
+    pub(crate) fn for_tenant(&self, tenant: &str) -> Self {
+        Self {
+            config: self.config.clone(),
+            reaper: self.reaper.clone(),
+            connection: self.connection.clone(),
+            tenant: tenant.into(),
+            quotas: self.quotas.clone(),
+            events: self.events.clone(),
+            container_states: self.container_states.clone(),
+            logs: self.logs.clone(),
+            watchdogs: self.watchdogs.clone(),
+            fd_socket: self.fd_socket.clone(),
+            exec_cache: self.exec_cache.clone(),
+            attach_mux: self.attach_mux.clone(),
+            seccomp_notify: self.seccomp_notify.clone(),
+            requests: self.requests.clone(),
+            tombstones: self.tombstones.clone(),
+            operation_locks: self.operation_locks.clone(),
+            state: self.state.clone(),
         }
+    }
 
-        args.extend([
-            "create".to_string(),
-            "--bundle".to_string(),
-            bundle_path.display().to_string(),
-            "--pid-file".to_string(),
-            pidfile.display().to_string(),
-        ]);
+    /// Create a token for a watcher, which gets cancelled if either the
+    /// provided token gets cancelled or the RPC connection gets closed.
+    pub(crate) fn watcher_token(&self, token: &CancellationToken) -> CancellationToken {
+        let watcher_token = CancellationToken::new();
+        let (token, connection, watcher_token_clone) = (
+            token.clone(),
+            self.connection().clone(),
+            watcher_token.clone(),
+        );
+        task::spawn_local(async move {
+            tokio::select! {
+                _ = token.cancelled() => {}
+                _ = connection.cancelled() => {}
+                _ = watcher_token_clone.cancelled() => {}
+            }
+            watcher_token_clone.cancel();
+        });
+        watcher_token
+    }
 
-        if let ContainerIOType::Terminal(terminal) = container_io.typ() {
-            args.push(format!("--console-socket={}", terminal.path().display()));
+    /// Retrieve the runtime options of a container, which are the ones of the
+    /// server unless overridden when creating it.
+    pub(crate) fn runtime_options(&self, container_id: &str) -> RuntimeOptions {
+        self.child(container_id, "")
+            .ok()
+            .and_then(|child| child.runtime().clone())
+            .unwrap_or_else(|| RuntimeOptions::from_config(self.config()))
+    }
+
+    /// Retrieve the child of a container, or the child of one of its exec
+    /// sessions if the exec session ID is not empty. Children of other tenants are not available.
+    pub(crate) fn child(&self, container_id: &str, exec_session_id: &str) -> Result<ReapableChild> {
+        if exec_session_id.is_empty() {
+            return self
+                .reaper()
+                .get(container_id)
+                .ok()
+                .filter(|child| child.tenant() == self.tenant())
+                .ok_or_else(|| {
+                    RpcError::not_found(format!("container {} not found", container_id)).into()
+                });
         }
-        args.push(id.into());
-        debug!("Runtime args {:?}", args.join(" "));
-        Ok(args)
+
+        debug!("Using exec session id {}", exec_session_id);
+        self.reaper()
+            .get(&Child::exec_session_id(container_id, exec_session_id))
+            .ok()
+            .filter(|child| child.tenant() == self.tenant())
+            .ok_or_else(|| {
+                RpcError::not_found(format!("exec session {} not found", exec_session_id)).into()
+            })
     }
 
-    /// Generate the OCI runtime CLI arguments from the provided parameters.
-    pub(crate) fn generate_exec_sync_args(
+    /// Retrieve the child of a running container.
+    pub(crate) fn running_child(&self, container_id: &str) -> Result<ReapableChild> {
+        let child = self.child(container_id, "")?;
+        if child.token().is_cancelled() {
+            bail!("container {} is not running", container_id)
+        }
+        Ok(child)
+    }
+
+    /// Lock the container of the tenant for the operation if operation locks
+    /// are enabled.
+    pub(crate) fn lock_operation(
         &self,
-        id: &str,
-        pidfile: &Path,
-        container_io: &ContainerIO,
-        command: &Reader,
-    ) -> Result<Vec<String>> {
-        let mut args = vec![];
-
-        if let Some(rr) = self.config().runtime_root() {
-            args.push(format!("--root={}", rr.display()));
+        container_id: &str,
+        operation: Operation,
+    ) -> Result<Option<OperationGuard>> {
+        if !self.config().operation_locks() {
+            return Ok(None);
         }
+        self.operation_locks()
+            .acquire(self.tenant(), container_id, operation)
+            .map(Some)
+    }
 
-        args.push("exec".to_string());
-        args.push("-d".to_string());
+    /// Retrieve the freezer of the cgroup of a running container.
+    pub(crate) fn freezer(&self, container_id: &str) -> Result<Freezer> {
+        Freezer::for_pid(self.running_child(container_id)?.pid())?
+            .with_context(|| format!("container {} has no cgroup freezer", container_id))
+    }
 
-        if let ContainerIOType::Terminal(terminal) = container_io.typ() {
-            args.push(format!("--console-socket={}", terminal.path().display()));
-            args.push("--tty".to_string());
+    /// Retrieve all children of the tenant.
+    pub(crate) fn children(&self) -> Result<Vec<ReapableChild>> {
+        Ok(self
+            .reaper()
+            .children()?
+            .into_iter()
+            .filter(|child| child.tenant() == self.tenant())
+            .collect())
+    }
+
+    /// Retrieve the children of all containers of the tenant by their ID,
+    /// which excludes exec sessions.
+    pub(crate) fn containers(&self) -> Result<BTreeMap<String, ReapableChild>> {
+        let mut containers = BTreeMap::new();
+        for child in self.children()? {
+            if Child::is_exec_session(child.id()) {
+                continue;
+            }
+            // Exec sync commands without an exec session share the ID of
+            // their container, which has been registered first.
+            containers.entry(child.id().clone()).or_insert(child);
+        }
+        Ok(containers)
+    }
+
+    /// Retrieve the quota limit of the resource, which is `None` if unlimited.
+    pub(crate) fn quota_limit(&self, resource: Resource) -> Option<u64> {
+        match resource {
+            Resource::Containers => self.config().tenant_max_containers(),
+            Resource::ExecSessions => self.config().tenant_max_exec_sessions(),
+            Resource::LogBytes => self.config().tenant_max_log_bytes(),
         }
+        .filter(|limit| *limit > 0)
+    }
+
+    /// Retrieve the usage of the resource by the tenant.
+    pub(crate) fn quota_usage(&self, resource: Resource) -> Result<u64> {
+        if resource == Resource::LogBytes {
+            return Ok(self
+                .quotas()
+                .log_bytes(self.tenant())?
+                .load(Ordering::Relaxed));
+        }
+
+        // Exec sync commands without an exec session share the ID of their
+        // container, which is why only distinct IDs are counted.
+        let exec_sessions = resource == Resource::ExecSessions;
+        let ids: HashSet<String> = self
+            .children()?
+            .iter()
+            .map(|child| child.id())
+            .filter(|id| Child::is_exec_session(id) == exec_sessions)
+            .cloned()
+            .collect();
+        Ok(ids.len() as u64 + self.quotas().reserved(self.tenant(), resource)?)
+    }
 
-        args.push(format!("--pid-file={}", pidfile.display()));
-        args.push(id.into());
+    /// Reserve a unit of the resource, which fails if the quota of the tenant
+    /// is exhausted. The reservation has to be kept until the child got
+    /// registered.
+    pub(crate) fn reserve_quota(&self, resource: Resource) -> Result<Reservation> {
+        let used = self.quota_usage(resource)?;
+        tenant_quota::check(resource, used, 1, self.quota_limit(resource))?;
+        self.quotas().reserve(self.tenant(), resource)
+    }
 
-        for value in command.iter() {
-            args.push(value?.to_string());
+    /// Retrieve the container log quota of the tenant.
+    pub(crate) fn log_quota(&self) -> Result<LogQuota> {
+        Ok(LogQuota::new(
+            self.quotas().log_bytes(self.tenant())?,
+            self.quota_limit(Resource::LogBytes),
+        ))
+    }
+
+    /// Generate the child ID of a new exec session. Fails if the exec session
+    /// ID is already in use.
+    pub(crate) fn new_exec_session_id(
+        &self,
+        container_id: &str,
+        exec_session_id: &str,
+    ) -> Result<String> {
+        let child_id = Child::exec_session_id(container_id, exec_session_id);
+        if self.reaper().get(&child_id).is_ok() {
+            bail!("exec session {} is already in use", exec_session_id)
         }
+        Ok(child_id)
+    }
 
-        debug!("Exec args {:?}", args.join(" "));
-        Ok(args)
+    /// Retrieve the attach session policy of exec sessions, which follow the
+    /// policy of their container.
+    pub(crate) fn exec_session_policy(
+        &self,
+        container_id: &str,
+    ) -> impl Future<Output = SessionPolicy> {
+        let container = self.child(container_id, "").ok();
+        let default_policy = SessionPolicy::new(
+            self.config().max_session_duration(),
+            self.config().session_warning_period(),
+        );
+        async move {
+            match container {
+                Some(container) => container.io().attach().await.policy().await,
+                None => default_policy,
+            }
+        }
     }
 }
@@ -0,0 +1,719 @@
+//! Pluggable persistence of the server state, which keeps records like the
+//! tombstones of removed containers across restarts of the server.
+use crate::config::StateBackend;
+use anyhow::{bail, format_err, Context, Result};
+use conmon_common::conmon_capnp::conmon::state_info;
+use getset::{CopyGetters, Getters};
+use rusqlite::{params, Connection};
+use std::{
+    collections::HashMap,
+    fmt::Debug,
+    fs::{self, File, OpenOptions},
+    io::{BufWriter, Read, Write},
+    os::unix::fs::OpenOptionsExt,
+    path::{Path, PathBuf},
+    sync::{Arc, Mutex, MutexGuard},
+    time::{SystemTime, UNIX_EPOCH},
+};
+use tracing::{debug, warn};
+
+/// The number of writes after which the file backend gets compacted
+/// automatically, if they are more than twice the number of records.
+const AUTO_COMPACT_WRITES: u64 = 1024;
+
+/// Operations of the records of the file backend.
+const OP_PUT: u8 = 1;
+const OP_DELETE: u8 = 2;
+
+#[derive(Clone, Debug, Eq, Getters, Hash, Ord, PartialEq, PartialOrd)]
+#[getset(get = "pub")]
+/// The key of a record, which belongs to a container of a tenant.
+pub struct Key {
+    /// The kind of the record, like "tombstone".
+    namespace: String,
+
+    tenant: String,
+
+    id: String,
+}
+
+impl Key {
+    /// Create a new key of the container `id` of the `tenant`.
+    pub fn new(namespace: &str, tenant: &str, id: &str) -> Self {
+        Self {
+            namespace: namespace.into(),
+            tenant: tenant.into(),
+            id: id.into(),
+        }
+    }
+}
+
+#[derive(Clone, Debug, Default)]
+/// The state of the server, which is persisted by the configured backend. All
+/// records are kept in memory as well, so reading them does not touch the
+/// backend.
+pub struct StateStore(Arc<Mutex<Inner>>);
+
+#[derive(Debug)]
+struct Inner {
+    kind: StateBackend,
+    path: Option<PathBuf>,
+    backend: Box<dyn Backend>,
+    entries: HashMap<Key, Vec<u8>>,
+    writes: u64,
+    compacted_at: u64,
+}
+
+impl Default for Inner {
+    fn default() -> Self {
+        Self {
+            kind: StateBackend::Memory,
+            path: None,
+            backend: Box::new(MemoryBackend),
+            entries: HashMap::new(),
+            writes: 0,
+            compacted_at: 0,
+        }
+    }
+}
+
+#[derive(Clone, CopyGetters, Debug, Getters)]
+/// Information about the persisted state.
+pub struct StateInfo {
+    #[getset(get_copy = "pub")]
+    kind: StateBackend,
+
+    #[getset(get = "pub")]
+    path: Option<PathBuf>,
+
+    #[getset(get_copy = "pub")]
+    entries: u64,
+
+    #[getset(get_copy = "pub")]
+    tenant_entries: u64,
+
+    #[getset(get_copy = "pub")]
+    size_bytes: u64,
+
+    #[getset(get_copy = "pub")]
+    writes_since_compaction: u64,
+
+    /// Nanoseconds since the unix epoch, zero if never compacted.
+    #[getset(get_copy = "pub")]
+    compacted_at: u64,
+}
+
+impl StateInfo {
+    /// Set the fields of the capnp state info.
+    pub fn build(&self, mut info: state_info::Builder) {
+        info.set_backend(match self.kind {
+            StateBackend::Memory => state_info::Backend::Memory,
+            StateBackend::File => state_info::Backend::File,
+            StateBackend::Sqlite => state_info::Backend::Sqlite,
+        });
+        if let Some(path) = &self.path {
+            info.set_path(&path.to_string_lossy());
+        }
+        info.set_entries(self.entries);
+        info.set_tenant_entries(self.tenant_entries);
+        info.set_size_bytes(self.size_bytes);
+        info.set_writes_since_compaction(self.writes_since_compaction);
+        info.set_compacted_at(self.compacted_at);
+    }
+}
+
+#[derive(Clone, Copy, CopyGetters, Debug)]
+#[getset(get_copy = "pub")]
+/// The result of a compaction.
+pub struct Compaction {
+    size_before: u64,
+    size_after: u64,
+    entries: u64,
+}
+
+impl StateStore {
+    /// Open the backend of the kind at `path`, replacing the current one and
+    /// its records. The path is not used by the memory backend.
+    pub fn open(&self, kind: StateBackend, path: &Path) -> Result<()> {
+        let mut backend: Box<dyn Backend> = match kind {
+            StateBackend::Memory => Box::new(MemoryBackend),
+            StateBackend::File => Box::new(FileBackend::open(path)?),
+            StateBackend::Sqlite => Box::new(SqliteBackend::open(path)?),
+        };
+        let entries = backend.load().context("load state")?;
+        debug!(
+            "Opened {:?} state backend with {} records",
+            kind,
+            entries.len()
+        );
+
+        let mut inner = self.lock()?;
+        *inner = Inner {
+            kind,
+            path: (kind != StateBackend::Memory).then(|| path.into()),
+            backend,
+            entries,
+            ..Default::default()
+        };
+        Ok(())
+    }
+
+    /// All records of the namespace.
+    pub fn entries(&self, namespace: &str) -> Result<Vec<(Key, Vec<u8>)>> {
+        Ok(self
+            .lock()?
+            .entries
+            .iter()
+            .filter(|(key, _)| key.namespace == namespace)
+            .map(|(key, value)| (key.clone(), value.clone()))
+            .collect())
+    }
+
+    /// Write the record, replacing an existing one of the same key.
+    pub fn put(&self, key: Key, value: Vec<u8>) -> Result<()> {
+        let mut inner = self.lock()?;
+        inner.backend.put(&key, &value)?;
+        inner.entries.insert(key, value);
+        inner.written()
+    }
+
+    /// Remove the record, if it exists.
+    pub fn delete(&self, key: &Key) -> Result<()> {
+        let mut inner = self.lock()?;
+        if inner.entries.remove(key).is_none() {
+            return Ok(());
+        }
+        inner.backend.delete(key)?;
+        inner.written()
+    }
+
+    /// Reclaim the space of overwritten and deleted records.
+    pub fn compact(&self) -> Result<Compaction> {
+        self.lock()?.compact()
+    }
+
+    /// Information about the persisted state, including the number of
+    /// records of the `tenant`.
+    pub fn info(&self, tenant: &str) -> Result<StateInfo> {
+        let inner = self.lock()?;
+        Ok(StateInfo {
+            kind: inner.kind,
+            path: inner.path.clone(),
+            entries: inner.entries.len() as u64,
+            tenant_entries: inner.entries.keys().filter(|x| x.tenant == tenant).count() as u64,
+            size_bytes: inner.backend.size()?,
+            writes_since_compaction: inner.writes,
+            compacted_at: inner.compacted_at,
+        })
+    }
+
+    fn lock(&self) -> Result<MutexGuard<Inner>> {
+        self.0.lock().map_err(|e| format_err!("lock state: {}", e))
+    }
+}
+
+impl Inner {
+    /// Account a write, compacting the backend if it grew too much, which
+    /// keeps the file of high container churn nodes small.
+    fn written(&mut self) -> Result<()> {
+        self.writes += 1;
+        if self.kind == StateBackend::File
+            && self.writes >= AUTO_COMPACT_WRITES
+            && self.writes > 2 * self.entries.len() as u64
+        {
+            let compaction = self.compact().context("compact state")?;
+            debug!(
+                "Compacted state from {} to {} bytes",
+                compaction.size_before, compaction.size_after
+            );
+        }
+        Ok(())
+    }
+
+    fn compact(&mut self) -> Result<Compaction> {
+        let size_before = self.backend.size()?;
+        self.backend.compact(&self.entries)?;
+        self.writes = 0;
+        self.compacted_at = now();
+        Ok(Compaction {
+            size_before,
+            size_after: self.backend.size()?,
+            entries: self.entries.len() as u64,
+        })
+    }
+}
+
+/// A persistence backend of the records.
+trait Backend: Debug + Send {
+    /// Load all records.
+    fn load(&mut self) -> Result<HashMap<Key, Vec<u8>>>;
+
+    /// Write the record, replacing an existing one of the same key.
+    fn put(&mut self, key: &Key, value: &[u8]) -> Result<()>;
+
+    /// Remove the record.
+    fn delete(&mut self, key: &Key) -> Result<()>;
+
+    /// Reclaim the space of overwritten and deleted records, given all
+    /// current records.
+    fn compact(&mut self, entries: &HashMap<Key, Vec<u8>>) -> Result<()>;
+
+    /// The size of the persisted state in bytes.
+    fn size(&self) -> Result<u64>;
+}
+
+#[derive(Debug)]
+/// Backend which does not persist anything.
+struct MemoryBackend;
+
+impl Backend for MemoryBackend {
+    fn load(&mut self) -> Result<HashMap<Key, Vec<u8>>> {
+        Ok(HashMap::new())
+    }
+
+    fn put(&mut self, _: &Key, _: &[u8]) -> Result<()> {
+        Ok(())
+    }
+
+    fn delete(&mut self, _: &Key) -> Result<()> {
+        Ok(())
+    }
+
+    fn compact(&mut self, _: &HashMap<Key, Vec<u8>>) -> Result<()> {
+        Ok(())
+    }
+
+    fn size(&self) -> Result<u64> {
+        Ok(0)
+    }
+}
+
+#[derive(Debug)]
+/// Backend appending the changes to a file. Every record consists of the
+/// operation followed by the length prefixed fields of the key and, for
+/// puts, the value.
+struct FileBackend {
+    path: PathBuf,
+    file: File,
+}
+
+impl FileBackend {
+    fn open(path: &Path) -> Result<Self> {
+        if let Some(parent) = path.parent() {
+            fs::create_dir_all(parent)
+                .with_context(|| format!("create state directory {}", parent.display()))?;
+        }
+        Ok(Self {
+            path: path.into(),
+            file: Self::open_file(path)?,
+        })
+    }
+
+    fn open_file(path: &Path) -> Result<File> {
+        OpenOptions::new()
+            .create(true)
+            .read(true)
+            .append(true)
+            .mode(0o600)
+            .open(path)
+            .with_context(|| format!("open state file {}", path.display()))
+    }
+
+    fn encode(buf: &mut Vec<u8>, op: u8, key: &Key, value: Option<&[u8]>) {
+        buf.push(op);
+        let fields = [
+            key.namespace.as_bytes(),
+            key.tenant.as_bytes(),
+            key.id.as_bytes(),
+        ];
+        for field in fields.iter().copied().chain(value) {
+            buf.extend_from_slice(&(field.len() as u32).to_le_bytes());
+            buf.extend_from_slice(field);
+        }
+    }
+
+    /// Decode the record at the start of `buf`, returning the operation, key,
+    /// value and length of the record, or `None` if it is incomplete.
+    fn decode(buf: &[u8]) -> Result<Option<(u8, Key, Vec<u8>, usize)>> {
+        let op = match buf.first() {
+            Some(op) => *op,
+            None => return Ok(None),
+        };
+        let count = match op {
+            OP_PUT => 4,
+            OP_DELETE => 3,
+            _ => bail!("invalid state record operation {}", op),
+        };
+
+        let mut offset = 1;
+        let mut fields = Vec::with_capacity(count);
+        for _ in 0..count {
+            let len = match buf.get(offset..offset + 4) {
+                Some(len) => u32::from_le_bytes([len[0], len[1], len[2], len[3]]) as usize,
+                None => return Ok(None),
+            };
+            offset += 4;
+            match buf.get(offset..offset + len) {
+                Some(field) => fields.push(field.to_vec()),
+                None => return Ok(None),
+            }
+            offset += len;
+        }
+
+        let value = if op == OP_PUT {
+            fields.pop().unwrap_or_default()
+        } else {
+            vec![]
+        };
+        let mut fields = fields.into_iter().map(String::from_utf8);
+        let mut next = || -> Result<String> {
+            Ok(fields
+                .next()
+                .context("missing key field")?
+                .context("key field is not UTF-8")?)
+        };
+        let key = Key {
+            namespace: next()?,
+            tenant: next()?,
+            id: next()?,
+        };
+        Ok(Some((op, key, value, offset)))
+    }
+
+    fn append(&mut self, buf: &[u8]) -> Result<()> {
+        self.file
+            .write_all(buf)
+            .with_context(|| format!("write state file {}", self.path.display()))
+    }
+}
+
+impl Backend for FileBackend {
+    fn load(&mut self) -> Result<HashMap<Key, Vec<u8>>> {
+        let mut buf = vec![];
+        File::open(&self.path)
+            .and_then(|mut x| x.read_to_end(&mut buf))
+            .with_context(|| format!("read state file {}", self.path.display()))?;
+
+        let mut entries = HashMap::new();
+        let mut offset = 0;
+        loop {
+            match Self::decode(&buf[offset..]) {
+                Ok(Some((op, key, value, len))) => {
+                    if op == OP_PUT {
+                        entries.insert(key, value);
+                    } else {
+                        entries.remove(&key);
+                    }
+                    offset += len;
+                }
+                Ok(None) => break,
+                Err(e) => {
+                    warn!("Dropping invalid state after offset {}: {:#}", offset, e);
+                    break;
+                }
+            }
+        }
+
+        // Drop an incomplete record of an interrupted write, because appended
+        // records would not be readable otherwise.
+        if offset < buf.len() {
+            warn!(
+                "Truncating state file {} from {} to {} bytes",
+                self.path.display(),
+                buf.len(),
+                offset
+            );
+            self.file
+                .set_len(offset as u64)
+                .context("truncate state file")?;
+        }
+        Ok(entries)
+    }
+
+    fn put(&mut self, key: &Key, value: &[u8]) -> Result<()> {
+        let mut buf = vec![];
+        Self::encode(&mut buf, OP_PUT, key, Some(value));
+        self.append(&buf)
+    }
+
+    fn delete(&mut self, key: &Key) -> Result<()> {
+        let mut buf = vec![];
+        Self::encode(&mut buf, OP_DELETE, key, None);
+        self.append(&buf)
+    }
+
+    fn compact(&mut self, entries: &HashMap<Key, Vec<u8>>) -> Result<()> {
+        let tmp = self.path.with_extension("tmp");
+        let file = OpenOptions::new()
+            .create(true)
+            .write(true)
+            .truncate(true)
+            .mode(0o600)
+            .open(&tmp)
+            .with_context(|| format!("create state file {}", tmp.display()))?;
+
+        let mut writer = BufWriter::new(file);
+        let mut buf = vec![];
+        for (key, value) in entries {
+            buf.clear();
+            Self::encode(&mut buf, OP_PUT, key, Some(value));
+            writer.write_all(&buf).context("write compacted state")?;
+        }
+        writer
+            .into_inner()
+            .map_err(|e| e.into_error())
+            .and_then(|x| x.sync_all())
+            .context("sync compacted state")?;
+
+        fs::rename(&tmp, &self.path).context("replace state file")?;
+        self.file = Self::open_file(&self.path)?;
+        Ok(())
+    }
+
+    fn size(&self) -> Result<u64> {
+        Ok(self.file.metadata().context("get state file size")?.len())
+    }
+}
+
+#[derive(Debug)]
+/// Backend storing the records in a sqlite database.
+struct SqliteBackend {
+    path: PathBuf,
+    conn: Connection,
+}
+
+impl SqliteBackend {
+    fn open(path: &Path) -> Result<Self> {
+        if let Some(parent) = path.parent() {
+            fs::create_dir_all(parent)
+                .with_context(|| format!("create state directory {}", parent.display()))?;
+        }
+        let conn = Connection::open(path)
+            .with_context(|| format!("open state database {}", path.display()))?;
+        conn.query_row("PRAGMA journal_mode = WAL", [], |_| Ok(()))
+            .context("enable write-ahead log")?;
+        conn.execute_batch(
+            "PRAGMA synchronous = NORMAL;
+            CREATE TABLE IF NOT EXISTS state (
+                namespace TEXT NOT NULL,
+                tenant TEXT NOT NULL,
+                id TEXT NOT NULL,
+                value BLOB NOT NULL,
+                PRIMARY KEY (namespace, tenant, id)
+            );",
+        )
+        .context("create state table")?;
+        Ok(Self {
+            path: path.into(),
+            conn,
+        })
+    }
+}
+
+impl Backend for SqliteBackend {
+    fn load(&mut self) -> Result<HashMap<Key, Vec<u8>>> {
+        let mut stmt = self
+            .conn
+            .prepare("SELECT namespace, tenant, id, value FROM state")?;
+        let rows = stmt.query_map([], |row| {
+            Ok((
+                Key {
+                    namespace: row.get(0)?,
+                    tenant: row.get(1)?,
+                    id: row.get(2)?,
+                },
+                row.get(3)?,
+            ))
+        })?;
+        Ok(rows.collect::<Result<_, _>>()?)
+    }
+
+    fn put(&mut self, key: &Key, value: &[u8]) -> Result<()> {
+        self.conn
+            .execute(
+                "INSERT OR REPLACE INTO state (namespace, tenant, id, value) VALUES (?1, ?2, ?3, ?4)",
+                params![key.namespace, key.tenant, key.id, value],
+            )
+            .context("insert state record")?;
+        Ok(())
+    }
+
+    fn delete(&mut self, key: &Key) -> Result<()> {
+        self.conn
+            .execute(
+                "DELETE FROM state WHERE namespace = ?1 AND tenant = ?2 AND id = ?3",
+                params![key.namespace, key.tenant, key.id],
+            )
+            .context("delete state record")?;
+        Ok(())
+    }
+
+    fn compact(&mut self, _: &HashMap<Key, Vec<u8>>) -> Result<()> {
+        self.conn
+            .execute_batch("VACUUM")
+            .context("vacuum state database")?;
+        self.conn
+            .query_row("PRAGMA wal_checkpoint(TRUNCATE)", [], |_| Ok(()))
+            .context("checkpoint state database")
+    }
+
+    fn size(&self) -> Result<u64> {
+        let mut wal = self.path.clone().into_os_string();
+        wal.push("-wal");
+        let mut size = 0;
+        for path in [self.path.clone(), wal.into()] {
+            match fs::metadata(&path) {
+                Ok(metadata) => size += metadata.len(),
+                Err(e) if e.kind() == std::io::ErrorKind::NotFound => {}
+                Err(e) => return Err(e).context("get state database size"),
+            }
+        }
+        Ok(size)
+    }
+}
+
+/// The current time in nanoseconds since the unix epoch.
+fn now() -> u64 {
+    SystemTime::now()
+        .duration_since(UNIX_EPOCH)
+        .unwrap_or_default()
+        .as_nanos() as u64
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use tempfile::tempdir;
+
+    fn key(id: &str) -> Key {
+        Key::new("test", "tenant", id)
+    }
+
+    #[test]
+    fn memory_keeps_records() -> Result<()> {
+        let sut = StateStore::default();
+        sut.put(key("a"), b"a".to_vec())?;
+        sut.put(key("b"), b"b".to_vec())?;
+        sut.delete(&key("b"))?;
+
+        assert_eq!(sut.entries("test")?, vec![(key("a"), b"a".to_vec())]);
+        assert!(sut.entries("other")?.is_empty());
+
+        let info = sut.info("tenant")?;
+        assert_eq!(info.kind(), StateBackend::Memory);
+        assert_eq!(info.entries(), 1);
+        assert_eq!(info.tenant_entries(), 1);
+        assert_eq!(info.size_bytes(), 0);
+        assert_eq!(sut.info("other")?.tenant_entries(), 0);
+        Ok(())
+    }
+
+    #[test]
+    fn file_restores_records() -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("state");
+
+        let sut = StateStore::default();
+        sut.open(StateBackend::File, &path)?;
+        sut.put(key("a"), b"first".to_vec())?;
+        sut.put(key("a"), b"second".to_vec())?;
+        sut.put(key("b"), b"b".to_vec())?;
+        sut.delete(&key("b"))?;
+
+        let restored = StateStore::default();
+        restored.open(StateBackend::File, &path)?;
+        assert_eq!(
+            restored.entries("test")?,
+            vec![(key("a"), b"second".to_vec())]
+        );
+        Ok(())
+    }
+
+    #[test]
+    fn file_drops_incomplete_record() -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("state");
+
+        let sut = StateStore::default();
+        sut.open(StateBackend::File, &path)?;
+        sut.put(key("a"), b"a".to_vec())?;
+        let size = fs::metadata(&path)?.len();
+        OpenOptions::new()
+            .append(true)
+            .open(&path)?
+            .write_all(&[OP_PUT, 4, 0])?;
+
+        let restored = StateStore::default();
+        restored.open(StateBackend::File, &path)?;
+        assert_eq!(fs::metadata(&path)?.len(), size);
+        restored.put(key("b"), b"b".to_vec())?;
+
+        let restored = StateStore::default();
+        restored.open(StateBackend::File, &path)?;
+        assert_eq!(restored.entries("test")?.len(), 2);
+        Ok(())
+    }
+
+    #[test]
+    fn file_compacts() -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("state");
+
+        let sut = StateStore::default();
+        sut.open(StateBackend::File, &path)?;
+        for i in 0..100 {
+            sut.put(key(&i.to_string()), vec![0; 100])?;
+            sut.delete(&key(&i.to_string()))?;
+        }
+        sut.put(key("a"), b"a".to_vec())?;
+
+        let compaction = sut.compact()?;
+        assert!(compaction.size_after() < compaction.size_before());
+        assert_eq!(compaction.entries(), 1);
+        assert_eq!(sut.info("tenant")?.writes_since_compaction(), 0);
+        assert!(sut.info("tenant")?.compacted_at() > 0);
+
+        let restored = StateStore::default();
+        restored.open(StateBackend::File, &path)?;
+        assert_eq!(restored.entries("test")?, vec![(key("a"), b"a".to_vec())]);
+        Ok(())
+    }
+
+    #[test]
+    fn file_compacts_automatically() -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("state");
+
+        let sut = StateStore::default();
+        sut.open(StateBackend::File, &path)?;
+        for _ in 0..AUTO_COMPACT_WRITES {
+            sut.put(key("a"), b"a".to_vec())?;
+        }
+        assert_eq!(sut.info("tenant")?.writes_since_compaction(), 0);
+        assert!(sut.info("tenant")?.size_bytes() < 100);
+        Ok(())
+    }
+
+    #[test]
+    fn sqlite_restores_records() -> Result<()> {
+        let dir = tempdir()?;
+        let path = dir.path().join("state.db");
+
+        let sut = StateStore::default();
+        sut.open(StateBackend::Sqlite, &path)?;
+        sut.put(key("a"), b"first".to_vec())?;
+        sut.put(key("a"), b"second".to_vec())?;
+        sut.put(key("b"), b"b".to_vec())?;
+        sut.delete(&key("b"))?;
+        sut.compact()?;
+
+        let restored = StateStore::default();
+        restored.open(StateBackend::Sqlite, &path)?;
+        assert_eq!(
+            restored.entries("test")?,
+            vec![(key("a"), b"second".to_vec())]
+        );
+        assert!(restored.info("tenant")?.size_bytes() > 0);
+        Ok(())
+    }
+}
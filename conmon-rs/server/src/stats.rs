@@ -0,0 +1,323 @@
+//! Cgroup statistics of running containers.
+use anyhow::{Context, Result};
+use conmon_common::conmon_capnp::conmon::container_stats;
+use getset::CopyGetters;
+use std::{fs, path::Path};
+
+/// The mount point of the cgroup file systems.
+pub(crate) const CGROUP_ROOT: &str = "/sys/fs/cgroup";
+
+/// The memory limit reported by cgroup v1 if it is not set.
+const V1_MEMORY_UNLIMITED: u64 = 0x7FFF_FFFF_FFFF_F000;
+
+#[derive(Clone, Copy, CopyGetters, Debug, Default, Eq, PartialEq)]
+#[getset(get_copy = "pub")]
+/// Resource usage statistics of a container.
+pub struct Stats {
+    /// Total CPU time consumed in nanoseconds.
+    cpu_usage_nanos: u64,
+
+    /// CPU time consumed in user mode in nanoseconds.
+    cpu_user_nanos: u64,
+
+    /// CPU time consumed in kernel mode in nanoseconds.
+    cpu_system_nanos: u64,
+
+    /// Current memory usage in bytes.
+    memory_usage_bytes: u64,
+
+    /// Memory limit in bytes, zero if unlimited.
+    memory_limit_bytes: u64,
+
+    /// Bytes read from block devices.
+    block_io_read_bytes: u64,
+
+    /// Bytes written to block devices.
+    block_io_write_bytes: u64,
+
+    /// Read operations on block devices.
+    block_io_read_ops: u64,
+
+    /// Write operations on block devices.
+    block_io_write_ops: u64,
+
+    /// Current number of processes.
+    pids_current: u64,
+
+    /// Maximum number of processes, zero if unlimited.
+    pids_limit: u64,
+}
+
+impl Stats {
+    /// Collect the statistics of the cgroup of the process `pid`.
+    pub fn collect(pid: u32) -> Result<Self> {
+        let path = format!("/proc/{}/cgroup", pid);
+        let cgroups = fs::read_to_string(&path).with_context(|| format!("read {}", path))?;
+        let mut stats = Self::default();
+
+        for (controllers, cgroup) in cgroups.lines().filter_map(parse_cgroup_line) {
+            let cgroup = cgroup.trim_start_matches('/');
+            if controllers.is_empty() {
+                // The unified hierarchy of cgroup v2.
+                stats.collect_v2(&Path::new(CGROUP_ROOT).join(cgroup));
+                continue;
+            }
+            for controller in controllers.split(',') {
+                let dir = Path::new(CGROUP_ROOT).join(controller).join(cgroup);
+                stats.collect_v1(controller, &dir);
+            }
+        }
+
+        Ok(stats)
+    }
+
+    /// Set the fields of the capnp stats, collected at `timestamp` in
+    /// nanoseconds since the unix epoch.
+    pub fn build(&self, timestamp: u64, mut stats: container_stats::Builder) {
+        stats.set_timestamp(timestamp);
+
+        let mut cpu = stats.reborrow().init_cpu();
+        cpu.set_usage_nanos(self.cpu_usage_nanos);
+        cpu.set_user_nanos(self.cpu_user_nanos);
+        cpu.set_system_nanos(self.cpu_system_nanos);
+
+        let mut memory = stats.reborrow().init_memory();
+        memory.set_usage_bytes(self.memory_usage_bytes);
+        memory.set_limit_bytes(self.memory_limit_bytes);
+
+        let mut block_io = stats.reborrow().init_block_io();
+        block_io.set_read_bytes(self.block_io_read_bytes);
+        block_io.set_write_bytes(self.block_io_write_bytes);
+        block_io.set_read_ops(self.block_io_read_ops);
+        block_io.set_write_ops(self.block_io_write_ops);
+
+        let mut pids = stats.init_pids();
+        pids.set_current(self.pids_current);
+        pids.set_limit(self.pids_limit);
+    }
+
+    /// Read the capnp stats, returning them together with their timestamp.
+    pub fn read(stats: container_stats::Reader) -> Result<(u64, Self)> {
+        let cpu = stats.get_cpu()?;
+        let memory = stats.get_memory()?;
+        let block_io = stats.get_block_io()?;
+        let pids = stats.get_pids()?;
+        Ok((
+            stats.get_timestamp(),
+            Self {
+                cpu_usage_nanos: cpu.get_usage_nanos(),
+                cpu_user_nanos: cpu.get_user_nanos(),
+                cpu_system_nanos: cpu.get_system_nanos(),
+                memory_usage_bytes: memory.get_usage_bytes(),
+                memory_limit_bytes: memory.get_limit_bytes(),
+                block_io_read_bytes: block_io.get_read_bytes(),
+                block_io_write_bytes: block_io.get_write_bytes(),
+                block_io_read_ops: block_io.get_read_ops(),
+                block_io_write_ops: block_io.get_write_ops(),
+                pids_current: pids.get_current(),
+                pids_limit: pids.get_limit(),
+            },
+        ))
+    }
+
+    fn collect_v2(&mut self, dir: &Path) {
+        if let Some(content) = read(dir, "cpu.stat") {
+            for (key, value) in parse_flat_keyed(&content) {
+                match key {
+                    "usage_usec" => self.cpu_usage_nanos = value * 1000,
+                    "user_usec" => self.cpu_user_nanos = value * 1000,
+                    "system_usec" => self.cpu_system_nanos = value * 1000,
+                    _ => {}
+                }
+            }
+        }
+        if let Some(value) = read_u64(dir, "memory.current") {
+            self.memory_usage_bytes = value;
+        }
+        if let Some(value) = read_u64(dir, "memory.max") {
+            self.memory_limit_bytes = value;
+        }
+        if let Some(content) = read(dir, "io.stat") {
+            for line in content.lines() {
+                // Format: `MAJ:MIN rbytes=1 wbytes=2 rios=3 wios=4 ...`
+                for field in line.split_whitespace().skip(1) {
+                    let (key, value) = match field.split_once('=') {
+                        Some((key, value)) => (key, value.parse::<u64>().unwrap_or_default()),
+                        None => continue,
+                    };
+                    match key {
+                        "rbytes" => self.block_io_read_bytes += value,
+                        "wbytes" => self.block_io_write_bytes += value,
+                        "rios" => self.block_io_read_ops += value,
+                        "wios" => self.block_io_write_ops += value,
+                        _ => {}
+                    }
+                }
+            }
+        }
+        self.collect_pids(dir);
+    }
+
+    fn collect_v1(&mut self, controller: &str, dir: &Path) {
+        match controller {
+            "cpuacct" => {
+                if let Some(value) = read_u64(dir, "cpuacct.usage") {
+                    self.cpu_usage_nanos = value;
+                }
+                if let Some(value) = read_u64(dir, "cpuacct.usage_user") {
+                    self.cpu_user_nanos = value;
+                }
+                if let Some(value) = read_u64(dir, "cpuacct.usage_sys") {
+                    self.cpu_system_nanos = value;
+                }
+            }
+            "memory" => {
+                if let Some(value) = read_u64(dir, "memory.usage_in_bytes") {
+                    self.memory_usage_bytes = value;
+                }
+                if let Some(value) = read_u64(dir, "memory.limit_in_bytes") {
+                    if value < V1_MEMORY_UNLIMITED {
+                        self.memory_limit_bytes = value;
+                    }
+                }
+            }
+            "blkio" => {
+                if let Some(content) = read(dir, "blkio.throttle.io_service_bytes") {
+                    let (read, write) = parse_blkio(&content);
+                    self.block_io_read_bytes = read;
+                    self.block_io_write_bytes = write;
+                }
+                if let Some(content) = read(dir, "blkio.throttle.io_serviced") {
+                    let (read, write) = parse_blkio(&content);
+                    self.block_io_read_ops = read;
+                    self.block_io_write_ops = write;
+                }
+            }
+            "pids" => self.collect_pids(dir),
+            _ => {}
+        }
+    }
+
+    fn collect_pids(&mut self, dir: &Path) {
+        if let Some(value) = read_u64(dir, "pids.current") {
+            self.pids_current = value;
+        }
+        if let Some(value) = read_u64(dir, "pids.max") {
+            self.pids_limit = value;
+        }
+    }
+}
+
+/// Parse a line of `/proc/PID/cgroup` into the controllers and the path.
+pub(crate) fn parse_cgroup_line(line: &str) -> Option<(&str, &str)> {
+    let mut fields = line.splitn(3, ':');
+    let _id = fields.next()?;
+    let controllers = fields.next()?;
+    let path = fields.next()?;
+    // Named hierarchies like `name=systemd` do not provide statistics.
+    if controllers.starts_with("name=") {
+        return None;
+    }
+    Some((controllers, path))
+}
+
+/// Parse a flat keyed file, which contains a `key value` pair per line.
+fn parse_flat_keyed(content: &str) -> impl Iterator<Item = (&str, u64)> {
+    content.lines().filter_map(|line| {
+        let (key, value) = line.split_once(' ')?;
+        Some((key, value.trim().parse().ok()?))
+    })
+}
+
+/// Parse the read and write totals of a cgroup v1 blkio file.
+fn parse_blkio(content: &str) -> (u64, u64) {
+    let (mut read, mut write) = (0, 0);
+    for line in content.lines() {
+        // Format: `MAJ:MIN Read 1`
+        let fields: Vec<&str> = line.split_whitespace().collect();
+        if let [_, op, value] = fields.as_slice() {
+            let value: u64 = value.parse().unwrap_or_default();
+            match *op {
+                "Read" => read += value,
+                "Write" => write += value,
+                _ => {}
+            }
+        }
+    }
+    (read, write)
+}
+
+fn read(dir: &Path, file: &str) -> Option<String> {
+    fs::read_to_string(dir.join(file)).ok()
+}
+
+/// Read a single value, where `max` is reported as zero.
+fn read_u64(dir: &Path, file: &str) -> Option<u64> {
+    let content = read(dir, file)?;
+    match content.trim() {
+        "max" => Some(0),
+        value => value.parse().ok(),
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use tempfile::tempdir;
+
+    #[test]
+    fn parse_cgroup_line_success() {
+        assert_eq!(
+            parse_cgroup_line("0::/user.slice"),
+            Some(("", "/user.slice"))
+        );
+        assert_eq!(
+            parse_cgroup_line("4:cpu,cpuacct:/docker/abc"),
+            Some(("cpu,cpuacct", "/docker/abc"))
+        );
+        assert_eq!(parse_cgroup_line("1:name=systemd:/init.scope"), None);
+        assert_eq!(parse_cgroup_line("invalid"), None);
+    }
+
+    #[test]
+    fn parse_blkio_success() {
+        let content = "8:0 Read 10\n8:0 Write 20\n8:0 Sync 30\n8:16 Read 5\nTotal 65\n";
+        assert_eq!(parse_blkio(content), (15, 20));
+    }
+
+    #[test]
+    fn collect_v2_success() -> Result<()> {
+        let dir = tempdir()?;
+        let write = |file: &str, content: &str| fs::write(dir.path().join(file), content);
+        write("cpu.stat", "usage_usec 10\nuser_usec 6\nsystem_usec 4\n")?;
+        write("memory.current", "1024\n")?;
+        write("memory.max", "max\n")?;
+        write(
+            "io.stat",
+            "8:0 rbytes=1 wbytes=2 rios=3 wios=4 dbytes=0 dios=0\n",
+        )?;
+        write("pids.current", "2\n")?;
+        write("pids.max", "100\n")?;
+
+        let mut stats = Stats::default();
+        stats.collect_v2(dir.path());
+        assert_eq!(stats.cpu_usage_nanos(), 10000);
+        assert_eq!(stats.cpu_user_nanos(), 6000);
+        assert_eq!(stats.cpu_system_nanos(), 4000);
+        assert_eq!(stats.memory_usage_bytes(), 1024);
+        assert_eq!(stats.memory_limit_bytes(), 0);
+        assert_eq!(stats.block_io_read_bytes(), 1);
+        assert_eq!(stats.block_io_write_bytes(), 2);
+        assert_eq!(stats.block_io_read_ops(), 3);
+        assert_eq!(stats.block_io_write_ops(), 4);
+        assert_eq!(stats.pids_current(), 2);
+        assert_eq!(stats.pids_limit(), 100);
+        Ok(())
+    }
+
+    #[test]
+    fn collect_self() -> Result<()> {
+        Stats::collect(std::process::id())?;
+        Ok(())
+    }
+}
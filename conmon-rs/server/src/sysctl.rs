@@ -0,0 +1,230 @@
+//! Namespaced sysctl updates for running containers.
+use anyhow::{bail, format_err, Context, Result};
+use nix::sched::{setns, CloneFlags};
+use std::{
+    fs::{self, File, Metadata},
+    os::unix::{fs::MetadataExt, io::AsRawFd},
+    path::PathBuf,
+    thread,
+};
+use strum::AsRefStr;
+use tokio::task;
+use tracing::debug;
+
+/// Sysctls which are namespaced by the IPC namespace.
+const IPC_SYSCTLS: &[&str] = &[
+    "kernel.msgmax",
+    "kernel.msgmnb",
+    "kernel.msgmni",
+    "kernel.sem",
+    "kernel.shmall",
+    "kernel.shmmax",
+    "kernel.shmmni",
+    "kernel.shm_rmid_forced",
+];
+
+/// Sysctl prefix which is namespaced by the IPC namespace.
+const IPC_PREFIX: &str = "fs.mqueue.";
+
+/// Sysctls which are namespaced by the UTS namespace.
+const UTS_SYSCTLS: &[&str] = &["kernel.domainname", "kernel.hostname"];
+
+/// Sysctl prefix which is namespaced by the network namespace.
+const NET_PREFIX: &str = "net.";
+
+#[derive(AsRefStr, Clone, Copy, Debug, Eq, PartialEq)]
+#[strum(serialize_all = "lowercase")]
+/// The namespace a sysctl belongs to.
+pub enum Namespace {
+    Ipc,
+    Net,
+    Uts,
+}
+
+impl Namespace {
+    fn clone_flag(self) -> CloneFlags {
+        match self {
+            Namespace::Ipc => CloneFlags::CLONE_NEWIPC,
+            Namespace::Net => CloneFlags::CLONE_NEWNET,
+            Namespace::Uts => CloneFlags::CLONE_NEWUTS,
+        }
+    }
+}
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// The reason why a sysctl got rejected.
+pub enum Rejection {
+    /// The key is not a valid sysctl name.
+    InvalidKey,
+
+    /// The key is not part of the namespaced sysctl allowlist.
+    NotAllowed,
+
+    /// The value contains invalid characters.
+    InvalidValue,
+
+    /// The container shares the namespace of the sysctl with the host.
+    HostNamespace,
+}
+
+#[derive(Clone, Debug, Eq, PartialEq)]
+/// A validated sysctl which can be applied to a container.
+pub struct Sysctl {
+    key: String,
+    value: String,
+    namespace: Namespace,
+}
+
+impl Sysctl {
+    /// Validate the provided key and value against the namespaced sysctl allowlist.
+    pub fn new(key: &str, value: &str) -> Result<Self, Rejection> {
+        let key = key.replace('/', ".");
+        if key.is_empty()
+            || key.split('.').any(|x| x.is_empty())
+            || !key
+                .chars()
+                .all(|c| c.is_ascii_alphanumeric() || c == '.' || c == '_' || c == '-')
+        {
+            return Err(Rejection::InvalidKey);
+        }
+
+        let namespace = if IPC_SYSCTLS.contains(&key.as_str()) || key.starts_with(IPC_PREFIX) {
+            Namespace::Ipc
+        } else if UTS_SYSCTLS.contains(&key.as_str()) {
+            Namespace::Uts
+        } else if key.starts_with(NET_PREFIX) {
+            Namespace::Net
+        } else {
+            return Err(Rejection::NotAllowed);
+        };
+
+        if value.contains('\n') || value.contains('\0') {
+            return Err(Rejection::InvalidValue);
+        }
+
+        Ok(Self {
+            key,
+            value: value.into(),
+            namespace,
+        })
+    }
+
+    /// The key of the sysctl in dotted notation.
+    pub fn key(&self) -> &str {
+        &self.key
+    }
+
+    /// The namespace the sysctl belongs to.
+    pub fn namespace(&self) -> Namespace {
+        self.namespace
+    }
+
+    /// The path of the sysctl below `/proc/sys`.
+    fn path(&self) -> PathBuf {
+        PathBuf::from("/proc/sys").join(self.key.replace('.', "/"))
+    }
+}
+
+/// Check if the process `pid` shares the provided namespace with the host,
+/// which means with the conmon server itself.
+pub fn shares_host_namespace(pid: u32, namespace: Namespace) -> Result<bool> {
+    let path = format!("/proc/{}/ns/{}", pid, namespace.as_ref());
+    let metadata = fs::metadata(&path).with_context(|| format!("stat namespace {}", path))?;
+    is_host_namespace(&metadata, namespace)
+}
+
+fn is_host_namespace(metadata: &Metadata, namespace: Namespace) -> Result<bool> {
+    let path = format!("/proc/self/ns/{}", namespace.as_ref());
+    let host = fs::metadata(&path).with_context(|| format!("stat namespace {}", path))?;
+    Ok(metadata.dev() == host.dev() && metadata.ino() == host.ino())
+}
+
+/// Apply the provided sysctls inside the namespaces of the process `pid`.
+pub async fn apply(pid: u32, sysctls: Vec<Sysctl>) -> Result<()> {
+    // Joining the namespaces taints the calling thread, which is why we use a
+    // dedicated thread rather than one of the runtime's blocking pool.
+    task::spawn_blocking(move || {
+        thread::spawn(move || apply_in_namespaces(pid, &sysctls))
+            .join()
+            .map_err(|_| format_err!("sysctl thread panicked"))?
+    })
+    .await?
+}
+
+fn apply_in_namespaces(pid: u32, sysctls: &[Sysctl]) -> Result<()> {
+    for namespace in [Namespace::Ipc, Namespace::Net, Namespace::Uts] {
+        if !sysctls.iter().any(|x| x.namespace == namespace) {
+            continue;
+        }
+        let path = format!("/proc/{}/ns/{}", pid, namespace.as_ref());
+        let file = File::open(&path).with_context(|| format!("open namespace {}", path))?;
+        // Re-check the opened namespace to not write to the host if the
+        // process got replaced in the meantime.
+        let metadata = file
+            .metadata()
+            .with_context(|| format!("stat namespace {}", path))?;
+        if is_host_namespace(&metadata, namespace)? {
+            bail!("namespace {} is shared with the host", path)
+        }
+        setns(file.as_raw_fd(), namespace.clone_flag())
+            .with_context(|| format!("join namespace {}", path))?;
+    }
+
+    for sysctl in sysctls {
+        debug!("Setting sysctl {}={}", sysctl.key, sysctl.value);
+        fs::write(sysctl.path(), &sysctl.value)
+            .with_context(|| format!("write sysctl {}", sysctl.key))?;
+    }
+
+    Ok(())
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn new_success() {
+        for (key, namespace) in [
+            ("kernel.shm_rmid_forced", Namespace::Ipc),
+            ("fs.mqueue.msg_max", Namespace::Ipc),
+            ("kernel.hostname", Namespace::Uts),
+            ("net.ipv4.ip_forward", Namespace::Net),
+            ("net/ipv4/ping_group_range", Namespace::Net),
+        ] {
+            let sysctl = Sysctl::new(key, "1").unwrap();
+            assert_eq!(sysctl.namespace, namespace);
+        }
+    }
+
+    #[test]
+    fn new_failure() {
+        for (key, value, rejection) in [
+            ("", "1", Rejection::InvalidKey),
+            ("net..ipv4", "1", Rejection::InvalidKey),
+            ("net.ipv4.*", "1", Rejection::InvalidKey),
+            ("kernel.pid_max", "1", Rejection::NotAllowed),
+            ("vm.swappiness", "1", Rejection::NotAllowed),
+            ("net.ipv4.ip_forward", "1\n", Rejection::InvalidValue),
+        ] {
+            assert_eq!(Sysctl::new(key, value).unwrap_err(), rejection);
+        }
+    }
+
+    #[test]
+    fn shares_host_namespace_success() -> Result<()> {
+        for namespace in [Namespace::Ipc, Namespace::Net, Namespace::Uts] {
+            assert!(shares_host_namespace(std::process::id(), namespace)?);
+        }
+        Ok(())
+    }
+
+    #[test]
+    fn path() {
+        let sysctl = Sysctl::new("net.ipv4.ip_forward", "1").unwrap();
+        assert_eq!(
+            sysctl.path(),
+            PathBuf::from("/proc/sys/net/ipv4/ip_forward")
+        );
+    }
+}
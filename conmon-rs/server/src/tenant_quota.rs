@@ -0,0 +1,185 @@
+//! Resource quotas of tenants.
+use anyhow::{bail, format_err, Result};
+use std::{
+    collections::HashMap,
+    sync::{
+        atomic::{AtomicU64, Ordering},
+        Arc, Mutex,
+    },
+};
+use strum::IntoStaticStr;
+
+macro_rules! lock {
+    ($x:expr) => {
+        $x.lock().map_err(|e| format_err!("{:#}", e))?
+    };
+}
+
+#[derive(Clone, Copy, Debug, Eq, Hash, IntoStaticStr, PartialEq)]
+#[strum(serialize_all = "camelCase")]
+/// Resources which can be limited per tenant.
+pub enum Resource {
+    /// Number of containers.
+    Containers,
+
+    /// Number of exec sessions.
+    ExecSessions,
+
+    /// Number of container log bytes.
+    LogBytes,
+}
+
+type Reservations = Arc<Mutex<HashMap<(String, Resource), u64>>>;
+
+#[derive(Debug, Default)]
+/// Resource usage of all tenants which cannot be derived from the watched
+/// children.
+pub struct TenantQuotas {
+    /// Container log bytes written per tenant.
+    log_bytes: Mutex<HashMap<String, Arc<AtomicU64>>>,
+
+    /// Resources reserved by requests which did not register their child yet.
+    reservations: Reservations,
+}
+
+impl TenantQuotas {
+    /// Retrieve the counter of the container log bytes of the tenant.
+    pub fn log_bytes(&self, tenant: &str) -> Result<Arc<AtomicU64>> {
+        Ok(lock!(self.log_bytes)
+            .entry(tenant.into())
+            .or_default()
+            .clone())
+    }
+
+    /// Retrieve the number of reserved units of the resource for the tenant.
+    pub fn reserved(&self, tenant: &str, resource: Resource) -> Result<u64> {
+        Ok(lock!(self.reservations)
+            .get(&(tenant.to_string(), resource))
+            .copied()
+            .unwrap_or_default())
+    }
+
+    /// Reserve a unit of the resource for the tenant, which gets released if
+    /// the returned reservation gets dropped.
+    pub fn reserve(&self, tenant: &str, resource: Resource) -> Result<Reservation> {
+        let key = (tenant.to_string(), resource);
+        *lock!(self.reservations).entry(key.clone()).or_default() += 1;
+        Ok(Reservation {
+            reservations: self.reservations.clone(),
+            key,
+        })
+    }
+}
+
+#[derive(Debug)]
+/// A reserved unit of a tenant resource.
+pub struct Reservation {
+    reservations: Reservations,
+    key: (String, Resource),
+}
+
+impl Drop for Reservation {
+    fn drop(&mut self) {
+        if let Ok(mut reservations) = self.reservations.lock() {
+            if let Some(count) = reservations.get_mut(&self.key) {
+                *count -= 1;
+                if *count == 0 {
+                    reservations.remove(&self.key);
+                }
+            }
+        }
+    }
+}
+
+/// Fail if using `requested` more units of a resource exceeds its limit.
+/// The error message is parsed by the client, which is why it has to be
+/// kept in sync with `pkg/client/tenant_quota.go`.
+pub fn check(resource: Resource, used: u64, requested: u64, limit: Option<u64>) -> Result<()> {
+    match limit {
+        Some(limit) if used.saturating_add(requested) > limit => {
+            let resource: &str = resource.into();
+            bail!(
+                "tenant quota exceeded for {}: limit of {} reached",
+                resource,
+                limit
+            )
+        }
+        _ => Ok(()),
+    }
+}
+
+#[derive(Clone, Debug)]
+/// The container log quota of a tenant.
+pub struct LogQuota {
+    used: Arc<AtomicU64>,
+    limit: Option<u64>,
+}
+
+impl LogQuota {
+    /// Create a new log quota for the shared counter of the tenant.
+    pub fn new(used: Arc<AtomicU64>, limit: Option<u64>) -> Self {
+        Self { used, limit }
+    }
+
+    /// Account `len` bytes to the quota, which fails if they do not fit.
+    pub fn acquire(&self, len: u64) -> Result<()> {
+        let mut used = self.used.load(Ordering::Relaxed);
+        loop {
+            check(Resource::LogBytes, used, len, self.limit)?;
+            match self.used.compare_exchange_weak(
+                used,
+                used.saturating_add(len),
+                Ordering::Relaxed,
+                Ordering::Relaxed,
+            ) {
+                Ok(_) => return Ok(()),
+                Err(x) => used = x,
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn check_limit() {
+        assert!(check(Resource::Containers, 1, 1, None).is_ok());
+        assert!(check(Resource::Containers, 1, 1, Some(2)).is_ok());
+        let err = check(Resource::ExecSessions, 2, 1, Some(2)).unwrap_err();
+        assert_eq!(
+            err.to_string(),
+            "tenant quota exceeded for execSessions: limit of 2 reached"
+        );
+    }
+
+    #[test]
+    fn reservation_released_on_drop() -> Result<()> {
+        let quotas = TenantQuotas::default();
+        let first = quotas.reserve("tenant", Resource::Containers)?;
+        let second = quotas.reserve("tenant", Resource::Containers)?;
+        assert_eq!(quotas.reserved("tenant", Resource::Containers)?, 2);
+        assert_eq!(quotas.reserved("other", Resource::Containers)?, 0);
+        assert_eq!(quotas.reserved("tenant", Resource::ExecSessions)?, 0);
+
+        drop(first);
+        assert_eq!(quotas.reserved("tenant", Resource::Containers)?, 1);
+        drop(second);
+        assert_eq!(quotas.reserved("tenant", Resource::Containers)?, 0);
+        Ok(())
+    }
+
+    #[test]
+    fn log_quota_acquire() -> Result<()> {
+        let quotas = TenantQuotas::default();
+        let quota = LogQuota::new(quotas.log_bytes("tenant")?, Some(10));
+        assert!(quota.acquire(6).is_ok());
+        assert!(quota.acquire(5).is_err());
+        assert!(quota.acquire(4).is_ok());
+        assert!(quota.acquire(1).is_err());
+        assert_eq!(quotas.log_bytes("tenant")?.load(Ordering::Relaxed), 10);
+        assert_eq!(quotas.log_bytes("other")?.load(Ordering::Relaxed), 0);
+        Ok(())
+    }
+}
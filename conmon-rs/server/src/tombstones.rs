@@ -0,0 +1,350 @@
+//! Tombstones of removed containers, which keep their exit information for
+//! answering late queries.
+use crate::{
+    child_reaper::ExitChannelData,
+    container_events::ContainerEvent,
+    state_store::{Key, StateStore},
+    stats::Stats,
+};
+use anyhow::{format_err, Result};
+use capnp::{message, serialize};
+use conmon_common::conmon_capnp::conmon::tombstone;
+use getset::{CopyGetters, Getters};
+use std::{
+    collections::{HashMap, VecDeque},
+    path::PathBuf,
+    sync::{Arc, Mutex, MutexGuard},
+    time::{Duration, SystemTime, UNIX_EPOCH},
+};
+use tracing::warn;
+
+/// The number of tombstones kept, regardless of the retention.
+const TOMBSTONES_CAPACITY: usize = 1000;
+
+/// The namespace of the tombstones in the state store.
+const NAMESPACE: &str = "tombstone";
+
+#[derive(Clone, CopyGetters, Debug, Getters)]
+/// The record of a removed container.
+pub struct Tombstone {
+    #[getset(get = "pub")]
+    id: String,
+
+    #[getset(get = "pub")]
+    tenant: String,
+
+    #[getset(get_copy = "pub")]
+    pid: u32,
+
+    #[getset(get_copy = "pub")]
+    exit_code: i32,
+
+    #[getset(get_copy = "pub")]
+    oom_killed: bool,
+
+    #[getset(get_copy = "pub")]
+    timed_out: bool,
+
+    /// Nanoseconds since the unix epoch.
+    #[getset(get_copy = "pub")]
+    exited_at: u64,
+
+    /// Nanoseconds since the unix epoch.
+    #[getset(get_copy = "pub")]
+    removed_at: u64,
+
+    /// The last stats collected while the container was running, with their
+    /// timestamp.
+    #[getset(get_copy = "pub")]
+    stats: Option<(u64, Stats)>,
+
+    #[getset(get = "pub")]
+    log_paths: Vec<PathBuf>,
+}
+
+impl Tombstone {
+    /// Create a new tombstone from the exit of a container.
+    pub fn new(event: &ContainerEvent, exit: &ExitChannelData) -> Self {
+        Self {
+            id: event.id().clone(),
+            tenant: event.tenant().clone(),
+            pid: event.pid(),
+            exit_code: event.exit_code(),
+            oom_killed: *exit.oomed(),
+            timed_out: *exit.timed_out(),
+            exited_at: event.timestamp(),
+            removed_at: now(),
+            stats: None,
+            log_paths: event.log_paths().clone(),
+        }
+    }
+
+    /// Set the fields of the capnp tombstone.
+    pub fn build(&self, mut tombstone: tombstone::Builder) {
+        tombstone.set_id(self.id());
+        tombstone.set_pid(self.pid());
+        tombstone.set_exit_code(self.exit_code());
+        tombstone.set_oom_killed(self.oom_killed());
+        tombstone.set_timed_out(self.timed_out());
+        tombstone.set_exited_at(self.exited_at());
+        tombstone.set_removed_at(self.removed_at());
+        if let Some((timestamp, stats)) = self.stats() {
+            stats.build(timestamp, tombstone.reborrow().init_stats());
+        }
+        let mut log_paths = tombstone.init_log_paths(self.log_paths().len() as u32);
+        for (i, path) in self.log_paths().iter().enumerate() {
+            log_paths.set(i as u32, &path.to_string_lossy());
+        }
+    }
+
+    /// Serialize the tombstone for the state store.
+    fn encode(&self) -> Result<Vec<u8>> {
+        let mut message = message::Builder::new_default();
+        self.build(message.init_root());
+        let mut buf = vec![];
+        serialize::write_message(&mut buf, &message)?;
+        Ok(buf)
+    }
+
+    /// Deserialize a tombstone of the `tenant` from the state store.
+    fn decode(tenant: &str, value: &[u8]) -> Result<Self> {
+        let message = serialize::read_message(&mut &value[..], message::ReaderOptions::new())?;
+        let tombstone = message.get_root::<tombstone::Reader>()?;
+        let mut log_paths = vec![];
+        for path in tombstone.get_log_paths()?.iter() {
+            log_paths.push(PathBuf::from(path?));
+        }
+        Ok(Self {
+            id: tombstone.get_id()?.into(),
+            tenant: tenant.into(),
+            pid: tombstone.get_pid(),
+            exit_code: tombstone.get_exit_code(),
+            oom_killed: tombstone.get_oom_killed(),
+            timed_out: tombstone.get_timed_out(),
+            exited_at: tombstone.get_exited_at(),
+            removed_at: tombstone.get_removed_at(),
+            stats: if tombstone.has_stats() {
+                Some(Stats::read(tombstone.get_stats()?)?)
+            } else {
+                None
+            },
+            log_paths,
+        })
+    }
+
+    fn key(&self) -> Key {
+        Key::new(NAMESPACE, &self.tenant, &self.id)
+    }
+
+    fn expired(&self, retention: Duration, now: u64) -> bool {
+        self.removed_at.saturating_add(retention.as_nanos() as u64) < now
+    }
+}
+
+#[derive(Clone, Debug, Default)]
+/// The tombstones of all containers.
+pub struct Tombstones(Arc<Mutex<Inner>>);
+
+#[derive(Debug, Default)]
+struct Inner {
+    tombstones: VecDeque<Tombstone>,
+
+    /// The last stats of the running containers by their PID.
+    last_stats: HashMap<u32, (u64, Stats)>,
+
+    /// The store persisting the tombstones, if they got restored from it.
+    store: Option<StateStore>,
+}
+
+impl Tombstones {
+    /// Remember the stats of the running process `pid`, which become the final
+    /// stats of its tombstone.
+    pub fn observe_stats(&self, pid: u32, timestamp: u64, stats: Stats) -> Result<()> {
+        self.lock()?.last_stats.insert(pid, (timestamp, stats));
+        Ok(())
+    }
+
+    /// Restore the tombstones from the store, which persists them from now
+    /// on. Tombstones older than the retention are removed from the store.
+    pub fn restore(&self, store: StateStore, retention: Duration) -> Result<()> {
+        let now = now();
+        let mut tombstones = vec![];
+        for (key, value) in store.entries(NAMESPACE)? {
+            match Tombstone::decode(key.tenant(), &value) {
+                Ok(tombstone) if !tombstone.expired(retention, now) => tombstones.push(tombstone),
+                Ok(_) => store.delete(&key)?,
+                Err(e) => {
+                    warn!(
+                        "Dropping invalid tombstone of container {}: {:#}",
+                        key.id(),
+                        e
+                    );
+                    store.delete(&key)?;
+                }
+            }
+        }
+        tombstones.sort_by_key(|x| x.removed_at);
+        let excess = tombstones.len().saturating_sub(TOMBSTONES_CAPACITY);
+        for tombstone in tombstones.drain(..excess) {
+            store.delete(&tombstone.key())?;
+        }
+
+        let mut inner = self.lock()?;
+        inner.tombstones = tombstones.into();
+        inner.store = Some(store);
+        Ok(())
+    }
+
+    /// Record the tombstone, replacing an older one of the same container,
+    /// and remove the ones older than the retention.
+    pub fn record(&self, mut tombstone: Tombstone, retention: Duration) -> Result<()> {
+        let mut inner = self.lock()?;
+        tombstone.stats = inner.last_stats.remove(&tombstone.pid);
+
+        let now = now();
+        let mut removed = vec![];
+        inner.tombstones.retain(|x| {
+            let keep = !x.expired(retention, now)
+                && (x.tenant != tombstone.tenant || x.id != tombstone.id);
+            if !keep {
+                removed.push(x.key());
+            }
+            keep
+        });
+        if inner.tombstones.len() == TOMBSTONES_CAPACITY {
+            removed.extend(inner.tombstones.pop_front().map(|x| x.key()));
+        }
+
+        if let Some(store) = &inner.store {
+            let key = tombstone.key();
+            for x in removed.iter().filter(|x| **x != key) {
+                store.delete(x)?;
+            }
+            store.put(key, tombstone.encode()?)?;
+        }
+        inner.tombstones.push_back(tombstone);
+        Ok(())
+    }
+
+    /// Retrieve the tombstone of the container, if it is not older than the
+    /// retention.
+    pub fn get(&self, tenant: &str, id: &str, retention: Duration) -> Result<Option<Tombstone>> {
+        let inner = self.lock()?;
+        let now = now();
+        Ok(inner
+            .tombstones
+            .iter()
+            .find(|x| x.tenant == tenant && x.id == id && !x.expired(retention, now))
+            .cloned())
+    }
+
+    fn lock(&self) -> Result<MutexGuard<Inner>> {
+        self.0
+            .lock()
+            .map_err(|e| format_err!("lock tombstones: {}", e))
+    }
+}
+
+/// The current time in nanoseconds since the unix epoch.
+fn now() -> u64 {
+    SystemTime::now()
+        .duration_since(UNIX_EPOCH)
+        .unwrap_or_default()
+        .as_nanos() as u64
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use std::thread;
+
+    fn tombstone(id: &str, pid: u32) -> Tombstone {
+        Tombstone {
+            id: id.into(),
+            tenant: "tenant".into(),
+            pid,
+            exit_code: 1,
+            oom_killed: false,
+            timed_out: false,
+            exited_at: now(),
+            removed_at: now(),
+            stats: None,
+            log_paths: vec![],
+        }
+    }
+
+    #[test]
+    fn get_tombstones_within_retention() -> Result<()> {
+        let sut = Tombstones::default();
+        sut.observe_stats(2, 42, Stats::default())?;
+        sut.record(tombstone("a", 1), Duration::from_secs(60))?;
+        sut.record(tombstone("a", 2), Duration::from_secs(60))?;
+
+        let found = sut.get("tenant", "a", Duration::from_secs(60))?.unwrap();
+        assert_eq!(found.pid(), 2);
+        assert_eq!(found.stats().map(|(timestamp, _)| timestamp), Some(42));
+        assert!(sut.get("other", "a", Duration::from_secs(60))?.is_none());
+        assert!(sut.get("tenant", "b", Duration::from_secs(60))?.is_none());
+
+        thread::sleep(Duration::from_millis(10));
+        assert!(sut.get("tenant", "a", Duration::from_millis(5))?.is_none());
+        Ok(())
+    }
+
+    #[test]
+    fn remove_expired_tombstones() -> Result<()> {
+        let sut = Tombstones::default();
+        sut.record(tombstone("a", 1), Duration::from_millis(5))?;
+        thread::sleep(Duration::from_millis(10));
+        sut.record(tombstone("b", 2), Duration::from_millis(5))?;
+
+        let ids: Vec<_> = sut
+            .lock()?
+            .tombstones
+            .iter()
+            .map(|x| x.id.clone())
+            .collect();
+        assert_eq!(ids, vec!["b".to_string()]);
+        Ok(())
+    }
+
+    #[test]
+    fn restore_tombstones_from_store() -> Result<()> {
+        let store = StateStore::default();
+        let sut = Tombstones::default();
+        sut.restore(store.clone(), Duration::from_secs(60))?;
+        sut.record(tombstone("a", 1), Duration::from_secs(60))?;
+        sut.record(tombstone("a", 2), Duration::from_secs(60))?;
+        sut.record(tombstone("b", 3), Duration::from_secs(60))?;
+        assert_eq!(store.entries(NAMESPACE)?.len(), 2);
+
+        let restored = Tombstones::default();
+        restored.restore(store.clone(), Duration::from_secs(60))?;
+        let found = restored
+            .get("tenant", "a", Duration::from_secs(60))?
+            .unwrap();
+        assert_eq!(found.pid(), 2);
+
+        thread::sleep(Duration::from_millis(10));
+        restored.restore(store.clone(), Duration::from_millis(5))?;
+        assert!(store.entries(NAMESPACE)?.is_empty());
+        Ok(())
+    }
+
+    #[test]
+    fn encode_tombstones() -> Result<()> {
+        let mut sut = tombstone("a", 1);
+        sut.stats = Some((42, Stats::default()));
+        sut.log_paths = vec![PathBuf::from("/log")];
+
+        let decoded = Tombstone::decode("tenant", &sut.encode()?)?;
+        assert_eq!(decoded.id(), "a");
+        assert_eq!(decoded.tenant(), "tenant");
+        assert_eq!(decoded.pid(), 1);
+        assert_eq!(decoded.exit_code(), 1);
+        assert_eq!(decoded.removed_at(), sut.removed_at());
+        assert_eq!(decoded.stats(), Some((42, Stats::default())));
+        assert_eq!(decoded.log_paths(), &vec![PathBuf::from("/log")]);
+        Ok(())
+    }
+}
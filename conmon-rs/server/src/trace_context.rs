@@ -0,0 +1,118 @@
+//! W3C trace context propagated by the client, which links the spans of a
+//! request to the trace of the caller.
+use conmon_common::conmon_capnp::conmon::trace_context;
+use getset::Getters;
+use tracing::debug;
+
+#[derive(Debug, Default, Getters)]
+/// The trace context of the caller of a request. All fields are empty if the
+/// caller did not propagate a valid context.
+pub struct TraceContext {
+    #[getset(get = "pub")]
+    /// The ID of the whole trace.
+    trace_id: String,
+
+    #[getset(get = "pub")]
+    /// The ID of the span of the caller.
+    parent_id: String,
+
+    #[getset(get = "pub")]
+    /// Vendor specific trace information.
+    tracestate: String,
+}
+
+impl TraceContext {
+    /// Parse the trace context of a request.
+    pub fn from_reader(reader: capnp::Result<trace_context::Reader>) -> Self {
+        let (traceparent, tracestate) =
+            match reader.and_then(|x| Ok((x.get_traceparent()?, x.get_tracestate()?))) {
+                Ok(x) => x,
+                Err(e) => {
+                    debug!("Unable to read trace context: {}", e);
+                    return Self::default();
+                }
+            };
+        if traceparent.is_empty() {
+            return Self::default();
+        }
+        Self::parse(traceparent, tracestate).unwrap_or_else(|| {
+            debug!("Ignoring invalid traceparent {:?}", traceparent);
+            Self::default()
+        })
+    }
+
+    /// Parse the `traceparent` of the format `version-trace_id-parent_id-flags`.
+    fn parse(traceparent: &str, tracestate: &str) -> Option<Self> {
+        let mut parts = traceparent.trim().split('-');
+        let version = parts.next()?;
+        let trace_id = parts.next()?;
+        let parent_id = parts.next()?;
+        let flags = parts.next()?;
+
+        // Later versions may append fields, which are ignored.
+        if version == "ff" || (version == "00" && parts.next().is_some()) {
+            return None;
+        }
+        for (field, len) in [(version, 2), (trace_id, 32), (parent_id, 16), (flags, 2)] {
+            if field.len() != len
+                || !field
+                    .bytes()
+                    .all(|b| matches!(b, b'0'..=b'9' | b'a'..=b'f'))
+            {
+                return None;
+            }
+        }
+        if trace_id.bytes().all(|b| b == b'0') || parent_id.bytes().all(|b| b == b'0') {
+            return None;
+        }
+
+        Some(Self {
+            trace_id: trace_id.into(),
+            parent_id: parent_id.into(),
+            tracestate: tracestate.trim().into(),
+        })
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+
+    #[test]
+    fn parse_success() {
+        let sut = TraceContext::parse(
+            "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+            "vendor=value",
+        )
+        .unwrap();
+        assert_eq!(sut.trace_id(), "4bf92f3577b34da6a3ce929d0e0e4736");
+        assert_eq!(sut.parent_id(), "00f067aa0ba902b7");
+        assert_eq!(sut.tracestate(), "vendor=value");
+
+        assert!(TraceContext::parse(
+            "01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-future",
+            ""
+        )
+        .is_some());
+    }
+
+    #[test]
+    fn parse_failure() {
+        for traceparent in [
+            "",
+            "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+            "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+            "ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+            "00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+            "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+            "00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+            "00-4bf92f3577b34da6-00f067aa0ba902b7-01",
+        ] {
+            assert!(
+                TraceContext::parse(traceparent, "").is_none(),
+                "{}",
+                traceparent
+            );
+        }
+    }
+}
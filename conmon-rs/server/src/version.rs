@@ -5,6 +5,17 @@ use shadow_rs::shadow;
 
 shadow!(build);
 
+// Sync with `pkg/client/version.go`
+/// The version of the RPC protocol, which gets incremented on changes being
+/// incompatible with older clients and on features clients have to detect
+/// before using them.
+///
+/// 2: Scratch directories including their mounts.
+const PROTOCOL_VERSION: u32 = 2;
+
+/// The oldest protocol version of clients supported by the server.
+const MIN_CLIENT_PROTOCOL_VERSION: u32 = 1;
+
 #[derive(CopyGetters, Debug, Default, Eq, PartialEq)]
 #[getset(get_copy = "pub")]
 /// The version structure.
@@ -23,6 +34,12 @@ pub struct Version {
 
     /// The used Rust version.
     rust_version: &'static str,
+
+    /// The version of the RPC protocol.
+    protocol_version: u32,
+
+    /// The oldest protocol version of clients supported by the server.
+    min_client_protocol_version: u32,
 }
 
 impl Version {
@@ -34,6 +51,8 @@ impl Version {
             commit: build::COMMIT_HASH,
             build_date: build::BUILD_TIME,
             rust_version: build::RUST_VERSION,
+            protocol_version: PROTOCOL_VERSION,
+            min_client_protocol_version: MIN_CLIENT_PROTOCOL_VERSION,
         }
     }
 
@@ -51,6 +70,7 @@ impl Version {
         println!("commit: {}", build::COMMIT_HASH);
         println!("build: {}", build::BUILD_TIME);
         println!("{}", build::RUST_VERSION);
+        println!("protocol: {}", PROTOCOL_VERSION);
     }
 }
 
@@ -66,6 +86,7 @@ mod tests {
         assert_eq!(v.commit(), build::COMMIT_HASH);
         assert_eq!(v.build_date(), build::BUILD_TIME);
         assert_eq!(v.rust_version(), build::RUST_VERSION);
+        assert!(v.min_client_protocol_version() <= v.protocol_version());
 
         v.print();
     }
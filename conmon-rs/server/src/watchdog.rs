@@ -0,0 +1,256 @@
+//! Watchdogs requiring periodic client heartbeats for containers.
+use crate::{
+    child_reaper::{kill_grandchild, ReapableChild},
+    container_events::ContainerEvents,
+};
+use anyhow::{bail, format_err, Result};
+use nix::sys::signal::Signal;
+use std::{
+    collections::HashMap,
+    sync::{
+        atomic::{AtomicU64, Ordering},
+        Arc, Mutex, MutexGuard,
+    },
+    time::Duration,
+};
+use tokio::{sync::watch, task, time};
+use tokio_util::sync::CancellationToken;
+use tracing::{debug, debug_span, warn, Instrument};
+
+/// The duration to wait for a container to exit after sending SIGTERM when
+/// stopping it, before it gets killed using SIGKILL.
+const STOP_TIMEOUT: Duration = Duration::from_secs(10);
+
+#[derive(Clone, Copy, Debug, Eq, PartialEq)]
+/// The policy applied if a watchdog expires.
+pub enum Policy {
+    /// Only publish a watchdog expired event.
+    Event,
+
+    /// Publish a watchdog expired event and stop the container.
+    Stop,
+}
+
+#[derive(Debug)]
+/// A single armed watchdog.
+struct Watchdog {
+    /// Distinguishes watchdogs replacing each other.
+    generation: u64,
+
+    /// Resets the timeout of the watchdog.
+    heartbeat: watch::Sender<()>,
+
+    /// Disarms the watchdog.
+    token: CancellationToken,
+}
+
+#[derive(Clone, Debug, Default)]
+/// The watchdogs of all containers by their tenant and ID.
+pub struct Watchdogs {
+    watchdogs: Arc<Mutex<HashMap<(String, String), Watchdog>>>,
+    generation: Arc<AtomicU64>,
+}
+
+impl Watchdogs {
+    /// Arm the watchdog of the container, which expires if there is no
+    /// heartbeat within the timeout. A previously armed watchdog of the
+    /// container gets replaced.
+    pub fn arm(
+        &self,
+        child: &ReapableChild,
+        timeout: Duration,
+        policy: Policy,
+        events: ContainerEvents,
+    ) -> Result<()> {
+        let key = (child.tenant().clone(), child.id().clone());
+        let generation = self.generation.fetch_add(1, Ordering::Relaxed);
+        let (heartbeat, rx) = watch::channel(());
+        let token = CancellationToken::new();
+        let watchdog = Watchdog {
+            generation,
+            heartbeat,
+            token: token.clone(),
+        };
+        if let Some(previous) = self.lock()?.insert(key.clone(), watchdog) {
+            previous.token.cancel();
+        }
+
+        let watchdogs = self.clone();
+        let (pid, child_token) = (child.pid(), child.token().clone());
+        task::spawn(
+            async move {
+                let expiry = Expiry {
+                    key,
+                    pid,
+                    policy,
+                    events,
+                    child_token,
+                };
+                let run = expiry.run(rx, timeout);
+                tokio::select! {
+                    _ = run => watchdogs.remove(&expiry.key, generation),
+                    _ = token.cancelled() => debug!("Watchdog disarmed"),
+                }
+            }
+            .instrument(debug_span!("watchdog", pid)),
+        );
+        Ok(())
+    }
+
+    /// Disarm the watchdog of the container.
+    pub fn disarm(&self, tenant: &str, id: &str) -> Result<()> {
+        match self.lock()?.remove(&(tenant.into(), id.into())) {
+            Some(watchdog) => {
+                watchdog.token.cancel();
+                Ok(())
+            }
+            None => bail!("no watchdog armed for container {}", id),
+        }
+    }
+
+    /// Reset the watchdogs of the provided containers, or all watchdogs of
+    /// the tenant if no IDs are provided.
+    pub fn heartbeat(&self, tenant: &str, ids: &[String]) -> Result<()> {
+        let watchdogs = self.lock()?;
+        if ids.is_empty() {
+            watchdogs
+                .iter()
+                .filter(|((t, _), _)| t == tenant)
+                .for_each(|(_, watchdog)| watchdog.beat());
+            return Ok(());
+        }
+        for id in ids {
+            match watchdogs.get(&(tenant.into(), id.clone())) {
+                Some(watchdog) => watchdog.beat(),
+                None => bail!("no watchdog armed for container {}", id),
+            }
+        }
+        Ok(())
+    }
+
+    fn remove(&self, key: &(String, String), generation: u64) {
+        if let Ok(mut watchdogs) = self.lock() {
+            if watchdogs.get(key).map(|x| x.generation) == Some(generation) {
+                watchdogs.remove(key);
+            }
+        }
+    }
+
+    fn lock(&self) -> Result<MutexGuard<HashMap<(String, String), Watchdog>>> {
+        self.watchdogs
+            .lock()
+            .map_err(|e| format_err!("lock watchdogs: {}", e))
+    }
+}
+
+impl Watchdog {
+    fn beat(&self) {
+        // Sending only fails if the container exited already.
+        let _ = self.heartbeat.send(());
+    }
+}
+
+/// The state of a running watchdog task.
+struct Expiry {
+    key: (String, String),
+    pid: u32,
+    policy: Policy,
+    events: ContainerEvents,
+    child_token: CancellationToken,
+}
+
+impl Expiry {
+    /// Run the watchdog until the container exits.
+    async fn run(&self, mut rx: watch::Receiver<()>, timeout: Duration) {
+        let mut expired = false;
+        loop {
+            tokio::select! {
+                _ = self.child_token.cancelled() => return,
+                changed = rx.changed() => {
+                    if changed.is_err() {
+                        return;
+                    }
+                    expired = false;
+                }
+                _ = time::sleep(timeout), if !expired => {
+                    expired = true;
+                    self.expire().await;
+                }
+            }
+        }
+    }
+
+    /// Apply the policy of the expired watchdog. The watchdog expires again
+    /// only after the next heartbeat.
+    async fn expire(&self) {
+        let (tenant, id) = &self.key;
+        warn!("Watchdog of container {} expired", id);
+        self.events.publish_watchdog_expired(id, tenant, self.pid);
+
+        if self.policy == Policy::Stop {
+            kill_grandchild(self.pid, Signal::SIGTERM);
+            let cancelled = self.child_token.cancelled();
+            if time::timeout(STOP_TIMEOUT, cancelled).await.is_err() {
+                debug!("Container did not exit within timeout, killing it");
+                kill_grandchild(self.pid, Signal::SIGKILL);
+            }
+        }
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::*;
+    use crate::{
+        child::Child,
+        container_events::EventType,
+        container_io::{ContainerIO, SharedContainerIO},
+        container_log::ContainerLog,
+    };
+
+    fn new_child(id: &str) -> Result<ReapableChild> {
+        let io = SharedContainerIO::new(ContainerIO::new(false, ContainerLog::new())?);
+        let child = Child::new(id.into(), 0, vec![], vec![], None, io, String::new());
+        Ok(ReapableChild::from_child(&child))
+    }
+
+    #[tokio::test]
+    async fn expire_without_heartbeat() -> Result<()> {
+        let events = ContainerEvents::default();
+        let mut rx = events.subscribe();
+        let sut = Watchdogs::default();
+        let timeout = Duration::from_millis(200);
+        sut.arm(&new_child("foo")?, timeout, Policy::Event, events)?;
+
+        for _ in 0..3 {
+            time::sleep(timeout / 2).await;
+            sut.heartbeat("", &[])?;
+        }
+        assert!(rx.try_recv().is_err());
+
+        let event = time::timeout(timeout * 2, rx.recv()).await??;
+        assert_eq!(event.typ(), EventType::WatchdogExpired);
+        assert_eq!(event.id(), "foo");
+        Ok(())
+    }
+
+    #[tokio::test]
+    async fn heartbeat_unknown_container() -> Result<()> {
+        let sut = Watchdogs::default();
+        sut.arm(
+            &new_child("foo")?,
+            Duration::from_secs(10),
+            Policy::Event,
+            ContainerEvents::default(),
+        )?;
+
+        sut.heartbeat("", &["foo".into()])?;
+        assert!(sut.heartbeat("", &["bar".into()]).is_err());
+        assert!(sut.heartbeat("other", &["foo".into()]).is_err());
+
+        sut.disarm("", "foo")?;
+        assert!(sut.heartbeat("", &["foo".into()]).is_err());
+        assert!(sut.disarm("", "foo").is_err());
+        Ok(())
+    }
+}
@@ -111,2188 +111,23344 @@ func (c Conmon) SetWindowSizeContainer(ctx context.Context, params func(Conmon_s
 	ans, release := c.Client.SendCall(ctx, s)
 	return Conmon_setWindowSizeContainer_Results_Future{Future: ans.Future()}, release
 }
-
-func (c Conmon) AddRef() Conmon {
-	return Conmon{
-		Client: c.Client.AddRef(),
+func (c Conmon) UpdateSysctls(ctx context.Context, params func(Conmon_updateSysctls_Params) error) (Conmon_updateSysctls_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      6,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "updateSysctls",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_updateSysctls_Params{Struct: s}) }
 	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_updateSysctls_Results_Future{Future: ans.Future()}, release
 }
-
-func (c Conmon) Release() {
-	c.Client.Release()
+func (c Conmon) WatchMounts(ctx context.Context, params func(Conmon_watchMounts_Params) error) (Conmon_watchMounts_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      7,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "watchMounts",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_watchMounts_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_watchMounts_Results_Future{Future: ans.Future()}, release
 }
-
-// A Conmon_Server is a Conmon with a local implementation.
-type Conmon_Server interface {
-	Version(context.Context, Conmon_version) error
-
-	CreateContainer(context.Context, Conmon_createContainer) error
-
-	ExecSyncContainer(context.Context, Conmon_execSyncContainer) error
-
-	AttachContainer(context.Context, Conmon_attachContainer) error
-
-	ReopenLogContainer(context.Context, Conmon_reopenLogContainer) error
-
-	SetWindowSizeContainer(context.Context, Conmon_setWindowSizeContainer) error
+func (c Conmon) WatchQuota(ctx context.Context, params func(Conmon_watchQuota_Params) error) (Conmon_watchQuota_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      8,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "watchQuota",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_watchQuota_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_watchQuota_Results_Future{Future: ans.Future()}, release
 }
-
-// Conmon_NewServer creates a new Server from an implementation of Conmon_Server.
-func Conmon_NewServer(s Conmon_Server, policy *server.Policy) *server.Server {
-	c, _ := s.(server.Shutdowner)
-	return server.New(Conmon_Methods(nil, s), s, c, policy)
+func (c Conmon) ListAttachSessions(ctx context.Context, params func(Conmon_listAttachSessions_Params) error) (Conmon_listAttachSessions_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      9,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "listAttachSessions",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_listAttachSessions_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_listAttachSessions_Results_Future{Future: ans.Future()}, release
 }
-
-// Conmon_ServerToClient creates a new Client from an implementation of Conmon_Server.
-// The caller is responsible for calling Release on the returned Client.
-func Conmon_ServerToClient(s Conmon_Server, policy *server.Policy) Conmon {
-	return Conmon{Client: capnp.NewClient(Conmon_NewServer(s, policy))}
+func (c Conmon) KillAttachSession(ctx context.Context, params func(Conmon_killAttachSession_Params) error) (Conmon_killAttachSession_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      10,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "killAttachSession",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_killAttachSession_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_killAttachSession_Results_Future{Future: ans.Future()}, release
 }
-
-// Conmon_Methods appends Methods to a slice that invoke the methods on s.
-// This can be used to create a more complicated Server.
-func Conmon_Methods(methods []server.Method, s Conmon_Server) []server.Method {
-	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 6)
+func (c Conmon) ExecContainer(ctx context.Context, params func(Conmon_execContainer_Params) error) (Conmon_execContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      11,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "execContainer",
+		},
 	}
-
-	methods = append(methods, server.Method{
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_execContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_execContainer_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) WithTenant(ctx context.Context, params func(Conmon_withTenant_Params) error) (Conmon_withTenant_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
 		Method: capnp.Method{
 			InterfaceID:   0xb737e899dd6633f1,
-			MethodID:      0,
+			MethodID:      12,
 			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
-			MethodName:    "version",
+			MethodName:    "withTenant",
 		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.Version(ctx, Conmon_version{call})
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_withTenant_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_withTenant_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) PortForwardContainer(ctx context.Context, params func(Conmon_portForwardContainer_Params) error) (Conmon_portForwardContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      13,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "portForwardContainer",
 		},
-	})
-
-	methods = append(methods, server.Method{
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_portForwardContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_portForwardContainer_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) ContainerStats(ctx context.Context, params func(Conmon_containerStats_Params) error) (Conmon_containerStats_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
 		Method: capnp.Method{
 			InterfaceID:   0xb737e899dd6633f1,
-			MethodID:      1,
+			MethodID:      14,
 			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
-			MethodName:    "createContainer",
+			MethodName:    "containerStats",
 		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.CreateContainer(ctx, Conmon_createContainer{call})
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_containerStats_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_containerStats_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) TenantQuota(ctx context.Context, params func(Conmon_tenantQuota_Params) error) (Conmon_tenantQuota_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      15,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "tenantQuota",
 		},
-	})
-
-	methods = append(methods, server.Method{
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_tenantQuota_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_tenantQuota_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) ListContainers(ctx context.Context, params func(Conmon_listContainers_Params) error) (Conmon_listContainers_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
 		Method: capnp.Method{
 			InterfaceID:   0xb737e899dd6633f1,
-			MethodID:      2,
+			MethodID:      16,
 			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
-			MethodName:    "execSyncContainer",
+			MethodName:    "listContainers",
 		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.ExecSyncContainer(ctx, Conmon_execSyncContainer{call})
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_listContainers_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_listContainers_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) StopContainer(ctx context.Context, params func(Conmon_stopContainer_Params) error) (Conmon_stopContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      17,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "stopContainer",
 		},
-	})
-
-	methods = append(methods, server.Method{
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_stopContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_stopContainer_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) WatchContainerEvents(ctx context.Context, params func(Conmon_watchContainerEvents_Params) error) (Conmon_watchContainerEvents_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
 		Method: capnp.Method{
 			InterfaceID:   0xb737e899dd6633f1,
-			MethodID:      3,
+			MethodID:      18,
 			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
-			MethodName:    "attachContainer",
+			MethodName:    "watchContainerEvents",
 		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.AttachContainer(ctx, Conmon_attachContainer{call})
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_watchContainerEvents_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_watchContainerEvents_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) SupportBundle(ctx context.Context, params func(Conmon_supportBundle_Params) error) (Conmon_supportBundle_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      19,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "supportBundle",
 		},
-	})
-
-	methods = append(methods, server.Method{
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_supportBundle_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_supportBundle_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) WaitContainer(ctx context.Context, params func(Conmon_waitContainer_Params) error) (Conmon_waitContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
 		Method: capnp.Method{
 			InterfaceID:   0xb737e899dd6633f1,
-			MethodID:      4,
+			MethodID:      20,
 			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
-			MethodName:    "reopenLogContainer",
+			MethodName:    "waitContainer",
 		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.ReopenLogContainer(ctx, Conmon_reopenLogContainer{call})
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_waitContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_waitContainer_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) SetWatchdog(ctx context.Context, params func(Conmon_setWatchdog_Params) error) (Conmon_setWatchdog_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      21,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "setWatchdog",
 		},
-	})
-
-	methods = append(methods, server.Method{
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_setWatchdog_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_setWatchdog_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) Heartbeat(ctx context.Context, params func(Conmon_heartbeat_Params) error) (Conmon_heartbeat_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
 		Method: capnp.Method{
 			InterfaceID:   0xb737e899dd6633f1,
-			MethodID:      5,
+			MethodID:      22,
 			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
-			MethodName:    "setWindowSizeContainer",
+			MethodName:    "heartbeat",
 		},
-		Impl: func(ctx context.Context, call *server.Call) error {
-			return s.SetWindowSizeContainer(ctx, Conmon_setWindowSizeContainer{call})
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_heartbeat_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_heartbeat_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) RotateLogContainer(ctx context.Context, params func(Conmon_rotateLogContainer_Params) error) (Conmon_rotateLogContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      23,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "rotateLogContainer",
 		},
-	})
-
-	return methods
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_rotateLogContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_rotateLogContainer_Results_Future{Future: ans.Future()}, release
 }
-
-// Conmon_version holds the state for a server call to Conmon.version.
-// See server.Call for documentation.
-type Conmon_version struct {
-	*server.Call
+func (c Conmon) ReadLogContainer(ctx context.Context, params func(Conmon_readLogContainer_Params) error) (Conmon_readLogContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      24,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "readLogContainer",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_readLogContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_readLogContainer_Results_Future{Future: ans.Future()}, release
 }
-
+func (c Conmon) SeekLogContainer(ctx context.Context, params func(Conmon_seekLogContainer_Params) error) (Conmon_seekLogContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      25,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "seekLogContainer",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_seekLogContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_seekLogContainer_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) FlushLogs(ctx context.Context, params func(Conmon_flushLogs_Params) error) (Conmon_flushLogs_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      26,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "flushLogs",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_flushLogs_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_flushLogs_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) CheckpointContainer(ctx context.Context, params func(Conmon_checkpointContainer_Params) error) (Conmon_checkpointContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      27,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "checkpointContainer",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_checkpointContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_checkpointContainer_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) RestoreContainer(ctx context.Context, params func(Conmon_restoreContainer_Params) error) (Conmon_restoreContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      28,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "restoreContainer",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_restoreContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_restoreContainer_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) KillContainer(ctx context.Context, params func(Conmon_killContainer_Params) error) (Conmon_killContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      29,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "killContainer",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_killContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_killContainer_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) PauseContainer(ctx context.Context, params func(Conmon_pauseContainer_Params) error) (Conmon_pauseContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      30,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "pauseContainer",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_pauseContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_pauseContainer_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) UnpauseContainer(ctx context.Context, params func(Conmon_unpauseContainer_Params) error) (Conmon_unpauseContainer_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      31,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "unpauseContainer",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_unpauseContainer_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_unpauseContainer_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) ArchiveLogs(ctx context.Context, params func(Conmon_archiveLogs_Params) error) (Conmon_archiveLogs_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      32,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "archiveLogs",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_archiveLogs_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_archiveLogs_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) SetLabels(ctx context.Context, params func(Conmon_setLabels_Params) error) (Conmon_setLabels_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      33,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "setLabels",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_setLabels_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_setLabels_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) GetLabels(ctx context.Context, params func(Conmon_getLabels_Params) error) (Conmon_getLabels_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      34,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "getLabels",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_getLabels_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_getLabels_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) ServeSeccompNotify(ctx context.Context, params func(Conmon_serveSeccompNotify_Params) error) (Conmon_serveSeccompNotify_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      35,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "serveSeccompNotify",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_serveSeccompNotify_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_serveSeccompNotify_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) UploadCheckpointImage(ctx context.Context, params func(Conmon_uploadCheckpointImage_Params) error) (Conmon_uploadCheckpointImage_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      36,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "uploadCheckpointImage",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_uploadCheckpointImage_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_uploadCheckpointImage_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) UpdateContainerResources(ctx context.Context, params func(Conmon_updateContainerResources_Params) error) (Conmon_updateContainerResources_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      37,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "updateContainerResources",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_updateContainerResources_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_updateContainerResources_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) HealthCheck(ctx context.Context, params func(Conmon_healthCheck_Params) error) (Conmon_healthCheck_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      38,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "healthCheck",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_healthCheck_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_healthCheck_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) CancelRequest(ctx context.Context, params func(Conmon_cancelRequest_Params) error) (Conmon_cancelRequest_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      39,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "cancelRequest",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_cancelRequest_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_cancelRequest_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) GetTombstone(ctx context.Context, params func(Conmon_getTombstone_Params) error) (Conmon_getTombstone_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      40,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "getTombstone",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_getTombstone_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_getTombstone_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) GetEvents(ctx context.Context, params func(Conmon_getEvents_Params) error) (Conmon_getEvents_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      41,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "getEvents",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_getEvents_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_getEvents_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) InspectState(ctx context.Context, params func(Conmon_inspectState_Params) error) (Conmon_inspectState_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      42,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "inspectState",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_inspectState_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_inspectState_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) CompactState(ctx context.Context, params func(Conmon_compactState_Params) error) (Conmon_compactState_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      43,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "compactState",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_compactState_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_compactState_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon) GetState(ctx context.Context, params func(Conmon_getState_Params) error) (Conmon_getState_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      44,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "getState",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_getState_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_getState_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Conmon) AddRef() Conmon {
+	return Conmon{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Conmon) Release() {
+	c.Client.Release()
+}
+
+// A Conmon_Server is a Conmon with a local implementation.
+type Conmon_Server interface {
+	Version(context.Context, Conmon_version) error
+
+	CreateContainer(context.Context, Conmon_createContainer) error
+
+	ExecSyncContainer(context.Context, Conmon_execSyncContainer) error
+
+	AttachContainer(context.Context, Conmon_attachContainer) error
+
+	ReopenLogContainer(context.Context, Conmon_reopenLogContainer) error
+
+	SetWindowSizeContainer(context.Context, Conmon_setWindowSizeContainer) error
+
+	UpdateSysctls(context.Context, Conmon_updateSysctls) error
+
+	WatchMounts(context.Context, Conmon_watchMounts) error
+
+	WatchQuota(context.Context, Conmon_watchQuota) error
+
+	ListAttachSessions(context.Context, Conmon_listAttachSessions) error
+
+	KillAttachSession(context.Context, Conmon_killAttachSession) error
+
+	ExecContainer(context.Context, Conmon_execContainer) error
+
+	WithTenant(context.Context, Conmon_withTenant) error
+
+	PortForwardContainer(context.Context, Conmon_portForwardContainer) error
+
+	ContainerStats(context.Context, Conmon_containerStats) error
+
+	TenantQuota(context.Context, Conmon_tenantQuota) error
+
+	ListContainers(context.Context, Conmon_listContainers) error
+
+	StopContainer(context.Context, Conmon_stopContainer) error
+
+	WatchContainerEvents(context.Context, Conmon_watchContainerEvents) error
+
+	SupportBundle(context.Context, Conmon_supportBundle) error
+
+	WaitContainer(context.Context, Conmon_waitContainer) error
+
+	SetWatchdog(context.Context, Conmon_setWatchdog) error
+
+	Heartbeat(context.Context, Conmon_heartbeat) error
+
+	RotateLogContainer(context.Context, Conmon_rotateLogContainer) error
+
+	ReadLogContainer(context.Context, Conmon_readLogContainer) error
+
+	SeekLogContainer(context.Context, Conmon_seekLogContainer) error
+
+	FlushLogs(context.Context, Conmon_flushLogs) error
+
+	CheckpointContainer(context.Context, Conmon_checkpointContainer) error
+
+	RestoreContainer(context.Context, Conmon_restoreContainer) error
+
+	KillContainer(context.Context, Conmon_killContainer) error
+
+	PauseContainer(context.Context, Conmon_pauseContainer) error
+
+	UnpauseContainer(context.Context, Conmon_unpauseContainer) error
+
+	ArchiveLogs(context.Context, Conmon_archiveLogs) error
+
+	SetLabels(context.Context, Conmon_setLabels) error
+
+	GetLabels(context.Context, Conmon_getLabels) error
+
+	ServeSeccompNotify(context.Context, Conmon_serveSeccompNotify) error
+
+	UploadCheckpointImage(context.Context, Conmon_uploadCheckpointImage) error
+
+	UpdateContainerResources(context.Context, Conmon_updateContainerResources) error
+
+	HealthCheck(context.Context, Conmon_healthCheck) error
+
+	CancelRequest(context.Context, Conmon_cancelRequest) error
+
+	GetTombstone(context.Context, Conmon_getTombstone) error
+
+	GetEvents(context.Context, Conmon_getEvents) error
+
+	InspectState(context.Context, Conmon_inspectState) error
+
+	CompactState(context.Context, Conmon_compactState) error
+
+	GetState(context.Context, Conmon_getState) error
+}
+
+// Conmon_NewServer creates a new Server from an implementation of Conmon_Server.
+func Conmon_NewServer(s Conmon_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Conmon_Methods(nil, s), s, c, policy)
+}
+
+// Conmon_ServerToClient creates a new Client from an implementation of Conmon_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Conmon_ServerToClient(s Conmon_Server, policy *server.Policy) Conmon {
+	return Conmon{Client: capnp.NewClient(Conmon_NewServer(s, policy))}
+}
+
+// Conmon_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Conmon_Methods(methods []server.Method, s Conmon_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 45)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "version",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Version(ctx, Conmon_version{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      1,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "createContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.CreateContainer(ctx, Conmon_createContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      2,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "execSyncContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ExecSyncContainer(ctx, Conmon_execSyncContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      3,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "attachContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.AttachContainer(ctx, Conmon_attachContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      4,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "reopenLogContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ReopenLogContainer(ctx, Conmon_reopenLogContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      5,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "setWindowSizeContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.SetWindowSizeContainer(ctx, Conmon_setWindowSizeContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      6,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "updateSysctls",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.UpdateSysctls(ctx, Conmon_updateSysctls{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      7,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "watchMounts",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.WatchMounts(ctx, Conmon_watchMounts{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      8,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "watchQuota",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.WatchQuota(ctx, Conmon_watchQuota{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      9,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "listAttachSessions",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ListAttachSessions(ctx, Conmon_listAttachSessions{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      10,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "killAttachSession",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.KillAttachSession(ctx, Conmon_killAttachSession{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      11,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "execContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ExecContainer(ctx, Conmon_execContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      12,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "withTenant",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.WithTenant(ctx, Conmon_withTenant{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      13,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "portForwardContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.PortForwardContainer(ctx, Conmon_portForwardContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      14,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "containerStats",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ContainerStats(ctx, Conmon_containerStats{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      15,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "tenantQuota",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.TenantQuota(ctx, Conmon_tenantQuota{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      16,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "listContainers",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ListContainers(ctx, Conmon_listContainers{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      17,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "stopContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.StopContainer(ctx, Conmon_stopContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      18,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "watchContainerEvents",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.WatchContainerEvents(ctx, Conmon_watchContainerEvents{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      19,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "supportBundle",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.SupportBundle(ctx, Conmon_supportBundle{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      20,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "waitContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.WaitContainer(ctx, Conmon_waitContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      21,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "setWatchdog",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.SetWatchdog(ctx, Conmon_setWatchdog{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      22,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "heartbeat",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Heartbeat(ctx, Conmon_heartbeat{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      23,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "rotateLogContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.RotateLogContainer(ctx, Conmon_rotateLogContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      24,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "readLogContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ReadLogContainer(ctx, Conmon_readLogContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      25,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "seekLogContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.SeekLogContainer(ctx, Conmon_seekLogContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      26,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "flushLogs",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.FlushLogs(ctx, Conmon_flushLogs{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      27,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "checkpointContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.CheckpointContainer(ctx, Conmon_checkpointContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      28,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "restoreContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.RestoreContainer(ctx, Conmon_restoreContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      29,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "killContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.KillContainer(ctx, Conmon_killContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      30,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "pauseContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.PauseContainer(ctx, Conmon_pauseContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      31,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "unpauseContainer",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.UnpauseContainer(ctx, Conmon_unpauseContainer{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      32,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "archiveLogs",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ArchiveLogs(ctx, Conmon_archiveLogs{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      33,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "setLabels",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.SetLabels(ctx, Conmon_setLabels{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      34,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "getLabels",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.GetLabels(ctx, Conmon_getLabels{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      35,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "serveSeccompNotify",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.ServeSeccompNotify(ctx, Conmon_serveSeccompNotify{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      36,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "uploadCheckpointImage",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.UploadCheckpointImage(ctx, Conmon_uploadCheckpointImage{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      37,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "updateContainerResources",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.UpdateContainerResources(ctx, Conmon_updateContainerResources{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      38,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "healthCheck",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.HealthCheck(ctx, Conmon_healthCheck{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      39,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "cancelRequest",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.CancelRequest(ctx, Conmon_cancelRequest{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      40,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "getTombstone",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.GetTombstone(ctx, Conmon_getTombstone{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      41,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "getEvents",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.GetEvents(ctx, Conmon_getEvents{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      42,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "inspectState",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.InspectState(ctx, Conmon_inspectState{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      43,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "compactState",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.CompactState(ctx, Conmon_compactState{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xb737e899dd6633f1,
+			MethodID:      44,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon",
+			MethodName:    "getState",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.GetState(ctx, Conmon_getState{call})
+		},
+	})
+
+	return methods
+}
+
+// Conmon_version holds the state for a server call to Conmon.version.
+// See server.Call for documentation.
+type Conmon_version struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_version) Args() Conmon_version_Params {
+	return Conmon_version_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_version) AllocResults() (Conmon_version_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_version_Results{Struct: r}, err
+}
+
+// Conmon_createContainer holds the state for a server call to Conmon.createContainer.
+// See server.Call for documentation.
+type Conmon_createContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_createContainer) Args() Conmon_createContainer_Params {
+	return Conmon_createContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_createContainer) AllocResults() (Conmon_createContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_createContainer_Results{Struct: r}, err
+}
+
+// Conmon_execSyncContainer holds the state for a server call to Conmon.execSyncContainer.
+// See server.Call for documentation.
+type Conmon_execSyncContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_execSyncContainer) Args() Conmon_execSyncContainer_Params {
+	return Conmon_execSyncContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_execSyncContainer) AllocResults() (Conmon_execSyncContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execSyncContainer_Results{Struct: r}, err
+}
+
+// Conmon_attachContainer holds the state for a server call to Conmon.attachContainer.
+// See server.Call for documentation.
+type Conmon_attachContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_attachContainer) Args() Conmon_attachContainer_Params {
+	return Conmon_attachContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_attachContainer) AllocResults() (Conmon_attachContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_attachContainer_Results{Struct: r}, err
+}
+
+// Conmon_reopenLogContainer holds the state for a server call to Conmon.reopenLogContainer.
+// See server.Call for documentation.
+type Conmon_reopenLogContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_reopenLogContainer) Args() Conmon_reopenLogContainer_Params {
+	return Conmon_reopenLogContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_reopenLogContainer) AllocResults() (Conmon_reopenLogContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_reopenLogContainer_Results{Struct: r}, err
+}
+
+// Conmon_setWindowSizeContainer holds the state for a server call to Conmon.setWindowSizeContainer.
+// See server.Call for documentation.
+type Conmon_setWindowSizeContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_setWindowSizeContainer) Args() Conmon_setWindowSizeContainer_Params {
+	return Conmon_setWindowSizeContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_setWindowSizeContainer) AllocResults() (Conmon_setWindowSizeContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWindowSizeContainer_Results{Struct: r}, err
+}
+
+// Conmon_updateSysctls holds the state for a server call to Conmon.updateSysctls.
+// See server.Call for documentation.
+type Conmon_updateSysctls struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_updateSysctls) Args() Conmon_updateSysctls_Params {
+	return Conmon_updateSysctls_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_updateSysctls) AllocResults() (Conmon_updateSysctls_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_updateSysctls_Results{Struct: r}, err
+}
+
+// Conmon_watchMounts holds the state for a server call to Conmon.watchMounts.
+// See server.Call for documentation.
+type Conmon_watchMounts struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_watchMounts) Args() Conmon_watchMounts_Params {
+	return Conmon_watchMounts_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_watchMounts) AllocResults() (Conmon_watchMounts_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchMounts_Results{Struct: r}, err
+}
+
+// Conmon_watchQuota holds the state for a server call to Conmon.watchQuota.
+// See server.Call for documentation.
+type Conmon_watchQuota struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_watchQuota) Args() Conmon_watchQuota_Params {
+	return Conmon_watchQuota_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_watchQuota) AllocResults() (Conmon_watchQuota_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchQuota_Results{Struct: r}, err
+}
+
+// Conmon_listAttachSessions holds the state for a server call to Conmon.listAttachSessions.
+// See server.Call for documentation.
+type Conmon_listAttachSessions struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_listAttachSessions) Args() Conmon_listAttachSessions_Params {
+	return Conmon_listAttachSessions_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_listAttachSessions) AllocResults() (Conmon_listAttachSessions_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_listAttachSessions_Results{Struct: r}, err
+}
+
+// Conmon_killAttachSession holds the state for a server call to Conmon.killAttachSession.
+// See server.Call for documentation.
+type Conmon_killAttachSession struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_killAttachSession) Args() Conmon_killAttachSession_Params {
+	return Conmon_killAttachSession_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_killAttachSession) AllocResults() (Conmon_killAttachSession_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_killAttachSession_Results{Struct: r}, err
+}
+
+// Conmon_execContainer holds the state for a server call to Conmon.execContainer.
+// See server.Call for documentation.
+type Conmon_execContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_execContainer) Args() Conmon_execContainer_Params {
+	return Conmon_execContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_execContainer) AllocResults() (Conmon_execContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execContainer_Results{Struct: r}, err
+}
+
+// Conmon_withTenant holds the state for a server call to Conmon.withTenant.
+// See server.Call for documentation.
+type Conmon_withTenant struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_withTenant) Args() Conmon_withTenant_Params {
+	return Conmon_withTenant_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_withTenant) AllocResults() (Conmon_withTenant_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_withTenant_Results{Struct: r}, err
+}
+
+// Conmon_portForwardContainer holds the state for a server call to Conmon.portForwardContainer.
+// See server.Call for documentation.
+type Conmon_portForwardContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_portForwardContainer) Args() Conmon_portForwardContainer_Params {
+	return Conmon_portForwardContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_portForwardContainer) AllocResults() (Conmon_portForwardContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_portForwardContainer_Results{Struct: r}, err
+}
+
+// Conmon_containerStats holds the state for a server call to Conmon.containerStats.
+// See server.Call for documentation.
+type Conmon_containerStats struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_containerStats) Args() Conmon_containerStats_Params {
+	return Conmon_containerStats_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_containerStats) AllocResults() (Conmon_containerStats_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerStats_Results{Struct: r}, err
+}
+
+// Conmon_tenantQuota holds the state for a server call to Conmon.tenantQuota.
+// See server.Call for documentation.
+type Conmon_tenantQuota struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_tenantQuota) Args() Conmon_tenantQuota_Params {
+	return Conmon_tenantQuota_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_tenantQuota) AllocResults() (Conmon_tenantQuota_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_tenantQuota_Results{Struct: r}, err
+}
+
+// Conmon_listContainers holds the state for a server call to Conmon.listContainers.
+// See server.Call for documentation.
+type Conmon_listContainers struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_listContainers) Args() Conmon_listContainers_Params {
+	return Conmon_listContainers_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_listContainers) AllocResults() (Conmon_listContainers_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_listContainers_Results{Struct: r}, err
+}
+
+// Conmon_stopContainer holds the state for a server call to Conmon.stopContainer.
+// See server.Call for documentation.
+type Conmon_stopContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_stopContainer) Args() Conmon_stopContainer_Params {
+	return Conmon_stopContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_stopContainer) AllocResults() (Conmon_stopContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_stopContainer_Results{Struct: r}, err
+}
+
+// Conmon_watchContainerEvents holds the state for a server call to Conmon.watchContainerEvents.
+// See server.Call for documentation.
+type Conmon_watchContainerEvents struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_watchContainerEvents) Args() Conmon_watchContainerEvents_Params {
+	return Conmon_watchContainerEvents_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_watchContainerEvents) AllocResults() (Conmon_watchContainerEvents_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchContainerEvents_Results{Struct: r}, err
+}
+
+// Conmon_supportBundle holds the state for a server call to Conmon.supportBundle.
+// See server.Call for documentation.
+type Conmon_supportBundle struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_supportBundle) Args() Conmon_supportBundle_Params {
+	return Conmon_supportBundle_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_supportBundle) AllocResults() (Conmon_supportBundle_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_supportBundle_Results{Struct: r}, err
+}
+
+// Conmon_waitContainer holds the state for a server call to Conmon.waitContainer.
+// See server.Call for documentation.
+type Conmon_waitContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_waitContainer) Args() Conmon_waitContainer_Params {
+	return Conmon_waitContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_waitContainer) AllocResults() (Conmon_waitContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_waitContainer_Results{Struct: r}, err
+}
+
+// Conmon_setWatchdog holds the state for a server call to Conmon.setWatchdog.
+// See server.Call for documentation.
+type Conmon_setWatchdog struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_setWatchdog) Args() Conmon_setWatchdog_Params {
+	return Conmon_setWatchdog_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_setWatchdog) AllocResults() (Conmon_setWatchdog_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWatchdog_Results{Struct: r}, err
+}
+
+// Conmon_heartbeat holds the state for a server call to Conmon.heartbeat.
+// See server.Call for documentation.
+type Conmon_heartbeat struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_heartbeat) Args() Conmon_heartbeat_Params {
+	return Conmon_heartbeat_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_heartbeat) AllocResults() (Conmon_heartbeat_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_heartbeat_Results{Struct: r}, err
+}
+
+// Conmon_rotateLogContainer holds the state for a server call to Conmon.rotateLogContainer.
+// See server.Call for documentation.
+type Conmon_rotateLogContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_rotateLogContainer) Args() Conmon_rotateLogContainer_Params {
+	return Conmon_rotateLogContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_rotateLogContainer) AllocResults() (Conmon_rotateLogContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_rotateLogContainer_Results{Struct: r}, err
+}
+
+// Conmon_readLogContainer holds the state for a server call to Conmon.readLogContainer.
+// See server.Call for documentation.
+type Conmon_readLogContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_readLogContainer) Args() Conmon_readLogContainer_Params {
+	return Conmon_readLogContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_readLogContainer) AllocResults() (Conmon_readLogContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_readLogContainer_Results{Struct: r}, err
+}
+
+// Conmon_seekLogContainer holds the state for a server call to Conmon.seekLogContainer.
+// See server.Call for documentation.
+type Conmon_seekLogContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_seekLogContainer) Args() Conmon_seekLogContainer_Params {
+	return Conmon_seekLogContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_seekLogContainer) AllocResults() (Conmon_seekLogContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_seekLogContainer_Results{Struct: r}, err
+}
+
+// Conmon_flushLogs holds the state for a server call to Conmon.flushLogs.
+// See server.Call for documentation.
+type Conmon_flushLogs struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_flushLogs) Args() Conmon_flushLogs_Params {
+	return Conmon_flushLogs_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_flushLogs) AllocResults() (Conmon_flushLogs_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_flushLogs_Results{Struct: r}, err
+}
+
+// Conmon_checkpointContainer holds the state for a server call to Conmon.checkpointContainer.
+// See server.Call for documentation.
+type Conmon_checkpointContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_checkpointContainer) Args() Conmon_checkpointContainer_Params {
+	return Conmon_checkpointContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_checkpointContainer) AllocResults() (Conmon_checkpointContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_checkpointContainer_Results{Struct: r}, err
+}
+
+// Conmon_restoreContainer holds the state for a server call to Conmon.restoreContainer.
+// See server.Call for documentation.
+type Conmon_restoreContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_restoreContainer) Args() Conmon_restoreContainer_Params {
+	return Conmon_restoreContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_restoreContainer) AllocResults() (Conmon_restoreContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_restoreContainer_Results{Struct: r}, err
+}
+
+// Conmon_killContainer holds the state for a server call to Conmon.killContainer.
+// See server.Call for documentation.
+type Conmon_killContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_killContainer) Args() Conmon_killContainer_Params {
+	return Conmon_killContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_killContainer) AllocResults() (Conmon_killContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_killContainer_Results{Struct: r}, err
+}
+
+// Conmon_pauseContainer holds the state for a server call to Conmon.pauseContainer.
+// See server.Call for documentation.
+type Conmon_pauseContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_pauseContainer) Args() Conmon_pauseContainer_Params {
+	return Conmon_pauseContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_pauseContainer) AllocResults() (Conmon_pauseContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_pauseContainer_Results{Struct: r}, err
+}
+
+// Conmon_unpauseContainer holds the state for a server call to Conmon.unpauseContainer.
+// See server.Call for documentation.
+type Conmon_unpauseContainer struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_unpauseContainer) Args() Conmon_unpauseContainer_Params {
+	return Conmon_unpauseContainer_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_unpauseContainer) AllocResults() (Conmon_unpauseContainer_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_unpauseContainer_Results{Struct: r}, err
+}
+
+// Conmon_archiveLogs holds the state for a server call to Conmon.archiveLogs.
+// See server.Call for documentation.
+type Conmon_archiveLogs struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_archiveLogs) Args() Conmon_archiveLogs_Params {
+	return Conmon_archiveLogs_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_archiveLogs) AllocResults() (Conmon_archiveLogs_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_archiveLogs_Results{Struct: r}, err
+}
+
+// Conmon_setLabels holds the state for a server call to Conmon.setLabels.
+// See server.Call for documentation.
+type Conmon_setLabels struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_setLabels) Args() Conmon_setLabels_Params {
+	return Conmon_setLabels_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_setLabels) AllocResults() (Conmon_setLabels_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setLabels_Results{Struct: r}, err
+}
+
+// Conmon_getLabels holds the state for a server call to Conmon.getLabels.
+// See server.Call for documentation.
+type Conmon_getLabels struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_getLabels) Args() Conmon_getLabels_Params {
+	return Conmon_getLabels_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_getLabels) AllocResults() (Conmon_getLabels_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_getLabels_Results{Struct: r}, err
+}
+
+// Conmon_serveSeccompNotify holds the state for a server call to Conmon.serveSeccompNotify.
+// See server.Call for documentation.
+type Conmon_serveSeccompNotify struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_serveSeccompNotify) Args() Conmon_serveSeccompNotify_Params {
+	return Conmon_serveSeccompNotify_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_serveSeccompNotify) AllocResults() (Conmon_serveSeccompNotify_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_serveSeccompNotify_Results{Struct: r}, err
+}
+
+// Conmon_uploadCheckpointImage holds the state for a server call to Conmon.uploadCheckpointImage.
+// See server.Call for documentation.
+type Conmon_uploadCheckpointImage struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_uploadCheckpointImage) Args() Conmon_uploadCheckpointImage_Params {
+	return Conmon_uploadCheckpointImage_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_uploadCheckpointImage) AllocResults() (Conmon_uploadCheckpointImage_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_uploadCheckpointImage_Results{Struct: r}, err
+}
+
+// Conmon_updateContainerResources holds the state for a server call to Conmon.updateContainerResources.
+// See server.Call for documentation.
+type Conmon_updateContainerResources struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_updateContainerResources) Args() Conmon_updateContainerResources_Params {
+	return Conmon_updateContainerResources_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_updateContainerResources) AllocResults() (Conmon_updateContainerResources_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_updateContainerResources_Results{Struct: r}, err
+}
+
+// Conmon_healthCheck holds the state for a server call to Conmon.healthCheck.
+// See server.Call for documentation.
+type Conmon_healthCheck struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_healthCheck) Args() Conmon_healthCheck_Params {
+	return Conmon_healthCheck_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_healthCheck) AllocResults() (Conmon_healthCheck_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_healthCheck_Results{Struct: r}, err
+}
+
+// Conmon_cancelRequest holds the state for a server call to Conmon.cancelRequest.
+// See server.Call for documentation.
+type Conmon_cancelRequest struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_cancelRequest) Args() Conmon_cancelRequest_Params {
+	return Conmon_cancelRequest_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_cancelRequest) AllocResults() (Conmon_cancelRequest_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_cancelRequest_Results{Struct: r}, err
+}
+
+// Conmon_getTombstone holds the state for a server call to Conmon.getTombstone.
+// See server.Call for documentation.
+type Conmon_getTombstone struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_getTombstone) Args() Conmon_getTombstone_Params {
+	return Conmon_getTombstone_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_getTombstone) AllocResults() (Conmon_getTombstone_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_getTombstone_Results{Struct: r}, err
+}
+
+// Conmon_getEvents holds the state for a server call to Conmon.getEvents.
+// See server.Call for documentation.
+type Conmon_getEvents struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_getEvents) Args() Conmon_getEvents_Params {
+	return Conmon_getEvents_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_getEvents) AllocResults() (Conmon_getEvents_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_getEvents_Results{Struct: r}, err
+}
+
+// Conmon_inspectState holds the state for a server call to Conmon.inspectState.
+// See server.Call for documentation.
+type Conmon_inspectState struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_inspectState) Args() Conmon_inspectState_Params {
+	return Conmon_inspectState_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_inspectState) AllocResults() (Conmon_inspectState_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_inspectState_Results{Struct: r}, err
+}
+
+// Conmon_compactState holds the state for a server call to Conmon.compactState.
+// See server.Call for documentation.
+type Conmon_compactState struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_compactState) Args() Conmon_compactState_Params {
+	return Conmon_compactState_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_compactState) AllocResults() (Conmon_compactState_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_compactState_Results{Struct: r}, err
+}
+
+// Conmon_getState holds the state for a server call to Conmon.getState.
+// See server.Call for documentation.
+type Conmon_getState struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_getState) Args() Conmon_getState_Params {
+	return Conmon_getState_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_getState) AllocResults() (Conmon_getState_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_getState_Results{Struct: r}, err
+}
+
+type Conmon_VersionResponse struct{ capnp.Struct }
+
+// Conmon_VersionResponse_TypeID is the unique identifier for the type Conmon_VersionResponse.
+const Conmon_VersionResponse_TypeID = 0xf34be5cbac1feed1
+
+func NewConmon_VersionResponse(s *capnp.Segment) (Conmon_VersionResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 5})
+	return Conmon_VersionResponse{st}, err
+}
+
+func NewRootConmon_VersionResponse(s *capnp.Segment) (Conmon_VersionResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 5})
+	return Conmon_VersionResponse{st}, err
+}
+
+func ReadRootConmon_VersionResponse(msg *capnp.Message) (Conmon_VersionResponse, error) {
+	root, err := msg.Root()
+	return Conmon_VersionResponse{root.Struct()}, err
+}
+
+func (s Conmon_VersionResponse) String() string {
+	str, _ := text.Marshal(0xf34be5cbac1feed1, s.Struct)
+	return str
+}
+
+func (s Conmon_VersionResponse) Version() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_VersionResponse) HasVersion() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_VersionResponse) VersionBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_VersionResponse) SetVersion(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_VersionResponse) Tag() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_VersionResponse) HasTag() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_VersionResponse) TagBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_VersionResponse) SetTag(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_VersionResponse) Commit() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s Conmon_VersionResponse) HasCommit() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_VersionResponse) CommitBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_VersionResponse) SetCommit(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
+func (s Conmon_VersionResponse) BuildDate() (string, error) {
+	p, err := s.Struct.Ptr(3)
+	return p.Text(), err
+}
+
+func (s Conmon_VersionResponse) HasBuildDate() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Conmon_VersionResponse) BuildDateBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(3)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_VersionResponse) SetBuildDate(v string) error {
+	return s.Struct.SetText(3, v)
+}
+
+func (s Conmon_VersionResponse) RustVersion() (string, error) {
+	p, err := s.Struct.Ptr(4)
+	return p.Text(), err
+}
+
+func (s Conmon_VersionResponse) HasRustVersion() bool {
+	return s.Struct.HasPtr(4)
+}
+
+func (s Conmon_VersionResponse) RustVersionBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(4)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_VersionResponse) SetRustVersion(v string) error {
+	return s.Struct.SetText(4, v)
+}
+
+func (s Conmon_VersionResponse) ProcessId() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Conmon_VersionResponse) SetProcessId(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s Conmon_VersionResponse) ProtocolVersion() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Conmon_VersionResponse) SetProtocolVersion(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+func (s Conmon_VersionResponse) MinClientProtocolVersion() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s Conmon_VersionResponse) SetMinClientProtocolVersion(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+// Conmon_VersionResponse_List is a list of Conmon_VersionResponse.
+type Conmon_VersionResponse_List = capnp.StructList[Conmon_VersionResponse]
+
+// NewConmon_VersionResponse creates a new list of Conmon_VersionResponse.
+func NewConmon_VersionResponse_List(s *capnp.Segment, sz int32) (Conmon_VersionResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 5}, sz)
+	return capnp.StructList[Conmon_VersionResponse]{l}, err
+}
+
+// Conmon_VersionResponse_Future is a wrapper for a Conmon_VersionResponse promised by a client call.
+type Conmon_VersionResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_VersionResponse_Future) Struct() (Conmon_VersionResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_VersionResponse{s}, err
+}
+
+type Conmon_ErrorInfo struct{ capnp.Struct }
+
+// Conmon_ErrorInfo_TypeID is the unique identifier for the type Conmon_ErrorInfo.
+const Conmon_ErrorInfo_TypeID = 0xf1f7be6741cee38d
+
+func NewConmon_ErrorInfo(s *capnp.Segment) (Conmon_ErrorInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
+	return Conmon_ErrorInfo{st}, err
+}
+
+func NewRootConmon_ErrorInfo(s *capnp.Segment) (Conmon_ErrorInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
+	return Conmon_ErrorInfo{st}, err
+}
+
+func ReadRootConmon_ErrorInfo(msg *capnp.Message) (Conmon_ErrorInfo, error) {
+	root, err := msg.Root()
+	return Conmon_ErrorInfo{root.Struct()}, err
+}
+
+func (s Conmon_ErrorInfo) String() string {
+	str, _ := text.Marshal(0xf1f7be6741cee38d, s.Struct)
+	return str
+}
+
+func (s Conmon_ErrorInfo) Kind() Conmon_ErrorInfo_Kind {
+	return Conmon_ErrorInfo_Kind(s.Struct.Uint16(0))
+}
+
+func (s Conmon_ErrorInfo) SetKind(v Conmon_ErrorInfo_Kind) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Conmon_ErrorInfo) Message() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ErrorInfo) HasMessage() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ErrorInfo) MessageBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ErrorInfo) SetMessage(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ErrorInfo) RuntimeStderr() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_ErrorInfo) HasRuntimeStderr() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ErrorInfo) RuntimeStderrBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ErrorInfo) SetRuntimeStderr(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_ErrorInfo) Reason() Conmon_ErrorInfo_Reason {
+	return Conmon_ErrorInfo_Reason(s.Struct.Uint16(2))
+}
+
+func (s Conmon_ErrorInfo) SetReason(v Conmon_ErrorInfo_Reason) {
+	s.Struct.SetUint16(2, uint16(v))
+}
+
+func (s Conmon_ErrorInfo) Hint() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s Conmon_ErrorInfo) HasHint() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_ErrorInfo) HintBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ErrorInfo) SetHint(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
+func (s Conmon_ErrorInfo) RuntimeLog() (string, error) {
+	p, err := s.Struct.Ptr(3)
+	return p.Text(), err
+}
+
+func (s Conmon_ErrorInfo) HasRuntimeLog() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Conmon_ErrorInfo) RuntimeLogBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(3)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ErrorInfo) SetRuntimeLog(v string) error {
+	return s.Struct.SetText(3, v)
+}
+
+func (s Conmon_ErrorInfo) Operation() (string, error) {
+	p, err := s.Struct.Ptr(4)
+	return p.Text(), err
+}
+
+func (s Conmon_ErrorInfo) HasOperation() bool {
+	return s.Struct.HasPtr(4)
+}
+
+func (s Conmon_ErrorInfo) OperationBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(4)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ErrorInfo) SetOperation(v string) error {
+	return s.Struct.SetText(4, v)
+}
+
+// Conmon_ErrorInfo_List is a list of Conmon_ErrorInfo.
+type Conmon_ErrorInfo_List = capnp.StructList[Conmon_ErrorInfo]
+
+// NewConmon_ErrorInfo creates a new list of Conmon_ErrorInfo.
+func NewConmon_ErrorInfo_List(s *capnp.Segment, sz int32) (Conmon_ErrorInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5}, sz)
+	return capnp.StructList[Conmon_ErrorInfo]{l}, err
+}
+
+// Conmon_ErrorInfo_Future is a wrapper for a Conmon_ErrorInfo promised by a client call.
+type Conmon_ErrorInfo_Future struct{ *capnp.Future }
+
+func (p Conmon_ErrorInfo_Future) Struct() (Conmon_ErrorInfo, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ErrorInfo{s}, err
+}
+
+type Conmon_ErrorInfo_Kind uint16
+
+// Conmon_ErrorInfo_Kind_TypeID is the unique identifier for the type Conmon_ErrorInfo_Kind.
+const Conmon_ErrorInfo_Kind_TypeID = 0xbffe11b1c86c44e3
+
+// Values of Conmon_ErrorInfo_Kind.
+const (
+	Conmon_ErrorInfo_Kind_unknown             Conmon_ErrorInfo_Kind = 0
+	Conmon_ErrorInfo_Kind_notFound            Conmon_ErrorInfo_Kind = 1
+	Conmon_ErrorInfo_Kind_alreadyExists       Conmon_ErrorInfo_Kind = 2
+	Conmon_ErrorInfo_Kind_runtimeFailure      Conmon_ErrorInfo_Kind = 3
+	Conmon_ErrorInfo_Kind_timeout             Conmon_ErrorInfo_Kind = 4
+	Conmon_ErrorInfo_Kind_cancelled           Conmon_ErrorInfo_Kind = 5
+	Conmon_ErrorInfo_Kind_operationInProgress Conmon_ErrorInfo_Kind = 6
+	Conmon_ErrorInfo_Kind_terminalMismatch    Conmon_ErrorInfo_Kind = 7
+)
+
+// String returns the enum's constant name.
+func (c Conmon_ErrorInfo_Kind) String() string {
+	switch c {
+	case Conmon_ErrorInfo_Kind_unknown:
+		return "unknown"
+	case Conmon_ErrorInfo_Kind_notFound:
+		return "notFound"
+	case Conmon_ErrorInfo_Kind_alreadyExists:
+		return "alreadyExists"
+	case Conmon_ErrorInfo_Kind_runtimeFailure:
+		return "runtimeFailure"
+	case Conmon_ErrorInfo_Kind_timeout:
+		return "timeout"
+	case Conmon_ErrorInfo_Kind_cancelled:
+		return "cancelled"
+	case Conmon_ErrorInfo_Kind_operationInProgress:
+		return "operationInProgress"
+	case Conmon_ErrorInfo_Kind_terminalMismatch:
+		return "terminalMismatch"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_ErrorInfo_KindFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_ErrorInfo_KindFromString(c string) Conmon_ErrorInfo_Kind {
+	switch c {
+	case "unknown":
+		return Conmon_ErrorInfo_Kind_unknown
+	case "notFound":
+		return Conmon_ErrorInfo_Kind_notFound
+	case "alreadyExists":
+		return Conmon_ErrorInfo_Kind_alreadyExists
+	case "runtimeFailure":
+		return Conmon_ErrorInfo_Kind_runtimeFailure
+	case "timeout":
+		return Conmon_ErrorInfo_Kind_timeout
+	case "cancelled":
+		return Conmon_ErrorInfo_Kind_cancelled
+	case "operationInProgress":
+		return Conmon_ErrorInfo_Kind_operationInProgress
+	case "terminalMismatch":
+		return Conmon_ErrorInfo_Kind_terminalMismatch
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_ErrorInfo_Kind_List = capnp.EnumList[Conmon_ErrorInfo_Kind]
+
+func NewConmon_ErrorInfo_Kind_List(s *capnp.Segment, sz int32) (Conmon_ErrorInfo_Kind_List, error) {
+	return capnp.NewEnumList[Conmon_ErrorInfo_Kind](s, sz)
+}
+
+type Conmon_ErrorInfo_Reason uint16
+
+// Conmon_ErrorInfo_Reason_TypeID is the unique identifier for the type Conmon_ErrorInfo_Reason.
+const Conmon_ErrorInfo_Reason_TypeID = 0xf6906c1b1b918f79
+
+// Values of Conmon_ErrorInfo_Reason.
+const (
+	Conmon_ErrorInfo_Reason_unspecified                  Conmon_ErrorInfo_Reason = 0
+	Conmon_ErrorInfo_Reason_cgroupControllerNotDelegated Conmon_ErrorInfo_Reason = 1
+	Conmon_ErrorInfo_Reason_idMappingUnavailable         Conmon_ErrorInfo_Reason = 2
+	Conmon_ErrorInfo_Reason_executableNotFound           Conmon_ErrorInfo_Reason = 3
+	Conmon_ErrorInfo_Reason_bundleInvalid                Conmon_ErrorInfo_Reason = 4
+	Conmon_ErrorInfo_Reason_permissionDenied             Conmon_ErrorInfo_Reason = 5
+)
+
+// String returns the enum's constant name.
+func (c Conmon_ErrorInfo_Reason) String() string {
+	switch c {
+	case Conmon_ErrorInfo_Reason_unspecified:
+		return "unspecified"
+	case Conmon_ErrorInfo_Reason_cgroupControllerNotDelegated:
+		return "cgroupControllerNotDelegated"
+	case Conmon_ErrorInfo_Reason_idMappingUnavailable:
+		return "idMappingUnavailable"
+	case Conmon_ErrorInfo_Reason_executableNotFound:
+		return "executableNotFound"
+	case Conmon_ErrorInfo_Reason_bundleInvalid:
+		return "bundleInvalid"
+	case Conmon_ErrorInfo_Reason_permissionDenied:
+		return "permissionDenied"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_ErrorInfo_ReasonFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_ErrorInfo_ReasonFromString(c string) Conmon_ErrorInfo_Reason {
+	switch c {
+	case "unspecified":
+		return Conmon_ErrorInfo_Reason_unspecified
+	case "cgroupControllerNotDelegated":
+		return Conmon_ErrorInfo_Reason_cgroupControllerNotDelegated
+	case "idMappingUnavailable":
+		return Conmon_ErrorInfo_Reason_idMappingUnavailable
+	case "executableNotFound":
+		return Conmon_ErrorInfo_Reason_executableNotFound
+	case "bundleInvalid":
+		return Conmon_ErrorInfo_Reason_bundleInvalid
+	case "permissionDenied":
+		return Conmon_ErrorInfo_Reason_permissionDenied
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_ErrorInfo_Reason_List = capnp.EnumList[Conmon_ErrorInfo_Reason]
+
+func NewConmon_ErrorInfo_Reason_List(s *capnp.Segment, sz int32) (Conmon_ErrorInfo_Reason_List, error) {
+	return capnp.NewEnumList[Conmon_ErrorInfo_Reason](s, sz)
+}
+
+type Conmon_TraceContext struct{ capnp.Struct }
+
+// Conmon_TraceContext_TypeID is the unique identifier for the type Conmon_TraceContext.
+const Conmon_TraceContext_TypeID = 0xcbe24b46b6b6a8aa
+
+func NewConmon_TraceContext(s *capnp.Segment) (Conmon_TraceContext, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_TraceContext{st}, err
+}
+
+func NewRootConmon_TraceContext(s *capnp.Segment) (Conmon_TraceContext, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_TraceContext{st}, err
+}
+
+func ReadRootConmon_TraceContext(msg *capnp.Message) (Conmon_TraceContext, error) {
+	root, err := msg.Root()
+	return Conmon_TraceContext{root.Struct()}, err
+}
+
+func (s Conmon_TraceContext) String() string {
+	str, _ := text.Marshal(0xcbe24b46b6b6a8aa, s.Struct)
+	return str
+}
+
+func (s Conmon_TraceContext) Traceparent() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_TraceContext) HasTraceparent() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_TraceContext) TraceparentBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_TraceContext) SetTraceparent(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_TraceContext) Tracestate() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_TraceContext) HasTracestate() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_TraceContext) TracestateBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_TraceContext) SetTracestate(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+// Conmon_TraceContext_List is a list of Conmon_TraceContext.
+type Conmon_TraceContext_List = capnp.StructList[Conmon_TraceContext]
+
+// NewConmon_TraceContext creates a new list of Conmon_TraceContext.
+func NewConmon_TraceContext_List(s *capnp.Segment, sz int32) (Conmon_TraceContext_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_TraceContext]{l}, err
+}
+
+// Conmon_TraceContext_Future is a wrapper for a Conmon_TraceContext promised by a client call.
+type Conmon_TraceContext_Future struct{ *capnp.Future }
+
+func (p Conmon_TraceContext_Future) Struct() (Conmon_TraceContext, error) {
+	s, err := p.Future.Struct()
+	return Conmon_TraceContext{s}, err
+}
+
+type Conmon_RequestScope struct{ capnp.Struct }
+
+// Conmon_RequestScope_TypeID is the unique identifier for the type Conmon_RequestScope.
+const Conmon_RequestScope_TypeID = 0xb5715db6b4a29dac
+
+func NewConmon_RequestScope(s *capnp.Segment) (Conmon_RequestScope, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_RequestScope{st}, err
+}
+
+func NewRootConmon_RequestScope(s *capnp.Segment) (Conmon_RequestScope, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_RequestScope{st}, err
+}
+
+func ReadRootConmon_RequestScope(msg *capnp.Message) (Conmon_RequestScope, error) {
+	root, err := msg.Root()
+	return Conmon_RequestScope{root.Struct()}, err
+}
+
+func (s Conmon_RequestScope) String() string {
+	str, _ := text.Marshal(0xb5715db6b4a29dac, s.Struct)
+	return str
+}
+
+func (s Conmon_RequestScope) RequestId() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_RequestScope) HasRequestId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_RequestScope) RequestIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_RequestScope) SetRequestId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_RequestScope) DeadlineUnixNano() int64 {
+	return int64(s.Struct.Uint64(0))
+}
+
+func (s Conmon_RequestScope) SetDeadlineUnixNano(v int64) {
+	s.Struct.SetUint64(0, uint64(v))
+}
+
+// Conmon_RequestScope_List is a list of Conmon_RequestScope.
+type Conmon_RequestScope_List = capnp.StructList[Conmon_RequestScope]
+
+// NewConmon_RequestScope creates a new list of Conmon_RequestScope.
+func NewConmon_RequestScope_List(s *capnp.Segment, sz int32) (Conmon_RequestScope_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_RequestScope]{l}, err
+}
+
+// Conmon_RequestScope_Future is a wrapper for a Conmon_RequestScope promised by a client call.
+type Conmon_RequestScope_Future struct{ *capnp.Future }
+
+func (p Conmon_RequestScope_Future) Struct() (Conmon_RequestScope, error) {
+	s, err := p.Future.Struct()
+	return Conmon_RequestScope{s}, err
+}
+
+type Conmon_CreateContainerRequest struct{ capnp.Struct }
+
+// Conmon_CreateContainerRequest_TypeID is the unique identifier for the type Conmon_CreateContainerRequest.
+const Conmon_CreateContainerRequest_TypeID = 0xba77e3fa3aa9b6ca
+
+func NewConmon_CreateContainerRequest(s *capnp.Segment) (Conmon_CreateContainerRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 15})
+	return Conmon_CreateContainerRequest{st}, err
+}
+
+func NewRootConmon_CreateContainerRequest(s *capnp.Segment) (Conmon_CreateContainerRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 15})
+	return Conmon_CreateContainerRequest{st}, err
+}
+
+func ReadRootConmon_CreateContainerRequest(msg *capnp.Message) (Conmon_CreateContainerRequest, error) {
+	root, err := msg.Root()
+	return Conmon_CreateContainerRequest{root.Struct()}, err
+}
+
+func (s Conmon_CreateContainerRequest) String() string {
+	str, _ := text.Marshal(0xba77e3fa3aa9b6ca, s.Struct)
+	return str
+}
+
+func (s Conmon_CreateContainerRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_CreateContainerRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CreateContainerRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CreateContainerRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_CreateContainerRequest) BundlePath() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_CreateContainerRequest) HasBundlePath() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_CreateContainerRequest) BundlePathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CreateContainerRequest) SetBundlePath(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_CreateContainerRequest) Terminal() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Conmon_CreateContainerRequest) SetTerminal(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+func (s Conmon_CreateContainerRequest) ExitPaths() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(2)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_CreateContainerRequest) HasExitPaths() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_CreateContainerRequest) SetExitPaths(v capnp.TextList) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
+}
+
+// NewExitPaths sets the exitPaths field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_CreateContainerRequest) NewExitPaths(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_CreateContainerRequest) OomExitPaths() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(3)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_CreateContainerRequest) HasOomExitPaths() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Conmon_CreateContainerRequest) SetOomExitPaths(v capnp.TextList) error {
+	return s.Struct.SetPtr(3, v.List.ToPtr())
+}
+
+// NewOomExitPaths sets the oomExitPaths field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_CreateContainerRequest) NewOomExitPaths(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(3, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_CreateContainerRequest) LogDrivers() (Conmon_LogDriver_List, error) {
+	p, err := s.Struct.Ptr(4)
+	return Conmon_LogDriver_List{List: p.List()}, err
+}
+
+func (s Conmon_CreateContainerRequest) HasLogDrivers() bool {
+	return s.Struct.HasPtr(4)
+}
+
+func (s Conmon_CreateContainerRequest) SetLogDrivers(v Conmon_LogDriver_List) error {
+	return s.Struct.SetPtr(4, v.List.ToPtr())
+}
+
+// NewLogDrivers sets the logDrivers field to a newly
+// allocated Conmon_LogDriver_List, preferring placement in s's segment.
+func (s Conmon_CreateContainerRequest) NewLogDrivers(n int32) (Conmon_LogDriver_List, error) {
+	l, err := NewConmon_LogDriver_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_LogDriver_List{}, err
+	}
+	err = s.Struct.SetPtr(4, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_CreateContainerRequest) MaxSessionDurationSec() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_CreateContainerRequest) SetMaxSessionDurationSec(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_CreateContainerRequest) LogFilter() (Conmon_LogFilter, error) {
+	p, err := s.Struct.Ptr(5)
+	return Conmon_LogFilter{Struct: p.Struct()}, err
+}
+
+func (s Conmon_CreateContainerRequest) HasLogFilter() bool {
+	return s.Struct.HasPtr(5)
+}
+
+func (s Conmon_CreateContainerRequest) SetLogFilter(v Conmon_LogFilter) error {
+	return s.Struct.SetPtr(5, v.Struct.ToPtr())
+}
+
+// NewLogFilter sets the logFilter field to a newly
+// allocated Conmon_LogFilter struct, preferring placement in s's segment.
+func (s Conmon_CreateContainerRequest) NewLogFilter() (Conmon_LogFilter, error) {
+	ss, err := NewConmon_LogFilter(s.Struct.Segment())
+	if err != nil {
+		return Conmon_LogFilter{}, err
+	}
+	err = s.Struct.SetPtr(5, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_CreateContainerRequest) AdditionalFds() (capnp.UInt64List, error) {
+	p, err := s.Struct.Ptr(6)
+	return capnp.UInt64List{List: p.List()}, err
+}
+
+func (s Conmon_CreateContainerRequest) HasAdditionalFds() bool {
+	return s.Struct.HasPtr(6)
+}
+
+func (s Conmon_CreateContainerRequest) SetAdditionalFds(v capnp.UInt64List) error {
+	return s.Struct.SetPtr(6, v.List.ToPtr())
+}
+
+// NewAdditionalFds sets the additionalFds field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s Conmon_CreateContainerRequest) NewAdditionalFds(n int32) (capnp.UInt64List, error) {
+	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.UInt64List{}, err
+	}
+	err = s.Struct.SetPtr(6, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_CreateContainerRequest) TraceContext() (Conmon_TraceContext, error) {
+	p, err := s.Struct.Ptr(7)
+	return Conmon_TraceContext{Struct: p.Struct()}, err
+}
+
+func (s Conmon_CreateContainerRequest) HasTraceContext() bool {
+	return s.Struct.HasPtr(7)
+}
+
+func (s Conmon_CreateContainerRequest) SetTraceContext(v Conmon_TraceContext) error {
+	return s.Struct.SetPtr(7, v.Struct.ToPtr())
+}
+
+// NewTraceContext sets the traceContext field to a newly
+// allocated Conmon_TraceContext struct, preferring placement in s's segment.
+func (s Conmon_CreateContainerRequest) NewTraceContext() (Conmon_TraceContext, error) {
+	ss, err := NewConmon_TraceContext(s.Struct.Segment())
+	if err != nil {
+		return Conmon_TraceContext{}, err
+	}
+	err = s.Struct.SetPtr(7, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_CreateContainerRequest) IoUser() (Conmon_IoUser, error) {
+	p, err := s.Struct.Ptr(8)
+	return Conmon_IoUser{Struct: p.Struct()}, err
+}
+
+func (s Conmon_CreateContainerRequest) HasIoUser() bool {
+	return s.Struct.HasPtr(8)
+}
+
+func (s Conmon_CreateContainerRequest) SetIoUser(v Conmon_IoUser) error {
+	return s.Struct.SetPtr(8, v.Struct.ToPtr())
+}
+
+// NewIoUser sets the ioUser field to a newly
+// allocated Conmon_IoUser struct, preferring placement in s's segment.
+func (s Conmon_CreateContainerRequest) NewIoUser() (Conmon_IoUser, error) {
+	ss, err := NewConmon_IoUser(s.Struct.Segment())
+	if err != nil {
+		return Conmon_IoUser{}, err
+	}
+	err = s.Struct.SetPtr(8, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_CreateContainerRequest) SeccompListenerPath() (string, error) {
+	p, err := s.Struct.Ptr(9)
+	return p.Text(), err
+}
+
+func (s Conmon_CreateContainerRequest) HasSeccompListenerPath() bool {
+	return s.Struct.HasPtr(9)
+}
+
+func (s Conmon_CreateContainerRequest) SeccompListenerPathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(9)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CreateContainerRequest) SetSeccompListenerPath(v string) error {
+	return s.Struct.SetText(9, v)
+}
+
+func (s Conmon_CreateContainerRequest) Scope() (Conmon_RequestScope, error) {
+	p, err := s.Struct.Ptr(10)
+	return Conmon_RequestScope{Struct: p.Struct()}, err
+}
+
+func (s Conmon_CreateContainerRequest) HasScope() bool {
+	return s.Struct.HasPtr(10)
+}
+
+func (s Conmon_CreateContainerRequest) SetScope(v Conmon_RequestScope) error {
+	return s.Struct.SetPtr(10, v.Struct.ToPtr())
+}
+
+// NewScope sets the scope field to a newly
+// allocated Conmon_RequestScope struct, preferring placement in s's segment.
+func (s Conmon_CreateContainerRequest) NewScope() (Conmon_RequestScope, error) {
+	ss, err := NewConmon_RequestScope(s.Struct.Segment())
+	if err != nil {
+		return Conmon_RequestScope{}, err
+	}
+	err = s.Struct.SetPtr(10, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_CreateContainerRequest) RuntimeOptions() (Conmon_RuntimeOptions, error) {
+	p, err := s.Struct.Ptr(11)
+	return Conmon_RuntimeOptions{Struct: p.Struct()}, err
+}
+
+func (s Conmon_CreateContainerRequest) HasRuntimeOptions() bool {
+	return s.Struct.HasPtr(11)
+}
+
+func (s Conmon_CreateContainerRequest) SetRuntimeOptions(v Conmon_RuntimeOptions) error {
+	return s.Struct.SetPtr(11, v.Struct.ToPtr())
+}
+
+// NewRuntimeOptions sets the runtimeOptions field to a newly
+// allocated Conmon_RuntimeOptions struct, preferring placement in s's segment.
+func (s Conmon_CreateContainerRequest) NewRuntimeOptions() (Conmon_RuntimeOptions, error) {
+	ss, err := NewConmon_RuntimeOptions(s.Struct.Segment())
+	if err != nil {
+		return Conmon_RuntimeOptions{}, err
+	}
+	err = s.Struct.SetPtr(11, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_CreateContainerRequest) RuntimeDebug() bool {
+	return s.Struct.Bit(1)
+}
+
+func (s Conmon_CreateContainerRequest) SetRuntimeDebug(v bool) {
+	s.Struct.SetBit(1, v)
+}
+
+func (s Conmon_CreateContainerRequest) FdMappings() (Conmon_FdMapping_List, error) {
+	p, err := s.Struct.Ptr(12)
+	return Conmon_FdMapping_List{List: p.List()}, err
+}
+
+func (s Conmon_CreateContainerRequest) HasFdMappings() bool {
+	return s.Struct.HasPtr(12)
+}
+
+func (s Conmon_CreateContainerRequest) SetFdMappings(v Conmon_FdMapping_List) error {
+	return s.Struct.SetPtr(12, v.List.ToPtr())
+}
+
+// NewFdMappings sets the fdMappings field to a newly
+// allocated Conmon_FdMapping_List, preferring placement in s's segment.
+func (s Conmon_CreateContainerRequest) NewFdMappings(n int32) (Conmon_FdMapping_List, error) {
+	l, err := NewConmon_FdMapping_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_FdMapping_List{}, err
+	}
+	err = s.Struct.SetPtr(12, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_CreateContainerRequest) Progress() Conmon_CreateProgress {
+	p, _ := s.Struct.Ptr(13)
+	return Conmon_CreateProgress{Client: p.Interface().Client()}
+}
+
+func (s Conmon_CreateContainerRequest) HasProgress() bool {
+	return s.Struct.HasPtr(13)
+}
+
+func (s Conmon_CreateContainerRequest) SetProgress(v Conmon_CreateProgress) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(13, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(13, in.ToPtr())
+}
+
+func (s Conmon_CreateContainerRequest) ScratchDirs() (Conmon_ScratchDir_List, error) {
+	p, err := s.Struct.Ptr(14)
+	return Conmon_ScratchDir_List{List: p.List()}, err
+}
+
+func (s Conmon_CreateContainerRequest) HasScratchDirs() bool {
+	return s.Struct.HasPtr(14)
+}
+
+func (s Conmon_CreateContainerRequest) SetScratchDirs(v Conmon_ScratchDir_List) error {
+	return s.Struct.SetPtr(14, v.List.ToPtr())
+}
+
+// NewScratchDirs sets the scratchDirs field to a newly
+// allocated Conmon_ScratchDir_List, preferring placement in s's segment.
+func (s Conmon_CreateContainerRequest) NewScratchDirs(n int32) (Conmon_ScratchDir_List, error) {
+	l, err := NewConmon_ScratchDir_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_ScratchDir_List{}, err
+	}
+	err = s.Struct.SetPtr(14, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_CreateContainerRequest_List is a list of Conmon_CreateContainerRequest.
+type Conmon_CreateContainerRequest_List = capnp.StructList[Conmon_CreateContainerRequest]
+
+// NewConmon_CreateContainerRequest creates a new list of Conmon_CreateContainerRequest.
+func NewConmon_CreateContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_CreateContainerRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 15}, sz)
+	return capnp.StructList[Conmon_CreateContainerRequest]{l}, err
+}
+
+// Conmon_CreateContainerRequest_Future is a wrapper for a Conmon_CreateContainerRequest promised by a client call.
+type Conmon_CreateContainerRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_CreateContainerRequest_Future) Struct() (Conmon_CreateContainerRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CreateContainerRequest{s}, err
+}
+
+func (p Conmon_CreateContainerRequest_Future) LogFilter() Conmon_LogFilter_Future {
+	return Conmon_LogFilter_Future{Future: p.Future.Field(5, nil)}
+}
+
+func (p Conmon_CreateContainerRequest_Future) TraceContext() Conmon_TraceContext_Future {
+	return Conmon_TraceContext_Future{Future: p.Future.Field(7, nil)}
+}
+
+func (p Conmon_CreateContainerRequest_Future) IoUser() Conmon_IoUser_Future {
+	return Conmon_IoUser_Future{Future: p.Future.Field(8, nil)}
+}
+
+func (p Conmon_CreateContainerRequest_Future) Scope() Conmon_RequestScope_Future {
+	return Conmon_RequestScope_Future{Future: p.Future.Field(10, nil)}
+}
+
+func (p Conmon_CreateContainerRequest_Future) RuntimeOptions() Conmon_RuntimeOptions_Future {
+	return Conmon_RuntimeOptions_Future{Future: p.Future.Field(11, nil)}
+}
+
+func (p Conmon_CreateContainerRequest_Future) Progress() Conmon_CreateProgress {
+	return Conmon_CreateProgress{Client: p.Future.Field(13, nil).Client()}
+}
+
+type Conmon_ScratchDir struct{ capnp.Struct }
+
+// Conmon_ScratchDir_TypeID is the unique identifier for the type Conmon_ScratchDir.
+const Conmon_ScratchDir_TypeID = 0xf16332617e40cd50
+
+func NewConmon_ScratchDir(s *capnp.Segment) (Conmon_ScratchDir, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_ScratchDir{st}, err
+}
+
+func NewRootConmon_ScratchDir(s *capnp.Segment) (Conmon_ScratchDir, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_ScratchDir{st}, err
+}
+
+func ReadRootConmon_ScratchDir(msg *capnp.Message) (Conmon_ScratchDir, error) {
+	root, err := msg.Root()
+	return Conmon_ScratchDir{root.Struct()}, err
+}
+
+func (s Conmon_ScratchDir) String() string {
+	str, _ := text.Marshal(0xf16332617e40cd50, s.Struct)
+	return str
+}
+
+func (s Conmon_ScratchDir) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ScratchDir) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ScratchDir) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ScratchDir) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ScratchDir) SizeBytes() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_ScratchDir) SetSizeBytes(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_ScratchDir) Destination() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_ScratchDir) HasDestination() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ScratchDir) DestinationBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ScratchDir) SetDestination(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+// Conmon_ScratchDir_List is a list of Conmon_ScratchDir.
+type Conmon_ScratchDir_List = capnp.StructList[Conmon_ScratchDir]
+
+// NewConmon_ScratchDir creates a new list of Conmon_ScratchDir.
+func NewConmon_ScratchDir_List(s *capnp.Segment, sz int32) (Conmon_ScratchDir_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_ScratchDir]{l}, err
+}
+
+// Conmon_ScratchDir_Future is a wrapper for a Conmon_ScratchDir promised by a client call.
+type Conmon_ScratchDir_Future struct{ *capnp.Future }
+
+func (p Conmon_ScratchDir_Future) Struct() (Conmon_ScratchDir, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ScratchDir{s}, err
+}
+
+type Conmon_CreateProgress struct{ Client *capnp.Client }
+
+// Conmon_CreateProgress_TypeID is the unique identifier for the type Conmon_CreateProgress.
+const Conmon_CreateProgress_TypeID = 0xa146cbcc3f804a40
+
+func (c Conmon_CreateProgress) Update(ctx context.Context, params func(Conmon_CreateProgress_update_Params) error) (Conmon_CreateProgress_update_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xa146cbcc3f804a40,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.CreateProgress",
+			MethodName:    "update",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_CreateProgress_update_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_CreateProgress_update_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Conmon_CreateProgress) AddRef() Conmon_CreateProgress {
+	return Conmon_CreateProgress{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Conmon_CreateProgress) Release() {
+	c.Client.Release()
+}
+
+// A Conmon_CreateProgress_Server is a Conmon_CreateProgress with a local implementation.
+type Conmon_CreateProgress_Server interface {
+	Update(context.Context, Conmon_CreateProgress_update) error
+}
+
+// Conmon_CreateProgress_NewServer creates a new Server from an implementation of Conmon_CreateProgress_Server.
+func Conmon_CreateProgress_NewServer(s Conmon_CreateProgress_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Conmon_CreateProgress_Methods(nil, s), s, c, policy)
+}
+
+// Conmon_CreateProgress_ServerToClient creates a new Client from an implementation of Conmon_CreateProgress_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Conmon_CreateProgress_ServerToClient(s Conmon_CreateProgress_Server, policy *server.Policy) Conmon_CreateProgress {
+	return Conmon_CreateProgress{Client: capnp.NewClient(Conmon_CreateProgress_NewServer(s, policy))}
+}
+
+// Conmon_CreateProgress_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Conmon_CreateProgress_Methods(methods []server.Method, s Conmon_CreateProgress_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 1)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa146cbcc3f804a40,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.CreateProgress",
+			MethodName:    "update",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Update(ctx, Conmon_CreateProgress_update{call})
+		},
+	})
+
+	return methods
+}
+
+// Conmon_CreateProgress_update holds the state for a server call to Conmon_CreateProgress.update.
+// See server.Call for documentation.
+type Conmon_CreateProgress_update struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_CreateProgress_update) Args() Conmon_CreateProgress_update_Params {
+	return Conmon_CreateProgress_update_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_CreateProgress_update) AllocResults() (Conmon_CreateProgress_update_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_CreateProgress_update_Results{Struct: r}, err
+}
+
+type Conmon_CreateProgress_Phase uint16
+
+// Conmon_CreateProgress_Phase_TypeID is the unique identifier for the type Conmon_CreateProgress_Phase.
+const Conmon_CreateProgress_Phase_TypeID = 0xb0330eff62073339
+
+// Values of Conmon_CreateProgress_Phase.
+const (
+	Conmon_CreateProgress_Phase_preparing  Conmon_CreateProgress_Phase = 0
+	Conmon_CreateProgress_Phase_runtime    Conmon_CreateProgress_Phase = 1
+	Conmon_CreateProgress_Phase_monitoring Conmon_CreateProgress_Phase = 2
+)
+
+// String returns the enum's constant name.
+func (c Conmon_CreateProgress_Phase) String() string {
+	switch c {
+	case Conmon_CreateProgress_Phase_preparing:
+		return "preparing"
+	case Conmon_CreateProgress_Phase_runtime:
+		return "runtime"
+	case Conmon_CreateProgress_Phase_monitoring:
+		return "monitoring"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_CreateProgress_PhaseFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_CreateProgress_PhaseFromString(c string) Conmon_CreateProgress_Phase {
+	switch c {
+	case "preparing":
+		return Conmon_CreateProgress_Phase_preparing
+	case "runtime":
+		return Conmon_CreateProgress_Phase_runtime
+	case "monitoring":
+		return Conmon_CreateProgress_Phase_monitoring
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_CreateProgress_Phase_List = capnp.EnumList[Conmon_CreateProgress_Phase]
+
+func NewConmon_CreateProgress_Phase_List(s *capnp.Segment, sz int32) (Conmon_CreateProgress_Phase_List, error) {
+	return capnp.NewEnumList[Conmon_CreateProgress_Phase](s, sz)
+}
+
+type Conmon_CreateProgress_update_Params struct{ capnp.Struct }
+
+// Conmon_CreateProgress_update_Params_TypeID is the unique identifier for the type Conmon_CreateProgress_update_Params.
+const Conmon_CreateProgress_update_Params_TypeID = 0x8cc7ec4f225f7e75
+
+func NewConmon_CreateProgress_update_Params(s *capnp.Segment) (Conmon_CreateProgress_update_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Conmon_CreateProgress_update_Params{st}, err
+}
+
+func NewRootConmon_CreateProgress_update_Params(s *capnp.Segment) (Conmon_CreateProgress_update_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Conmon_CreateProgress_update_Params{st}, err
+}
+
+func ReadRootConmon_CreateProgress_update_Params(msg *capnp.Message) (Conmon_CreateProgress_update_Params, error) {
+	root, err := msg.Root()
+	return Conmon_CreateProgress_update_Params{root.Struct()}, err
+}
+
+func (s Conmon_CreateProgress_update_Params) String() string {
+	str, _ := text.Marshal(0x8cc7ec4f225f7e75, s.Struct)
+	return str
+}
+
+func (s Conmon_CreateProgress_update_Params) Phase() Conmon_CreateProgress_Phase {
+	return Conmon_CreateProgress_Phase(s.Struct.Uint16(0))
+}
+
+func (s Conmon_CreateProgress_update_Params) SetPhase(v Conmon_CreateProgress_Phase) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+// Conmon_CreateProgress_update_Params_List is a list of Conmon_CreateProgress_update_Params.
+type Conmon_CreateProgress_update_Params_List = capnp.StructList[Conmon_CreateProgress_update_Params]
+
+// NewConmon_CreateProgress_update_Params creates a new list of Conmon_CreateProgress_update_Params.
+func NewConmon_CreateProgress_update_Params_List(s *capnp.Segment, sz int32) (Conmon_CreateProgress_update_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_CreateProgress_update_Params]{l}, err
+}
+
+// Conmon_CreateProgress_update_Params_Future is a wrapper for a Conmon_CreateProgress_update_Params promised by a client call.
+type Conmon_CreateProgress_update_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_CreateProgress_update_Params_Future) Struct() (Conmon_CreateProgress_update_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CreateProgress_update_Params{s}, err
+}
+
+type Conmon_CreateProgress_update_Results struct{ capnp.Struct }
+
+// Conmon_CreateProgress_update_Results_TypeID is the unique identifier for the type Conmon_CreateProgress_update_Results.
+const Conmon_CreateProgress_update_Results_TypeID = 0xc2114ae2534ba177
+
+func NewConmon_CreateProgress_update_Results(s *capnp.Segment) (Conmon_CreateProgress_update_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_CreateProgress_update_Results{st}, err
+}
+
+func NewRootConmon_CreateProgress_update_Results(s *capnp.Segment) (Conmon_CreateProgress_update_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_CreateProgress_update_Results{st}, err
+}
+
+func ReadRootConmon_CreateProgress_update_Results(msg *capnp.Message) (Conmon_CreateProgress_update_Results, error) {
+	root, err := msg.Root()
+	return Conmon_CreateProgress_update_Results{root.Struct()}, err
+}
+
+func (s Conmon_CreateProgress_update_Results) String() string {
+	str, _ := text.Marshal(0xc2114ae2534ba177, s.Struct)
+	return str
+}
+
+// Conmon_CreateProgress_update_Results_List is a list of Conmon_CreateProgress_update_Results.
+type Conmon_CreateProgress_update_Results_List = capnp.StructList[Conmon_CreateProgress_update_Results]
+
+// NewConmon_CreateProgress_update_Results creates a new list of Conmon_CreateProgress_update_Results.
+func NewConmon_CreateProgress_update_Results_List(s *capnp.Segment, sz int32) (Conmon_CreateProgress_update_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_CreateProgress_update_Results]{l}, err
+}
+
+// Conmon_CreateProgress_update_Results_Future is a wrapper for a Conmon_CreateProgress_update_Results promised by a client call.
+type Conmon_CreateProgress_update_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_CreateProgress_update_Results_Future) Struct() (Conmon_CreateProgress_update_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CreateProgress_update_Results{s}, err
+}
+
+type Conmon_FdMapping struct{ capnp.Struct }
+
+// Conmon_FdMapping_TypeID is the unique identifier for the type Conmon_FdMapping.
+const Conmon_FdMapping_TypeID = 0xd7ad1299ae3d2e12
+
+func NewConmon_FdMapping(s *capnp.Segment) (Conmon_FdMapping, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_FdMapping{st}, err
+}
+
+func NewRootConmon_FdMapping(s *capnp.Segment) (Conmon_FdMapping, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_FdMapping{st}, err
+}
+
+func ReadRootConmon_FdMapping(msg *capnp.Message) (Conmon_FdMapping, error) {
+	root, err := msg.Root()
+	return Conmon_FdMapping{root.Struct()}, err
+}
+
+func (s Conmon_FdMapping) String() string {
+	str, _ := text.Marshal(0xd7ad1299ae3d2e12, s.Struct)
+	return str
+}
+
+func (s Conmon_FdMapping) Slot() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_FdMapping) SetSlot(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_FdMapping) Target() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s Conmon_FdMapping) SetTarget(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+func (s Conmon_FdMapping) Kind() Conmon_FdMapping_Kind {
+	return Conmon_FdMapping_Kind(s.Struct.Uint16(12))
+}
+
+func (s Conmon_FdMapping) SetKind(v Conmon_FdMapping_Kind) {
+	s.Struct.SetUint16(12, uint16(v))
+}
+
+func (s Conmon_FdMapping) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_FdMapping) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_FdMapping) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_FdMapping) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_FdMapping_List is a list of Conmon_FdMapping.
+type Conmon_FdMapping_List = capnp.StructList[Conmon_FdMapping]
+
+// NewConmon_FdMapping creates a new list of Conmon_FdMapping.
+func NewConmon_FdMapping_List(s *capnp.Segment, sz int32) (Conmon_FdMapping_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_FdMapping]{l}, err
+}
+
+// Conmon_FdMapping_Future is a wrapper for a Conmon_FdMapping promised by a client call.
+type Conmon_FdMapping_Future struct{ *capnp.Future }
+
+func (p Conmon_FdMapping_Future) Struct() (Conmon_FdMapping, error) {
+	s, err := p.Future.Struct()
+	return Conmon_FdMapping{s}, err
+}
+
+type Conmon_FdMapping_Kind uint16
+
+// Conmon_FdMapping_Kind_TypeID is the unique identifier for the type Conmon_FdMapping_Kind.
+const Conmon_FdMapping_Kind_TypeID = 0xcf3f7730ca68e06b
+
+// Values of Conmon_FdMapping_Kind.
+const (
+	Conmon_FdMapping_Kind_generic      Conmon_FdMapping_Kind = 0
+	Conmon_FdMapping_Kind_listenSocket Conmon_FdMapping_Kind = 1
+)
+
+// String returns the enum's constant name.
+func (c Conmon_FdMapping_Kind) String() string {
+	switch c {
+	case Conmon_FdMapping_Kind_generic:
+		return "generic"
+	case Conmon_FdMapping_Kind_listenSocket:
+		return "listenSocket"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_FdMapping_KindFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_FdMapping_KindFromString(c string) Conmon_FdMapping_Kind {
+	switch c {
+	case "generic":
+		return Conmon_FdMapping_Kind_generic
+	case "listenSocket":
+		return Conmon_FdMapping_Kind_listenSocket
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_FdMapping_Kind_List = capnp.EnumList[Conmon_FdMapping_Kind]
+
+func NewConmon_FdMapping_Kind_List(s *capnp.Segment, sz int32) (Conmon_FdMapping_Kind_List, error) {
+	return capnp.NewEnumList[Conmon_FdMapping_Kind](s, sz)
+}
+
+type Conmon_RuntimeOptions struct{ capnp.Struct }
+
+// Conmon_RuntimeOptions_TypeID is the unique identifier for the type Conmon_RuntimeOptions.
+const Conmon_RuntimeOptions_TypeID = 0xebbb2cf3800c1b99
+
+func NewConmon_RuntimeOptions(s *capnp.Segment) (Conmon_RuntimeOptions, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_RuntimeOptions{st}, err
+}
+
+func NewRootConmon_RuntimeOptions(s *capnp.Segment) (Conmon_RuntimeOptions, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_RuntimeOptions{st}, err
+}
+
+func ReadRootConmon_RuntimeOptions(msg *capnp.Message) (Conmon_RuntimeOptions, error) {
+	root, err := msg.Root()
+	return Conmon_RuntimeOptions{root.Struct()}, err
+}
+
+func (s Conmon_RuntimeOptions) String() string {
+	str, _ := text.Marshal(0xebbb2cf3800c1b99, s.Struct)
+	return str
+}
+
+func (s Conmon_RuntimeOptions) Runtime() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_RuntimeOptions) HasRuntime() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_RuntimeOptions) RuntimeBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_RuntimeOptions) SetRuntime(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_RuntimeOptions) RuntimeRoot() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_RuntimeOptions) HasRuntimeRoot() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_RuntimeOptions) RuntimeRootBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_RuntimeOptions) SetRuntimeRoot(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_RuntimeOptions) CgroupManager() Conmon_RuntimeOptions_CgroupManager {
+	return Conmon_RuntimeOptions_CgroupManager(s.Struct.Uint16(0))
+}
+
+func (s Conmon_RuntimeOptions) SetCgroupManager(v Conmon_RuntimeOptions_CgroupManager) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+// Conmon_RuntimeOptions_List is a list of Conmon_RuntimeOptions.
+type Conmon_RuntimeOptions_List = capnp.StructList[Conmon_RuntimeOptions]
+
+// NewConmon_RuntimeOptions creates a new list of Conmon_RuntimeOptions.
+func NewConmon_RuntimeOptions_List(s *capnp.Segment, sz int32) (Conmon_RuntimeOptions_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_RuntimeOptions]{l}, err
+}
+
+// Conmon_RuntimeOptions_Future is a wrapper for a Conmon_RuntimeOptions promised by a client call.
+type Conmon_RuntimeOptions_Future struct{ *capnp.Future }
+
+func (p Conmon_RuntimeOptions_Future) Struct() (Conmon_RuntimeOptions, error) {
+	s, err := p.Future.Struct()
+	return Conmon_RuntimeOptions{s}, err
+}
+
+type Conmon_RuntimeOptions_CgroupManager uint16
+
+// Conmon_RuntimeOptions_CgroupManager_TypeID is the unique identifier for the type Conmon_RuntimeOptions_CgroupManager.
+const Conmon_RuntimeOptions_CgroupManager_TypeID = 0xddc0bbd610330888
+
+// Values of Conmon_RuntimeOptions_CgroupManager.
+const (
+	Conmon_RuntimeOptions_CgroupManager_default  Conmon_RuntimeOptions_CgroupManager = 0
+	Conmon_RuntimeOptions_CgroupManager_systemd  Conmon_RuntimeOptions_CgroupManager = 1
+	Conmon_RuntimeOptions_CgroupManager_cgroupfs Conmon_RuntimeOptions_CgroupManager = 2
+)
+
+// String returns the enum's constant name.
+func (c Conmon_RuntimeOptions_CgroupManager) String() string {
+	switch c {
+	case Conmon_RuntimeOptions_CgroupManager_default:
+		return "default"
+	case Conmon_RuntimeOptions_CgroupManager_systemd:
+		return "systemd"
+	case Conmon_RuntimeOptions_CgroupManager_cgroupfs:
+		return "cgroupfs"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_RuntimeOptions_CgroupManagerFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_RuntimeOptions_CgroupManagerFromString(c string) Conmon_RuntimeOptions_CgroupManager {
+	switch c {
+	case "default":
+		return Conmon_RuntimeOptions_CgroupManager_default
+	case "systemd":
+		return Conmon_RuntimeOptions_CgroupManager_systemd
+	case "cgroupfs":
+		return Conmon_RuntimeOptions_CgroupManager_cgroupfs
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_RuntimeOptions_CgroupManager_List = capnp.EnumList[Conmon_RuntimeOptions_CgroupManager]
+
+func NewConmon_RuntimeOptions_CgroupManager_List(s *capnp.Segment, sz int32) (Conmon_RuntimeOptions_CgroupManager_List, error) {
+	return capnp.NewEnumList[Conmon_RuntimeOptions_CgroupManager](s, sz)
+}
+
+type Conmon_IoUser struct{ capnp.Struct }
+
+// Conmon_IoUser_TypeID is the unique identifier for the type Conmon_IoUser.
+const Conmon_IoUser_TypeID = 0xb780efa29376762d
+
+func NewConmon_IoUser(s *capnp.Segment) (Conmon_IoUser, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Conmon_IoUser{st}, err
+}
+
+func NewRootConmon_IoUser(s *capnp.Segment) (Conmon_IoUser, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Conmon_IoUser{st}, err
+}
+
+func ReadRootConmon_IoUser(msg *capnp.Message) (Conmon_IoUser, error) {
+	root, err := msg.Root()
+	return Conmon_IoUser{root.Struct()}, err
+}
+
+func (s Conmon_IoUser) String() string {
+	str, _ := text.Marshal(0xb780efa29376762d, s.Struct)
+	return str
+}
+
+func (s Conmon_IoUser) Uid() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Conmon_IoUser) SetUid(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s Conmon_IoUser) Gid() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Conmon_IoUser) SetGid(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+// Conmon_IoUser_List is a list of Conmon_IoUser.
+type Conmon_IoUser_List = capnp.StructList[Conmon_IoUser]
+
+// NewConmon_IoUser creates a new list of Conmon_IoUser.
+func NewConmon_IoUser_List(s *capnp.Segment, sz int32) (Conmon_IoUser_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_IoUser]{l}, err
+}
+
+// Conmon_IoUser_Future is a wrapper for a Conmon_IoUser promised by a client call.
+type Conmon_IoUser_Future struct{ *capnp.Future }
+
+func (p Conmon_IoUser_Future) Struct() (Conmon_IoUser, error) {
+	s, err := p.Future.Struct()
+	return Conmon_IoUser{s}, err
+}
+
+type Conmon_LogDriver struct{ capnp.Struct }
+
+// Conmon_LogDriver_TypeID is the unique identifier for the type Conmon_LogDriver.
+const Conmon_LogDriver_TypeID = 0xae78ee8eb6b3a134
+
+func NewConmon_LogDriver(s *capnp.Segment) (Conmon_LogDriver, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2})
+	return Conmon_LogDriver{st}, err
+}
+
+func NewRootConmon_LogDriver(s *capnp.Segment) (Conmon_LogDriver, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2})
+	return Conmon_LogDriver{st}, err
+}
+
+func ReadRootConmon_LogDriver(msg *capnp.Message) (Conmon_LogDriver, error) {
+	root, err := msg.Root()
+	return Conmon_LogDriver{root.Struct()}, err
+}
+
+func (s Conmon_LogDriver) String() string {
+	str, _ := text.Marshal(0xae78ee8eb6b3a134, s.Struct)
+	return str
+}
+
+func (s Conmon_LogDriver) Type() Conmon_LogDriver_Type {
+	return Conmon_LogDriver_Type(s.Struct.Uint16(0))
+}
+
+func (s Conmon_LogDriver) SetType(v Conmon_LogDriver_Type) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Conmon_LogDriver) Path() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_LogDriver) HasPath() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_LogDriver) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_LogDriver) SetPath(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_LogDriver) MaxSize() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_LogDriver) SetMaxSize(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_LogDriver) Tag() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_LogDriver) HasTag() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_LogDriver) TagBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_LogDriver) SetTag(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_LogDriver) MaxFiles() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Conmon_LogDriver) SetMaxFiles(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+func (s Conmon_LogDriver) Compress() bool {
+	return s.Struct.Bit(16)
+}
+
+func (s Conmon_LogDriver) SetCompress(v bool) {
+	s.Struct.SetBit(16, v)
+}
+
+func (s Conmon_LogDriver) BufferSize() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_LogDriver) SetBufferSize(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+func (s Conmon_LogDriver) FsyncPolicy() Conmon_LogDriver_FsyncPolicy {
+	return Conmon_LogDriver_FsyncPolicy(s.Struct.Uint16(24))
+}
+
+func (s Conmon_LogDriver) SetFsyncPolicy(v Conmon_LogDriver_FsyncPolicy) {
+	s.Struct.SetUint16(24, uint16(v))
+}
+
+func (s Conmon_LogDriver) FsyncIntervalMs() uint64 {
+	return s.Struct.Uint64(32)
+}
+
+func (s Conmon_LogDriver) SetFsyncIntervalMs(v uint64) {
+	s.Struct.SetUint64(32, v)
+}
+
+func (s Conmon_LogDriver) DirectIo() bool {
+	return s.Struct.Bit(17)
+}
+
+func (s Conmon_LogDriver) SetDirectIo(v bool) {
+	s.Struct.SetBit(17, v)
+}
+
+// Conmon_LogDriver_List is a list of Conmon_LogDriver.
+type Conmon_LogDriver_List = capnp.StructList[Conmon_LogDriver]
+
+// NewConmon_LogDriver creates a new list of Conmon_LogDriver.
+func NewConmon_LogDriver_List(s *capnp.Segment, sz int32) (Conmon_LogDriver_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 40, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_LogDriver]{l}, err
+}
+
+// Conmon_LogDriver_Future is a wrapper for a Conmon_LogDriver promised by a client call.
+type Conmon_LogDriver_Future struct{ *capnp.Future }
+
+func (p Conmon_LogDriver_Future) Struct() (Conmon_LogDriver, error) {
+	s, err := p.Future.Struct()
+	return Conmon_LogDriver{s}, err
+}
+
+type Conmon_LogDriver_FsyncPolicy uint16
+
+// Conmon_LogDriver_FsyncPolicy_TypeID is the unique identifier for the type Conmon_LogDriver_FsyncPolicy.
+const Conmon_LogDriver_FsyncPolicy_TypeID = 0xf7c52eede51486a1
+
+// Values of Conmon_LogDriver_FsyncPolicy.
+const (
+	Conmon_LogDriver_FsyncPolicy_never     Conmon_LogDriver_FsyncPolicy = 0
+	Conmon_LogDriver_FsyncPolicy_interval  Conmon_LogDriver_FsyncPolicy = 1
+	Conmon_LogDriver_FsyncPolicy_everyLine Conmon_LogDriver_FsyncPolicy = 2
+)
+
+// String returns the enum's constant name.
+func (c Conmon_LogDriver_FsyncPolicy) String() string {
+	switch c {
+	case Conmon_LogDriver_FsyncPolicy_never:
+		return "never"
+	case Conmon_LogDriver_FsyncPolicy_interval:
+		return "interval"
+	case Conmon_LogDriver_FsyncPolicy_everyLine:
+		return "everyLine"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_LogDriver_FsyncPolicyFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_LogDriver_FsyncPolicyFromString(c string) Conmon_LogDriver_FsyncPolicy {
+	switch c {
+	case "never":
+		return Conmon_LogDriver_FsyncPolicy_never
+	case "interval":
+		return Conmon_LogDriver_FsyncPolicy_interval
+	case "everyLine":
+		return Conmon_LogDriver_FsyncPolicy_everyLine
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_LogDriver_FsyncPolicy_List = capnp.EnumList[Conmon_LogDriver_FsyncPolicy]
+
+func NewConmon_LogDriver_FsyncPolicy_List(s *capnp.Segment, sz int32) (Conmon_LogDriver_FsyncPolicy_List, error) {
+	return capnp.NewEnumList[Conmon_LogDriver_FsyncPolicy](s, sz)
+}
+
+type Conmon_LogDriver_Type uint16
+
+// Conmon_LogDriver_Type_TypeID is the unique identifier for the type Conmon_LogDriver_Type.
+const Conmon_LogDriver_Type_TypeID = 0xf026e3d750335bc1
+
+// Values of Conmon_LogDriver_Type.
+const (
+	Conmon_LogDriver_Type_containerRuntimeInterface Conmon_LogDriver_Type = 0
+	Conmon_LogDriver_Type_json                      Conmon_LogDriver_Type = 1
+	Conmon_LogDriver_Type_journald                  Conmon_LogDriver_Type = 2
+	Conmon_LogDriver_Type_none                      Conmon_LogDriver_Type = 3
+)
+
+// String returns the enum's constant name.
+func (c Conmon_LogDriver_Type) String() string {
+	switch c {
+	case Conmon_LogDriver_Type_containerRuntimeInterface:
+		return "containerRuntimeInterface"
+	case Conmon_LogDriver_Type_json:
+		return "json"
+	case Conmon_LogDriver_Type_journald:
+		return "journald"
+	case Conmon_LogDriver_Type_none:
+		return "none"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_LogDriver_TypeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_LogDriver_TypeFromString(c string) Conmon_LogDriver_Type {
+	switch c {
+	case "containerRuntimeInterface":
+		return Conmon_LogDriver_Type_containerRuntimeInterface
+	case "json":
+		return Conmon_LogDriver_Type_json
+	case "journald":
+		return Conmon_LogDriver_Type_journald
+	case "none":
+		return Conmon_LogDriver_Type_none
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_LogDriver_Type_List = capnp.EnumList[Conmon_LogDriver_Type]
+
+func NewConmon_LogDriver_Type_List(s *capnp.Segment, sz int32) (Conmon_LogDriver_Type_List, error) {
+	return capnp.NewEnumList[Conmon_LogDriver_Type](s, sz)
+}
+
+type Conmon_LogFilter struct{ capnp.Struct }
+
+// Conmon_LogFilter_TypeID is the unique identifier for the type Conmon_LogFilter.
+const Conmon_LogFilter_TypeID = 0xe8a3e5397a0d9a33
+
+func NewConmon_LogFilter(s *capnp.Segment) (Conmon_LogFilter, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_LogFilter{st}, err
+}
+
+func NewRootConmon_LogFilter(s *capnp.Segment) (Conmon_LogFilter, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_LogFilter{st}, err
+}
+
+func ReadRootConmon_LogFilter(msg *capnp.Message) (Conmon_LogFilter, error) {
+	root, err := msg.Root()
+	return Conmon_LogFilter{root.Struct()}, err
+}
+
+func (s Conmon_LogFilter) String() string {
+	str, _ := text.Marshal(0xe8a3e5397a0d9a33, s.Struct)
+	return str
+}
+
+func (s Conmon_LogFilter) Path() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_LogFilter) HasPath() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_LogFilter) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_LogFilter) SetPath(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_LogFilter) Args() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(1)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_LogFilter) HasArgs() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_LogFilter) SetArgs(v capnp.TextList) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewArgs sets the args field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_LogFilter) NewArgs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_LogFilter) RestartPolicy() Conmon_LogFilter_RestartPolicy {
+	return Conmon_LogFilter_RestartPolicy(s.Struct.Uint16(0))
+}
+
+func (s Conmon_LogFilter) SetRestartPolicy(v Conmon_LogFilter_RestartPolicy) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Conmon_LogFilter) MaxRestarts() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Conmon_LogFilter) SetMaxRestarts(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+// Conmon_LogFilter_List is a list of Conmon_LogFilter.
+type Conmon_LogFilter_List = capnp.StructList[Conmon_LogFilter]
+
+// NewConmon_LogFilter creates a new list of Conmon_LogFilter.
+func NewConmon_LogFilter_List(s *capnp.Segment, sz int32) (Conmon_LogFilter_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_LogFilter]{l}, err
+}
+
+// Conmon_LogFilter_Future is a wrapper for a Conmon_LogFilter promised by a client call.
+type Conmon_LogFilter_Future struct{ *capnp.Future }
+
+func (p Conmon_LogFilter_Future) Struct() (Conmon_LogFilter, error) {
+	s, err := p.Future.Struct()
+	return Conmon_LogFilter{s}, err
+}
+
+type Conmon_LogFilter_RestartPolicy uint16
+
+// Conmon_LogFilter_RestartPolicy_TypeID is the unique identifier for the type Conmon_LogFilter_RestartPolicy.
+const Conmon_LogFilter_RestartPolicy_TypeID = 0xfe29e3539554005a
+
+// Values of Conmon_LogFilter_RestartPolicy.
+const (
+	Conmon_LogFilter_RestartPolicy_never     Conmon_LogFilter_RestartPolicy = 0
+	Conmon_LogFilter_RestartPolicy_onFailure Conmon_LogFilter_RestartPolicy = 1
+	Conmon_LogFilter_RestartPolicy_always    Conmon_LogFilter_RestartPolicy = 2
+)
+
+// String returns the enum's constant name.
+func (c Conmon_LogFilter_RestartPolicy) String() string {
+	switch c {
+	case Conmon_LogFilter_RestartPolicy_never:
+		return "never"
+	case Conmon_LogFilter_RestartPolicy_onFailure:
+		return "onFailure"
+	case Conmon_LogFilter_RestartPolicy_always:
+		return "always"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_LogFilter_RestartPolicyFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_LogFilter_RestartPolicyFromString(c string) Conmon_LogFilter_RestartPolicy {
+	switch c {
+	case "never":
+		return Conmon_LogFilter_RestartPolicy_never
+	case "onFailure":
+		return Conmon_LogFilter_RestartPolicy_onFailure
+	case "always":
+		return Conmon_LogFilter_RestartPolicy_always
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_LogFilter_RestartPolicy_List = capnp.EnumList[Conmon_LogFilter_RestartPolicy]
+
+func NewConmon_LogFilter_RestartPolicy_List(s *capnp.Segment, sz int32) (Conmon_LogFilter_RestartPolicy_List, error) {
+	return capnp.NewEnumList[Conmon_LogFilter_RestartPolicy](s, sz)
+}
+
+type Conmon_CreateContainerResponse struct{ capnp.Struct }
+
+// Conmon_CreateContainerResponse_TypeID is the unique identifier for the type Conmon_CreateContainerResponse.
+const Conmon_CreateContainerResponse_TypeID = 0xde3a625e70772b9a
+
+func NewConmon_CreateContainerResponse(s *capnp.Segment) (Conmon_CreateContainerResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_CreateContainerResponse{st}, err
+}
+
+func NewRootConmon_CreateContainerResponse(s *capnp.Segment) (Conmon_CreateContainerResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_CreateContainerResponse{st}, err
+}
+
+func ReadRootConmon_CreateContainerResponse(msg *capnp.Message) (Conmon_CreateContainerResponse, error) {
+	root, err := msg.Root()
+	return Conmon_CreateContainerResponse{root.Struct()}, err
+}
+
+func (s Conmon_CreateContainerResponse) String() string {
+	str, _ := text.Marshal(0xde3a625e70772b9a, s.Struct)
+	return str
+}
+
+func (s Conmon_CreateContainerResponse) ContainerPid() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Conmon_CreateContainerResponse) SetContainerPid(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s Conmon_CreateContainerResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_CreateContainerResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CreateContainerResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_CreateContainerResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_CreateContainerResponse) ScratchDirPaths() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(1)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_CreateContainerResponse) HasScratchDirPaths() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_CreateContainerResponse) SetScratchDirPaths(v capnp.TextList) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewScratchDirPaths sets the scratchDirPaths field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_CreateContainerResponse) NewScratchDirPaths(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_CreateContainerResponse_List is a list of Conmon_CreateContainerResponse.
+type Conmon_CreateContainerResponse_List = capnp.StructList[Conmon_CreateContainerResponse]
+
+// NewConmon_CreateContainerResponse creates a new list of Conmon_CreateContainerResponse.
+func NewConmon_CreateContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_CreateContainerResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_CreateContainerResponse]{l}, err
+}
+
+// Conmon_CreateContainerResponse_Future is a wrapper for a Conmon_CreateContainerResponse promised by a client call.
+type Conmon_CreateContainerResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_CreateContainerResponse_Future) Struct() (Conmon_CreateContainerResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CreateContainerResponse{s}, err
+}
+
+func (p Conmon_CreateContainerResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_ExecSyncContainerRequest struct{ capnp.Struct }
+
+// Conmon_ExecSyncContainerRequest_TypeID is the unique identifier for the type Conmon_ExecSyncContainerRequest.
+const Conmon_ExecSyncContainerRequest_TypeID = 0xf41122f890a371a6
+
+func NewConmon_ExecSyncContainerRequest(s *capnp.Segment) (Conmon_ExecSyncContainerRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 6})
+	return Conmon_ExecSyncContainerRequest{st}, err
+}
+
+func NewRootConmon_ExecSyncContainerRequest(s *capnp.Segment) (Conmon_ExecSyncContainerRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 6})
+	return Conmon_ExecSyncContainerRequest{st}, err
+}
+
+func ReadRootConmon_ExecSyncContainerRequest(msg *capnp.Message) (Conmon_ExecSyncContainerRequest, error) {
+	root, err := msg.Root()
+	return Conmon_ExecSyncContainerRequest{root.Struct()}, err
+}
+
+func (s Conmon_ExecSyncContainerRequest) String() string {
+	str, _ := text.Marshal(0xf41122f890a371a6, s.Struct)
+	return str
+}
+
+func (s Conmon_ExecSyncContainerRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ExecSyncContainerRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ExecSyncContainerRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ExecSyncContainerRequest) TimeoutSec() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetTimeoutSec(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_ExecSyncContainerRequest) Command() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(1)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_ExecSyncContainerRequest) HasCommand() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetCommand(v capnp.TextList) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewCommand sets the command field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_ExecSyncContainerRequest) NewCommand(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_ExecSyncContainerRequest) Terminal() bool {
+	return s.Struct.Bit(64)
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetTerminal(v bool) {
+	s.Struct.SetBit(64, v)
+}
+
+func (s Conmon_ExecSyncContainerRequest) ExecSessionId() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s Conmon_ExecSyncContainerRequest) HasExecSessionId() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_ExecSyncContainerRequest) ExecSessionIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetExecSessionId(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
+func (s Conmon_ExecSyncContainerRequest) MaxOutputBytes() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetMaxOutputBytes(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+func (s Conmon_ExecSyncContainerRequest) CacheTtlMs() uint64 {
+	return s.Struct.Uint64(24)
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetCacheTtlMs(v uint64) {
+	s.Struct.SetUint64(24, v)
+}
+
+func (s Conmon_ExecSyncContainerRequest) TraceContext() (Conmon_TraceContext, error) {
+	p, err := s.Struct.Ptr(3)
+	return Conmon_TraceContext{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ExecSyncContainerRequest) HasTraceContext() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetTraceContext(v Conmon_TraceContext) error {
+	return s.Struct.SetPtr(3, v.Struct.ToPtr())
+}
+
+// NewTraceContext sets the traceContext field to a newly
+// allocated Conmon_TraceContext struct, preferring placement in s's segment.
+func (s Conmon_ExecSyncContainerRequest) NewTraceContext() (Conmon_TraceContext, error) {
+	ss, err := NewConmon_TraceContext(s.Struct.Segment())
+	if err != nil {
+		return Conmon_TraceContext{}, err
+	}
+	err = s.Struct.SetPtr(3, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_ExecSyncContainerRequest) Scope() (Conmon_RequestScope, error) {
+	p, err := s.Struct.Ptr(4)
+	return Conmon_RequestScope{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ExecSyncContainerRequest) HasScope() bool {
+	return s.Struct.HasPtr(4)
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetScope(v Conmon_RequestScope) error {
+	return s.Struct.SetPtr(4, v.Struct.ToPtr())
+}
+
+// NewScope sets the scope field to a newly
+// allocated Conmon_RequestScope struct, preferring placement in s's segment.
+func (s Conmon_ExecSyncContainerRequest) NewScope() (Conmon_RequestScope, error) {
+	ss, err := NewConmon_RequestScope(s.Struct.Segment())
+	if err != nil {
+		return Conmon_RequestScope{}, err
+	}
+	err = s.Struct.SetPtr(4, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_ExecSyncContainerRequest) RuntimeDebug() bool {
+	return s.Struct.Bit(65)
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetRuntimeDebug(v bool) {
+	s.Struct.SetBit(65, v)
+}
+
+func (s Conmon_ExecSyncContainerRequest) FdMappings() (Conmon_FdMapping_List, error) {
+	p, err := s.Struct.Ptr(5)
+	return Conmon_FdMapping_List{List: p.List()}, err
+}
+
+func (s Conmon_ExecSyncContainerRequest) HasFdMappings() bool {
+	return s.Struct.HasPtr(5)
+}
+
+func (s Conmon_ExecSyncContainerRequest) SetFdMappings(v Conmon_FdMapping_List) error {
+	return s.Struct.SetPtr(5, v.List.ToPtr())
+}
+
+// NewFdMappings sets the fdMappings field to a newly
+// allocated Conmon_FdMapping_List, preferring placement in s's segment.
+func (s Conmon_ExecSyncContainerRequest) NewFdMappings(n int32) (Conmon_FdMapping_List, error) {
+	l, err := NewConmon_FdMapping_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_FdMapping_List{}, err
+	}
+	err = s.Struct.SetPtr(5, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_ExecSyncContainerRequest_List is a list of Conmon_ExecSyncContainerRequest.
+type Conmon_ExecSyncContainerRequest_List = capnp.StructList[Conmon_ExecSyncContainerRequest]
+
+// NewConmon_ExecSyncContainerRequest creates a new list of Conmon_ExecSyncContainerRequest.
+func NewConmon_ExecSyncContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_ExecSyncContainerRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 6}, sz)
+	return capnp.StructList[Conmon_ExecSyncContainerRequest]{l}, err
+}
+
+// Conmon_ExecSyncContainerRequest_Future is a wrapper for a Conmon_ExecSyncContainerRequest promised by a client call.
+type Conmon_ExecSyncContainerRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_ExecSyncContainerRequest_Future) Struct() (Conmon_ExecSyncContainerRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ExecSyncContainerRequest{s}, err
+}
+
+func (p Conmon_ExecSyncContainerRequest_Future) TraceContext() Conmon_TraceContext_Future {
+	return Conmon_TraceContext_Future{Future: p.Future.Field(3, nil)}
+}
+
+func (p Conmon_ExecSyncContainerRequest_Future) Scope() Conmon_RequestScope_Future {
+	return Conmon_RequestScope_Future{Future: p.Future.Field(4, nil)}
+}
+
+type Conmon_ExecSyncContainerResponse struct{ capnp.Struct }
+
+// Conmon_ExecSyncContainerResponse_TypeID is the unique identifier for the type Conmon_ExecSyncContainerResponse.
+const Conmon_ExecSyncContainerResponse_TypeID = 0xd9d61d1d803c85fc
+
+func NewConmon_ExecSyncContainerResponse(s *capnp.Segment) (Conmon_ExecSyncContainerResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
+	return Conmon_ExecSyncContainerResponse{st}, err
+}
+
+func NewRootConmon_ExecSyncContainerResponse(s *capnp.Segment) (Conmon_ExecSyncContainerResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
+	return Conmon_ExecSyncContainerResponse{st}, err
+}
+
+func ReadRootConmon_ExecSyncContainerResponse(msg *capnp.Message) (Conmon_ExecSyncContainerResponse, error) {
+	root, err := msg.Root()
+	return Conmon_ExecSyncContainerResponse{root.Struct()}, err
+}
+
+func (s Conmon_ExecSyncContainerResponse) String() string {
+	str, _ := text.Marshal(0xd9d61d1d803c85fc, s.Struct)
+	return str
+}
+
+func (s Conmon_ExecSyncContainerResponse) ExitCode() int32 {
+	return int32(s.Struct.Uint32(0))
+}
+
+func (s Conmon_ExecSyncContainerResponse) SetExitCode(v int32) {
+	s.Struct.SetUint32(0, uint32(v))
+}
+
+func (s Conmon_ExecSyncContainerResponse) Stdout() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Conmon_ExecSyncContainerResponse) HasStdout() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ExecSyncContainerResponse) SetStdout(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+func (s Conmon_ExecSyncContainerResponse) Stderr() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return []byte(p.Data()), err
+}
+
+func (s Conmon_ExecSyncContainerResponse) HasStderr() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ExecSyncContainerResponse) SetStderr(v []byte) error {
+	return s.Struct.SetData(1, v)
+}
+
+func (s Conmon_ExecSyncContainerResponse) TimedOut() bool {
+	return s.Struct.Bit(32)
+}
+
+func (s Conmon_ExecSyncContainerResponse) SetTimedOut(v bool) {
+	s.Struct.SetBit(32, v)
+}
+
+func (s Conmon_ExecSyncContainerResponse) StdoutTruncated() bool {
+	return s.Struct.Bit(33)
+}
+
+func (s Conmon_ExecSyncContainerResponse) SetStdoutTruncated(v bool) {
+	s.Struct.SetBit(33, v)
+}
+
+func (s Conmon_ExecSyncContainerResponse) StderrTruncated() bool {
+	return s.Struct.Bit(34)
+}
+
+func (s Conmon_ExecSyncContainerResponse) SetStderrTruncated(v bool) {
+	s.Struct.SetBit(34, v)
+}
+
+func (s Conmon_ExecSyncContainerResponse) Cached() bool {
+	return s.Struct.Bit(35)
+}
+
+func (s Conmon_ExecSyncContainerResponse) SetCached(v bool) {
+	s.Struct.SetBit(35, v)
+}
+
+func (s Conmon_ExecSyncContainerResponse) CacheAgeMs() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_ExecSyncContainerResponse) SetCacheAgeMs(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_ExecSyncContainerResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(2)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ExecSyncContainerResponse) HasError() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_ExecSyncContainerResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(2, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_ExecSyncContainerResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(2, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_ExecSyncContainerResponse_List is a list of Conmon_ExecSyncContainerResponse.
+type Conmon_ExecSyncContainerResponse_List = capnp.StructList[Conmon_ExecSyncContainerResponse]
+
+// NewConmon_ExecSyncContainerResponse creates a new list of Conmon_ExecSyncContainerResponse.
+func NewConmon_ExecSyncContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_ExecSyncContainerResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_ExecSyncContainerResponse]{l}, err
+}
+
+// Conmon_ExecSyncContainerResponse_Future is a wrapper for a Conmon_ExecSyncContainerResponse promised by a client call.
+type Conmon_ExecSyncContainerResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_ExecSyncContainerResponse_Future) Struct() (Conmon_ExecSyncContainerResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ExecSyncContainerResponse{s}, err
+}
+
+func (p Conmon_ExecSyncContainerResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(2, nil)}
+}
+
+type Conmon_AttachRequest struct{ capnp.Struct }
+
+// Conmon_AttachRequest_TypeID is the unique identifier for the type Conmon_AttachRequest.
+const Conmon_AttachRequest_TypeID = 0xdf703ca0befc3afc
+
+func NewConmon_AttachRequest(s *capnp.Segment) (Conmon_AttachRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Conmon_AttachRequest{st}, err
+}
+
+func NewRootConmon_AttachRequest(s *capnp.Segment) (Conmon_AttachRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Conmon_AttachRequest{st}, err
+}
+
+func ReadRootConmon_AttachRequest(msg *capnp.Message) (Conmon_AttachRequest, error) {
+	root, err := msg.Root()
+	return Conmon_AttachRequest{root.Struct()}, err
+}
+
+func (s Conmon_AttachRequest) String() string {
+	str, _ := text.Marshal(0xdf703ca0befc3afc, s.Struct)
+	return str
+}
+
+func (s Conmon_AttachRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_AttachRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_AttachRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_AttachRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_AttachRequest) SocketPath() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_AttachRequest) HasSocketPath() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_AttachRequest) SocketPathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_AttachRequest) SetSocketPath(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_AttachRequest) ExecSessionId() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s Conmon_AttachRequest) HasExecSessionId() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_AttachRequest) ExecSessionIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_AttachRequest) SetExecSessionId(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
+func (s Conmon_AttachRequest) SimulateTerminal() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Conmon_AttachRequest) SetSimulateTerminal(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+func (s Conmon_AttachRequest) TraceContext() (Conmon_TraceContext, error) {
+	p, err := s.Struct.Ptr(3)
+	return Conmon_TraceContext{Struct: p.Struct()}, err
+}
+
+func (s Conmon_AttachRequest) HasTraceContext() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Conmon_AttachRequest) SetTraceContext(v Conmon_TraceContext) error {
+	return s.Struct.SetPtr(3, v.Struct.ToPtr())
+}
+
+// NewTraceContext sets the traceContext field to a newly
+// allocated Conmon_TraceContext struct, preferring placement in s's segment.
+func (s Conmon_AttachRequest) NewTraceContext() (Conmon_TraceContext, error) {
+	ss, err := NewConmon_TraceContext(s.Struct.Segment())
+	if err != nil {
+		return Conmon_TraceContext{}, err
+	}
+	err = s.Struct.SetPtr(3, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_AttachRequest) OutputOnly() bool {
+	return s.Struct.Bit(1)
+}
+
+func (s Conmon_AttachRequest) SetOutputOnly(v bool) {
+	s.Struct.SetBit(1, v)
+}
+
+func (s Conmon_AttachRequest) Sequenced() bool {
+	return s.Struct.Bit(2)
+}
+
+func (s Conmon_AttachRequest) SetSequenced(v bool) {
+	s.Struct.SetBit(2, v)
+}
+
+func (s Conmon_AttachRequest) Terminal() bool {
+	return s.Struct.Bit(3)
+}
+
+func (s Conmon_AttachRequest) SetTerminal(v bool) {
+	s.Struct.SetBit(3, v)
+}
+
+func (s Conmon_AttachRequest) StrictTerminal() bool {
+	return s.Struct.Bit(4)
+}
+
+func (s Conmon_AttachRequest) SetStrictTerminal(v bool) {
+	s.Struct.SetBit(4, v)
+}
+
+func (s Conmon_AttachRequest) ReportEnd() bool {
+	return s.Struct.Bit(5)
+}
+
+func (s Conmon_AttachRequest) SetReportEnd(v bool) {
+	s.Struct.SetBit(5, v)
+}
+
+func (s Conmon_AttachRequest) StderrPriority() bool {
+	return s.Struct.Bit(6)
+}
+
+func (s Conmon_AttachRequest) SetStderrPriority(v bool) {
+	s.Struct.SetBit(6, v)
+}
+
+// Conmon_AttachRequest_List is a list of Conmon_AttachRequest.
+type Conmon_AttachRequest_List = capnp.StructList[Conmon_AttachRequest]
+
+// NewConmon_AttachRequest creates a new list of Conmon_AttachRequest.
+func NewConmon_AttachRequest_List(s *capnp.Segment, sz int32) (Conmon_AttachRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4}, sz)
+	return capnp.StructList[Conmon_AttachRequest]{l}, err
+}
+
+// Conmon_AttachRequest_Future is a wrapper for a Conmon_AttachRequest promised by a client call.
+type Conmon_AttachRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_AttachRequest_Future) Struct() (Conmon_AttachRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_AttachRequest{s}, err
+}
+
+func (p Conmon_AttachRequest_Future) TraceContext() Conmon_TraceContext_Future {
+	return Conmon_TraceContext_Future{Future: p.Future.Field(3, nil)}
+}
+
+type Conmon_AttachResponse struct{ capnp.Struct }
+
+// Conmon_AttachResponse_TypeID is the unique identifier for the type Conmon_AttachResponse.
+const Conmon_AttachResponse_TypeID = 0xace5517aafc86077
+
+func NewConmon_AttachResponse(s *capnp.Segment) (Conmon_AttachResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_AttachResponse{st}, err
+}
+
+func NewRootConmon_AttachResponse(s *capnp.Segment) (Conmon_AttachResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_AttachResponse{st}, err
+}
+
+func ReadRootConmon_AttachResponse(msg *capnp.Message) (Conmon_AttachResponse, error) {
+	root, err := msg.Root()
+	return Conmon_AttachResponse{root.Struct()}, err
+}
+
+func (s Conmon_AttachResponse) String() string {
+	str, _ := text.Marshal(0xace5517aafc86077, s.Struct)
+	return str
+}
+
+func (s Conmon_AttachResponse) Sequenced() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Conmon_AttachResponse) SetSequenced(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+func (s Conmon_AttachResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_AttachResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_AttachResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_AttachResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_AttachResponse) StrictTerminal() bool {
+	return s.Struct.Bit(1)
+}
+
+func (s Conmon_AttachResponse) SetStrictTerminal(v bool) {
+	s.Struct.SetBit(1, v)
+}
+
+func (s Conmon_AttachResponse) ReportEnd() bool {
+	return s.Struct.Bit(2)
+}
+
+func (s Conmon_AttachResponse) SetReportEnd(v bool) {
+	s.Struct.SetBit(2, v)
+}
+
+func (s Conmon_AttachResponse) StderrPriority() bool {
+	return s.Struct.Bit(3)
+}
+
+func (s Conmon_AttachResponse) SetStderrPriority(v bool) {
+	s.Struct.SetBit(3, v)
+}
+
+// Conmon_AttachResponse_List is a list of Conmon_AttachResponse.
+type Conmon_AttachResponse_List = capnp.StructList[Conmon_AttachResponse]
+
+// NewConmon_AttachResponse creates a new list of Conmon_AttachResponse.
+func NewConmon_AttachResponse_List(s *capnp.Segment, sz int32) (Conmon_AttachResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_AttachResponse]{l}, err
+}
+
+// Conmon_AttachResponse_Future is a wrapper for a Conmon_AttachResponse promised by a client call.
+type Conmon_AttachResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_AttachResponse_Future) Struct() (Conmon_AttachResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_AttachResponse{s}, err
+}
+
+func (p Conmon_AttachResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_ReopenLogRequest struct{ capnp.Struct }
+
+// Conmon_ReopenLogRequest_TypeID is the unique identifier for the type Conmon_ReopenLogRequest.
+const Conmon_ReopenLogRequest_TypeID = 0xd0476e0f34d1411a
+
+func NewConmon_ReopenLogRequest(s *capnp.Segment) (Conmon_ReopenLogRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_ReopenLogRequest{st}, err
+}
+
+func NewRootConmon_ReopenLogRequest(s *capnp.Segment) (Conmon_ReopenLogRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_ReopenLogRequest{st}, err
+}
+
+func ReadRootConmon_ReopenLogRequest(msg *capnp.Message) (Conmon_ReopenLogRequest, error) {
+	root, err := msg.Root()
+	return Conmon_ReopenLogRequest{root.Struct()}, err
+}
+
+func (s Conmon_ReopenLogRequest) String() string {
+	str, _ := text.Marshal(0xd0476e0f34d1411a, s.Struct)
+	return str
+}
+
+func (s Conmon_ReopenLogRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ReopenLogRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ReopenLogRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ReopenLogRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ReopenLogRequest) ExecSessionId() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_ReopenLogRequest) HasExecSessionId() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ReopenLogRequest) ExecSessionIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ReopenLogRequest) SetExecSessionId(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_ReopenLogRequest) Path() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s Conmon_ReopenLogRequest) HasPath() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_ReopenLogRequest) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ReopenLogRequest) SetPath(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
+// Conmon_ReopenLogRequest_List is a list of Conmon_ReopenLogRequest.
+type Conmon_ReopenLogRequest_List = capnp.StructList[Conmon_ReopenLogRequest]
+
+// NewConmon_ReopenLogRequest creates a new list of Conmon_ReopenLogRequest.
+func NewConmon_ReopenLogRequest_List(s *capnp.Segment, sz int32) (Conmon_ReopenLogRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_ReopenLogRequest]{l}, err
+}
+
+// Conmon_ReopenLogRequest_Future is a wrapper for a Conmon_ReopenLogRequest promised by a client call.
+type Conmon_ReopenLogRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_ReopenLogRequest_Future) Struct() (Conmon_ReopenLogRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ReopenLogRequest{s}, err
+}
+
+type Conmon_ReopenLogResponse struct{ capnp.Struct }
+
+// Conmon_ReopenLogResponse_TypeID is the unique identifier for the type Conmon_ReopenLogResponse.
+const Conmon_ReopenLogResponse_TypeID = 0xa20f49456be85b99
+
+func NewConmon_ReopenLogResponse(s *capnp.Segment) (Conmon_ReopenLogResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_ReopenLogResponse{st}, err
+}
+
+func NewRootConmon_ReopenLogResponse(s *capnp.Segment) (Conmon_ReopenLogResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_ReopenLogResponse{st}, err
+}
+
+func ReadRootConmon_ReopenLogResponse(msg *capnp.Message) (Conmon_ReopenLogResponse, error) {
+	root, err := msg.Root()
+	return Conmon_ReopenLogResponse{root.Struct()}, err
+}
+
+func (s Conmon_ReopenLogResponse) String() string {
+	str, _ := text.Marshal(0xa20f49456be85b99, s.Struct)
+	return str
+}
+
+// Conmon_ReopenLogResponse_List is a list of Conmon_ReopenLogResponse.
+type Conmon_ReopenLogResponse_List = capnp.StructList[Conmon_ReopenLogResponse]
+
+// NewConmon_ReopenLogResponse creates a new list of Conmon_ReopenLogResponse.
+func NewConmon_ReopenLogResponse_List(s *capnp.Segment, sz int32) (Conmon_ReopenLogResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_ReopenLogResponse]{l}, err
+}
+
+// Conmon_ReopenLogResponse_Future is a wrapper for a Conmon_ReopenLogResponse promised by a client call.
+type Conmon_ReopenLogResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_ReopenLogResponse_Future) Struct() (Conmon_ReopenLogResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ReopenLogResponse{s}, err
+}
+
+type Conmon_SetWindowSizeRequest struct{ capnp.Struct }
+
+// Conmon_SetWindowSizeRequest_TypeID is the unique identifier for the type Conmon_SetWindowSizeRequest.
+const Conmon_SetWindowSizeRequest_TypeID = 0xb5418b8ea8ead17b
+
+func NewConmon_SetWindowSizeRequest(s *capnp.Segment) (Conmon_SetWindowSizeRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_SetWindowSizeRequest{st}, err
+}
+
+func NewRootConmon_SetWindowSizeRequest(s *capnp.Segment) (Conmon_SetWindowSizeRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_SetWindowSizeRequest{st}, err
+}
+
+func ReadRootConmon_SetWindowSizeRequest(msg *capnp.Message) (Conmon_SetWindowSizeRequest, error) {
+	root, err := msg.Root()
+	return Conmon_SetWindowSizeRequest{root.Struct()}, err
+}
+
+func (s Conmon_SetWindowSizeRequest) String() string {
+	str, _ := text.Marshal(0xb5418b8ea8ead17b, s.Struct)
+	return str
+}
+
+func (s Conmon_SetWindowSizeRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_SetWindowSizeRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_SetWindowSizeRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_SetWindowSizeRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_SetWindowSizeRequest) Width() uint16 {
+	return s.Struct.Uint16(0)
+}
+
+func (s Conmon_SetWindowSizeRequest) SetWidth(v uint16) {
+	s.Struct.SetUint16(0, v)
+}
+
+func (s Conmon_SetWindowSizeRequest) Height() uint16 {
+	return s.Struct.Uint16(2)
+}
+
+func (s Conmon_SetWindowSizeRequest) SetHeight(v uint16) {
+	s.Struct.SetUint16(2, v)
+}
+
+func (s Conmon_SetWindowSizeRequest) ExecSessionId() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_SetWindowSizeRequest) HasExecSessionId() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_SetWindowSizeRequest) ExecSessionIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_SetWindowSizeRequest) SetExecSessionId(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+// Conmon_SetWindowSizeRequest_List is a list of Conmon_SetWindowSizeRequest.
+type Conmon_SetWindowSizeRequest_List = capnp.StructList[Conmon_SetWindowSizeRequest]
+
+// NewConmon_SetWindowSizeRequest creates a new list of Conmon_SetWindowSizeRequest.
+func NewConmon_SetWindowSizeRequest_List(s *capnp.Segment, sz int32) (Conmon_SetWindowSizeRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_SetWindowSizeRequest]{l}, err
+}
+
+// Conmon_SetWindowSizeRequest_Future is a wrapper for a Conmon_SetWindowSizeRequest promised by a client call.
+type Conmon_SetWindowSizeRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_SetWindowSizeRequest_Future) Struct() (Conmon_SetWindowSizeRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SetWindowSizeRequest{s}, err
+}
+
+type Conmon_SetWindowSizeResponse struct{ capnp.Struct }
+
+// Conmon_SetWindowSizeResponse_TypeID is the unique identifier for the type Conmon_SetWindowSizeResponse.
+const Conmon_SetWindowSizeResponse_TypeID = 0xf9b3cd8033aba1f8
+
+func NewConmon_SetWindowSizeResponse(s *capnp.Segment) (Conmon_SetWindowSizeResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_SetWindowSizeResponse{st}, err
+}
+
+func NewRootConmon_SetWindowSizeResponse(s *capnp.Segment) (Conmon_SetWindowSizeResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_SetWindowSizeResponse{st}, err
+}
+
+func ReadRootConmon_SetWindowSizeResponse(msg *capnp.Message) (Conmon_SetWindowSizeResponse, error) {
+	root, err := msg.Root()
+	return Conmon_SetWindowSizeResponse{root.Struct()}, err
+}
+
+func (s Conmon_SetWindowSizeResponse) String() string {
+	str, _ := text.Marshal(0xf9b3cd8033aba1f8, s.Struct)
+	return str
+}
+
+// Conmon_SetWindowSizeResponse_List is a list of Conmon_SetWindowSizeResponse.
+type Conmon_SetWindowSizeResponse_List = capnp.StructList[Conmon_SetWindowSizeResponse]
+
+// NewConmon_SetWindowSizeResponse creates a new list of Conmon_SetWindowSizeResponse.
+func NewConmon_SetWindowSizeResponse_List(s *capnp.Segment, sz int32) (Conmon_SetWindowSizeResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_SetWindowSizeResponse]{l}, err
+}
+
+// Conmon_SetWindowSizeResponse_Future is a wrapper for a Conmon_SetWindowSizeResponse promised by a client call.
+type Conmon_SetWindowSizeResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_SetWindowSizeResponse_Future) Struct() (Conmon_SetWindowSizeResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SetWindowSizeResponse{s}, err
+}
+
+type Conmon_UpdateSysctlsRequest struct{ capnp.Struct }
+
+// Conmon_UpdateSysctlsRequest_TypeID is the unique identifier for the type Conmon_UpdateSysctlsRequest.
+const Conmon_UpdateSysctlsRequest_TypeID = 0xde0533ba0ea48fa4
+
+func NewConmon_UpdateSysctlsRequest(s *capnp.Segment) (Conmon_UpdateSysctlsRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_UpdateSysctlsRequest{st}, err
+}
+
+func NewRootConmon_UpdateSysctlsRequest(s *capnp.Segment) (Conmon_UpdateSysctlsRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_UpdateSysctlsRequest{st}, err
+}
+
+func ReadRootConmon_UpdateSysctlsRequest(msg *capnp.Message) (Conmon_UpdateSysctlsRequest, error) {
+	root, err := msg.Root()
+	return Conmon_UpdateSysctlsRequest{root.Struct()}, err
+}
+
+func (s Conmon_UpdateSysctlsRequest) String() string {
+	str, _ := text.Marshal(0xde0533ba0ea48fa4, s.Struct)
+	return str
+}
+
+func (s Conmon_UpdateSysctlsRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_UpdateSysctlsRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_UpdateSysctlsRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_UpdateSysctlsRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_UpdateSysctlsRequest) Sysctls() (Conmon_Sysctl_List, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_Sysctl_List{List: p.List()}, err
+}
+
+func (s Conmon_UpdateSysctlsRequest) HasSysctls() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_UpdateSysctlsRequest) SetSysctls(v Conmon_Sysctl_List) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewSysctls sets the sysctls field to a newly
+// allocated Conmon_Sysctl_List, preferring placement in s's segment.
+func (s Conmon_UpdateSysctlsRequest) NewSysctls(n int32) (Conmon_Sysctl_List, error) {
+	l, err := NewConmon_Sysctl_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_Sysctl_List{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_UpdateSysctlsRequest_List is a list of Conmon_UpdateSysctlsRequest.
+type Conmon_UpdateSysctlsRequest_List = capnp.StructList[Conmon_UpdateSysctlsRequest]
+
+// NewConmon_UpdateSysctlsRequest creates a new list of Conmon_UpdateSysctlsRequest.
+func NewConmon_UpdateSysctlsRequest_List(s *capnp.Segment, sz int32) (Conmon_UpdateSysctlsRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_UpdateSysctlsRequest]{l}, err
+}
+
+// Conmon_UpdateSysctlsRequest_Future is a wrapper for a Conmon_UpdateSysctlsRequest promised by a client call.
+type Conmon_UpdateSysctlsRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_UpdateSysctlsRequest_Future) Struct() (Conmon_UpdateSysctlsRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_UpdateSysctlsRequest{s}, err
+}
+
+type Conmon_Sysctl struct{ capnp.Struct }
+
+// Conmon_Sysctl_TypeID is the unique identifier for the type Conmon_Sysctl.
+const Conmon_Sysctl_TypeID = 0xf798b7a4fe56d11d
+
+func NewConmon_Sysctl(s *capnp.Segment) (Conmon_Sysctl, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_Sysctl{st}, err
+}
+
+func NewRootConmon_Sysctl(s *capnp.Segment) (Conmon_Sysctl, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_Sysctl{st}, err
+}
+
+func ReadRootConmon_Sysctl(msg *capnp.Message) (Conmon_Sysctl, error) {
+	root, err := msg.Root()
+	return Conmon_Sysctl{root.Struct()}, err
+}
+
+func (s Conmon_Sysctl) String() string {
+	str, _ := text.Marshal(0xf798b7a4fe56d11d, s.Struct)
+	return str
+}
+
+func (s Conmon_Sysctl) Key() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_Sysctl) HasKey() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_Sysctl) KeyBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_Sysctl) SetKey(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_Sysctl) Value() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_Sysctl) HasValue() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_Sysctl) ValueBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_Sysctl) SetValue(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+// Conmon_Sysctl_List is a list of Conmon_Sysctl.
+type Conmon_Sysctl_List = capnp.StructList[Conmon_Sysctl]
+
+// NewConmon_Sysctl creates a new list of Conmon_Sysctl.
+func NewConmon_Sysctl_List(s *capnp.Segment, sz int32) (Conmon_Sysctl_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_Sysctl]{l}, err
+}
+
+// Conmon_Sysctl_Future is a wrapper for a Conmon_Sysctl promised by a client call.
+type Conmon_Sysctl_Future struct{ *capnp.Future }
+
+func (p Conmon_Sysctl_Future) Struct() (Conmon_Sysctl, error) {
+	s, err := p.Future.Struct()
+	return Conmon_Sysctl{s}, err
+}
+
+type Conmon_UpdateSysctlsResponse struct{ capnp.Struct }
+
+// Conmon_UpdateSysctlsResponse_TypeID is the unique identifier for the type Conmon_UpdateSysctlsResponse.
+const Conmon_UpdateSysctlsResponse_TypeID = 0xdc48fbfc31ee1cbe
+
+func NewConmon_UpdateSysctlsResponse(s *capnp.Segment) (Conmon_UpdateSysctlsResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_UpdateSysctlsResponse{st}, err
+}
+
+func NewRootConmon_UpdateSysctlsResponse(s *capnp.Segment) (Conmon_UpdateSysctlsResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_UpdateSysctlsResponse{st}, err
+}
+
+func ReadRootConmon_UpdateSysctlsResponse(msg *capnp.Message) (Conmon_UpdateSysctlsResponse, error) {
+	root, err := msg.Root()
+	return Conmon_UpdateSysctlsResponse{root.Struct()}, err
+}
+
+func (s Conmon_UpdateSysctlsResponse) String() string {
+	str, _ := text.Marshal(0xdc48fbfc31ee1cbe, s.Struct)
+	return str
+}
+
+func (s Conmon_UpdateSysctlsResponse) Rejections() (Conmon_SysctlRejection_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SysctlRejection_List{List: p.List()}, err
+}
+
+func (s Conmon_UpdateSysctlsResponse) HasRejections() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_UpdateSysctlsResponse) SetRejections(v Conmon_SysctlRejection_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewRejections sets the rejections field to a newly
+// allocated Conmon_SysctlRejection_List, preferring placement in s's segment.
+func (s Conmon_UpdateSysctlsResponse) NewRejections(n int32) (Conmon_SysctlRejection_List, error) {
+	l, err := NewConmon_SysctlRejection_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_SysctlRejection_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_UpdateSysctlsResponse_List is a list of Conmon_UpdateSysctlsResponse.
+type Conmon_UpdateSysctlsResponse_List = capnp.StructList[Conmon_UpdateSysctlsResponse]
+
+// NewConmon_UpdateSysctlsResponse creates a new list of Conmon_UpdateSysctlsResponse.
+func NewConmon_UpdateSysctlsResponse_List(s *capnp.Segment, sz int32) (Conmon_UpdateSysctlsResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_UpdateSysctlsResponse]{l}, err
+}
+
+// Conmon_UpdateSysctlsResponse_Future is a wrapper for a Conmon_UpdateSysctlsResponse promised by a client call.
+type Conmon_UpdateSysctlsResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_UpdateSysctlsResponse_Future) Struct() (Conmon_UpdateSysctlsResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_UpdateSysctlsResponse{s}, err
+}
+
+type Conmon_SysctlRejection struct{ capnp.Struct }
+
+// Conmon_SysctlRejection_TypeID is the unique identifier for the type Conmon_SysctlRejection.
+const Conmon_SysctlRejection_TypeID = 0xa02baa9843c3319e
+
+func NewConmon_SysctlRejection(s *capnp.Segment) (Conmon_SysctlRejection, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_SysctlRejection{st}, err
+}
+
+func NewRootConmon_SysctlRejection(s *capnp.Segment) (Conmon_SysctlRejection, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_SysctlRejection{st}, err
+}
+
+func ReadRootConmon_SysctlRejection(msg *capnp.Message) (Conmon_SysctlRejection, error) {
+	root, err := msg.Root()
+	return Conmon_SysctlRejection{root.Struct()}, err
+}
+
+func (s Conmon_SysctlRejection) String() string {
+	str, _ := text.Marshal(0xa02baa9843c3319e, s.Struct)
+	return str
+}
+
+func (s Conmon_SysctlRejection) Key() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_SysctlRejection) HasKey() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_SysctlRejection) KeyBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_SysctlRejection) SetKey(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_SysctlRejection) Reason() Conmon_SysctlRejection_Reason {
+	return Conmon_SysctlRejection_Reason(s.Struct.Uint16(0))
+}
+
+func (s Conmon_SysctlRejection) SetReason(v Conmon_SysctlRejection_Reason) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+// Conmon_SysctlRejection_List is a list of Conmon_SysctlRejection.
+type Conmon_SysctlRejection_List = capnp.StructList[Conmon_SysctlRejection]
+
+// NewConmon_SysctlRejection creates a new list of Conmon_SysctlRejection.
+func NewConmon_SysctlRejection_List(s *capnp.Segment, sz int32) (Conmon_SysctlRejection_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_SysctlRejection]{l}, err
+}
+
+// Conmon_SysctlRejection_Future is a wrapper for a Conmon_SysctlRejection promised by a client call.
+type Conmon_SysctlRejection_Future struct{ *capnp.Future }
+
+func (p Conmon_SysctlRejection_Future) Struct() (Conmon_SysctlRejection, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SysctlRejection{s}, err
+}
+
+type Conmon_SysctlRejection_Reason uint16
+
+// Conmon_SysctlRejection_Reason_TypeID is the unique identifier for the type Conmon_SysctlRejection_Reason.
+const Conmon_SysctlRejection_Reason_TypeID = 0xbfd1a9d245bcd107
+
+// Values of Conmon_SysctlRejection_Reason.
+const (
+	Conmon_SysctlRejection_Reason_invalidKey    Conmon_SysctlRejection_Reason = 0
+	Conmon_SysctlRejection_Reason_notAllowed    Conmon_SysctlRejection_Reason = 1
+	Conmon_SysctlRejection_Reason_invalidValue  Conmon_SysctlRejection_Reason = 2
+	Conmon_SysctlRejection_Reason_hostNamespace Conmon_SysctlRejection_Reason = 3
+)
+
+// String returns the enum's constant name.
+func (c Conmon_SysctlRejection_Reason) String() string {
+	switch c {
+	case Conmon_SysctlRejection_Reason_invalidKey:
+		return "invalidKey"
+	case Conmon_SysctlRejection_Reason_notAllowed:
+		return "notAllowed"
+	case Conmon_SysctlRejection_Reason_invalidValue:
+		return "invalidValue"
+	case Conmon_SysctlRejection_Reason_hostNamespace:
+		return "hostNamespace"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_SysctlRejection_ReasonFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_SysctlRejection_ReasonFromString(c string) Conmon_SysctlRejection_Reason {
+	switch c {
+	case "invalidKey":
+		return Conmon_SysctlRejection_Reason_invalidKey
+	case "notAllowed":
+		return Conmon_SysctlRejection_Reason_notAllowed
+	case "invalidValue":
+		return Conmon_SysctlRejection_Reason_invalidValue
+	case "hostNamespace":
+		return Conmon_SysctlRejection_Reason_hostNamespace
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_SysctlRejection_Reason_List = capnp.EnumList[Conmon_SysctlRejection_Reason]
+
+func NewConmon_SysctlRejection_Reason_List(s *capnp.Segment, sz int32) (Conmon_SysctlRejection_Reason_List, error) {
+	return capnp.NewEnumList[Conmon_SysctlRejection_Reason](s, sz)
+}
+
+type Conmon_WatchMountsRequest struct{ capnp.Struct }
+
+// Conmon_WatchMountsRequest_TypeID is the unique identifier for the type Conmon_WatchMountsRequest.
+const Conmon_WatchMountsRequest_TypeID = 0xbbaa233f6a4c8bd5
+
+func NewConmon_WatchMountsRequest(s *capnp.Segment) (Conmon_WatchMountsRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_WatchMountsRequest{st}, err
+}
+
+func NewRootConmon_WatchMountsRequest(s *capnp.Segment) (Conmon_WatchMountsRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_WatchMountsRequest{st}, err
+}
+
+func ReadRootConmon_WatchMountsRequest(msg *capnp.Message) (Conmon_WatchMountsRequest, error) {
+	root, err := msg.Root()
+	return Conmon_WatchMountsRequest{root.Struct()}, err
+}
+
+func (s Conmon_WatchMountsRequest) String() string {
+	str, _ := text.Marshal(0xbbaa233f6a4c8bd5, s.Struct)
+	return str
+}
+
+func (s Conmon_WatchMountsRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_WatchMountsRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_WatchMountsRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_WatchMountsRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_WatchMountsRequest) Watcher() Conmon_MountWatcher {
+	p, _ := s.Struct.Ptr(1)
+	return Conmon_MountWatcher{Client: p.Interface().Client()}
+}
+
+func (s Conmon_WatchMountsRequest) HasWatcher() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_WatchMountsRequest) SetWatcher(v Conmon_MountWatcher) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(1, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(1, in.ToPtr())
+}
+
+// Conmon_WatchMountsRequest_List is a list of Conmon_WatchMountsRequest.
+type Conmon_WatchMountsRequest_List = capnp.StructList[Conmon_WatchMountsRequest]
+
+// NewConmon_WatchMountsRequest creates a new list of Conmon_WatchMountsRequest.
+func NewConmon_WatchMountsRequest_List(s *capnp.Segment, sz int32) (Conmon_WatchMountsRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_WatchMountsRequest]{l}, err
+}
+
+// Conmon_WatchMountsRequest_Future is a wrapper for a Conmon_WatchMountsRequest promised by a client call.
+type Conmon_WatchMountsRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_WatchMountsRequest_Future) Struct() (Conmon_WatchMountsRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_WatchMountsRequest{s}, err
+}
+
+func (p Conmon_WatchMountsRequest_Future) Watcher() Conmon_MountWatcher {
+	return Conmon_MountWatcher{Client: p.Future.Field(1, nil).Client()}
+}
+
+type Conmon_WatchMountsResponse struct{ capnp.Struct }
+
+// Conmon_WatchMountsResponse_TypeID is the unique identifier for the type Conmon_WatchMountsResponse.
+const Conmon_WatchMountsResponse_TypeID = 0xb8a04df0eb432fc1
+
+func NewConmon_WatchMountsResponse(s *capnp.Segment) (Conmon_WatchMountsResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_WatchMountsResponse{st}, err
+}
+
+func NewRootConmon_WatchMountsResponse(s *capnp.Segment) (Conmon_WatchMountsResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_WatchMountsResponse{st}, err
+}
+
+func ReadRootConmon_WatchMountsResponse(msg *capnp.Message) (Conmon_WatchMountsResponse, error) {
+	root, err := msg.Root()
+	return Conmon_WatchMountsResponse{root.Struct()}, err
+}
+
+func (s Conmon_WatchMountsResponse) String() string {
+	str, _ := text.Marshal(0xb8a04df0eb432fc1, s.Struct)
+	return str
+}
+
+// Conmon_WatchMountsResponse_List is a list of Conmon_WatchMountsResponse.
+type Conmon_WatchMountsResponse_List = capnp.StructList[Conmon_WatchMountsResponse]
+
+// NewConmon_WatchMountsResponse creates a new list of Conmon_WatchMountsResponse.
+func NewConmon_WatchMountsResponse_List(s *capnp.Segment, sz int32) (Conmon_WatchMountsResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_WatchMountsResponse]{l}, err
+}
+
+// Conmon_WatchMountsResponse_Future is a wrapper for a Conmon_WatchMountsResponse promised by a client call.
+type Conmon_WatchMountsResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_WatchMountsResponse_Future) Struct() (Conmon_WatchMountsResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_WatchMountsResponse{s}, err
+}
+
+type Conmon_MountWatcher struct{ Client *capnp.Client }
+
+// Conmon_MountWatcher_TypeID is the unique identifier for the type Conmon_MountWatcher.
+const Conmon_MountWatcher_TypeID = 0xe66c9b755e97c2a3
+
+func (c Conmon_MountWatcher) Event(ctx context.Context, params func(Conmon_MountWatcher_event_Params) error) (Conmon_MountWatcher_event_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe66c9b755e97c2a3,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.MountWatcher",
+			MethodName:    "event",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_MountWatcher_event_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_MountWatcher_event_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon_MountWatcher) Done(ctx context.Context, params func(Conmon_MountWatcher_done_Params) error) (Conmon_MountWatcher_done_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xe66c9b755e97c2a3,
+			MethodID:      1,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.MountWatcher",
+			MethodName:    "done",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_MountWatcher_done_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_MountWatcher_done_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Conmon_MountWatcher) AddRef() Conmon_MountWatcher {
+	return Conmon_MountWatcher{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Conmon_MountWatcher) Release() {
+	c.Client.Release()
+}
+
+// A Conmon_MountWatcher_Server is a Conmon_MountWatcher with a local implementation.
+type Conmon_MountWatcher_Server interface {
+	Event(context.Context, Conmon_MountWatcher_event) error
+
+	Done(context.Context, Conmon_MountWatcher_done) error
+}
+
+// Conmon_MountWatcher_NewServer creates a new Server from an implementation of Conmon_MountWatcher_Server.
+func Conmon_MountWatcher_NewServer(s Conmon_MountWatcher_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Conmon_MountWatcher_Methods(nil, s), s, c, policy)
+}
+
+// Conmon_MountWatcher_ServerToClient creates a new Client from an implementation of Conmon_MountWatcher_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Conmon_MountWatcher_ServerToClient(s Conmon_MountWatcher_Server, policy *server.Policy) Conmon_MountWatcher {
+	return Conmon_MountWatcher{Client: capnp.NewClient(Conmon_MountWatcher_NewServer(s, policy))}
+}
+
+// Conmon_MountWatcher_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Conmon_MountWatcher_Methods(methods []server.Method, s Conmon_MountWatcher_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe66c9b755e97c2a3,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.MountWatcher",
+			MethodName:    "event",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Event(ctx, Conmon_MountWatcher_event{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xe66c9b755e97c2a3,
+			MethodID:      1,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.MountWatcher",
+			MethodName:    "done",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Done(ctx, Conmon_MountWatcher_done{call})
+		},
+	})
+
+	return methods
+}
+
+// Conmon_MountWatcher_event holds the state for a server call to Conmon_MountWatcher.event.
+// See server.Call for documentation.
+type Conmon_MountWatcher_event struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_MountWatcher_event) Args() Conmon_MountWatcher_event_Params {
+	return Conmon_MountWatcher_event_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_MountWatcher_event) AllocResults() (Conmon_MountWatcher_event_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_MountWatcher_event_Results{Struct: r}, err
+}
+
+// Conmon_MountWatcher_done holds the state for a server call to Conmon_MountWatcher.done.
+// See server.Call for documentation.
+type Conmon_MountWatcher_done struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_MountWatcher_done) Args() Conmon_MountWatcher_done_Params {
+	return Conmon_MountWatcher_done_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_MountWatcher_done) AllocResults() (Conmon_MountWatcher_done_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_MountWatcher_done_Results{Struct: r}, err
+}
+
+type Conmon_MountWatcher_event_Params struct{ capnp.Struct }
+
+// Conmon_MountWatcher_event_Params_TypeID is the unique identifier for the type Conmon_MountWatcher_event_Params.
+const Conmon_MountWatcher_event_Params_TypeID = 0xd540a6df70b7ad2e
+
+func NewConmon_MountWatcher_event_Params(s *capnp.Segment) (Conmon_MountWatcher_event_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_MountWatcher_event_Params{st}, err
+}
+
+func NewRootConmon_MountWatcher_event_Params(s *capnp.Segment) (Conmon_MountWatcher_event_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_MountWatcher_event_Params{st}, err
+}
+
+func ReadRootConmon_MountWatcher_event_Params(msg *capnp.Message) (Conmon_MountWatcher_event_Params, error) {
+	root, err := msg.Root()
+	return Conmon_MountWatcher_event_Params{root.Struct()}, err
+}
+
+func (s Conmon_MountWatcher_event_Params) String() string {
+	str, _ := text.Marshal(0xd540a6df70b7ad2e, s.Struct)
+	return str
+}
+
+func (s Conmon_MountWatcher_event_Params) Event() (Conmon_MountEvent, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_MountEvent{Struct: p.Struct()}, err
+}
+
+func (s Conmon_MountWatcher_event_Params) HasEvent() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_MountWatcher_event_Params) SetEvent(v Conmon_MountEvent) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewEvent sets the event field to a newly
+// allocated Conmon_MountEvent struct, preferring placement in s's segment.
+func (s Conmon_MountWatcher_event_Params) NewEvent() (Conmon_MountEvent, error) {
+	ss, err := NewConmon_MountEvent(s.Struct.Segment())
+	if err != nil {
+		return Conmon_MountEvent{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_MountWatcher_event_Params_List is a list of Conmon_MountWatcher_event_Params.
+type Conmon_MountWatcher_event_Params_List = capnp.StructList[Conmon_MountWatcher_event_Params]
+
+// NewConmon_MountWatcher_event_Params creates a new list of Conmon_MountWatcher_event_Params.
+func NewConmon_MountWatcher_event_Params_List(s *capnp.Segment, sz int32) (Conmon_MountWatcher_event_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_MountWatcher_event_Params]{l}, err
+}
+
+// Conmon_MountWatcher_event_Params_Future is a wrapper for a Conmon_MountWatcher_event_Params promised by a client call.
+type Conmon_MountWatcher_event_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_MountWatcher_event_Params_Future) Struct() (Conmon_MountWatcher_event_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_MountWatcher_event_Params{s}, err
+}
+
+func (p Conmon_MountWatcher_event_Params_Future) Event() Conmon_MountEvent_Future {
+	return Conmon_MountEvent_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_MountWatcher_event_Results struct{ capnp.Struct }
+
+// Conmon_MountWatcher_event_Results_TypeID is the unique identifier for the type Conmon_MountWatcher_event_Results.
+const Conmon_MountWatcher_event_Results_TypeID = 0x9b5f6f6f36f0c785
+
+func NewConmon_MountWatcher_event_Results(s *capnp.Segment) (Conmon_MountWatcher_event_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_MountWatcher_event_Results{st}, err
+}
+
+func NewRootConmon_MountWatcher_event_Results(s *capnp.Segment) (Conmon_MountWatcher_event_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_MountWatcher_event_Results{st}, err
+}
+
+func ReadRootConmon_MountWatcher_event_Results(msg *capnp.Message) (Conmon_MountWatcher_event_Results, error) {
+	root, err := msg.Root()
+	return Conmon_MountWatcher_event_Results{root.Struct()}, err
+}
+
+func (s Conmon_MountWatcher_event_Results) String() string {
+	str, _ := text.Marshal(0x9b5f6f6f36f0c785, s.Struct)
+	return str
+}
+
+// Conmon_MountWatcher_event_Results_List is a list of Conmon_MountWatcher_event_Results.
+type Conmon_MountWatcher_event_Results_List = capnp.StructList[Conmon_MountWatcher_event_Results]
+
+// NewConmon_MountWatcher_event_Results creates a new list of Conmon_MountWatcher_event_Results.
+func NewConmon_MountWatcher_event_Results_List(s *capnp.Segment, sz int32) (Conmon_MountWatcher_event_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_MountWatcher_event_Results]{l}, err
+}
+
+// Conmon_MountWatcher_event_Results_Future is a wrapper for a Conmon_MountWatcher_event_Results promised by a client call.
+type Conmon_MountWatcher_event_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_MountWatcher_event_Results_Future) Struct() (Conmon_MountWatcher_event_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_MountWatcher_event_Results{s}, err
+}
+
+type Conmon_MountWatcher_done_Params struct{ capnp.Struct }
+
+// Conmon_MountWatcher_done_Params_TypeID is the unique identifier for the type Conmon_MountWatcher_done_Params.
+const Conmon_MountWatcher_done_Params_TypeID = 0xc1be5c9d05700c3f
+
+func NewConmon_MountWatcher_done_Params(s *capnp.Segment) (Conmon_MountWatcher_done_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_MountWatcher_done_Params{st}, err
+}
+
+func NewRootConmon_MountWatcher_done_Params(s *capnp.Segment) (Conmon_MountWatcher_done_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_MountWatcher_done_Params{st}, err
+}
+
+func ReadRootConmon_MountWatcher_done_Params(msg *capnp.Message) (Conmon_MountWatcher_done_Params, error) {
+	root, err := msg.Root()
+	return Conmon_MountWatcher_done_Params{root.Struct()}, err
+}
+
+func (s Conmon_MountWatcher_done_Params) String() string {
+	str, _ := text.Marshal(0xc1be5c9d05700c3f, s.Struct)
+	return str
+}
+
+func (s Conmon_MountWatcher_done_Params) Error() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_MountWatcher_done_Params) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_MountWatcher_done_Params) ErrorBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_MountWatcher_done_Params) SetError(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_MountWatcher_done_Params_List is a list of Conmon_MountWatcher_done_Params.
+type Conmon_MountWatcher_done_Params_List = capnp.StructList[Conmon_MountWatcher_done_Params]
+
+// NewConmon_MountWatcher_done_Params creates a new list of Conmon_MountWatcher_done_Params.
+func NewConmon_MountWatcher_done_Params_List(s *capnp.Segment, sz int32) (Conmon_MountWatcher_done_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_MountWatcher_done_Params]{l}, err
+}
+
+// Conmon_MountWatcher_done_Params_Future is a wrapper for a Conmon_MountWatcher_done_Params promised by a client call.
+type Conmon_MountWatcher_done_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_MountWatcher_done_Params_Future) Struct() (Conmon_MountWatcher_done_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_MountWatcher_done_Params{s}, err
+}
+
+type Conmon_MountWatcher_done_Results struct{ capnp.Struct }
+
+// Conmon_MountWatcher_done_Results_TypeID is the unique identifier for the type Conmon_MountWatcher_done_Results.
+const Conmon_MountWatcher_done_Results_TypeID = 0xc153f281de6e1fcf
+
+func NewConmon_MountWatcher_done_Results(s *capnp.Segment) (Conmon_MountWatcher_done_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_MountWatcher_done_Results{st}, err
+}
+
+func NewRootConmon_MountWatcher_done_Results(s *capnp.Segment) (Conmon_MountWatcher_done_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_MountWatcher_done_Results{st}, err
+}
+
+func ReadRootConmon_MountWatcher_done_Results(msg *capnp.Message) (Conmon_MountWatcher_done_Results, error) {
+	root, err := msg.Root()
+	return Conmon_MountWatcher_done_Results{root.Struct()}, err
+}
+
+func (s Conmon_MountWatcher_done_Results) String() string {
+	str, _ := text.Marshal(0xc153f281de6e1fcf, s.Struct)
+	return str
+}
+
+// Conmon_MountWatcher_done_Results_List is a list of Conmon_MountWatcher_done_Results.
+type Conmon_MountWatcher_done_Results_List = capnp.StructList[Conmon_MountWatcher_done_Results]
+
+// NewConmon_MountWatcher_done_Results creates a new list of Conmon_MountWatcher_done_Results.
+func NewConmon_MountWatcher_done_Results_List(s *capnp.Segment, sz int32) (Conmon_MountWatcher_done_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_MountWatcher_done_Results]{l}, err
+}
+
+// Conmon_MountWatcher_done_Results_Future is a wrapper for a Conmon_MountWatcher_done_Results promised by a client call.
+type Conmon_MountWatcher_done_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_MountWatcher_done_Results_Future) Struct() (Conmon_MountWatcher_done_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_MountWatcher_done_Results{s}, err
+}
+
+type Conmon_MountEvent struct{ capnp.Struct }
+
+// Conmon_MountEvent_TypeID is the unique identifier for the type Conmon_MountEvent.
+const Conmon_MountEvent_TypeID = 0xa6f4e4f5dcdf6711
+
+func NewConmon_MountEvent(s *capnp.Segment) (Conmon_MountEvent, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Conmon_MountEvent{st}, err
+}
+
+func NewRootConmon_MountEvent(s *capnp.Segment) (Conmon_MountEvent, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Conmon_MountEvent{st}, err
+}
+
+func ReadRootConmon_MountEvent(msg *capnp.Message) (Conmon_MountEvent, error) {
+	root, err := msg.Root()
+	return Conmon_MountEvent{root.Struct()}, err
+}
+
+func (s Conmon_MountEvent) String() string {
+	str, _ := text.Marshal(0xa6f4e4f5dcdf6711, s.Struct)
+	return str
+}
+
+func (s Conmon_MountEvent) Type() Conmon_MountEvent_Type {
+	return Conmon_MountEvent_Type(s.Struct.Uint16(0))
+}
+
+func (s Conmon_MountEvent) SetType(v Conmon_MountEvent_Type) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Conmon_MountEvent) MountPoint() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_MountEvent) HasMountPoint() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_MountEvent) MountPointBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_MountEvent) SetMountPoint(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_MountEvent) Source() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_MountEvent) HasSource() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_MountEvent) SourceBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_MountEvent) SetSource(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_MountEvent) FsType() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s Conmon_MountEvent) HasFsType() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_MountEvent) FsTypeBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_MountEvent) SetFsType(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
+func (s Conmon_MountEvent) Options() (string, error) {
+	p, err := s.Struct.Ptr(3)
+	return p.Text(), err
+}
+
+func (s Conmon_MountEvent) HasOptions() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Conmon_MountEvent) OptionsBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(3)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_MountEvent) SetOptions(v string) error {
+	return s.Struct.SetText(3, v)
+}
+
+// Conmon_MountEvent_List is a list of Conmon_MountEvent.
+type Conmon_MountEvent_List = capnp.StructList[Conmon_MountEvent]
+
+// NewConmon_MountEvent creates a new list of Conmon_MountEvent.
+func NewConmon_MountEvent_List(s *capnp.Segment, sz int32) (Conmon_MountEvent_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4}, sz)
+	return capnp.StructList[Conmon_MountEvent]{l}, err
+}
+
+// Conmon_MountEvent_Future is a wrapper for a Conmon_MountEvent promised by a client call.
+type Conmon_MountEvent_Future struct{ *capnp.Future }
+
+func (p Conmon_MountEvent_Future) Struct() (Conmon_MountEvent, error) {
+	s, err := p.Future.Struct()
+	return Conmon_MountEvent{s}, err
+}
+
+type Conmon_MountEvent_Type uint16
+
+// Conmon_MountEvent_Type_TypeID is the unique identifier for the type Conmon_MountEvent_Type.
+const Conmon_MountEvent_Type_TypeID = 0xf9de99d1fab38e05
+
+// Values of Conmon_MountEvent_Type.
+const (
+	Conmon_MountEvent_Type_mount   Conmon_MountEvent_Type = 0
+	Conmon_MountEvent_Type_unmount Conmon_MountEvent_Type = 1
+)
+
+// String returns the enum's constant name.
+func (c Conmon_MountEvent_Type) String() string {
+	switch c {
+	case Conmon_MountEvent_Type_mount:
+		return "mount"
+	case Conmon_MountEvent_Type_unmount:
+		return "unmount"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_MountEvent_TypeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_MountEvent_TypeFromString(c string) Conmon_MountEvent_Type {
+	switch c {
+	case "mount":
+		return Conmon_MountEvent_Type_mount
+	case "unmount":
+		return Conmon_MountEvent_Type_unmount
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_MountEvent_Type_List = capnp.EnumList[Conmon_MountEvent_Type]
+
+func NewConmon_MountEvent_Type_List(s *capnp.Segment, sz int32) (Conmon_MountEvent_Type_List, error) {
+	return capnp.NewEnumList[Conmon_MountEvent_Type](s, sz)
+}
+
+type Conmon_WatchQuotaRequest struct{ capnp.Struct }
+
+// Conmon_WatchQuotaRequest_TypeID is the unique identifier for the type Conmon_WatchQuotaRequest.
+const Conmon_WatchQuotaRequest_TypeID = 0xbe1b87da3e2eae84
+
+func NewConmon_WatchQuotaRequest(s *capnp.Segment) (Conmon_WatchQuotaRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Conmon_WatchQuotaRequest{st}, err
+}
+
+func NewRootConmon_WatchQuotaRequest(s *capnp.Segment) (Conmon_WatchQuotaRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Conmon_WatchQuotaRequest{st}, err
+}
+
+func ReadRootConmon_WatchQuotaRequest(msg *capnp.Message) (Conmon_WatchQuotaRequest, error) {
+	root, err := msg.Root()
+	return Conmon_WatchQuotaRequest{root.Struct()}, err
+}
+
+func (s Conmon_WatchQuotaRequest) String() string {
+	str, _ := text.Marshal(0xbe1b87da3e2eae84, s.Struct)
+	return str
+}
+
+func (s Conmon_WatchQuotaRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_WatchQuotaRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_WatchQuotaRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_WatchQuotaRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_WatchQuotaRequest) Path() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_WatchQuotaRequest) HasPath() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_WatchQuotaRequest) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_WatchQuotaRequest) SetPath(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_WatchQuotaRequest) Thresholds() (Conmon_QuotaThreshold_List, error) {
+	p, err := s.Struct.Ptr(2)
+	return Conmon_QuotaThreshold_List{List: p.List()}, err
+}
+
+func (s Conmon_WatchQuotaRequest) HasThresholds() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_WatchQuotaRequest) SetThresholds(v Conmon_QuotaThreshold_List) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
+}
+
+// NewThresholds sets the thresholds field to a newly
+// allocated Conmon_QuotaThreshold_List, preferring placement in s's segment.
+func (s Conmon_WatchQuotaRequest) NewThresholds(n int32) (Conmon_QuotaThreshold_List, error) {
+	l, err := NewConmon_QuotaThreshold_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_QuotaThreshold_List{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_WatchQuotaRequest) IntervalSec() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_WatchQuotaRequest) SetIntervalSec(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_WatchQuotaRequest) Watcher() Conmon_QuotaWatcher {
+	p, _ := s.Struct.Ptr(3)
+	return Conmon_QuotaWatcher{Client: p.Interface().Client()}
+}
+
+func (s Conmon_WatchQuotaRequest) HasWatcher() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Conmon_WatchQuotaRequest) SetWatcher(v Conmon_QuotaWatcher) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(3, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(3, in.ToPtr())
+}
+
+// Conmon_WatchQuotaRequest_List is a list of Conmon_WatchQuotaRequest.
+type Conmon_WatchQuotaRequest_List = capnp.StructList[Conmon_WatchQuotaRequest]
+
+// NewConmon_WatchQuotaRequest creates a new list of Conmon_WatchQuotaRequest.
+func NewConmon_WatchQuotaRequest_List(s *capnp.Segment, sz int32) (Conmon_WatchQuotaRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4}, sz)
+	return capnp.StructList[Conmon_WatchQuotaRequest]{l}, err
+}
+
+// Conmon_WatchQuotaRequest_Future is a wrapper for a Conmon_WatchQuotaRequest promised by a client call.
+type Conmon_WatchQuotaRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_WatchQuotaRequest_Future) Struct() (Conmon_WatchQuotaRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_WatchQuotaRequest{s}, err
+}
+
+func (p Conmon_WatchQuotaRequest_Future) Watcher() Conmon_QuotaWatcher {
+	return Conmon_QuotaWatcher{Client: p.Future.Field(3, nil).Client()}
+}
+
+type Conmon_QuotaThreshold struct{ capnp.Struct }
+
+// Conmon_QuotaThreshold_TypeID is the unique identifier for the type Conmon_QuotaThreshold.
+const Conmon_QuotaThreshold_TypeID = 0xfb4ebd2f1be74feb
+
+func NewConmon_QuotaThreshold(s *capnp.Segment) (Conmon_QuotaThreshold, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Conmon_QuotaThreshold{st}, err
+}
+
+func NewRootConmon_QuotaThreshold(s *capnp.Segment) (Conmon_QuotaThreshold, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Conmon_QuotaThreshold{st}, err
+}
+
+func ReadRootConmon_QuotaThreshold(msg *capnp.Message) (Conmon_QuotaThreshold, error) {
+	root, err := msg.Root()
+	return Conmon_QuotaThreshold{root.Struct()}, err
+}
+
+func (s Conmon_QuotaThreshold) String() string {
+	str, _ := text.Marshal(0xfb4ebd2f1be74feb, s.Struct)
+	return str
+}
+
+func (s Conmon_QuotaThreshold) Bytes() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_QuotaThreshold) SetBytes(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+// Conmon_QuotaThreshold_List is a list of Conmon_QuotaThreshold.
+type Conmon_QuotaThreshold_List = capnp.StructList[Conmon_QuotaThreshold]
+
+// NewConmon_QuotaThreshold creates a new list of Conmon_QuotaThreshold.
+func NewConmon_QuotaThreshold_List(s *capnp.Segment, sz int32) (Conmon_QuotaThreshold_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_QuotaThreshold]{l}, err
+}
+
+// Conmon_QuotaThreshold_Future is a wrapper for a Conmon_QuotaThreshold promised by a client call.
+type Conmon_QuotaThreshold_Future struct{ *capnp.Future }
+
+func (p Conmon_QuotaThreshold_Future) Struct() (Conmon_QuotaThreshold, error) {
+	s, err := p.Future.Struct()
+	return Conmon_QuotaThreshold{s}, err
+}
+
+type Conmon_WatchQuotaResponse struct{ capnp.Struct }
+
+// Conmon_WatchQuotaResponse_TypeID is the unique identifier for the type Conmon_WatchQuotaResponse.
+const Conmon_WatchQuotaResponse_TypeID = 0xecbee01cad589e46
+
+func NewConmon_WatchQuotaResponse(s *capnp.Segment) (Conmon_WatchQuotaResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_WatchQuotaResponse{st}, err
+}
+
+func NewRootConmon_WatchQuotaResponse(s *capnp.Segment) (Conmon_WatchQuotaResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_WatchQuotaResponse{st}, err
+}
+
+func ReadRootConmon_WatchQuotaResponse(msg *capnp.Message) (Conmon_WatchQuotaResponse, error) {
+	root, err := msg.Root()
+	return Conmon_WatchQuotaResponse{root.Struct()}, err
+}
+
+func (s Conmon_WatchQuotaResponse) String() string {
+	str, _ := text.Marshal(0xecbee01cad589e46, s.Struct)
+	return str
+}
+
+// Conmon_WatchQuotaResponse_List is a list of Conmon_WatchQuotaResponse.
+type Conmon_WatchQuotaResponse_List = capnp.StructList[Conmon_WatchQuotaResponse]
+
+// NewConmon_WatchQuotaResponse creates a new list of Conmon_WatchQuotaResponse.
+func NewConmon_WatchQuotaResponse_List(s *capnp.Segment, sz int32) (Conmon_WatchQuotaResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_WatchQuotaResponse]{l}, err
+}
+
+// Conmon_WatchQuotaResponse_Future is a wrapper for a Conmon_WatchQuotaResponse promised by a client call.
+type Conmon_WatchQuotaResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_WatchQuotaResponse_Future) Struct() (Conmon_WatchQuotaResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_WatchQuotaResponse{s}, err
+}
+
+type Conmon_QuotaWatcher struct{ Client *capnp.Client }
+
+// Conmon_QuotaWatcher_TypeID is the unique identifier for the type Conmon_QuotaWatcher.
+const Conmon_QuotaWatcher_TypeID = 0xc16fddcfb5be823f
+
+func (c Conmon_QuotaWatcher) Event(ctx context.Context, params func(Conmon_QuotaWatcher_event_Params) error) (Conmon_QuotaWatcher_event_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc16fddcfb5be823f,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.QuotaWatcher",
+			MethodName:    "event",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_QuotaWatcher_event_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_QuotaWatcher_event_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon_QuotaWatcher) Done(ctx context.Context, params func(Conmon_QuotaWatcher_done_Params) error) (Conmon_QuotaWatcher_done_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xc16fddcfb5be823f,
+			MethodID:      1,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.QuotaWatcher",
+			MethodName:    "done",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_QuotaWatcher_done_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_QuotaWatcher_done_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Conmon_QuotaWatcher) AddRef() Conmon_QuotaWatcher {
+	return Conmon_QuotaWatcher{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Conmon_QuotaWatcher) Release() {
+	c.Client.Release()
+}
+
+// A Conmon_QuotaWatcher_Server is a Conmon_QuotaWatcher with a local implementation.
+type Conmon_QuotaWatcher_Server interface {
+	Event(context.Context, Conmon_QuotaWatcher_event) error
+
+	Done(context.Context, Conmon_QuotaWatcher_done) error
+}
+
+// Conmon_QuotaWatcher_NewServer creates a new Server from an implementation of Conmon_QuotaWatcher_Server.
+func Conmon_QuotaWatcher_NewServer(s Conmon_QuotaWatcher_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Conmon_QuotaWatcher_Methods(nil, s), s, c, policy)
+}
+
+// Conmon_QuotaWatcher_ServerToClient creates a new Client from an implementation of Conmon_QuotaWatcher_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Conmon_QuotaWatcher_ServerToClient(s Conmon_QuotaWatcher_Server, policy *server.Policy) Conmon_QuotaWatcher {
+	return Conmon_QuotaWatcher{Client: capnp.NewClient(Conmon_QuotaWatcher_NewServer(s, policy))}
+}
+
+// Conmon_QuotaWatcher_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Conmon_QuotaWatcher_Methods(methods []server.Method, s Conmon_QuotaWatcher_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc16fddcfb5be823f,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.QuotaWatcher",
+			MethodName:    "event",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Event(ctx, Conmon_QuotaWatcher_event{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xc16fddcfb5be823f,
+			MethodID:      1,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.QuotaWatcher",
+			MethodName:    "done",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Done(ctx, Conmon_QuotaWatcher_done{call})
+		},
+	})
+
+	return methods
+}
+
+// Conmon_QuotaWatcher_event holds the state for a server call to Conmon_QuotaWatcher.event.
+// See server.Call for documentation.
+type Conmon_QuotaWatcher_event struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_QuotaWatcher_event) Args() Conmon_QuotaWatcher_event_Params {
+	return Conmon_QuotaWatcher_event_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_QuotaWatcher_event) AllocResults() (Conmon_QuotaWatcher_event_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_QuotaWatcher_event_Results{Struct: r}, err
+}
+
+// Conmon_QuotaWatcher_done holds the state for a server call to Conmon_QuotaWatcher.done.
+// See server.Call for documentation.
+type Conmon_QuotaWatcher_done struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_QuotaWatcher_done) Args() Conmon_QuotaWatcher_done_Params {
+	return Conmon_QuotaWatcher_done_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_QuotaWatcher_done) AllocResults() (Conmon_QuotaWatcher_done_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_QuotaWatcher_done_Results{Struct: r}, err
+}
+
+type Conmon_QuotaWatcher_event_Params struct{ capnp.Struct }
+
+// Conmon_QuotaWatcher_event_Params_TypeID is the unique identifier for the type Conmon_QuotaWatcher_event_Params.
+const Conmon_QuotaWatcher_event_Params_TypeID = 0x8f14b14bb946d04a
+
+func NewConmon_QuotaWatcher_event_Params(s *capnp.Segment) (Conmon_QuotaWatcher_event_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_QuotaWatcher_event_Params{st}, err
+}
+
+func NewRootConmon_QuotaWatcher_event_Params(s *capnp.Segment) (Conmon_QuotaWatcher_event_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_QuotaWatcher_event_Params{st}, err
+}
+
+func ReadRootConmon_QuotaWatcher_event_Params(msg *capnp.Message) (Conmon_QuotaWatcher_event_Params, error) {
+	root, err := msg.Root()
+	return Conmon_QuotaWatcher_event_Params{root.Struct()}, err
+}
+
+func (s Conmon_QuotaWatcher_event_Params) String() string {
+	str, _ := text.Marshal(0x8f14b14bb946d04a, s.Struct)
+	return str
+}
+
+func (s Conmon_QuotaWatcher_event_Params) Event() (Conmon_QuotaEvent, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_QuotaEvent{Struct: p.Struct()}, err
+}
+
+func (s Conmon_QuotaWatcher_event_Params) HasEvent() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_QuotaWatcher_event_Params) SetEvent(v Conmon_QuotaEvent) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewEvent sets the event field to a newly
+// allocated Conmon_QuotaEvent struct, preferring placement in s's segment.
+func (s Conmon_QuotaWatcher_event_Params) NewEvent() (Conmon_QuotaEvent, error) {
+	ss, err := NewConmon_QuotaEvent(s.Struct.Segment())
+	if err != nil {
+		return Conmon_QuotaEvent{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_QuotaWatcher_event_Params_List is a list of Conmon_QuotaWatcher_event_Params.
+type Conmon_QuotaWatcher_event_Params_List = capnp.StructList[Conmon_QuotaWatcher_event_Params]
+
+// NewConmon_QuotaWatcher_event_Params creates a new list of Conmon_QuotaWatcher_event_Params.
+func NewConmon_QuotaWatcher_event_Params_List(s *capnp.Segment, sz int32) (Conmon_QuotaWatcher_event_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_QuotaWatcher_event_Params]{l}, err
+}
+
+// Conmon_QuotaWatcher_event_Params_Future is a wrapper for a Conmon_QuotaWatcher_event_Params promised by a client call.
+type Conmon_QuotaWatcher_event_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_QuotaWatcher_event_Params_Future) Struct() (Conmon_QuotaWatcher_event_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_QuotaWatcher_event_Params{s}, err
+}
+
+func (p Conmon_QuotaWatcher_event_Params_Future) Event() Conmon_QuotaEvent_Future {
+	return Conmon_QuotaEvent_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_QuotaWatcher_event_Results struct{ capnp.Struct }
+
+// Conmon_QuotaWatcher_event_Results_TypeID is the unique identifier for the type Conmon_QuotaWatcher_event_Results.
+const Conmon_QuotaWatcher_event_Results_TypeID = 0xc33c4cc3fbe42de7
+
+func NewConmon_QuotaWatcher_event_Results(s *capnp.Segment) (Conmon_QuotaWatcher_event_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_QuotaWatcher_event_Results{st}, err
+}
+
+func NewRootConmon_QuotaWatcher_event_Results(s *capnp.Segment) (Conmon_QuotaWatcher_event_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_QuotaWatcher_event_Results{st}, err
+}
+
+func ReadRootConmon_QuotaWatcher_event_Results(msg *capnp.Message) (Conmon_QuotaWatcher_event_Results, error) {
+	root, err := msg.Root()
+	return Conmon_QuotaWatcher_event_Results{root.Struct()}, err
+}
+
+func (s Conmon_QuotaWatcher_event_Results) String() string {
+	str, _ := text.Marshal(0xc33c4cc3fbe42de7, s.Struct)
+	return str
+}
+
+// Conmon_QuotaWatcher_event_Results_List is a list of Conmon_QuotaWatcher_event_Results.
+type Conmon_QuotaWatcher_event_Results_List = capnp.StructList[Conmon_QuotaWatcher_event_Results]
+
+// NewConmon_QuotaWatcher_event_Results creates a new list of Conmon_QuotaWatcher_event_Results.
+func NewConmon_QuotaWatcher_event_Results_List(s *capnp.Segment, sz int32) (Conmon_QuotaWatcher_event_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_QuotaWatcher_event_Results]{l}, err
+}
+
+// Conmon_QuotaWatcher_event_Results_Future is a wrapper for a Conmon_QuotaWatcher_event_Results promised by a client call.
+type Conmon_QuotaWatcher_event_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_QuotaWatcher_event_Results_Future) Struct() (Conmon_QuotaWatcher_event_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_QuotaWatcher_event_Results{s}, err
+}
+
+type Conmon_QuotaWatcher_done_Params struct{ capnp.Struct }
+
+// Conmon_QuotaWatcher_done_Params_TypeID is the unique identifier for the type Conmon_QuotaWatcher_done_Params.
+const Conmon_QuotaWatcher_done_Params_TypeID = 0x9ef241db2f0da0a2
+
+func NewConmon_QuotaWatcher_done_Params(s *capnp.Segment) (Conmon_QuotaWatcher_done_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_QuotaWatcher_done_Params{st}, err
+}
+
+func NewRootConmon_QuotaWatcher_done_Params(s *capnp.Segment) (Conmon_QuotaWatcher_done_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_QuotaWatcher_done_Params{st}, err
+}
+
+func ReadRootConmon_QuotaWatcher_done_Params(msg *capnp.Message) (Conmon_QuotaWatcher_done_Params, error) {
+	root, err := msg.Root()
+	return Conmon_QuotaWatcher_done_Params{root.Struct()}, err
+}
+
+func (s Conmon_QuotaWatcher_done_Params) String() string {
+	str, _ := text.Marshal(0x9ef241db2f0da0a2, s.Struct)
+	return str
+}
+
+func (s Conmon_QuotaWatcher_done_Params) Error() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_QuotaWatcher_done_Params) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_QuotaWatcher_done_Params) ErrorBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_QuotaWatcher_done_Params) SetError(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_QuotaWatcher_done_Params_List is a list of Conmon_QuotaWatcher_done_Params.
+type Conmon_QuotaWatcher_done_Params_List = capnp.StructList[Conmon_QuotaWatcher_done_Params]
+
+// NewConmon_QuotaWatcher_done_Params creates a new list of Conmon_QuotaWatcher_done_Params.
+func NewConmon_QuotaWatcher_done_Params_List(s *capnp.Segment, sz int32) (Conmon_QuotaWatcher_done_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_QuotaWatcher_done_Params]{l}, err
+}
+
+// Conmon_QuotaWatcher_done_Params_Future is a wrapper for a Conmon_QuotaWatcher_done_Params promised by a client call.
+type Conmon_QuotaWatcher_done_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_QuotaWatcher_done_Params_Future) Struct() (Conmon_QuotaWatcher_done_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_QuotaWatcher_done_Params{s}, err
+}
+
+type Conmon_QuotaWatcher_done_Results struct{ capnp.Struct }
+
+// Conmon_QuotaWatcher_done_Results_TypeID is the unique identifier for the type Conmon_QuotaWatcher_done_Results.
+const Conmon_QuotaWatcher_done_Results_TypeID = 0xb5ff0b0049002785
+
+func NewConmon_QuotaWatcher_done_Results(s *capnp.Segment) (Conmon_QuotaWatcher_done_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_QuotaWatcher_done_Results{st}, err
+}
+
+func NewRootConmon_QuotaWatcher_done_Results(s *capnp.Segment) (Conmon_QuotaWatcher_done_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_QuotaWatcher_done_Results{st}, err
+}
+
+func ReadRootConmon_QuotaWatcher_done_Results(msg *capnp.Message) (Conmon_QuotaWatcher_done_Results, error) {
+	root, err := msg.Root()
+	return Conmon_QuotaWatcher_done_Results{root.Struct()}, err
+}
+
+func (s Conmon_QuotaWatcher_done_Results) String() string {
+	str, _ := text.Marshal(0xb5ff0b0049002785, s.Struct)
+	return str
+}
+
+// Conmon_QuotaWatcher_done_Results_List is a list of Conmon_QuotaWatcher_done_Results.
+type Conmon_QuotaWatcher_done_Results_List = capnp.StructList[Conmon_QuotaWatcher_done_Results]
+
+// NewConmon_QuotaWatcher_done_Results creates a new list of Conmon_QuotaWatcher_done_Results.
+func NewConmon_QuotaWatcher_done_Results_List(s *capnp.Segment, sz int32) (Conmon_QuotaWatcher_done_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_QuotaWatcher_done_Results]{l}, err
+}
+
+// Conmon_QuotaWatcher_done_Results_Future is a wrapper for a Conmon_QuotaWatcher_done_Results promised by a client call.
+type Conmon_QuotaWatcher_done_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_QuotaWatcher_done_Results_Future) Struct() (Conmon_QuotaWatcher_done_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_QuotaWatcher_done_Results{s}, err
+}
+
+type Conmon_QuotaEvent struct{ capnp.Struct }
+
+// Conmon_QuotaEvent_TypeID is the unique identifier for the type Conmon_QuotaEvent.
+const Conmon_QuotaEvent_TypeID = 0xaaca00bdc6db2092
+
+func NewConmon_QuotaEvent(s *capnp.Segment) (Conmon_QuotaEvent, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Conmon_QuotaEvent{st}, err
+}
+
+func NewRootConmon_QuotaEvent(s *capnp.Segment) (Conmon_QuotaEvent, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Conmon_QuotaEvent{st}, err
+}
+
+func ReadRootConmon_QuotaEvent(msg *capnp.Message) (Conmon_QuotaEvent, error) {
+	root, err := msg.Root()
+	return Conmon_QuotaEvent{root.Struct()}, err
+}
+
+func (s Conmon_QuotaEvent) String() string {
+	str, _ := text.Marshal(0xaaca00bdc6db2092, s.Struct)
+	return str
+}
+
+func (s Conmon_QuotaEvent) Path() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_QuotaEvent) HasPath() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_QuotaEvent) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_QuotaEvent) SetPath(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_QuotaEvent) ThresholdBytes() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_QuotaEvent) SetThresholdBytes(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_QuotaEvent) UsageBytes() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_QuotaEvent) SetUsageBytes(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_QuotaEvent) Exceeded() bool {
+	return s.Struct.Bit(128)
+}
+
+func (s Conmon_QuotaEvent) SetExceeded(v bool) {
+	s.Struct.SetBit(128, v)
+}
+
+// Conmon_QuotaEvent_List is a list of Conmon_QuotaEvent.
+type Conmon_QuotaEvent_List = capnp.StructList[Conmon_QuotaEvent]
+
+// NewConmon_QuotaEvent creates a new list of Conmon_QuotaEvent.
+func NewConmon_QuotaEvent_List(s *capnp.Segment, sz int32) (Conmon_QuotaEvent_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_QuotaEvent]{l}, err
+}
+
+// Conmon_QuotaEvent_Future is a wrapper for a Conmon_QuotaEvent promised by a client call.
+type Conmon_QuotaEvent_Future struct{ *capnp.Future }
+
+func (p Conmon_QuotaEvent_Future) Struct() (Conmon_QuotaEvent, error) {
+	s, err := p.Future.Struct()
+	return Conmon_QuotaEvent{s}, err
+}
+
+type Conmon_ListAttachSessionsRequest struct{ capnp.Struct }
+
+// Conmon_ListAttachSessionsRequest_TypeID is the unique identifier for the type Conmon_ListAttachSessionsRequest.
+const Conmon_ListAttachSessionsRequest_TypeID = 0xb9b7756057da8dbf
+
+func NewConmon_ListAttachSessionsRequest(s *capnp.Segment) (Conmon_ListAttachSessionsRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ListAttachSessionsRequest{st}, err
+}
+
+func NewRootConmon_ListAttachSessionsRequest(s *capnp.Segment) (Conmon_ListAttachSessionsRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ListAttachSessionsRequest{st}, err
+}
+
+func ReadRootConmon_ListAttachSessionsRequest(msg *capnp.Message) (Conmon_ListAttachSessionsRequest, error) {
+	root, err := msg.Root()
+	return Conmon_ListAttachSessionsRequest{root.Struct()}, err
+}
+
+func (s Conmon_ListAttachSessionsRequest) String() string {
+	str, _ := text.Marshal(0xb9b7756057da8dbf, s.Struct)
+	return str
+}
+
+func (s Conmon_ListAttachSessionsRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ListAttachSessionsRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ListAttachSessionsRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ListAttachSessionsRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_ListAttachSessionsRequest_List is a list of Conmon_ListAttachSessionsRequest.
+type Conmon_ListAttachSessionsRequest_List = capnp.StructList[Conmon_ListAttachSessionsRequest]
+
+// NewConmon_ListAttachSessionsRequest creates a new list of Conmon_ListAttachSessionsRequest.
+func NewConmon_ListAttachSessionsRequest_List(s *capnp.Segment, sz int32) (Conmon_ListAttachSessionsRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ListAttachSessionsRequest]{l}, err
+}
+
+// Conmon_ListAttachSessionsRequest_Future is a wrapper for a Conmon_ListAttachSessionsRequest promised by a client call.
+type Conmon_ListAttachSessionsRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_ListAttachSessionsRequest_Future) Struct() (Conmon_ListAttachSessionsRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ListAttachSessionsRequest{s}, err
+}
+
+type Conmon_ListAttachSessionsResponse struct{ capnp.Struct }
+
+// Conmon_ListAttachSessionsResponse_TypeID is the unique identifier for the type Conmon_ListAttachSessionsResponse.
+const Conmon_ListAttachSessionsResponse_TypeID = 0x8b78c63c526a4540
+
+func NewConmon_ListAttachSessionsResponse(s *capnp.Segment) (Conmon_ListAttachSessionsResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ListAttachSessionsResponse{st}, err
+}
+
+func NewRootConmon_ListAttachSessionsResponse(s *capnp.Segment) (Conmon_ListAttachSessionsResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ListAttachSessionsResponse{st}, err
+}
+
+func ReadRootConmon_ListAttachSessionsResponse(msg *capnp.Message) (Conmon_ListAttachSessionsResponse, error) {
+	root, err := msg.Root()
+	return Conmon_ListAttachSessionsResponse{root.Struct()}, err
+}
+
+func (s Conmon_ListAttachSessionsResponse) String() string {
+	str, _ := text.Marshal(0x8b78c63c526a4540, s.Struct)
+	return str
+}
+
+func (s Conmon_ListAttachSessionsResponse) Sessions() (Conmon_AttachSession_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_AttachSession_List{List: p.List()}, err
+}
+
+func (s Conmon_ListAttachSessionsResponse) HasSessions() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ListAttachSessionsResponse) SetSessions(v Conmon_AttachSession_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewSessions sets the sessions field to a newly
+// allocated Conmon_AttachSession_List, preferring placement in s's segment.
+func (s Conmon_ListAttachSessionsResponse) NewSessions(n int32) (Conmon_AttachSession_List, error) {
+	l, err := NewConmon_AttachSession_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_AttachSession_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_ListAttachSessionsResponse_List is a list of Conmon_ListAttachSessionsResponse.
+type Conmon_ListAttachSessionsResponse_List = capnp.StructList[Conmon_ListAttachSessionsResponse]
+
+// NewConmon_ListAttachSessionsResponse creates a new list of Conmon_ListAttachSessionsResponse.
+func NewConmon_ListAttachSessionsResponse_List(s *capnp.Segment, sz int32) (Conmon_ListAttachSessionsResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ListAttachSessionsResponse]{l}, err
+}
+
+// Conmon_ListAttachSessionsResponse_Future is a wrapper for a Conmon_ListAttachSessionsResponse promised by a client call.
+type Conmon_ListAttachSessionsResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_ListAttachSessionsResponse_Future) Struct() (Conmon_ListAttachSessionsResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ListAttachSessionsResponse{s}, err
+}
+
+type Conmon_AttachSession struct{ capnp.Struct }
+
+// Conmon_AttachSession_TypeID is the unique identifier for the type Conmon_AttachSession.
+const Conmon_AttachSession_TypeID = 0xc559d59901c70e15
+
+func NewConmon_AttachSession(s *capnp.Segment) (Conmon_AttachSession, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
+	return Conmon_AttachSession{st}, err
+}
+
+func NewRootConmon_AttachSession(s *capnp.Segment) (Conmon_AttachSession, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
+	return Conmon_AttachSession{st}, err
+}
+
+func ReadRootConmon_AttachSession(msg *capnp.Message) (Conmon_AttachSession, error) {
+	root, err := msg.Root()
+	return Conmon_AttachSession{root.Struct()}, err
+}
+
+func (s Conmon_AttachSession) String() string {
+	str, _ := text.Marshal(0xc559d59901c70e15, s.Struct)
+	return str
+}
+
+func (s Conmon_AttachSession) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_AttachSession) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_AttachSession) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_AttachSession) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_AttachSession) SocketPath() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_AttachSession) HasSocketPath() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_AttachSession) SocketPathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_AttachSession) SetSocketPath(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_AttachSession) ConnectedAt() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_AttachSession) SetConnectedAt(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_AttachSession) Uid() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s Conmon_AttachSession) SetUid(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+func (s Conmon_AttachSession) Gid() uint32 {
+	return s.Struct.Uint32(12)
+}
+
+func (s Conmon_AttachSession) SetGid(v uint32) {
+	s.Struct.SetUint32(12, v)
+}
+
+func (s Conmon_AttachSession) Pid() int32 {
+	return int32(s.Struct.Uint32(16))
+}
+
+func (s Conmon_AttachSession) SetPid(v int32) {
+	s.Struct.SetUint32(16, uint32(v))
+}
+
+// Conmon_AttachSession_List is a list of Conmon_AttachSession.
+type Conmon_AttachSession_List = capnp.StructList[Conmon_AttachSession]
+
+// NewConmon_AttachSession creates a new list of Conmon_AttachSession.
+func NewConmon_AttachSession_List(s *capnp.Segment, sz int32) (Conmon_AttachSession_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_AttachSession]{l}, err
+}
+
+// Conmon_AttachSession_Future is a wrapper for a Conmon_AttachSession promised by a client call.
+type Conmon_AttachSession_Future struct{ *capnp.Future }
+
+func (p Conmon_AttachSession_Future) Struct() (Conmon_AttachSession, error) {
+	s, err := p.Future.Struct()
+	return Conmon_AttachSession{s}, err
+}
+
+type Conmon_KillAttachSessionRequest struct{ capnp.Struct }
+
+// Conmon_KillAttachSessionRequest_TypeID is the unique identifier for the type Conmon_KillAttachSessionRequest.
+const Conmon_KillAttachSessionRequest_TypeID = 0x9c06ae6cf17a2ab0
+
+func NewConmon_KillAttachSessionRequest(s *capnp.Segment) (Conmon_KillAttachSessionRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_KillAttachSessionRequest{st}, err
+}
+
+func NewRootConmon_KillAttachSessionRequest(s *capnp.Segment) (Conmon_KillAttachSessionRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_KillAttachSessionRequest{st}, err
+}
+
+func ReadRootConmon_KillAttachSessionRequest(msg *capnp.Message) (Conmon_KillAttachSessionRequest, error) {
+	root, err := msg.Root()
+	return Conmon_KillAttachSessionRequest{root.Struct()}, err
+}
+
+func (s Conmon_KillAttachSessionRequest) String() string {
+	str, _ := text.Marshal(0x9c06ae6cf17a2ab0, s.Struct)
+	return str
+}
+
+func (s Conmon_KillAttachSessionRequest) SessionId() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_KillAttachSessionRequest) HasSessionId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_KillAttachSessionRequest) SessionIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_KillAttachSessionRequest) SetSessionId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_KillAttachSessionRequest_List is a list of Conmon_KillAttachSessionRequest.
+type Conmon_KillAttachSessionRequest_List = capnp.StructList[Conmon_KillAttachSessionRequest]
+
+// NewConmon_KillAttachSessionRequest creates a new list of Conmon_KillAttachSessionRequest.
+func NewConmon_KillAttachSessionRequest_List(s *capnp.Segment, sz int32) (Conmon_KillAttachSessionRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_KillAttachSessionRequest]{l}, err
+}
+
+// Conmon_KillAttachSessionRequest_Future is a wrapper for a Conmon_KillAttachSessionRequest promised by a client call.
+type Conmon_KillAttachSessionRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_KillAttachSessionRequest_Future) Struct() (Conmon_KillAttachSessionRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_KillAttachSessionRequest{s}, err
+}
+
+type Conmon_KillAttachSessionResponse struct{ capnp.Struct }
+
+// Conmon_KillAttachSessionResponse_TypeID is the unique identifier for the type Conmon_KillAttachSessionResponse.
+const Conmon_KillAttachSessionResponse_TypeID = 0xfd2ae33e75dc9a9a
+
+func NewConmon_KillAttachSessionResponse(s *capnp.Segment) (Conmon_KillAttachSessionResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_KillAttachSessionResponse{st}, err
+}
+
+func NewRootConmon_KillAttachSessionResponse(s *capnp.Segment) (Conmon_KillAttachSessionResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_KillAttachSessionResponse{st}, err
+}
+
+func ReadRootConmon_KillAttachSessionResponse(msg *capnp.Message) (Conmon_KillAttachSessionResponse, error) {
+	root, err := msg.Root()
+	return Conmon_KillAttachSessionResponse{root.Struct()}, err
+}
+
+func (s Conmon_KillAttachSessionResponse) String() string {
+	str, _ := text.Marshal(0xfd2ae33e75dc9a9a, s.Struct)
+	return str
+}
+
+// Conmon_KillAttachSessionResponse_List is a list of Conmon_KillAttachSessionResponse.
+type Conmon_KillAttachSessionResponse_List = capnp.StructList[Conmon_KillAttachSessionResponse]
+
+// NewConmon_KillAttachSessionResponse creates a new list of Conmon_KillAttachSessionResponse.
+func NewConmon_KillAttachSessionResponse_List(s *capnp.Segment, sz int32) (Conmon_KillAttachSessionResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_KillAttachSessionResponse]{l}, err
+}
+
+// Conmon_KillAttachSessionResponse_Future is a wrapper for a Conmon_KillAttachSessionResponse promised by a client call.
+type Conmon_KillAttachSessionResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_KillAttachSessionResponse_Future) Struct() (Conmon_KillAttachSessionResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_KillAttachSessionResponse{s}, err
+}
+
+type Conmon_ExecContainerRequest struct{ capnp.Struct }
+
+// Conmon_ExecContainerRequest_TypeID is the unique identifier for the type Conmon_ExecContainerRequest.
+const Conmon_ExecContainerRequest_TypeID = 0xc9971c07179123bc
+
+func NewConmon_ExecContainerRequest(s *capnp.Segment) (Conmon_ExecContainerRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Conmon_ExecContainerRequest{st}, err
+}
+
+func NewRootConmon_ExecContainerRequest(s *capnp.Segment) (Conmon_ExecContainerRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4})
+	return Conmon_ExecContainerRequest{st}, err
+}
+
+func ReadRootConmon_ExecContainerRequest(msg *capnp.Message) (Conmon_ExecContainerRequest, error) {
+	root, err := msg.Root()
+	return Conmon_ExecContainerRequest{root.Struct()}, err
+}
+
+func (s Conmon_ExecContainerRequest) String() string {
+	str, _ := text.Marshal(0xc9971c07179123bc, s.Struct)
+	return str
+}
+
+func (s Conmon_ExecContainerRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ExecContainerRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ExecContainerRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ExecContainerRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ExecContainerRequest) Command() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(1)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_ExecContainerRequest) HasCommand() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ExecContainerRequest) SetCommand(v capnp.TextList) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewCommand sets the command field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_ExecContainerRequest) NewCommand(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_ExecContainerRequest) Terminal() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Conmon_ExecContainerRequest) SetTerminal(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+func (s Conmon_ExecContainerRequest) ExecSessionId() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s Conmon_ExecContainerRequest) HasExecSessionId() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_ExecContainerRequest) ExecSessionIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ExecContainerRequest) SetExecSessionId(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
+func (s Conmon_ExecContainerRequest) LogDrivers() (Conmon_LogDriver_List, error) {
+	p, err := s.Struct.Ptr(3)
+	return Conmon_LogDriver_List{List: p.List()}, err
+}
+
+func (s Conmon_ExecContainerRequest) HasLogDrivers() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Conmon_ExecContainerRequest) SetLogDrivers(v Conmon_LogDriver_List) error {
+	return s.Struct.SetPtr(3, v.List.ToPtr())
+}
+
+// NewLogDrivers sets the logDrivers field to a newly
+// allocated Conmon_LogDriver_List, preferring placement in s's segment.
+func (s Conmon_ExecContainerRequest) NewLogDrivers(n int32) (Conmon_LogDriver_List, error) {
+	l, err := NewConmon_LogDriver_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_LogDriver_List{}, err
+	}
+	err = s.Struct.SetPtr(3, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_ExecContainerRequest_List is a list of Conmon_ExecContainerRequest.
+type Conmon_ExecContainerRequest_List = capnp.StructList[Conmon_ExecContainerRequest]
+
+// NewConmon_ExecContainerRequest creates a new list of Conmon_ExecContainerRequest.
+func NewConmon_ExecContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_ExecContainerRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 4}, sz)
+	return capnp.StructList[Conmon_ExecContainerRequest]{l}, err
+}
+
+// Conmon_ExecContainerRequest_Future is a wrapper for a Conmon_ExecContainerRequest promised by a client call.
+type Conmon_ExecContainerRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_ExecContainerRequest_Future) Struct() (Conmon_ExecContainerRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ExecContainerRequest{s}, err
+}
+
+type Conmon_ExecContainerResponse struct{ capnp.Struct }
+
+// Conmon_ExecContainerResponse_TypeID is the unique identifier for the type Conmon_ExecContainerResponse.
+const Conmon_ExecContainerResponse_TypeID = 0xeacad74128d0e3e7
+
+func NewConmon_ExecContainerResponse(s *capnp.Segment) (Conmon_ExecContainerResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_ExecContainerResponse{st}, err
+}
+
+func NewRootConmon_ExecContainerResponse(s *capnp.Segment) (Conmon_ExecContainerResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_ExecContainerResponse{st}, err
+}
+
+func ReadRootConmon_ExecContainerResponse(msg *capnp.Message) (Conmon_ExecContainerResponse, error) {
+	root, err := msg.Root()
+	return Conmon_ExecContainerResponse{root.Struct()}, err
+}
+
+func (s Conmon_ExecContainerResponse) String() string {
+	str, _ := text.Marshal(0xeacad74128d0e3e7, s.Struct)
+	return str
+}
+
+func (s Conmon_ExecContainerResponse) ExecSessionId() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ExecContainerResponse) HasExecSessionId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ExecContainerResponse) ExecSessionIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ExecContainerResponse) SetExecSessionId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ExecContainerResponse) Pid() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Conmon_ExecContainerResponse) SetPid(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+// Conmon_ExecContainerResponse_List is a list of Conmon_ExecContainerResponse.
+type Conmon_ExecContainerResponse_List = capnp.StructList[Conmon_ExecContainerResponse]
+
+// NewConmon_ExecContainerResponse creates a new list of Conmon_ExecContainerResponse.
+func NewConmon_ExecContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_ExecContainerResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ExecContainerResponse]{l}, err
+}
+
+// Conmon_ExecContainerResponse_Future is a wrapper for a Conmon_ExecContainerResponse promised by a client call.
+type Conmon_ExecContainerResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_ExecContainerResponse_Future) Struct() (Conmon_ExecContainerResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ExecContainerResponse{s}, err
+}
+
+type Conmon_WithTenantRequest struct{ capnp.Struct }
+
+// Conmon_WithTenantRequest_TypeID is the unique identifier for the type Conmon_WithTenantRequest.
+const Conmon_WithTenantRequest_TypeID = 0x82c3638366192499
+
+func NewConmon_WithTenantRequest(s *capnp.Segment) (Conmon_WithTenantRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_WithTenantRequest{st}, err
+}
+
+func NewRootConmon_WithTenantRequest(s *capnp.Segment) (Conmon_WithTenantRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_WithTenantRequest{st}, err
+}
+
+func ReadRootConmon_WithTenantRequest(msg *capnp.Message) (Conmon_WithTenantRequest, error) {
+	root, err := msg.Root()
+	return Conmon_WithTenantRequest{root.Struct()}, err
+}
+
+func (s Conmon_WithTenantRequest) String() string {
+	str, _ := text.Marshal(0x82c3638366192499, s.Struct)
+	return str
+}
+
+func (s Conmon_WithTenantRequest) Tenant() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_WithTenantRequest) HasTenant() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_WithTenantRequest) TenantBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_WithTenantRequest) SetTenant(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_WithTenantRequest_List is a list of Conmon_WithTenantRequest.
+type Conmon_WithTenantRequest_List = capnp.StructList[Conmon_WithTenantRequest]
+
+// NewConmon_WithTenantRequest creates a new list of Conmon_WithTenantRequest.
+func NewConmon_WithTenantRequest_List(s *capnp.Segment, sz int32) (Conmon_WithTenantRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_WithTenantRequest]{l}, err
+}
+
+// Conmon_WithTenantRequest_Future is a wrapper for a Conmon_WithTenantRequest promised by a client call.
+type Conmon_WithTenantRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_WithTenantRequest_Future) Struct() (Conmon_WithTenantRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_WithTenantRequest{s}, err
+}
+
+type Conmon_WithTenantResponse struct{ capnp.Struct }
+
+// Conmon_WithTenantResponse_TypeID is the unique identifier for the type Conmon_WithTenantResponse.
+const Conmon_WithTenantResponse_TypeID = 0xe41bba77bdac220f
+
+func NewConmon_WithTenantResponse(s *capnp.Segment) (Conmon_WithTenantResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_WithTenantResponse{st}, err
+}
+
+func NewRootConmon_WithTenantResponse(s *capnp.Segment) (Conmon_WithTenantResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_WithTenantResponse{st}, err
+}
+
+func ReadRootConmon_WithTenantResponse(msg *capnp.Message) (Conmon_WithTenantResponse, error) {
+	root, err := msg.Root()
+	return Conmon_WithTenantResponse{root.Struct()}, err
+}
+
+func (s Conmon_WithTenantResponse) String() string {
+	str, _ := text.Marshal(0xe41bba77bdac220f, s.Struct)
+	return str
+}
+
+func (s Conmon_WithTenantResponse) Conmon() Conmon {
+	p, _ := s.Struct.Ptr(0)
+	return Conmon{Client: p.Interface().Client()}
+}
+
+func (s Conmon_WithTenantResponse) HasConmon() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_WithTenantResponse) SetConmon(v Conmon) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(0, in.ToPtr())
+}
+
+// Conmon_WithTenantResponse_List is a list of Conmon_WithTenantResponse.
+type Conmon_WithTenantResponse_List = capnp.StructList[Conmon_WithTenantResponse]
+
+// NewConmon_WithTenantResponse creates a new list of Conmon_WithTenantResponse.
+func NewConmon_WithTenantResponse_List(s *capnp.Segment, sz int32) (Conmon_WithTenantResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_WithTenantResponse]{l}, err
+}
+
+// Conmon_WithTenantResponse_Future is a wrapper for a Conmon_WithTenantResponse promised by a client call.
+type Conmon_WithTenantResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_WithTenantResponse_Future) Struct() (Conmon_WithTenantResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_WithTenantResponse{s}, err
+}
+
+func (p Conmon_WithTenantResponse_Future) Conmon() Conmon {
+	return Conmon{Client: p.Future.Field(0, nil).Client()}
+}
+
+type Conmon_PortForwardRequest struct{ capnp.Struct }
+
+// Conmon_PortForwardRequest_TypeID is the unique identifier for the type Conmon_PortForwardRequest.
+const Conmon_PortForwardRequest_TypeID = 0xb78b75a9a91a9748
+
+func NewConmon_PortForwardRequest(s *capnp.Segment) (Conmon_PortForwardRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return Conmon_PortForwardRequest{st}, err
+}
+
+func NewRootConmon_PortForwardRequest(s *capnp.Segment) (Conmon_PortForwardRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return Conmon_PortForwardRequest{st}, err
+}
+
+func ReadRootConmon_PortForwardRequest(msg *capnp.Message) (Conmon_PortForwardRequest, error) {
+	root, err := msg.Root()
+	return Conmon_PortForwardRequest{root.Struct()}, err
+}
+
+func (s Conmon_PortForwardRequest) String() string {
+	str, _ := text.Marshal(0xb78b75a9a91a9748, s.Struct)
+	return str
+}
+
+func (s Conmon_PortForwardRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_PortForwardRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_PortForwardRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_PortForwardRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_PortForwardRequest) Port() uint16 {
+	return s.Struct.Uint16(0)
+}
+
+func (s Conmon_PortForwardRequest) SetPort(v uint16) {
+	s.Struct.SetUint16(0, v)
+}
+
+func (s Conmon_PortForwardRequest) SocketPath() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_PortForwardRequest) HasSocketPath() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_PortForwardRequest) SocketPathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_PortForwardRequest) SetSocketPath(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_PortForwardRequest) Family() Conmon_AddressFamily {
+	return Conmon_AddressFamily(s.Struct.Uint16(2))
+}
+
+func (s Conmon_PortForwardRequest) SetFamily(v Conmon_AddressFamily) {
+	s.Struct.SetUint16(2, uint16(v))
+}
+
+func (s Conmon_PortForwardRequest) Host() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s Conmon_PortForwardRequest) HasHost() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_PortForwardRequest) HostBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_PortForwardRequest) SetHost(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
+// Conmon_PortForwardRequest_List is a list of Conmon_PortForwardRequest.
+type Conmon_PortForwardRequest_List = capnp.StructList[Conmon_PortForwardRequest]
+
+// NewConmon_PortForwardRequest creates a new list of Conmon_PortForwardRequest.
+func NewConmon_PortForwardRequest_List(s *capnp.Segment, sz int32) (Conmon_PortForwardRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_PortForwardRequest]{l}, err
+}
+
+// Conmon_PortForwardRequest_Future is a wrapper for a Conmon_PortForwardRequest promised by a client call.
+type Conmon_PortForwardRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_PortForwardRequest_Future) Struct() (Conmon_PortForwardRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_PortForwardRequest{s}, err
+}
+
+type Conmon_PortForwardResponse struct{ capnp.Struct }
+
+// Conmon_PortForwardResponse_TypeID is the unique identifier for the type Conmon_PortForwardResponse.
+const Conmon_PortForwardResponse_TypeID = 0xfa066186bb70bb83
+
+func NewConmon_PortForwardResponse(s *capnp.Segment) (Conmon_PortForwardResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_PortForwardResponse{st}, err
+}
+
+func NewRootConmon_PortForwardResponse(s *capnp.Segment) (Conmon_PortForwardResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_PortForwardResponse{st}, err
+}
+
+func ReadRootConmon_PortForwardResponse(msg *capnp.Message) (Conmon_PortForwardResponse, error) {
+	root, err := msg.Root()
+	return Conmon_PortForwardResponse{root.Struct()}, err
+}
+
+func (s Conmon_PortForwardResponse) String() string {
+	str, _ := text.Marshal(0xfa066186bb70bb83, s.Struct)
+	return str
+}
+
+func (s Conmon_PortForwardResponse) Address() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_PortForwardResponse) HasAddress() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_PortForwardResponse) AddressBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_PortForwardResponse) SetAddress(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_PortForwardResponse) Family() Conmon_AddressFamily {
+	return Conmon_AddressFamily(s.Struct.Uint16(0))
+}
+
+func (s Conmon_PortForwardResponse) SetFamily(v Conmon_AddressFamily) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+// Conmon_PortForwardResponse_List is a list of Conmon_PortForwardResponse.
+type Conmon_PortForwardResponse_List = capnp.StructList[Conmon_PortForwardResponse]
+
+// NewConmon_PortForwardResponse creates a new list of Conmon_PortForwardResponse.
+func NewConmon_PortForwardResponse_List(s *capnp.Segment, sz int32) (Conmon_PortForwardResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_PortForwardResponse]{l}, err
+}
+
+// Conmon_PortForwardResponse_Future is a wrapper for a Conmon_PortForwardResponse promised by a client call.
+type Conmon_PortForwardResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_PortForwardResponse_Future) Struct() (Conmon_PortForwardResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_PortForwardResponse{s}, err
+}
+
+type Conmon_AddressFamily uint16
+
+// Conmon_AddressFamily_TypeID is the unique identifier for the type Conmon_AddressFamily.
+const Conmon_AddressFamily_TypeID = 0x89ac8b2a8f68928a
+
+// Values of Conmon_AddressFamily.
+const (
+	Conmon_AddressFamily_any  Conmon_AddressFamily = 0
+	Conmon_AddressFamily_ipv4 Conmon_AddressFamily = 1
+	Conmon_AddressFamily_ipv6 Conmon_AddressFamily = 2
+)
+
+// String returns the enum's constant name.
+func (c Conmon_AddressFamily) String() string {
+	switch c {
+	case Conmon_AddressFamily_any:
+		return "any"
+	case Conmon_AddressFamily_ipv4:
+		return "ipv4"
+	case Conmon_AddressFamily_ipv6:
+		return "ipv6"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_AddressFamilyFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_AddressFamilyFromString(c string) Conmon_AddressFamily {
+	switch c {
+	case "any":
+		return Conmon_AddressFamily_any
+	case "ipv4":
+		return Conmon_AddressFamily_ipv4
+	case "ipv6":
+		return Conmon_AddressFamily_ipv6
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_AddressFamily_List = capnp.EnumList[Conmon_AddressFamily]
+
+func NewConmon_AddressFamily_List(s *capnp.Segment, sz int32) (Conmon_AddressFamily_List, error) {
+	return capnp.NewEnumList[Conmon_AddressFamily](s, sz)
+}
+
+type Conmon_ContainerStatsRequest struct{ capnp.Struct }
+
+// Conmon_ContainerStatsRequest_TypeID is the unique identifier for the type Conmon_ContainerStatsRequest.
+const Conmon_ContainerStatsRequest_TypeID = 0x870856d7715ebfde
+
+func NewConmon_ContainerStatsRequest(s *capnp.Segment) (Conmon_ContainerStatsRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ContainerStatsRequest{st}, err
+}
+
+func NewRootConmon_ContainerStatsRequest(s *capnp.Segment) (Conmon_ContainerStatsRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ContainerStatsRequest{st}, err
+}
+
+func ReadRootConmon_ContainerStatsRequest(msg *capnp.Message) (Conmon_ContainerStatsRequest, error) {
+	root, err := msg.Root()
+	return Conmon_ContainerStatsRequest{root.Struct()}, err
+}
+
+func (s Conmon_ContainerStatsRequest) String() string {
+	str, _ := text.Marshal(0x870856d7715ebfde, s.Struct)
+	return str
+}
+
+func (s Conmon_ContainerStatsRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ContainerStatsRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ContainerStatsRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ContainerStatsRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_ContainerStatsRequest_List is a list of Conmon_ContainerStatsRequest.
+type Conmon_ContainerStatsRequest_List = capnp.StructList[Conmon_ContainerStatsRequest]
+
+// NewConmon_ContainerStatsRequest creates a new list of Conmon_ContainerStatsRequest.
+func NewConmon_ContainerStatsRequest_List(s *capnp.Segment, sz int32) (Conmon_ContainerStatsRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ContainerStatsRequest]{l}, err
+}
+
+// Conmon_ContainerStatsRequest_Future is a wrapper for a Conmon_ContainerStatsRequest promised by a client call.
+type Conmon_ContainerStatsRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_ContainerStatsRequest_Future) Struct() (Conmon_ContainerStatsRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ContainerStatsRequest{s}, err
+}
+
+type Conmon_ContainerStatsResponse struct{ capnp.Struct }
+
+// Conmon_ContainerStatsResponse_TypeID is the unique identifier for the type Conmon_ContainerStatsResponse.
+const Conmon_ContainerStatsResponse_TypeID = 0x8ada080957d5db5d
+
+func NewConmon_ContainerStatsResponse(s *capnp.Segment) (Conmon_ContainerStatsResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ContainerStatsResponse{st}, err
+}
+
+func NewRootConmon_ContainerStatsResponse(s *capnp.Segment) (Conmon_ContainerStatsResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ContainerStatsResponse{st}, err
+}
+
+func ReadRootConmon_ContainerStatsResponse(msg *capnp.Message) (Conmon_ContainerStatsResponse, error) {
+	root, err := msg.Root()
+	return Conmon_ContainerStatsResponse{root.Struct()}, err
+}
+
+func (s Conmon_ContainerStatsResponse) String() string {
+	str, _ := text.Marshal(0x8ada080957d5db5d, s.Struct)
+	return str
+}
+
+func (s Conmon_ContainerStatsResponse) Stats() (Conmon_ContainerStats, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerStats{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ContainerStatsResponse) HasStats() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ContainerStatsResponse) SetStats(v Conmon_ContainerStats) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewStats sets the stats field to a newly
+// allocated Conmon_ContainerStats struct, preferring placement in s's segment.
+func (s Conmon_ContainerStatsResponse) NewStats() (Conmon_ContainerStats, error) {
+	ss, err := NewConmon_ContainerStats(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ContainerStats{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_ContainerStatsResponse_List is a list of Conmon_ContainerStatsResponse.
+type Conmon_ContainerStatsResponse_List = capnp.StructList[Conmon_ContainerStatsResponse]
+
+// NewConmon_ContainerStatsResponse creates a new list of Conmon_ContainerStatsResponse.
+func NewConmon_ContainerStatsResponse_List(s *capnp.Segment, sz int32) (Conmon_ContainerStatsResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ContainerStatsResponse]{l}, err
+}
+
+// Conmon_ContainerStatsResponse_Future is a wrapper for a Conmon_ContainerStatsResponse promised by a client call.
+type Conmon_ContainerStatsResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_ContainerStatsResponse_Future) Struct() (Conmon_ContainerStatsResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ContainerStatsResponse{s}, err
+}
+
+func (p Conmon_ContainerStatsResponse_Future) Stats() Conmon_ContainerStats_Future {
+	return Conmon_ContainerStats_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_ContainerStats struct{ capnp.Struct }
+
+// Conmon_ContainerStats_TypeID is the unique identifier for the type Conmon_ContainerStats.
+const Conmon_ContainerStats_TypeID = 0xef9ecb15313f7502
+
+func NewConmon_ContainerStats(s *capnp.Segment) (Conmon_ContainerStats, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 6})
+	return Conmon_ContainerStats{st}, err
+}
+
+func NewRootConmon_ContainerStats(s *capnp.Segment) (Conmon_ContainerStats, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 6})
+	return Conmon_ContainerStats{st}, err
+}
+
+func ReadRootConmon_ContainerStats(msg *capnp.Message) (Conmon_ContainerStats, error) {
+	root, err := msg.Root()
+	return Conmon_ContainerStats{root.Struct()}, err
+}
+
+func (s Conmon_ContainerStats) String() string {
+	str, _ := text.Marshal(0xef9ecb15313f7502, s.Struct)
+	return str
+}
+
+func (s Conmon_ContainerStats) Timestamp() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_ContainerStats) SetTimestamp(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_ContainerStats) Cpu() (Conmon_CpuStats, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_CpuStats{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ContainerStats) HasCpu() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ContainerStats) SetCpu(v Conmon_CpuStats) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewCpu sets the cpu field to a newly
+// allocated Conmon_CpuStats struct, preferring placement in s's segment.
+func (s Conmon_ContainerStats) NewCpu() (Conmon_CpuStats, error) {
+	ss, err := NewConmon_CpuStats(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CpuStats{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_ContainerStats) Memory() (Conmon_MemoryStats, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_MemoryStats{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ContainerStats) HasMemory() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ContainerStats) SetMemory(v Conmon_MemoryStats) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewMemory sets the memory field to a newly
+// allocated Conmon_MemoryStats struct, preferring placement in s's segment.
+func (s Conmon_ContainerStats) NewMemory() (Conmon_MemoryStats, error) {
+	ss, err := NewConmon_MemoryStats(s.Struct.Segment())
+	if err != nil {
+		return Conmon_MemoryStats{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_ContainerStats) BlockIo() (Conmon_BlockIoStats, error) {
+	p, err := s.Struct.Ptr(2)
+	return Conmon_BlockIoStats{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ContainerStats) HasBlockIo() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_ContainerStats) SetBlockIo(v Conmon_BlockIoStats) error {
+	return s.Struct.SetPtr(2, v.Struct.ToPtr())
+}
+
+// NewBlockIo sets the blockIo field to a newly
+// allocated Conmon_BlockIoStats struct, preferring placement in s's segment.
+func (s Conmon_ContainerStats) NewBlockIo() (Conmon_BlockIoStats, error) {
+	ss, err := NewConmon_BlockIoStats(s.Struct.Segment())
+	if err != nil {
+		return Conmon_BlockIoStats{}, err
+	}
+	err = s.Struct.SetPtr(2, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_ContainerStats) Pids() (Conmon_PidsStats, error) {
+	p, err := s.Struct.Ptr(3)
+	return Conmon_PidsStats{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ContainerStats) HasPids() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Conmon_ContainerStats) SetPids(v Conmon_PidsStats) error {
+	return s.Struct.SetPtr(3, v.Struct.ToPtr())
+}
+
+// NewPids sets the pids field to a newly
+// allocated Conmon_PidsStats struct, preferring placement in s's segment.
+func (s Conmon_ContainerStats) NewPids() (Conmon_PidsStats, error) {
+	ss, err := NewConmon_PidsStats(s.Struct.Segment())
+	if err != nil {
+		return Conmon_PidsStats{}, err
+	}
+	err = s.Struct.SetPtr(3, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_ContainerStats) LogFilter() (Conmon_LogFilterHealth, error) {
+	p, err := s.Struct.Ptr(4)
+	return Conmon_LogFilterHealth{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ContainerStats) HasLogFilter() bool {
+	return s.Struct.HasPtr(4)
+}
+
+func (s Conmon_ContainerStats) SetLogFilter(v Conmon_LogFilterHealth) error {
+	return s.Struct.SetPtr(4, v.Struct.ToPtr())
+}
+
+// NewLogFilter sets the logFilter field to a newly
+// allocated Conmon_LogFilterHealth struct, preferring placement in s's segment.
+func (s Conmon_ContainerStats) NewLogFilter() (Conmon_LogFilterHealth, error) {
+	ss, err := NewConmon_LogFilterHealth(s.Struct.Segment())
+	if err != nil {
+		return Conmon_LogFilterHealth{}, err
+	}
+	err = s.Struct.SetPtr(4, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_ContainerStats) Network() (Conmon_NetworkStats, error) {
+	p, err := s.Struct.Ptr(5)
+	return Conmon_NetworkStats{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ContainerStats) HasNetwork() bool {
+	return s.Struct.HasPtr(5)
+}
+
+func (s Conmon_ContainerStats) SetNetwork(v Conmon_NetworkStats) error {
+	return s.Struct.SetPtr(5, v.Struct.ToPtr())
+}
+
+// NewNetwork sets the network field to a newly
+// allocated Conmon_NetworkStats struct, preferring placement in s's segment.
+func (s Conmon_ContainerStats) NewNetwork() (Conmon_NetworkStats, error) {
+	ss, err := NewConmon_NetworkStats(s.Struct.Segment())
+	if err != nil {
+		return Conmon_NetworkStats{}, err
+	}
+	err = s.Struct.SetPtr(5, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_ContainerStats_List is a list of Conmon_ContainerStats.
+type Conmon_ContainerStats_List = capnp.StructList[Conmon_ContainerStats]
+
+// NewConmon_ContainerStats creates a new list of Conmon_ContainerStats.
+func NewConmon_ContainerStats_List(s *capnp.Segment, sz int32) (Conmon_ContainerStats_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 6}, sz)
+	return capnp.StructList[Conmon_ContainerStats]{l}, err
+}
+
+// Conmon_ContainerStats_Future is a wrapper for a Conmon_ContainerStats promised by a client call.
+type Conmon_ContainerStats_Future struct{ *capnp.Future }
+
+func (p Conmon_ContainerStats_Future) Struct() (Conmon_ContainerStats, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ContainerStats{s}, err
+}
+
+func (p Conmon_ContainerStats_Future) Cpu() Conmon_CpuStats_Future {
+	return Conmon_CpuStats_Future{Future: p.Future.Field(0, nil)}
+}
+
+func (p Conmon_ContainerStats_Future) Memory() Conmon_MemoryStats_Future {
+	return Conmon_MemoryStats_Future{Future: p.Future.Field(1, nil)}
+}
+
+func (p Conmon_ContainerStats_Future) BlockIo() Conmon_BlockIoStats_Future {
+	return Conmon_BlockIoStats_Future{Future: p.Future.Field(2, nil)}
+}
+
+func (p Conmon_ContainerStats_Future) Pids() Conmon_PidsStats_Future {
+	return Conmon_PidsStats_Future{Future: p.Future.Field(3, nil)}
+}
+
+func (p Conmon_ContainerStats_Future) LogFilter() Conmon_LogFilterHealth_Future {
+	return Conmon_LogFilterHealth_Future{Future: p.Future.Field(4, nil)}
+}
+
+func (p Conmon_ContainerStats_Future) Network() Conmon_NetworkStats_Future {
+	return Conmon_NetworkStats_Future{Future: p.Future.Field(5, nil)}
+}
+
+type Conmon_CpuStats struct{ capnp.Struct }
+
+// Conmon_CpuStats_TypeID is the unique identifier for the type Conmon_CpuStats.
+const Conmon_CpuStats_TypeID = 0xcbb9ae1e6dadf0d0
+
+func NewConmon_CpuStats(s *capnp.Segment) (Conmon_CpuStats, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Conmon_CpuStats{st}, err
+}
+
+func NewRootConmon_CpuStats(s *capnp.Segment) (Conmon_CpuStats, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Conmon_CpuStats{st}, err
+}
+
+func ReadRootConmon_CpuStats(msg *capnp.Message) (Conmon_CpuStats, error) {
+	root, err := msg.Root()
+	return Conmon_CpuStats{root.Struct()}, err
+}
+
+func (s Conmon_CpuStats) String() string {
+	str, _ := text.Marshal(0xcbb9ae1e6dadf0d0, s.Struct)
+	return str
+}
+
+func (s Conmon_CpuStats) UsageNanos() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_CpuStats) SetUsageNanos(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_CpuStats) UserNanos() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_CpuStats) SetUserNanos(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_CpuStats) SystemNanos() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_CpuStats) SetSystemNanos(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+// Conmon_CpuStats_List is a list of Conmon_CpuStats.
+type Conmon_CpuStats_List = capnp.StructList[Conmon_CpuStats]
+
+// NewConmon_CpuStats creates a new list of Conmon_CpuStats.
+func NewConmon_CpuStats_List(s *capnp.Segment, sz int32) (Conmon_CpuStats_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_CpuStats]{l}, err
+}
+
+// Conmon_CpuStats_Future is a wrapper for a Conmon_CpuStats promised by a client call.
+type Conmon_CpuStats_Future struct{ *capnp.Future }
+
+func (p Conmon_CpuStats_Future) Struct() (Conmon_CpuStats, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CpuStats{s}, err
+}
+
+type Conmon_MemoryStats struct{ capnp.Struct }
+
+// Conmon_MemoryStats_TypeID is the unique identifier for the type Conmon_MemoryStats.
+const Conmon_MemoryStats_TypeID = 0xec53de7966fdb174
+
+func NewConmon_MemoryStats(s *capnp.Segment) (Conmon_MemoryStats, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Conmon_MemoryStats{st}, err
+}
+
+func NewRootConmon_MemoryStats(s *capnp.Segment) (Conmon_MemoryStats, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Conmon_MemoryStats{st}, err
+}
+
+func ReadRootConmon_MemoryStats(msg *capnp.Message) (Conmon_MemoryStats, error) {
+	root, err := msg.Root()
+	return Conmon_MemoryStats{root.Struct()}, err
+}
+
+func (s Conmon_MemoryStats) String() string {
+	str, _ := text.Marshal(0xec53de7966fdb174, s.Struct)
+	return str
+}
+
+func (s Conmon_MemoryStats) UsageBytes() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_MemoryStats) SetUsageBytes(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_MemoryStats) LimitBytes() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_MemoryStats) SetLimitBytes(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+// Conmon_MemoryStats_List is a list of Conmon_MemoryStats.
+type Conmon_MemoryStats_List = capnp.StructList[Conmon_MemoryStats]
+
+// NewConmon_MemoryStats creates a new list of Conmon_MemoryStats.
+func NewConmon_MemoryStats_List(s *capnp.Segment, sz int32) (Conmon_MemoryStats_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_MemoryStats]{l}, err
+}
+
+// Conmon_MemoryStats_Future is a wrapper for a Conmon_MemoryStats promised by a client call.
+type Conmon_MemoryStats_Future struct{ *capnp.Future }
+
+func (p Conmon_MemoryStats_Future) Struct() (Conmon_MemoryStats, error) {
+	s, err := p.Future.Struct()
+	return Conmon_MemoryStats{s}, err
+}
+
+type Conmon_BlockIoStats struct{ capnp.Struct }
+
+// Conmon_BlockIoStats_TypeID is the unique identifier for the type Conmon_BlockIoStats.
+const Conmon_BlockIoStats_TypeID = 0x82a19fdf41e356fb
+
+func NewConmon_BlockIoStats(s *capnp.Segment) (Conmon_BlockIoStats, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0})
+	return Conmon_BlockIoStats{st}, err
+}
+
+func NewRootConmon_BlockIoStats(s *capnp.Segment) (Conmon_BlockIoStats, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0})
+	return Conmon_BlockIoStats{st}, err
+}
+
+func ReadRootConmon_BlockIoStats(msg *capnp.Message) (Conmon_BlockIoStats, error) {
+	root, err := msg.Root()
+	return Conmon_BlockIoStats{root.Struct()}, err
+}
+
+func (s Conmon_BlockIoStats) String() string {
+	str, _ := text.Marshal(0x82a19fdf41e356fb, s.Struct)
+	return str
+}
+
+func (s Conmon_BlockIoStats) ReadBytes() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_BlockIoStats) SetReadBytes(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_BlockIoStats) WriteBytes() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_BlockIoStats) SetWriteBytes(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_BlockIoStats) ReadOps() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_BlockIoStats) SetReadOps(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+func (s Conmon_BlockIoStats) WriteOps() uint64 {
+	return s.Struct.Uint64(24)
+}
+
+func (s Conmon_BlockIoStats) SetWriteOps(v uint64) {
+	s.Struct.SetUint64(24, v)
+}
+
+// Conmon_BlockIoStats_List is a list of Conmon_BlockIoStats.
+type Conmon_BlockIoStats_List = capnp.StructList[Conmon_BlockIoStats]
+
+// NewConmon_BlockIoStats creates a new list of Conmon_BlockIoStats.
+func NewConmon_BlockIoStats_List(s *capnp.Segment, sz int32) (Conmon_BlockIoStats_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_BlockIoStats]{l}, err
+}
+
+// Conmon_BlockIoStats_Future is a wrapper for a Conmon_BlockIoStats promised by a client call.
+type Conmon_BlockIoStats_Future struct{ *capnp.Future }
+
+func (p Conmon_BlockIoStats_Future) Struct() (Conmon_BlockIoStats, error) {
+	s, err := p.Future.Struct()
+	return Conmon_BlockIoStats{s}, err
+}
+
+type Conmon_PidsStats struct{ capnp.Struct }
+
+// Conmon_PidsStats_TypeID is the unique identifier for the type Conmon_PidsStats.
+const Conmon_PidsStats_TypeID = 0xb131cbb7b097105a
+
+func NewConmon_PidsStats(s *capnp.Segment) (Conmon_PidsStats, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Conmon_PidsStats{st}, err
+}
+
+func NewRootConmon_PidsStats(s *capnp.Segment) (Conmon_PidsStats, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Conmon_PidsStats{st}, err
+}
+
+func ReadRootConmon_PidsStats(msg *capnp.Message) (Conmon_PidsStats, error) {
+	root, err := msg.Root()
+	return Conmon_PidsStats{root.Struct()}, err
+}
+
+func (s Conmon_PidsStats) String() string {
+	str, _ := text.Marshal(0xb131cbb7b097105a, s.Struct)
+	return str
+}
+
+func (s Conmon_PidsStats) Current() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_PidsStats) SetCurrent(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_PidsStats) Limit() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_PidsStats) SetLimit(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+// Conmon_PidsStats_List is a list of Conmon_PidsStats.
+type Conmon_PidsStats_List = capnp.StructList[Conmon_PidsStats]
+
+// NewConmon_PidsStats creates a new list of Conmon_PidsStats.
+func NewConmon_PidsStats_List(s *capnp.Segment, sz int32) (Conmon_PidsStats_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_PidsStats]{l}, err
+}
+
+// Conmon_PidsStats_Future is a wrapper for a Conmon_PidsStats promised by a client call.
+type Conmon_PidsStats_Future struct{ *capnp.Future }
+
+func (p Conmon_PidsStats_Future) Struct() (Conmon_PidsStats, error) {
+	s, err := p.Future.Struct()
+	return Conmon_PidsStats{s}, err
+}
+
+type Conmon_LogFilterHealth struct{ capnp.Struct }
+
+// Conmon_LogFilterHealth_TypeID is the unique identifier for the type Conmon_LogFilterHealth.
+const Conmon_LogFilterHealth_TypeID = 0x94ec55ba81be1563
+
+func NewConmon_LogFilterHealth(s *capnp.Segment) (Conmon_LogFilterHealth, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Conmon_LogFilterHealth{st}, err
+}
+
+func NewRootConmon_LogFilterHealth(s *capnp.Segment) (Conmon_LogFilterHealth, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Conmon_LogFilterHealth{st}, err
+}
+
+func ReadRootConmon_LogFilterHealth(msg *capnp.Message) (Conmon_LogFilterHealth, error) {
+	root, err := msg.Root()
+	return Conmon_LogFilterHealth{root.Struct()}, err
+}
+
+func (s Conmon_LogFilterHealth) String() string {
+	str, _ := text.Marshal(0x94ec55ba81be1563, s.Struct)
+	return str
+}
+
+func (s Conmon_LogFilterHealth) Configured() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Conmon_LogFilterHealth) SetConfigured(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+func (s Conmon_LogFilterHealth) Running() bool {
+	return s.Struct.Bit(1)
+}
+
+func (s Conmon_LogFilterHealth) SetRunning(v bool) {
+	s.Struct.SetBit(1, v)
+}
+
+func (s Conmon_LogFilterHealth) Restarts() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Conmon_LogFilterHealth) SetRestarts(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+func (s Conmon_LogFilterHealth) LastExitCode() int32 {
+	return int32(s.Struct.Uint32(8))
+}
+
+func (s Conmon_LogFilterHealth) SetLastExitCode(v int32) {
+	s.Struct.SetUint32(8, uint32(v))
+}
+
+func (s Conmon_LogFilterHealth) DroppedBytes() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_LogFilterHealth) SetDroppedBytes(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+// Conmon_LogFilterHealth_List is a list of Conmon_LogFilterHealth.
+type Conmon_LogFilterHealth_List = capnp.StructList[Conmon_LogFilterHealth]
+
+// NewConmon_LogFilterHealth creates a new list of Conmon_LogFilterHealth.
+func NewConmon_LogFilterHealth_List(s *capnp.Segment, sz int32) (Conmon_LogFilterHealth_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_LogFilterHealth]{l}, err
+}
+
+// Conmon_LogFilterHealth_Future is a wrapper for a Conmon_LogFilterHealth promised by a client call.
+type Conmon_LogFilterHealth_Future struct{ *capnp.Future }
+
+func (p Conmon_LogFilterHealth_Future) Struct() (Conmon_LogFilterHealth, error) {
+	s, err := p.Future.Struct()
+	return Conmon_LogFilterHealth{s}, err
+}
+
+type Conmon_NetworkStats struct{ capnp.Struct }
+
+// Conmon_NetworkStats_TypeID is the unique identifier for the type Conmon_NetworkStats.
+const Conmon_NetworkStats_TypeID = 0x94111a4ff83b847a
+
+func NewConmon_NetworkStats(s *capnp.Segment) (Conmon_NetworkStats, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_NetworkStats{st}, err
+}
+
+func NewRootConmon_NetworkStats(s *capnp.Segment) (Conmon_NetworkStats, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_NetworkStats{st}, err
+}
+
+func ReadRootConmon_NetworkStats(msg *capnp.Message) (Conmon_NetworkStats, error) {
+	root, err := msg.Root()
+	return Conmon_NetworkStats{root.Struct()}, err
+}
+
+func (s Conmon_NetworkStats) String() string {
+	str, _ := text.Marshal(0x94111a4ff83b847a, s.Struct)
+	return str
+}
+
+func (s Conmon_NetworkStats) Interfaces() (Conmon_NetworkInterfaceStats_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_NetworkInterfaceStats_List{List: p.List()}, err
+}
+
+func (s Conmon_NetworkStats) HasInterfaces() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_NetworkStats) SetInterfaces(v Conmon_NetworkInterfaceStats_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewInterfaces sets the interfaces field to a newly
+// allocated Conmon_NetworkInterfaceStats_List, preferring placement in s's segment.
+func (s Conmon_NetworkStats) NewInterfaces(n int32) (Conmon_NetworkInterfaceStats_List, error) {
+	l, err := NewConmon_NetworkInterfaceStats_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_NetworkInterfaceStats_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_NetworkStats) Ipv4() (Conmon_IpStats, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_IpStats{Struct: p.Struct()}, err
+}
+
+func (s Conmon_NetworkStats) HasIpv4() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_NetworkStats) SetIpv4(v Conmon_IpStats) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewIpv4 sets the ipv4 field to a newly
+// allocated Conmon_IpStats struct, preferring placement in s's segment.
+func (s Conmon_NetworkStats) NewIpv4() (Conmon_IpStats, error) {
+	ss, err := NewConmon_IpStats(s.Struct.Segment())
+	if err != nil {
+		return Conmon_IpStats{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_NetworkStats) Ipv6() (Conmon_IpStats, error) {
+	p, err := s.Struct.Ptr(2)
+	return Conmon_IpStats{Struct: p.Struct()}, err
+}
+
+func (s Conmon_NetworkStats) HasIpv6() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_NetworkStats) SetIpv6(v Conmon_IpStats) error {
+	return s.Struct.SetPtr(2, v.Struct.ToPtr())
+}
+
+// NewIpv6 sets the ipv6 field to a newly
+// allocated Conmon_IpStats struct, preferring placement in s's segment.
+func (s Conmon_NetworkStats) NewIpv6() (Conmon_IpStats, error) {
+	ss, err := NewConmon_IpStats(s.Struct.Segment())
+	if err != nil {
+		return Conmon_IpStats{}, err
+	}
+	err = s.Struct.SetPtr(2, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_NetworkStats_List is a list of Conmon_NetworkStats.
+type Conmon_NetworkStats_List = capnp.StructList[Conmon_NetworkStats]
+
+// NewConmon_NetworkStats creates a new list of Conmon_NetworkStats.
+func NewConmon_NetworkStats_List(s *capnp.Segment, sz int32) (Conmon_NetworkStats_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_NetworkStats]{l}, err
+}
+
+// Conmon_NetworkStats_Future is a wrapper for a Conmon_NetworkStats promised by a client call.
+type Conmon_NetworkStats_Future struct{ *capnp.Future }
+
+func (p Conmon_NetworkStats_Future) Struct() (Conmon_NetworkStats, error) {
+	s, err := p.Future.Struct()
+	return Conmon_NetworkStats{s}, err
+}
+
+func (p Conmon_NetworkStats_Future) Ipv4() Conmon_IpStats_Future {
+	return Conmon_IpStats_Future{Future: p.Future.Field(1, nil)}
+}
+
+func (p Conmon_NetworkStats_Future) Ipv6() Conmon_IpStats_Future {
+	return Conmon_IpStats_Future{Future: p.Future.Field(2, nil)}
+}
+
+type Conmon_NetworkInterfaceStats struct{ capnp.Struct }
+
+// Conmon_NetworkInterfaceStats_TypeID is the unique identifier for the type Conmon_NetworkInterfaceStats.
+const Conmon_NetworkInterfaceStats_TypeID = 0xeda03e0f6c801890
+
+func NewConmon_NetworkInterfaceStats(s *capnp.Segment) (Conmon_NetworkInterfaceStats, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 64, PointerCount: 1})
+	return Conmon_NetworkInterfaceStats{st}, err
+}
+
+func NewRootConmon_NetworkInterfaceStats(s *capnp.Segment) (Conmon_NetworkInterfaceStats, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 64, PointerCount: 1})
+	return Conmon_NetworkInterfaceStats{st}, err
+}
+
+func ReadRootConmon_NetworkInterfaceStats(msg *capnp.Message) (Conmon_NetworkInterfaceStats, error) {
+	root, err := msg.Root()
+	return Conmon_NetworkInterfaceStats{root.Struct()}, err
+}
+
+func (s Conmon_NetworkInterfaceStats) String() string {
+	str, _ := text.Marshal(0xeda03e0f6c801890, s.Struct)
+	return str
+}
+
+func (s Conmon_NetworkInterfaceStats) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_NetworkInterfaceStats) HasName() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_NetworkInterfaceStats) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_NetworkInterfaceStats) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_NetworkInterfaceStats) RxBytes() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_NetworkInterfaceStats) SetRxBytes(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_NetworkInterfaceStats) RxPackets() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_NetworkInterfaceStats) SetRxPackets(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_NetworkInterfaceStats) RxErrors() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_NetworkInterfaceStats) SetRxErrors(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+func (s Conmon_NetworkInterfaceStats) RxDropped() uint64 {
+	return s.Struct.Uint64(24)
+}
+
+func (s Conmon_NetworkInterfaceStats) SetRxDropped(v uint64) {
+	s.Struct.SetUint64(24, v)
+}
+
+func (s Conmon_NetworkInterfaceStats) TxBytes() uint64 {
+	return s.Struct.Uint64(32)
+}
+
+func (s Conmon_NetworkInterfaceStats) SetTxBytes(v uint64) {
+	s.Struct.SetUint64(32, v)
+}
+
+func (s Conmon_NetworkInterfaceStats) TxPackets() uint64 {
+	return s.Struct.Uint64(40)
+}
+
+func (s Conmon_NetworkInterfaceStats) SetTxPackets(v uint64) {
+	s.Struct.SetUint64(40, v)
+}
+
+func (s Conmon_NetworkInterfaceStats) TxErrors() uint64 {
+	return s.Struct.Uint64(48)
+}
+
+func (s Conmon_NetworkInterfaceStats) SetTxErrors(v uint64) {
+	s.Struct.SetUint64(48, v)
+}
+
+func (s Conmon_NetworkInterfaceStats) TxDropped() uint64 {
+	return s.Struct.Uint64(56)
+}
+
+func (s Conmon_NetworkInterfaceStats) SetTxDropped(v uint64) {
+	s.Struct.SetUint64(56, v)
+}
+
+// Conmon_NetworkInterfaceStats_List is a list of Conmon_NetworkInterfaceStats.
+type Conmon_NetworkInterfaceStats_List = capnp.StructList[Conmon_NetworkInterfaceStats]
+
+// NewConmon_NetworkInterfaceStats creates a new list of Conmon_NetworkInterfaceStats.
+func NewConmon_NetworkInterfaceStats_List(s *capnp.Segment, sz int32) (Conmon_NetworkInterfaceStats_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 64, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_NetworkInterfaceStats]{l}, err
+}
+
+// Conmon_NetworkInterfaceStats_Future is a wrapper for a Conmon_NetworkInterfaceStats promised by a client call.
+type Conmon_NetworkInterfaceStats_Future struct{ *capnp.Future }
+
+func (p Conmon_NetworkInterfaceStats_Future) Struct() (Conmon_NetworkInterfaceStats, error) {
+	s, err := p.Future.Struct()
+	return Conmon_NetworkInterfaceStats{s}, err
+}
+
+type Conmon_IpStats struct{ capnp.Struct }
+
+// Conmon_IpStats_TypeID is the unique identifier for the type Conmon_IpStats.
+const Conmon_IpStats_TypeID = 0xe7a304c4d4f0c163
+
+func NewConmon_IpStats(s *capnp.Segment) (Conmon_IpStats, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 56, PointerCount: 0})
+	return Conmon_IpStats{st}, err
+}
+
+func NewRootConmon_IpStats(s *capnp.Segment) (Conmon_IpStats, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 56, PointerCount: 0})
+	return Conmon_IpStats{st}, err
+}
+
+func ReadRootConmon_IpStats(msg *capnp.Message) (Conmon_IpStats, error) {
+	root, err := msg.Root()
+	return Conmon_IpStats{root.Struct()}, err
+}
+
+func (s Conmon_IpStats) String() string {
+	str, _ := text.Marshal(0xe7a304c4d4f0c163, s.Struct)
+	return str
+}
+
+func (s Conmon_IpStats) Available() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Conmon_IpStats) SetAvailable(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+func (s Conmon_IpStats) InPackets() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_IpStats) SetInPackets(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_IpStats) OutPackets() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_IpStats) SetOutPackets(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+func (s Conmon_IpStats) InOctets() uint64 {
+	return s.Struct.Uint64(24)
+}
+
+func (s Conmon_IpStats) SetInOctets(v uint64) {
+	s.Struct.SetUint64(24, v)
+}
+
+func (s Conmon_IpStats) OutOctets() uint64 {
+	return s.Struct.Uint64(32)
+}
+
+func (s Conmon_IpStats) SetOutOctets(v uint64) {
+	s.Struct.SetUint64(32, v)
+}
+
+func (s Conmon_IpStats) InDiscards() uint64 {
+	return s.Struct.Uint64(40)
+}
+
+func (s Conmon_IpStats) SetInDiscards(v uint64) {
+	s.Struct.SetUint64(40, v)
+}
+
+func (s Conmon_IpStats) OutDiscards() uint64 {
+	return s.Struct.Uint64(48)
+}
+
+func (s Conmon_IpStats) SetOutDiscards(v uint64) {
+	s.Struct.SetUint64(48, v)
+}
+
+// Conmon_IpStats_List is a list of Conmon_IpStats.
+type Conmon_IpStats_List = capnp.StructList[Conmon_IpStats]
+
+// NewConmon_IpStats creates a new list of Conmon_IpStats.
+func NewConmon_IpStats_List(s *capnp.Segment, sz int32) (Conmon_IpStats_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 56, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_IpStats]{l}, err
+}
+
+// Conmon_IpStats_Future is a wrapper for a Conmon_IpStats promised by a client call.
+type Conmon_IpStats_Future struct{ *capnp.Future }
+
+func (p Conmon_IpStats_Future) Struct() (Conmon_IpStats, error) {
+	s, err := p.Future.Struct()
+	return Conmon_IpStats{s}, err
+}
+
+type Conmon_TenantQuotaRequest struct{ capnp.Struct }
+
+// Conmon_TenantQuotaRequest_TypeID is the unique identifier for the type Conmon_TenantQuotaRequest.
+const Conmon_TenantQuotaRequest_TypeID = 0xb521277328734a65
+
+func NewConmon_TenantQuotaRequest(s *capnp.Segment) (Conmon_TenantQuotaRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_TenantQuotaRequest{st}, err
+}
+
+func NewRootConmon_TenantQuotaRequest(s *capnp.Segment) (Conmon_TenantQuotaRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_TenantQuotaRequest{st}, err
+}
+
+func ReadRootConmon_TenantQuotaRequest(msg *capnp.Message) (Conmon_TenantQuotaRequest, error) {
+	root, err := msg.Root()
+	return Conmon_TenantQuotaRequest{root.Struct()}, err
+}
+
+func (s Conmon_TenantQuotaRequest) String() string {
+	str, _ := text.Marshal(0xb521277328734a65, s.Struct)
+	return str
+}
+
+// Conmon_TenantQuotaRequest_List is a list of Conmon_TenantQuotaRequest.
+type Conmon_TenantQuotaRequest_List = capnp.StructList[Conmon_TenantQuotaRequest]
+
+// NewConmon_TenantQuotaRequest creates a new list of Conmon_TenantQuotaRequest.
+func NewConmon_TenantQuotaRequest_List(s *capnp.Segment, sz int32) (Conmon_TenantQuotaRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_TenantQuotaRequest]{l}, err
+}
+
+// Conmon_TenantQuotaRequest_Future is a wrapper for a Conmon_TenantQuotaRequest promised by a client call.
+type Conmon_TenantQuotaRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_TenantQuotaRequest_Future) Struct() (Conmon_TenantQuotaRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_TenantQuotaRequest{s}, err
+}
+
+type Conmon_TenantQuotaResponse struct{ capnp.Struct }
+
+// Conmon_TenantQuotaResponse_TypeID is the unique identifier for the type Conmon_TenantQuotaResponse.
+const Conmon_TenantQuotaResponse_TypeID = 0xac0b4225e039c31c
+
+func NewConmon_TenantQuotaResponse(s *capnp.Segment) (Conmon_TenantQuotaResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_TenantQuotaResponse{st}, err
+}
+
+func NewRootConmon_TenantQuotaResponse(s *capnp.Segment) (Conmon_TenantQuotaResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_TenantQuotaResponse{st}, err
+}
+
+func ReadRootConmon_TenantQuotaResponse(msg *capnp.Message) (Conmon_TenantQuotaResponse, error) {
+	root, err := msg.Root()
+	return Conmon_TenantQuotaResponse{root.Struct()}, err
+}
+
+func (s Conmon_TenantQuotaResponse) String() string {
+	str, _ := text.Marshal(0xac0b4225e039c31c, s.Struct)
+	return str
+}
+
+func (s Conmon_TenantQuotaResponse) Containers() (Conmon_QuotaUsage, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_QuotaUsage{Struct: p.Struct()}, err
+}
+
+func (s Conmon_TenantQuotaResponse) HasContainers() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_TenantQuotaResponse) SetContainers(v Conmon_QuotaUsage) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewContainers sets the containers field to a newly
+// allocated Conmon_QuotaUsage struct, preferring placement in s's segment.
+func (s Conmon_TenantQuotaResponse) NewContainers() (Conmon_QuotaUsage, error) {
+	ss, err := NewConmon_QuotaUsage(s.Struct.Segment())
+	if err != nil {
+		return Conmon_QuotaUsage{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_TenantQuotaResponse) ExecSessions() (Conmon_QuotaUsage, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_QuotaUsage{Struct: p.Struct()}, err
+}
+
+func (s Conmon_TenantQuotaResponse) HasExecSessions() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_TenantQuotaResponse) SetExecSessions(v Conmon_QuotaUsage) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewExecSessions sets the execSessions field to a newly
+// allocated Conmon_QuotaUsage struct, preferring placement in s's segment.
+func (s Conmon_TenantQuotaResponse) NewExecSessions() (Conmon_QuotaUsage, error) {
+	ss, err := NewConmon_QuotaUsage(s.Struct.Segment())
+	if err != nil {
+		return Conmon_QuotaUsage{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_TenantQuotaResponse) LogBytes() (Conmon_QuotaUsage, error) {
+	p, err := s.Struct.Ptr(2)
+	return Conmon_QuotaUsage{Struct: p.Struct()}, err
+}
+
+func (s Conmon_TenantQuotaResponse) HasLogBytes() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_TenantQuotaResponse) SetLogBytes(v Conmon_QuotaUsage) error {
+	return s.Struct.SetPtr(2, v.Struct.ToPtr())
+}
+
+// NewLogBytes sets the logBytes field to a newly
+// allocated Conmon_QuotaUsage struct, preferring placement in s's segment.
+func (s Conmon_TenantQuotaResponse) NewLogBytes() (Conmon_QuotaUsage, error) {
+	ss, err := NewConmon_QuotaUsage(s.Struct.Segment())
+	if err != nil {
+		return Conmon_QuotaUsage{}, err
+	}
+	err = s.Struct.SetPtr(2, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_TenantQuotaResponse_List is a list of Conmon_TenantQuotaResponse.
+type Conmon_TenantQuotaResponse_List = capnp.StructList[Conmon_TenantQuotaResponse]
+
+// NewConmon_TenantQuotaResponse creates a new list of Conmon_TenantQuotaResponse.
+func NewConmon_TenantQuotaResponse_List(s *capnp.Segment, sz int32) (Conmon_TenantQuotaResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_TenantQuotaResponse]{l}, err
+}
+
+// Conmon_TenantQuotaResponse_Future is a wrapper for a Conmon_TenantQuotaResponse promised by a client call.
+type Conmon_TenantQuotaResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_TenantQuotaResponse_Future) Struct() (Conmon_TenantQuotaResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_TenantQuotaResponse{s}, err
+}
+
+func (p Conmon_TenantQuotaResponse_Future) Containers() Conmon_QuotaUsage_Future {
+	return Conmon_QuotaUsage_Future{Future: p.Future.Field(0, nil)}
+}
+
+func (p Conmon_TenantQuotaResponse_Future) ExecSessions() Conmon_QuotaUsage_Future {
+	return Conmon_QuotaUsage_Future{Future: p.Future.Field(1, nil)}
+}
+
+func (p Conmon_TenantQuotaResponse_Future) LogBytes() Conmon_QuotaUsage_Future {
+	return Conmon_QuotaUsage_Future{Future: p.Future.Field(2, nil)}
+}
+
+type Conmon_QuotaUsage struct{ capnp.Struct }
+
+// Conmon_QuotaUsage_TypeID is the unique identifier for the type Conmon_QuotaUsage.
+const Conmon_QuotaUsage_TypeID = 0xacb3cda2797eb884
+
+func NewConmon_QuotaUsage(s *capnp.Segment) (Conmon_QuotaUsage, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Conmon_QuotaUsage{st}, err
+}
+
+func NewRootConmon_QuotaUsage(s *capnp.Segment) (Conmon_QuotaUsage, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Conmon_QuotaUsage{st}, err
+}
+
+func ReadRootConmon_QuotaUsage(msg *capnp.Message) (Conmon_QuotaUsage, error) {
+	root, err := msg.Root()
+	return Conmon_QuotaUsage{root.Struct()}, err
+}
+
+func (s Conmon_QuotaUsage) String() string {
+	str, _ := text.Marshal(0xacb3cda2797eb884, s.Struct)
+	return str
+}
+
+func (s Conmon_QuotaUsage) Used() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_QuotaUsage) SetUsed(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_QuotaUsage) Limit() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_QuotaUsage) SetLimit(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+// Conmon_QuotaUsage_List is a list of Conmon_QuotaUsage.
+type Conmon_QuotaUsage_List = capnp.StructList[Conmon_QuotaUsage]
+
+// NewConmon_QuotaUsage creates a new list of Conmon_QuotaUsage.
+func NewConmon_QuotaUsage_List(s *capnp.Segment, sz int32) (Conmon_QuotaUsage_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_QuotaUsage]{l}, err
+}
+
+// Conmon_QuotaUsage_Future is a wrapper for a Conmon_QuotaUsage promised by a client call.
+type Conmon_QuotaUsage_Future struct{ *capnp.Future }
+
+func (p Conmon_QuotaUsage_Future) Struct() (Conmon_QuotaUsage, error) {
+	s, err := p.Future.Struct()
+	return Conmon_QuotaUsage{s}, err
+}
+
+type Conmon_ListContainersRequest struct{ capnp.Struct }
+
+// Conmon_ListContainersRequest_TypeID is the unique identifier for the type Conmon_ListContainersRequest.
+const Conmon_ListContainersRequest_TypeID = 0xbc1bca51fe8ba645
+
+func NewConmon_ListContainersRequest(s *capnp.Segment) (Conmon_ListContainersRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ListContainersRequest{st}, err
+}
+
+func NewRootConmon_ListContainersRequest(s *capnp.Segment) (Conmon_ListContainersRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ListContainersRequest{st}, err
+}
+
+func ReadRootConmon_ListContainersRequest(msg *capnp.Message) (Conmon_ListContainersRequest, error) {
+	root, err := msg.Root()
+	return Conmon_ListContainersRequest{root.Struct()}, err
+}
+
+func (s Conmon_ListContainersRequest) String() string {
+	str, _ := text.Marshal(0xbc1bca51fe8ba645, s.Struct)
+	return str
+}
+
+func (s Conmon_ListContainersRequest) LabelSelector() (Conmon_Label_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_Label_List{List: p.List()}, err
+}
+
+func (s Conmon_ListContainersRequest) HasLabelSelector() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ListContainersRequest) SetLabelSelector(v Conmon_Label_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewLabelSelector sets the labelSelector field to a newly
+// allocated Conmon_Label_List, preferring placement in s's segment.
+func (s Conmon_ListContainersRequest) NewLabelSelector(n int32) (Conmon_Label_List, error) {
+	l, err := NewConmon_Label_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_Label_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_ListContainersRequest_List is a list of Conmon_ListContainersRequest.
+type Conmon_ListContainersRequest_List = capnp.StructList[Conmon_ListContainersRequest]
+
+// NewConmon_ListContainersRequest creates a new list of Conmon_ListContainersRequest.
+func NewConmon_ListContainersRequest_List(s *capnp.Segment, sz int32) (Conmon_ListContainersRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ListContainersRequest]{l}, err
+}
+
+// Conmon_ListContainersRequest_Future is a wrapper for a Conmon_ListContainersRequest promised by a client call.
+type Conmon_ListContainersRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_ListContainersRequest_Future) Struct() (Conmon_ListContainersRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ListContainersRequest{s}, err
+}
+
+type Conmon_ListContainersResponse struct{ capnp.Struct }
+
+// Conmon_ListContainersResponse_TypeID is the unique identifier for the type Conmon_ListContainersResponse.
+const Conmon_ListContainersResponse_TypeID = 0xfdae861fa8890aa3
+
+func NewConmon_ListContainersResponse(s *capnp.Segment) (Conmon_ListContainersResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ListContainersResponse{st}, err
+}
+
+func NewRootConmon_ListContainersResponse(s *capnp.Segment) (Conmon_ListContainersResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ListContainersResponse{st}, err
+}
+
+func ReadRootConmon_ListContainersResponse(msg *capnp.Message) (Conmon_ListContainersResponse, error) {
+	root, err := msg.Root()
+	return Conmon_ListContainersResponse{root.Struct()}, err
+}
+
+func (s Conmon_ListContainersResponse) String() string {
+	str, _ := text.Marshal(0xfdae861fa8890aa3, s.Struct)
+	return str
+}
+
+func (s Conmon_ListContainersResponse) Containers() (Conmon_ContainerInfo_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerInfo_List{List: p.List()}, err
+}
+
+func (s Conmon_ListContainersResponse) HasContainers() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ListContainersResponse) SetContainers(v Conmon_ContainerInfo_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewContainers sets the containers field to a newly
+// allocated Conmon_ContainerInfo_List, preferring placement in s's segment.
+func (s Conmon_ListContainersResponse) NewContainers(n int32) (Conmon_ContainerInfo_List, error) {
+	l, err := NewConmon_ContainerInfo_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_ContainerInfo_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_ListContainersResponse_List is a list of Conmon_ListContainersResponse.
+type Conmon_ListContainersResponse_List = capnp.StructList[Conmon_ListContainersResponse]
+
+// NewConmon_ListContainersResponse creates a new list of Conmon_ListContainersResponse.
+func NewConmon_ListContainersResponse_List(s *capnp.Segment, sz int32) (Conmon_ListContainersResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ListContainersResponse]{l}, err
+}
+
+// Conmon_ListContainersResponse_Future is a wrapper for a Conmon_ListContainersResponse promised by a client call.
+type Conmon_ListContainersResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_ListContainersResponse_Future) Struct() (Conmon_ListContainersResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ListContainersResponse{s}, err
+}
+
+type Conmon_ContainerInfo struct{ capnp.Struct }
+
+// Conmon_ContainerInfo_TypeID is the unique identifier for the type Conmon_ContainerInfo.
+const Conmon_ContainerInfo_TypeID = 0xca8ef19be0ffbd77
+
+func NewConmon_ContainerInfo(s *capnp.Segment) (Conmon_ContainerInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_ContainerInfo{st}, err
+}
+
+func NewRootConmon_ContainerInfo(s *capnp.Segment) (Conmon_ContainerInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_ContainerInfo{st}, err
+}
+
+func ReadRootConmon_ContainerInfo(msg *capnp.Message) (Conmon_ContainerInfo, error) {
+	root, err := msg.Root()
+	return Conmon_ContainerInfo{root.Struct()}, err
+}
+
+func (s Conmon_ContainerInfo) String() string {
+	str, _ := text.Marshal(0xca8ef19be0ffbd77, s.Struct)
+	return str
+}
+
+func (s Conmon_ContainerInfo) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ContainerInfo) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ContainerInfo) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ContainerInfo) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ContainerInfo) Pid() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Conmon_ContainerInfo) SetPid(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s Conmon_ContainerInfo) Labels() (Conmon_Label_List, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_Label_List{List: p.List()}, err
+}
+
+func (s Conmon_ContainerInfo) HasLabels() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ContainerInfo) SetLabels(v Conmon_Label_List) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewLabels sets the labels field to a newly
+// allocated Conmon_Label_List, preferring placement in s's segment.
+func (s Conmon_ContainerInfo) NewLabels(n int32) (Conmon_Label_List, error) {
+	l, err := NewConmon_Label_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_Label_List{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_ContainerInfo_List is a list of Conmon_ContainerInfo.
+type Conmon_ContainerInfo_List = capnp.StructList[Conmon_ContainerInfo]
+
+// NewConmon_ContainerInfo creates a new list of Conmon_ContainerInfo.
+func NewConmon_ContainerInfo_List(s *capnp.Segment, sz int32) (Conmon_ContainerInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_ContainerInfo]{l}, err
+}
+
+// Conmon_ContainerInfo_Future is a wrapper for a Conmon_ContainerInfo promised by a client call.
+type Conmon_ContainerInfo_Future struct{ *capnp.Future }
+
+func (p Conmon_ContainerInfo_Future) Struct() (Conmon_ContainerInfo, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ContainerInfo{s}, err
+}
+
+type Conmon_StopContainerRequest struct{ capnp.Struct }
+
+// Conmon_StopContainerRequest_TypeID is the unique identifier for the type Conmon_StopContainerRequest.
+const Conmon_StopContainerRequest_TypeID = 0x8ffcab79749f8dc8
+
+func NewConmon_StopContainerRequest(s *capnp.Segment) (Conmon_StopContainerRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_StopContainerRequest{st}, err
+}
+
+func NewRootConmon_StopContainerRequest(s *capnp.Segment) (Conmon_StopContainerRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_StopContainerRequest{st}, err
+}
+
+func ReadRootConmon_StopContainerRequest(msg *capnp.Message) (Conmon_StopContainerRequest, error) {
+	root, err := msg.Root()
+	return Conmon_StopContainerRequest{root.Struct()}, err
+}
+
+func (s Conmon_StopContainerRequest) String() string {
+	str, _ := text.Marshal(0x8ffcab79749f8dc8, s.Struct)
+	return str
+}
+
+func (s Conmon_StopContainerRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_StopContainerRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_StopContainerRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_StopContainerRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_StopContainerRequest) TimeoutSec() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_StopContainerRequest) SetTimeoutSec(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+// Conmon_StopContainerRequest_List is a list of Conmon_StopContainerRequest.
+type Conmon_StopContainerRequest_List = capnp.StructList[Conmon_StopContainerRequest]
+
+// NewConmon_StopContainerRequest creates a new list of Conmon_StopContainerRequest.
+func NewConmon_StopContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_StopContainerRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_StopContainerRequest]{l}, err
+}
+
+// Conmon_StopContainerRequest_Future is a wrapper for a Conmon_StopContainerRequest promised by a client call.
+type Conmon_StopContainerRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_StopContainerRequest_Future) Struct() (Conmon_StopContainerRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_StopContainerRequest{s}, err
+}
+
+type Conmon_StopContainerResponse struct{ capnp.Struct }
+
+// Conmon_StopContainerResponse_TypeID is the unique identifier for the type Conmon_StopContainerResponse.
+const Conmon_StopContainerResponse_TypeID = 0xb30f1911e341e283
+
+func NewConmon_StopContainerResponse(s *capnp.Segment) (Conmon_StopContainerResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_StopContainerResponse{st}, err
+}
+
+func NewRootConmon_StopContainerResponse(s *capnp.Segment) (Conmon_StopContainerResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_StopContainerResponse{st}, err
+}
+
+func ReadRootConmon_StopContainerResponse(msg *capnp.Message) (Conmon_StopContainerResponse, error) {
+	root, err := msg.Root()
+	return Conmon_StopContainerResponse{root.Struct()}, err
+}
+
+func (s Conmon_StopContainerResponse) String() string {
+	str, _ := text.Marshal(0xb30f1911e341e283, s.Struct)
+	return str
+}
+
+func (s Conmon_StopContainerResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_StopContainerResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_StopContainerResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_StopContainerResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_StopContainerResponse_List is a list of Conmon_StopContainerResponse.
+type Conmon_StopContainerResponse_List = capnp.StructList[Conmon_StopContainerResponse]
+
+// NewConmon_StopContainerResponse creates a new list of Conmon_StopContainerResponse.
+func NewConmon_StopContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_StopContainerResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_StopContainerResponse]{l}, err
+}
+
+// Conmon_StopContainerResponse_Future is a wrapper for a Conmon_StopContainerResponse promised by a client call.
+type Conmon_StopContainerResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_StopContainerResponse_Future) Struct() (Conmon_StopContainerResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_StopContainerResponse{s}, err
+}
+
+func (p Conmon_StopContainerResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_WatchContainerEventsRequest struct{ capnp.Struct }
+
+// Conmon_WatchContainerEventsRequest_TypeID is the unique identifier for the type Conmon_WatchContainerEventsRequest.
+const Conmon_WatchContainerEventsRequest_TypeID = 0x9ad8fd7f599216a6
+
+func NewConmon_WatchContainerEventsRequest(s *capnp.Segment) (Conmon_WatchContainerEventsRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_WatchContainerEventsRequest{st}, err
+}
+
+func NewRootConmon_WatchContainerEventsRequest(s *capnp.Segment) (Conmon_WatchContainerEventsRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_WatchContainerEventsRequest{st}, err
+}
+
+func ReadRootConmon_WatchContainerEventsRequest(msg *capnp.Message) (Conmon_WatchContainerEventsRequest, error) {
+	root, err := msg.Root()
+	return Conmon_WatchContainerEventsRequest{root.Struct()}, err
+}
+
+func (s Conmon_WatchContainerEventsRequest) String() string {
+	str, _ := text.Marshal(0x9ad8fd7f599216a6, s.Struct)
+	return str
+}
+
+func (s Conmon_WatchContainerEventsRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_WatchContainerEventsRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_WatchContainerEventsRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_WatchContainerEventsRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_WatchContainerEventsRequest) Watcher() Conmon_ContainerEventWatcher {
+	p, _ := s.Struct.Ptr(1)
+	return Conmon_ContainerEventWatcher{Client: p.Interface().Client()}
+}
+
+func (s Conmon_WatchContainerEventsRequest) HasWatcher() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_WatchContainerEventsRequest) SetWatcher(v Conmon_ContainerEventWatcher) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(1, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(1, in.ToPtr())
+}
+
+// Conmon_WatchContainerEventsRequest_List is a list of Conmon_WatchContainerEventsRequest.
+type Conmon_WatchContainerEventsRequest_List = capnp.StructList[Conmon_WatchContainerEventsRequest]
+
+// NewConmon_WatchContainerEventsRequest creates a new list of Conmon_WatchContainerEventsRequest.
+func NewConmon_WatchContainerEventsRequest_List(s *capnp.Segment, sz int32) (Conmon_WatchContainerEventsRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_WatchContainerEventsRequest]{l}, err
+}
+
+// Conmon_WatchContainerEventsRequest_Future is a wrapper for a Conmon_WatchContainerEventsRequest promised by a client call.
+type Conmon_WatchContainerEventsRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_WatchContainerEventsRequest_Future) Struct() (Conmon_WatchContainerEventsRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_WatchContainerEventsRequest{s}, err
+}
+
+func (p Conmon_WatchContainerEventsRequest_Future) Watcher() Conmon_ContainerEventWatcher {
+	return Conmon_ContainerEventWatcher{Client: p.Future.Field(1, nil).Client()}
+}
+
+type Conmon_WatchContainerEventsResponse struct{ capnp.Struct }
+
+// Conmon_WatchContainerEventsResponse_TypeID is the unique identifier for the type Conmon_WatchContainerEventsResponse.
+const Conmon_WatchContainerEventsResponse_TypeID = 0xd7aec62dfbdd89f0
+
+func NewConmon_WatchContainerEventsResponse(s *capnp.Segment) (Conmon_WatchContainerEventsResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_WatchContainerEventsResponse{st}, err
+}
+
+func NewRootConmon_WatchContainerEventsResponse(s *capnp.Segment) (Conmon_WatchContainerEventsResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_WatchContainerEventsResponse{st}, err
+}
+
+func ReadRootConmon_WatchContainerEventsResponse(msg *capnp.Message) (Conmon_WatchContainerEventsResponse, error) {
+	root, err := msg.Root()
+	return Conmon_WatchContainerEventsResponse{root.Struct()}, err
+}
+
+func (s Conmon_WatchContainerEventsResponse) String() string {
+	str, _ := text.Marshal(0xd7aec62dfbdd89f0, s.Struct)
+	return str
+}
+
+// Conmon_WatchContainerEventsResponse_List is a list of Conmon_WatchContainerEventsResponse.
+type Conmon_WatchContainerEventsResponse_List = capnp.StructList[Conmon_WatchContainerEventsResponse]
+
+// NewConmon_WatchContainerEventsResponse creates a new list of Conmon_WatchContainerEventsResponse.
+func NewConmon_WatchContainerEventsResponse_List(s *capnp.Segment, sz int32) (Conmon_WatchContainerEventsResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_WatchContainerEventsResponse]{l}, err
+}
+
+// Conmon_WatchContainerEventsResponse_Future is a wrapper for a Conmon_WatchContainerEventsResponse promised by a client call.
+type Conmon_WatchContainerEventsResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_WatchContainerEventsResponse_Future) Struct() (Conmon_WatchContainerEventsResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_WatchContainerEventsResponse{s}, err
+}
+
+type Conmon_ContainerEventWatcher struct{ Client *capnp.Client }
+
+// Conmon_ContainerEventWatcher_TypeID is the unique identifier for the type Conmon_ContainerEventWatcher.
+const Conmon_ContainerEventWatcher_TypeID = 0x8101b81800b56a96
+
+func (c Conmon_ContainerEventWatcher) Event(ctx context.Context, params func(Conmon_ContainerEventWatcher_event_Params) error) (Conmon_ContainerEventWatcher_event_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x8101b81800b56a96,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.ContainerEventWatcher",
+			MethodName:    "event",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_ContainerEventWatcher_event_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_ContainerEventWatcher_event_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon_ContainerEventWatcher) Done(ctx context.Context, params func(Conmon_ContainerEventWatcher_done_Params) error) (Conmon_ContainerEventWatcher_done_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0x8101b81800b56a96,
+			MethodID:      1,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.ContainerEventWatcher",
+			MethodName:    "done",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_ContainerEventWatcher_done_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_ContainerEventWatcher_done_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Conmon_ContainerEventWatcher) AddRef() Conmon_ContainerEventWatcher {
+	return Conmon_ContainerEventWatcher{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Conmon_ContainerEventWatcher) Release() {
+	c.Client.Release()
+}
+
+// A Conmon_ContainerEventWatcher_Server is a Conmon_ContainerEventWatcher with a local implementation.
+type Conmon_ContainerEventWatcher_Server interface {
+	Event(context.Context, Conmon_ContainerEventWatcher_event) error
+
+	Done(context.Context, Conmon_ContainerEventWatcher_done) error
+}
+
+// Conmon_ContainerEventWatcher_NewServer creates a new Server from an implementation of Conmon_ContainerEventWatcher_Server.
+func Conmon_ContainerEventWatcher_NewServer(s Conmon_ContainerEventWatcher_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Conmon_ContainerEventWatcher_Methods(nil, s), s, c, policy)
+}
+
+// Conmon_ContainerEventWatcher_ServerToClient creates a new Client from an implementation of Conmon_ContainerEventWatcher_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Conmon_ContainerEventWatcher_ServerToClient(s Conmon_ContainerEventWatcher_Server, policy *server.Policy) Conmon_ContainerEventWatcher {
+	return Conmon_ContainerEventWatcher{Client: capnp.NewClient(Conmon_ContainerEventWatcher_NewServer(s, policy))}
+}
+
+// Conmon_ContainerEventWatcher_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Conmon_ContainerEventWatcher_Methods(methods []server.Method, s Conmon_ContainerEventWatcher_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x8101b81800b56a96,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.ContainerEventWatcher",
+			MethodName:    "event",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Event(ctx, Conmon_ContainerEventWatcher_event{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x8101b81800b56a96,
+			MethodID:      1,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.ContainerEventWatcher",
+			MethodName:    "done",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Done(ctx, Conmon_ContainerEventWatcher_done{call})
+		},
+	})
+
+	return methods
+}
+
+// Conmon_ContainerEventWatcher_event holds the state for a server call to Conmon_ContainerEventWatcher.event.
+// See server.Call for documentation.
+type Conmon_ContainerEventWatcher_event struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_ContainerEventWatcher_event) Args() Conmon_ContainerEventWatcher_event_Params {
+	return Conmon_ContainerEventWatcher_event_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_ContainerEventWatcher_event) AllocResults() (Conmon_ContainerEventWatcher_event_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_ContainerEventWatcher_event_Results{Struct: r}, err
+}
+
+// Conmon_ContainerEventWatcher_done holds the state for a server call to Conmon_ContainerEventWatcher.done.
+// See server.Call for documentation.
+type Conmon_ContainerEventWatcher_done struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_ContainerEventWatcher_done) Args() Conmon_ContainerEventWatcher_done_Params {
+	return Conmon_ContainerEventWatcher_done_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_ContainerEventWatcher_done) AllocResults() (Conmon_ContainerEventWatcher_done_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_ContainerEventWatcher_done_Results{Struct: r}, err
+}
+
+type Conmon_ContainerEventWatcher_event_Params struct{ capnp.Struct }
+
+// Conmon_ContainerEventWatcher_event_Params_TypeID is the unique identifier for the type Conmon_ContainerEventWatcher_event_Params.
+const Conmon_ContainerEventWatcher_event_Params_TypeID = 0x8e74e877862ab1fc
+
+func NewConmon_ContainerEventWatcher_event_Params(s *capnp.Segment) (Conmon_ContainerEventWatcher_event_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ContainerEventWatcher_event_Params{st}, err
+}
+
+func NewRootConmon_ContainerEventWatcher_event_Params(s *capnp.Segment) (Conmon_ContainerEventWatcher_event_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ContainerEventWatcher_event_Params{st}, err
+}
+
+func ReadRootConmon_ContainerEventWatcher_event_Params(msg *capnp.Message) (Conmon_ContainerEventWatcher_event_Params, error) {
+	root, err := msg.Root()
+	return Conmon_ContainerEventWatcher_event_Params{root.Struct()}, err
+}
+
+func (s Conmon_ContainerEventWatcher_event_Params) String() string {
+	str, _ := text.Marshal(0x8e74e877862ab1fc, s.Struct)
+	return str
+}
+
+func (s Conmon_ContainerEventWatcher_event_Params) Event() (Conmon_ContainerEvent, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerEvent{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ContainerEventWatcher_event_Params) HasEvent() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ContainerEventWatcher_event_Params) SetEvent(v Conmon_ContainerEvent) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewEvent sets the event field to a newly
+// allocated Conmon_ContainerEvent struct, preferring placement in s's segment.
+func (s Conmon_ContainerEventWatcher_event_Params) NewEvent() (Conmon_ContainerEvent, error) {
+	ss, err := NewConmon_ContainerEvent(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ContainerEvent{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_ContainerEventWatcher_event_Params_List is a list of Conmon_ContainerEventWatcher_event_Params.
+type Conmon_ContainerEventWatcher_event_Params_List = capnp.StructList[Conmon_ContainerEventWatcher_event_Params]
+
+// NewConmon_ContainerEventWatcher_event_Params creates a new list of Conmon_ContainerEventWatcher_event_Params.
+func NewConmon_ContainerEventWatcher_event_Params_List(s *capnp.Segment, sz int32) (Conmon_ContainerEventWatcher_event_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ContainerEventWatcher_event_Params]{l}, err
+}
+
+// Conmon_ContainerEventWatcher_event_Params_Future is a wrapper for a Conmon_ContainerEventWatcher_event_Params promised by a client call.
+type Conmon_ContainerEventWatcher_event_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_ContainerEventWatcher_event_Params_Future) Struct() (Conmon_ContainerEventWatcher_event_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ContainerEventWatcher_event_Params{s}, err
+}
+
+func (p Conmon_ContainerEventWatcher_event_Params_Future) Event() Conmon_ContainerEvent_Future {
+	return Conmon_ContainerEvent_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_ContainerEventWatcher_event_Results struct{ capnp.Struct }
+
+// Conmon_ContainerEventWatcher_event_Results_TypeID is the unique identifier for the type Conmon_ContainerEventWatcher_event_Results.
+const Conmon_ContainerEventWatcher_event_Results_TypeID = 0xdaa272aff9507fc0
+
+func NewConmon_ContainerEventWatcher_event_Results(s *capnp.Segment) (Conmon_ContainerEventWatcher_event_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_ContainerEventWatcher_event_Results{st}, err
+}
+
+func NewRootConmon_ContainerEventWatcher_event_Results(s *capnp.Segment) (Conmon_ContainerEventWatcher_event_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_ContainerEventWatcher_event_Results{st}, err
+}
+
+func ReadRootConmon_ContainerEventWatcher_event_Results(msg *capnp.Message) (Conmon_ContainerEventWatcher_event_Results, error) {
+	root, err := msg.Root()
+	return Conmon_ContainerEventWatcher_event_Results{root.Struct()}, err
+}
+
+func (s Conmon_ContainerEventWatcher_event_Results) String() string {
+	str, _ := text.Marshal(0xdaa272aff9507fc0, s.Struct)
+	return str
+}
+
+// Conmon_ContainerEventWatcher_event_Results_List is a list of Conmon_ContainerEventWatcher_event_Results.
+type Conmon_ContainerEventWatcher_event_Results_List = capnp.StructList[Conmon_ContainerEventWatcher_event_Results]
+
+// NewConmon_ContainerEventWatcher_event_Results creates a new list of Conmon_ContainerEventWatcher_event_Results.
+func NewConmon_ContainerEventWatcher_event_Results_List(s *capnp.Segment, sz int32) (Conmon_ContainerEventWatcher_event_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_ContainerEventWatcher_event_Results]{l}, err
+}
+
+// Conmon_ContainerEventWatcher_event_Results_Future is a wrapper for a Conmon_ContainerEventWatcher_event_Results promised by a client call.
+type Conmon_ContainerEventWatcher_event_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_ContainerEventWatcher_event_Results_Future) Struct() (Conmon_ContainerEventWatcher_event_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ContainerEventWatcher_event_Results{s}, err
+}
+
+type Conmon_ContainerEventWatcher_done_Params struct{ capnp.Struct }
+
+// Conmon_ContainerEventWatcher_done_Params_TypeID is the unique identifier for the type Conmon_ContainerEventWatcher_done_Params.
+const Conmon_ContainerEventWatcher_done_Params_TypeID = 0xb7ed9aac85d16f68
+
+func NewConmon_ContainerEventWatcher_done_Params(s *capnp.Segment) (Conmon_ContainerEventWatcher_done_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ContainerEventWatcher_done_Params{st}, err
+}
+
+func NewRootConmon_ContainerEventWatcher_done_Params(s *capnp.Segment) (Conmon_ContainerEventWatcher_done_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ContainerEventWatcher_done_Params{st}, err
+}
+
+func ReadRootConmon_ContainerEventWatcher_done_Params(msg *capnp.Message) (Conmon_ContainerEventWatcher_done_Params, error) {
+	root, err := msg.Root()
+	return Conmon_ContainerEventWatcher_done_Params{root.Struct()}, err
+}
+
+func (s Conmon_ContainerEventWatcher_done_Params) String() string {
+	str, _ := text.Marshal(0xb7ed9aac85d16f68, s.Struct)
+	return str
+}
+
+func (s Conmon_ContainerEventWatcher_done_Params) Error() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ContainerEventWatcher_done_Params) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ContainerEventWatcher_done_Params) ErrorBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ContainerEventWatcher_done_Params) SetError(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_ContainerEventWatcher_done_Params_List is a list of Conmon_ContainerEventWatcher_done_Params.
+type Conmon_ContainerEventWatcher_done_Params_List = capnp.StructList[Conmon_ContainerEventWatcher_done_Params]
+
+// NewConmon_ContainerEventWatcher_done_Params creates a new list of Conmon_ContainerEventWatcher_done_Params.
+func NewConmon_ContainerEventWatcher_done_Params_List(s *capnp.Segment, sz int32) (Conmon_ContainerEventWatcher_done_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ContainerEventWatcher_done_Params]{l}, err
+}
+
+// Conmon_ContainerEventWatcher_done_Params_Future is a wrapper for a Conmon_ContainerEventWatcher_done_Params promised by a client call.
+type Conmon_ContainerEventWatcher_done_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_ContainerEventWatcher_done_Params_Future) Struct() (Conmon_ContainerEventWatcher_done_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ContainerEventWatcher_done_Params{s}, err
+}
+
+type Conmon_ContainerEventWatcher_done_Results struct{ capnp.Struct }
+
+// Conmon_ContainerEventWatcher_done_Results_TypeID is the unique identifier for the type Conmon_ContainerEventWatcher_done_Results.
+const Conmon_ContainerEventWatcher_done_Results_TypeID = 0x9ed86251d579582d
+
+func NewConmon_ContainerEventWatcher_done_Results(s *capnp.Segment) (Conmon_ContainerEventWatcher_done_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_ContainerEventWatcher_done_Results{st}, err
+}
+
+func NewRootConmon_ContainerEventWatcher_done_Results(s *capnp.Segment) (Conmon_ContainerEventWatcher_done_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_ContainerEventWatcher_done_Results{st}, err
+}
+
+func ReadRootConmon_ContainerEventWatcher_done_Results(msg *capnp.Message) (Conmon_ContainerEventWatcher_done_Results, error) {
+	root, err := msg.Root()
+	return Conmon_ContainerEventWatcher_done_Results{root.Struct()}, err
+}
+
+func (s Conmon_ContainerEventWatcher_done_Results) String() string {
+	str, _ := text.Marshal(0x9ed86251d579582d, s.Struct)
+	return str
+}
+
+// Conmon_ContainerEventWatcher_done_Results_List is a list of Conmon_ContainerEventWatcher_done_Results.
+type Conmon_ContainerEventWatcher_done_Results_List = capnp.StructList[Conmon_ContainerEventWatcher_done_Results]
+
+// NewConmon_ContainerEventWatcher_done_Results creates a new list of Conmon_ContainerEventWatcher_done_Results.
+func NewConmon_ContainerEventWatcher_done_Results_List(s *capnp.Segment, sz int32) (Conmon_ContainerEventWatcher_done_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_ContainerEventWatcher_done_Results]{l}, err
+}
+
+// Conmon_ContainerEventWatcher_done_Results_Future is a wrapper for a Conmon_ContainerEventWatcher_done_Results promised by a client call.
+type Conmon_ContainerEventWatcher_done_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_ContainerEventWatcher_done_Results_Future) Struct() (Conmon_ContainerEventWatcher_done_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ContainerEventWatcher_done_Results{s}, err
+}
+
+type Conmon_ContainerEvent struct{ capnp.Struct }
+
+// Conmon_ContainerEvent_TypeID is the unique identifier for the type Conmon_ContainerEvent.
+const Conmon_ContainerEvent_TypeID = 0x9017adaffb99a954
+
+func NewConmon_ContainerEvent(s *capnp.Segment) (Conmon_ContainerEvent, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
+	return Conmon_ContainerEvent{st}, err
+}
+
+func NewRootConmon_ContainerEvent(s *capnp.Segment) (Conmon_ContainerEvent, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
+	return Conmon_ContainerEvent{st}, err
+}
+
+func ReadRootConmon_ContainerEvent(msg *capnp.Message) (Conmon_ContainerEvent, error) {
+	root, err := msg.Root()
+	return Conmon_ContainerEvent{root.Struct()}, err
+}
+
+func (s Conmon_ContainerEvent) String() string {
+	str, _ := text.Marshal(0x9017adaffb99a954, s.Struct)
+	return str
+}
+
+func (s Conmon_ContainerEvent) Type() Conmon_ContainerEvent_Type {
+	return Conmon_ContainerEvent_Type(s.Struct.Uint16(0))
+}
+
+func (s Conmon_ContainerEvent) SetType(v Conmon_ContainerEvent_Type) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Conmon_ContainerEvent) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ContainerEvent) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ContainerEvent) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ContainerEvent) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ContainerEvent) ExitCode() int32 {
+	return int32(s.Struct.Uint32(4))
+}
+
+func (s Conmon_ContainerEvent) SetExitCode(v int32) {
+	s.Struct.SetUint32(4, uint32(v))
+}
+
+func (s Conmon_ContainerEvent) Timestamp() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_ContainerEvent) SetTimestamp(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_ContainerEvent) Pid() uint32 {
+	return s.Struct.Uint32(16)
+}
+
+func (s Conmon_ContainerEvent) SetPid(v uint32) {
+	s.Struct.SetUint32(16, v)
+}
+
+func (s Conmon_ContainerEvent) State() Conmon_ContainerState {
+	return Conmon_ContainerState(s.Struct.Uint16(2))
+}
+
+func (s Conmon_ContainerEvent) SetState(v Conmon_ContainerState) {
+	s.Struct.SetUint16(2, uint16(v))
+}
+
+func (s Conmon_ContainerEvent) PreviousState() Conmon_ContainerState {
+	return Conmon_ContainerState(s.Struct.Uint16(20))
+}
+
+func (s Conmon_ContainerEvent) SetPreviousState(v Conmon_ContainerState) {
+	s.Struct.SetUint16(20, uint16(v))
+}
+
+func (s Conmon_ContainerEvent) ExecSessionId() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_ContainerEvent) HasExecSessionId() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ContainerEvent) ExecSessionIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ContainerEvent) SetExecSessionId(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+// Conmon_ContainerEvent_List is a list of Conmon_ContainerEvent.
+type Conmon_ContainerEvent_List = capnp.StructList[Conmon_ContainerEvent]
+
+// NewConmon_ContainerEvent creates a new list of Conmon_ContainerEvent.
+func NewConmon_ContainerEvent_List(s *capnp.Segment, sz int32) (Conmon_ContainerEvent_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_ContainerEvent]{l}, err
+}
+
+// Conmon_ContainerEvent_Future is a wrapper for a Conmon_ContainerEvent promised by a client call.
+type Conmon_ContainerEvent_Future struct{ *capnp.Future }
+
+func (p Conmon_ContainerEvent_Future) Struct() (Conmon_ContainerEvent, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ContainerEvent{s}, err
+}
+
+type Conmon_ContainerEvent_Type uint16
+
+// Conmon_ContainerEvent_Type_TypeID is the unique identifier for the type Conmon_ContainerEvent_Type.
+const Conmon_ContainerEvent_Type_TypeID = 0x9bd5ecd9970b4cf0
+
+// Values of Conmon_ContainerEvent_Type.
+const (
+	Conmon_ContainerEvent_Type_oomKilled       Conmon_ContainerEvent_Type = 0
+	Conmon_ContainerEvent_Type_exited          Conmon_ContainerEvent_Type = 1
+	Conmon_ContainerEvent_Type_paused          Conmon_ContainerEvent_Type = 2
+	Conmon_ContainerEvent_Type_resumed         Conmon_ContainerEvent_Type = 3
+	Conmon_ContainerEvent_Type_watchdogExpired Conmon_ContainerEvent_Type = 4
+	Conmon_ContainerEvent_Type_reaped          Conmon_ContainerEvent_Type = 5
+	Conmon_ContainerEvent_Type_stateChanged    Conmon_ContainerEvent_Type = 6
+	Conmon_ContainerEvent_Type_execExited      Conmon_ContainerEvent_Type = 7
+)
+
+// String returns the enum's constant name.
+func (c Conmon_ContainerEvent_Type) String() string {
+	switch c {
+	case Conmon_ContainerEvent_Type_oomKilled:
+		return "oomKilled"
+	case Conmon_ContainerEvent_Type_exited:
+		return "exited"
+	case Conmon_ContainerEvent_Type_paused:
+		return "paused"
+	case Conmon_ContainerEvent_Type_resumed:
+		return "resumed"
+	case Conmon_ContainerEvent_Type_watchdogExpired:
+		return "watchdogExpired"
+	case Conmon_ContainerEvent_Type_reaped:
+		return "reaped"
+	case Conmon_ContainerEvent_Type_stateChanged:
+		return "stateChanged"
+	case Conmon_ContainerEvent_Type_execExited:
+		return "execExited"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_ContainerEvent_TypeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_ContainerEvent_TypeFromString(c string) Conmon_ContainerEvent_Type {
+	switch c {
+	case "oomKilled":
+		return Conmon_ContainerEvent_Type_oomKilled
+	case "exited":
+		return Conmon_ContainerEvent_Type_exited
+	case "paused":
+		return Conmon_ContainerEvent_Type_paused
+	case "resumed":
+		return Conmon_ContainerEvent_Type_resumed
+	case "watchdogExpired":
+		return Conmon_ContainerEvent_Type_watchdogExpired
+	case "reaped":
+		return Conmon_ContainerEvent_Type_reaped
+	case "stateChanged":
+		return Conmon_ContainerEvent_Type_stateChanged
+	case "execExited":
+		return Conmon_ContainerEvent_Type_execExited
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_ContainerEvent_Type_List = capnp.EnumList[Conmon_ContainerEvent_Type]
+
+func NewConmon_ContainerEvent_Type_List(s *capnp.Segment, sz int32) (Conmon_ContainerEvent_Type_List, error) {
+	return capnp.NewEnumList[Conmon_ContainerEvent_Type](s, sz)
+}
+
+type Conmon_SupportBundleRequest struct{ capnp.Struct }
+
+// Conmon_SupportBundleRequest_TypeID is the unique identifier for the type Conmon_SupportBundleRequest.
+const Conmon_SupportBundleRequest_TypeID = 0xc495a5057fb98032
+
+func NewConmon_SupportBundleRequest(s *capnp.Segment) (Conmon_SupportBundleRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_SupportBundleRequest{st}, err
+}
+
+func NewRootConmon_SupportBundleRequest(s *capnp.Segment) (Conmon_SupportBundleRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_SupportBundleRequest{st}, err
+}
+
+func ReadRootConmon_SupportBundleRequest(msg *capnp.Message) (Conmon_SupportBundleRequest, error) {
+	root, err := msg.Root()
+	return Conmon_SupportBundleRequest{root.Struct()}, err
+}
+
+func (s Conmon_SupportBundleRequest) String() string {
+	str, _ := text.Marshal(0xc495a5057fb98032, s.Struct)
+	return str
+}
+
+// Conmon_SupportBundleRequest_List is a list of Conmon_SupportBundleRequest.
+type Conmon_SupportBundleRequest_List = capnp.StructList[Conmon_SupportBundleRequest]
+
+// NewConmon_SupportBundleRequest creates a new list of Conmon_SupportBundleRequest.
+func NewConmon_SupportBundleRequest_List(s *capnp.Segment, sz int32) (Conmon_SupportBundleRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_SupportBundleRequest]{l}, err
+}
+
+// Conmon_SupportBundleRequest_Future is a wrapper for a Conmon_SupportBundleRequest promised by a client call.
+type Conmon_SupportBundleRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_SupportBundleRequest_Future) Struct() (Conmon_SupportBundleRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SupportBundleRequest{s}, err
+}
+
+type Conmon_SupportBundleResponse struct{ capnp.Struct }
+
+// Conmon_SupportBundleResponse_TypeID is the unique identifier for the type Conmon_SupportBundleResponse.
+const Conmon_SupportBundleResponse_TypeID = 0xfc3863c375973cf7
+
+func NewConmon_SupportBundleResponse(s *capnp.Segment) (Conmon_SupportBundleResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_SupportBundleResponse{st}, err
+}
+
+func NewRootConmon_SupportBundleResponse(s *capnp.Segment) (Conmon_SupportBundleResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_SupportBundleResponse{st}, err
+}
+
+func ReadRootConmon_SupportBundleResponse(msg *capnp.Message) (Conmon_SupportBundleResponse, error) {
+	root, err := msg.Root()
+	return Conmon_SupportBundleResponse{root.Struct()}, err
+}
+
+func (s Conmon_SupportBundleResponse) String() string {
+	str, _ := text.Marshal(0xfc3863c375973cf7, s.Struct)
+	return str
+}
+
+func (s Conmon_SupportBundleResponse) Config() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_SupportBundleResponse) HasConfig() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_SupportBundleResponse) ConfigBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_SupportBundleResponse) SetConfig(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_SupportBundleResponse) Logs() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(1)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_SupportBundleResponse) HasLogs() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_SupportBundleResponse) SetLogs(v capnp.TextList) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewLogs sets the logs field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_SupportBundleResponse) NewLogs(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_SupportBundleResponse) Events() (Conmon_ContainerEvent_List, error) {
+	p, err := s.Struct.Ptr(2)
+	return Conmon_ContainerEvent_List{List: p.List()}, err
+}
+
+func (s Conmon_SupportBundleResponse) HasEvents() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_SupportBundleResponse) SetEvents(v Conmon_ContainerEvent_List) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
+}
+
+// NewEvents sets the events field to a newly
+// allocated Conmon_ContainerEvent_List, preferring placement in s's segment.
+func (s Conmon_SupportBundleResponse) NewEvents(n int32) (Conmon_ContainerEvent_List, error) {
+	l, err := NewConmon_ContainerEvent_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_ContainerEvent_List{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_SupportBundleResponse_List is a list of Conmon_SupportBundleResponse.
+type Conmon_SupportBundleResponse_List = capnp.StructList[Conmon_SupportBundleResponse]
+
+// NewConmon_SupportBundleResponse creates a new list of Conmon_SupportBundleResponse.
+func NewConmon_SupportBundleResponse_List(s *capnp.Segment, sz int32) (Conmon_SupportBundleResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_SupportBundleResponse]{l}, err
+}
+
+// Conmon_SupportBundleResponse_Future is a wrapper for a Conmon_SupportBundleResponse promised by a client call.
+type Conmon_SupportBundleResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_SupportBundleResponse_Future) Struct() (Conmon_SupportBundleResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SupportBundleResponse{s}, err
+}
+
+type Conmon_WaitContainerRequest struct{ capnp.Struct }
+
+// Conmon_WaitContainerRequest_TypeID is the unique identifier for the type Conmon_WaitContainerRequest.
+const Conmon_WaitContainerRequest_TypeID = 0x8bf9d41f934c512d
+
+func NewConmon_WaitContainerRequest(s *capnp.Segment) (Conmon_WaitContainerRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_WaitContainerRequest{st}, err
+}
+
+func NewRootConmon_WaitContainerRequest(s *capnp.Segment) (Conmon_WaitContainerRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_WaitContainerRequest{st}, err
+}
+
+func ReadRootConmon_WaitContainerRequest(msg *capnp.Message) (Conmon_WaitContainerRequest, error) {
+	root, err := msg.Root()
+	return Conmon_WaitContainerRequest{root.Struct()}, err
+}
+
+func (s Conmon_WaitContainerRequest) String() string {
+	str, _ := text.Marshal(0x8bf9d41f934c512d, s.Struct)
+	return str
+}
+
+func (s Conmon_WaitContainerRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_WaitContainerRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_WaitContainerRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_WaitContainerRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_WaitContainerRequest) ExecSessionId() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_WaitContainerRequest) HasExecSessionId() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_WaitContainerRequest) ExecSessionIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_WaitContainerRequest) SetExecSessionId(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+// Conmon_WaitContainerRequest_List is a list of Conmon_WaitContainerRequest.
+type Conmon_WaitContainerRequest_List = capnp.StructList[Conmon_WaitContainerRequest]
+
+// NewConmon_WaitContainerRequest creates a new list of Conmon_WaitContainerRequest.
+func NewConmon_WaitContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_WaitContainerRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_WaitContainerRequest]{l}, err
+}
+
+// Conmon_WaitContainerRequest_Future is a wrapper for a Conmon_WaitContainerRequest promised by a client call.
+type Conmon_WaitContainerRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_WaitContainerRequest_Future) Struct() (Conmon_WaitContainerRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_WaitContainerRequest{s}, err
+}
+
+type Conmon_WaitContainerResponse struct{ capnp.Struct }
+
+// Conmon_WaitContainerResponse_TypeID is the unique identifier for the type Conmon_WaitContainerResponse.
+const Conmon_WaitContainerResponse_TypeID = 0xb6f01d18a2b0fd8e
+
+func NewConmon_WaitContainerResponse(s *capnp.Segment) (Conmon_WaitContainerResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Conmon_WaitContainerResponse{st}, err
+}
+
+func NewRootConmon_WaitContainerResponse(s *capnp.Segment) (Conmon_WaitContainerResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Conmon_WaitContainerResponse{st}, err
+}
+
+func ReadRootConmon_WaitContainerResponse(msg *capnp.Message) (Conmon_WaitContainerResponse, error) {
+	root, err := msg.Root()
+	return Conmon_WaitContainerResponse{root.Struct()}, err
+}
+
+func (s Conmon_WaitContainerResponse) String() string {
+	str, _ := text.Marshal(0xb6f01d18a2b0fd8e, s.Struct)
+	return str
+}
+
+func (s Conmon_WaitContainerResponse) ExitCode() int32 {
+	return int32(s.Struct.Uint32(0))
+}
+
+func (s Conmon_WaitContainerResponse) SetExitCode(v int32) {
+	s.Struct.SetUint32(0, uint32(v))
+}
+
+func (s Conmon_WaitContainerResponse) Timestamp() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_WaitContainerResponse) SetTimestamp(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+// Conmon_WaitContainerResponse_List is a list of Conmon_WaitContainerResponse.
+type Conmon_WaitContainerResponse_List = capnp.StructList[Conmon_WaitContainerResponse]
+
+// NewConmon_WaitContainerResponse creates a new list of Conmon_WaitContainerResponse.
+func NewConmon_WaitContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_WaitContainerResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_WaitContainerResponse]{l}, err
+}
+
+// Conmon_WaitContainerResponse_Future is a wrapper for a Conmon_WaitContainerResponse promised by a client call.
+type Conmon_WaitContainerResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_WaitContainerResponse_Future) Struct() (Conmon_WaitContainerResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_WaitContainerResponse{s}, err
+}
+
+type Conmon_CrashReport struct{ capnp.Struct }
+
+// Conmon_CrashReport_TypeID is the unique identifier for the type Conmon_CrashReport.
+const Conmon_CrashReport_TypeID = 0xf1d4840d20d62e34
+
+func NewConmon_CrashReport(s *capnp.Segment) (Conmon_CrashReport, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
+	return Conmon_CrashReport{st}, err
+}
+
+func NewRootConmon_CrashReport(s *capnp.Segment) (Conmon_CrashReport, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4})
+	return Conmon_CrashReport{st}, err
+}
+
+func ReadRootConmon_CrashReport(msg *capnp.Message) (Conmon_CrashReport, error) {
+	root, err := msg.Root()
+	return Conmon_CrashReport{root.Struct()}, err
+}
+
+func (s Conmon_CrashReport) String() string {
+	str, _ := text.Marshal(0xf1d4840d20d62e34, s.Struct)
+	return str
+}
+
+func (s Conmon_CrashReport) Timestamp() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_CrashReport) SetTimestamp(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_CrashReport) Pid() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s Conmon_CrashReport) SetPid(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+func (s Conmon_CrashReport) Message() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_CrashReport) HasMessage() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CrashReport) MessageBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CrashReport) SetMessage(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_CrashReport) Backtrace() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_CrashReport) HasBacktrace() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_CrashReport) BacktraceBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CrashReport) SetBacktrace(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_CrashReport) RecentRpcs() (Conmon_CrashReport_Rpc_List, error) {
+	p, err := s.Struct.Ptr(2)
+	return Conmon_CrashReport_Rpc_List{List: p.List()}, err
+}
+
+func (s Conmon_CrashReport) HasRecentRpcs() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_CrashReport) SetRecentRpcs(v Conmon_CrashReport_Rpc_List) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
+}
+
+// NewRecentRpcs sets the recentRpcs field to a newly
+// allocated Conmon_CrashReport_Rpc_List, preferring placement in s's segment.
+func (s Conmon_CrashReport) NewRecentRpcs(n int32) (Conmon_CrashReport_Rpc_List, error) {
+	l, err := NewConmon_CrashReport_Rpc_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_CrashReport_Rpc_List{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_CrashReport) ContainerIds() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(3)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_CrashReport) HasContainerIds() bool {
+	return s.Struct.HasPtr(3)
+}
+
+func (s Conmon_CrashReport) SetContainerIds(v capnp.TextList) error {
+	return s.Struct.SetPtr(3, v.List.ToPtr())
+}
+
+// NewContainerIds sets the containerIds field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_CrashReport) NewContainerIds(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(3, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_CrashReport_List is a list of Conmon_CrashReport.
+type Conmon_CrashReport_List = capnp.StructList[Conmon_CrashReport]
+
+// NewConmon_CrashReport creates a new list of Conmon_CrashReport.
+func NewConmon_CrashReport_List(s *capnp.Segment, sz int32) (Conmon_CrashReport_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 4}, sz)
+	return capnp.StructList[Conmon_CrashReport]{l}, err
+}
+
+// Conmon_CrashReport_Future is a wrapper for a Conmon_CrashReport promised by a client call.
+type Conmon_CrashReport_Future struct{ *capnp.Future }
+
+func (p Conmon_CrashReport_Future) Struct() (Conmon_CrashReport, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CrashReport{s}, err
+}
+
+type Conmon_CrashReport_Rpc struct{ capnp.Struct }
+
+// Conmon_CrashReport_Rpc_TypeID is the unique identifier for the type Conmon_CrashReport_Rpc.
+const Conmon_CrashReport_Rpc_TypeID = 0xe7c5a149df911f70
+
+func NewConmon_CrashReport_Rpc(s *capnp.Segment) (Conmon_CrashReport_Rpc, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_CrashReport_Rpc{st}, err
+}
+
+func NewRootConmon_CrashReport_Rpc(s *capnp.Segment) (Conmon_CrashReport_Rpc, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_CrashReport_Rpc{st}, err
+}
+
+func ReadRootConmon_CrashReport_Rpc(msg *capnp.Message) (Conmon_CrashReport_Rpc, error) {
+	root, err := msg.Root()
+	return Conmon_CrashReport_Rpc{root.Struct()}, err
+}
+
+func (s Conmon_CrashReport_Rpc) String() string {
+	str, _ := text.Marshal(0xe7c5a149df911f70, s.Struct)
+	return str
+}
+
+func (s Conmon_CrashReport_Rpc) Method() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_CrashReport_Rpc) HasMethod() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CrashReport_Rpc) MethodBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CrashReport_Rpc) SetMethod(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_CrashReport_Rpc) ContainerId() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_CrashReport_Rpc) HasContainerId() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_CrashReport_Rpc) ContainerIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CrashReport_Rpc) SetContainerId(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_CrashReport_Rpc) Timestamp() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_CrashReport_Rpc) SetTimestamp(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+// Conmon_CrashReport_Rpc_List is a list of Conmon_CrashReport_Rpc.
+type Conmon_CrashReport_Rpc_List = capnp.StructList[Conmon_CrashReport_Rpc]
+
+// NewConmon_CrashReport_Rpc creates a new list of Conmon_CrashReport_Rpc.
+func NewConmon_CrashReport_Rpc_List(s *capnp.Segment, sz int32) (Conmon_CrashReport_Rpc_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_CrashReport_Rpc]{l}, err
+}
+
+// Conmon_CrashReport_Rpc_Future is a wrapper for a Conmon_CrashReport_Rpc promised by a client call.
+type Conmon_CrashReport_Rpc_Future struct{ *capnp.Future }
+
+func (p Conmon_CrashReport_Rpc_Future) Struct() (Conmon_CrashReport_Rpc, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CrashReport_Rpc{s}, err
+}
+
+type Conmon_SetWatchdogRequest struct{ capnp.Struct }
+
+// Conmon_SetWatchdogRequest_TypeID is the unique identifier for the type Conmon_SetWatchdogRequest.
+const Conmon_SetWatchdogRequest_TypeID = 0x91f4aad4a8e7009d
+
+func NewConmon_SetWatchdogRequest(s *capnp.Segment) (Conmon_SetWatchdogRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_SetWatchdogRequest{st}, err
+}
+
+func NewRootConmon_SetWatchdogRequest(s *capnp.Segment) (Conmon_SetWatchdogRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_SetWatchdogRequest{st}, err
+}
+
+func ReadRootConmon_SetWatchdogRequest(msg *capnp.Message) (Conmon_SetWatchdogRequest, error) {
+	root, err := msg.Root()
+	return Conmon_SetWatchdogRequest{root.Struct()}, err
+}
+
+func (s Conmon_SetWatchdogRequest) String() string {
+	str, _ := text.Marshal(0x91f4aad4a8e7009d, s.Struct)
+	return str
+}
+
+func (s Conmon_SetWatchdogRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_SetWatchdogRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_SetWatchdogRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_SetWatchdogRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_SetWatchdogRequest) TimeoutSec() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_SetWatchdogRequest) SetTimeoutSec(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_SetWatchdogRequest) Policy() Conmon_SetWatchdogRequest_Policy {
+	return Conmon_SetWatchdogRequest_Policy(s.Struct.Uint16(8))
+}
+
+func (s Conmon_SetWatchdogRequest) SetPolicy(v Conmon_SetWatchdogRequest_Policy) {
+	s.Struct.SetUint16(8, uint16(v))
+}
+
+// Conmon_SetWatchdogRequest_List is a list of Conmon_SetWatchdogRequest.
+type Conmon_SetWatchdogRequest_List = capnp.StructList[Conmon_SetWatchdogRequest]
+
+// NewConmon_SetWatchdogRequest creates a new list of Conmon_SetWatchdogRequest.
+func NewConmon_SetWatchdogRequest_List(s *capnp.Segment, sz int32) (Conmon_SetWatchdogRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_SetWatchdogRequest]{l}, err
+}
+
+// Conmon_SetWatchdogRequest_Future is a wrapper for a Conmon_SetWatchdogRequest promised by a client call.
+type Conmon_SetWatchdogRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_SetWatchdogRequest_Future) Struct() (Conmon_SetWatchdogRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SetWatchdogRequest{s}, err
+}
+
+type Conmon_SetWatchdogRequest_Policy uint16
+
+// Conmon_SetWatchdogRequest_Policy_TypeID is the unique identifier for the type Conmon_SetWatchdogRequest_Policy.
+const Conmon_SetWatchdogRequest_Policy_TypeID = 0xe56192340d8af3f6
+
+// Values of Conmon_SetWatchdogRequest_Policy.
+const (
+	Conmon_SetWatchdogRequest_Policy_event Conmon_SetWatchdogRequest_Policy = 0
+	Conmon_SetWatchdogRequest_Policy_stop  Conmon_SetWatchdogRequest_Policy = 1
+)
+
+// String returns the enum's constant name.
+func (c Conmon_SetWatchdogRequest_Policy) String() string {
+	switch c {
+	case Conmon_SetWatchdogRequest_Policy_event:
+		return "event"
+	case Conmon_SetWatchdogRequest_Policy_stop:
+		return "stop"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_SetWatchdogRequest_PolicyFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_SetWatchdogRequest_PolicyFromString(c string) Conmon_SetWatchdogRequest_Policy {
+	switch c {
+	case "event":
+		return Conmon_SetWatchdogRequest_Policy_event
+	case "stop":
+		return Conmon_SetWatchdogRequest_Policy_stop
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_SetWatchdogRequest_Policy_List = capnp.EnumList[Conmon_SetWatchdogRequest_Policy]
+
+func NewConmon_SetWatchdogRequest_Policy_List(s *capnp.Segment, sz int32) (Conmon_SetWatchdogRequest_Policy_List, error) {
+	return capnp.NewEnumList[Conmon_SetWatchdogRequest_Policy](s, sz)
+}
+
+type Conmon_SetWatchdogResponse struct{ capnp.Struct }
+
+// Conmon_SetWatchdogResponse_TypeID is the unique identifier for the type Conmon_SetWatchdogResponse.
+const Conmon_SetWatchdogResponse_TypeID = 0xbb108b9aa42fcf07
+
+func NewConmon_SetWatchdogResponse(s *capnp.Segment) (Conmon_SetWatchdogResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_SetWatchdogResponse{st}, err
+}
+
+func NewRootConmon_SetWatchdogResponse(s *capnp.Segment) (Conmon_SetWatchdogResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_SetWatchdogResponse{st}, err
+}
+
+func ReadRootConmon_SetWatchdogResponse(msg *capnp.Message) (Conmon_SetWatchdogResponse, error) {
+	root, err := msg.Root()
+	return Conmon_SetWatchdogResponse{root.Struct()}, err
+}
+
+func (s Conmon_SetWatchdogResponse) String() string {
+	str, _ := text.Marshal(0xbb108b9aa42fcf07, s.Struct)
+	return str
+}
+
+// Conmon_SetWatchdogResponse_List is a list of Conmon_SetWatchdogResponse.
+type Conmon_SetWatchdogResponse_List = capnp.StructList[Conmon_SetWatchdogResponse]
+
+// NewConmon_SetWatchdogResponse creates a new list of Conmon_SetWatchdogResponse.
+func NewConmon_SetWatchdogResponse_List(s *capnp.Segment, sz int32) (Conmon_SetWatchdogResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_SetWatchdogResponse]{l}, err
+}
+
+// Conmon_SetWatchdogResponse_Future is a wrapper for a Conmon_SetWatchdogResponse promised by a client call.
+type Conmon_SetWatchdogResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_SetWatchdogResponse_Future) Struct() (Conmon_SetWatchdogResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SetWatchdogResponse{s}, err
+}
+
+type Conmon_HeartbeatRequest struct{ capnp.Struct }
+
+// Conmon_HeartbeatRequest_TypeID is the unique identifier for the type Conmon_HeartbeatRequest.
+const Conmon_HeartbeatRequest_TypeID = 0xe6f0bb96774b2e8a
+
+func NewConmon_HeartbeatRequest(s *capnp.Segment) (Conmon_HeartbeatRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_HeartbeatRequest{st}, err
+}
+
+func NewRootConmon_HeartbeatRequest(s *capnp.Segment) (Conmon_HeartbeatRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_HeartbeatRequest{st}, err
+}
+
+func ReadRootConmon_HeartbeatRequest(msg *capnp.Message) (Conmon_HeartbeatRequest, error) {
+	root, err := msg.Root()
+	return Conmon_HeartbeatRequest{root.Struct()}, err
+}
+
+func (s Conmon_HeartbeatRequest) String() string {
+	str, _ := text.Marshal(0xe6f0bb96774b2e8a, s.Struct)
+	return str
+}
+
+func (s Conmon_HeartbeatRequest) Ids() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_HeartbeatRequest) HasIds() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_HeartbeatRequest) SetIds(v capnp.TextList) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewIds sets the ids field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_HeartbeatRequest) NewIds(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_HeartbeatRequest_List is a list of Conmon_HeartbeatRequest.
+type Conmon_HeartbeatRequest_List = capnp.StructList[Conmon_HeartbeatRequest]
+
+// NewConmon_HeartbeatRequest creates a new list of Conmon_HeartbeatRequest.
+func NewConmon_HeartbeatRequest_List(s *capnp.Segment, sz int32) (Conmon_HeartbeatRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_HeartbeatRequest]{l}, err
+}
+
+// Conmon_HeartbeatRequest_Future is a wrapper for a Conmon_HeartbeatRequest promised by a client call.
+type Conmon_HeartbeatRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_HeartbeatRequest_Future) Struct() (Conmon_HeartbeatRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_HeartbeatRequest{s}, err
+}
+
+type Conmon_HeartbeatResponse struct{ capnp.Struct }
+
+// Conmon_HeartbeatResponse_TypeID is the unique identifier for the type Conmon_HeartbeatResponse.
+const Conmon_HeartbeatResponse_TypeID = 0xbf8d2f031ea7151c
+
+func NewConmon_HeartbeatResponse(s *capnp.Segment) (Conmon_HeartbeatResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_HeartbeatResponse{st}, err
+}
+
+func NewRootConmon_HeartbeatResponse(s *capnp.Segment) (Conmon_HeartbeatResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_HeartbeatResponse{st}, err
+}
+
+func ReadRootConmon_HeartbeatResponse(msg *capnp.Message) (Conmon_HeartbeatResponse, error) {
+	root, err := msg.Root()
+	return Conmon_HeartbeatResponse{root.Struct()}, err
+}
+
+func (s Conmon_HeartbeatResponse) String() string {
+	str, _ := text.Marshal(0xbf8d2f031ea7151c, s.Struct)
+	return str
+}
+
+// Conmon_HeartbeatResponse_List is a list of Conmon_HeartbeatResponse.
+type Conmon_HeartbeatResponse_List = capnp.StructList[Conmon_HeartbeatResponse]
+
+// NewConmon_HeartbeatResponse creates a new list of Conmon_HeartbeatResponse.
+func NewConmon_HeartbeatResponse_List(s *capnp.Segment, sz int32) (Conmon_HeartbeatResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_HeartbeatResponse]{l}, err
+}
+
+// Conmon_HeartbeatResponse_Future is a wrapper for a Conmon_HeartbeatResponse promised by a client call.
+type Conmon_HeartbeatResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_HeartbeatResponse_Future) Struct() (Conmon_HeartbeatResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_HeartbeatResponse{s}, err
+}
+
+type Conmon_RotateLogRequest struct{ capnp.Struct }
+
+// Conmon_RotateLogRequest_TypeID is the unique identifier for the type Conmon_RotateLogRequest.
+const Conmon_RotateLogRequest_TypeID = 0x9bdf59c63c72cecd
+
+func NewConmon_RotateLogRequest(s *capnp.Segment) (Conmon_RotateLogRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_RotateLogRequest{st}, err
+}
+
+func NewRootConmon_RotateLogRequest(s *capnp.Segment) (Conmon_RotateLogRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_RotateLogRequest{st}, err
+}
+
+func ReadRootConmon_RotateLogRequest(msg *capnp.Message) (Conmon_RotateLogRequest, error) {
+	root, err := msg.Root()
+	return Conmon_RotateLogRequest{root.Struct()}, err
+}
+
+func (s Conmon_RotateLogRequest) String() string {
+	str, _ := text.Marshal(0x9bdf59c63c72cecd, s.Struct)
+	return str
+}
+
+func (s Conmon_RotateLogRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_RotateLogRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_RotateLogRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_RotateLogRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_RotateLogRequest) ExecSessionId() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_RotateLogRequest) HasExecSessionId() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_RotateLogRequest) ExecSessionIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_RotateLogRequest) SetExecSessionId(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_RotateLogRequest) Path() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s Conmon_RotateLogRequest) HasPath() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_RotateLogRequest) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_RotateLogRequest) SetPath(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
+// Conmon_RotateLogRequest_List is a list of Conmon_RotateLogRequest.
+type Conmon_RotateLogRequest_List = capnp.StructList[Conmon_RotateLogRequest]
+
+// NewConmon_RotateLogRequest creates a new list of Conmon_RotateLogRequest.
+func NewConmon_RotateLogRequest_List(s *capnp.Segment, sz int32) (Conmon_RotateLogRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_RotateLogRequest]{l}, err
+}
+
+// Conmon_RotateLogRequest_Future is a wrapper for a Conmon_RotateLogRequest promised by a client call.
+type Conmon_RotateLogRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_RotateLogRequest_Future) Struct() (Conmon_RotateLogRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_RotateLogRequest{s}, err
+}
+
+type Conmon_RotateLogResponse struct{ capnp.Struct }
+
+// Conmon_RotateLogResponse_TypeID is the unique identifier for the type Conmon_RotateLogResponse.
+const Conmon_RotateLogResponse_TypeID = 0x8b09b7679204aa0f
+
+func NewConmon_RotateLogResponse(s *capnp.Segment) (Conmon_RotateLogResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_RotateLogResponse{st}, err
+}
+
+func NewRootConmon_RotateLogResponse(s *capnp.Segment) (Conmon_RotateLogResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_RotateLogResponse{st}, err
+}
+
+func ReadRootConmon_RotateLogResponse(msg *capnp.Message) (Conmon_RotateLogResponse, error) {
+	root, err := msg.Root()
+	return Conmon_RotateLogResponse{root.Struct()}, err
+}
+
+func (s Conmon_RotateLogResponse) String() string {
+	str, _ := text.Marshal(0x8b09b7679204aa0f, s.Struct)
+	return str
+}
+
+// Conmon_RotateLogResponse_List is a list of Conmon_RotateLogResponse.
+type Conmon_RotateLogResponse_List = capnp.StructList[Conmon_RotateLogResponse]
+
+// NewConmon_RotateLogResponse creates a new list of Conmon_RotateLogResponse.
+func NewConmon_RotateLogResponse_List(s *capnp.Segment, sz int32) (Conmon_RotateLogResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_RotateLogResponse]{l}, err
+}
+
+// Conmon_RotateLogResponse_Future is a wrapper for a Conmon_RotateLogResponse promised by a client call.
+type Conmon_RotateLogResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_RotateLogResponse_Future) Struct() (Conmon_RotateLogResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_RotateLogResponse{s}, err
+}
+
+type Conmon_ReadLogRequest struct{ capnp.Struct }
+
+// Conmon_ReadLogRequest_TypeID is the unique identifier for the type Conmon_ReadLogRequest.
+const Conmon_ReadLogRequest_TypeID = 0x9b0d278358e9d418
+
+func NewConmon_ReadLogRequest(s *capnp.Segment) (Conmon_ReadLogRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return Conmon_ReadLogRequest{st}, err
+}
+
+func NewRootConmon_ReadLogRequest(s *capnp.Segment) (Conmon_ReadLogRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
+	return Conmon_ReadLogRequest{st}, err
+}
+
+func ReadRootConmon_ReadLogRequest(msg *capnp.Message) (Conmon_ReadLogRequest, error) {
+	root, err := msg.Root()
+	return Conmon_ReadLogRequest{root.Struct()}, err
+}
+
+func (s Conmon_ReadLogRequest) String() string {
+	str, _ := text.Marshal(0x9b0d278358e9d418, s.Struct)
+	return str
+}
+
+func (s Conmon_ReadLogRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ReadLogRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ReadLogRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ReadLogRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ReadLogRequest) Path() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_ReadLogRequest) HasPath() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ReadLogRequest) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ReadLogRequest) SetPath(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_ReadLogRequest) Offset() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_ReadLogRequest) SetOffset(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_ReadLogRequest) Count() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s Conmon_ReadLogRequest) SetCount(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+func (s Conmon_ReadLogRequest) Backward() bool {
+	return s.Struct.Bit(96)
+}
+
+func (s Conmon_ReadLogRequest) SetBackward(v bool) {
+	s.Struct.SetBit(96, v)
+}
+
+// Conmon_ReadLogRequest_List is a list of Conmon_ReadLogRequest.
+type Conmon_ReadLogRequest_List = capnp.StructList[Conmon_ReadLogRequest]
+
+// NewConmon_ReadLogRequest creates a new list of Conmon_ReadLogRequest.
+func NewConmon_ReadLogRequest_List(s *capnp.Segment, sz int32) (Conmon_ReadLogRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_ReadLogRequest]{l}, err
+}
+
+// Conmon_ReadLogRequest_Future is a wrapper for a Conmon_ReadLogRequest promised by a client call.
+type Conmon_ReadLogRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_ReadLogRequest_Future) Struct() (Conmon_ReadLogRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ReadLogRequest{s}, err
+}
+
+type Conmon_ReadLogResponse struct{ capnp.Struct }
+
+// Conmon_ReadLogResponse_TypeID is the unique identifier for the type Conmon_ReadLogResponse.
+const Conmon_ReadLogResponse_TypeID = 0xebbc7ae7ae262bb9
+
+func NewConmon_ReadLogResponse(s *capnp.Segment) (Conmon_ReadLogResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_ReadLogResponse{st}, err
+}
+
+func NewRootConmon_ReadLogResponse(s *capnp.Segment) (Conmon_ReadLogResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_ReadLogResponse{st}, err
+}
+
+func ReadRootConmon_ReadLogResponse(msg *capnp.Message) (Conmon_ReadLogResponse, error) {
+	root, err := msg.Root()
+	return Conmon_ReadLogResponse{root.Struct()}, err
+}
+
+func (s Conmon_ReadLogResponse) String() string {
+	str, _ := text.Marshal(0xebbc7ae7ae262bb9, s.Struct)
+	return str
+}
+
+func (s Conmon_ReadLogResponse) Entries() (Conmon_LogEntry_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_LogEntry_List{List: p.List()}, err
+}
+
+func (s Conmon_ReadLogResponse) HasEntries() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ReadLogResponse) SetEntries(v Conmon_LogEntry_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewEntries sets the entries field to a newly
+// allocated Conmon_LogEntry_List, preferring placement in s's segment.
+func (s Conmon_ReadLogResponse) NewEntries(n int32) (Conmon_LogEntry_List, error) {
+	l, err := NewConmon_LogEntry_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_LogEntry_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_ReadLogResponse) Offset() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_ReadLogResponse) SetOffset(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_ReadLogResponse) Size() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_ReadLogResponse) SetSize(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+// Conmon_ReadLogResponse_List is a list of Conmon_ReadLogResponse.
+type Conmon_ReadLogResponse_List = capnp.StructList[Conmon_ReadLogResponse]
+
+// NewConmon_ReadLogResponse creates a new list of Conmon_ReadLogResponse.
+func NewConmon_ReadLogResponse_List(s *capnp.Segment, sz int32) (Conmon_ReadLogResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ReadLogResponse]{l}, err
+}
+
+// Conmon_ReadLogResponse_Future is a wrapper for a Conmon_ReadLogResponse promised by a client call.
+type Conmon_ReadLogResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_ReadLogResponse_Future) Struct() (Conmon_ReadLogResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ReadLogResponse{s}, err
+}
+
+type Conmon_LogEntry struct{ capnp.Struct }
+
+// Conmon_LogEntry_TypeID is the unique identifier for the type Conmon_LogEntry.
+const Conmon_LogEntry_TypeID = 0xacc3207e16e1ffb0
+
+func NewConmon_LogEntry(s *capnp.Segment) (Conmon_LogEntry, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
+	return Conmon_LogEntry{st}, err
+}
+
+func NewRootConmon_LogEntry(s *capnp.Segment) (Conmon_LogEntry, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
+	return Conmon_LogEntry{st}, err
+}
+
+func ReadRootConmon_LogEntry(msg *capnp.Message) (Conmon_LogEntry, error) {
+	root, err := msg.Root()
+	return Conmon_LogEntry{root.Struct()}, err
+}
+
+func (s Conmon_LogEntry) String() string {
+	str, _ := text.Marshal(0xacc3207e16e1ffb0, s.Struct)
+	return str
+}
+
+func (s Conmon_LogEntry) Offset() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_LogEntry) SetOffset(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_LogEntry) Timestamp() int64 {
+	return int64(s.Struct.Uint64(8))
+}
+
+func (s Conmon_LogEntry) SetTimestamp(v int64) {
+	s.Struct.SetUint64(8, uint64(v))
+}
+
+func (s Conmon_LogEntry) Stream() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_LogEntry) HasStream() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_LogEntry) StreamBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_LogEntry) SetStream(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_LogEntry) Partial() bool {
+	return s.Struct.Bit(128)
+}
+
+func (s Conmon_LogEntry) SetPartial(v bool) {
+	s.Struct.SetBit(128, v)
+}
+
+func (s Conmon_LogEntry) Message() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return []byte(p.Data()), err
+}
+
+func (s Conmon_LogEntry) HasMessage() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_LogEntry) SetMessage(v []byte) error {
+	return s.Struct.SetData(1, v)
+}
+
+// Conmon_LogEntry_List is a list of Conmon_LogEntry.
+type Conmon_LogEntry_List = capnp.StructList[Conmon_LogEntry]
+
+// NewConmon_LogEntry creates a new list of Conmon_LogEntry.
+func NewConmon_LogEntry_List(s *capnp.Segment, sz int32) (Conmon_LogEntry_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_LogEntry]{l}, err
+}
+
+// Conmon_LogEntry_Future is a wrapper for a Conmon_LogEntry promised by a client call.
+type Conmon_LogEntry_Future struct{ *capnp.Future }
+
+func (p Conmon_LogEntry_Future) Struct() (Conmon_LogEntry, error) {
+	s, err := p.Future.Struct()
+	return Conmon_LogEntry{s}, err
+}
+
+type Conmon_SeekLogRequest struct{ capnp.Struct }
+
+// Conmon_SeekLogRequest_TypeID is the unique identifier for the type Conmon_SeekLogRequest.
+const Conmon_SeekLogRequest_TypeID = 0x99f3551c2bfc4f48
+
+func NewConmon_SeekLogRequest(s *capnp.Segment) (Conmon_SeekLogRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_SeekLogRequest{st}, err
+}
+
+func NewRootConmon_SeekLogRequest(s *capnp.Segment) (Conmon_SeekLogRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_SeekLogRequest{st}, err
+}
+
+func ReadRootConmon_SeekLogRequest(msg *capnp.Message) (Conmon_SeekLogRequest, error) {
+	root, err := msg.Root()
+	return Conmon_SeekLogRequest{root.Struct()}, err
+}
+
+func (s Conmon_SeekLogRequest) String() string {
+	str, _ := text.Marshal(0x99f3551c2bfc4f48, s.Struct)
+	return str
+}
+
+func (s Conmon_SeekLogRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_SeekLogRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_SeekLogRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_SeekLogRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_SeekLogRequest) Path() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_SeekLogRequest) HasPath() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_SeekLogRequest) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_SeekLogRequest) SetPath(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_SeekLogRequest) Timestamp() int64 {
+	return int64(s.Struct.Uint64(0))
+}
+
+func (s Conmon_SeekLogRequest) SetTimestamp(v int64) {
+	s.Struct.SetUint64(0, uint64(v))
+}
+
+// Conmon_SeekLogRequest_List is a list of Conmon_SeekLogRequest.
+type Conmon_SeekLogRequest_List = capnp.StructList[Conmon_SeekLogRequest]
+
+// NewConmon_SeekLogRequest creates a new list of Conmon_SeekLogRequest.
+func NewConmon_SeekLogRequest_List(s *capnp.Segment, sz int32) (Conmon_SeekLogRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_SeekLogRequest]{l}, err
+}
+
+// Conmon_SeekLogRequest_Future is a wrapper for a Conmon_SeekLogRequest promised by a client call.
+type Conmon_SeekLogRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_SeekLogRequest_Future) Struct() (Conmon_SeekLogRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SeekLogRequest{s}, err
+}
+
+type Conmon_SeekLogResponse struct{ capnp.Struct }
+
+// Conmon_SeekLogResponse_TypeID is the unique identifier for the type Conmon_SeekLogResponse.
+const Conmon_SeekLogResponse_TypeID = 0xf78dea81ed58ba5a
+
+func NewConmon_SeekLogResponse(s *capnp.Segment) (Conmon_SeekLogResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Conmon_SeekLogResponse{st}, err
+}
+
+func NewRootConmon_SeekLogResponse(s *capnp.Segment) (Conmon_SeekLogResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Conmon_SeekLogResponse{st}, err
+}
+
+func ReadRootConmon_SeekLogResponse(msg *capnp.Message) (Conmon_SeekLogResponse, error) {
+	root, err := msg.Root()
+	return Conmon_SeekLogResponse{root.Struct()}, err
+}
+
+func (s Conmon_SeekLogResponse) String() string {
+	str, _ := text.Marshal(0xf78dea81ed58ba5a, s.Struct)
+	return str
+}
+
+func (s Conmon_SeekLogResponse) Offset() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_SeekLogResponse) SetOffset(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+// Conmon_SeekLogResponse_List is a list of Conmon_SeekLogResponse.
+type Conmon_SeekLogResponse_List = capnp.StructList[Conmon_SeekLogResponse]
+
+// NewConmon_SeekLogResponse creates a new list of Conmon_SeekLogResponse.
+func NewConmon_SeekLogResponse_List(s *capnp.Segment, sz int32) (Conmon_SeekLogResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_SeekLogResponse]{l}, err
+}
+
+// Conmon_SeekLogResponse_Future is a wrapper for a Conmon_SeekLogResponse promised by a client call.
+type Conmon_SeekLogResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_SeekLogResponse_Future) Struct() (Conmon_SeekLogResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SeekLogResponse{s}, err
+}
+
+type Conmon_FlushLogsRequest struct{ capnp.Struct }
+
+// Conmon_FlushLogsRequest_TypeID is the unique identifier for the type Conmon_FlushLogsRequest.
+const Conmon_FlushLogsRequest_TypeID = 0x91b43ed9c2b59a3d
+
+func NewConmon_FlushLogsRequest(s *capnp.Segment) (Conmon_FlushLogsRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_FlushLogsRequest{st}, err
+}
+
+func NewRootConmon_FlushLogsRequest(s *capnp.Segment) (Conmon_FlushLogsRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_FlushLogsRequest{st}, err
+}
+
+func ReadRootConmon_FlushLogsRequest(msg *capnp.Message) (Conmon_FlushLogsRequest, error) {
+	root, err := msg.Root()
+	return Conmon_FlushLogsRequest{root.Struct()}, err
+}
+
+func (s Conmon_FlushLogsRequest) String() string {
+	str, _ := text.Marshal(0x91b43ed9c2b59a3d, s.Struct)
+	return str
+}
+
+func (s Conmon_FlushLogsRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_FlushLogsRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_FlushLogsRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_FlushLogsRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_FlushLogsRequest_List is a list of Conmon_FlushLogsRequest.
+type Conmon_FlushLogsRequest_List = capnp.StructList[Conmon_FlushLogsRequest]
+
+// NewConmon_FlushLogsRequest creates a new list of Conmon_FlushLogsRequest.
+func NewConmon_FlushLogsRequest_List(s *capnp.Segment, sz int32) (Conmon_FlushLogsRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_FlushLogsRequest]{l}, err
+}
+
+// Conmon_FlushLogsRequest_Future is a wrapper for a Conmon_FlushLogsRequest promised by a client call.
+type Conmon_FlushLogsRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_FlushLogsRequest_Future) Struct() (Conmon_FlushLogsRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_FlushLogsRequest{s}, err
+}
+
+type Conmon_FlushLogsResponse struct{ capnp.Struct }
+
+// Conmon_FlushLogsResponse_TypeID is the unique identifier for the type Conmon_FlushLogsResponse.
+const Conmon_FlushLogsResponse_TypeID = 0xe2b79aecdbff1367
+
+func NewConmon_FlushLogsResponse(s *capnp.Segment) (Conmon_FlushLogsResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_FlushLogsResponse{st}, err
+}
+
+func NewRootConmon_FlushLogsResponse(s *capnp.Segment) (Conmon_FlushLogsResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_FlushLogsResponse{st}, err
+}
+
+func ReadRootConmon_FlushLogsResponse(msg *capnp.Message) (Conmon_FlushLogsResponse, error) {
+	root, err := msg.Root()
+	return Conmon_FlushLogsResponse{root.Struct()}, err
+}
+
+func (s Conmon_FlushLogsResponse) String() string {
+	str, _ := text.Marshal(0xe2b79aecdbff1367, s.Struct)
+	return str
+}
+
+// Conmon_FlushLogsResponse_List is a list of Conmon_FlushLogsResponse.
+type Conmon_FlushLogsResponse_List = capnp.StructList[Conmon_FlushLogsResponse]
+
+// NewConmon_FlushLogsResponse creates a new list of Conmon_FlushLogsResponse.
+func NewConmon_FlushLogsResponse_List(s *capnp.Segment, sz int32) (Conmon_FlushLogsResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_FlushLogsResponse]{l}, err
+}
+
+// Conmon_FlushLogsResponse_Future is a wrapper for a Conmon_FlushLogsResponse promised by a client call.
+type Conmon_FlushLogsResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_FlushLogsResponse_Future) Struct() (Conmon_FlushLogsResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_FlushLogsResponse{s}, err
+}
+
+type Conmon_CheckpointOptions struct{ capnp.Struct }
+
+// Conmon_CheckpointOptions_TypeID is the unique identifier for the type Conmon_CheckpointOptions.
+const Conmon_CheckpointOptions_TypeID = 0xc5a3e1c6546c65c5
+
+func NewConmon_CheckpointOptions(s *capnp.Segment) (Conmon_CheckpointOptions, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_CheckpointOptions{st}, err
+}
+
+func NewRootConmon_CheckpointOptions(s *capnp.Segment) (Conmon_CheckpointOptions, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return Conmon_CheckpointOptions{st}, err
+}
+
+func ReadRootConmon_CheckpointOptions(msg *capnp.Message) (Conmon_CheckpointOptions, error) {
+	root, err := msg.Root()
+	return Conmon_CheckpointOptions{root.Struct()}, err
+}
+
+func (s Conmon_CheckpointOptions) String() string {
+	str, _ := text.Marshal(0xc5a3e1c6546c65c5, s.Struct)
+	return str
+}
+
+func (s Conmon_CheckpointOptions) ImagePath() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_CheckpointOptions) HasImagePath() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CheckpointOptions) ImagePathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CheckpointOptions) SetImagePath(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_CheckpointOptions) WorkPath() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_CheckpointOptions) HasWorkPath() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_CheckpointOptions) WorkPathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CheckpointOptions) SetWorkPath(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_CheckpointOptions) TcpEstablished() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Conmon_CheckpointOptions) SetTcpEstablished(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+// Conmon_CheckpointOptions_List is a list of Conmon_CheckpointOptions.
+type Conmon_CheckpointOptions_List = capnp.StructList[Conmon_CheckpointOptions]
+
+// NewConmon_CheckpointOptions creates a new list of Conmon_CheckpointOptions.
+func NewConmon_CheckpointOptions_List(s *capnp.Segment, sz int32) (Conmon_CheckpointOptions_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_CheckpointOptions]{l}, err
+}
+
+// Conmon_CheckpointOptions_Future is a wrapper for a Conmon_CheckpointOptions promised by a client call.
+type Conmon_CheckpointOptions_Future struct{ *capnp.Future }
+
+func (p Conmon_CheckpointOptions_Future) Struct() (Conmon_CheckpointOptions, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CheckpointOptions{s}, err
+}
+
+type Conmon_CheckpointContainerRequest struct{ capnp.Struct }
+
+// Conmon_CheckpointContainerRequest_TypeID is the unique identifier for the type Conmon_CheckpointContainerRequest.
+const Conmon_CheckpointContainerRequest_TypeID = 0xcfae465adf42c669
+
+func NewConmon_CheckpointContainerRequest(s *capnp.Segment) (Conmon_CheckpointContainerRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return Conmon_CheckpointContainerRequest{st}, err
+}
+
+func NewRootConmon_CheckpointContainerRequest(s *capnp.Segment) (Conmon_CheckpointContainerRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3})
+	return Conmon_CheckpointContainerRequest{st}, err
+}
+
+func ReadRootConmon_CheckpointContainerRequest(msg *capnp.Message) (Conmon_CheckpointContainerRequest, error) {
+	root, err := msg.Root()
+	return Conmon_CheckpointContainerRequest{root.Struct()}, err
+}
+
+func (s Conmon_CheckpointContainerRequest) String() string {
+	str, _ := text.Marshal(0xcfae465adf42c669, s.Struct)
+	return str
+}
+
+func (s Conmon_CheckpointContainerRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_CheckpointContainerRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CheckpointContainerRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CheckpointContainerRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_CheckpointContainerRequest) Options() (Conmon_CheckpointOptions, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_CheckpointOptions{Struct: p.Struct()}, err
+}
+
+func (s Conmon_CheckpointContainerRequest) HasOptions() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_CheckpointContainerRequest) SetOptions(v Conmon_CheckpointOptions) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewOptions sets the options field to a newly
+// allocated Conmon_CheckpointOptions struct, preferring placement in s's segment.
+func (s Conmon_CheckpointContainerRequest) NewOptions() (Conmon_CheckpointOptions, error) {
+	ss, err := NewConmon_CheckpointOptions(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CheckpointOptions{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_CheckpointContainerRequest) LeaveRunning() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Conmon_CheckpointContainerRequest) SetLeaveRunning(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+func (s Conmon_CheckpointContainerRequest) ImageWriter() Conmon_CheckpointImageWriter {
+	p, _ := s.Struct.Ptr(2)
+	return Conmon_CheckpointImageWriter{Client: p.Interface().Client()}
+}
+
+func (s Conmon_CheckpointContainerRequest) HasImageWriter() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_CheckpointContainerRequest) SetImageWriter(v Conmon_CheckpointImageWriter) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(2, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(2, in.ToPtr())
+}
+
+// Conmon_CheckpointContainerRequest_List is a list of Conmon_CheckpointContainerRequest.
+type Conmon_CheckpointContainerRequest_List = capnp.StructList[Conmon_CheckpointContainerRequest]
+
+// NewConmon_CheckpointContainerRequest creates a new list of Conmon_CheckpointContainerRequest.
+func NewConmon_CheckpointContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_CheckpointContainerRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_CheckpointContainerRequest]{l}, err
+}
+
+// Conmon_CheckpointContainerRequest_Future is a wrapper for a Conmon_CheckpointContainerRequest promised by a client call.
+type Conmon_CheckpointContainerRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_CheckpointContainerRequest_Future) Struct() (Conmon_CheckpointContainerRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CheckpointContainerRequest{s}, err
+}
+
+func (p Conmon_CheckpointContainerRequest_Future) Options() Conmon_CheckpointOptions_Future {
+	return Conmon_CheckpointOptions_Future{Future: p.Future.Field(1, nil)}
+}
+
+func (p Conmon_CheckpointContainerRequest_Future) ImageWriter() Conmon_CheckpointImageWriter {
+	return Conmon_CheckpointImageWriter{Client: p.Future.Field(2, nil).Client()}
+}
+
+type Conmon_CheckpointContainerResponse struct{ capnp.Struct }
+
+// Conmon_CheckpointContainerResponse_TypeID is the unique identifier for the type Conmon_CheckpointContainerResponse.
+const Conmon_CheckpointContainerResponse_TypeID = 0x82510d3464397f38
+
+func NewConmon_CheckpointContainerResponse(s *capnp.Segment) (Conmon_CheckpointContainerResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_CheckpointContainerResponse{st}, err
+}
+
+func NewRootConmon_CheckpointContainerResponse(s *capnp.Segment) (Conmon_CheckpointContainerResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_CheckpointContainerResponse{st}, err
+}
+
+func ReadRootConmon_CheckpointContainerResponse(msg *capnp.Message) (Conmon_CheckpointContainerResponse, error) {
+	root, err := msg.Root()
+	return Conmon_CheckpointContainerResponse{root.Struct()}, err
+}
+
+func (s Conmon_CheckpointContainerResponse) String() string {
+	str, _ := text.Marshal(0x82510d3464397f38, s.Struct)
+	return str
+}
+
+func (s Conmon_CheckpointContainerResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_CheckpointContainerResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CheckpointContainerResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_CheckpointContainerResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_CheckpointContainerResponse_List is a list of Conmon_CheckpointContainerResponse.
+type Conmon_CheckpointContainerResponse_List = capnp.StructList[Conmon_CheckpointContainerResponse]
+
+// NewConmon_CheckpointContainerResponse creates a new list of Conmon_CheckpointContainerResponse.
+func NewConmon_CheckpointContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_CheckpointContainerResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_CheckpointContainerResponse]{l}, err
+}
+
+// Conmon_CheckpointContainerResponse_Future is a wrapper for a Conmon_CheckpointContainerResponse promised by a client call.
+type Conmon_CheckpointContainerResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_CheckpointContainerResponse_Future) Struct() (Conmon_CheckpointContainerResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CheckpointContainerResponse{s}, err
+}
+
+func (p Conmon_CheckpointContainerResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_CheckpointImageWriter struct{ Client *capnp.Client }
+
+// Conmon_CheckpointImageWriter_TypeID is the unique identifier for the type Conmon_CheckpointImageWriter.
+const Conmon_CheckpointImageWriter_TypeID = 0xa7645531c88cbedd
+
+func (c Conmon_CheckpointImageWriter) File(ctx context.Context, params func(Conmon_CheckpointImageWriter_file_Params) error) (Conmon_CheckpointImageWriter_file_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xa7645531c88cbedd,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.CheckpointImageWriter",
+			MethodName:    "file",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 16, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_CheckpointImageWriter_file_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_CheckpointImageWriter_file_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon_CheckpointImageWriter) Write(ctx context.Context, params func(Conmon_CheckpointImageWriter_write_Params) error) (Conmon_CheckpointImageWriter_write_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xa7645531c88cbedd,
+			MethodID:      1,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.CheckpointImageWriter",
+			MethodName:    "write",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_CheckpointImageWriter_write_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_CheckpointImageWriter_write_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Conmon_CheckpointImageWriter) AddRef() Conmon_CheckpointImageWriter {
+	return Conmon_CheckpointImageWriter{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Conmon_CheckpointImageWriter) Release() {
+	c.Client.Release()
+}
+
+// A Conmon_CheckpointImageWriter_Server is a Conmon_CheckpointImageWriter with a local implementation.
+type Conmon_CheckpointImageWriter_Server interface {
+	File(context.Context, Conmon_CheckpointImageWriter_file) error
+
+	Write(context.Context, Conmon_CheckpointImageWriter_write) error
+}
+
+// Conmon_CheckpointImageWriter_NewServer creates a new Server from an implementation of Conmon_CheckpointImageWriter_Server.
+func Conmon_CheckpointImageWriter_NewServer(s Conmon_CheckpointImageWriter_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Conmon_CheckpointImageWriter_Methods(nil, s), s, c, policy)
+}
+
+// Conmon_CheckpointImageWriter_ServerToClient creates a new Client from an implementation of Conmon_CheckpointImageWriter_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Conmon_CheckpointImageWriter_ServerToClient(s Conmon_CheckpointImageWriter_Server, policy *server.Policy) Conmon_CheckpointImageWriter {
+	return Conmon_CheckpointImageWriter{Client: capnp.NewClient(Conmon_CheckpointImageWriter_NewServer(s, policy))}
+}
+
+// Conmon_CheckpointImageWriter_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Conmon_CheckpointImageWriter_Methods(methods []server.Method, s Conmon_CheckpointImageWriter_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa7645531c88cbedd,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.CheckpointImageWriter",
+			MethodName:    "file",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.File(ctx, Conmon_CheckpointImageWriter_file{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa7645531c88cbedd,
+			MethodID:      1,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.CheckpointImageWriter",
+			MethodName:    "write",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Write(ctx, Conmon_CheckpointImageWriter_write{call})
+		},
+	})
+
+	return methods
+}
+
+// Conmon_CheckpointImageWriter_file holds the state for a server call to Conmon_CheckpointImageWriter.file.
+// See server.Call for documentation.
+type Conmon_CheckpointImageWriter_file struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_CheckpointImageWriter_file) Args() Conmon_CheckpointImageWriter_file_Params {
+	return Conmon_CheckpointImageWriter_file_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_CheckpointImageWriter_file) AllocResults() (Conmon_CheckpointImageWriter_file_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_CheckpointImageWriter_file_Results{Struct: r}, err
+}
+
+// Conmon_CheckpointImageWriter_write holds the state for a server call to Conmon_CheckpointImageWriter.write.
+// See server.Call for documentation.
+type Conmon_CheckpointImageWriter_write struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_CheckpointImageWriter_write) Args() Conmon_CheckpointImageWriter_write_Params {
+	return Conmon_CheckpointImageWriter_write_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_CheckpointImageWriter_write) AllocResults() (Conmon_CheckpointImageWriter_write_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_CheckpointImageWriter_write_Results{Struct: r}, err
+}
+
+type Conmon_CheckpointImageWriter_file_Params struct{ capnp.Struct }
+
+// Conmon_CheckpointImageWriter_file_Params_TypeID is the unique identifier for the type Conmon_CheckpointImageWriter_file_Params.
+const Conmon_CheckpointImageWriter_file_Params_TypeID = 0xeea4b272d5001936
+
+func NewConmon_CheckpointImageWriter_file_Params(s *capnp.Segment) (Conmon_CheckpointImageWriter_file_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_CheckpointImageWriter_file_Params{st}, err
+}
+
+func NewRootConmon_CheckpointImageWriter_file_Params(s *capnp.Segment) (Conmon_CheckpointImageWriter_file_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_CheckpointImageWriter_file_Params{st}, err
+}
+
+func ReadRootConmon_CheckpointImageWriter_file_Params(msg *capnp.Message) (Conmon_CheckpointImageWriter_file_Params, error) {
+	root, err := msg.Root()
+	return Conmon_CheckpointImageWriter_file_Params{root.Struct()}, err
+}
+
+func (s Conmon_CheckpointImageWriter_file_Params) String() string {
+	str, _ := text.Marshal(0xeea4b272d5001936, s.Struct)
+	return str
+}
+
+func (s Conmon_CheckpointImageWriter_file_Params) Path() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_CheckpointImageWriter_file_Params) HasPath() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CheckpointImageWriter_file_Params) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CheckpointImageWriter_file_Params) SetPath(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_CheckpointImageWriter_file_Params) Size() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_CheckpointImageWriter_file_Params) SetSize(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_CheckpointImageWriter_file_Params) Mode() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s Conmon_CheckpointImageWriter_file_Params) SetMode(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+// Conmon_CheckpointImageWriter_file_Params_List is a list of Conmon_CheckpointImageWriter_file_Params.
+type Conmon_CheckpointImageWriter_file_Params_List = capnp.StructList[Conmon_CheckpointImageWriter_file_Params]
+
+// NewConmon_CheckpointImageWriter_file_Params creates a new list of Conmon_CheckpointImageWriter_file_Params.
+func NewConmon_CheckpointImageWriter_file_Params_List(s *capnp.Segment, sz int32) (Conmon_CheckpointImageWriter_file_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_CheckpointImageWriter_file_Params]{l}, err
+}
+
+// Conmon_CheckpointImageWriter_file_Params_Future is a wrapper for a Conmon_CheckpointImageWriter_file_Params promised by a client call.
+type Conmon_CheckpointImageWriter_file_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_CheckpointImageWriter_file_Params_Future) Struct() (Conmon_CheckpointImageWriter_file_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CheckpointImageWriter_file_Params{s}, err
+}
+
+type Conmon_CheckpointImageWriter_file_Results struct{ capnp.Struct }
+
+// Conmon_CheckpointImageWriter_file_Results_TypeID is the unique identifier for the type Conmon_CheckpointImageWriter_file_Results.
+const Conmon_CheckpointImageWriter_file_Results_TypeID = 0xe2362c256b305a3d
+
+func NewConmon_CheckpointImageWriter_file_Results(s *capnp.Segment) (Conmon_CheckpointImageWriter_file_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_CheckpointImageWriter_file_Results{st}, err
+}
+
+func NewRootConmon_CheckpointImageWriter_file_Results(s *capnp.Segment) (Conmon_CheckpointImageWriter_file_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_CheckpointImageWriter_file_Results{st}, err
+}
+
+func ReadRootConmon_CheckpointImageWriter_file_Results(msg *capnp.Message) (Conmon_CheckpointImageWriter_file_Results, error) {
+	root, err := msg.Root()
+	return Conmon_CheckpointImageWriter_file_Results{root.Struct()}, err
+}
+
+func (s Conmon_CheckpointImageWriter_file_Results) String() string {
+	str, _ := text.Marshal(0xe2362c256b305a3d, s.Struct)
+	return str
+}
+
+// Conmon_CheckpointImageWriter_file_Results_List is a list of Conmon_CheckpointImageWriter_file_Results.
+type Conmon_CheckpointImageWriter_file_Results_List = capnp.StructList[Conmon_CheckpointImageWriter_file_Results]
+
+// NewConmon_CheckpointImageWriter_file_Results creates a new list of Conmon_CheckpointImageWriter_file_Results.
+func NewConmon_CheckpointImageWriter_file_Results_List(s *capnp.Segment, sz int32) (Conmon_CheckpointImageWriter_file_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_CheckpointImageWriter_file_Results]{l}, err
+}
+
+// Conmon_CheckpointImageWriter_file_Results_Future is a wrapper for a Conmon_CheckpointImageWriter_file_Results promised by a client call.
+type Conmon_CheckpointImageWriter_file_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_CheckpointImageWriter_file_Results_Future) Struct() (Conmon_CheckpointImageWriter_file_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CheckpointImageWriter_file_Results{s}, err
+}
+
+type Conmon_CheckpointImageWriter_write_Params struct{ capnp.Struct }
+
+// Conmon_CheckpointImageWriter_write_Params_TypeID is the unique identifier for the type Conmon_CheckpointImageWriter_write_Params.
+const Conmon_CheckpointImageWriter_write_Params_TypeID = 0x8efcb63ea313a021
+
+func NewConmon_CheckpointImageWriter_write_Params(s *capnp.Segment) (Conmon_CheckpointImageWriter_write_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_CheckpointImageWriter_write_Params{st}, err
+}
+
+func NewRootConmon_CheckpointImageWriter_write_Params(s *capnp.Segment) (Conmon_CheckpointImageWriter_write_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_CheckpointImageWriter_write_Params{st}, err
+}
+
+func ReadRootConmon_CheckpointImageWriter_write_Params(msg *capnp.Message) (Conmon_CheckpointImageWriter_write_Params, error) {
+	root, err := msg.Root()
+	return Conmon_CheckpointImageWriter_write_Params{root.Struct()}, err
+}
+
+func (s Conmon_CheckpointImageWriter_write_Params) String() string {
+	str, _ := text.Marshal(0x8efcb63ea313a021, s.Struct)
+	return str
+}
+
+func (s Conmon_CheckpointImageWriter_write_Params) Data() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Conmon_CheckpointImageWriter_write_Params) HasData() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CheckpointImageWriter_write_Params) SetData(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+// Conmon_CheckpointImageWriter_write_Params_List is a list of Conmon_CheckpointImageWriter_write_Params.
+type Conmon_CheckpointImageWriter_write_Params_List = capnp.StructList[Conmon_CheckpointImageWriter_write_Params]
+
+// NewConmon_CheckpointImageWriter_write_Params creates a new list of Conmon_CheckpointImageWriter_write_Params.
+func NewConmon_CheckpointImageWriter_write_Params_List(s *capnp.Segment, sz int32) (Conmon_CheckpointImageWriter_write_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_CheckpointImageWriter_write_Params]{l}, err
+}
+
+// Conmon_CheckpointImageWriter_write_Params_Future is a wrapper for a Conmon_CheckpointImageWriter_write_Params promised by a client call.
+type Conmon_CheckpointImageWriter_write_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_CheckpointImageWriter_write_Params_Future) Struct() (Conmon_CheckpointImageWriter_write_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CheckpointImageWriter_write_Params{s}, err
+}
+
+type Conmon_CheckpointImageWriter_write_Results struct{ capnp.Struct }
+
+// Conmon_CheckpointImageWriter_write_Results_TypeID is the unique identifier for the type Conmon_CheckpointImageWriter_write_Results.
+const Conmon_CheckpointImageWriter_write_Results_TypeID = 0x97f46a0732c0868b
+
+func NewConmon_CheckpointImageWriter_write_Results(s *capnp.Segment) (Conmon_CheckpointImageWriter_write_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_CheckpointImageWriter_write_Results{st}, err
+}
+
+func NewRootConmon_CheckpointImageWriter_write_Results(s *capnp.Segment) (Conmon_CheckpointImageWriter_write_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_CheckpointImageWriter_write_Results{st}, err
+}
+
+func ReadRootConmon_CheckpointImageWriter_write_Results(msg *capnp.Message) (Conmon_CheckpointImageWriter_write_Results, error) {
+	root, err := msg.Root()
+	return Conmon_CheckpointImageWriter_write_Results{root.Struct()}, err
+}
+
+func (s Conmon_CheckpointImageWriter_write_Results) String() string {
+	str, _ := text.Marshal(0x97f46a0732c0868b, s.Struct)
+	return str
+}
+
+// Conmon_CheckpointImageWriter_write_Results_List is a list of Conmon_CheckpointImageWriter_write_Results.
+type Conmon_CheckpointImageWriter_write_Results_List = capnp.StructList[Conmon_CheckpointImageWriter_write_Results]
+
+// NewConmon_CheckpointImageWriter_write_Results creates a new list of Conmon_CheckpointImageWriter_write_Results.
+func NewConmon_CheckpointImageWriter_write_Results_List(s *capnp.Segment, sz int32) (Conmon_CheckpointImageWriter_write_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_CheckpointImageWriter_write_Results]{l}, err
+}
+
+// Conmon_CheckpointImageWriter_write_Results_Future is a wrapper for a Conmon_CheckpointImageWriter_write_Results promised by a client call.
+type Conmon_CheckpointImageWriter_write_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_CheckpointImageWriter_write_Results_Future) Struct() (Conmon_CheckpointImageWriter_write_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CheckpointImageWriter_write_Results{s}, err
+}
+
+type Conmon_RestoreContainerRequest struct{ capnp.Struct }
+
+// Conmon_RestoreContainerRequest_TypeID is the unique identifier for the type Conmon_RestoreContainerRequest.
+const Conmon_RestoreContainerRequest_TypeID = 0xeadf00d4ff560865
+
+func NewConmon_RestoreContainerRequest(s *capnp.Segment) (Conmon_RestoreContainerRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_RestoreContainerRequest{st}, err
+}
+
+func NewRootConmon_RestoreContainerRequest(s *capnp.Segment) (Conmon_RestoreContainerRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_RestoreContainerRequest{st}, err
+}
+
+func ReadRootConmon_RestoreContainerRequest(msg *capnp.Message) (Conmon_RestoreContainerRequest, error) {
+	root, err := msg.Root()
+	return Conmon_RestoreContainerRequest{root.Struct()}, err
+}
+
+func (s Conmon_RestoreContainerRequest) String() string {
+	str, _ := text.Marshal(0xeadf00d4ff560865, s.Struct)
+	return str
+}
+
+func (s Conmon_RestoreContainerRequest) Container() (Conmon_CreateContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_CreateContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_RestoreContainerRequest) HasContainer() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_RestoreContainerRequest) SetContainer(v Conmon_CreateContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewContainer sets the container field to a newly
+// allocated Conmon_CreateContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_RestoreContainerRequest) NewContainer() (Conmon_CreateContainerRequest, error) {
+	ss, err := NewConmon_CreateContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CreateContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_RestoreContainerRequest) Options() (Conmon_CheckpointOptions, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_CheckpointOptions{Struct: p.Struct()}, err
+}
+
+func (s Conmon_RestoreContainerRequest) HasOptions() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_RestoreContainerRequest) SetOptions(v Conmon_CheckpointOptions) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewOptions sets the options field to a newly
+// allocated Conmon_CheckpointOptions struct, preferring placement in s's segment.
+func (s Conmon_RestoreContainerRequest) NewOptions() (Conmon_CheckpointOptions, error) {
+	ss, err := NewConmon_CheckpointOptions(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CheckpointOptions{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_RestoreContainerRequest_List is a list of Conmon_RestoreContainerRequest.
+type Conmon_RestoreContainerRequest_List = capnp.StructList[Conmon_RestoreContainerRequest]
+
+// NewConmon_RestoreContainerRequest creates a new list of Conmon_RestoreContainerRequest.
+func NewConmon_RestoreContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_RestoreContainerRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_RestoreContainerRequest]{l}, err
+}
+
+// Conmon_RestoreContainerRequest_Future is a wrapper for a Conmon_RestoreContainerRequest promised by a client call.
+type Conmon_RestoreContainerRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_RestoreContainerRequest_Future) Struct() (Conmon_RestoreContainerRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_RestoreContainerRequest{s}, err
+}
+
+func (p Conmon_RestoreContainerRequest_Future) Container() Conmon_CreateContainerRequest_Future {
+	return Conmon_CreateContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+func (p Conmon_RestoreContainerRequest_Future) Options() Conmon_CheckpointOptions_Future {
+	return Conmon_CheckpointOptions_Future{Future: p.Future.Field(1, nil)}
+}
+
+type Conmon_RestoreContainerResponse struct{ capnp.Struct }
+
+// Conmon_RestoreContainerResponse_TypeID is the unique identifier for the type Conmon_RestoreContainerResponse.
+const Conmon_RestoreContainerResponse_TypeID = 0xacc53302ab486d36
+
+func NewConmon_RestoreContainerResponse(s *capnp.Segment) (Conmon_RestoreContainerResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_RestoreContainerResponse{st}, err
+}
+
+func NewRootConmon_RestoreContainerResponse(s *capnp.Segment) (Conmon_RestoreContainerResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_RestoreContainerResponse{st}, err
+}
+
+func ReadRootConmon_RestoreContainerResponse(msg *capnp.Message) (Conmon_RestoreContainerResponse, error) {
+	root, err := msg.Root()
+	return Conmon_RestoreContainerResponse{root.Struct()}, err
+}
+
+func (s Conmon_RestoreContainerResponse) String() string {
+	str, _ := text.Marshal(0xacc53302ab486d36, s.Struct)
+	return str
+}
+
+func (s Conmon_RestoreContainerResponse) ContainerPid() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Conmon_RestoreContainerResponse) SetContainerPid(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s Conmon_RestoreContainerResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_RestoreContainerResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_RestoreContainerResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_RestoreContainerResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_RestoreContainerResponse_List is a list of Conmon_RestoreContainerResponse.
+type Conmon_RestoreContainerResponse_List = capnp.StructList[Conmon_RestoreContainerResponse]
+
+// NewConmon_RestoreContainerResponse creates a new list of Conmon_RestoreContainerResponse.
+func NewConmon_RestoreContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_RestoreContainerResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_RestoreContainerResponse]{l}, err
+}
+
+// Conmon_RestoreContainerResponse_Future is a wrapper for a Conmon_RestoreContainerResponse promised by a client call.
+type Conmon_RestoreContainerResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_RestoreContainerResponse_Future) Struct() (Conmon_RestoreContainerResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_RestoreContainerResponse{s}, err
+}
+
+func (p Conmon_RestoreContainerResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_KillContainerRequest struct{ capnp.Struct }
+
+// Conmon_KillContainerRequest_TypeID is the unique identifier for the type Conmon_KillContainerRequest.
+const Conmon_KillContainerRequest_TypeID = 0xd285ab9e532f8e8f
+
+func NewConmon_KillContainerRequest(s *capnp.Segment) (Conmon_KillContainerRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_KillContainerRequest{st}, err
+}
+
+func NewRootConmon_KillContainerRequest(s *capnp.Segment) (Conmon_KillContainerRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Conmon_KillContainerRequest{st}, err
+}
+
+func ReadRootConmon_KillContainerRequest(msg *capnp.Message) (Conmon_KillContainerRequest, error) {
+	root, err := msg.Root()
+	return Conmon_KillContainerRequest{root.Struct()}, err
+}
+
+func (s Conmon_KillContainerRequest) String() string {
+	str, _ := text.Marshal(0xd285ab9e532f8e8f, s.Struct)
+	return str
+}
+
+func (s Conmon_KillContainerRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_KillContainerRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_KillContainerRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_KillContainerRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_KillContainerRequest) Signal() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Conmon_KillContainerRequest) SetSignal(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s Conmon_KillContainerRequest) All() bool {
+	return s.Struct.Bit(32)
+}
+
+func (s Conmon_KillContainerRequest) SetAll(v bool) {
+	s.Struct.SetBit(32, v)
+}
+
+// Conmon_KillContainerRequest_List is a list of Conmon_KillContainerRequest.
+type Conmon_KillContainerRequest_List = capnp.StructList[Conmon_KillContainerRequest]
+
+// NewConmon_KillContainerRequest creates a new list of Conmon_KillContainerRequest.
+func NewConmon_KillContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_KillContainerRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_KillContainerRequest]{l}, err
+}
+
+// Conmon_KillContainerRequest_Future is a wrapper for a Conmon_KillContainerRequest promised by a client call.
+type Conmon_KillContainerRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_KillContainerRequest_Future) Struct() (Conmon_KillContainerRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_KillContainerRequest{s}, err
+}
+
+type Conmon_KillContainerResponse struct{ capnp.Struct }
+
+// Conmon_KillContainerResponse_TypeID is the unique identifier for the type Conmon_KillContainerResponse.
+const Conmon_KillContainerResponse_TypeID = 0xadb66abea677f8fc
+
+func NewConmon_KillContainerResponse(s *capnp.Segment) (Conmon_KillContainerResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_KillContainerResponse{st}, err
+}
+
+func NewRootConmon_KillContainerResponse(s *capnp.Segment) (Conmon_KillContainerResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_KillContainerResponse{st}, err
+}
+
+func ReadRootConmon_KillContainerResponse(msg *capnp.Message) (Conmon_KillContainerResponse, error) {
+	root, err := msg.Root()
+	return Conmon_KillContainerResponse{root.Struct()}, err
+}
+
+func (s Conmon_KillContainerResponse) String() string {
+	str, _ := text.Marshal(0xadb66abea677f8fc, s.Struct)
+	return str
+}
+
+func (s Conmon_KillContainerResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_KillContainerResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_KillContainerResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_KillContainerResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_KillContainerResponse_List is a list of Conmon_KillContainerResponse.
+type Conmon_KillContainerResponse_List = capnp.StructList[Conmon_KillContainerResponse]
+
+// NewConmon_KillContainerResponse creates a new list of Conmon_KillContainerResponse.
+func NewConmon_KillContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_KillContainerResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_KillContainerResponse]{l}, err
+}
+
+// Conmon_KillContainerResponse_Future is a wrapper for a Conmon_KillContainerResponse promised by a client call.
+type Conmon_KillContainerResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_KillContainerResponse_Future) Struct() (Conmon_KillContainerResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_KillContainerResponse{s}, err
+}
+
+func (p Conmon_KillContainerResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_PauseContainerRequest struct{ capnp.Struct }
+
+// Conmon_PauseContainerRequest_TypeID is the unique identifier for the type Conmon_PauseContainerRequest.
+const Conmon_PauseContainerRequest_TypeID = 0xcefe45fd0d8dabff
+
+func NewConmon_PauseContainerRequest(s *capnp.Segment) (Conmon_PauseContainerRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_PauseContainerRequest{st}, err
+}
+
+func NewRootConmon_PauseContainerRequest(s *capnp.Segment) (Conmon_PauseContainerRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_PauseContainerRequest{st}, err
+}
+
+func ReadRootConmon_PauseContainerRequest(msg *capnp.Message) (Conmon_PauseContainerRequest, error) {
+	root, err := msg.Root()
+	return Conmon_PauseContainerRequest{root.Struct()}, err
+}
+
+func (s Conmon_PauseContainerRequest) String() string {
+	str, _ := text.Marshal(0xcefe45fd0d8dabff, s.Struct)
+	return str
+}
+
+func (s Conmon_PauseContainerRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_PauseContainerRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_PauseContainerRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_PauseContainerRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_PauseContainerRequest_List is a list of Conmon_PauseContainerRequest.
+type Conmon_PauseContainerRequest_List = capnp.StructList[Conmon_PauseContainerRequest]
+
+// NewConmon_PauseContainerRequest creates a new list of Conmon_PauseContainerRequest.
+func NewConmon_PauseContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_PauseContainerRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_PauseContainerRequest]{l}, err
+}
+
+// Conmon_PauseContainerRequest_Future is a wrapper for a Conmon_PauseContainerRequest promised by a client call.
+type Conmon_PauseContainerRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_PauseContainerRequest_Future) Struct() (Conmon_PauseContainerRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_PauseContainerRequest{s}, err
+}
+
+type Conmon_PauseContainerResponse struct{ capnp.Struct }
+
+// Conmon_PauseContainerResponse_TypeID is the unique identifier for the type Conmon_PauseContainerResponse.
+const Conmon_PauseContainerResponse_TypeID = 0xab9e06d122b40479
+
+func NewConmon_PauseContainerResponse(s *capnp.Segment) (Conmon_PauseContainerResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_PauseContainerResponse{st}, err
+}
+
+func NewRootConmon_PauseContainerResponse(s *capnp.Segment) (Conmon_PauseContainerResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_PauseContainerResponse{st}, err
+}
+
+func ReadRootConmon_PauseContainerResponse(msg *capnp.Message) (Conmon_PauseContainerResponse, error) {
+	root, err := msg.Root()
+	return Conmon_PauseContainerResponse{root.Struct()}, err
+}
+
+func (s Conmon_PauseContainerResponse) String() string {
+	str, _ := text.Marshal(0xab9e06d122b40479, s.Struct)
+	return str
+}
+
+func (s Conmon_PauseContainerResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_PauseContainerResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_PauseContainerResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_PauseContainerResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_PauseContainerResponse_List is a list of Conmon_PauseContainerResponse.
+type Conmon_PauseContainerResponse_List = capnp.StructList[Conmon_PauseContainerResponse]
+
+// NewConmon_PauseContainerResponse creates a new list of Conmon_PauseContainerResponse.
+func NewConmon_PauseContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_PauseContainerResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_PauseContainerResponse]{l}, err
+}
+
+// Conmon_PauseContainerResponse_Future is a wrapper for a Conmon_PauseContainerResponse promised by a client call.
+type Conmon_PauseContainerResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_PauseContainerResponse_Future) Struct() (Conmon_PauseContainerResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_PauseContainerResponse{s}, err
+}
+
+func (p Conmon_PauseContainerResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_UnpauseContainerRequest struct{ capnp.Struct }
+
+// Conmon_UnpauseContainerRequest_TypeID is the unique identifier for the type Conmon_UnpauseContainerRequest.
+const Conmon_UnpauseContainerRequest_TypeID = 0x97094d00caad0b04
+
+func NewConmon_UnpauseContainerRequest(s *capnp.Segment) (Conmon_UnpauseContainerRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_UnpauseContainerRequest{st}, err
+}
+
+func NewRootConmon_UnpauseContainerRequest(s *capnp.Segment) (Conmon_UnpauseContainerRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_UnpauseContainerRequest{st}, err
+}
+
+func ReadRootConmon_UnpauseContainerRequest(msg *capnp.Message) (Conmon_UnpauseContainerRequest, error) {
+	root, err := msg.Root()
+	return Conmon_UnpauseContainerRequest{root.Struct()}, err
+}
+
+func (s Conmon_UnpauseContainerRequest) String() string {
+	str, _ := text.Marshal(0x97094d00caad0b04, s.Struct)
+	return str
+}
+
+func (s Conmon_UnpauseContainerRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_UnpauseContainerRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_UnpauseContainerRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_UnpauseContainerRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_UnpauseContainerRequest_List is a list of Conmon_UnpauseContainerRequest.
+type Conmon_UnpauseContainerRequest_List = capnp.StructList[Conmon_UnpauseContainerRequest]
+
+// NewConmon_UnpauseContainerRequest creates a new list of Conmon_UnpauseContainerRequest.
+func NewConmon_UnpauseContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_UnpauseContainerRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_UnpauseContainerRequest]{l}, err
+}
+
+// Conmon_UnpauseContainerRequest_Future is a wrapper for a Conmon_UnpauseContainerRequest promised by a client call.
+type Conmon_UnpauseContainerRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_UnpauseContainerRequest_Future) Struct() (Conmon_UnpauseContainerRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_UnpauseContainerRequest{s}, err
+}
+
+type Conmon_UnpauseContainerResponse struct{ capnp.Struct }
+
+// Conmon_UnpauseContainerResponse_TypeID is the unique identifier for the type Conmon_UnpauseContainerResponse.
+const Conmon_UnpauseContainerResponse_TypeID = 0xf3fdff7dbc62813a
+
+func NewConmon_UnpauseContainerResponse(s *capnp.Segment) (Conmon_UnpauseContainerResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_UnpauseContainerResponse{st}, err
+}
+
+func NewRootConmon_UnpauseContainerResponse(s *capnp.Segment) (Conmon_UnpauseContainerResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_UnpauseContainerResponse{st}, err
+}
+
+func ReadRootConmon_UnpauseContainerResponse(msg *capnp.Message) (Conmon_UnpauseContainerResponse, error) {
+	root, err := msg.Root()
+	return Conmon_UnpauseContainerResponse{root.Struct()}, err
+}
+
+func (s Conmon_UnpauseContainerResponse) String() string {
+	str, _ := text.Marshal(0xf3fdff7dbc62813a, s.Struct)
+	return str
+}
+
+func (s Conmon_UnpauseContainerResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_UnpauseContainerResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_UnpauseContainerResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_UnpauseContainerResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_UnpauseContainerResponse_List is a list of Conmon_UnpauseContainerResponse.
+type Conmon_UnpauseContainerResponse_List = capnp.StructList[Conmon_UnpauseContainerResponse]
+
+// NewConmon_UnpauseContainerResponse creates a new list of Conmon_UnpauseContainerResponse.
+func NewConmon_UnpauseContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_UnpauseContainerResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_UnpauseContainerResponse]{l}, err
+}
+
+// Conmon_UnpauseContainerResponse_Future is a wrapper for a Conmon_UnpauseContainerResponse promised by a client call.
+type Conmon_UnpauseContainerResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_UnpauseContainerResponse_Future) Struct() (Conmon_UnpauseContainerResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_UnpauseContainerResponse{s}, err
+}
+
+func (p Conmon_UnpauseContainerResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_ArchiveLogsRequest struct{ capnp.Struct }
+
+// Conmon_ArchiveLogsRequest_TypeID is the unique identifier for the type Conmon_ArchiveLogsRequest.
+const Conmon_ArchiveLogsRequest_TypeID = 0x8d313ebdf5ea4abe
+
+func NewConmon_ArchiveLogsRequest(s *capnp.Segment) (Conmon_ArchiveLogsRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ArchiveLogsRequest{st}, err
+}
+
+func NewRootConmon_ArchiveLogsRequest(s *capnp.Segment) (Conmon_ArchiveLogsRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ArchiveLogsRequest{st}, err
+}
+
+func ReadRootConmon_ArchiveLogsRequest(msg *capnp.Message) (Conmon_ArchiveLogsRequest, error) {
+	root, err := msg.Root()
+	return Conmon_ArchiveLogsRequest{root.Struct()}, err
+}
+
+func (s Conmon_ArchiveLogsRequest) String() string {
+	str, _ := text.Marshal(0x8d313ebdf5ea4abe, s.Struct)
+	return str
+}
+
+func (s Conmon_ArchiveLogsRequest) Ids() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_ArchiveLogsRequest) HasIds() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ArchiveLogsRequest) SetIds(v capnp.TextList) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewIds sets the ids field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_ArchiveLogsRequest) NewIds(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_ArchiveLogsRequest_List is a list of Conmon_ArchiveLogsRequest.
+type Conmon_ArchiveLogsRequest_List = capnp.StructList[Conmon_ArchiveLogsRequest]
+
+// NewConmon_ArchiveLogsRequest creates a new list of Conmon_ArchiveLogsRequest.
+func NewConmon_ArchiveLogsRequest_List(s *capnp.Segment, sz int32) (Conmon_ArchiveLogsRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ArchiveLogsRequest]{l}, err
+}
+
+// Conmon_ArchiveLogsRequest_Future is a wrapper for a Conmon_ArchiveLogsRequest promised by a client call.
+type Conmon_ArchiveLogsRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_ArchiveLogsRequest_Future) Struct() (Conmon_ArchiveLogsRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ArchiveLogsRequest{s}, err
+}
+
+type Conmon_ArchivedContainer struct{ capnp.Struct }
+
+// Conmon_ArchivedContainer_TypeID is the unique identifier for the type Conmon_ArchivedContainer.
+const Conmon_ArchivedContainer_TypeID = 0xcf52eceec0c85167
+
+func NewConmon_ArchivedContainer(s *capnp.Segment) (Conmon_ArchivedContainer, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
+	return Conmon_ArchivedContainer{st}, err
+}
+
+func NewRootConmon_ArchivedContainer(s *capnp.Segment) (Conmon_ArchivedContainer, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
+	return Conmon_ArchivedContainer{st}, err
+}
+
+func ReadRootConmon_ArchivedContainer(msg *capnp.Message) (Conmon_ArchivedContainer, error) {
+	root, err := msg.Root()
+	return Conmon_ArchivedContainer{root.Struct()}, err
+}
+
+func (s Conmon_ArchivedContainer) String() string {
+	str, _ := text.Marshal(0xcf52eceec0c85167, s.Struct)
+	return str
+}
+
+func (s Conmon_ArchivedContainer) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ArchivedContainer) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ArchivedContainer) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ArchivedContainer) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ArchivedContainer) LogPaths() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(1)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_ArchivedContainer) HasLogPaths() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ArchivedContainer) SetLogPaths(v capnp.TextList) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewLogPaths sets the logPaths field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_ArchivedContainer) NewLogPaths(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_ArchivedContainer) Exited() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Conmon_ArchivedContainer) SetExited(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+func (s Conmon_ArchivedContainer) ExitCode() int32 {
+	return int32(s.Struct.Uint32(4))
+}
+
+func (s Conmon_ArchivedContainer) SetExitCode(v int32) {
+	s.Struct.SetUint32(4, uint32(v))
+}
+
+func (s Conmon_ArchivedContainer) Timestamp() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_ArchivedContainer) SetTimestamp(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_ArchivedContainer) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(2)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ArchivedContainer) HasError() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_ArchivedContainer) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(2, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_ArchivedContainer) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(2, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_ArchivedContainer_List is a list of Conmon_ArchivedContainer.
+type Conmon_ArchivedContainer_List = capnp.StructList[Conmon_ArchivedContainer]
+
+// NewConmon_ArchivedContainer creates a new list of Conmon_ArchivedContainer.
+func NewConmon_ArchivedContainer_List(s *capnp.Segment, sz int32) (Conmon_ArchivedContainer_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_ArchivedContainer]{l}, err
+}
+
+// Conmon_ArchivedContainer_Future is a wrapper for a Conmon_ArchivedContainer promised by a client call.
+type Conmon_ArchivedContainer_Future struct{ *capnp.Future }
+
+func (p Conmon_ArchivedContainer_Future) Struct() (Conmon_ArchivedContainer, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ArchivedContainer{s}, err
+}
+
+func (p Conmon_ArchivedContainer_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(2, nil)}
+}
+
+type Conmon_ArchiveLogsResponse struct{ capnp.Struct }
+
+// Conmon_ArchiveLogsResponse_TypeID is the unique identifier for the type Conmon_ArchiveLogsResponse.
+const Conmon_ArchiveLogsResponse_TypeID = 0x8234b52ad8d55d61
+
+func NewConmon_ArchiveLogsResponse(s *capnp.Segment) (Conmon_ArchiveLogsResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ArchiveLogsResponse{st}, err
+}
+
+func NewRootConmon_ArchiveLogsResponse(s *capnp.Segment) (Conmon_ArchiveLogsResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ArchiveLogsResponse{st}, err
+}
+
+func ReadRootConmon_ArchiveLogsResponse(msg *capnp.Message) (Conmon_ArchiveLogsResponse, error) {
+	root, err := msg.Root()
+	return Conmon_ArchiveLogsResponse{root.Struct()}, err
+}
+
+func (s Conmon_ArchiveLogsResponse) String() string {
+	str, _ := text.Marshal(0x8234b52ad8d55d61, s.Struct)
+	return str
+}
+
+func (s Conmon_ArchiveLogsResponse) Containers() (Conmon_ArchivedContainer_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ArchivedContainer_List{List: p.List()}, err
+}
+
+func (s Conmon_ArchiveLogsResponse) HasContainers() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ArchiveLogsResponse) SetContainers(v Conmon_ArchivedContainer_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewContainers sets the containers field to a newly
+// allocated Conmon_ArchivedContainer_List, preferring placement in s's segment.
+func (s Conmon_ArchiveLogsResponse) NewContainers(n int32) (Conmon_ArchivedContainer_List, error) {
+	l, err := NewConmon_ArchivedContainer_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_ArchivedContainer_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_ArchiveLogsResponse_List is a list of Conmon_ArchiveLogsResponse.
+type Conmon_ArchiveLogsResponse_List = capnp.StructList[Conmon_ArchiveLogsResponse]
+
+// NewConmon_ArchiveLogsResponse creates a new list of Conmon_ArchiveLogsResponse.
+func NewConmon_ArchiveLogsResponse_List(s *capnp.Segment, sz int32) (Conmon_ArchiveLogsResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ArchiveLogsResponse]{l}, err
+}
+
+// Conmon_ArchiveLogsResponse_Future is a wrapper for a Conmon_ArchiveLogsResponse promised by a client call.
+type Conmon_ArchiveLogsResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_ArchiveLogsResponse_Future) Struct() (Conmon_ArchiveLogsResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ArchiveLogsResponse{s}, err
+}
+
+type Conmon_Label struct{ capnp.Struct }
+
+// Conmon_Label_TypeID is the unique identifier for the type Conmon_Label.
+const Conmon_Label_TypeID = 0xaa06e856a55ab43f
+
+func NewConmon_Label(s *capnp.Segment) (Conmon_Label, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_Label{st}, err
+}
+
+func NewRootConmon_Label(s *capnp.Segment) (Conmon_Label, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_Label{st}, err
+}
+
+func ReadRootConmon_Label(msg *capnp.Message) (Conmon_Label, error) {
+	root, err := msg.Root()
+	return Conmon_Label{root.Struct()}, err
+}
+
+func (s Conmon_Label) String() string {
+	str, _ := text.Marshal(0xaa06e856a55ab43f, s.Struct)
+	return str
+}
+
+func (s Conmon_Label) Key() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_Label) HasKey() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_Label) KeyBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_Label) SetKey(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_Label) Value() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_Label) HasValue() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_Label) ValueBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_Label) SetValue(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+// Conmon_Label_List is a list of Conmon_Label.
+type Conmon_Label_List = capnp.StructList[Conmon_Label]
+
+// NewConmon_Label creates a new list of Conmon_Label.
+func NewConmon_Label_List(s *capnp.Segment, sz int32) (Conmon_Label_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_Label]{l}, err
+}
+
+// Conmon_Label_Future is a wrapper for a Conmon_Label promised by a client call.
+type Conmon_Label_Future struct{ *capnp.Future }
+
+func (p Conmon_Label_Future) Struct() (Conmon_Label, error) {
+	s, err := p.Future.Struct()
+	return Conmon_Label{s}, err
+}
+
+type Conmon_SetLabelsRequest struct{ capnp.Struct }
+
+// Conmon_SetLabelsRequest_TypeID is the unique identifier for the type Conmon_SetLabelsRequest.
+const Conmon_SetLabelsRequest_TypeID = 0xc5d23d62a3774838
+
+func NewConmon_SetLabelsRequest(s *capnp.Segment) (Conmon_SetLabelsRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_SetLabelsRequest{st}, err
+}
+
+func NewRootConmon_SetLabelsRequest(s *capnp.Segment) (Conmon_SetLabelsRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_SetLabelsRequest{st}, err
+}
+
+func ReadRootConmon_SetLabelsRequest(msg *capnp.Message) (Conmon_SetLabelsRequest, error) {
+	root, err := msg.Root()
+	return Conmon_SetLabelsRequest{root.Struct()}, err
+}
+
+func (s Conmon_SetLabelsRequest) String() string {
+	str, _ := text.Marshal(0xc5d23d62a3774838, s.Struct)
+	return str
+}
+
+func (s Conmon_SetLabelsRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_SetLabelsRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_SetLabelsRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_SetLabelsRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_SetLabelsRequest) Labels() (Conmon_Label_List, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_Label_List{List: p.List()}, err
+}
+
+func (s Conmon_SetLabelsRequest) HasLabels() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_SetLabelsRequest) SetLabels(v Conmon_Label_List) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewLabels sets the labels field to a newly
+// allocated Conmon_Label_List, preferring placement in s's segment.
+func (s Conmon_SetLabelsRequest) NewLabels(n int32) (Conmon_Label_List, error) {
+	l, err := NewConmon_Label_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_Label_List{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_SetLabelsRequest_List is a list of Conmon_SetLabelsRequest.
+type Conmon_SetLabelsRequest_List = capnp.StructList[Conmon_SetLabelsRequest]
+
+// NewConmon_SetLabelsRequest creates a new list of Conmon_SetLabelsRequest.
+func NewConmon_SetLabelsRequest_List(s *capnp.Segment, sz int32) (Conmon_SetLabelsRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_SetLabelsRequest]{l}, err
+}
+
+// Conmon_SetLabelsRequest_Future is a wrapper for a Conmon_SetLabelsRequest promised by a client call.
+type Conmon_SetLabelsRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_SetLabelsRequest_Future) Struct() (Conmon_SetLabelsRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SetLabelsRequest{s}, err
+}
+
+type Conmon_SetLabelsResponse struct{ capnp.Struct }
+
+// Conmon_SetLabelsResponse_TypeID is the unique identifier for the type Conmon_SetLabelsResponse.
+const Conmon_SetLabelsResponse_TypeID = 0xfa54490c8d7a3f3f
+
+func NewConmon_SetLabelsResponse(s *capnp.Segment) (Conmon_SetLabelsResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_SetLabelsResponse{st}, err
+}
+
+func NewRootConmon_SetLabelsResponse(s *capnp.Segment) (Conmon_SetLabelsResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_SetLabelsResponse{st}, err
+}
+
+func ReadRootConmon_SetLabelsResponse(msg *capnp.Message) (Conmon_SetLabelsResponse, error) {
+	root, err := msg.Root()
+	return Conmon_SetLabelsResponse{root.Struct()}, err
+}
+
+func (s Conmon_SetLabelsResponse) String() string {
+	str, _ := text.Marshal(0xfa54490c8d7a3f3f, s.Struct)
+	return str
+}
+
+func (s Conmon_SetLabelsResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_SetLabelsResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_SetLabelsResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_SetLabelsResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_SetLabelsResponse_List is a list of Conmon_SetLabelsResponse.
+type Conmon_SetLabelsResponse_List = capnp.StructList[Conmon_SetLabelsResponse]
+
+// NewConmon_SetLabelsResponse creates a new list of Conmon_SetLabelsResponse.
+func NewConmon_SetLabelsResponse_List(s *capnp.Segment, sz int32) (Conmon_SetLabelsResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_SetLabelsResponse]{l}, err
+}
+
+// Conmon_SetLabelsResponse_Future is a wrapper for a Conmon_SetLabelsResponse promised by a client call.
+type Conmon_SetLabelsResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_SetLabelsResponse_Future) Struct() (Conmon_SetLabelsResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SetLabelsResponse{s}, err
+}
+
+func (p Conmon_SetLabelsResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_GetLabelsRequest struct{ capnp.Struct }
+
+// Conmon_GetLabelsRequest_TypeID is the unique identifier for the type Conmon_GetLabelsRequest.
+const Conmon_GetLabelsRequest_TypeID = 0xa970a2775e6a0d18
+
+func NewConmon_GetLabelsRequest(s *capnp.Segment) (Conmon_GetLabelsRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_GetLabelsRequest{st}, err
+}
+
+func NewRootConmon_GetLabelsRequest(s *capnp.Segment) (Conmon_GetLabelsRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_GetLabelsRequest{st}, err
+}
+
+func ReadRootConmon_GetLabelsRequest(msg *capnp.Message) (Conmon_GetLabelsRequest, error) {
+	root, err := msg.Root()
+	return Conmon_GetLabelsRequest{root.Struct()}, err
+}
+
+func (s Conmon_GetLabelsRequest) String() string {
+	str, _ := text.Marshal(0xa970a2775e6a0d18, s.Struct)
+	return str
+}
+
+func (s Conmon_GetLabelsRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_GetLabelsRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_GetLabelsRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_GetLabelsRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_GetLabelsRequest_List is a list of Conmon_GetLabelsRequest.
+type Conmon_GetLabelsRequest_List = capnp.StructList[Conmon_GetLabelsRequest]
+
+// NewConmon_GetLabelsRequest creates a new list of Conmon_GetLabelsRequest.
+func NewConmon_GetLabelsRequest_List(s *capnp.Segment, sz int32) (Conmon_GetLabelsRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_GetLabelsRequest]{l}, err
+}
+
+// Conmon_GetLabelsRequest_Future is a wrapper for a Conmon_GetLabelsRequest promised by a client call.
+type Conmon_GetLabelsRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_GetLabelsRequest_Future) Struct() (Conmon_GetLabelsRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_GetLabelsRequest{s}, err
+}
+
+type Conmon_GetLabelsResponse struct{ capnp.Struct }
+
+// Conmon_GetLabelsResponse_TypeID is the unique identifier for the type Conmon_GetLabelsResponse.
+const Conmon_GetLabelsResponse_TypeID = 0xf508192f5b9061f9
+
+func NewConmon_GetLabelsResponse(s *capnp.Segment) (Conmon_GetLabelsResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_GetLabelsResponse{st}, err
+}
+
+func NewRootConmon_GetLabelsResponse(s *capnp.Segment) (Conmon_GetLabelsResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_GetLabelsResponse{st}, err
+}
+
+func ReadRootConmon_GetLabelsResponse(msg *capnp.Message) (Conmon_GetLabelsResponse, error) {
+	root, err := msg.Root()
+	return Conmon_GetLabelsResponse{root.Struct()}, err
+}
+
+func (s Conmon_GetLabelsResponse) String() string {
+	str, _ := text.Marshal(0xf508192f5b9061f9, s.Struct)
+	return str
+}
+
+func (s Conmon_GetLabelsResponse) Labels() (Conmon_Label_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_Label_List{List: p.List()}, err
+}
+
+func (s Conmon_GetLabelsResponse) HasLabels() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_GetLabelsResponse) SetLabels(v Conmon_Label_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewLabels sets the labels field to a newly
+// allocated Conmon_Label_List, preferring placement in s's segment.
+func (s Conmon_GetLabelsResponse) NewLabels(n int32) (Conmon_Label_List, error) {
+	l, err := NewConmon_Label_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_Label_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_GetLabelsResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_GetLabelsResponse) HasError() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_GetLabelsResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_GetLabelsResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_GetLabelsResponse_List is a list of Conmon_GetLabelsResponse.
+type Conmon_GetLabelsResponse_List = capnp.StructList[Conmon_GetLabelsResponse]
+
+// NewConmon_GetLabelsResponse creates a new list of Conmon_GetLabelsResponse.
+func NewConmon_GetLabelsResponse_List(s *capnp.Segment, sz int32) (Conmon_GetLabelsResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_GetLabelsResponse]{l}, err
+}
+
+// Conmon_GetLabelsResponse_Future is a wrapper for a Conmon_GetLabelsResponse promised by a client call.
+type Conmon_GetLabelsResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_GetLabelsResponse_Future) Struct() (Conmon_GetLabelsResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_GetLabelsResponse{s}, err
+}
+
+func (p Conmon_GetLabelsResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(1, nil)}
+}
+
+type Conmon_ServeSeccompNotifyRequest struct{ capnp.Struct }
+
+// Conmon_ServeSeccompNotifyRequest_TypeID is the unique identifier for the type Conmon_ServeSeccompNotifyRequest.
+const Conmon_ServeSeccompNotifyRequest_TypeID = 0xe1610143b0a97fd5
+
+func NewConmon_ServeSeccompNotifyRequest(s *capnp.Segment) (Conmon_ServeSeccompNotifyRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_ServeSeccompNotifyRequest{st}, err
+}
+
+func NewRootConmon_ServeSeccompNotifyRequest(s *capnp.Segment) (Conmon_ServeSeccompNotifyRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_ServeSeccompNotifyRequest{st}, err
+}
+
+func ReadRootConmon_ServeSeccompNotifyRequest(msg *capnp.Message) (Conmon_ServeSeccompNotifyRequest, error) {
+	root, err := msg.Root()
+	return Conmon_ServeSeccompNotifyRequest{root.Struct()}, err
+}
+
+func (s Conmon_ServeSeccompNotifyRequest) String() string {
+	str, _ := text.Marshal(0xe1610143b0a97fd5, s.Struct)
+	return str
+}
+
+func (s Conmon_ServeSeccompNotifyRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_ServeSeccompNotifyRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ServeSeccompNotifyRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_ServeSeccompNotifyRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_ServeSeccompNotifyRequest) Handler() Conmon_SeccompNotifyHandler {
+	p, _ := s.Struct.Ptr(1)
+	return Conmon_SeccompNotifyHandler{Client: p.Interface().Client()}
+}
+
+func (s Conmon_ServeSeccompNotifyRequest) HasHandler() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_ServeSeccompNotifyRequest) SetHandler(v Conmon_SeccompNotifyHandler) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(1, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(1, in.ToPtr())
+}
+
+// Conmon_ServeSeccompNotifyRequest_List is a list of Conmon_ServeSeccompNotifyRequest.
+type Conmon_ServeSeccompNotifyRequest_List = capnp.StructList[Conmon_ServeSeccompNotifyRequest]
+
+// NewConmon_ServeSeccompNotifyRequest creates a new list of Conmon_ServeSeccompNotifyRequest.
+func NewConmon_ServeSeccompNotifyRequest_List(s *capnp.Segment, sz int32) (Conmon_ServeSeccompNotifyRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_ServeSeccompNotifyRequest]{l}, err
+}
+
+// Conmon_ServeSeccompNotifyRequest_Future is a wrapper for a Conmon_ServeSeccompNotifyRequest promised by a client call.
+type Conmon_ServeSeccompNotifyRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_ServeSeccompNotifyRequest_Future) Struct() (Conmon_ServeSeccompNotifyRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ServeSeccompNotifyRequest{s}, err
+}
+
+func (p Conmon_ServeSeccompNotifyRequest_Future) Handler() Conmon_SeccompNotifyHandler {
+	return Conmon_SeccompNotifyHandler{Client: p.Future.Field(1, nil).Client()}
+}
+
+type Conmon_ServeSeccompNotifyResponse struct{ capnp.Struct }
+
+// Conmon_ServeSeccompNotifyResponse_TypeID is the unique identifier for the type Conmon_ServeSeccompNotifyResponse.
+const Conmon_ServeSeccompNotifyResponse_TypeID = 0x9a716e5edad0cd20
+
+func NewConmon_ServeSeccompNotifyResponse(s *capnp.Segment) (Conmon_ServeSeccompNotifyResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ServeSeccompNotifyResponse{st}, err
+}
+
+func NewRootConmon_ServeSeccompNotifyResponse(s *capnp.Segment) (Conmon_ServeSeccompNotifyResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_ServeSeccompNotifyResponse{st}, err
+}
+
+func ReadRootConmon_ServeSeccompNotifyResponse(msg *capnp.Message) (Conmon_ServeSeccompNotifyResponse, error) {
+	root, err := msg.Root()
+	return Conmon_ServeSeccompNotifyResponse{root.Struct()}, err
+}
+
+func (s Conmon_ServeSeccompNotifyResponse) String() string {
+	str, _ := text.Marshal(0x9a716e5edad0cd20, s.Struct)
+	return str
+}
+
+func (s Conmon_ServeSeccompNotifyResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_ServeSeccompNotifyResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_ServeSeccompNotifyResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_ServeSeccompNotifyResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_ServeSeccompNotifyResponse_List is a list of Conmon_ServeSeccompNotifyResponse.
+type Conmon_ServeSeccompNotifyResponse_List = capnp.StructList[Conmon_ServeSeccompNotifyResponse]
+
+// NewConmon_ServeSeccompNotifyResponse creates a new list of Conmon_ServeSeccompNotifyResponse.
+func NewConmon_ServeSeccompNotifyResponse_List(s *capnp.Segment, sz int32) (Conmon_ServeSeccompNotifyResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_ServeSeccompNotifyResponse]{l}, err
+}
+
+// Conmon_ServeSeccompNotifyResponse_Future is a wrapper for a Conmon_ServeSeccompNotifyResponse promised by a client call.
+type Conmon_ServeSeccompNotifyResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_ServeSeccompNotifyResponse_Future) Struct() (Conmon_ServeSeccompNotifyResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_ServeSeccompNotifyResponse{s}, err
+}
+
+func (p Conmon_ServeSeccompNotifyResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_SeccompNotifyHandler struct{ Client *capnp.Client }
+
+// Conmon_SeccompNotifyHandler_TypeID is the unique identifier for the type Conmon_SeccompNotifyHandler.
+const Conmon_SeccompNotifyHandler_TypeID = 0xa85bc00ca9d9e314
+
+func (c Conmon_SeccompNotifyHandler) Handle(ctx context.Context, params func(Conmon_SeccompNotifyHandler_handle_Params) error) (Conmon_SeccompNotifyHandler_handle_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xa85bc00ca9d9e314,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.SeccompNotifyHandler",
+			MethodName:    "handle",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_SeccompNotifyHandler_handle_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_SeccompNotifyHandler_handle_Results_Future{Future: ans.Future()}, release
+}
+func (c Conmon_SeccompNotifyHandler) Done(ctx context.Context, params func(Conmon_SeccompNotifyHandler_done_Params) error) (Conmon_SeccompNotifyHandler_done_Results_Future, capnp.ReleaseFunc) {
+	s := capnp.Send{
+		Method: capnp.Method{
+			InterfaceID:   0xa85bc00ca9d9e314,
+			MethodID:      1,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.SeccompNotifyHandler",
+			MethodName:    "done",
+		},
+	}
+	if params != nil {
+		s.ArgsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 1}
+		s.PlaceArgs = func(s capnp.Struct) error { return params(Conmon_SeccompNotifyHandler_done_Params{Struct: s}) }
+	}
+	ans, release := c.Client.SendCall(ctx, s)
+	return Conmon_SeccompNotifyHandler_done_Results_Future{Future: ans.Future()}, release
+}
+
+func (c Conmon_SeccompNotifyHandler) AddRef() Conmon_SeccompNotifyHandler {
+	return Conmon_SeccompNotifyHandler{
+		Client: c.Client.AddRef(),
+	}
+}
+
+func (c Conmon_SeccompNotifyHandler) Release() {
+	c.Client.Release()
+}
+
+// A Conmon_SeccompNotifyHandler_Server is a Conmon_SeccompNotifyHandler with a local implementation.
+type Conmon_SeccompNotifyHandler_Server interface {
+	Handle(context.Context, Conmon_SeccompNotifyHandler_handle) error
+
+	Done(context.Context, Conmon_SeccompNotifyHandler_done) error
+}
+
+// Conmon_SeccompNotifyHandler_NewServer creates a new Server from an implementation of Conmon_SeccompNotifyHandler_Server.
+func Conmon_SeccompNotifyHandler_NewServer(s Conmon_SeccompNotifyHandler_Server, policy *server.Policy) *server.Server {
+	c, _ := s.(server.Shutdowner)
+	return server.New(Conmon_SeccompNotifyHandler_Methods(nil, s), s, c, policy)
+}
+
+// Conmon_SeccompNotifyHandler_ServerToClient creates a new Client from an implementation of Conmon_SeccompNotifyHandler_Server.
+// The caller is responsible for calling Release on the returned Client.
+func Conmon_SeccompNotifyHandler_ServerToClient(s Conmon_SeccompNotifyHandler_Server, policy *server.Policy) Conmon_SeccompNotifyHandler {
+	return Conmon_SeccompNotifyHandler{Client: capnp.NewClient(Conmon_SeccompNotifyHandler_NewServer(s, policy))}
+}
+
+// Conmon_SeccompNotifyHandler_Methods appends Methods to a slice that invoke the methods on s.
+// This can be used to create a more complicated Server.
+func Conmon_SeccompNotifyHandler_Methods(methods []server.Method, s Conmon_SeccompNotifyHandler_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 2)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa85bc00ca9d9e314,
+			MethodID:      0,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.SeccompNotifyHandler",
+			MethodName:    "handle",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Handle(ctx, Conmon_SeccompNotifyHandler_handle{call})
+		},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa85bc00ca9d9e314,
+			MethodID:      1,
+			InterfaceName: "conmon-rs/common/proto/conmon.capnp:Conmon.SeccompNotifyHandler",
+			MethodName:    "done",
+		},
+		Impl: func(ctx context.Context, call *server.Call) error {
+			return s.Done(ctx, Conmon_SeccompNotifyHandler_done{call})
+		},
+	})
+
+	return methods
+}
+
+// Conmon_SeccompNotifyHandler_handle holds the state for a server call to Conmon_SeccompNotifyHandler.handle.
+// See server.Call for documentation.
+type Conmon_SeccompNotifyHandler_handle struct {
+	*server.Call
+}
+
 // Args returns the call's arguments.
-func (c Conmon_version) Args() Conmon_version_Params {
-	return Conmon_version_Params{Struct: c.Call.Args()}
+func (c Conmon_SeccompNotifyHandler_handle) Args() Conmon_SeccompNotifyHandler_handle_Params {
+	return Conmon_SeccompNotifyHandler_handle_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_SeccompNotifyHandler_handle) AllocResults() (Conmon_SeccompNotifyHandler_handle_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_SeccompNotifyHandler_handle_Results{Struct: r}, err
+}
+
+// Conmon_SeccompNotifyHandler_done holds the state for a server call to Conmon_SeccompNotifyHandler.done.
+// See server.Call for documentation.
+type Conmon_SeccompNotifyHandler_done struct {
+	*server.Call
+}
+
+// Args returns the call's arguments.
+func (c Conmon_SeccompNotifyHandler_done) Args() Conmon_SeccompNotifyHandler_done_Params {
+	return Conmon_SeccompNotifyHandler_done_Params{Struct: c.Call.Args()}
+}
+
+// AllocResults allocates the results struct.
+func (c Conmon_SeccompNotifyHandler_done) AllocResults() (Conmon_SeccompNotifyHandler_done_Results, error) {
+	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_SeccompNotifyHandler_done_Results{Struct: r}, err
+}
+
+type Conmon_SeccompNotifyHandler_handle_Params struct{ capnp.Struct }
+
+// Conmon_SeccompNotifyHandler_handle_Params_TypeID is the unique identifier for the type Conmon_SeccompNotifyHandler_handle_Params.
+const Conmon_SeccompNotifyHandler_handle_Params_TypeID = 0xdea4ee41af9d3e55
+
+func NewConmon_SeccompNotifyHandler_handle_Params(s *capnp.Segment) (Conmon_SeccompNotifyHandler_handle_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_SeccompNotifyHandler_handle_Params{st}, err
+}
+
+func NewRootConmon_SeccompNotifyHandler_handle_Params(s *capnp.Segment) (Conmon_SeccompNotifyHandler_handle_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_SeccompNotifyHandler_handle_Params{st}, err
+}
+
+func ReadRootConmon_SeccompNotifyHandler_handle_Params(msg *capnp.Message) (Conmon_SeccompNotifyHandler_handle_Params, error) {
+	root, err := msg.Root()
+	return Conmon_SeccompNotifyHandler_handle_Params{root.Struct()}, err
+}
+
+func (s Conmon_SeccompNotifyHandler_handle_Params) String() string {
+	str, _ := text.Marshal(0xdea4ee41af9d3e55, s.Struct)
+	return str
+}
+
+func (s Conmon_SeccompNotifyHandler_handle_Params) Notification() (Conmon_SeccompNotification, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SeccompNotification{Struct: p.Struct()}, err
+}
+
+func (s Conmon_SeccompNotifyHandler_handle_Params) HasNotification() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_SeccompNotifyHandler_handle_Params) SetNotification(v Conmon_SeccompNotification) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewNotification sets the notification field to a newly
+// allocated Conmon_SeccompNotification struct, preferring placement in s's segment.
+func (s Conmon_SeccompNotifyHandler_handle_Params) NewNotification() (Conmon_SeccompNotification, error) {
+	ss, err := NewConmon_SeccompNotification(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SeccompNotification{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_SeccompNotifyHandler_handle_Params_List is a list of Conmon_SeccompNotifyHandler_handle_Params.
+type Conmon_SeccompNotifyHandler_handle_Params_List = capnp.StructList[Conmon_SeccompNotifyHandler_handle_Params]
+
+// NewConmon_SeccompNotifyHandler_handle_Params creates a new list of Conmon_SeccompNotifyHandler_handle_Params.
+func NewConmon_SeccompNotifyHandler_handle_Params_List(s *capnp.Segment, sz int32) (Conmon_SeccompNotifyHandler_handle_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_SeccompNotifyHandler_handle_Params]{l}, err
+}
+
+// Conmon_SeccompNotifyHandler_handle_Params_Future is a wrapper for a Conmon_SeccompNotifyHandler_handle_Params promised by a client call.
+type Conmon_SeccompNotifyHandler_handle_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_SeccompNotifyHandler_handle_Params_Future) Struct() (Conmon_SeccompNotifyHandler_handle_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SeccompNotifyHandler_handle_Params{s}, err
+}
+
+func (p Conmon_SeccompNotifyHandler_handle_Params_Future) Notification() Conmon_SeccompNotification_Future {
+	return Conmon_SeccompNotification_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_SeccompNotifyHandler_handle_Results struct{ capnp.Struct }
+
+// Conmon_SeccompNotifyHandler_handle_Results_TypeID is the unique identifier for the type Conmon_SeccompNotifyHandler_handle_Results.
+const Conmon_SeccompNotifyHandler_handle_Results_TypeID = 0xf9e85731d816e2fa
+
+func NewConmon_SeccompNotifyHandler_handle_Results(s *capnp.Segment) (Conmon_SeccompNotifyHandler_handle_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_SeccompNotifyHandler_handle_Results{st}, err
+}
+
+func NewRootConmon_SeccompNotifyHandler_handle_Results(s *capnp.Segment) (Conmon_SeccompNotifyHandler_handle_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_SeccompNotifyHandler_handle_Results{st}, err
+}
+
+func ReadRootConmon_SeccompNotifyHandler_handle_Results(msg *capnp.Message) (Conmon_SeccompNotifyHandler_handle_Results, error) {
+	root, err := msg.Root()
+	return Conmon_SeccompNotifyHandler_handle_Results{root.Struct()}, err
+}
+
+func (s Conmon_SeccompNotifyHandler_handle_Results) String() string {
+	str, _ := text.Marshal(0xf9e85731d816e2fa, s.Struct)
+	return str
+}
+
+func (s Conmon_SeccompNotifyHandler_handle_Results) Response() (Conmon_SeccompNotificationResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SeccompNotificationResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_SeccompNotifyHandler_handle_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_SeccompNotifyHandler_handle_Results) SetResponse(v Conmon_SeccompNotificationResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_SeccompNotificationResponse struct, preferring placement in s's segment.
+func (s Conmon_SeccompNotifyHandler_handle_Results) NewResponse() (Conmon_SeccompNotificationResponse, error) {
+	ss, err := NewConmon_SeccompNotificationResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SeccompNotificationResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_SeccompNotifyHandler_handle_Results_List is a list of Conmon_SeccompNotifyHandler_handle_Results.
+type Conmon_SeccompNotifyHandler_handle_Results_List = capnp.StructList[Conmon_SeccompNotifyHandler_handle_Results]
+
+// NewConmon_SeccompNotifyHandler_handle_Results creates a new list of Conmon_SeccompNotifyHandler_handle_Results.
+func NewConmon_SeccompNotifyHandler_handle_Results_List(s *capnp.Segment, sz int32) (Conmon_SeccompNotifyHandler_handle_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_SeccompNotifyHandler_handle_Results]{l}, err
+}
+
+// Conmon_SeccompNotifyHandler_handle_Results_Future is a wrapper for a Conmon_SeccompNotifyHandler_handle_Results promised by a client call.
+type Conmon_SeccompNotifyHandler_handle_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_SeccompNotifyHandler_handle_Results_Future) Struct() (Conmon_SeccompNotifyHandler_handle_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SeccompNotifyHandler_handle_Results{s}, err
+}
+
+func (p Conmon_SeccompNotifyHandler_handle_Results_Future) Response() Conmon_SeccompNotificationResponse_Future {
+	return Conmon_SeccompNotificationResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_SeccompNotifyHandler_done_Params struct{ capnp.Struct }
+
+// Conmon_SeccompNotifyHandler_done_Params_TypeID is the unique identifier for the type Conmon_SeccompNotifyHandler_done_Params.
+const Conmon_SeccompNotifyHandler_done_Params_TypeID = 0xa5c5421589865e90
+
+func NewConmon_SeccompNotifyHandler_done_Params(s *capnp.Segment) (Conmon_SeccompNotifyHandler_done_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_SeccompNotifyHandler_done_Params{st}, err
+}
+
+func NewRootConmon_SeccompNotifyHandler_done_Params(s *capnp.Segment) (Conmon_SeccompNotifyHandler_done_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_SeccompNotifyHandler_done_Params{st}, err
+}
+
+func ReadRootConmon_SeccompNotifyHandler_done_Params(msg *capnp.Message) (Conmon_SeccompNotifyHandler_done_Params, error) {
+	root, err := msg.Root()
+	return Conmon_SeccompNotifyHandler_done_Params{root.Struct()}, err
+}
+
+func (s Conmon_SeccompNotifyHandler_done_Params) String() string {
+	str, _ := text.Marshal(0xa5c5421589865e90, s.Struct)
+	return str
+}
+
+func (s Conmon_SeccompNotifyHandler_done_Params) Error() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_SeccompNotifyHandler_done_Params) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_SeccompNotifyHandler_done_Params) ErrorBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_SeccompNotifyHandler_done_Params) SetError(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_SeccompNotifyHandler_done_Params_List is a list of Conmon_SeccompNotifyHandler_done_Params.
+type Conmon_SeccompNotifyHandler_done_Params_List = capnp.StructList[Conmon_SeccompNotifyHandler_done_Params]
+
+// NewConmon_SeccompNotifyHandler_done_Params creates a new list of Conmon_SeccompNotifyHandler_done_Params.
+func NewConmon_SeccompNotifyHandler_done_Params_List(s *capnp.Segment, sz int32) (Conmon_SeccompNotifyHandler_done_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_SeccompNotifyHandler_done_Params]{l}, err
+}
+
+// Conmon_SeccompNotifyHandler_done_Params_Future is a wrapper for a Conmon_SeccompNotifyHandler_done_Params promised by a client call.
+type Conmon_SeccompNotifyHandler_done_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_SeccompNotifyHandler_done_Params_Future) Struct() (Conmon_SeccompNotifyHandler_done_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SeccompNotifyHandler_done_Params{s}, err
+}
+
+type Conmon_SeccompNotifyHandler_done_Results struct{ capnp.Struct }
+
+// Conmon_SeccompNotifyHandler_done_Results_TypeID is the unique identifier for the type Conmon_SeccompNotifyHandler_done_Results.
+const Conmon_SeccompNotifyHandler_done_Results_TypeID = 0x9730e2bb79a6f04c
+
+func NewConmon_SeccompNotifyHandler_done_Results(s *capnp.Segment) (Conmon_SeccompNotifyHandler_done_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_SeccompNotifyHandler_done_Results{st}, err
+}
+
+func NewRootConmon_SeccompNotifyHandler_done_Results(s *capnp.Segment) (Conmon_SeccompNotifyHandler_done_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_SeccompNotifyHandler_done_Results{st}, err
+}
+
+func ReadRootConmon_SeccompNotifyHandler_done_Results(msg *capnp.Message) (Conmon_SeccompNotifyHandler_done_Results, error) {
+	root, err := msg.Root()
+	return Conmon_SeccompNotifyHandler_done_Results{root.Struct()}, err
+}
+
+func (s Conmon_SeccompNotifyHandler_done_Results) String() string {
+	str, _ := text.Marshal(0x9730e2bb79a6f04c, s.Struct)
+	return str
+}
+
+// Conmon_SeccompNotifyHandler_done_Results_List is a list of Conmon_SeccompNotifyHandler_done_Results.
+type Conmon_SeccompNotifyHandler_done_Results_List = capnp.StructList[Conmon_SeccompNotifyHandler_done_Results]
+
+// NewConmon_SeccompNotifyHandler_done_Results creates a new list of Conmon_SeccompNotifyHandler_done_Results.
+func NewConmon_SeccompNotifyHandler_done_Results_List(s *capnp.Segment, sz int32) (Conmon_SeccompNotifyHandler_done_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_SeccompNotifyHandler_done_Results]{l}, err
+}
+
+// Conmon_SeccompNotifyHandler_done_Results_Future is a wrapper for a Conmon_SeccompNotifyHandler_done_Results promised by a client call.
+type Conmon_SeccompNotifyHandler_done_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_SeccompNotifyHandler_done_Results_Future) Struct() (Conmon_SeccompNotifyHandler_done_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SeccompNotifyHandler_done_Results{s}, err
+}
+
+type Conmon_SeccompNotification struct{ capnp.Struct }
+
+// Conmon_SeccompNotification_TypeID is the unique identifier for the type Conmon_SeccompNotification.
+const Conmon_SeccompNotification_TypeID = 0xc0c3b835f6821b06
+
+func NewConmon_SeccompNotification(s *capnp.Segment) (Conmon_SeccompNotification, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1})
+	return Conmon_SeccompNotification{st}, err
+}
+
+func NewRootConmon_SeccompNotification(s *capnp.Segment) (Conmon_SeccompNotification, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1})
+	return Conmon_SeccompNotification{st}, err
+}
+
+func ReadRootConmon_SeccompNotification(msg *capnp.Message) (Conmon_SeccompNotification, error) {
+	root, err := msg.Root()
+	return Conmon_SeccompNotification{root.Struct()}, err
+}
+
+func (s Conmon_SeccompNotification) String() string {
+	str, _ := text.Marshal(0xc0c3b835f6821b06, s.Struct)
+	return str
+}
+
+func (s Conmon_SeccompNotification) Id() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_SeccompNotification) SetId(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_SeccompNotification) Pid() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s Conmon_SeccompNotification) SetPid(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+func (s Conmon_SeccompNotification) Syscall() int32 {
+	return int32(s.Struct.Uint32(12))
+}
+
+func (s Conmon_SeccompNotification) SetSyscall(v int32) {
+	s.Struct.SetUint32(12, uint32(v))
+}
+
+func (s Conmon_SeccompNotification) Arch() uint32 {
+	return s.Struct.Uint32(16)
+}
+
+func (s Conmon_SeccompNotification) SetArch(v uint32) {
+	s.Struct.SetUint32(16, v)
+}
+
+func (s Conmon_SeccompNotification) InstructionPointer() uint64 {
+	return s.Struct.Uint64(24)
+}
+
+func (s Conmon_SeccompNotification) SetInstructionPointer(v uint64) {
+	s.Struct.SetUint64(24, v)
+}
+
+func (s Conmon_SeccompNotification) Args() (capnp.UInt64List, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.UInt64List{List: p.List()}, err
+}
+
+func (s Conmon_SeccompNotification) HasArgs() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_SeccompNotification) SetArgs(v capnp.UInt64List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewArgs sets the args field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s Conmon_SeccompNotification) NewArgs(n int32) (capnp.UInt64List, error) {
+	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.UInt64List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_SeccompNotification_List is a list of Conmon_SeccompNotification.
+type Conmon_SeccompNotification_List = capnp.StructList[Conmon_SeccompNotification]
+
+// NewConmon_SeccompNotification creates a new list of Conmon_SeccompNotification.
+func NewConmon_SeccompNotification_List(s *capnp.Segment, sz int32) (Conmon_SeccompNotification_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_SeccompNotification]{l}, err
+}
+
+// Conmon_SeccompNotification_Future is a wrapper for a Conmon_SeccompNotification promised by a client call.
+type Conmon_SeccompNotification_Future struct{ *capnp.Future }
+
+func (p Conmon_SeccompNotification_Future) Struct() (Conmon_SeccompNotification, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SeccompNotification{s}, err
+}
+
+type Conmon_SeccompNotificationResponse struct{ capnp.Struct }
+
+// Conmon_SeccompNotificationResponse_TypeID is the unique identifier for the type Conmon_SeccompNotificationResponse.
+const Conmon_SeccompNotificationResponse_TypeID = 0xa16847f8fc4fe2f5
+
+func NewConmon_SeccompNotificationResponse(s *capnp.Segment) (Conmon_SeccompNotificationResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Conmon_SeccompNotificationResponse{st}, err
+}
+
+func NewRootConmon_SeccompNotificationResponse(s *capnp.Segment) (Conmon_SeccompNotificationResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Conmon_SeccompNotificationResponse{st}, err
+}
+
+func ReadRootConmon_SeccompNotificationResponse(msg *capnp.Message) (Conmon_SeccompNotificationResponse, error) {
+	root, err := msg.Root()
+	return Conmon_SeccompNotificationResponse{root.Struct()}, err
+}
+
+func (s Conmon_SeccompNotificationResponse) String() string {
+	str, _ := text.Marshal(0xa16847f8fc4fe2f5, s.Struct)
+	return str
+}
+
+func (s Conmon_SeccompNotificationResponse) Action() Conmon_SeccompNotificationResponse_Action {
+	return Conmon_SeccompNotificationResponse_Action(s.Struct.Uint16(0))
+}
+
+func (s Conmon_SeccompNotificationResponse) SetAction(v Conmon_SeccompNotificationResponse_Action) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Conmon_SeccompNotificationResponse) Errno() int32 {
+	return int32(s.Struct.Uint32(4))
+}
+
+func (s Conmon_SeccompNotificationResponse) SetErrno(v int32) {
+	s.Struct.SetUint32(4, uint32(v))
+}
+
+func (s Conmon_SeccompNotificationResponse) Value() int64 {
+	return int64(s.Struct.Uint64(8))
+}
+
+func (s Conmon_SeccompNotificationResponse) SetValue(v int64) {
+	s.Struct.SetUint64(8, uint64(v))
+}
+
+// Conmon_SeccompNotificationResponse_List is a list of Conmon_SeccompNotificationResponse.
+type Conmon_SeccompNotificationResponse_List = capnp.StructList[Conmon_SeccompNotificationResponse]
+
+// NewConmon_SeccompNotificationResponse creates a new list of Conmon_SeccompNotificationResponse.
+func NewConmon_SeccompNotificationResponse_List(s *capnp.Segment, sz int32) (Conmon_SeccompNotificationResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_SeccompNotificationResponse]{l}, err
+}
+
+// Conmon_SeccompNotificationResponse_Future is a wrapper for a Conmon_SeccompNotificationResponse promised by a client call.
+type Conmon_SeccompNotificationResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_SeccompNotificationResponse_Future) Struct() (Conmon_SeccompNotificationResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_SeccompNotificationResponse{s}, err
+}
+
+type Conmon_SeccompNotificationResponse_Action uint16
+
+// Conmon_SeccompNotificationResponse_Action_TypeID is the unique identifier for the type Conmon_SeccompNotificationResponse_Action.
+const Conmon_SeccompNotificationResponse_Action_TypeID = 0xf1d99215fd97ddaf
+
+// Values of Conmon_SeccompNotificationResponse_Action.
+const (
+	Conmon_SeccompNotificationResponse_Action_continueSyscall Conmon_SeccompNotificationResponse_Action = 0
+	Conmon_SeccompNotificationResponse_Action_returnError     Conmon_SeccompNotificationResponse_Action = 1
+	Conmon_SeccompNotificationResponse_Action_returnValue     Conmon_SeccompNotificationResponse_Action = 2
+)
+
+// String returns the enum's constant name.
+func (c Conmon_SeccompNotificationResponse_Action) String() string {
+	switch c {
+	case Conmon_SeccompNotificationResponse_Action_continueSyscall:
+		return "continueSyscall"
+	case Conmon_SeccompNotificationResponse_Action_returnError:
+		return "returnError"
+	case Conmon_SeccompNotificationResponse_Action_returnValue:
+		return "returnValue"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_SeccompNotificationResponse_ActionFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_SeccompNotificationResponse_ActionFromString(c string) Conmon_SeccompNotificationResponse_Action {
+	switch c {
+	case "continueSyscall":
+		return Conmon_SeccompNotificationResponse_Action_continueSyscall
+	case "returnError":
+		return Conmon_SeccompNotificationResponse_Action_returnError
+	case "returnValue":
+		return Conmon_SeccompNotificationResponse_Action_returnValue
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_SeccompNotificationResponse_Action_List = capnp.EnumList[Conmon_SeccompNotificationResponse_Action]
+
+func NewConmon_SeccompNotificationResponse_Action_List(s *capnp.Segment, sz int32) (Conmon_SeccompNotificationResponse_Action_List, error) {
+	return capnp.NewEnumList[Conmon_SeccompNotificationResponse_Action](s, sz)
+}
+
+type Conmon_UploadCheckpointImageRequest struct{ capnp.Struct }
+
+// Conmon_UploadCheckpointImageRequest_TypeID is the unique identifier for the type Conmon_UploadCheckpointImageRequest.
+const Conmon_UploadCheckpointImageRequest_TypeID = 0xcaecd1f6482ab8fc
+
+func NewConmon_UploadCheckpointImageRequest(s *capnp.Segment) (Conmon_UploadCheckpointImageRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_UploadCheckpointImageRequest{st}, err
+}
+
+func NewRootConmon_UploadCheckpointImageRequest(s *capnp.Segment) (Conmon_UploadCheckpointImageRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_UploadCheckpointImageRequest{st}, err
+}
+
+func ReadRootConmon_UploadCheckpointImageRequest(msg *capnp.Message) (Conmon_UploadCheckpointImageRequest, error) {
+	root, err := msg.Root()
+	return Conmon_UploadCheckpointImageRequest{root.Struct()}, err
+}
+
+func (s Conmon_UploadCheckpointImageRequest) String() string {
+	str, _ := text.Marshal(0xcaecd1f6482ab8fc, s.Struct)
+	return str
+}
+
+func (s Conmon_UploadCheckpointImageRequest) ImagePath() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_UploadCheckpointImageRequest) HasImagePath() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_UploadCheckpointImageRequest) ImagePathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_UploadCheckpointImageRequest) SetImagePath(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_UploadCheckpointImageRequest_List is a list of Conmon_UploadCheckpointImageRequest.
+type Conmon_UploadCheckpointImageRequest_List = capnp.StructList[Conmon_UploadCheckpointImageRequest]
+
+// NewConmon_UploadCheckpointImageRequest creates a new list of Conmon_UploadCheckpointImageRequest.
+func NewConmon_UploadCheckpointImageRequest_List(s *capnp.Segment, sz int32) (Conmon_UploadCheckpointImageRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_UploadCheckpointImageRequest]{l}, err
+}
+
+// Conmon_UploadCheckpointImageRequest_Future is a wrapper for a Conmon_UploadCheckpointImageRequest promised by a client call.
+type Conmon_UploadCheckpointImageRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_UploadCheckpointImageRequest_Future) Struct() (Conmon_UploadCheckpointImageRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_UploadCheckpointImageRequest{s}, err
+}
+
+type Conmon_UploadCheckpointImageResponse struct{ capnp.Struct }
+
+// Conmon_UploadCheckpointImageResponse_TypeID is the unique identifier for the type Conmon_UploadCheckpointImageResponse.
+const Conmon_UploadCheckpointImageResponse_TypeID = 0x84a2bcf11a54e25a
+
+func NewConmon_UploadCheckpointImageResponse(s *capnp.Segment) (Conmon_UploadCheckpointImageResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_UploadCheckpointImageResponse{st}, err
+}
+
+func NewRootConmon_UploadCheckpointImageResponse(s *capnp.Segment) (Conmon_UploadCheckpointImageResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_UploadCheckpointImageResponse{st}, err
+}
+
+func ReadRootConmon_UploadCheckpointImageResponse(msg *capnp.Message) (Conmon_UploadCheckpointImageResponse, error) {
+	root, err := msg.Root()
+	return Conmon_UploadCheckpointImageResponse{root.Struct()}, err
+}
+
+func (s Conmon_UploadCheckpointImageResponse) String() string {
+	str, _ := text.Marshal(0x84a2bcf11a54e25a, s.Struct)
+	return str
+}
+
+func (s Conmon_UploadCheckpointImageResponse) Writer() Conmon_CheckpointImageWriter {
+	p, _ := s.Struct.Ptr(0)
+	return Conmon_CheckpointImageWriter{Client: p.Interface().Client()}
+}
+
+func (s Conmon_UploadCheckpointImageResponse) HasWriter() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_UploadCheckpointImageResponse) SetWriter(v Conmon_CheckpointImageWriter) error {
+	if !v.Client.IsValid() {
+		return s.Struct.SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(0, in.ToPtr())
+}
+
+func (s Conmon_UploadCheckpointImageResponse) ImagePath() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_UploadCheckpointImageResponse) HasImagePath() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_UploadCheckpointImageResponse) ImagePathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_UploadCheckpointImageResponse) SetImagePath(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_UploadCheckpointImageResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(2)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_UploadCheckpointImageResponse) HasError() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_UploadCheckpointImageResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(2, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_UploadCheckpointImageResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(2, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_UploadCheckpointImageResponse_List is a list of Conmon_UploadCheckpointImageResponse.
+type Conmon_UploadCheckpointImageResponse_List = capnp.StructList[Conmon_UploadCheckpointImageResponse]
+
+// NewConmon_UploadCheckpointImageResponse creates a new list of Conmon_UploadCheckpointImageResponse.
+func NewConmon_UploadCheckpointImageResponse_List(s *capnp.Segment, sz int32) (Conmon_UploadCheckpointImageResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_UploadCheckpointImageResponse]{l}, err
+}
+
+// Conmon_UploadCheckpointImageResponse_Future is a wrapper for a Conmon_UploadCheckpointImageResponse promised by a client call.
+type Conmon_UploadCheckpointImageResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_UploadCheckpointImageResponse_Future) Struct() (Conmon_UploadCheckpointImageResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_UploadCheckpointImageResponse{s}, err
+}
+
+func (p Conmon_UploadCheckpointImageResponse_Future) Writer() Conmon_CheckpointImageWriter {
+	return Conmon_CheckpointImageWriter{Client: p.Future.Field(0, nil).Client()}
+}
+
+func (p Conmon_UploadCheckpointImageResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(2, nil)}
+}
+
+type Conmon_UpdateContainerResourcesRequest struct{ capnp.Struct }
+
+// Conmon_UpdateContainerResourcesRequest_TypeID is the unique identifier for the type Conmon_UpdateContainerResourcesRequest.
+const Conmon_UpdateContainerResourcesRequest_TypeID = 0xf3c4f20ca0d204ce
+
+func NewConmon_UpdateContainerResourcesRequest(s *capnp.Segment) (Conmon_UpdateContainerResourcesRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_UpdateContainerResourcesRequest{st}, err
+}
+
+func NewRootConmon_UpdateContainerResourcesRequest(s *capnp.Segment) (Conmon_UpdateContainerResourcesRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_UpdateContainerResourcesRequest{st}, err
+}
+
+func ReadRootConmon_UpdateContainerResourcesRequest(msg *capnp.Message) (Conmon_UpdateContainerResourcesRequest, error) {
+	root, err := msg.Root()
+	return Conmon_UpdateContainerResourcesRequest{root.Struct()}, err
+}
+
+func (s Conmon_UpdateContainerResourcesRequest) String() string {
+	str, _ := text.Marshal(0xf3c4f20ca0d204ce, s.Struct)
+	return str
+}
+
+func (s Conmon_UpdateContainerResourcesRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_UpdateContainerResourcesRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_UpdateContainerResourcesRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_UpdateContainerResourcesRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_UpdateContainerResourcesRequest) Values() (Conmon_CgroupValue_List, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_CgroupValue_List{List: p.List()}, err
+}
+
+func (s Conmon_UpdateContainerResourcesRequest) HasValues() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_UpdateContainerResourcesRequest) SetValues(v Conmon_CgroupValue_List) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewValues sets the values field to a newly
+// allocated Conmon_CgroupValue_List, preferring placement in s's segment.
+func (s Conmon_UpdateContainerResourcesRequest) NewValues(n int32) (Conmon_CgroupValue_List, error) {
+	l, err := NewConmon_CgroupValue_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_CgroupValue_List{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_UpdateContainerResourcesRequest_List is a list of Conmon_UpdateContainerResourcesRequest.
+type Conmon_UpdateContainerResourcesRequest_List = capnp.StructList[Conmon_UpdateContainerResourcesRequest]
+
+// NewConmon_UpdateContainerResourcesRequest creates a new list of Conmon_UpdateContainerResourcesRequest.
+func NewConmon_UpdateContainerResourcesRequest_List(s *capnp.Segment, sz int32) (Conmon_UpdateContainerResourcesRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_UpdateContainerResourcesRequest]{l}, err
+}
+
+// Conmon_UpdateContainerResourcesRequest_Future is a wrapper for a Conmon_UpdateContainerResourcesRequest promised by a client call.
+type Conmon_UpdateContainerResourcesRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_UpdateContainerResourcesRequest_Future) Struct() (Conmon_UpdateContainerResourcesRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_UpdateContainerResourcesRequest{s}, err
+}
+
+type Conmon_CgroupValue struct{ capnp.Struct }
+
+// Conmon_CgroupValue_TypeID is the unique identifier for the type Conmon_CgroupValue.
+const Conmon_CgroupValue_TypeID = 0xf7bebb3fdc09132e
+
+func NewConmon_CgroupValue(s *capnp.Segment) (Conmon_CgroupValue, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_CgroupValue{st}, err
+}
+
+func NewRootConmon_CgroupValue(s *capnp.Segment) (Conmon_CgroupValue, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
+	return Conmon_CgroupValue{st}, err
+}
+
+func ReadRootConmon_CgroupValue(msg *capnp.Message) (Conmon_CgroupValue, error) {
+	root, err := msg.Root()
+	return Conmon_CgroupValue{root.Struct()}, err
+}
+
+func (s Conmon_CgroupValue) String() string {
+	str, _ := text.Marshal(0xf7bebb3fdc09132e, s.Struct)
+	return str
+}
+
+func (s Conmon_CgroupValue) Controller() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_CgroupValue) HasController() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CgroupValue) ControllerBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CgroupValue) SetController(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_CgroupValue) File() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s Conmon_CgroupValue) HasFile() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_CgroupValue) FileBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CgroupValue) SetFile(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s Conmon_CgroupValue) Value() (string, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.Text(), err
+}
+
+func (s Conmon_CgroupValue) HasValue() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_CgroupValue) ValueBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(2)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CgroupValue) SetValue(v string) error {
+	return s.Struct.SetText(2, v)
+}
+
+// Conmon_CgroupValue_List is a list of Conmon_CgroupValue.
+type Conmon_CgroupValue_List = capnp.StructList[Conmon_CgroupValue]
+
+// NewConmon_CgroupValue creates a new list of Conmon_CgroupValue.
+func NewConmon_CgroupValue_List(s *capnp.Segment, sz int32) (Conmon_CgroupValue_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_CgroupValue]{l}, err
+}
+
+// Conmon_CgroupValue_Future is a wrapper for a Conmon_CgroupValue promised by a client call.
+type Conmon_CgroupValue_Future struct{ *capnp.Future }
+
+func (p Conmon_CgroupValue_Future) Struct() (Conmon_CgroupValue, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CgroupValue{s}, err
+}
+
+type Conmon_UpdateContainerResourcesResponse struct{ capnp.Struct }
+
+// Conmon_UpdateContainerResourcesResponse_TypeID is the unique identifier for the type Conmon_UpdateContainerResourcesResponse.
+const Conmon_UpdateContainerResourcesResponse_TypeID = 0x90dbf7ca3e53e1f6
+
+func NewConmon_UpdateContainerResourcesResponse(s *capnp.Segment) (Conmon_UpdateContainerResourcesResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_UpdateContainerResourcesResponse{st}, err
+}
+
+func NewRootConmon_UpdateContainerResourcesResponse(s *capnp.Segment) (Conmon_UpdateContainerResourcesResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_UpdateContainerResourcesResponse{st}, err
+}
+
+func ReadRootConmon_UpdateContainerResourcesResponse(msg *capnp.Message) (Conmon_UpdateContainerResourcesResponse, error) {
+	root, err := msg.Root()
+	return Conmon_UpdateContainerResourcesResponse{root.Struct()}, err
+}
+
+func (s Conmon_UpdateContainerResourcesResponse) String() string {
+	str, _ := text.Marshal(0x90dbf7ca3e53e1f6, s.Struct)
+	return str
+}
+
+func (s Conmon_UpdateContainerResourcesResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_UpdateContainerResourcesResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_UpdateContainerResourcesResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_UpdateContainerResourcesResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_UpdateContainerResourcesResponse_List is a list of Conmon_UpdateContainerResourcesResponse.
+type Conmon_UpdateContainerResourcesResponse_List = capnp.StructList[Conmon_UpdateContainerResourcesResponse]
+
+// NewConmon_UpdateContainerResourcesResponse creates a new list of Conmon_UpdateContainerResourcesResponse.
+func NewConmon_UpdateContainerResourcesResponse_List(s *capnp.Segment, sz int32) (Conmon_UpdateContainerResourcesResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_UpdateContainerResourcesResponse]{l}, err
+}
+
+// Conmon_UpdateContainerResourcesResponse_Future is a wrapper for a Conmon_UpdateContainerResourcesResponse promised by a client call.
+type Conmon_UpdateContainerResourcesResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_UpdateContainerResourcesResponse_Future) Struct() (Conmon_UpdateContainerResourcesResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_UpdateContainerResourcesResponse{s}, err
+}
+
+func (p Conmon_UpdateContainerResourcesResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_HealthCheckResponse struct{ capnp.Struct }
+
+// Conmon_HealthCheckResponse_TypeID is the unique identifier for the type Conmon_HealthCheckResponse.
+const Conmon_HealthCheckResponse_TypeID = 0xb204b044eaca48e2
+
+func NewConmon_HealthCheckResponse(s *capnp.Segment) (Conmon_HealthCheckResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Conmon_HealthCheckResponse{st}, err
+}
+
+func NewRootConmon_HealthCheckResponse(s *capnp.Segment) (Conmon_HealthCheckResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Conmon_HealthCheckResponse{st}, err
+}
+
+func ReadRootConmon_HealthCheckResponse(msg *capnp.Message) (Conmon_HealthCheckResponse, error) {
+	root, err := msg.Root()
+	return Conmon_HealthCheckResponse{root.Struct()}, err
+}
+
+func (s Conmon_HealthCheckResponse) String() string {
+	str, _ := text.Marshal(0xb204b044eaca48e2, s.Struct)
+	return str
+}
+
+func (s Conmon_HealthCheckResponse) OpenFds() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_HealthCheckResponse) SetOpenFds(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_HealthCheckResponse) MemoryRssBytes() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_HealthCheckResponse) SetMemoryRssBytes(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_HealthCheckResponse) Containers() uint32 {
+	return s.Struct.Uint32(16)
+}
+
+func (s Conmon_HealthCheckResponse) SetContainers(v uint32) {
+	s.Struct.SetUint32(16, v)
+}
+
+// Conmon_HealthCheckResponse_List is a list of Conmon_HealthCheckResponse.
+type Conmon_HealthCheckResponse_List = capnp.StructList[Conmon_HealthCheckResponse]
+
+// NewConmon_HealthCheckResponse creates a new list of Conmon_HealthCheckResponse.
+func NewConmon_HealthCheckResponse_List(s *capnp.Segment, sz int32) (Conmon_HealthCheckResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_HealthCheckResponse]{l}, err
+}
+
+// Conmon_HealthCheckResponse_Future is a wrapper for a Conmon_HealthCheckResponse promised by a client call.
+type Conmon_HealthCheckResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_HealthCheckResponse_Future) Struct() (Conmon_HealthCheckResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_HealthCheckResponse{s}, err
+}
+
+type Conmon_CancelRequestRequest struct{ capnp.Struct }
+
+// Conmon_CancelRequestRequest_TypeID is the unique identifier for the type Conmon_CancelRequestRequest.
+const Conmon_CancelRequestRequest_TypeID = 0xcb729343a515ef11
+
+func NewConmon_CancelRequestRequest(s *capnp.Segment) (Conmon_CancelRequestRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_CancelRequestRequest{st}, err
+}
+
+func NewRootConmon_CancelRequestRequest(s *capnp.Segment) (Conmon_CancelRequestRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_CancelRequestRequest{st}, err
+}
+
+func ReadRootConmon_CancelRequestRequest(msg *capnp.Message) (Conmon_CancelRequestRequest, error) {
+	root, err := msg.Root()
+	return Conmon_CancelRequestRequest{root.Struct()}, err
+}
+
+func (s Conmon_CancelRequestRequest) String() string {
+	str, _ := text.Marshal(0xcb729343a515ef11, s.Struct)
+	return str
+}
+
+func (s Conmon_CancelRequestRequest) RequestId() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_CancelRequestRequest) HasRequestId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CancelRequestRequest) RequestIdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_CancelRequestRequest) SetRequestId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_CancelRequestRequest_List is a list of Conmon_CancelRequestRequest.
+type Conmon_CancelRequestRequest_List = capnp.StructList[Conmon_CancelRequestRequest]
+
+// NewConmon_CancelRequestRequest creates a new list of Conmon_CancelRequestRequest.
+func NewConmon_CancelRequestRequest_List(s *capnp.Segment, sz int32) (Conmon_CancelRequestRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_CancelRequestRequest]{l}, err
+}
+
+// Conmon_CancelRequestRequest_Future is a wrapper for a Conmon_CancelRequestRequest promised by a client call.
+type Conmon_CancelRequestRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_CancelRequestRequest_Future) Struct() (Conmon_CancelRequestRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CancelRequestRequest{s}, err
+}
+
+type Conmon_CancelRequestResponse struct{ capnp.Struct }
+
+// Conmon_CancelRequestResponse_TypeID is the unique identifier for the type Conmon_CancelRequestResponse.
+const Conmon_CancelRequestResponse_TypeID = 0xc90f9c407ec24e15
+
+func NewConmon_CancelRequestResponse(s *capnp.Segment) (Conmon_CancelRequestResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Conmon_CancelRequestResponse{st}, err
+}
+
+func NewRootConmon_CancelRequestResponse(s *capnp.Segment) (Conmon_CancelRequestResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Conmon_CancelRequestResponse{st}, err
+}
+
+func ReadRootConmon_CancelRequestResponse(msg *capnp.Message) (Conmon_CancelRequestResponse, error) {
+	root, err := msg.Root()
+	return Conmon_CancelRequestResponse{root.Struct()}, err
+}
+
+func (s Conmon_CancelRequestResponse) String() string {
+	str, _ := text.Marshal(0xc90f9c407ec24e15, s.Struct)
+	return str
+}
+
+func (s Conmon_CancelRequestResponse) Cancelled() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s Conmon_CancelRequestResponse) SetCancelled(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+// Conmon_CancelRequestResponse_List is a list of Conmon_CancelRequestResponse.
+type Conmon_CancelRequestResponse_List = capnp.StructList[Conmon_CancelRequestResponse]
+
+// NewConmon_CancelRequestResponse creates a new list of Conmon_CancelRequestResponse.
+func NewConmon_CancelRequestResponse_List(s *capnp.Segment, sz int32) (Conmon_CancelRequestResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_CancelRequestResponse]{l}, err
+}
+
+// Conmon_CancelRequestResponse_Future is a wrapper for a Conmon_CancelRequestResponse promised by a client call.
+type Conmon_CancelRequestResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_CancelRequestResponse_Future) Struct() (Conmon_CancelRequestResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CancelRequestResponse{s}, err
+}
+
+type Conmon_GetTombstoneRequest struct{ capnp.Struct }
+
+// Conmon_GetTombstoneRequest_TypeID is the unique identifier for the type Conmon_GetTombstoneRequest.
+const Conmon_GetTombstoneRequest_TypeID = 0x88894a061158febc
+
+func NewConmon_GetTombstoneRequest(s *capnp.Segment) (Conmon_GetTombstoneRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_GetTombstoneRequest{st}, err
+}
+
+func NewRootConmon_GetTombstoneRequest(s *capnp.Segment) (Conmon_GetTombstoneRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_GetTombstoneRequest{st}, err
+}
+
+func ReadRootConmon_GetTombstoneRequest(msg *capnp.Message) (Conmon_GetTombstoneRequest, error) {
+	root, err := msg.Root()
+	return Conmon_GetTombstoneRequest{root.Struct()}, err
+}
+
+func (s Conmon_GetTombstoneRequest) String() string {
+	str, _ := text.Marshal(0x88894a061158febc, s.Struct)
+	return str
+}
+
+func (s Conmon_GetTombstoneRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_GetTombstoneRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_GetTombstoneRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_GetTombstoneRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_GetTombstoneRequest_List is a list of Conmon_GetTombstoneRequest.
+type Conmon_GetTombstoneRequest_List = capnp.StructList[Conmon_GetTombstoneRequest]
+
+// NewConmon_GetTombstoneRequest creates a new list of Conmon_GetTombstoneRequest.
+func NewConmon_GetTombstoneRequest_List(s *capnp.Segment, sz int32) (Conmon_GetTombstoneRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_GetTombstoneRequest]{l}, err
+}
+
+// Conmon_GetTombstoneRequest_Future is a wrapper for a Conmon_GetTombstoneRequest promised by a client call.
+type Conmon_GetTombstoneRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_GetTombstoneRequest_Future) Struct() (Conmon_GetTombstoneRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_GetTombstoneRequest{s}, err
+}
+
+type Conmon_Tombstone struct{ capnp.Struct }
+
+// Conmon_Tombstone_TypeID is the unique identifier for the type Conmon_Tombstone.
+const Conmon_Tombstone_TypeID = 0xed878ff3a2e3932c
+
+func NewConmon_Tombstone(s *capnp.Segment) (Conmon_Tombstone, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 3})
+	return Conmon_Tombstone{st}, err
+}
+
+func NewRootConmon_Tombstone(s *capnp.Segment) (Conmon_Tombstone, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 3})
+	return Conmon_Tombstone{st}, err
+}
+
+func ReadRootConmon_Tombstone(msg *capnp.Message) (Conmon_Tombstone, error) {
+	root, err := msg.Root()
+	return Conmon_Tombstone{root.Struct()}, err
+}
+
+func (s Conmon_Tombstone) String() string {
+	str, _ := text.Marshal(0xed878ff3a2e3932c, s.Struct)
+	return str
+}
+
+func (s Conmon_Tombstone) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_Tombstone) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_Tombstone) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_Tombstone) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_Tombstone) Pid() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Conmon_Tombstone) SetPid(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s Conmon_Tombstone) ExitCode() int32 {
+	return int32(s.Struct.Uint32(4))
+}
+
+func (s Conmon_Tombstone) SetExitCode(v int32) {
+	s.Struct.SetUint32(4, uint32(v))
+}
+
+func (s Conmon_Tombstone) OomKilled() bool {
+	return s.Struct.Bit(64)
+}
+
+func (s Conmon_Tombstone) SetOomKilled(v bool) {
+	s.Struct.SetBit(64, v)
+}
+
+func (s Conmon_Tombstone) TimedOut() bool {
+	return s.Struct.Bit(65)
+}
+
+func (s Conmon_Tombstone) SetTimedOut(v bool) {
+	s.Struct.SetBit(65, v)
+}
+
+func (s Conmon_Tombstone) ExitedAt() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_Tombstone) SetExitedAt(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+func (s Conmon_Tombstone) RemovedAt() uint64 {
+	return s.Struct.Uint64(24)
+}
+
+func (s Conmon_Tombstone) SetRemovedAt(v uint64) {
+	s.Struct.SetUint64(24, v)
+}
+
+func (s Conmon_Tombstone) Stats() (Conmon_ContainerStats, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_ContainerStats{Struct: p.Struct()}, err
+}
+
+func (s Conmon_Tombstone) HasStats() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_Tombstone) SetStats(v Conmon_ContainerStats) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewStats sets the stats field to a newly
+// allocated Conmon_ContainerStats struct, preferring placement in s's segment.
+func (s Conmon_Tombstone) NewStats() (Conmon_ContainerStats, error) {
+	ss, err := NewConmon_ContainerStats(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ContainerStats{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_Tombstone) LogPaths() (capnp.TextList, error) {
+	p, err := s.Struct.Ptr(2)
+	return capnp.TextList{List: p.List()}, err
+}
+
+func (s Conmon_Tombstone) HasLogPaths() bool {
+	return s.Struct.HasPtr(2)
+}
+
+func (s Conmon_Tombstone) SetLogPaths(v capnp.TextList) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
+}
+
+// NewLogPaths sets the logPaths field to a newly
+// allocated capnp.TextList, preferring placement in s's segment.
+func (s Conmon_Tombstone) NewLogPaths(n int32) (capnp.TextList, error) {
+	l, err := capnp.NewTextList(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.TextList{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
+}
+
+// Conmon_Tombstone_List is a list of Conmon_Tombstone.
+type Conmon_Tombstone_List = capnp.StructList[Conmon_Tombstone]
+
+// NewConmon_Tombstone creates a new list of Conmon_Tombstone.
+func NewConmon_Tombstone_List(s *capnp.Segment, sz int32) (Conmon_Tombstone_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 3}, sz)
+	return capnp.StructList[Conmon_Tombstone]{l}, err
+}
+
+// Conmon_Tombstone_Future is a wrapper for a Conmon_Tombstone promised by a client call.
+type Conmon_Tombstone_Future struct{ *capnp.Future }
+
+func (p Conmon_Tombstone_Future) Struct() (Conmon_Tombstone, error) {
+	s, err := p.Future.Struct()
+	return Conmon_Tombstone{s}, err
+}
+
+func (p Conmon_Tombstone_Future) Stats() Conmon_ContainerStats_Future {
+	return Conmon_ContainerStats_Future{Future: p.Future.Field(1, nil)}
+}
+
+type Conmon_GetTombstoneResponse struct{ capnp.Struct }
+
+// Conmon_GetTombstoneResponse_TypeID is the unique identifier for the type Conmon_GetTombstoneResponse.
+const Conmon_GetTombstoneResponse_TypeID = 0xff42f547f8ccd1b9
+
+func NewConmon_GetTombstoneResponse(s *capnp.Segment) (Conmon_GetTombstoneResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_GetTombstoneResponse{st}, err
+}
+
+func NewRootConmon_GetTombstoneResponse(s *capnp.Segment) (Conmon_GetTombstoneResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_GetTombstoneResponse{st}, err
+}
+
+func ReadRootConmon_GetTombstoneResponse(msg *capnp.Message) (Conmon_GetTombstoneResponse, error) {
+	root, err := msg.Root()
+	return Conmon_GetTombstoneResponse{root.Struct()}, err
+}
+
+func (s Conmon_GetTombstoneResponse) String() string {
+	str, _ := text.Marshal(0xff42f547f8ccd1b9, s.Struct)
+	return str
+}
+
+func (s Conmon_GetTombstoneResponse) Tombstone() (Conmon_Tombstone, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_Tombstone{Struct: p.Struct()}, err
+}
+
+func (s Conmon_GetTombstoneResponse) HasTombstone() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_GetTombstoneResponse) SetTombstone(v Conmon_Tombstone) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewTombstone sets the tombstone field to a newly
+// allocated Conmon_Tombstone struct, preferring placement in s's segment.
+func (s Conmon_GetTombstoneResponse) NewTombstone() (Conmon_Tombstone, error) {
+	ss, err := NewConmon_Tombstone(s.Struct.Segment())
+	if err != nil {
+		return Conmon_Tombstone{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_GetTombstoneResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_GetTombstoneResponse) HasError() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_GetTombstoneResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_GetTombstoneResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_GetTombstoneResponse_List is a list of Conmon_GetTombstoneResponse.
+type Conmon_GetTombstoneResponse_List = capnp.StructList[Conmon_GetTombstoneResponse]
+
+// NewConmon_GetTombstoneResponse creates a new list of Conmon_GetTombstoneResponse.
+func NewConmon_GetTombstoneResponse_List(s *capnp.Segment, sz int32) (Conmon_GetTombstoneResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_GetTombstoneResponse]{l}, err
+}
+
+// Conmon_GetTombstoneResponse_Future is a wrapper for a Conmon_GetTombstoneResponse promised by a client call.
+type Conmon_GetTombstoneResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_GetTombstoneResponse_Future) Struct() (Conmon_GetTombstoneResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_GetTombstoneResponse{s}, err
+}
+
+func (p Conmon_GetTombstoneResponse_Future) Tombstone() Conmon_Tombstone_Future {
+	return Conmon_Tombstone_Future{Future: p.Future.Field(0, nil)}
+}
+
+func (p Conmon_GetTombstoneResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(1, nil)}
+}
+
+type Conmon_GetEventsRequest struct{ capnp.Struct }
+
+// Conmon_GetEventsRequest_TypeID is the unique identifier for the type Conmon_GetEventsRequest.
+const Conmon_GetEventsRequest_TypeID = 0xd8998defc6b19abf
+
+func NewConmon_GetEventsRequest(s *capnp.Segment) (Conmon_GetEventsRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Conmon_GetEventsRequest{st}, err
+}
+
+func NewRootConmon_GetEventsRequest(s *capnp.Segment) (Conmon_GetEventsRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Conmon_GetEventsRequest{st}, err
+}
+
+func ReadRootConmon_GetEventsRequest(msg *capnp.Message) (Conmon_GetEventsRequest, error) {
+	root, err := msg.Root()
+	return Conmon_GetEventsRequest{root.Struct()}, err
+}
+
+func (s Conmon_GetEventsRequest) String() string {
+	str, _ := text.Marshal(0xd8998defc6b19abf, s.Struct)
+	return str
+}
+
+func (s Conmon_GetEventsRequest) Cursor() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_GetEventsRequest) SetCursor(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_GetEventsRequest) Max() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s Conmon_GetEventsRequest) SetMax(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+func (s Conmon_GetEventsRequest) TimeoutMs() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_GetEventsRequest) SetTimeoutMs(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+// Conmon_GetEventsRequest_List is a list of Conmon_GetEventsRequest.
+type Conmon_GetEventsRequest_List = capnp.StructList[Conmon_GetEventsRequest]
+
+// NewConmon_GetEventsRequest creates a new list of Conmon_GetEventsRequest.
+func NewConmon_GetEventsRequest_List(s *capnp.Segment, sz int32) (Conmon_GetEventsRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_GetEventsRequest]{l}, err
+}
+
+// Conmon_GetEventsRequest_Future is a wrapper for a Conmon_GetEventsRequest promised by a client call.
+type Conmon_GetEventsRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_GetEventsRequest_Future) Struct() (Conmon_GetEventsRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_GetEventsRequest{s}, err
+}
+
+type Conmon_GetEventsResponse struct{ capnp.Struct }
+
+// Conmon_GetEventsResponse_TypeID is the unique identifier for the type Conmon_GetEventsResponse.
+const Conmon_GetEventsResponse_TypeID = 0xd45fb0bc9ddaf48d
+
+func NewConmon_GetEventsResponse(s *capnp.Segment) (Conmon_GetEventsResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_GetEventsResponse{st}, err
+}
+
+func NewRootConmon_GetEventsResponse(s *capnp.Segment) (Conmon_GetEventsResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Conmon_GetEventsResponse{st}, err
+}
+
+func ReadRootConmon_GetEventsResponse(msg *capnp.Message) (Conmon_GetEventsResponse, error) {
+	root, err := msg.Root()
+	return Conmon_GetEventsResponse{root.Struct()}, err
+}
+
+func (s Conmon_GetEventsResponse) String() string {
+	str, _ := text.Marshal(0xd45fb0bc9ddaf48d, s.Struct)
+	return str
+}
+
+func (s Conmon_GetEventsResponse) Events() (Conmon_ContainerEvent_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerEvent_List{List: p.List()}, err
+}
+
+func (s Conmon_GetEventsResponse) HasEvents() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_GetEventsResponse) SetEvents(v Conmon_ContainerEvent_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewEvents sets the events field to a newly
+// allocated Conmon_ContainerEvent_List, preferring placement in s's segment.
+func (s Conmon_GetEventsResponse) NewEvents(n int32) (Conmon_ContainerEvent_List, error) {
+	l, err := NewConmon_ContainerEvent_List(s.Struct.Segment(), n)
+	if err != nil {
+		return Conmon_ContainerEvent_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+func (s Conmon_GetEventsResponse) NextCursor() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_GetEventsResponse) SetNextCursor(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_GetEventsResponse) Missed() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_GetEventsResponse) SetMissed(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+// Conmon_GetEventsResponse_List is a list of Conmon_GetEventsResponse.
+type Conmon_GetEventsResponse_List = capnp.StructList[Conmon_GetEventsResponse]
+
+// NewConmon_GetEventsResponse creates a new list of Conmon_GetEventsResponse.
+func NewConmon_GetEventsResponse_List(s *capnp.Segment, sz int32) (Conmon_GetEventsResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_GetEventsResponse]{l}, err
+}
+
+// Conmon_GetEventsResponse_Future is a wrapper for a Conmon_GetEventsResponse promised by a client call.
+type Conmon_GetEventsResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_GetEventsResponse_Future) Struct() (Conmon_GetEventsResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_GetEventsResponse{s}, err
+}
+
+type Conmon_InspectStateRequest struct{ capnp.Struct }
+
+// Conmon_InspectStateRequest_TypeID is the unique identifier for the type Conmon_InspectStateRequest.
+const Conmon_InspectStateRequest_TypeID = 0x841d412e6bb13263
+
+func NewConmon_InspectStateRequest(s *capnp.Segment) (Conmon_InspectStateRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_InspectStateRequest{st}, err
+}
+
+func NewRootConmon_InspectStateRequest(s *capnp.Segment) (Conmon_InspectStateRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_InspectStateRequest{st}, err
+}
+
+func ReadRootConmon_InspectStateRequest(msg *capnp.Message) (Conmon_InspectStateRequest, error) {
+	root, err := msg.Root()
+	return Conmon_InspectStateRequest{root.Struct()}, err
+}
+
+func (s Conmon_InspectStateRequest) String() string {
+	str, _ := text.Marshal(0x841d412e6bb13263, s.Struct)
+	return str
+}
+
+// Conmon_InspectStateRequest_List is a list of Conmon_InspectStateRequest.
+type Conmon_InspectStateRequest_List = capnp.StructList[Conmon_InspectStateRequest]
+
+// NewConmon_InspectStateRequest creates a new list of Conmon_InspectStateRequest.
+func NewConmon_InspectStateRequest_List(s *capnp.Segment, sz int32) (Conmon_InspectStateRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_InspectStateRequest]{l}, err
+}
+
+// Conmon_InspectStateRequest_Future is a wrapper for a Conmon_InspectStateRequest promised by a client call.
+type Conmon_InspectStateRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_InspectStateRequest_Future) Struct() (Conmon_InspectStateRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_InspectStateRequest{s}, err
+}
+
+type Conmon_StateInfo struct{ capnp.Struct }
+
+// Conmon_StateInfo_TypeID is the unique identifier for the type Conmon_StateInfo.
+const Conmon_StateInfo_TypeID = 0xae9dffa6cdb1ee6c
+
+func NewConmon_StateInfo(s *capnp.Segment) (Conmon_StateInfo, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 1})
+	return Conmon_StateInfo{st}, err
+}
+
+func NewRootConmon_StateInfo(s *capnp.Segment) (Conmon_StateInfo, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 1})
+	return Conmon_StateInfo{st}, err
+}
+
+func ReadRootConmon_StateInfo(msg *capnp.Message) (Conmon_StateInfo, error) {
+	root, err := msg.Root()
+	return Conmon_StateInfo{root.Struct()}, err
+}
+
+func (s Conmon_StateInfo) String() string {
+	str, _ := text.Marshal(0xae9dffa6cdb1ee6c, s.Struct)
+	return str
+}
+
+func (s Conmon_StateInfo) Backend() Conmon_StateInfo_Backend {
+	return Conmon_StateInfo_Backend(s.Struct.Uint16(0))
+}
+
+func (s Conmon_StateInfo) SetBackend(v Conmon_StateInfo_Backend) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Conmon_StateInfo) Path() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_StateInfo) HasPath() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_StateInfo) PathBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_StateInfo) SetPath(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s Conmon_StateInfo) Entries() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_StateInfo) SetEntries(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_StateInfo) TenantEntries() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_StateInfo) SetTenantEntries(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+func (s Conmon_StateInfo) SizeBytes() uint64 {
+	return s.Struct.Uint64(24)
+}
+
+func (s Conmon_StateInfo) SetSizeBytes(v uint64) {
+	s.Struct.SetUint64(24, v)
+}
+
+func (s Conmon_StateInfo) WritesSinceCompaction() uint64 {
+	return s.Struct.Uint64(32)
+}
+
+func (s Conmon_StateInfo) SetWritesSinceCompaction(v uint64) {
+	s.Struct.SetUint64(32, v)
+}
+
+func (s Conmon_StateInfo) CompactedAt() uint64 {
+	return s.Struct.Uint64(40)
+}
+
+func (s Conmon_StateInfo) SetCompactedAt(v uint64) {
+	s.Struct.SetUint64(40, v)
+}
+
+// Conmon_StateInfo_List is a list of Conmon_StateInfo.
+type Conmon_StateInfo_List = capnp.StructList[Conmon_StateInfo]
+
+// NewConmon_StateInfo creates a new list of Conmon_StateInfo.
+func NewConmon_StateInfo_List(s *capnp.Segment, sz int32) (Conmon_StateInfo_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 48, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_StateInfo]{l}, err
+}
+
+// Conmon_StateInfo_Future is a wrapper for a Conmon_StateInfo promised by a client call.
+type Conmon_StateInfo_Future struct{ *capnp.Future }
+
+func (p Conmon_StateInfo_Future) Struct() (Conmon_StateInfo, error) {
+	s, err := p.Future.Struct()
+	return Conmon_StateInfo{s}, err
+}
+
+type Conmon_StateInfo_Backend uint16
+
+// Conmon_StateInfo_Backend_TypeID is the unique identifier for the type Conmon_StateInfo_Backend.
+const Conmon_StateInfo_Backend_TypeID = 0x91e7721ca9f4432f
+
+// Values of Conmon_StateInfo_Backend.
+const (
+	Conmon_StateInfo_Backend_memory Conmon_StateInfo_Backend = 0
+	Conmon_StateInfo_Backend_file   Conmon_StateInfo_Backend = 1
+	Conmon_StateInfo_Backend_sqlite Conmon_StateInfo_Backend = 2
+)
+
+// String returns the enum's constant name.
+func (c Conmon_StateInfo_Backend) String() string {
+	switch c {
+	case Conmon_StateInfo_Backend_memory:
+		return "memory"
+	case Conmon_StateInfo_Backend_file:
+		return "file"
+	case Conmon_StateInfo_Backend_sqlite:
+		return "sqlite"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_StateInfo_BackendFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_StateInfo_BackendFromString(c string) Conmon_StateInfo_Backend {
+	switch c {
+	case "memory":
+		return Conmon_StateInfo_Backend_memory
+	case "file":
+		return Conmon_StateInfo_Backend_file
+	case "sqlite":
+		return Conmon_StateInfo_Backend_sqlite
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_StateInfo_Backend_List = capnp.EnumList[Conmon_StateInfo_Backend]
+
+func NewConmon_StateInfo_Backend_List(s *capnp.Segment, sz int32) (Conmon_StateInfo_Backend_List, error) {
+	return capnp.NewEnumList[Conmon_StateInfo_Backend](s, sz)
+}
+
+type Conmon_InspectStateResponse struct{ capnp.Struct }
+
+// Conmon_InspectStateResponse_TypeID is the unique identifier for the type Conmon_InspectStateResponse.
+const Conmon_InspectStateResponse_TypeID = 0xdc4d14e96aa1033e
+
+func NewConmon_InspectStateResponse(s *capnp.Segment) (Conmon_InspectStateResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_InspectStateResponse{st}, err
+}
+
+func NewRootConmon_InspectStateResponse(s *capnp.Segment) (Conmon_InspectStateResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return Conmon_InspectStateResponse{st}, err
+}
+
+func ReadRootConmon_InspectStateResponse(msg *capnp.Message) (Conmon_InspectStateResponse, error) {
+	root, err := msg.Root()
+	return Conmon_InspectStateResponse{root.Struct()}, err
+}
+
+func (s Conmon_InspectStateResponse) String() string {
+	str, _ := text.Marshal(0xdc4d14e96aa1033e, s.Struct)
+	return str
+}
+
+func (s Conmon_InspectStateResponse) Info() (Conmon_StateInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_StateInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_InspectStateResponse) HasInfo() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_InspectStateResponse) SetInfo(v Conmon_StateInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewInfo sets the info field to a newly
+// allocated Conmon_StateInfo struct, preferring placement in s's segment.
+func (s Conmon_InspectStateResponse) NewInfo() (Conmon_StateInfo, error) {
+	ss, err := NewConmon_StateInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_StateInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Conmon_InspectStateResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(1)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_InspectStateResponse) HasError() bool {
+	return s.Struct.HasPtr(1)
+}
+
+func (s Conmon_InspectStateResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_InspectStateResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_InspectStateResponse_List is a list of Conmon_InspectStateResponse.
+type Conmon_InspectStateResponse_List = capnp.StructList[Conmon_InspectStateResponse]
+
+// NewConmon_InspectStateResponse creates a new list of Conmon_InspectStateResponse.
+func NewConmon_InspectStateResponse_List(s *capnp.Segment, sz int32) (Conmon_InspectStateResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return capnp.StructList[Conmon_InspectStateResponse]{l}, err
+}
+
+// Conmon_InspectStateResponse_Future is a wrapper for a Conmon_InspectStateResponse promised by a client call.
+type Conmon_InspectStateResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_InspectStateResponse_Future) Struct() (Conmon_InspectStateResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_InspectStateResponse{s}, err
+}
+
+func (p Conmon_InspectStateResponse_Future) Info() Conmon_StateInfo_Future {
+	return Conmon_StateInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+func (p Conmon_InspectStateResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(1, nil)}
+}
+
+type Conmon_CompactStateRequest struct{ capnp.Struct }
+
+// Conmon_CompactStateRequest_TypeID is the unique identifier for the type Conmon_CompactStateRequest.
+const Conmon_CompactStateRequest_TypeID = 0xe2720800f0cc3148
+
+func NewConmon_CompactStateRequest(s *capnp.Segment) (Conmon_CompactStateRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_CompactStateRequest{st}, err
+}
+
+func NewRootConmon_CompactStateRequest(s *capnp.Segment) (Conmon_CompactStateRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_CompactStateRequest{st}, err
+}
+
+func ReadRootConmon_CompactStateRequest(msg *capnp.Message) (Conmon_CompactStateRequest, error) {
+	root, err := msg.Root()
+	return Conmon_CompactStateRequest{root.Struct()}, err
+}
+
+func (s Conmon_CompactStateRequest) String() string {
+	str, _ := text.Marshal(0xe2720800f0cc3148, s.Struct)
+	return str
+}
+
+// Conmon_CompactStateRequest_List is a list of Conmon_CompactStateRequest.
+type Conmon_CompactStateRequest_List = capnp.StructList[Conmon_CompactStateRequest]
+
+// NewConmon_CompactStateRequest creates a new list of Conmon_CompactStateRequest.
+func NewConmon_CompactStateRequest_List(s *capnp.Segment, sz int32) (Conmon_CompactStateRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_CompactStateRequest]{l}, err
+}
+
+// Conmon_CompactStateRequest_Future is a wrapper for a Conmon_CompactStateRequest promised by a client call.
+type Conmon_CompactStateRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_CompactStateRequest_Future) Struct() (Conmon_CompactStateRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CompactStateRequest{s}, err
+}
+
+type Conmon_CompactStateResponse struct{ capnp.Struct }
+
+// Conmon_CompactStateResponse_TypeID is the unique identifier for the type Conmon_CompactStateResponse.
+const Conmon_CompactStateResponse_TypeID = 0xc196034eb51b223c
+
+func NewConmon_CompactStateResponse(s *capnp.Segment) (Conmon_CompactStateResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Conmon_CompactStateResponse{st}, err
+}
+
+func NewRootConmon_CompactStateResponse(s *capnp.Segment) (Conmon_CompactStateResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Conmon_CompactStateResponse{st}, err
+}
+
+func ReadRootConmon_CompactStateResponse(msg *capnp.Message) (Conmon_CompactStateResponse, error) {
+	root, err := msg.Root()
+	return Conmon_CompactStateResponse{root.Struct()}, err
+}
+
+func (s Conmon_CompactStateResponse) String() string {
+	str, _ := text.Marshal(0xc196034eb51b223c, s.Struct)
+	return str
+}
+
+func (s Conmon_CompactStateResponse) SizeBefore() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Conmon_CompactStateResponse) SetSizeBefore(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Conmon_CompactStateResponse) SizeAfter() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Conmon_CompactStateResponse) SetSizeAfter(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Conmon_CompactStateResponse) Entries() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_CompactStateResponse) SetEntries(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+func (s Conmon_CompactStateResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_CompactStateResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_CompactStateResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_CompactStateResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_CompactStateResponse_List is a list of Conmon_CompactStateResponse.
+type Conmon_CompactStateResponse_List = capnp.StructList[Conmon_CompactStateResponse]
+
+// NewConmon_CompactStateResponse creates a new list of Conmon_CompactStateResponse.
+func NewConmon_CompactStateResponse_List(s *capnp.Segment, sz int32) (Conmon_CompactStateResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_CompactStateResponse]{l}, err
+}
+
+// Conmon_CompactStateResponse_Future is a wrapper for a Conmon_CompactStateResponse promised by a client call.
+type Conmon_CompactStateResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_CompactStateResponse_Future) Struct() (Conmon_CompactStateResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_CompactStateResponse{s}, err
+}
+
+func (p Conmon_CompactStateResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_ContainerState uint16
+
+// Conmon_ContainerState_TypeID is the unique identifier for the type Conmon_ContainerState.
+const Conmon_ContainerState_TypeID = 0x9da87c83d6ee4b84
+
+// Values of Conmon_ContainerState.
+const (
+	Conmon_ContainerState_unknown  Conmon_ContainerState = 0
+	Conmon_ContainerState_created  Conmon_ContainerState = 1
+	Conmon_ContainerState_running  Conmon_ContainerState = 2
+	Conmon_ContainerState_paused   Conmon_ContainerState = 3
+	Conmon_ContainerState_stopping Conmon_ContainerState = 4
+	Conmon_ContainerState_exited   Conmon_ContainerState = 5
+	Conmon_ContainerState_removed  Conmon_ContainerState = 6
+)
+
+// String returns the enum's constant name.
+func (c Conmon_ContainerState) String() string {
+	switch c {
+	case Conmon_ContainerState_unknown:
+		return "unknown"
+	case Conmon_ContainerState_created:
+		return "created"
+	case Conmon_ContainerState_running:
+		return "running"
+	case Conmon_ContainerState_paused:
+		return "paused"
+	case Conmon_ContainerState_stopping:
+		return "stopping"
+	case Conmon_ContainerState_exited:
+		return "exited"
+	case Conmon_ContainerState_removed:
+		return "removed"
+
+	default:
+		return ""
+	}
+}
+
+// Conmon_ContainerStateFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Conmon_ContainerStateFromString(c string) Conmon_ContainerState {
+	switch c {
+	case "unknown":
+		return Conmon_ContainerState_unknown
+	case "created":
+		return Conmon_ContainerState_created
+	case "running":
+		return Conmon_ContainerState_running
+	case "paused":
+		return Conmon_ContainerState_paused
+	case "stopping":
+		return Conmon_ContainerState_stopping
+	case "exited":
+		return Conmon_ContainerState_exited
+	case "removed":
+		return Conmon_ContainerState_removed
+
+	default:
+		return 0
+	}
+}
+
+type Conmon_ContainerState_List = capnp.EnumList[Conmon_ContainerState]
+
+func NewConmon_ContainerState_List(s *capnp.Segment, sz int32) (Conmon_ContainerState_List, error) {
+	return capnp.NewEnumList[Conmon_ContainerState](s, sz)
+}
+
+type Conmon_GetStateRequest struct{ capnp.Struct }
+
+// Conmon_GetStateRequest_TypeID is the unique identifier for the type Conmon_GetStateRequest.
+const Conmon_GetStateRequest_TypeID = 0xe816df6a73dc01fb
+
+func NewConmon_GetStateRequest(s *capnp.Segment) (Conmon_GetStateRequest, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_GetStateRequest{st}, err
+}
+
+func NewRootConmon_GetStateRequest(s *capnp.Segment) (Conmon_GetStateRequest, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_GetStateRequest{st}, err
+}
+
+func ReadRootConmon_GetStateRequest(msg *capnp.Message) (Conmon_GetStateRequest, error) {
+	root, err := msg.Root()
+	return Conmon_GetStateRequest{root.Struct()}, err
+}
+
+func (s Conmon_GetStateRequest) String() string {
+	str, _ := text.Marshal(0xe816df6a73dc01fb, s.Struct)
+	return str
+}
+
+func (s Conmon_GetStateRequest) Id() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Conmon_GetStateRequest) HasId() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_GetStateRequest) IdBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Conmon_GetStateRequest) SetId(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Conmon_GetStateRequest_List is a list of Conmon_GetStateRequest.
+type Conmon_GetStateRequest_List = capnp.StructList[Conmon_GetStateRequest]
+
+// NewConmon_GetStateRequest creates a new list of Conmon_GetStateRequest.
+func NewConmon_GetStateRequest_List(s *capnp.Segment, sz int32) (Conmon_GetStateRequest_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_GetStateRequest]{l}, err
+}
+
+// Conmon_GetStateRequest_Future is a wrapper for a Conmon_GetStateRequest promised by a client call.
+type Conmon_GetStateRequest_Future struct{ *capnp.Future }
+
+func (p Conmon_GetStateRequest_Future) Struct() (Conmon_GetStateRequest, error) {
+	s, err := p.Future.Struct()
+	return Conmon_GetStateRequest{s}, err
+}
+
+type Conmon_GetStateResponse struct{ capnp.Struct }
+
+// Conmon_GetStateResponse_TypeID is the unique identifier for the type Conmon_GetStateResponse.
+const Conmon_GetStateResponse_TypeID = 0xad8b23925f51c168
+
+func NewConmon_GetStateResponse(s *capnp.Segment) (Conmon_GetStateResponse, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Conmon_GetStateResponse{st}, err
+}
+
+func NewRootConmon_GetStateResponse(s *capnp.Segment) (Conmon_GetStateResponse, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return Conmon_GetStateResponse{st}, err
+}
+
+func ReadRootConmon_GetStateResponse(msg *capnp.Message) (Conmon_GetStateResponse, error) {
+	root, err := msg.Root()
+	return Conmon_GetStateResponse{root.Struct()}, err
+}
+
+func (s Conmon_GetStateResponse) String() string {
+	str, _ := text.Marshal(0xad8b23925f51c168, s.Struct)
+	return str
+}
+
+func (s Conmon_GetStateResponse) State() Conmon_ContainerState {
+	return Conmon_ContainerState(s.Struct.Uint16(0))
+}
+
+func (s Conmon_GetStateResponse) SetState(v Conmon_ContainerState) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s Conmon_GetStateResponse) Pid() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Conmon_GetStateResponse) SetPid(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+func (s Conmon_GetStateResponse) ExitCode() int32 {
+	return int32(s.Struct.Uint32(8))
+}
+
+func (s Conmon_GetStateResponse) SetExitCode(v int32) {
+	s.Struct.SetUint32(8, uint32(v))
+}
+
+func (s Conmon_GetStateResponse) UpdatedAt() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Conmon_GetStateResponse) SetUpdatedAt(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+func (s Conmon_GetStateResponse) Error() (Conmon_ErrorInfo, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ErrorInfo{Struct: p.Struct()}, err
+}
+
+func (s Conmon_GetStateResponse) HasError() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_GetStateResponse) SetError(v Conmon_ErrorInfo) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewError sets the error field to a newly
+// allocated Conmon_ErrorInfo struct, preferring placement in s's segment.
+func (s Conmon_GetStateResponse) NewError() (Conmon_ErrorInfo, error) {
+	ss, err := NewConmon_ErrorInfo(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ErrorInfo{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_GetStateResponse_List is a list of Conmon_GetStateResponse.
+type Conmon_GetStateResponse_List = capnp.StructList[Conmon_GetStateResponse]
+
+// NewConmon_GetStateResponse creates a new list of Conmon_GetStateResponse.
+func NewConmon_GetStateResponse_List(s *capnp.Segment, sz int32) (Conmon_GetStateResponse_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_GetStateResponse]{l}, err
+}
+
+// Conmon_GetStateResponse_Future is a wrapper for a Conmon_GetStateResponse promised by a client call.
+type Conmon_GetStateResponse_Future struct{ *capnp.Future }
+
+func (p Conmon_GetStateResponse_Future) Struct() (Conmon_GetStateResponse, error) {
+	s, err := p.Future.Struct()
+	return Conmon_GetStateResponse{s}, err
+}
+
+func (p Conmon_GetStateResponse_Future) Error() Conmon_ErrorInfo_Future {
+	return Conmon_ErrorInfo_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_version_Params struct{ capnp.Struct }
+
+// Conmon_version_Params_TypeID is the unique identifier for the type Conmon_version_Params.
+const Conmon_version_Params_TypeID = 0xcc2f70676afee4e7
+
+func NewConmon_version_Params(s *capnp.Segment) (Conmon_version_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_version_Params{st}, err
+}
+
+func NewRootConmon_version_Params(s *capnp.Segment) (Conmon_version_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Conmon_version_Params{st}, err
+}
+
+func ReadRootConmon_version_Params(msg *capnp.Message) (Conmon_version_Params, error) {
+	root, err := msg.Root()
+	return Conmon_version_Params{root.Struct()}, err
+}
+
+func (s Conmon_version_Params) String() string {
+	str, _ := text.Marshal(0xcc2f70676afee4e7, s.Struct)
+	return str
+}
+
+// Conmon_version_Params_List is a list of Conmon_version_Params.
+type Conmon_version_Params_List = capnp.StructList[Conmon_version_Params]
+
+// NewConmon_version_Params creates a new list of Conmon_version_Params.
+func NewConmon_version_Params_List(s *capnp.Segment, sz int32) (Conmon_version_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return capnp.StructList[Conmon_version_Params]{l}, err
+}
+
+// Conmon_version_Params_Future is a wrapper for a Conmon_version_Params promised by a client call.
+type Conmon_version_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_version_Params_Future) Struct() (Conmon_version_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_version_Params{s}, err
+}
+
+type Conmon_version_Results struct{ capnp.Struct }
+
+// Conmon_version_Results_TypeID is the unique identifier for the type Conmon_version_Results.
+const Conmon_version_Results_TypeID = 0xe313695ea9477b30
+
+func NewConmon_version_Results(s *capnp.Segment) (Conmon_version_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_version_Results{st}, err
+}
+
+func NewRootConmon_version_Results(s *capnp.Segment) (Conmon_version_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_version_Results{st}, err
+}
+
+func ReadRootConmon_version_Results(msg *capnp.Message) (Conmon_version_Results, error) {
+	root, err := msg.Root()
+	return Conmon_version_Results{root.Struct()}, err
+}
+
+func (s Conmon_version_Results) String() string {
+	str, _ := text.Marshal(0xe313695ea9477b30, s.Struct)
+	return str
+}
+
+func (s Conmon_version_Results) Response() (Conmon_VersionResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_VersionResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_version_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_version_Results) SetResponse(v Conmon_VersionResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_VersionResponse struct, preferring placement in s's segment.
+func (s Conmon_version_Results) NewResponse() (Conmon_VersionResponse, error) {
+	ss, err := NewConmon_VersionResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_VersionResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_version_Results_List is a list of Conmon_version_Results.
+type Conmon_version_Results_List = capnp.StructList[Conmon_version_Results]
+
+// NewConmon_version_Results creates a new list of Conmon_version_Results.
+func NewConmon_version_Results_List(s *capnp.Segment, sz int32) (Conmon_version_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_version_Results]{l}, err
+}
+
+// Conmon_version_Results_Future is a wrapper for a Conmon_version_Results promised by a client call.
+type Conmon_version_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_version_Results_Future) Struct() (Conmon_version_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_version_Results{s}, err
+}
+
+func (p Conmon_version_Results_Future) Response() Conmon_VersionResponse_Future {
+	return Conmon_VersionResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_createContainer_Params struct{ capnp.Struct }
+
+// Conmon_createContainer_Params_TypeID is the unique identifier for the type Conmon_createContainer_Params.
+const Conmon_createContainer_Params_TypeID = 0xf44732c48f949ab8
+
+func NewConmon_createContainer_Params(s *capnp.Segment) (Conmon_createContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_createContainer_Params{st}, err
+}
+
+func NewRootConmon_createContainer_Params(s *capnp.Segment) (Conmon_createContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_createContainer_Params{st}, err
+}
+
+func ReadRootConmon_createContainer_Params(msg *capnp.Message) (Conmon_createContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_createContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_createContainer_Params) String() string {
+	str, _ := text.Marshal(0xf44732c48f949ab8, s.Struct)
+	return str
+}
+
+func (s Conmon_createContainer_Params) Request() (Conmon_CreateContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_CreateContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_createContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_createContainer_Params) SetRequest(v Conmon_CreateContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_CreateContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_createContainer_Params) NewRequest() (Conmon_CreateContainerRequest, error) {
+	ss, err := NewConmon_CreateContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CreateContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_createContainer_Params_List is a list of Conmon_createContainer_Params.
+type Conmon_createContainer_Params_List = capnp.StructList[Conmon_createContainer_Params]
+
+// NewConmon_createContainer_Params creates a new list of Conmon_createContainer_Params.
+func NewConmon_createContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_createContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_createContainer_Params]{l}, err
+}
+
+// Conmon_createContainer_Params_Future is a wrapper for a Conmon_createContainer_Params promised by a client call.
+type Conmon_createContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_createContainer_Params_Future) Struct() (Conmon_createContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_createContainer_Params{s}, err
+}
+
+func (p Conmon_createContainer_Params_Future) Request() Conmon_CreateContainerRequest_Future {
+	return Conmon_CreateContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_createContainer_Results struct{ capnp.Struct }
+
+// Conmon_createContainer_Results_TypeID is the unique identifier for the type Conmon_createContainer_Results.
+const Conmon_createContainer_Results_TypeID = 0xceba3c1a97be15f8
+
+func NewConmon_createContainer_Results(s *capnp.Segment) (Conmon_createContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_createContainer_Results{st}, err
+}
+
+func NewRootConmon_createContainer_Results(s *capnp.Segment) (Conmon_createContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_createContainer_Results{st}, err
+}
+
+func ReadRootConmon_createContainer_Results(msg *capnp.Message) (Conmon_createContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_createContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_createContainer_Results) String() string {
+	str, _ := text.Marshal(0xceba3c1a97be15f8, s.Struct)
+	return str
+}
+
+func (s Conmon_createContainer_Results) Response() (Conmon_CreateContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_CreateContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_createContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_createContainer_Results) SetResponse(v Conmon_CreateContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_CreateContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_createContainer_Results) NewResponse() (Conmon_CreateContainerResponse, error) {
+	ss, err := NewConmon_CreateContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CreateContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_createContainer_Results_List is a list of Conmon_createContainer_Results.
+type Conmon_createContainer_Results_List = capnp.StructList[Conmon_createContainer_Results]
+
+// NewConmon_createContainer_Results creates a new list of Conmon_createContainer_Results.
+func NewConmon_createContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_createContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_createContainer_Results]{l}, err
+}
+
+// Conmon_createContainer_Results_Future is a wrapper for a Conmon_createContainer_Results promised by a client call.
+type Conmon_createContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_createContainer_Results_Future) Struct() (Conmon_createContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_createContainer_Results{s}, err
+}
+
+func (p Conmon_createContainer_Results_Future) Response() Conmon_CreateContainerResponse_Future {
+	return Conmon_CreateContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_execSyncContainer_Params struct{ capnp.Struct }
+
+// Conmon_execSyncContainer_Params_TypeID is the unique identifier for the type Conmon_execSyncContainer_Params.
+const Conmon_execSyncContainer_Params_TypeID = 0x83479da67279e173
+
+func NewConmon_execSyncContainer_Params(s *capnp.Segment) (Conmon_execSyncContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execSyncContainer_Params{st}, err
+}
+
+func NewRootConmon_execSyncContainer_Params(s *capnp.Segment) (Conmon_execSyncContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execSyncContainer_Params{st}, err
+}
+
+func ReadRootConmon_execSyncContainer_Params(msg *capnp.Message) (Conmon_execSyncContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_execSyncContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_execSyncContainer_Params) String() string {
+	str, _ := text.Marshal(0x83479da67279e173, s.Struct)
+	return str
+}
+
+func (s Conmon_execSyncContainer_Params) Request() (Conmon_ExecSyncContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ExecSyncContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_execSyncContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_execSyncContainer_Params) SetRequest(v Conmon_ExecSyncContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ExecSyncContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_execSyncContainer_Params) NewRequest() (Conmon_ExecSyncContainerRequest, error) {
+	ss, err := NewConmon_ExecSyncContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ExecSyncContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_execSyncContainer_Params_List is a list of Conmon_execSyncContainer_Params.
+type Conmon_execSyncContainer_Params_List = capnp.StructList[Conmon_execSyncContainer_Params]
+
+// NewConmon_execSyncContainer_Params creates a new list of Conmon_execSyncContainer_Params.
+func NewConmon_execSyncContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_execSyncContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_execSyncContainer_Params]{l}, err
+}
+
+// Conmon_execSyncContainer_Params_Future is a wrapper for a Conmon_execSyncContainer_Params promised by a client call.
+type Conmon_execSyncContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_execSyncContainer_Params_Future) Struct() (Conmon_execSyncContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_execSyncContainer_Params{s}, err
+}
+
+func (p Conmon_execSyncContainer_Params_Future) Request() Conmon_ExecSyncContainerRequest_Future {
+	return Conmon_ExecSyncContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_execSyncContainer_Results struct{ capnp.Struct }
+
+// Conmon_execSyncContainer_Results_TypeID is the unique identifier for the type Conmon_execSyncContainer_Results.
+const Conmon_execSyncContainer_Results_TypeID = 0xf8e86a5c0baa01bc
+
+func NewConmon_execSyncContainer_Results(s *capnp.Segment) (Conmon_execSyncContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execSyncContainer_Results{st}, err
+}
+
+func NewRootConmon_execSyncContainer_Results(s *capnp.Segment) (Conmon_execSyncContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execSyncContainer_Results{st}, err
+}
+
+func ReadRootConmon_execSyncContainer_Results(msg *capnp.Message) (Conmon_execSyncContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_execSyncContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_execSyncContainer_Results) String() string {
+	str, _ := text.Marshal(0xf8e86a5c0baa01bc, s.Struct)
+	return str
+}
+
+func (s Conmon_execSyncContainer_Results) Response() (Conmon_ExecSyncContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ExecSyncContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_execSyncContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_execSyncContainer_Results) SetResponse(v Conmon_ExecSyncContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ExecSyncContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_execSyncContainer_Results) NewResponse() (Conmon_ExecSyncContainerResponse, error) {
+	ss, err := NewConmon_ExecSyncContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ExecSyncContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_execSyncContainer_Results_List is a list of Conmon_execSyncContainer_Results.
+type Conmon_execSyncContainer_Results_List = capnp.StructList[Conmon_execSyncContainer_Results]
+
+// NewConmon_execSyncContainer_Results creates a new list of Conmon_execSyncContainer_Results.
+func NewConmon_execSyncContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_execSyncContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_execSyncContainer_Results]{l}, err
+}
+
+// Conmon_execSyncContainer_Results_Future is a wrapper for a Conmon_execSyncContainer_Results promised by a client call.
+type Conmon_execSyncContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_execSyncContainer_Results_Future) Struct() (Conmon_execSyncContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_execSyncContainer_Results{s}, err
+}
+
+func (p Conmon_execSyncContainer_Results_Future) Response() Conmon_ExecSyncContainerResponse_Future {
+	return Conmon_ExecSyncContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_attachContainer_Params struct{ capnp.Struct }
+
+// Conmon_attachContainer_Params_TypeID is the unique identifier for the type Conmon_attachContainer_Params.
+const Conmon_attachContainer_Params_TypeID = 0xaa2f3c8ad1c3af24
+
+func NewConmon_attachContainer_Params(s *capnp.Segment) (Conmon_attachContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_attachContainer_Params{st}, err
+}
+
+func NewRootConmon_attachContainer_Params(s *capnp.Segment) (Conmon_attachContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_attachContainer_Params{st}, err
+}
+
+func ReadRootConmon_attachContainer_Params(msg *capnp.Message) (Conmon_attachContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_attachContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_attachContainer_Params) String() string {
+	str, _ := text.Marshal(0xaa2f3c8ad1c3af24, s.Struct)
+	return str
+}
+
+func (s Conmon_attachContainer_Params) Request() (Conmon_AttachRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_AttachRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_attachContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_attachContainer_Params) SetRequest(v Conmon_AttachRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_AttachRequest struct, preferring placement in s's segment.
+func (s Conmon_attachContainer_Params) NewRequest() (Conmon_AttachRequest, error) {
+	ss, err := NewConmon_AttachRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_AttachRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_attachContainer_Params_List is a list of Conmon_attachContainer_Params.
+type Conmon_attachContainer_Params_List = capnp.StructList[Conmon_attachContainer_Params]
+
+// NewConmon_attachContainer_Params creates a new list of Conmon_attachContainer_Params.
+func NewConmon_attachContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_attachContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_attachContainer_Params]{l}, err
+}
+
+// Conmon_attachContainer_Params_Future is a wrapper for a Conmon_attachContainer_Params promised by a client call.
+type Conmon_attachContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_attachContainer_Params_Future) Struct() (Conmon_attachContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_attachContainer_Params{s}, err
+}
+
+func (p Conmon_attachContainer_Params_Future) Request() Conmon_AttachRequest_Future {
+	return Conmon_AttachRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_attachContainer_Results struct{ capnp.Struct }
+
+// Conmon_attachContainer_Results_TypeID is the unique identifier for the type Conmon_attachContainer_Results.
+const Conmon_attachContainer_Results_TypeID = 0xc5e65eec3dcf5b10
+
+func NewConmon_attachContainer_Results(s *capnp.Segment) (Conmon_attachContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_attachContainer_Results{st}, err
+}
+
+func NewRootConmon_attachContainer_Results(s *capnp.Segment) (Conmon_attachContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_attachContainer_Results{st}, err
+}
+
+func ReadRootConmon_attachContainer_Results(msg *capnp.Message) (Conmon_attachContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_attachContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_attachContainer_Results) String() string {
+	str, _ := text.Marshal(0xc5e65eec3dcf5b10, s.Struct)
+	return str
+}
+
+func (s Conmon_attachContainer_Results) Response() (Conmon_AttachResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_AttachResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_attachContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_attachContainer_Results) SetResponse(v Conmon_AttachResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_AttachResponse struct, preferring placement in s's segment.
+func (s Conmon_attachContainer_Results) NewResponse() (Conmon_AttachResponse, error) {
+	ss, err := NewConmon_AttachResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_AttachResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_attachContainer_Results_List is a list of Conmon_attachContainer_Results.
+type Conmon_attachContainer_Results_List = capnp.StructList[Conmon_attachContainer_Results]
+
+// NewConmon_attachContainer_Results creates a new list of Conmon_attachContainer_Results.
+func NewConmon_attachContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_attachContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_attachContainer_Results]{l}, err
+}
+
+// Conmon_attachContainer_Results_Future is a wrapper for a Conmon_attachContainer_Results promised by a client call.
+type Conmon_attachContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_attachContainer_Results_Future) Struct() (Conmon_attachContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_attachContainer_Results{s}, err
+}
+
+func (p Conmon_attachContainer_Results_Future) Response() Conmon_AttachResponse_Future {
+	return Conmon_AttachResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_reopenLogContainer_Params struct{ capnp.Struct }
+
+// Conmon_reopenLogContainer_Params_TypeID is the unique identifier for the type Conmon_reopenLogContainer_Params.
+const Conmon_reopenLogContainer_Params_TypeID = 0xe5ea916eb0c31336
+
+func NewConmon_reopenLogContainer_Params(s *capnp.Segment) (Conmon_reopenLogContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_reopenLogContainer_Params{st}, err
+}
+
+func NewRootConmon_reopenLogContainer_Params(s *capnp.Segment) (Conmon_reopenLogContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_reopenLogContainer_Params{st}, err
+}
+
+func ReadRootConmon_reopenLogContainer_Params(msg *capnp.Message) (Conmon_reopenLogContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_reopenLogContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_reopenLogContainer_Params) String() string {
+	str, _ := text.Marshal(0xe5ea916eb0c31336, s.Struct)
+	return str
+}
+
+func (s Conmon_reopenLogContainer_Params) Request() (Conmon_ReopenLogRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ReopenLogRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_reopenLogContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_reopenLogContainer_Params) SetRequest(v Conmon_ReopenLogRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ReopenLogRequest struct, preferring placement in s's segment.
+func (s Conmon_reopenLogContainer_Params) NewRequest() (Conmon_ReopenLogRequest, error) {
+	ss, err := NewConmon_ReopenLogRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ReopenLogRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_reopenLogContainer_Params_List is a list of Conmon_reopenLogContainer_Params.
+type Conmon_reopenLogContainer_Params_List = capnp.StructList[Conmon_reopenLogContainer_Params]
+
+// NewConmon_reopenLogContainer_Params creates a new list of Conmon_reopenLogContainer_Params.
+func NewConmon_reopenLogContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_reopenLogContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_reopenLogContainer_Params]{l}, err
+}
+
+// Conmon_reopenLogContainer_Params_Future is a wrapper for a Conmon_reopenLogContainer_Params promised by a client call.
+type Conmon_reopenLogContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_reopenLogContainer_Params_Future) Struct() (Conmon_reopenLogContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_reopenLogContainer_Params{s}, err
+}
+
+func (p Conmon_reopenLogContainer_Params_Future) Request() Conmon_ReopenLogRequest_Future {
+	return Conmon_ReopenLogRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_reopenLogContainer_Results struct{ capnp.Struct }
+
+// Conmon_reopenLogContainer_Results_TypeID is the unique identifier for the type Conmon_reopenLogContainer_Results.
+const Conmon_reopenLogContainer_Results_TypeID = 0xa0ef8355b64ee985
+
+func NewConmon_reopenLogContainer_Results(s *capnp.Segment) (Conmon_reopenLogContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_reopenLogContainer_Results{st}, err
+}
+
+func NewRootConmon_reopenLogContainer_Results(s *capnp.Segment) (Conmon_reopenLogContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_reopenLogContainer_Results{st}, err
+}
+
+func ReadRootConmon_reopenLogContainer_Results(msg *capnp.Message) (Conmon_reopenLogContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_reopenLogContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_reopenLogContainer_Results) String() string {
+	str, _ := text.Marshal(0xa0ef8355b64ee985, s.Struct)
+	return str
+}
+
+func (s Conmon_reopenLogContainer_Results) Response() (Conmon_ReopenLogResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ReopenLogResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_reopenLogContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_reopenLogContainer_Results) SetResponse(v Conmon_ReopenLogResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ReopenLogResponse struct, preferring placement in s's segment.
+func (s Conmon_reopenLogContainer_Results) NewResponse() (Conmon_ReopenLogResponse, error) {
+	ss, err := NewConmon_ReopenLogResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ReopenLogResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_reopenLogContainer_Results_List is a list of Conmon_reopenLogContainer_Results.
+type Conmon_reopenLogContainer_Results_List = capnp.StructList[Conmon_reopenLogContainer_Results]
+
+// NewConmon_reopenLogContainer_Results creates a new list of Conmon_reopenLogContainer_Results.
+func NewConmon_reopenLogContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_reopenLogContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_reopenLogContainer_Results]{l}, err
+}
+
+// Conmon_reopenLogContainer_Results_Future is a wrapper for a Conmon_reopenLogContainer_Results promised by a client call.
+type Conmon_reopenLogContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_reopenLogContainer_Results_Future) Struct() (Conmon_reopenLogContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_reopenLogContainer_Results{s}, err
+}
+
+func (p Conmon_reopenLogContainer_Results_Future) Response() Conmon_ReopenLogResponse_Future {
+	return Conmon_ReopenLogResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_setWindowSizeContainer_Params struct{ capnp.Struct }
+
+// Conmon_setWindowSizeContainer_Params_TypeID is the unique identifier for the type Conmon_setWindowSizeContainer_Params.
+const Conmon_setWindowSizeContainer_Params_TypeID = 0xc76ccd4502bb61e7
+
+func NewConmon_setWindowSizeContainer_Params(s *capnp.Segment) (Conmon_setWindowSizeContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWindowSizeContainer_Params{st}, err
+}
+
+func NewRootConmon_setWindowSizeContainer_Params(s *capnp.Segment) (Conmon_setWindowSizeContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWindowSizeContainer_Params{st}, err
+}
+
+func ReadRootConmon_setWindowSizeContainer_Params(msg *capnp.Message) (Conmon_setWindowSizeContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_setWindowSizeContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_setWindowSizeContainer_Params) String() string {
+	str, _ := text.Marshal(0xc76ccd4502bb61e7, s.Struct)
+	return str
+}
+
+func (s Conmon_setWindowSizeContainer_Params) Request() (Conmon_SetWindowSizeRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SetWindowSizeRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_setWindowSizeContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_setWindowSizeContainer_Params) SetRequest(v Conmon_SetWindowSizeRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_SetWindowSizeRequest struct, preferring placement in s's segment.
+func (s Conmon_setWindowSizeContainer_Params) NewRequest() (Conmon_SetWindowSizeRequest, error) {
+	ss, err := NewConmon_SetWindowSizeRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SetWindowSizeRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_setWindowSizeContainer_Params_List is a list of Conmon_setWindowSizeContainer_Params.
+type Conmon_setWindowSizeContainer_Params_List = capnp.StructList[Conmon_setWindowSizeContainer_Params]
+
+// NewConmon_setWindowSizeContainer_Params creates a new list of Conmon_setWindowSizeContainer_Params.
+func NewConmon_setWindowSizeContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_setWindowSizeContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_setWindowSizeContainer_Params]{l}, err
+}
+
+// Conmon_setWindowSizeContainer_Params_Future is a wrapper for a Conmon_setWindowSizeContainer_Params promised by a client call.
+type Conmon_setWindowSizeContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_setWindowSizeContainer_Params_Future) Struct() (Conmon_setWindowSizeContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_setWindowSizeContainer_Params{s}, err
+}
+
+func (p Conmon_setWindowSizeContainer_Params_Future) Request() Conmon_SetWindowSizeRequest_Future {
+	return Conmon_SetWindowSizeRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_setWindowSizeContainer_Results struct{ capnp.Struct }
+
+// Conmon_setWindowSizeContainer_Results_TypeID is the unique identifier for the type Conmon_setWindowSizeContainer_Results.
+const Conmon_setWindowSizeContainer_Results_TypeID = 0xe00e522611477055
+
+func NewConmon_setWindowSizeContainer_Results(s *capnp.Segment) (Conmon_setWindowSizeContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWindowSizeContainer_Results{st}, err
+}
+
+func NewRootConmon_setWindowSizeContainer_Results(s *capnp.Segment) (Conmon_setWindowSizeContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWindowSizeContainer_Results{st}, err
+}
+
+func ReadRootConmon_setWindowSizeContainer_Results(msg *capnp.Message) (Conmon_setWindowSizeContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_setWindowSizeContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_setWindowSizeContainer_Results) String() string {
+	str, _ := text.Marshal(0xe00e522611477055, s.Struct)
+	return str
+}
+
+func (s Conmon_setWindowSizeContainer_Results) Response() (Conmon_SetWindowSizeResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SetWindowSizeResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_setWindowSizeContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_setWindowSizeContainer_Results) SetResponse(v Conmon_SetWindowSizeResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_SetWindowSizeResponse struct, preferring placement in s's segment.
+func (s Conmon_setWindowSizeContainer_Results) NewResponse() (Conmon_SetWindowSizeResponse, error) {
+	ss, err := NewConmon_SetWindowSizeResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SetWindowSizeResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_setWindowSizeContainer_Results_List is a list of Conmon_setWindowSizeContainer_Results.
+type Conmon_setWindowSizeContainer_Results_List = capnp.StructList[Conmon_setWindowSizeContainer_Results]
+
+// NewConmon_setWindowSizeContainer_Results creates a new list of Conmon_setWindowSizeContainer_Results.
+func NewConmon_setWindowSizeContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_setWindowSizeContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_setWindowSizeContainer_Results]{l}, err
+}
+
+// Conmon_setWindowSizeContainer_Results_Future is a wrapper for a Conmon_setWindowSizeContainer_Results promised by a client call.
+type Conmon_setWindowSizeContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_setWindowSizeContainer_Results_Future) Struct() (Conmon_setWindowSizeContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_setWindowSizeContainer_Results{s}, err
+}
+
+func (p Conmon_setWindowSizeContainer_Results_Future) Response() Conmon_SetWindowSizeResponse_Future {
+	return Conmon_SetWindowSizeResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_updateSysctls_Params struct{ capnp.Struct }
+
+// Conmon_updateSysctls_Params_TypeID is the unique identifier for the type Conmon_updateSysctls_Params.
+const Conmon_updateSysctls_Params_TypeID = 0x8b4c03a0662a38dc
+
+func NewConmon_updateSysctls_Params(s *capnp.Segment) (Conmon_updateSysctls_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_updateSysctls_Params{st}, err
+}
+
+func NewRootConmon_updateSysctls_Params(s *capnp.Segment) (Conmon_updateSysctls_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_updateSysctls_Params{st}, err
+}
+
+func ReadRootConmon_updateSysctls_Params(msg *capnp.Message) (Conmon_updateSysctls_Params, error) {
+	root, err := msg.Root()
+	return Conmon_updateSysctls_Params{root.Struct()}, err
+}
+
+func (s Conmon_updateSysctls_Params) String() string {
+	str, _ := text.Marshal(0x8b4c03a0662a38dc, s.Struct)
+	return str
+}
+
+func (s Conmon_updateSysctls_Params) Request() (Conmon_UpdateSysctlsRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_UpdateSysctlsRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_updateSysctls_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_updateSysctls_Params) SetRequest(v Conmon_UpdateSysctlsRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_UpdateSysctlsRequest struct, preferring placement in s's segment.
+func (s Conmon_updateSysctls_Params) NewRequest() (Conmon_UpdateSysctlsRequest, error) {
+	ss, err := NewConmon_UpdateSysctlsRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_UpdateSysctlsRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_updateSysctls_Params_List is a list of Conmon_updateSysctls_Params.
+type Conmon_updateSysctls_Params_List = capnp.StructList[Conmon_updateSysctls_Params]
+
+// NewConmon_updateSysctls_Params creates a new list of Conmon_updateSysctls_Params.
+func NewConmon_updateSysctls_Params_List(s *capnp.Segment, sz int32) (Conmon_updateSysctls_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_updateSysctls_Params]{l}, err
+}
+
+// Conmon_updateSysctls_Params_Future is a wrapper for a Conmon_updateSysctls_Params promised by a client call.
+type Conmon_updateSysctls_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_updateSysctls_Params_Future) Struct() (Conmon_updateSysctls_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_updateSysctls_Params{s}, err
+}
+
+func (p Conmon_updateSysctls_Params_Future) Request() Conmon_UpdateSysctlsRequest_Future {
+	return Conmon_UpdateSysctlsRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_updateSysctls_Results struct{ capnp.Struct }
+
+// Conmon_updateSysctls_Results_TypeID is the unique identifier for the type Conmon_updateSysctls_Results.
+const Conmon_updateSysctls_Results_TypeID = 0x8aef91973dc8a4f5
+
+func NewConmon_updateSysctls_Results(s *capnp.Segment) (Conmon_updateSysctls_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_updateSysctls_Results{st}, err
+}
+
+func NewRootConmon_updateSysctls_Results(s *capnp.Segment) (Conmon_updateSysctls_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_updateSysctls_Results{st}, err
+}
+
+func ReadRootConmon_updateSysctls_Results(msg *capnp.Message) (Conmon_updateSysctls_Results, error) {
+	root, err := msg.Root()
+	return Conmon_updateSysctls_Results{root.Struct()}, err
+}
+
+func (s Conmon_updateSysctls_Results) String() string {
+	str, _ := text.Marshal(0x8aef91973dc8a4f5, s.Struct)
+	return str
+}
+
+func (s Conmon_updateSysctls_Results) Response() (Conmon_UpdateSysctlsResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_UpdateSysctlsResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_updateSysctls_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_updateSysctls_Results) SetResponse(v Conmon_UpdateSysctlsResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_UpdateSysctlsResponse struct, preferring placement in s's segment.
+func (s Conmon_updateSysctls_Results) NewResponse() (Conmon_UpdateSysctlsResponse, error) {
+	ss, err := NewConmon_UpdateSysctlsResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_UpdateSysctlsResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_updateSysctls_Results_List is a list of Conmon_updateSysctls_Results.
+type Conmon_updateSysctls_Results_List = capnp.StructList[Conmon_updateSysctls_Results]
+
+// NewConmon_updateSysctls_Results creates a new list of Conmon_updateSysctls_Results.
+func NewConmon_updateSysctls_Results_List(s *capnp.Segment, sz int32) (Conmon_updateSysctls_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_updateSysctls_Results]{l}, err
+}
+
+// Conmon_updateSysctls_Results_Future is a wrapper for a Conmon_updateSysctls_Results promised by a client call.
+type Conmon_updateSysctls_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_updateSysctls_Results_Future) Struct() (Conmon_updateSysctls_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_updateSysctls_Results{s}, err
+}
+
+func (p Conmon_updateSysctls_Results_Future) Response() Conmon_UpdateSysctlsResponse_Future {
+	return Conmon_UpdateSysctlsResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_watchMounts_Params struct{ capnp.Struct }
+
+// Conmon_watchMounts_Params_TypeID is the unique identifier for the type Conmon_watchMounts_Params.
+const Conmon_watchMounts_Params_TypeID = 0xce733f0914c80b6b
+
+func NewConmon_watchMounts_Params(s *capnp.Segment) (Conmon_watchMounts_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchMounts_Params{st}, err
+}
+
+func NewRootConmon_watchMounts_Params(s *capnp.Segment) (Conmon_watchMounts_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchMounts_Params{st}, err
+}
+
+func ReadRootConmon_watchMounts_Params(msg *capnp.Message) (Conmon_watchMounts_Params, error) {
+	root, err := msg.Root()
+	return Conmon_watchMounts_Params{root.Struct()}, err
+}
+
+func (s Conmon_watchMounts_Params) String() string {
+	str, _ := text.Marshal(0xce733f0914c80b6b, s.Struct)
+	return str
+}
+
+func (s Conmon_watchMounts_Params) Request() (Conmon_WatchMountsRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_WatchMountsRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_watchMounts_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_watchMounts_Params) SetRequest(v Conmon_WatchMountsRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_WatchMountsRequest struct, preferring placement in s's segment.
+func (s Conmon_watchMounts_Params) NewRequest() (Conmon_WatchMountsRequest, error) {
+	ss, err := NewConmon_WatchMountsRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_WatchMountsRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_watchMounts_Params_List is a list of Conmon_watchMounts_Params.
+type Conmon_watchMounts_Params_List = capnp.StructList[Conmon_watchMounts_Params]
+
+// NewConmon_watchMounts_Params creates a new list of Conmon_watchMounts_Params.
+func NewConmon_watchMounts_Params_List(s *capnp.Segment, sz int32) (Conmon_watchMounts_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_watchMounts_Params]{l}, err
+}
+
+// Conmon_watchMounts_Params_Future is a wrapper for a Conmon_watchMounts_Params promised by a client call.
+type Conmon_watchMounts_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_watchMounts_Params_Future) Struct() (Conmon_watchMounts_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_watchMounts_Params{s}, err
+}
+
+func (p Conmon_watchMounts_Params_Future) Request() Conmon_WatchMountsRequest_Future {
+	return Conmon_WatchMountsRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_watchMounts_Results struct{ capnp.Struct }
+
+// Conmon_watchMounts_Results_TypeID is the unique identifier for the type Conmon_watchMounts_Results.
+const Conmon_watchMounts_Results_TypeID = 0xf4e3e92ae0815f15
+
+func NewConmon_watchMounts_Results(s *capnp.Segment) (Conmon_watchMounts_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchMounts_Results{st}, err
+}
+
+func NewRootConmon_watchMounts_Results(s *capnp.Segment) (Conmon_watchMounts_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchMounts_Results{st}, err
+}
+
+func ReadRootConmon_watchMounts_Results(msg *capnp.Message) (Conmon_watchMounts_Results, error) {
+	root, err := msg.Root()
+	return Conmon_watchMounts_Results{root.Struct()}, err
+}
+
+func (s Conmon_watchMounts_Results) String() string {
+	str, _ := text.Marshal(0xf4e3e92ae0815f15, s.Struct)
+	return str
+}
+
+func (s Conmon_watchMounts_Results) Response() (Conmon_WatchMountsResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_WatchMountsResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_watchMounts_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_watchMounts_Results) SetResponse(v Conmon_WatchMountsResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_WatchMountsResponse struct, preferring placement in s's segment.
+func (s Conmon_watchMounts_Results) NewResponse() (Conmon_WatchMountsResponse, error) {
+	ss, err := NewConmon_WatchMountsResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_WatchMountsResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_watchMounts_Results_List is a list of Conmon_watchMounts_Results.
+type Conmon_watchMounts_Results_List = capnp.StructList[Conmon_watchMounts_Results]
+
+// NewConmon_watchMounts_Results creates a new list of Conmon_watchMounts_Results.
+func NewConmon_watchMounts_Results_List(s *capnp.Segment, sz int32) (Conmon_watchMounts_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_watchMounts_Results]{l}, err
+}
+
+// Conmon_watchMounts_Results_Future is a wrapper for a Conmon_watchMounts_Results promised by a client call.
+type Conmon_watchMounts_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_watchMounts_Results_Future) Struct() (Conmon_watchMounts_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_watchMounts_Results{s}, err
+}
+
+func (p Conmon_watchMounts_Results_Future) Response() Conmon_WatchMountsResponse_Future {
+	return Conmon_WatchMountsResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_watchQuota_Params struct{ capnp.Struct }
+
+// Conmon_watchQuota_Params_TypeID is the unique identifier for the type Conmon_watchQuota_Params.
+const Conmon_watchQuota_Params_TypeID = 0x90a3950a51412b8b
+
+func NewConmon_watchQuota_Params(s *capnp.Segment) (Conmon_watchQuota_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchQuota_Params{st}, err
+}
+
+func NewRootConmon_watchQuota_Params(s *capnp.Segment) (Conmon_watchQuota_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchQuota_Params{st}, err
+}
+
+func ReadRootConmon_watchQuota_Params(msg *capnp.Message) (Conmon_watchQuota_Params, error) {
+	root, err := msg.Root()
+	return Conmon_watchQuota_Params{root.Struct()}, err
+}
+
+func (s Conmon_watchQuota_Params) String() string {
+	str, _ := text.Marshal(0x90a3950a51412b8b, s.Struct)
+	return str
+}
+
+func (s Conmon_watchQuota_Params) Request() (Conmon_WatchQuotaRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_WatchQuotaRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_watchQuota_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_watchQuota_Params) SetRequest(v Conmon_WatchQuotaRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_WatchQuotaRequest struct, preferring placement in s's segment.
+func (s Conmon_watchQuota_Params) NewRequest() (Conmon_WatchQuotaRequest, error) {
+	ss, err := NewConmon_WatchQuotaRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_WatchQuotaRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_watchQuota_Params_List is a list of Conmon_watchQuota_Params.
+type Conmon_watchQuota_Params_List = capnp.StructList[Conmon_watchQuota_Params]
+
+// NewConmon_watchQuota_Params creates a new list of Conmon_watchQuota_Params.
+func NewConmon_watchQuota_Params_List(s *capnp.Segment, sz int32) (Conmon_watchQuota_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_watchQuota_Params]{l}, err
+}
+
+// Conmon_watchQuota_Params_Future is a wrapper for a Conmon_watchQuota_Params promised by a client call.
+type Conmon_watchQuota_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_watchQuota_Params_Future) Struct() (Conmon_watchQuota_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_watchQuota_Params{s}, err
+}
+
+func (p Conmon_watchQuota_Params_Future) Request() Conmon_WatchQuotaRequest_Future {
+	return Conmon_WatchQuotaRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_watchQuota_Results struct{ capnp.Struct }
+
+// Conmon_watchQuota_Results_TypeID is the unique identifier for the type Conmon_watchQuota_Results.
+const Conmon_watchQuota_Results_TypeID = 0xdebaeed2a782ac80
+
+func NewConmon_watchQuota_Results(s *capnp.Segment) (Conmon_watchQuota_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchQuota_Results{st}, err
+}
+
+func NewRootConmon_watchQuota_Results(s *capnp.Segment) (Conmon_watchQuota_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchQuota_Results{st}, err
+}
+
+func ReadRootConmon_watchQuota_Results(msg *capnp.Message) (Conmon_watchQuota_Results, error) {
+	root, err := msg.Root()
+	return Conmon_watchQuota_Results{root.Struct()}, err
+}
+
+func (s Conmon_watchQuota_Results) String() string {
+	str, _ := text.Marshal(0xdebaeed2a782ac80, s.Struct)
+	return str
+}
+
+func (s Conmon_watchQuota_Results) Response() (Conmon_WatchQuotaResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_WatchQuotaResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_watchQuota_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_watchQuota_Results) SetResponse(v Conmon_WatchQuotaResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_WatchQuotaResponse struct, preferring placement in s's segment.
+func (s Conmon_watchQuota_Results) NewResponse() (Conmon_WatchQuotaResponse, error) {
+	ss, err := NewConmon_WatchQuotaResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_WatchQuotaResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_watchQuota_Results_List is a list of Conmon_watchQuota_Results.
+type Conmon_watchQuota_Results_List = capnp.StructList[Conmon_watchQuota_Results]
+
+// NewConmon_watchQuota_Results creates a new list of Conmon_watchQuota_Results.
+func NewConmon_watchQuota_Results_List(s *capnp.Segment, sz int32) (Conmon_watchQuota_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_watchQuota_Results]{l}, err
+}
+
+// Conmon_watchQuota_Results_Future is a wrapper for a Conmon_watchQuota_Results promised by a client call.
+type Conmon_watchQuota_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_watchQuota_Results_Future) Struct() (Conmon_watchQuota_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_watchQuota_Results{s}, err
+}
+
+func (p Conmon_watchQuota_Results_Future) Response() Conmon_WatchQuotaResponse_Future {
+	return Conmon_WatchQuotaResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_listAttachSessions_Params struct{ capnp.Struct }
+
+// Conmon_listAttachSessions_Params_TypeID is the unique identifier for the type Conmon_listAttachSessions_Params.
+const Conmon_listAttachSessions_Params_TypeID = 0xa3cb406c522dcab1
+
+func NewConmon_listAttachSessions_Params(s *capnp.Segment) (Conmon_listAttachSessions_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_listAttachSessions_Params{st}, err
+}
+
+func NewRootConmon_listAttachSessions_Params(s *capnp.Segment) (Conmon_listAttachSessions_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_listAttachSessions_Params{st}, err
+}
+
+func ReadRootConmon_listAttachSessions_Params(msg *capnp.Message) (Conmon_listAttachSessions_Params, error) {
+	root, err := msg.Root()
+	return Conmon_listAttachSessions_Params{root.Struct()}, err
+}
+
+func (s Conmon_listAttachSessions_Params) String() string {
+	str, _ := text.Marshal(0xa3cb406c522dcab1, s.Struct)
+	return str
+}
+
+func (s Conmon_listAttachSessions_Params) Request() (Conmon_ListAttachSessionsRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ListAttachSessionsRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_listAttachSessions_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_listAttachSessions_Params) SetRequest(v Conmon_ListAttachSessionsRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ListAttachSessionsRequest struct, preferring placement in s's segment.
+func (s Conmon_listAttachSessions_Params) NewRequest() (Conmon_ListAttachSessionsRequest, error) {
+	ss, err := NewConmon_ListAttachSessionsRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ListAttachSessionsRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_listAttachSessions_Params_List is a list of Conmon_listAttachSessions_Params.
+type Conmon_listAttachSessions_Params_List = capnp.StructList[Conmon_listAttachSessions_Params]
+
+// NewConmon_listAttachSessions_Params creates a new list of Conmon_listAttachSessions_Params.
+func NewConmon_listAttachSessions_Params_List(s *capnp.Segment, sz int32) (Conmon_listAttachSessions_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_listAttachSessions_Params]{l}, err
+}
+
+// Conmon_listAttachSessions_Params_Future is a wrapper for a Conmon_listAttachSessions_Params promised by a client call.
+type Conmon_listAttachSessions_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_listAttachSessions_Params_Future) Struct() (Conmon_listAttachSessions_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_listAttachSessions_Params{s}, err
+}
+
+func (p Conmon_listAttachSessions_Params_Future) Request() Conmon_ListAttachSessionsRequest_Future {
+	return Conmon_ListAttachSessionsRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_listAttachSessions_Results struct{ capnp.Struct }
+
+// Conmon_listAttachSessions_Results_TypeID is the unique identifier for the type Conmon_listAttachSessions_Results.
+const Conmon_listAttachSessions_Results_TypeID = 0xedd2e5b018f17bbb
+
+func NewConmon_listAttachSessions_Results(s *capnp.Segment) (Conmon_listAttachSessions_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_listAttachSessions_Results{st}, err
+}
+
+func NewRootConmon_listAttachSessions_Results(s *capnp.Segment) (Conmon_listAttachSessions_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_listAttachSessions_Results{st}, err
+}
+
+func ReadRootConmon_listAttachSessions_Results(msg *capnp.Message) (Conmon_listAttachSessions_Results, error) {
+	root, err := msg.Root()
+	return Conmon_listAttachSessions_Results{root.Struct()}, err
+}
+
+func (s Conmon_listAttachSessions_Results) String() string {
+	str, _ := text.Marshal(0xedd2e5b018f17bbb, s.Struct)
+	return str
+}
+
+func (s Conmon_listAttachSessions_Results) Response() (Conmon_ListAttachSessionsResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ListAttachSessionsResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_listAttachSessions_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_listAttachSessions_Results) SetResponse(v Conmon_ListAttachSessionsResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ListAttachSessionsResponse struct, preferring placement in s's segment.
+func (s Conmon_listAttachSessions_Results) NewResponse() (Conmon_ListAttachSessionsResponse, error) {
+	ss, err := NewConmon_ListAttachSessionsResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ListAttachSessionsResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_listAttachSessions_Results_List is a list of Conmon_listAttachSessions_Results.
+type Conmon_listAttachSessions_Results_List = capnp.StructList[Conmon_listAttachSessions_Results]
+
+// NewConmon_listAttachSessions_Results creates a new list of Conmon_listAttachSessions_Results.
+func NewConmon_listAttachSessions_Results_List(s *capnp.Segment, sz int32) (Conmon_listAttachSessions_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_listAttachSessions_Results]{l}, err
+}
+
+// Conmon_listAttachSessions_Results_Future is a wrapper for a Conmon_listAttachSessions_Results promised by a client call.
+type Conmon_listAttachSessions_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_listAttachSessions_Results_Future) Struct() (Conmon_listAttachSessions_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_listAttachSessions_Results{s}, err
+}
+
+func (p Conmon_listAttachSessions_Results_Future) Response() Conmon_ListAttachSessionsResponse_Future {
+	return Conmon_ListAttachSessionsResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_killAttachSession_Params struct{ capnp.Struct }
+
+// Conmon_killAttachSession_Params_TypeID is the unique identifier for the type Conmon_killAttachSession_Params.
+const Conmon_killAttachSession_Params_TypeID = 0x9d82529754851252
+
+func NewConmon_killAttachSession_Params(s *capnp.Segment) (Conmon_killAttachSession_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_killAttachSession_Params{st}, err
+}
+
+func NewRootConmon_killAttachSession_Params(s *capnp.Segment) (Conmon_killAttachSession_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_killAttachSession_Params{st}, err
+}
+
+func ReadRootConmon_killAttachSession_Params(msg *capnp.Message) (Conmon_killAttachSession_Params, error) {
+	root, err := msg.Root()
+	return Conmon_killAttachSession_Params{root.Struct()}, err
+}
+
+func (s Conmon_killAttachSession_Params) String() string {
+	str, _ := text.Marshal(0x9d82529754851252, s.Struct)
+	return str
+}
+
+func (s Conmon_killAttachSession_Params) Request() (Conmon_KillAttachSessionRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_KillAttachSessionRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_killAttachSession_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_killAttachSession_Params) SetRequest(v Conmon_KillAttachSessionRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_KillAttachSessionRequest struct, preferring placement in s's segment.
+func (s Conmon_killAttachSession_Params) NewRequest() (Conmon_KillAttachSessionRequest, error) {
+	ss, err := NewConmon_KillAttachSessionRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_KillAttachSessionRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_killAttachSession_Params_List is a list of Conmon_killAttachSession_Params.
+type Conmon_killAttachSession_Params_List = capnp.StructList[Conmon_killAttachSession_Params]
+
+// NewConmon_killAttachSession_Params creates a new list of Conmon_killAttachSession_Params.
+func NewConmon_killAttachSession_Params_List(s *capnp.Segment, sz int32) (Conmon_killAttachSession_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_killAttachSession_Params]{l}, err
+}
+
+// Conmon_killAttachSession_Params_Future is a wrapper for a Conmon_killAttachSession_Params promised by a client call.
+type Conmon_killAttachSession_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_killAttachSession_Params_Future) Struct() (Conmon_killAttachSession_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_killAttachSession_Params{s}, err
+}
+
+func (p Conmon_killAttachSession_Params_Future) Request() Conmon_KillAttachSessionRequest_Future {
+	return Conmon_KillAttachSessionRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_killAttachSession_Results struct{ capnp.Struct }
+
+// Conmon_killAttachSession_Results_TypeID is the unique identifier for the type Conmon_killAttachSession_Results.
+const Conmon_killAttachSession_Results_TypeID = 0xae5e0ae5001ebdfe
+
+func NewConmon_killAttachSession_Results(s *capnp.Segment) (Conmon_killAttachSession_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_killAttachSession_Results{st}, err
+}
+
+func NewRootConmon_killAttachSession_Results(s *capnp.Segment) (Conmon_killAttachSession_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_killAttachSession_Results{st}, err
+}
+
+func ReadRootConmon_killAttachSession_Results(msg *capnp.Message) (Conmon_killAttachSession_Results, error) {
+	root, err := msg.Root()
+	return Conmon_killAttachSession_Results{root.Struct()}, err
+}
+
+func (s Conmon_killAttachSession_Results) String() string {
+	str, _ := text.Marshal(0xae5e0ae5001ebdfe, s.Struct)
+	return str
+}
+
+func (s Conmon_killAttachSession_Results) Response() (Conmon_KillAttachSessionResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_KillAttachSessionResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_killAttachSession_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_killAttachSession_Results) SetResponse(v Conmon_KillAttachSessionResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_KillAttachSessionResponse struct, preferring placement in s's segment.
+func (s Conmon_killAttachSession_Results) NewResponse() (Conmon_KillAttachSessionResponse, error) {
+	ss, err := NewConmon_KillAttachSessionResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_KillAttachSessionResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_killAttachSession_Results_List is a list of Conmon_killAttachSession_Results.
+type Conmon_killAttachSession_Results_List = capnp.StructList[Conmon_killAttachSession_Results]
+
+// NewConmon_killAttachSession_Results creates a new list of Conmon_killAttachSession_Results.
+func NewConmon_killAttachSession_Results_List(s *capnp.Segment, sz int32) (Conmon_killAttachSession_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_killAttachSession_Results]{l}, err
+}
+
+// Conmon_killAttachSession_Results_Future is a wrapper for a Conmon_killAttachSession_Results promised by a client call.
+type Conmon_killAttachSession_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_killAttachSession_Results_Future) Struct() (Conmon_killAttachSession_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_killAttachSession_Results{s}, err
+}
+
+func (p Conmon_killAttachSession_Results_Future) Response() Conmon_KillAttachSessionResponse_Future {
+	return Conmon_KillAttachSessionResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_execContainer_Params struct{ capnp.Struct }
+
+// Conmon_execContainer_Params_TypeID is the unique identifier for the type Conmon_execContainer_Params.
+const Conmon_execContainer_Params_TypeID = 0xa6d76ce69f13a816
+
+func NewConmon_execContainer_Params(s *capnp.Segment) (Conmon_execContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execContainer_Params{st}, err
+}
+
+func NewRootConmon_execContainer_Params(s *capnp.Segment) (Conmon_execContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execContainer_Params{st}, err
+}
+
+func ReadRootConmon_execContainer_Params(msg *capnp.Message) (Conmon_execContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_execContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_execContainer_Params) String() string {
+	str, _ := text.Marshal(0xa6d76ce69f13a816, s.Struct)
+	return str
+}
+
+func (s Conmon_execContainer_Params) Request() (Conmon_ExecContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ExecContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_execContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_execContainer_Params) SetRequest(v Conmon_ExecContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ExecContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_execContainer_Params) NewRequest() (Conmon_ExecContainerRequest, error) {
+	ss, err := NewConmon_ExecContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ExecContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_execContainer_Params_List is a list of Conmon_execContainer_Params.
+type Conmon_execContainer_Params_List = capnp.StructList[Conmon_execContainer_Params]
+
+// NewConmon_execContainer_Params creates a new list of Conmon_execContainer_Params.
+func NewConmon_execContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_execContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_execContainer_Params]{l}, err
+}
+
+// Conmon_execContainer_Params_Future is a wrapper for a Conmon_execContainer_Params promised by a client call.
+type Conmon_execContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_execContainer_Params_Future) Struct() (Conmon_execContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_execContainer_Params{s}, err
+}
+
+func (p Conmon_execContainer_Params_Future) Request() Conmon_ExecContainerRequest_Future {
+	return Conmon_ExecContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_execContainer_Results struct{ capnp.Struct }
+
+// Conmon_execContainer_Results_TypeID is the unique identifier for the type Conmon_execContainer_Results.
+const Conmon_execContainer_Results_TypeID = 0xaaa69aebe451afba
+
+func NewConmon_execContainer_Results(s *capnp.Segment) (Conmon_execContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execContainer_Results{st}, err
+}
+
+func NewRootConmon_execContainer_Results(s *capnp.Segment) (Conmon_execContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_execContainer_Results{st}, err
+}
+
+func ReadRootConmon_execContainer_Results(msg *capnp.Message) (Conmon_execContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_execContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_execContainer_Results) String() string {
+	str, _ := text.Marshal(0xaaa69aebe451afba, s.Struct)
+	return str
+}
+
+func (s Conmon_execContainer_Results) Response() (Conmon_ExecContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ExecContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_execContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_execContainer_Results) SetResponse(v Conmon_ExecContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ExecContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_execContainer_Results) NewResponse() (Conmon_ExecContainerResponse, error) {
+	ss, err := NewConmon_ExecContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ExecContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_execContainer_Results_List is a list of Conmon_execContainer_Results.
+type Conmon_execContainer_Results_List = capnp.StructList[Conmon_execContainer_Results]
+
+// NewConmon_execContainer_Results creates a new list of Conmon_execContainer_Results.
+func NewConmon_execContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_execContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_execContainer_Results]{l}, err
+}
+
+// Conmon_execContainer_Results_Future is a wrapper for a Conmon_execContainer_Results promised by a client call.
+type Conmon_execContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_execContainer_Results_Future) Struct() (Conmon_execContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_execContainer_Results{s}, err
+}
+
+func (p Conmon_execContainer_Results_Future) Response() Conmon_ExecContainerResponse_Future {
+	return Conmon_ExecContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_withTenant_Params struct{ capnp.Struct }
+
+// Conmon_withTenant_Params_TypeID is the unique identifier for the type Conmon_withTenant_Params.
+const Conmon_withTenant_Params_TypeID = 0xe989fde14d6e82dd
+
+func NewConmon_withTenant_Params(s *capnp.Segment) (Conmon_withTenant_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_withTenant_Params{st}, err
+}
+
+func NewRootConmon_withTenant_Params(s *capnp.Segment) (Conmon_withTenant_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_withTenant_Params{st}, err
+}
+
+func ReadRootConmon_withTenant_Params(msg *capnp.Message) (Conmon_withTenant_Params, error) {
+	root, err := msg.Root()
+	return Conmon_withTenant_Params{root.Struct()}, err
+}
+
+func (s Conmon_withTenant_Params) String() string {
+	str, _ := text.Marshal(0xe989fde14d6e82dd, s.Struct)
+	return str
+}
+
+func (s Conmon_withTenant_Params) Request() (Conmon_WithTenantRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_WithTenantRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_withTenant_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_withTenant_Params) SetRequest(v Conmon_WithTenantRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_WithTenantRequest struct, preferring placement in s's segment.
+func (s Conmon_withTenant_Params) NewRequest() (Conmon_WithTenantRequest, error) {
+	ss, err := NewConmon_WithTenantRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_WithTenantRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_withTenant_Params_List is a list of Conmon_withTenant_Params.
+type Conmon_withTenant_Params_List = capnp.StructList[Conmon_withTenant_Params]
+
+// NewConmon_withTenant_Params creates a new list of Conmon_withTenant_Params.
+func NewConmon_withTenant_Params_List(s *capnp.Segment, sz int32) (Conmon_withTenant_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_withTenant_Params]{l}, err
+}
+
+// Conmon_withTenant_Params_Future is a wrapper for a Conmon_withTenant_Params promised by a client call.
+type Conmon_withTenant_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_withTenant_Params_Future) Struct() (Conmon_withTenant_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_withTenant_Params{s}, err
+}
+
+func (p Conmon_withTenant_Params_Future) Request() Conmon_WithTenantRequest_Future {
+	return Conmon_WithTenantRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_withTenant_Results struct{ capnp.Struct }
+
+// Conmon_withTenant_Results_TypeID is the unique identifier for the type Conmon_withTenant_Results.
+const Conmon_withTenant_Results_TypeID = 0x9488d71c49c86c29
+
+func NewConmon_withTenant_Results(s *capnp.Segment) (Conmon_withTenant_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_withTenant_Results{st}, err
+}
+
+func NewRootConmon_withTenant_Results(s *capnp.Segment) (Conmon_withTenant_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_withTenant_Results{st}, err
+}
+
+func ReadRootConmon_withTenant_Results(msg *capnp.Message) (Conmon_withTenant_Results, error) {
+	root, err := msg.Root()
+	return Conmon_withTenant_Results{root.Struct()}, err
+}
+
+func (s Conmon_withTenant_Results) String() string {
+	str, _ := text.Marshal(0x9488d71c49c86c29, s.Struct)
+	return str
+}
+
+func (s Conmon_withTenant_Results) Response() (Conmon_WithTenantResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_WithTenantResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_withTenant_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_withTenant_Results) SetResponse(v Conmon_WithTenantResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_WithTenantResponse struct, preferring placement in s's segment.
+func (s Conmon_withTenant_Results) NewResponse() (Conmon_WithTenantResponse, error) {
+	ss, err := NewConmon_WithTenantResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_WithTenantResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_withTenant_Results_List is a list of Conmon_withTenant_Results.
+type Conmon_withTenant_Results_List = capnp.StructList[Conmon_withTenant_Results]
+
+// NewConmon_withTenant_Results creates a new list of Conmon_withTenant_Results.
+func NewConmon_withTenant_Results_List(s *capnp.Segment, sz int32) (Conmon_withTenant_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_withTenant_Results]{l}, err
+}
+
+// Conmon_withTenant_Results_Future is a wrapper for a Conmon_withTenant_Results promised by a client call.
+type Conmon_withTenant_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_withTenant_Results_Future) Struct() (Conmon_withTenant_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_withTenant_Results{s}, err
+}
+
+func (p Conmon_withTenant_Results_Future) Response() Conmon_WithTenantResponse_Future {
+	return Conmon_WithTenantResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_portForwardContainer_Params struct{ capnp.Struct }
+
+// Conmon_portForwardContainer_Params_TypeID is the unique identifier for the type Conmon_portForwardContainer_Params.
+const Conmon_portForwardContainer_Params_TypeID = 0xad5e6e3b177fdffd
+
+func NewConmon_portForwardContainer_Params(s *capnp.Segment) (Conmon_portForwardContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_portForwardContainer_Params{st}, err
+}
+
+func NewRootConmon_portForwardContainer_Params(s *capnp.Segment) (Conmon_portForwardContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_portForwardContainer_Params{st}, err
+}
+
+func ReadRootConmon_portForwardContainer_Params(msg *capnp.Message) (Conmon_portForwardContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_portForwardContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_portForwardContainer_Params) String() string {
+	str, _ := text.Marshal(0xad5e6e3b177fdffd, s.Struct)
+	return str
+}
+
+func (s Conmon_portForwardContainer_Params) Request() (Conmon_PortForwardRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_PortForwardRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_portForwardContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_portForwardContainer_Params) SetRequest(v Conmon_PortForwardRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_PortForwardRequest struct, preferring placement in s's segment.
+func (s Conmon_portForwardContainer_Params) NewRequest() (Conmon_PortForwardRequest, error) {
+	ss, err := NewConmon_PortForwardRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_PortForwardRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_portForwardContainer_Params_List is a list of Conmon_portForwardContainer_Params.
+type Conmon_portForwardContainer_Params_List = capnp.StructList[Conmon_portForwardContainer_Params]
+
+// NewConmon_portForwardContainer_Params creates a new list of Conmon_portForwardContainer_Params.
+func NewConmon_portForwardContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_portForwardContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_portForwardContainer_Params]{l}, err
+}
+
+// Conmon_portForwardContainer_Params_Future is a wrapper for a Conmon_portForwardContainer_Params promised by a client call.
+type Conmon_portForwardContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_portForwardContainer_Params_Future) Struct() (Conmon_portForwardContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_portForwardContainer_Params{s}, err
+}
+
+func (p Conmon_portForwardContainer_Params_Future) Request() Conmon_PortForwardRequest_Future {
+	return Conmon_PortForwardRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_portForwardContainer_Results struct{ capnp.Struct }
+
+// Conmon_portForwardContainer_Results_TypeID is the unique identifier for the type Conmon_portForwardContainer_Results.
+const Conmon_portForwardContainer_Results_TypeID = 0xc9701dd28ecc4dec
+
+func NewConmon_portForwardContainer_Results(s *capnp.Segment) (Conmon_portForwardContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_portForwardContainer_Results{st}, err
+}
+
+func NewRootConmon_portForwardContainer_Results(s *capnp.Segment) (Conmon_portForwardContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_portForwardContainer_Results{st}, err
+}
+
+func ReadRootConmon_portForwardContainer_Results(msg *capnp.Message) (Conmon_portForwardContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_portForwardContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_portForwardContainer_Results) String() string {
+	str, _ := text.Marshal(0xc9701dd28ecc4dec, s.Struct)
+	return str
+}
+
+func (s Conmon_portForwardContainer_Results) Response() (Conmon_PortForwardResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_PortForwardResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_portForwardContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_portForwardContainer_Results) SetResponse(v Conmon_PortForwardResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_PortForwardResponse struct, preferring placement in s's segment.
+func (s Conmon_portForwardContainer_Results) NewResponse() (Conmon_PortForwardResponse, error) {
+	ss, err := NewConmon_PortForwardResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_PortForwardResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_portForwardContainer_Results_List is a list of Conmon_portForwardContainer_Results.
+type Conmon_portForwardContainer_Results_List = capnp.StructList[Conmon_portForwardContainer_Results]
+
+// NewConmon_portForwardContainer_Results creates a new list of Conmon_portForwardContainer_Results.
+func NewConmon_portForwardContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_portForwardContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_portForwardContainer_Results]{l}, err
+}
+
+// Conmon_portForwardContainer_Results_Future is a wrapper for a Conmon_portForwardContainer_Results promised by a client call.
+type Conmon_portForwardContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_portForwardContainer_Results_Future) Struct() (Conmon_portForwardContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_portForwardContainer_Results{s}, err
+}
+
+func (p Conmon_portForwardContainer_Results_Future) Response() Conmon_PortForwardResponse_Future {
+	return Conmon_PortForwardResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_containerStats_Params struct{ capnp.Struct }
+
+// Conmon_containerStats_Params_TypeID is the unique identifier for the type Conmon_containerStats_Params.
+const Conmon_containerStats_Params_TypeID = 0xe1d66f75234ae38a
+
+func NewConmon_containerStats_Params(s *capnp.Segment) (Conmon_containerStats_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerStats_Params{st}, err
+}
+
+func NewRootConmon_containerStats_Params(s *capnp.Segment) (Conmon_containerStats_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerStats_Params{st}, err
+}
+
+func ReadRootConmon_containerStats_Params(msg *capnp.Message) (Conmon_containerStats_Params, error) {
+	root, err := msg.Root()
+	return Conmon_containerStats_Params{root.Struct()}, err
+}
+
+func (s Conmon_containerStats_Params) String() string {
+	str, _ := text.Marshal(0xe1d66f75234ae38a, s.Struct)
+	return str
+}
+
+func (s Conmon_containerStats_Params) Request() (Conmon_ContainerStatsRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerStatsRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_containerStats_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_containerStats_Params) SetRequest(v Conmon_ContainerStatsRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ContainerStatsRequest struct, preferring placement in s's segment.
+func (s Conmon_containerStats_Params) NewRequest() (Conmon_ContainerStatsRequest, error) {
+	ss, err := NewConmon_ContainerStatsRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ContainerStatsRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_containerStats_Params_List is a list of Conmon_containerStats_Params.
+type Conmon_containerStats_Params_List = capnp.StructList[Conmon_containerStats_Params]
+
+// NewConmon_containerStats_Params creates a new list of Conmon_containerStats_Params.
+func NewConmon_containerStats_Params_List(s *capnp.Segment, sz int32) (Conmon_containerStats_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_containerStats_Params]{l}, err
+}
+
+// Conmon_containerStats_Params_Future is a wrapper for a Conmon_containerStats_Params promised by a client call.
+type Conmon_containerStats_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_containerStats_Params_Future) Struct() (Conmon_containerStats_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_containerStats_Params{s}, err
+}
+
+func (p Conmon_containerStats_Params_Future) Request() Conmon_ContainerStatsRequest_Future {
+	return Conmon_ContainerStatsRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_containerStats_Results struct{ capnp.Struct }
+
+// Conmon_containerStats_Results_TypeID is the unique identifier for the type Conmon_containerStats_Results.
+const Conmon_containerStats_Results_TypeID = 0xb34e262fa935335a
+
+func NewConmon_containerStats_Results(s *capnp.Segment) (Conmon_containerStats_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerStats_Results{st}, err
+}
+
+func NewRootConmon_containerStats_Results(s *capnp.Segment) (Conmon_containerStats_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_containerStats_Results{st}, err
+}
+
+func ReadRootConmon_containerStats_Results(msg *capnp.Message) (Conmon_containerStats_Results, error) {
+	root, err := msg.Root()
+	return Conmon_containerStats_Results{root.Struct()}, err
+}
+
+func (s Conmon_containerStats_Results) String() string {
+	str, _ := text.Marshal(0xb34e262fa935335a, s.Struct)
+	return str
+}
+
+func (s Conmon_containerStats_Results) Response() (Conmon_ContainerStatsResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ContainerStatsResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_containerStats_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_containerStats_Results) SetResponse(v Conmon_ContainerStatsResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ContainerStatsResponse struct, preferring placement in s's segment.
+func (s Conmon_containerStats_Results) NewResponse() (Conmon_ContainerStatsResponse, error) {
+	ss, err := NewConmon_ContainerStatsResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ContainerStatsResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_containerStats_Results_List is a list of Conmon_containerStats_Results.
+type Conmon_containerStats_Results_List = capnp.StructList[Conmon_containerStats_Results]
+
+// NewConmon_containerStats_Results creates a new list of Conmon_containerStats_Results.
+func NewConmon_containerStats_Results_List(s *capnp.Segment, sz int32) (Conmon_containerStats_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_containerStats_Results]{l}, err
+}
+
+// Conmon_containerStats_Results_Future is a wrapper for a Conmon_containerStats_Results promised by a client call.
+type Conmon_containerStats_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_containerStats_Results_Future) Struct() (Conmon_containerStats_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_containerStats_Results{s}, err
+}
+
+func (p Conmon_containerStats_Results_Future) Response() Conmon_ContainerStatsResponse_Future {
+	return Conmon_ContainerStatsResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_tenantQuota_Params struct{ capnp.Struct }
+
+// Conmon_tenantQuota_Params_TypeID is the unique identifier for the type Conmon_tenantQuota_Params.
+const Conmon_tenantQuota_Params_TypeID = 0xd2cb6549091ed7df
+
+func NewConmon_tenantQuota_Params(s *capnp.Segment) (Conmon_tenantQuota_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_tenantQuota_Params{st}, err
+}
+
+func NewRootConmon_tenantQuota_Params(s *capnp.Segment) (Conmon_tenantQuota_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_tenantQuota_Params{st}, err
+}
+
+func ReadRootConmon_tenantQuota_Params(msg *capnp.Message) (Conmon_tenantQuota_Params, error) {
+	root, err := msg.Root()
+	return Conmon_tenantQuota_Params{root.Struct()}, err
+}
+
+func (s Conmon_tenantQuota_Params) String() string {
+	str, _ := text.Marshal(0xd2cb6549091ed7df, s.Struct)
+	return str
+}
+
+func (s Conmon_tenantQuota_Params) Request() (Conmon_TenantQuotaRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_TenantQuotaRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_tenantQuota_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_tenantQuota_Params) SetRequest(v Conmon_TenantQuotaRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_TenantQuotaRequest struct, preferring placement in s's segment.
+func (s Conmon_tenantQuota_Params) NewRequest() (Conmon_TenantQuotaRequest, error) {
+	ss, err := NewConmon_TenantQuotaRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_TenantQuotaRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_tenantQuota_Params_List is a list of Conmon_tenantQuota_Params.
+type Conmon_tenantQuota_Params_List = capnp.StructList[Conmon_tenantQuota_Params]
+
+// NewConmon_tenantQuota_Params creates a new list of Conmon_tenantQuota_Params.
+func NewConmon_tenantQuota_Params_List(s *capnp.Segment, sz int32) (Conmon_tenantQuota_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_tenantQuota_Params]{l}, err
+}
+
+// Conmon_tenantQuota_Params_Future is a wrapper for a Conmon_tenantQuota_Params promised by a client call.
+type Conmon_tenantQuota_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_tenantQuota_Params_Future) Struct() (Conmon_tenantQuota_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_tenantQuota_Params{s}, err
+}
+
+func (p Conmon_tenantQuota_Params_Future) Request() Conmon_TenantQuotaRequest_Future {
+	return Conmon_TenantQuotaRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_tenantQuota_Results struct{ capnp.Struct }
+
+// Conmon_tenantQuota_Results_TypeID is the unique identifier for the type Conmon_tenantQuota_Results.
+const Conmon_tenantQuota_Results_TypeID = 0x97c2918f8d3765ca
+
+func NewConmon_tenantQuota_Results(s *capnp.Segment) (Conmon_tenantQuota_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_tenantQuota_Results{st}, err
+}
+
+func NewRootConmon_tenantQuota_Results(s *capnp.Segment) (Conmon_tenantQuota_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_tenantQuota_Results{st}, err
+}
+
+func ReadRootConmon_tenantQuota_Results(msg *capnp.Message) (Conmon_tenantQuota_Results, error) {
+	root, err := msg.Root()
+	return Conmon_tenantQuota_Results{root.Struct()}, err
+}
+
+func (s Conmon_tenantQuota_Results) String() string {
+	str, _ := text.Marshal(0x97c2918f8d3765ca, s.Struct)
+	return str
+}
+
+func (s Conmon_tenantQuota_Results) Response() (Conmon_TenantQuotaResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_TenantQuotaResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_tenantQuota_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_tenantQuota_Results) SetResponse(v Conmon_TenantQuotaResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_TenantQuotaResponse struct, preferring placement in s's segment.
+func (s Conmon_tenantQuota_Results) NewResponse() (Conmon_TenantQuotaResponse, error) {
+	ss, err := NewConmon_TenantQuotaResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_TenantQuotaResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_tenantQuota_Results_List is a list of Conmon_tenantQuota_Results.
+type Conmon_tenantQuota_Results_List = capnp.StructList[Conmon_tenantQuota_Results]
+
+// NewConmon_tenantQuota_Results creates a new list of Conmon_tenantQuota_Results.
+func NewConmon_tenantQuota_Results_List(s *capnp.Segment, sz int32) (Conmon_tenantQuota_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_tenantQuota_Results]{l}, err
+}
+
+// Conmon_tenantQuota_Results_Future is a wrapper for a Conmon_tenantQuota_Results promised by a client call.
+type Conmon_tenantQuota_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_tenantQuota_Results_Future) Struct() (Conmon_tenantQuota_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_tenantQuota_Results{s}, err
+}
+
+func (p Conmon_tenantQuota_Results_Future) Response() Conmon_TenantQuotaResponse_Future {
+	return Conmon_TenantQuotaResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_listContainers_Params struct{ capnp.Struct }
+
+// Conmon_listContainers_Params_TypeID is the unique identifier for the type Conmon_listContainers_Params.
+const Conmon_listContainers_Params_TypeID = 0xa01442f335a6cc00
+
+func NewConmon_listContainers_Params(s *capnp.Segment) (Conmon_listContainers_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_listContainers_Params{st}, err
+}
+
+func NewRootConmon_listContainers_Params(s *capnp.Segment) (Conmon_listContainers_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_listContainers_Params{st}, err
+}
+
+func ReadRootConmon_listContainers_Params(msg *capnp.Message) (Conmon_listContainers_Params, error) {
+	root, err := msg.Root()
+	return Conmon_listContainers_Params{root.Struct()}, err
+}
+
+func (s Conmon_listContainers_Params) String() string {
+	str, _ := text.Marshal(0xa01442f335a6cc00, s.Struct)
+	return str
+}
+
+func (s Conmon_listContainers_Params) Request() (Conmon_ListContainersRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ListContainersRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_listContainers_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_listContainers_Params) SetRequest(v Conmon_ListContainersRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ListContainersRequest struct, preferring placement in s's segment.
+func (s Conmon_listContainers_Params) NewRequest() (Conmon_ListContainersRequest, error) {
+	ss, err := NewConmon_ListContainersRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ListContainersRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_listContainers_Params_List is a list of Conmon_listContainers_Params.
+type Conmon_listContainers_Params_List = capnp.StructList[Conmon_listContainers_Params]
+
+// NewConmon_listContainers_Params creates a new list of Conmon_listContainers_Params.
+func NewConmon_listContainers_Params_List(s *capnp.Segment, sz int32) (Conmon_listContainers_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_listContainers_Params]{l}, err
+}
+
+// Conmon_listContainers_Params_Future is a wrapper for a Conmon_listContainers_Params promised by a client call.
+type Conmon_listContainers_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_listContainers_Params_Future) Struct() (Conmon_listContainers_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_listContainers_Params{s}, err
+}
+
+func (p Conmon_listContainers_Params_Future) Request() Conmon_ListContainersRequest_Future {
+	return Conmon_ListContainersRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_listContainers_Results struct{ capnp.Struct }
+
+// Conmon_listContainers_Results_TypeID is the unique identifier for the type Conmon_listContainers_Results.
+const Conmon_listContainers_Results_TypeID = 0xa85a62dd95c50d7f
+
+func NewConmon_listContainers_Results(s *capnp.Segment) (Conmon_listContainers_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_listContainers_Results{st}, err
+}
+
+func NewRootConmon_listContainers_Results(s *capnp.Segment) (Conmon_listContainers_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_listContainers_Results{st}, err
+}
+
+func ReadRootConmon_listContainers_Results(msg *capnp.Message) (Conmon_listContainers_Results, error) {
+	root, err := msg.Root()
+	return Conmon_listContainers_Results{root.Struct()}, err
+}
+
+func (s Conmon_listContainers_Results) String() string {
+	str, _ := text.Marshal(0xa85a62dd95c50d7f, s.Struct)
+	return str
+}
+
+func (s Conmon_listContainers_Results) Response() (Conmon_ListContainersResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ListContainersResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_listContainers_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_listContainers_Results) SetResponse(v Conmon_ListContainersResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ListContainersResponse struct, preferring placement in s's segment.
+func (s Conmon_listContainers_Results) NewResponse() (Conmon_ListContainersResponse, error) {
+	ss, err := NewConmon_ListContainersResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ListContainersResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_listContainers_Results_List is a list of Conmon_listContainers_Results.
+type Conmon_listContainers_Results_List = capnp.StructList[Conmon_listContainers_Results]
+
+// NewConmon_listContainers_Results creates a new list of Conmon_listContainers_Results.
+func NewConmon_listContainers_Results_List(s *capnp.Segment, sz int32) (Conmon_listContainers_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_listContainers_Results]{l}, err
+}
+
+// Conmon_listContainers_Results_Future is a wrapper for a Conmon_listContainers_Results promised by a client call.
+type Conmon_listContainers_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_listContainers_Results_Future) Struct() (Conmon_listContainers_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_listContainers_Results{s}, err
+}
+
+func (p Conmon_listContainers_Results_Future) Response() Conmon_ListContainersResponse_Future {
+	return Conmon_ListContainersResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_stopContainer_Params struct{ capnp.Struct }
+
+// Conmon_stopContainer_Params_TypeID is the unique identifier for the type Conmon_stopContainer_Params.
+const Conmon_stopContainer_Params_TypeID = 0xdfca9ee49ebf0d98
+
+func NewConmon_stopContainer_Params(s *capnp.Segment) (Conmon_stopContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_stopContainer_Params{st}, err
+}
+
+func NewRootConmon_stopContainer_Params(s *capnp.Segment) (Conmon_stopContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_stopContainer_Params{st}, err
+}
+
+func ReadRootConmon_stopContainer_Params(msg *capnp.Message) (Conmon_stopContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_stopContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_stopContainer_Params) String() string {
+	str, _ := text.Marshal(0xdfca9ee49ebf0d98, s.Struct)
+	return str
+}
+
+func (s Conmon_stopContainer_Params) Request() (Conmon_StopContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_StopContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_stopContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_stopContainer_Params) SetRequest(v Conmon_StopContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_StopContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_stopContainer_Params) NewRequest() (Conmon_StopContainerRequest, error) {
+	ss, err := NewConmon_StopContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_StopContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_stopContainer_Params_List is a list of Conmon_stopContainer_Params.
+type Conmon_stopContainer_Params_List = capnp.StructList[Conmon_stopContainer_Params]
+
+// NewConmon_stopContainer_Params creates a new list of Conmon_stopContainer_Params.
+func NewConmon_stopContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_stopContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_stopContainer_Params]{l}, err
+}
+
+// Conmon_stopContainer_Params_Future is a wrapper for a Conmon_stopContainer_Params promised by a client call.
+type Conmon_stopContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_stopContainer_Params_Future) Struct() (Conmon_stopContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_stopContainer_Params{s}, err
+}
+
+func (p Conmon_stopContainer_Params_Future) Request() Conmon_StopContainerRequest_Future {
+	return Conmon_StopContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_stopContainer_Results struct{ capnp.Struct }
+
+// Conmon_stopContainer_Results_TypeID is the unique identifier for the type Conmon_stopContainer_Results.
+const Conmon_stopContainer_Results_TypeID = 0x968709e5ac646fae
+
+func NewConmon_stopContainer_Results(s *capnp.Segment) (Conmon_stopContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_stopContainer_Results{st}, err
+}
+
+func NewRootConmon_stopContainer_Results(s *capnp.Segment) (Conmon_stopContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_stopContainer_Results{st}, err
+}
+
+func ReadRootConmon_stopContainer_Results(msg *capnp.Message) (Conmon_stopContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_stopContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_stopContainer_Results) String() string {
+	str, _ := text.Marshal(0x968709e5ac646fae, s.Struct)
+	return str
+}
+
+func (s Conmon_stopContainer_Results) Response() (Conmon_StopContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_StopContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_stopContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_stopContainer_Results) SetResponse(v Conmon_StopContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_StopContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_stopContainer_Results) NewResponse() (Conmon_StopContainerResponse, error) {
+	ss, err := NewConmon_StopContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_StopContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_stopContainer_Results_List is a list of Conmon_stopContainer_Results.
+type Conmon_stopContainer_Results_List = capnp.StructList[Conmon_stopContainer_Results]
+
+// NewConmon_stopContainer_Results creates a new list of Conmon_stopContainer_Results.
+func NewConmon_stopContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_stopContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_stopContainer_Results]{l}, err
+}
+
+// Conmon_stopContainer_Results_Future is a wrapper for a Conmon_stopContainer_Results promised by a client call.
+type Conmon_stopContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_stopContainer_Results_Future) Struct() (Conmon_stopContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_stopContainer_Results{s}, err
+}
+
+func (p Conmon_stopContainer_Results_Future) Response() Conmon_StopContainerResponse_Future {
+	return Conmon_StopContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_watchContainerEvents_Params struct{ capnp.Struct }
+
+// Conmon_watchContainerEvents_Params_TypeID is the unique identifier for the type Conmon_watchContainerEvents_Params.
+const Conmon_watchContainerEvents_Params_TypeID = 0xf18bd11dcac0404b
+
+func NewConmon_watchContainerEvents_Params(s *capnp.Segment) (Conmon_watchContainerEvents_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchContainerEvents_Params{st}, err
+}
+
+func NewRootConmon_watchContainerEvents_Params(s *capnp.Segment) (Conmon_watchContainerEvents_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchContainerEvents_Params{st}, err
+}
+
+func ReadRootConmon_watchContainerEvents_Params(msg *capnp.Message) (Conmon_watchContainerEvents_Params, error) {
+	root, err := msg.Root()
+	return Conmon_watchContainerEvents_Params{root.Struct()}, err
+}
+
+func (s Conmon_watchContainerEvents_Params) String() string {
+	str, _ := text.Marshal(0xf18bd11dcac0404b, s.Struct)
+	return str
+}
+
+func (s Conmon_watchContainerEvents_Params) Request() (Conmon_WatchContainerEventsRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_WatchContainerEventsRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_watchContainerEvents_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_watchContainerEvents_Params) SetRequest(v Conmon_WatchContainerEventsRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_WatchContainerEventsRequest struct, preferring placement in s's segment.
+func (s Conmon_watchContainerEvents_Params) NewRequest() (Conmon_WatchContainerEventsRequest, error) {
+	ss, err := NewConmon_WatchContainerEventsRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_WatchContainerEventsRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_watchContainerEvents_Params_List is a list of Conmon_watchContainerEvents_Params.
+type Conmon_watchContainerEvents_Params_List = capnp.StructList[Conmon_watchContainerEvents_Params]
+
+// NewConmon_watchContainerEvents_Params creates a new list of Conmon_watchContainerEvents_Params.
+func NewConmon_watchContainerEvents_Params_List(s *capnp.Segment, sz int32) (Conmon_watchContainerEvents_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_watchContainerEvents_Params]{l}, err
+}
+
+// Conmon_watchContainerEvents_Params_Future is a wrapper for a Conmon_watchContainerEvents_Params promised by a client call.
+type Conmon_watchContainerEvents_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_watchContainerEvents_Params_Future) Struct() (Conmon_watchContainerEvents_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_watchContainerEvents_Params{s}, err
+}
+
+func (p Conmon_watchContainerEvents_Params_Future) Request() Conmon_WatchContainerEventsRequest_Future {
+	return Conmon_WatchContainerEventsRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_watchContainerEvents_Results struct{ capnp.Struct }
+
+// Conmon_watchContainerEvents_Results_TypeID is the unique identifier for the type Conmon_watchContainerEvents_Results.
+const Conmon_watchContainerEvents_Results_TypeID = 0xa199c5435b00304a
+
+func NewConmon_watchContainerEvents_Results(s *capnp.Segment) (Conmon_watchContainerEvents_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchContainerEvents_Results{st}, err
+}
+
+func NewRootConmon_watchContainerEvents_Results(s *capnp.Segment) (Conmon_watchContainerEvents_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_watchContainerEvents_Results{st}, err
+}
+
+func ReadRootConmon_watchContainerEvents_Results(msg *capnp.Message) (Conmon_watchContainerEvents_Results, error) {
+	root, err := msg.Root()
+	return Conmon_watchContainerEvents_Results{root.Struct()}, err
+}
+
+func (s Conmon_watchContainerEvents_Results) String() string {
+	str, _ := text.Marshal(0xa199c5435b00304a, s.Struct)
+	return str
+}
+
+func (s Conmon_watchContainerEvents_Results) Response() (Conmon_WatchContainerEventsResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_WatchContainerEventsResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_watchContainerEvents_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_watchContainerEvents_Results) SetResponse(v Conmon_WatchContainerEventsResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_WatchContainerEventsResponse struct, preferring placement in s's segment.
+func (s Conmon_watchContainerEvents_Results) NewResponse() (Conmon_WatchContainerEventsResponse, error) {
+	ss, err := NewConmon_WatchContainerEventsResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_WatchContainerEventsResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_watchContainerEvents_Results_List is a list of Conmon_watchContainerEvents_Results.
+type Conmon_watchContainerEvents_Results_List = capnp.StructList[Conmon_watchContainerEvents_Results]
+
+// NewConmon_watchContainerEvents_Results creates a new list of Conmon_watchContainerEvents_Results.
+func NewConmon_watchContainerEvents_Results_List(s *capnp.Segment, sz int32) (Conmon_watchContainerEvents_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_watchContainerEvents_Results]{l}, err
+}
+
+// Conmon_watchContainerEvents_Results_Future is a wrapper for a Conmon_watchContainerEvents_Results promised by a client call.
+type Conmon_watchContainerEvents_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_watchContainerEvents_Results_Future) Struct() (Conmon_watchContainerEvents_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_watchContainerEvents_Results{s}, err
+}
+
+func (p Conmon_watchContainerEvents_Results_Future) Response() Conmon_WatchContainerEventsResponse_Future {
+	return Conmon_WatchContainerEventsResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_supportBundle_Params struct{ capnp.Struct }
+
+// Conmon_supportBundle_Params_TypeID is the unique identifier for the type Conmon_supportBundle_Params.
+const Conmon_supportBundle_Params_TypeID = 0x8ceb3503d8b127df
+
+func NewConmon_supportBundle_Params(s *capnp.Segment) (Conmon_supportBundle_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_supportBundle_Params{st}, err
+}
+
+func NewRootConmon_supportBundle_Params(s *capnp.Segment) (Conmon_supportBundle_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_supportBundle_Params{st}, err
+}
+
+func ReadRootConmon_supportBundle_Params(msg *capnp.Message) (Conmon_supportBundle_Params, error) {
+	root, err := msg.Root()
+	return Conmon_supportBundle_Params{root.Struct()}, err
+}
+
+func (s Conmon_supportBundle_Params) String() string {
+	str, _ := text.Marshal(0x8ceb3503d8b127df, s.Struct)
+	return str
+}
+
+func (s Conmon_supportBundle_Params) Request() (Conmon_SupportBundleRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SupportBundleRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_supportBundle_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_supportBundle_Params) SetRequest(v Conmon_SupportBundleRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_SupportBundleRequest struct, preferring placement in s's segment.
+func (s Conmon_supportBundle_Params) NewRequest() (Conmon_SupportBundleRequest, error) {
+	ss, err := NewConmon_SupportBundleRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SupportBundleRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_supportBundle_Params_List is a list of Conmon_supportBundle_Params.
+type Conmon_supportBundle_Params_List = capnp.StructList[Conmon_supportBundle_Params]
+
+// NewConmon_supportBundle_Params creates a new list of Conmon_supportBundle_Params.
+func NewConmon_supportBundle_Params_List(s *capnp.Segment, sz int32) (Conmon_supportBundle_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_supportBundle_Params]{l}, err
+}
+
+// Conmon_supportBundle_Params_Future is a wrapper for a Conmon_supportBundle_Params promised by a client call.
+type Conmon_supportBundle_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_supportBundle_Params_Future) Struct() (Conmon_supportBundle_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_supportBundle_Params{s}, err
+}
+
+func (p Conmon_supportBundle_Params_Future) Request() Conmon_SupportBundleRequest_Future {
+	return Conmon_SupportBundleRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_supportBundle_Results struct{ capnp.Struct }
+
+// Conmon_supportBundle_Results_TypeID is the unique identifier for the type Conmon_supportBundle_Results.
+const Conmon_supportBundle_Results_TypeID = 0xfaf066b0dfd2c1d5
+
+func NewConmon_supportBundle_Results(s *capnp.Segment) (Conmon_supportBundle_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_supportBundle_Results{st}, err
+}
+
+func NewRootConmon_supportBundle_Results(s *capnp.Segment) (Conmon_supportBundle_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_supportBundle_Results{st}, err
+}
+
+func ReadRootConmon_supportBundle_Results(msg *capnp.Message) (Conmon_supportBundle_Results, error) {
+	root, err := msg.Root()
+	return Conmon_supportBundle_Results{root.Struct()}, err
+}
+
+func (s Conmon_supportBundle_Results) String() string {
+	str, _ := text.Marshal(0xfaf066b0dfd2c1d5, s.Struct)
+	return str
+}
+
+func (s Conmon_supportBundle_Results) Response() (Conmon_SupportBundleResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SupportBundleResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_supportBundle_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_supportBundle_Results) SetResponse(v Conmon_SupportBundleResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_SupportBundleResponse struct, preferring placement in s's segment.
+func (s Conmon_supportBundle_Results) NewResponse() (Conmon_SupportBundleResponse, error) {
+	ss, err := NewConmon_SupportBundleResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SupportBundleResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_supportBundle_Results_List is a list of Conmon_supportBundle_Results.
+type Conmon_supportBundle_Results_List = capnp.StructList[Conmon_supportBundle_Results]
+
+// NewConmon_supportBundle_Results creates a new list of Conmon_supportBundle_Results.
+func NewConmon_supportBundle_Results_List(s *capnp.Segment, sz int32) (Conmon_supportBundle_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_supportBundle_Results]{l}, err
+}
+
+// Conmon_supportBundle_Results_Future is a wrapper for a Conmon_supportBundle_Results promised by a client call.
+type Conmon_supportBundle_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_supportBundle_Results_Future) Struct() (Conmon_supportBundle_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_supportBundle_Results{s}, err
+}
+
+func (p Conmon_supportBundle_Results_Future) Response() Conmon_SupportBundleResponse_Future {
+	return Conmon_SupportBundleResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_waitContainer_Params struct{ capnp.Struct }
+
+// Conmon_waitContainer_Params_TypeID is the unique identifier for the type Conmon_waitContainer_Params.
+const Conmon_waitContainer_Params_TypeID = 0x88a7c20d48426128
+
+func NewConmon_waitContainer_Params(s *capnp.Segment) (Conmon_waitContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_waitContainer_Params{st}, err
+}
+
+func NewRootConmon_waitContainer_Params(s *capnp.Segment) (Conmon_waitContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_waitContainer_Params{st}, err
+}
+
+func ReadRootConmon_waitContainer_Params(msg *capnp.Message) (Conmon_waitContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_waitContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_waitContainer_Params) String() string {
+	str, _ := text.Marshal(0x88a7c20d48426128, s.Struct)
+	return str
+}
+
+func (s Conmon_waitContainer_Params) Request() (Conmon_WaitContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_WaitContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_waitContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_waitContainer_Params) SetRequest(v Conmon_WaitContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_WaitContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_waitContainer_Params) NewRequest() (Conmon_WaitContainerRequest, error) {
+	ss, err := NewConmon_WaitContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_WaitContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_waitContainer_Params_List is a list of Conmon_waitContainer_Params.
+type Conmon_waitContainer_Params_List = capnp.StructList[Conmon_waitContainer_Params]
+
+// NewConmon_waitContainer_Params creates a new list of Conmon_waitContainer_Params.
+func NewConmon_waitContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_waitContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_waitContainer_Params]{l}, err
+}
+
+// Conmon_waitContainer_Params_Future is a wrapper for a Conmon_waitContainer_Params promised by a client call.
+type Conmon_waitContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_waitContainer_Params_Future) Struct() (Conmon_waitContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_waitContainer_Params{s}, err
+}
+
+func (p Conmon_waitContainer_Params_Future) Request() Conmon_WaitContainerRequest_Future {
+	return Conmon_WaitContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_waitContainer_Results struct{ capnp.Struct }
+
+// Conmon_waitContainer_Results_TypeID is the unique identifier for the type Conmon_waitContainer_Results.
+const Conmon_waitContainer_Results_TypeID = 0xbe34f78f6a935b18
+
+func NewConmon_waitContainer_Results(s *capnp.Segment) (Conmon_waitContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_waitContainer_Results{st}, err
+}
+
+func NewRootConmon_waitContainer_Results(s *capnp.Segment) (Conmon_waitContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_waitContainer_Results{st}, err
+}
+
+func ReadRootConmon_waitContainer_Results(msg *capnp.Message) (Conmon_waitContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_waitContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_waitContainer_Results) String() string {
+	str, _ := text.Marshal(0xbe34f78f6a935b18, s.Struct)
+	return str
+}
+
+func (s Conmon_waitContainer_Results) Response() (Conmon_WaitContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_WaitContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_waitContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_waitContainer_Results) SetResponse(v Conmon_WaitContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_WaitContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_waitContainer_Results) NewResponse() (Conmon_WaitContainerResponse, error) {
+	ss, err := NewConmon_WaitContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_WaitContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_waitContainer_Results_List is a list of Conmon_waitContainer_Results.
+type Conmon_waitContainer_Results_List = capnp.StructList[Conmon_waitContainer_Results]
+
+// NewConmon_waitContainer_Results creates a new list of Conmon_waitContainer_Results.
+func NewConmon_waitContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_waitContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_waitContainer_Results]{l}, err
+}
+
+// Conmon_waitContainer_Results_Future is a wrapper for a Conmon_waitContainer_Results promised by a client call.
+type Conmon_waitContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_waitContainer_Results_Future) Struct() (Conmon_waitContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_waitContainer_Results{s}, err
+}
+
+func (p Conmon_waitContainer_Results_Future) Response() Conmon_WaitContainerResponse_Future {
+	return Conmon_WaitContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_setWatchdog_Params struct{ capnp.Struct }
+
+// Conmon_setWatchdog_Params_TypeID is the unique identifier for the type Conmon_setWatchdog_Params.
+const Conmon_setWatchdog_Params_TypeID = 0xfd592f0d89b7b928
+
+func NewConmon_setWatchdog_Params(s *capnp.Segment) (Conmon_setWatchdog_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWatchdog_Params{st}, err
+}
+
+func NewRootConmon_setWatchdog_Params(s *capnp.Segment) (Conmon_setWatchdog_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWatchdog_Params{st}, err
+}
+
+func ReadRootConmon_setWatchdog_Params(msg *capnp.Message) (Conmon_setWatchdog_Params, error) {
+	root, err := msg.Root()
+	return Conmon_setWatchdog_Params{root.Struct()}, err
+}
+
+func (s Conmon_setWatchdog_Params) String() string {
+	str, _ := text.Marshal(0xfd592f0d89b7b928, s.Struct)
+	return str
+}
+
+func (s Conmon_setWatchdog_Params) Request() (Conmon_SetWatchdogRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SetWatchdogRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_setWatchdog_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_setWatchdog_Params) SetRequest(v Conmon_SetWatchdogRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_SetWatchdogRequest struct, preferring placement in s's segment.
+func (s Conmon_setWatchdog_Params) NewRequest() (Conmon_SetWatchdogRequest, error) {
+	ss, err := NewConmon_SetWatchdogRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SetWatchdogRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_setWatchdog_Params_List is a list of Conmon_setWatchdog_Params.
+type Conmon_setWatchdog_Params_List = capnp.StructList[Conmon_setWatchdog_Params]
+
+// NewConmon_setWatchdog_Params creates a new list of Conmon_setWatchdog_Params.
+func NewConmon_setWatchdog_Params_List(s *capnp.Segment, sz int32) (Conmon_setWatchdog_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_setWatchdog_Params]{l}, err
+}
+
+// Conmon_setWatchdog_Params_Future is a wrapper for a Conmon_setWatchdog_Params promised by a client call.
+type Conmon_setWatchdog_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_setWatchdog_Params_Future) Struct() (Conmon_setWatchdog_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_setWatchdog_Params{s}, err
+}
+
+func (p Conmon_setWatchdog_Params_Future) Request() Conmon_SetWatchdogRequest_Future {
+	return Conmon_SetWatchdogRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_setWatchdog_Results struct{ capnp.Struct }
+
+// Conmon_setWatchdog_Results_TypeID is the unique identifier for the type Conmon_setWatchdog_Results.
+const Conmon_setWatchdog_Results_TypeID = 0xa8757cef51f9fba2
+
+func NewConmon_setWatchdog_Results(s *capnp.Segment) (Conmon_setWatchdog_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWatchdog_Results{st}, err
+}
+
+func NewRootConmon_setWatchdog_Results(s *capnp.Segment) (Conmon_setWatchdog_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setWatchdog_Results{st}, err
+}
+
+func ReadRootConmon_setWatchdog_Results(msg *capnp.Message) (Conmon_setWatchdog_Results, error) {
+	root, err := msg.Root()
+	return Conmon_setWatchdog_Results{root.Struct()}, err
+}
+
+func (s Conmon_setWatchdog_Results) String() string {
+	str, _ := text.Marshal(0xa8757cef51f9fba2, s.Struct)
+	return str
+}
+
+func (s Conmon_setWatchdog_Results) Response() (Conmon_SetWatchdogResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SetWatchdogResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_setWatchdog_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_setWatchdog_Results) SetResponse(v Conmon_SetWatchdogResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_SetWatchdogResponse struct, preferring placement in s's segment.
+func (s Conmon_setWatchdog_Results) NewResponse() (Conmon_SetWatchdogResponse, error) {
+	ss, err := NewConmon_SetWatchdogResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SetWatchdogResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_setWatchdog_Results_List is a list of Conmon_setWatchdog_Results.
+type Conmon_setWatchdog_Results_List = capnp.StructList[Conmon_setWatchdog_Results]
+
+// NewConmon_setWatchdog_Results creates a new list of Conmon_setWatchdog_Results.
+func NewConmon_setWatchdog_Results_List(s *capnp.Segment, sz int32) (Conmon_setWatchdog_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_setWatchdog_Results]{l}, err
+}
+
+// Conmon_setWatchdog_Results_Future is a wrapper for a Conmon_setWatchdog_Results promised by a client call.
+type Conmon_setWatchdog_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_setWatchdog_Results_Future) Struct() (Conmon_setWatchdog_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_setWatchdog_Results{s}, err
+}
+
+func (p Conmon_setWatchdog_Results_Future) Response() Conmon_SetWatchdogResponse_Future {
+	return Conmon_SetWatchdogResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_heartbeat_Params struct{ capnp.Struct }
+
+// Conmon_heartbeat_Params_TypeID is the unique identifier for the type Conmon_heartbeat_Params.
+const Conmon_heartbeat_Params_TypeID = 0x9a5dadc3cb5eb5a1
+
+func NewConmon_heartbeat_Params(s *capnp.Segment) (Conmon_heartbeat_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_heartbeat_Params{st}, err
+}
+
+func NewRootConmon_heartbeat_Params(s *capnp.Segment) (Conmon_heartbeat_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_heartbeat_Params{st}, err
+}
+
+func ReadRootConmon_heartbeat_Params(msg *capnp.Message) (Conmon_heartbeat_Params, error) {
+	root, err := msg.Root()
+	return Conmon_heartbeat_Params{root.Struct()}, err
+}
+
+func (s Conmon_heartbeat_Params) String() string {
+	str, _ := text.Marshal(0x9a5dadc3cb5eb5a1, s.Struct)
+	return str
+}
+
+func (s Conmon_heartbeat_Params) Request() (Conmon_HeartbeatRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_HeartbeatRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_heartbeat_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_heartbeat_Params) SetRequest(v Conmon_HeartbeatRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_HeartbeatRequest struct, preferring placement in s's segment.
+func (s Conmon_heartbeat_Params) NewRequest() (Conmon_HeartbeatRequest, error) {
+	ss, err := NewConmon_HeartbeatRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_HeartbeatRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_heartbeat_Params_List is a list of Conmon_heartbeat_Params.
+type Conmon_heartbeat_Params_List = capnp.StructList[Conmon_heartbeat_Params]
+
+// NewConmon_heartbeat_Params creates a new list of Conmon_heartbeat_Params.
+func NewConmon_heartbeat_Params_List(s *capnp.Segment, sz int32) (Conmon_heartbeat_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_heartbeat_Params]{l}, err
+}
+
+// Conmon_heartbeat_Params_Future is a wrapper for a Conmon_heartbeat_Params promised by a client call.
+type Conmon_heartbeat_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_heartbeat_Params_Future) Struct() (Conmon_heartbeat_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_heartbeat_Params{s}, err
+}
+
+func (p Conmon_heartbeat_Params_Future) Request() Conmon_HeartbeatRequest_Future {
+	return Conmon_HeartbeatRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_heartbeat_Results struct{ capnp.Struct }
+
+// Conmon_heartbeat_Results_TypeID is the unique identifier for the type Conmon_heartbeat_Results.
+const Conmon_heartbeat_Results_TypeID = 0xe3cc22436fc42c31
+
+func NewConmon_heartbeat_Results(s *capnp.Segment) (Conmon_heartbeat_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_heartbeat_Results{st}, err
+}
+
+func NewRootConmon_heartbeat_Results(s *capnp.Segment) (Conmon_heartbeat_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_heartbeat_Results{st}, err
+}
+
+func ReadRootConmon_heartbeat_Results(msg *capnp.Message) (Conmon_heartbeat_Results, error) {
+	root, err := msg.Root()
+	return Conmon_heartbeat_Results{root.Struct()}, err
+}
+
+func (s Conmon_heartbeat_Results) String() string {
+	str, _ := text.Marshal(0xe3cc22436fc42c31, s.Struct)
+	return str
+}
+
+func (s Conmon_heartbeat_Results) Response() (Conmon_HeartbeatResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_HeartbeatResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_heartbeat_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_heartbeat_Results) SetResponse(v Conmon_HeartbeatResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_HeartbeatResponse struct, preferring placement in s's segment.
+func (s Conmon_heartbeat_Results) NewResponse() (Conmon_HeartbeatResponse, error) {
+	ss, err := NewConmon_HeartbeatResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_HeartbeatResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_heartbeat_Results_List is a list of Conmon_heartbeat_Results.
+type Conmon_heartbeat_Results_List = capnp.StructList[Conmon_heartbeat_Results]
+
+// NewConmon_heartbeat_Results creates a new list of Conmon_heartbeat_Results.
+func NewConmon_heartbeat_Results_List(s *capnp.Segment, sz int32) (Conmon_heartbeat_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_heartbeat_Results]{l}, err
+}
+
+// Conmon_heartbeat_Results_Future is a wrapper for a Conmon_heartbeat_Results promised by a client call.
+type Conmon_heartbeat_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_heartbeat_Results_Future) Struct() (Conmon_heartbeat_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_heartbeat_Results{s}, err
+}
+
+func (p Conmon_heartbeat_Results_Future) Response() Conmon_HeartbeatResponse_Future {
+	return Conmon_HeartbeatResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_rotateLogContainer_Params struct{ capnp.Struct }
+
+// Conmon_rotateLogContainer_Params_TypeID is the unique identifier for the type Conmon_rotateLogContainer_Params.
+const Conmon_rotateLogContainer_Params_TypeID = 0xe5adba5696f0c278
+
+func NewConmon_rotateLogContainer_Params(s *capnp.Segment) (Conmon_rotateLogContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_rotateLogContainer_Params{st}, err
+}
+
+func NewRootConmon_rotateLogContainer_Params(s *capnp.Segment) (Conmon_rotateLogContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_rotateLogContainer_Params{st}, err
+}
+
+func ReadRootConmon_rotateLogContainer_Params(msg *capnp.Message) (Conmon_rotateLogContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_rotateLogContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_rotateLogContainer_Params) String() string {
+	str, _ := text.Marshal(0xe5adba5696f0c278, s.Struct)
+	return str
+}
+
+func (s Conmon_rotateLogContainer_Params) Request() (Conmon_RotateLogRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_RotateLogRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_rotateLogContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_rotateLogContainer_Params) SetRequest(v Conmon_RotateLogRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_RotateLogRequest struct, preferring placement in s's segment.
+func (s Conmon_rotateLogContainer_Params) NewRequest() (Conmon_RotateLogRequest, error) {
+	ss, err := NewConmon_RotateLogRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_RotateLogRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_rotateLogContainer_Params_List is a list of Conmon_rotateLogContainer_Params.
+type Conmon_rotateLogContainer_Params_List = capnp.StructList[Conmon_rotateLogContainer_Params]
+
+// NewConmon_rotateLogContainer_Params creates a new list of Conmon_rotateLogContainer_Params.
+func NewConmon_rotateLogContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_rotateLogContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_rotateLogContainer_Params]{l}, err
+}
+
+// Conmon_rotateLogContainer_Params_Future is a wrapper for a Conmon_rotateLogContainer_Params promised by a client call.
+type Conmon_rotateLogContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_rotateLogContainer_Params_Future) Struct() (Conmon_rotateLogContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_rotateLogContainer_Params{s}, err
+}
+
+func (p Conmon_rotateLogContainer_Params_Future) Request() Conmon_RotateLogRequest_Future {
+	return Conmon_RotateLogRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_rotateLogContainer_Results struct{ capnp.Struct }
+
+// Conmon_rotateLogContainer_Results_TypeID is the unique identifier for the type Conmon_rotateLogContainer_Results.
+const Conmon_rotateLogContainer_Results_TypeID = 0x86b1a5ed2ee3fe0a
+
+func NewConmon_rotateLogContainer_Results(s *capnp.Segment) (Conmon_rotateLogContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_rotateLogContainer_Results{st}, err
+}
+
+func NewRootConmon_rotateLogContainer_Results(s *capnp.Segment) (Conmon_rotateLogContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_rotateLogContainer_Results{st}, err
+}
+
+func ReadRootConmon_rotateLogContainer_Results(msg *capnp.Message) (Conmon_rotateLogContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_rotateLogContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_rotateLogContainer_Results) String() string {
+	str, _ := text.Marshal(0x86b1a5ed2ee3fe0a, s.Struct)
+	return str
+}
+
+func (s Conmon_rotateLogContainer_Results) Response() (Conmon_RotateLogResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_RotateLogResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_rotateLogContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_rotateLogContainer_Results) SetResponse(v Conmon_RotateLogResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_RotateLogResponse struct, preferring placement in s's segment.
+func (s Conmon_rotateLogContainer_Results) NewResponse() (Conmon_RotateLogResponse, error) {
+	ss, err := NewConmon_RotateLogResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_RotateLogResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_rotateLogContainer_Results_List is a list of Conmon_rotateLogContainer_Results.
+type Conmon_rotateLogContainer_Results_List = capnp.StructList[Conmon_rotateLogContainer_Results]
+
+// NewConmon_rotateLogContainer_Results creates a new list of Conmon_rotateLogContainer_Results.
+func NewConmon_rotateLogContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_rotateLogContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_rotateLogContainer_Results]{l}, err
+}
+
+// Conmon_rotateLogContainer_Results_Future is a wrapper for a Conmon_rotateLogContainer_Results promised by a client call.
+type Conmon_rotateLogContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_rotateLogContainer_Results_Future) Struct() (Conmon_rotateLogContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_rotateLogContainer_Results{s}, err
+}
+
+func (p Conmon_rotateLogContainer_Results_Future) Response() Conmon_RotateLogResponse_Future {
+	return Conmon_RotateLogResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_readLogContainer_Params struct{ capnp.Struct }
+
+// Conmon_readLogContainer_Params_TypeID is the unique identifier for the type Conmon_readLogContainer_Params.
+const Conmon_readLogContainer_Params_TypeID = 0xcfb7c5597c044cdb
+
+func NewConmon_readLogContainer_Params(s *capnp.Segment) (Conmon_readLogContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_readLogContainer_Params{st}, err
+}
+
+func NewRootConmon_readLogContainer_Params(s *capnp.Segment) (Conmon_readLogContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_readLogContainer_Params{st}, err
+}
+
+func ReadRootConmon_readLogContainer_Params(msg *capnp.Message) (Conmon_readLogContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_readLogContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_readLogContainer_Params) String() string {
+	str, _ := text.Marshal(0xcfb7c5597c044cdb, s.Struct)
+	return str
+}
+
+func (s Conmon_readLogContainer_Params) Request() (Conmon_ReadLogRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ReadLogRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_readLogContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_readLogContainer_Params) SetRequest(v Conmon_ReadLogRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_ReadLogRequest struct, preferring placement in s's segment.
+func (s Conmon_readLogContainer_Params) NewRequest() (Conmon_ReadLogRequest, error) {
+	ss, err := NewConmon_ReadLogRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ReadLogRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_readLogContainer_Params_List is a list of Conmon_readLogContainer_Params.
+type Conmon_readLogContainer_Params_List = capnp.StructList[Conmon_readLogContainer_Params]
+
+// NewConmon_readLogContainer_Params creates a new list of Conmon_readLogContainer_Params.
+func NewConmon_readLogContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_readLogContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_readLogContainer_Params]{l}, err
+}
+
+// Conmon_readLogContainer_Params_Future is a wrapper for a Conmon_readLogContainer_Params promised by a client call.
+type Conmon_readLogContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_readLogContainer_Params_Future) Struct() (Conmon_readLogContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_readLogContainer_Params{s}, err
+}
+
+func (p Conmon_readLogContainer_Params_Future) Request() Conmon_ReadLogRequest_Future {
+	return Conmon_ReadLogRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_readLogContainer_Results struct{ capnp.Struct }
+
+// Conmon_readLogContainer_Results_TypeID is the unique identifier for the type Conmon_readLogContainer_Results.
+const Conmon_readLogContainer_Results_TypeID = 0xb2b0116d3f068d4f
+
+func NewConmon_readLogContainer_Results(s *capnp.Segment) (Conmon_readLogContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_readLogContainer_Results{st}, err
+}
+
+func NewRootConmon_readLogContainer_Results(s *capnp.Segment) (Conmon_readLogContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_readLogContainer_Results{st}, err
+}
+
+func ReadRootConmon_readLogContainer_Results(msg *capnp.Message) (Conmon_readLogContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_readLogContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_readLogContainer_Results) String() string {
+	str, _ := text.Marshal(0xb2b0116d3f068d4f, s.Struct)
+	return str
+}
+
+func (s Conmon_readLogContainer_Results) Response() (Conmon_ReadLogResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ReadLogResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_readLogContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_readLogContainer_Results) SetResponse(v Conmon_ReadLogResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_ReadLogResponse struct, preferring placement in s's segment.
+func (s Conmon_readLogContainer_Results) NewResponse() (Conmon_ReadLogResponse, error) {
+	ss, err := NewConmon_ReadLogResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ReadLogResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_readLogContainer_Results_List is a list of Conmon_readLogContainer_Results.
+type Conmon_readLogContainer_Results_List = capnp.StructList[Conmon_readLogContainer_Results]
+
+// NewConmon_readLogContainer_Results creates a new list of Conmon_readLogContainer_Results.
+func NewConmon_readLogContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_readLogContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_readLogContainer_Results]{l}, err
+}
+
+// Conmon_readLogContainer_Results_Future is a wrapper for a Conmon_readLogContainer_Results promised by a client call.
+type Conmon_readLogContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_readLogContainer_Results_Future) Struct() (Conmon_readLogContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_readLogContainer_Results{s}, err
+}
+
+func (p Conmon_readLogContainer_Results_Future) Response() Conmon_ReadLogResponse_Future {
+	return Conmon_ReadLogResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_seekLogContainer_Params struct{ capnp.Struct }
+
+// Conmon_seekLogContainer_Params_TypeID is the unique identifier for the type Conmon_seekLogContainer_Params.
+const Conmon_seekLogContainer_Params_TypeID = 0xaf643dcb7f32e91b
+
+func NewConmon_seekLogContainer_Params(s *capnp.Segment) (Conmon_seekLogContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_seekLogContainer_Params{st}, err
+}
+
+func NewRootConmon_seekLogContainer_Params(s *capnp.Segment) (Conmon_seekLogContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_seekLogContainer_Params{st}, err
+}
+
+func ReadRootConmon_seekLogContainer_Params(msg *capnp.Message) (Conmon_seekLogContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_seekLogContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_seekLogContainer_Params) String() string {
+	str, _ := text.Marshal(0xaf643dcb7f32e91b, s.Struct)
+	return str
+}
+
+func (s Conmon_seekLogContainer_Params) Request() (Conmon_SeekLogRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SeekLogRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_seekLogContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_seekLogContainer_Params) SetRequest(v Conmon_SeekLogRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_SeekLogRequest struct, preferring placement in s's segment.
+func (s Conmon_seekLogContainer_Params) NewRequest() (Conmon_SeekLogRequest, error) {
+	ss, err := NewConmon_SeekLogRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SeekLogRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_seekLogContainer_Params_List is a list of Conmon_seekLogContainer_Params.
+type Conmon_seekLogContainer_Params_List = capnp.StructList[Conmon_seekLogContainer_Params]
+
+// NewConmon_seekLogContainer_Params creates a new list of Conmon_seekLogContainer_Params.
+func NewConmon_seekLogContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_seekLogContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_seekLogContainer_Params]{l}, err
+}
+
+// Conmon_seekLogContainer_Params_Future is a wrapper for a Conmon_seekLogContainer_Params promised by a client call.
+type Conmon_seekLogContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_seekLogContainer_Params_Future) Struct() (Conmon_seekLogContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_seekLogContainer_Params{s}, err
+}
+
+func (p Conmon_seekLogContainer_Params_Future) Request() Conmon_SeekLogRequest_Future {
+	return Conmon_SeekLogRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_seekLogContainer_Results struct{ capnp.Struct }
+
+// Conmon_seekLogContainer_Results_TypeID is the unique identifier for the type Conmon_seekLogContainer_Results.
+const Conmon_seekLogContainer_Results_TypeID = 0xefbec970d17dc985
+
+func NewConmon_seekLogContainer_Results(s *capnp.Segment) (Conmon_seekLogContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_seekLogContainer_Results{st}, err
+}
+
+func NewRootConmon_seekLogContainer_Results(s *capnp.Segment) (Conmon_seekLogContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_seekLogContainer_Results{st}, err
+}
+
+func ReadRootConmon_seekLogContainer_Results(msg *capnp.Message) (Conmon_seekLogContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_seekLogContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_seekLogContainer_Results) String() string {
+	str, _ := text.Marshal(0xefbec970d17dc985, s.Struct)
+	return str
+}
+
+func (s Conmon_seekLogContainer_Results) Response() (Conmon_SeekLogResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SeekLogResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_seekLogContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_seekLogContainer_Results) SetResponse(v Conmon_SeekLogResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_SeekLogResponse struct, preferring placement in s's segment.
+func (s Conmon_seekLogContainer_Results) NewResponse() (Conmon_SeekLogResponse, error) {
+	ss, err := NewConmon_SeekLogResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SeekLogResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_seekLogContainer_Results_List is a list of Conmon_seekLogContainer_Results.
+type Conmon_seekLogContainer_Results_List = capnp.StructList[Conmon_seekLogContainer_Results]
+
+// NewConmon_seekLogContainer_Results creates a new list of Conmon_seekLogContainer_Results.
+func NewConmon_seekLogContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_seekLogContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_seekLogContainer_Results]{l}, err
+}
+
+// Conmon_seekLogContainer_Results_Future is a wrapper for a Conmon_seekLogContainer_Results promised by a client call.
+type Conmon_seekLogContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_seekLogContainer_Results_Future) Struct() (Conmon_seekLogContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_seekLogContainer_Results{s}, err
+}
+
+func (p Conmon_seekLogContainer_Results_Future) Response() Conmon_SeekLogResponse_Future {
+	return Conmon_SeekLogResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_flushLogs_Params struct{ capnp.Struct }
+
+// Conmon_flushLogs_Params_TypeID is the unique identifier for the type Conmon_flushLogs_Params.
+const Conmon_flushLogs_Params_TypeID = 0xc6e1e7b26fef688a
+
+func NewConmon_flushLogs_Params(s *capnp.Segment) (Conmon_flushLogs_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_flushLogs_Params{st}, err
+}
+
+func NewRootConmon_flushLogs_Params(s *capnp.Segment) (Conmon_flushLogs_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_flushLogs_Params{st}, err
+}
+
+func ReadRootConmon_flushLogs_Params(msg *capnp.Message) (Conmon_flushLogs_Params, error) {
+	root, err := msg.Root()
+	return Conmon_flushLogs_Params{root.Struct()}, err
+}
+
+func (s Conmon_flushLogs_Params) String() string {
+	str, _ := text.Marshal(0xc6e1e7b26fef688a, s.Struct)
+	return str
+}
+
+func (s Conmon_flushLogs_Params) Request() (Conmon_FlushLogsRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_FlushLogsRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_flushLogs_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_flushLogs_Params) SetRequest(v Conmon_FlushLogsRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_FlushLogsRequest struct, preferring placement in s's segment.
+func (s Conmon_flushLogs_Params) NewRequest() (Conmon_FlushLogsRequest, error) {
+	ss, err := NewConmon_FlushLogsRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_FlushLogsRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_flushLogs_Params_List is a list of Conmon_flushLogs_Params.
+type Conmon_flushLogs_Params_List = capnp.StructList[Conmon_flushLogs_Params]
+
+// NewConmon_flushLogs_Params creates a new list of Conmon_flushLogs_Params.
+func NewConmon_flushLogs_Params_List(s *capnp.Segment, sz int32) (Conmon_flushLogs_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_flushLogs_Params]{l}, err
+}
+
+// Conmon_flushLogs_Params_Future is a wrapper for a Conmon_flushLogs_Params promised by a client call.
+type Conmon_flushLogs_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_flushLogs_Params_Future) Struct() (Conmon_flushLogs_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_flushLogs_Params{s}, err
+}
+
+func (p Conmon_flushLogs_Params_Future) Request() Conmon_FlushLogsRequest_Future {
+	return Conmon_FlushLogsRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_flushLogs_Results struct{ capnp.Struct }
+
+// Conmon_flushLogs_Results_TypeID is the unique identifier for the type Conmon_flushLogs_Results.
+const Conmon_flushLogs_Results_TypeID = 0xe024baaf8cbb64fb
+
+func NewConmon_flushLogs_Results(s *capnp.Segment) (Conmon_flushLogs_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_flushLogs_Results{st}, err
+}
+
+func NewRootConmon_flushLogs_Results(s *capnp.Segment) (Conmon_flushLogs_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_flushLogs_Results{st}, err
 }
 
-// AllocResults allocates the results struct.
-func (c Conmon_version) AllocResults() (Conmon_version_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_version_Results{Struct: r}, err
+func ReadRootConmon_flushLogs_Results(msg *capnp.Message) (Conmon_flushLogs_Results, error) {
+	root, err := msg.Root()
+	return Conmon_flushLogs_Results{root.Struct()}, err
+}
+
+func (s Conmon_flushLogs_Results) String() string {
+	str, _ := text.Marshal(0xe024baaf8cbb64fb, s.Struct)
+	return str
+}
+
+func (s Conmon_flushLogs_Results) Response() (Conmon_FlushLogsResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_FlushLogsResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_flushLogs_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_flushLogs_Results) SetResponse(v Conmon_FlushLogsResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_FlushLogsResponse struct, preferring placement in s's segment.
+func (s Conmon_flushLogs_Results) NewResponse() (Conmon_FlushLogsResponse, error) {
+	ss, err := NewConmon_FlushLogsResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_FlushLogsResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_flushLogs_Results_List is a list of Conmon_flushLogs_Results.
+type Conmon_flushLogs_Results_List = capnp.StructList[Conmon_flushLogs_Results]
+
+// NewConmon_flushLogs_Results creates a new list of Conmon_flushLogs_Results.
+func NewConmon_flushLogs_Results_List(s *capnp.Segment, sz int32) (Conmon_flushLogs_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_flushLogs_Results]{l}, err
+}
+
+// Conmon_flushLogs_Results_Future is a wrapper for a Conmon_flushLogs_Results promised by a client call.
+type Conmon_flushLogs_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_flushLogs_Results_Future) Struct() (Conmon_flushLogs_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_flushLogs_Results{s}, err
+}
+
+func (p Conmon_flushLogs_Results_Future) Response() Conmon_FlushLogsResponse_Future {
+	return Conmon_FlushLogsResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_checkpointContainer_Params struct{ capnp.Struct }
+
+// Conmon_checkpointContainer_Params_TypeID is the unique identifier for the type Conmon_checkpointContainer_Params.
+const Conmon_checkpointContainer_Params_TypeID = 0xca27841148b7050e
+
+func NewConmon_checkpointContainer_Params(s *capnp.Segment) (Conmon_checkpointContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_checkpointContainer_Params{st}, err
+}
+
+func NewRootConmon_checkpointContainer_Params(s *capnp.Segment) (Conmon_checkpointContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_checkpointContainer_Params{st}, err
+}
+
+func ReadRootConmon_checkpointContainer_Params(msg *capnp.Message) (Conmon_checkpointContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_checkpointContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_checkpointContainer_Params) String() string {
+	str, _ := text.Marshal(0xca27841148b7050e, s.Struct)
+	return str
+}
+
+func (s Conmon_checkpointContainer_Params) Request() (Conmon_CheckpointContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_CheckpointContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_checkpointContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_checkpointContainer_Params) SetRequest(v Conmon_CheckpointContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_CheckpointContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_checkpointContainer_Params) NewRequest() (Conmon_CheckpointContainerRequest, error) {
+	ss, err := NewConmon_CheckpointContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CheckpointContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_checkpointContainer_Params_List is a list of Conmon_checkpointContainer_Params.
+type Conmon_checkpointContainer_Params_List = capnp.StructList[Conmon_checkpointContainer_Params]
+
+// NewConmon_checkpointContainer_Params creates a new list of Conmon_checkpointContainer_Params.
+func NewConmon_checkpointContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_checkpointContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_checkpointContainer_Params]{l}, err
+}
+
+// Conmon_checkpointContainer_Params_Future is a wrapper for a Conmon_checkpointContainer_Params promised by a client call.
+type Conmon_checkpointContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_checkpointContainer_Params_Future) Struct() (Conmon_checkpointContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_checkpointContainer_Params{s}, err
+}
+
+func (p Conmon_checkpointContainer_Params_Future) Request() Conmon_CheckpointContainerRequest_Future {
+	return Conmon_CheckpointContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_checkpointContainer_Results struct{ capnp.Struct }
+
+// Conmon_checkpointContainer_Results_TypeID is the unique identifier for the type Conmon_checkpointContainer_Results.
+const Conmon_checkpointContainer_Results_TypeID = 0xf5024761975c861f
+
+func NewConmon_checkpointContainer_Results(s *capnp.Segment) (Conmon_checkpointContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_checkpointContainer_Results{st}, err
+}
+
+func NewRootConmon_checkpointContainer_Results(s *capnp.Segment) (Conmon_checkpointContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_checkpointContainer_Results{st}, err
+}
+
+func ReadRootConmon_checkpointContainer_Results(msg *capnp.Message) (Conmon_checkpointContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_checkpointContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_checkpointContainer_Results) String() string {
+	str, _ := text.Marshal(0xf5024761975c861f, s.Struct)
+	return str
+}
+
+func (s Conmon_checkpointContainer_Results) Response() (Conmon_CheckpointContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_CheckpointContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_checkpointContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_checkpointContainer_Results) SetResponse(v Conmon_CheckpointContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_CheckpointContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_checkpointContainer_Results) NewResponse() (Conmon_CheckpointContainerResponse, error) {
+	ss, err := NewConmon_CheckpointContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CheckpointContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_checkpointContainer_Results_List is a list of Conmon_checkpointContainer_Results.
+type Conmon_checkpointContainer_Results_List = capnp.StructList[Conmon_checkpointContainer_Results]
+
+// NewConmon_checkpointContainer_Results creates a new list of Conmon_checkpointContainer_Results.
+func NewConmon_checkpointContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_checkpointContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_checkpointContainer_Results]{l}, err
+}
+
+// Conmon_checkpointContainer_Results_Future is a wrapper for a Conmon_checkpointContainer_Results promised by a client call.
+type Conmon_checkpointContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_checkpointContainer_Results_Future) Struct() (Conmon_checkpointContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_checkpointContainer_Results{s}, err
+}
+
+func (p Conmon_checkpointContainer_Results_Future) Response() Conmon_CheckpointContainerResponse_Future {
+	return Conmon_CheckpointContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_restoreContainer_Params struct{ capnp.Struct }
+
+// Conmon_restoreContainer_Params_TypeID is the unique identifier for the type Conmon_restoreContainer_Params.
+const Conmon_restoreContainer_Params_TypeID = 0xeb6d1af8db5d3520
+
+func NewConmon_restoreContainer_Params(s *capnp.Segment) (Conmon_restoreContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_restoreContainer_Params{st}, err
+}
+
+func NewRootConmon_restoreContainer_Params(s *capnp.Segment) (Conmon_restoreContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_restoreContainer_Params{st}, err
+}
+
+func ReadRootConmon_restoreContainer_Params(msg *capnp.Message) (Conmon_restoreContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_restoreContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_restoreContainer_Params) String() string {
+	str, _ := text.Marshal(0xeb6d1af8db5d3520, s.Struct)
+	return str
+}
+
+func (s Conmon_restoreContainer_Params) Request() (Conmon_RestoreContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_RestoreContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_restoreContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_restoreContainer_Params) SetRequest(v Conmon_RestoreContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_RestoreContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_restoreContainer_Params) NewRequest() (Conmon_RestoreContainerRequest, error) {
+	ss, err := NewConmon_RestoreContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_RestoreContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_restoreContainer_Params_List is a list of Conmon_restoreContainer_Params.
+type Conmon_restoreContainer_Params_List = capnp.StructList[Conmon_restoreContainer_Params]
+
+// NewConmon_restoreContainer_Params creates a new list of Conmon_restoreContainer_Params.
+func NewConmon_restoreContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_restoreContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_restoreContainer_Params]{l}, err
+}
+
+// Conmon_restoreContainer_Params_Future is a wrapper for a Conmon_restoreContainer_Params promised by a client call.
+type Conmon_restoreContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_restoreContainer_Params_Future) Struct() (Conmon_restoreContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_restoreContainer_Params{s}, err
+}
+
+func (p Conmon_restoreContainer_Params_Future) Request() Conmon_RestoreContainerRequest_Future {
+	return Conmon_RestoreContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_restoreContainer_Results struct{ capnp.Struct }
+
+// Conmon_restoreContainer_Results_TypeID is the unique identifier for the type Conmon_restoreContainer_Results.
+const Conmon_restoreContainer_Results_TypeID = 0x844530cf92fcc3c6
+
+func NewConmon_restoreContainer_Results(s *capnp.Segment) (Conmon_restoreContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_restoreContainer_Results{st}, err
+}
+
+func NewRootConmon_restoreContainer_Results(s *capnp.Segment) (Conmon_restoreContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_restoreContainer_Results{st}, err
+}
+
+func ReadRootConmon_restoreContainer_Results(msg *capnp.Message) (Conmon_restoreContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_restoreContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_restoreContainer_Results) String() string {
+	str, _ := text.Marshal(0x844530cf92fcc3c6, s.Struct)
+	return str
+}
+
+func (s Conmon_restoreContainer_Results) Response() (Conmon_RestoreContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_RestoreContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_restoreContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_restoreContainer_Results) SetResponse(v Conmon_RestoreContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewResponse sets the response field to a newly
+// allocated Conmon_RestoreContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_restoreContainer_Results) NewResponse() (Conmon_RestoreContainerResponse, error) {
+	ss, err := NewConmon_RestoreContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_RestoreContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_restoreContainer_Results_List is a list of Conmon_restoreContainer_Results.
+type Conmon_restoreContainer_Results_List = capnp.StructList[Conmon_restoreContainer_Results]
+
+// NewConmon_restoreContainer_Results creates a new list of Conmon_restoreContainer_Results.
+func NewConmon_restoreContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_restoreContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_restoreContainer_Results]{l}, err
+}
+
+// Conmon_restoreContainer_Results_Future is a wrapper for a Conmon_restoreContainer_Results promised by a client call.
+type Conmon_restoreContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_restoreContainer_Results_Future) Struct() (Conmon_restoreContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_restoreContainer_Results{s}, err
+}
+
+func (p Conmon_restoreContainer_Results_Future) Response() Conmon_RestoreContainerResponse_Future {
+	return Conmon_RestoreContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_killContainer_Params struct{ capnp.Struct }
+
+// Conmon_killContainer_Params_TypeID is the unique identifier for the type Conmon_killContainer_Params.
+const Conmon_killContainer_Params_TypeID = 0xa788b2549075c742
+
+func NewConmon_killContainer_Params(s *capnp.Segment) (Conmon_killContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_killContainer_Params{st}, err
+}
+
+func NewRootConmon_killContainer_Params(s *capnp.Segment) (Conmon_killContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_killContainer_Params{st}, err
+}
+
+func ReadRootConmon_killContainer_Params(msg *capnp.Message) (Conmon_killContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_killContainer_Params{root.Struct()}, err
+}
+
+func (s Conmon_killContainer_Params) String() string {
+	str, _ := text.Marshal(0xa788b2549075c742, s.Struct)
+	return str
+}
+
+func (s Conmon_killContainer_Params) Request() (Conmon_KillContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_KillContainerRequest{Struct: p.Struct()}, err
+}
+
+func (s Conmon_killContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_killContainer_Params) SetRequest(v Conmon_KillContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewRequest sets the request field to a newly
+// allocated Conmon_KillContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_killContainer_Params) NewRequest() (Conmon_KillContainerRequest, error) {
+	ss, err := NewConmon_KillContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_KillContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_killContainer_Params_List is a list of Conmon_killContainer_Params.
+type Conmon_killContainer_Params_List = capnp.StructList[Conmon_killContainer_Params]
+
+// NewConmon_killContainer_Params creates a new list of Conmon_killContainer_Params.
+func NewConmon_killContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_killContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_killContainer_Params]{l}, err
+}
+
+// Conmon_killContainer_Params_Future is a wrapper for a Conmon_killContainer_Params promised by a client call.
+type Conmon_killContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_killContainer_Params_Future) Struct() (Conmon_killContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_killContainer_Params{s}, err
+}
+
+func (p Conmon_killContainer_Params_Future) Request() Conmon_KillContainerRequest_Future {
+	return Conmon_KillContainerRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_killContainer_Results struct{ capnp.Struct }
+
+// Conmon_killContainer_Results_TypeID is the unique identifier for the type Conmon_killContainer_Results.
+const Conmon_killContainer_Results_TypeID = 0xb727729a699a1475
+
+func NewConmon_killContainer_Results(s *capnp.Segment) (Conmon_killContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_killContainer_Results{st}, err
+}
+
+func NewRootConmon_killContainer_Results(s *capnp.Segment) (Conmon_killContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_killContainer_Results{st}, err
+}
+
+func ReadRootConmon_killContainer_Results(msg *capnp.Message) (Conmon_killContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_killContainer_Results{root.Struct()}, err
+}
+
+func (s Conmon_killContainer_Results) String() string {
+	str, _ := text.Marshal(0xb727729a699a1475, s.Struct)
+	return str
+}
+
+func (s Conmon_killContainer_Results) Response() (Conmon_KillContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_KillContainerResponse{Struct: p.Struct()}, err
+}
+
+func (s Conmon_killContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
+
+func (s Conmon_killContainer_Results) SetResponse(v Conmon_KillContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-// Conmon_createContainer holds the state for a server call to Conmon.createContainer.
-// See server.Call for documentation.
-type Conmon_createContainer struct {
-	*server.Call
+// NewResponse sets the response field to a newly
+// allocated Conmon_KillContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_killContainer_Results) NewResponse() (Conmon_KillContainerResponse, error) {
+	ss, err := NewConmon_KillContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_KillContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-// Args returns the call's arguments.
-func (c Conmon_createContainer) Args() Conmon_createContainer_Params {
-	return Conmon_createContainer_Params{Struct: c.Call.Args()}
+// Conmon_killContainer_Results_List is a list of Conmon_killContainer_Results.
+type Conmon_killContainer_Results_List = capnp.StructList[Conmon_killContainer_Results]
+
+// NewConmon_killContainer_Results creates a new list of Conmon_killContainer_Results.
+func NewConmon_killContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_killContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_killContainer_Results]{l}, err
 }
 
-// AllocResults allocates the results struct.
-func (c Conmon_createContainer) AllocResults() (Conmon_createContainer_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_createContainer_Results{Struct: r}, err
+// Conmon_killContainer_Results_Future is a wrapper for a Conmon_killContainer_Results promised by a client call.
+type Conmon_killContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_killContainer_Results_Future) Struct() (Conmon_killContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_killContainer_Results{s}, err
 }
 
-// Conmon_execSyncContainer holds the state for a server call to Conmon.execSyncContainer.
-// See server.Call for documentation.
-type Conmon_execSyncContainer struct {
-	*server.Call
+func (p Conmon_killContainer_Results_Future) Response() Conmon_KillContainerResponse_Future {
+	return Conmon_KillContainerResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-// Args returns the call's arguments.
-func (c Conmon_execSyncContainer) Args() Conmon_execSyncContainer_Params {
-	return Conmon_execSyncContainer_Params{Struct: c.Call.Args()}
+type Conmon_pauseContainer_Params struct{ capnp.Struct }
+
+// Conmon_pauseContainer_Params_TypeID is the unique identifier for the type Conmon_pauseContainer_Params.
+const Conmon_pauseContainer_Params_TypeID = 0xef387164b6b1f60d
+
+func NewConmon_pauseContainer_Params(s *capnp.Segment) (Conmon_pauseContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_pauseContainer_Params{st}, err
 }
 
-// AllocResults allocates the results struct.
-func (c Conmon_execSyncContainer) AllocResults() (Conmon_execSyncContainer_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_execSyncContainer_Results{Struct: r}, err
+func NewRootConmon_pauseContainer_Params(s *capnp.Segment) (Conmon_pauseContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_pauseContainer_Params{st}, err
 }
 
-// Conmon_attachContainer holds the state for a server call to Conmon.attachContainer.
-// See server.Call for documentation.
-type Conmon_attachContainer struct {
-	*server.Call
+func ReadRootConmon_pauseContainer_Params(msg *capnp.Message) (Conmon_pauseContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_pauseContainer_Params{root.Struct()}, err
 }
 
-// Args returns the call's arguments.
-func (c Conmon_attachContainer) Args() Conmon_attachContainer_Params {
-	return Conmon_attachContainer_Params{Struct: c.Call.Args()}
+func (s Conmon_pauseContainer_Params) String() string {
+	str, _ := text.Marshal(0xef387164b6b1f60d, s.Struct)
+	return str
 }
 
-// AllocResults allocates the results struct.
-func (c Conmon_attachContainer) AllocResults() (Conmon_attachContainer_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_attachContainer_Results{Struct: r}, err
+func (s Conmon_pauseContainer_Params) Request() (Conmon_PauseContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_PauseContainerRequest{Struct: p.Struct()}, err
 }
 
-// Conmon_reopenLogContainer holds the state for a server call to Conmon.reopenLogContainer.
-// See server.Call for documentation.
-type Conmon_reopenLogContainer struct {
-	*server.Call
+func (s Conmon_pauseContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
 }
 
-// Args returns the call's arguments.
-func (c Conmon_reopenLogContainer) Args() Conmon_reopenLogContainer_Params {
-	return Conmon_reopenLogContainer_Params{Struct: c.Call.Args()}
+func (s Conmon_pauseContainer_Params) SetRequest(v Conmon_PauseContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-// AllocResults allocates the results struct.
-func (c Conmon_reopenLogContainer) AllocResults() (Conmon_reopenLogContainer_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_reopenLogContainer_Results{Struct: r}, err
+// NewRequest sets the request field to a newly
+// allocated Conmon_PauseContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_pauseContainer_Params) NewRequest() (Conmon_PauseContainerRequest, error) {
+	ss, err := NewConmon_PauseContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_PauseContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-// Conmon_setWindowSizeContainer holds the state for a server call to Conmon.setWindowSizeContainer.
-// See server.Call for documentation.
-type Conmon_setWindowSizeContainer struct {
-	*server.Call
+// Conmon_pauseContainer_Params_List is a list of Conmon_pauseContainer_Params.
+type Conmon_pauseContainer_Params_List = capnp.StructList[Conmon_pauseContainer_Params]
+
+// NewConmon_pauseContainer_Params creates a new list of Conmon_pauseContainer_Params.
+func NewConmon_pauseContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_pauseContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_pauseContainer_Params]{l}, err
 }
 
-// Args returns the call's arguments.
-func (c Conmon_setWindowSizeContainer) Args() Conmon_setWindowSizeContainer_Params {
-	return Conmon_setWindowSizeContainer_Params{Struct: c.Call.Args()}
+// Conmon_pauseContainer_Params_Future is a wrapper for a Conmon_pauseContainer_Params promised by a client call.
+type Conmon_pauseContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_pauseContainer_Params_Future) Struct() (Conmon_pauseContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_pauseContainer_Params{s}, err
 }
 
-// AllocResults allocates the results struct.
-func (c Conmon_setWindowSizeContainer) AllocResults() (Conmon_setWindowSizeContainer_Results, error) {
-	r, err := c.Call.AllocResults(capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_setWindowSizeContainer_Results{Struct: r}, err
+func (p Conmon_pauseContainer_Params_Future) Request() Conmon_PauseContainerRequest_Future {
+	return Conmon_PauseContainerRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_VersionResponse struct{ capnp.Struct }
+type Conmon_pauseContainer_Results struct{ capnp.Struct }
 
-// Conmon_VersionResponse_TypeID is the unique identifier for the type Conmon_VersionResponse.
-const Conmon_VersionResponse_TypeID = 0xf34be5cbac1feed1
+// Conmon_pauseContainer_Results_TypeID is the unique identifier for the type Conmon_pauseContainer_Results.
+const Conmon_pauseContainer_Results_TypeID = 0xcb5061b78617cf88
 
-func NewConmon_VersionResponse(s *capnp.Segment) (Conmon_VersionResponse, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
-	return Conmon_VersionResponse{st}, err
+func NewConmon_pauseContainer_Results(s *capnp.Segment) (Conmon_pauseContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_pauseContainer_Results{st}, err
 }
 
-func NewRootConmon_VersionResponse(s *capnp.Segment) (Conmon_VersionResponse, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
-	return Conmon_VersionResponse{st}, err
+func NewRootConmon_pauseContainer_Results(s *capnp.Segment) (Conmon_pauseContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_pauseContainer_Results{st}, err
 }
 
-func ReadRootConmon_VersionResponse(msg *capnp.Message) (Conmon_VersionResponse, error) {
+func ReadRootConmon_pauseContainer_Results(msg *capnp.Message) (Conmon_pauseContainer_Results, error) {
 	root, err := msg.Root()
-	return Conmon_VersionResponse{root.Struct()}, err
+	return Conmon_pauseContainer_Results{root.Struct()}, err
 }
 
-func (s Conmon_VersionResponse) String() string {
-	str, _ := text.Marshal(0xf34be5cbac1feed1, s.Struct)
+func (s Conmon_pauseContainer_Results) String() string {
+	str, _ := text.Marshal(0xcb5061b78617cf88, s.Struct)
 	return str
 }
 
-func (s Conmon_VersionResponse) Version() (string, error) {
+func (s Conmon_pauseContainer_Results) Response() (Conmon_PauseContainerResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return p.Text(), err
+	return Conmon_PauseContainerResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_VersionResponse) HasVersion() bool {
+func (s Conmon_pauseContainer_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_VersionResponse) VersionBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.TextBytes(), err
+func (s Conmon_pauseContainer_Results) SetResponse(v Conmon_PauseContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func (s Conmon_VersionResponse) SetVersion(v string) error {
-	return s.Struct.SetText(0, v)
+// NewResponse sets the response field to a newly
+// allocated Conmon_PauseContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_pauseContainer_Results) NewResponse() (Conmon_PauseContainerResponse, error) {
+	ss, err := NewConmon_PauseContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_PauseContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-func (s Conmon_VersionResponse) Tag() (string, error) {
-	p, err := s.Struct.Ptr(1)
-	return p.Text(), err
+// Conmon_pauseContainer_Results_List is a list of Conmon_pauseContainer_Results.
+type Conmon_pauseContainer_Results_List = capnp.StructList[Conmon_pauseContainer_Results]
+
+// NewConmon_pauseContainer_Results creates a new list of Conmon_pauseContainer_Results.
+func NewConmon_pauseContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_pauseContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_pauseContainer_Results]{l}, err
 }
 
-func (s Conmon_VersionResponse) HasTag() bool {
-	return s.Struct.HasPtr(1)
+// Conmon_pauseContainer_Results_Future is a wrapper for a Conmon_pauseContainer_Results promised by a client call.
+type Conmon_pauseContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_pauseContainer_Results_Future) Struct() (Conmon_pauseContainer_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_pauseContainer_Results{s}, err
 }
 
-func (s Conmon_VersionResponse) TagBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(1)
-	return p.TextBytes(), err
+func (p Conmon_pauseContainer_Results_Future) Response() Conmon_PauseContainerResponse_Future {
+	return Conmon_PauseContainerResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-func (s Conmon_VersionResponse) SetTag(v string) error {
-	return s.Struct.SetText(1, v)
+type Conmon_unpauseContainer_Params struct{ capnp.Struct }
+
+// Conmon_unpauseContainer_Params_TypeID is the unique identifier for the type Conmon_unpauseContainer_Params.
+const Conmon_unpauseContainer_Params_TypeID = 0x85e40ceb4cb0ce18
+
+func NewConmon_unpauseContainer_Params(s *capnp.Segment) (Conmon_unpauseContainer_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_unpauseContainer_Params{st}, err
 }
 
-func (s Conmon_VersionResponse) Commit() (string, error) {
-	p, err := s.Struct.Ptr(2)
-	return p.Text(), err
+func NewRootConmon_unpauseContainer_Params(s *capnp.Segment) (Conmon_unpauseContainer_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_unpauseContainer_Params{st}, err
 }
 
-func (s Conmon_VersionResponse) HasCommit() bool {
-	return s.Struct.HasPtr(2)
+func ReadRootConmon_unpauseContainer_Params(msg *capnp.Message) (Conmon_unpauseContainer_Params, error) {
+	root, err := msg.Root()
+	return Conmon_unpauseContainer_Params{root.Struct()}, err
 }
 
-func (s Conmon_VersionResponse) CommitBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(2)
-	return p.TextBytes(), err
+func (s Conmon_unpauseContainer_Params) String() string {
+	str, _ := text.Marshal(0x85e40ceb4cb0ce18, s.Struct)
+	return str
 }
 
-func (s Conmon_VersionResponse) SetCommit(v string) error {
-	return s.Struct.SetText(2, v)
+func (s Conmon_unpauseContainer_Params) Request() (Conmon_UnpauseContainerRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_UnpauseContainerRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_VersionResponse) BuildDate() (string, error) {
-	p, err := s.Struct.Ptr(3)
-	return p.Text(), err
+func (s Conmon_unpauseContainer_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_VersionResponse) HasBuildDate() bool {
-	return s.Struct.HasPtr(3)
+func (s Conmon_unpauseContainer_Params) SetRequest(v Conmon_UnpauseContainerRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func (s Conmon_VersionResponse) BuildDateBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(3)
-	return p.TextBytes(), err
+// NewRequest sets the request field to a newly
+// allocated Conmon_UnpauseContainerRequest struct, preferring placement in s's segment.
+func (s Conmon_unpauseContainer_Params) NewRequest() (Conmon_UnpauseContainerRequest, error) {
+	ss, err := NewConmon_UnpauseContainerRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_UnpauseContainerRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-func (s Conmon_VersionResponse) SetBuildDate(v string) error {
-	return s.Struct.SetText(3, v)
+// Conmon_unpauseContainer_Params_List is a list of Conmon_unpauseContainer_Params.
+type Conmon_unpauseContainer_Params_List = capnp.StructList[Conmon_unpauseContainer_Params]
+
+// NewConmon_unpauseContainer_Params creates a new list of Conmon_unpauseContainer_Params.
+func NewConmon_unpauseContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_unpauseContainer_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_unpauseContainer_Params]{l}, err
 }
 
-func (s Conmon_VersionResponse) RustVersion() (string, error) {
-	p, err := s.Struct.Ptr(4)
-	return p.Text(), err
+// Conmon_unpauseContainer_Params_Future is a wrapper for a Conmon_unpauseContainer_Params promised by a client call.
+type Conmon_unpauseContainer_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_unpauseContainer_Params_Future) Struct() (Conmon_unpauseContainer_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_unpauseContainer_Params{s}, err
 }
 
-func (s Conmon_VersionResponse) HasRustVersion() bool {
-	return s.Struct.HasPtr(4)
+func (p Conmon_unpauseContainer_Params_Future) Request() Conmon_UnpauseContainerRequest_Future {
+	return Conmon_UnpauseContainerRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-func (s Conmon_VersionResponse) RustVersionBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(4)
-	return p.TextBytes(), err
+type Conmon_unpauseContainer_Results struct{ capnp.Struct }
+
+// Conmon_unpauseContainer_Results_TypeID is the unique identifier for the type Conmon_unpauseContainer_Results.
+const Conmon_unpauseContainer_Results_TypeID = 0x8e227cb048ce3dce
+
+func NewConmon_unpauseContainer_Results(s *capnp.Segment) (Conmon_unpauseContainer_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_unpauseContainer_Results{st}, err
 }
 
-func (s Conmon_VersionResponse) SetRustVersion(v string) error {
-	return s.Struct.SetText(4, v)
+func NewRootConmon_unpauseContainer_Results(s *capnp.Segment) (Conmon_unpauseContainer_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_unpauseContainer_Results{st}, err
 }
 
-func (s Conmon_VersionResponse) ProcessId() uint32 {
-	return s.Struct.Uint32(0)
+func ReadRootConmon_unpauseContainer_Results(msg *capnp.Message) (Conmon_unpauseContainer_Results, error) {
+	root, err := msg.Root()
+	return Conmon_unpauseContainer_Results{root.Struct()}, err
 }
 
-func (s Conmon_VersionResponse) SetProcessId(v uint32) {
-	s.Struct.SetUint32(0, v)
+func (s Conmon_unpauseContainer_Results) String() string {
+	str, _ := text.Marshal(0x8e227cb048ce3dce, s.Struct)
+	return str
 }
 
-// Conmon_VersionResponse_List is a list of Conmon_VersionResponse.
-type Conmon_VersionResponse_List = capnp.StructList[Conmon_VersionResponse]
+func (s Conmon_unpauseContainer_Results) Response() (Conmon_UnpauseContainerResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_UnpauseContainerResponse{Struct: p.Struct()}, err
+}
 
-// NewConmon_VersionResponse creates a new list of Conmon_VersionResponse.
-func NewConmon_VersionResponse_List(s *capnp.Segment, sz int32) (Conmon_VersionResponse_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5}, sz)
-	return capnp.StructList[Conmon_VersionResponse]{l}, err
+func (s Conmon_unpauseContainer_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
 }
 
-// Conmon_VersionResponse_Future is a wrapper for a Conmon_VersionResponse promised by a client call.
-type Conmon_VersionResponse_Future struct{ *capnp.Future }
+func (s Conmon_unpauseContainer_Results) SetResponse(v Conmon_UnpauseContainerResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
 
-func (p Conmon_VersionResponse_Future) Struct() (Conmon_VersionResponse, error) {
+// NewResponse sets the response field to a newly
+// allocated Conmon_UnpauseContainerResponse struct, preferring placement in s's segment.
+func (s Conmon_unpauseContainer_Results) NewResponse() (Conmon_UnpauseContainerResponse, error) {
+	ss, err := NewConmon_UnpauseContainerResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_UnpauseContainerResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_unpauseContainer_Results_List is a list of Conmon_unpauseContainer_Results.
+type Conmon_unpauseContainer_Results_List = capnp.StructList[Conmon_unpauseContainer_Results]
+
+// NewConmon_unpauseContainer_Results creates a new list of Conmon_unpauseContainer_Results.
+func NewConmon_unpauseContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_unpauseContainer_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_unpauseContainer_Results]{l}, err
+}
+
+// Conmon_unpauseContainer_Results_Future is a wrapper for a Conmon_unpauseContainer_Results promised by a client call.
+type Conmon_unpauseContainer_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_unpauseContainer_Results_Future) Struct() (Conmon_unpauseContainer_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_VersionResponse{s}, err
+	return Conmon_unpauseContainer_Results{s}, err
 }
 
-type Conmon_CreateContainerRequest struct{ capnp.Struct }
+func (p Conmon_unpauseContainer_Results_Future) Response() Conmon_UnpauseContainerResponse_Future {
+	return Conmon_UnpauseContainerResponse_Future{Future: p.Future.Field(0, nil)}
+}
 
-// Conmon_CreateContainerRequest_TypeID is the unique identifier for the type Conmon_CreateContainerRequest.
-const Conmon_CreateContainerRequest_TypeID = 0xba77e3fa3aa9b6ca
+type Conmon_archiveLogs_Params struct{ capnp.Struct }
 
-func NewConmon_CreateContainerRequest(s *capnp.Segment) (Conmon_CreateContainerRequest, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
-	return Conmon_CreateContainerRequest{st}, err
+// Conmon_archiveLogs_Params_TypeID is the unique identifier for the type Conmon_archiveLogs_Params.
+const Conmon_archiveLogs_Params_TypeID = 0xce07fd7fe8b3d1c9
+
+func NewConmon_archiveLogs_Params(s *capnp.Segment) (Conmon_archiveLogs_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_archiveLogs_Params{st}, err
 }
 
-func NewRootConmon_CreateContainerRequest(s *capnp.Segment) (Conmon_CreateContainerRequest, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5})
-	return Conmon_CreateContainerRequest{st}, err
+func NewRootConmon_archiveLogs_Params(s *capnp.Segment) (Conmon_archiveLogs_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_archiveLogs_Params{st}, err
 }
 
-func ReadRootConmon_CreateContainerRequest(msg *capnp.Message) (Conmon_CreateContainerRequest, error) {
+func ReadRootConmon_archiveLogs_Params(msg *capnp.Message) (Conmon_archiveLogs_Params, error) {
 	root, err := msg.Root()
-	return Conmon_CreateContainerRequest{root.Struct()}, err
+	return Conmon_archiveLogs_Params{root.Struct()}, err
 }
 
-func (s Conmon_CreateContainerRequest) String() string {
-	str, _ := text.Marshal(0xba77e3fa3aa9b6ca, s.Struct)
+func (s Conmon_archiveLogs_Params) String() string {
+	str, _ := text.Marshal(0xce07fd7fe8b3d1c9, s.Struct)
 	return str
 }
 
-func (s Conmon_CreateContainerRequest) Id() (string, error) {
+func (s Conmon_archiveLogs_Params) Request() (Conmon_ArchiveLogsRequest, error) {
 	p, err := s.Struct.Ptr(0)
-	return p.Text(), err
+	return Conmon_ArchiveLogsRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_CreateContainerRequest) HasId() bool {
+func (s Conmon_archiveLogs_Params) HasRequest() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_CreateContainerRequest) IdBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.TextBytes(), err
+func (s Conmon_archiveLogs_Params) SetRequest(v Conmon_ArchiveLogsRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func (s Conmon_CreateContainerRequest) SetId(v string) error {
-	return s.Struct.SetText(0, v)
+// NewRequest sets the request field to a newly
+// allocated Conmon_ArchiveLogsRequest struct, preferring placement in s's segment.
+func (s Conmon_archiveLogs_Params) NewRequest() (Conmon_ArchiveLogsRequest, error) {
+	ss, err := NewConmon_ArchiveLogsRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ArchiveLogsRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Conmon_archiveLogs_Params_List is a list of Conmon_archiveLogs_Params.
+type Conmon_archiveLogs_Params_List = capnp.StructList[Conmon_archiveLogs_Params]
+
+// NewConmon_archiveLogs_Params creates a new list of Conmon_archiveLogs_Params.
+func NewConmon_archiveLogs_Params_List(s *capnp.Segment, sz int32) (Conmon_archiveLogs_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_archiveLogs_Params]{l}, err
+}
+
+// Conmon_archiveLogs_Params_Future is a wrapper for a Conmon_archiveLogs_Params promised by a client call.
+type Conmon_archiveLogs_Params_Future struct{ *capnp.Future }
+
+func (p Conmon_archiveLogs_Params_Future) Struct() (Conmon_archiveLogs_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_archiveLogs_Params{s}, err
+}
+
+func (p Conmon_archiveLogs_Params_Future) Request() Conmon_ArchiveLogsRequest_Future {
+	return Conmon_ArchiveLogsRequest_Future{Future: p.Future.Field(0, nil)}
+}
+
+type Conmon_archiveLogs_Results struct{ capnp.Struct }
+
+// Conmon_archiveLogs_Results_TypeID is the unique identifier for the type Conmon_archiveLogs_Results.
+const Conmon_archiveLogs_Results_TypeID = 0xebf2395e50275e51
+
+func NewConmon_archiveLogs_Results(s *capnp.Segment) (Conmon_archiveLogs_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_archiveLogs_Results{st}, err
 }
 
-func (s Conmon_CreateContainerRequest) BundlePath() (string, error) {
-	p, err := s.Struct.Ptr(1)
-	return p.Text(), err
+func NewRootConmon_archiveLogs_Results(s *capnp.Segment) (Conmon_archiveLogs_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_archiveLogs_Results{st}, err
 }
 
-func (s Conmon_CreateContainerRequest) HasBundlePath() bool {
-	return s.Struct.HasPtr(1)
+func ReadRootConmon_archiveLogs_Results(msg *capnp.Message) (Conmon_archiveLogs_Results, error) {
+	root, err := msg.Root()
+	return Conmon_archiveLogs_Results{root.Struct()}, err
 }
 
-func (s Conmon_CreateContainerRequest) BundlePathBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(1)
-	return p.TextBytes(), err
+func (s Conmon_archiveLogs_Results) String() string {
+	str, _ := text.Marshal(0xebf2395e50275e51, s.Struct)
+	return str
 }
 
-func (s Conmon_CreateContainerRequest) SetBundlePath(v string) error {
-	return s.Struct.SetText(1, v)
+func (s Conmon_archiveLogs_Results) Response() (Conmon_ArchiveLogsResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ArchiveLogsResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_CreateContainerRequest) Terminal() bool {
-	return s.Struct.Bit(0)
+func (s Conmon_archiveLogs_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_CreateContainerRequest) SetTerminal(v bool) {
-	s.Struct.SetBit(0, v)
+func (s Conmon_archiveLogs_Results) SetResponse(v Conmon_ArchiveLogsResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func (s Conmon_CreateContainerRequest) ExitPaths() (capnp.TextList, error) {
-	p, err := s.Struct.Ptr(2)
-	return capnp.TextList{List: p.List()}, err
+// NewResponse sets the response field to a newly
+// allocated Conmon_ArchiveLogsResponse struct, preferring placement in s's segment.
+func (s Conmon_archiveLogs_Results) NewResponse() (Conmon_ArchiveLogsResponse, error) {
+	ss, err := NewConmon_ArchiveLogsResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ArchiveLogsResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-func (s Conmon_CreateContainerRequest) HasExitPaths() bool {
-	return s.Struct.HasPtr(2)
+// Conmon_archiveLogs_Results_List is a list of Conmon_archiveLogs_Results.
+type Conmon_archiveLogs_Results_List = capnp.StructList[Conmon_archiveLogs_Results]
+
+// NewConmon_archiveLogs_Results creates a new list of Conmon_archiveLogs_Results.
+func NewConmon_archiveLogs_Results_List(s *capnp.Segment, sz int32) (Conmon_archiveLogs_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_archiveLogs_Results]{l}, err
 }
 
-func (s Conmon_CreateContainerRequest) SetExitPaths(v capnp.TextList) error {
-	return s.Struct.SetPtr(2, v.List.ToPtr())
+// Conmon_archiveLogs_Results_Future is a wrapper for a Conmon_archiveLogs_Results promised by a client call.
+type Conmon_archiveLogs_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_archiveLogs_Results_Future) Struct() (Conmon_archiveLogs_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_archiveLogs_Results{s}, err
 }
 
-// NewExitPaths sets the exitPaths field to a newly
-// allocated capnp.TextList, preferring placement in s's segment.
-func (s Conmon_CreateContainerRequest) NewExitPaths(n int32) (capnp.TextList, error) {
-	l, err := capnp.NewTextList(s.Struct.Segment(), n)
-	if err != nil {
-		return capnp.TextList{}, err
-	}
-	err = s.Struct.SetPtr(2, l.List.ToPtr())
-	return l, err
+func (p Conmon_archiveLogs_Results_Future) Response() Conmon_ArchiveLogsResponse_Future {
+	return Conmon_ArchiveLogsResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-func (s Conmon_CreateContainerRequest) OomExitPaths() (capnp.TextList, error) {
-	p, err := s.Struct.Ptr(3)
-	return capnp.TextList{List: p.List()}, err
+type Conmon_setLabels_Params struct{ capnp.Struct }
+
+// Conmon_setLabels_Params_TypeID is the unique identifier for the type Conmon_setLabels_Params.
+const Conmon_setLabels_Params_TypeID = 0x9fb7fa9c3928a4d3
+
+func NewConmon_setLabels_Params(s *capnp.Segment) (Conmon_setLabels_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setLabels_Params{st}, err
 }
 
-func (s Conmon_CreateContainerRequest) HasOomExitPaths() bool {
-	return s.Struct.HasPtr(3)
+func NewRootConmon_setLabels_Params(s *capnp.Segment) (Conmon_setLabels_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setLabels_Params{st}, err
 }
 
-func (s Conmon_CreateContainerRequest) SetOomExitPaths(v capnp.TextList) error {
-	return s.Struct.SetPtr(3, v.List.ToPtr())
+func ReadRootConmon_setLabels_Params(msg *capnp.Message) (Conmon_setLabels_Params, error) {
+	root, err := msg.Root()
+	return Conmon_setLabels_Params{root.Struct()}, err
 }
 
-// NewOomExitPaths sets the oomExitPaths field to a newly
-// allocated capnp.TextList, preferring placement in s's segment.
-func (s Conmon_CreateContainerRequest) NewOomExitPaths(n int32) (capnp.TextList, error) {
-	l, err := capnp.NewTextList(s.Struct.Segment(), n)
-	if err != nil {
-		return capnp.TextList{}, err
-	}
-	err = s.Struct.SetPtr(3, l.List.ToPtr())
-	return l, err
+func (s Conmon_setLabels_Params) String() string {
+	str, _ := text.Marshal(0x9fb7fa9c3928a4d3, s.Struct)
+	return str
 }
 
-func (s Conmon_CreateContainerRequest) LogDrivers() (Conmon_LogDriver_List, error) {
-	p, err := s.Struct.Ptr(4)
-	return Conmon_LogDriver_List{List: p.List()}, err
+func (s Conmon_setLabels_Params) Request() (Conmon_SetLabelsRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SetLabelsRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_CreateContainerRequest) HasLogDrivers() bool {
-	return s.Struct.HasPtr(4)
+func (s Conmon_setLabels_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_CreateContainerRequest) SetLogDrivers(v Conmon_LogDriver_List) error {
-	return s.Struct.SetPtr(4, v.List.ToPtr())
+func (s Conmon_setLabels_Params) SetRequest(v Conmon_SetLabelsRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-// NewLogDrivers sets the logDrivers field to a newly
-// allocated Conmon_LogDriver_List, preferring placement in s's segment.
-func (s Conmon_CreateContainerRequest) NewLogDrivers(n int32) (Conmon_LogDriver_List, error) {
-	l, err := NewConmon_LogDriver_List(s.Struct.Segment(), n)
+// NewRequest sets the request field to a newly
+// allocated Conmon_SetLabelsRequest struct, preferring placement in s's segment.
+func (s Conmon_setLabels_Params) NewRequest() (Conmon_SetLabelsRequest, error) {
+	ss, err := NewConmon_SetLabelsRequest(s.Struct.Segment())
 	if err != nil {
-		return Conmon_LogDriver_List{}, err
+		return Conmon_SetLabelsRequest{}, err
 	}
-	err = s.Struct.SetPtr(4, l.List.ToPtr())
-	return l, err
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-// Conmon_CreateContainerRequest_List is a list of Conmon_CreateContainerRequest.
-type Conmon_CreateContainerRequest_List = capnp.StructList[Conmon_CreateContainerRequest]
+// Conmon_setLabels_Params_List is a list of Conmon_setLabels_Params.
+type Conmon_setLabels_Params_List = capnp.StructList[Conmon_setLabels_Params]
 
-// NewConmon_CreateContainerRequest creates a new list of Conmon_CreateContainerRequest.
-func NewConmon_CreateContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_CreateContainerRequest_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 5}, sz)
-	return capnp.StructList[Conmon_CreateContainerRequest]{l}, err
+// NewConmon_setLabels_Params creates a new list of Conmon_setLabels_Params.
+func NewConmon_setLabels_Params_List(s *capnp.Segment, sz int32) (Conmon_setLabels_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_setLabels_Params]{l}, err
 }
 
-// Conmon_CreateContainerRequest_Future is a wrapper for a Conmon_CreateContainerRequest promised by a client call.
-type Conmon_CreateContainerRequest_Future struct{ *capnp.Future }
+// Conmon_setLabels_Params_Future is a wrapper for a Conmon_setLabels_Params promised by a client call.
+type Conmon_setLabels_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_CreateContainerRequest_Future) Struct() (Conmon_CreateContainerRequest, error) {
+func (p Conmon_setLabels_Params_Future) Struct() (Conmon_setLabels_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_CreateContainerRequest{s}, err
+	return Conmon_setLabels_Params{s}, err
 }
 
-type Conmon_LogDriver struct{ capnp.Struct }
+func (p Conmon_setLabels_Params_Future) Request() Conmon_SetLabelsRequest_Future {
+	return Conmon_SetLabelsRequest_Future{Future: p.Future.Field(0, nil)}
+}
 
-// Conmon_LogDriver_TypeID is the unique identifier for the type Conmon_LogDriver.
-const Conmon_LogDriver_TypeID = 0xae78ee8eb6b3a134
+type Conmon_setLabels_Results struct{ capnp.Struct }
 
-func NewConmon_LogDriver(s *capnp.Segment) (Conmon_LogDriver, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Conmon_LogDriver{st}, err
+// Conmon_setLabels_Results_TypeID is the unique identifier for the type Conmon_setLabels_Results.
+const Conmon_setLabels_Results_TypeID = 0xfc257fe2fa86af93
+
+func NewConmon_setLabels_Results(s *capnp.Segment) (Conmon_setLabels_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setLabels_Results{st}, err
 }
 
-func NewRootConmon_LogDriver(s *capnp.Segment) (Conmon_LogDriver, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Conmon_LogDriver{st}, err
+func NewRootConmon_setLabels_Results(s *capnp.Segment) (Conmon_setLabels_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_setLabels_Results{st}, err
 }
 
-func ReadRootConmon_LogDriver(msg *capnp.Message) (Conmon_LogDriver, error) {
+func ReadRootConmon_setLabels_Results(msg *capnp.Message) (Conmon_setLabels_Results, error) {
 	root, err := msg.Root()
-	return Conmon_LogDriver{root.Struct()}, err
+	return Conmon_setLabels_Results{root.Struct()}, err
 }
 
-func (s Conmon_LogDriver) String() string {
-	str, _ := text.Marshal(0xae78ee8eb6b3a134, s.Struct)
+func (s Conmon_setLabels_Results) String() string {
+	str, _ := text.Marshal(0xfc257fe2fa86af93, s.Struct)
 	return str
 }
 
-func (s Conmon_LogDriver) Type() Conmon_LogDriver_Type {
-	return Conmon_LogDriver_Type(s.Struct.Uint16(0))
+func (s Conmon_setLabels_Results) Response() (Conmon_SetLabelsResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_SetLabelsResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_LogDriver) SetType(v Conmon_LogDriver_Type) {
-	s.Struct.SetUint16(0, uint16(v))
+func (s Conmon_setLabels_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_LogDriver) Path() (string, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.Text(), err
+func (s Conmon_setLabels_Results) SetResponse(v Conmon_SetLabelsResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func (s Conmon_LogDriver) HasPath() bool {
-	return s.Struct.HasPtr(0)
+// NewResponse sets the response field to a newly
+// allocated Conmon_SetLabelsResponse struct, preferring placement in s's segment.
+func (s Conmon_setLabels_Results) NewResponse() (Conmon_SetLabelsResponse, error) {
+	ss, err := NewConmon_SetLabelsResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_SetLabelsResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-func (s Conmon_LogDriver) PathBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.TextBytes(), err
+// Conmon_setLabels_Results_List is a list of Conmon_setLabels_Results.
+type Conmon_setLabels_Results_List = capnp.StructList[Conmon_setLabels_Results]
+
+// NewConmon_setLabels_Results creates a new list of Conmon_setLabels_Results.
+func NewConmon_setLabels_Results_List(s *capnp.Segment, sz int32) (Conmon_setLabels_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_setLabels_Results]{l}, err
 }
 
-func (s Conmon_LogDriver) SetPath(v string) error {
-	return s.Struct.SetText(0, v)
+// Conmon_setLabels_Results_Future is a wrapper for a Conmon_setLabels_Results promised by a client call.
+type Conmon_setLabels_Results_Future struct{ *capnp.Future }
+
+func (p Conmon_setLabels_Results_Future) Struct() (Conmon_setLabels_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_setLabels_Results{s}, err
 }
 
-// Conmon_LogDriver_List is a list of Conmon_LogDriver.
-type Conmon_LogDriver_List = capnp.StructList[Conmon_LogDriver]
+func (p Conmon_setLabels_Results_Future) Response() Conmon_SetLabelsResponse_Future {
+	return Conmon_SetLabelsResponse_Future{Future: p.Future.Field(0, nil)}
+}
 
-// NewConmon_LogDriver creates a new list of Conmon_LogDriver.
-func NewConmon_LogDriver_List(s *capnp.Segment, sz int32) (Conmon_LogDriver_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_LogDriver]{l}, err
+type Conmon_getLabels_Params struct{ capnp.Struct }
+
+// Conmon_getLabels_Params_TypeID is the unique identifier for the type Conmon_getLabels_Params.
+const Conmon_getLabels_Params_TypeID = 0xb30aaab6c6870e33
+
+func NewConmon_getLabels_Params(s *capnp.Segment) (Conmon_getLabels_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_getLabels_Params{st}, err
 }
 
-// Conmon_LogDriver_Future is a wrapper for a Conmon_LogDriver promised by a client call.
-type Conmon_LogDriver_Future struct{ *capnp.Future }
+func NewRootConmon_getLabels_Params(s *capnp.Segment) (Conmon_getLabels_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_getLabels_Params{st}, err
+}
 
-func (p Conmon_LogDriver_Future) Struct() (Conmon_LogDriver, error) {
-	s, err := p.Future.Struct()
-	return Conmon_LogDriver{s}, err
+func ReadRootConmon_getLabels_Params(msg *capnp.Message) (Conmon_getLabels_Params, error) {
+	root, err := msg.Root()
+	return Conmon_getLabels_Params{root.Struct()}, err
 }
 
-type Conmon_LogDriver_Type uint16
+func (s Conmon_getLabels_Params) String() string {
+	str, _ := text.Marshal(0xb30aaab6c6870e33, s.Struct)
+	return str
+}
 
-// Conmon_LogDriver_Type_TypeID is the unique identifier for the type Conmon_LogDriver_Type.
-const Conmon_LogDriver_Type_TypeID = 0xf026e3d750335bc1
+func (s Conmon_getLabels_Params) Request() (Conmon_GetLabelsRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_GetLabelsRequest{Struct: p.Struct()}, err
+}
 
-// Values of Conmon_LogDriver_Type.
-const (
-	Conmon_LogDriver_Type_containerRuntimeInterface Conmon_LogDriver_Type = 0
-)
+func (s Conmon_getLabels_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
+}
 
-// String returns the enum's constant name.
-func (c Conmon_LogDriver_Type) String() string {
-	switch c {
-	case Conmon_LogDriver_Type_containerRuntimeInterface:
-		return "containerRuntimeInterface"
+func (s Conmon_getLabels_Params) SetRequest(v Conmon_GetLabelsRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
 
-	default:
-		return ""
+// NewRequest sets the request field to a newly
+// allocated Conmon_GetLabelsRequest struct, preferring placement in s's segment.
+func (s Conmon_getLabels_Params) NewRequest() (Conmon_GetLabelsRequest, error) {
+	ss, err := NewConmon_GetLabelsRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_GetLabelsRequest{}, err
 	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-// Conmon_LogDriver_TypeFromString returns the enum value with a name,
-// or the zero value if there's no such value.
-func Conmon_LogDriver_TypeFromString(c string) Conmon_LogDriver_Type {
-	switch c {
-	case "containerRuntimeInterface":
-		return Conmon_LogDriver_Type_containerRuntimeInterface
+// Conmon_getLabels_Params_List is a list of Conmon_getLabels_Params.
+type Conmon_getLabels_Params_List = capnp.StructList[Conmon_getLabels_Params]
 
-	default:
-		return 0
-	}
+// NewConmon_getLabels_Params creates a new list of Conmon_getLabels_Params.
+func NewConmon_getLabels_Params_List(s *capnp.Segment, sz int32) (Conmon_getLabels_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_getLabels_Params]{l}, err
 }
 
-type Conmon_LogDriver_Type_List = capnp.EnumList[Conmon_LogDriver_Type]
+// Conmon_getLabels_Params_Future is a wrapper for a Conmon_getLabels_Params promised by a client call.
+type Conmon_getLabels_Params_Future struct{ *capnp.Future }
 
-func NewConmon_LogDriver_Type_List(s *capnp.Segment, sz int32) (Conmon_LogDriver_Type_List, error) {
-	return capnp.NewEnumList[Conmon_LogDriver_Type](s, sz)
+func (p Conmon_getLabels_Params_Future) Struct() (Conmon_getLabels_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_getLabels_Params{s}, err
 }
 
-type Conmon_CreateContainerResponse struct{ capnp.Struct }
+func (p Conmon_getLabels_Params_Future) Request() Conmon_GetLabelsRequest_Future {
+	return Conmon_GetLabelsRequest_Future{Future: p.Future.Field(0, nil)}
+}
 
-// Conmon_CreateContainerResponse_TypeID is the unique identifier for the type Conmon_CreateContainerResponse.
-const Conmon_CreateContainerResponse_TypeID = 0xde3a625e70772b9a
+type Conmon_getLabels_Results struct{ capnp.Struct }
 
-func NewConmon_CreateContainerResponse(s *capnp.Segment) (Conmon_CreateContainerResponse, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Conmon_CreateContainerResponse{st}, err
+// Conmon_getLabels_Results_TypeID is the unique identifier for the type Conmon_getLabels_Results.
+const Conmon_getLabels_Results_TypeID = 0xd0066da47b16db35
+
+func NewConmon_getLabels_Results(s *capnp.Segment) (Conmon_getLabels_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_getLabels_Results{st}, err
 }
 
-func NewRootConmon_CreateContainerResponse(s *capnp.Segment) (Conmon_CreateContainerResponse, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Conmon_CreateContainerResponse{st}, err
+func NewRootConmon_getLabels_Results(s *capnp.Segment) (Conmon_getLabels_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_getLabels_Results{st}, err
 }
 
-func ReadRootConmon_CreateContainerResponse(msg *capnp.Message) (Conmon_CreateContainerResponse, error) {
+func ReadRootConmon_getLabels_Results(msg *capnp.Message) (Conmon_getLabels_Results, error) {
 	root, err := msg.Root()
-	return Conmon_CreateContainerResponse{root.Struct()}, err
+	return Conmon_getLabels_Results{root.Struct()}, err
 }
 
-func (s Conmon_CreateContainerResponse) String() string {
-	str, _ := text.Marshal(0xde3a625e70772b9a, s.Struct)
+func (s Conmon_getLabels_Results) String() string {
+	str, _ := text.Marshal(0xd0066da47b16db35, s.Struct)
 	return str
 }
 
-func (s Conmon_CreateContainerResponse) ContainerPid() uint32 {
-	return s.Struct.Uint32(0)
+func (s Conmon_getLabels_Results) Response() (Conmon_GetLabelsResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_GetLabelsResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_CreateContainerResponse) SetContainerPid(v uint32) {
-	s.Struct.SetUint32(0, v)
+func (s Conmon_getLabels_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
 }
 
-// Conmon_CreateContainerResponse_List is a list of Conmon_CreateContainerResponse.
-type Conmon_CreateContainerResponse_List = capnp.StructList[Conmon_CreateContainerResponse]
+func (s Conmon_getLabels_Results) SetResponse(v Conmon_GetLabelsResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
 
-// NewConmon_CreateContainerResponse creates a new list of Conmon_CreateContainerResponse.
-func NewConmon_CreateContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_CreateContainerResponse_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return capnp.StructList[Conmon_CreateContainerResponse]{l}, err
+// NewResponse sets the response field to a newly
+// allocated Conmon_GetLabelsResponse struct, preferring placement in s's segment.
+func (s Conmon_getLabels_Results) NewResponse() (Conmon_GetLabelsResponse, error) {
+	ss, err := NewConmon_GetLabelsResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_GetLabelsResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-// Conmon_CreateContainerResponse_Future is a wrapper for a Conmon_CreateContainerResponse promised by a client call.
-type Conmon_CreateContainerResponse_Future struct{ *capnp.Future }
+// Conmon_getLabels_Results_List is a list of Conmon_getLabels_Results.
+type Conmon_getLabels_Results_List = capnp.StructList[Conmon_getLabels_Results]
 
-func (p Conmon_CreateContainerResponse_Future) Struct() (Conmon_CreateContainerResponse, error) {
-	s, err := p.Future.Struct()
-	return Conmon_CreateContainerResponse{s}, err
+// NewConmon_getLabels_Results creates a new list of Conmon_getLabels_Results.
+func NewConmon_getLabels_Results_List(s *capnp.Segment, sz int32) (Conmon_getLabels_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_getLabels_Results]{l}, err
 }
 
-type Conmon_ExecSyncContainerRequest struct{ capnp.Struct }
+// Conmon_getLabels_Results_Future is a wrapper for a Conmon_getLabels_Results promised by a client call.
+type Conmon_getLabels_Results_Future struct{ *capnp.Future }
 
-// Conmon_ExecSyncContainerRequest_TypeID is the unique identifier for the type Conmon_ExecSyncContainerRequest.
-const Conmon_ExecSyncContainerRequest_TypeID = 0xf41122f890a371a6
+func (p Conmon_getLabels_Results_Future) Struct() (Conmon_getLabels_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_getLabels_Results{s}, err
+}
 
-func NewConmon_ExecSyncContainerRequest(s *capnp.Segment) (Conmon_ExecSyncContainerRequest, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
-	return Conmon_ExecSyncContainerRequest{st}, err
+func (p Conmon_getLabels_Results_Future) Response() Conmon_GetLabelsResponse_Future {
+	return Conmon_GetLabelsResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-func NewRootConmon_ExecSyncContainerRequest(s *capnp.Segment) (Conmon_ExecSyncContainerRequest, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2})
-	return Conmon_ExecSyncContainerRequest{st}, err
+type Conmon_serveSeccompNotify_Params struct{ capnp.Struct }
+
+// Conmon_serveSeccompNotify_Params_TypeID is the unique identifier for the type Conmon_serveSeccompNotify_Params.
+const Conmon_serveSeccompNotify_Params_TypeID = 0x9fe3605dfe914696
+
+func NewConmon_serveSeccompNotify_Params(s *capnp.Segment) (Conmon_serveSeccompNotify_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_serveSeccompNotify_Params{st}, err
 }
 
-func ReadRootConmon_ExecSyncContainerRequest(msg *capnp.Message) (Conmon_ExecSyncContainerRequest, error) {
+func NewRootConmon_serveSeccompNotify_Params(s *capnp.Segment) (Conmon_serveSeccompNotify_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_serveSeccompNotify_Params{st}, err
+}
+
+func ReadRootConmon_serveSeccompNotify_Params(msg *capnp.Message) (Conmon_serveSeccompNotify_Params, error) {
 	root, err := msg.Root()
-	return Conmon_ExecSyncContainerRequest{root.Struct()}, err
+	return Conmon_serveSeccompNotify_Params{root.Struct()}, err
 }
 
-func (s Conmon_ExecSyncContainerRequest) String() string {
-	str, _ := text.Marshal(0xf41122f890a371a6, s.Struct)
+func (s Conmon_serveSeccompNotify_Params) String() string {
+	str, _ := text.Marshal(0x9fe3605dfe914696, s.Struct)
 	return str
 }
 
-func (s Conmon_ExecSyncContainerRequest) Id() (string, error) {
+func (s Conmon_serveSeccompNotify_Params) Request() (Conmon_ServeSeccompNotifyRequest, error) {
 	p, err := s.Struct.Ptr(0)
-	return p.Text(), err
+	return Conmon_ServeSeccompNotifyRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_ExecSyncContainerRequest) HasId() bool {
+func (s Conmon_serveSeccompNotify_Params) HasRequest() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_ExecSyncContainerRequest) IdBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.TextBytes(), err
+func (s Conmon_serveSeccompNotify_Params) SetRequest(v Conmon_ServeSeccompNotifyRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func (s Conmon_ExecSyncContainerRequest) SetId(v string) error {
-	return s.Struct.SetText(0, v)
+// NewRequest sets the request field to a newly
+// allocated Conmon_ServeSeccompNotifyRequest struct, preferring placement in s's segment.
+func (s Conmon_serveSeccompNotify_Params) NewRequest() (Conmon_ServeSeccompNotifyRequest, error) {
+	ss, err := NewConmon_ServeSeccompNotifyRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ServeSeccompNotifyRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-func (s Conmon_ExecSyncContainerRequest) TimeoutSec() uint64 {
-	return s.Struct.Uint64(0)
-}
+// Conmon_serveSeccompNotify_Params_List is a list of Conmon_serveSeccompNotify_Params.
+type Conmon_serveSeccompNotify_Params_List = capnp.StructList[Conmon_serveSeccompNotify_Params]
 
-func (s Conmon_ExecSyncContainerRequest) SetTimeoutSec(v uint64) {
-	s.Struct.SetUint64(0, v)
+// NewConmon_serveSeccompNotify_Params creates a new list of Conmon_serveSeccompNotify_Params.
+func NewConmon_serveSeccompNotify_Params_List(s *capnp.Segment, sz int32) (Conmon_serveSeccompNotify_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_serveSeccompNotify_Params]{l}, err
 }
 
-func (s Conmon_ExecSyncContainerRequest) Command() (capnp.TextList, error) {
-	p, err := s.Struct.Ptr(1)
-	return capnp.TextList{List: p.List()}, err
-}
+// Conmon_serveSeccompNotify_Params_Future is a wrapper for a Conmon_serveSeccompNotify_Params promised by a client call.
+type Conmon_serveSeccompNotify_Params_Future struct{ *capnp.Future }
 
-func (s Conmon_ExecSyncContainerRequest) HasCommand() bool {
-	return s.Struct.HasPtr(1)
+func (p Conmon_serveSeccompNotify_Params_Future) Struct() (Conmon_serveSeccompNotify_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_serveSeccompNotify_Params{s}, err
 }
 
-func (s Conmon_ExecSyncContainerRequest) SetCommand(v capnp.TextList) error {
-	return s.Struct.SetPtr(1, v.List.ToPtr())
+func (p Conmon_serveSeccompNotify_Params_Future) Request() Conmon_ServeSeccompNotifyRequest_Future {
+	return Conmon_ServeSeccompNotifyRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-// NewCommand sets the command field to a newly
-// allocated capnp.TextList, preferring placement in s's segment.
-func (s Conmon_ExecSyncContainerRequest) NewCommand(n int32) (capnp.TextList, error) {
-	l, err := capnp.NewTextList(s.Struct.Segment(), n)
-	if err != nil {
-		return capnp.TextList{}, err
-	}
-	err = s.Struct.SetPtr(1, l.List.ToPtr())
-	return l, err
+type Conmon_serveSeccompNotify_Results struct{ capnp.Struct }
+
+// Conmon_serveSeccompNotify_Results_TypeID is the unique identifier for the type Conmon_serveSeccompNotify_Results.
+const Conmon_serveSeccompNotify_Results_TypeID = 0xa2c865b278522903
+
+func NewConmon_serveSeccompNotify_Results(s *capnp.Segment) (Conmon_serveSeccompNotify_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_serveSeccompNotify_Results{st}, err
 }
 
-func (s Conmon_ExecSyncContainerRequest) Terminal() bool {
-	return s.Struct.Bit(64)
+func NewRootConmon_serveSeccompNotify_Results(s *capnp.Segment) (Conmon_serveSeccompNotify_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_serveSeccompNotify_Results{st}, err
 }
 
-func (s Conmon_ExecSyncContainerRequest) SetTerminal(v bool) {
-	s.Struct.SetBit(64, v)
+func ReadRootConmon_serveSeccompNotify_Results(msg *capnp.Message) (Conmon_serveSeccompNotify_Results, error) {
+	root, err := msg.Root()
+	return Conmon_serveSeccompNotify_Results{root.Struct()}, err
 }
 
-// Conmon_ExecSyncContainerRequest_List is a list of Conmon_ExecSyncContainerRequest.
-type Conmon_ExecSyncContainerRequest_List = capnp.StructList[Conmon_ExecSyncContainerRequest]
+func (s Conmon_serveSeccompNotify_Results) String() string {
+	str, _ := text.Marshal(0xa2c865b278522903, s.Struct)
+	return str
+}
 
-// NewConmon_ExecSyncContainerRequest creates a new list of Conmon_ExecSyncContainerRequest.
-func NewConmon_ExecSyncContainerRequest_List(s *capnp.Segment, sz int32) (Conmon_ExecSyncContainerRequest_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 2}, sz)
-	return capnp.StructList[Conmon_ExecSyncContainerRequest]{l}, err
+func (s Conmon_serveSeccompNotify_Results) Response() (Conmon_ServeSeccompNotifyResponse, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_ServeSeccompNotifyResponse{Struct: p.Struct()}, err
 }
 
-// Conmon_ExecSyncContainerRequest_Future is a wrapper for a Conmon_ExecSyncContainerRequest promised by a client call.
-type Conmon_ExecSyncContainerRequest_Future struct{ *capnp.Future }
+func (s Conmon_serveSeccompNotify_Results) HasResponse() bool {
+	return s.Struct.HasPtr(0)
+}
 
-func (p Conmon_ExecSyncContainerRequest_Future) Struct() (Conmon_ExecSyncContainerRequest, error) {
-	s, err := p.Future.Struct()
-	return Conmon_ExecSyncContainerRequest{s}, err
+func (s Conmon_serveSeccompNotify_Results) SetResponse(v Conmon_ServeSeccompNotifyResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-type Conmon_ExecSyncContainerResponse struct{ capnp.Struct }
+// NewResponse sets the response field to a newly
+// allocated Conmon_ServeSeccompNotifyResponse struct, preferring placement in s's segment.
+func (s Conmon_serveSeccompNotify_Results) NewResponse() (Conmon_ServeSeccompNotifyResponse, error) {
+	ss, err := NewConmon_ServeSeccompNotifyResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_ServeSeccompNotifyResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
 
-// Conmon_ExecSyncContainerResponse_TypeID is the unique identifier for the type Conmon_ExecSyncContainerResponse.
-const Conmon_ExecSyncContainerResponse_TypeID = 0xd9d61d1d803c85fc
+// Conmon_serveSeccompNotify_Results_List is a list of Conmon_serveSeccompNotify_Results.
+type Conmon_serveSeccompNotify_Results_List = capnp.StructList[Conmon_serveSeccompNotify_Results]
 
-func NewConmon_ExecSyncContainerResponse(s *capnp.Segment) (Conmon_ExecSyncContainerResponse, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Conmon_ExecSyncContainerResponse{st}, err
+// NewConmon_serveSeccompNotify_Results creates a new list of Conmon_serveSeccompNotify_Results.
+func NewConmon_serveSeccompNotify_Results_List(s *capnp.Segment, sz int32) (Conmon_serveSeccompNotify_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_serveSeccompNotify_Results]{l}, err
 }
 
-func NewRootConmon_ExecSyncContainerResponse(s *capnp.Segment) (Conmon_ExecSyncContainerResponse, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
-	return Conmon_ExecSyncContainerResponse{st}, err
-}
+// Conmon_serveSeccompNotify_Results_Future is a wrapper for a Conmon_serveSeccompNotify_Results promised by a client call.
+type Conmon_serveSeccompNotify_Results_Future struct{ *capnp.Future }
 
-func ReadRootConmon_ExecSyncContainerResponse(msg *capnp.Message) (Conmon_ExecSyncContainerResponse, error) {
-	root, err := msg.Root()
-	return Conmon_ExecSyncContainerResponse{root.Struct()}, err
+func (p Conmon_serveSeccompNotify_Results_Future) Struct() (Conmon_serveSeccompNotify_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_serveSeccompNotify_Results{s}, err
 }
 
-func (s Conmon_ExecSyncContainerResponse) String() string {
-	str, _ := text.Marshal(0xd9d61d1d803c85fc, s.Struct)
-	return str
+func (p Conmon_serveSeccompNotify_Results_Future) Response() Conmon_ServeSeccompNotifyResponse_Future {
+	return Conmon_ServeSeccompNotifyResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-func (s Conmon_ExecSyncContainerResponse) ExitCode() int32 {
-	return int32(s.Struct.Uint32(0))
-}
+type Conmon_uploadCheckpointImage_Params struct{ capnp.Struct }
 
-func (s Conmon_ExecSyncContainerResponse) SetExitCode(v int32) {
-	s.Struct.SetUint32(0, uint32(v))
-}
+// Conmon_uploadCheckpointImage_Params_TypeID is the unique identifier for the type Conmon_uploadCheckpointImage_Params.
+const Conmon_uploadCheckpointImage_Params_TypeID = 0x9bef35d18b7c8e16
 
-func (s Conmon_ExecSyncContainerResponse) Stdout() ([]byte, error) {
-	p, err := s.Struct.Ptr(0)
-	return []byte(p.Data()), err
+func NewConmon_uploadCheckpointImage_Params(s *capnp.Segment) (Conmon_uploadCheckpointImage_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_uploadCheckpointImage_Params{st}, err
 }
 
-func (s Conmon_ExecSyncContainerResponse) HasStdout() bool {
-	return s.Struct.HasPtr(0)
+func NewRootConmon_uploadCheckpointImage_Params(s *capnp.Segment) (Conmon_uploadCheckpointImage_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_uploadCheckpointImage_Params{st}, err
 }
 
-func (s Conmon_ExecSyncContainerResponse) SetStdout(v []byte) error {
-	return s.Struct.SetData(0, v)
+func ReadRootConmon_uploadCheckpointImage_Params(msg *capnp.Message) (Conmon_uploadCheckpointImage_Params, error) {
+	root, err := msg.Root()
+	return Conmon_uploadCheckpointImage_Params{root.Struct()}, err
 }
 
-func (s Conmon_ExecSyncContainerResponse) Stderr() ([]byte, error) {
-	p, err := s.Struct.Ptr(1)
-	return []byte(p.Data()), err
+func (s Conmon_uploadCheckpointImage_Params) String() string {
+	str, _ := text.Marshal(0x9bef35d18b7c8e16, s.Struct)
+	return str
 }
 
-func (s Conmon_ExecSyncContainerResponse) HasStderr() bool {
-	return s.Struct.HasPtr(1)
+func (s Conmon_uploadCheckpointImage_Params) Request() (Conmon_UploadCheckpointImageRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_UploadCheckpointImageRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_ExecSyncContainerResponse) SetStderr(v []byte) error {
-	return s.Struct.SetData(1, v)
+func (s Conmon_uploadCheckpointImage_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_ExecSyncContainerResponse) TimedOut() bool {
-	return s.Struct.Bit(32)
+func (s Conmon_uploadCheckpointImage_Params) SetRequest(v Conmon_UploadCheckpointImageRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func (s Conmon_ExecSyncContainerResponse) SetTimedOut(v bool) {
-	s.Struct.SetBit(32, v)
+// NewRequest sets the request field to a newly
+// allocated Conmon_UploadCheckpointImageRequest struct, preferring placement in s's segment.
+func (s Conmon_uploadCheckpointImage_Params) NewRequest() (Conmon_UploadCheckpointImageRequest, error) {
+	ss, err := NewConmon_UploadCheckpointImageRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_UploadCheckpointImageRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-// Conmon_ExecSyncContainerResponse_List is a list of Conmon_ExecSyncContainerResponse.
-type Conmon_ExecSyncContainerResponse_List = capnp.StructList[Conmon_ExecSyncContainerResponse]
+// Conmon_uploadCheckpointImage_Params_List is a list of Conmon_uploadCheckpointImage_Params.
+type Conmon_uploadCheckpointImage_Params_List = capnp.StructList[Conmon_uploadCheckpointImage_Params]
 
-// NewConmon_ExecSyncContainerResponse creates a new list of Conmon_ExecSyncContainerResponse.
-func NewConmon_ExecSyncContainerResponse_List(s *capnp.Segment, sz int32) (Conmon_ExecSyncContainerResponse_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
-	return capnp.StructList[Conmon_ExecSyncContainerResponse]{l}, err
+// NewConmon_uploadCheckpointImage_Params creates a new list of Conmon_uploadCheckpointImage_Params.
+func NewConmon_uploadCheckpointImage_Params_List(s *capnp.Segment, sz int32) (Conmon_uploadCheckpointImage_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_uploadCheckpointImage_Params]{l}, err
 }
 
-// Conmon_ExecSyncContainerResponse_Future is a wrapper for a Conmon_ExecSyncContainerResponse promised by a client call.
-type Conmon_ExecSyncContainerResponse_Future struct{ *capnp.Future }
+// Conmon_uploadCheckpointImage_Params_Future is a wrapper for a Conmon_uploadCheckpointImage_Params promised by a client call.
+type Conmon_uploadCheckpointImage_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_ExecSyncContainerResponse_Future) Struct() (Conmon_ExecSyncContainerResponse, error) {
+func (p Conmon_uploadCheckpointImage_Params_Future) Struct() (Conmon_uploadCheckpointImage_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_ExecSyncContainerResponse{s}, err
+	return Conmon_uploadCheckpointImage_Params{s}, err
 }
 
-type Conmon_AttachRequest struct{ capnp.Struct }
+func (p Conmon_uploadCheckpointImage_Params_Future) Request() Conmon_UploadCheckpointImageRequest_Future {
+	return Conmon_UploadCheckpointImageRequest_Future{Future: p.Future.Field(0, nil)}
+}
 
-// Conmon_AttachRequest_TypeID is the unique identifier for the type Conmon_AttachRequest.
-const Conmon_AttachRequest_TypeID = 0xdf703ca0befc3afc
+type Conmon_uploadCheckpointImage_Results struct{ capnp.Struct }
 
-func NewConmon_AttachRequest(s *capnp.Segment) (Conmon_AttachRequest, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
-	return Conmon_AttachRequest{st}, err
+// Conmon_uploadCheckpointImage_Results_TypeID is the unique identifier for the type Conmon_uploadCheckpointImage_Results.
+const Conmon_uploadCheckpointImage_Results_TypeID = 0xdb0d49abe2bb2ab6
+
+func NewConmon_uploadCheckpointImage_Results(s *capnp.Segment) (Conmon_uploadCheckpointImage_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_uploadCheckpointImage_Results{st}, err
 }
 
-func NewRootConmon_AttachRequest(s *capnp.Segment) (Conmon_AttachRequest, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3})
-	return Conmon_AttachRequest{st}, err
+func NewRootConmon_uploadCheckpointImage_Results(s *capnp.Segment) (Conmon_uploadCheckpointImage_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_uploadCheckpointImage_Results{st}, err
 }
 
-func ReadRootConmon_AttachRequest(msg *capnp.Message) (Conmon_AttachRequest, error) {
+func ReadRootConmon_uploadCheckpointImage_Results(msg *capnp.Message) (Conmon_uploadCheckpointImage_Results, error) {
 	root, err := msg.Root()
-	return Conmon_AttachRequest{root.Struct()}, err
+	return Conmon_uploadCheckpointImage_Results{root.Struct()}, err
 }
 
-func (s Conmon_AttachRequest) String() string {
-	str, _ := text.Marshal(0xdf703ca0befc3afc, s.Struct)
+func (s Conmon_uploadCheckpointImage_Results) String() string {
+	str, _ := text.Marshal(0xdb0d49abe2bb2ab6, s.Struct)
 	return str
 }
 
-func (s Conmon_AttachRequest) Id() (string, error) {
+func (s Conmon_uploadCheckpointImage_Results) Response() (Conmon_UploadCheckpointImageResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return p.Text(), err
+	return Conmon_UploadCheckpointImageResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_AttachRequest) HasId() bool {
+func (s Conmon_uploadCheckpointImage_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_AttachRequest) IdBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.TextBytes(), err
+func (s Conmon_uploadCheckpointImage_Results) SetResponse(v Conmon_UploadCheckpointImageResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func (s Conmon_AttachRequest) SetId(v string) error {
-	return s.Struct.SetText(0, v)
+// NewResponse sets the response field to a newly
+// allocated Conmon_UploadCheckpointImageResponse struct, preferring placement in s's segment.
+func (s Conmon_uploadCheckpointImage_Results) NewResponse() (Conmon_UploadCheckpointImageResponse, error) {
+	ss, err := NewConmon_UploadCheckpointImageResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_UploadCheckpointImageResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-func (s Conmon_AttachRequest) SocketPath() (string, error) {
-	p, err := s.Struct.Ptr(1)
-	return p.Text(), err
-}
+// Conmon_uploadCheckpointImage_Results_List is a list of Conmon_uploadCheckpointImage_Results.
+type Conmon_uploadCheckpointImage_Results_List = capnp.StructList[Conmon_uploadCheckpointImage_Results]
 
-func (s Conmon_AttachRequest) HasSocketPath() bool {
-	return s.Struct.HasPtr(1)
+// NewConmon_uploadCheckpointImage_Results creates a new list of Conmon_uploadCheckpointImage_Results.
+func NewConmon_uploadCheckpointImage_Results_List(s *capnp.Segment, sz int32) (Conmon_uploadCheckpointImage_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_uploadCheckpointImage_Results]{l}, err
 }
 
-func (s Conmon_AttachRequest) SocketPathBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(1)
-	return p.TextBytes(), err
-}
+// Conmon_uploadCheckpointImage_Results_Future is a wrapper for a Conmon_uploadCheckpointImage_Results promised by a client call.
+type Conmon_uploadCheckpointImage_Results_Future struct{ *capnp.Future }
 
-func (s Conmon_AttachRequest) SetSocketPath(v string) error {
-	return s.Struct.SetText(1, v)
+func (p Conmon_uploadCheckpointImage_Results_Future) Struct() (Conmon_uploadCheckpointImage_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_uploadCheckpointImage_Results{s}, err
 }
 
-func (s Conmon_AttachRequest) ExecSessionId() (string, error) {
-	p, err := s.Struct.Ptr(2)
-	return p.Text(), err
+func (p Conmon_uploadCheckpointImage_Results_Future) Response() Conmon_UploadCheckpointImageResponse_Future {
+	return Conmon_UploadCheckpointImageResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-func (s Conmon_AttachRequest) HasExecSessionId() bool {
-	return s.Struct.HasPtr(2)
-}
+type Conmon_updateContainerResources_Params struct{ capnp.Struct }
 
-func (s Conmon_AttachRequest) ExecSessionIdBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(2)
-	return p.TextBytes(), err
-}
+// Conmon_updateContainerResources_Params_TypeID is the unique identifier for the type Conmon_updateContainerResources_Params.
+const Conmon_updateContainerResources_Params_TypeID = 0x8709f75f61f7fec9
 
-func (s Conmon_AttachRequest) SetExecSessionId(v string) error {
-	return s.Struct.SetText(2, v)
+func NewConmon_updateContainerResources_Params(s *capnp.Segment) (Conmon_updateContainerResources_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_updateContainerResources_Params{st}, err
 }
 
-// Conmon_AttachRequest_List is a list of Conmon_AttachRequest.
-type Conmon_AttachRequest_List = capnp.StructList[Conmon_AttachRequest]
-
-// NewConmon_AttachRequest creates a new list of Conmon_AttachRequest.
-func NewConmon_AttachRequest_List(s *capnp.Segment, sz int32) (Conmon_AttachRequest_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 3}, sz)
-	return capnp.StructList[Conmon_AttachRequest]{l}, err
+func NewRootConmon_updateContainerResources_Params(s *capnp.Segment) (Conmon_updateContainerResources_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_updateContainerResources_Params{st}, err
 }
 
-// Conmon_AttachRequest_Future is a wrapper for a Conmon_AttachRequest promised by a client call.
-type Conmon_AttachRequest_Future struct{ *capnp.Future }
-
-func (p Conmon_AttachRequest_Future) Struct() (Conmon_AttachRequest, error) {
-	s, err := p.Future.Struct()
-	return Conmon_AttachRequest{s}, err
+func ReadRootConmon_updateContainerResources_Params(msg *capnp.Message) (Conmon_updateContainerResources_Params, error) {
+	root, err := msg.Root()
+	return Conmon_updateContainerResources_Params{root.Struct()}, err
 }
 
-type Conmon_AttachResponse struct{ capnp.Struct }
-
-// Conmon_AttachResponse_TypeID is the unique identifier for the type Conmon_AttachResponse.
-const Conmon_AttachResponse_TypeID = 0xace5517aafc86077
+func (s Conmon_updateContainerResources_Params) String() string {
+	str, _ := text.Marshal(0x8709f75f61f7fec9, s.Struct)
+	return str
+}
 
-func NewConmon_AttachResponse(s *capnp.Segment) (Conmon_AttachResponse, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Conmon_AttachResponse{st}, err
+func (s Conmon_updateContainerResources_Params) Request() (Conmon_UpdateContainerResourcesRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_UpdateContainerResourcesRequest{Struct: p.Struct()}, err
 }
 
-func NewRootConmon_AttachResponse(s *capnp.Segment) (Conmon_AttachResponse, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Conmon_AttachResponse{st}, err
+func (s Conmon_updateContainerResources_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
 }
 
-func ReadRootConmon_AttachResponse(msg *capnp.Message) (Conmon_AttachResponse, error) {
-	root, err := msg.Root()
-	return Conmon_AttachResponse{root.Struct()}, err
+func (s Conmon_updateContainerResources_Params) SetRequest(v Conmon_UpdateContainerResourcesRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func (s Conmon_AttachResponse) String() string {
-	str, _ := text.Marshal(0xace5517aafc86077, s.Struct)
-	return str
+// NewRequest sets the request field to a newly
+// allocated Conmon_UpdateContainerResourcesRequest struct, preferring placement in s's segment.
+func (s Conmon_updateContainerResources_Params) NewRequest() (Conmon_UpdateContainerResourcesRequest, error) {
+	ss, err := NewConmon_UpdateContainerResourcesRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_UpdateContainerResourcesRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-// Conmon_AttachResponse_List is a list of Conmon_AttachResponse.
-type Conmon_AttachResponse_List = capnp.StructList[Conmon_AttachResponse]
+// Conmon_updateContainerResources_Params_List is a list of Conmon_updateContainerResources_Params.
+type Conmon_updateContainerResources_Params_List = capnp.StructList[Conmon_updateContainerResources_Params]
 
-// NewConmon_AttachResponse creates a new list of Conmon_AttachResponse.
-func NewConmon_AttachResponse_List(s *capnp.Segment, sz int32) (Conmon_AttachResponse_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[Conmon_AttachResponse]{l}, err
+// NewConmon_updateContainerResources_Params creates a new list of Conmon_updateContainerResources_Params.
+func NewConmon_updateContainerResources_Params_List(s *capnp.Segment, sz int32) (Conmon_updateContainerResources_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_updateContainerResources_Params]{l}, err
 }
 
-// Conmon_AttachResponse_Future is a wrapper for a Conmon_AttachResponse promised by a client call.
-type Conmon_AttachResponse_Future struct{ *capnp.Future }
+// Conmon_updateContainerResources_Params_Future is a wrapper for a Conmon_updateContainerResources_Params promised by a client call.
+type Conmon_updateContainerResources_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_AttachResponse_Future) Struct() (Conmon_AttachResponse, error) {
+func (p Conmon_updateContainerResources_Params_Future) Struct() (Conmon_updateContainerResources_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_AttachResponse{s}, err
+	return Conmon_updateContainerResources_Params{s}, err
 }
 
-type Conmon_ReopenLogRequest struct{ capnp.Struct }
+func (p Conmon_updateContainerResources_Params_Future) Request() Conmon_UpdateContainerResourcesRequest_Future {
+	return Conmon_UpdateContainerResourcesRequest_Future{Future: p.Future.Field(0, nil)}
+}
 
-// Conmon_ReopenLogRequest_TypeID is the unique identifier for the type Conmon_ReopenLogRequest.
-const Conmon_ReopenLogRequest_TypeID = 0xd0476e0f34d1411a
+type Conmon_updateContainerResources_Results struct{ capnp.Struct }
 
-func NewConmon_ReopenLogRequest(s *capnp.Segment) (Conmon_ReopenLogRequest, error) {
+// Conmon_updateContainerResources_Results_TypeID is the unique identifier for the type Conmon_updateContainerResources_Results.
+const Conmon_updateContainerResources_Results_TypeID = 0xe5a25a689d86a6a8
+
+func NewConmon_updateContainerResources_Results(s *capnp.Segment) (Conmon_updateContainerResources_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_ReopenLogRequest{st}, err
+	return Conmon_updateContainerResources_Results{st}, err
 }
 
-func NewRootConmon_ReopenLogRequest(s *capnp.Segment) (Conmon_ReopenLogRequest, error) {
+func NewRootConmon_updateContainerResources_Results(s *capnp.Segment) (Conmon_updateContainerResources_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_ReopenLogRequest{st}, err
+	return Conmon_updateContainerResources_Results{st}, err
 }
 
-func ReadRootConmon_ReopenLogRequest(msg *capnp.Message) (Conmon_ReopenLogRequest, error) {
+func ReadRootConmon_updateContainerResources_Results(msg *capnp.Message) (Conmon_updateContainerResources_Results, error) {
 	root, err := msg.Root()
-	return Conmon_ReopenLogRequest{root.Struct()}, err
+	return Conmon_updateContainerResources_Results{root.Struct()}, err
 }
 
-func (s Conmon_ReopenLogRequest) String() string {
-	str, _ := text.Marshal(0xd0476e0f34d1411a, s.Struct)
+func (s Conmon_updateContainerResources_Results) String() string {
+	str, _ := text.Marshal(0xe5a25a689d86a6a8, s.Struct)
 	return str
 }
 
-func (s Conmon_ReopenLogRequest) Id() (string, error) {
+func (s Conmon_updateContainerResources_Results) Response() (Conmon_UpdateContainerResourcesResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return p.Text(), err
+	return Conmon_UpdateContainerResourcesResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_ReopenLogRequest) HasId() bool {
+func (s Conmon_updateContainerResources_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_ReopenLogRequest) IdBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.TextBytes(), err
+func (s Conmon_updateContainerResources_Results) SetResponse(v Conmon_UpdateContainerResourcesResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func (s Conmon_ReopenLogRequest) SetId(v string) error {
-	return s.Struct.SetText(0, v)
+// NewResponse sets the response field to a newly
+// allocated Conmon_UpdateContainerResourcesResponse struct, preferring placement in s's segment.
+func (s Conmon_updateContainerResources_Results) NewResponse() (Conmon_UpdateContainerResourcesResponse, error) {
+	ss, err := NewConmon_UpdateContainerResourcesResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_UpdateContainerResourcesResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-// Conmon_ReopenLogRequest_List is a list of Conmon_ReopenLogRequest.
-type Conmon_ReopenLogRequest_List = capnp.StructList[Conmon_ReopenLogRequest]
+// Conmon_updateContainerResources_Results_List is a list of Conmon_updateContainerResources_Results.
+type Conmon_updateContainerResources_Results_List = capnp.StructList[Conmon_updateContainerResources_Results]
 
-// NewConmon_ReopenLogRequest creates a new list of Conmon_ReopenLogRequest.
-func NewConmon_ReopenLogRequest_List(s *capnp.Segment, sz int32) (Conmon_ReopenLogRequest_List, error) {
+// NewConmon_updateContainerResources_Results creates a new list of Conmon_updateContainerResources_Results.
+func NewConmon_updateContainerResources_Results_List(s *capnp.Segment, sz int32) (Conmon_updateContainerResources_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_ReopenLogRequest]{l}, err
+	return capnp.StructList[Conmon_updateContainerResources_Results]{l}, err
 }
 
-// Conmon_ReopenLogRequest_Future is a wrapper for a Conmon_ReopenLogRequest promised by a client call.
-type Conmon_ReopenLogRequest_Future struct{ *capnp.Future }
+// Conmon_updateContainerResources_Results_Future is a wrapper for a Conmon_updateContainerResources_Results promised by a client call.
+type Conmon_updateContainerResources_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_ReopenLogRequest_Future) Struct() (Conmon_ReopenLogRequest, error) {
+func (p Conmon_updateContainerResources_Results_Future) Struct() (Conmon_updateContainerResources_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_ReopenLogRequest{s}, err
+	return Conmon_updateContainerResources_Results{s}, err
 }
 
-type Conmon_ReopenLogResponse struct{ capnp.Struct }
+func (p Conmon_updateContainerResources_Results_Future) Response() Conmon_UpdateContainerResourcesResponse_Future {
+	return Conmon_UpdateContainerResourcesResponse_Future{Future: p.Future.Field(0, nil)}
+}
 
-// Conmon_ReopenLogResponse_TypeID is the unique identifier for the type Conmon_ReopenLogResponse.
-const Conmon_ReopenLogResponse_TypeID = 0xa20f49456be85b99
+type Conmon_healthCheck_Params struct{ capnp.Struct }
 
-func NewConmon_ReopenLogResponse(s *capnp.Segment) (Conmon_ReopenLogResponse, error) {
+// Conmon_healthCheck_Params_TypeID is the unique identifier for the type Conmon_healthCheck_Params.
+const Conmon_healthCheck_Params_TypeID = 0x9b41a13e97cb6558
+
+func NewConmon_healthCheck_Params(s *capnp.Segment) (Conmon_healthCheck_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Conmon_ReopenLogResponse{st}, err
+	return Conmon_healthCheck_Params{st}, err
 }
 
-func NewRootConmon_ReopenLogResponse(s *capnp.Segment) (Conmon_ReopenLogResponse, error) {
+func NewRootConmon_healthCheck_Params(s *capnp.Segment) (Conmon_healthCheck_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Conmon_ReopenLogResponse{st}, err
+	return Conmon_healthCheck_Params{st}, err
 }
 
-func ReadRootConmon_ReopenLogResponse(msg *capnp.Message) (Conmon_ReopenLogResponse, error) {
+func ReadRootConmon_healthCheck_Params(msg *capnp.Message) (Conmon_healthCheck_Params, error) {
 	root, err := msg.Root()
-	return Conmon_ReopenLogResponse{root.Struct()}, err
+	return Conmon_healthCheck_Params{root.Struct()}, err
 }
 
-func (s Conmon_ReopenLogResponse) String() string {
-	str, _ := text.Marshal(0xa20f49456be85b99, s.Struct)
+func (s Conmon_healthCheck_Params) String() string {
+	str, _ := text.Marshal(0x9b41a13e97cb6558, s.Struct)
 	return str
 }
 
-// Conmon_ReopenLogResponse_List is a list of Conmon_ReopenLogResponse.
-type Conmon_ReopenLogResponse_List = capnp.StructList[Conmon_ReopenLogResponse]
+// Conmon_healthCheck_Params_List is a list of Conmon_healthCheck_Params.
+type Conmon_healthCheck_Params_List = capnp.StructList[Conmon_healthCheck_Params]
 
-// NewConmon_ReopenLogResponse creates a new list of Conmon_ReopenLogResponse.
-func NewConmon_ReopenLogResponse_List(s *capnp.Segment, sz int32) (Conmon_ReopenLogResponse_List, error) {
+// NewConmon_healthCheck_Params creates a new list of Conmon_healthCheck_Params.
+func NewConmon_healthCheck_Params_List(s *capnp.Segment, sz int32) (Conmon_healthCheck_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[Conmon_ReopenLogResponse]{l}, err
+	return capnp.StructList[Conmon_healthCheck_Params]{l}, err
 }
 
-// Conmon_ReopenLogResponse_Future is a wrapper for a Conmon_ReopenLogResponse promised by a client call.
-type Conmon_ReopenLogResponse_Future struct{ *capnp.Future }
+// Conmon_healthCheck_Params_Future is a wrapper for a Conmon_healthCheck_Params promised by a client call.
+type Conmon_healthCheck_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_ReopenLogResponse_Future) Struct() (Conmon_ReopenLogResponse, error) {
+func (p Conmon_healthCheck_Params_Future) Struct() (Conmon_healthCheck_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_ReopenLogResponse{s}, err
+	return Conmon_healthCheck_Params{s}, err
 }
 
-type Conmon_SetWindowSizeRequest struct{ capnp.Struct }
+type Conmon_healthCheck_Results struct{ capnp.Struct }
 
-// Conmon_SetWindowSizeRequest_TypeID is the unique identifier for the type Conmon_SetWindowSizeRequest.
-const Conmon_SetWindowSizeRequest_TypeID = 0xb5418b8ea8ead17b
+// Conmon_healthCheck_Results_TypeID is the unique identifier for the type Conmon_healthCheck_Results.
+const Conmon_healthCheck_Results_TypeID = 0x96dcde632ac079f4
 
-func NewConmon_SetWindowSizeRequest(s *capnp.Segment) (Conmon_SetWindowSizeRequest, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Conmon_SetWindowSizeRequest{st}, err
+func NewConmon_healthCheck_Results(s *capnp.Segment) (Conmon_healthCheck_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_healthCheck_Results{st}, err
 }
 
-func NewRootConmon_SetWindowSizeRequest(s *capnp.Segment) (Conmon_SetWindowSizeRequest, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
-	return Conmon_SetWindowSizeRequest{st}, err
+func NewRootConmon_healthCheck_Results(s *capnp.Segment) (Conmon_healthCheck_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_healthCheck_Results{st}, err
 }
 
-func ReadRootConmon_SetWindowSizeRequest(msg *capnp.Message) (Conmon_SetWindowSizeRequest, error) {
+func ReadRootConmon_healthCheck_Results(msg *capnp.Message) (Conmon_healthCheck_Results, error) {
 	root, err := msg.Root()
-	return Conmon_SetWindowSizeRequest{root.Struct()}, err
+	return Conmon_healthCheck_Results{root.Struct()}, err
 }
 
-func (s Conmon_SetWindowSizeRequest) String() string {
-	str, _ := text.Marshal(0xb5418b8ea8ead17b, s.Struct)
+func (s Conmon_healthCheck_Results) String() string {
+	str, _ := text.Marshal(0x96dcde632ac079f4, s.Struct)
 	return str
 }
 
-func (s Conmon_SetWindowSizeRequest) Id() (string, error) {
+func (s Conmon_healthCheck_Results) Response() (Conmon_HealthCheckResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return p.Text(), err
+	return Conmon_HealthCheckResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_SetWindowSizeRequest) HasId() bool {
+func (s Conmon_healthCheck_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_SetWindowSizeRequest) IdBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.TextBytes(), err
-}
-
-func (s Conmon_SetWindowSizeRequest) SetId(v string) error {
-	return s.Struct.SetText(0, v)
-}
-
-func (s Conmon_SetWindowSizeRequest) Width() uint16 {
-	return s.Struct.Uint16(0)
+func (s Conmon_healthCheck_Results) SetResponse(v Conmon_HealthCheckResponse) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func (s Conmon_SetWindowSizeRequest) SetWidth(v uint16) {
-	s.Struct.SetUint16(0, v)
+// NewResponse sets the response field to a newly
+// allocated Conmon_HealthCheckResponse struct, preferring placement in s's segment.
+func (s Conmon_healthCheck_Results) NewResponse() (Conmon_HealthCheckResponse, error) {
+	ss, err := NewConmon_HealthCheckResponse(s.Struct.Segment())
+	if err != nil {
+		return Conmon_HealthCheckResponse{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-func (s Conmon_SetWindowSizeRequest) Height() uint16 {
-	return s.Struct.Uint16(2)
-}
+// Conmon_healthCheck_Results_List is a list of Conmon_healthCheck_Results.
+type Conmon_healthCheck_Results_List = capnp.StructList[Conmon_healthCheck_Results]
 
-func (s Conmon_SetWindowSizeRequest) SetHeight(v uint16) {
-	s.Struct.SetUint16(2, v)
+// NewConmon_healthCheck_Results creates a new list of Conmon_healthCheck_Results.
+func NewConmon_healthCheck_Results_List(s *capnp.Segment, sz int32) (Conmon_healthCheck_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_healthCheck_Results]{l}, err
 }
 
-// Conmon_SetWindowSizeRequest_List is a list of Conmon_SetWindowSizeRequest.
-type Conmon_SetWindowSizeRequest_List = capnp.StructList[Conmon_SetWindowSizeRequest]
+// Conmon_healthCheck_Results_Future is a wrapper for a Conmon_healthCheck_Results promised by a client call.
+type Conmon_healthCheck_Results_Future struct{ *capnp.Future }
 
-// NewConmon_SetWindowSizeRequest creates a new list of Conmon_SetWindowSizeRequest.
-func NewConmon_SetWindowSizeRequest_List(s *capnp.Segment, sz int32) (Conmon_SetWindowSizeRequest_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_SetWindowSizeRequest]{l}, err
+func (p Conmon_healthCheck_Results_Future) Struct() (Conmon_healthCheck_Results, error) {
+	s, err := p.Future.Struct()
+	return Conmon_healthCheck_Results{s}, err
 }
 
-// Conmon_SetWindowSizeRequest_Future is a wrapper for a Conmon_SetWindowSizeRequest promised by a client call.
-type Conmon_SetWindowSizeRequest_Future struct{ *capnp.Future }
-
-func (p Conmon_SetWindowSizeRequest_Future) Struct() (Conmon_SetWindowSizeRequest, error) {
-	s, err := p.Future.Struct()
-	return Conmon_SetWindowSizeRequest{s}, err
+func (p Conmon_healthCheck_Results_Future) Response() Conmon_HealthCheckResponse_Future {
+	return Conmon_HealthCheckResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_SetWindowSizeResponse struct{ capnp.Struct }
+type Conmon_cancelRequest_Params struct{ capnp.Struct }
 
-// Conmon_SetWindowSizeResponse_TypeID is the unique identifier for the type Conmon_SetWindowSizeResponse.
-const Conmon_SetWindowSizeResponse_TypeID = 0xf9b3cd8033aba1f8
+// Conmon_cancelRequest_Params_TypeID is the unique identifier for the type Conmon_cancelRequest_Params.
+const Conmon_cancelRequest_Params_TypeID = 0xeedd21a69204efcb
 
-func NewConmon_SetWindowSizeResponse(s *capnp.Segment) (Conmon_SetWindowSizeResponse, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Conmon_SetWindowSizeResponse{st}, err
+func NewConmon_cancelRequest_Params(s *capnp.Segment) (Conmon_cancelRequest_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_cancelRequest_Params{st}, err
 }
 
-func NewRootConmon_SetWindowSizeResponse(s *capnp.Segment) (Conmon_SetWindowSizeResponse, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Conmon_SetWindowSizeResponse{st}, err
+func NewRootConmon_cancelRequest_Params(s *capnp.Segment) (Conmon_cancelRequest_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Conmon_cancelRequest_Params{st}, err
 }
 
-func ReadRootConmon_SetWindowSizeResponse(msg *capnp.Message) (Conmon_SetWindowSizeResponse, error) {
+func ReadRootConmon_cancelRequest_Params(msg *capnp.Message) (Conmon_cancelRequest_Params, error) {
 	root, err := msg.Root()
-	return Conmon_SetWindowSizeResponse{root.Struct()}, err
+	return Conmon_cancelRequest_Params{root.Struct()}, err
 }
 
-func (s Conmon_SetWindowSizeResponse) String() string {
-	str, _ := text.Marshal(0xf9b3cd8033aba1f8, s.Struct)
+func (s Conmon_cancelRequest_Params) String() string {
+	str, _ := text.Marshal(0xeedd21a69204efcb, s.Struct)
 	return str
 }
 
-// Conmon_SetWindowSizeResponse_List is a list of Conmon_SetWindowSizeResponse.
-type Conmon_SetWindowSizeResponse_List = capnp.StructList[Conmon_SetWindowSizeResponse]
-
-// NewConmon_SetWindowSizeResponse creates a new list of Conmon_SetWindowSizeResponse.
-func NewConmon_SetWindowSizeResponse_List(s *capnp.Segment, sz int32) (Conmon_SetWindowSizeResponse_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[Conmon_SetWindowSizeResponse]{l}, err
+func (s Conmon_cancelRequest_Params) Request() (Conmon_CancelRequestRequest, error) {
+	p, err := s.Struct.Ptr(0)
+	return Conmon_CancelRequestRequest{Struct: p.Struct()}, err
 }
 
-// Conmon_SetWindowSizeResponse_Future is a wrapper for a Conmon_SetWindowSizeResponse promised by a client call.
-type Conmon_SetWindowSizeResponse_Future struct{ *capnp.Future }
-
-func (p Conmon_SetWindowSizeResponse_Future) Struct() (Conmon_SetWindowSizeResponse, error) {
-	s, err := p.Future.Struct()
-	return Conmon_SetWindowSizeResponse{s}, err
+func (s Conmon_cancelRequest_Params) HasRequest() bool {
+	return s.Struct.HasPtr(0)
 }
 
-type Conmon_version_Params struct{ capnp.Struct }
-
-// Conmon_version_Params_TypeID is the unique identifier for the type Conmon_version_Params.
-const Conmon_version_Params_TypeID = 0xcc2f70676afee4e7
-
-func NewConmon_version_Params(s *capnp.Segment) (Conmon_version_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Conmon_version_Params{st}, err
+func (s Conmon_cancelRequest_Params) SetRequest(v Conmon_CancelRequestRequest) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
-func NewRootConmon_version_Params(s *capnp.Segment) (Conmon_version_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Conmon_version_Params{st}, err
+// NewRequest sets the request field to a newly
+// allocated Conmon_CancelRequestRequest struct, preferring placement in s's segment.
+func (s Conmon_cancelRequest_Params) NewRequest() (Conmon_CancelRequestRequest, error) {
+	ss, err := NewConmon_CancelRequestRequest(s.Struct.Segment())
+	if err != nil {
+		return Conmon_CancelRequestRequest{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-func ReadRootConmon_version_Params(msg *capnp.Message) (Conmon_version_Params, error) {
-	root, err := msg.Root()
-	return Conmon_version_Params{root.Struct()}, err
-}
+// Conmon_cancelRequest_Params_List is a list of Conmon_cancelRequest_Params.
+type Conmon_cancelRequest_Params_List = capnp.StructList[Conmon_cancelRequest_Params]
 
-func (s Conmon_version_Params) String() string {
-	str, _ := text.Marshal(0xcc2f70676afee4e7, s.Struct)
-	return str
+// NewConmon_cancelRequest_Params creates a new list of Conmon_cancelRequest_Params.
+func NewConmon_cancelRequest_Params_List(s *capnp.Segment, sz int32) (Conmon_cancelRequest_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return capnp.StructList[Conmon_cancelRequest_Params]{l}, err
 }
 
-// Conmon_version_Params_List is a list of Conmon_version_Params.
-type Conmon_version_Params_List = capnp.StructList[Conmon_version_Params]
+// Conmon_cancelRequest_Params_Future is a wrapper for a Conmon_cancelRequest_Params promised by a client call.
+type Conmon_cancelRequest_Params_Future struct{ *capnp.Future }
 
-// NewConmon_version_Params creates a new list of Conmon_version_Params.
-func NewConmon_version_Params_List(s *capnp.Segment, sz int32) (Conmon_version_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return capnp.StructList[Conmon_version_Params]{l}, err
+func (p Conmon_cancelRequest_Params_Future) Struct() (Conmon_cancelRequest_Params, error) {
+	s, err := p.Future.Struct()
+	return Conmon_cancelRequest_Params{s}, err
 }
 
-// Conmon_version_Params_Future is a wrapper for a Conmon_version_Params promised by a client call.
-type Conmon_version_Params_Future struct{ *capnp.Future }
-
-func (p Conmon_version_Params_Future) Struct() (Conmon_version_Params, error) {
-	s, err := p.Future.Struct()
-	return Conmon_version_Params{s}, err
+func (p Conmon_cancelRequest_Params_Future) Request() Conmon_CancelRequestRequest_Future {
+	return Conmon_CancelRequestRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_version_Results struct{ capnp.Struct }
+type Conmon_cancelRequest_Results struct{ capnp.Struct }
 
-// Conmon_version_Results_TypeID is the unique identifier for the type Conmon_version_Results.
-const Conmon_version_Results_TypeID = 0xe313695ea9477b30
+// Conmon_cancelRequest_Results_TypeID is the unique identifier for the type Conmon_cancelRequest_Results.
+const Conmon_cancelRequest_Results_TypeID = 0xf2725610fbee91f4
 
-func NewConmon_version_Results(s *capnp.Segment) (Conmon_version_Results, error) {
+func NewConmon_cancelRequest_Results(s *capnp.Segment) (Conmon_cancelRequest_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_version_Results{st}, err
+	return Conmon_cancelRequest_Results{st}, err
 }
 
-func NewRootConmon_version_Results(s *capnp.Segment) (Conmon_version_Results, error) {
+func NewRootConmon_cancelRequest_Results(s *capnp.Segment) (Conmon_cancelRequest_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_version_Results{st}, err
+	return Conmon_cancelRequest_Results{st}, err
 }
 
-func ReadRootConmon_version_Results(msg *capnp.Message) (Conmon_version_Results, error) {
+func ReadRootConmon_cancelRequest_Results(msg *capnp.Message) (Conmon_cancelRequest_Results, error) {
 	root, err := msg.Root()
-	return Conmon_version_Results{root.Struct()}, err
+	return Conmon_cancelRequest_Results{root.Struct()}, err
 }
 
-func (s Conmon_version_Results) String() string {
-	str, _ := text.Marshal(0xe313695ea9477b30, s.Struct)
+func (s Conmon_cancelRequest_Results) String() string {
+	str, _ := text.Marshal(0xf2725610fbee91f4, s.Struct)
 	return str
 }
 
-func (s Conmon_version_Results) Response() (Conmon_VersionResponse, error) {
+func (s Conmon_cancelRequest_Results) Response() (Conmon_CancelRequestResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_VersionResponse{Struct: p.Struct()}, err
+	return Conmon_CancelRequestResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_version_Results) HasResponse() bool {
+func (s Conmon_cancelRequest_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_version_Results) SetResponse(v Conmon_VersionResponse) error {
+func (s Conmon_cancelRequest_Results) SetResponse(v Conmon_CancelRequestResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResponse sets the response field to a newly
-// allocated Conmon_VersionResponse struct, preferring placement in s's segment.
-func (s Conmon_version_Results) NewResponse() (Conmon_VersionResponse, error) {
-	ss, err := NewConmon_VersionResponse(s.Struct.Segment())
+// allocated Conmon_CancelRequestResponse struct, preferring placement in s's segment.
+func (s Conmon_cancelRequest_Results) NewResponse() (Conmon_CancelRequestResponse, error) {
+	ss, err := NewConmon_CancelRequestResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_VersionResponse{}, err
+		return Conmon_CancelRequestResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_version_Results_List is a list of Conmon_version_Results.
-type Conmon_version_Results_List = capnp.StructList[Conmon_version_Results]
+// Conmon_cancelRequest_Results_List is a list of Conmon_cancelRequest_Results.
+type Conmon_cancelRequest_Results_List = capnp.StructList[Conmon_cancelRequest_Results]
 
-// NewConmon_version_Results creates a new list of Conmon_version_Results.
-func NewConmon_version_Results_List(s *capnp.Segment, sz int32) (Conmon_version_Results_List, error) {
+// NewConmon_cancelRequest_Results creates a new list of Conmon_cancelRequest_Results.
+func NewConmon_cancelRequest_Results_List(s *capnp.Segment, sz int32) (Conmon_cancelRequest_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_version_Results]{l}, err
+	return capnp.StructList[Conmon_cancelRequest_Results]{l}, err
 }
 
-// Conmon_version_Results_Future is a wrapper for a Conmon_version_Results promised by a client call.
-type Conmon_version_Results_Future struct{ *capnp.Future }
+// Conmon_cancelRequest_Results_Future is a wrapper for a Conmon_cancelRequest_Results promised by a client call.
+type Conmon_cancelRequest_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_version_Results_Future) Struct() (Conmon_version_Results, error) {
+func (p Conmon_cancelRequest_Results_Future) Struct() (Conmon_cancelRequest_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_version_Results{s}, err
+	return Conmon_cancelRequest_Results{s}, err
 }
 
-func (p Conmon_version_Results_Future) Response() Conmon_VersionResponse_Future {
-	return Conmon_VersionResponse_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_cancelRequest_Results_Future) Response() Conmon_CancelRequestResponse_Future {
+	return Conmon_CancelRequestResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_createContainer_Params struct{ capnp.Struct }
+type Conmon_getTombstone_Params struct{ capnp.Struct }
 
-// Conmon_createContainer_Params_TypeID is the unique identifier for the type Conmon_createContainer_Params.
-const Conmon_createContainer_Params_TypeID = 0xf44732c48f949ab8
+// Conmon_getTombstone_Params_TypeID is the unique identifier for the type Conmon_getTombstone_Params.
+const Conmon_getTombstone_Params_TypeID = 0xd74ba8d601b5841e
 
-func NewConmon_createContainer_Params(s *capnp.Segment) (Conmon_createContainer_Params, error) {
+func NewConmon_getTombstone_Params(s *capnp.Segment) (Conmon_getTombstone_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_createContainer_Params{st}, err
+	return Conmon_getTombstone_Params{st}, err
 }
 
-func NewRootConmon_createContainer_Params(s *capnp.Segment) (Conmon_createContainer_Params, error) {
+func NewRootConmon_getTombstone_Params(s *capnp.Segment) (Conmon_getTombstone_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_createContainer_Params{st}, err
+	return Conmon_getTombstone_Params{st}, err
 }
 
-func ReadRootConmon_createContainer_Params(msg *capnp.Message) (Conmon_createContainer_Params, error) {
+func ReadRootConmon_getTombstone_Params(msg *capnp.Message) (Conmon_getTombstone_Params, error) {
 	root, err := msg.Root()
-	return Conmon_createContainer_Params{root.Struct()}, err
+	return Conmon_getTombstone_Params{root.Struct()}, err
 }
 
-func (s Conmon_createContainer_Params) String() string {
-	str, _ := text.Marshal(0xf44732c48f949ab8, s.Struct)
+func (s Conmon_getTombstone_Params) String() string {
+	str, _ := text.Marshal(0xd74ba8d601b5841e, s.Struct)
 	return str
 }
 
-func (s Conmon_createContainer_Params) Request() (Conmon_CreateContainerRequest, error) {
+func (s Conmon_getTombstone_Params) Request() (Conmon_GetTombstoneRequest, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_CreateContainerRequest{Struct: p.Struct()}, err
+	return Conmon_GetTombstoneRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_createContainer_Params) HasRequest() bool {
+func (s Conmon_getTombstone_Params) HasRequest() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_createContainer_Params) SetRequest(v Conmon_CreateContainerRequest) error {
+func (s Conmon_getTombstone_Params) SetRequest(v Conmon_GetTombstoneRequest) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewRequest sets the request field to a newly
-// allocated Conmon_CreateContainerRequest struct, preferring placement in s's segment.
-func (s Conmon_createContainer_Params) NewRequest() (Conmon_CreateContainerRequest, error) {
-	ss, err := NewConmon_CreateContainerRequest(s.Struct.Segment())
+// allocated Conmon_GetTombstoneRequest struct, preferring placement in s's segment.
+func (s Conmon_getTombstone_Params) NewRequest() (Conmon_GetTombstoneRequest, error) {
+	ss, err := NewConmon_GetTombstoneRequest(s.Struct.Segment())
 	if err != nil {
-		return Conmon_CreateContainerRequest{}, err
+		return Conmon_GetTombstoneRequest{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_createContainer_Params_List is a list of Conmon_createContainer_Params.
-type Conmon_createContainer_Params_List = capnp.StructList[Conmon_createContainer_Params]
+// Conmon_getTombstone_Params_List is a list of Conmon_getTombstone_Params.
+type Conmon_getTombstone_Params_List = capnp.StructList[Conmon_getTombstone_Params]
 
-// NewConmon_createContainer_Params creates a new list of Conmon_createContainer_Params.
-func NewConmon_createContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_createContainer_Params_List, error) {
+// NewConmon_getTombstone_Params creates a new list of Conmon_getTombstone_Params.
+func NewConmon_getTombstone_Params_List(s *capnp.Segment, sz int32) (Conmon_getTombstone_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_createContainer_Params]{l}, err
+	return capnp.StructList[Conmon_getTombstone_Params]{l}, err
 }
 
-// Conmon_createContainer_Params_Future is a wrapper for a Conmon_createContainer_Params promised by a client call.
-type Conmon_createContainer_Params_Future struct{ *capnp.Future }
+// Conmon_getTombstone_Params_Future is a wrapper for a Conmon_getTombstone_Params promised by a client call.
+type Conmon_getTombstone_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_createContainer_Params_Future) Struct() (Conmon_createContainer_Params, error) {
+func (p Conmon_getTombstone_Params_Future) Struct() (Conmon_getTombstone_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_createContainer_Params{s}, err
+	return Conmon_getTombstone_Params{s}, err
 }
 
-func (p Conmon_createContainer_Params_Future) Request() Conmon_CreateContainerRequest_Future {
-	return Conmon_CreateContainerRequest_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_getTombstone_Params_Future) Request() Conmon_GetTombstoneRequest_Future {
+	return Conmon_GetTombstoneRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_createContainer_Results struct{ capnp.Struct }
+type Conmon_getTombstone_Results struct{ capnp.Struct }
 
-// Conmon_createContainer_Results_TypeID is the unique identifier for the type Conmon_createContainer_Results.
-const Conmon_createContainer_Results_TypeID = 0xceba3c1a97be15f8
+// Conmon_getTombstone_Results_TypeID is the unique identifier for the type Conmon_getTombstone_Results.
+const Conmon_getTombstone_Results_TypeID = 0xdf59f911433f86e4
 
-func NewConmon_createContainer_Results(s *capnp.Segment) (Conmon_createContainer_Results, error) {
+func NewConmon_getTombstone_Results(s *capnp.Segment) (Conmon_getTombstone_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_createContainer_Results{st}, err
+	return Conmon_getTombstone_Results{st}, err
 }
 
-func NewRootConmon_createContainer_Results(s *capnp.Segment) (Conmon_createContainer_Results, error) {
+func NewRootConmon_getTombstone_Results(s *capnp.Segment) (Conmon_getTombstone_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_createContainer_Results{st}, err
+	return Conmon_getTombstone_Results{st}, err
 }
 
-func ReadRootConmon_createContainer_Results(msg *capnp.Message) (Conmon_createContainer_Results, error) {
+func ReadRootConmon_getTombstone_Results(msg *capnp.Message) (Conmon_getTombstone_Results, error) {
 	root, err := msg.Root()
-	return Conmon_createContainer_Results{root.Struct()}, err
+	return Conmon_getTombstone_Results{root.Struct()}, err
 }
 
-func (s Conmon_createContainer_Results) String() string {
-	str, _ := text.Marshal(0xceba3c1a97be15f8, s.Struct)
+func (s Conmon_getTombstone_Results) String() string {
+	str, _ := text.Marshal(0xdf59f911433f86e4, s.Struct)
 	return str
 }
 
-func (s Conmon_createContainer_Results) Response() (Conmon_CreateContainerResponse, error) {
+func (s Conmon_getTombstone_Results) Response() (Conmon_GetTombstoneResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_CreateContainerResponse{Struct: p.Struct()}, err
+	return Conmon_GetTombstoneResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_createContainer_Results) HasResponse() bool {
+func (s Conmon_getTombstone_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_createContainer_Results) SetResponse(v Conmon_CreateContainerResponse) error {
+func (s Conmon_getTombstone_Results) SetResponse(v Conmon_GetTombstoneResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResponse sets the response field to a newly
-// allocated Conmon_CreateContainerResponse struct, preferring placement in s's segment.
-func (s Conmon_createContainer_Results) NewResponse() (Conmon_CreateContainerResponse, error) {
-	ss, err := NewConmon_CreateContainerResponse(s.Struct.Segment())
+// allocated Conmon_GetTombstoneResponse struct, preferring placement in s's segment.
+func (s Conmon_getTombstone_Results) NewResponse() (Conmon_GetTombstoneResponse, error) {
+	ss, err := NewConmon_GetTombstoneResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_CreateContainerResponse{}, err
+		return Conmon_GetTombstoneResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_createContainer_Results_List is a list of Conmon_createContainer_Results.
-type Conmon_createContainer_Results_List = capnp.StructList[Conmon_createContainer_Results]
+// Conmon_getTombstone_Results_List is a list of Conmon_getTombstone_Results.
+type Conmon_getTombstone_Results_List = capnp.StructList[Conmon_getTombstone_Results]
 
-// NewConmon_createContainer_Results creates a new list of Conmon_createContainer_Results.
-func NewConmon_createContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_createContainer_Results_List, error) {
+// NewConmon_getTombstone_Results creates a new list of Conmon_getTombstone_Results.
+func NewConmon_getTombstone_Results_List(s *capnp.Segment, sz int32) (Conmon_getTombstone_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_createContainer_Results]{l}, err
+	return capnp.StructList[Conmon_getTombstone_Results]{l}, err
 }
 
-// Conmon_createContainer_Results_Future is a wrapper for a Conmon_createContainer_Results promised by a client call.
-type Conmon_createContainer_Results_Future struct{ *capnp.Future }
+// Conmon_getTombstone_Results_Future is a wrapper for a Conmon_getTombstone_Results promised by a client call.
+type Conmon_getTombstone_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_createContainer_Results_Future) Struct() (Conmon_createContainer_Results, error) {
+func (p Conmon_getTombstone_Results_Future) Struct() (Conmon_getTombstone_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_createContainer_Results{s}, err
+	return Conmon_getTombstone_Results{s}, err
 }
 
-func (p Conmon_createContainer_Results_Future) Response() Conmon_CreateContainerResponse_Future {
-	return Conmon_CreateContainerResponse_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_getTombstone_Results_Future) Response() Conmon_GetTombstoneResponse_Future {
+	return Conmon_GetTombstoneResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_execSyncContainer_Params struct{ capnp.Struct }
+type Conmon_getEvents_Params struct{ capnp.Struct }
 
-// Conmon_execSyncContainer_Params_TypeID is the unique identifier for the type Conmon_execSyncContainer_Params.
-const Conmon_execSyncContainer_Params_TypeID = 0x83479da67279e173
+// Conmon_getEvents_Params_TypeID is the unique identifier for the type Conmon_getEvents_Params.
+const Conmon_getEvents_Params_TypeID = 0xb6415168af7a33a6
 
-func NewConmon_execSyncContainer_Params(s *capnp.Segment) (Conmon_execSyncContainer_Params, error) {
+func NewConmon_getEvents_Params(s *capnp.Segment) (Conmon_getEvents_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_execSyncContainer_Params{st}, err
+	return Conmon_getEvents_Params{st}, err
 }
 
-func NewRootConmon_execSyncContainer_Params(s *capnp.Segment) (Conmon_execSyncContainer_Params, error) {
+func NewRootConmon_getEvents_Params(s *capnp.Segment) (Conmon_getEvents_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_execSyncContainer_Params{st}, err
+	return Conmon_getEvents_Params{st}, err
 }
 
-func ReadRootConmon_execSyncContainer_Params(msg *capnp.Message) (Conmon_execSyncContainer_Params, error) {
+func ReadRootConmon_getEvents_Params(msg *capnp.Message) (Conmon_getEvents_Params, error) {
 	root, err := msg.Root()
-	return Conmon_execSyncContainer_Params{root.Struct()}, err
+	return Conmon_getEvents_Params{root.Struct()}, err
 }
 
-func (s Conmon_execSyncContainer_Params) String() string {
-	str, _ := text.Marshal(0x83479da67279e173, s.Struct)
+func (s Conmon_getEvents_Params) String() string {
+	str, _ := text.Marshal(0xb6415168af7a33a6, s.Struct)
 	return str
 }
 
-func (s Conmon_execSyncContainer_Params) Request() (Conmon_ExecSyncContainerRequest, error) {
+func (s Conmon_getEvents_Params) Request() (Conmon_GetEventsRequest, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_ExecSyncContainerRequest{Struct: p.Struct()}, err
+	return Conmon_GetEventsRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_execSyncContainer_Params) HasRequest() bool {
+func (s Conmon_getEvents_Params) HasRequest() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_execSyncContainer_Params) SetRequest(v Conmon_ExecSyncContainerRequest) error {
+func (s Conmon_getEvents_Params) SetRequest(v Conmon_GetEventsRequest) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewRequest sets the request field to a newly
-// allocated Conmon_ExecSyncContainerRequest struct, preferring placement in s's segment.
-func (s Conmon_execSyncContainer_Params) NewRequest() (Conmon_ExecSyncContainerRequest, error) {
-	ss, err := NewConmon_ExecSyncContainerRequest(s.Struct.Segment())
+// allocated Conmon_GetEventsRequest struct, preferring placement in s's segment.
+func (s Conmon_getEvents_Params) NewRequest() (Conmon_GetEventsRequest, error) {
+	ss, err := NewConmon_GetEventsRequest(s.Struct.Segment())
 	if err != nil {
-		return Conmon_ExecSyncContainerRequest{}, err
+		return Conmon_GetEventsRequest{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_execSyncContainer_Params_List is a list of Conmon_execSyncContainer_Params.
-type Conmon_execSyncContainer_Params_List = capnp.StructList[Conmon_execSyncContainer_Params]
+// Conmon_getEvents_Params_List is a list of Conmon_getEvents_Params.
+type Conmon_getEvents_Params_List = capnp.StructList[Conmon_getEvents_Params]
 
-// NewConmon_execSyncContainer_Params creates a new list of Conmon_execSyncContainer_Params.
-func NewConmon_execSyncContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_execSyncContainer_Params_List, error) {
+// NewConmon_getEvents_Params creates a new list of Conmon_getEvents_Params.
+func NewConmon_getEvents_Params_List(s *capnp.Segment, sz int32) (Conmon_getEvents_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_execSyncContainer_Params]{l}, err
+	return capnp.StructList[Conmon_getEvents_Params]{l}, err
 }
 
-// Conmon_execSyncContainer_Params_Future is a wrapper for a Conmon_execSyncContainer_Params promised by a client call.
-type Conmon_execSyncContainer_Params_Future struct{ *capnp.Future }
+// Conmon_getEvents_Params_Future is a wrapper for a Conmon_getEvents_Params promised by a client call.
+type Conmon_getEvents_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_execSyncContainer_Params_Future) Struct() (Conmon_execSyncContainer_Params, error) {
+func (p Conmon_getEvents_Params_Future) Struct() (Conmon_getEvents_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_execSyncContainer_Params{s}, err
+	return Conmon_getEvents_Params{s}, err
 }
 
-func (p Conmon_execSyncContainer_Params_Future) Request() Conmon_ExecSyncContainerRequest_Future {
-	return Conmon_ExecSyncContainerRequest_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_getEvents_Params_Future) Request() Conmon_GetEventsRequest_Future {
+	return Conmon_GetEventsRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_execSyncContainer_Results struct{ capnp.Struct }
+type Conmon_getEvents_Results struct{ capnp.Struct }
 
-// Conmon_execSyncContainer_Results_TypeID is the unique identifier for the type Conmon_execSyncContainer_Results.
-const Conmon_execSyncContainer_Results_TypeID = 0xf8e86a5c0baa01bc
+// Conmon_getEvents_Results_TypeID is the unique identifier for the type Conmon_getEvents_Results.
+const Conmon_getEvents_Results_TypeID = 0xe1f5eafd8167552c
 
-func NewConmon_execSyncContainer_Results(s *capnp.Segment) (Conmon_execSyncContainer_Results, error) {
+func NewConmon_getEvents_Results(s *capnp.Segment) (Conmon_getEvents_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_execSyncContainer_Results{st}, err
+	return Conmon_getEvents_Results{st}, err
 }
 
-func NewRootConmon_execSyncContainer_Results(s *capnp.Segment) (Conmon_execSyncContainer_Results, error) {
+func NewRootConmon_getEvents_Results(s *capnp.Segment) (Conmon_getEvents_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_execSyncContainer_Results{st}, err
+	return Conmon_getEvents_Results{st}, err
 }
 
-func ReadRootConmon_execSyncContainer_Results(msg *capnp.Message) (Conmon_execSyncContainer_Results, error) {
+func ReadRootConmon_getEvents_Results(msg *capnp.Message) (Conmon_getEvents_Results, error) {
 	root, err := msg.Root()
-	return Conmon_execSyncContainer_Results{root.Struct()}, err
+	return Conmon_getEvents_Results{root.Struct()}, err
 }
 
-func (s Conmon_execSyncContainer_Results) String() string {
-	str, _ := text.Marshal(0xf8e86a5c0baa01bc, s.Struct)
+func (s Conmon_getEvents_Results) String() string {
+	str, _ := text.Marshal(0xe1f5eafd8167552c, s.Struct)
 	return str
 }
 
-func (s Conmon_execSyncContainer_Results) Response() (Conmon_ExecSyncContainerResponse, error) {
+func (s Conmon_getEvents_Results) Response() (Conmon_GetEventsResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_ExecSyncContainerResponse{Struct: p.Struct()}, err
+	return Conmon_GetEventsResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_execSyncContainer_Results) HasResponse() bool {
+func (s Conmon_getEvents_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_execSyncContainer_Results) SetResponse(v Conmon_ExecSyncContainerResponse) error {
+func (s Conmon_getEvents_Results) SetResponse(v Conmon_GetEventsResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResponse sets the response field to a newly
-// allocated Conmon_ExecSyncContainerResponse struct, preferring placement in s's segment.
-func (s Conmon_execSyncContainer_Results) NewResponse() (Conmon_ExecSyncContainerResponse, error) {
-	ss, err := NewConmon_ExecSyncContainerResponse(s.Struct.Segment())
+// allocated Conmon_GetEventsResponse struct, preferring placement in s's segment.
+func (s Conmon_getEvents_Results) NewResponse() (Conmon_GetEventsResponse, error) {
+	ss, err := NewConmon_GetEventsResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_ExecSyncContainerResponse{}, err
+		return Conmon_GetEventsResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_execSyncContainer_Results_List is a list of Conmon_execSyncContainer_Results.
-type Conmon_execSyncContainer_Results_List = capnp.StructList[Conmon_execSyncContainer_Results]
+// Conmon_getEvents_Results_List is a list of Conmon_getEvents_Results.
+type Conmon_getEvents_Results_List = capnp.StructList[Conmon_getEvents_Results]
 
-// NewConmon_execSyncContainer_Results creates a new list of Conmon_execSyncContainer_Results.
-func NewConmon_execSyncContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_execSyncContainer_Results_List, error) {
+// NewConmon_getEvents_Results creates a new list of Conmon_getEvents_Results.
+func NewConmon_getEvents_Results_List(s *capnp.Segment, sz int32) (Conmon_getEvents_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_execSyncContainer_Results]{l}, err
+	return capnp.StructList[Conmon_getEvents_Results]{l}, err
 }
 
-// Conmon_execSyncContainer_Results_Future is a wrapper for a Conmon_execSyncContainer_Results promised by a client call.
-type Conmon_execSyncContainer_Results_Future struct{ *capnp.Future }
+// Conmon_getEvents_Results_Future is a wrapper for a Conmon_getEvents_Results promised by a client call.
+type Conmon_getEvents_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_execSyncContainer_Results_Future) Struct() (Conmon_execSyncContainer_Results, error) {
+func (p Conmon_getEvents_Results_Future) Struct() (Conmon_getEvents_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_execSyncContainer_Results{s}, err
+	return Conmon_getEvents_Results{s}, err
 }
 
-func (p Conmon_execSyncContainer_Results_Future) Response() Conmon_ExecSyncContainerResponse_Future {
-	return Conmon_ExecSyncContainerResponse_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_getEvents_Results_Future) Response() Conmon_GetEventsResponse_Future {
+	return Conmon_GetEventsResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_attachContainer_Params struct{ capnp.Struct }
+type Conmon_inspectState_Params struct{ capnp.Struct }
 
-// Conmon_attachContainer_Params_TypeID is the unique identifier for the type Conmon_attachContainer_Params.
-const Conmon_attachContainer_Params_TypeID = 0xaa2f3c8ad1c3af24
+// Conmon_inspectState_Params_TypeID is the unique identifier for the type Conmon_inspectState_Params.
+const Conmon_inspectState_Params_TypeID = 0xe4a203379a3b5085
 
-func NewConmon_attachContainer_Params(s *capnp.Segment) (Conmon_attachContainer_Params, error) {
+func NewConmon_inspectState_Params(s *capnp.Segment) (Conmon_inspectState_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_attachContainer_Params{st}, err
+	return Conmon_inspectState_Params{st}, err
 }
 
-func NewRootConmon_attachContainer_Params(s *capnp.Segment) (Conmon_attachContainer_Params, error) {
+func NewRootConmon_inspectState_Params(s *capnp.Segment) (Conmon_inspectState_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_attachContainer_Params{st}, err
+	return Conmon_inspectState_Params{st}, err
 }
 
-func ReadRootConmon_attachContainer_Params(msg *capnp.Message) (Conmon_attachContainer_Params, error) {
+func ReadRootConmon_inspectState_Params(msg *capnp.Message) (Conmon_inspectState_Params, error) {
 	root, err := msg.Root()
-	return Conmon_attachContainer_Params{root.Struct()}, err
+	return Conmon_inspectState_Params{root.Struct()}, err
 }
 
-func (s Conmon_attachContainer_Params) String() string {
-	str, _ := text.Marshal(0xaa2f3c8ad1c3af24, s.Struct)
+func (s Conmon_inspectState_Params) String() string {
+	str, _ := text.Marshal(0xe4a203379a3b5085, s.Struct)
 	return str
 }
 
-func (s Conmon_attachContainer_Params) Request() (Conmon_AttachRequest, error) {
+func (s Conmon_inspectState_Params) Request() (Conmon_InspectStateRequest, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_AttachRequest{Struct: p.Struct()}, err
+	return Conmon_InspectStateRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_attachContainer_Params) HasRequest() bool {
+func (s Conmon_inspectState_Params) HasRequest() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_attachContainer_Params) SetRequest(v Conmon_AttachRequest) error {
+func (s Conmon_inspectState_Params) SetRequest(v Conmon_InspectStateRequest) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewRequest sets the request field to a newly
-// allocated Conmon_AttachRequest struct, preferring placement in s's segment.
-func (s Conmon_attachContainer_Params) NewRequest() (Conmon_AttachRequest, error) {
-	ss, err := NewConmon_AttachRequest(s.Struct.Segment())
+// allocated Conmon_InspectStateRequest struct, preferring placement in s's segment.
+func (s Conmon_inspectState_Params) NewRequest() (Conmon_InspectStateRequest, error) {
+	ss, err := NewConmon_InspectStateRequest(s.Struct.Segment())
 	if err != nil {
-		return Conmon_AttachRequest{}, err
+		return Conmon_InspectStateRequest{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_attachContainer_Params_List is a list of Conmon_attachContainer_Params.
-type Conmon_attachContainer_Params_List = capnp.StructList[Conmon_attachContainer_Params]
+// Conmon_inspectState_Params_List is a list of Conmon_inspectState_Params.
+type Conmon_inspectState_Params_List = capnp.StructList[Conmon_inspectState_Params]
 
-// NewConmon_attachContainer_Params creates a new list of Conmon_attachContainer_Params.
-func NewConmon_attachContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_attachContainer_Params_List, error) {
+// NewConmon_inspectState_Params creates a new list of Conmon_inspectState_Params.
+func NewConmon_inspectState_Params_List(s *capnp.Segment, sz int32) (Conmon_inspectState_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_attachContainer_Params]{l}, err
+	return capnp.StructList[Conmon_inspectState_Params]{l}, err
 }
 
-// Conmon_attachContainer_Params_Future is a wrapper for a Conmon_attachContainer_Params promised by a client call.
-type Conmon_attachContainer_Params_Future struct{ *capnp.Future }
+// Conmon_inspectState_Params_Future is a wrapper for a Conmon_inspectState_Params promised by a client call.
+type Conmon_inspectState_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_attachContainer_Params_Future) Struct() (Conmon_attachContainer_Params, error) {
+func (p Conmon_inspectState_Params_Future) Struct() (Conmon_inspectState_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_attachContainer_Params{s}, err
+	return Conmon_inspectState_Params{s}, err
 }
 
-func (p Conmon_attachContainer_Params_Future) Request() Conmon_AttachRequest_Future {
-	return Conmon_AttachRequest_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_inspectState_Params_Future) Request() Conmon_InspectStateRequest_Future {
+	return Conmon_InspectStateRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_attachContainer_Results struct{ capnp.Struct }
+type Conmon_inspectState_Results struct{ capnp.Struct }
 
-// Conmon_attachContainer_Results_TypeID is the unique identifier for the type Conmon_attachContainer_Results.
-const Conmon_attachContainer_Results_TypeID = 0xc5e65eec3dcf5b10
+// Conmon_inspectState_Results_TypeID is the unique identifier for the type Conmon_inspectState_Results.
+const Conmon_inspectState_Results_TypeID = 0x8e35947ccc5bb354
 
-func NewConmon_attachContainer_Results(s *capnp.Segment) (Conmon_attachContainer_Results, error) {
+func NewConmon_inspectState_Results(s *capnp.Segment) (Conmon_inspectState_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_attachContainer_Results{st}, err
+	return Conmon_inspectState_Results{st}, err
 }
 
-func NewRootConmon_attachContainer_Results(s *capnp.Segment) (Conmon_attachContainer_Results, error) {
+func NewRootConmon_inspectState_Results(s *capnp.Segment) (Conmon_inspectState_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_attachContainer_Results{st}, err
+	return Conmon_inspectState_Results{st}, err
 }
 
-func ReadRootConmon_attachContainer_Results(msg *capnp.Message) (Conmon_attachContainer_Results, error) {
+func ReadRootConmon_inspectState_Results(msg *capnp.Message) (Conmon_inspectState_Results, error) {
 	root, err := msg.Root()
-	return Conmon_attachContainer_Results{root.Struct()}, err
+	return Conmon_inspectState_Results{root.Struct()}, err
 }
 
-func (s Conmon_attachContainer_Results) String() string {
-	str, _ := text.Marshal(0xc5e65eec3dcf5b10, s.Struct)
+func (s Conmon_inspectState_Results) String() string {
+	str, _ := text.Marshal(0x8e35947ccc5bb354, s.Struct)
 	return str
 }
 
-func (s Conmon_attachContainer_Results) Response() (Conmon_AttachResponse, error) {
+func (s Conmon_inspectState_Results) Response() (Conmon_InspectStateResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_AttachResponse{Struct: p.Struct()}, err
+	return Conmon_InspectStateResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_attachContainer_Results) HasResponse() bool {
+func (s Conmon_inspectState_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_attachContainer_Results) SetResponse(v Conmon_AttachResponse) error {
+func (s Conmon_inspectState_Results) SetResponse(v Conmon_InspectStateResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResponse sets the response field to a newly
-// allocated Conmon_AttachResponse struct, preferring placement in s's segment.
-func (s Conmon_attachContainer_Results) NewResponse() (Conmon_AttachResponse, error) {
-	ss, err := NewConmon_AttachResponse(s.Struct.Segment())
+// allocated Conmon_InspectStateResponse struct, preferring placement in s's segment.
+func (s Conmon_inspectState_Results) NewResponse() (Conmon_InspectStateResponse, error) {
+	ss, err := NewConmon_InspectStateResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_AttachResponse{}, err
+		return Conmon_InspectStateResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_attachContainer_Results_List is a list of Conmon_attachContainer_Results.
-type Conmon_attachContainer_Results_List = capnp.StructList[Conmon_attachContainer_Results]
+// Conmon_inspectState_Results_List is a list of Conmon_inspectState_Results.
+type Conmon_inspectState_Results_List = capnp.StructList[Conmon_inspectState_Results]
 
-// NewConmon_attachContainer_Results creates a new list of Conmon_attachContainer_Results.
-func NewConmon_attachContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_attachContainer_Results_List, error) {
+// NewConmon_inspectState_Results creates a new list of Conmon_inspectState_Results.
+func NewConmon_inspectState_Results_List(s *capnp.Segment, sz int32) (Conmon_inspectState_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_attachContainer_Results]{l}, err
+	return capnp.StructList[Conmon_inspectState_Results]{l}, err
 }
 
-// Conmon_attachContainer_Results_Future is a wrapper for a Conmon_attachContainer_Results promised by a client call.
-type Conmon_attachContainer_Results_Future struct{ *capnp.Future }
+// Conmon_inspectState_Results_Future is a wrapper for a Conmon_inspectState_Results promised by a client call.
+type Conmon_inspectState_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_attachContainer_Results_Future) Struct() (Conmon_attachContainer_Results, error) {
+func (p Conmon_inspectState_Results_Future) Struct() (Conmon_inspectState_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_attachContainer_Results{s}, err
+	return Conmon_inspectState_Results{s}, err
 }
 
-func (p Conmon_attachContainer_Results_Future) Response() Conmon_AttachResponse_Future {
-	return Conmon_AttachResponse_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_inspectState_Results_Future) Response() Conmon_InspectStateResponse_Future {
+	return Conmon_InspectStateResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_reopenLogContainer_Params struct{ capnp.Struct }
+type Conmon_compactState_Params struct{ capnp.Struct }
 
-// Conmon_reopenLogContainer_Params_TypeID is the unique identifier for the type Conmon_reopenLogContainer_Params.
-const Conmon_reopenLogContainer_Params_TypeID = 0xe5ea916eb0c31336
+// Conmon_compactState_Params_TypeID is the unique identifier for the type Conmon_compactState_Params.
+const Conmon_compactState_Params_TypeID = 0xf990f9ce111e2bd1
 
-func NewConmon_reopenLogContainer_Params(s *capnp.Segment) (Conmon_reopenLogContainer_Params, error) {
+func NewConmon_compactState_Params(s *capnp.Segment) (Conmon_compactState_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_reopenLogContainer_Params{st}, err
+	return Conmon_compactState_Params{st}, err
 }
 
-func NewRootConmon_reopenLogContainer_Params(s *capnp.Segment) (Conmon_reopenLogContainer_Params, error) {
+func NewRootConmon_compactState_Params(s *capnp.Segment) (Conmon_compactState_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_reopenLogContainer_Params{st}, err
+	return Conmon_compactState_Params{st}, err
 }
 
-func ReadRootConmon_reopenLogContainer_Params(msg *capnp.Message) (Conmon_reopenLogContainer_Params, error) {
+func ReadRootConmon_compactState_Params(msg *capnp.Message) (Conmon_compactState_Params, error) {
 	root, err := msg.Root()
-	return Conmon_reopenLogContainer_Params{root.Struct()}, err
+	return Conmon_compactState_Params{root.Struct()}, err
 }
 
-func (s Conmon_reopenLogContainer_Params) String() string {
-	str, _ := text.Marshal(0xe5ea916eb0c31336, s.Struct)
+func (s Conmon_compactState_Params) String() string {
+	str, _ := text.Marshal(0xf990f9ce111e2bd1, s.Struct)
 	return str
 }
 
-func (s Conmon_reopenLogContainer_Params) Request() (Conmon_ReopenLogRequest, error) {
+func (s Conmon_compactState_Params) Request() (Conmon_CompactStateRequest, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_ReopenLogRequest{Struct: p.Struct()}, err
+	return Conmon_CompactStateRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_reopenLogContainer_Params) HasRequest() bool {
+func (s Conmon_compactState_Params) HasRequest() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_reopenLogContainer_Params) SetRequest(v Conmon_ReopenLogRequest) error {
+func (s Conmon_compactState_Params) SetRequest(v Conmon_CompactStateRequest) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewRequest sets the request field to a newly
-// allocated Conmon_ReopenLogRequest struct, preferring placement in s's segment.
-func (s Conmon_reopenLogContainer_Params) NewRequest() (Conmon_ReopenLogRequest, error) {
-	ss, err := NewConmon_ReopenLogRequest(s.Struct.Segment())
+// allocated Conmon_CompactStateRequest struct, preferring placement in s's segment.
+func (s Conmon_compactState_Params) NewRequest() (Conmon_CompactStateRequest, error) {
+	ss, err := NewConmon_CompactStateRequest(s.Struct.Segment())
 	if err != nil {
-		return Conmon_ReopenLogRequest{}, err
+		return Conmon_CompactStateRequest{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_reopenLogContainer_Params_List is a list of Conmon_reopenLogContainer_Params.
-type Conmon_reopenLogContainer_Params_List = capnp.StructList[Conmon_reopenLogContainer_Params]
+// Conmon_compactState_Params_List is a list of Conmon_compactState_Params.
+type Conmon_compactState_Params_List = capnp.StructList[Conmon_compactState_Params]
 
-// NewConmon_reopenLogContainer_Params creates a new list of Conmon_reopenLogContainer_Params.
-func NewConmon_reopenLogContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_reopenLogContainer_Params_List, error) {
+// NewConmon_compactState_Params creates a new list of Conmon_compactState_Params.
+func NewConmon_compactState_Params_List(s *capnp.Segment, sz int32) (Conmon_compactState_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_reopenLogContainer_Params]{l}, err
+	return capnp.StructList[Conmon_compactState_Params]{l}, err
 }
 
-// Conmon_reopenLogContainer_Params_Future is a wrapper for a Conmon_reopenLogContainer_Params promised by a client call.
-type Conmon_reopenLogContainer_Params_Future struct{ *capnp.Future }
+// Conmon_compactState_Params_Future is a wrapper for a Conmon_compactState_Params promised by a client call.
+type Conmon_compactState_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_reopenLogContainer_Params_Future) Struct() (Conmon_reopenLogContainer_Params, error) {
+func (p Conmon_compactState_Params_Future) Struct() (Conmon_compactState_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_reopenLogContainer_Params{s}, err
+	return Conmon_compactState_Params{s}, err
 }
 
-func (p Conmon_reopenLogContainer_Params_Future) Request() Conmon_ReopenLogRequest_Future {
-	return Conmon_ReopenLogRequest_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_compactState_Params_Future) Request() Conmon_CompactStateRequest_Future {
+	return Conmon_CompactStateRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_reopenLogContainer_Results struct{ capnp.Struct }
+type Conmon_compactState_Results struct{ capnp.Struct }
 
-// Conmon_reopenLogContainer_Results_TypeID is the unique identifier for the type Conmon_reopenLogContainer_Results.
-const Conmon_reopenLogContainer_Results_TypeID = 0xa0ef8355b64ee985
+// Conmon_compactState_Results_TypeID is the unique identifier for the type Conmon_compactState_Results.
+const Conmon_compactState_Results_TypeID = 0x8089ac8e6715e1db
 
-func NewConmon_reopenLogContainer_Results(s *capnp.Segment) (Conmon_reopenLogContainer_Results, error) {
+func NewConmon_compactState_Results(s *capnp.Segment) (Conmon_compactState_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_reopenLogContainer_Results{st}, err
+	return Conmon_compactState_Results{st}, err
 }
 
-func NewRootConmon_reopenLogContainer_Results(s *capnp.Segment) (Conmon_reopenLogContainer_Results, error) {
+func NewRootConmon_compactState_Results(s *capnp.Segment) (Conmon_compactState_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_reopenLogContainer_Results{st}, err
+	return Conmon_compactState_Results{st}, err
 }
 
-func ReadRootConmon_reopenLogContainer_Results(msg *capnp.Message) (Conmon_reopenLogContainer_Results, error) {
+func ReadRootConmon_compactState_Results(msg *capnp.Message) (Conmon_compactState_Results, error) {
 	root, err := msg.Root()
-	return Conmon_reopenLogContainer_Results{root.Struct()}, err
+	return Conmon_compactState_Results{root.Struct()}, err
 }
 
-func (s Conmon_reopenLogContainer_Results) String() string {
-	str, _ := text.Marshal(0xa0ef8355b64ee985, s.Struct)
+func (s Conmon_compactState_Results) String() string {
+	str, _ := text.Marshal(0x8089ac8e6715e1db, s.Struct)
 	return str
 }
 
-func (s Conmon_reopenLogContainer_Results) Response() (Conmon_ReopenLogResponse, error) {
+func (s Conmon_compactState_Results) Response() (Conmon_CompactStateResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_ReopenLogResponse{Struct: p.Struct()}, err
+	return Conmon_CompactStateResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_reopenLogContainer_Results) HasResponse() bool {
+func (s Conmon_compactState_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_reopenLogContainer_Results) SetResponse(v Conmon_ReopenLogResponse) error {
+func (s Conmon_compactState_Results) SetResponse(v Conmon_CompactStateResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResponse sets the response field to a newly
-// allocated Conmon_ReopenLogResponse struct, preferring placement in s's segment.
-func (s Conmon_reopenLogContainer_Results) NewResponse() (Conmon_ReopenLogResponse, error) {
-	ss, err := NewConmon_ReopenLogResponse(s.Struct.Segment())
+// allocated Conmon_CompactStateResponse struct, preferring placement in s's segment.
+func (s Conmon_compactState_Results) NewResponse() (Conmon_CompactStateResponse, error) {
+	ss, err := NewConmon_CompactStateResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_ReopenLogResponse{}, err
+		return Conmon_CompactStateResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_reopenLogContainer_Results_List is a list of Conmon_reopenLogContainer_Results.
-type Conmon_reopenLogContainer_Results_List = capnp.StructList[Conmon_reopenLogContainer_Results]
+// Conmon_compactState_Results_List is a list of Conmon_compactState_Results.
+type Conmon_compactState_Results_List = capnp.StructList[Conmon_compactState_Results]
 
-// NewConmon_reopenLogContainer_Results creates a new list of Conmon_reopenLogContainer_Results.
-func NewConmon_reopenLogContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_reopenLogContainer_Results_List, error) {
+// NewConmon_compactState_Results creates a new list of Conmon_compactState_Results.
+func NewConmon_compactState_Results_List(s *capnp.Segment, sz int32) (Conmon_compactState_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_reopenLogContainer_Results]{l}, err
+	return capnp.StructList[Conmon_compactState_Results]{l}, err
 }
 
-// Conmon_reopenLogContainer_Results_Future is a wrapper for a Conmon_reopenLogContainer_Results promised by a client call.
-type Conmon_reopenLogContainer_Results_Future struct{ *capnp.Future }
+// Conmon_compactState_Results_Future is a wrapper for a Conmon_compactState_Results promised by a client call.
+type Conmon_compactState_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_reopenLogContainer_Results_Future) Struct() (Conmon_reopenLogContainer_Results, error) {
+func (p Conmon_compactState_Results_Future) Struct() (Conmon_compactState_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_reopenLogContainer_Results{s}, err
+	return Conmon_compactState_Results{s}, err
 }
 
-func (p Conmon_reopenLogContainer_Results_Future) Response() Conmon_ReopenLogResponse_Future {
-	return Conmon_ReopenLogResponse_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_compactState_Results_Future) Response() Conmon_CompactStateResponse_Future {
+	return Conmon_CompactStateResponse_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_setWindowSizeContainer_Params struct{ capnp.Struct }
+type Conmon_getState_Params struct{ capnp.Struct }
 
-// Conmon_setWindowSizeContainer_Params_TypeID is the unique identifier for the type Conmon_setWindowSizeContainer_Params.
-const Conmon_setWindowSizeContainer_Params_TypeID = 0xc76ccd4502bb61e7
+// Conmon_getState_Params_TypeID is the unique identifier for the type Conmon_getState_Params.
+const Conmon_getState_Params_TypeID = 0xd7c212e5cd3d99dd
 
-func NewConmon_setWindowSizeContainer_Params(s *capnp.Segment) (Conmon_setWindowSizeContainer_Params, error) {
+func NewConmon_getState_Params(s *capnp.Segment) (Conmon_getState_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_setWindowSizeContainer_Params{st}, err
+	return Conmon_getState_Params{st}, err
 }
 
-func NewRootConmon_setWindowSizeContainer_Params(s *capnp.Segment) (Conmon_setWindowSizeContainer_Params, error) {
+func NewRootConmon_getState_Params(s *capnp.Segment) (Conmon_getState_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_setWindowSizeContainer_Params{st}, err
+	return Conmon_getState_Params{st}, err
 }
 
-func ReadRootConmon_setWindowSizeContainer_Params(msg *capnp.Message) (Conmon_setWindowSizeContainer_Params, error) {
+func ReadRootConmon_getState_Params(msg *capnp.Message) (Conmon_getState_Params, error) {
 	root, err := msg.Root()
-	return Conmon_setWindowSizeContainer_Params{root.Struct()}, err
+	return Conmon_getState_Params{root.Struct()}, err
 }
 
-func (s Conmon_setWindowSizeContainer_Params) String() string {
-	str, _ := text.Marshal(0xc76ccd4502bb61e7, s.Struct)
+func (s Conmon_getState_Params) String() string {
+	str, _ := text.Marshal(0xd7c212e5cd3d99dd, s.Struct)
 	return str
 }
 
-func (s Conmon_setWindowSizeContainer_Params) Request() (Conmon_SetWindowSizeRequest, error) {
+func (s Conmon_getState_Params) Request() (Conmon_GetStateRequest, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_SetWindowSizeRequest{Struct: p.Struct()}, err
+	return Conmon_GetStateRequest{Struct: p.Struct()}, err
 }
 
-func (s Conmon_setWindowSizeContainer_Params) HasRequest() bool {
+func (s Conmon_getState_Params) HasRequest() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_setWindowSizeContainer_Params) SetRequest(v Conmon_SetWindowSizeRequest) error {
+func (s Conmon_getState_Params) SetRequest(v Conmon_GetStateRequest) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewRequest sets the request field to a newly
-// allocated Conmon_SetWindowSizeRequest struct, preferring placement in s's segment.
-func (s Conmon_setWindowSizeContainer_Params) NewRequest() (Conmon_SetWindowSizeRequest, error) {
-	ss, err := NewConmon_SetWindowSizeRequest(s.Struct.Segment())
+// allocated Conmon_GetStateRequest struct, preferring placement in s's segment.
+func (s Conmon_getState_Params) NewRequest() (Conmon_GetStateRequest, error) {
+	ss, err := NewConmon_GetStateRequest(s.Struct.Segment())
 	if err != nil {
-		return Conmon_SetWindowSizeRequest{}, err
+		return Conmon_GetStateRequest{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_setWindowSizeContainer_Params_List is a list of Conmon_setWindowSizeContainer_Params.
-type Conmon_setWindowSizeContainer_Params_List = capnp.StructList[Conmon_setWindowSizeContainer_Params]
+// Conmon_getState_Params_List is a list of Conmon_getState_Params.
+type Conmon_getState_Params_List = capnp.StructList[Conmon_getState_Params]
 
-// NewConmon_setWindowSizeContainer_Params creates a new list of Conmon_setWindowSizeContainer_Params.
-func NewConmon_setWindowSizeContainer_Params_List(s *capnp.Segment, sz int32) (Conmon_setWindowSizeContainer_Params_List, error) {
+// NewConmon_getState_Params creates a new list of Conmon_getState_Params.
+func NewConmon_getState_Params_List(s *capnp.Segment, sz int32) (Conmon_getState_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_setWindowSizeContainer_Params]{l}, err
+	return capnp.StructList[Conmon_getState_Params]{l}, err
 }
 
-// Conmon_setWindowSizeContainer_Params_Future is a wrapper for a Conmon_setWindowSizeContainer_Params promised by a client call.
-type Conmon_setWindowSizeContainer_Params_Future struct{ *capnp.Future }
+// Conmon_getState_Params_Future is a wrapper for a Conmon_getState_Params promised by a client call.
+type Conmon_getState_Params_Future struct{ *capnp.Future }
 
-func (p Conmon_setWindowSizeContainer_Params_Future) Struct() (Conmon_setWindowSizeContainer_Params, error) {
+func (p Conmon_getState_Params_Future) Struct() (Conmon_getState_Params, error) {
 	s, err := p.Future.Struct()
-	return Conmon_setWindowSizeContainer_Params{s}, err
+	return Conmon_getState_Params{s}, err
 }
 
-func (p Conmon_setWindowSizeContainer_Params_Future) Request() Conmon_SetWindowSizeRequest_Future {
-	return Conmon_SetWindowSizeRequest_Future{Future: p.Future.Field(0, nil)}
+func (p Conmon_getState_Params_Future) Request() Conmon_GetStateRequest_Future {
+	return Conmon_GetStateRequest_Future{Future: p.Future.Field(0, nil)}
 }
 
-type Conmon_setWindowSizeContainer_Results struct{ capnp.Struct }
+type Conmon_getState_Results struct{ capnp.Struct }
 
-// Conmon_setWindowSizeContainer_Results_TypeID is the unique identifier for the type Conmon_setWindowSizeContainer_Results.
-const Conmon_setWindowSizeContainer_Results_TypeID = 0xe00e522611477055
+// Conmon_getState_Results_TypeID is the unique identifier for the type Conmon_getState_Results.
+const Conmon_getState_Results_TypeID = 0x97fd7270d05fe927
 
-func NewConmon_setWindowSizeContainer_Results(s *capnp.Segment) (Conmon_setWindowSizeContainer_Results, error) {
+func NewConmon_getState_Results(s *capnp.Segment) (Conmon_getState_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_setWindowSizeContainer_Results{st}, err
+	return Conmon_getState_Results{st}, err
 }
 
-func NewRootConmon_setWindowSizeContainer_Results(s *capnp.Segment) (Conmon_setWindowSizeContainer_Results, error) {
+func NewRootConmon_getState_Results(s *capnp.Segment) (Conmon_getState_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Conmon_setWindowSizeContainer_Results{st}, err
+	return Conmon_getState_Results{st}, err
 }
 
-func ReadRootConmon_setWindowSizeContainer_Results(msg *capnp.Message) (Conmon_setWindowSizeContainer_Results, error) {
+func ReadRootConmon_getState_Results(msg *capnp.Message) (Conmon_getState_Results, error) {
 	root, err := msg.Root()
-	return Conmon_setWindowSizeContainer_Results{root.Struct()}, err
+	return Conmon_getState_Results{root.Struct()}, err
 }
 
-func (s Conmon_setWindowSizeContainer_Results) String() string {
-	str, _ := text.Marshal(0xe00e522611477055, s.Struct)
+func (s Conmon_getState_Results) String() string {
+	str, _ := text.Marshal(0x97fd7270d05fe927, s.Struct)
 	return str
 }
 
-func (s Conmon_setWindowSizeContainer_Results) Response() (Conmon_SetWindowSizeResponse, error) {
+func (s Conmon_getState_Results) Response() (Conmon_GetStateResponse, error) {
 	p, err := s.Struct.Ptr(0)
-	return Conmon_SetWindowSizeResponse{Struct: p.Struct()}, err
+	return Conmon_GetStateResponse{Struct: p.Struct()}, err
 }
 
-func (s Conmon_setWindowSizeContainer_Results) HasResponse() bool {
+func (s Conmon_getState_Results) HasResponse() bool {
 	return s.Struct.HasPtr(0)
 }
 
-func (s Conmon_setWindowSizeContainer_Results) SetResponse(v Conmon_SetWindowSizeResponse) error {
+func (s Conmon_getState_Results) SetResponse(v Conmon_GetStateResponse) error {
 	return s.Struct.SetPtr(0, v.Struct.ToPtr())
 }
 
 // NewResponse sets the response field to a newly
-// allocated Conmon_SetWindowSizeResponse struct, preferring placement in s's segment.
-func (s Conmon_setWindowSizeContainer_Results) NewResponse() (Conmon_SetWindowSizeResponse, error) {
-	ss, err := NewConmon_SetWindowSizeResponse(s.Struct.Segment())
+// allocated Conmon_GetStateResponse struct, preferring placement in s's segment.
+func (s Conmon_getState_Results) NewResponse() (Conmon_GetStateResponse, error) {
+	ss, err := NewConmon_GetStateResponse(s.Struct.Segment())
 	if err != nil {
-		return Conmon_SetWindowSizeResponse{}, err
+		return Conmon_GetStateResponse{}, err
 	}
 	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
 	return ss, err
 }
 
-// Conmon_setWindowSizeContainer_Results_List is a list of Conmon_setWindowSizeContainer_Results.
-type Conmon_setWindowSizeContainer_Results_List = capnp.StructList[Conmon_setWindowSizeContainer_Results]
+// Conmon_getState_Results_List is a list of Conmon_getState_Results.
+type Conmon_getState_Results_List = capnp.StructList[Conmon_getState_Results]
 
-// NewConmon_setWindowSizeContainer_Results creates a new list of Conmon_setWindowSizeContainer_Results.
-func NewConmon_setWindowSizeContainer_Results_List(s *capnp.Segment, sz int32) (Conmon_setWindowSizeContainer_Results_List, error) {
+// NewConmon_getState_Results creates a new list of Conmon_getState_Results.
+func NewConmon_getState_Results_List(s *capnp.Segment, sz int32) (Conmon_getState_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return capnp.StructList[Conmon_setWindowSizeContainer_Results]{l}, err
+	return capnp.StructList[Conmon_getState_Results]{l}, err
 }
 
-// Conmon_setWindowSizeContainer_Results_Future is a wrapper for a Conmon_setWindowSizeContainer_Results promised by a client call.
-type Conmon_setWindowSizeContainer_Results_Future struct{ *capnp.Future }
+// Conmon_getState_Results_Future is a wrapper for a Conmon_getState_Results promised by a client call.
+type Conmon_getState_Results_Future struct{ *capnp.Future }
 
-func (p Conmon_setWindowSizeContainer_Results_Future) Struct() (Conmon_setWindowSizeContainer_Results, error) {
+func (p Conmon_getState_Results_Future) Struct() (Conmon_getState_Results, error) {
 	s, err := p.Future.Struct()
-	return Conmon_setWindowSizeContainer_Results{s}, err
-}
-
-func (p Conmon_setWindowSizeContainer_Results_Future) Response() Conmon_SetWindowSizeResponse_Future {
-	return Conmon_SetWindowSizeResponse_Future{Future: p.Future.Field(0, nil)}
-}
-
-const schema_ffaaf7385bc4adad = "x\xda\xacW}l\x1cG\x15\x7fo\xe6\xd6\xe7\xda>" +
-	"\xdfM\xd7\x88b)2\x8aBE\x12\xb5\x0eq\xca\x87" +
-	"\x15d\xe7\xc32.\x09\xdc\\\x12\xa2\xe6K\xdd\xdcM" +
-	"\xedM}\xbb\xe7\xdd\xbd8N\x89\x92\xb6XH\x85\x16" +
-	"Z\x15\x81#\"%\xd0T\x8d\x89IKI\xa8\x02T" +
-	"@\x13\xb5\x04\x02\xd8\x12\xa0V\x14\x1aBh\x1a\xd1\x02" +
-	"\xa2\x08\x07U,\x9a\xdd\xdb\x8f\xbb\x18\xd5\x1f\xfcw\xb7" +
-	"\xfb\xdb7o\xde\xbc\xdf\xef\xf7fE\xbf\xd2\x9d\xf8P" +
-	"jk\x03\x10\xbeO\xa9s\xedK#\xd6\x93Gz\x1f" +
-	"\x04\xb6\x1c\x01\x14L\x02t0e1\x01T\x97*]" +
-	"\x80\xee\xe8\x1b\x9f:\xb3\xe5\xc1\xbf\x1e\x8d\x03\xfa\x94\x95" +
-	"\x12\xa0y\x80\xb1\xedW\xef\xed\xe9K\x7fC\x02\xdc\xbf" +
-	"w\xdc\xf3\xea\xd8\xd5\x8f|\x0f\x12\x127\xaa\\C\xf5" +
-	"\x98\x92\x04\xea.9\xf5\xc2\xe4C\xab\xdb\xc7\xe3a\x0e" +
-	"(7\xcb0_\xf1\xc2\x0c\xdf\xfd\xd2\xa9\xfd\xfc\xca\xc9" +
-	"\x19\xc2\x9cV\xa6P\x9d\xf4\xc2\xac:\xf6\xec\x99G\xde" +
-	"\xda\xf7m\xe0\xcb1\x06\xf3\xe3M(\xe3\xa8\x9eW\xde" +
-	"\x0b\xa0^T\x86\x01\xdd\xfb&\xaf=\xf5\xc8\x17\xd6\x9c" +
-	"\x9e\x11\xbd\xb4\x8e\x10\xb5\xa7.\x09\xa0\xae\xa9;\x05\xb1" +
-	"\xf7l\x09u'&\xcem\xff\xe8\xbf\xc6]\x00\xecx" +
-	"\xb9n\x1b\xaao\xd6\xbd\x08\xd01\x99\xfc<\xaa\x97\xea" +
-	"\x93\x00\xee\x853':\xff}y\xf8lmpE\x06" +
-	"\xffi\xfd\xcdD}C\xe2:\xae\xd4oE@7\xb3" +
-	"\xfd\x97\x1f\xff\xcb\xae?\x9f\x8f\x17\xa0\xdc\xd0*\x0b\xf0" +
-	"p\x83,\xc0\xeb\xda\xf7I\xcf\xc5\xc1\x17\xe3\x80g\x1a" +
-	"\xee\x94\x80\x8b>\xe0O\xff\xd9\xd3_j\xff\xb9\x0f\xf0" +
-	"*\xf3f\xc3\x14B\xc2\x9d~\xcf\xf3_m]}\xf6" +
-	"\x17\xf1O/\xf9\xb1\xaf{\x9f\xb6\xae\x99\\\x956z" +
-	"\x7fU]\\\x1f\xb8\xa8\xf1\x8f\xa8~\xacQ\xd6\xe1\x8e" +
-	"F\x09~gt\xf5\xa1E\x8b~\xf3r\xed\xc6\x88D" +
-	"oi\\F\xd4\xb2\x87\x1ej|\x1d\xd0=\xbc|\xb8" +
-	"\xb4kw\xe7\xefk\xd0^z\x1b\x9bZ\x89Zl\x92" +
-	"`\xbd\xc9\x0b\xdd\xf9\xce\xf3GW\x97\xfeP\x93\x07\x95" +
-	"\xe0\x87\x9b.\xa0z\xc2\x03\x1fo\x92\xe7\xb1\xa5\xd4\xcb" +
-	"n\xcd5\xbf\x16\xdfUO*\xe7u^JF[q" +
-	"_\xef\x89]\xbaz9\x0e\x18M\xbd\x82\x80\xea\x98\x07" +
-	"\xf8\xb0\xfa\xc2\xd3\xc6\xa3\xd7\xae\xc4\x01?L-\x93\x11" +
-	"~\xed\x01~\xbc\xbd#\xfb\xdb\xcb\xb7\xfe\x0d\xd8\x1d$" +
-	"\xea-\xc0\x8e\x7f\xa6\xa6Pe\xcd2\x99Ts\x1b\xa0" +
-	";\xf9V\xdb\xc9\x9f]\xf9\xe4?f<\xedT\xf3+" +
-	"\xa8.\x95\xe8\x8e\x0f4{\xa7\xfd\xe4\xd07\xbf<\xbd" +
-	"\x98\xbd-\xe1\xa4\xb6\x86\x07\xd2\x8b\x89z$-\x83\x8f" +
-	"\xa5e\x0d\x9f;\xfc\xf8\x97\xce\xad\xec}\xbbj#\x19" +
-	"\x8f\x1cG22\xcf\x1f\xe0x\xe3\x8e=W\xa7\xab6" +
-	"\x92\xf17\xe2\x01\xa6\x8f}\xab\xe3\xd0\xc5g\xaf\xcf\xc0" +
-	"\x9e\xeb\x99\x06\xa2\xbe\x8f%\xa1\xdd\xcd\x9bF\xd14n" +
-	"\xb3\x92v{\xde,\x16M\xa3\xbdd\x99\x8e\xd9\xee?" +
-	"\xbf=\xaf\x95\x8cR\xe7:\xff\x8f\xd8'\xf2\x9bF\x8c" +
-	"\xfc:\xd3p4\xdd\x10\xd6\x92\xacf%\xb5\xa2\xcd\x13" +
-	"4\x01\x90@\x00\x96Z\x0b\xc0\xeb)\xf2\x16\x82\x07-" +
-	"1T\x16\xb6\x83\x99h\xf3\x80\x98\x01\x9c\xd3\xb2\x960" +
-	"K\xc2\xd8`\xf6G\xeb\xe6D\x9b]\x1et\xaa\x16\xbe" +
-	"\x13\x807Q\xe4\xb7\x10t-a\x97L\xc3\x16\x00\x80" +
-	"\x99H\x8ej\x16\xaf\x9b\xc5\xe2\xb9`\xf1\x9c\xb0Ki" +
-	"\x193\x8bsK_s\x1c-?PU3\xad\x88\xb3" +
-	"\xa8Y\xc8\x8cy\xa4\xbd\xc6[4\xe7\x97\x01\xabrV" +
-	"f\xf1\xf9\x06\xb3\x7f\xbd\x95\xd6\xf7\x0a\x8b'0\xce\x09" +
-	"\\\x96\xde<R\x12\xbc>L~\xe92\x00\xbe\x84\"" +
-	"_A\x10\xb1\x05\xe5\xb3\xdb\xe4\xb3\x0fR\xe4\xab\x08\xa6" +
-	"\x9d\x91\x92\xc0t\x14\x03\x10\xd3\x80\xe9\x92\xe6\x0c`\x13" +
-	"\x10l\x9ac;l\x12\xceV\xdd(\x98\xc3\x9b\xf4\xfd" +
-	"\"\xe7W\x0b\xb2\x88\xbc)\xcc\xa9\xa7\x15\x80wS\xe4" +
-	"\x1b\xa2\x9c\xfaV\x02\xf0\xf5\x14y\x96 #\xd8\x82\x04" +
-	"\x80m\xec\x04\xe0\x9f\xa0\xc87\x13\xa4z!\xc8\xa7m" +
-	"X/8\x03\x98\x04\x82I\xc0\xae\x01\xa1\xf7\x0f8\xc1" +
-	"\xdf0\xd9\xc4\xbb%KM\x83\xaf\xc0\x98P\xb0\xbb\x1e" +
-	"\x88<\x82\xddu6\xd2\x17\xb63\x17\xc9&\xdb\xf9\x93" +
-	"\x880L\xbb\x10\xc9/\xd3\xa7b\x829d\xc5,r" +
-	"h\x7fL\xd2\x87\x1e\x8ayp\xf9\xb1\xc8\xf5\xd8\xc8x" +
-	"L\x18\x0e|\xc7\xfd\x8c\xb0l\xdd4r4`\xcc:" +
-	"Kh\x8e\x08\xdb5\xd7\xe5W\xd8\xf5ZB\xdf+\x00" +
-	"-7\xc0(\x01(\xf8\xb8\xa7V!\x82\xf3\x017x" +
-	"Eb\xef*\xdd\xe9\x06\xdd\x0am\xfeZ\xe1\xff.?" +
-	"\xae\x1b\xb0\x10\xfb\xa3\x80\xf1gA\xa0\xa070h\x8e" +
-	"\xb4\x17\xaf\xf6\xb1\xdd\xe6\x85\xe5\xb7P\x05 4Q\x0c" +
-	"\xcc\x83=\xb3\x16\x08;\x9e\xc4H\x861\xf0S6\xf6" +
-	"\x00\x10\xf6h\x12I8'a \xc5l\xf41 \xec" +
-	"\xfe$F\xb3\x0d\x06\x1e\xcf\xca\xf2\xbbb\x12\x13\xa1\x05" +
-	"a0G1\xed0\x10\xb63\x89J\xe8\xf8\x188\x1d" +
-	"\xe3g\x81\xb0\x8d\xc9\x83{\xfd\x83\xeaF7_\xa9>" +
-	"V\xea\x08\xdd\xe8\x06\xd2\x8cAu\xd1\xeaF7\x90\x9e" +
-	"8\xd2\x0a\xcbV\x81R!\xa1vU\x89\xd6\x99F\x97" +
-	"\xffI7\xceU\xefj\x1b\xc8;0t$Co\x09" +
-	"\x19:&\x19\xfa8E~\x94 \x0b(zd\x1b\x00" +
-	"\xff:E\xfe\x14A$>C\x8fKY\x7f\x82\"\x7f" +
-	"\x9a \xa3\xa4\x05)\x00\x9b\xc8\x01\xf0\x93\x14\xf9K\x04" +
-	"Y\x82\xb6`\x02\x80\x9d\xdf\x03\xc0\xcfQ\xe4\xaf\x11d" +
-	"J\xa2\x05\x15\x00\xf6\xaa\x0c\xf9;\x8a|\xba\x8a\xe0\xee" +
-	"\xee\xb2Q\x18\x14Y\x0dhL\x85\x1ca\x15uC\x1b" +
-	"\x94\xae\x81@P\xba\xb6\xd8\xa7;Y\xcd\x19\x00\xb4\xb1" +
-	"\x190K\xd1\x837\x03\xba\xa6Y\xec\x91o!\xad9" +
-	"\x037\xbc\x1d\x0c\xf8B\xad\xf0]&>Px\xa8\x85" +
-	"\x18IN\xd8\xe5A:[\x13\x0c\x95\xa2\xc6M\xeag" +
-	"\xb1\xb2\x1d\x97\xdc\x1a'\xb3\x01\xde\xdd\xcaB\xfd\x99\x87" +
-	"\x95U\x1a?\xf0\xcd9\x95,_\xdd\x8bs,Y(" +
-	"\xc8\x0b\x9b\x1b\x86\xcaIa{\xfd\x1f[\xb55\xaaS" +
-	"\xbc/\xe7\xb2\xb9\x19\xc46\x1aQx&\\K\x93;" +
-	"\xbc\x9b\"\x1f\x8c\xdcP\x97\xc6W\xa0\xc8K17," +
-	"\xca\x87\x03\x14\xb9#\xb9\xf6~\x9fkC\xf2\xeb\x12E" +
-	"\xfeY\xe2\xb3a\x9dY\xf0\xea\x93\x00\x82\x09\xc0.\xdb" +
-	")\x98e\x07S@0\xe5\xff\x15\x96\x15\xfcu\x1d\xbd" +
-	"(\x0a\x9f.;qN-HM\xe4\x01Q\x7f\x8b\xb1" +
-	"r\xee\x89\x1db\xbe\x02\x86\xb4\x95\xd5\x0bX\x0f\x04\xeb" +
-	"\xe79>U\xbc\xe6\x7fO\x17\xa1v\xf5m\x8b&\x09" +
-	"F*\xe2\xc5-\x00\x9e\xa5\xc8wT\x9f\xb2m\xe6\xef" +
-	"\x15N\x8d\xfax\x12.l\x1b\xdat\xd3\xe8\xbb\xb1%" +
-	"\x16@T\xaf\xed\x1d\x9ce\xdb\x87\xf3\xc1\x02\xc8:7" +
-	"\xa2\x85\x03\xd2\xff\xe7v\x90\xd5\xd2\xd6\xacn%\xe1\xc0" +
-	"4\x8f\x9d\x06\xf3\x90u\xfb\xe6\x91\x12\xfa\xfd\xe8\x1d\xba" +
-	"2\x05\x10\xf6 \xb1reCr\xa0\xcfp\x84u\x8f" +
-	"\x96G1\xa7U\x82\xf1,\xde\xf61\x17];\x93\x8b" +
-	".\x06\xe0_\xa3\xc8\x9f\x88u\xe2\xb1\xce\xc8Z\x19\xa5" +
-	">\xb5\x8f\xe7b\xde\x9aH\xf86:\xb1\xbb\xe2\xad\xcf" +
-	"\x11D\xc5w\xd1\xd3\x12\xf8]\x8a\xfcG\x04\x83A$" +
-	"\xe8\xcf\xa4\xa3\xf5\x07\xbf\xbb\xe4~t'\xe6\xb2\xfa`" +
-	"a\xbd\xe6\x00\x8a\xf0\x99U\xb6\x1d\xb9+H\xc6\x82\xb8" +
-	"%\xcb\xcc\x0b\xdb\xee\x03\xbc\x91\xb0\xf3\xd4\xc2Hvc" +
-	"R(\xa9\xbb\x83\"\x1f\x88\xa4Pl\x9bI\x0a\xd7V" +
-	"\xa4\xf0s\xb2^\xdd~\xbd\xee\x97\x1d|\x88\"\xffb" +
-	"5\x9d\xe5\xf9\x9aeg\x13P\x91\xc7\x9b\x80\xe0M\x80" +
-	"\x07e\xca\x9aQ\xa8\x1d\x0bf\x9a1\x16\xe2h\xb3\xbe" +
-	"M\x86\xf7\x8eyp\xec\xc6\x8b\x7fN\xd8\xe9\xd9_\xc0" +
-	"\xc3\xfb\xcb<\xd6\xae\xb9\xee\x05a\xb3\x88\xff\x0d\x00\x00" +
-	"\xff\xff\x1a==\xe6"
+	return Conmon_getState_Results{s}, err
+}
+
+func (p Conmon_getState_Results_Future) Response() Conmon_GetStateResponse_Future {
+	return Conmon_GetStateResponse_Future{Future: p.Future.Field(0, nil)}
+}
+
+const schema_ffaaf7385bc4adad = "x\xda\xcc\xfd}|T\xc5\xd9?\x8e\xcfu\xce.\x0b" +
+	"(.\xeb@+>4B\xa5j\x94\xa7 \x16Rp" +
+	"I @\x02h6!*\xb1\xd8\x9e\xec\x1e\x92\x85\xcd" +
+	"\xeer\xf6,\x10\x14y\xa8\xa8\x80\xa8\xa0\x14\x03\xc5\x9b" +
+	"\xa0RAy\x08\x8a\x0a\x8a\x15-VP\xaa\xf0+\xad" +
+	"OH\x05\xb9\x15*\x0aV\xaa\xa0\xb8\xbf\xd7\xcc9s" +
+	"f\xce\xe6\xa4\xec.\xdc\x9f\xd7\xf7\x9fV&\xd7\xce\xcc" +
+	"\x99\x87k\xae\xc7\xf7\xd5\xfb\x8f\xfd\x07\xbb\xfat\xc8\xbb" +
+	"\x0dI\x95W\xb8\xdcmR\x1f~\xd2\xb9\xf6\x81g\xe6" +
+	"\xceD\xbek\x00!7x\x10\xea\x9b\xec!I\x08\xf0" +
+	"\xdc\x1e~\x04\xdf\xfe~\xc2\xa6\x8b^\x84Y\xbek\xe4" +
+	"\xd4\xf1\xbe\xe3\xf75~\xfe\xcb\x17\x10\x82\xbek{\xb4" +
+	"\x97\xf0\xae\x1e\x1e\x84\xf0\x8e\x1e\xf7\xe2.==\x08\xa5" +
+	"\x94q{\xdf\xcb\xdft\xddl\xd2\x19\xa76:\x85\x9e" +
+	"\xa7\x00_F\xc8p\x97\x9e~\x04\xa9\xfe3\x06\x84\xae" +
+	"\xeb\x10p$\x1e\xdd\xb3P\xc2\xf5\x948L\x89\xbf\xbf" +
+	"\xf9`\xd1\xfe\xffi\x9a\x8d\x02\xd7\x80\x8bS\xbb\x08\xf1" +
+	"\xdc\x9e\xaf\x01n\xa2\xc4\xcb{~\x86 \xd5xE\x97" +
+	"\xf1\xbf\x0b\xbe\xee\xd8\xf3\xf4^G\x007\xf6\"\xc4\x8b" +
+	"{\x91\x9e\x13\x9f4h\xab\x96\x0f\xff\x9d\xb8\x00\x9bz" +
+	"u#\x0b\xb0\x8b\x12\x04\x0b\x9a'\xf6,\xba\xecn{" +
+	"ot\xe4\xa3\xbdN\x01n\xd7\xdb\x83\xe4\xd4\x1b\xaf\xff" +
+	"\xb0\xe8\x9d\xde%w\x8b\xdd\x1c2\xba9M\xbb\xa9>" +
+	"0\xe6\xe2\xe3/\xafL\xeb\xc6-\x13\xc2\xae\xbd\xcb$" +
+	"\\\xd2\x9bL\xaa\xa8\xf7z\x04\xa9\x8b\xfe\xbaa\xd4\xbf" +
+	"\xce\xfft\x8e\xd8\xdb\xbe\xde\x17\x93\xdeN\xf4&\xbd\xb5" +
+	"\xff\xf1`\xcf\xa3O6\xdf#\x12t\xeeS@\x08z" +
+	"\xf4!\x04\x1f\xff\xe9\xf6I\xff\xb8\xb9\xed\xbdNk\x10" +
+	"\xe8\xd3^\xc2\x93\xfa\x90\xe1\xea)\xf1\x8e\x1f\xbfU~" +
+	"\xf3m\xbb{\xc5\xde\x16\xf4\xa9&\xbd\xad\xa6\x04/\xff" +
+	"x\xab\xafM\xd9\xdc\xfb\x9cz\xdb\xd1\xe7\x14\xe0C\xb4" +
+	"\xb7O(\xf1UJ\xf1\x88\x0e\xaf\xfd\xf1>\xb17(" +
+	"\xa0G\xaas\x01!\x98\xb7\xa8\xee\xc1\xfc\xf9\xcf\xccE" +
+	"\xbek$\xdb\xa1\xeaW\xb0\x13p\xa0\x80t5\xba\xe0" +
+	"&\x04\xa9q\x1f\xee\xbd\xa5]\xdb\x0f\xe69\x8d\xab\x14" +
+	"\\(\xe1Y\x94x:\xed\xf6\xc4\x13o\x0eZ\xb2\xf0" +
+	"\xaby\xe2\xb8\xcb\x0b\xda\x93q7Q\x02\xef\x1a\xd7\xa2" +
+	"\xda\x17\xda\xcdw\xd8\xc9\xf7\x0b\x8e\x00>Q@v\xf2" +
+	"\xa3\xfe\xf9\xe3W\xc8\xa3\xe6\x8b\xdd\xec6\xa6\x7f\x88v" +
+	"3\xb8dB\xc5\xc07\xa6\xcew\x9a\x94\xbbo\x81\x84" +
+	"\xbb\xf7%\x93\xea\xda\x97\x10\xf7\x08\x8cz8\xefo'" +
+	"\xd3\x89%B\\\xd5W\x92\xf0$J\\\xdfw\x0a\x82" +
+	"T\xf2\xae\xdft\xbb\xe9\x8b\xbf\xdc\x8f\x02\x85\x00\xc8\x98" +
+	"\xd9\xae\xbe\xc5th\xda\xdb\xfe+\x9b\xdf\x93\xfb\xfd\xeb" +
+	"~qn\xee\xeb\xe8\xdc\xba\\G\x08^);rb" +
+	"\xeb\x0d}\x168\xcdm\xc0u_\x03\xae\xba\x8e\x0c\x17" +
+	"\xa0\xc4\x7f\x1d\xf4\xd7\x11\x1b\xee\xec\xf6\x80\xd8\xdb\xac\xeb" +
+	"\xe8\x99m\xa4\x04c\x9e\xbd\xed\xed;\x1f\xe9g#\xd8" +
+	"j\x0c\xb7\x9b\x12\xfc\xd0\x9c\x7f\xcf\x94\xcf\xf5\x07\x90\xaf" +
+	"\xc8\"8q\x9dF\x08|\xfd\x08A\xd7\x15\xf8\xf1\x1b" +
+	"\x9e\xff\xc1F\xd0\xa7\x1f%\x18M\x09\xca\xde\x1d\xb6y" +
+	"ds\xa7\x07\x91\xaf\xbfEP\xdf/\x9f\x10\xcc\xa1\x04" +
+	"o.\xf8\x1f\xbd\xe1\xe9\x1f\x1e$7\xbf\xc5'=\xd9" +
+	"O\x92\xf0\xb6~\xe4\x93\xb6\xf6#+8fu\xe3\xf7" +
+	"\xeb\xd7\xfe\xf4!B-\xa7\xaf\xf7e\xd7\xef\x01<\xe0" +
+	"\xfa\x9f\"\xd4\xb7\xe8\xfa\xbf\x00\x82\xd4\xfck\x8a\x02\xed" +
+	"\x17?\xfe\x90\xed\x1a\xf5?\x02\x08\xf0\xd5\xfd\xc9\xe8\xff" +
+	"\xf9\xa4\xf2\x86\x9d\xdf~\xf8\x90\xd3z\x96\xf6\xaf\x91p" +
+	"}\x7f\xca\xa4(\xf1\xa0\xa5\x9b^{\xff\x86\xe7\x16:" +
+	"\x11\xcf\xed\x7f\x00\xf0\x93\x94\xb8\x89\x12\xf7\x1a\xf2\xcd\xea" +
+	"K\xb5\xcf\x16\"_?)\x15\xf9\xb2y\xd7\xaa\xd4\xf2" +
+	"u\xe4\x12l\xeb\x7f\x04\xf0>J\xf9~\xff\x9b\x10\x9c" +
+	"^\xfe\xd9S\x7f[\xf3\xcd\xc2\xc05 \xa5\xf7z\xb4" +
+	"\xff\xd7\x80\xdb\x0d\xf8)B\xd87\x800\x8eiw\xff" +
+	"\xea\xbb\x9b.\xf6=\xe2\xc4e\xd6\x0ex\x0d\xf0\xf6\x01" +
+	"\xa4\xe3m\x94\xf8\xea\xc8\x9b\xa5\x97\xfe\xe3\xbeG\xc4\xaf" +
+	"\xaf/\xfc\x9a|\xfd\xacB\xca\xfa:\xbf2kK\xd5" +
+	"\x17\x8f\xa4\xad&=\x97O\x16~\x00x[!=\x12" +
+	"\x85yd1\xd7\xc5B\xcf\x1cjw\xef\xefmL\xeb" +
+	"W\xf4\xfe\x9d\xf8\x15\xe9\xee\x9b\x86W\xf3\x83\x1f\x7fd" +
+	"#\xe82\xf0\x14\x19\xaf\xc7@?\x82\xaf\\\xe7\xad\xdd" +
+	"9\xba\xdd\x12'\x965\xf0b\x09O\x1aH\xaf\x0a!" +
+	"M\x8d:\xb6\xaa\xe1\xa5\x03\xbd\x97 \xdf`vS\x16" +
+	"\x0c\x9c !Wj\xa7\xfa\xcb\x05\x0f.|m\x898" +
+	"\xca\\c\x94\xe5\xf4\xa7\xf3\xefy\xb5\xc03\xe1\x9b%" +
+	"\xc6\x99\xa4?\xdd6p\x1a\xf9\xe9\x95\x87\x7f\xf3n\\" +
+	";m\xfb\xe9\xd6\x81\x07\xc8Ow\xd3\x9f\x8e\xb8\xe9\x87" +
+	"k.\xad\xfawc\xfaa\xa4\xc7\xeb\xc4\xc0=\x80}" +
+	"\x83\xc8\x1c;\x0c\"\xeb\xdb\xb4\xe9\xf6\xb7^_;n" +
+	"\xa9\xd8\xdd\xeaA\xb4\xbb\xad\x83Hw\x97\xefz\xf7\x83" +
+	"\xdb\xa3\x93\x96:\x1d\x98}\x83\x0a$|\x9a\xf6v\x92" +
+	"\x12\xaf\xfa\xc9\xa2\xb13N\xbf\xb7\xd4\x89\x93t\xb9\xa1" +
+	"P\xc2\x03n \xc4\xfdn \xf7\xe0\xa2\xbf\x1d\xbe\xf5" +
+	"wWvX\x86\xd2N\x0d\xa5^x\xc3\x1e\xc0ko" +
+	"\xa0\xd3\xb9\x81n\xdd\xad\xea[Knh*Zf\xcc" +
+	"\x94.\xc9V\xff\xd7\x80\\\xa99\x7f9v},\xf6" +
+	"\x9be\xc6\xfd\xa4\x7fi\xf6\x17\x90\xc5:6\xea\xbc%" +
+	"\xef\x7f\xb1w\x19\xf2\x15J\xfc\xde!\xe8\xbb\xda\x7f\x0a" +
+	"\xf0v?=g\xfe\xbf H\xed\xfa\xab6\xf0\x8d\xb1" +
+	"\xfb\x979\x1d\xca~\x83\x0f\x00\x0e\x0c\xa6,\x7f0Y" +
+	"\xb4\x9f<p\xe7\xfc\xdd\xfd\xbeZ&.\xda\xe1\xc1\x94" +
+	"\x07B\x11Y\x87\x0d\xf9\xd3\x8eG\xd6\xb5\xf9\x83\xd3\xa2" +
+	"u-\xea&\xe1\xa2\"\xd2\xdb J\\q\xe1\x9c1" +
+	"K*f/\x17{S\x8a(\x8bk\xa0\x04w\x8f\xfc" +
+	"\xf2\xef\xbf\xbb\xf3\xa9\xe5-\xde\xa2\xc6\xa2=\x807\xd1" +
+	"\xae\x9a\x8b\xc8\xc4z\xdc\xda\xb07P\xf3\xdec\xc2\xb1" +
+	"iW\xac\x91\x95X\xb9\xa2C\xaf\x0f\x8b\xbe~L\xe4" +
+	"a\xeeb:\xc8e\xc5d\x90\xff\xdf\x13W\x0d\xf8\xc3" +
+	"\xa9\x17\xfeG\x9c\xc5\xa0bz\x10\x02\x94\xe0\xf7\xc3\x16" +
+	"\xfe8\xee\xb7\x07m\x04\x93\x8a)\x17\x9cK\x08~|" +
+	"{U\xbf\x7f\x17wZ!\x1e\xa4bz\xb3\xb6\xd1\xdf" +
+	"?\xd6\xe7\xf5!\x8f\xae\xb9f\x85#\x93\xfc\xa4\xf8\x03" +
+	"\xc0\xa7\x8b\x09\x93p\x0f!\x87c\xce\xe1\x1b\x9f\xaf\xfa" +
+	"\xddW+l\x8b2\x84\x0a\x0f\x0dC\xe8\x0bW6\xd3" +
+	"\xff\xf6[\xc3\x9aP\xba\xd4\xb7|\xc8\x1e\xc0\x9b\x87\x90" +
+	"\xae\xb6\x0d\x19\x8e\x0f\x0f\xf1 \x94:q\xe0\xa6\x1f\xbe" +
+	"\x1b^\xd7\x94v\xd6\xe8\x0a\xed\x1eR(\xe1\xe3\xf4\x07" +
+	"'\x87\xacG\xf0}Y\xef\xdb\x86lol\x12F^" +
+	"8\x94n\xee\xea\xa1d\xe4\xc6\xdb>\x9fXR\xea]" +
+	"\xe9\xf0D\xef\x1az\x04\xf0\xe1\xa1\xe4\x89\x96\xaf\xae\x98" +
+	"\xbaQ}s\xa5\xf8\x01\xdb\x87\xd2\x0f\xd8G\xbbi\xde" +
+	"\xd9\xa3\"2\xf8\xad\xc7E\x02(\xa1\xeb\xd9\xa5\x84\x10" +
+	"<t\xfb=s;\x17o\x7f\xd2`\x1f\xe6\xfbXR" +
+	"C\x08\xaa(\xc1O\x9e\xc2\xff\xf3\xbf\x91\x7f\xac\xb2\xc9" +
+	"\xc5%\x86\\L\x09|\xb5\xfb?:\xf1\xe97\xab\xd2" +
+	"\x97\x9c\xceuu\xc9F\xc0\xdbJ\xc8K\xb3\xa3\x84\xde" +
+	"\xb0}\xaf\xdc\xfff\x9f\xaa\xd0\x1f[,\xe9\xbea\xed" +
+	"%|z\x18\xbd\xe7\xc3\xee\xc5\xa5\xc3\xc9\x92\x16\xff%" +
+	"\xf9\xd0\x98\x8d\xf7\xfdQ\x1c\xbd\xcfp:z\xc9p2" +
+	"\xfa\x8c\x0e\xdb\x17\xef\xab\xa9~J$P\x87_H\x08" +
+	"\xa6S\x82N\x07\xdf_}\xfe\xab\xb7=\xd5b\xbc\xa6" +
+	"\xe1\x92\x84\xb7\x92Q\xf0\xe6\xe1\xf7\xe2v#\xc8x+" +
+	"\xbf?\x19\xf8\xea\xce\xa4\xad\xbb\xe3\xc3)\xcft\x8f " +
+	"\xdd]\xd4a\xc2\xedSV\xc6W;]\xba\xabG\x1c" +
+	"\x00\\2\x82J\xaf\x94\xd8\xff\\\xf5\x937\x7f\xdef" +
+	"\x8d\x13\xa7RF\xcc\x03\xdc@\x89\x93#\xc8a\xbcb" +
+	"\xfd\xeb\xbb\xe7\x0d\xec\xb5\xc6&n\x8d\xa0_r\x98\xf6" +
+	"\xb6e}\xe0\xd3\x7f-]e#hWJ\x0f\x7f\xd7" +
+	"R?\x82\xfd\x8b.\xff\xf0\x8d\xad;\xd7\xa4\xbd\xf8\x94" +
+	"\xae\xa4t#\xe0q\xa5d\xb4\xb1\xa5D5hp=" +
+	"\xd7mw\x9b\xc7\x9ev\x94\x8f\xca.\x94\xf0\xd82B" +
+	"\\UFF\xbe\xf4\xf5\x01\xff\xec^|\xde3N|" +
+	"+Yv\x0a\xf0BJ\xbc\xa0\x8c\xb0\x87\xbb_\xbc\xab" +
+	"a\xe5\xaeg\x9fq\xba\x05}Fn\x04\\:\x92\x10" +
+	"\x97\x8c$\x1f\xbd!\xf5\xc9O\xee\xba\xfc\xf5g\x1c\xc5" +
+	"\x94\xa6\x91+\x01o\x1eI\x15\x91\x91\xf4\xf0\\_?" +
+	"\xe2i\xa9\xef\xf6g\x1c\xaf\xf7\x8eQ\xdd$|x\x14" +
+	"\xe9\xfc\xd0(\xd2\xf9\x94\xdf\xbe\xb9~Z\xe0\x903u" +
+	"\xc9\xe8=\x80\x95\xd1\xe4?\xc7\x8d\xa6\x9d\x9f\xde?\xe3" +
+	"\xa7\xbf\x8a\xde\xbeV\\\xdf\xe97\x16\x92\xf5]|#" +
+	"Y\x86\xbam\x81\xdf,\xfa\xf9\xfc\xb5\xc8i\x857\xdd" +
+	"x\x00\xf0\xee\x1b\xe9\x05\xbd\x91\xf6\xf7\xc3wSV\xbd" +
+	"2\xe1\xf9\xb5NK|\xe8\xa6\xf6\x12v\x97\x93\xb9B" +
+	"9\xd1.\x7f\xdc\xfa\xb3C\xedo_'\x0c\xdd\xb5\x9c" +
+	"^\xd3\x01\xe4\xcf\xa9\xeb\x9a\x9e}\xfe\x81/\xa7\xae#" +
+	"C\xbb\xd3\xd7i\\\xf9\x1a\xc0\xc9\xf2+\xc9\xd3_\xfe" +
+	"K\x09\x01\x17\xa3\x02\xd7@\x9b\x16l\xb0b\x0d\xe0\x93" +
+	"\x15\xe4NB\xe5\x83d\xa6\x97\x1c.\x98\xf1\xd6\xa0\xd0" +
+	"z\x9b\xc20\x86jY\x9b\xc6\x90\xe1\x07\xf4\xf5\xd4\xa4" +
+	".\xe8\xbb\x81>q\x16SD\xd0w\xef\x18I\xc2\xc7" +
+	"\xc7\x90\xef8:\x86(*\xd5\x1d\x97lx\xe1\xad>" +
+	"\xcdN\xbb\xdf\xaej\x0d\xe0\xaeU\x84\xf8\xb2*\xb2A" +
+	"\x07F\xec<2t\x83k\xa3\x93X\xd5Pu\x0a\xf0" +
+	"bJ\xbc\xb0\x8a\x9c\xab\x9b\x16\xb4\xf1\xd7\xfb6l\xb4" +
+	"\xb1\x82\x9b\xe9\xe3Rz3\x99d\xdf\x0b\xee}\xe3\xf9" +
+	"5\xed\x9f\x15\x09\xeao\xa6\x8f\xcb,J\xf0\xbb\x03E" +
+	"\x07}]\xbc\xcf:mH\xd3\xcd\xed%\xbc\xedf*" +
+	"@S\xe2\xea\xbe\xfdV\xf7\xfa\xc5\x8d\xb6\xde\xf6\xddL" +
+	"\xaf\xe3\x09J\xa0\x96%\xaeJ\\\xd9u\x93\x03\x87\xee" +
+	"r\xcb\xd7\x80\xfb\xddB8\xf4\x1d\xbb\x8f<\xf5\xc0\xfc" +
+	"\xa2M\x8e\x82\x92\xef\x16I\xc2=n!\x83^}\x0b" +
+	"\xb9\x95\xcf,_\xf9\xdc\xf3\xe3&mr<\xb1p\xeb" +
+	"k\x80\xbb\xdcJ\xa8;\xdf:\x05\xc1\xbf\xe6\\Yz" +
+	"^j\x13\x97G\xa6\xdf\x9aO^\xe1U}\xa7\xad\xaf" +
+	"\x0b\x14=o\xe3\xd8\xb7\xd2u\x98{+\x99\xf9\x03\xa7" +
+	"7\xac\xbc\xe8\xb2c\xcf;\xed\xd1\xea[\xdbKx\x07" +
+	"\x1dd\xfb\xadT\x15\xeb\xb44\xbcT\xbb\xf2\x05\xb1\xb7" +
+	"\xab\xc7R\xaeS4\x96\xf4f\xfd\xdew\x85\x9cZ\xbb" +
+	"\xf6\xcf\xb7\xf5\xffvM\x8a\x9c\x0dul5\xf4m\x18" +
+	"{K[\xc2\x92\x83\xc3\xcf\xc3\xcaD\xc2b{L\x9e" +
+	"\xfc\xf0\xca\xaff\xbe\x90\xf6\x8dt\xf4\xd2\x89\x8b\xc0 " +
+	"\xc3\xe3&\x92\xd1G,\xb9x\xf5\xea\xe4\xfctb\x83" +
+	"\xf5l\x9e\xf85\xe0\xbd\x13)\xa3\x9cH\xaf\\]l" +
+	"\xf7\x9cg\x96\x1e}A\xd4\xb2\x0eE&\x90\xc9B=" +
+	"\x99\xec\xb6^C\xfeul\xf4\x8a\x17\x1d6\xadk\xfd" +
+	")\xc0\x83\xea\xc9\xa6\xfdi\xc1\x07\xb7\xfc6\xf9\xc2f" +
+	"\xa7\x83\xd2\xa5>_\xc2\x03\xea\xa9\x84I\xbb\xdc\xf9\xfc" +
+	"\xea\xc2S\x07\xa7lI\x970\xbdT\xb3\xad\xbfP\xc2" +
+	"IB\xddwR\xfd\xb72\x82\x94\xe7\x9d^O,\x9d" +
+	"\xdf\xf1%\x87\x19\xf4\xd1N\x01\x1e\xad\x91\x19\xec\x9d?" +
+	"j\x82\xff\xe7k^rz9\xae\xd6\xbe\x06\\\xa2\xd1" +
+	"gF#\x8bT\xb2j\xfe\x8f\x81\x9d\x97\xbc\xec4\xdd" +
+	"\xe5Z{\x09o\xa5\xc4\x9b5*\xe7\xad\xeby\xc3\x07" +
+	"\xf7^\xf2\x8a\xe3s}T;\x02\xb8]\x82\xfc\xa7;" +
+	"AW\xf4\xa2\xdb\x1e\x9e\xf0\xe0\xb7\xd7\xbd\"n\x7f\x0f" +
+	"\x9dn\x7f\x89N\xdf\x86\xce\x7f\xfc\x99\xdck\xc1\x9f\x1c" +
+	"\xbe'\xac\x1f\x01<G'\xdf\xe3\xd9\xfdr\xc9\x9e\xd5" +
+	"\xbb\xff\x84|\xbf\x92\xb8\x90\x86\xa0\xaf\xa2\x13\xe3\x85N" +
+	"\x8d\x17z-\x82\xd4\xc1\xa1\x917\x9b}?\xfe\x89\xaa" +
+	"\x83\x0b\x0e\xfe\xb5\xa8\xf6\x95o\x8f\x13\xcaf}\x0f\xe0" +
+	"]\x94r\x87N\xa4\xe96\x97\xcc\xfeO\xbf\x17_\x7f" +
+	"5\xcd\x14f\x1a\xce\x92\xa7\x00\x87\x93\xe4?\xd5\xe4-" +
+	"\xe4K\xde\xc9\x8b~<\xeb\xeb\xcam\x82\x00\xbfc2" +
+	"\xbd0\xfe\xd9\xaflzg_l[\x0b\x11a\xdb\xe4" +
+	"\xd7\x00\xbf?\x99\x0c\xb9w\xf2\xbd\xb8\xfb\x14r~\x07" +
+	"v\xbbd\xd3\x8d\xf2\xef\xb79\xbe\x02\x1d\xa6H\x12\xbe" +
+	"\x9a\xd0\xe1\xeeS\xc8\x8d\xf6\x9f\x1fw/\xff\xf5+\xdb" +
+	"D\x91\x18\xa6R\xae\xd5e*Y\xbf)M#+\x0f" +
+	"\x94\xf9^C\xbeB6\xad\x01S\xcb\xc8\xb4>\xeb\xf1" +
+	"\xe9\xf7\xaf\x8f\x1a\xf8\xba0\xe1~S\xa9\xc6Q0s" +
+	"\xf3\x0c\xf7\x93\x8b\xff\xec\xb0\xe6=\xa6J\x12.\x9dJ" +
+	"\xd6\xbc\xf3\x05\x7f\x81\xc6\xbdc\xb7;\xbe\xad\xdd\xa7\xee" +
+	"\x04\\4\x95\x0a\xe0S\xe9\xfalW#c\xde\xf8\xe4" +
+	"\xf1\xed\x8e\x9cja\xc3\x11\xc0k\x1b\xc8w\xadn " +
+	"\xdc\xb8\xff\x88)\x8f\xd7\x0c\xda\xb3\xdd\xe9\x80\x8e\x9ev" +
+	"\x00px\x1a!V\xa7\x91\x03\xda\xf1\xb6w\x06}q" +
+	"\xfb\xffn\xb7\x896\xd3\xe8\xfbrx\x1a5\x84\xd5}" +
+	"\x15\xdb\xf8\xd9'o\x88\x04\x1d\xee\xa0,\xab\xeb\x1d\x84" +
+	"\xe03\xe5%\xa9dW\xe4/\"A\xd1\x1de\xa4\x87" +
+	"q\x94\xa0\xf3\x8d\xaf\xdd5\xf8\x0f\xde\x1dN\\e\xfa" +
+	"\x1d\xed%\xbc\xfc\x0e2\x9fFJ\xfc\xc5\xe8\xb7\x1f\xd8" +
+	"sY|\x87M\xbd\xbd\x83J\xdf{)\xc1\xcb?_" +
+	"\xf8S\xcf\xa5Kv8^\x92\x93wH\x12\xee|'" +
+	"\xf9O\xdf\x9d\xf4\x92\\\xe0~a\x84\xef\xee+w\x8a" +
+	"\xfd\x8d\x9eN\xc5pu:\xdd\xe4\xad\xa9\x7f.;\xfe" +
+	"\xc0N\xc7\xb5\x9d3}'\xe0\xa6\xe9\xd4l;\x9d\xac" +
+	"\xed\x0f/\xe6\x8f\xf8\xcf\xee/v:\xdd\xe7\x92\xbb\x8a" +
+	"%\xac\xdeE\x88\x95\xbbH\xd7\xf7\xbd\xf3\xd3{^P" +
+	"\xca\xdf\x12\xc7\x9es\x17}\xa7\x96S\x02\xdfW\x9d\x9f" +
+	"\x1c\xf2\xb0\xf6\x96So\xdb\xee\x92$\xbc\x8f\xf6\xf6>" +
+	"%~\xf7\xd8\xda\xfa\x9f\xad\xdb\xfc\x96\xd3\x8b|\xfa\xae" +
+	"\x95\x80;\xcf \xc4\xbe\x19d\x9ek\x9ez\xfe\xf9a" +
+	"#\x0f\xbc\xe5t\x066\xcdx\x0d\xf0.J\xbcc\x06" +
+	"9\x03\x9f}\xfa\xe3\x84\xdax\xaf\xb7\x05\xcd\xba\xc7\xcc" +
+	"=D\xb3\xde\xb1\xfb\xd9\xcfg\x9c\xf6\xfcU\xfc\x82\xae" +
+	"3\xa9\xf5\xa5\xdfL2\xa9\x89\xe7\xbd\xd9\xa9\x9d?a" +
+	"#\xa82\x08\xc2\x94\xe0\xbb\xce\xaf,\xb9x\xe0\x16\x1b" +
+	"\xc1\xdc\x99\xf4|5Q\x82\xd4\xd3\x0b:\x9c.\xf9\xf1" +
+	"\xafNk\xb0}f{\x09\x1f\x9aI\xcd\xb6\xc6p\xff" +
+	"\xac\xdb\xd9{\x8a\xff\x1d\xca\x81.\xec9h]\xe3\x85" +
+	"k\xff\x81\x10\xf4\x85Y{\x00_6\x8bZ\xeeg\xfd" +
+	"\x12A\xaa6\xf0\xe6\xab_~Q\xf1N:\xeb7l" +
+	"\xd9\xb3\x8e\x00\x1e4\x8b^\xe8Y\xf4\x86\x85\xdf(\xde" +
+	"_=l\xdd;\x8e\x8fY\xd3\xec\x02\x09o\x9bM\x05" +
+	"\x90\xd9\x84s|8\xcau\xe7\xd8\xed/\xbc#~T" +
+	"\xe3\xef\xe8G5\xff\x8e\xcc\xb3\xdf\x87?\xb9\xe3\x89\xfa" +
+	"6\xef\xdan\xd5\xef\xa8\xcd\xee\x10%\xb8\xb8h\xf7u" +
+	"\xde\xe8\xf0w\x9d\xc4\xf6vw\x1f\x00\xdc\xfdnj\x9f" +
+	"\xbd\x9bl\xe6\x83\x0f\xf4\xaa|\xec\xe99{\x1cE\x8f" +
+	"\xadwK\x12~\x9fR\xef\xa5\xd4\xfb\xff\xf1\xb3v\xa5" +
+	"\xea[{l\xb2\xd6\x1c\xc3b6\x87\x8c\xbd\xe0\x9b\x0f" +
+	"\x96\xbf\xbc\xe17\x7fCN\xd6\xba\xa69G\x00o\x9d" +
+	"C\x1f\xa59\xa4\xbb\x9ek_\x88\xef_5x\xaf\xc8" +
+	"%\xd5{\xa8\xfc;\xfd\x1e\xd2\xdd\xcf\xee\xde\x04\x7f\x7f" +
+	"j\xe4?l\x12\xea=T/k\xa6\x04\xd6>9\x8d" +
+	"\xb7\xfb\x9e5\x80\x0f\xdfC\x94\xef\xe3\xf7\x90\xb5=6" +
+	"w\xdf\xf7=\xdeX\xf7\x0f\x07\x06\xba\xfd\xdeb\x09\x1f" +
+	"\xba\x970\xd0}\x8d\x83v\x1d\xba\xf05\xdb\xa0\xdb\xee" +
+	"\xfd\x80\x0c\xba\xf7^2\xe8\x9f\x966\xbf\xf1\xd5\x82\xc6" +
+	"\xf7\x9cn\xcb\x89{\x0f\x00\xf6\xddG\x8d`\xf7\xd1[" +
+	"=g\xe0\xcc\xcb.\xfb\xfb\xfb\x8e\x87e\xf5}\xf9\x12" +
+	"\xdeq\x1f\x9d\xc0})rX^\x9dQ~r\xbd\xb6" +
+	"\xf2\x03\xc1\xca\xf2\xfe<j\x9c{>\xff\xa5\x03O\x97" +
+	"v\xf8P\x9c\xd6\xdey\x94\x17\x1e\x9fGm\xdf\x97~" +
+	"\xd9\xe7\x87\xefG|\xe4t\xda;\xcfo/\xe1~\xf3" +
+	"\xc9\xb4\xfa\xcc'\xc47\xc8M\x13\x0ew\x1a\xfd\x91\xd3" +
+	"%V\xe7K\x12\x9eE\x89\xa7\xcf'\x97\xf8\xbe\xb6}" +
+	";\xfe\xfd\xa5W\xf7Q=\xa0\xf1\x92\xf3g\xfe\xfb\xda" +
+	"\x97\xfeE\xae\xc6\xfb\xf3\x8b%|\x92R\x9e\x98O\xf4" +
+	"\x80'\x1e|\xe2\x82-}\xdd\x1f;u\xdb\xe1~\xf2" +
+	"H\xdeO\x1f\xc9\xfbI\xb7K\xaf\x99\x12\xbf\xbd\xa6\xf0" +
+	"c\xe7\xa7\xe7\xfe\x8b%\xdcL\xa9\xd7\xdeO\x16\xb2\xea" +
+	"\x86\xe5\xeb\x8b\xbe|\xe2c\xd1d1n\x015\xa57" +
+	", \x9f4\xf3\x99\xd9\x7f\xdc\xf3\xe5\x96\x8fm\x87e" +
+	"\x01=\x9c\xcd\x94\xe0\xd3{\xfcC|'\xc7\xee\xb7\xad" +
+	"\xe0\x02jU8L\x09~(\xfc\xe1\x95\x15\x03\xe3\xfb" +
+	"\x1d\xf9\x7f\x87\x07v\x02\xbe\xfa\x01\xfa\x8a>\x10#\xea" +
+	"\xd6\xa3\x1d\xfe\xf4\xd8\xa7\x8f\xed\xb4\xf5\xb7\xe3!\xda\xdf" +
+	"\xbe\x87H\x7fU\xf1\xe1\xbe_T\\\xf0O\x91\xe0\xf4" +
+	"C\x15T\x0aXH\xddz\xa1\x97\xee_\xbf\xe5\x0a\x1b" +
+	"\xc1\xa0\x85\xf4.\x07(\xc1\xde\x19\xab7\x0c\x01\xe5\x13" +
+	"\xa7\xf5L.\xcc\x97\xf0\xe2\x85TUZH\xd6s\xde" +
+	"\xc1\xb2\x9f'c\x7f\xffD\xec\xed\xe8B*\xb4\xb9\x17" +
+	"\x91\xde\xae\xad\xaa\x9du\xfa\xc8\x09\x1bA\xf7Et\xb8" +
+	"\x01\x94`Pu\xef\x89\xdd\xaf\xbd\xfe\x80p\xfa\xc6-" +
+	"\"6\xbe\xafF\xf4y\xfbX[\xed@\xcb\x8b3v" +
+	"\xd1)\xc0\xc9E\xe4\xe2\xd4\xe2\xd4\x87_,}\xe1\x80" +
+	"\xc3\xf5\x0a,:\x02\xb8\x9eR\xf5\xbec\xf8\xea\xdb\xc3" +
+	"\xf8\xa08\x89\xd2E\xf4z\x8d\xa3\x93\xe8s\xed\x9fc" +
+	"C\xba\xbdm#\x98e\xccr1%\xf0v{f\xeb" +
+	"\x94-\x97|\xeat\xd07/\"\xca\xc1\"\xb2(\xbb" +
+	")\xf1\x9c\xf2_-\xfd\xa5\xbc\xf2S\x9big\x91a" +
+	"\xday\x98\xba8\xfe=\xaf\xc3u\x8b\x94C\xc8w\x83" +
+	"\xc4|\x0d\x08\xfav\x7f8_\xc2%\x0fSq\xfba" +
+	"\xc2\xf5\x9fZu\xcf\xf2\xba\xea\x95\x87\xc4\x8eJ\x1e\xa6" +
+	"&3\x85v4\xf5\xb5c\xbf\xbfy\xcbZ\x1b\xc1\x9c" +
+	"\x87)7[N\x09\xae\xc7\xafo\x88.<b#\xd8" +
+	"j\x10\xec\xa5\x04\x8f\xbf\xb6\xe4\xf6\xe4\xb2\xc8\xff\xb6\x90" +
+	"HO<\xfc\x1a\xe0\x0e\x8f\x90\xc9\xb4{\xe4^<\x96" +
+	"\xfcWj^\xcf\x91S~\xff\xd2\xb1\xffuZ\x86\xa2" +
+	"G\x0e\x00\x1eG\x7f0\xf6\x11\xd2up\xdb\xb1\xbf\xfd" +
+	"\xd9\xf5\xf8g\xe4h{\xd2\x1d\xc8\x8f,\x05\xdc\xf4\x08" +
+	"\xbd/\x8fP\xcb@<o\xe1\xfe\xd2\xa6\xed\x9f\xa1\xc0" +
+	"/\x01R\xd7\xf5\xfc\xfb\xe5\x1d\xee\xfe\xdbq\xf3\xdc\x15" +
+	"\xfd\xfe\x03\xc0\xe3~O\xfb\xfe=\xb9\x99\xdf\xc3G\x89" +
+	"\x09\xfb\x7f\xf2\xb9\xd3DN\x12\xe2\xceK\x08\xb1o\x09" +
+	"U\xd7\x97v\x986\xe0\xd0\xe3\x9f;^\xfa>K\xd6" +
+	"\x00.]B8v\xd5\x12\xc2\xb1\xf7\xcd\x8e\x8e\xfe\xe4" +
+	"\xf4\xdc\xc36\x01\xf0Qz\x16\xaa\x1e\xa5\x12\xe2\xc1w" +
+	"\xaf*\xfa\xc7\xce#\x8e\xefW\xf2\xd1\xf6\x12^\xfc(" +
+	"\xbd!\x8fNA\xb0_m{s\xeao\xfb\x8f8\\" +
+	"\xa6\xe3\x8f^,a_#\xe5\xdb\x8d\xe42]\xdeo" +
+	"\xdc\x87\xdf]\\\xff/\xdbS\xd7H\xdf\xe19\x8d\xd4" +
+	"T\xcb\xf8\xa0\xd3\x87<\xd9\xb8\x07\xf0\xb6F\xf2!\xbb" +
+	"\x1a\xc9\x1am\xbe\xe6\x17\xeb>\x9b\xf6\xf2\xbf\x1c\x1f\xaa" +
+	"\xfa\xa5\x1f\x00\x9e\xbb\x94\x0c>g)\xa1\x0e\xdc~e" +
+	"\xf9\xed\x03\xbe\xb6\x0d\xdec\x19=\xb4E\xcb\xc8\xe0z" +
+	"\xf3\xe9\xf1\x0d\x1fW~\xe1\xa4\xcb\xab\xcb\xb6\x00\x9e\xbe" +
+	"\x8c\xf4\xd6\xb0\x8c|\xca\xb0\xc7n]{\xe9?_\xf9" +
+	"\xc2\xe1^\xee[\xf65\xe0\x93\xcb\xc8\xbd\xbc\xf6\xe1\x83" +
+	"+\xff\xfd\xe0\xbdG\xd3\xf5*\xfaP\xed]\xb6\x06\xf0" +
+	"\xd1e\xd4\x19\xb1\x8c>T\x0f]43\xe2\xbda\x05" +
+	"%o\x9b\xfeA\xbe\xc7\xdaK\xb8\xcfct\xda\x8fQ" +
+	"\xf2\x97\xee8~\xd1\x86C{\x8e\xdan\xcf\x0a*+" +
+	"\x8f[\xe1G\xf0\xfd\xf5]\xf6j\x1b\x9f\xf82P\x04" +
+	"\x92u\xe9WP\x15~\xf9\x0a\xb2$o}\xe5Z\xb4" +
+	"\xaa\xeb\xbe/\xc5\x0e\xfa5Qf[\xdaD\x96\xa4\xc3" +
+	"\x7f\x9a\x9f\x0fM\xea\xff\x95H\x10n\xa2\xdco\x16%" +
+	"\x90\x92\xfe>\x9d\xdfz\xec\xab\xf4\x0dkCe\x93\xa6" +
+	"=\x80\xb76Q\x16\xd2D\xef\xc0\x9c\x1d\xd3w\xc7w" +
+	"\xbcb\xeb\xaf\xf4q\xaa\xc2)\x8fS\xa3\xc2m}\xcb" +
+	"\xffq\xf0\x17\xc7\xa8\xc0hY\xea\x10\xf4\x9d\xf3\xf8\x1e" +
+	"\xc0M\x8fS\xc9\xfeq\xa2\xdc\x96\xef\x1a|\x97R\x10" +
+	"<\xeexTv?\xbe\x11\xf0aJ}\xe8q\xf2\xa5" +
+	"#\x07\xbf\xba\xf3\xb2\xdd\xf3\x8f\xdb\xf8\xc8\x13\xd4 \xb9" +
+	"\xfc\x09?\x12\xeec\xdaY2\xfcVOl\x01\xbc\xf7" +
+	"\x09b\xe6\xdb\xf7\x04\x95?\xd7\xef[r\xba\xf3\xa2\xf7" +
+	"\x8f#\xdfp\x89;+\x10\xf4\x1d\xbbJ\x93\xf0\xf4U" +
+	"\xf4\xa0\xac\"\xaf\xb7\xa5w\xa7\xcd\xd3M\x85\xd0Uk" +
+	"\x007\xaf\"\xe6\xc6\xed\xab\xe8\x0a}\xb3\xf0\xcb\xef;" +
+	"\xde\xac}-Nt\xecSt\xc5'=E&\xba\xfb" +
+	"\xcb\xbcg\xde:4\xf2\xdf\xe9\x13\xa5\xfd-~\xea\x03" +
+	"\xc0\xcdOQ\xcf\xecS\xd4\x1b\xfdW\xd7\x9e\x15\xe7\x7f" +
+	"\xfd\xe7\x7f;=v\x0b\xd6TK\xb8y\x0d\x15\x07\xd6" +
+	"\x90C]8\xab\xe6\xe5\xe9\xa9\xd3\xffv4\xd6<\xdd" +
+	"M\xc2\x03\x9e\xa6\xc6\x9a\xa7\xa9\xefp\xd2\xe3\x0f}\xd7" +
+	"\xcd\xf7M\xfa\xd9\xa6[_E\xa8\x93\x84\xba\xef\xa4\xa7" +
+	"\xe9\xc3\xfe\xe2\xd2G\x1e\xfcs\xc1\xf0ol\xc6\xafu" +
+	"T\xb9*ZG\xd5\xce\xdf\xcc\xfag\xfe\xe1\x836\x02" +
+	"e\x1d\xbd\x9fIJ\x90w\xcf\xaf\x97(\xc3\xa5\x136" +
+	")~\x1d\xdd\xc3fJpRy\xe8\xb6^]\xda\x9e" +
+	"p\xfa\xd6\xbd\xeb\x8e\x00>\xbe\x8eZW\xd7\x91om" +
+	"xp\xe1%\x97D\x1e\xfaO\x0b\xe3\xc8\xd8\xf5\x07\x00" +
+	"'\xd7\x13\xcaI\xeb\x97\x10\xf3\xe5\x96[\x8f\xce:\xb2" +
+	"\xe0['}\xf8\x93\xf5\xc4\x0fF\x89O\xae's\xb8" +
+	"l\xf7\xcd?>\xf1\xc2\xa3\xdf:zT7,\x02\xdc" +
+	"g\x03!\xee\xb1\x81\xcc\xa1'n\xf7\x91\xff\xa5W\xbe" +
+	"u\xd2*\xe6n\xd8\x02\xb8\x89\x12/\xdf@=\xbf\xf7" +
+	"t:t\xb4\xe7\xf6o[\\\x8d\x01\xcd\xed%<\xb6" +
+	"\x99\xfa\x18\x9a\xc9\x91{\x19\xd6\x9c\xf7\xeb\x09\x9f\x7fg" +
+	"\xf3\xfc5\x1b\x9e\xbffz\x86\xae\xf9\x99\xef\xaf'\x1f" +
+	":)\x12\xacm\xa6K\xbd\x8d\x12|\xd7\xf4t\xdf\x99" +
+	"\xbb\x9e=\xe9\xc0\xdd>!\xa3\xc1F\xc2\xdd\xdc\x0f<" +
+	"{jw\xe3\xc7'\x91\xefz\x89\xfb\xae\x88\xff\xa9\xf9" +
+	"\x03\xc0'\xe9\x8cN4\x93w\xfe\xd4\x81\x9f\xbc\xd7\xe7" +
+	"\x96\xcfO\x8ar\xe6\xc9\xe6i4|g#\xb5'\xbf" +
+	"\x14\x7f\xe9\x1e\xa5\xcd)\xc7'g\xc0\xc6S\x80\xab6" +
+	"\x92\xee\x02\x1b\xc9\xba\xf9\xfd\xd3\x16\x9c_:\xe6\x94\xd3" +
+	"9]\xbb\xf1\x08\xe0\x1d\x94x;\xedz\xef\xb6=\xfb" +
+	"7\x8c?v\xca\x16E\xb5\x91\xde\xa8\xd3\x94\xe0_7" +
+	"}vI\xaf\xad7~\xef\xb4\xbf]\x9f\xdd\x03x\xd0" +
+	"\xb3\xa4\xb7\x01\xcf\x12\xe2\x87\xd7\xdfs\xea\xc0\x8c\xee?" +
+	"\xd8\xee\xe7\xb3\xf4\xf1\xac\xa7\x04\xdf\x0e\\\x92|=\xd8" +
+	"\xff\x07\xa7=]\xf8l{\x097\xd3\xde\xd6>K\xf6" +
+	"t\xe9\xd2\x8f\x927\x1c\xcc?\xed\xb0\xce\xeas\xf9\x12" +
+	"\x9e\xf3\x1cY\xe7\xab6\xbf0\xb7C\xaf\xb1\xa7m7" +
+	"\xe39*\x84'\x9f\xa32N\xfb\xb9O\xe5\xdd\xb3\xee" +
+	"\xb4\xd3z,~\xeeB\x09oz\x8e\xfa\x9c\x09\xf1\xe9" +
+	"\xea1\x8b+\x0f^\xfd#9E\x96\xdc\x80\xa0\xef\xa1" +
+	"\xe7.\x96\xb0{\x13\xa1\x83M\xe4\x14m\xde\xfd\xf6w" +
+	"\xc3O\x14\xa7\x1cO\xf2&I\xc2\xfd(q\x9fMS" +
+	"P\x8fT0\x16\xad\x8fE{h\x9eD\xaf`\xac\xbe" +
+	">\x16\xed\x15\xd7bz\xac\x97\xd1\xde3\xa8\xc4\xa3\xf1" +
+	"\xc2!\xe6?b\xf5q%\xa8W\xea\x8a\xae^Q\xa1" +
+	"&\x92\x11=\x81\x02.\xd9\x85\x90\x0b\x10\xf2u(C" +
+	"(p\xbe\x0c\x81\x8b$Hij\"\x1e\x8b&T\x84" +
+	"\x10t\xe4\x06C\x04\xd0\x91H`Y\x0c;$\x16\xd5" +
+	"\x95pT\xd5J&\xabQ\xfd\x16E\x0f\xd6\xa9\x1aB" +
+	"\xe5\x00\x81\xb6\xb2\x1b!+\xf0\x08\x98\xbe\xe8\xebS\x80" +
+	"$_w\x0fpk80\x8f\xbd\xafK>\x92|\x1d" +
+	"<y*\xe9m0xC\xb1\xa8:\x18\xca\x81O\xaa" +
+	"M\x06\x93*\xd2\x82u\xe1\xc9\xea\xa8Xm\xa2B\xf5" +
+	"\x1b\x9fJf$\xacF\xb5\xb9\x1aWI\xb4k\xfa\x0d" +
+	"H\xd6\x12p\x01\x82r\x19\xa0#\xb7\x9d  \x8d\xd9" +
+	"\xadJ\x9d\x1a\x9c\x18\x8f\x85\xa3\xba\xb5>\xadM\xa4\x00" +
+	"\xa1@[\x19\x02\x9d$\xc8S5-\xa6AG\x91q" +
+	"\xda6$\x93o/\x8e\xc4\x82\x13Kc\xe4\x1c$\xe8" +
+	"6t\xb4\xc6R*\x10\x0a\xfcV\x86@D\x02\x1f@" +
+	"' \x8da\xb2\x12u2\x04t\x09|\x92\xd4\x09$" +
+	"\x84|\x93\x8a\x11\x0aDd\x08L\x95\xc0'\xcb\x9d@" +
+	"F\xc8\x97$'H\x97!0\x93\x9e %T\xdc\xa0" +
+	"\xab\x08\x12\xd0\x0eI\xd0\x8e\x18\x11\xb5\xb0\xae\x167\xe8" +
+	"HV\xad\xc6\x19\x84\xf0\xa6x\x1a\xd1M\xf1\x04B\xc8" +
+	"j\xcb\xe6\xfbn\x09\xebuc\xd4\xa8\x12\xd5+\xd4I" +
+	"\xde\xa4\x9a\xd0\xd3\x16\xb4\x90/\xa8_\xa7\x84p>\x92" +
+	"\xe0\xfc,\xb7P\x9d\xaa\x06+\x1b\xa2Ak\x03\xaf(" +
+	"W4\x8fR\x9f\x10\xc7*\xe6c\xcd\xd0\xd4Id6" +
+	"\xd0\x91\xbf\xe19l_i4\x11W\xcdk\\\xe17" +
+	"\xba,\x87\xec\xa6\xae\xa9\x09=\xa6\xa9|\xe6\x84\x1dx" +
+	"\"z\"3v`\xf9\xb0\xd3\xa6\xdf6\x83\xa1\xab\xe2" +
+	"\x91\x98\x12\xe2\xc7\xbf\xb4^\xa9U+\xac\xee\xc9V\x9d" +
+	"o\xcd\xa1\x84l\xd5`\x19\x02\xa3\x84\xf3XJ\x0e\xe9" +
+	"\x08\x19\x02c\x84\xf3\x18 \xb7d\x94\x0c\x81[%\xf0" +
+	"\xd3\x13\xa4\x81\x8fGj \x00\x1f1_\x92\xc1\xca\x15" +
+	"\x1dA\x1d\xdb\xf23^\xa9L\xd63\x19\x8d+\xc9\x84" +
+	"j;\x09\x8a\x9c\xc1I`ar9\x8c\xa9\xc5\xc8\x09" +
+	"\x18\x15\xab\x15w1\x8fr\xf5\xccv\xd1\x0a\xa1=\x1b" +
+	"\xa6N\xb9H\x85\xf19\xc6\xee\x09c_\xcc?Y\x0e" +
+	"\x87Z\\\xb2L\x8eK2\x1eRtU\xe0\x91\x89X" +
+	"R\x0b\xaa\x89\x8cW\x98K\xe29\xdc\xb5\xe1\xaa>&" +
+	"V_\x93\xd0cQ\xf1\xaee\xf1\x8d\x99,\xe6\x14%" +
+	"\xac\xdb\x8fN}\x02\x9d\xf9\xc3\xacp\xe4\x1c>\xac(" +
+	"\x14\xd2\xd4Db\x98R\x1f\x8e4\x98\xb7\x8e\xde\xa3\xcb" +
+	"\xba\xd1\xbb\xd29\x1f!\x90|\x1d\xf2\x11\xf2(\xd1\x06" +
+	"o8>\xf9:\xf2?\xd7\x9f\xd5)\xa1\xa7\x0f\xfe\xdb" +
+	"\xfb\x96 \x84\xd0\x91\xeb\xb7\xb9\\Fzd*\x1b\x12" +
+	"A=\x92\xb0\x04\x9d\x0c%\x1d\xcb\xf0\x9c\xc3\xa2V\xb0" +
+	"\x1bI\xbe\xd4k\xbe\xe4g1\xf5\x8cO\x82e\xa9\xce" +
+	"a\xb5F\x85\x13z\x91\xae+\xc1\xbaJ5\x91\x08\xc7" +
+	"\xa2d\x9f\xf2\x9c\xe4\x902A J\x98\xb4\x08!." +
+	"\x0fY\xde\xda\x1c\xe4\xa1[\xc4;\xc0\xf8\x89!#\xb2" +
+	"\x09\\M\xae\xda\x152\x04z\x0b\x8fA\x0f\x0d\xa1\xc0" +
+	"\xb52\x04\xfa\xdb\xef\x1f}\x9c\xd5D\x02\xe5\x85c\xd1" +
+	"\xd2\xdcx\xcf\x10MUt\xb5\\\x8b\xd5\x92\xbbbn" +
+	"\x8e\xd3\xae\x88'8^\xa7$T\xf0\xf2x#\x04\xe0" +
+	"\xcdr1\x12\xc9x<\xa6\xe9\xc5\xc9h(\xa2f~" +
+	"\x0c,\x9fz.\x0cA\x14\x88\xf3\x9c\x18]7s\xcc" +
+	"+$\xf0\x84C\x96\x18L\x16\xf6\x82\xb3}.\xb3\x13" +
+	"?,sI\x0e\xc7=,HOY*A\x96\x9b)" +
+	"\x07\xa9\xc7Q\x09\xeaIu\x98+\xca\xf3\xe8\x06\xb7*" +
+	"\xf2\x13\"\xe8(\x86eg?\xbc]\xdc\xba\x85\xcaG" +
+	"=\xa9\x98\xe44|>\x1f\xde\x1bRt\x05: \x09" +
+	":d\xb9\xd2\x81dLW\xd2\xbeT\xf1f\xf0\xa5," +
+	"\x084\x87\xdd\xad\xd4c\xf1,\xb8\x88\xc5D\xaa\x9d\x99" +
+	"\x88\x1e\xaeWcI\xbd\x12\xc9j0'U\xc4\xb6\xed" +
+	"\xa0\x07\\\x00B\xac=\xe4{\xc74\xc4\xd5\xc0\xe5\xd6" +
+	"\xe4v\x93\x95\x7f[\x86\xc0{|r{\xc9\x84\xdf\x95" +
+	"!\xf0\x11\x11w\xc1\x10w\xdf'\xc7\xf4=\x19\x02\x9f" +
+	"\x12\xf5\x0b\x0c\xf5\xeb\x13\"\x18\xffS\x86\xc0\x17\x12\xf8" +
+	"\\\xaeN\xe0B\xc8w\x98\xdc\xdbOe\x08\x1c\x93\xc0" +
+	"\xe7\x86N\xe0F\xc8w\x94,\xfb\xe72\x04\xbe\x91\xc0" +
+	"\xd7\xa6}'h\x83\x90\xef8a\xa5\xc7d\x08\xfc " +
+	"\x81\xcf\x03\x9d\xc0\x83\x90\xef$i\xfcN\x86J\x17H" +
+	"\xe0\xd5\x1b\xe2\x84\xb7Y\x9f`\xf06;\xdf%|<" +
+	"D\xaf\x8c\x0bI\xe02\x971\xa1+\xf5\x08\xe2l\x15" +
+	"=\xf1p\x08\xda\"\x09\xda\"\xe3\xd1'\xddZ\xf1\xfb" +
+	"&\xcb\x8ck\xea\xe4p,\x99@y\x95\xadP\x9c\x89" +
+	"\xcb\xb7\xc9H\xfa\xd2\x83u\xf4\xb0:\x9dOgNk" +
+	"E\xa0\xe5\xa4\x009K\xb4T<\xf2\xfc\xdf\xab\xff\xc3" +
+	"\"\xc9D\x9d\xc1\xe7'%=Y\x0b\xb4m2\xba\x86" +
+	"\x8a\xae\x96F\xc7\xc7z\x16+A\xefD5\x1a\x12\x04" +
+	"\xccB\x9b\x80Y\x88\x90\xbf^\xad\x8fi\x0d\xde\xf1\xe1" +
+	"\x88\xeaOL\x8a\x84u5\xbb\xd1T\x83\xa1\x86b\xb5" +
+	"\xec\xe9\xa2\x17\x8d;C\xa1\xd0_\x1e\x8b\x84\x83\x0d\xa2" +
+	"jy1W--\xcd\xb2Z\xd4,]\xa6fY\xc8" +
+	"5\xcb31\x07\x7f\x9c\x0e\x03^>x\xda\xf3\x9f\xc9" +
+	"\x07\xdd\xa8\xeaSb\xdaDn\xa0\x11fMf8T" +
+	"\x86\xc0o\x05\x19h\x1c\xe1\x1a\xb7\xca\x10\x08\x09\x0a\xb1" +
+	"B\x1a\x7f-C\xa0N\x82T8\xaa\xab\xdax%H" +
+	"\xed.\x96\xbcf\xb9\xbd\x0cy\x8d\x0a\xf9\xd0\x91\xbbW" +
+	"\x8d\xc3E\xc5\xfe\x96\xcd\xd9\xdd1\xcb$\x93\xad\x8e\xca" +
+	"\xdc\xe39\x0c:*V;,\x1c\xd1Um\x84\xaaD" +
+	"d\xbd\x8e\xacd'k\xd0\xe9d%\xef\x94!p\x9f" +
+	"\xb0\x92s\xc8u\x9f)C\xe0~\x81\xd7\xce%\xd3\xbb" +
+	"O\x86\xc0#\x84\xd7J\x06\xaf]8\x01\xa1\xc0C2" +
+	"\x04\xfe@x\xadd\xf0\xdaF\xd2\xf8\xa8\x0c\x81'\x0c" +
+	"\x9b\xe1\xf8pmRC\xb2\x1a\x02@\x12\x00\xb1u%" +
+	"\xa3\xd1p\xb4\x96\xfd\x9b|\xad\xaeh:\x95\xa3Mv" +
+	"\x98\x8a(\x09\xbddjXG^\xc2H-.\x1a\xd2" +
+	"b\xf1\xb8\x1a*F\xde\x06\x9d[\xcf\xb2\x93+\xc5\xe7" +
+	"1[\xcd\xc8\x0a=\xcfa+\xeaT%\xa2\xd7Q)" +
+	"\xe4\x8a\x0a\xbf\x9a\xc5\x01\xb0\xe2\xebs\x90\x06\xaa\xd2\xc4" +
+	"L*\x10\xc8\xd92\xbc\xb6\x19\xb1\xa0 \xb1\xae\xdf\x18" +
+	"\xd3\xc3\xe3\x1bF(Dl\xd7z\x12\xcb4Yd/" +
+	"\xf9\xda\xac\x96\xcb0J\x1aoRv\xcbe\xa5\xb9\x9c" +
+	"c!\x91\xcd\"\xab\xcf\xa8U\x059;s\xf1\xde\xca" +
+	"Q\xc9\xe1\xa0U\xaa\xeaD\xaa\x80\x93g\x00\xf44\xe6" +
+	"y\xb1\x9351\xdf\xe4\xa8\xe5\x12\x80\xc9;GW8" +
+	"\xb2|o\\\xd1\xebl\xfc\x9fI5n$\x81;\xfb" +
+	";\xa1\xe95\xaa\xa2gn6\xb6\xa2dr\x11\x8dU" +
+	"m\xb2j;\xa7\xad\xe9\xf9\xd9\x08\x1c\x99\xa9\xf6z\xb0" +
+	"\xce\xae\x00%Dc\xda\x994|\xb2\x16W\xc9\x10\xb8" +
+	"\xce\xb6\x193\xa6\x18\xaa\x05\xf8\x18\xac\x85i\xe3\xcd\xca" +
+	"b\xa3*\xa1\xb4\xe3\"\xbc\x10d6Se\x08\xdc-" +
+	"\xccfV>\x7f6\xd8q\x99S(\xbc\x1a\xec\x81\x98" +
+	"K\x96\xf1n\x19\x02\x0f\x91\x07\xe2\xb7\xc6\x03\xb1\x80\x9c" +
+	"\xfa\xfbe\x08<\xda\xfa\xc1\xf2\xc7\xc6\x8fO\xa8:c" +
+	"\xf0y\xc1X2\xaa[\x8fC\x8d\x12\x9c8E\xd1B" +
+	"\x08!\xeb\x11\xc9\x95\x13\x9b\x9a_V\x9b9\x9a\xcc\xc6" +
+	"\xae\xd5\xb1\x17=w\xcd\xc8\xaf\xf7$\x8a\x10Y\xfe\xcb" +
+	"\xe9\x8a\x8e\xad\xa0BbU!\x15\x12G\x93\xff\x93}" +
+	"%\xc5\x08\x81\xcb7h6B\xe0\xf6\x0d \x8dm|" +
+	"}& \x04\x1e\xaa\xc1\xa5b\xb1\xfa\x91\xe1HDE" +
+	"\x10\xf2\x13=D\x0d\xf9\xe9\x03\x10\x9a\xa1\xa9\x89d\xbd" +
+	"\x1aJM1\xe5D(\x99\x1a\x0fkj\x08\xf95U" +
+	"\x89\xab\xa1\x14UA\x86\xd4)\xc8\x1b\xadUCT\xaf" +
+	"\xa0O\xb0\xac\x86r5\x01r\xe1\xfaL\x1cHs\xf2" +
+	"g\xe4;K\x9d\xadh<v\xd6\x94\x9d\x81\xdd\xc1\x1f" +
+	"\x93\xb9\xcd\xc9\x0a\xf4\xcf\x811\x90\xcd\xb2\x99\x1e[\xd1" +
+	"H*\x84\xa7\xc24<\x96\"\xc8\xcd\xd6>1}\xcc" +
+	"\xcc\xb9\xaf\x95\xac\x9e\xc3\xbbd\xb3\x84\x1b\x16\xf0K\x8d" +
+	"\xa7\xa6\x98\x9etz\xb6%\xdf\xa0bz\xd2\xfb\x15\xd2" +
+	"\x93\xde\xa3\x8c\x9e\xf4\xab\x8d\x93\xde\xb5\x18\xa1\x19\xc9\xe8" +
+	"\xc4hlJtF\x90\x9a&CL\x9e4\xcfy\x8a" +
+	"\x08x\xf1p\xb4\x16!d\xde\x80\x19\x9aZ\x1f\x9b\xac" +
+	"\x86\xb2:\x13\xce\xd6*S\x9eI\xbb\xecY\x9b\x82h" +
+	"7\x0e\x8b\xde\xf2\xd9\xc9E\xf5L\xa8\xfa(\xa5F\x8d" +
+	"$\x9c\x86p\xdeW+\x0f(\x87#\x9ch\xf1\xaaf" +
+	"nD\xb0\xe2\xa1s\x187\x12Nps\xb9\xe5)\xc8" +
+	"\xe0\xbeZ\x89v\xb9\x08W\xd4/Q\xa1NP\x83z" +
+	"X\x8eE\xa9\x96\xcd\xd3\xe2\xa0\xd0_\xa1*\x89XT" +
+	"|\xd1\xbb9X\xdb\x0a\xf9\x83\xee\x99\xa86X\x0f\x9f" +
+	"F\x7f\x0d^\xdeg\x0e\xb6sM\x8d\xc5\xd5\xe8Y\xb8" +
+	"E-\xd8\x82\\\xae\xb9\xe81\x80\x04] \x9ex\x0c" +
+	"\x05y\xe5\xc4;\x10p\xd1\xb8\x17\x86\x10\x04,\x7f\xce" +
+	"\xe7+D\x92\xcf\xed\xf1\x1b\x9e\x06{T\x8b'K5" +
+	"$\x1cTt\xcaR\xcd\xa0\x12:\x17\x1e>\x09\x85\xfe" +
+	"\xa2 !8\xa3\xb7\xbd\x80\xcb\xc7\x96J<\xba\x80?" +
+	"Y~\x85\xf6\x03^\xde\xbb\xb1m\xe4\x16GcL\x7f" +
+	"\xcd\x9b\xacD\x92j\x0bI\xb9m\xa6\x16\xba4\x012" +
+	"K\xdb\xbd\x95-\x93\x8b[\x8f\x9d\xa8\x9c\xddz\x0e\\" +
+	"\"\xbb3i\x81\xcb\xe4\xc8*\xec\x0e\xbe\xccY\x94\x95" +
+	"E\x9c\x83:\xd9\xbaN\x9c\x13\xf3\xcf4\"'\x07G" +
+	"\xba\x95*\x99\xf6\x95\xeeL\xe5\xe1<z&\xe9\x0d\xe3" +
+	"\xb1\x99\xcc\xba/(\x14\xf9\\\xa1\xb0\xf4\x89j'\x8b" +
+	"S\xa1\xa0;0\x85bA\xa1`\x86r\xc9\x86B\xb1" +
+	"\xb0\x98+\x14\xcc:oM\xc1\xe4\x9e\xf5d\x8a\xe5\xb1" +
+	"0\x92y\xa0\x93\xdf0:[\xff\x1c\x9f s\xb5t" +
+	"\xabX\x9c\\\xe9DN\x9b\xe0hF\x10\xe2\xfdXt" +
+	"\xbc\x90\xa0\xd3'\x9f\xc5\xfb1\x8c1`\xc0N\xbe." +
+	"\x054\xde\x8fZ\x87\x07C\x1e5Gd\xcf\x19\x89\xd8" +
+	"\x97\xc3\xc9\xb02\x0a\xcf\xfe\x896\xf8\x15dx\xe1\xad" +
+	"\xc8\xd2\x9c4\xfd\x96\x17\x8f/\xbf\x95{\x06,8\xd8" +
+	"\xd7\xa7\x90-?\x83\xd2\x01\x06\xc9\xc5\xc2-\xfdu\xb4" +
+	"\x9f\x9c\xe3-\x13\xdcF\x9f\xa5E\xcb\x02\x1b\xc8-|" +
+	"\xc7\x10\x06ssudr\xffi\xff(\xdd\xc7\xd8\xcd" +
+	"\xc9\x8eQ\xe0,\xf6\x98\x0fc.WM\xa1l=\xed" +
+	"\\C\x06|\xddJ\x0e\xcc\xe1x\xd9\x99l\x96Fd" +
+	"+\xc5)\x07VK\xb5\x08\x83\xd5\xa6E\xad\x0a\xae\x0e" +
+	"\xb6\xda\xea4\x84\x02!\x19\x02q\x81\xaf\xd6W\x8bA" +
+	"\xab3[\x06\xad\xa6Y\xf8\xea45Q\x17\x8b \x7f" +
+	"\xa8\xd8fsO&\x94\xda\xf40\xd6\x94:5\xa8\xaa" +
+	"!\xd5\xd12\x93\xc9\xba\x96\xa7\xd9\xaa\xcf\x1c,u." +
+	"\xbc\x81c\xb8\xa9\xd9\x16\x7f\xec\xe0r*\x17\x0e\xf3\xe8" +
+	"\x09\xdc<a\xd9,\xaa\xc8J\x8e1\x9cS\xf6\x90\xe9" +
+	"\x8e\x1cR\xc9\x9c\xa3e\xc7\xf0\xd2\x87\xa6%A$V" +
+	"K\x17\xdd87\xe9\x7f\xcd\xfe\xdcT\x91=K\xbb\xa6" +
+	"\xf9g\xb8\xa6^\xa2T[\x86\xb8H\xb8>\xac\xb7\xf0" +
+	"\xbb\xb83\xf3D\x95D=\xba\xd6\x90f`,t2" +
+	"0Vp\x81\x00$'y\xc0<\xb7\x0b\x8aEy\x00" +
+	"Z\xca\x03i\x86D'\x83\xb5?\xa1k\xaaRo\xbd" +
+	"\xfbqE\xd3\xc3J\xc4rW\xd5\xab\x09\xb2l9\xc5" +
+	"\x7fT\xa4\xc5\x18\xdb\xfc\xdb\xc2&L\xe0\xeb\xcd\xd6\xa0" +
+	"O\x01\x8f\xc7\xe0\x07\xc9\xab\x95\x0bA\x03\xe7\xe2\xf0\x1b" +
+	"b\xb1\xed\xaa\x09\xbbS!Xz\x99\x7f\xb0\xc0IZ" +
+	"\x9b\xe6\xe4\x1f\xac\x10\xfd\x83\xa6\xb4\xd68M\xf0\x0f&" +
+	"\x087\x8e\x06\x89\xb5\x92\xadw\xab\x1f\x95\xd0\xb5pP" +
+	"\x1f\xa3\"\xbfV\x1f\x8e\xf2\x0dJi*\x09\x14+\x89" +
+	"\x0a\x9d\xa4\x12zH\xd5\xb4r\x0d\xf9\xc31-\xac7" +
+	"\xe4\xc4\x8dH\xb7\xc3b\x1a15sf\xef/W2" +
+	"\xd3\x1b,\xcc\xa3\xdc\x1em#\xb6]</\xc2\xb6\x14" +
+	"8]\x9an\x823\x97\xb1\xa39eNRt\x05\xbf" +
+	" `\x86\xc8,.\xe0{\xd5Z0\x8a\x18\xb0\xe2\x18" +
+	"\xe4b\xe8\xec\xa1\"\x04\xdct\x7f.\xbc'#E\xc9" +
+	"\xd5\x1e'\xff\x7f\xe8\xb3ii&e\x8e\xcc\xcc\xdey" +
+	"+\xc1*\x07~=*V;T\xf3\x86'\xabZ\xa0" +
+	"-\x88\xf9w\xedj\x84<\xd5v\xf9\xa9a\x89\x86h" +
+	"\xb0<\x16A\x9ep\xb0\xc1P\xb6\xaeb\x93\xc3\xed " +
+	"\x1f\xa1J\x17\xc8P\xd9\x11\xac\x1b\x8c;\xd0\xe6\xb6\xa4" +
+	"\xb9\x13\xf0K\x8c}P\x8cP\xe5\xf9\xa4\xfd\"\xe01" +
+	"U\xb83tC\xa8\xb2#i\xbf\x148\xa3\xc5]\xa0" +
+	"\x0c\xa1\xca\x8bH\xfb\x15\xa4\xdd\xdd\x91FV\xe1\xae\xb4" +
+	"\xfdr\xd2~-io#\xd1\xe0*|5T#T" +
+	"y\x15i\xbf\x8e\xb4{\xce\xa7\xf1U\xb8\x0f\xd4 T" +
+	"\xd9\x9b\xb4\x0f$\xedm]\x9d\xa0-\xc9z\x83\xd9\x08" +
+	"U\xf6'\xedCI{;_'hG\xf2\xf7i\xff" +
+	"\x83I\xfb(!\"\xcbZ\x17\xe3\xb4\xda\xe4\x98\x19\xf5" +
+	"\xca\xd4\xca\xf04\xd5\x8a\xbd\xd2\x95Z\xf6\xb7T\xbd2" +
+	"uX8\xa2\xda\x02\x10\x88\xf6@\"^EI\xa6&" +
+	"9~\xbc\xaaU\x86\x91\xcc;J\x8d\x177\x00\xbc|" +
+	"\xabL\xcd\x93\xfe\xbd4\xaa\x83\xaaMV\"\xa3\x13<" +
+	"\x9f'\x14\xd6\xd4\xa0^\x1as\x12\x96\xdc\x99F\x18y" +
+	"I\x88\x11\xd5\xba9\x003\x14\xcf(V\x82$\xe4(" +
+	"p\xa9uP7\x11.\xb5A\x86\xc0\xcb\x9c\x95o&" +
+	"\x8f\xffs2\x04^\x15X\xf9VB\xf8\xa2\x0c\x81?" +
+	"\x0b,c\x9b\x86P\xe0U\x19\x02o\x0b\xac|\x07\xe1" +
+	"#o\xca\x10\xf8\x1b\xd9|\x97\x11V\xb7{#B\x81" +
+	"\xbf\xc9\x10\xf8'\xd9y\xb7\x11V\xb7\xaf\x06\xa1\xc0G" +
+	"2\x04>\x97`F\x8d17\xf0\xf2);\xed\x98\x1a" +
+	"\xd5\xb5\xb0 [\x1a\x01\x01%Q\x94goO\x84\xa7" +
+	"\xa9\x8e9V\x89\xca0D\x83\xea\x10\x92\xf2\x97g\xd8" +
+	"\xe7\xb8\xe0B\xd3\x00U\xe4\x09\x15\xe9\xb9\x85\x91\x18N" +
+	"\xf6\xec\xb3]8\xe6r.)'\xf6hljI\x15" +
+	"\x13\x17\x0c\x97a\x17\xc3\x91\xe2#\xfe\xc0\xb8\xa6\xc6\x15" +
+	"-\x1cEPK\x1c%D\xfeI\xd5\xc7\xa2a=\xa6" +
+	"\x11cHmV'\xae<\x1cJTzIHV\x9a" +
+	"\xfcR|\x06!rF0\xa9ijT?\x83\x1c\x99" +
+	"\xc9\xd38\x82;o[\x13\xd6\x8b\x9dL\xb8\xd3\x9c\xc2" +
+	"\xda\x88X_.C\xe0\xd7\x12\xb1\xf7\xa8\xd1a!~" +
+	"\x86\x8c\x88\xbc\x8a\x04\xf2'\x8a\xd3\xa3\x8d\xb8T\xcf\xf9" +
+	"E6\xe6y%d;;\xd9\xc5\x86X\xd0\x139\x08" +
+	"\x16\xb5\xd9\xbb\x86,\xa8\xdc\xb3\x8fE\xfe\x7f\xf4p\x07" +
+	"m)7YZ\x9d\xac\x12\x0dg\xabH\xe6\xe5\x94\x95" +
+	"H\x029\xc3\xd1Pl\x0ay\xad\xc4\xe8mA\xd5\xbf" +
+	"\xd8A\xd5/\x10\x92V\x19'\x0f\x17\x0a\xfa?\x0b\x90" +
+	"\xae\xd7\xb8\xfe/\x18|\xf2\xa6\x84Cz\x1dx\x90\x04" +
+	"\x1e\x04\xfe:5\\[\xa7\xb3\x7f\x9e\x93\x18c\xf3c" +
+	"*\x83\xb1\xb8\x9an+\xaap\xd0\x7f\xe6!\x14\xb8N" +
+	"\x86\xc0`\xbaE\xf4\xb76\x8fwHUB\x91pT" +
+	"\x85\xaahx\xea\x8dJ4\x86P\x0b\xcfJn\x8e\xd9" +
+	"\x9c\xe2\xd5jU\xdd\xf4\xcad|\xb3,\xdc\xb1\x9c\x02" +
+	"\x8al\xb9B\xe2\xcd\x12\xd6\xb5\x8c\xaf\xab\xc5\x09\xfb\x90" +
+	"\xc5\xee-C`\xa0\x94q\xa8\xfaY\xd8\x99\xb34\x8e" +
+	"Yh\xcbik\xe2:\xd3\xc0r,Z\xd9I\x02\x01" +
+	"\xa4\x04wu\xcd\xe6|\x04wuUp\xa8C\xdc\xd5" +
+	"5\x81\xc3\xf4\xd2\x7fY\x00\xb0\xb8\xabk\x0b\x87x\xc1" +
+	"\xdd]\xd5\x1c+\x19wwM\xe3 u\xb8\xbb\xab\x82" +
+	"\xe3\x06\xd1\xbfY\xb0\xb8\xb8\xbb\xab\x90Ce\xd0\xd1-" +
+	"\xc8\x03\xfa/\x0b-\x0dwu\xbd\xc6\xb3\xa1qw\xd7" +
+	"N\x0e2\x87{\xb8\xf6pK%\xee\xe7\xd28\x1c6" +
+	"\xee\xe7\x9a\xc6\xc1\x01q?\xd7<\xee\xb7\xc5\x03\\\x8b" +
+	"8h1\x1e\xe4Z\xc3q5p\x91k#O\x97\xc3" +
+	"%\xae5\x1c9\x04\x97\xba\x0ay\xfe\x1f.qm\xe4" +
+	"0\xaf\xb8\xd45\x9b#\xda\xe2R\xd7R\x8e\xc3\x8bG" +
+	"\xbbVr\xb8+\x1cpM\xe0\xa0\x1c8\xe0\xaa\xe6\xd9" +
+	"\x028\xe0Z\xc4a/p\x95k\x1a\xc73\xc2U\xae" +
+	"\xa5\x1c\xc6\x15\x8fuM`y0x\xac\xab\x9a{\xe2" +
+	"\xf0X\xd7\x1e^\xb6\x06+\xae\x0fx\xde\x1d\x0e\xbb4" +
+	"\x1e\xa6\x82\xc3\xae\x9d\\\x11\xc3\x93\\{\xb8\xa7\x0b7" +
+	"\xb8\xd6pc,\x9e\xee\xda\xc8+-\xe1Y\xaeE<" +
+	"\xf0\x1a\xcfq-\xe5\xfa<\x9e\xebZ\xcaAC\xf0\x02" +
+	"\xd7J^\x01\x08/ti\xbcV\x11^\xe8\xda\xc8\x1f" +
+	"\x14\xbc\xd8\xb5\x85'u\xe2F\xd74\x8e\xd3\x89\x1b]" +
+	"e\x1c\x04\x0a7\xbajx\x89(\xdc\xe8\x9a\xc0\x01\xb9" +
+	"q\xa3\xab\x82\x172\xc1\x8d\xae\xd9\xbcH\x0a\xa5\xb4\"" +
+	"\xdaq\xa3k#\x8fX\xc7\xcb]\xc5\x1c\xec\x1a7\xba" +
+	"\x96\xf2@Y\xbc\xdc\xb5\x92\x9b\x17q\x93\xab\x9a\xc7H" +
+	"\xe0&\xd7F\xee\x8a\xc1O\xba\xb6p\x18T\xbc\xda\xa5" +
+	"\xf1\x826x\xb5k\x0d\x8f\x90\xc6k]\x1by\x8d\x0f" +
+	"\xdc\xec:\xc0\x1d\xd0x\xb3\xeb\x08\x8bX\xc4\xdb\\\x1b" +
+	"y^\x17\xde\xee\x9a\xc6s\xf8\xf0v\xd7\x1a\x0ej\x82" +
+	"w\xb86\xf2\x84_\xbc\xcb\xb5\x86C`\xe3\xdd\xae\x8d" +
+	"\x1cG\x09\xefu\xd50\xe87\xbc\xd7\xb5\x94;P\xf0" +
+	"\xfb\xae\x95<\x84\x14\xefs\xcd\xe3\xc8\xc7\xf8\x13\xd7\"" +
+	"^\xdb\x03\x1fr\xcd\xe3\xe9\xe1\xf8\xb0k\x11/B\x82" +
+	"\x8f\xba\xa6q1\x0c\x1fu\xcd\xe6\x08\xf8\xf8\xa8\xab\x8c" +
+	"\xcb\xf8\x94\xd2\x02\xf1\xa1\x94VU\x1d|\xd45\x8fc" +
+	"\xec\xe1\xe3\xaeE\x1c\xbc\x17\x9fp-\xe2@\xa3\xf8\xa4" +
+	"\xeb\x03^_\x0c\x83\xfb\x00\xcf\xea\xc7\xed\xdc\x1b\x19\x0e" +
+	"\x1a\xee\xe0~\x8d\x03\x13`\x9f{'\xf7\xde\xe1.\xee" +
+	"5\x9c\xbb\xe2\xcb\xdc\x1b9\x9c*\xee\xea\xde\xc8K\x09" +
+	"\xe0\xee\xee-,'\x1f_\xed~\x8d\xe7\x1a\xe2\x1e\xee" +
+	"\x9d\xbc$\x13\xee\xe7^\xca\xf1?\xf0\x00\xf7\"^0" +
+	"\x0d\x0fr\xaf\xe4u\x13p\x91\xbb\x80\x87\x18\xe1A\xee" +
+	"y\x1cF\x07\x17\xb9\x17q\x19\x13\x97\xb8\xe7q,%" +
+	"\\\xea^\xc4C\x84\xf0h\xf7\x1e\x1e\x05\x80\xab\xdc\x1f" +
+	"\xf0\xaa\x10x\x9c{\x0dG\x92\xc6\x8a{%G\xc6\xc2" +
+	"\xaa\xfb\x00\x8f\xd1\xc3\xf5\xee#\xbcx\x19N\xba\xbf\xe6" +
+	"\xd9\xf1}\xa7\xbb%\x01\x19\x09\xcfq\xd7\xf0\x8aI}" +
+	"\xe7\xb8\xdb\x0bX\xf7x\xa1{%\x07\xe5\xc5\x8b\xddk" +
+	"8x1nto\xe45\xc6\xf0r\xf7J\x8e\xc6\x86" +
+	"\x9b\xdc\x15\x1c\xdf\x067\xb9\xd7p9\x00?\xe9\x9e\xc7" +
+	"!W\xf1j\xf7\"^\xad\x0d\xafu\xaf\xe4\x05\x01p" +
+	"\xb3\xbb\x82'c\xe2f\xf7\x1a\x86\xe8\x887\xb9Wr" +
+	"\xac\x1a\xbc\xd9\xbd\x86\x9b\xf1\xf0V\xf74\x8e\xee\x87\xb7" +
+	"\xbag\xf3@s\xbc\xd5=/u\xb3\xaa\xd1\x18D\x89" +
+	"\xbd\xc9%D\x1c/\x8d\x8e\x87Xj\x8c\xa6\x10e:" +
+	"\x8a\xbc\xba:UO1q\x0ey\x89@\x9724\xd3" +
+	"!1`\"\x89\x19\xdc\x9c\xaa\x0cjD\xb6\x1a\x8a\xe4" +
+	"\xb0\x96b\xfa+\xf2\x1b\x1aljXh\xb4B\xa2\xf5" +
+	"\x10\xd4\xa6*\x0c\xf5\xf4&\xe47<\xef\xfe\xd2XU" +
+	"B\xd5R\xd4\x16\x16\x9e\xac\"\xd0R,\x9f\x05\x01\xeb" +
+	"lH\xcc\x9d.\x05\x95\xa4\x83\x92\x98sE(\xc5\xfe" +
+	"$\xb5t2\xa5\x98%\x1c\x19\x92;\xff\xb7\xa9e\xa6" +
+	"X\x10\x0c\xd4\xf2\x0e\xc56\xd6\x11\x93\xe1\x81\x09\xf1^" +
+	"c%\xd2\x9a\xcd\xc8\xf3T\x95\x99\xf0\x0e4\xe3\x9d\x91" +
+	"\xfb\x8dH\xb3\x16\x7fe\xbfb\x81h2\x8dD\x8bE" +
+	"\x11\x15ai(F\x82%y\xa4X\x1bDu\x9e[" +
+	"\x97b\xf1\xcb\xc8Kd^\xe3\x9f%\x93U$G\xcd" +
+	"_\x04\x921\xd0\x15\xe3#AOQ\x09yL\x9d\x86" +
+	"\xfc\xd4\x15\x18\xb2\x13\x91\xaf\x96\x13j\x8a\xc9\xd1f\xaf" +
+	"\xf4\x9f\xacW\x96`/\x89\x19\xf6f\xef\x8e\x7fc\x9d" +
+	"2\xfb+\xca\xa3\x7fI\xb1pY\xc9\x16/kl\x85" +
+	"\xd3\xdf\xd8\x96\x94\x98\x0e[`\xe7\xc1\xd8\x92\xf4f\xb6" +
+	"\xb8\x0c>\x07\xa2\xba5O[\x1b\x9b_\xb9\xe9\"\x00" +
+	"E\x0bY\xabnod\xab\xce\xe0&P\x1e\x05\x9cH" +
+	"\xb1\x13\x08\x0c\x19\xc2<v-\xda\xd9\xf1c\x7f@~" +
+	"\xe3/\xa9!\xf1$\xfd\x0f\x84Pj4\xb5VT\xea" +
+	"\xc8C\xfe\xc2\xe0\x8d\x105\xd6\xa4\xa8\xddFWt\x04" +
+	"\x09\xeb\x06I\x9aaIA,\xd3\xce 5\xffU\x0a" +
+	"f\xb6\x9cJ\xf3N\x133J\xe3\xc6\x98L\xc5\x85\x98" +
+	"\xaeX\x1flod\x1fLO@UBAr\xadJ" +
+	"w\x99\xaf4\xff\xda\x16\xed-\xbe6\x8f\xf0\x9fX\x8a" +
+	"\x99\x12\xd2v0\xbd\xd9\xdaA3^N\xb2e\\\x98" +
+	"!\x10\xad\xfd\xd5\x0cm\x13\xb6\xc0\x0c\xfe\xcd\xa3\xda\xa1" +
+	"\xb8\x03\xf4\x0f\xa9J\x13\xa4\x00(J\x01\x9fTZ3" +
+	"\x9fTXw\xf8\x86\xf4fF>DS\x12u\x15j" +
+	"\x1cyb\x9a\xc1>\xc8\xb4!\x14\xab\xb5V\xde\xde\xc8" +
+	"V~\x84\x99V\x03:\xbf\x1db\x1b\xbb\x15,R\xdf" +
+	"\xc6\xd0\x846\x8b\xceL\x11A\x8c\xa9\xb3\x06\xeb\x9d\xa0" +
+	"\xde]]k@(\xc5\xd2\x8f,b\xd6 3b[" +
+	"\xf6\xad1*k\x02\x8e\x90\x92bAT\x10\xd5o\xa2" +
+	"/\x02$\xac6)\xaa\xb7Hik\xe5\x8flQ\x84" +
+	"\xee\x8c\xa0\xac<\x1a\x95\x95b>Zw\xfak\xe1\xe8" +
+	"\xbc%\xf37X\x8d\xc3F\xa67\xb3\x8dda\x0d\xc0" +
+	":2\x0f\x7f\x8bvv\xf8Y\xd6^\x8b9\xb5L\xe7" +
+	"\xb3\xe6\xc4\xd0+\x80-,Y\x12\xb31\x04\xfcH\xa7" +
+	"\x11\x9a\xcb\x93G\xcd\x82\xe4<\xd1\xff\x00ao\xc46" +
+	"\xb67\xc3\x1d\xe8\x86;\xd0\xb1\x8c+IL\xb92\x19" +
+	"\xaa\xe3\xdf\x18ce\x01\\\xc0\"\xb8\xbc$\x84\xcb\xde" +
+	"L\xc2{=\xe4U`\xad\x92-\xe8\x97m<C\xdb" +
+	"\x92\xd2\xe0\xb6\xccMk\xed\xcf\xf6\xe7yH\xcc\xd52" +
+	"Y\xdd\xf8\xf2!\xb5Z,\x19\xbfY\xf1D\x92\x9cZ" +
+	"vLm7v\x8a\x99\xb0\x81\xda\xb0\xad\xf3\xa9D\x83" +
+	"j\xa4B\x05\xda\xab5\xbd\xf4f6-\x06\xcb\x04\x14" +
+	"\x97\x8916\x86\xd4\x84\xa0\x05\x05cn\xc3MCU" +
+	"\xda\xd6Yml\xeb\x18\xc6\x1a\x18\x8ehs\x00\x96\xd6" +
+	"\x8e \x96N\xc1\xb9\xa7\x81\xb1h\xffaZk:\xab" +
+	"5\x1f5:=\xfa\x1f2\x9b\x9d\xcd\x17N\x170\xf0" +
+	"\x1c\x0d\xe2c\xa5(\x80!\x90\xe3\x85\xeeb$\xe19" +
+	"n\x0fp\xccW`U%p\x83{6\x92\xf0$\xb7" +
+	"\x07$\xabJ20\xbcR\xac\xba\x17!\x09+n\x0f" +
+	"\xc8V\x0d7`\x15Op\x15\xfd\xedh\xb7\x07\\\x16" +
+	"\xec7\xb0\xba\x83\xb8\xc8\xbd\x14Ix\x90\xdb\x03n\xab" +
+	"\xc4\x090\xb0x\xdc\xc7\xbd\x05I\xb8\x87\xdb\x03m\xac" +
+	"b\xbc\xc0\x8a\xfb\xe2\xaen\x0dI\xb8\x8b\xdb\x03\x1e\xab" +
+	"B\x060<Z\xdc\xc1]\x83$\xecv{\xa0\xadU" +
+	"\xdc\x15\x180>>\xe9\xaaF\x12>\xee\xf2@;\xab" +
+	"D 0df|\xc8Ef\xf5\x89\xcb\x03\xed\xad\xba" +
+	"\x91\xf0\xe3\xd6\x9f!R\xa1\x0c\xefu\x91\xef\xdd\xed\xf2" +
+	"\xc0yVq@`\xb5\xe9\xf0v\x17\x99\xd5V\x97\x07" +
+	"\xce\xb70\xbb\x81\xd5W\xc5\xcdt\xdc\xd5.\x0ft\xb0" +
+	"\xca\xad\x01\xab\xc6\x82\x97\xbb\xd6 \x097\xba<p\x81" +
+	"\x05a\x0f\xac\x0e\x17^\xe0\x9aF\xf6\xc8\xe5\x01\xafU" +
+	"~\x02X\x8dS\xdc\xe0\"\xdf;\xc9\xe5\x81\x8e\xacF" +
+	"$\xaf\x0d\x88U\xfa\xdbq.\x0f\xf8,\xb0~`e" +
+	"Zq\x80\xce\xb9\xd4\xe5\x81\x0b-\xcce(\xeb\x8dh" +
+	"\x81Fb\x02C\x12\x1e\xe0\xf2\x00\xb6\xca\x0e\x03\xc3g" +
+	"\xc5=\xe8o\xbb\xbb<\xd0\xc9\xaa\xf7\x0c\xach\x12\xee" +
+	"B\xff\xeasy\xa0\xb3\x05\x88\x0a\xac\xc6 v\xd39" +
+	"\x9f\x96=\xf0\x13\xabD*0\xd0{|\\\xae@\x12" +
+	">,{\xe0\xa7\x16\xa2<\xb0r\xd7x\x9fL\xf6\xe8" +
+	"}\xd9\x03\x17Y\x95B\x80\x95H\xc3\xbb\xe4yH\xc2" +
+	";d\x0ft\xb1\x8a\xbb\x01\xc3\xb1\xc6[\xe9_7\xcb" +
+	"\x1e\xb8\xd8\xaa\xbc\x03\xac\x02\x01^K\xc7}R\xf6\xc0" +
+	"%Va\x1b`0\xc6\xb8Q^\x89$\xbcX\xf6\xc0" +
+	"\xa5\x162:\xb0\x9a\xdfx.\xedy\x8e\xec\x81\xcb\xac" +
+	"\xd2\x8d\xc0*\x88\xe1\x06\x99\xac\xc6$\xd9\x03?\xb3@" +
+	"\xba\x81\xd5\xaf\xc1\xaaL\xf7H\xf6@\x9eU\xf6\x1bX" +
+	"ef\x1c\xa0=\x8f\x96=p\xb9U0\x06\x18::" +
+	".\x92\xc9J\x0e\x90=\xd0\xd5\xaa1\x0a\x0c\xf5\x16\xf7" +
+	"\xa0_\xd4]\xf6@7\xabH\x1c\xb0\xea)\xb8\x0b\xfd" +
+	"\xabO\xf6\xc0\xcf\xad\xf2\xa3\xc0\x0akb7]g\x90" +
+	"=p\x85U\x91\x15X\x05\x0e|B\xdaH\xee\x91\xe4" +
+	"\x81\xeeV\xedp`\xf5\x01\xf0!i'\x92\xf0!\xc9" +
+	"\x03\xbf\xb0\x0a\xcb\x02\xab\xfd\x8b\xdf\x97\xc8\x9cwK\x1e" +
+	"\xb8\xd2\xc24\x07\x86\xa4\x8d\xb7K\xf4\x1eI\x1e\xb8\xca" +
+	"*~\x02\xacn\x05n\x96&\x90{$y\xe0j\xab" +
+	"\xdc\x1b\xb0\xa2\x0ex\xb9D\xbeh\xb1\xe4\x81|\xab\xea" +
+	"\x01\xb0\x12\xd6x.\xfd\xed,\xc9\x03\xd7X\x98\xca\xc0" +
+	"\xaa\xdf\xe3$\xfdk\xbd\xe4\x81k\xad\xfa'\xc0\xca\x01" +
+	"cE*C\x12\x1e+yfL6\x14\xfe\xc1\x90\x0a" +
+	"\xa6)\xf0h\xb0\xe9\xf6i\x88\x06\x05\xe9a0\xa4X" +
+	"\xc4\xadH\xa9Yj\xb0I*\xab\x844aSy\x87" +
+	"\xc4\xa2~\xe3'\x83Y\xacPe\x03\xca\xa3\x8a\xed`" +
+	"0\x12SG\xc7\x92\xc8\x13\xd5\xad\x7f\x07\x921$\xeb" +
+	"\xca`H\xb1\x1c\x0e`\xea\x9d\x1c%T,J\xc7j" +
+	"\x86\xa89s2\x13\x94\xc7\xc6c\xf0\x1bD\x1f\x1d\x0c" +
+	"\xa9\xb8\xa0\xa3\xd1){M\xba`\x9a\x965\x98E\x1e" +
+	"\x04\x92\xc8\x13\xb3fB;\xf7\x1bJ\x0b\xf9PS\x0d" +
+	"\x11\xc63U\x0c`*\x86W5>\x8b\x01\x9b\xa1\xbc" +
+	"\xa4\x11N\x9eb\xd8\x87\xfc\xc7,T\x1cyB\xb1\xda" +
+	"\xc1\x90by\xf2\x08\xc8\xdc5KD\xb7-6s+" +
+	"\x03\x13\x0e\x11\xa2]\xa9\x13[\xb6\x8e7\xe5m\x04d" +
+	"JA.\x1a\x1b=z\xa2f\x8f\x86\x04l\xff-\xf3" +
+	"\xef\xf0\xe92\x99\x14\x09\xdbk\x0a\xaa\xf6\x9f*\xa6\xe8" +
+	"\x89<\xb1\xda\x84\xf1\x9dF\xf08\x9dF\xad\xed_," +
+	"a\x08\x98x(\x8fo\xa0\xe7\xc6\x10\xd7\x80\x89ky" +
+	"T^\xb3N\xd4\x90\x98\x94.z\xd1\xa1Y\xd27\xf2" +
+	"\xa8\xc1\x89\xe4\x9bM\xb9\xca\xb4\xf6\x18\xc3Sy\x09y" +
+	"u\x1a\xdf\x9fb.=c>\x0c5\x8d\xea\xd4\xea`" +
+	"+\x88\x8470\xac\x07D\x06\x14\x1d\xbf\x99\xc4VP" +
+	"c\x17h\x99\x04\xd1w\x13\x82\xe8\x93<$\xcfS\xcb" +
+	"\xff;+\xd7e9\x8fs\x14q\xef\x9cQ\x00\x9c@" +
+	"\x00,\x8f\xf3\x9cj1\xdc\x10\x9c\x92vL\x98\x98\x85" +
+	"\xf9\xad\xa0\x00\xc44\xeevN\xc4\x82\x13U\xbd\\A" +
+	"\xb2\x00\x0d0\x9e\x9aO\xc0\xcb\x9d.f\xf4P]," +
+	"\xa1\xe7\x86q\xd8z\xaa\xef\x99\xd0\xdar\xce\xd1\xb5Y" +
+	"\xebx\x18\xcbY\xc3V:\x82=\x9f\x03|Vfl" +
+	"\xb5\xe9\xa3\x06Z\xc4\x086\x10>\x0c\x17#T\xf9)" +
+	"\x09\xcc;\x06\xfc\xc4\xe2\xa34\xf0\xef\x0b\xd2\xfe\x1dX" +
+	"!\xdd\xf8\x04\x8d\xe3\xfb\x06d\xa8\x90x\xac\x19>\x0d" +
+	"\x15\x08U\xfe@\xc3\x10%\x1en\x86;K\x13\x10\xaa" +
+	"\xec$\xc9P\xd9[\xe2\x11g\xb8\x87Dz\xbf\x96\xb4" +
+	"\x8f \xedm\xc0\x087,\x916\"T9\x82\xb4\x8f" +
+	"!\xed\x1e\xb7\x11n\x18\x90H\xff\xe5\xa4\xfd\xd7\xa4\xbd" +
+	"m\x1b#\xdcp\xac\xa4!Ty+i\xd7I{;" +
+	"\x8f\x11n8\x89\x8e\x1b'\xedw\x92\xf6\xf6m;A" +
+	"{\x84p\x83T\x88P\xa5N\xdag\x92\xf6\xf3\xdau" +
+	"\x82\xf3H\x8d-i%B\x953I\xfb\xfd\xa4\xfd\xfc" +
+	"\xf6\x9d\xe0|\x84\xf0\\\xa9\x00\xa1\xca\xbbI\xfbC\xa4" +
+	"\xbd\xc3y\x9d\xa0\x03\xa9\xb7,MC\xa8\xf2~\xd2\xfe" +
+	"(i\xbf\x00:\xc1\x05\x08\xe1\xc5t\xdcGH\xfb\x0a" +
+	"\xd2\xee=\xbf\x13xII\x06\xfa\xbd\x7f \xed/\x92" +
+	"\xf6\x8e\x1d:AG\x84\xf0&\x89,\xe7s\xa4\xfdU" +
+	"\xd2\xee\xbb\xa0\x13\xf8H\x1d:\x89\x84W\xbeL\xda\xdf" +
+	"\x93\xec'\xa0\x86>A\xf6\x0b\x96\xd2U#\xc0Z\x8c" +
+	"K$q\x02\xe5\x8a^\x87\xa0\x05\xe8e,VO`" +
+	"\"\xca\x91W\xd1\xebZ\xfc5\xc2\x8c\xf76\xd4x\xa1" +
+	"\x84\x04\xa5\"\xd1\x99\xe4\x08C,:4\xa9)z8" +
+	"/\x16\xad\x14\xf0\x06#\xdc\xec\x0f\x1d\xc5\xd2\x014F" +
+	"@\x09\x85\xc2\xd4\x04\x9e\xa7D\x86qT\xcev\xe6\x14" +
+	"t\x9b\xe3\x02:\xf2(\x00\xe3\xf7\xfe0\xf53@G" +
+	"\xee\xbc7;N\x18v\x85Q\x10N\xe8jT\xd5\xca" +
+	"=B\x80b^\x828>\xa0#\x0f#0\x7f\xa5\xa5" +
+	"\xf91\xa0#\x8f\x11\xb0\x93\x0cE^\xb5&\xc9\x91\xb0" +
+	"\xc63o\x88\\+,\x96P\xbb\x90~O\xdc\x0c\xff" +
+	"C\x08\x81O,\xe3L\xa1_\x12\xcc\xdf\xe2\x09\x8b+" +
+	"n\xc53\xa4\xe1\xd2f\x0d.\xe7\xc0\xab\xb2\xe6wy" +
+	"\xe7\x0c\xf7\x86\xc7\x1c\xa4!\xdfd\xca?yvbk" +
+	"\x00\xde\x9a\x00\xf9\x1b!\xe2I\xa5\x1aAyjP\x8f" +
+	"i|y-Wg\x0e\xcb{\x8b\x05\xbe(\xb2\xef," +
+	"0xx\xb4?{~WH\x00f=\x82\xe5$\xf4" +
+	"\xf6\x0f2\x04\x9e\x12\"w\x9f$\xeb\xbaB\x86\xc03" +
+	"\xff\x0d\xdc\x89\xa5~\xc9!\xe1 Y\xa1\x1b\xe6\x97R" +
+	"X\xbd\xc9J\x04y\x84\x0b+l\x90\x15\xce\x91\xc3\x06" +
+	"\xd9A\xc1\xb3\x8c$\xb2b\x03r\x08\xe4c\xc6r=" +
+	"\xe7tw\x1bN\x84\x81\xb2\x94\x88A\x94F\xf2\xd1\xbd" +
+	"\xba\xba\x9a\xaeH\xf7j\x1a\xab\xdbu\x02\x05=\xb9L" +
+	"C(\x15\x8eNV\"\xe1\xd0H$\xab\x0d\xa9hL" +
+	"/\x8aDbS(\x0a\x8f\xf9\x97\x9b\x91\x97\xa4K\xa6" +
+	"\x88\xc0s\xa3RO\x9ccq%\x98\x1d`$s\xec" +
+	"\xc6z\x8e\x0cG!$\xc0\x0e\x19`,\x812\x03v" +
+	"H\xa3\xf3\"\xd1\xb3\xe02\x10Z\xdc\xbeA\x15\x14\x8c" +
+	"e\xc0J\x0a;4`\x1e\xc7d!\xd3\x1d\x16KF" +
+	"C\x08\xa1\x94\x12!\x1aIC\x09\xca\x9b\x1aN\xe8\x09" +
+	"\xc6\xf4\x86\x11\xb5)\x92\xd4\xd4\x19&z\xa4)\x8aS" +
+	"\xcc\xa2T,\xae\x12\xfe\x1f\x83hi\x94\xc69{\x88" +
+	"\x9b\x98=I0:\x9c\xa8\xa7\x8a\x11\xca\x92\x83\x09\xa0" +
+	"\x10~\xc3@L>\xf9\"\xeb 5\x92K\xf6\x88q" +
+	"u|D@!\x8d\xcb\xbb\xf1\\%\x9f$\x1b\x97\xac" +
+	"\xa9X\xb8P\xb2\xcb\xb8eO\xe6\xf3\x0be\xdd\xb2\xd5" +
+	"K\x11\x0a<#C\xe0E\x09\xc0m\x84\xc7o\xca7" +
+	"\xc3\xf0\xdf6n\x9e\x03\x16\xec\x8cDC\"\xa8D\"" +
+	", \xcfK\xb4&\xf6G\xa2\x86\xe8Z2\xa8\x03\xf9" +
+	"\x04\xa2\xff\xc8\xaa\xc6z\xf1*Zm\x8b\xe7/gd" +
+	"\xab\xdcc!\xc5\x90J!\xeb\xba\xec\xdda\x9bG6" +
+	"wz\x10XIl\xa1\xc8\xcd\xca\x15\x1dz}X\xf4" +
+	"\xf5c\x88\x95\xc3?s\x8d\x9b\xcc\xd0\xf7y\xbd\x1f\xcb" +
+	"\x80\x9c\x16N[\xedT\xef\xa5\xe2\x0c\xf5^\x18{M" +
+	"\x12u .C\xe0N\xc9LEP\xc7\xc7\x90\xac\xa9" +
+	"\xb6\xfc\x84\xa2\xf1\x86\xec\xc2\xd8cZn\xc39\x89z" +
+	"n\xb9w9aL\xe4\x0e\x0ao\xf1\xe7\xb3\x85\xc8\xce" +
+	"\x09_\xa9R\xc4\x8b\x17\x02\xa7sH\x864\xb5)\x84" +
+	"\xfe\x1b\x8b0\x0f\xca\xf2j\xce\x0dX\xaa\xea\x93\xe4\xc9" +
+	"}B\x86\xc0\x06!\x83f-\xe1%O\xc9\x10xN" +
+	"\xe0\x10\xcd\xdd8\x87\xb02h6u\xe3\x99:\xa2\xb0" +
+	"\xee\xa4\x0d\x93\xaf\x8b\xaa\xe9\x99,\xadY\x05(\x97a" +
+	"a\xbeYa\xfaXx\x167\xc5u\x9a\xad\x9c\x96r" +
+	"Q\xe1\x94\x1f]\xc6\xf3\xa3\xd9\xd2TM\x13\xd2\xa3\x1d" +
+	"*\xd1\xa4H\xf0\x01U4\x10\xb2\xda\xf4`\xbc$\xa1" +
+	"+5\xc8\x1f\x09'\xea8\x92l\xb6BlK\x1c\x84" +
+	"3\x89\x9f\x0c\xa5i\xa8m'\xfcT\x0cL\x9cY\xfa" +
+	"\xcb\x05\xbf\x80\xde\"9\xd3\\\x10+\x188\x07!\x87" +
+	"\xd9\x00\xb3\x88X\xb7b\x17s\xc0\xc2I\x88\x99\x0d-" +
+	"pH2\x00\"\xb1\xc2\x99sI\x9c2\xad}\xcc\x89" +
+	"\xea\x9c\x88\"\x82\xec\x09BI\x8b\xf3\xd66\xd7\xe4\xe1" +
+	"\xec\x00\x9b\xac\x18\xe3\x1c>\xb8DD\xa8\x10\xf3H\xce" +
+	"\xa4W\x14\x9bz\xc5\xa3\xfc\xd2..\x13\x18\x1f\xe3g" +
+	"\xcb5'\xbd\xa2\xda\xe4|\xaf\xda\xf552]%\x1a" +
+	"J7\x0e8[\x1a\x9cSM23$d\x95&\xd4" +
+	"\xb2\xfc\x9cS!\x08\xe7\xc3h\xc5\xe6\x9e\x0d\x10\"\x91" +
+	"\xbf[\x94\xfbr\xc2d\xef\xe6\x84?Vhf\xb4\x85" +
+	"lk-J\x92\x193\xaa\xb3(Z\xc6OV+\x17" +
+	"\xc9\x89\xcbg\x95\x85\xdf\xb2LJ\xe6\xd9\\VP\xf3" +
+	"\xd9\xf3\x8c3~\xa8S\x92R6\x1e\x00\x1a\xce\xe71" +
+	"\x93+\xcf\x84=R\xe1\x84=R#<\xae\x14\x9f\xe5" +
+	"F%\x8a\xe4\x98\x08\xda\xa2j4UJ\xc8\x95M4" +
+	"$t\xb5\xfeF\x05y\xa2\xb1DN)\x99,2\x98" +
+	"\x98\xd7\xd23\x90j\x9c2\x90\xaa\x85\x0c$j\x9d\x8b" +
+	"+\x1a\xf2\xa8B\x0dB\xda\x9a\xd0\x89\xac\xa3\xe6d_" +
+	"7\x1d\x9b\x0c\x0e(\xab\xdf*\xbc\x0eP\xe6\x0c\xc1\x0a" +
+	"\x8b\xcf\xa54\x007\x8ee>\xa0\x95\x89\x93K\x86\xa4" +
+	"\xdd\x9a\x9f\xa5\xd0ae.\xe50ryK4\xfa\xec" +
+	"\x8b\xe6eT\xe2\xc3\xb4\xa5\xd6r\x03G[zK|" +
+	"\x86\x81\xa3\xdd\x04\x84f\xd4\x12\xabn8H=\xbaj" +
+	"\xb42\x86\xbcD\xc4\xce\xa5h\x94\xe0D\xceDk(" +
+	"3\x1f\xcf\x17\xf9+\xbb\xa9\x90\x8b\xfd\x96\xefls\x99" +
+	"\x90\x8c\xcf\x00n\xb6U\x08\xc9\xf8n\xc9\xd0\x1av\x10" +
+	"\xd5\xee\xcf2\x04\xde\xb5\xafY$VK\xe4i\xb1J" +
+	"\x99\xf9\xfc\x9a\xe8\xb86\x1b\x7f\x06\xb9\x80\xe7DYu" +
+	",\xfd\xca\xcd\xc2\xce\xc9\xae\xd6\xf2\xa9\xc5\\eg\xcb" +
+	"\x17\x9e h\xecLH\x99T\xc3\xb5sQ\x1e\x89Y" +
+	"\x96y+\xe3\x86A.\xa9\xcad\xb5\"\x19E^[" +
+	"\x8d\x8a\xb0\x09\xe3\x87<\xceE5\xcf*\xfd;c\xe8" +
+	"\x00+\x01\xe9\xac2\xbf\xb3C1\xb1\x92q\xce\x0e5" +
+	"\xf4\xff\x03H\xe09 \xcd\xf07\xff\x0c\x02Z\xa1(" +
+	"\xa0]n\x0ah\xdd\xf8\x97\x88\xdac\"\\KL\x99" +
+	"L''\x96\xbe\\\x14Z\xb1@F\xc6\x8f\x86\x95\x00" +
+	"\x98\x1b(QZ\xa0|+\x85j\x7f\xcd\x17fl\xb5" +
+	"X\x96\xc7\x94\\\x95B~\xa7\xfd\xd4\xe4#\x88\xa8b" +
+	"\x897\"\xa2F\xd5\xa9\xfa\x90\xa4\x96@r\xcc\xb2\x9f" +
+	"\xf9\xeb\xc3\x09\x01\x95\xecl\xc1\xfb3+\xc9&V\x99" +
+	"\xcf\xe5\xf6YET3\x87\x90\xb2R\xc8r@\x10\xa2" +
+	"\xef\x9f\x97<\x80\x14\x1df\xe2?\xebv\xf6\x9e\xe2\x7f" +
+	"\x87b\xb2\x8e\x0cGC\xad`\x07Z\xb6o\xb5PD" +
+	"\x14hc2\xd9|\x8e(\xc0,\xa0\xf5\xf9\x9c\xf1z" +
+	"\x13\x91\x98e\x85\xf2\xeb\x8aV\xabZe\x1b\xbc\x13\xc3" +
+	"\x14\xf4\xc5\x9a\x89\x19\xb6\x11U\xea\xd5\x9c\xac\x90\x8e5" +
+	"5\x04\x1bonuZ2f\xc6V\x1e\xdd\xd9]%" +
+	"G\xc6h\x03\xa1\x96\x9c\x94@\x87*-\xfe`RK" +
+	"\xf0K\xe2\xa9W\xa6Z^\x03\xd3\xd52Z\x14\xfc\xb3" +
+	"5'\xa4\xa5\xd4\x09<\xe0\x0ak\xe6G\xc9C\xf2\x85" +
+	"\x0c\x81\xef8\x0f8Q(\xd4\xd4c<\xe0$i\xfc" +
+	"F\x86\x0a\x0a4u\xb9q\x94N\x93_\xff Ce" +
+	"[\xd2\xea\xeaj\xc4}\xb8a\xb6\x88b\xe5sw3" +
+	"\xe2>:\xd0v\x0eW\xd5\xe6\xe7F\xdcGg(\xb4" +
+	"\xc1U\x99e\xfcp\x17\xa8\xb6\xc1U\xb5\x95\x8c\xb8\x8f" +
+	"\xae@\xe22.%\xedW\x813&\x82?\xa1\x87b" +
+	"I\x9da\x00\xfa\x0dx9\xf6O\xba\xba\xa1\x9b\x92\xba" +
+	"h\xc70~1F\x83d4H@\xd1P\x1a6\x9d" +
+	"\xc3_\xfcA%h3o\x92\x7f\x16\xd5\xaaH\x1e\x9d" +
+	"\xb9\xab\xe0l+q\xb6\xa8r\x94{\x01\x8d,\x1d\xb6" +
+	"VRn.e\xae\xc4\xa2\xc1\xad\xda\xf7\xaa\x05W\xbe" +
+	"f\xbaf\x91\x1c\x15^\x1f\x0b\xec \x07{\x92\xad(" +
+	"\xbd\xe8k\xca\x06m3\x1c\x1d\x1f\x83\x8e<\xd1\xd7X" +
+	"\x8bs\xb2\xeb,\xbf\xd6\x90}{\x1ai+\xa3\x95\xa8" +
+	"R\xabj\"\x88\x94\xa1\x1fu.6\x8a\x13\x96!4" +
+	"#\xa4\x8eW\x92\x11}\x86a*\x08\xa5\x82\xf4\xa7\xe3" +
+	"i\xe2\xe1Y\xecR\xe6\x05\x8e\x8b\x1d\xed\xf0\xd4Q\xaa" +
+	"\x8b\xf6-\x0b\xc7\"\x87\xedk\x11\xe1f\xa6\"\xa5\xb1" +
+	"\xe6\x09\x9c\x0b[\xc6\x98\x02Qd5\x19\\`\xb6\x89" +
+	"-\x15\xc9\x1e\xbf\x93\xc5\xf0@X3t\xb7\xd6\x0a\x0d" +
+	"\xe7\x8cAo@Y;\xc9\x8d\x13\x84\xfb\x195\xf3\xa9" +
+	"\x90\x97\x1c\x19\xe8\xc83\xecs5r\x98\xf5\xdc\xb2\x02" +
+	"\xfe\xb7\xe0Ar\xe0\x0b6\xb9+;\xeb\xbb\x95\xa3\x7f" +
+	"\x16\xa0\xaa\x82u\xe3Z6&\xee\x0e\x17\xdb\xe0\x11Y" +
+	"\x94d:<\xa2\xf9\xc6\xe3>\xa0\x89\xf0\x88\xa6\xdc\x85" +
+	"\x07\xc0<\x84*\x07\x92\xe6\x11 \x84I\x96\x00\x09\x1b" +
+	"\x1cJ\xda\xcb\x81\xd7\xbb\xc5\xa3i\xf7\xa3H\xfb\xad\"" +
+	"*c\x15\x0d\xb7\x1cC\xda\x7fK\xda=\xb2\xf1\\\x8e" +
+	"\xa3\xd1\x99\xbf&\xedu\"*\xa3\x0a$\\1D\xda" +
+	"\xe3\xa4\xbd\x9d\xdb\x08\x93\xac\xa7\xfdDH\xfbT\xd2\xde" +
+	"\xbe\x8d\x11&\x99\xa4\xf4:i\x9f\x09\x19x4[s" +
+	"\x00$\xc2\xf5\xc9\x88\xa2\xab0\xc6\xf2\x1aX/\xe5\x99" +
+	"B\xfcR\xb1\xa4\x1eO\xea7E\x91\x1c\xe1X\xb0\x0e" +
+	"\xe8\xb3\x8e.\x89\xff\xf7\xc8\xb3\xf6\"\x95\x19C\xf5[" +
+	"P,\xe7\xce=\x97\x9d\xb9\xdd\xc2\x18:+wdv" +
+	"\x06\x0a\x0bA\xe5\x1c\x15\x04\xe4!x9\x06'\x1a\xbc" +
+	"\x95X\x89,x\x92\x1c\xacDi v\x99\xbbI-" +
+	"\xf4\xa1\xdc\xd4T^Z&\x8b=\xb00K\xceY\xc9" +
+	"MR\xea\"\xd7\xaazB\xc8\x0f/\xb1\x98k}h" +
+	"\x87\xf8\xbfl\xfc\x0f\xd9Y\xd6-\xec\xb2\xdc\xea\xcb\x9a" +
+	"\xb54\xb3\xdb;\x0b\xff(\x871o\xb1j\x1a\xb7V" +
+	"H\xb3\x90\x9fQ\xbf\xd1\x03\xf8R\xc7\xfb\x8e\xdf\xd7\xf8" +
+	"\xf9/_@9\x94\xab\x0c\x0bRv\xe6\x96\x14\x0bF" +
+	"''&!\x84?\xd3\x0e{\x96\xc7\xbc\xa4\xc6\xb5\xe0" +
+	"T(0\x9c\x0a\xf9\x08\x19\xf6\"/a\xe1Y\xeaP" +
+	"\xce5\xd1\xb3D\x07\xb5\xf0\x8ar\xf8R\x96Xf\xb3" +
+	"MgX&\xc9\x02\xce\xcae\xdc\x96\xf5\xca2\x1e\xd7" +
+	"\x02\xc0\xcb\xe1\xf8\x8a\xb6@!N\xb1\xe7\xda\x17\xe2\xfb" +
+	"W\x0d\xde\x0bs\xfer\xec\xfaX\xec7\xcb\x848E" +
+	"\xff\xf9q\xf7\xf2_\xbf\xb2\x0d\xde\xc9\x8b~<\xeb\xeb" +
+	"\xcam\x19\x04*f\x19q\xecX\xa6\xa5\x9b\xf9\xf1W" +
+	"H\xe0\x09\x87\x12\xad)\x04\x19e\x9a\x11(\x17\xd9p" +
+	"3\x0b\xf8\xd0\x15\x02\x164{\xe2\xb6\x92\xc6\x97e\x08" +
+	"\xbc)\xd8\x9c\xb6WsO\x93O6\xad\x80\xbb\xc8\x89" +
+	"|[\x86\xc0{D\x145A\xe5\xf7V\x08X\xd0n" +
+	"3\x02v_5\xc7\x82\xf6\xb5ic\x00D\x1f\"\x8e" +
+	"\x9aOe\x08\x1c\x93 \xa5LV\xc2\x11\xa5&\x82@" +
+	"\xe5\x0e\x98h9\x81\x8d\xd6E\x03V,\xa9\xd3F$" +
+	"\xeb\xbc1\x1c\xbd)\xa8\xabF5q\x81\x906\x8a?" +
+	"\x0eG\x87\x86\x13AE\xa3\xd1\xed\x02!mE\x1e-" +
+	"\x94\x9b/\x9ca\xb6\xc44\xbdg\x85\x1c\x0f\xfe7\xc3" +
+	"\x1es\x1e\xd4p\xf5\x91y\xb3\x02\x15\x1c\x99\xd8_\xaf" +
+	"\xeau1\x9b\x17\xd4P&=Zi\xc8\xb1\x14s." +
+	"\x13\xe70\x0b\xb9\x14\x08wgZ\x8b\xdeK\x8a\xd1\x13" +
+	"\x8b\xf4\xe9\xea1\x8b+\x0f^\xfd\xa3\x0f\x0c\x08\x16E" +
+	"\xd3\xcbQ^\x8c\xb0\xd5VM\xd3\xcc\xfd\x97o\x9a\xa6" +
+	"\xef\xe4\x0b\xd6\xa0\x09\xc1L\xcc{:\xab\x86\x97\xaa\xb0" +
+	"\xf9\x84l\x81\xd2\xec\x0ei\xf6Y\x80\x97M\x91\xd5\x10" +
+	"S\xa6\xd2\x89\"\x8f\xa6'rJ\xb4\x9cb=\x97\x99" +
+	"\xf37\x0b\x92\xf2\xec\x83\xc0Z\x01\x89\xd5\x1c\xc0w\xbb" +
+	"\x09\xc5GZQ\xc8l\xd5 \xce\xae(\x0a?q\xce" +
+	"\x98\xc0<r\xa4\xd8\xa9(\x0aM\x0a\xb3\xe0[\x8du" +
+	"\xfa/.\xde\xec^&\xfb\\3v\x0f0H\xc6\\" +
+	"\xbc\xa6\xa2\x9d\xce,\xa0y_\xdb\xbe\x1d\xff\xfe\xd2\xab" +
+	"\xfb\x10\xb9.\xccr\x87\xf2\xa8\xed\xee\x8c\xd0\xe7N\xfc" +
+	"E\x13\x90\xcf\xcd\xc4\x0c\xebR\x9b\xff\xae@\x9eXL" +
+	"\xc8\xa8\xb5\x8f\x0a^>\xa9\xb4\x12\xa5YU\x1dw\xb6" +
+	"\xb2\x15;\xb8\x12\x0byt\x93ee\x1b\x97\xcf\xfd\x8b" +
+	"VT\xbde\x0e\xb4@9\x0ds`Z1 oB" +
+	"(\x08\x91s\x80Pv\x95\xdc,\x80\xcc\\\xca,\x12" +
+	"\x887\x7fC\xa5\x03\xfe~\xf5\x99\xa2\xac\x1c\xabuQ" +
+	"\x10\xfe\xf4\xc6\\3\xd7\xb8\xf8\x9f\xd5GQ\xeb\x1cM" +
+	"\xb8Os$\x91\x07\xe7s\x19\x02\xdf\xf0\x13p\xbc\x1b" +
+	"w.Y'\xe0D\x99\xe8H\x1al:\x92*l\x8e" +
+	"\xa4\"\xe6H*\xb3;\x92$\xe6H*\xb3;\x92d" +
+	"\xe6H\"\x16\xadN\xa4\xfdr\xd1\x91t\x19\x14\xb4\xe2" +
+	"H\xb2\xea\x9e\x0c\x84V\x832\x1d\xe3l\x84\xb2\xed\xdc" +
+	"\x14\xe5\xe0U2Bv\x8atQ\xba1\xab[\xdb\xaa" +
+	"\xee\x90\x0a>\x84\xf7Yp\xc3\xbc\xa2X+\xd1@Y" +
+	"\xb1E\x86\x12\xc8@\x02M\x18\xc2\xb4-\xccw\xd8\xc2" +
+	"b\xa7-\xac\x10\xb7Pr\xf4\x05\xcal\x0b+\xec[" +
+	"\xe8b[Xl+ic\x16\x1e\xc1>\xa8\xb0\xfb\x02" +
+	"\xdb0_\xa0\xbdtM[\x0f\xdb\xc2\x0a\xd16ks" +
+	"N\xcf\xd0\xa6\xda\x0bAhS[\xca\xa4\xdaT\x9aY" +
+	"g\x13?\xb5\xa9C\xb5X<N7\xd7l\x9b\xa1\xa7" +
+	"\xf5\xa5;\xf4\xa5;\xf4\xa5\xb7\xec\xebl+\xe1fg" +
+	"\x8d\xb7`\xb7\xcf\xad\xa9\x87\xc9C\xc2\x1b\x90\xef\x10g" +
+	"\x93\xef\xe4\x03\xcf\xe7\xef\x9b]\xca\x13\x19\xbc\xb7>\x16" +
+	"Rs\x92W\x82b\x1cp\xe6\xc6X\x0b\x1b8\x07\xb1" +
+	"#-\xe69s\xe3\x9f\x05\xe9|\xd6\xf5\xf9\xff\x8bz" +
+	"hi\x87\xdd\x84\xe0Dv\x99\xb7\x15\x0a*#\xbb\xcc" +
+	"\xdb\x8b\x9d\xca\x07\xe5\x0bz$K~\xdaU!\xe8\x91" +
+	"\xac|\xd0^\xf2\xf3we\x08|$9*:\x9e`" +
+	"<\x09\x1d9\x9a\xba\x99\xd7oTp\x81\x8e\x1cX\xdd" +
+	"\x94\x0dk\x0cxT\xe8\xc8A\xd6\x8d\xbfx\xe3D\xb7" +
+	"\xee\xc8\xd1\xd69\xdb\x14\xf0\x07,\xf4u\xb3\xbb\xa8\xc1" +
+	"\x0d\xa1#Gb\xcf\xa9\x8c~ZE\xa1\xec\xec>\x16" +
+	"\xc8x\x0e;\xcf\x10\x96\xb5\x9ec\x1a\xe2\xa0\x0aI\xca" +
+	"\xb4\xea\xaf\xafG>\xf5\x05w/\xa3\xc9\xc0]\xf3\x0d" +
+	"\xb7/\x9d\xa6\xa4\x99\xe2*{\x0b@\xf5NH\xc4\xa2" +
+	"\xa9\x09\xb1\xa4\x16U\"!\x84\x907\x1a\x8b\xaa\xd9\x95" +
+	"\xb6\"\x0e\xd0\xbc`\xdd\xd0\xb0\x96&\x18:1\x85\x0a" +
+	"'\xf7k\x0d\x17p\xed!FN\xf5\xa2BjB\x0f" +
+	"G\x15\x1d\x118\xc9\x9c\x02\x07\x1d\xeb\xb3gl\x9e\xb4" +
+	"p\xf2s\x10\x0b\xa9\xc5\xc1o\x98\x1c\xa8\xae\x10\xcf[" +
+	"\xb8\xbf\xb4i\xfbg\xc8\x07\xdd<\x15\xf1\xa0\x18\x8c\\" +
+	"!$4\xb3\xc0\xa2\xa6n\x0e)\x8c\x02@\x80\xa5N" +
+	"\xaf\xae\x10S\x18M \x9f\xe6j\x9e\xd0\xecs\xcbf" +
+	"0\xf2\x04\xb32\xd8\xa7\xad\\X1\xdd\x99\x15\xd8\xb4" +
+	"@I\x94\xe0D\xe2\xd0C\xc0\xdb45\xa8F\xf5\x8a" +
+	"8\x92\x83\x82|o})w\xf737{i\xeb6" +
+	"\xb2l\x9d\xe6\"\xa0h4\xa1\xf6,2\x8a\x89\x09\x11" +
+	"\x13\xb3\x8d\xb2[5\xf4\x96t\xae1\xafG8\x9aT" +
+	"\xa5J#\x97\x1bi\xaa\x9e\xd4\xa2%\x9aG\x8bi)" +
+	"\xe3\x1f7+\x88B\x86f\xb3\xd9T 0j\xbe\x91" +
+	"\xba\x80\x07\x87F\xdel\xf6\xfd\xf8'Z\x0a\xb0\xe1\xc1" +
+	"\x85\x97\\\x12y\xe8?\xc8\xe7.\xa4!~~\x0a:" +
+	"\x10\x15\xb9x\xbeC\x11\xb8b\xa7\"p\x9a\xc8\xc5\xcd" +
+	"\xfd\xdf^(rq\xc9\x89\x8b\x9b\xfb\xbf\xabZ\xe4\xe2" +
+	".\x93\x8b\x0b\xd6@\x16\x0ch}\x80\xa1B\xb68\x0b" +
+	"\xa6*Z\x89\xf2\x8c\x88+\xb3\xdd\xaf\xd1/\x03/\xff" +
+	"h\x06\x02\x15\x8e\xea\xe9\xbf\x1e\x85\xe4\x18\xaf\xf6\xc7\x10" +
+	"\x06\x10\xe4v\xd3\xed\xe2@\x961NV\x89\x80\x1c\xb8" +
+	"4\x03\xe7\x17U\xe6\xcb\xadAw\x17\x0bK\xce\xf6v" +
+	"o7\xfepZ\xf2\xd2\xfb\x85\x82U\x96\xd9o\xf7U" +
+	"\x08VYf\xbf\x15\xad\xb2\x0c\xc0\xe0h\x85 \xc1\x9b" +
+	"PK\xbe\x13\xb3\x05\x09\xde#Q\x19\xdbwz\xa7(" +
+	"\xaa3\xa8AK%\x12*0\xfa\xc9\xb7\x87u\x01\x96" +
+	"(\x1c\x09\x0dUt\x1b\x07H&t\xb2\x02v\x16\x1d" +
+	"\xd7bA5\x91\xa09WL\xb6\xa3+\x18\x8cE\xc0" +
+	"\\0^\xd4\xb1>\x1c\x1d\x12\x09\xabQI/7i" +
+	"\x18\x09j!\x19\xb6\xcd8\x9e\xa9\xa5\xcb\xa6\x15\x8bV" +
+	"6)\xc6\xb4 \xba\xc0\xea\xac\xa2\x149D69\x82" +
+	"F{Z\xfa\xea\xceu\x1d6\xc7:\x0c\xcc\xb7\xd1j" +
+	"<L\xab\xe10\xc0\xc2a\x88\xaaw\xad\x15\xf7b\xea" +
+	"\xfc\xb8\x04\xcalq/&\x83\xc2\xa3A\xb3\xc5\xbd0" +
+	"\xad\xbf\x0a\xa6\xd9\xe2^\x98\xd6?\x0e\xaamq/," +
+	"\x1eF\x85\x09\xb6\xb8\x17\x16\x0fSO\xad\x01u\xa4]" +
+	"'\xed\xed\x8aL\xd80J\x1f'\xedw\xd2x\x18\xb7" +
+	"\x09\x1bF\xfb\x9fJ\xda\x1fI\x8b\x871\xa3t+\x91" +
+	",@\xe9\x9c\x83\xdc\xd9ze\xeaM$\x00\x06\xf9\xf5" +
+	"\xb4R\x86$\xc2t\x8c\x1e\x11#L\xcf\x18Ls&" +
+	"\xe0\xab\xdcP\xad\xce&}-\xe3\x9a\xffiF\xe2\x9c" +
+	"\x93\xf4\xb23\xfaYU\xb2r\xc9\xd4sHR\xcen" +
+	"t\xabnPnq\xea\x0c4A\x08\xf7\x16XZ\xa1" +
+	"\xc9\xd2\x06\x0b,m\x10\xe1#\xfd\x0d\x96v\xa6\x0c\xe4" +
+	"sR\xc2\x9cc\x19U\xa8\x8a'aB\xfb\xd0\xb7\xae" +
+	"\xa8\x86\x8af\x83\x8eP\xd1\xach\x0dU`\x8a\x96R" +
+	"4\xa3\"\xcd@3\x9a\x87P*I\xa3\x0a\xc2\xe3\x91" +
+	"'\xac\xb2\xf8V\x8a$\xaa\xc5\"\x11U\xbb1\xa6\x0f" +
+	"U#j\xad\x97\xc4k\xa7\xc2\xe6\x81\x86\xda\xaa(\xf5" +
+	"Uz\x95\x9a\x88Jo_RWj \xa2\xdeHa" +
+	"\x90\xe4h\xc8D\xd8+\x8d\xa2<\x0a\xdd\x94\x8a\x93k" +
+	"k\"\xdd\xa9\xd1\xb0\x1a\xca\x1a\xc9\xc8(\xa6 \x0a\x01" +
+	"\xad\x84[\xa4\x95\xbe\xcfJ\xff\"\x81\xb5\x10\xc9\x04\x8c" +
+	"T\x08^\xf6LT\x1b,t<\xfa\x80\xe5\x96\x07M" +
+	"V\xdf\x1f\xbf\x99t\x90I*t\xbeS\xc2X\x01\xcf" +
+	"\x8b \x83\xd3}D\x04*\x89\xd9\x86\x88\xd5\xe9\x0c\x93" +
+	"\xf5d\xa5:\xb3J\xdc\xe1`\x03\x12\xc3\xa9\x8d\xc8\x90" +
+	"\xce\x06\x9e\x96\xaf\x02\xa1\xbc\xa8:Y\xd58p\x1aB" +
+	")\xd2\xd00*La\xfb\xb3\x19]M\x7fa\xb3\x8c" +
+	"\xfd\xb1\xea\x0c\xe6p\xeb\x82B\x9cU\xc6\x9a.\xabR" +
+	"\x95c\x18\x8eP\x19Upff\x1f\xf3A\xf5\xf3\x9e" +
+	"c\x1a\xe4\xb8\xda2\x86\xa7\x18\xa1\xbczB5#\x19" +
+	"\xa5\xff\x7f\xce\xc2\xad\xb3c\xdcV\x01\xb3\x1c6\xc7\x06" +
+	"\xe9+TIv\xae\xd9\xdcB\x18%%\x9b\x15\xa3^" +
+	"\xd1\x19qwsE\xe1\x11\x1e\x94\xffC\x194!\xc2" +
+	"Be\xab\xadY\xa5\x07s\xd8\x01V4\x8bB(\x1a" +
+	"\xf9\xe7\xad|fMzu\xe9\x8c\xc7H\xe4\x98\xd3k" +
+	"\x15\xdf\xcb\xe5\x1a\xdaq\xb6\xc4\x98\x823\xc5\xb90\xe3" +
+	"\xfdo\x05F=\xae\xd0t\xe0\xeaF\x80\xe0\xf8\xb0\xa5" +
+	"\x15z#\xb1\x16A\x1agL\x17\xcd6\xd9\xd7\xe6\x0b" +
+	"\xc99\xda3\xc1C\x043\xce\xc5e\x05*s\xd8\x83" +
+	"t\xe0R\xb3d\xcb\x7f\xc9v\xb2\xd5,\xb7\x16\xcf*" +
+	"\xe4\x99\xc3\xe2\xb1:bZO\x16\xbd\x13\x8b\x84\xe5`" +
+	"C\xcb\x97\xaf\xc2x\xf9\x0a\xad\x97/\x16\x1dF\x11 " +
+	"\x11\xa8~%2Ei\xc8\x0e\xe9m\xb8\x90\xd3\xd1Z" +
+	"\x9e\x95c\xf0H\x81\x10<\xa2\xf3B9\xd0\x91Wg" +
+	"\xfc\xef\xe9V\xff\xff\x01\x00\xb6 LK"
 
 func init() {
 	schemas.Register(schema_ffaaf7385bc4adad,
+		0x8089ac8e6715e1db,
+		0x8101b81800b56a96,
+		0x8234b52ad8d55d61,
+		0x82510d3464397f38,
+		0x82a19fdf41e356fb,
+		0x82c3638366192499,
 		0x83479da67279e173,
+		0x841d412e6bb13263,
+		0x844530cf92fcc3c6,
+		0x84a2bcf11a54e25a,
+		0x85e40ceb4cb0ce18,
+		0x86b1a5ed2ee3fe0a,
+		0x870856d7715ebfde,
+		0x8709f75f61f7fec9,
+		0x88894a061158febc,
+		0x88a7c20d48426128,
+		0x89ac8b2a8f68928a,
+		0x8ada080957d5db5d,
+		0x8aef91973dc8a4f5,
+		0x8b09b7679204aa0f,
+		0x8b4c03a0662a38dc,
+		0x8b78c63c526a4540,
+		0x8bf9d41f934c512d,
+		0x8cc7ec4f225f7e75,
+		0x8ceb3503d8b127df,
+		0x8d313ebdf5ea4abe,
+		0x8e227cb048ce3dce,
+		0x8e35947ccc5bb354,
+		0x8e74e877862ab1fc,
+		0x8efcb63ea313a021,
+		0x8f14b14bb946d04a,
+		0x8ffcab79749f8dc8,
+		0x9017adaffb99a954,
+		0x90a3950a51412b8b,
+		0x90dbf7ca3e53e1f6,
+		0x91b43ed9c2b59a3d,
+		0x91e7721ca9f4432f,
+		0x91f4aad4a8e7009d,
+		0x94111a4ff83b847a,
+		0x9488d71c49c86c29,
+		0x94ec55ba81be1563,
+		0x968709e5ac646fae,
+		0x96dcde632ac079f4,
+		0x97094d00caad0b04,
+		0x9730e2bb79a6f04c,
+		0x97c2918f8d3765ca,
+		0x97f46a0732c0868b,
+		0x97fd7270d05fe927,
+		0x99f3551c2bfc4f48,
+		0x9a5dadc3cb5eb5a1,
+		0x9a716e5edad0cd20,
+		0x9ad8fd7f599216a6,
+		0x9b0d278358e9d418,
+		0x9b41a13e97cb6558,
+		0x9b5f6f6f36f0c785,
+		0x9bd5ecd9970b4cf0,
+		0x9bdf59c63c72cecd,
+		0x9bef35d18b7c8e16,
+		0x9c06ae6cf17a2ab0,
+		0x9d82529754851252,
+		0x9da87c83d6ee4b84,
+		0x9ed86251d579582d,
+		0x9ef241db2f0da0a2,
+		0x9fb7fa9c3928a4d3,
+		0x9fe3605dfe914696,
+		0xa01442f335a6cc00,
+		0xa02baa9843c3319e,
 		0xa0ef8355b64ee985,
+		0xa146cbcc3f804a40,
+		0xa16847f8fc4fe2f5,
+		0xa199c5435b00304a,
 		0xa20f49456be85b99,
+		0xa2c865b278522903,
+		0xa3cb406c522dcab1,
+		0xa5c5421589865e90,
+		0xa6d76ce69f13a816,
+		0xa6f4e4f5dcdf6711,
+		0xa7645531c88cbedd,
+		0xa788b2549075c742,
+		0xa85a62dd95c50d7f,
+		0xa85bc00ca9d9e314,
+		0xa8757cef51f9fba2,
+		0xa970a2775e6a0d18,
+		0xaa06e856a55ab43f,
 		0xaa2f3c8ad1c3af24,
+		0xaaa69aebe451afba,
+		0xaaca00bdc6db2092,
+		0xab9e06d122b40479,
+		0xac0b4225e039c31c,
+		0xacb3cda2797eb884,
+		0xacc3207e16e1ffb0,
+		0xacc53302ab486d36,
 		0xace5517aafc86077,
+		0xad5e6e3b177fdffd,
+		0xad8b23925f51c168,
+		0xadb66abea677f8fc,
+		0xae5e0ae5001ebdfe,
 		0xae78ee8eb6b3a134,
+		0xae9dffa6cdb1ee6c,
+		0xaf643dcb7f32e91b,
+		0xb0330eff62073339,
+		0xb131cbb7b097105a,
+		0xb204b044eaca48e2,
+		0xb2b0116d3f068d4f,
+		0xb30aaab6c6870e33,
+		0xb30f1911e341e283,
+		0xb34e262fa935335a,
+		0xb521277328734a65,
 		0xb5418b8ea8ead17b,
+		0xb5715db6b4a29dac,
+		0xb5ff0b0049002785,
+		0xb6415168af7a33a6,
+		0xb6f01d18a2b0fd8e,
+		0xb727729a699a1475,
 		0xb737e899dd6633f1,
+		0xb780efa29376762d,
+		0xb78b75a9a91a9748,
+		0xb7ed9aac85d16f68,
+		0xb8a04df0eb432fc1,
+		0xb9b7756057da8dbf,
 		0xba77e3fa3aa9b6ca,
+		0xbb108b9aa42fcf07,
+		0xbbaa233f6a4c8bd5,
+		0xbc1bca51fe8ba645,
+		0xbe1b87da3e2eae84,
+		0xbe34f78f6a935b18,
+		0xbf8d2f031ea7151c,
+		0xbfd1a9d245bcd107,
+		0xbffe11b1c86c44e3,
+		0xc0c3b835f6821b06,
+		0xc153f281de6e1fcf,
+		0xc16fddcfb5be823f,
+		0xc196034eb51b223c,
+		0xc1be5c9d05700c3f,
+		0xc2114ae2534ba177,
+		0xc33c4cc3fbe42de7,
+		0xc495a5057fb98032,
+		0xc559d59901c70e15,
+		0xc5a3e1c6546c65c5,
+		0xc5d23d62a3774838,
 		0xc5e65eec3dcf5b10,
+		0xc6e1e7b26fef688a,
 		0xc76ccd4502bb61e7,
+		0xc90f9c407ec24e15,
+		0xc9701dd28ecc4dec,
+		0xc9971c07179123bc,
+		0xca27841148b7050e,
+		0xca8ef19be0ffbd77,
+		0xcaecd1f6482ab8fc,
+		0xcb5061b78617cf88,
+		0xcb729343a515ef11,
+		0xcbb9ae1e6dadf0d0,
+		0xcbe24b46b6b6a8aa,
 		0xcc2f70676afee4e7,
+		0xce07fd7fe8b3d1c9,
+		0xce733f0914c80b6b,
 		0xceba3c1a97be15f8,
+		0xcefe45fd0d8dabff,
+		0xcf3f7730ca68e06b,
+		0xcf52eceec0c85167,
+		0xcfae465adf42c669,
+		0xcfb7c5597c044cdb,
+		0xd0066da47b16db35,
 		0xd0476e0f34d1411a,
+		0xd285ab9e532f8e8f,
+		0xd2cb6549091ed7df,
+		0xd45fb0bc9ddaf48d,
+		0xd540a6df70b7ad2e,
+		0xd74ba8d601b5841e,
+		0xd7ad1299ae3d2e12,
+		0xd7aec62dfbdd89f0,
+		0xd7c212e5cd3d99dd,
+		0xd8998defc6b19abf,
 		0xd9d61d1d803c85fc,
+		0xdaa272aff9507fc0,
+		0xdb0d49abe2bb2ab6,
+		0xdc48fbfc31ee1cbe,
+		0xdc4d14e96aa1033e,
+		0xddc0bbd610330888,
+		0xde0533ba0ea48fa4,
 		0xde3a625e70772b9a,
+		0xdea4ee41af9d3e55,
+		0xdebaeed2a782ac80,
+		0xdf59f911433f86e4,
 		0xdf703ca0befc3afc,
+		0xdfca9ee49ebf0d98,
 		0xe00e522611477055,
+		0xe024baaf8cbb64fb,
+		0xe1610143b0a97fd5,
+		0xe1d66f75234ae38a,
+		0xe1f5eafd8167552c,
+		0xe2362c256b305a3d,
+		0xe2720800f0cc3148,
+		0xe2b79aecdbff1367,
 		0xe313695ea9477b30,
+		0xe3cc22436fc42c31,
+		0xe41bba77bdac220f,
+		0xe4a203379a3b5085,
+		0xe56192340d8af3f6,
+		0xe5a25a689d86a6a8,
+		0xe5adba5696f0c278,
 		0xe5ea916eb0c31336,
+		0xe66c9b755e97c2a3,
+		0xe6f0bb96774b2e8a,
+		0xe7a304c4d4f0c163,
+		0xe7c5a149df911f70,
+		0xe816df6a73dc01fb,
+		0xe8a3e5397a0d9a33,
+		0xe989fde14d6e82dd,
+		0xeacad74128d0e3e7,
+		0xeadf00d4ff560865,
+		0xeb6d1af8db5d3520,
+		0xebbb2cf3800c1b99,
+		0xebbc7ae7ae262bb9,
+		0xebf2395e50275e51,
+		0xec53de7966fdb174,
+		0xecbee01cad589e46,
+		0xed878ff3a2e3932c,
+		0xeda03e0f6c801890,
+		0xedd2e5b018f17bbb,
+		0xeea4b272d5001936,
+		0xeedd21a69204efcb,
+		0xef387164b6b1f60d,
+		0xef9ecb15313f7502,
+		0xefbec970d17dc985,
 		0xf026e3d750335bc1,
+		0xf16332617e40cd50,
+		0xf18bd11dcac0404b,
+		0xf1d4840d20d62e34,
+		0xf1d99215fd97ddaf,
+		0xf1f7be6741cee38d,
+		0xf2725610fbee91f4,
 		0xf34be5cbac1feed1,
+		0xf3c4f20ca0d204ce,
+		0xf3fdff7dbc62813a,
 		0xf41122f890a371a6,
 		0xf44732c48f949ab8,
+		0xf4e3e92ae0815f15,
+		0xf5024761975c861f,
+		0xf508192f5b9061f9,
+		0xf6906c1b1b918f79,
+		0xf78dea81ed58ba5a,
+		0xf798b7a4fe56d11d,
+		0xf7bebb3fdc09132e,
+		0xf7c52eede51486a1,
 		0xf8e86a5c0baa01bc,
-		0xf9b3cd8033aba1f8)
+		0xf990f9ce111e2bd1,
+		0xf9b3cd8033aba1f8,
+		0xf9de99d1fab38e05,
+		0xf9e85731d816e2fa,
+		0xfa066186bb70bb83,
+		0xfa54490c8d7a3f3f,
+		0xfaf066b0dfd2c1d5,
+		0xfb4ebd2f1be74feb,
+		0xfc257fe2fa86af93,
+		0xfc3863c375973cf7,
+		0xfd2ae33e75dc9a9a,
+		0xfd592f0d89b7b928,
+		0xfdae861fa8890aa3,
+		0xfe29e3539554005a,
+		0xff42f547f8ccd1b9)
 }
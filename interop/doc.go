@@ -0,0 +1,7 @@
+// Package interop adapts the conmon-rs client to the types of podman and
+// exports its metrics to Prometheus.
+//
+// The client module github.com/containers/conmon-rs only depends on the RPC
+// and attach primitives, while this module pulls in podman and the Prometheus
+// client for callers which already use them.
+package interop
@@ -0,0 +1,67 @@
+package interop
+
+import (
+	"errors"
+
+	"github.com/containers/conmon-rs/pkg/client"
+	"github.com/containers/podman/v4/libpod/define"
+)
+
+// TerminalSize converts a podman terminal size into the one of the client.
+func TerminalSize(size define.TerminalSize) client.TerminalSize {
+	return client.TerminalSize{Width: size.Width, Height: size.Height}
+}
+
+// PodmanTerminalSize converts a terminal size of the client into the one of
+// podman.
+func PodmanTerminalSize(size client.TerminalSize) define.TerminalSize {
+	return define.TerminalSize{Width: size.Width, Height: size.Height}
+}
+
+// Resize forwards the podman resize events to a channel usable for
+// client.AttachConfig.Resize. The returned channel gets closed after the
+// resize channel got closed. It returns nil for a nil resize channel.
+func Resize(resize <-chan define.TerminalSize) chan client.TerminalSize {
+	if resize == nil {
+		return nil
+	}
+
+	res := make(chan client.TerminalSize, 1)
+	go func() {
+		defer close(res)
+		for size := range resize {
+			res <- TerminalSize(size)
+		}
+	}()
+
+	return res
+}
+
+// detachError is an error matching both client.ErrDetach and
+// define.ErrDetach.
+type detachError struct {
+	err error
+}
+
+func (e *detachError) Error() string {
+	return e.err.Error()
+}
+
+func (e *detachError) Unwrap() error {
+	return e.err
+}
+
+func (e *detachError) Is(target error) bool {
+	return target == define.ErrDetach
+}
+
+// DetachError makes an error of client.AttachContainer matching
+// client.ErrDetach also match define.ErrDetach, which podman uses to detect
+// detached sessions. Other errors are returned unchanged.
+func DetachError(err error) error {
+	if !errors.Is(err, client.ErrDetach) {
+		return err
+	}
+
+	return &detachError{err: err}
+}
@@ -0,0 +1,36 @@
+package interop_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/conmon-rs/interop"
+	"github.com/containers/conmon-rs/pkg/client"
+	"github.com/containers/podman/v4/libpod/define"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Podman", func() {
+	It("should forward resize events until the channel is closed", func() {
+		resize := make(chan define.TerminalSize)
+		res := interop.Resize(resize)
+
+		resize <- define.TerminalSize{Width: 80, Height: 24}
+		Eventually(res).Should(Receive(Equal(client.TerminalSize{Width: 80, Height: 24})))
+
+		close(resize)
+		Eventually(res).Should(BeClosed())
+		Expect(interop.Resize(nil)).To(BeNil())
+	})
+
+	It("should match the podman detach error", func() {
+		err := interop.DetachError(fmt.Errorf("attach: %w", client.ErrDetach))
+		Expect(errors.Is(err, define.ErrDetach)).To(BeTrue())
+		Expect(errors.Is(err, client.ErrDetach)).To(BeTrue())
+
+		other := errors.New("other")
+		Expect(interop.DetachError(other)).To(Equal(other))
+		Expect(interop.DetachError(nil)).To(BeNil())
+	})
+})
@@ -0,0 +1,104 @@
+package interop
+
+import (
+	"github.com/containers/conmon-rs/pkg/client"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// metricsNamespace is the namespace of the metrics exported by the
+// collector.
+const metricsNamespace = "conmonrs_client"
+
+var (
+	rpcLatencyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "rpc_duration_seconds"),
+		"Latency of the RPCs to the server in seconds.",
+		[]string{"method"}, nil,
+	)
+	attachBytesInDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "attach", "bytes_in_total"),
+		"Bytes copied from the standard input of attach sessions to the containers.",
+		nil, nil,
+	)
+	attachBytesOutDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "attach", "bytes_out_total"),
+		"Bytes copied from the containers to the output of attach sessions.",
+		nil, nil,
+	)
+	attachBytesDroppedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "attach", "bytes_dropped_total"),
+		"Output bytes of attach sessions discarded because the output buffer was exhausted.",
+		nil, nil,
+	)
+	activeAttachSessionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "attach", "active_sessions"),
+		"Number of running attach sessions.",
+		nil, nil,
+	)
+	reconnectsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "reconnects_total"),
+		"Number of successful reconnects to the server.",
+		nil, nil,
+	)
+	connectRetriesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, "", "connect_retries_total"),
+		"Number of retried connection attempts to the server.",
+		nil, nil,
+	)
+)
+
+// MetricsCollector exports the client.Metrics to Prometheus.
+type MetricsCollector struct {
+	metrics *client.Metrics
+}
+
+// NewMetricsCollector creates a collector exporting the metrics, which have
+// to be configured for the clients.
+func NewMetricsCollector(metrics *client.Metrics) *MetricsCollector {
+	return &MetricsCollector{metrics: metrics}
+}
+
+// Describe sends the descriptors of all exported metrics.
+func (c *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		rpcLatencyDesc,
+		attachBytesInDesc,
+		attachBytesOutDesc,
+		attachBytesDroppedDesc,
+		activeAttachSessionsDesc,
+		reconnectsDesc,
+		connectRetriesDesc,
+	} {
+		ch <- desc
+	}
+}
+
+// Collect sends the current values of the metrics.
+func (c *MetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	snapshot := c.metrics.Snapshot()
+
+	for method, histogram := range snapshot.RPCLatency {
+		ch <- prometheus.MustNewConstHistogram(
+			rpcLatencyDesc, histogram.Count, histogram.Sum, histogram.Buckets, method,
+		)
+	}
+
+	ch <- prometheus.MustNewConstMetric(
+		attachBytesInDesc, prometheus.CounterValue, float64(snapshot.AttachBytesIn),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		attachBytesOutDesc, prometheus.CounterValue, float64(snapshot.AttachBytesOut),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		attachBytesDroppedDesc, prometheus.CounterValue, float64(snapshot.AttachBytesDropped),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		activeAttachSessionsDesc, prometheus.GaugeValue, float64(snapshot.ActiveAttachSessions),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		reconnectsDesc, prometheus.CounterValue, float64(snapshot.Reconnects),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		connectRetriesDesc, prometheus.CounterValue, float64(snapshot.ConnectRetries),
+	)
+}
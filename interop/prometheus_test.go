@@ -0,0 +1,42 @@
+package interop_test
+
+import (
+	"github.com/containers/conmon-rs/interop"
+	"github.com/containers/conmon-rs/pkg/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+var _ = Describe("MetricsCollector", func() {
+	It("should export the metrics when registered", func() {
+		registry := prometheus.NewPedanticRegistry()
+		Expect(registry.Register(interop.NewMetricsCollector(client.NewMetrics()))).To(Succeed())
+
+		families, err := registry.Gather()
+		Expect(err).To(BeNil())
+
+		types := map[string]dto.MetricType{}
+		for _, family := range families {
+			Expect(family.GetMetric()).To(HaveLen(1))
+			Expect(family.GetMetric()[0].GetCounter().GetValue()).To(BeZero())
+			types[family.GetName()] = family.GetType()
+		}
+		Expect(types).To(Equal(map[string]dto.MetricType{
+			"conmonrs_client_attach_bytes_in_total":      dto.MetricType_COUNTER,
+			"conmonrs_client_attach_bytes_out_total":     dto.MetricType_COUNTER,
+			"conmonrs_client_attach_bytes_dropped_total": dto.MetricType_COUNTER,
+			"conmonrs_client_attach_active_sessions":     dto.MetricType_GAUGE,
+			"conmonrs_client_reconnects_total":           dto.MetricType_COUNTER,
+			"conmonrs_client_connect_retries_total":      dto.MetricType_COUNTER,
+		}))
+	})
+
+	It("should fail to register twice", func() {
+		registry := prometheus.NewRegistry()
+		metrics := client.NewMetrics()
+		Expect(registry.Register(interop.NewMetricsCollector(metrics))).To(Succeed())
+		Expect(registry.Register(interop.NewMetricsCollector(metrics))).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,236 @@
+package client
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// archivedContainer is the server state of a single container required for
+// archiving its logs.
+type archivedContainer struct {
+	id       string
+	logPaths []string
+	exited   bool
+	exitCode int32
+	exitedAt time.Time
+	err      error
+}
+
+// archiveExit is the serialized exit information of a container.
+type archiveExit struct {
+	ID        string     `json:"id"`
+	Exited    bool       `json:"exited"`
+	ExitCode  int32      `json:"exitCode"`
+	ExitedAt  *time.Time `json:"exitedAt,omitempty"`
+	LogFiles  []string   `json:"logFiles,omitempty"`
+	LogErrors []string   `json:"logErrors,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// ArchiveLogs writes a tar archive to w, which contains the log files of all
+// file based log drivers and the exit information of the containers. The logs
+// of running containers are flushed before being archived.
+//
+// Every container has its own directory in the archive, containing the log
+// files and an `exit.json`. Containers which are unknown to the server or
+// whose log files do not exist anymore do not fail the archive, the errors
+// are recorded in their `exit.json` instead.
+func (c *ConmonClient) ArchiveLogs(ctx context.Context, ids []string, w io.Writer) error {
+	normalized := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id, err := c.containerID(ctx, id)
+		if err != nil {
+			return err
+		}
+		normalized = append(normalized, id)
+	}
+
+	containers, err := c.archiveLogs(ctx, normalized)
+	if err != nil {
+		return err
+	}
+
+	tarWriter := tar.NewWriter(w)
+	now := time.Now()
+
+	for _, container := range containers {
+		if err := writeArchivedContainer(tarWriter, container, now); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("close tar writer: %w", err)
+	}
+
+	return nil
+}
+
+func writeArchivedContainer(tarWriter *tar.Writer, container *archivedContainer, now time.Time) error {
+	exit := archiveExit{
+		ID:       container.id,
+		Exited:   container.exited,
+		ExitCode: container.exitCode,
+	}
+	if container.exited {
+		exit.ExitedAt = &container.exitedAt
+	}
+
+	if container.err != nil {
+		exit.Error = container.err.Error()
+	}
+
+	// Log drivers may use the same file name in different directories.
+	used := make(map[string]bool, len(container.logPaths))
+	for i, logPath := range container.logPaths {
+		name := filepath.Base(logPath)
+		if used[name] {
+			name = strconv.Itoa(i) + "-" + name
+		}
+		used[name] = true
+
+		file, err := os.Open(logPath)
+		if err != nil {
+			exit.LogErrors = append(exit.LogErrors, err.Error())
+
+			continue
+		}
+
+		err = writeTarLogFile(tarWriter, path.Join(container.id, name), file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+		exit.LogFiles = append(exit.LogFiles, name)
+	}
+
+	data, err := json.MarshalIndent(exit, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal exit of %s: %w", container.id, err)
+	}
+
+	return writeTarFile(tarWriter, path.Join(container.id, "exit.json"), data, now)
+}
+
+// writeTarLogFile streams the log file into the archive. Only the content
+// present when starting is archived, because running containers may still
+// write to the file.
+func writeTarLogFile(tarWriter *tar.Writer, name string, file *os.File) error {
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("stat %s: %w", file.Name(), err)
+	}
+
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    info.Size(),
+		ModTime: info.ModTime(),
+	}); err != nil {
+		return fmt.Errorf("write header of %s: %w", name, err)
+	}
+
+	if _, err := io.CopyN(tarWriter, file, info.Size()); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (c *ConmonClient) archiveLogs(ctx context.Context, ids []string) ([]*archivedContainer, error) {
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("ArchiveLogs")()
+	diag := c.diagnoseRPC("ArchiveLogs")
+	future, free := client.ArchiveLogs(ctx, func(p proto.Conmon_archiveLogs_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := stringSliceToTextList(ids, req.NewIds); err != nil {
+			return fmt.Errorf("set IDs: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	list, err := response.Containers()
+	if err != nil {
+		return nil, fmt.Errorf("get containers: %w", err)
+	}
+
+	containers := make([]*archivedContainer, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		container, err := archivedContainerFromProto(list.At(i))
+		if err != nil {
+			return nil, err
+		}
+		containers = append(containers, container)
+	}
+
+	return containers, nil
+}
+
+func archivedContainerFromProto(item proto.Conmon_ArchivedContainer) (*archivedContainer, error) {
+	id, err := item.Id()
+	if err != nil {
+		return nil, fmt.Errorf("get ID: %w", err)
+	}
+
+	logPaths, err := item.LogPaths()
+	if err != nil {
+		return nil, fmt.Errorf("get log paths: %w", err)
+	}
+
+	container := &archivedContainer{
+		id:       id,
+		logPaths: make([]string, 0, logPaths.Len()),
+		exited:   item.Exited(),
+		exitCode: item.ExitCode(),
+		exitedAt: time.Unix(0, int64(item.Timestamp())),
+		err:      responseError(item),
+	}
+
+	for i := 0; i < logPaths.Len(); i++ {
+		logPath, err := logPaths.At(i)
+		if err != nil {
+			return nil, fmt.Errorf("get log path: %w", err)
+		}
+		container.logPaths = append(container.logPaths, logPath)
+	}
+
+	return container, nil
+}
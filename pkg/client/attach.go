@@ -6,11 +6,11 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"sync/atomic"
+	"syscall"
+	"time"
 
 	"github.com/containers/conmon-rs/internal/proto"
-	"github.com/containers/podman/v4/libpod/define"
-	"github.com/containers/podman/v4/pkg/kubeutils"
-	"github.com/containers/podman/v4/utils"
 )
 
 const (
@@ -21,8 +21,23 @@ const (
 )
 
 var (
-	errOutputDestNil   = errors.New("output destination cannot be nil")
-	errTerminalSizeNil = errors.New("terminal size cannot be nil")
+	// ErrStrictTTYUnsupported is returned if the server does not support the
+	// AttachConfig.StrictTTY.
+	ErrStrictTTYUnsupported = errors.New("server does not support strict TTY validation")
+
+	// ErrStderrPriorityUnsupported is returned if the server does not
+	// support the AttachConfig.PrioritizeStderr.
+	ErrStderrPriorityUnsupported = errors.New("server does not support stream priorities")
+)
+
+var (
+	errOutputDestNil           = errors.New("output destination cannot be nil")
+	errTerminalSizeNil         = errors.New("terminal size cannot be nil")
+	errUnroutableCallbackNil   = errors.New("unroutable packet callback cannot be nil")
+	errDetachKeysConflict      = errors.New("detach keys and detach keys spec are mutually exclusive")
+	errOutputOnlyStdin         = errors.New("output only sessions cannot have a standard input")
+	errStderrPriorityTerminal  = errors.New("the standard error cannot be prioritized with a simulated terminal")
+	errStderrPrioritySequenced = errors.New("the standard error cannot be prioritized with sequenced output")
 )
 
 // AttachStreams are the stdio streams for the AttachConfig.
@@ -63,15 +78,50 @@ type AttachConfig struct {
 	// Whether a terminal was setup for the command this is attaching to.
 	Tty bool
 
+	// StrictTTY makes the server validate the Tty against the terminal of
+	// the container or exec session before attaching. A mismatch fails the
+	// attach with an RPCError matching ErrTTYMismatch, rather than mixing
+	// up the output streams. The attach fails with ErrStrictTTYUnsupported
+	// if the server does not support it.
+	StrictTTY bool
+
+	// SimulateTerminal runs a pseudo terminal on the server in front of the
+	// standard streams of a command without a terminal, which provides echo
+	// and line editing and delivers the interrupt and quit characters as
+	// signals. It applies to all sessions of the SocketPath, which merge the
+	// standard error into the standard output.
+	SimulateTerminal bool
+
+	// OutputOnly attaches without standard input, like following the logs.
+	// The server never reads the input of the sessions, which applies to all
+	// sessions of the SocketPath. The Streams must not contain a Stdin.
+	OutputOnly bool
+
 	// Whether stdout/stderr should continue to be processed after stdin is closed.
 	StopAfterStdinEOF bool
 
 	// Whether the output is passed through the caller's std streams, rather than
-	// ones created for the attach session.
+	// ones created for the attach session. The Resize and Signals channels are
+	// still handled for passthrough sessions.
 	Passthrough bool
 
-	// Channel of resize events.
-	Resize chan define.TerminalSize
+	// WaitForExit makes passthrough sessions block until the container
+	// exited, like attach sessions end with the container. It does not apply
+	// to exec sessions.
+	WaitForExit bool
+
+	// OnExit is called with the exit of the container if WaitForExit is set.
+	OnExit func(*WaitContainerResult)
+
+	// Channel of resize events. They are sent via the control lane of
+	// multiplexed sessions if supported by the server, which is not delayed
+	// by the output of other sessions.
+	Resize chan TerminalSize
+
+	// Channel of signals for the attached process. They are sent via the
+	// control lane of multiplexed sessions if supported by the server, or
+	// using KillContainer otherwise, which does not support exec sessions.
+	Signals chan syscall.Signal
 
 	// The standard streams for this attach session.
 	Streams AttachStreams
@@ -86,11 +136,272 @@ type AttachConfig struct {
 
 	// The keys that indicate the attach session should be detached.
 	DetachKeys []byte
+
+	// DetachKeysSpec are the detach keys in a human-readable form like
+	// "ctrl-p,ctrl-q", see ParseDetachKeys. It cannot be used together with
+	// DetachKeys.
+	DetachKeysSpec string
+
+	// OnDetach is called if the session got detached by the detach keys,
+	// after the output streams have been stopped. AttachContainer returns
+	// ErrDetach in that case.
+	OnDetach func()
+
+	// Transformations applied to the standard streams, which can be added
+	// via WithStreamTransform.
+	Transforms []StreamTransform
+
+	// UnroutablePacketPolicy defines how packets of unknown types are
+	// handled. Defaults to UnroutablePacketPolicyError.
+	UnroutablePacketPolicy UnroutablePacketPolicy
+
+	// OnUnroutablePacket is called for every packet which cannot be routed
+	// if UnroutablePacketPolicyCallback is set. This includes packets of
+	// streams which are nil. Returning an error stops the attach session.
+	OnUnroutablePacket func(*UnroutablePacket) error
+
+	// OnSessionEnd is called with a summary of the session after it ended.
+	// It is not called for passthrough sessions.
+	OnSessionEnd func(*AttachSessionSummary)
+
+	// IdleTimeout ends the session with ErrAttachIdleTimeout if no bytes have
+	// been transferred in either direction for its duration. Zero disables
+	// the timeout.
+	IdleTimeout time.Duration
+
+	// IdleNotifyAfter is the duration without bytes transferred in either
+	// direction after which the session is considered idle. Zero disables
+	// the notifications.
+	IdleNotifyAfter time.Duration
+
+	// OnActivityChange is called if the session becomes idle, and if it
+	// becomes active again afterwards, when IdleNotifyAfter is set. It is
+	// called synchronously with the transfers and must not block.
+	OnActivityChange func(*AttachActivityChange)
+
+	// MaxDuration ends the session with ErrAttachMaxDuration once it has been
+	// attached for its duration. Zero disables the limit.
+	MaxDuration time.Duration
+
+	// OutputBufferSize is the size in bytes of the buffers between reading
+	// the output and writing it to the standard output and error streams,
+	// which are written in the background. The buffered output is written
+	// before AttachContainer returns. Zero disables buffering, which blocks
+	// reading the output while a stream is slow.
+	OutputBufferSize int
+
+	// OutputBufferPolicy specifies how the output is handled if the buffer
+	// of a stream is full. Defaults to OutputBufferPolicyBlock.
+	OutputBufferPolicy OutputBufferPolicy
+
+	// StdinStallTimeout is the duration for which writing the standard input
+	// may block or be retried if the attach socket is temporarily out of
+	// buffer space, before the session ends with ErrStdinStalled. Defaults
+	// to 10 seconds.
+	StdinStallTimeout time.Duration
+
+	// Recording records the standard input and output of the session into
+	// a file, for example to audit interactive sessions. It is not applied
+	// to passthrough sessions.
+	Recording *AttachRecording
+
+	// SequencedOutput requests the server to number the output of the
+	// standard output and error in a single sequence, which preserves their
+	// order as read by the server. It applies to all sessions of the
+	// SocketPath and cannot be used together with SimulateTerminal. The
+	// attach fails with ErrSequencedOutputUnsupported if the server does not
+	// support it.
+	SequencedOutput bool
+
+	// OnOutput is called with every chunk of the output of a session with
+	// SequencedOutput, in the order of the sequence and before the chunk gets
+	// written to its stream. It receives the output of nil streams as well,
+	// while the Transforms and the OutputBufferSize do not apply to it.
+	// Returning an error stops the attach session.
+	OnOutput func(*OutputChunk) error
+
+	// ReportSessionEnd requests the server to announce the end of the
+	// sessions with the reason, rather than just closing them. The attach
+	// fails with an AttachEndError then, which matches ErrContainerRemoved
+	// if the container or exec process exited or got stopped. It applies to
+	// all sessions of the SocketPath. The attach fails with
+	// ErrSessionEndUnsupported if the server does not support it.
+	ReportSessionEnd bool
+
+	// PrioritizeStderr makes the server queue the output of the sessions
+	// per stream and deliver the queued standard error first, which lets
+	// error messages of a process flooding its standard output surface
+	// promptly to slow sessions. The order between the streams is not
+	// preserved then. It applies to all sessions of the SocketPath and
+	// cannot be used together with SimulateTerminal or SequencedOutput. The
+	// attach fails with ErrStderrPriorityUnsupported if the server does not
+	// support it.
+	PrioritizeStderr bool
+}
+
+// AttachSessionEndReason specifies why an attach session ended.
+type AttachSessionEndReason int
+
+const (
+	// AttachSessionEndReasonExit indicates that the streams have been closed
+	// regularly, for example because the container exited.
+	AttachSessionEndReasonExit AttachSessionEndReason = iota
+
+	// AttachSessionEndReasonDetach indicates that the detach keys have been
+	// received.
+	AttachSessionEndReasonDetach
+
+	// AttachSessionEndReasonError indicates that the session failed.
+	AttachSessionEndReasonError
+
+	// AttachSessionEndReasonTimeout indicates that the IdleTimeout or the
+	// MaxDuration of the session expired.
+	AttachSessionEndReasonTimeout
+
+	// AttachSessionEndReasonRemoved indicates that the server ended the
+	// session because the container or exec process got removed, see
+	// AttachConfig.ReportSessionEnd.
+	AttachSessionEndReasonRemoved
+)
+
+// AttachSessionSummary contains the statistics of an ended attach session.
+type AttachSessionSummary struct {
+	// Duration is the time the streams have been attached.
+	Duration time.Duration
+
+	// BytesIn is the number of bytes sent to the standard input.
+	BytesIn uint64
+
+	// BytesOut is the number of bytes received from the standard output and
+	// error.
+	BytesOut uint64
+
+	// BytesDropped is the number of output bytes discarded because the
+	// OutputBufferSize was exhausted.
+	BytesDropped uint64
+
+	// Reason is the reason why the session ended.
+	Reason AttachSessionEndReason
+
+	// LastError is the error which ended the session, if any.
+	LastError error
+}
+
+// UnroutablePacketPolicy specifies the handling of attach packets which
+// cannot be routed to an output stream.
+type UnroutablePacketPolicy int
+
+const (
+	// UnroutablePacketPolicyError stops the attach session with an error
+	// if a packet of an unknown type is received.
+	UnroutablePacketPolicyError UnroutablePacketPolicy = iota
+
+	// UnroutablePacketPolicyIgnore drops packets of unknown types.
+	UnroutablePacketPolicyIgnore
+
+	// UnroutablePacketPolicyCallback passes all unroutable packets to the
+	// OnUnroutablePacket callback of the AttachConfig.
+	UnroutablePacketPolicyCallback
+)
+
+// UnroutablePacketReason is the reason why a packet could not be routed.
+type UnroutablePacketReason int
+
+const (
+	// UnroutablePacketReasonUnknownType indicates that the type of the packet
+	// is not known to the client.
+	UnroutablePacketReasonUnknownType UnroutablePacketReason = iota
+
+	// UnroutablePacketReasonNoDestination indicates that the stream of the
+	// packet is nil.
+	UnroutablePacketReasonNoDestination
+)
+
+// UnroutablePacket is an attach packet which could not be routed to an
+// output stream.
+type UnroutablePacket struct {
+	// Reason is the reason why the packet could not be routed.
+	Reason UnroutablePacketReason
+
+	// Type is the type of the packet, which is the first byte of the frame.
+	Type byte
+
+	// Frame is the raw packet including the type. It is only valid during
+	// the callback.
+	Frame []byte
+}
+
+// AttachContainer can be used to attach to a running container. Multiple
+// sessions can be attached to the same container concurrently, also using
+// the same SocketPath. The container output is written to all of them, while
+// the standard input of all sessions gets merged.
+func (c *ConmonClient) AttachContainer(ctx context.Context, cfg *AttachConfig) (retErr error) {
+	ctx, end := c.startSpan(ctx, "AttachContainer")
+	defer end(&retErr)
+
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.OutputOnly && cfg.Streams.Stdin != nil {
+		return errOutputOnlyStdin
+	}
+
+	if err := cfg.validateSequencedOutput(); err != nil {
+		return err
+	}
+
+	if cfg.PrioritizeStderr && cfg.SimulateTerminal {
+		return errStderrPriorityTerminal
+	}
+
+	if cfg.PrioritizeStderr && cfg.SequencedOutput {
+		return errStderrPrioritySequenced
+	}
+
+	if cfg.DetachKeysSpec != "" {
+		if len(cfg.DetachKeys) > 0 {
+			return errDetachKeysConflict
+		}
+
+		keys, err := ParseDetachKeys(cfg.DetachKeysSpec)
+		if err != nil {
+			return fmt.Errorf("parse detach keys: %w", err)
+		}
+
+		resolved := *cfg
+		resolved.DetachKeys = keys
+		cfg = &resolved
+	}
+
+	if err := c.attachContainer(ctx, cfg); err != nil {
+		return err
+	}
+
+	if err := c.attach(ctx, cfg); err != nil {
+		return fmt.Errorf("run attach: %w", err)
+	}
+
+	return nil
 }
 
-// AttachContainer can be used to attach to a running container.
-func (c *ConmonClient) AttachContainer(ctx context.Context, cfg *AttachConfig) error {
-	conn, err := c.newRPCConn()
+// attachContainer requests the attach socket from the server.
+func (c *ConmonClient) attachContainer(ctx context.Context, cfg *AttachConfig) (retErr error) {
+	ctx, end := c.startSpan(ctx, "AttachContainer/rpc")
+	defer end(&retErr)
+
+	id, err := c.containerID(ctx, cfg.ID)
+	if err != nil {
+		return err
+	}
+
+	execSession, err := c.execSessionID(cfg.ExecSession)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
 	if err != nil {
 		return fmt.Errorf("create RPC connection: %w", err)
 	}
@@ -100,14 +411,16 @@ func (c *ConmonClient) AttachContainer(ctx context.Context, cfg *AttachConfig) e
 		}
 	}()
 
-	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("AttachContainer")()
+	diag := c.diagnoseRPC("AttachContainer")
 	future, free := client.AttachContainer(ctx, func(p proto.Conmon_attachContainer_Params) error {
 		req, err := p.NewRequest()
 		if err != nil {
 			return fmt.Errorf("create request: %w", err)
 		}
 
-		if err := req.SetId(cfg.ID); err != nil {
+		if err := req.SetId(id); err != nil {
 			return fmt.Errorf("set ID: %w", err)
 		}
 
@@ -115,53 +428,155 @@ func (c *ConmonClient) AttachContainer(ctx context.Context, cfg *AttachConfig) e
 			return fmt.Errorf("set socket path: %w", err)
 		}
 
-		// TODO: add exec session
+		if err := req.SetExecSessionId(execSession); err != nil {
+			return fmt.Errorf("set exec session ID: %w", err)
+		}
+
+		req.SetSimulateTerminal(cfg.SimulateTerminal)
+		req.SetOutputOnly(cfg.OutputOnly)
+		req.SetSequenced(cfg.SequencedOutput)
+		req.SetTerminal(cfg.Tty)
+		req.SetStrictTerminal(cfg.StrictTTY)
+		req.SetReportEnd(cfg.ReportSessionEnd)
+		req.SetStderrPriority(cfg.PrioritizeStderr)
+
+		if err := c.initTraceContext(ctx, req.NewTraceContext); err != nil {
+			return err
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
 		return nil
 	})
 	defer free()
 
 	result, err := future.Struct()
+	diag.report(ctx, result, err)
 	if err != nil {
 		return fmt.Errorf("create result: %w", err)
 	}
 
-	if _, err := result.Response(); err != nil {
+	response, err := result.Response()
+	if err != nil {
 		return fmt.Errorf("set response: %w", err)
 	}
 
-	if err := c.attach(ctx, cfg); err != nil {
-		return fmt.Errorf("run attach: %w", err)
+	if err := responseError(response); err != nil {
+		return err
+	}
+
+	// Servers without support ignore the request.
+	if cfg.SequencedOutput && !response.Sequenced() {
+		return ErrSequencedOutputUnsupported
+	}
+
+	if cfg.StrictTTY && !response.StrictTerminal() {
+		return ErrStrictTTYUnsupported
+	}
+
+	if cfg.ReportSessionEnd && !response.ReportEnd() {
+		return ErrSessionEndUnsupported
+	}
+
+	if cfg.PrioritizeStderr && !response.StderrPriority() {
+		return ErrStderrPriorityUnsupported
 	}
 
 	return nil
 }
 
+// handleAttachControl forwards the resize events and signals of the
+// session, preferring the control lane of the connection if there is one.
+func (c *ConmonClient) handleAttachControl(ctx context.Context, cfg *AttachConfig, conn attachConn) {
+	control, ok := conn.(attachControl)
+
+	handleResizing(cfg.Resize, func(size TerminalSize) {
+		c.logger.Debugf("Got a resize event: %+v", size)
+		if ok {
+			err := control.Resize(size.Width, size.Height)
+			if err == nil {
+				return
+			}
+			if !errors.Is(err, errAttachMuxNoControl) {
+				c.logger.Debugf("Failed to resize terminal via the control lane: %v", err)
+
+				return
+			}
+		}
+
+		if err := c.SetWindowSizeContainer(ctx, &SetWindowSizeContainerConfig{
+			ID:          cfg.ID,
+			ExecSession: cfg.ExecSession,
+			Size:        &size,
+		}); err != nil {
+			c.logger.Debugf("Failed to write to control file to resize terminal: %v", err)
+		}
+	})
+
+	if cfg.Signals == nil {
+		return
+	}
+
+	go func() {
+		for signal := range cfg.Signals {
+			c.logger.Debugf("Got a signal event: %v", signal)
+			if ok {
+				err := control.Signal(signal)
+				if err == nil {
+					continue
+				}
+				if !errors.Is(err, errAttachMuxNoControl) {
+					c.logger.Debugf("Failed to signal via the control lane: %v", err)
+
+					continue
+				}
+			}
+
+			if cfg.ExecSession != "" {
+				c.logger.Debugf("Unable to signal exec session %s without control lane", cfg.ExecSession)
+
+				continue
+			}
+
+			if err := c.KillContainer(ctx, cfg.ID, signal, false); err != nil {
+				c.logger.Debugf("Failed to signal container: %v", err)
+			}
+		}
+	}()
+}
+
 func (c *ConmonClient) attach(ctx context.Context, cfg *AttachConfig) (err error) {
-	var conn *net.UnixConn
+	var conn attachConn
 	if !cfg.Passthrough {
 		c.logger.Debugf("Attaching to container %s", cfg.ID)
 
-		kubeutils.HandleResizing(cfg.Resize, func(size define.TerminalSize) {
-			c.logger.Debugf("Got a resize event: %+v", size)
-			if err := c.SetWindowSizeContainer(ctx, &SetWindowSizeContainerConfig{
-				ID:   cfg.ID,
-				Size: &size,
-			}); err != nil {
-				c.logger.Debugf("Failed to write to control file to resize terminal: %v", err)
-			}
-		})
+		// The session is tracked to be drained by Close.
+		var session *trackedSession
+		session, err = c.state.startSession(cfg)
+		if err != nil {
+			return err
+		}
+		defer func() { session.end(err) }()
 
-		conn, err = DialLongSocket("unixpacket", cfg.SocketPath)
+		_, endDial := c.startSpan(ctx, "AttachContainer/dial")
+		conn, err = c.dialAttach(ctx, cfg.SocketPath)
+		endDial(&err)
 		if err != nil {
-			return fmt.Errorf("failed to connect to container's attach socket: %v: %w", cfg.SocketPath, err)
+			return err
 		}
+		session.setConn(conn)
 		defer func() {
-			if err := conn.Close(); err != nil {
+			if err := conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
 				c.logger.Errorf("unable to close socket: %q", err)
 			}
 		}()
 	}
 
+	c.handleAttachControl(ctx, cfg, conn)
+
 	if cfg.PreAttachFunc != nil {
 		if err := cfg.PreAttachFunc(); err != nil {
 			return fmt.Errorf("run pre attach func: %w", err)
@@ -169,36 +584,160 @@ func (c *ConmonClient) attach(ctx context.Context, cfg *AttachConfig) (err error
 	}
 
 	if cfg.Passthrough {
-		return nil
+		return c.waitPassthrough(ctx, cfg)
+	}
+
+	if len(cfg.Transforms) > 0 {
+		transformed := *cfg
+		var closeStreams func() error
+		transformed.Streams, closeStreams = cfg.transformStreams()
+		cfg = &transformed
+		defer func() {
+			if err := closeStreams(); err != nil {
+				c.logger.Errorf("Unable to close transformed streams: %v", err)
+			}
+		}()
+	}
+
+	// The recording wraps the transformed streams to record what gets
+	// exchanged with the container.
+	if cfg.Recording != nil {
+		recorder, err := newAttachRecorder(cfg.Recording)
+		if err != nil {
+			return fmt.Errorf("start recording: %w", err)
+		}
+		recorded := *cfg
+		recorded.Streams = recorder.trackStreams(cfg.Streams)
+		cfg = &recorded
+		defer func() {
+			if err := recorder.close(); err != nil {
+				c.logger.Errorf("Unable to record attach session: %v", err)
+			}
+		}()
+	}
+
+	// The counters of the output bytes dropped by the buffers.
+	var dropped []*uint64
+
+	if cfg.OnSessionEnd != nil {
+		counted := *cfg
+		stats := &attachStats{start: time.Now()}
+		counted.Streams = stats.countStreams(cfg.Streams)
+		cfg = &counted
+		dropped = append(dropped, &stats.bytesDropped)
+		defer func() {
+			cfg.OnSessionEnd(stats.summary(err))
+		}()
+	}
+
+	if c.metrics != nil {
+		metered := *cfg
+		var endSession func()
+		metered.Streams, endSession = c.metrics.startAttachSession(cfg.Streams)
+		cfg = &metered
+		defer endSession()
+		dropped = append(dropped, &c.metrics.attach.bytesDropped)
 	}
 
-	receiveStdoutError, stdinDone := c.setupStdioChannels(cfg, conn)
+	if c.sloReporter != nil {
+		measured := *cfg
+		kind := SLOSessionKindAttach
+		if cfg.ExecSession != "" {
+			kind = SLOSessionKindExec
+		}
+		var endSession func()
+		measured.Streams, endSession = c.sloReporter.startSession(kind, cfg.Streams)
+		cfg = &measured
+		defer endSession()
+	}
+
+	timeout := newAttachTimeout(cfg)
+	if timeout != nil {
+		limited := *cfg
+		limited.Streams = timeout.trackStreams(cfg.Streams)
+		cfg = &limited
+		defer timeout.watch(conn)()
+	}
+
+	if activity := newAttachActivity(cfg); activity != nil {
+		tracked := *cfg
+		tracked.Streams = activity.trackStreams(cfg.Streams)
+		cfg = &tracked
+		defer activity.watch()()
+	}
+
+	// The buffers have to wrap the streams last to be flushed after the
+	// output ended.
+	if cfg.OutputBufferSize > 0 {
+		buffered := *cfg
+		buffered.Streams = bufferStreams(cfg, dropped)
+		cfg = &buffered
+	}
+
+	receiveStdoutError, stdinDone := c.setupStdioChannels(ctx, cfg, conn)
 	if cfg.PostAttachFunc != nil {
 		if err := cfg.PostAttachFunc(); err != nil {
 			return fmt.Errorf("run post attach func: %w", err)
 		}
 	}
 
-	if err := c.readStdio(cfg, conn, receiveStdoutError, stdinDone); err != nil {
+	err = c.readStdio(cfg, conn, receiveStdoutError, stdinDone)
+
+	// Closing the connection on expiry ends the session like a regular exit.
+	if timeoutErr := timeout.err(); timeoutErr != nil {
+		return timeoutErr
+	}
+
+	if err != nil {
 		return fmt.Errorf("read stdio: %w", err)
 	}
 
 	return nil
 }
 
+// waitPassthrough blocks until the container of a passthrough session exited,
+// if requested.
+func (c *ConmonClient) waitPassthrough(ctx context.Context, cfg *AttachConfig) error {
+	if !cfg.WaitForExit || cfg.ExecSession != "" {
+		return nil
+	}
+
+	result, err := c.WaitContainer(ctx, cfg.ID)
+	if err != nil {
+		return fmt.Errorf("wait for container exit: %w", err)
+	}
+
+	if cfg.OnExit != nil {
+		cfg.OnExit(result)
+	}
+
+	return nil
+}
+
 func (c *ConmonClient) setupStdioChannels(
-	cfg *AttachConfig, conn *net.UnixConn,
+	ctx context.Context, cfg *AttachConfig, conn attachConn,
 ) (receiveStdoutError, stdinDone chan error) {
-	receiveStdoutError = make(chan error)
+	// The channels are buffered to not leak the goroutines if the session
+	// ends before they are done.
+	receiveStdoutError = make(chan error, 1)
 	go func() {
-		receiveStdoutError <- c.redirectResponseToOutputStreams(cfg, conn)
+		_, end := c.startSpan(ctx, "AttachContainer/output")
+		err := c.redirectResponseToOutputStreams(cfg, conn)
+		if flushErr := flushOutputBuffers(cfg.Streams); err == nil && flushErr != nil {
+			err = fmt.Errorf("flush output: %w", flushErr)
+		}
+		end(&err)
+		receiveStdoutError <- err
 	}()
 
-	stdinDone = make(chan error)
+	stdinDone = make(chan error, 1)
 	go func() {
 		var err error
 		if cfg.Streams.Stdin != nil {
-			_, err = utils.CopyDetachable(conn, cfg.Streams.Stdin, cfg.DetachKeys)
+			_, end := c.startSpan(ctx, "AttachContainer/stdin")
+			stdin := newStdinWriter(conn, cfg.StdinStallTimeout)
+			_, err = copyDetachable(stdin, cfg.Streams.Stdin, cfg.DetachKeys)
+			end(&err)
 		}
 		stdinDone <- err
 	}()
@@ -208,11 +747,18 @@ func (c *ConmonClient) setupStdioChannels(
 
 func (c *ConmonClient) redirectResponseToOutputStreams(cfg *AttachConfig, conn io.Reader) (err error) {
 	buf := make([]byte, attachPacketBufSize+1) /* Sync with conmonrs ATTACH_PACKET_BUF_SIZE */
+	// The end packet is the last one before the server closes the session.
+	var endErr *AttachEndError
 	for {
 		nr, er := conn.Read(buf)
-		if nr > 0 {
+		if nr > 0 && cfg.ReportSessionEnd && buf[0] == attachPipeEnd {
+			if endErr, err = parseEndPacket(buf[:nr]); err != nil {
+				break
+			}
+		} else if nr > 0 {
 			var dst io.Writer
 			var doWrite bool
+			reason := UnroutablePacketReasonNoDestination
 			switch buf[0] {
 			case attachPipeStdout:
 				dst = cfg.Streams.Stdout
@@ -222,19 +768,37 @@ func (c *ConmonClient) redirectResponseToOutputStreams(cfg *AttachConfig, conn i
 				doWrite = cfg.Streams.Stderr != nil
 			default:
 				c.logger.Infof("Received unexpected attach type %+d", buf[0])
+				reason = UnroutablePacketReasonUnknownType
 			}
-			if dst == nil {
-				return errOutputDestNil
+
+			header := 1
+			routed := false
+			if cfg.SequencedOutput && reason == UnroutablePacketReasonNoDestination {
+				if ew := handleSequencedPacket(cfg, buf[:nr]); ew != nil {
+					err = ew
+
+					break
+				}
+				header += attachSequenceSize
+				routed = cfg.OnOutput != nil
+			}
+
+			if !doWrite && !routed {
+				if ew := c.handleUnroutablePacket(cfg, reason, buf[:nr]); ew != nil {
+					err = ew
+
+					break
+				}
 			}
 
 			if doWrite {
-				nw, ew := dst.Write(buf[1:nr])
+				nw, ew := dst.Write(buf[header:nr])
 				if ew != nil {
 					err = ew
 
 					break
 				}
-				if nr != nw+1 {
+				if nr != nw+header {
 					err = io.ErrShortWrite
 
 					break
@@ -255,11 +819,126 @@ func (c *ConmonClient) redirectResponseToOutputStreams(cfg *AttachConfig, conn i
 		return fmt.Errorf("redirect response to output streams: %w", err)
 	}
 
+	if endErr != nil {
+		return endErr
+	}
+
+	return nil
+}
+
+// attachStats collects the statistics of an attach session.
+type attachStats struct {
+	start        time.Time
+	bytesIn      uint64
+	bytesOut     uint64
+	bytesDropped uint64
+}
+
+// countStreams wraps the streams to count the transferred bytes.
+func (a *attachStats) countStreams(streams AttachStreams) AttachStreams {
+	if streams.Stdin != nil {
+		streams.Stdin = &In{countingReader{streams.Stdin.Reader, &a.bytesIn}}
+	}
+
+	if streams.Stdout != nil {
+		streams.Stdout = &Out{countingWriteCloser{streams.Stdout.WriteCloser, &a.bytesOut}}
+	}
+
+	if streams.Stderr != nil {
+		streams.Stderr = &Out{countingWriteCloser{streams.Stderr.WriteCloser, &a.bytesOut}}
+	}
+
+	return streams
+}
+
+func (a *attachStats) summary(err error) *AttachSessionSummary {
+	summary := &AttachSessionSummary{
+		Duration:     time.Since(a.start),
+		BytesIn:      atomic.LoadUint64(&a.bytesIn),
+		BytesOut:     atomic.LoadUint64(&a.bytesOut),
+		BytesDropped: atomic.LoadUint64(&a.bytesDropped),
+		Reason:       AttachSessionEndReasonExit,
+		LastError:    err,
+	}
+
+	switch {
+	case errors.Is(err, ErrDetach):
+		summary.Reason = AttachSessionEndReasonDetach
+	case errors.Is(err, ErrAttachIdleTimeout), errors.Is(err, ErrAttachMaxDuration):
+		summary.Reason = AttachSessionEndReasonTimeout
+	case errors.Is(err, ErrContainerRemoved):
+		summary.Reason = AttachSessionEndReasonRemoved
+	case err != nil:
+		summary.Reason = AttachSessionEndReasonError
+	}
+
+	return summary
+}
+
+// countingReader counts the bytes read from the wrapped reader.
+type countingReader struct {
+	io.Reader
+	count *uint64
+}
+
+func (c countingReader) Read(p []byte) (int, error) {
+	n, err := c.Reader.Read(p)
+	atomic.AddUint64(c.count, uint64(n))
+
+	// nolint:wrapcheck // keep the io.Reader semantics
+	return n, err
+}
+
+// countingWriteCloser counts the bytes written to the wrapped writer.
+type countingWriteCloser struct {
+	io.WriteCloser
+	count *uint64
+}
+
+func (c countingWriteCloser) Write(p []byte) (int, error) {
+	n, err := c.WriteCloser.Write(p)
+	atomic.AddUint64(c.count, uint64(n))
+
+	// nolint:wrapcheck // keep the io.Writer semantics
+	return n, err
+}
+
+// handleUnroutablePacket applies the UnroutablePacketPolicy of the config to
+// a packet which cannot be written to an output stream.
+func (c *ConmonClient) handleUnroutablePacket(
+	cfg *AttachConfig, reason UnroutablePacketReason, frame []byte,
+) error {
+	switch cfg.UnroutablePacketPolicy {
+	case UnroutablePacketPolicyCallback:
+		if cfg.OnUnroutablePacket == nil {
+			return errUnroutableCallbackNil
+		}
+
+		if err := cfg.OnUnroutablePacket(&UnroutablePacket{
+			Reason: reason,
+			Type:   frame[0],
+			Frame:  frame,
+		}); err != nil {
+			return fmt.Errorf("handle unroutable packet: %w", err)
+		}
+
+	case UnroutablePacketPolicyIgnore:
+		if reason == UnroutablePacketReasonUnknownType {
+			c.logger.Debugf("Ignoring attach packet of unknown type %d", frame[0])
+		}
+
+	case UnroutablePacketPolicyError:
+		// Packets of nil streams are always dropped.
+		if reason == UnroutablePacketReasonUnknownType {
+			return fmt.Errorf("%w: unknown packet type %d", errOutputDestNil, frame[0])
+		}
+	}
+
 	return nil
 }
 
 func (c *ConmonClient) readStdio(
-	cfg *AttachConfig, conn *net.UnixConn, receiveStdoutError, stdinDone chan error,
+	cfg *AttachConfig, conn attachConn, receiveStdoutError, stdinDone chan error,
 ) error {
 	var err error
 	select {
@@ -283,13 +962,23 @@ func (c *ConmonClient) readStdio(
 		if cfg.StopAfterStdinEOF {
 			return nil
 		}
-		if errors.Is(err, define.ErrDetach) {
+		if errors.Is(err, ErrDetach) {
+			c.stopOutput(conn, receiveStdoutError)
+			if cfg.OnDetach != nil {
+				cfg.OnDetach()
+			}
+
 			if closeErr := conn.CloseWrite(); closeErr != nil {
 				return fmt.Errorf("%v: %w", closeErr, err)
 			}
 
 			return err
 		}
+		if errors.Is(err, ErrStdinStalled) {
+			c.stopOutput(conn, receiveStdoutError)
+
+			return err
+		}
 		if err == nil {
 			// copy stdin is done, close it
 			if connErr := conn.CloseWrite(); connErr != nil {
@@ -304,27 +993,59 @@ func (c *ConmonClient) readStdio(
 	return nil
 }
 
+// stopOutput stops reading the output from the connection and waits until
+// nothing gets written to the output streams any more.
+func (c *ConmonClient) stopOutput(conn attachConn, receiveStdoutError chan error) {
+	if err := conn.CloseRead(); err != nil {
+		c.logger.Debugf("Unable to close reading side of conn: %v", err)
+	}
+
+	if err := <-receiveStdoutError; err != nil {
+		c.logger.Debugf("Output stopped with error: %v", err)
+	}
+}
+
 // SetWindowSizeContainerConfig is the configuration for calling the SetWindowSizeContainer method.
 type SetWindowSizeContainerConfig struct {
 	// ID specifies the container ID.
 	ID string
 
+	// ExecSession ID, if the terminal of an exec session should be resized.
+	ExecSession string
+
 	// Size is the new terminal size.
-	Size *define.TerminalSize
+	Size *TerminalSize
 }
 
 // SetWindowSizeContainer can be used to change the window size of a running container.
 func (c *ConmonClient) SetWindowSizeContainer(ctx context.Context, cfg *SetWindowSizeContainerConfig) error {
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return err
+	}
+
 	if cfg.Size == nil {
 		return errTerminalSizeNil
 	}
 
-	conn, err := c.newRPCConn()
+	id, err := c.containerID(ctx, cfg.ID)
+	if err != nil {
+		return err
+	}
+
+	execSession, err := c.execSessionID(cfg.ExecSession)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
 	if err != nil {
 		return fmt.Errorf("create RPC connection: %w", err)
 	}
 	defer conn.Close()
-	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("SetWindowSizeContainer")()
+	diag := c.diagnoseRPC("SetWindowSizeContainer")
 
 	future, free := client.SetWindowSizeContainer(ctx, func(p proto.Conmon_setWindowSizeContainer_Params) error {
 		req, err := p.NewRequest()
@@ -332,22 +1053,171 @@ func (c *ConmonClient) SetWindowSizeContainer(ctx context.Context, cfg *SetWindo
 			return fmt.Errorf("create request: %w", err)
 		}
 
-		if err := req.SetId(cfg.ID); err != nil {
+		if err := req.SetId(id); err != nil {
 			return fmt.Errorf("set ID: %w", err)
 		}
 
+		if err := req.SetExecSessionId(execSession); err != nil {
+			return fmt.Errorf("set exec session ID: %w", err)
+		}
+
 		req.SetWidth(cfg.Size.Width)
 		req.SetHeight(cfg.Size.Height)
 
 		if err := p.SetRequest(req); err != nil {
 			return fmt.Errorf("set request: %w", err)
 		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	if _, err := result.Response(); err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	return nil
+}
+
+// AttachSession is a client connected to the attach socket of a container.
+type AttachSession struct {
+	// ID is the unique session identifier.
+	ID string
+
+	// SocketPath is the path of the attach socket the session is connected to.
+	SocketPath string
+
+	// ConnectedAt is the time when the session got connected.
+	ConnectedAt time.Time
+
+	// UID is the user ID of the connected peer.
+	UID uint32
+
+	// GID is the group ID of the connected peer.
+	GID uint32
+
+	// PID is the process ID of the connected peer, zero if not available.
+	PID int32
+}
+
+// ListAttachSessions can be used to retrieve all sessions currently attached
+// to a running container.
+func (c *ConmonClient) ListAttachSessions(ctx context.Context, id string) ([]AttachSession, error) {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("ListAttachSessions")()
+	diag := c.diagnoseRPC("ListAttachSessions")
+
+	future, free := client.ListAttachSessions(ctx, func(p proto.Conmon_listAttachSessions_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	sessionList, err := response.Sessions()
+	if err != nil {
+		return nil, fmt.Errorf("get sessions: %w", err)
+	}
+
+	sessions := make([]AttachSession, 0, sessionList.Len())
+	for i := 0; i < sessionList.Len(); i++ {
+		session := sessionList.At(i)
+
+		sessionID, err := session.Id()
+		if err != nil {
+			return nil, fmt.Errorf("get session ID: %w", err)
+		}
+
+		socketPath, err := session.SocketPath()
+		if err != nil {
+			return nil, fmt.Errorf("get socket path: %w", err)
+		}
+
+		sessions = append(sessions, AttachSession{
+			ID:          sessionID,
+			SocketPath:  socketPath,
+			ConnectedAt: time.Unix(int64(session.ConnectedAt()), 0),
+			UID:         session.Uid(),
+			GID:         session.Gid(),
+			PID:         session.Pid(),
+		})
+	}
+
+	return sessions, nil
+}
+
+// KillAttachSession can be used to forcibly disconnect an attach session.
+func (c *ConmonClient) KillAttachSession(ctx context.Context, sessionID string) error {
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("KillAttachSession")()
+	diag := c.diagnoseRPC("KillAttachSession")
+
+	future, free := client.KillAttachSession(ctx, func(p proto.Conmon_killAttachSession_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetSessionId(sessionID); err != nil {
+			return fmt.Errorf("set session ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
 
 		return nil
 	})
 	defer free()
 
 	result, err := future.Struct()
+	diag.report(ctx, result, err)
 	if err != nil {
 		return fmt.Errorf("create result: %w", err)
 	}
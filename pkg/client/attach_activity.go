@@ -0,0 +1,154 @@
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// AttachActivityState is the activity state of an attach session.
+type AttachActivityState int
+
+const (
+	// AttachActivityActive indicates that bytes have been transferred
+	// recently.
+	AttachActivityActive AttachActivityState = iota
+
+	// AttachActivityIdle indicates that no bytes have been transferred for
+	// the IdleNotifyAfter duration of the AttachConfig.
+	AttachActivityIdle
+)
+
+// String returns the name of the state.
+func (s AttachActivityState) String() string {
+	switch s {
+	case AttachActivityActive:
+		return "active"
+	case AttachActivityIdle:
+		return "idle"
+	default:
+		return "unknown"
+	}
+}
+
+// AttachActivityChange is a transition of an attach session between the
+// active and idle states.
+type AttachActivityChange struct {
+	// State is the new state of the session.
+	State AttachActivityState
+
+	// LastActivity is the time bytes have been transferred last, which is
+	// the time the session became active for AttachActivityActive.
+	LastActivity time.Time
+}
+
+// attachActivity notifies about transitions of an attach session between the
+// active and idle states.
+type attachActivity struct {
+	idleAfter time.Duration
+	onChange  func(*AttachActivityChange)
+
+	// lastActivity is the time of the last transfer in Unix nanoseconds.
+	lastActivity int64
+
+	// idle is 1 if the session is idle, which is only changed while holding
+	// mu to serialize the notifications.
+	idle int32
+	mu   sync.Mutex
+
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// newAttachActivity creates an attachActivity for the config, nil if no
+// notifications are requested.
+func newAttachActivity(cfg *AttachConfig) *attachActivity {
+	if cfg.IdleNotifyAfter <= 0 || cfg.OnActivityChange == nil {
+		return nil
+	}
+
+	return &attachActivity{
+		idleAfter:    cfg.IdleNotifyAfter,
+		onChange:     cfg.OnActivityChange,
+		lastActivity: time.Now().UnixNano(),
+		done:         make(chan struct{}),
+	}
+}
+
+// trackStreams wraps the streams to record their activity.
+func (a *attachActivity) trackStreams(streams AttachStreams) AttachStreams {
+	if streams.Stdin != nil {
+		streams.Stdin = &In{activityReader{streams.Stdin.Reader, a}}
+	}
+
+	if streams.Stdout != nil {
+		streams.Stdout = &Out{activityWriteCloser{streams.Stdout.WriteCloser, a}}
+	}
+
+	if streams.Stderr != nil {
+		streams.Stderr = &Out{activityWriteCloser{streams.Stderr.WriteCloser, a}}
+	}
+
+	return streams
+}
+
+// touch records activity of the session, notifying if it was idle.
+func (a *attachActivity) touch() {
+	now := time.Now()
+	atomic.StoreInt64(&a.lastActivity, now.UnixNano())
+
+	if atomic.LoadInt32(&a.idle) == 0 {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if atomic.LoadInt32(&a.idle) == 1 {
+		atomic.StoreInt32(&a.idle, 0)
+		a.onChange(&AttachActivityChange{State: AttachActivityActive, LastActivity: now})
+	}
+}
+
+// watch notifies once the session becomes idle. The returned function stops
+// watching.
+func (a *attachActivity) watch() func() {
+	go func() {
+		for {
+			timer := time.NewTimer(a.check())
+			select {
+			case <-a.done:
+				timer.Stop()
+
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+
+	return func() {
+		a.stopOnce.Do(func() { close(a.done) })
+	}
+}
+
+// check notifies if the session became idle and returns the time until it
+// has to be checked again.
+func (a *attachActivity) check() time.Duration {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	lastActivity := time.Unix(0, atomic.LoadInt64(&a.lastActivity))
+	remaining := a.idleAfter - time.Since(lastActivity)
+	if remaining > 0 {
+		return remaining
+	}
+
+	if atomic.LoadInt32(&a.idle) == 0 {
+		atomic.StoreInt32(&a.idle, 1)
+		a.onChange(&AttachActivityChange{State: AttachActivityIdle, LastActivity: lastActivity})
+	}
+
+	// Idle sessions become active by transfers, which do not need to be
+	// polled.
+	return a.idleAfter
+}
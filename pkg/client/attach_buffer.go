@@ -0,0 +1,246 @@
+package client
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// OutputBufferPolicy specifies how the output of an attach session is handled
+// if the OutputBufferSize of a stream is exhausted.
+type OutputBufferPolicy int
+
+const (
+	// OutputBufferPolicyBlock stops reading the output until the stream
+	// caught up, which eventually blocks the container if it writes to its
+	// standard output or error.
+	OutputBufferPolicyBlock OutputBufferPolicy = iota
+
+	// OutputBufferPolicyDropOldest discards the oldest buffered output to
+	// make room for the new one.
+	OutputBufferPolicyDropOldest
+
+	// OutputBufferPolicyDropNewest discards the new output which does not
+	// fit into the buffer.
+	OutputBufferPolicyDropNewest
+)
+
+// bufferStreams buffers the output streams of the config in ring buffers of
+// its OutputBufferSize. Dropped bytes are added to the counters.
+func bufferStreams(cfg *AttachConfig, dropped []*uint64) AttachStreams {
+	streams := cfg.Streams
+
+	if streams.Stdout != nil {
+		streams.Stdout = &Out{newOutputBuffer(
+			streams.Stdout.WriteCloser, cfg.OutputBufferSize, cfg.OutputBufferPolicy, dropped,
+		)}
+	}
+
+	if streams.Stderr != nil {
+		streams.Stderr = &Out{newOutputBuffer(
+			streams.Stderr.WriteCloser, cfg.OutputBufferSize, cfg.OutputBufferPolicy, dropped,
+		)}
+	}
+
+	return streams
+}
+
+// flushOutputBuffers waits until the buffered output of the streams has been
+// written.
+func flushOutputBuffers(streams AttachStreams) error {
+	for _, out := range []*Out{streams.Stdout, streams.Stderr} {
+		if out == nil {
+			continue
+		}
+
+		if buffer, ok := out.WriteCloser.(*outputBuffer); ok {
+			if err := buffer.flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// outputBuffer decouples reading the output of an attach session from
+// writing it to a stream, which may be slow. The output is written to the
+// stream in the background.
+type outputBuffer struct {
+	dst     io.WriteCloser
+	policy  OutputBufferPolicy
+	dropped []*uint64
+
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	// buf is a ring buffer of size bytes starting at start.
+	buf   []byte
+	start int
+	size  int
+
+	flushed bool
+	err     error
+	done    chan struct{}
+}
+
+func newOutputBuffer(
+	dst io.WriteCloser, size int, policy OutputBufferPolicy, dropped []*uint64,
+) *outputBuffer {
+	b := &outputBuffer{
+		dst:     dst,
+		policy:  policy,
+		dropped: dropped,
+		buf:     make([]byte, size),
+		done:    make(chan struct{}),
+	}
+	b.cond = sync.NewCond(&b.mu)
+
+	go b.drain()
+
+	return b
+}
+
+// Write adds p to the buffer according to the policy. Dropped bytes are
+// reported as written to not end the session.
+func (b *outputBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	written := 0
+	for len(p) > 0 {
+		if b.err != nil {
+			// nolint:wrapcheck // keep the io.Writer semantics
+			return written, b.err
+		}
+
+		if b.flushed {
+			return written, io.ErrClosedPipe
+		}
+
+		free := len(b.buf) - b.size
+		if len(p) > free {
+			switch b.policy {
+			case OutputBufferPolicyDropNewest:
+				b.drop(len(p) - free)
+				written += len(p) - free
+				p = p[:free]
+
+			case OutputBufferPolicyDropOldest:
+				if len(p) > len(b.buf) {
+					b.drop(len(p) - len(b.buf))
+					written += len(p) - len(b.buf)
+					p = p[len(p)-len(b.buf):]
+				}
+				discard := len(p) - free
+				b.drop(discard)
+				b.start = (b.start + discard) % len(b.buf)
+				b.size -= discard
+
+			case OutputBufferPolicyBlock:
+				if free == 0 {
+					b.cond.Wait()
+
+					continue
+				}
+			}
+		}
+
+		n := b.push(p)
+		written += n
+		p = p[n:]
+		b.cond.Broadcast()
+	}
+
+	return written, nil
+}
+
+// push copies as much of p into the buffer as fits.
+func (b *outputBuffer) push(p []byte) int {
+	written := 0
+	for len(p) > 0 && b.size < len(b.buf) {
+		end := (b.start + b.size) % len(b.buf)
+		limit := len(b.buf)
+		if end < b.start {
+			limit = b.start
+		}
+
+		n := copy(b.buf[end:limit], p)
+		b.size += n
+		written += n
+		p = p[n:]
+	}
+
+	return written
+}
+
+func (b *outputBuffer) drop(n int) {
+	for _, counter := range b.dropped {
+		atomic.AddUint64(counter, uint64(n))
+	}
+}
+
+// drain writes the buffered output to the stream until the buffer got
+// flushed or writing failed.
+func (b *outputBuffer) drain() {
+	defer close(b.done)
+
+	chunk := make([]byte, len(b.buf))
+	for {
+		b.mu.Lock()
+		for b.size == 0 && !b.flushed {
+			b.cond.Wait()
+		}
+
+		if b.size == 0 {
+			b.mu.Unlock()
+
+			return
+		}
+
+		n := b.size
+		if b.start+n > len(b.buf) {
+			n = len(b.buf) - b.start
+		}
+		copy(chunk, b.buf[b.start:b.start+n])
+		b.start = (b.start + n) % len(b.buf)
+		b.size -= n
+		b.cond.Broadcast()
+		b.mu.Unlock()
+
+		if _, err := b.dst.Write(chunk[:n]); err != nil {
+			b.mu.Lock()
+			b.err = err
+			b.cond.Broadcast()
+			b.mu.Unlock()
+
+			return
+		}
+	}
+}
+
+// flush waits until the buffered output has been written and returns the
+// error of writing it, if any. Writing to the buffer afterwards fails.
+func (b *outputBuffer) flush() error {
+	b.mu.Lock()
+	b.flushed = true
+	b.cond.Broadcast()
+	b.mu.Unlock()
+
+	<-b.done
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.err
+}
+
+// Close flushes the buffer and closes the stream.
+func (b *outputBuffer) Close() error {
+	if err := b.flush(); err != nil {
+		return err
+	}
+
+	// nolint:wrapcheck // keep the io.Closer semantics
+	return b.dst.Close()
+}
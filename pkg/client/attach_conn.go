@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// AttachConnConfig is the configuration for calling the AttachConn method.
+type AttachConnConfig struct {
+	// ID of the container.
+	ID string
+
+	// Path of the attach socket.
+	SocketPath string
+
+	// ExecSession ID, if this is an attach for an Exec.
+	ExecSession string
+
+	// SeparateStderr indicates that the standard error of the session should
+	// be provided by the Stderr reader rather than being merged into Read.
+	SeparateStderr bool
+
+	// SimulateTerminal runs a pseudo terminal on the server in front of the
+	// standard streams, see AttachConfig.
+	SimulateTerminal bool
+}
+
+// AttachConn is an attach session exposed as a single io.ReadWriteCloser.
+// Writes go to the standard input of the session while reads return its
+// standard output. The connection gets closed if the context used to create
+// it is done.
+type AttachConn struct {
+	conn      attachConn
+	stdout    *io.PipeReader
+	stderr    *io.PipeReader
+	done      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// AttachConn attaches to a running container and returns the session as an
+// io.ReadWriteCloser, which can be used by libraries expecting a connection
+// like object. Stream transformations are not supported, because the
+// returned connection can be wrapped directly instead.
+func (c *ConmonClient) AttachConn(ctx context.Context, cfg *AttachConnConfig) (*AttachConn, error) {
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.attachContainer(ctx, &AttachConfig{
+		ID:               cfg.ID,
+		SocketPath:       cfg.SocketPath,
+		ExecSession:      cfg.ExecSession,
+		SimulateTerminal: cfg.SimulateTerminal,
+	}); err != nil {
+		return nil, err
+	}
+
+	conn, err := c.dialAttach(ctx, cfg.SocketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	stdoutReader, stdoutWriter := io.Pipe()
+	stderrWriter := stdoutWriter
+	a := &AttachConn{
+		conn:   conn,
+		stdout: stdoutReader,
+		done:   make(chan struct{}),
+	}
+	if cfg.SeparateStderr {
+		a.stderr, stderrWriter = io.Pipe()
+	}
+
+	go a.demultiplex(stdoutWriter, stderrWriter)
+	go func() {
+		select {
+		case <-ctx.Done():
+			stdoutWriter.CloseWithError(ctx.Err())
+			stderrWriter.CloseWithError(ctx.Err())
+			if err := a.Close(); err != nil {
+				c.logger.Errorf("Unable to close attach connection: %v", err)
+			}
+		case <-a.done:
+		}
+	}()
+
+	return a, nil
+}
+
+// demultiplex splits the attach packets into the standard output and error
+// pipes until the connection gets closed.
+func (a *AttachConn) demultiplex(stdout, stderr *io.PipeWriter) {
+	buf := make([]byte, attachPacketBufSize+1) /* Sync with conmonrs ATTACH_PACKET_BUF_SIZE */
+	for {
+		nr, err := a.conn.Read(buf)
+		if nr > 0 {
+			dst := stdout
+			if buf[0] == attachPipeStderr {
+				dst = stderr
+			}
+			if _, ew := dst.Write(buf[1:nr]); ew != nil {
+				err = ew
+			}
+		}
+		if err != nil {
+			stdout.CloseWithError(err)
+			stderr.CloseWithError(err)
+
+			return
+		}
+	}
+}
+
+// Read reads from the standard output of the session. It also contains the
+// standard error if SeparateStderr has not been set.
+func (a *AttachConn) Read(p []byte) (int, error) {
+	// nolint:wrapcheck // io.EOF must not be wrapped
+	return a.stdout.Read(p)
+}
+
+// Stderr returns the reader for the standard error of the session or nil if
+// SeparateStderr has not been set. The reader has to be consumed
+// concurrently to Read, otherwise the standard output will be blocked.
+func (a *AttachConn) Stderr() io.Reader {
+	if a.stderr == nil {
+		return nil
+	}
+
+	return a.stderr
+}
+
+// Write writes to the standard input of the session.
+func (a *AttachConn) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		size := len(p)
+		if size > attachPacketBufSize {
+			size = attachPacketBufSize
+		}
+
+		nw, ew := a.conn.Write(p[:size])
+		n += nw
+		if ew != nil {
+			// nolint:wrapcheck // keep the io.Writer semantics
+			return n, ew
+		}
+		p = p[size:]
+	}
+
+	return n, nil
+}
+
+// CloseWrite closes the standard input of the session while the output can
+// still be read.
+func (a *AttachConn) CloseWrite() error {
+	if err := a.conn.CloseWrite(); err != nil {
+		return fmt.Errorf("close write: %w", err)
+	}
+
+	return nil
+}
+
+// Close disconnects the session.
+func (a *AttachConn) Close() error {
+	a.closeOnce.Do(func() {
+		close(a.done)
+		a.stdout.Close()
+		if a.stderr != nil {
+			a.stderr.Close()
+		}
+		if err := a.conn.Close(); err != nil {
+			a.closeErr = fmt.Errorf("close connection: %w", err)
+		}
+	})
+
+	return a.closeErr
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+// attachPipeEnd is the type of the packet announcing the end of a session
+// with AttachConfig.ReportSessionEnd, which carries the reason followed by
+// a message.
+const attachPipeEnd = 4
+
+var (
+	// ErrContainerRemoved is matched by the AttachEndError of sessions with
+	// AttachConfig.ReportSessionEnd, which ended because the container or
+	// exec process exited or got stopped, and got removed from the server.
+	ErrContainerRemoved = errors.New("container removed")
+
+	// ErrSessionEndUnsupported is returned if the server does not support
+	// the AttachConfig.ReportSessionEnd.
+	ErrSessionEndUnsupported = errors.New("server does not support reporting the session end")
+
+	errEndPacketShort = errors.New("attach end packet too short")
+)
+
+// AttachEndReason is the reason why the server ended an attach session.
+type AttachEndReason byte
+
+const (
+	// AttachEndReasonExited indicates that the container or exec process
+	// exited.
+	AttachEndReasonExited AttachEndReason = 1
+
+	// AttachEndReasonStopped indicates that the container exited after
+	// getting stopped via StopContainer.
+	AttachEndReasonStopped AttachEndReason = 2
+
+	// AttachEndReasonKilled indicates that the session got killed via
+	// KillAttachSession.
+	AttachEndReasonKilled AttachEndReason = 3
+
+	// AttachEndReasonExpired indicates that the session reached the maximum
+	// duration of the session policy of the server.
+	AttachEndReasonExpired AttachEndReason = 4
+)
+
+// String returns the name of the reason.
+func (r AttachEndReason) String() string {
+	switch r {
+	case AttachEndReasonExited:
+		return "exited"
+	case AttachEndReasonStopped:
+		return "stopped"
+	case AttachEndReasonKilled:
+		return "killed"
+	case AttachEndReasonExpired:
+		return "expired"
+	}
+
+	return fmt.Sprintf("AttachEndReason(%d)", byte(r))
+}
+
+// AttachEndError is returned by AttachContainer for sessions with
+// AttachConfig.ReportSessionEnd, once the server ended them. It matches
+// ErrContainerRemoved if the process exited or got stopped.
+type AttachEndError struct {
+	// Reason is the reason why the server ended the session.
+	Reason AttachEndReason
+
+	// Message describes the end of the session, for example including the
+	// exit code.
+	Message string
+}
+
+func (e *AttachEndError) Error() string {
+	return fmt.Sprintf("attach session ended by server (%s): %s", e.Reason, e.Message)
+}
+
+// Is matches ErrContainerRemoved for the reasons of a removed process.
+func (e *AttachEndError) Is(target error) bool {
+	if target != ErrContainerRemoved {
+		return false
+	}
+
+	return e.Reason == AttachEndReasonExited || e.Reason == AttachEndReasonStopped
+}
+
+// parseEndPacket parses the packet announcing the end of a session.
+func parseEndPacket(packet []byte) (*AttachEndError, error) {
+	if len(packet) < 2 {
+		return nil, fmt.Errorf("%w: %d bytes", errEndPacketShort, len(packet))
+	}
+
+	return &AttachEndError{
+		Reason:  AttachEndReason(packet[1]),
+		Message: string(packet[2:]),
+	}, nil
+}
@@ -0,0 +1,511 @@
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	attachMuxSocketName = "conmon-attach.sock"
+
+	// attachMuxHeaderSize is the size of a frame header, which consists of
+	// the little endian uint32 session ID, the frame kind and the little
+	// endian uint32 payload length.
+	attachMuxHeaderSize = 9
+
+	// attachMuxQueueSize is the number of packets which get buffered per
+	// session. Sessions which do not read their output block all other
+	// sessions of the connection once the queue is full.
+	attachMuxQueueSize = 64
+
+	attachMuxFrameOpen       = 1
+	attachMuxFrameData       = 2
+	attachMuxFrameCloseWrite = 3
+	attachMuxFrameClose      = 4
+	attachMuxFrameResize     = 5
+	attachMuxFrameSignal     = 6
+
+	// attachMuxFeatureControl is set in the open acknowledgement of servers
+	// supporting the resize and signal frames.
+	attachMuxFeatureControl = 1
+)
+
+var (
+	errAttachMuxUnsupported = errors.New("attach multiplexing is not supported by the server")
+	errAttachMuxClosed      = errors.New("attach multiplexing connection closed")
+	errAttachMuxSession     = errors.New("attach session failed")
+	errAttachMuxFrameSize   = errors.New("attach frame exceeds the maximum size")
+	errAttachMuxNoControl   = errors.New("attach session control is not supported by the server")
+)
+
+// attachControl is implemented by attach connections which can resize and
+// signal the attached process without a separate RPC.
+type attachControl interface {
+	Resize(width, height uint16) error
+	Signal(signal syscall.Signal) error
+}
+
+// attachConn is a connection to an attach socket, which is either dialed
+// directly or a session of the multiplexed connection.
+type attachConn interface {
+	io.ReadWriteCloser
+	CloseRead() error
+	CloseWrite() error
+}
+
+// dialAttach connects to the attach socket. The multiplexed connection is
+// used if enabled, falling back to the attach socket if the server does not
+// support it.
+func (c *ConmonClient) dialAttach(ctx context.Context, socketPath string) (attachConn, error) {
+	if c.multiplexAttachSessions {
+		conn, err := c.openAttachMuxSession(ctx, socketPath)
+		if err == nil {
+			return conn, nil
+		}
+
+		if !errors.Is(err, errAttachMuxUnsupported) {
+			return nil, err
+		}
+
+		c.logger.Debugf("Using attach socket instead of multiplexing: %v", err)
+	}
+
+	conn, err := c.dialContext(ctx, "unixpacket", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to container's attach socket: %v: %w", socketPath, err)
+	}
+
+	return halfClose(conn), nil
+}
+
+// openAttachMuxSession opens a session on the multiplexed connection, which
+// gets established on first use and re-established if it broke.
+func (c *ConmonClient) openAttachMuxSession(ctx context.Context, socketPath string) (*attachMuxSession, error) {
+	mux, err := c.attachMux.get(func() (*attachMux, error) {
+		conn, err := c.dialContext(ctx, "unix", filepath.Join(c.runDir, attachMuxSocketName))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errAttachMuxUnsupported, err)
+		}
+
+		return newAttachMux(conn, c.logger), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mux.open(socketPath)
+}
+
+// sharedAttachMux holds the multiplexed connection of a client. It is shared
+// with the clients returned by WithTenant, which use the same connection.
+type sharedAttachMux struct {
+	mu  sync.Mutex
+	mux *attachMux
+}
+
+// get returns the multiplexed connection, which gets created if there is
+// none or the previous one broke.
+func (s *sharedAttachMux) get(create func() (*attachMux, error)) (*attachMux, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mux == nil || s.mux.isClosed() {
+		mux, err := create()
+		if err != nil {
+			return nil, err
+		}
+		s.mux = mux
+	}
+
+	return s.mux, nil
+}
+
+// close closes the multiplexed connection if there is one.
+func (s *sharedAttachMux) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mux == nil {
+		return nil
+	}
+
+	if err := s.mux.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		return fmt.Errorf("close attach multiplexing connection: %w", err)
+	}
+
+	return nil
+}
+
+// attachMux multiplexes the attach sessions of the client over a single
+// connection to the server. Control frames are written before queued data
+// frames, which keeps sessions responsive while standard input of other
+// sessions saturates the connection.
+type attachMux struct {
+	conn   net.Conn
+	logger *logrus.Logger
+
+	// control and data are the lanes of the frames to write.
+	control chan *attachMuxFrame
+	data    chan *attachMuxFrame
+	done    chan struct{}
+
+	mu       sync.Mutex
+	next     uint32
+	sessions map[uint32]*attachMuxSession
+	err      error
+}
+
+// attachMuxFrame is a frame to write, which reports the result of writing
+// it.
+type attachMuxFrame struct {
+	buf    []byte
+	result chan error
+}
+
+func newAttachMux(conn net.Conn, logger *logrus.Logger) *attachMux {
+	m := &attachMux{
+		conn:     conn,
+		logger:   logger,
+		control:  make(chan *attachMuxFrame),
+		data:     make(chan *attachMuxFrame),
+		done:     make(chan struct{}),
+		sessions: make(map[uint32]*attachMuxSession),
+	}
+	go m.receive()
+	go m.send()
+
+	return m
+}
+
+func (m *attachMux) isClosed() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.err != nil
+}
+
+// open opens a new session for the attach socket and waits until the
+// server acknowledged it.
+func (m *attachMux) open(socketPath string) (*attachMuxSession, error) {
+	m.mu.Lock()
+	if m.err != nil {
+		m.mu.Unlock()
+
+		return nil, m.err
+	}
+	m.next++
+	s := &attachMuxSession{
+		id:         m.next,
+		mux:        m,
+		opened:     make(chan error, 1),
+		packets:    make(chan []byte, attachMuxQueueSize),
+		readClosed: make(chan struct{}),
+		closed:     make(chan struct{}),
+	}
+	m.sessions[s.id] = s
+	m.mu.Unlock()
+
+	if err := m.write(s.id, attachMuxFrameOpen, []byte(socketPath)); err != nil {
+		m.remove(s.id)
+
+		return nil, err
+	}
+
+	if err := <-s.opened; err != nil {
+		m.remove(s.id)
+
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (m *attachMux) remove(id uint32) {
+	m.mu.Lock()
+	delete(m.sessions, id)
+	m.mu.Unlock()
+}
+
+// write writes a frame and waits until it has been written. Data and close
+// write frames use the data lane to keep their order, all others the control
+// lane.
+func (m *attachMux) write(id uint32, kind byte, payload []byte) error {
+	if len(payload) > attachPacketBufSize {
+		return fmt.Errorf("%w: %d bytes", errAttachMuxFrameSize, len(payload))
+	}
+
+	buf := make([]byte, attachMuxHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(buf, id)
+	buf[4] = kind
+	binary.LittleEndian.PutUint32(buf[5:], uint32(len(payload)))
+	copy(buf[attachMuxHeaderSize:], payload)
+
+	lane := m.control
+	if kind == attachMuxFrameData || kind == attachMuxFrameCloseWrite {
+		lane = m.data
+	}
+
+	frame := &attachMuxFrame{buf: buf, result: make(chan error, 1)}
+	select {
+	case lane <- frame:
+	case <-m.done:
+		return m.closedErr()
+	}
+
+	return <-frame.result
+}
+
+// send writes the queued frames, preferring the control lane, until the
+// connection breaks.
+func (m *attachMux) send() {
+	for {
+		var frame *attachMuxFrame
+		select {
+		case frame = <-m.control:
+		default:
+			select {
+			case frame = <-m.control:
+			case frame = <-m.data:
+			case <-m.done:
+				return
+			}
+		}
+
+		if _, err := m.conn.Write(frame.buf); err != nil {
+			frame.result <- fmt.Errorf("write attach frame: %w", err)
+
+			continue
+		}
+		frame.result <- nil
+	}
+}
+
+func (m *attachMux) closedErr() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.err
+}
+
+// receive dispatches the frames of the server to the sessions until the
+// connection breaks, which ends all sessions.
+func (m *attachMux) receive() {
+	header := make([]byte, attachMuxHeaderSize)
+	for {
+		if _, err := io.ReadFull(m.conn, header); err != nil {
+			m.fail(fmt.Errorf("%w: %v", errAttachMuxClosed, err))
+
+			return
+		}
+
+		size := binary.LittleEndian.Uint32(header[5:])
+		if size > attachPacketBufSize {
+			m.fail(fmt.Errorf("%w: %d bytes", errAttachMuxFrameSize, size))
+
+			return
+		}
+
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(m.conn, payload); err != nil {
+			m.fail(fmt.Errorf("%w: %v", errAttachMuxClosed, err))
+
+			return
+		}
+
+		m.mu.Lock()
+		s, ok := m.sessions[binary.LittleEndian.Uint32(header)]
+		m.mu.Unlock()
+		if !ok {
+			continue
+		}
+
+		switch header[4] {
+		case attachMuxFrameOpen:
+			s.control = len(payload) > 0 && payload[0]&attachMuxFeatureControl != 0
+			s.opened <- nil
+
+		case attachMuxFrameData:
+			s.deliver(payload)
+
+		case attachMuxFrameClose:
+			var err error
+			if len(payload) > 0 {
+				err = fmt.Errorf("%w: %s", errAttachMuxSession, payload)
+			}
+			m.remove(s.id)
+			s.finish(err)
+		}
+	}
+}
+
+// fail closes the connection and ends all sessions with the error.
+func (m *attachMux) fail(err error) {
+	m.logger.Debugf("Closing attach multiplexing connection: %v", err)
+	if closeErr := m.conn.Close(); closeErr != nil && !errors.Is(closeErr, net.ErrClosed) {
+		m.logger.Errorf("Unable to close attach multiplexing connection: %v", closeErr)
+	}
+
+	m.mu.Lock()
+	m.err = err
+	sessions := m.sessions
+	m.sessions = make(map[uint32]*attachMuxSession)
+	m.mu.Unlock()
+	close(m.done)
+
+	for _, s := range sessions {
+		s.finish(err)
+	}
+}
+
+// attachMuxSession is a single attach session of the multiplexed connection.
+// Every Read returns a single attach packet, like reading from the attach
+// socket.
+type attachMuxSession struct {
+	id  uint32
+	mux *attachMux
+
+	// control is set before opened if the server supports resize and signal
+	// frames.
+	control bool
+
+	// opened receives the result of opening the session once.
+	opened chan error
+
+	// packets gets closed by the receiver after the session ended.
+	packets chan []byte
+	err     error
+
+	readClosed     chan struct{}
+	readCloseOnce  sync.Once
+	closed         chan struct{}
+	closeOnce      sync.Once
+	finishOnce     sync.Once
+	closeWriteOnce sync.Once
+}
+
+// deliver queues a packet, which is dropped if the output is not read any
+// more.
+func (s *attachMuxSession) deliver(packet []byte) {
+	select {
+	case s.packets <- packet:
+	case <-s.readClosed:
+	case <-s.closed:
+	}
+}
+
+// finish ends the session, which has to be called by the receiver.
+func (s *attachMuxSession) finish(err error) {
+	s.finishOnce.Do(func() {
+		s.err = err
+		select {
+		case s.opened <- err:
+		default:
+		}
+		close(s.packets)
+	})
+}
+
+func (s *attachMuxSession) Read(p []byte) (int, error) {
+	select {
+	case packet, ok := <-s.packets:
+		if !ok {
+			if s.err != nil {
+				return 0, s.err
+			}
+
+			return 0, io.EOF
+		}
+
+		return copy(p, packet), nil
+
+	case <-s.readClosed:
+		return 0, io.EOF
+
+	case <-s.closed:
+		return 0, net.ErrClosed
+	}
+}
+
+func (s *attachMuxSession) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		size := len(p)
+		if size > attachPacketBufSize {
+			size = attachPacketBufSize
+		}
+
+		if err := s.mux.write(s.id, attachMuxFrameData, p[:size]); err != nil {
+			return n, err
+		}
+		n += size
+		p = p[size:]
+	}
+
+	return n, nil
+}
+
+// CloseRead stops reading the output of the session.
+func (s *attachMuxSession) CloseRead() error {
+	s.readCloseOnce.Do(func() {
+		close(s.readClosed)
+	})
+
+	return nil
+}
+
+// CloseWrite closes the standard input of the session.
+func (s *attachMuxSession) CloseWrite() (err error) {
+	s.closeWriteOnce.Do(func() {
+		err = s.mux.write(s.id, attachMuxFrameCloseWrite, nil)
+	})
+
+	return err
+}
+
+// Close closes the session, while the multiplexed connection stays open.
+func (s *attachMuxSession) Close() (err error) {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+		s.mux.remove(s.id)
+		if s.mux.isClosed() {
+			return
+		}
+		err = s.mux.write(s.id, attachMuxFrameClose, nil)
+	})
+
+	return err
+}
+
+// Resize resizes the terminal of the session via the control lane.
+func (s *attachMuxSession) Resize(width, height uint16) error {
+	if !s.control {
+		return errAttachMuxNoControl
+	}
+
+	payload := make([]byte, 4)
+	binary.LittleEndian.PutUint16(payload, width)
+	binary.LittleEndian.PutUint16(payload[2:], height)
+
+	return s.mux.write(s.id, attachMuxFrameResize, payload)
+}
+
+// Signal sends the signal to the process of the session via the control
+// lane.
+func (s *attachMuxSession) Signal(signal syscall.Signal) error {
+	if !s.control {
+		return errAttachMuxNoControl
+	}
+
+	payload := make([]byte, 4)
+	binary.LittleEndian.PutUint32(payload, uint32(int32(signal)))
+
+	return s.mux.write(s.id, attachMuxFrameSignal, payload)
+}
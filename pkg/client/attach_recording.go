@@ -0,0 +1,413 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// AttachRecordingFormat is the file format of an attach session recording.
+type AttachRecordingFormat int
+
+const (
+	// AttachRecordingFormatAsciicast records the session into a single
+	// asciicast v2 file, which can be replayed by asciinema.
+	AttachRecordingFormatAsciicast AttachRecordingFormat = iota
+
+	// AttachRecordingFormatRaw records the transferred bytes into the Path
+	// and their timing into the TimingPath in the advanced format of
+	// script(1), which can be replayed by scriptreplay(1).
+	AttachRecordingFormatRaw
+)
+
+// AttachRecordingSync specifies when a recording is synced to its storage.
+type AttachRecordingSync int
+
+const (
+	// AttachRecordingSyncOnClose syncs the files once the session ended.
+	AttachRecordingSyncOnClose AttachRecordingSync = iota
+
+	// AttachRecordingSyncNever leaves syncing to the operating system.
+	AttachRecordingSyncNever
+
+	// AttachRecordingSyncAlways syncs the files after every recorded event,
+	// which slows down the session.
+	AttachRecordingSyncAlways
+)
+
+// defaultRecordingWidth and defaultRecordingHeight are the terminal size of
+// asciicast recordings without a Width or Height.
+const (
+	defaultRecordingWidth  = 80
+	defaultRecordingHeight = 24
+)
+
+// AttachRecording configures the recording of an attach session, which
+// records the standard input and output with the time of their transfer.
+// Recording errors do not end the session, they stop the recording and get
+// logged instead.
+type AttachRecording struct {
+	// Path of the recording, which gets created or truncated.
+	Path string
+
+	// Format of the recording. Defaults to AttachRecordingFormatAsciicast.
+	Format AttachRecordingFormat
+
+	// TimingPath is the path of the timing file of raw recordings. Defaults
+	// to the Path with the suffix ".timing".
+	TimingPath string
+
+	// MaxBytes is the maximum size of the recording files in bytes. The
+	// recording stops with the first event exceeding it, which keeps the
+	// recorded events complete. Zero disables the limit.
+	MaxBytes int64
+
+	// Sync specifies when the recording is synced to its storage. Defaults
+	// to AttachRecordingSyncOnClose.
+	Sync AttachRecordingSync
+
+	// Width and Height are the terminal size in the header of asciicast
+	// recordings. They default to 80 columns and 24 rows.
+	Width, Height uint16
+}
+
+// Stream codes of the recorded events, which match the asciicast event
+// types.
+const (
+	recordingInput  = "i"
+	recordingOutput = "o"
+)
+
+// attachRecorder records the streams of an attach session.
+type attachRecorder struct {
+	cfg AttachRecording
+
+	mu      sync.Mutex
+	data    *os.File
+	timing  *os.File
+	start   time.Time
+	last    time.Time
+	written int64
+	stopped bool
+	err     error
+
+	// pending are the bytes of incomplete UTF-8 sequences per stream, which
+	// are recorded with the next event of asciicast recordings.
+	pending map[string][]byte
+}
+
+// newAttachRecorder creates the files of the recording and writes its
+// header.
+func newAttachRecorder(cfg *AttachRecording) (*attachRecorder, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("%w: recording without path", errInvalidValue)
+	}
+
+	r := &attachRecorder{
+		cfg:     *cfg,
+		start:   time.Now(),
+		pending: map[string][]byte{},
+	}
+	r.last = r.start
+
+	const perm = 0o600
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+
+	data, err := os.OpenFile(cfg.Path, flags, perm)
+	if err != nil {
+		return nil, fmt.Errorf("create recording: %w", err)
+	}
+	r.data = data
+
+	switch cfg.Format {
+	case AttachRecordingFormatAsciicast:
+		if err := r.writeHeader(); err != nil {
+			r.closeFiles()
+
+			return nil, err
+		}
+
+	case AttachRecordingFormatRaw:
+		timingPath := cfg.TimingPath
+		if timingPath == "" {
+			timingPath = cfg.Path + ".timing"
+		}
+
+		timing, err := os.OpenFile(timingPath, flags, perm)
+		if err != nil {
+			r.closeFiles()
+
+			return nil, fmt.Errorf("create recording timing: %w", err)
+		}
+		r.timing = timing
+
+	default:
+		r.closeFiles()
+
+		return nil, fmt.Errorf("%w: recording format %d", errInvalidValue, cfg.Format)
+	}
+
+	return r, nil
+}
+
+// writeHeader writes the asciicast header line.
+func (r *attachRecorder) writeHeader() error {
+	header := struct {
+		Version   int   `json:"version"`
+		Width     int   `json:"width"`
+		Height    int   `json:"height"`
+		Timestamp int64 `json:"timestamp"`
+	}{
+		Version:   2,
+		Width:     defaultRecordingWidth,
+		Height:    defaultRecordingHeight,
+		Timestamp: r.start.Unix(),
+	}
+
+	if r.cfg.Width > 0 {
+		header.Width = int(r.cfg.Width)
+	}
+
+	if r.cfg.Height > 0 {
+		header.Height = int(r.cfg.Height)
+	}
+
+	line, err := json.Marshal(header)
+	if err != nil {
+		return fmt.Errorf("marshal recording header: %w", err)
+	}
+
+	if err := r.write(r.data, append(line, '\n')); err != nil {
+		return fmt.Errorf("write recording header: %w", err)
+	}
+
+	return nil
+}
+
+// trackStreams wraps the streams to record their transfers.
+func (r *attachRecorder) trackStreams(streams AttachStreams) AttachStreams {
+	if streams.Stdin != nil {
+		streams.Stdin = &In{recordingReader{streams.Stdin.Reader, r}}
+	}
+
+	if streams.Stdout != nil {
+		streams.Stdout = &Out{recordingWriteCloser{streams.Stdout.WriteCloser, r}}
+	}
+
+	if streams.Stderr != nil {
+		streams.Stderr = &Out{recordingWriteCloser{streams.Stderr.WriteCloser, r}}
+	}
+
+	return streams
+}
+
+// record records the bytes transferred via the stream.
+func (r *attachRecorder) record(stream string, p []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopped {
+		return
+	}
+
+	now := time.Now()
+	if err := r.recordEvent(now, stream, p); err != nil {
+		r.stop(err)
+
+		return
+	}
+
+	if r.cfg.Sync == AttachRecordingSyncAlways {
+		if err := r.sync(); err != nil {
+			r.stop(err)
+		}
+	}
+}
+
+// recordEvent writes a single event of the format.
+func (r *attachRecorder) recordEvent(now time.Time, stream string, p []byte) error {
+	if r.timing != nil {
+		delay := now.Sub(r.last).Seconds()
+		r.last = now
+		line := fmt.Sprintf("%s %s %d\n", stream, strconv.FormatFloat(delay, 'f', 6, 64), len(p))
+
+		if !r.fits(int64(len(p) + len(line))) {
+			return nil
+		}
+
+		if err := r.write(r.data, p); err != nil {
+			return fmt.Errorf("write recording: %w", err)
+		}
+
+		if err := r.write(r.timing, []byte(line)); err != nil {
+			return fmt.Errorf("write recording timing: %w", err)
+		}
+
+		return nil
+	}
+
+	data := append(r.pending[stream], p...)
+	data, r.pending[stream] = splitIncompleteRune(data)
+	if len(data) == 0 {
+		return nil
+	}
+
+	event, err := json.Marshal([]interface{}{now.Sub(r.start).Seconds(), stream, string(data)})
+	if err != nil {
+		return fmt.Errorf("marshal recording event: %w", err)
+	}
+	event = append(event, '\n')
+
+	if !r.fits(int64(len(event))) {
+		return nil
+	}
+
+	if err := r.write(r.data, event); err != nil {
+		return fmt.Errorf("write recording: %w", err)
+	}
+
+	return nil
+}
+
+// fits returns whether the bytes fit into the MaxBytes, stopping the
+// recording otherwise.
+func (r *attachRecorder) fits(n int64) bool {
+	if r.cfg.MaxBytes > 0 && r.written+n > r.cfg.MaxBytes {
+		r.stopped = true
+
+		return false
+	}
+
+	return true
+}
+
+func (r *attachRecorder) write(f *os.File, p []byte) error {
+	n, err := f.Write(p)
+	r.written += int64(n)
+
+	// nolint:wrapcheck // wrapped by the callers
+	return err
+}
+
+// stop stops the recording because of the error.
+func (r *attachRecorder) stop(err error) {
+	r.stopped = true
+	r.err = err
+}
+
+func (r *attachRecorder) sync() error {
+	for _, f := range []*os.File{r.data, r.timing} {
+		if f == nil {
+			continue
+		}
+
+		if err := f.Sync(); err != nil {
+			return fmt.Errorf("sync recording: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// close finishes the recording and closes its files. It returns the first
+// error of the recording.
+func (r *attachRecorder) close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Incomplete sequences at the end of a stream are recorded as they are.
+	if !r.stopped {
+		for stream, p := range r.pending {
+			if len(p) == 0 {
+				continue
+			}
+
+			delete(r.pending, stream)
+			if err := r.recordEvent(time.Now(), stream, p); err != nil {
+				r.stop(err)
+
+				break
+			}
+		}
+	}
+
+	if r.err == nil && r.cfg.Sync != AttachRecordingSyncNever {
+		r.err = r.sync()
+	}
+
+	if err := r.closeFiles(); err != nil && r.err == nil {
+		r.err = err
+	}
+
+	return r.err
+}
+
+func (r *attachRecorder) closeFiles() (err error) {
+	for _, f := range []*os.File{r.data, r.timing} {
+		if f == nil {
+			continue
+		}
+
+		if closeErr := f.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("close recording: %w", closeErr)
+		}
+	}
+
+	return err
+}
+
+// splitIncompleteRune splits an incomplete UTF-8 sequence off the end of p,
+// which is completed by the next transfer.
+func splitIncompleteRune(p []byte) (complete, rest []byte) {
+	for i := 1; i < utf8.UTFMax && i <= len(p); i++ {
+		start := len(p) - i
+		if !utf8.RuneStart(p[start]) {
+			continue
+		}
+
+		if !utf8.FullRune(p[start:]) {
+			return p[:start], append([]byte(nil), p[start:]...)
+		}
+
+		break
+	}
+
+	return p, nil
+}
+
+// recordingReader records the input read from the wrapped reader.
+type recordingReader struct {
+	io.Reader
+	r *attachRecorder
+}
+
+func (r recordingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if n > 0 {
+		r.r.record(recordingInput, p[:n])
+	}
+
+	// nolint:wrapcheck // keep the io.Reader semantics
+	return n, err
+}
+
+// recordingWriteCloser records the output written to the wrapped writer.
+type recordingWriteCloser struct {
+	io.WriteCloser
+	r *attachRecorder
+}
+
+func (r recordingWriteCloser) Write(p []byte) (int, error) {
+	n, err := r.WriteCloser.Write(p)
+	if n > 0 {
+		r.r.record(recordingOutput, p[:n])
+	}
+
+	// nolint:wrapcheck // keep the io.Writer semantics
+	return n, err
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// attachSequenceSize is the size of the sequence number following the type
+// of sequenced attach packets. Sync with conmonrs SEQUENCE_SIZE.
+const attachSequenceSize = 8
+
+var (
+	// ErrSequencedOutputUnsupported is returned if the server does not
+	// support the AttachConfig.SequencedOutput.
+	ErrSequencedOutputUnsupported = errors.New("server does not support sequenced output")
+
+	errSequencedOutputTerminal = errors.New("sequenced output cannot be used with a simulated terminal")
+	errOnOutputNotSequenced    = errors.New("output callback requires sequenced output")
+	errSequencedPacketShort    = errors.New("sequenced attach packet too short")
+)
+
+// OutputChunk is a chunk of the output of an attach session with
+// AttachConfig.SequencedOutput.
+type OutputChunk struct {
+	// Sequence is the number of the chunk, which orders the chunks of the
+	// standard output and error. Numbers may be skipped, for example for the
+	// output written before the session got connected. Chunks which exceed
+	// the size of a packet are split and keep the same number.
+	Sequence uint64
+
+	// Stream is the name of the stream, like "stdout" or "stderr".
+	Stream string
+
+	// Data of the chunk. It is only valid during the callback.
+	Data []byte
+}
+
+// validateSequencedOutput checks that the sequenced output options of the
+// config can be combined.
+func (a *AttachConfig) validateSequencedOutput() error {
+	if a.SequencedOutput && a.SimulateTerminal {
+		return errSequencedOutputTerminal
+	}
+
+	if a.OnOutput != nil && !a.SequencedOutput {
+		return errOnOutputNotSequenced
+	}
+
+	return nil
+}
+
+// handleSequencedPacket passes the chunk of a sequenced output packet to the
+// OnOutput callback of the config, if set.
+func handleSequencedPacket(cfg *AttachConfig, packet []byte) error {
+	if len(packet) < 1+attachSequenceSize {
+		return fmt.Errorf("%w: %d bytes", errSequencedPacketShort, len(packet))
+	}
+
+	if cfg.OnOutput == nil {
+		return nil
+	}
+
+	stream := "stdout"
+	if packet[0] == attachPipeStderr {
+		stream = "stderr"
+	}
+
+	if err := cfg.OnOutput(&OutputChunk{
+		Sequence: binary.BigEndian.Uint64(packet[1 : 1+attachSequenceSize]),
+		Stream:   stream,
+		Data:     packet[1+attachSequenceSize:],
+	}); err != nil {
+		return fmt.Errorf("handle output chunk: %w", err)
+	}
+
+	return nil
+}
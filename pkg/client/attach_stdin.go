@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+const (
+	// defaultStdinStallTimeout is the StdinStallTimeout if none is
+	// configured.
+	defaultStdinStallTimeout = 10 * time.Second
+
+	// The bounds of the exponential backoff between retries of stdin writes.
+	stdinRetryMinBackoff = time.Millisecond
+	stdinRetryMaxBackoff = 100 * time.Millisecond
+)
+
+// ErrStdinStalled is returned by AttachContainer if the standard input could
+// not be written to the attach socket within the StdinStallTimeout.
+var ErrStdinStalled = errors.New("writing the standard input stalled")
+
+// writeDeadliner is implemented by connections supporting write deadlines.
+type writeDeadliner interface {
+	SetWriteDeadline(t time.Time) error
+}
+
+// stdinWriter writes the standard input to the attach socket. Writes which
+// fail because the socket is temporarily out of buffer space are retried
+// until the stall timeout expires. Writes blocking for the stall timeout fail
+// as well, if the connection supports write deadlines.
+type stdinWriter struct {
+	w            io.Writer
+	stallTimeout time.Duration
+}
+
+func newStdinWriter(w io.Writer, stallTimeout time.Duration) *stdinWriter {
+	if stallTimeout <= 0 {
+		stallTimeout = defaultStdinStallTimeout
+	}
+
+	return &stdinWriter{w: w, stallTimeout: stallTimeout}
+}
+
+// Write writes all of p. The packet boundaries are preserved if the socket
+// accepts p at once, which is always the case for packet sockets.
+func (s *stdinWriter) Write(p []byte) (int, error) {
+	written := 0
+	backoff := stdinRetryMinBackoff
+	var deadline time.Time
+
+	deadliner, hasDeadline := s.w.(writeDeadliner)
+	if hasDeadline {
+		defer func() {
+			_ = deadliner.SetWriteDeadline(time.Time{})
+		}()
+	}
+
+	for written < len(p) {
+		if hasDeadline {
+			if err := deadliner.SetWriteDeadline(time.Now().Add(s.stallTimeout)); err != nil {
+				return written, fmt.Errorf("set write deadline: %w", err)
+			}
+		}
+
+		n, err := s.w.Write(p[written:])
+		written += n
+
+		if n > 0 {
+			// Progress resets the stall detection.
+			deadline = time.Time{}
+			backoff = stdinRetryMinBackoff
+		}
+
+		if err == nil {
+			if n == 0 {
+				return written, io.ErrShortWrite
+			}
+
+			continue
+		}
+
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			if n > 0 {
+				continue
+			}
+
+			return written, fmt.Errorf("%w after %v: %v", ErrStdinStalled, s.stallTimeout, err)
+		}
+
+		if !isTemporaryWriteError(err) {
+			// nolint:wrapcheck // keep the io.Writer semantics
+			return written, err
+		}
+
+		now := time.Now()
+		if deadline.IsZero() {
+			deadline = now.Add(s.stallTimeout)
+		} else if !now.Before(deadline) {
+			return written, fmt.Errorf("%w after %v: %v", ErrStdinStalled, s.stallTimeout, err)
+		}
+
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > stdinRetryMaxBackoff {
+			backoff = stdinRetryMaxBackoff
+		}
+	}
+
+	return written, nil
+}
+
+// isTemporaryWriteError returns true if writing failed because the socket is
+// out of buffer space at the moment.
+func isTemporaryWriteError(err error) bool {
+	return errors.Is(err, syscall.ENOBUFS) ||
+		errors.Is(err, syscall.EAGAIN) ||
+		errors.Is(err, syscall.EWOULDBLOCK)
+}
+
+// WriteStdinContainer writes the data of r to the standard input of a running
+// container, without the output handling of an attach session. The end of r
+// is forwarded like the end of the standard input of an attach session. The
+// container output is discarded, it can be followed via the container logs.
+func (c *ConmonClient) WriteStdinContainer(ctx context.Context, id string, r io.Reader) error {
+	socketDir, err := os.MkdirTemp(c.runDir, "stdin-")
+	if err != nil {
+		return fmt.Errorf("create attach socket directory: %w", err)
+	}
+	defer os.RemoveAll(socketDir)
+
+	if err := c.AttachContainer(ctx, &AttachConfig{
+		ID:         id,
+		SocketPath: filepath.Join(socketDir, "attach"),
+		Streams:    AttachStreams{Stdin: &In{r}},
+	}); err != nil {
+		return fmt.Errorf("write standard input: %w", err)
+	}
+
+	return nil
+}
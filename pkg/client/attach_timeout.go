@@ -0,0 +1,190 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// ErrAttachIdleTimeout is returned by AttachContainer if no bytes have
+	// been transferred within the IdleTimeout of the AttachConfig.
+	ErrAttachIdleTimeout = errors.New("attach session idle timeout exceeded")
+
+	// ErrAttachMaxDuration is returned by AttachContainer if the session
+	// reached the MaxDuration of the AttachConfig.
+	ErrAttachMaxDuration = errors.New("attach session maximum duration exceeded")
+)
+
+// attachTimeout ends an attach session if it is idle for too long or reaches
+// its maximum duration.
+type attachTimeout struct {
+	idleTimeout time.Duration
+	maxDuration time.Duration
+	start       time.Time
+
+	// lastActivity is the time of the last transfer in Unix nanoseconds.
+	lastActivity int64
+
+	mu       sync.Mutex
+	expired  error
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// newAttachTimeout creates an attachTimeout for the config, nil if the
+// session is not limited.
+func newAttachTimeout(cfg *AttachConfig) *attachTimeout {
+	if cfg.IdleTimeout <= 0 && cfg.MaxDuration <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	return &attachTimeout{
+		idleTimeout:  cfg.IdleTimeout,
+		maxDuration:  cfg.MaxDuration,
+		start:        now,
+		lastActivity: now.UnixNano(),
+		done:         make(chan struct{}),
+	}
+}
+
+// trackStreams wraps the streams to record their activity.
+func (t *attachTimeout) trackStreams(streams AttachStreams) AttachStreams {
+	if t.idleTimeout <= 0 {
+		return streams
+	}
+
+	if streams.Stdin != nil {
+		streams.Stdin = &In{activityReader{streams.Stdin.Reader, t}}
+	}
+
+	if streams.Stdout != nil {
+		streams.Stdout = &Out{activityWriteCloser{streams.Stdout.WriteCloser, t}}
+	}
+
+	if streams.Stderr != nil {
+		streams.Stderr = &Out{activityWriteCloser{streams.Stderr.WriteCloser, t}}
+	}
+
+	return streams
+}
+
+// touch records activity of the session.
+func (t *attachTimeout) touch() {
+	atomic.StoreInt64(&t.lastActivity, time.Now().UnixNano())
+}
+
+// watch stops reading the output of conn once the session expires, which
+// ends the session like detaching it. The returned function stops watching.
+func (t *attachTimeout) watch(conn attachConn) func() {
+	go func() {
+		for {
+			wait, err := t.next()
+			if err != nil {
+				t.mu.Lock()
+				t.expired = err
+				t.mu.Unlock()
+
+				if err := conn.CloseRead(); err != nil {
+					conn.Close()
+				}
+
+				return
+			}
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-t.done:
+				timer.Stop()
+
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+
+	return func() {
+		t.stopOnce.Do(func() { close(t.done) })
+	}
+}
+
+// next returns the time until the session has to be checked again, or the
+// error if it expired.
+func (t *attachTimeout) next() (time.Duration, error) {
+	now := time.Now()
+	wait := time.Duration(math.MaxInt64)
+
+	if t.maxDuration > 0 {
+		remaining := t.maxDuration - now.Sub(t.start)
+		if remaining <= 0 {
+			return 0, fmt.Errorf("%w: %v", ErrAttachMaxDuration, t.maxDuration)
+		}
+		wait = remaining
+	}
+
+	if t.idleTimeout > 0 {
+		idle := now.Sub(time.Unix(0, atomic.LoadInt64(&t.lastActivity)))
+		if remaining := t.idleTimeout - idle; remaining <= 0 {
+			return 0, fmt.Errorf("%w: %v", ErrAttachIdleTimeout, t.idleTimeout)
+		} else if remaining < wait {
+			wait = remaining
+		}
+	}
+
+	return wait, nil
+}
+
+// err returns the error if the session expired. It is nil safe.
+func (t *attachTimeout) err() error {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.expired
+}
+
+// activityRecorder records the activity of attach streams.
+type activityRecorder interface {
+	touch()
+}
+
+// activityReader records the activity of the wrapped reader.
+type activityReader struct {
+	io.Reader
+	t activityRecorder
+}
+
+func (a activityReader) Read(p []byte) (int, error) {
+	n, err := a.Reader.Read(p)
+	if n > 0 {
+		a.t.touch()
+	}
+
+	// nolint:wrapcheck // keep the io.Reader semantics
+	return n, err
+}
+
+// activityWriteCloser records the activity of the wrapped writer.
+type activityWriteCloser struct {
+	io.WriteCloser
+	t activityRecorder
+}
+
+func (a activityWriteCloser) Write(p []byte) (int, error) {
+	n, err := a.WriteCloser.Write(p)
+	if n > 0 {
+		a.t.touch()
+	}
+
+	// nolint:wrapcheck // keep the io.Writer semantics
+	return n, err
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"syscall"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// DefaultBatchConcurrency is the number of calls of a batch in flight if no
+// other Concurrency is configured.
+const DefaultBatchConcurrency = 16
+
+// BatchCall is a single call of a batch, which gets the client of the batch.
+type BatchCall func(ctx context.Context, c *ConmonClient) error
+
+// BatchConfig is the configuration of Batch.
+type BatchConfig struct {
+	// Concurrency limits the number of calls in flight. Defaults to
+	// DefaultBatchConcurrency.
+	Concurrency int
+
+	// FailFast cancels the context of the calls in flight and skips the
+	// remaining calls after the first call failed.
+	FailFast bool
+}
+
+// BatchResult is the result of a batch.
+type BatchResult struct {
+	// Errors contains the error of every call in the order of the calls,
+	// which is nil for successful calls. Calls skipped because of FailFast
+	// or the context fail with the error of the context.
+	Errors []error
+}
+
+// Err combines the errors of the failed calls, nil if all calls succeeded.
+func (b *BatchResult) Err() error {
+	var result *multierror.Error
+	for i, err := range b.Errors {
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("call %d: %w", i, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+// Batch issues the calls concurrently, which is best combined with an
+// RPCConnectionPoolSize to avoid dialing a connection per call. It returns
+// once all calls have finished or been skipped.
+func (c *ConmonClient) Batch(ctx context.Context, cfg *BatchConfig, calls ...BatchCall) *BatchResult {
+	if cfg == nil {
+		cfg = &BatchConfig{}
+	}
+
+	concurrency := cfg.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	result := &BatchResult{Errors: make([]error, len(calls))}
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, call := range calls {
+		select {
+		case <-ctx.Done():
+			result.Errors[i] = ctx.Err()
+
+			continue
+		case slots <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, call BatchCall) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if err := call(ctx, c); err != nil {
+				// Every call writes its own index only.
+				result.Errors[i] = err
+				if cfg.FailFast {
+					cancel()
+				}
+			}
+		}(i, call)
+	}
+
+	wg.Wait()
+
+	return result
+}
+
+// BatchCreateContainers creates the containers concurrently. The responses
+// are in the order of the configurations, nil for failed calls.
+func (c *ConmonClient) BatchCreateContainers(
+	ctx context.Context, cfg *BatchConfig, configs []*CreateContainerConfig,
+) ([]*CreateContainerResponse, *BatchResult) {
+	responses := make([]*CreateContainerResponse, len(configs))
+	calls := make([]BatchCall, 0, len(configs))
+
+	for i := range configs {
+		i := i
+		calls = append(calls, func(ctx context.Context, c *ConmonClient) (err error) {
+			responses[i], err = c.CreateContainer(ctx, configs[i])
+
+			return err
+		})
+	}
+
+	return responses, c.Batch(ctx, cfg, calls...)
+}
+
+// BatchKillContainers sends the signal to the containers concurrently, see
+// KillContainer.
+func (c *ConmonClient) BatchKillContainers(
+	ctx context.Context, cfg *BatchConfig, ids []string, signal syscall.Signal, all bool,
+) *BatchResult {
+	calls := make([]BatchCall, 0, len(ids))
+
+	for i := range ids {
+		id := ids[i]
+		calls = append(calls, func(ctx context.Context, c *ConmonClient) error {
+			return c.KillContainer(ctx, id, signal, all)
+		})
+	}
+
+	return c.Batch(ctx, cfg, calls...)
+}
@@ -0,0 +1,544 @@
+package client
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// CheckpointOptions are the options of a container checkpoint, which have to
+// match between checkpointing and restoring the container.
+type CheckpointOptions struct {
+	// ImagePath is the directory the checkpoint image gets written to or
+	// read from. It is required.
+	ImagePath string
+
+	// WorkPath is the directory for the CRIU logs and work files. The runtime
+	// default is used if it is empty.
+	WorkPath string
+
+	// TCPEstablished indicates that established TCP connections get
+	// checkpointed and restored.
+	TCPEstablished bool
+}
+
+// CheckpointContainerConfig is the configuration for calling the
+// CheckpointContainer method.
+type CheckpointContainerConfig struct {
+	// ID is the container identifier.
+	ID string
+
+	// Options are the checkpoint options.
+	Options CheckpointOptions
+
+	// LeaveRunning keeps the container running after the checkpoint has been
+	// created, otherwise it exits like it would have been stopped.
+	LeaveRunning bool
+}
+
+// CheckpointContainer creates a checkpoint of a running container using the
+// runtime, which requires CRIU to be installed.
+func (c *ConmonClient) CheckpointContainer(ctx context.Context, cfg *CheckpointContainerConfig) error {
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	return c.checkpointContainer(ctx, conn, cfg, nil)
+}
+
+// CheckpointContainerStream creates a checkpoint like CheckpointContainer
+// and streams the checkpoint image as uncompressed tar archive, which does
+// not require access to the file system of the server. The image gets
+// written to a temporary directory of the server if Options.ImagePath is
+// empty, which is removed after the image has been streamed.
+//
+// The returned reader fails with the error of the checkpoint if it fails
+// while streaming. Closing the reader before the end of the archive aborts
+// the stream, the checkpoint itself is not undone.
+func (c *ConmonClient) CheckpointContainerStream(
+	ctx context.Context, cfg *CheckpointContainerConfig,
+) (io.ReadCloser, error) {
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// The image writer is a capability exported to the server.
+	conn, err := c.newDedicatedRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+
+	pr, pw := io.Pipe()
+	writer := &checkpointImageWriter{tar: tar.NewWriter(pw), started: make(chan struct{})}
+	done := make(chan error, 1)
+
+	go func() {
+		defer conn.Close()
+
+		err := c.checkpointContainer(ctx, conn, cfg, writer)
+		if err == nil {
+			err = writer.close()
+		}
+		pw.CloseWithError(err)
+		done <- err
+	}()
+
+	// Errors of the checkpoint are returned directly if they happen before
+	// the first file of the image.
+	select {
+	case <-writer.started:
+		return pr, nil
+	case err := <-done:
+		if err != nil {
+			return nil, err
+		}
+
+		return pr, nil
+	}
+}
+
+func (c *ConmonClient) checkpointContainer(
+	ctx context.Context, conn *rpcConn, cfg *CheckpointContainerConfig, writer *checkpointImageWriter,
+) error {
+	id, err := c.containerID(ctx, cfg.ID)
+	if err != nil {
+		return err
+	}
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("CheckpointContainer")()
+	diag := c.diagnoseRPC("CheckpointContainer")
+	future, free := client.CheckpointContainer(ctx, func(p proto.Conmon_checkpointContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		options, err := req.NewOptions()
+		if err != nil {
+			return fmt.Errorf("create options: %w", err)
+		}
+
+		if err := initCheckpointOptions(options, &cfg.Options); err != nil {
+			return err
+		}
+
+		req.SetLeaveRunning(cfg.LeaveRunning)
+
+		if writer != nil {
+			if err := req.SetImageWriter(proto.Conmon_CheckpointImageWriter_ServerToClient(writer, nil)); err != nil {
+				return fmt.Errorf("set image writer: %w", err)
+			}
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	return responseError(response)
+}
+
+// checkpointImageWriter is the local implementation of the
+// CheckpointImageWriter interface, which writes the streamed files of the
+// image to a tar archive.
+type checkpointImageWriter struct {
+	mu      sync.Mutex
+	tar     *tar.Writer
+	started chan struct{}
+	once    sync.Once
+}
+
+// File is called by the server before the content of every file.
+func (w *checkpointImageWriter) File(ctx context.Context, call proto.Conmon_CheckpointImageWriter_file) error {
+	w.once.Do(func() { close(w.started) })
+
+	path, err := call.Args().Path()
+	if err != nil {
+		return fmt.Errorf("get path: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.tar.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     path,
+		Mode:     int64(call.Args().Mode()),
+		Size:     int64(call.Args().Size()),
+		ModTime:  time.Now(),
+	}); err != nil {
+		return fmt.Errorf("write header of %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Write is called by the server for every chunk of the current file.
+func (w *checkpointImageWriter) Write(ctx context.Context, call proto.Conmon_CheckpointImageWriter_write) error {
+	data, err := call.Args().Data()
+	if err != nil {
+		return fmt.Errorf("get data: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, err := w.tar.Write(data); err != nil {
+		return fmt.Errorf("write data: %w", err)
+	}
+
+	return nil
+}
+
+// close writes the end of the archive.
+func (w *checkpointImageWriter) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.tar.Close(); err != nil {
+		return fmt.Errorf("close archive: %w", err)
+	}
+
+	return nil
+}
+
+// checkpointImageChunkSize is the size of the chunks a checkpoint image gets
+// uploaded in.
+const checkpointImageChunkSize = 64 * 1024
+
+var errUnsupportedImageFile = errors.New("unsupported checkpoint image file type")
+
+// CheckpointImage is a checkpoint image uploaded to the server.
+type CheckpointImage struct {
+	// Path is the directory of the image on the server, which is used as
+	// ImagePath for restoring the container.
+	Path string
+
+	conn   *rpcConn
+	writer proto.Conmon_CheckpointImageWriter
+}
+
+// Close releases the image, which removes a temporary image directory from
+// the server. The image is not required anymore once the container has been
+// restored.
+func (i *CheckpointImage) Close() error {
+	if i.conn == nil {
+		return nil
+	}
+	i.writer.Release()
+
+	return i.conn.Close()
+}
+
+// UploadCheckpointImage uploads a checkpoint image from an uncompressed tar
+// archive like written by CheckpointContainerStream, which does not require
+// access to the file system of the server. The image gets written to a
+// temporary directory of the server if the imagePath is empty, which lives
+// until the returned image is closed.
+func (c *ConmonClient) UploadCheckpointImage(
+	ctx context.Context, imagePath string, archive io.Reader,
+) (*CheckpointImage, error) {
+	// The uploaded image lives as long as the connection.
+	conn, err := c.newDedicatedRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+
+	image, err := c.uploadCheckpointImage(ctx, conn, imagePath)
+	if err != nil {
+		conn.Close()
+
+		return nil, err
+	}
+
+	if err := image.upload(ctx, archive); err != nil {
+		image.Close()
+
+		return nil, err
+	}
+
+	return image, nil
+}
+
+func (c *ConmonClient) uploadCheckpointImage(
+	ctx context.Context, conn *rpcConn, imagePath string,
+) (*CheckpointImage, error) {
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("UploadCheckpointImage")()
+	diag := c.diagnoseRPC("UploadCheckpointImage")
+	future, free := client.UploadCheckpointImage(ctx, func(p proto.Conmon_uploadCheckpointImage_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetImagePath(imagePath); err != nil {
+			return fmt.Errorf("set image path: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	path, err := response.ImagePath()
+	if err != nil {
+		return nil, fmt.Errorf("get image path: %w", err)
+	}
+
+	return &CheckpointImage{Path: path, conn: conn, writer: response.Writer().AddRef()}, nil
+}
+
+// upload writes the regular files of the archive to the image.
+func (i *CheckpointImage) upload(ctx context.Context, archive io.Reader) error {
+	reader := tar.NewReader(archive)
+	buf := make([]byte, checkpointImageChunkSize)
+
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read archive: %w", err)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			continue
+		case tar.TypeReg:
+		default:
+			return fmt.Errorf("%w: %s", errUnsupportedImageFile, header.Name)
+		}
+
+		if err := i.writeFile(ctx, header); err != nil {
+			return err
+		}
+
+		for {
+			n, err := io.ReadFull(reader, buf)
+			if n > 0 {
+				if err := i.write(ctx, buf[:n]); err != nil {
+					return err
+				}
+			}
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("read %s: %w", header.Name, err)
+			}
+		}
+	}
+}
+
+func (i *CheckpointImage) writeFile(ctx context.Context, header *tar.Header) error {
+	future, free := i.writer.File(ctx, func(p proto.Conmon_CheckpointImageWriter_file_Params) error {
+		if err := p.SetPath(header.Name); err != nil {
+			return fmt.Errorf("set path: %w", err)
+		}
+		p.SetSize(uint64(header.Size))
+		p.SetMode(uint32(header.Mode))
+
+		return nil
+	})
+	defer free()
+
+	if _, err := future.Struct(); err != nil {
+		return fmt.Errorf("upload %s: %w", header.Name, err)
+	}
+
+	return nil
+}
+
+func (i *CheckpointImage) write(ctx context.Context, data []byte) error {
+	future, free := i.writer.Write(ctx, func(p proto.Conmon_CheckpointImageWriter_write_Params) error {
+		if err := p.SetData(data); err != nil {
+			return fmt.Errorf("set data: %w", err)
+		}
+
+		return nil
+	})
+	defer free()
+
+	if _, err := future.Struct(); err != nil {
+		return fmt.Errorf("upload data: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreContainerConfig is the configuration for calling the
+// RestoreContainer method.
+type RestoreContainerConfig struct {
+	// CreateContainerConfig is the configuration of the restored container,
+	// like it would be used for creating it.
+	CreateContainerConfig
+
+	// Options are the checkpoint options, which have to match the ones used
+	// for creating the checkpoint.
+	Options CheckpointOptions
+}
+
+// RestoreContainerResponse is the response of the RestoreContainer method.
+type RestoreContainerResponse struct {
+	// PID is the container process identifier.
+	PID uint32
+}
+
+// RestoreContainer restores a running container from a checkpoint using the
+// runtime. The restored container is managed like a created one, which
+// means that it does not have to be started.
+func (c *ConmonClient) RestoreContainer(
+	ctx context.Context, cfg *RestoreContainerConfig,
+) (*RestoreContainerResponse, error) {
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.newContainerID(cfg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.validateScratchDirs(cfg.ScratchDirs); err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("RestoreContainer")()
+	diag := c.diagnoseRPC("RestoreContainer")
+
+	reqID := requestID(ctx)
+	defer c.cancelOnDone(ctx, reqID)()
+
+	future, free := client.RestoreContainer(ctx, func(p proto.Conmon_restoreContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		container, err := req.NewContainer()
+		if err != nil {
+			return fmt.Errorf("create container: %w", err)
+		}
+
+		if err := c.initCreateContainerRequest(container, id, &cfg.CreateContainerConfig); err != nil {
+			return err
+		}
+
+		if err := initRequestScope(ctx, reqID, container.NewScope); err != nil {
+			return err
+		}
+
+		options, err := req.NewOptions()
+		if err != nil {
+			return fmt.Errorf("create options: %w", err)
+		}
+
+		if err := initCheckpointOptions(options, &cfg.Options); err != nil {
+			return err
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", quotaError(err))
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	if err := responseError(response); err != nil {
+		return nil, quotaError(err)
+	}
+
+	return &RestoreContainerResponse{
+		PID: response.ContainerPid(),
+	}, nil
+}
+
+func initCheckpointOptions(options proto.Conmon_CheckpointOptions, cfg *CheckpointOptions) error {
+	if err := options.SetImagePath(cfg.ImagePath); err != nil {
+		return fmt.Errorf("set image path: %w", err)
+	}
+
+	if err := options.SetWorkPath(cfg.WorkPath); err != nil {
+		return fmt.Errorf("set work path: %w", err)
+	}
+
+	options.SetTcpEstablished(cfg.TCPEstablished)
+
+	return nil
+}
@@ -2,42 +2,78 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
-	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"capnproto.org/go/capnp/v3"
-	"capnproto.org/go/capnp/v3/rpc"
 	"github.com/containers/conmon-rs/internal/proto"
 	"github.com/sirupsen/logrus"
 )
 
 const (
-	binaryName     = "conmonrs"
-	socketName     = "conmon.sock"
-	pidFileName    = "pidfile"
-	defaultTimeout = 10 * time.Second
+	binaryName      = "conmonrs"
+	socketName      = "conmon.sock"
+	pidFileName     = "pidfile"
+	crashReportName = "crash-report"
+	defaultTimeout  = 10 * time.Second
 )
 
 var (
-	errRuntimeUnspecified = errors.New("runtime must be specified")
-	errRunDirUnspecified  = errors.New("RunDir must be specified")
-	errInvalidValue       = errors.New("invalid value")
-	errRunDirNotCreated   = errors.New("could not create RunDir")
+	errRuntimeUnspecified    = errors.New("runtime must be specified")
+	errRunDirUnspecified     = errors.New("RunDir must be specified")
+	errInvalidValue          = errors.New("invalid value")
+	errRunDirNotCreated      = errors.New("could not create RunDir")
+	errReapRequiresSubreaper = errors.New("ReapEvents and ReapIgnorePIDs require the child subreaper")
+
+	// ErrServerRunning is returned by StartServer if a server is already
+	// running within the ServerRunDir.
+	ErrServerRunning = errors.New("server is already running")
 )
 
 // ConmonClient is the main client structure of this package.
 type ConmonClient struct {
-	serverPID uint32
-	runDir    string
-	logger    *logrus.Logger
+	serverPID       uint32
+	protocolVersion uint32
+	runDir          string
+	logger          *logrus.Logger
+	tenant          string
+	connectRetries  uint
+	connectBackoff  time.Duration
+	crashReportPath string
+
+	address   *serverAddress
+	tlsConfig *tls.Config
+	dialer    DialerFunc
+
+	multiplexAttachSessions bool
+	attachMux               *sharedAttachMux
+
+	rpcPool    *rpcPool
+	statsCache *statsCache
+	timeout    time.Duration
+
+	idValidator IDValidator
+	idResolver  IDResolver
+
+	tracer          Tracer
+	tracePropagator TracePropagator
+
+	metrics     *Metrics
+	sloReporter *SLOReporter
+
+	requestMutator RequestMutator
+	rpcInterceptor RPCInterceptor
+
+	state *clientState
 }
 
 // ConmonServerConfig is the configuration for the conmon server instance.
@@ -65,10 +101,178 @@ type ConmonServerConfig struct {
 	// containers.
 	RuntimeRoot string
 
+	// CgroupManager is the cgroup manager used by the OCI runtime.
+	// Can be "systemd" or "cgroupfs".
+	CgroupManager string
+
 	// ServerRunDir is the path of the directory for the server to hold files
 	// at runtime.
 	ServerRunDir string
 
+	// MaxSessionDuration is the maximum duration of attach sessions. Sessions
+	// are not limited if the duration is zero.
+	MaxSessionDuration time.Duration
+
+	// SessionWarningPeriod is the time before an attach session reaches
+	// MaxSessionDuration at which the client gets warned about the upcoming
+	// termination. The server default is used if the period is zero.
+	SessionWarningPeriod time.Duration
+
+	// TenantMaxContainers is the maximum number of containers per tenant.
+	// The number of containers is not limited if it is zero.
+	TenantMaxContainers uint64
+
+	// TenantMaxExecSessions is the maximum number of exec sessions per
+	// tenant. The number of exec sessions is not limited if it is zero.
+	TenantMaxExecSessions uint64
+
+	// TenantMaxLogBytes is the maximum number of container log bytes written
+	// per tenant. Further container output is not logged once the quota is
+	// exhausted. The log bytes are not limited if it is zero.
+	TenantMaxLogBytes uint64
+
+	// ConnectRetries is the number of times the client retries to connect to
+	// the server socket if it is unavailable, for example while the server
+	// gets restarted. Connecting is not retried if it is zero.
+	ConnectRetries uint
+
+	// ConnectBackoff is the initial delay between connection retries, which
+	// doubles after every retry. A default of 100ms is used if it is zero.
+	ConnectBackoff time.Duration
+
+	// CrashReportPath is the path of the crash report written by the server
+	// if it panics. Defaults to "crash-report" within ServerRunDir.
+	CrashReportPath string
+
+	// TombstoneRetention is the time the server keeps a tombstone of removed
+	// containers, see GetTombstone. Tombstones are disabled if it is zero.
+	TombstoneRetention time.Duration
+
+	// StateRetention is the time the server reports exited containers as
+	// ContainerStateExited before they are ContainerStateRemoved, see
+	// GetState. Defaults to 5 minutes if it is zero.
+	StateRetention time.Duration
+
+	// StateBackend is the backend persisting the state of the server across
+	// restarts, like the tombstones of removed containers. It is one of
+	// StateBackendMemory, StateBackendFile or StateBackendSqlite and defaults
+	// to StateBackendMemory. See InspectState and CompactState.
+	StateBackend string
+
+	// StatePath is the path of the file or database of the StateBackend.
+	// Defaults to "state" or "state.db" within ServerRunDir.
+	StatePath string
+
+	// OperationLocks makes the server reject operations on a container while
+	// a conflicting one is in progress, for example stopping it while
+	// commands get executed in it. Rejected operations fail with an error
+	// matching ErrOperationInProgress. Concurrent executions of commands do
+	// not conflict, while stopping, pausing, unpausing, checkpointing and
+	// updating the resources conflict with every operation. Killing is
+	// never rejected.
+	OperationLocks bool
+
+	// DisableSubreaper keeps the server from becoming the child subreaper.
+	// Orphaned processes of the containers get reparented to init instead
+	// of the server, which is not able to report the exit codes of
+	// containers whose runtime does not keep them as children.
+	DisableSubreaper bool
+
+	// ReapEvents publishes a ContainerEventTypeReaped event whenever the
+	// server reaped an orphaned process, which is no container, see
+	// WatchContainerEvents. Requires the child subreaper.
+	ReapEvents bool
+
+	// ReapIgnorePIDs are the PIDs of orphaned processes which are never
+	// reaped by the server, for example because another component waits
+	// for them. Requires the child subreaper.
+	ReapIgnorePIDs []uint32
+
+	// MultiplexAttachSessions shares a single connection to the server
+	// between all attach sessions of the client, instead of connecting to
+	// the attach socket of every container. The attach sockets are used if
+	// the server does not support it.
+	MultiplexAttachSessions bool
+
+	// RPCConnectionPoolSize is the number of persistent connections to the
+	// server, which are shared between calls instead of dialing a connection
+	// for every call. Concurrent calls are multiplexed over the connections.
+	// Event streams and seccomp notify handlers use connections of their
+	// own. A connection gets dialed for every call if zero.
+	RPCConnectionPoolSize int
+
+	// StatsCacheMaxStaleness enables a cache of the container statistics,
+	// which ContainerStats and ListContainerStats serve if the statistics
+	// are at most that old. The statistics of requested containers get
+	// refreshed in the background every half of it, until they have not
+	// been requested for ten refreshes or the container is gone. Cached
+	// statistics are shared and must not be modified. The cache is disabled
+	// if zero.
+	StatsCacheMaxStaleness time.Duration
+
+	// IDValidator validates and normalizes all container and exec session
+	// IDs before they are sent to the server. Defaults to IDRules without
+	// restrictions, which only rejects IDs unsafe to be used within paths.
+	IDValidator IDValidator
+
+	// IDResolver resolves the references to existing containers provided to
+	// the client, like short IDs or names, see ContainerRegistryResolver.
+	// References are used as IDs if nil.
+	IDResolver IDResolver
+
+	// TracerProvider provides the tracer for the spans of the RPCs and
+	// attach sessions of the client. No spans are recorded if nil.
+	TracerProvider TracerProvider
+
+	// TracePropagator extracts the trace context of the caller, which gets
+	// passed to the server on create, exec and attach. The server records
+	// it in the span of the request. No trace context is passed if nil.
+	TracePropagator TracePropagator
+
+	// Metrics collects the RPC latencies, attach session and reconnect
+	// metrics of the client if set. It can be shared between clients.
+	Metrics *Metrics
+
+	// SLOReporter measures the latency and throughput of the attach
+	// sessions of the client if set. It can be shared between clients.
+	SLOReporter *SLOReporter
+
+	// RequestMutator gets called with the configuration of every request
+	// before it gets sent, for example to inject defaults. The
+	// configurations are not modified if nil.
+	RequestMutator RequestMutator
+
+	// RPCInterceptor gets called with the message sizes and allocations of
+	// every RPC, for example to identify oversized requests. No diagnostics
+	// are recorded if nil.
+	RPCInterceptor RPCInterceptor
+
+	// ServerAddress connects the client to an already running server instead
+	// of the socket within ServerRunDir, for example within a virtual machine
+	// or on a remote node. Supported formats are "unix:///path/to/socket",
+	// "tcp://host:port", "vsock://cid:port" and, on Windows,
+	// "npipe:////./pipe/name" for named pipes relaying the server socket, for
+	// example into WSL2. Attach sessions require MultiplexAttachSessions on
+	// Windows, which does not support packet sockets. The server does not get
+	// started and cannot be shut down by the client. Attach sessions, port
+	// forwarding and remote file descriptors are unsupported for servers
+	// which are not reachable via ServerRunDir.
+	ServerAddress string
+
+	// TLSConfig enables TLS for "tcp" ServerAddress values. Client
+	// certificates can be configured for mutual TLS.
+	TLSConfig *tls.Config
+
+	// Dialer establishes the connections to the server and the attach and
+	// port forward sockets if set, which allows routing them through tunnels
+	// or proxies. Remote file descriptors always use the local fd socket.
+	Dialer DialerFunc
+
+	// Timeout is the timeout of the requests the client makes on its own,
+	// like checking for a running server when it gets created. Defaults to
+	// 10s if zero.
+	Timeout time.Duration
+
 	// Stdout is the standard output stream of the server when the log driver
 	// "stdout" is being used (can be nil).
 	Stdout io.WriteCloser
@@ -96,62 +300,158 @@ func NewConmonServerConfig(
 }
 
 // New creates a new conmon server, starts it and connects a new client to it.
-func New(config *ConmonServerConfig) (client *ConmonClient, retErr error) {
+// An already running server within the ServerRunDir gets inherited instead.
+// It only connects to the server if a ServerAddress is configured.
+func New(config *ConmonServerConfig) (*ConmonClient, error) {
 	cl, err := config.toClient()
 	if err != nil {
 		return nil, fmt.Errorf("convert config to client: %w", err)
 	}
 	// Check if the process has already started, and inherit that process instead.
-	ctx, cancel := defaultContext()
+	ctx, cancel := cl.defaultContext()
 	defer cancel()
-	if resp, err := cl.Version(ctx); err == nil {
-		cl.serverPID = resp.ProcessID
+	resp, err := cl.Version(ctx)
+	if err == nil {
+		if err := cl.connected(config, resp); err != nil {
+			return nil, err
+		}
 
 		return cl, nil
 	}
-	if err := cl.startServer(config); err != nil {
-		return nil, fmt.Errorf("start server: %w", err)
+	if cl.address != nil {
+		return nil, fmt.Errorf("connect to server %s: %w", config.ServerAddress, err)
+	}
+	if err := cl.launch(config); err != nil {
+		return nil, err
 	}
 
-	pid, err := pidGivenFile(cl.pidFile())
+	return cl, nil
+}
+
+// StartServer starts a new conmon server and connects a new client to it.
+// Contrary to New, it fails with ErrServerRunning if a server is already
+// running within the ServerRunDir, which allows callers to detect that the
+// server is shared with others.
+func StartServer(config *ConmonServerConfig) (*ConmonClient, error) {
+	if config.ServerAddress != "" {
+		return nil, errRemoteServer
+	}
+
+	cl, err := config.toClient()
 	if err != nil {
-		return nil, fmt.Errorf("get pid from env: %w", err)
+		return nil, fmt.Errorf("convert config to client: %w", err)
 	}
 
-	cl.serverPID = pid
+	ctx, cancel := cl.defaultContext()
+	defer cancel()
+	if _, err := cl.Version(ctx); err == nil {
+		return nil, fmt.Errorf("%s: %w", config.ServerRunDir, ErrServerRunning)
+	}
+
+	if err := cl.launch(config); err != nil {
+		return nil, err
+	}
+
+	return cl, nil
+}
+
+// launch starts the server and waits until it is up.
+func (c *ConmonClient) launch(config *ConmonServerConfig) (retErr error) {
+	if err := c.startServer(config); err != nil {
+		return fmt.Errorf("start server: %w", err)
+	}
+
+	pid, err := pidGivenFile(c.pidFile())
+	if err != nil {
+		return fmt.Errorf("get pid from env: %w", err)
+	}
+
+	c.serverPID = pid
 
 	// Cleanup the background server process
 	// if we fail any of the next steps
 	defer func() {
 		if retErr != nil {
-			if err := cl.Shutdown(); err != nil {
-				cl.logger.Errorf("Unable to shutdown server: %v", err)
+			if err := c.Shutdown(); err != nil {
+				c.logger.Errorf("Unable to shutdown server: %v", err)
 			}
 		}
 	}()
-	if err := cl.waitUntilServerUp(); err != nil {
-		return nil, fmt.Errorf("wait until server is up: %w", err)
+	resp, err := c.waitUntilServerUp()
+	if err != nil {
+		return fmt.Errorf("wait until server is up: %w", err)
 	}
-	if err := os.Remove(cl.pidFile()); err != nil {
-		return nil, fmt.Errorf("remove pid file: %w", err)
+	if err := os.Remove(c.pidFile()); err != nil {
+		return fmt.Errorf("remove pid file: %w", err)
 	}
 
-	return cl, nil
+	return c.connected(config, resp)
+}
+
+// connected negotiates the protocol version with the server and configures
+// the connection retries. Retrying only after startup does not delay
+// detecting a missing server.
+func (c *ConmonClient) connected(config *ConmonServerConfig, resp *VersionResponse) error {
+	if err := c.negotiateVersion(resp); err != nil {
+		return fmt.Errorf("negotiate version: %w", err)
+	}
+
+	c.serverPID = resp.ProcessID
+	c.configureRetries(config)
+
+	return nil
 }
 
 func (c *ConmonServerConfig) toClient() (*ConmonClient, error) {
-	const perm = 0o755
-	if err := os.MkdirAll(c.ServerRunDir, perm); err != nil && !os.IsExist(err) {
-		return nil, fmt.Errorf("%s: %w", c.ServerRunDir, errRunDirNotCreated)
+	var address *serverAddress
+	if c.ServerAddress != "" {
+		var err error
+		if address, err = parseServerAddress(c.ServerAddress); err != nil {
+			return nil, err
+		}
+	} else {
+		const perm = 0o755
+		if err := os.MkdirAll(c.ServerRunDir, perm); err != nil && !os.IsExist(err) {
+			return nil, fmt.Errorf("%s: %w", c.ServerRunDir, errRunDirNotCreated)
+		}
 	}
 
 	if c.ClientLogger == nil {
 		c.ClientLogger = logrus.StandardLogger()
 	}
 
+	idValidator := c.IDValidator
+	if idValidator == nil {
+		idValidator = &IDRules{}
+	}
+
+	var tracer Tracer = noopTracer{}
+	if c.TracerProvider != nil {
+		tracer = c.TracerProvider.Tracer(tracerName)
+	}
+
 	return &ConmonClient{
-		runDir: c.ServerRunDir,
-		logger: c.ClientLogger,
+		runDir:          c.ServerRunDir,
+		logger:          c.ClientLogger,
+		crashReportPath: crashReportPath(c),
+		address:         address,
+		tlsConfig:       c.TLSConfig,
+		dialer:          c.Dialer,
+
+		multiplexAttachSessions: c.MultiplexAttachSessions,
+		attachMux:               &sharedAttachMux{},
+		rpcPool:                 newRPCPool(c.RPCConnectionPoolSize),
+		statsCache:              newStatsCache(c.StatsCacheMaxStaleness),
+		timeout:                 c.Timeout,
+		idValidator:             idValidator,
+		idResolver:              c.IDResolver,
+		tracer:                  tracer,
+		tracePropagator:         c.TracePropagator,
+		metrics:                 c.Metrics,
+		sloReporter:             c.SLOReporter,
+		requestMutator:          c.RequestMutator,
+		rpcInterceptor:          c.RPCInterceptor,
+		state:                   newClientState(),
 	}, nil
 }
 
@@ -162,9 +462,7 @@ func (c *ConmonClient) startServer(config *ConmonServerConfig) error {
 	}
 	cmd := exec.Command(entrypoint, args...)
 
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		Setpgid: true,
-	}
+	cmd.SysProcAttr = serverSysProcAttr()
 
 	if config.LogDriver == LogDriverStdout {
 		cmd.Stdout = os.Stdout
@@ -210,6 +508,13 @@ func (c *ConmonClient) toArgs(config *ConmonServerConfig) (entrypoint string, ar
 		args = append(args, "--runtime-root", config.RuntimeRoot)
 	}
 
+	if config.CgroupManager != "" {
+		if err := validateCgroupManager(config.CgroupManager); err != nil {
+			return "", args, fmt.Errorf("validate cgroup manager: %w", err)
+		}
+		args = append(args, "--cgroup-manager", config.CgroupManager)
+	}
+
 	if config.LogLevel != "" {
 		if err := validateLogLevel(config.LogLevel); err != nil {
 			return "", args, fmt.Errorf("validate log level: %w", err)
@@ -224,9 +529,81 @@ func (c *ConmonClient) toArgs(config *ConmonServerConfig) (entrypoint string, ar
 		args = append(args, "--log-driver", config.LogDriver)
 	}
 
+	if config.MaxSessionDuration > 0 {
+		args = append(args, "--max-session-duration", strconv.FormatUint(durationSeconds(config.MaxSessionDuration), 10))
+	}
+
+	if config.SessionWarningPeriod > 0 {
+		args = append(args, "--session-warning-period", strconv.FormatUint(durationSeconds(config.SessionWarningPeriod), 10))
+	}
+
+	if config.TenantMaxContainers > 0 {
+		args = append(args, "--tenant-max-containers", strconv.FormatUint(config.TenantMaxContainers, 10))
+	}
+
+	if config.TenantMaxExecSessions > 0 {
+		args = append(args, "--tenant-max-exec-sessions", strconv.FormatUint(config.TenantMaxExecSessions, 10))
+	}
+
+	if config.TenantMaxLogBytes > 0 {
+		args = append(args, "--tenant-max-log-bytes", strconv.FormatUint(config.TenantMaxLogBytes, 10))
+	}
+
+	if config.CrashReportPath != "" {
+		args = append(args, "--crash-report-path", config.CrashReportPath)
+	}
+
+	if config.TombstoneRetention > 0 {
+		args = append(args, "--tombstone-retention", strconv.FormatUint(durationSeconds(config.TombstoneRetention), 10))
+	}
+
+	if config.StateRetention > 0 {
+		args = append(args, "--state-retention", strconv.FormatUint(durationSeconds(config.StateRetention), 10))
+	}
+
+	if config.OperationLocks {
+		args = append(args, "--operation-locks")
+	}
+
+	if config.DisableSubreaper {
+		if config.ReapEvents || len(config.ReapIgnorePIDs) > 0 {
+			return "", args, errReapRequiresSubreaper
+		}
+		args = append(args, "--no-subreaper")
+	}
+
+	if config.ReapEvents {
+		args = append(args, "--reap-events")
+	}
+
+	for _, pid := range config.ReapIgnorePIDs {
+		args = append(args, "--reap-ignore-pid", strconv.FormatUint(uint64(pid), 10))
+	}
+
+	if config.StateBackend != "" {
+		if err := validateStateBackend(config.StateBackend); err != nil {
+			return "", args, fmt.Errorf("validate state backend: %w", err)
+		}
+		args = append(args, "--state-backend", config.StateBackend)
+	}
+
+	if config.StatePath != "" {
+		args = append(args, "--state-path", config.StatePath)
+	}
+
 	return entrypoint, args, nil
 }
 
+// durationSeconds converts a duration into whole seconds, rounded up to not
+// lose durations below a second.
+func durationSeconds(d time.Duration) uint64 {
+	if d <= 0 {
+		return 0
+	}
+
+	return uint64((d + time.Second - 1) / time.Second)
+}
+
 func validateLogLevel(level string) error {
 	return validateStringSlice(
 		"log level",
@@ -243,6 +620,22 @@ func validateLogDriver(driver string) error {
 	)
 }
 
+func validateCgroupManager(manager string) error {
+	return validateStringSlice(
+		"cgroup manager",
+		manager,
+		CgroupManagerSystemd, CgroupManagerCgroupfs,
+	)
+}
+
+func validateStateBackend(backend string) error {
+	return validateStringSlice(
+		"state backend",
+		backend,
+		StateBackendMemory, StateBackendFile, StateBackendSqlite,
+	)
+}
+
 func validateStringSlice(typ, given string, possibleValues ...string) error {
 	for _, possibleValue := range possibleValues {
 		if given == possibleValue {
@@ -270,11 +663,11 @@ func pidGivenFile(file string) (uint32, error) {
 	return uint32(pidU64), nil
 }
 
-func (c *ConmonClient) waitUntilServerUp() (err error) {
+func (c *ConmonClient) waitUntilServerUp() (resp *VersionResponse, err error) {
 	for i := 0; i < 100; i++ {
-		ctx, cancel := defaultContext()
+		ctx, cancel := c.defaultContext()
 
-		_, err = c.Version(ctx)
+		resp, err = c.Version(ctx)
 		if err == nil {
 			cancel()
 
@@ -285,49 +678,59 @@ func (c *ConmonClient) waitUntilServerUp() (err error) {
 		time.Sleep(1 * time.Millisecond)
 	}
 
-	return err
+	return resp, err
 }
 
-func defaultContext() (context.Context, context.CancelFunc) {
-	return context.WithTimeout(context.Background(), defaultTimeout)
-}
-
-func (c *ConmonClient) newRPCConn() (*rpc.Conn, error) {
-	socketConn, err := DialLongSocket("unix", c.socket())
-	if err != nil {
-		return nil, fmt.Errorf("dial long socket: %w", err)
+// defaultContext returns the context of the requests the client makes on its
+// own.
+func (c *ConmonClient) defaultContext() (context.Context, context.CancelFunc) {
+	timeout := c.timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
 	}
 
-	return rpc.NewConn(rpc.NewStreamTransport(socketConn), nil), nil
+	return context.WithTimeout(context.Background(), timeout)
 }
 
-// DialLongSocket is a wrapper around net.DialUnix.
-// Its purpose is to allow for an arbitrarily long socket.
-// It does so by opening the parent directory of path, and using the
-// `/proc/self/fd` entry of that parent (which is a symlink to the actual parent)
-// to construct the path to the socket.
-// It assumes a valid path, as well as a file name that doesn't exceed the unix max socket length.
-func DialLongSocket(network, path string) (*net.UnixConn, error) {
-	parent := filepath.Dir(path)
-	f, err := os.Open(parent)
-	if err != nil {
-		return nil, fmt.Errorf("open socket parent: %w", err)
+// bootstrap retrieves the server capability of the connection, which is
+// scoped to the tenant of the client if set. It gets released when the
+// connection is closed.
+func (c *ConmonClient) bootstrap(ctx context.Context, conn *rpcConn) proto.Conmon {
+	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+	conn.onClose(client.Release)
+	if c.tenant == "" {
+		return client
 	}
-	defer f.Close()
 
-	socketName := filepath.Base(path)
+	future, free := client.WithTenant(ctx, func(p proto.Conmon_withTenant_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetTenant(c.tenant); err != nil {
+			return fmt.Errorf("set tenant: %w", err)
+		}
 
-	const procSelfFDPath = "/proc/self/fd"
-	socketPath := filepath.Join(procSelfFDPath, strconv.Itoa(int(f.Fd())), socketName)
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
 
-	conn, err := net.DialUnix(network, nil, &net.UnixAddr{
-		Name: socketPath, Net: network,
+		return nil
 	})
-	if err != nil {
-		return nil, fmt.Errorf("dial unix socket: %w", err)
-	}
+	conn.onClose(free)
+
+	return future.Response().Conmon()
+}
 
-	return conn, nil
+// WithTenant returns a copy of the client which is scoped to the provided
+// tenant. Containers created by the returned client are only visible to
+// clients of the same tenant, while all tenants share the same server.
+func (c *ConmonClient) WithTenant(tenant string) *ConmonClient {
+	scoped := *c
+	scoped.tenant = tenant
+
+	return &scoped
 }
 
 // VersionResponse is the response of the Version method.
@@ -349,21 +752,32 @@ type VersionResponse struct {
 
 	// ProcessID is the PID of the server.
 	ProcessID uint32
+
+	// ProtocolVersion is the version of the RPC protocol of the server, zero
+	// for servers predating protocol versions.
+	ProtocolVersion uint32
+
+	// MinClientProtocolVersion is the oldest protocol version of clients
+	// supported by the server.
+	MinClientProtocolVersion uint32
 }
 
 // Version can be used to retrieve all available version information.
 func (c *ConmonClient) Version(ctx context.Context) (*VersionResponse, error) {
-	conn, err := c.newRPCConn()
+	conn, err := c.newRPCConn(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("create RPC connection: %w", err)
 	}
 	defer conn.Close()
-	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("Version")()
+	diag := c.diagnoseRPC("Version")
 
 	future, free := client.Version(ctx, nil)
 	defer free()
 
 	result, err := future.Struct()
+	diag.report(ctx, result, err)
 	if err != nil {
 		return nil, fmt.Errorf("create result: %w", err)
 	}
@@ -405,6 +819,9 @@ func (c *ConmonClient) Version(ctx context.Context) (*VersionResponse, error) {
 		BuildDate:   buildDate,
 		RustVersion: rustVersion,
 		ProcessID:   response.ProcessId(),
+
+		ProtocolVersion:          response.ProtocolVersion(),
+		MinClientProtocolVersion: response.MinClientProtocolVersion(),
 	}, nil
 }
 
@@ -428,6 +845,66 @@ type CreateContainerConfig struct {
 
 	// LogDrivers is a slice of selected log drivers.
 	LogDrivers []LogDriver
+
+	// MaxSessionDuration is the maximum duration of attach sessions for the
+	// container, which overrides the server wide setting if non zero.
+	MaxSessionDuration time.Duration
+
+	// LogFilter is an optional external filter the container output gets
+	// passed through before being logged.
+	LogFilter *LogFilter
+
+	// AdditionalFDs are file descriptors sent using RemoteFDs, which get
+	// passed into the container process as file descriptor 3 and onwards.
+	// The runtime has to support the --preserve-fds option. It cannot be
+	// used together with FDMap.
+	AdditionalFDs []RemoteFD
+
+	// FDMap passes file descriptors sent using RemoteFDs into the container
+	// process at explicit numbers.
+	FDMap FDMap
+
+	// IOUser is the user the helper processes of the container IO, like the
+	// log filter, run as. Usually the host user the container root is
+	// mapped to. They run as the user of the server if nil.
+	IOUser *IOUser
+
+	// SeccompNotify proxies the seccomp notifications of the container to a
+	// handler if not nil.
+	SeccompNotify *SeccompNotifyConfig
+
+	// Runtime is the binary path of the OCI runtime of the container, which
+	// overrides the Runtime of the server if not empty. The server uses it
+	// for all later operations on the container, like exec and checkpoint.
+	Runtime string
+
+	// RuntimeRoot is the root directory of the OCI runtime of the
+	// container, which overrides the RuntimeRoot of the server if not empty.
+	RuntimeRoot string
+
+	// CgroupManager is the cgroup manager used by the OCI runtime for the
+	// container, which overrides the CgroupManager of the server if not
+	// empty. Can be "systemd" or "cgroupfs".
+	CgroupManager string
+
+	// RuntimeDebug runs the OCI runtime with debug messages, whose log is
+	// returned in the RuntimeLog of the RPCError if the runtime fails.
+	RuntimeDebug bool
+
+	// ScratchDirs are size limited tmpfs directories owned by the server,
+	// which get created within the bundle before the runtime runs and
+	// removed once the container exits. The server needs CAP_SYS_ADMIN to
+	// mount them, which rootless servers usually lack.
+	ScratchDirs []ScratchDir
+}
+
+// IOUser is the user and group ID of the helper processes of a container.
+type IOUser struct {
+	// UID is the user ID.
+	UID uint32
+
+	// GID is the group ID.
+	GID uint32
 }
 
 // LogDriver specifies a selected logging mechanism.
@@ -435,10 +912,63 @@ type LogDriver struct {
 	// Type defines the log driver variant.
 	Type LogDriverType
 
-	// Path specifies the filesystem path of the log driver.
+	// Path specifies the filesystem path of the log driver, which is required
+	// for the CRI and JSON log drivers.
 	Path string
+
+	// MaxSize is the maximum size of the log file in bytes, after which it
+	// gets rotated. It is only supported by the CRI and JSON log drivers.
+	// The size is not limited if it is zero.
+	MaxSize uint64
+
+	// MaxFiles is the number of rotated log files to keep, the most recent
+	// one has the suffix ".1". The log file gets truncated instead of
+	// rotated if it is zero.
+	MaxFiles uint32
+
+	// Compress indicates that rotated log files get compressed using gzip,
+	// which adds the suffix ".gz".
+	Compress bool
+
+	// Tag is added to the log entries of the JSON and journald log drivers
+	// if it is not empty.
+	Tag string
+
+	// BufferSize is the size of the write buffer in bytes, which is 8 KiB if
+	// zero. It is only supported by the CRI log driver.
+	BufferSize uint64
+
+	// FsyncPolicy specifies when the log gets synchronized to disk. It is
+	// only supported by the CRI log driver.
+	FsyncPolicy LogFsyncPolicy
+
+	// FsyncInterval is the minimum time between synchronizations of the
+	// LogFsyncPolicyInterval policy.
+	FsyncInterval time.Duration
+
+	// DirectIO writes the log bypassing the page cache, which falls back to
+	// buffered I/O if the filesystem does not support it. It is only
+	// supported by the CRI log driver.
+	DirectIO bool
 }
 
+// LogFsyncPolicy specifies when a log gets synchronized to disk, trading
+// durability for throughput.
+type LogFsyncPolicy int
+
+const (
+	// LogFsyncPolicyNever leaves synchronizing the log to the operating
+	// system.
+	LogFsyncPolicyNever LogFsyncPolicy = iota
+
+	// LogFsyncPolicyInterval synchronizes the log when writing if the
+	// FsyncInterval passed since the last synchronization.
+	LogFsyncPolicyInterval
+
+	// LogFsyncPolicyEveryLine synchronizes the log after writing every line.
+	LogFsyncPolicyEveryLine
+)
+
 // LogDriverType specifies available log drivers.
 type LogDriverType int
 
@@ -446,62 +976,98 @@ const (
 	// LogDriverTypeContainerRuntimeInterface is the Kubernetes CRI logger
 	// type.
 	LogDriverTypeContainerRuntimeInterface LogDriverType = iota
+
+	// LogDriverTypeJSON is the JSON file logger type, which writes a JSON
+	// object per line compatible with the docker json-file log driver.
+	LogDriverTypeJSON
+
+	// LogDriverTypeJournald is the systemd journald logger type.
+	LogDriverTypeJournald
+
+	// LogDriverTypeNone discards all output.
+	LogDriverTypeNone
 )
 
 // CreateContainerResponse is the response of the CreateContainer method.
 type CreateContainerResponse struct {
 	// PID is the container process identifier.
 	PID uint32
+
+	// ScratchDirPaths maps the names of the ScratchDirs to their paths on
+	// the host.
+	ScratchDirPaths map[string]string
 }
 
 // CreateContainer can be used to create a new running container instance.
 func (c *ConmonClient) CreateContainer(
 	ctx context.Context, cfg *CreateContainerConfig,
-) (*CreateContainerResponse, error) {
-	conn, err := c.newRPCConn()
+) (_ *CreateContainerResponse, retErr error) {
+	ctx, end := c.startSpan(ctx, "CreateContainer")
+	defer end(&retErr)
+
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.newContainerID(cfg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.validateScratchDirs(cfg.ScratchDirs); err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newRPCConn(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("create RPC connection: %w", err)
 	}
 	defer conn.Close()
-	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("CreateContainer")()
+	diag := c.diagnoseRPC("CreateContainer")
+
+	reqID := requestID(ctx)
+	defer c.cancelOnDone(ctx, reqID)()
 
 	future, free := client.CreateContainer(ctx, func(p proto.Conmon_createContainer_Params) error {
 		req, err := p.NewRequest()
 		if err != nil {
 			return fmt.Errorf("create request: %w", err)
 		}
-		if err := req.SetId(cfg.ID); err != nil {
-			return fmt.Errorf("set ID: %w", err)
-		}
-		if err := req.SetBundlePath(cfg.BundlePath); err != nil {
-			return fmt.Errorf("set bundle path: %w", err)
-		}
-		req.SetTerminal(cfg.Terminal)
-		if err := stringSliceToTextList(cfg.ExitPaths, req.NewExitPaths); err != nil {
-			return fmt.Errorf("convert exit paths string slice to text list: %w", err)
+
+		if err := c.initCreateContainerRequest(req, id, cfg); err != nil {
+			return err
 		}
-		if err := stringSliceToTextList(cfg.OOMExitPaths, req.NewOomExitPaths); err != nil {
-			return fmt.Errorf("convert oom exit paths string slice to text list: %w", err)
+
+		if err := c.initTraceContext(ctx, req.NewTraceContext); err != nil {
+			return err
 		}
-		if err := stringSliceToTextList(cfg.OOMExitPaths, req.NewOomExitPaths); err != nil {
+
+		if err := initRequestScope(ctx, reqID, req.NewScope); err != nil {
 			return err
 		}
 
-		if err := c.initLogDrivers(&req, cfg.LogDrivers); err != nil {
-			return fmt.Errorf("init log drivers: %w", err)
+		if progress := createProgressFromContext(ctx); progress != nil {
+			if err := req.SetProgress(proto.Conmon_CreateProgress_ServerToClient(progress, nil)); err != nil {
+				return fmt.Errorf("set progress: %w", err)
+			}
 		}
 
 		if err := p.SetRequest(req); err != nil {
 			return fmt.Errorf("set request: %w", err)
 		}
+		diag.request(p)
 
 		return nil
 	})
 	defer free()
 
 	result, err := future.Struct()
+	diag.report(ctx, result, err)
 	if err != nil {
-		return nil, fmt.Errorf("create result: %w", err)
+		return nil, fmt.Errorf("create result: %w", quotaError(err))
 	}
 
 	response, err := result.Response()
@@ -509,11 +1075,128 @@ func (c *ConmonClient) CreateContainer(
 		return nil, fmt.Errorf("set response: %w", err)
 	}
 
+	if err := responseError(response); err != nil {
+		return nil, quotaError(err)
+	}
+
+	if cfg.SeccompNotify != nil && cfg.SeccompNotify.Handler != nil {
+		// The handler is served for the lifetime of the container or until
+		// the client gets closed.
+		// nolint:contextcheck // not bound to the create request
+		if err := c.ServeSeccompNotify(c.state.ctx, id, cfg.SeccompNotify.Handler); err != nil {
+			return nil, fmt.Errorf("serve seccomp notifications: %w", err)
+		}
+	}
+
+	scratchPaths, err := scratchDirPaths(cfg.ScratchDirs, response)
+	if err != nil {
+		return nil, err
+	}
+
 	return &CreateContainerResponse{
-		PID: response.ContainerPid(),
+		PID:             response.ContainerPid(),
+		ScratchDirPaths: scratchPaths,
 	}, nil
 }
 
+func (c *ConmonClient) initCreateContainerRequest(
+	req proto.Conmon_CreateContainerRequest, id string, cfg *CreateContainerConfig,
+) error {
+	if err := req.SetId(id); err != nil {
+		return fmt.Errorf("set ID: %w", err)
+	}
+	if err := req.SetBundlePath(cfg.BundlePath); err != nil {
+		return fmt.Errorf("set bundle path: %w", err)
+	}
+	req.SetTerminal(cfg.Terminal)
+	if err := stringSliceToTextList(cfg.ExitPaths, req.NewExitPaths); err != nil {
+		return fmt.Errorf("convert exit paths string slice to text list: %w", err)
+	}
+	if err := stringSliceToTextList(cfg.OOMExitPaths, req.NewOomExitPaths); err != nil {
+		return fmt.Errorf("convert oom exit paths string slice to text list: %w", err)
+	}
+	if err := stringSliceToTextList(cfg.OOMExitPaths, req.NewOomExitPaths); err != nil {
+		return err
+	}
+
+	if err := c.initLogDrivers(req.NewLogDrivers, cfg.LogDrivers); err != nil {
+		return fmt.Errorf("init log drivers: %w", err)
+	}
+
+	req.SetMaxSessionDurationSec(durationSeconds(cfg.MaxSessionDuration))
+
+	if err := initLogFilter(req, cfg.LogFilter); err != nil {
+		return fmt.Errorf("init log filter: %w", err)
+	}
+
+	if err := remoteFDSliceToUInt64List(cfg.AdditionalFDs, req.NewAdditionalFds); err != nil {
+		return fmt.Errorf("convert additional fds to list: %w", err)
+	}
+
+	if err := setFDMap(cfg.FDMap, req.NewFdMappings); err != nil {
+		return fmt.Errorf("set fd map: %w", err)
+	}
+
+	if cfg.IOUser != nil {
+		user, err := req.NewIoUser()
+		if err != nil {
+			return fmt.Errorf("create IO user: %w", err)
+		}
+		user.SetUid(cfg.IOUser.UID)
+		user.SetGid(cfg.IOUser.GID)
+	}
+
+	if cfg.SeccompNotify != nil {
+		if err := req.SetSeccompListenerPath(cfg.SeccompNotify.ListenerPath); err != nil {
+			return fmt.Errorf("set seccomp listener path: %w", err)
+		}
+	}
+
+	if err := initRuntimeOptions(req, cfg); err != nil {
+		return fmt.Errorf("init runtime options: %w", err)
+	}
+	req.SetRuntimeDebug(cfg.RuntimeDebug)
+
+	if err := setScratchDirs(cfg.ScratchDirs, req.NewScratchDirs); err != nil {
+		return fmt.Errorf("set scratch dirs: %w", err)
+	}
+
+	return nil
+}
+
+// initRuntimeOptions sets the runtime overrides of the container, if any.
+func initRuntimeOptions(req proto.Conmon_CreateContainerRequest, cfg *CreateContainerConfig) error {
+	if cfg.Runtime == "" && cfg.RuntimeRoot == "" && cfg.CgroupManager == "" {
+		return nil
+	}
+
+	options, err := req.NewRuntimeOptions()
+	if err != nil {
+		return fmt.Errorf("create runtime options: %w", err)
+	}
+
+	if err := options.SetRuntime(cfg.Runtime); err != nil {
+		return fmt.Errorf("set runtime: %w", err)
+	}
+
+	if err := options.SetRuntimeRoot(cfg.RuntimeRoot); err != nil {
+		return fmt.Errorf("set runtime root: %w", err)
+	}
+
+	switch cfg.CgroupManager {
+	case "":
+		options.SetCgroupManager(proto.Conmon_RuntimeOptions_CgroupManager_default)
+	case CgroupManagerSystemd:
+		options.SetCgroupManager(proto.Conmon_RuntimeOptions_CgroupManager_systemd)
+	case CgroupManagerCgroupfs:
+		options.SetCgroupManager(proto.Conmon_RuntimeOptions_CgroupManager_cgroupfs)
+	default:
+		return validateCgroupManager(cfg.CgroupManager)
+	}
+
+	return nil
+}
+
 // ExecSyncConfig is the configuration for calling the ExecSyncContainer
 // method.
 type ExecSyncConfig struct {
@@ -528,6 +1211,29 @@ type ExecSyncConfig struct {
 
 	// Terminal specifies if a tty should be used.
 	Terminal bool
+
+	// ExecSession is an optional identifier for the command, which can be
+	// used to attach to it while it is running.
+	ExecSession string
+
+	// MaxOutputBytes limits the size of the returned stdout and stderr each.
+	// The output is unlimited if zero.
+	MaxOutputBytes uint64
+
+	// CacheTTL enables returning the result of an identical command for the
+	// same container if it is not older than the TTL, instead of running the
+	// command again. It is intended for idempotent probes and disabled if
+	// zero. Results of exec sessions are never cached.
+	CacheTTL time.Duration
+
+	// RuntimeDebug runs the OCI runtime with debug messages, whose log is
+	// returned in the RuntimeLog of the RPCError if the runtime fails.
+	RuntimeDebug bool
+
+	// FDMap passes file descriptors sent using RemoteFDs into the process
+	// at explicit numbers. Results of commands with passed file descriptors
+	// are never cached.
+	FDMap FDMap
 }
 
 // ExecContainerResult is the result for calling the ExecSyncContainer method.
@@ -543,24 +1249,62 @@ type ExecContainerResult struct {
 
 	// TimedOut is true if the command timed out.
 	TimedOut bool
+
+	// StdoutTruncated is true if the stdout exceeded MaxOutputBytes.
+	StdoutTruncated bool
+
+	// StderrTruncated is true if the stderr exceeded MaxOutputBytes.
+	StderrTruncated bool
+
+	// Cached is true if the result has been returned from the cache.
+	Cached bool
+
+	// CacheAge is the age of the cached result.
+	CacheAge time.Duration
 }
 
 // ExecSyncContainer can be used to execute a command within a running
 // container.
-func (c *ConmonClient) ExecSyncContainer(ctx context.Context, cfg *ExecSyncConfig) (*ExecContainerResult, error) {
-	conn, err := c.newRPCConn()
+func (c *ConmonClient) ExecSyncContainer(
+	ctx context.Context, cfg *ExecSyncConfig,
+) (_ *ExecContainerResult, retErr error) {
+	ctx, end := c.startSpan(ctx, "ExecSyncContainer")
+	defer end(&retErr)
+
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.containerID(ctx, cfg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	execSession, err := c.execSessionID(cfg.ExecSession)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newRPCConn(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("create RPC connection: %w", err)
 	}
 	defer conn.Close()
 
-	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("ExecSyncContainer")()
+	diag := c.diagnoseRPC("ExecSyncContainer")
+
+	reqID := requestID(ctx)
+	defer c.cancelOnDone(ctx, reqID)()
+
 	future, free := client.ExecSyncContainer(ctx, func(p proto.Conmon_execSyncContainer_Params) error {
 		req, err := p.NewRequest()
 		if err != nil {
 			return fmt.Errorf("create request: %w", err)
 		}
-		if err := req.SetId(cfg.ID); err != nil {
+		if err := req.SetId(id); err != nil {
 			return fmt.Errorf("set ID: %w", err)
 		}
 		req.SetTimeoutSec(cfg.Timeout)
@@ -568,17 +1312,34 @@ func (c *ConmonClient) ExecSyncContainer(ctx context.Context, cfg *ExecSyncConfi
 			return err
 		}
 		req.SetTerminal(cfg.Terminal)
+		if err := req.SetExecSessionId(execSession); err != nil {
+			return fmt.Errorf("set exec session ID: %w", err)
+		}
+		req.SetMaxOutputBytes(cfg.MaxOutputBytes)
+		req.SetCacheTtlMs(uint64(cfg.CacheTTL.Milliseconds()))
+		req.SetRuntimeDebug(cfg.RuntimeDebug)
+		if err := setFDMap(cfg.FDMap, req.NewFdMappings); err != nil {
+			return fmt.Errorf("set fd map: %w", err)
+		}
+		if err := c.initTraceContext(ctx, req.NewTraceContext); err != nil {
+			return err
+		}
+		if err := initRequestScope(ctx, reqID, req.NewScope); err != nil {
+			return err
+		}
 		if err := p.SetRequest(req); err != nil {
 			return fmt.Errorf("set request: %w", err)
 		}
+		diag.request(p)
 
 		return nil
 	})
 	defer free()
 
 	result, err := future.Struct()
+	diag.report(ctx, result, err)
 	if err != nil {
-		return nil, fmt.Errorf("create result: %w", err)
+		return nil, fmt.Errorf("create result: %w", quotaError(err))
 	}
 
 	resp, err := result.Response()
@@ -586,6 +1347,10 @@ func (c *ConmonClient) ExecSyncContainer(ctx context.Context, cfg *ExecSyncConfi
 		return nil, fmt.Errorf("set response: %w", err)
 	}
 
+	if err := responseError(resp); err != nil {
+		return nil, err
+	}
+
 	stdout, err := resp.Stdout()
 	if err != nil {
 		return nil, fmt.Errorf("get stdout: %w", err)
@@ -597,10 +1362,14 @@ func (c *ConmonClient) ExecSyncContainer(ctx context.Context, cfg *ExecSyncConfi
 	}
 
 	execContainerResult := &ExecContainerResult{
-		ExitCode: resp.ExitCode(),
-		Stdout:   stdout,
-		Stderr:   stderr,
-		TimedOut: resp.TimedOut(),
+		ExitCode:        resp.ExitCode(),
+		Stdout:          stdout,
+		Stderr:          stderr,
+		TimedOut:        resp.TimedOut(),
+		StdoutTruncated: resp.StdoutTruncated(),
+		StderrTruncated: resp.StderrTruncated(),
+		Cached:          resp.Cached(),
+		CacheAge:        time.Duration(resp.CacheAgeMs()) * time.Millisecond,
 	}
 
 	return execContainerResult, nil
@@ -624,19 +1393,45 @@ func stringSliceToTextList(src []string, newFunc func(int32) (capnp.TextList, er
 	return nil
 }
 
-func (c *ConmonClient) initLogDrivers(req *proto.Conmon_CreateContainerRequest, logDrivers []LogDriver) error {
-	newLogDrivers, err := req.NewLogDrivers(int32(len(logDrivers)))
+func (c *ConmonClient) initLogDrivers(
+	newLogDrivers func(int32) (proto.Conmon_LogDriver_List, error), logDrivers []LogDriver,
+) error {
+	list, err := newLogDrivers(int32(len(logDrivers)))
 	if err != nil {
 		return fmt.Errorf("create log drivers: %w", err)
 	}
 	for i, logDriver := range logDrivers {
-		n := newLogDrivers.At(i)
-		if logDriver.Type == LogDriverTypeContainerRuntimeInterface {
+		n := list.At(i)
+		switch logDriver.Type {
+		case LogDriverTypeContainerRuntimeInterface:
 			n.SetType(proto.Conmon_LogDriver_Type_containerRuntimeInterface)
+		case LogDriverTypeJSON:
+			n.SetType(proto.Conmon_LogDriver_Type_json)
+		case LogDriverTypeJournald:
+			n.SetType(proto.Conmon_LogDriver_Type_journald)
+		case LogDriverTypeNone:
+			n.SetType(proto.Conmon_LogDriver_Type_none)
 		}
 		if err := n.SetPath(logDriver.Path); err != nil {
 			return fmt.Errorf("set log driver path: %w", err)
 		}
+		n.SetMaxSize(logDriver.MaxSize)
+		n.SetMaxFiles(logDriver.MaxFiles)
+		n.SetCompress(logDriver.Compress)
+		if err := n.SetTag(logDriver.Tag); err != nil {
+			return fmt.Errorf("set log driver tag: %w", err)
+		}
+		n.SetBufferSize(logDriver.BufferSize)
+		switch logDriver.FsyncPolicy {
+		case LogFsyncPolicyNever:
+			n.SetFsyncPolicy(proto.Conmon_LogDriver_FsyncPolicy_never)
+		case LogFsyncPolicyInterval:
+			n.SetFsyncPolicy(proto.Conmon_LogDriver_FsyncPolicy_interval)
+		case LogFsyncPolicyEveryLine:
+			n.SetFsyncPolicy(proto.Conmon_LogDriver_FsyncPolicy_everyLine)
+		}
+		n.SetFsyncIntervalMs(uint64(logDriver.FsyncInterval.Milliseconds()))
+		n.SetDirectIo(logDriver.DirectIO)
 	}
 
 	return nil
@@ -644,18 +1439,57 @@ func (c *ConmonClient) initLogDrivers(req *proto.Conmon_CreateContainerRequest,
 
 // PID returns the server process ID.
 func (c *ConmonClient) PID() uint32 {
-	return c.serverPID
+	return atomic.LoadUint32(&c.serverPID)
 }
 
-// Shutdown kill the server via SIGINT.
+// Shutdown kill the server via SIGINT. It fails for servers connected via
+// ServerAddress, because their PID may not refer to a local process.
 func (c *ConmonClient) Shutdown() error {
-	if err := syscall.Kill(int(c.serverPID), syscall.SIGINT); err != nil {
+	if c.address != nil {
+		return errRemoteServer
+	}
+
+	if err := signalProcess(int(c.PID()), syscall.SIGINT); err != nil {
 		return fmt.Errorf("kill server PID: %w", err)
 	}
 
 	return nil
 }
 
+// GracefulShutdown terminates the server via SIGTERM and waits until it has
+// exited. The server gets killed if it does not exit before the context is
+// done.
+func (c *ConmonClient) GracefulShutdown(ctx context.Context) error {
+	if c.address != nil {
+		return errRemoteServer
+	}
+
+	pid := int(c.PID())
+	if err := signalProcess(pid, syscall.SIGTERM); err != nil {
+		return fmt.Errorf("terminate server PID: %w", err)
+	}
+
+	const pollInterval = 10 * time.Millisecond
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := signalProcess(pid, 0); errors.Is(err, syscall.ESRCH) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if err := signalProcess(pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+				return fmt.Errorf("kill server PID: %w", err)
+			}
+
+			return fmt.Errorf("wait for server to exit: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
 func (c *ConmonClient) pidFile() string {
 	return filepath.Join(c.runDir, pidFileName)
 }
@@ -669,17 +1503,42 @@ func (c *ConmonClient) socket() string {
 type ReopenLogContainerConfig struct {
 	// ID is the container identifier.
 	ID string
+
+	// ExecSession reopens the log drivers of the exec session instead of the
+	// container if set.
+	ExecSession string
+
+	// Path reopens only the log driver of the path if set, otherwise all log
+	// drivers get reopened.
+	Path string
 }
 
 // ReopenLogContainer can be used to rotate all configured container log
-// drivers.
+// drivers, or only a single one by its path.
 func (c *ConmonClient) ReopenLogContainer(ctx context.Context, cfg *ReopenLogContainerConfig) error {
-	conn, err := c.newRPCConn()
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return err
+	}
+
+	id, err := c.containerID(ctx, cfg.ID)
+	if err != nil {
+		return err
+	}
+
+	execSession, err := c.execSessionID(cfg.ExecSession)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
 	if err != nil {
 		return fmt.Errorf("create RPC connection: %w", err)
 	}
 	defer conn.Close()
-	client := proto.Conmon{Client: conn.Bootstrap(ctx)}
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("ReopenLogContainer")()
+	diag := c.diagnoseRPC("ReopenLogContainer")
 
 	future, free := client.ReopenLogContainer(ctx, func(p proto.Conmon_reopenLogContainer_Params) error {
 		req, err := p.NewRequest()
@@ -687,19 +1546,29 @@ func (c *ConmonClient) ReopenLogContainer(ctx context.Context, cfg *ReopenLogCon
 			return fmt.Errorf("create request: %w", err)
 		}
 
-		if err := req.SetId(cfg.ID); err != nil {
+		if err := req.SetId(id); err != nil {
 			return fmt.Errorf("set ID: %w", err)
 		}
 
+		if err := req.SetExecSessionId(execSession); err != nil {
+			return fmt.Errorf("set exec session ID: %w", err)
+		}
+
+		if err := req.SetPath(cfg.Path); err != nil {
+			return fmt.Errorf("set path: %w", err)
+		}
+
 		if err := p.SetRequest(req); err != nil {
 			return fmt.Errorf("set request: %w", err)
 		}
+		diag.request(p)
 
 		return nil
 	})
 	defer free()
 
 	result, err := future.Struct()
+	diag.report(ctx, result, err)
 	if err != nil {
 		return fmt.Errorf("create result: %w", err)
 	}
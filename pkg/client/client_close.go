@@ -0,0 +1,251 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// forceCloseTimeout is the time to wait for attach sessions to end after
+// their connection got closed.
+const forceCloseTimeout = 5 * time.Second
+
+var (
+	// ErrClientClosed is returned by the calls of a client after Close.
+	ErrClientClosed = errors.New("client is closed")
+
+	// ErrAttachSessionHung is the error of an attach session which did not
+	// end after its connection got closed, for example because writing its
+	// output blocks.
+	ErrAttachSessionHung = errors.New("attach session did not end after being closed")
+)
+
+// AttachSessionResult describes how an attach session ended while closing
+// the client.
+type AttachSessionResult struct {
+	// ID of the container.
+	ID string
+
+	// ExecSession ID, if the session attached to an exec.
+	ExecSession string
+
+	// SocketPath is the attach socket of the session.
+	SocketPath string
+
+	// Forced is true if the session got closed because it did not end
+	// within the drain timeout.
+	Forced bool
+
+	// Err is the error the session ended with, nil if it ended regularly.
+	Err error
+}
+
+// clientState tracks whether a client is closed and its active attach
+// sessions. It is shared with the clients returned by WithTenant.
+type clientState struct {
+	mu       sync.Mutex
+	closed   bool
+	next     uint64
+	sessions map[uint64]*trackedSession
+
+	// ctx is the context of the background work started by the client,
+	// which gets canceled on Close.
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// trackedSession is an active attach session of the client.
+type trackedSession struct {
+	state *clientState
+	id    uint64
+	done  chan struct{}
+
+	// The fields are guarded by the mutex of the state.
+	result AttachSessionResult
+	conn   attachConn
+}
+
+func newClientState() *clientState {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &clientState{
+		sessions: make(map[uint64]*trackedSession),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// checkOpen returns ErrClientClosed if the client is closed.
+func (s *clientState) checkOpen() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClientClosed
+	}
+
+	return nil
+}
+
+// startSession tracks a new attach session, which has to be ended once it
+// is done.
+func (s *clientState) startSession(cfg *AttachConfig) (*trackedSession, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, ErrClientClosed
+	}
+
+	s.next++
+	session := &trackedSession{
+		state: s,
+		id:    s.next,
+		done:  make(chan struct{}),
+		result: AttachSessionResult{
+			ID:          cfg.ID,
+			ExecSession: cfg.ExecSession,
+			SocketPath:  cfg.SocketPath,
+		},
+	}
+	s.sessions[session.id] = session
+
+	return session, nil
+}
+
+// setConn sets the connection of the session, which gets closed right away
+// if the session has been closed in the meantime.
+func (t *trackedSession) setConn(conn attachConn) {
+	t.state.mu.Lock()
+	defer t.state.mu.Unlock()
+
+	t.conn = conn
+	if t.result.Forced {
+		t.closeConn()
+	}
+}
+
+// forceClose closes the connection of the session to end it. Sessions which
+// already ended or got force-closed before are left as they are.
+func (t *trackedSession) forceClose() {
+	t.state.mu.Lock()
+	defer t.state.mu.Unlock()
+
+	select {
+	case <-t.done:
+		return
+	default:
+	}
+
+	if t.result.Forced {
+		return
+	}
+
+	t.result.Forced = true
+	if t.conn != nil {
+		t.closeConn()
+	}
+}
+
+// closeConn closes the connection, the mutex of the state has to be held.
+func (t *trackedSession) closeConn() {
+	if err := t.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		t.result.Err = fmt.Errorf("close attach connection: %w", err)
+	}
+}
+
+// end records the result of the session and stops tracking it.
+func (t *trackedSession) end(err error) {
+	t.state.mu.Lock()
+	defer t.state.mu.Unlock()
+
+	if t.result.Err == nil {
+		t.result.Err = err
+	}
+	delete(t.state.sessions, t.id)
+	close(t.done)
+}
+
+// close marks the client as closed and returns its active sessions in the
+// order they started.
+func (s *clientState) close() []*trackedSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	s.cancel()
+	sessions := make([]*trackedSession, 0, len(s.sessions))
+	for _, session := range s.sessions {
+		sessions = append(sessions, session)
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].id < sessions[j].id })
+
+	return sessions
+}
+
+// Close stops the client from making new calls and waits for the active
+// attach sessions to end. Sessions which do not end within the drain timeout
+// get force-closed. The persistent connections of the client are closed
+// afterwards, and the seccomp notify handlers registered by CreateContainer
+// stop being served. It returns the results of the sessions which were active. The
+// client must not be used anymore, which applies to all clients returned by
+// WithTenant as well. The server keeps running, see Shutdown.
+func (c *ConmonClient) Close(drainTimeout time.Duration) ([]*AttachSessionResult, error) {
+	sessions := c.state.close()
+	c.statsCache.close()
+
+	drained := waitSessions(sessions, drainTimeout)
+	if !drained {
+		for _, session := range sessions {
+			session.forceClose()
+		}
+		waitSessions(sessions, forceCloseTimeout)
+	}
+
+	results := make([]*AttachSessionResult, 0, len(sessions))
+	c.state.mu.Lock()
+	for _, session := range sessions {
+		result := session.result
+		select {
+		case <-session.done:
+		default:
+			result.Err = ErrAttachSessionHung
+		}
+		results = append(results, &result)
+	}
+	c.state.mu.Unlock()
+
+	var result *multierror.Error
+	if err := c.CloseConnections(); err != nil {
+		result = multierror.Append(result, fmt.Errorf("close RPC connections: %w", err))
+	}
+
+	if err := c.attachMux.close(); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return results, result.ErrorOrNil()
+}
+
+// waitSessions waits until all sessions ended or the timeout expired. It
+// returns false if the timeout expired.
+func waitSessions(sessions []*trackedSession, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for _, session := range sessions {
+		select {
+		case <-session.done:
+		case <-timer.C:
+			return false
+		}
+	}
+
+	return true
+}
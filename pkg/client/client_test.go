@@ -1,19 +1,31 @@
 package client_test
 
 import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"sync"
+	"syscall"
 	"time"
 
+	"capnproto.org/go/capnp/v3"
+	"github.com/containers/conmon-rs/internal/proto"
 	"github.com/containers/conmon-rs/pkg/client"
-	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/storage/pkg/unshare"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/opencontainers/runtime-tools/generate"
 )
 
@@ -119,7 +131,7 @@ var _ = Describe("ConmonClient", func() {
 					context.Background(),
 					&client.SetWindowSizeContainerConfig{
 						ID: tr.ctrID,
-						Size: &define.TerminalSize{
+						Size: &client.TerminalSize{
 							Width:  10,
 							Height: 20,
 						},
@@ -224,6 +236,28 @@ var _ = Describe("ConmonClient", func() {
 				Expect(logs).To(BeEmpty())
 			})
 
+			It(testName("should limit the output size", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(terminal, []string{"/busybox", "sleep", "10"}, nil)
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+				tr.startContainer(sut)
+
+				result, err := sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+					ID:             tr.ctrID,
+					Command:        []string{"/busybox", "echo", "-n", "hello", "world"},
+					Timeout:        timeoutUnlimited,
+					Terminal:       terminal,
+					MaxOutputBytes: 5,
+				})
+
+				Expect(err).To(BeNil())
+				Expect(result.ExitCode).To(BeEquivalentTo(0))
+				Expect(result.Stdout).To(BeEquivalentTo("hello"))
+				Expect(result.StdoutTruncated).To(BeTrue())
+				Expect(result.StderrTruncated).To(BeFalse())
+			})
+
 			It(testName("should succeed with timeout", terminal), func() {
 				tr = newTestRunner()
 				tr.createRuntimeConfigWithProcessArgs(terminal, []string{"/busybox", "sleep", "10"}, nil)
@@ -329,4 +363,4928 @@ var _ = Describe("ConmonClient", func() {
 			})
 		}
 	})
+
+	Describe("UpdateSysctls", func() {
+		for _, terminal := range []bool{true, false} {
+			terminal := terminal
+			It(testName("should succeed to update a namespaced sysctl", terminal), func() {
+				if unshare.IsRootless() {
+					Skip("does not run rootless")
+				}
+
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(terminal, []string{"/busybox", "sleep", "10"}, nil)
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+				tr.startContainer(sut)
+
+				err := sut.UpdateSysctls(context.Background(), tr.ctrID, map[string]string{
+					"net.ipv4.ip_forward": "1",
+				})
+				Expect(err).To(BeNil())
+
+				result, err := sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+					ID:       tr.ctrID,
+					Command:  []string{"/busybox", "cat", "/proc/sys/net/ipv4/ip_forward"},
+					Timeout:  timeoutUnlimited,
+					Terminal: terminal,
+				})
+				Expect(err).To(BeNil())
+				Expect(string(result.Stdout)).To(HavePrefix("1"))
+			})
+
+			It(testName("should reject sysctls which are not allowed", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(terminal, []string{"/busybox", "sleep", "10"}, nil)
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+				tr.startContainer(sut)
+
+				err := sut.UpdateSysctls(context.Background(), tr.ctrID, map[string]string{
+					"net.ipv4.ip_forward": "1",
+					"kernel.pid_max":      "1",
+					"net..invalid":        "1",
+				})
+				Expect(err).NotTo(BeNil())
+
+				var rejectedErr *client.SysctlRejectedError
+				Expect(errors.As(err, &rejectedErr)).To(BeTrue())
+				Expect(rejectedErr.Rejections).To(Equal(map[string]client.SysctlRejectionReason{
+					"kernel.pid_max": client.SysctlRejectionReasonNotAllowed,
+					"net..invalid":   client.SysctlRejectionReasonInvalidKey,
+				}))
+			})
+
+			It(testName("should reject sysctls of namespaces shared with the host", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(terminal, []string{"/busybox", "sleep", "10"},
+					func(g generate.Generator) {
+						Expect(g.RemoveLinuxNamespace("uts")).To(BeNil())
+					},
+				)
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+				tr.startContainer(sut)
+
+				err := sut.UpdateSysctls(context.Background(), tr.ctrID, map[string]string{
+					"kernel.hostname": "conmon",
+				})
+				Expect(err).NotTo(BeNil())
+
+				var rejectedErr *client.SysctlRejectedError
+				Expect(errors.As(err, &rejectedErr)).To(BeTrue())
+				Expect(rejectedErr.Rejections).To(Equal(map[string]client.SysctlRejectionReason{
+					"kernel.hostname": client.SysctlRejectionReasonHostNamespace,
+				}))
+			})
+		}
+	})
+
+	Describe("WatchMounts", func() {
+		for _, terminal := range []bool{true, false} {
+			terminal := terminal
+			It(testName("should receive mount events", terminal), func() {
+				if unshare.IsRootless() {
+					Skip("does not run rootless")
+				}
+
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(
+					terminal,
+					[]string{"/busybox", "sleep", "20"},
+					func(g generate.Generator) {
+						Expect(g.AddProcessCapability("CAP_SYS_ADMIN")).To(BeNil())
+					},
+				)
+				MustDirInTempDir(tr.tmpRootfs, "data")
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+				tr.startContainer(sut)
+
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				events, err := sut.WatchMounts(ctx, tr.ctrID)
+				Expect(err).To(BeNil())
+
+				result, err := sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+					ID:       tr.ctrID,
+					Command:  []string{"/busybox", "mount", "-t", "tmpfs", "tmpfs", "/data"},
+					Timeout:  timeoutUnlimited,
+					Terminal: terminal,
+				})
+				Expect(err).To(BeNil())
+				Expect(result.ExitCode).To(BeZero())
+
+				var event client.MountEvent
+				Eventually(events, time.Second*10).Should(Receive(&event))
+				Expect(event.Type).To(Equal(client.MountEventTypeMount))
+				Expect(event.MountPoint).To(Equal("/data"))
+				Expect(event.FSType).To(Equal("tmpfs"))
+
+				cancel()
+				Eventually(events, time.Second*10).Should(BeClosed())
+			})
+		}
+	})
+
+	Describe("WatchQuota", func() {
+		for _, terminal := range []bool{true, false} {
+			terminal := terminal
+			It(testName("should fire events if thresholds are crossed", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(terminal, []string{"/busybox", "sleep", "20"}, nil)
+				MustDirInTempDir(tr.tmpRootfs, "data")
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+				tr.startContainer(sut)
+
+				const threshold = 1024 * 1024
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				events, err := sut.WatchQuota(ctx, &client.WatchQuotaConfig{
+					ID:         tr.ctrID,
+					Path:       "/data",
+					Thresholds: []uint64{threshold},
+					Interval:   time.Second,
+				})
+				Expect(err).To(BeNil())
+
+				result, err := sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+					ID:       tr.ctrID,
+					Command:  []string{"/busybox", "dd", "if=/dev/zero", "of=/data/file", "bs=1M", "count=2"},
+					Timeout:  timeoutUnlimited,
+					Terminal: terminal,
+				})
+				Expect(err).To(BeNil())
+				Expect(result.ExitCode).To(BeZero())
+
+				var event client.QuotaEvent
+				Eventually(events, time.Second*10).Should(Receive(&event))
+				Expect(event.Path).To(Equal("/data"))
+				Expect(event.Threshold).To(BeEquivalentTo(threshold))
+				Expect(event.Usage).To(BeNumerically(">=", threshold))
+				Expect(event.Exceeded).To(BeTrue())
+
+				result, err = sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+					ID:       tr.ctrID,
+					Command:  []string{"/busybox", "rm", "/data/file"},
+					Timeout:  timeoutUnlimited,
+					Terminal: terminal,
+				})
+				Expect(err).To(BeNil())
+				Expect(result.ExitCode).To(BeZero())
+
+				Eventually(events, time.Second*10).Should(Receive(&event))
+				Expect(event.Exceeded).To(BeFalse())
+			})
+
+			It(testName("should fail without thresholds", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfig(terminal)
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+
+				_, err := sut.WatchQuota(context.Background(), &client.WatchQuotaConfig{
+					ID:   tr.ctrID,
+					Path: "/",
+				})
+				Expect(err).NotTo(BeNil())
+			})
+
+			It(testName("should fail for invalid paths", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfig(terminal)
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+
+				for _, path := range []string{"data", "/data/../etc"} {
+					_, err := sut.WatchQuota(context.Background(), &client.WatchQuotaConfig{
+						ID:         tr.ctrID,
+						Path:       path,
+						Thresholds: []uint64{1},
+					})
+					Expect(err).NotTo(BeNil())
+				}
+			})
+		}
+	})
+
+	Describe("AttachSessions", func() {
+		for _, terminal := range []bool{true, false} {
+			terminal := terminal
+			It(testName("should list and kill attach sessions", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(terminal, []string{"/busybox", "sh"}, nil)
+				sut = tr.configGivenEnv()
+				tr.createContainer(sut, terminal)
+				tr.startContainer(sut)
+
+				stdin, _ := io.Pipe()
+				_, stdout := io.Pipe()
+				_, stderr := io.Pipe()
+				socketPath := filepath.Join(tr.tmpDir, "attach")
+				attachDone := make(chan error)
+				go func() {
+					attachDone <- sut.AttachContainer(context.Background(), &client.AttachConfig{
+						ID:         tr.ctrID,
+						SocketPath: socketPath,
+						Tty:        terminal,
+						Streams: client.AttachStreams{
+							Stdin:  &client.In{stdin},
+							Stdout: &client.Out{stdout},
+							Stderr: &client.Out{stderr},
+						},
+					})
+				}()
+
+				var sessions []client.AttachSession
+				Eventually(func() int {
+					var err error
+					sessions, err = sut.ListAttachSessions(context.Background(), tr.ctrID)
+					Expect(err).To(BeNil())
+
+					return len(sessions)
+				}, time.Second*10).Should(Equal(1))
+				Expect(sessions[0].ID).NotTo(BeEmpty())
+				Expect(sessions[0].SocketPath).To(Equal(socketPath))
+				Expect(sessions[0].PID).To(BeEquivalentTo(os.Getpid()))
+
+				Expect(sut.KillAttachSession(context.Background(), sessions[0].ID)).To(BeNil())
+				Eventually(attachDone, time.Second*10).Should(Receive())
+
+				sessions, err := sut.ListAttachSessions(context.Background(), tr.ctrID)
+				Expect(err).To(BeNil())
+				Expect(sessions).To(BeEmpty())
+
+				Expect(sut.KillAttachSession(context.Background(), "invalid")).NotTo(BeNil())
+			})
+		}
+	})
+
+	Describe("SessionPolicy", func() {
+		for _, terminal := range []bool{true, false} {
+			terminal := terminal
+			It(testName("should terminate attach sessions after the maximum duration", terminal), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(terminal, []string{"/busybox", "sh"}, nil)
+				cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+				cfg.ConmonServerPath = conmonPath
+				cfg.MaxSessionDuration = 3 * time.Second
+				cfg.SessionWarningPeriod = time.Second
+				var err error
+				sut, err = client.New(cfg)
+				Expect(err).To(BeNil())
+				tr.createContainer(sut, terminal)
+				tr.startContainer(sut)
+
+				stdin, _ := io.Pipe()
+				stdoutReader, stdout := io.Pipe()
+				_, stderr := io.Pipe()
+				output := make(chan string)
+				go func() {
+					buf := make([]byte, 1024)
+					for {
+						n, err := stdoutReader.Read(buf)
+						if err != nil {
+							return
+						}
+						output <- string(buf[:n])
+					}
+				}()
+
+				attachDone := make(chan error)
+				go func() {
+					attachDone <- sut.AttachContainer(context.Background(), &client.AttachConfig{
+						ID:         tr.ctrID,
+						SocketPath: filepath.Join(tr.tmpDir, "attach"),
+						Tty:        terminal,
+						Streams: client.AttachStreams{
+							Stdin:  &client.In{stdin},
+							Stdout: &client.Out{stdout},
+							Stderr: &client.Out{stderr},
+						},
+					})
+				}()
+
+				Eventually(output, time.Second*10).Should(Receive(ContainSubstring("will be terminated in 1 seconds")))
+				Eventually(attachDone, time.Second*10).Should(Receive())
+			})
+		}
+	})
+
+	Describe("AttachConn", func() {
+		It("should read and write the session as a single connection", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			conn, err := sut.AttachConn(ctx, &client.AttachConnConfig{
+				ID:         tr.ctrID,
+				SocketPath: filepath.Join(tr.tmpDir, "attach"),
+			})
+			Expect(err).To(BeNil())
+
+			// The data must be transferred without losing or adding any bytes.
+			for _, data := range [][]byte{
+				{'h', 'e', 'l', 'l', 'o', 0, 'w', 'o', 'r', 'l', 'd', 0, 0, '\n'},
+				[]byte("next\n"),
+			} {
+				_, err = conn.Write(data)
+				Expect(err).To(BeNil())
+
+				buf := make([]byte, len(data))
+				_, err = io.ReadFull(conn, buf)
+				Expect(err).To(BeNil())
+				Expect(buf).To(Equal(data))
+			}
+
+			buf := make([]byte, 64)
+
+			cancel()
+			Eventually(func() error {
+				_, err := conn.Read(buf)
+
+				return err
+			}, time.Second*5).ShouldNot(BeNil())
+			Expect(conn.Close()).To(BeNil())
+		})
+	})
+
+	Describe("ExecSession", func() {
+		It("should attach to a running exec session", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			const execSession = "exec-session"
+			execDone := make(chan *client.ExecContainerResult)
+			go func() {
+				defer GinkgoRecover()
+				result, err := sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+					ID:          tr.ctrID,
+					Command:     []string{"/busybox", "sh", "-c", "sleep 3; echo hello"},
+					Timeout:     timeoutUnlimited,
+					ExecSession: execSession,
+				})
+				Expect(err).To(BeNil())
+				execDone <- result
+			}()
+
+			var conn *client.AttachConn
+			Eventually(func() (err error) {
+				conn, err = sut.AttachConn(context.Background(), &client.AttachConnConfig{
+					ID:          tr.ctrID,
+					SocketPath:  filepath.Join(tr.tmpDir, "attach"),
+					ExecSession: execSession,
+				})
+
+				return err
+			}, time.Second*3).Should(BeNil())
+			defer conn.Close()
+
+			_, err := sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+				ID:          tr.ctrID,
+				Command:     []string{"/busybox", "true"},
+				Timeout:     timeoutUnlimited,
+				ExecSession: execSession,
+			})
+			Expect(err).NotTo(BeNil())
+
+			buf := make([]byte, 64)
+			n, err := conn.Read(buf)
+			Expect(err).To(BeNil())
+			Expect(string(buf[:n])).To(ContainSubstring("hello"))
+
+			var result *client.ExecContainerResult
+			Eventually(execDone, time.Second*10).Should(Receive(&result))
+			Expect(result.ExitCode).To(BeZero())
+
+			Eventually(func() error {
+				return sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:          tr.ctrID,
+					SocketPath:  filepath.Join(tr.tmpDir, "attach-finished"),
+					ExecSession: execSession,
+				})
+			}, time.Second*3).ShouldNot(BeNil())
+
+			err = sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:          tr.ctrID,
+				SocketPath:  filepath.Join(tr.tmpDir, "attach-invalid"),
+				ExecSession: "invalid",
+			})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("WithStreamTransform", func() {
+		It("should transform the attach streams", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdin, stdinWrite := io.Pipe()
+			stdoutRead, stdout := io.Pipe()
+			_, stderr := io.Pipe()
+			cfg := (&client.AttachConfig{
+				ID:         tr.ctrID,
+				SocketPath: filepath.Join(tr.tmpDir, "attach"),
+				Streams: client.AttachStreams{
+					Stdin:  &client.In{stdin},
+					Stdout: &client.Out{stdout},
+					Stderr: &client.Out{stderr},
+				},
+			}).WithStreamTransform(client.StreamTransform{
+				Stdin: func(r io.Reader) io.Reader {
+					return io.MultiReader(strings.NewReader("prefix "), r)
+				},
+			}).WithStreamTransform(client.StreamTransform{
+				Stdout: func(w io.Writer) io.Writer {
+					return upperWriter{w}
+				},
+			})
+			go func() {
+				defer GinkgoRecover()
+				Expect(sut.AttachContainer(context.Background(), cfg)).To(BeNil())
+			}()
+
+			_, err := fmt.Fprintf(stdinWrite, "hello world\n")
+			Expect(err).To(BeNil())
+
+			line, err := bufio.NewReader(stdoutRead).ReadString('\n')
+			Expect(err).To(BeNil())
+			Expect(line).To(ContainSubstring("PREFIX HELLO WORLD"))
+		})
+	})
+
+	Describe("UnroutablePacketPolicy", func() {
+		It("should pass packets without destination to the callback", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdin, stdinWrite := io.Pipe()
+			frames := make(chan []byte, 1)
+			go func() {
+				defer GinkgoRecover()
+				Expect(sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:         tr.ctrID,
+					SocketPath: filepath.Join(tr.tmpDir, "attach"),
+					Streams: client.AttachStreams{
+						Stdin: &client.In{stdin},
+					},
+					UnroutablePacketPolicy: client.UnroutablePacketPolicyCallback,
+					OnUnroutablePacket: func(packet *client.UnroutablePacket) error {
+						Expect(packet.Reason).To(Equal(client.UnroutablePacketReasonNoDestination))
+						Expect(packet.Type).To(Equal(byte(2)))
+						frames <- append([]byte{}, packet.Frame...)
+
+						return nil
+					},
+				})).To(BeNil())
+			}()
+
+			_, err := fmt.Fprintf(stdinWrite, "hello world\n")
+			Expect(err).To(BeNil())
+
+			var frame []byte
+			Eventually(frames, time.Second*10).Should(Receive(&frame))
+			Expect(frame).To(Equal(append([]byte{2}, "hello world\n"...)))
+		})
+	})
+
+	Describe("SequencedOutput", func() {
+		It("should pass the output of both streams in sequence to the callback", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdin, stdinWrite := io.Pipe()
+			chunks := make(chan client.OutputChunk, 10)
+			go func() {
+				defer GinkgoRecover()
+				Expect(sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:              tr.ctrID,
+					SocketPath:      filepath.Join(tr.tmpDir, "attach"),
+					SequencedOutput: true,
+					Streams: client.AttachStreams{
+						Stdin: &client.In{stdin},
+					},
+					OnOutput: func(chunk *client.OutputChunk) error {
+						chunk.Data = append([]byte{}, chunk.Data...)
+						chunks <- *chunk
+
+						return nil
+					},
+				})).To(BeNil())
+			}()
+
+			sequences := []uint64{}
+			for _, line := range []string{"first\n", "second\n"} {
+				_, err := fmt.Fprint(stdinWrite, line)
+				Expect(err).To(BeNil())
+
+				var chunk client.OutputChunk
+				Eventually(chunks, time.Second*10).Should(Receive(&chunk))
+				Expect(chunk.Stream).To(Equal("stdout"))
+				Expect(string(chunk.Data)).To(Equal(line))
+				sequences = append(sequences, chunk.Sequence)
+			}
+			Expect(sequences[1]).To(BeNumerically(">", sequences[0]))
+		})
+
+		It("should fail together with a simulated terminal", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			err := sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:               tr.ctrID,
+				SocketPath:       filepath.Join(tr.tmpDir, "attach"),
+				SequencedOutput:  true,
+				SimulateTerminal: true,
+			})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("ExecContainer", func() {
+		It("should run an interactive exec session", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			exec, err := sut.ExecContainer(context.Background(), &client.ExecContainerConfig{
+				ID:      tr.ctrID,
+				Command: []string{"/busybox", "cat"},
+			})
+			Expect(err).To(BeNil())
+			Expect(exec.ExecSession).NotTo(BeEmpty())
+			Expect(exec.PID).NotTo(BeZero())
+
+			conn, err := sut.AttachConn(context.Background(), &client.AttachConnConfig{
+				ID:          tr.ctrID,
+				SocketPath:  filepath.Join(tr.tmpDir, "attach"),
+				ExecSession: exec.ExecSession,
+			})
+			Expect(err).To(BeNil())
+			defer conn.Close()
+
+			_, err = conn.Write([]byte("hello\n"))
+			Expect(err).To(BeNil())
+
+			buf := make([]byte, len("hello\n"))
+			_, err = io.ReadFull(conn, buf)
+			Expect(err).To(BeNil())
+			Expect(string(buf)).To(Equal("hello\n"))
+
+			_, err = sut.ExecContainer(context.Background(), &client.ExecContainerConfig{
+				ID:          tr.ctrID,
+				Command:     []string{"/busybox", "true"},
+				ExecSession: exec.ExecSession,
+			})
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should resize the terminal of an exec session", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			exec, err := sut.ExecContainer(context.Background(), &client.ExecContainerConfig{
+				ID:       tr.ctrID,
+				Command:  []string{"/busybox", "sh"},
+				Terminal: true,
+			})
+			Expect(err).To(BeNil())
+
+			Expect(sut.SetWindowSizeContainer(context.Background(), &client.SetWindowSizeContainerConfig{
+				ID:          tr.ctrID,
+				ExecSession: exec.ExecSession,
+				Size:        &client.TerminalSize{Width: 10, Height: 20},
+			})).To(BeNil())
+		})
+	})
+
+	Describe("VerifyIOPath", func() {
+		It("should verify the echo and the log of a container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			report, err := sut.VerifyIOPath(context.Background(), tr.ctrID, &client.VerifyIOPathOptions{
+				Command: []string{"/busybox", "cat"},
+			})
+			Expect(err).To(BeNil())
+			Expect(report.Echoed()).To(BeTrue())
+			Expect(report.Logged()).To(BeTrue())
+			Expect(report.Bytes).NotTo(BeZero())
+			Expect(report.EchoedBytes).To(Equal(report.Bytes))
+			Expect(report.FirstByte).To(BeNumerically("<=", report.RoundTrip))
+			Expect(report.LogLatency).NotTo(BeZero())
+		})
+
+		It("should report a mismatch if the command does not echo", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			report, err := sut.VerifyIOPath(ctx, tr.ctrID, &client.VerifyIOPathOptions{
+				Command: []string{"/busybox", "head", "-c", "10"},
+			})
+			Expect(errors.Is(err, client.ErrIOPathMismatch)).To(BeTrue())
+			Expect(report.Echoed()).To(BeFalse())
+			Expect(report.EchoedBytes).To(BeEquivalentTo(10))
+		})
+	})
+
+	Describe("OnSessionEnd", func() {
+		It("should summarize a detached session", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdin, stdinWrite := io.Pipe()
+			stdoutRead, stdout := io.Pipe()
+			summaries := make(chan *client.AttachSessionSummary, 1)
+			go func() {
+				defer GinkgoRecover()
+				err := sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:         tr.ctrID,
+					SocketPath: filepath.Join(tr.tmpDir, "attach"),
+					Streams: client.AttachStreams{
+						Stdin:  &client.In{stdin},
+						Stdout: &client.Out{stdout},
+					},
+					DetachKeys: []byte{16, 17},
+					OnSessionEnd: func(summary *client.AttachSessionSummary) {
+						summaries <- summary
+					},
+				})
+				Expect(errors.Is(err, client.ErrDetach)).To(BeTrue())
+			}()
+
+			_, err := fmt.Fprintf(stdinWrite, "hello\n")
+			Expect(err).To(BeNil())
+			line, err := bufio.NewReader(stdoutRead).ReadString('\n')
+			Expect(err).To(BeNil())
+			Expect(line).To(Equal("hello\n"))
+
+			_, err = stdinWrite.Write([]byte{16, 17})
+			Expect(err).To(BeNil())
+
+			var summary *client.AttachSessionSummary
+			Eventually(summaries, time.Second*10).Should(Receive(&summary))
+			Expect(summary.Reason).To(Equal(client.AttachSessionEndReasonDetach))
+			Expect(summary.BytesIn).To(BeNumerically(">=", 6))
+			Expect(summary.BytesOut).To(BeEquivalentTo(6))
+			Expect(summary.Duration).To(BeNumerically(">", 0))
+			Expect(errors.Is(summary.LastError, client.ErrDetach)).To(BeTrue())
+		})
+	})
+
+	Describe("SLOReporter", func() {
+		It("should report the latency and throughput of attach sessions", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			summaries := make(chan *client.SLOSummary, 10)
+			reporter := client.NewSLOReporter(&client.SLOReporterConfig{
+				Interval:  time.Millisecond * 100,
+				OnSummary: func(summary *client.SLOSummary) { summaries <- summary },
+			})
+			defer reporter.Close()
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.SLOReporter = reporter
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdin, stdinWrite := io.Pipe()
+			stdoutRead, stdout := io.Pipe()
+			attachDone := make(chan error, 1)
+			go func() {
+				attachDone <- sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:         tr.ctrID,
+					SocketPath: filepath.Join(tr.tmpDir, "attach"),
+					Streams: client.AttachStreams{
+						Stdin:  &client.In{stdin},
+						Stdout: &client.Out{stdout},
+					},
+					DetachKeys: []byte{16, 17},
+				})
+			}()
+
+			_, err = fmt.Fprintf(stdinWrite, "hello\n")
+			Expect(err).To(BeNil())
+			line, err := bufio.NewReader(stdoutRead).ReadString('\n')
+			Expect(err).To(BeNil())
+			Expect(line).To(Equal("hello\n"))
+
+			_, err = stdinWrite.Write([]byte{16, 17})
+			Expect(err).To(BeNil())
+			Eventually(attachDone, time.Second*10).Should(Receive(MatchError(client.ErrDetach)))
+
+			snapshot := reporter.Snapshot()
+			Expect(snapshot.Latency[client.SLOSessionKindAttach].Count).To(BeEquivalentTo(1))
+			Expect(snapshot.Throughput[client.SLOSessionKindAttach].Count).To(BeEquivalentTo(1))
+
+			var summary *client.SLOSummary
+			Eventually(func() uint64 {
+				Eventually(summaries, time.Second*5).Should(Receive(&summary))
+
+				return summary.Sessions
+			}, time.Second*5).Should(BeEquivalentTo(1))
+			Expect(summary.Kind).To(Equal(client.SLOSessionKindAttach))
+		})
+	})
+
+	Describe("WithTenant", func() {
+		It("should scope containers to their tenant", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			teamA := sut.WithTenant("team-a")
+			tr.createContainer(teamA, false)
+			tr.startContainer(teamA)
+
+			reopen := func(c *client.ConmonClient) error {
+				return c.ReopenLogContainer(context.Background(), &client.ReopenLogContainerConfig{
+					ID: tr.ctrID,
+				})
+			}
+			Expect(reopen(teamA)).To(BeNil())
+			Expect(reopen(sut.WithTenant("team-b"))).NotTo(BeNil())
+			Expect(reopen(sut)).NotTo(BeNil())
+		})
+	})
+
+	Describe("PortForwardContainer", func() {
+		It("should forward a connection into the container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false,
+				[]string{"/busybox", "nc", "-l", "-p", "8080", "-e", "/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			local, remote := net.Pipe()
+			defer local.Close()
+
+			// Retry until the port is listening, the call blocks afterwards.
+			errs := make(chan error, 1)
+			Eventually(func() error {
+				go func() {
+					errs <- sut.PortForwardContainer(ctx, &client.PortForwardConfig{
+						ID:         tr.ctrID,
+						Port:       8080,
+						SocketPath: filepath.Join(tr.tmpDir, "port-forward"),
+						Stream:     remote,
+					})
+				}()
+				select {
+				case err := <-errs:
+					return err
+				case <-time.After(time.Second):
+					return nil
+				}
+			}, time.Second*10).Should(BeNil())
+
+			_, err := local.Write([]byte("hello\n"))
+			Expect(err).To(BeNil())
+
+			buf := make([]byte, len("hello\n"))
+			_, err = io.ReadFull(local, buf)
+			Expect(err).To(BeNil())
+			Expect(string(buf)).To(Equal("hello\n"))
+		})
+
+		It("should forward a connection via IPv6", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false,
+				[]string{"/busybox", "nc", "-l", "-p", "8081", "-e", "/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			local, remote := net.Pipe()
+			defer local.Close()
+
+			infos := make(chan *client.PortForwardInfo, 1)
+			errs := make(chan error, 1)
+			Eventually(func() error {
+				go func() {
+					errs <- sut.PortForwardContainer(ctx, &client.PortForwardConfig{
+						ID:          tr.ctrID,
+						Port:        8081,
+						Family:      client.AddressFamilyIPv6,
+						SocketPath:  filepath.Join(tr.tmpDir, "port-forward"),
+						Stream:      remote,
+						OnConnected: func(info *client.PortForwardInfo) { infos <- info },
+					})
+				}()
+				select {
+				case err := <-errs:
+					return err
+				case <-time.After(time.Second):
+					return nil
+				}
+			}, time.Second*10).Should(BeNil())
+
+			var info *client.PortForwardInfo
+			Eventually(infos).Should(Receive(&info))
+			Expect(info.Family).To(Equal(client.AddressFamilyIPv6))
+			Expect(info.Address.Addr().Is6()).To(BeTrue())
+			Expect(info.Address.Port()).To(BeEquivalentTo(8081))
+
+			_, err := local.Write([]byte("hello\n"))
+			Expect(err).To(BeNil())
+
+			buf := make([]byte, len("hello\n"))
+			_, err = io.ReadFull(local, buf)
+			Expect(err).To(BeNil())
+			Expect(string(buf)).To(Equal("hello\n"))
+		})
+
+		It("should fail if the host does not match the address family", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			err := sut.PortForwardContainer(context.Background(), &client.PortForwardConfig{
+				ID:         tr.ctrID,
+				Port:       8080,
+				Family:     client.AddressFamilyIPv4,
+				Host:       "::1",
+				SocketPath: filepath.Join(tr.tmpDir, "port-forward"),
+				Stream:     &bytes.Buffer{},
+			})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("ContainerStats", func() {
+		It("should retrieve the container statistics", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stats, err := sut.ContainerStats(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(stats.Timestamp).NotTo(BeZero())
+			Expect(stats.Memory.UsageBytes).NotTo(BeZero())
+			Expect(stats.PIDs.Current).To(BeEquivalentTo(1))
+			Expect(stats.Network.IPv4.Available || stats.Network.IPv6.Available).To(BeTrue())
+		})
+
+		It("should stream the container statistics", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			stats, err := sut.StreamStats(ctx, tr.ctrID, time.Second)
+			Expect(err).To(BeNil())
+
+			var first, second client.ContainerStats
+			Eventually(stats, time.Second*5).Should(Receive(&first))
+			Eventually(stats, time.Second*5).Should(Receive(&second))
+			Expect(second.Timestamp.After(first.Timestamp)).To(BeTrue())
+
+			cancel()
+			Eventually(stats, time.Second*5).Should(BeClosed())
+		})
+
+		It("should fail if the container does not exist", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			_, err := sut.ContainerStats(context.Background(), tr.ctrID)
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should serve the statistics from the cache", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.StatsCacheMaxStaleness = time.Second
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			first, err := sut.ContainerStats(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			cached, err := sut.ContainerStats(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(cached.Timestamp).To(Equal(first.Timestamp))
+
+			// The cache gets refreshed in the background.
+			Eventually(func() time.Time {
+				stats, err := sut.ContainerStats(context.Background(), tr.ctrID)
+				Expect(err).To(BeNil())
+
+				return stats.Timestamp
+			}, time.Second*5).Should(BeTemporally(">", first.Timestamp))
+
+			stats, err := sut.ListContainerStats(context.Background(), tr.ctrID, "missing")
+			Expect(err).NotTo(BeNil())
+			Expect(stats).To(HaveLen(1))
+			Expect(stats).To(HaveKey(tr.ctrID))
+
+			_, err = sut.Close(time.Second)
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Describe("Close", func() {
+		attach := func(c *client.ConmonClient, id, name string) <-chan error {
+			stdin, _ := io.Pipe()
+			_, stdout := io.Pipe()
+			_, stderr := io.Pipe()
+			attachDone := make(chan error, 1)
+			go func() {
+				attachDone <- c.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:         id,
+					SocketPath: filepath.Join(tr.tmpDir, name),
+					Streams: client.AttachStreams{
+						Stdin:  &client.In{stdin},
+						Stdout: &client.Out{stdout},
+						Stderr: &client.Out{stderr},
+					},
+				})
+			}()
+			Eventually(func() (int, error) {
+				sessions, err := sut.ListAttachSessions(context.Background(), id)
+
+				return len(sessions), err
+			}, time.Second*5).Should(Equal(1))
+
+			return attachDone
+		}
+
+		It("should drain the active attach sessions", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "3"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+			attachDone := attach(sut, tr.ctrID, "attach")
+
+			// The session ends with the container within the drain timeout.
+			results, err := sut.Close(time.Second * 10)
+			Expect(err).To(BeNil())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].ID).To(Equal(tr.ctrID))
+			Expect(results[0].Forced).To(BeFalse())
+			Expect(results[0].Err).To(BeNil())
+			Expect(attachDone).To(Receive(BeNil()))
+		})
+
+		It("should force-close sessions after the drain timeout", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+			attachDone := attach(sut, tr.ctrID, "attach")
+
+			results, err := sut.Close(time.Millisecond * 100)
+			Expect(err).To(BeNil())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Forced).To(BeTrue())
+			// The session ended once its connection got closed.
+			Expect(errors.Is(results[0].Err, client.ErrAttachSessionHung)).To(BeFalse())
+			Eventually(attachDone, time.Second*5).Should(Receive())
+		})
+
+		It("should only force-close the sessions which did not end", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ending := newTestRunner()
+			defer func() {
+				Expect(ending.rr.RunCommand("delete", "-f", ending.ctrID)).To(BeNil())
+				Expect(os.RemoveAll(ending.tmpDir)).To(BeNil())
+			}()
+			ending.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "1"}, nil)
+			// The server runs all containers within its runtime root.
+			ending.rr = tr.rr
+			ending.createContainer(sut, false)
+			ending.startContainer(sut)
+
+			endingDone := attach(sut, ending.ctrID, "attach-ending")
+			hangingDone := attach(sut, tr.ctrID, "attach-hanging")
+
+			// The first session ends with its container within the drain
+			// timeout, while the second one has to be force-closed.
+			results, err := sut.Close(time.Second * 5)
+			Expect(err).To(BeNil())
+			Expect(results).To(HaveLen(2))
+			Expect(results[0].ID).To(Equal(ending.ctrID))
+			Expect(results[0].Forced).To(BeFalse())
+			Expect(results[0].Err).To(BeNil())
+			Expect(results[1].ID).To(Equal(tr.ctrID))
+			Expect(results[1].Forced).To(BeTrue())
+			Expect(errors.Is(results[1].Err, client.ErrAttachSessionHung)).To(BeFalse())
+			Expect(endingDone).To(Receive(BeNil()))
+			Eventually(hangingDone, time.Second*5).Should(Receive())
+		})
+
+		It("should force-close multiplexed sessions of tenant scoped clients", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.MultiplexAttachSessions = true
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+			attachDone := attach(sut.WithTenant(""), tr.ctrID, "attach")
+
+			results, err := sut.Close(time.Millisecond * 100)
+			Expect(err).To(BeNil())
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Forced).To(BeTrue())
+			Eventually(attachDone, time.Second*5).Should(Receive())
+		})
+
+		It("should reject calls after Close", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+			scoped := sut.WithTenant("other")
+
+			results, err := sut.Close(time.Second)
+			Expect(err).To(BeNil())
+			Expect(results).To(BeEmpty())
+
+			_, err = sut.Version(context.Background())
+			Expect(errors.Is(err, client.ErrClientClosed)).To(BeTrue())
+
+			// Clients returned by WithTenant are closed as well.
+			_, err = scoped.Version(context.Background())
+			Expect(errors.Is(err, client.ErrClientClosed)).To(BeTrue())
+
+			err = sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:         tr.ctrID,
+				SocketPath: filepath.Join(tr.tmpDir, "attach"),
+			})
+			Expect(errors.Is(err, client.ErrClientClosed)).To(BeTrue())
+		})
+	})
+
+	Describe("WatchExits", func() {
+		var (
+			exitDir string
+			exits   chan *client.ContainerExit
+		)
+
+		watchExits := func(track ...string) *client.ExitWatcher {
+			exitDir = MustDirInTempDir(tr.tmpDir, "exits")
+			exits = make(chan *client.ContainerExit, 10)
+			for _, id := range []string{"first", "second"} {
+				Expect(os.WriteFile(filepath.Join(exitDir, id), []byte("1"), 0o600)).To(BeNil())
+			}
+
+			watcher, err := sut.WatchExits(context.Background(), &client.ExitWatcherConfig{
+				ExitDir: exitDir,
+				OnExit:  func(exit *client.ContainerExit) { exits <- exit },
+				Track:   track,
+			})
+			Expect(err).To(BeNil())
+
+			return watcher
+		}
+
+		It("should deliver the existing and written exit files once", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+			watcher := watchExits()
+			defer watcher.Close()
+
+			// The existing files are delivered by the initial scan.
+			ids := []string{}
+			for range []string{"first", "second"} {
+				var exit *client.ContainerExit
+				Eventually(exits, time.Second*5).Should(Receive(&exit))
+				Expect(exit.ExitCode).To(BeEquivalentTo(1))
+				Expect(exit.Reconciled).To(BeFalse())
+				ids = append(ids, exit.ID)
+			}
+			Expect(ids).To(ConsistOf("first", "second"))
+
+			// New files are delivered via the notifier.
+			Expect(os.WriteFile(filepath.Join(exitDir, "third"), []byte("3\n"), 0o600)).To(BeNil())
+			var exit *client.ContainerExit
+			Eventually(exits, time.Second*5).Should(Receive(&exit))
+			Expect(exit.ID).To(Equal("third"))
+			Expect(exit.ExitCode).To(BeEquivalentTo(3))
+
+			// Exits are not delivered twice.
+			Expect(os.WriteFile(filepath.Join(exitDir, "first"), []byte("1"), 0o600)).To(BeNil())
+			Consistently(exits, time.Second*2).ShouldNot(Receive())
+		})
+
+		It("should deliver the exit file of containers tracked later", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+			watcher := watchExits()
+			defer watcher.Close()
+			Eventually(exits, time.Second*5).Should(Receive())
+			Eventually(exits, time.Second*5).Should(Receive())
+
+			watcher.Forget("first")
+			watcher.Track("first")
+			var exit *client.ContainerExit
+			Expect(exits).To(Receive(&exit))
+			Expect(exit.ID).To(Equal("first"))
+		})
+
+		It("should reconcile the exits of tracked containers via the server", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "sleep 1; exit 3"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			// The server writes the exit file of the container elsewhere.
+			watcher := watchExits(tr.ctrID)
+			defer watcher.Close()
+			Eventually(exits, time.Second*5).Should(Receive())
+			Eventually(exits, time.Second*5).Should(Receive())
+
+			_, err := sut.WaitContainer(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(watcher.Reconcile(context.Background())).To(BeNil())
+
+			var exit *client.ContainerExit
+			Eventually(exits, time.Second*5).Should(Receive(&exit))
+			Expect(exit.ID).To(Equal(tr.ctrID))
+			Expect(exit.ExitCode).To(BeEquivalentTo(3))
+			Expect(exit.Reconciled).To(BeTrue())
+		})
+	})
+
+	Describe("TenantQuota", func() {
+		It("should enforce the quotas of a tenant", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.TenantMaxContainers = 1
+			cfg.TenantMaxExecSessions = 1
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			teamA := sut.WithTenant("team-a")
+			tr.createContainer(teamA, false)
+			tr.startContainer(teamA)
+
+			_, err = teamA.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:         "other",
+				BundlePath: tr.tmpDir,
+			})
+			var quotaErr *client.QuotaExceededError
+			Expect(errors.As(err, &quotaErr)).To(BeTrue())
+			Expect(quotaErr.Resource).To(Equal(client.QuotaResourceContainers))
+			Expect(quotaErr.Limit).To(BeEquivalentTo(1))
+			Expect(errors.Is(err, client.ErrQuotaExceeded)).To(BeTrue())
+
+			execCfg := &client.ExecContainerConfig{
+				ID:      tr.ctrID,
+				Command: []string{"/busybox", "sleep", "10"},
+			}
+			_, err = teamA.ExecContainer(context.Background(), execCfg)
+			Expect(err).To(BeNil())
+			_, err = teamA.ExecContainer(context.Background(), execCfg)
+			Expect(errors.As(err, &quotaErr)).To(BeTrue())
+			Expect(quotaErr.Resource).To(Equal(client.QuotaResourceExecSessions))
+
+			quota, err := teamA.TenantQuota(context.Background())
+			Expect(err).To(BeNil())
+			Expect(quota.Containers).To(Equal(client.QuotaUsage{Used: 1, Limit: 1}))
+			Expect(quota.ExecSessions).To(Equal(client.QuotaUsage{Used: 1, Limit: 1}))
+			Expect(quota.LogBytes.Limit).To(BeZero())
+
+			quota, err = sut.WithTenant("team-b").TenantQuota(context.Background())
+			Expect(err).To(BeNil())
+			Expect(quota.Containers).To(Equal(client.QuotaUsage{Used: 0, Limit: 1}))
+		})
+	})
+
+	Describe("Reconcile", func() {
+		It("should create and stop containers to match the desired state", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			desired := []client.ContainerSpec{{
+				Config: &client.CreateContainerConfig{
+					ID:         tr.ctrID,
+					BundlePath: tr.tmpDir,
+					ExitPaths:  []string{tr.exitPath()},
+				},
+			}}
+
+			report, err := sut.Reconcile(context.Background(), desired)
+			Expect(err).To(BeNil())
+			Expect(report.Created).To(Equal([]string{tr.ctrID}))
+			Expect(report.Stopped).To(BeEmpty())
+			Expect(report.Unchanged).To(BeEmpty())
+			tr.startContainer(sut)
+
+			report, err = sut.Reconcile(context.Background(), desired)
+			Expect(err).To(BeNil())
+			Expect(report.Created).To(BeEmpty())
+			Expect(report.Unchanged).To(Equal([]string{tr.ctrID}))
+
+			containers, err := sut.ListContainers(context.Background())
+			Expect(err).To(BeNil())
+			Expect(containers).To(HaveLen(1))
+			Expect(containers[0].ID).To(Equal(tr.ctrID))
+			Expect(containers[0].PID).NotTo(BeZero())
+
+			report, err = sut.ReconcileWithConfig(context.Background(), &client.ReconcileConfig{
+				StopTimeout: time.Second,
+			})
+			Expect(err).To(BeNil())
+			Expect(report.Stopped).To(Equal([]string{tr.ctrID}))
+			Expect(report.Failed).To(BeEmpty())
+			Eventually(func() ([]client.ContainerInfo, error) {
+				return sut.ListContainers(context.Background())
+			}, time.Second*5).Should(BeEmpty())
+		})
+
+		It("should reject duplicate container IDs", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+			spec := client.ContainerSpec{Config: &client.CreateContainerConfig{ID: tr.ctrID}}
+
+			_, err := sut.Reconcile(context.Background(), []client.ContainerSpec{spec, spec})
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should compare the normalized container IDs", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.IDValidator = &client.IDRules{MinLength: 64, MaxLength: 64, LowercaseHex: true}
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			upper := client.ContainerSpec{Config: &client.CreateContainerConfig{
+				ID:         strings.ToUpper(tr.ctrID),
+				BundlePath: tr.tmpDir,
+			}}
+			report, err := sut.Reconcile(context.Background(), []client.ContainerSpec{upper})
+			Expect(err).To(BeNil())
+			Expect(report.Unchanged).To(Equal([]string{tr.ctrID}))
+			Expect(report.Stopped).To(BeEmpty())
+			Expect(report.Created).To(BeEmpty())
+
+			lower := client.ContainerSpec{Config: &client.CreateContainerConfig{ID: tr.ctrID}}
+			_, err = sut.Reconcile(context.Background(), []client.ContainerSpec{upper, lower})
+			Expect(err).NotTo(BeNil())
+
+			invalid := client.ContainerSpec{Config: &client.CreateContainerConfig{ID: "container"}}
+			_, err = sut.Reconcile(context.Background(), []client.ContainerSpec{invalid})
+			Expect(errors.Is(err, client.ErrInvalidID)).To(BeTrue())
+		})
+	})
+
+	Describe("WatchContainerEvents", func() {
+		It("should report the exit of a container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "exit 3"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			events, err := sut.WatchContainerEvents(ctx, "")
+			Expect(err).To(BeNil())
+			tr.startContainer(sut)
+
+			var event client.ContainerEvent
+			Eventually(events, time.Second*10).Should(Receive(&event))
+			Expect(event.Type).To(Equal(client.ContainerEventTypeExited))
+			Expect(event.ID).To(Equal(tr.ctrID))
+			Expect(event.ExitCode).To(BeEquivalentTo(3))
+			Expect(event.Timestamp).NotTo(BeZero())
+		})
+
+		It("should report paused and resumed containers", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			events, err := sut.WatchContainerEvents(ctx, tr.ctrID)
+			Expect(err).To(BeNil())
+
+			var event client.ContainerEvent
+			Expect(tr.rr.RunCommand("pause", tr.ctrID)).To(BeNil())
+			Eventually(events, time.Second*5).Should(Receive(&event))
+			Expect(event.Type).To(Equal(client.ContainerEventTypePaused))
+
+			Expect(tr.rr.RunCommand("resume", tr.ctrID)).To(BeNil())
+			Eventually(events, time.Second*5).Should(Receive(&event))
+			Expect(event.Type).To(Equal(client.ContainerEventTypeResumed))
+		})
+
+		It("should not report events of other tenants", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "exit 3"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			events, err := sut.WithTenant("other").WatchContainerEvents(ctx, "")
+			Expect(err).To(BeNil())
+			tr.startContainer(sut)
+
+			Consistently(events, time.Second*3).ShouldNot(Receive())
+		})
+	})
+
+	Describe("CollectSupportBundle", func() {
+		It("should collect the server state", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			var buf bytes.Buffer
+			Expect(sut.CollectSupportBundle(context.Background(), &buf)).To(BeNil())
+
+			gzipReader, err := gzip.NewReader(&buf)
+			Expect(err).To(BeNil())
+			tarReader := tar.NewReader(gzipReader)
+			files := map[string]string{}
+			for {
+				header, err := tarReader.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				Expect(err).To(BeNil())
+				data, err := io.ReadAll(tarReader)
+				Expect(err).To(BeNil())
+				files[header.Name] = string(data)
+			}
+
+			Expect(files).To(HaveKey("version.json"))
+			Expect(files).To(HaveKey("config.txt"))
+			Expect(files).To(HaveKey("events.json"))
+			Expect(files["containers.json"]).To(ContainSubstring(tr.ctrID))
+			Expect(files["stats.json"]).To(ContainSubstring(tr.ctrID))
+			Expect(files["server.log"]).To(ContainSubstring("create container"))
+		})
+
+		It("should not be available to other tenants", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			var buf bytes.Buffer
+			Expect(sut.WithTenant("team-a").CollectSupportBundle(context.Background(), &buf)).NotTo(BeNil())
+		})
+	})
+
+	Describe("WaitContainer", func() {
+		It("should return the exit code of a container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "sleep 1; exit 3"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			result, err := sut.WaitContainer(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(result.ExitCode).To(BeEquivalentTo(3))
+			Expect(result.ExitedAt).NotTo(BeZero())
+
+			// The exit is remembered after the container is gone.
+			result, err = sut.WaitContainer(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(result.ExitCode).To(BeEquivalentTo(3))
+		})
+
+		It("should honor the context deadline", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			_, err := sut.WaitContainer(ctx, tr.ctrID)
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+
+		It("should fail for an unknown container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			_, err := sut.WaitContainer(context.Background(), "unknown")
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should return the exit code of an exec session", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			events, err := sut.WatchContainerEvents(ctx, tr.ctrID)
+			Expect(err).To(BeNil())
+
+			exec, err := sut.ExecContainer(context.Background(), &client.ExecContainerConfig{
+				ID:      tr.ctrID,
+				Command: []string{"/busybox", "sh", "-c", "sleep 1; exit 5"},
+			})
+			Expect(err).To(BeNil())
+
+			result, err := sut.WaitExecSession(context.Background(), tr.ctrID, exec.ExecSession)
+			Expect(err).To(BeNil())
+			Expect(result.ExitCode).To(BeEquivalentTo(5))
+
+			// The exit is remembered after the exec session is gone.
+			result, err = sut.WaitExecSession(context.Background(), tr.ctrID, exec.ExecSession)
+			Expect(err).To(BeNil())
+			Expect(result.ExitCode).To(BeEquivalentTo(5))
+
+			var event client.ContainerEvent
+			Eventually(func() client.ContainerEventType {
+				Eventually(events, time.Second*5).Should(Receive(&event))
+				return event.Type
+			}, time.Second*10).Should(Equal(client.ContainerEventTypeExecExited))
+			Expect(event.ExecSession).To(Equal(exec.ExecSession))
+			Expect(event.ExitCode).To(BeEquivalentTo(5))
+
+			// Exec exits do not end the wait for the container.
+			waitCtx, waitCancel := context.WithTimeout(context.Background(), time.Second)
+			defer waitCancel()
+			_, err = sut.WaitContainer(waitCtx, tr.ctrID)
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+	})
+
+	Describe("GetTombstone", func() {
+		It("should return the tombstone of a removed container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "sleep 2; exit 3"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.TombstoneRetention = time.Minute
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			_, err = sut.ContainerStats(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			_, err = sut.WaitContainer(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+
+			var tombstone *client.Tombstone
+			Eventually(func() error {
+				tombstone, err = sut.GetTombstone(context.Background(), tr.ctrID)
+
+				return err
+			}, time.Second*5).Should(BeNil())
+			Expect(tombstone.ID).To(Equal(tr.ctrID))
+			Expect(tombstone.ExitCode).To(BeEquivalentTo(3))
+			Expect(tombstone.RemovedAt).NotTo(BeZero())
+			Expect(tombstone.Stats).NotTo(BeNil())
+		})
+
+		It("should fail if tombstones are disabled", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "true"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			_, err := sut.WaitContainer(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			_, err = sut.GetTombstone(context.Background(), tr.ctrID)
+			Expect(errors.Is(err, client.ErrContainerNotFound)).To(BeTrue())
+		})
+	})
+
+	Describe("GetState", func() {
+		It("should report the lifecycle of a container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "sleep 2; exit 3"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.StateRetention = time.Second
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+
+			state, err := sut.GetState(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(state.State).To(Equal(client.ContainerStateCreated))
+			Expect(state.PID).NotTo(BeZero())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			events, err := sut.WatchContainerEvents(ctx, tr.ctrID)
+			Expect(err).To(BeNil())
+			tr.startContainer(sut)
+
+			for _, want := range []client.ContainerState{
+				client.ContainerStateRunning,
+				client.ContainerStateExited,
+				client.ContainerStateRemoved,
+			} {
+				var event client.ContainerEvent
+				isStateChanged := HaveField("Type", client.ContainerEventTypeStateChanged)
+				Eventually(events, time.Second*10).Should(Receive(&event, isStateChanged))
+				Expect(event.State).To(Equal(want))
+			}
+
+			state, err = sut.GetState(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(state.State).To(Equal(client.ContainerStateRemoved))
+			Expect(state.ExitCode).To(BeEquivalentTo(3))
+			Expect(state.UpdatedAt).NotTo(BeZero())
+		})
+
+		It("should fail for unknown containers", func() {
+			tr = newTestRunner()
+			sut = tr.configGivenEnv()
+
+			_, err := sut.GetState(context.Background(), "unknown")
+			Expect(errors.Is(err, client.ErrContainerNotFound)).To(BeTrue())
+		})
+	})
+
+	Describe("StateBackend", func() {
+		for _, backend := range []string{client.StateBackendFile, client.StateBackendSqlite} {
+			backend := backend
+			It("should restore tombstones after a restart with the "+backend+" backend", func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "exit 3"}, nil)
+				cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+				cfg.ConmonServerPath = conmonPath
+				cfg.TombstoneRetention = time.Minute
+				cfg.StateBackend = backend
+				var err error
+				sut, err = client.New(cfg)
+				Expect(err).To(BeNil())
+				tr.createContainer(sut, false)
+				tr.startContainer(sut)
+
+				_, err = sut.WaitContainer(context.Background(), tr.ctrID)
+				Expect(err).To(BeNil())
+				Eventually(func() error {
+					_, err := sut.GetTombstone(context.Background(), tr.ctrID)
+
+					return err
+				}, time.Second*5).Should(BeNil())
+
+				info, err := sut.InspectState(context.Background())
+				Expect(err).To(BeNil())
+				Expect(info.Backend).To(Equal(backend))
+				Expect(info.Path).NotTo(BeEmpty())
+				Expect(info.Entries).To(BeEquivalentTo(1))
+				Expect(info.TenantEntries).To(BeEquivalentTo(1))
+				Expect(info.SizeBytes).NotTo(BeZero())
+
+				compaction, err := sut.CompactState(context.Background())
+				Expect(err).To(BeNil())
+				Expect(compaction.Entries).To(BeEquivalentTo(1))
+
+				Expect(sut.Shutdown()).To(BeNil())
+				sut, err = client.New(cfg)
+				Expect(err).To(BeNil())
+
+				tombstone, err := sut.GetTombstone(context.Background(), tr.ctrID)
+				Expect(err).To(BeNil())
+				Expect(tombstone.ExitCode).To(BeEquivalentTo(3))
+			})
+		}
+
+		It("should keep the state in memory by default", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			info, err := sut.InspectState(context.Background())
+			Expect(err).To(BeNil())
+			Expect(info.Backend).To(Equal(client.StateBackendMemory))
+			Expect(info.Path).To(BeEmpty())
+			Expect(info.SizeBytes).To(BeZero())
+		})
+	})
+
+	Describe("OperationLocks", func() {
+		It("should reject stopping a container while executing a command", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "10"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.OperationLocks = true
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			execDone := make(chan error, 1)
+			go func() {
+				_, err := sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+					ID:      tr.ctrID,
+					Command: []string{"/busybox", "sleep", "2"},
+					Timeout: timeoutUnlimited,
+				})
+				execDone <- err
+			}()
+
+			stop := &client.StopContainerConfig{ID: tr.ctrID, Timeout: time.Second}
+			Eventually(func() error {
+				return sut.StopContainer(context.Background(), stop)
+			}, time.Second*2).Should(MatchError(client.ErrOperationInProgress))
+
+			var rpcErr *client.RPCError
+			Expect(errors.As(sut.StopContainer(context.Background(), stop), &rpcErr)).To(BeTrue())
+			Expect(rpcErr.Operation).To(Equal("exec"))
+
+			Eventually(execDone, time.Second*10).Should(Receive(BeNil()))
+			Expect(sut.StopContainer(context.Background(), stop)).To(BeNil())
+		})
+	})
+
+	Describe("ReapEvents", func() {
+		It("should report reaped orphans", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "/busybox sleep 10 & exit 0"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.ReapEvents = true
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			events, err := sut.WatchContainerEvents(ctx, "")
+			Expect(err).To(BeNil())
+			tr.startContainer(sut)
+
+			Eventually(func() client.ContainerEventType {
+				var event client.ContainerEvent
+				Eventually(events, time.Second*10).Should(Receive(&event))
+				if event.Type == client.ContainerEventTypeReaped {
+					Expect(event.ID).To(BeEmpty())
+					Expect(event.PID).NotTo(BeZero())
+				}
+
+				return event.Type
+			}, time.Second*20).Should(Equal(client.ContainerEventTypeReaped))
+		})
+
+		It("should fail to disable the subreaper with reap events", func() {
+			cfg := client.NewConmonServerConfig(runtimePath, "", MustTempDir("reap-events"))
+			cfg.ConmonServerPath = conmonPath
+			cfg.DisableSubreaper = true
+			cfg.ReapIgnorePIDs = []uint32{1}
+			_, err := client.New(cfg)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("GetEvents", func() {
+		It("should return the events after the cursor", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "sleep 1; exit 3"}, nil)
+			sut = tr.configGivenEnv()
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+			defer cancel()
+			batch, err := sut.GetEvents(ctx, 0, 0)
+			Expect(err).To(BeNil())
+			Expect(batch.Events).To(BeEmpty())
+
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			cursor := batch.Next
+			batch, err = sut.GetEvents(context.Background(), cursor, 1)
+			Expect(err).To(BeNil())
+			Expect(batch.Missed).To(BeZero())
+			Expect(batch.Events).To(HaveLen(1))
+			Expect(batch.Events[0].ID).To(Equal(tr.ctrID))
+			Expect(batch.Events[0].Type).To(Equal(client.ContainerEventTypeExited))
+			Expect(batch.Events[0].ExitCode).To(BeEquivalentTo(3))
+			Expect(batch.Next).To(BeNumerically(">", cursor))
+
+			// Polling again from the same cursor returns the same events.
+			again, err := sut.GetEvents(context.Background(), cursor, 1)
+			Expect(err).To(BeNil())
+			Expect(again.Events).To(Equal(batch.Events))
+		})
+	})
+
+	Describe("Reconnect", func() {
+		It("should keep the server PID if the server is unchanged", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+			pid := sut.PID()
+
+			Expect(sut.Reconnect(context.Background())).To(BeNil())
+			Expect(sut.PID()).To(Equal(pid))
+		})
+
+		It("should retry while the server socket is unavailable", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.ConnectRetries = 10
+			cfg.ConnectBackoff = 50 * time.Millisecond
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+
+			socket := filepath.Join(tr.tmpDir, "conmon.sock")
+			moved := socket + ".moved"
+			Expect(os.Rename(socket, moved)).To(BeNil())
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(300 * time.Millisecond)
+				Expect(os.Rename(moved, socket)).To(BeNil())
+			}()
+
+			Expect(sut.Reconnect(context.Background())).To(BeNil())
+			_, err = sut.Version(context.Background())
+			Expect(err).To(BeNil())
+		})
+
+		It("should fail without retries if the server socket is unavailable", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			socket := filepath.Join(tr.tmpDir, "conmon.sock")
+			Expect(os.Rename(socket, socket+".moved")).To(BeNil())
+			defer func() { Expect(os.Rename(socket+".moved", socket)).To(BeNil()) }()
+
+			Expect(sut.Reconnect(context.Background())).NotTo(BeNil())
+		})
+	})
+
+	Describe("CrashReport", func() {
+		writeCrashReport := func(path string) {
+			msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+			Expect(err).To(BeNil())
+			report, err := proto.NewRootConmon_CrashReport(seg)
+			Expect(err).To(BeNil())
+			report.SetPid(42)
+			Expect(report.SetMessage("panicked at 'boom', src/rpc.rs:1:1")).To(BeNil())
+			Expect(report.SetBacktrace("0: conmon::rpc")).To(BeNil())
+
+			rpcs, err := report.NewRecentRpcs(1)
+			Expect(err).To(BeNil())
+			Expect(rpcs.At(0).SetMethod("create_container")).To(BeNil())
+			Expect(rpcs.At(0).SetContainerId("foo")).To(BeNil())
+
+			ids, err := report.NewContainerIds(1)
+			Expect(err).To(BeNil())
+			Expect(ids.Set(0, "foo")).To(BeNil())
+
+			file, err := os.Create(path)
+			Expect(err).To(BeNil())
+			defer file.Close()
+			Expect(capnp.NewEncoder(file).Encode(msg)).To(BeNil())
+		}
+
+		It("should detect, parse and remove crash reports", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			exists, err := sut.HasCrashReport()
+			Expect(err).To(BeNil())
+			Expect(exists).To(BeFalse())
+			_, err = sut.CrashReport()
+			Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			reports, err := sut.WatchCrashReport(ctx)
+			Expect(err).To(BeNil())
+
+			writeCrashReport(filepath.Join(tr.tmpDir, "crash-report"))
+			exists, err = sut.HasCrashReport()
+			Expect(err).To(BeNil())
+			Expect(exists).To(BeTrue())
+
+			var report *client.CrashReport
+			Eventually(reports, time.Second*5).Should(Receive(&report))
+			Expect(report.PID).To(BeEquivalentTo(42))
+			Expect(report.Message).To(ContainSubstring("boom"))
+			Expect(report.Backtrace).NotTo(BeEmpty())
+			Expect(report.RecentRPCs).To(HaveLen(1))
+			Expect(report.RecentRPCs[0].Method).To(Equal("create_container"))
+			Expect(report.RecentRPCs[0].ContainerID).To(Equal("foo"))
+			Expect(report.ContainerIDs).To(Equal([]string{"foo"}))
+
+			Expect(sut.RemoveCrashReport()).To(BeNil())
+			exists, err = sut.HasCrashReport()
+			Expect(err).To(BeNil())
+			Expect(exists).To(BeFalse())
+		})
+	})
+
+	Describe("LogDrivers", func() {
+		It("should write the output to multiple log drivers", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "echo", "hello"}, nil)
+			sut = tr.configGivenEnv()
+			jsonPath := filepath.Join(tr.tmpDir, "json.log")
+
+			_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:         tr.ctrID,
+				BundlePath: tr.tmpDir,
+				ExitPaths:  []string{tr.exitPath()},
+				LogDrivers: []client.LogDriver{
+					{Type: client.LogDriverTypeContainerRuntimeInterface, Path: tr.logPath()},
+					{Type: client.LogDriverTypeJSON, Path: jsonPath, Tag: "web"},
+					{Type: client.LogDriverTypeNone},
+				},
+			})
+			Expect(err).To(BeNil())
+			tr.startContainer(sut)
+
+			Expect(fileContents(tr.exitPath())).To(Equal("0"))
+			Expect(fileContents(tr.logPath())).To(ContainSubstring(" stdout F hello"))
+			Expect(fileContents(jsonPath)).To(HavePrefix(`{"log":"hello\n","stream":"stdout","time":"`))
+			Expect(fileContents(jsonPath)).To(ContainSubstring(`"attrs":{"tag":"web"}`))
+		})
+
+		It("should reopen a single log driver by its path", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "10"}, nil)
+			sut = tr.configGivenEnv()
+			jsonPath := filepath.Join(tr.tmpDir, "json.log")
+
+			_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:         tr.ctrID,
+				BundlePath: tr.tmpDir,
+				LogDrivers: []client.LogDriver{
+					{Type: client.LogDriverTypeContainerRuntimeInterface, Path: tr.logPath()},
+					{Type: client.LogDriverTypeJSON, Path: jsonPath, MaxSize: 1024},
+				},
+			})
+			Expect(err).To(BeNil())
+			tr.startContainer(sut)
+
+			Expect(sut.ReopenLogContainer(context.Background(), &client.ReopenLogContainerConfig{
+				ID:   tr.ctrID,
+				Path: jsonPath,
+			})).To(BeNil())
+			Expect(sut.ReopenLogContainer(context.Background(), &client.ReopenLogContainerConfig{
+				ID:   tr.ctrID,
+				Path: filepath.Join(tr.tmpDir, "unknown.log"),
+			})).NotTo(BeNil())
+		})
+	})
+
+	Describe("Watchdog", func() {
+		It("should keep the container running while receiving heartbeats", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			events, err := sut.WatchContainerEvents(ctx, tr.ctrID)
+			Expect(err).To(BeNil())
+
+			Expect(sut.SetWatchdog(context.Background(), &client.SetWatchdogConfig{
+				ID:      tr.ctrID,
+				Timeout: time.Second,
+				Policy:  client.WatchdogPolicyStop,
+			})).To(BeNil())
+
+			heartbeatCtx, stopHeartbeats := context.WithCancel(context.Background())
+			go sut.RunHeartbeats(heartbeatCtx, 200*time.Millisecond)
+			Consistently(events, time.Second*3).ShouldNot(Receive())
+
+			// The management daemon disappears.
+			stopHeartbeats()
+			var event client.ContainerEvent
+			Eventually(events, time.Second*5).Should(Receive(&event))
+			Expect(event.Type).To(Equal(client.ContainerEventTypeWatchdogExpired))
+			Eventually(events, time.Second*15).Should(Receive(&event))
+			Expect(event.Type).To(Equal(client.ContainerEventTypeExited))
+		})
+
+		It("should fail for containers without watchdog", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Expect(sut.Heartbeat(context.Background())).To(BeNil())
+			Expect(sut.Heartbeat(context.Background(), tr.ctrID)).NotTo(BeNil())
+			Expect(sut.SetWatchdog(context.Background(), &client.SetWatchdogConfig{ID: tr.ctrID})).NotTo(BeNil())
+		})
+	})
+
+	Describe("RotateLogContainer", func() {
+		It("should rotate and compress the container log", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "echo first; sleep 20"}, nil)
+			sut = tr.configGivenEnv()
+
+			_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:         tr.ctrID,
+				BundlePath: tr.tmpDir,
+				LogDrivers: []client.LogDriver{{
+					Type:     client.LogDriverTypeContainerRuntimeInterface,
+					Path:     tr.logPath(),
+					MaxFiles: 2,
+					Compress: true,
+				}},
+			})
+			Expect(err).To(BeNil())
+			tr.startContainer(sut)
+			Eventually(func() string {
+				return fileContents(tr.logPath())
+			}, time.Second*5).Should(ContainSubstring("first"))
+
+			Expect(sut.RotateLogContainer(context.Background(), &client.RotateLogContainerConfig{
+				ID: tr.ctrID,
+			})).To(BeNil())
+			Expect(fileContents(tr.logPath())).To(BeEmpty())
+
+			file, err := os.Open(tr.logPath() + ".1.gz")
+			Expect(err).To(BeNil())
+			defer file.Close()
+			reader, err := gzip.NewReader(file)
+			Expect(err).To(BeNil())
+			rotated, err := io.ReadAll(reader)
+			Expect(err).To(BeNil())
+			Expect(string(rotated)).To(ContainSubstring("stdout F first"))
+		})
+
+		It("should fail for unknown containers", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			Expect(sut.RotateLogContainer(context.Background(), &client.RotateLogContainerConfig{
+				ID: "unknown",
+			})).NotTo(BeNil())
+		})
+	})
+
+	Describe("LogFilter", func() {
+		createContainer := func(filter *client.LogFilter) {
+			_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:         tr.ctrID,
+				BundlePath: tr.tmpDir,
+				ExitPaths:  []string{tr.exitPath()},
+				LogDrivers: []client.LogDriver{{
+					Type: client.LogDriverTypeContainerRuntimeInterface,
+					Path: tr.logPath(),
+				}},
+				LogFilter: filter,
+			})
+			Expect(err).To(BeNil())
+			tr.startContainer(sut)
+		}
+
+		It("should pass the output through the filter", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(
+				false, []string{"/busybox", "sh", "-c", "echo user=secret; sleep 20"}, nil,
+			)
+			sut = tr.configGivenEnv()
+			createContainer(&client.LogFilter{
+				Path:          "/usr/bin/sed",
+				Args:          []string{"-u", "s/secret/masked/"},
+				RestartPolicy: client.LogFilterRestartPolicyOnFailure,
+			})
+
+			Eventually(func() string {
+				return fileContents(tr.logPath())
+			}, time.Second*5).Should(ContainSubstring("user=masked"))
+			Expect(fileContents(tr.logPath())).NotTo(ContainSubstring("secret"))
+
+			stats, err := sut.ContainerStats(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(stats.LogFilter).NotTo(BeNil())
+			Expect(stats.LogFilter.Running).To(BeTrue())
+			Expect(stats.LogFilter.Restarts).To(BeZero())
+			Expect(stats.LogFilter.LastExitCode).To(BeEquivalentTo(-1))
+		})
+
+		It("should report the health of an exited filter", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(
+				false, []string{"/busybox", "sh", "-c", "sleep 3; echo hello; sleep 20"}, nil,
+			)
+			sut = tr.configGivenEnv()
+			createContainer(&client.LogFilter{
+				Path:          "/usr/bin/false",
+				RestartPolicy: client.LogFilterRestartPolicyOnFailure,
+				MaxRestarts:   1,
+			})
+
+			var health *client.LogFilterHealth
+			Eventually(func() error {
+				stats, err := sut.ContainerStats(context.Background(), tr.ctrID)
+				if err != nil {
+					return err
+				}
+				health = stats.LogFilter
+				if health == nil || health.DroppedBytes == 0 {
+					return errors.New("no output dropped yet")
+				}
+
+				return nil
+			}, time.Second*10).Should(BeNil())
+			Expect(health.Running).To(BeFalse())
+			Expect(health.Restarts).To(BeEquivalentTo(1))
+			Expect(health.LastExitCode).To(BeEquivalentTo(1))
+			Expect(fileContents(tr.logPath())).NotTo(ContainSubstring("hello"))
+		})
+
+		It("should not report the health without filter", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			createContainer(nil)
+
+			stats, err := sut.ContainerStats(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(stats.LogFilter).To(BeNil())
+		})
+	})
+
+	Describe("AttachMultiplexing", func() {
+		It("should fan out the output to concurrent sessions and merge their input", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			socketPath := filepath.Join(tr.tmpDir, "attach")
+			stdins := []*io.PipeWriter{}
+			outputs := []*bufio.Reader{}
+			for i := 0; i < 2; i++ {
+				stdinReader, stdinWriter := io.Pipe()
+				stdoutReader, stdout := io.Pipe()
+				_, stderr := io.Pipe()
+				stdins = append(stdins, stdinWriter)
+				outputs = append(outputs, bufio.NewReader(stdoutReader))
+				go func() {
+					defer GinkgoRecover()
+					Expect(sut.AttachContainer(context.Background(), &client.AttachConfig{
+						ID:         tr.ctrID,
+						SocketPath: socketPath,
+						Streams: client.AttachStreams{
+							Stdin:  &client.In{stdinReader},
+							Stdout: &client.Out{stdout},
+							Stderr: &client.Out{stderr},
+						},
+					})).To(BeNil())
+				}()
+			}
+
+			Eventually(func() (int, error) {
+				sessions, err := sut.ListAttachSessions(context.Background(), tr.ctrID)
+
+				return len(sessions), err
+			}, time.Second*10).Should(Equal(2))
+
+			for i, stdin := range stdins {
+				message := fmt.Sprintf("hello from session %d", i)
+				_, err := fmt.Fprintf(stdin, "/busybox echo %s\n", message)
+				Expect(err).To(BeNil())
+
+				for _, output := range outputs {
+					line, err := output.ReadString('\n')
+					Expect(err).To(BeNil())
+					Expect(line).To(ContainSubstring(message))
+				}
+			}
+		})
+	})
+
+	Describe("LogCursor", func() {
+		messages := func(entries []client.LogEntry) (res []string) {
+			for _, entry := range entries {
+				res = append(res, string(entry.Message))
+			}
+
+			return res
+		}
+
+		It("should page through the container log", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(
+				false, []string{"/busybox", "sh", "-c", "for i in 1 2 3 4 5; do echo $i; done; sleep 20"}, nil,
+			)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ctx := context.Background()
+			cursor, err := sut.OpenLogCursor(ctx, tr.ctrID)
+			Expect(err).To(BeNil())
+			Eventually(func() string {
+				return fileContents(tr.logPath())
+			}, time.Second*5).Should(ContainSubstring(" 5\n"))
+
+			entries, err := cursor.Next(ctx, 2)
+			Expect(err).To(BeNil())
+			Expect(messages(entries)).To(Equal([]string{"1", "2"}))
+
+			entries, err = cursor.Next(ctx, 10)
+			Expect(err).To(BeNil())
+			Expect(messages(entries)).To(Equal([]string{"3", "4", "5"}))
+			Expect(entries[0].Stream).To(Equal("stdout"))
+			Expect(entries[0].Timestamp).NotTo(BeZero())
+
+			entries, err = cursor.Next(ctx, -2)
+			Expect(err).To(BeNil())
+			Expect(messages(entries)).To(Equal([]string{"4", "5"}))
+			Expect(cursor.Offset()).To(Equal(entries[0].Offset))
+
+			_, err = cursor.SeekOffset(ctx, 0, io.SeekStart)
+			Expect(err).To(BeNil())
+			entries, err = cursor.Next(ctx, 1)
+			Expect(err).To(BeNil())
+			Expect(messages(entries)).To(Equal([]string{"1"}))
+
+			_, err = cursor.SeekOffset(ctx, 0, io.SeekEnd)
+			Expect(err).To(BeNil())
+			entries, err = cursor.Next(ctx, -1)
+			Expect(err).To(BeNil())
+			Expect(messages(entries)).To(Equal([]string{"5"}))
+
+			Expect(cursor.SeekTime(ctx, time.Now().Add(time.Hour))).To(BeNil())
+			entries, err = cursor.Next(ctx, 1)
+			Expect(err).To(BeNil())
+			Expect(entries).To(BeEmpty())
+
+			Expect(cursor.SeekTime(ctx, time.Unix(0, 0))).To(BeNil())
+			Expect(cursor.Offset()).To(BeZero())
+		})
+
+		It("should fail if the container does not exist", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			_, err := sut.OpenLogCursor(context.Background(), tr.ctrID)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("DetachKeys", func() {
+		It("should parse human-readable detach keys", func() {
+			keys, err := client.ParseDetachKeys("ctrl-p,ctrl-q")
+			Expect(err).To(BeNil())
+			Expect(keys).To(Equal([]byte{16, 17}))
+
+			keys, err = client.ParseDetachKeys("a,CTRL-A,ctrl-@,ctrl-_,,")
+			Expect(err).NotTo(BeNil())
+			Expect(keys).To(BeNil())
+
+			keys, err = client.ParseDetachKeys("a,CTRL-A,ctrl-@,ctrl-_")
+			Expect(err).To(BeNil())
+			Expect(keys).To(Equal([]byte{'a', 1, 0, 31}))
+
+			for _, invalid := range []string{"", "ctrl-", "ctrl-1", "ctrl-pq", "shift-a"} {
+				_, err := client.ParseDetachKeys(invalid)
+				Expect(err).NotTo(BeNil(), invalid)
+			}
+		})
+
+		It("should stop the output and notify on detach", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdin, stdinWrite := io.Pipe()
+			stdoutRead, stdout := io.Pipe()
+			detached := make(chan struct{})
+			attachDone := make(chan error, 1)
+			go func() {
+				attachDone <- sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:         tr.ctrID,
+					SocketPath: filepath.Join(tr.tmpDir, "attach"),
+					Streams: client.AttachStreams{
+						Stdin:  &client.In{stdin},
+						Stdout: &client.Out{stdout},
+					},
+					DetachKeysSpec: "ctrl-p,ctrl-q",
+					OnDetach:       func() { close(detached) },
+				})
+			}()
+
+			_, err := fmt.Fprintf(stdinWrite, "hello\n")
+			Expect(err).To(BeNil())
+			line, err := bufio.NewReader(stdoutRead).ReadString('\n')
+			Expect(err).To(BeNil())
+			Expect(line).To(Equal("hello\n"))
+
+			_, err = stdinWrite.Write([]byte{16, 17})
+			Expect(err).To(BeNil())
+
+			Eventually(detached, time.Second*10).Should(BeClosed())
+			Eventually(attachDone, time.Second*10).Should(Receive(MatchError(client.ErrDetach)))
+		})
+
+		It("should fail if both detach keys representations are set", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			err := sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:             tr.ctrID,
+				SocketPath:     filepath.Join(tr.tmpDir, "attach"),
+				DetachKeys:     []byte{16, 17},
+				DetachKeysSpec: "ctrl-p,ctrl-q",
+			})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("FlushLogs", func() {
+		It("should flush the container output to the log", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(
+				false, []string{"/busybox", "sh", "-c", "echo hello; sleep 20"}, nil,
+			)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Eventually(func() string {
+				Expect(sut.FlushLogs(context.Background(), tr.ctrID)).To(BeNil())
+
+				return fileContents(tr.logPath())
+			}, time.Second*5).Should(ContainSubstring("stdout F hello\n"))
+		})
+
+		It("should fail if the container does not exist", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			Expect(sut.FlushLogs(context.Background(), tr.ctrID)).NotTo(BeNil())
+		})
+	})
+
+	Describe("AdditionalFDs", func() {
+		createContainer := func(fds []client.RemoteFD) error {
+			_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:         tr.ctrID,
+				BundlePath: tr.tmpDir,
+				ExitPaths:  []string{tr.exitPath()},
+				LogDrivers: []client.LogDriver{{
+					Type: client.LogDriverTypeContainerRuntimeInterface,
+					Path: tr.logPath(),
+				}},
+				AdditionalFDs: fds,
+			})
+
+			return err
+		}
+
+		It("should pass the file descriptors into the container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(
+				false, []string{"/busybox", "sh", "-c", "echo hello >&3"}, nil,
+			)
+			sut = tr.configGivenEnv()
+
+			file, err := os.Create(filepath.Join(tr.tmpDir, "fd-output"))
+			Expect(err).To(BeNil())
+			defer file.Close()
+
+			remoteFDs, err := sut.NewRemoteFDs()
+			Expect(err).To(BeNil())
+			defer remoteFDs.Close()
+
+			fds, err := remoteFDs.Send(int(file.Fd()))
+			Expect(err).To(BeNil())
+			Expect(fds).To(HaveLen(1))
+
+			Expect(createContainer(fds)).To(BeNil())
+			tr.startContainer(sut)
+
+			Eventually(func() string {
+				return fileContents(file.Name())
+			}, time.Second*5).Should(Equal("hello\n"))
+		})
+
+		It("should fail if the file descriptors have not been sent", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			Expect(createContainer([]client.RemoteFD{1 << 32})).NotTo(BeNil())
+		})
+	})
+
+	Describe("FDMap", func() {
+		It("should pass the file descriptors at their numbers into an exec process", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			file, err := os.Create(filepath.Join(tr.tmpDir, "fd-output"))
+			Expect(err).To(BeNil())
+			defer file.Close()
+
+			remoteFDs, err := sut.NewRemoteFDs()
+			Expect(err).To(BeNil())
+			defer remoteFDs.Close()
+
+			fdMap, err := remoteFDs.SendFDMap(map[int]int{5: int(file.Fd())})
+			Expect(err).To(BeNil())
+			Expect(fdMap).To(HaveKey(5))
+
+			result, err := sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+				ID:      tr.ctrID,
+				Command: []string{"/busybox", "sh", "-c", "echo hello >&5 && test -e /proc/self/fd/4"},
+				Timeout: timeoutUnlimited,
+				FDMap:   fdMap,
+			})
+			Expect(err).To(BeNil())
+			Expect(result.ExitCode).To(BeZero())
+			Expect(fileContents(file.Name())).To(Equal("hello\n"))
+		})
+
+		It("should reject listen sockets after generic file descriptors", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			remoteFDs, err := sut.NewRemoteFDs()
+			Expect(err).To(BeNil())
+			defer remoteFDs.Close()
+
+			fdMap, err := remoteFDs.SendFDMap(map[int]int{3: int(os.Stdin.Fd()), 4: int(os.Stdin.Fd())})
+			Expect(err).To(BeNil())
+			fdMap[4] = client.FDMapping{FD: fdMap[4].FD, Kind: client.FDKindListenSocket, Name: "http"}
+
+			_, err = sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+				ID:      tr.ctrID,
+				Command: []string{"/busybox", "true"},
+				Timeout: timeoutUnlimited,
+				FDMap:   fdMap,
+			})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("ExecSyncContainer cache", func() {
+		It("should return cached results of identical commands", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			exec := func(cacheTTL time.Duration) *client.ExecContainerResult {
+				result, err := sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+					ID:       tr.ctrID,
+					Command:  []string{"/busybox", "sh", "-c", "echo x >> /tmp/count; /busybox wc -l < /tmp/count"},
+					Timeout:  timeoutUnlimited,
+					CacheTTL: cacheTTL,
+				})
+				Expect(err).To(BeNil())
+				Expect(result.ExitCode).To(BeEquivalentTo(0))
+
+				return result
+			}
+
+			result := exec(time.Minute)
+			Expect(result.Stdout).To(BeEquivalentTo("1\n"))
+			Expect(result.Cached).To(BeFalse())
+
+			result = exec(time.Minute)
+			Expect(result.Stdout).To(BeEquivalentTo("1\n"))
+			Expect(result.Cached).To(BeTrue())
+			Expect(result.CacheAge).To(BeNumerically("<", time.Minute))
+
+			result = exec(0)
+			Expect(result.Stdout).To(BeEquivalentTo("2\n"))
+			Expect(result.Cached).To(BeFalse())
+		})
+	})
+
+	Describe("CheckpointContainer", func() {
+		It("should checkpoint and restore a container", func() {
+			if _, err := exec.LookPath("criu"); err != nil {
+				Skip("criu is not available")
+			}
+			if unshare.IsRootless() {
+				Skip("does not run rootless")
+			}
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "30"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			options := client.CheckpointOptions{ImagePath: filepath.Join(tr.tmpDir, "checkpoint")}
+			Expect(sut.CheckpointContainer(context.Background(), &client.CheckpointContainerConfig{
+				ID:      tr.ctrID,
+				Options: options,
+			})).To(BeNil())
+			Eventually(func() error {
+				return tr.rr.RunCommandCheckOutput("stopped", "list")
+			}, time.Second*10).Should(BeNil())
+			Expect(tr.rr.RunCommand("delete", tr.ctrID)).To(BeNil())
+
+			resp, err := sut.RestoreContainer(context.Background(), &client.RestoreContainerConfig{
+				CreateContainerConfig: client.CreateContainerConfig{
+					ID:         tr.ctrID,
+					BundlePath: tr.tmpDir,
+					ExitPaths:  []string{tr.exitPath()},
+					LogDrivers: []client.LogDriver{{
+						Type: client.LogDriverTypeContainerRuntimeInterface,
+						Path: tr.logPath(),
+					}},
+				},
+				Options: options,
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.PID).NotTo(BeZero())
+			Eventually(func() error {
+				return tr.rr.RunCommandCheckOutput("running", "list")
+			}, time.Second*10).Should(BeNil())
+		})
+
+		It("should fail without image path", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "30"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Expect(sut.CheckpointContainer(context.Background(), &client.CheckpointContainerConfig{
+				ID: tr.ctrID,
+			})).NotTo(BeNil())
+		})
+
+		It("should fail if the container does not exist", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			Expect(sut.CheckpointContainer(context.Background(), &client.CheckpointContainerConfig{
+				ID:      tr.ctrID,
+				Options: client.CheckpointOptions{ImagePath: tr.tmpDir},
+			})).NotTo(BeNil())
+		})
+
+		It("should fail to restore without checkpoint", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			_, err := sut.RestoreContainer(context.Background(), &client.RestoreContainerConfig{
+				CreateContainerConfig: client.CreateContainerConfig{
+					ID:         tr.ctrID,
+					BundlePath: tr.tmpDir,
+				},
+				Options: client.CheckpointOptions{ImagePath: filepath.Join(tr.tmpDir, "missing")},
+			})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("LogDriver write options", func() {
+		for _, policy := range []client.LogFsyncPolicy{
+			client.LogFsyncPolicyNever, client.LogFsyncPolicyInterval, client.LogFsyncPolicyEveryLine,
+		} {
+			policy := policy
+			It(fmt.Sprintf("should log the container output with fsync policy %d", policy), func() {
+				tr = newTestRunner()
+				tr.createRuntimeConfigWithProcessArgs(
+					false, []string{"/busybox", "sh", "-c", "echo hello; echo world"}, nil,
+				)
+				sut = tr.configGivenEnv()
+
+				_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+					ID:         tr.ctrID,
+					BundlePath: tr.tmpDir,
+					ExitPaths:  []string{tr.exitPath()},
+					LogDrivers: []client.LogDriver{{
+						Type:          client.LogDriverTypeContainerRuntimeInterface,
+						Path:          tr.logPath(),
+						BufferSize:    16,
+						FsyncPolicy:   policy,
+						FsyncInterval: time.Millisecond,
+						DirectIO:      true,
+					}},
+				})
+				Expect(err).To(BeNil())
+				tr.startContainer(sut)
+
+				Eventually(func() string {
+					return fileContents(tr.logPath())
+				}, time.Second*5).Should(And(
+					ContainSubstring("stdout F hello\n"),
+					ContainSubstring("stdout F world\n"),
+				))
+			})
+		}
+	})
+
+	Describe("MultiplexAttachSessions", func() {
+		It("should share a single connection between attach sessions", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.MultiplexAttachSessions = true
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			conns := []*client.AttachConn{}
+			for _, name := range []string{"attach-1", "attach-2"} {
+				conn, err := sut.AttachConn(context.Background(), &client.AttachConnConfig{
+					ID:         tr.ctrID,
+					SocketPath: filepath.Join(tr.tmpDir, name),
+				})
+				Expect(err).To(BeNil())
+				conns = append(conns, conn)
+			}
+
+			sessions, err := sut.ListAttachSessions(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(sessions).To(HaveLen(2))
+
+			data := []byte("hello\n")
+			_, err = conns[1].Write(data)
+			Expect(err).To(BeNil())
+			for _, conn := range conns {
+				buf := make([]byte, len(data))
+				_, err = io.ReadFull(conn, buf)
+				Expect(err).To(BeNil())
+				Expect(buf).To(Equal(data))
+			}
+
+			for _, conn := range conns {
+				Expect(conn.Close()).To(BeNil())
+			}
+			Eventually(func() (int, error) {
+				sessions, err := sut.ListAttachSessions(context.Background(), tr.ctrID)
+
+				return len(sessions), err
+			}, time.Second*5).Should(BeZero())
+		})
+
+		It("should share the connection with tenant scoped clients", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			var (
+				mu       sync.Mutex
+				muxDials int
+			)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.MultiplexAttachSessions = true
+			cfg.Dialer = func(_ context.Context, network, address string) (net.Conn, error) {
+				if filepath.Base(address) == "conmon-attach.sock" {
+					mu.Lock()
+					muxDials++
+					mu.Unlock()
+				}
+
+				return client.DialLongSocket(network, address)
+			}
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			// The scoped client is a copy of the default tenant client.
+			for i, c := range []*client.ConmonClient{sut, sut.WithTenant("")} {
+				conn, err := c.AttachConn(context.Background(), &client.AttachConnConfig{
+					ID:         tr.ctrID,
+					SocketPath: filepath.Join(tr.tmpDir, fmt.Sprintf("attach-%d", i)),
+				})
+				Expect(err).To(BeNil())
+				defer conn.Close()
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			Expect(muxDials).To(Equal(1))
+		})
+
+		It("should signal the container via the control lane", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.MultiplexAttachSessions = true
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdin, _ := io.Pipe()
+			_, stdout := io.Pipe()
+			_, stderr := io.Pipe()
+			signals := make(chan syscall.Signal, 1)
+			defer close(signals)
+			attachDone := make(chan error, 1)
+			go func() {
+				attachDone <- sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:         tr.ctrID,
+					SocketPath: filepath.Join(tr.tmpDir, "attach"),
+					Streams: client.AttachStreams{
+						Stdin:  &client.In{stdin},
+						Stdout: &client.Out{stdout},
+						Stderr: &client.Out{stderr},
+					},
+					Signals: signals,
+				})
+			}()
+
+			Eventually(func() (int, error) {
+				sessions, err := sut.ListAttachSessions(context.Background(), tr.ctrID)
+
+				return len(sessions), err
+			}, time.Second*10).Should(Equal(1))
+
+			signals <- syscall.SIGKILL
+			result, err := sut.WaitContainer(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(result.ExitCode).To(BeEquivalentTo(137))
+			Eventually(attachDone, time.Second*10).Should(Receive())
+		})
+	})
+
+	Describe("KillContainer", func() {
+		It("should send the signal to the container process", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "trap 'exit 7' USR1; while true; do /busybox sleep 0.1; done",
+			}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Expect(sut.KillContainer(context.Background(), tr.ctrID, syscall.SIGUSR1, false)).To(BeNil())
+
+			result, err := sut.WaitContainer(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(result.ExitCode).To(BeEquivalentTo(7))
+		})
+
+		It("should send the signal to all processes of the container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Expect(sut.KillContainer(context.Background(), tr.ctrID, syscall.SIGKILL, true)).To(BeNil())
+
+			result, err := sut.WaitContainer(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(result.ExitCode).To(BeEquivalentTo(137))
+		})
+
+		It("should fail for an unknown container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			Expect(sut.KillContainer(context.Background(), "unknown", syscall.SIGTERM, false)).NotTo(BeNil())
+		})
+	})
+
+	Describe("SimulateTerminal", func() {
+		readUntil := func(conn io.Reader, suffix string) string {
+			var output string
+			buf := make([]byte, 1024)
+			for !strings.HasSuffix(output, suffix) {
+				n, err := conn.Read(buf)
+				Expect(err).To(BeNil())
+				output += string(buf[:n])
+			}
+
+			return output
+		}
+
+		It("should provide line editing for a container without terminal", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			conn, err := sut.AttachConn(context.Background(), &client.AttachConnConfig{
+				ID:               tr.ctrID,
+				SocketPath:       filepath.Join(tr.tmpDir, "attach"),
+				SimulateTerminal: true,
+			})
+			Expect(err).To(BeNil())
+			defer conn.Close()
+
+			_, err = conn.Write([]byte("helo\x7flo\r"))
+			Expect(err).To(BeNil())
+
+			// The echo of the input is followed by the output of cat.
+			output := readUntil(conn, "hello\r\n")
+			Expect(output).To(HavePrefix("helo"))
+		})
+
+		It("should deliver the interrupt character as signal", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "trap 'exit 7' INT; while true; do /busybox sleep 0.1; done",
+			}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			conn, err := sut.AttachConn(context.Background(), &client.AttachConnConfig{
+				ID:               tr.ctrID,
+				SocketPath:       filepath.Join(tr.tmpDir, "attach"),
+				SimulateTerminal: true,
+			})
+			Expect(err).To(BeNil())
+			defer conn.Close()
+
+			_, err = conn.Write([]byte("\x03"))
+			Expect(err).To(BeNil())
+			readUntil(conn, "^C\r\n")
+
+			result, err := sut.WaitContainer(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(result.ExitCode).To(BeEquivalentTo(7))
+		})
+
+		It("should fail for a container with terminal", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(true, []string{"/busybox", "sh"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, true)
+			tr.startContainer(sut)
+
+			_, err := sut.AttachConn(context.Background(), &client.AttachConnConfig{
+				ID:               tr.ctrID,
+				SocketPath:       filepath.Join(tr.tmpDir, "attach"),
+				SimulateTerminal: true,
+			})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("PauseContainer", func() {
+		It("should pause and unpause the container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Expect(sut.PauseContainer(context.Background(), tr.ctrID)).To(BeNil())
+			err := sut.PauseContainer(context.Background(), tr.ctrID)
+			Expect(errors.Is(err, client.ErrContainerAlreadyPaused)).To(BeTrue())
+
+			Expect(sut.UnpauseContainer(context.Background(), tr.ctrID)).To(BeNil())
+			err = sut.UnpauseContainer(context.Background(), tr.ctrID)
+			Expect(errors.Is(err, client.ErrContainerNotPaused)).To(BeTrue())
+			Expect(errors.Is(err, client.ErrContainerAlreadyPaused)).To(BeFalse())
+		})
+
+		It("should fail for an unknown container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			err := sut.PauseContainer(context.Background(), "unknown")
+			Expect(err).NotTo(BeNil())
+			Expect(errors.Is(err, client.ErrContainerAlreadyPaused)).To(BeFalse())
+		})
+	})
+
+	Describe("IDValidator", func() {
+		It("should reject IDs unsafe to be used within paths", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			for _, id := range []string{"", "..", "../container", "a\x00b"} {
+				_, err := sut.ContainerStats(context.Background(), id)
+				Expect(errors.Is(err, client.ErrInvalidID)).To(BeTrue())
+
+				var invalidErr *client.InvalidIDError
+				Expect(errors.As(err, &invalidErr)).To(BeTrue())
+				Expect(invalidErr.Kind).To(Equal(client.IDKindContainer))
+				Expect(invalidErr.ID).To(Equal(id))
+			}
+
+			_, err := sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+				ID:          tr.ctrID,
+				Command:     []string{"/busybox", "true"},
+				ExecSession: "../session",
+			})
+			var invalidErr *client.InvalidIDError
+			Expect(errors.As(err, &invalidErr)).To(BeTrue())
+			Expect(invalidErr.Kind).To(Equal(client.IDKindExecSession))
+		})
+
+		It("should normalize IDs to lowercase hex", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.IDValidator = &client.IDRules{MinLength: 64, MaxLength: 64, LowercaseHex: true}
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			// The server only knows the lowercase ID.
+			_, err = sut.ContainerStats(context.Background(), strings.ToUpper(tr.ctrID))
+			Expect(err).To(BeNil())
+
+			_, err = sut.ContainerStats(context.Background(), "container")
+			Expect(errors.Is(err, client.ErrInvalidID)).To(BeTrue())
+		})
+
+		It("should use custom validators", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.IDValidator = client.IDValidatorFunc(func(kind client.IDKind, id string) (string, error) {
+				return "", errors.New("rejected")
+			})
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+
+			err = sut.FlushLogs(context.Background(), tr.ctrID)
+			Expect(errors.Is(err, client.ErrInvalidID)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("rejected"))
+		})
+	})
+
+	Describe("RPCError", func() {
+		It("should report an already existing container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+
+			_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:         tr.ctrID,
+				BundlePath: tr.tmpDir,
+			})
+			Expect(errors.Is(err, client.ErrAlreadyExists)).To(BeTrue())
+
+			var rpcErr *client.RPCError
+			Expect(errors.As(err, &rpcErr)).To(BeTrue())
+			Expect(rpcErr.Kind).To(Equal(client.ErrorKindAlreadyExists))
+			Expect(rpcErr.Message).To(ContainSubstring(tr.ctrID))
+		})
+
+		It("should report an unknown container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			err := sut.StopContainer(context.Background(), &client.StopContainerConfig{ID: "unknown"})
+			Expect(errors.Is(err, client.ErrContainerNotFound)).To(BeTrue())
+			Expect(errors.Is(err, client.ErrRuntimeFailure)).To(BeFalse())
+
+			err = sut.KillContainer(context.Background(), "unknown", syscall.SIGTERM, false)
+			Expect(errors.Is(err, client.ErrContainerNotFound)).To(BeTrue())
+		})
+
+		It("should report runtime failures with their error output", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			// The work path cannot be created below the log file.
+			err := sut.CheckpointContainer(context.Background(), &client.CheckpointContainerConfig{
+				ID: tr.ctrID,
+				Options: client.CheckpointOptions{
+					ImagePath: filepath.Join(tr.tmpDir, "checkpoint"),
+					WorkPath:  filepath.Join(tr.logPath(), "work"),
+				},
+				LeaveRunning: true,
+			})
+			Expect(errors.Is(err, client.ErrRuntimeFailure)).To(BeTrue())
+
+			var rpcErr *client.RPCError
+			Expect(errors.As(err, &rpcErr)).To(BeTrue())
+			Expect(rpcErr.RuntimeStderr).NotTo(BeEmpty())
+		})
+
+		It("should classify runtime failures on create", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/missing"}, nil)
+			sut = tr.configGivenEnv()
+
+			_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:         tr.ctrID,
+				BundlePath: tr.tmpDir,
+			})
+			Expect(errors.Is(err, client.ErrRuntimeFailure)).To(BeTrue())
+
+			var rpcErr *client.RPCError
+			Expect(errors.As(err, &rpcErr)).To(BeTrue())
+			Expect(rpcErr.RuntimeStderr).To(ContainSubstring("/missing"))
+			Expect(rpcErr.Reason).To(Equal(client.RejectionReasonExecutableNotFound))
+			Expect(rpcErr.Hint).NotTo(BeEmpty())
+			Expect(rpcErr.RuntimeLog).To(BeEmpty())
+		})
+
+		It("should return the runtime debug log on failure", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/missing"}, nil)
+			sut = tr.configGivenEnv()
+
+			_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:           tr.ctrID,
+				BundlePath:   tr.tmpDir,
+				RuntimeDebug: true,
+			})
+			Expect(errors.Is(err, client.ErrRuntimeFailure)).To(BeTrue())
+
+			var rpcErr *client.RPCError
+			Expect(errors.As(err, &rpcErr)).To(BeTrue())
+			Expect(rpcErr.RuntimeLog).To(ContainSubstring(`"level":"debug"`))
+			Expect(rpcErr.RuntimeLog).To(ContainSubstring("/missing"))
+		})
+	})
+
+	Describe("ArchiveLogs", func() {
+		readArchive := func(buf *bytes.Buffer) map[string]string {
+			tarReader := tar.NewReader(buf)
+			files := map[string]string{}
+			for {
+				header, err := tarReader.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				Expect(err).To(BeNil())
+				data, err := io.ReadAll(tarReader)
+				Expect(err).To(BeNil())
+				files[header.Name] = string(data)
+			}
+
+			return files
+		}
+
+		It("should archive the logs and exit of an exited container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "echo hello; exit 3"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			_, err := sut.WaitContainer(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+
+			var buf bytes.Buffer
+			Expect(sut.ArchiveLogs(context.Background(), []string{tr.ctrID, "unknown"}, &buf)).To(BeNil())
+
+			files := readArchive(&buf)
+			Expect(files[tr.ctrID+"/"+filepath.Base(tr.logPath())]).To(ContainSubstring("stdout F hello\n"))
+			Expect(files[tr.ctrID+"/exit.json"]).To(ContainSubstring(`"exitCode": 3`))
+			Expect(files["unknown/exit.json"]).To(ContainSubstring("not found"))
+		})
+
+		It("should archive the logs of a running container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(
+				false, []string{"/busybox", "sh", "-c", "echo hello; sleep 20"}, nil,
+			)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Eventually(func() string {
+				var buf bytes.Buffer
+				Expect(sut.ArchiveLogs(context.Background(), []string{tr.ctrID}, &buf)).To(BeNil())
+
+				files := readArchive(&buf)
+				Expect(files[tr.ctrID+"/exit.json"]).To(ContainSubstring(`"exited": false`))
+
+				return files[tr.ctrID+"/"+filepath.Base(tr.logPath())]
+			}, time.Second*5).Should(ContainSubstring("stdout F hello\n"))
+		})
+	})
+
+	Describe("Tracing", func() {
+		It("should record spans and propagate the trace context", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			tracer := &recordingTracer{}
+			propagated := 0
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.TracerProvider = tracer
+			cfg.TracePropagator = func(ctx context.Context) client.TraceContext {
+				propagated++
+
+				return client.TraceContext{
+					Traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				}
+			}
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			_, err = sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+				ID:      tr.ctrID,
+				Command: []string{"/busybox", "true"},
+				Timeout: 10,
+			})
+			Expect(err).To(BeNil())
+
+			_, err = sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+				ID: "unknown",
+			})
+			Expect(err).NotTo(BeNil())
+
+			Expect(propagated).To(Equal(3))
+			Expect(tracer.ended()).To(Equal([]string{
+				"CreateContainer: <nil>",
+				"ExecSyncContainer: <nil>",
+				"ExecSyncContainer: " + err.Error(),
+			}))
+		})
+	})
+
+	Describe("Metrics", func() {
+		It("should record RPC latencies and reconnects", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			metrics := client.NewMetrics(0.5, 60)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.Metrics = metrics
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+			Expect(sut.Reconnect(context.Background())).To(BeNil())
+
+			snapshot := metrics.Snapshot()
+			Expect(snapshot.Reconnects).To(BeEquivalentTo(1))
+			Expect(snapshot.ActiveAttachSessions).To(BeZero())
+
+			create := snapshot.RPCLatency["CreateContainer"]
+			Expect(create.Count).To(BeEquivalentTo(1))
+			Expect(create.Buckets[60]).To(BeEquivalentTo(1))
+			Expect(create.Sum).To(BeNumerically(">", 0))
+
+			// Version is called while waiting for the server and by Reconnect.
+			Expect(snapshot.RPCLatency["Version"].Count).To(BeNumerically(">=", 2))
+		})
+	})
+
+	Describe("IOUser", func() {
+		It("should run the log filter as the IO user", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "echo hello; sleep 20"}, nil)
+			sut = tr.configGivenEnv()
+			_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:         tr.ctrID,
+				BundlePath: tr.tmpDir,
+				ExitPaths:  []string{tr.exitPath()},
+				LogDrivers: []client.LogDriver{{
+					Type: client.LogDriverTypeContainerRuntimeInterface,
+					Path: tr.logPath(),
+				}},
+				LogFilter: &client.LogFilter{
+					Path: "/bin/sh",
+					Args: []string{"-c", "echo uid=$(id -u) gid=$(id -g); exec cat"},
+				},
+				IOUser: &client.IOUser{UID: 65534, GID: 65534},
+			})
+			Expect(err).To(BeNil())
+			tr.startContainer(sut)
+
+			Eventually(func() string {
+				return fileContents(tr.logPath())
+			}, time.Second*5).Should(ContainSubstring("hello"))
+			Expect(fileContents(tr.logPath())).To(ContainSubstring("uid=65534 gid=65534"))
+		})
+	})
+
+	Describe("ServerAddress", func() {
+		It("should connect to a running server without managing it", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ServerAddress = "unix://" + filepath.Join(tr.tmpDir, "conmon.sock")
+			remote, err := client.New(cfg)
+			Expect(err).To(BeNil())
+			Expect(remote.PID()).To(Equal(sut.PID()))
+
+			_, err = remote.Version(context.Background())
+			Expect(err).To(BeNil())
+			Expect(remote.Reconnect(context.Background())).To(BeNil())
+			Expect(remote.Shutdown()).NotTo(BeNil())
+		})
+
+		It("should fail if the server is not running", func() {
+			tr = newTestRunner()
+			sut = nil
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ServerAddress = "unix://" + filepath.Join(tr.tmpDir, "conmon.sock")
+			_, err := client.New(cfg)
+			Expect(err).NotTo(BeNil())
+		})
+
+		It("should fail on invalid addresses", func() {
+			tr = newTestRunner()
+			sut = nil
+			for _, address := range []string{
+				"conmon.sock",
+				"unix://",
+				"tcp://localhost",
+				"vsock://host:1024",
+				"vsock://3:port",
+				"npipe://pipe",
+			} {
+				cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+				cfg.ServerAddress = address
+				_, err := client.New(cfg)
+				Expect(err).NotTo(BeNil(), address)
+			}
+		})
+	})
+
+	Describe("RequestMutator", func() {
+		It("should inject defaults into requests", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "echo", "hello"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.RequestMutator = func(_ context.Context, req interface{}) error {
+				if create, ok := req.(*client.CreateContainerConfig); ok && len(create.LogDrivers) == 0 {
+					create.LogDrivers = []client.LogDriver{{
+						Type: client.LogDriverTypeContainerRuntimeInterface,
+						Path: tr.logPath(),
+					}}
+				}
+
+				return nil
+			}
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+
+			createConfig := &client.CreateContainerConfig{
+				ID:         tr.ctrID,
+				BundlePath: tr.tmpDir,
+				ExitPaths:  []string{tr.exitPath()},
+			}
+			_, err = sut.CreateContainer(context.Background(), createConfig)
+			Expect(err).To(BeNil())
+			Expect(createConfig.LogDrivers).To(BeEmpty())
+			tr.startContainer(sut)
+
+			Eventually(func() string {
+				return fileContents(tr.logPath())
+			}, time.Second*5).Should(ContainSubstring("hello"))
+		})
+
+		It("should abort requests if the mutator fails", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			errRejected := errors.New("rejected")
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.RequestMutator = func(context.Context, interface{}) error {
+				return errRejected
+			}
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+
+			_, err = sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:         tr.ctrID,
+				BundlePath: tr.tmpDir,
+			})
+			Expect(errors.Is(err, errRejected)).To(BeTrue())
+			Expect(tr.rr.RunCommandCheckOutput(tr.ctrID, "list")).NotTo(BeNil())
+		})
+	})
+
+	Describe("Lifecycle", func() {
+		It("should negotiate the protocol version", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+			Expect(sut.NegotiatedProtocolVersion()).To(Equal(client.ProtocolVersion))
+
+			version, err := sut.Version(context.Background())
+			Expect(err).To(BeNil())
+			Expect(version.ProtocolVersion).To(BeNumerically(">=", version.MinClientProtocolVersion))
+		})
+
+		It("should start a server only if none is running", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.CgroupManager = client.CgroupManagerCgroupfs
+			var err error
+			sut, err = client.StartServer(cfg)
+			Expect(err).To(BeNil())
+
+			_, err = client.StartServer(cfg)
+			Expect(errors.Is(err, client.ErrServerRunning)).To(BeTrue())
+
+			pid := sut.PID()
+			Expect(sut.GracefulShutdown(context.Background())).To(BeNil())
+			Expect(syscall.Kill(int(pid), 0)).To(Equal(syscall.ESRCH))
+
+			sut, err = client.StartServer(cfg)
+			Expect(err).To(BeNil())
+			Expect(sut.PID()).NotTo(Equal(pid))
+		})
+
+		It("should fail with an invalid cgroup manager", func() {
+			tr = newTestRunner()
+			sut = nil
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.CgroupManager = "invalid"
+			_, err := client.StartServer(cfg)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("Dialer", func() {
+		It("should dial the server and attach sockets via the dialer", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			var (
+				mu       sync.Mutex
+				networks = map[string]int{}
+			)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.Dialer = func(_ context.Context, network, address string) (net.Conn, error) {
+				mu.Lock()
+				networks[network]++
+				mu.Unlock()
+
+				return client.DialLongSocket(network, address)
+			}
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			exec, err := sut.ExecContainer(context.Background(), &client.ExecContainerConfig{
+				ID:      tr.ctrID,
+				Command: []string{"/busybox", "cat"},
+			})
+			Expect(err).To(BeNil())
+
+			conn, err := sut.AttachConn(context.Background(), &client.AttachConnConfig{
+				ID:          tr.ctrID,
+				SocketPath:  filepath.Join(tr.tmpDir, "attach"),
+				ExecSession: exec.ExecSession,
+			})
+			Expect(err).To(BeNil())
+			defer conn.Close()
+
+			_, err = conn.Write([]byte("hello\n"))
+			Expect(err).To(BeNil())
+			buf := make([]byte, len("hello\n"))
+			_, err = io.ReadFull(conn, buf)
+			Expect(err).To(BeNil())
+			Expect(string(buf)).To(Equal("hello\n"))
+
+			mu.Lock()
+			defer mu.Unlock()
+			Expect(networks["unix"]).To(BeNumerically(">", 0))
+			Expect(networks["unixpacket"]).To(Equal(1))
+		})
+
+		It("should fail if the dialer fails", func() {
+			tr = newTestRunner()
+			sut = nil
+			errDial := errors.New("dial failed")
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ServerAddress = "tcp://localhost:1234"
+			cfg.Dialer = func(context.Context, string, string) (net.Conn, error) {
+				return nil, errDial
+			}
+			_, err := client.New(cfg)
+			Expect(errors.Is(err, errDial)).To(BeTrue())
+		})
+	})
+
+	Describe("AttachTimeout", func() {
+		It("should end an idle session", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			_, stdout := io.Pipe()
+			summaries := make(chan *client.AttachSessionSummary, 1)
+			err := sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:          tr.ctrID,
+				SocketPath:  filepath.Join(tr.tmpDir, "attach"),
+				Streams:     client.AttachStreams{Stdout: &client.Out{stdout}},
+				IdleTimeout: 500 * time.Millisecond,
+				OnSessionEnd: func(summary *client.AttachSessionSummary) {
+					summaries <- summary
+				},
+			})
+			Expect(errors.Is(err, client.ErrAttachIdleTimeout)).To(BeTrue())
+
+			var summary *client.AttachSessionSummary
+			Eventually(summaries, time.Second*10).Should(Receive(&summary))
+			Expect(summary.Reason).To(Equal(client.AttachSessionEndReasonTimeout))
+		})
+
+		It("should end an active session after its maximum duration", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "while true; do echo hello; sleep 0.1; done",
+			}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdoutRead, stdout := io.Pipe()
+			go func() {
+				_, _ = io.Copy(io.Discard, stdoutRead)
+			}()
+
+			start := time.Now()
+			err := sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:          tr.ctrID,
+				SocketPath:  filepath.Join(tr.tmpDir, "attach"),
+				Streams:     client.AttachStreams{Stdout: &client.Out{stdout}},
+				IdleTimeout: 500 * time.Millisecond,
+				MaxDuration: 2 * time.Second,
+			})
+			Expect(errors.Is(err, client.ErrAttachMaxDuration)).To(BeTrue())
+			Expect(time.Since(start)).To(BeNumerically(">=", 2*time.Second))
+		})
+	})
+
+	Describe("AttachActivity", func() {
+		It("should notify about idle and active sessions", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdinRead, stdin := io.Pipe()
+			stdoutRead, stdout := io.Pipe()
+			go func() {
+				_, _ = io.Copy(io.Discard, stdoutRead)
+			}()
+
+			changes := make(chan client.AttachActivityState, 10)
+			attachDone := make(chan error, 1)
+			go func() {
+				attachDone <- sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:         tr.ctrID,
+					SocketPath: filepath.Join(tr.tmpDir, "attach"),
+					Streams: client.AttachStreams{
+						Stdin:  &client.In{stdinRead},
+						Stdout: &client.Out{stdout},
+					},
+					IdleNotifyAfter: 200 * time.Millisecond,
+					OnActivityChange: func(change *client.AttachActivityChange) {
+						changes <- change.State
+					},
+				})
+			}()
+
+			Eventually(changes, time.Second*10).Should(Receive(Equal(client.AttachActivityIdle)))
+			_, err := stdin.Write([]byte("hello\n"))
+			Expect(err).To(BeNil())
+			Eventually(changes, time.Second*10).Should(Receive(Equal(client.AttachActivityActive)))
+			Eventually(changes, time.Second*10).Should(Receive(Equal(client.AttachActivityIdle)))
+
+			Expect(stdin.Close()).To(BeNil())
+			Eventually(attachDone, time.Second*10).Should(Receive())
+		})
+	})
+
+	Describe("Labels", func() {
+		It("should store and query the labels of containers", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			labels, err := sut.GetLabels(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(labels).To(BeEmpty())
+
+			Expect(sut.SetLabels(context.Background(), tr.ctrID, map[string]string{
+				"owner": "controller",
+				"job":   "42",
+			})).To(BeNil())
+
+			labels, err = sut.GetLabels(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(labels).To(Equal(map[string]string{"owner": "controller", "job": "42"}))
+
+			containers, err := sut.ListContainers(context.Background(), client.LabelSelector{"owner": "controller"})
+			Expect(err).To(BeNil())
+			Expect(containers).To(HaveLen(1))
+			Expect(containers[0].ID).To(Equal(tr.ctrID))
+			Expect(containers[0].Labels).To(HaveKeyWithValue("job", "42"))
+
+			containers, err = sut.ListContainers(context.Background(), client.LabelSelector{"owner": "other"})
+			Expect(err).To(BeNil())
+			Expect(containers).To(BeEmpty())
+
+			Expect(sut.SetLabels(context.Background(), tr.ctrID, map[string]string{"": "invalid"})).NotTo(BeNil())
+			Expect(sut.SetLabels(context.Background(), "unknown", nil)).NotTo(BeNil())
+		})
+	})
+
+	Describe("OutputBuffer", func() {
+		const output = 200 * 11
+
+		attach := func(policy client.OutputBufferPolicy, stdout *slowWriter) *client.AttachSessionSummary {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "sleep 1; for i in $(seq 200); do echo 0123456789; done",
+			}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			var summary *client.AttachSessionSummary
+			err := sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:                 tr.ctrID,
+				SocketPath:         filepath.Join(tr.tmpDir, "attach"),
+				Streams:            client.AttachStreams{Stdout: &client.Out{stdout}},
+				OutputBufferSize:   64,
+				OutputBufferPolicy: policy,
+				OnSessionEnd: func(s *client.AttachSessionSummary) {
+					summary = s
+				},
+			})
+			Expect(err).To(BeNil())
+			Expect(summary).NotTo(BeNil())
+
+			return summary
+		}
+
+		It("should write all output with the block policy", func() {
+			stdout := &slowWriter{delay: time.Millisecond}
+			summary := attach(client.OutputBufferPolicyBlock, stdout)
+			Expect(stdout.written()).To(BeEquivalentTo(output))
+			Expect(summary.BytesOut).To(BeEquivalentTo(output))
+			Expect(summary.BytesDropped).To(BeZero())
+		})
+
+		for _, policy := range []client.OutputBufferPolicy{
+			client.OutputBufferPolicyDropOldest, client.OutputBufferPolicyDropNewest,
+		} {
+			policy := policy
+			It(fmt.Sprintf("should drop output of slow streams with policy %d", policy), func() {
+				stdout := &slowWriter{delay: 50 * time.Millisecond}
+				summary := attach(policy, stdout)
+				Expect(summary.BytesDropped).NotTo(BeZero())
+				Expect(summary.BytesOut + summary.BytesDropped).To(BeEquivalentTo(output))
+				Expect(stdout.written()).To(BeEquivalentTo(summary.BytesOut))
+			})
+		}
+	})
+
+	Describe("StdinStallTimeout", func() {
+		It("should end the session if the standard input is not consumed", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			err := sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:         tr.ctrID,
+				SocketPath: filepath.Join(tr.tmpDir, "attach"),
+				Streams: client.AttachStreams{
+					Stdin: &client.In{bytes.NewReader(make([]byte, 64*1024*1024))},
+				},
+				StdinStallTimeout: 500 * time.Millisecond,
+			})
+			Expect(errors.Is(err, client.ErrStdinStalled)).To(BeTrue())
+		})
+	})
+
+	Describe("LogLineAssembler", func() {
+		entry := func(stream, message string, partial bool) *client.LogEntry {
+			return &client.LogEntry{Stream: stream, Message: []byte(message), Partial: partial}
+		}
+
+		messages := func(lines []client.LogLine) (result []string) {
+			for _, line := range lines {
+				result = append(result, string(line.Message))
+			}
+
+			return result
+		}
+
+		BeforeEach(func() {
+			tr = newTestRunner()
+			sut = nil
+		})
+
+		It("should reassemble partial entries per stream", func() {
+			a := client.NewLogLineAssembler(nil)
+			Expect(a.Add(entry("stdout", "hello ", true))).To(BeEmpty())
+			Expect(messages(a.Add(entry("stderr", "error", false)))).To(Equal([]string{"error"}))
+			Expect(messages(a.Add(entry("stdout", "world", false)))).To(Equal([]string{"hello world"}))
+			Expect(a.Add(entry("stdout", "incomplete", true))).To(BeEmpty())
+
+			lines := a.Flush()
+			Expect(messages(lines)).To(Equal([]string{"incomplete"}))
+			Expect(lines[0].Partial).To(BeTrue())
+		})
+
+		It("should split long lines with continuation markers", func() {
+			a := client.NewLogLineAssembler(&client.LogLineOptions{
+				MaxLineLength:      4,
+				ContinuationMarker: []byte("+"),
+				IncludeRecords:     true,
+			})
+			lines := a.Add(entry("stdout", "abcdef", true))
+			Expect(messages(lines)).To(Equal([]string{"abcd+"}))
+			Expect(lines[0].Continued).To(BeTrue())
+			Expect(lines[0].Records).To(HaveLen(1))
+
+			lines = a.Add(entry("stdout", "ghij", false))
+			Expect(messages(lines)).To(Equal([]string{"efgh+", "ij"}))
+			Expect(lines[1].Continued).To(BeFalse())
+			Expect(lines[0].Records).To(HaveLen(1))
+		})
+
+		It("should not reassemble raw entries", func() {
+			a := client.NewLogLineAssembler(&client.LogLineOptions{Raw: true})
+			lines := a.Add(entry("stdout", "hello ", true))
+			Expect(messages(lines)).To(Equal([]string{"hello "}))
+			Expect(lines[0].Partial).To(BeTrue())
+			Expect(a.Flush()).To(BeEmpty())
+		})
+	})
+
+	Describe("ReadLogs", func() {
+		collect := func(entries <-chan client.LogEntry) (messages []string) {
+			for entry := range entries {
+				messages = append(messages, string(entry.Message))
+			}
+
+			return messages
+		}
+
+		It("should follow the tail of the log until the container exits", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "echo a; echo b; echo c; sleep 2; echo d",
+			}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Eventually(func() string {
+				return fileContents(tr.logPath())
+			}, time.Second*5).Should(ContainSubstring("F c"))
+
+			entries, err := sut.ReadLogs(context.Background(), tr.ctrID, &client.ReadLogsOptions{
+				Follow:    true,
+				TailLines: 2,
+			})
+			Expect(err).To(BeNil())
+
+			done := make(chan []string)
+			go func() {
+				done <- collect(entries)
+			}()
+			Eventually(done, time.Second*10).Should(Receive(Equal([]string{"b", "c", "d"})))
+		})
+
+		It("should read the entries within the time range", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "echo a; sleep 1; echo b; sleep 1; echo c; sleep 20",
+			}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Eventually(func() string {
+				return fileContents(tr.logPath())
+			}, time.Second*10).Should(ContainSubstring("F c"))
+
+			entries, err := sut.ReadLogs(context.Background(), tr.ctrID, nil)
+			Expect(err).To(BeNil())
+			var all []client.LogEntry
+			for entry := range entries {
+				all = append(all, entry)
+			}
+			Expect(all).To(HaveLen(3))
+
+			entries, err = sut.ReadLogs(context.Background(), tr.ctrID, &client.ReadLogsOptions{
+				Since: all[1].Timestamp,
+				Until: all[1].Timestamp,
+			})
+			Expect(err).To(BeNil())
+			Expect(collect(entries)).To(Equal([]string{"b"}))
+		})
+	})
+
+	Describe("SelfTest", func() {
+		statuses := func(report *client.SelfTestReport) map[string]client.SelfTestStatus {
+			result := map[string]client.SelfTestStatus{}
+			for _, check := range report.Checks {
+				Expect(check.Err).To(BeNil(), check.Name)
+				result[check.Name] = check.Status
+			}
+
+			return result
+		}
+
+		It("should skip the container checks without fixture", func() {
+			tr = newTestRunner()
+			sut = tr.configGivenEnv()
+
+			report := sut.SelfTest(context.Background(), nil)
+			Expect(report.Compatible()).To(BeTrue())
+			Expect(report.ServerVersion).NotTo(BeNil())
+			Expect(report.NegotiatedProtocolVersion).To(Equal(client.ProtocolVersion))
+			Expect(statuses(report)).To(Equal(map[string]client.SelfTestStatus{
+				"Version":         client.SelfTestPassed,
+				"ListContainers":  client.SelfTestPassed,
+				"TenantQuota":     client.SelfTestPassed,
+				"CreateContainer": client.SelfTestSkipped,
+				"AttachContainer": client.SelfTestSkipped,
+				"ContainerStats":  client.SelfTestSkipped,
+				"WaitContainer":   client.SelfTestSkipped,
+			}))
+		})
+
+		It("should exercise the container RPCs with a fixture", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+
+			report := sut.SelfTest(context.Background(), &client.SelfTestConfig{
+				Fixture: &client.SelfTestFixture{
+					BundlePath: tr.tmpDir,
+					Start: func(_ context.Context, id string) error {
+						return tr.rr.RunCommand("start", id)
+					},
+					Cleanup: func(_ context.Context, id string) error {
+						return tr.rr.RunCommand("delete", "-f", id)
+					},
+				},
+			})
+			for _, status := range statuses(report) {
+				Expect(status).To(Equal(client.SelfTestPassed))
+			}
+			Expect(report.Compatible()).To(BeTrue())
+		})
+	})
+
+	Describe("SeccompNotify", func() {
+		It("should let the handler decide about syscalls", func() {
+			tr = newTestRunner()
+			listenerPath := filepath.Join(tr.tmpDir, "seccomp.sock")
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "sleep 1; /busybox mkdir /notified || echo denied; sleep 20",
+			}, func(g generate.Generator) {
+				g.Config.Linux.Seccomp = &specs.LinuxSeccomp{
+					DefaultAction: specs.ActAllow,
+					ListenerPath:  listenerPath,
+					Syscalls: []specs.LinuxSyscall{{
+						Names:  []string{"mkdir", "mkdirat"},
+						Action: specs.ActNotify,
+					}},
+				}
+			})
+			sut = tr.configGivenEnv()
+
+			notifications := make(chan client.SeccompNotification, 1)
+			handler := client.SeccompNotifyHandlerFunc(func(
+				ctx context.Context, n *client.SeccompNotification,
+			) (*client.SeccompNotifyResponse, error) {
+				select {
+				case notifications <- *n:
+				default:
+				}
+
+				return &client.SeccompNotifyResponse{Action: client.SeccompNotifyActionError, Errno: syscall.EACCES}, nil
+			})
+
+			_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:         tr.ctrID,
+				BundlePath: tr.tmpDir,
+				LogDrivers: []client.LogDriver{{
+					Type: client.LogDriverTypeContainerRuntimeInterface,
+					Path: tr.logPath(),
+				}},
+				SeccompNotify: &client.SeccompNotifyConfig{
+					ListenerPath: listenerPath,
+					Handler:      handler,
+				},
+			})
+			Expect(err).To(BeNil())
+			tr.startContainer(sut)
+
+			var notification client.SeccompNotification
+			Eventually(notifications, 10*time.Second).Should(Receive(&notification))
+			Expect(notification.PID).NotTo(BeZero())
+			Eventually(func() string {
+				return fileContents(tr.logPath())
+			}, 10*time.Second).Should(ContainSubstring("denied"))
+			Expect(filepath.Join(tr.tmpRootfs, "notified")).NotTo(BeADirectory())
+		})
+
+		It("should fail to serve containers without listener", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+
+			err := sut.ServeSeccompNotify(context.Background(), tr.ctrID, client.SeccompNotifyHandlerFunc(nil))
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("Batch", func() {
+		newPooledClient := func() *client.ConmonClient {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.RPCConnectionPoolSize = 2
+			c, err := client.New(cfg)
+			Expect(err).To(BeNil())
+
+			return c
+		}
+
+		It("should issue calls concurrently over pooled connections", func() {
+			sut = newPooledClient()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			calls := make([]client.BatchCall, 50)
+			for i := range calls {
+				calls[i] = func(ctx context.Context, c *client.ConmonClient) error {
+					containers, err := c.ListContainers(ctx)
+					if err != nil {
+						return err
+					}
+					if len(containers) != 1 {
+						return fmt.Errorf("unexpected containers: %v", containers)
+					}
+
+					return nil
+				}
+			}
+			result := sut.Batch(context.Background(), &client.BatchConfig{Concurrency: 8}, calls...)
+			Expect(result.Err()).To(BeNil())
+			Expect(result.Errors).To(HaveLen(50))
+
+			// Broken connections of the pool get re-established.
+			Expect(sut.CloseConnections()).To(BeNil())
+			_, err := sut.Version(context.Background())
+			Expect(err).To(BeNil())
+
+			result = sut.BatchKillContainers(context.Background(), nil, []string{tr.ctrID, "unknown"}, syscall.SIGKILL, false)
+			Expect(result.Errors[0]).To(BeNil())
+			Expect(result.Errors[1]).NotTo(BeNil())
+			Expect(result.Err()).NotTo(BeNil())
+		})
+
+		It("should dial pooled connections without blocking other calls", func() {
+			tr = newTestRunner()
+			var (
+				mu   sync.Mutex
+				hang bool
+			)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.RPCConnectionPoolSize = 2
+			cfg.Dialer = func(ctx context.Context, network, address string) (net.Conn, error) {
+				mu.Lock()
+				hangDial := hang
+				hang = false
+				mu.Unlock()
+				if hangDial {
+					<-ctx.Done()
+
+					return nil, ctx.Err()
+				}
+
+				return client.DialLongSocket(network, address)
+			}
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			Expect(sut.CloseConnections()).To(BeNil())
+
+			mu.Lock()
+			hang = true
+			mu.Unlock()
+			hungDone := make(chan error, 1)
+			go func() {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+				defer cancel()
+				_, err := sut.Version(ctx)
+				hungDone <- err
+			}()
+			Eventually(func() bool {
+				mu.Lock()
+				defer mu.Unlock()
+
+				return hang
+			}, time.Second*5).Should(BeFalse())
+
+			// The other connection of the pool is dialed while the first one
+			// hangs, which ends with the deadline of its call.
+			_, err = sut.Version(context.Background())
+			Expect(err).To(BeNil())
+			Consistently(hungDone, time.Second).ShouldNot(Receive())
+			Eventually(hungDone, time.Second*5).Should(Receive(MatchError(context.DeadlineExceeded)))
+		})
+
+		It("should skip the remaining calls on failure if configured", func() {
+			sut = newPooledClient()
+
+			errFailed := errors.New("failed")
+			calls := []client.BatchCall{
+				func(context.Context, *client.ConmonClient) error {
+					return errFailed
+				},
+				func(ctx context.Context, _ *client.ConmonClient) error {
+					return ctx.Err()
+				},
+			}
+			result := sut.Batch(context.Background(), &client.BatchConfig{Concurrency: 1, FailFast: true}, calls...)
+			Expect(result.Errors[0]).To(MatchError(errFailed))
+			Expect(result.Errors[1]).To(MatchError(context.Canceled))
+			Expect(result.Err()).To(MatchError(errFailed))
+		})
+	})
+
+	Describe("CheckpointContainerStream", func() {
+		It("should stream the checkpoint image", func() {
+			if _, err := exec.LookPath("criu"); err != nil {
+				Skip("criu is not available")
+			}
+			if unshare.IsRootless() {
+				Skip("does not run rootless")
+			}
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "30"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			reader, err := sut.CheckpointContainerStream(context.Background(), &client.CheckpointContainerConfig{
+				ID: tr.ctrID,
+			})
+			Expect(err).To(BeNil())
+			defer reader.Close()
+
+			files := []string{}
+			archive := tar.NewReader(reader)
+			for {
+				header, err := archive.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				Expect(err).To(BeNil())
+				files = append(files, header.Name)
+			}
+			Expect(files).To(ContainElement("inventory.img"))
+			Eventually(func() error {
+				return tr.rr.RunCommandCheckOutput("stopped", "list")
+			}, time.Second*10).Should(BeNil())
+		})
+
+		It("should fail if the container does not exist", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			_, err := sut.CheckpointContainerStream(context.Background(), &client.CheckpointContainerConfig{
+				ID: tr.ctrID,
+			})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("UploadCheckpointImage", func() {
+		It("should upload the files of the archive", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			var buf bytes.Buffer
+			archive := tar.NewWriter(&buf)
+			Expect(archive.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: "sub/", Mode: 0o755})).To(BeNil())
+			Expect(archive.WriteHeader(&tar.Header{
+				Typeflag: tar.TypeReg, Name: "sub/inventory.img", Mode: 0o600, Size: 9,
+			})).To(BeNil())
+			_, err := archive.Write([]byte("inventory"))
+			Expect(err).To(BeNil())
+			Expect(archive.Close()).To(BeNil())
+
+			image, err := sut.UploadCheckpointImage(context.Background(), "", &buf)
+			Expect(err).To(BeNil())
+			content, err := os.ReadFile(filepath.Join(image.Path, "sub", "inventory.img"))
+			Expect(err).To(BeNil())
+			Expect(string(content)).To(Equal("inventory"))
+
+			Expect(image.Close()).To(BeNil())
+			Eventually(func() bool {
+				_, err := os.Stat(image.Path)
+
+				return os.IsNotExist(err)
+			}, time.Second*5).Should(BeTrue())
+		})
+
+		It("should fail to upload unsupported files", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			var buf bytes.Buffer
+			archive := tar.NewWriter(&buf)
+			Expect(archive.WriteHeader(&tar.Header{
+				Typeflag: tar.TypeSymlink, Name: "link", Linkname: "/etc/passwd",
+			})).To(BeNil())
+			Expect(archive.Close()).To(BeNil())
+
+			_, err := sut.UploadCheckpointImage(context.Background(), "", &buf)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("CgroupValues", func() {
+		shares, period := uint64(1024), uint64(100000)
+		quota, limit, swap := int64(50000), int64(64*1024*1024), int64(128*1024*1024)
+		resources := &specs.LinuxResources{
+			CPU:    &specs.LinuxCPU{Shares: &shares, Quota: &quota, Period: &period, Cpus: "0-1"},
+			Memory: &specs.LinuxMemory{Limit: &limit, Swap: &swap},
+			Pids:   &specs.LinuxPids{Limit: 0},
+		}
+
+		It("should translate the resources for cgroup v1", func() {
+			values, err := client.CgroupValues(resources, client.CgroupVersionV1)
+			Expect(err).To(BeNil())
+			Expect(values).To(Equal([]client.CgroupValue{
+				{Controller: "cpu", File: "cpu.shares", Value: "1024"},
+				{Controller: "cpu", File: "cpu.cfs_period_us", Value: "100000"},
+				{Controller: "cpu", File: "cpu.cfs_quota_us", Value: "50000"},
+				{Controller: "cpuset", File: "cpuset.cpus", Value: "0-1"},
+				{Controller: "memory", File: "memory.limit_in_bytes", Value: "67108864"},
+				{Controller: "memory", File: "memory.memsw.limit_in_bytes", Value: "134217728"},
+				{Controller: "pids", File: "pids.max", Value: "max"},
+			}))
+		})
+
+		It("should translate the resources for cgroup v2", func() {
+			values, err := client.CgroupValues(resources, client.CgroupVersionV2)
+			Expect(err).To(BeNil())
+			Expect(values).To(Equal([]client.CgroupValue{
+				{File: "cpu.weight", Value: "39"},
+				{File: "cpu.max", Value: "50000 100000"},
+				{File: "cpuset.cpus", Value: "0-1"},
+				{File: "memory.max", Value: "67108864"},
+				{File: "memory.swap.max", Value: "67108864"},
+				{File: "pids.max", Value: "max"},
+			}))
+		})
+
+		It("should fail for invalid resources", func() {
+			_, err := client.CgroupValues(&specs.LinuxResources{
+				Unified: map[string]string{"io.max": "max"},
+			}, client.CgroupVersionV1)
+			Expect(err).NotTo(BeNil())
+
+			_, err = client.CgroupValues(&specs.LinuxResources{
+				Memory: &specs.LinuxMemory{Swap: &swap},
+			}, client.CgroupVersionV2)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("UpdateContainerResources", func() {
+		It("should update the memory limit of a running container", func() {
+			if unshare.IsRootless() {
+				Skip("does not run rootless")
+			}
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "30"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			limit := int64(64 * 1024 * 1024)
+			Expect(sut.UpdateContainerResources(context.Background(), tr.ctrID, &specs.LinuxResources{
+				Memory: &specs.LinuxMemory{Limit: &limit},
+			})).To(BeNil())
+
+			resp, err := sut.ContainerStats(context.Background(), tr.ctrID)
+			Expect(err).To(BeNil())
+			Expect(resp.Memory.LimitBytes).To(BeEquivalentTo(limit))
+		})
+
+		It("should reject the cgroup core files", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "30"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Expect(sut.UpdateContainerCgroupValues(context.Background(), tr.ctrID, []client.CgroupValue{
+				{File: "cgroup.procs", Value: "1"},
+			})).NotTo(BeNil())
+		})
+	})
+
+	Describe("HealthCheck", func() {
+		It("should report the resource usage of the server", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			status, err := sut.HealthCheck(context.Background())
+			Expect(err).To(BeNil())
+			Expect(status.Latency).To(BeNumerically(">", 0))
+			Expect(status.OpenFDs).To(BeNumerically(">", 0))
+			Expect(status.MemoryRSSBytes).To(BeNumerically(">", 0))
+			Expect(status.Containers).To(BeZero())
+
+			tr.createContainer(sut, false)
+			status, err = sut.HealthCheck(context.Background())
+			Expect(err).To(BeNil())
+			Expect(status.Containers).To(BeEquivalentTo(1))
+		})
+	})
+
+	Describe("WriteStdinContainer", func() {
+		It("should write the standard input of the container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Expect(sut.WriteStdinContainer(
+				context.Background(), tr.ctrID, strings.NewReader("hello\nworld\n"),
+			)).To(BeNil())
+
+			Eventually(func() string {
+				return fileContents(tr.logPath())
+			}, time.Second*5).Should(And(
+				ContainSubstring("stdout F hello\n"),
+				ContainSubstring("stdout F world\n"),
+			))
+		})
+
+		It("should fail for an unknown container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			Expect(sut.WriteStdinContainer(context.Background(), "unknown", strings.NewReader(""))).NotTo(BeNil())
+		})
+	})
+
+	Describe("Passthrough", func() {
+		It("should wait for the container exit", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "/busybox sleep 1; exit 3",
+			}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			var result *client.WaitContainerResult
+			Expect(sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:          tr.ctrID,
+				SocketPath:  filepath.Join(tr.tmpDir, "attach"),
+				Passthrough: true,
+				WaitForExit: true,
+				OnExit: func(exit *client.WaitContainerResult) {
+					result = exit
+				},
+			})).To(BeNil())
+			Expect(result).NotTo(BeNil())
+			Expect(result.ExitCode).To(BeEquivalentTo(3))
+		})
+	})
+
+	Describe("IDResolver", func() {
+		It("should resolve names and ID prefixes", func() {
+			containers := fakeContainerLister{
+				{ID: "abc123", Labels: map[string]string{"name": "web"}},
+				{ID: "abd456", Labels: map[string]string{"name": "db"}},
+				{ID: "web"},
+			}
+			resolver := &client.ContainerRegistryResolver{NameLabel: "name", MinPrefixLength: 2}
+			resolve := func(reference string) (string, error) {
+				return resolver.ResolveID(context.Background(), containers, reference)
+			}
+
+			Expect(resolve("web")).To(Equal("web"))
+			Expect(resolve("db")).To(Equal("abd456"))
+			Expect(resolve("abc")).To(Equal("abc123"))
+			Expect(resolve("a")).To(Equal("a"))
+			Expect(resolve("unknown")).To(Equal("unknown"))
+
+			_, err := resolve("ab")
+			Expect(errors.Is(err, client.ErrAmbiguousID)).To(BeTrue())
+			var ambiguous *client.AmbiguousIDError
+			Expect(errors.As(err, &ambiguous)).To(BeTrue())
+			Expect(ambiguous.Matches).To(Equal([]string{"abc123", "abd456"}))
+		})
+
+		It("should resolve the containers of the server", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.IDResolver = &client.ContainerRegistryResolver{NameLabel: "name"}
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			Expect(sut.SetLabels(context.Background(), tr.ctrID[:8], map[string]string{"name": "sleeper"})).To(BeNil())
+			labels, err := sut.GetLabels(context.Background(), "sleeper")
+			Expect(err).To(BeNil())
+			Expect(labels).To(HaveKeyWithValue("name", "sleeper"))
+		})
+	})
+
+	Describe("OutputOnly", func() {
+		It("should attach without standard input", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "while true; do echo hello; /busybox sleep 0.1; done",
+			}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdin, _ := io.Pipe()
+			Expect(sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:         tr.ctrID,
+				SocketPath: filepath.Join(tr.tmpDir, "attach"),
+				OutputOnly: true,
+				Streams:    client.AttachStreams{Stdin: &client.In{stdin}},
+			})).NotTo(BeNil())
+
+			stdoutRead, stdout := io.Pipe()
+			go func() {
+				defer GinkgoRecover()
+				Expect(sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:         tr.ctrID,
+					SocketPath: filepath.Join(tr.tmpDir, "attach"),
+					OutputOnly: true,
+					Streams:    client.AttachStreams{Stdout: &client.Out{stdout}},
+				})).To(BeNil())
+			}()
+
+			reader := bufio.NewReader(stdoutRead)
+			line, err := reader.ReadString('\n')
+			Expect(err).To(BeNil())
+			Expect(line).To(Equal("hello\n"))
+			go func() {
+				_, _ = io.Copy(io.Discard, reader)
+			}()
+
+			Expect(sut.KillContainer(context.Background(), tr.ctrID, syscall.SIGKILL, false)).To(BeNil())
+		})
+	})
+
+	Describe("StrictTTY", func() {
+		It("should fail the attach if the terminal mismatches", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "while true; do echo hello; /busybox sleep 0.1; done",
+			}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			_, stdout := io.Pipe()
+			err := sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:         tr.ctrID,
+				SocketPath: filepath.Join(tr.tmpDir, "attach"),
+				Tty:        true,
+				StrictTTY:  true,
+				Streams:    client.AttachStreams{Stdout: &client.Out{stdout}},
+			})
+			Expect(errors.Is(err, client.ErrTTYMismatch)).To(BeTrue())
+			var rpcErr *client.RPCError
+			Expect(errors.As(err, &rpcErr)).To(BeTrue())
+			Expect(rpcErr.Kind).To(Equal(client.ErrorKindTerminalMismatch))
+			Expect(rpcErr.Message).To(ContainSubstring("has no terminal"))
+
+			stdoutRead, stdout := io.Pipe()
+			go func() {
+				defer GinkgoRecover()
+				Expect(sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:         tr.ctrID,
+					SocketPath: filepath.Join(tr.tmpDir, "attach"),
+					StrictTTY:  true,
+					Streams:    client.AttachStreams{Stdout: &client.Out{stdout}},
+				})).To(BeNil())
+			}()
+
+			reader := bufio.NewReader(stdoutRead)
+			line, err := reader.ReadString('\n')
+			Expect(err).To(BeNil())
+			Expect(line).To(Equal("hello\n"))
+			go func() {
+				_, _ = io.Copy(io.Discard, reader)
+			}()
+
+			Expect(sut.KillContainer(context.Background(), tr.ctrID, syscall.SIGKILL, false)).To(BeNil())
+		})
+	})
+
+	Describe("ReportSessionEnd", func() {
+		It("should report the removal of a stopped container", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "while true; do echo hello; /busybox sleep 0.1; done",
+			}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			var summary *client.AttachSessionSummary
+			stdoutRead, stdout := io.Pipe()
+			done := make(chan error, 1)
+			go func() {
+				done <- sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:               tr.ctrID,
+					SocketPath:       filepath.Join(tr.tmpDir, "attach"),
+					ReportSessionEnd: true,
+					Streams:          client.AttachStreams{Stdout: &client.Out{stdout}},
+					OnSessionEnd: func(s *client.AttachSessionSummary) {
+						summary = s
+					},
+				})
+			}()
+
+			reader := bufio.NewReader(stdoutRead)
+			line, err := reader.ReadString('\n')
+			Expect(err).To(BeNil())
+			Expect(line).To(Equal("hello\n"))
+			go func() {
+				_, _ = io.Copy(io.Discard, reader)
+			}()
+
+			Expect(sut.StopContainer(context.Background(), &client.StopContainerConfig{
+				ID:      tr.ctrID,
+				Timeout: time.Second,
+			})).To(BeNil())
+
+			var attachErr error
+			Eventually(done, time.Second*10).Should(Receive(&attachErr))
+			Expect(attachErr).To(MatchError(client.ErrContainerRemoved))
+			var endErr *client.AttachEndError
+			Expect(errors.As(attachErr, &endErr)).To(BeTrue())
+			Expect(endErr.Reason).To(Equal(client.AttachEndReasonStopped))
+			Expect(endErr.Message).To(ContainSubstring("container stopped"))
+			Expect(summary.Reason).To(Equal(client.AttachSessionEndReasonRemoved))
+		})
+
+		It("should report killed sessions", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			_, stdout := io.Pipe()
+			done := make(chan error, 1)
+			go func() {
+				done <- sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:               tr.ctrID,
+					SocketPath:       filepath.Join(tr.tmpDir, "attach"),
+					ReportSessionEnd: true,
+					Streams:          client.AttachStreams{Stdout: &client.Out{stdout}},
+				})
+			}()
+
+			var sessions []client.AttachSession
+			Eventually(func() (int, error) {
+				var err error
+				sessions, err = sut.ListAttachSessions(context.Background(), tr.ctrID)
+
+				return len(sessions), err
+			}, time.Second*10).Should(Equal(1))
+			Expect(sut.KillAttachSession(context.Background(), sessions[0].ID)).To(BeNil())
+
+			var attachErr error
+			Eventually(done, time.Second*10).Should(Receive(&attachErr))
+			var endErr *client.AttachEndError
+			Expect(errors.As(attachErr, &endErr)).To(BeTrue())
+			Expect(endErr.Reason).To(Equal(client.AttachEndReasonKilled))
+			Expect(errors.Is(attachErr, client.ErrContainerRemoved)).To(BeFalse())
+		})
+	})
+
+	Describe("PrioritizeStderr", func() {
+		It("should deliver the standard error of a flooding process", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{
+				"/busybox", "sh", "-c", "/busybox cat /dev/zero & /busybox sleep 1; echo error >&2; /busybox sleep 20",
+			}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdoutRead, stdout := io.Pipe()
+			stderrRead, stderr := io.Pipe()
+			go func() {
+				// A slow consumer congests the standard output.
+				buf := make([]byte, 4096)
+				for {
+					if _, err := stdoutRead.Read(buf); err != nil {
+						return
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+			}()
+			go func() {
+				_ = sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:               tr.ctrID,
+					SocketPath:       filepath.Join(tr.tmpDir, "attach"),
+					PrioritizeStderr: true,
+					Streams: client.AttachStreams{
+						Stdout: &client.Out{stdout},
+						Stderr: &client.Out{stderr},
+					},
+				})
+			}()
+
+			lines := make(chan string, 1)
+			go func() {
+				line, _ := bufio.NewReader(stderrRead).ReadString('\n')
+				lines <- line
+			}()
+			Eventually(lines, time.Second*10).Should(Receive(Equal("error\n")))
+
+			err := sut.AttachContainer(context.Background(), &client.AttachConfig{
+				ID:               tr.ctrID,
+				SocketPath:       filepath.Join(tr.tmpDir, "attach"),
+				PrioritizeStderr: true,
+				SequencedOutput:  true,
+			})
+			Expect(err).NotTo(BeNil())
+
+			Expect(sut.KillContainer(context.Background(), tr.ctrID, syscall.SIGKILL, false)).To(BeNil())
+		})
+	})
+
+	Describe("CancelRequest", func() {
+		It("should abort a cancelled exec", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			done := make(chan error)
+			go func() {
+				_, err := sut.ExecSyncContainer(client.WithRequestID(context.Background(), "hanging"), &client.ExecSyncConfig{
+					ID:      tr.ctrID,
+					Command: []string{"/busybox", "sleep", "100"},
+					Timeout: timeoutUnlimited,
+				})
+				done <- err
+			}()
+
+			Eventually(func() (bool, error) {
+				return sut.CancelRequest(context.Background(), "hanging")
+			}, time.Second*10).Should(BeTrue())
+			Eventually(done, time.Second*10).Should(Receive(MatchError(client.ErrCancelled)))
+
+			cancelled, err := sut.CancelRequest(context.Background(), "hanging")
+			Expect(err).To(BeNil())
+			Expect(cancelled).To(BeFalse())
+		})
+
+		It("should abort an exec at the deadline", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			start := time.Now()
+			_, err := sut.ExecSyncContainer(ctx, &client.ExecSyncConfig{
+				ID:      tr.ctrID,
+				Command: []string{"/busybox", "sleep", "100"},
+				Timeout: timeoutUnlimited,
+			})
+			Expect(err).NotTo(BeNil())
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second*10))
+		})
+
+		It("should not cancel unknown requests", func() {
+			tr = newTestRunner()
+			sut = tr.configGivenEnv()
+			cancelled, err := sut.CancelRequest(context.Background(), "unknown")
+			Expect(err).To(BeNil())
+			Expect(cancelled).To(BeFalse())
+		})
+	})
+
+	Describe("ScratchDirs", func() {
+		It("should mount scratch dirs and remove them at exit", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false,
+				[]string{"/busybox", "sh", "-c", "echo hello > /scratch/file && sleep 3"}, nil)
+			sut = tr.configGivenEnv()
+			config, err := os.ReadFile(filepath.Join(tr.tmpDir, "config.json"))
+			Expect(err).To(BeNil())
+
+			resp, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:           tr.ctrID,
+				BundlePath:   tr.tmpDir,
+				ExitPaths:    []string{tr.exitPath()},
+				OOMExitPaths: []string{tr.oomExitPath()},
+				LogDrivers: []client.LogDriver{{
+					Type: client.LogDriverTypeContainerRuntimeInterface,
+					Path: tr.logPath(),
+				}},
+				ScratchDirs: []client.ScratchDir{{
+					Name:        "tmp",
+					SizeBytes:   1024 * 1024,
+					Destination: "/scratch",
+				}},
+			})
+			Expect(err).To(BeNil())
+			path := resp.ScratchDirPaths["tmp"]
+			Expect(path).To(Equal(filepath.Join(tr.tmpDir, "conmon-scratch", "tmp")))
+			Expect(os.ReadFile(filepath.Join(tr.tmpDir, "config.json"))).To(Equal(config))
+			tr.startContainer(sut)
+
+			Eventually(func() (string, error) {
+				content, err := os.ReadFile(filepath.Join(path, "file"))
+
+				return string(content), err
+			}, time.Second*5).Should(Equal("hello\n"))
+			Eventually(func() bool {
+				_, err := os.Stat(path)
+
+				return os.IsNotExist(err)
+			}, time.Second*10).Should(BeTrue())
+		})
+
+		It("should fail with invalid scratch dirs", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			for _, dir := range []client.ScratchDir{
+				{Name: "", SizeBytes: 1024},
+				{Name: "../etc", SizeBytes: 1024},
+				{Name: "tmp", SizeBytes: 0},
+				{Name: "tmp", SizeBytes: 1024, Destination: "scratch"},
+			} {
+				_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+					ID:          tr.ctrID,
+					BundlePath:  tr.tmpDir,
+					ScratchDirs: []client.ScratchDir{dir},
+				})
+				Expect(err).NotTo(BeNil())
+			}
+		})
+	})
+
+	Describe("CreateContainerAsync", func() {
+		It("should report the progress of a creation", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			sut = tr.configGivenEnv()
+
+			op, err := sut.CreateContainerAsync(context.Background(), &client.CreateContainerConfig{
+				ID:           tr.ctrID,
+				BundlePath:   tr.tmpDir,
+				ExitPaths:    []string{tr.exitPath()},
+				OOMExitPaths: []string{tr.oomExitPath()},
+				LogDrivers: []client.LogDriver{{
+					Type: client.LogDriverTypeContainerRuntimeInterface,
+					Path: tr.logPath(),
+				}},
+			})
+			Expect(err).To(BeNil())
+			Expect(op.ID).To(Equal(tr.ctrID))
+			Expect(op.RequestID).NotTo(BeEmpty())
+
+			resp, err := op.Wait()
+			Expect(err).To(BeNil())
+			Expect(resp.PID).NotTo(BeZero())
+			Eventually(op.Done()).Should(BeClosed())
+
+			progress := op.Progress()
+			Expect(progress.Phase).To(Equal(client.CreatePhaseDone))
+			Expect(progress.Elapsed).To(BeNumerically(">", 0))
+			Expect(op.Cancel()).To(BeNil())
+			Expect(tr.rr.RunCommandCheckOutput(tr.ctrID, "list")).To(BeNil())
+		})
+
+		It("should cancel a hanging creation", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, func(g generate.Generator) {
+				g.Config.Hooks = &specs.Hooks{
+					CreateRuntime: []specs.Hook{{
+						Path: busyboxDest,
+						Args: []string{"busybox", "sleep", "100"},
+					}},
+				}
+			})
+			sut = tr.configGivenEnv()
+
+			op, err := sut.CreateContainerAsync(
+				client.WithRequestID(context.Background(), "slow-create"),
+				&client.CreateContainerConfig{
+					ID:         tr.ctrID,
+					BundlePath: tr.tmpDir,
+					ExitPaths:  []string{tr.exitPath()},
+				},
+			)
+			Expect(err).To(BeNil())
+			Expect(op.RequestID).To(Equal("slow-create"))
+
+			Eventually(func() client.CreatePhase {
+				return op.Progress().Phase
+			}, time.Second*10).Should(Equal(client.CreatePhaseRuntime))
+			Consistently(op.Done(), time.Second).ShouldNot(BeClosed())
+
+			start := time.Now()
+			Expect(op.Cancel()).To(BeNil())
+			_, err = op.Wait()
+			Expect(err).To(MatchError(client.ErrCancelled))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second*10))
+			Expect(op.Progress().Phase).To(Equal(client.CreatePhaseDone))
+		})
+	})
+
+	Describe("RPCInterceptor", func() {
+		It("should report the message sizes of the RPCs", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			var (
+				mu          sync.Mutex
+				diagnostics = map[string]client.RPCDiagnostics{}
+			)
+			cfg := client.NewConmonServerConfig(runtimePath, tr.rr.runtimeRoot, tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			cfg.RPCInterceptor = client.RPCInterceptorFunc(func(_ context.Context, d *client.RPCDiagnostics) {
+				mu.Lock()
+				defer mu.Unlock()
+				diagnostics[d.Method] = *d
+			})
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+			tr.createContainer(sut, false)
+
+			mu.Lock()
+			defer mu.Unlock()
+			create, ok := diagnostics["CreateContainer"]
+			Expect(ok).To(BeTrue())
+			Expect(create.Err).To(BeNil())
+			Expect(create.Request.Segments).To(BeNumerically(">=", 1))
+			Expect(create.Request.EncodedBytes).To(BeNumerically(">", len(tr.ctrID)))
+			Expect(create.Request.AllocBytes).To(BeNumerically(">", 0))
+			Expect(create.Response.EncodedBytes).To(BeNumerically(">", 0))
+		})
+	})
+
+	Describe("RuntimeOptions", func() {
+		It("should operate the container with its own runtime root", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+			cfg := client.NewConmonServerConfig(runtimePath, MustDirInTempDir(tr.tmpDir, "server-root"), tr.tmpDir)
+			cfg.ConmonServerPath = conmonPath
+			var err error
+			sut, err = client.New(cfg)
+			Expect(err).To(BeNil())
+
+			_, err = sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:            tr.ctrID,
+				BundlePath:    tr.tmpDir,
+				ExitPaths:     []string{tr.exitPath()},
+				Runtime:       runtimePath,
+				RuntimeRoot:   tr.rr.runtimeRoot,
+				CgroupManager: client.CgroupManagerCgroupfs,
+			})
+			Expect(err).To(BeNil())
+			tr.startContainer(sut)
+
+			result, err := sut.ExecSyncContainer(context.Background(), &client.ExecSyncConfig{
+				ID:      tr.ctrID,
+				Command: []string{"/busybox", "echo", "-n", "hello"},
+				Timeout: timeoutUnlimited,
+			})
+			Expect(err).To(BeNil())
+			Expect(string(result.Stdout)).To(Equal("hello"))
+		})
+
+		It("should fail with an invalid cgroup manager", func() {
+			tr = newTestRunner()
+			tr.createRuntimeConfig(false)
+			sut = tr.configGivenEnv()
+
+			_, err := sut.CreateContainer(context.Background(), &client.CreateContainerConfig{
+				ID:            tr.ctrID,
+				BundlePath:    tr.tmpDir,
+				CgroupManager: "invalid",
+			})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("ConfigFromEnv", func() {
+		setenv := func(env map[string]string) {
+			for _, key := range []string{client.EnvSocket, client.EnvTimeout, client.EnvLogLevel, client.EnvTransport} {
+				value, ok := os.LookupEnv(key)
+				key := key
+				DeferCleanup(func() {
+					if ok {
+						Expect(os.Setenv(key, value)).To(BeNil())
+					} else {
+						Expect(os.Unsetenv(key)).To(BeNil())
+					}
+				})
+				Expect(os.Unsetenv(key)).To(BeNil())
+			}
+			for key, value := range env {
+				Expect(os.Setenv(key, value)).To(BeNil())
+			}
+		}
+
+		It("should configure the client", func() {
+			setenv(map[string]string{
+				client.EnvSocket:   "/run/conmonrs/conmon.sock",
+				client.EnvTimeout:  "30s",
+				client.EnvLogLevel: client.LogLevelInfo,
+			})
+			cfg, err := client.ConfigFromEnv()
+			Expect(err).To(BeNil())
+			Expect(cfg.ServerAddress).To(Equal("unix:///run/conmonrs/conmon.sock"))
+			Expect(cfg.Timeout).To(Equal(30 * time.Second))
+			Expect(cfg.LogLevel).To(Equal(client.LogLevelInfo))
+		})
+
+		It("should use the defaults without variables", func() {
+			setenv(nil)
+			cfg, err := client.ConfigFromEnv()
+			Expect(err).To(BeNil())
+			Expect(cfg.ServerAddress).To(BeEmpty())
+			Expect(cfg.Timeout).To(BeZero())
+			Expect(cfg.LogLevel).To(Equal(client.LogLevelDebug))
+		})
+
+		It("should combine the socket and the transport", func() {
+			setenv(map[string]string{client.EnvSocket: "localhost:1234", client.EnvTransport: "tcp"})
+			cfg, err := client.ConfigFromEnv()
+			Expect(err).To(BeNil())
+			Expect(cfg.ServerAddress).To(Equal("tcp://localhost:1234"))
+		})
+
+		for _, env := range []map[string]string{
+			{client.EnvTimeout: "soon"},
+			{client.EnvTimeout: "-1s"},
+			{client.EnvLogLevel: "verbose"},
+			{client.EnvSocket: "relative.sock"},
+			{client.EnvTransport: "tcp"},
+			{client.EnvSocket: "localhost:1234", client.EnvTransport: "udp"},
+			{client.EnvSocket: "vsock://3:1234", client.EnvTransport: "tcp"},
+		} {
+			env := env
+			It(fmt.Sprintf("should fail for %v", env), func() {
+				setenv(env)
+				_, err := client.ConfigFromEnv()
+				Expect(err).NotTo(BeNil())
+			})
+		}
+	})
+
+	Describe("AttachRecording", func() {
+		attachRecorded := func(recording *client.AttachRecording) {
+			tr = newTestRunner()
+			tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "cat"}, nil)
+			sut = tr.configGivenEnv()
+			tr.createContainer(sut, false)
+			tr.startContainer(sut)
+
+			stdinRead, stdin := io.Pipe()
+			stdoutRead, stdout := io.Pipe()
+			output := make(chan string, 1)
+			go func() {
+				line, _ := bufio.NewReader(stdoutRead).ReadString('\n')
+				output <- line
+				_, _ = io.Copy(io.Discard, stdoutRead)
+			}()
+
+			attachDone := make(chan error, 1)
+			go func() {
+				attachDone <- sut.AttachContainer(context.Background(), &client.AttachConfig{
+					ID:         tr.ctrID,
+					SocketPath: filepath.Join(tr.tmpDir, "attach"),
+					Streams: client.AttachStreams{
+						Stdin:  &client.In{stdinRead},
+						Stdout: &client.Out{stdout},
+					},
+					StopAfterStdinEOF: true,
+					Recording:         recording,
+				})
+			}()
+
+			_, err := stdin.Write([]byte("hello\n"))
+			Expect(err).To(BeNil())
+			Eventually(output, time.Second*10).Should(Receive(Equal("hello\n")))
+			Expect(stdin.Close()).To(BeNil())
+			Eventually(attachDone, time.Second*10).Should(Receive())
+		}
+
+		It("should record the session as asciicast", func() {
+			path := filepath.Join(MustTempDir("recording"), "session.cast")
+			attachRecorded(&client.AttachRecording{Path: path, Width: 100})
+
+			content, err := os.ReadFile(path)
+			Expect(err).To(BeNil())
+			lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+			Expect(lines).To(HaveLen(3))
+
+			var header map[string]interface{}
+			Expect(json.Unmarshal([]byte(lines[0]), &header)).To(BeNil())
+			Expect(header).To(HaveKeyWithValue("version", BeNumerically("==", 2)))
+			Expect(header).To(HaveKeyWithValue("width", BeNumerically("==", 100)))
+			Expect(header).To(HaveKeyWithValue("height", BeNumerically("==", 24)))
+
+			var input, output []interface{}
+			Expect(json.Unmarshal([]byte(lines[1]), &input)).To(BeNil())
+			Expect(input[1:]).To(Equal([]interface{}{"i", "hello\n"}))
+			Expect(json.Unmarshal([]byte(lines[2]), &output)).To(BeNil())
+			Expect(output[1:]).To(Equal([]interface{}{"o", "hello\n"}))
+			Expect(output[0]).To(BeNumerically(">=", input[0]))
+		})
+
+		It("should record the session raw with timing", func() {
+			dir := MustTempDir("recording")
+			path := filepath.Join(dir, "session")
+			attachRecorded(&client.AttachRecording{
+				Path:   path,
+				Format: client.AttachRecordingFormatRaw,
+				Sync:   client.AttachRecordingSyncAlways,
+			})
+
+			content, err := os.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(string(content)).To(Equal("hello\nhello\n"))
+
+			timing, err := os.ReadFile(path + ".timing")
+			Expect(err).To(BeNil())
+			lines := strings.Split(strings.TrimSpace(string(timing)), "\n")
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(MatchRegexp(`^i \d+\.\d{6} 6$`))
+			Expect(lines[1]).To(MatchRegexp(`^o \d+\.\d{6} 6$`))
+		})
+
+		It("should stop recording at the size limit", func() {
+			path := filepath.Join(MustTempDir("recording"), "session.cast")
+			attachRecorded(&client.AttachRecording{Path: path, MaxBytes: 80})
+
+			content, err := os.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(len(content)).To(BeNumerically("<=", 80))
+			Expect(strings.Split(strings.TrimSpace(string(content)), "\n")).To(HaveLen(1))
+		})
+	})
 })
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// EnvSocket is the environment variable of the server to connect to,
+	// either a ServerAddress like "tcp://host:port" or a socket of the
+	// EnvTransport, like "/run/conmonrs/conmon.sock" for unix sockets.
+	EnvSocket = "CONMONRS_SOCKET"
+
+	// EnvTimeout is the environment variable of the Timeout, for example
+	// "30s".
+	EnvTimeout = "CONMONRS_TIMEOUT"
+
+	// EnvLogLevel is the environment variable of the LogLevel of the
+	// server.
+	EnvLogLevel = "CONMONRS_LOG_LEVEL"
+
+	// EnvTransport is the environment variable of the transport of the
+	// EnvSocket, which is one of "unix", "tcp", "vsock" or "npipe". Defaults
+	// to "unix".
+	EnvTransport = "CONMONRS_TRANSPORT"
+)
+
+// ConfigFromEnv creates a new ConmonServerConfig like NewConmonServerConfig,
+// which is configured by the environment variables EnvSocket, EnvTimeout,
+// EnvLogLevel and EnvTransport if they are set. The client connects to the
+// EnvSocket instead of starting a server if set, otherwise Runtime,
+// RuntimeRoot and ServerRunDir have to be set by the caller. Invalid values
+// fail with an error naming the variable.
+func ConfigFromEnv() (*ConmonServerConfig, error) {
+	config := NewConmonServerConfig("", "", "")
+
+	address, err := serverAddressFromEnv(os.Getenv(EnvSocket), os.Getenv(EnvTransport))
+	if err != nil {
+		return nil, err
+	}
+	config.ServerAddress = address
+
+	if value := os.Getenv(EnvTimeout); value != "" {
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", EnvTimeout, err)
+		}
+		if timeout <= 0 {
+			return nil, fmt.Errorf("%s: %w: timeout %s is not positive", EnvTimeout, errInvalidValue, value)
+		}
+		config.Timeout = timeout
+	}
+
+	if value := os.Getenv(EnvLogLevel); value != "" {
+		if err := validateLogLevel(value); err != nil {
+			return nil, fmt.Errorf("%s: %w", EnvLogLevel, err)
+		}
+		config.LogLevel = value
+	}
+
+	return config, nil
+}
+
+// serverAddressFromEnv converts the values of EnvSocket and EnvTransport into
+// a ServerAddress, which is empty if no socket is set.
+func serverAddressFromEnv(socket, transport string) (string, error) {
+	if socket == "" {
+		if transport != "" {
+			return "", fmt.Errorf("%s: %w: transport without %s", EnvTransport, errInvalidValue, EnvSocket)
+		}
+
+		return "", nil
+	}
+
+	address := socket
+	if scheme, _, ok := strings.Cut(socket, "://"); ok {
+		if transport != "" && transport != scheme {
+			return "", fmt.Errorf(
+				"%s: %w: transport %q does not match %s %q", EnvTransport, errInvalidValue, transport, EnvSocket, socket,
+			)
+		}
+	} else {
+		switch transport {
+		case "", schemeUnix:
+			if !filepath.IsAbs(socket) {
+				return "", fmt.Errorf("%s: %w: socket path %q is not absolute", EnvSocket, errInvalidValue, socket)
+			}
+			address = schemeUnix + "://" + socket
+		case schemeTCP, schemeVsock:
+			address = transport + "://" + socket
+		case schemeNpipe:
+			address = schemeNpipe + "://" + strings.ReplaceAll(socket, `\`, "/")
+		default:
+			return "", fmt.Errorf("%s: %w: transport %q", EnvTransport, errInvalidValue, transport)
+		}
+	}
+
+	if _, err := parseServerAddress(address); err != nil {
+		return "", fmt.Errorf("%s: %w", EnvSocket, err)
+	}
+
+	return address, nil
+}
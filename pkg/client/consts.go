@@ -7,6 +7,13 @@ const (
 	// LogDriverSystemd is the log driver printing to systemd journald.
 	LogDriverSystemd = "systemd"
 
+	// CgroupManagerSystemd is the cgroup manager using systemd.
+	CgroupManagerSystemd = "systemd"
+
+	// CgroupManagerCgroupfs is the cgroup manager writing the cgroup
+	// filesystem directly.
+	CgroupManagerCgroupfs = "cgroupfs"
+
 	// LogLevelTrace is the log level printing only "trace" messages.
 	LogLevelTrace = "trace"
 
@@ -24,4 +31,16 @@ const (
 
 	// LogLevelOff is the log level printing no messages.
 	LogLevelOff = "off"
+
+	// StateBackendMemory is the state backend keeping the state in memory
+	// only, which gets lost if the server exits.
+	StateBackendMemory = "memory"
+
+	// StateBackendFile is the state backend appending the changes to a file,
+	// which gets compacted from time to time.
+	StateBackendFile = "file"
+
+	// StateBackendSqlite is the state backend storing the state in a sqlite
+	// database.
+	StateBackendSqlite = "sqlite"
 )
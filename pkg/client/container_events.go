@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// ContainerEventType specifies the type of a container event.
+type ContainerEventType int
+
+const (
+	// ContainerEventTypeOOMKilled indicates that the container got killed
+	// because it ran out of memory. It is followed by an exited event.
+	ContainerEventTypeOOMKilled ContainerEventType = iota
+
+	// ContainerEventTypeExited indicates that the container exited.
+	ContainerEventTypeExited
+
+	// ContainerEventTypePaused indicates that the container got paused.
+	ContainerEventTypePaused
+
+	// ContainerEventTypeResumed indicates that the container got resumed.
+	ContainerEventTypeResumed
+
+	// ContainerEventTypeWatchdogExpired indicates that no heartbeat has been
+	// received within the timeout of the watchdog of the container.
+	ContainerEventTypeWatchdogExpired
+
+	// ContainerEventTypeReaped indicates that the server reaped an orphaned
+	// process, which is no container. Only published with
+	// ConmonServerConfig.ReapEvents to clients of the default tenant, without
+	// ID.
+	ContainerEventTypeReaped
+
+	// ContainerEventTypeStateChanged indicates that the lifecycle state of
+	// the container changed, see GetState.
+	ContainerEventTypeStateChanged
+
+	// ContainerEventTypeExecExited indicates that an exec session of the
+	// container exited, see WaitExecSession.
+	ContainerEventTypeExecExited
+)
+
+// String returns the name of the event type.
+func (t ContainerEventType) String() string {
+	switch t {
+	case ContainerEventTypeOOMKilled:
+		return "oomKilled"
+	case ContainerEventTypeExited:
+		return "exited"
+	case ContainerEventTypePaused:
+		return "paused"
+	case ContainerEventTypeResumed:
+		return "resumed"
+	case ContainerEventTypeWatchdogExpired:
+		return "watchdogExpired"
+	case ContainerEventTypeReaped:
+		return "reaped"
+	case ContainerEventTypeStateChanged:
+		return "stateChanged"
+	case ContainerEventTypeExecExited:
+		return "execExited"
+	}
+
+	return fmt.Sprintf("unknown(%d)", int(t))
+}
+
+// ContainerEvent is a single lifecycle event of a container.
+type ContainerEvent struct {
+	// Type is the type of the event.
+	Type ContainerEventType
+
+	// ID of the container.
+	ID string
+
+	// PID is the PID of the container process, or of the orphaned process
+	// for reaped events.
+	PID uint32
+
+	// ExitCode is the exit code of the container, only set for exited,
+	// reaped and exec exited events.
+	ExitCode int32
+
+	// Timestamp is the time when the server noticed the event.
+	Timestamp time.Time
+
+	// State is the new lifecycle state of the container, only set for state
+	// changed events.
+	State ContainerState
+
+	// PreviousState is the lifecycle state of the container before the
+	// transition, only set for state changed events.
+	PreviousState ContainerState
+
+	// ExecSession is the ID of the exec session, only set for exec exited
+	// events.
+	ExecSession string
+}
+
+// WatchContainerEvents can be used to stream the lifecycle events of the
+// containers of the tenant of the client. Only the events of the container
+// with the provided ID are streamed if it is not empty. Paused and resumed
+// events are detected within a second. The returned channel gets closed if
+// the context is done or the connection to the server breaks.
+func (c *ConmonClient) WatchContainerEvents(ctx context.Context, id string) (<-chan ContainerEvent, error) {
+	watcher, err := c.watchContainerEvents(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return watcher.events, nil
+}
+
+func (c *ConmonClient) watchContainerEvents(ctx context.Context, id string) (*eventStream[ContainerEvent], error) {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newDedicatedRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("WatchContainerEvents")()
+	diag := c.diagnoseRPC("WatchContainerEvents")
+
+	watcher := containerEventWatcher{newEventStream[ContainerEvent]()}
+	future, free := client.WatchContainerEvents(ctx, func(p proto.Conmon_watchContainerEvents_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := req.SetWatcher(proto.Conmon_ContainerEventWatcher_ServerToClient(watcher, nil)); err != nil {
+			return fmt.Errorf("set watcher: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	if _, err := result.Response(); err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	watcher.serve(ctx, conn, c.logger)
+
+	return watcher.eventStream, nil
+}
+
+// containerEventWatcher is the local implementation of the
+// ContainerEventWatcher interface.
+type containerEventWatcher struct {
+	*eventStream[ContainerEvent]
+}
+
+// Event is called by the server for every container event.
+func (w containerEventWatcher) Event(ctx context.Context, call proto.Conmon_ContainerEventWatcher_event) error {
+	event, err := call.Args().Event()
+	if err != nil {
+		return fmt.Errorf("get event: %w", err)
+	}
+
+	containerEvent, err := containerEventFromProto(event)
+	if err != nil {
+		return err
+	}
+
+	return w.send(ctx, containerEvent)
+}
+
+func containerEventFromProto(event proto.Conmon_ContainerEvent) (ContainerEvent, error) {
+	containerEvent := ContainerEvent{
+		PID:           event.Pid(),
+		ExitCode:      event.ExitCode(),
+		Timestamp:     time.Unix(0, int64(event.Timestamp())),
+		State:         containerStateFromProto(event.State()),
+		PreviousState: containerStateFromProto(event.PreviousState()),
+	}
+
+	switch event.Type() {
+	case proto.Conmon_ContainerEvent_Type_oomKilled:
+		containerEvent.Type = ContainerEventTypeOOMKilled
+	case proto.Conmon_ContainerEvent_Type_exited:
+		containerEvent.Type = ContainerEventTypeExited
+	case proto.Conmon_ContainerEvent_Type_paused:
+		containerEvent.Type = ContainerEventTypePaused
+	case proto.Conmon_ContainerEvent_Type_resumed:
+		containerEvent.Type = ContainerEventTypeResumed
+	case proto.Conmon_ContainerEvent_Type_watchdogExpired:
+		containerEvent.Type = ContainerEventTypeWatchdogExpired
+	case proto.Conmon_ContainerEvent_Type_reaped:
+		containerEvent.Type = ContainerEventTypeReaped
+	case proto.Conmon_ContainerEvent_Type_stateChanged:
+		containerEvent.Type = ContainerEventTypeStateChanged
+	case proto.Conmon_ContainerEvent_Type_execExited:
+		containerEvent.Type = ContainerEventTypeExecExited
+	}
+
+	id, err := event.Id()
+	if err != nil {
+		return containerEvent, fmt.Errorf("get ID: %w", err)
+	}
+	containerEvent.ID = id
+
+	execSession, err := event.ExecSessionId()
+	if err != nil {
+		return containerEvent, fmt.Errorf("get exec session ID: %w", err)
+	}
+	containerEvent.ExecSession = execSession
+
+	return containerEvent, nil
+}
+
+// Done is called by the server before it releases the watcher.
+func (w containerEventWatcher) Done(ctx context.Context, call proto.Conmon_ContainerEventWatcher_done) error {
+	msg, err := call.Args().Error()
+	if err != nil {
+		return fmt.Errorf("get error: %w", err)
+	}
+	w.stop(msg)
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// ContainerState is the lifecycle state of a container tracked by the
+// server.
+type ContainerState int
+
+const (
+	// ContainerStateUnknown indicates that the state is not known.
+	ContainerStateUnknown ContainerState = iota
+
+	// ContainerStateCreated indicates that the runtime created the
+	// container, which has not been started yet.
+	ContainerStateCreated
+
+	// ContainerStateRunning indicates that the container executes its
+	// entrypoint.
+	ContainerStateRunning
+
+	// ContainerStatePaused indicates that the cgroup of the container is
+	// frozen.
+	ContainerStatePaused
+
+	// ContainerStateStopping indicates that StopContainer is in progress.
+	ContainerStateStopping
+
+	// ContainerStateExited indicates that the container process exited.
+	ContainerStateExited
+
+	// ContainerStateRemoved indicates that the container exited longer than
+	// ConmonServerConfig.StateRetention ago.
+	ContainerStateRemoved
+)
+
+// String returns the name of the state.
+func (s ContainerState) String() string {
+	switch s {
+	case ContainerStateUnknown:
+		return "unknown"
+	case ContainerStateCreated:
+		return "created"
+	case ContainerStateRunning:
+		return "running"
+	case ContainerStatePaused:
+		return "paused"
+	case ContainerStateStopping:
+		return "stopping"
+	case ContainerStateExited:
+		return "exited"
+	case ContainerStateRemoved:
+		return "removed"
+	}
+
+	return fmt.Sprintf("ContainerState(%d)", int(s))
+}
+
+// ContainerStateInfo is the current lifecycle state of a container.
+type ContainerStateInfo struct {
+	// State of the container.
+	State ContainerState
+
+	// PID of the container process.
+	PID uint32
+
+	// ExitCode of the container process, only set once it exited.
+	ExitCode int32
+
+	// UpdatedAt is the time of the last state transition.
+	UpdatedAt time.Time
+}
+
+// GetState retrieves the lifecycle state of a container. Exited containers
+// are reported until they are removed, removed containers are reported until
+// the state of newer ones replaces them. The returned error matches
+// ErrContainerNotFound if the server does not know the container. The state
+// transitions are also published as ContainerEventTypeStateChanged events.
+func (c *ConmonClient) GetState(ctx context.Context, id string) (*ContainerStateInfo, error) {
+	// The ID is not resolved because removed containers are not known
+	// anymore.
+	id, err := c.newContainerID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("GetState")()
+	diag := c.diagnoseRPC("GetState")
+	future, free := client.GetState(ctx, func(p proto.Conmon_getState_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	return &ContainerStateInfo{
+		State:     containerStateFromProto(response.State()),
+		PID:       response.Pid(),
+		ExitCode:  response.ExitCode(),
+		UpdatedAt: time.Unix(0, int64(response.UpdatedAt())),
+	}, nil
+}
+
+func containerStateFromProto(state proto.Conmon_ContainerState) ContainerState {
+	switch state {
+	case proto.Conmon_ContainerState_unknown:
+		return ContainerStateUnknown
+	case proto.Conmon_ContainerState_created:
+		return ContainerStateCreated
+	case proto.Conmon_ContainerState_running:
+		return ContainerStateRunning
+	case proto.Conmon_ContainerState_paused:
+		return ContainerStatePaused
+	case proto.Conmon_ContainerState_stopping:
+		return ContainerStateStopping
+	case proto.Conmon_ContainerState_exited:
+		return ContainerStateExited
+	case proto.Conmon_ContainerState_removed:
+		return ContainerStateRemoved
+	}
+
+	return ContainerStateUnknown
+}
@@ -0,0 +1,337 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// ContainerInfo describes a container supervised by the server.
+type ContainerInfo struct {
+	// ID of the container.
+	ID string
+
+	// PID of the container process.
+	PID uint32
+
+	// Labels of the container, see SetLabels.
+	Labels map[string]string
+}
+
+// ListContainers returns all running containers of the tenant of the client
+// which are supervised by the server. Exec sessions are not included. If
+// selectors are provided, only the containers matching all of them are
+// returned.
+func (c *ConmonClient) ListContainers(ctx context.Context, filter ...LabelSelector) ([]ContainerInfo, error) {
+	selector := LabelSelector{}
+	for _, s := range filter {
+		for key, value := range s {
+			selector[key] = value
+		}
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("ListContainers")()
+	diag := c.diagnoseRPC("ListContainers")
+	future, free := client.ListContainers(ctx, func(p proto.Conmon_listContainers_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		list, err := req.NewLabelSelector(int32(len(selector)))
+		if err != nil {
+			return fmt.Errorf("create label selector: %w", err)
+		}
+
+		if err := initLabels(list, selector); err != nil {
+			return err
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	list, err := response.Containers()
+	if err != nil {
+		return nil, fmt.Errorf("get containers: %w", err)
+	}
+
+	containers := make([]ContainerInfo, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		container := list.At(i)
+
+		id, err := container.Id()
+		if err != nil {
+			return nil, fmt.Errorf("get container ID: %w", err)
+		}
+
+		labelList, err := container.Labels()
+		if err != nil {
+			return nil, fmt.Errorf("get container labels: %w", err)
+		}
+
+		labels, err := labelsFromList(labelList)
+		if err != nil {
+			return nil, err
+		}
+
+		containers = append(containers, ContainerInfo{
+			ID:     id,
+			PID:    container.Pid(),
+			Labels: labels,
+		})
+	}
+
+	return containers, nil
+}
+
+// StopContainerConfig is the configuration for calling the StopContainer
+// method.
+type StopContainerConfig struct {
+	// ID of the container.
+	ID string
+
+	// Timeout is the duration to wait for the container to exit after
+	// sending SIGTERM, before it gets killed using SIGKILL.
+	Timeout time.Duration
+}
+
+// StopContainer stops a running container and waits for it to exit.
+func (c *ConmonClient) StopContainer(ctx context.Context, cfg *StopContainerConfig) error {
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return err
+	}
+
+	id, err := c.containerID(ctx, cfg.ID)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("StopContainer")()
+	diag := c.diagnoseRPC("StopContainer")
+	future, free := client.StopContainer(ctx, func(p proto.Conmon_stopContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		req.SetTimeoutSec(durationSeconds(cfg.Timeout))
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	return responseError(response)
+}
+
+// KillContainer sends the signal to the process of a running container. The
+// signal is sent to all processes of the container cgroup by the runtime if
+// all is set. The server resolves the process itself, which avoids races
+// with the reuse of the PID after the container exited.
+func (c *ConmonClient) KillContainer(ctx context.Context, id string, signal syscall.Signal, all bool) error {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("KillContainer")()
+	diag := c.diagnoseRPC("KillContainer")
+	future, free := client.KillContainer(ctx, func(p proto.Conmon_killContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		req.SetSignal(uint32(signal))
+		req.SetAll(all)
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	return responseError(response)
+}
+
+// WaitContainerResult is the result of the WaitContainer method.
+type WaitContainerResult struct {
+	// ExitCode of the container process.
+	ExitCode int32
+
+	// ExitedAt is the time when the server noticed the exit.
+	ExitedAt time.Time
+}
+
+// WaitContainer blocks until the container with the provided ID exits. It
+// returns immediately if the container exited already and the server still
+// remembers the exit. The wait gets aborted if the context is done.
+func (c *ConmonClient) WaitContainer(ctx context.Context, id string) (*WaitContainerResult, error) {
+	return c.waitContainer(ctx, id, "")
+}
+
+// WaitExecSession blocks until the exec session of the container exits, like
+// WaitContainer. The exit code is delivered by the server without exit files.
+func (c *ConmonClient) WaitExecSession(
+	ctx context.Context, id, execSession string,
+) (*WaitContainerResult, error) {
+	if execSession == "" {
+		return nil, fmt.Errorf("%w: exec session must be specified", errInvalidValue)
+	}
+
+	return c.waitContainer(ctx, id, execSession)
+}
+
+func (c *ConmonClient) waitContainer(ctx context.Context, id, execSession string) (*WaitContainerResult, error) {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	execSession, err = c.execSessionID(execSession)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	// Resolving the future does not honor the context, closing the
+	// connection aborts the call instead.
+	waitDone := make(chan struct{})
+	defer close(waitDone)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-waitDone:
+		}
+	}()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("WaitContainer")()
+	diag := c.diagnoseRPC("WaitContainer")
+	future, free := client.WaitContainer(ctx, func(p proto.Conmon_waitContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := req.SetExecSessionId(execSession); err != nil {
+			return fmt.Errorf("set exec session ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("wait for container %s: %w", id, ctx.Err())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	return &WaitContainerResult{
+		ExitCode: response.ExitCode(),
+		ExitedAt: time.Unix(0, int64(response.Timestamp())),
+	}, nil
+}
@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"capnproto.org/go/capnp/v3"
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// crashReportPollInterval is the interval in which WatchCrashReport checks
+// for new crash reports.
+const crashReportPollInterval = time.Second
+
+// CrashReport is a structured report written by the server if it panics.
+type CrashReport struct {
+	// Timestamp is the time of the crash.
+	Timestamp time.Time
+
+	// PID of the crashed server.
+	PID uint32
+
+	// Message is the panic message including its source location.
+	Message string
+
+	// Backtrace of the panicking thread.
+	Backtrace string
+
+	// RecentRPCs are the most recent RPCs received by the server, starting
+	// with the oldest one.
+	RecentRPCs []CrashReportRPC
+
+	// ContainerIDs are the IDs of the containers and exec sessions
+	// supervised by the server at the time of the crash.
+	ContainerIDs []string
+}
+
+// CrashReportRPC is a single RPC received by the server before it crashed.
+type CrashReportRPC struct {
+	// Method is the name of the RPC.
+	Method string
+
+	// ContainerID is the container the RPC operated on.
+	ContainerID string
+
+	// Timestamp is the time when the RPC was received.
+	Timestamp time.Time
+}
+
+// HasCrashReport returns true if the server wrote a crash report.
+func (c *ConmonClient) HasCrashReport() (bool, error) {
+	if _, err := os.Stat(c.crashReportPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("stat crash report: %w", err)
+	}
+
+	return true, nil
+}
+
+// CrashReport reads and parses the crash report written by the server. The
+// returned error matches os.ErrNotExist if there is no crash report.
+func (c *ConmonClient) CrashReport() (*CrashReport, error) {
+	file, err := os.Open(c.crashReportPath)
+	if err != nil {
+		return nil, fmt.Errorf("open crash report: %w", err)
+	}
+	defer file.Close()
+
+	return ParseCrashReport(file)
+}
+
+// RemoveCrashReport removes the crash report written by the server, for
+// example after it has been collected. It is not an error if there is no
+// crash report.
+func (c *ConmonClient) RemoveCrashReport() error {
+	if err := os.Remove(c.crashReportPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove crash report: %w", err)
+	}
+
+	return nil
+}
+
+// WatchCrashReport can be used to get notified about crash reports written
+// by the server. An already existing crash report is sent immediately,
+// afterwards every newly written report is sent. The returned channel gets
+// closed if the context is done.
+func (c *ConmonClient) WatchCrashReport(ctx context.Context) (<-chan *CrashReport, error) {
+	if _, err := c.HasCrashReport(); err != nil {
+		return nil, err
+	}
+
+	reports := make(chan *CrashReport)
+	go func() {
+		defer close(reports)
+
+		ticker := time.NewTicker(crashReportPollInterval)
+		defer ticker.Stop()
+
+		var lastModified time.Time
+		for {
+			if info, err := os.Stat(c.crashReportPath); err == nil && !info.ModTime().Equal(lastModified) {
+				report, err := c.CrashReport()
+				if err != nil {
+					c.logger.Errorf("Unable to read crash report: %v", err)
+				} else {
+					lastModified = info.ModTime()
+					select {
+					case reports <- report:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return reports, nil
+}
+
+// ParseCrashReport parses a crash report written by the server.
+func ParseCrashReport(r io.Reader) (*CrashReport, error) {
+	msg, err := capnp.NewDecoder(r).Decode()
+	if err != nil {
+		return nil, fmt.Errorf("decode crash report: %w", err)
+	}
+
+	report, err := proto.ReadRootConmon_CrashReport(msg)
+	if err != nil {
+		return nil, fmt.Errorf("read crash report: %w", err)
+	}
+
+	crashReport := &CrashReport{
+		Timestamp: time.Unix(0, int64(report.Timestamp())),
+		PID:       report.Pid(),
+	}
+
+	if crashReport.Message, err = report.Message(); err != nil {
+		return nil, fmt.Errorf("get message: %w", err)
+	}
+
+	if crashReport.Backtrace, err = report.Backtrace(); err != nil {
+		return nil, fmt.Errorf("get backtrace: %w", err)
+	}
+
+	rpcs, err := report.RecentRpcs()
+	if err != nil {
+		return nil, fmt.Errorf("get recent RPCs: %w", err)
+	}
+
+	for i := 0; i < rpcs.Len(); i++ {
+		rpc := rpcs.At(i)
+
+		method, err := rpc.Method()
+		if err != nil {
+			return nil, fmt.Errorf("get RPC method: %w", err)
+		}
+
+		containerID, err := rpc.ContainerId()
+		if err != nil {
+			return nil, fmt.Errorf("get RPC container ID: %w", err)
+		}
+
+		crashReport.RecentRPCs = append(crashReport.RecentRPCs, CrashReportRPC{
+			Method:      method,
+			ContainerID: containerID,
+			Timestamp:   time.Unix(0, int64(rpc.Timestamp())),
+		})
+	}
+
+	ids, err := report.ContainerIds()
+	if err != nil {
+		return nil, fmt.Errorf("get container IDs: %w", err)
+	}
+
+	for i := 0; i < ids.Len(); i++ {
+		id, err := ids.At(i)
+		if err != nil {
+			return nil, fmt.Errorf("get container ID: %w", err)
+		}
+		crashReport.ContainerIDs = append(crashReport.ContainerIDs, id)
+	}
+
+	return crashReport, nil
+}
+
+// crashReportPath returns the path of the crash report for the provided
+// server config.
+func crashReportPath(config *ConmonServerConfig) string {
+	if config.CrashReportPath != "" {
+		return config.CrashReportPath
+	}
+
+	return filepath.Join(config.ServerRunDir, crashReportName)
+}
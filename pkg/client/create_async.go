@@ -0,0 +1,250 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// CreatePhase is the phase of a container creation started via
+// CreateContainerAsync.
+type CreatePhase int
+
+const (
+	// CreatePhasePending is the phase until the server started the creation.
+	CreatePhasePending CreatePhase = iota
+
+	// CreatePhasePreparing is the phase while the server prepares the logs,
+	// the streams and the cgroup of the container.
+	CreatePhasePreparing
+
+	// CreatePhaseRuntime is the phase while the runtime creates the
+	// container, including hooks and the boot of sandboxed runtimes.
+	CreatePhaseRuntime
+
+	// CreatePhaseMonitoring is the phase after the runtime created the
+	// container, while the server sets up its monitoring.
+	CreatePhaseMonitoring
+
+	// CreatePhaseDone is the phase after the creation finished, successfully
+	// or not.
+	CreatePhaseDone
+)
+
+// String returns the name of the phase.
+func (p CreatePhase) String() string {
+	switch p {
+	case CreatePhasePending:
+		return "pending"
+	case CreatePhasePreparing:
+		return "preparing"
+	case CreatePhaseRuntime:
+		return "runtime"
+	case CreatePhaseMonitoring:
+		return "monitoring"
+	case CreatePhaseDone:
+		return "done"
+	}
+
+	return fmt.Sprintf("CreatePhase(%d)", int(p))
+}
+
+// CreateProgress is the progress of a CreateOperation.
+type CreateProgress struct {
+	// Phase is the current phase of the creation.
+	Phase CreatePhase
+
+	// Since is the time the creation entered the phase.
+	Since time.Time
+
+	// Elapsed is the time since the creation started, until it finished.
+	Elapsed time.Duration
+}
+
+// CreateOperation is a container creation started via CreateContainerAsync.
+type CreateOperation struct {
+	// ID is the ID of the container.
+	ID string
+
+	// RequestID is the ID of the request, which allows cancelling it via
+	// CancelRequest as well.
+	RequestID string
+
+	client *ConmonClient
+	cancel context.CancelFunc
+	done   chan struct{}
+	start  time.Time
+
+	mu        sync.Mutex
+	progress  CreateProgress
+	cancelled bool
+	response  *CreateContainerResponse
+	err       error
+}
+
+type createProgressKey struct{}
+
+// createProgressFromContext returns the receiver of the progress of a
+// CreateContainer request set by CreateContainerAsync.
+func createProgressFromContext(ctx context.Context) proto.Conmon_CreateProgress_Server {
+	progress, ok := ctx.Value(createProgressKey{}).(proto.Conmon_CreateProgress_Server)
+	if !ok {
+		return nil
+	}
+
+	return progress
+}
+
+// CreateContainerAsync starts creating a container like CreateContainer
+// without waiting for it, which allows following the progress of slow
+// creations and aborting them. The request gets a random ID if the context
+// does not assign one via WithRequestID. Cancelling the context aborts the
+// creation as well.
+func (c *ConmonClient) CreateContainerAsync(
+	ctx context.Context, cfg *CreateContainerConfig,
+) (*CreateOperation, error) {
+	if cfg == nil {
+		return nil, errors.New("create container config is nil")
+	}
+
+	id, err := c.newContainerID(cfg.ID)
+	if err != nil {
+		return nil, err
+	}
+	asyncCfg := *cfg
+	asyncCfg.ID = id
+
+	reqID := requestID(ctx)
+	ctx, cancel := context.WithCancel(WithRequestID(ctx, reqID))
+
+	op := &CreateOperation{
+		ID:        id,
+		RequestID: reqID,
+		client:    c,
+		cancel:    cancel,
+		done:      make(chan struct{}),
+		start:     time.Now(),
+	}
+	op.progress = CreateProgress{Phase: CreatePhasePending, Since: op.start}
+	ctx = context.WithValue(ctx, createProgressKey{}, createProgressReceiver{op})
+
+	go op.run(ctx, &asyncCfg)
+
+	return op, nil
+}
+
+func (o *CreateOperation) run(ctx context.Context, cfg *CreateContainerConfig) {
+	defer o.cancel()
+
+	response, err := o.client.CreateContainer(ctx, cfg)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if err != nil && o.cancelled && !errors.Is(err, ErrCancelled) {
+		err = fmt.Errorf("%w: %v", ErrCancelled, err)
+	}
+	o.response, o.err = response, err
+	o.setPhase(CreatePhaseDone)
+	close(o.done)
+}
+
+// Wait blocks until the creation finished and returns its result. The error
+// matches ErrCancelled if the creation got cancelled.
+func (o *CreateOperation) Wait() (*CreateContainerResponse, error) {
+	<-o.done
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.response, o.err
+}
+
+// Done returns a channel which is closed once the creation finished.
+func (o *CreateOperation) Done() <-chan struct{} {
+	return o.done
+}
+
+// Progress returns the current progress of the creation.
+func (o *CreateOperation) Progress() CreateProgress {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	progress := o.progress
+	if progress.Phase != CreatePhaseDone {
+		progress.Elapsed = time.Since(o.start)
+	}
+
+	return progress
+}
+
+// Cancel aborts the creation, which makes the server kill a hanging runtime
+// and clean up after it. Wait returns an error matching ErrCancelled
+// afterwards, unless the creation finished before. Cancelling a finished
+// creation is a no-op.
+func (o *CreateOperation) Cancel() error {
+	o.mu.Lock()
+	select {
+	case <-o.done:
+		o.mu.Unlock()
+
+		return nil
+	default:
+	}
+	o.cancelled = true
+	o.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), cancelRequestTimeout)
+	defer cancel()
+
+	cancelled, err := o.client.CancelRequest(ctx, o.RequestID)
+	if err != nil || !cancelled {
+		// The server did not start the request yet, or it is unreachable.
+		o.cancel()
+	}
+	if err != nil {
+		return fmt.Errorf("cancel request %s: %w", o.RequestID, err)
+	}
+
+	return nil
+}
+
+// setPhase updates the progress, the caller has to hold the lock.
+func (o *CreateOperation) setPhase(phase CreatePhase) {
+	if phase <= o.progress.Phase {
+		return
+	}
+
+	now := time.Now()
+	o.progress = CreateProgress{Phase: phase, Since: now, Elapsed: now.Sub(o.start)}
+}
+
+// createProgressReceiver receives the phases of a creation from the server.
+type createProgressReceiver struct {
+	op *CreateOperation
+}
+
+// Update is called by the server whenever the creation enters a phase.
+func (r createProgressReceiver) Update(ctx context.Context, call proto.Conmon_CreateProgress_update) error {
+	var phase CreatePhase
+	switch call.Args().Phase() {
+	case proto.Conmon_CreateProgress_Phase_preparing:
+		phase = CreatePhasePreparing
+	case proto.Conmon_CreateProgress_Phase_runtime:
+		phase = CreatePhaseRuntime
+	case proto.Conmon_CreateProgress_Phase_monitoring:
+		phase = CreatePhaseMonitoring
+	default:
+		return nil
+	}
+
+	r.op.mu.Lock()
+	defer r.op.mu.Unlock()
+	r.op.setPhase(phase)
+
+	return nil
+}
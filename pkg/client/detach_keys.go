@@ -0,0 +1,118 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	// ErrDetach is returned by AttachContainer if the session got detached
+	// by the detach keys.
+	ErrDetach = errors.New("detached from container")
+
+	// errInvalidDetachKeys is returned if detach keys cannot be parsed.
+	errInvalidDetachKeys = errors.New("invalid detach keys")
+)
+
+// ctrlKeys maps the non-letter keys which can be combined with ctrl to
+// their control codes.
+var ctrlKeys = map[string]byte{
+	"@":  0,
+	"[":  27,
+	"\\": 28,
+	"]":  29,
+	"^":  30,
+	"_":  31,
+}
+
+// ParseDetachKeys converts a human-readable detach key sequence like
+// "ctrl-p,ctrl-q" into the bytes used for AttachConfig.DetachKeys. Every
+// comma separated key is either a single character or "ctrl-" followed by a
+// letter or one of "@[\]^_".
+func ParseDetachKeys(keys string) ([]byte, error) {
+	if keys == "" {
+		return nil, fmt.Errorf("%w: empty key sequence", errInvalidDetachKeys)
+	}
+
+	codes := []byte{}
+	for _, key := range strings.Split(keys, ",") {
+		if len(key) == 1 {
+			codes = append(codes, key[0])
+
+			continue
+		}
+
+		lower := strings.ToLower(key)
+		if !strings.HasPrefix(lower, "ctrl-") || len(lower) != len("ctrl-")+1 {
+			return nil, fmt.Errorf("%w: unknown key %q", errInvalidDetachKeys, key)
+		}
+
+		value := lower[len("ctrl-"):]
+		switch code, ok := ctrlKeys[value]; {
+		case ok:
+			codes = append(codes, code)
+		case value[0] >= 'a' && value[0] <= 'z':
+			codes = append(codes, value[0]-'a'+1)
+		default:
+			return nil, fmt.Errorf("%w: unknown key %q", errInvalidDetachKeys, key)
+		}
+	}
+
+	return codes, nil
+}
+
+// copyDetachable copies from src to dst until EOF or until the keys are read
+// as separate reads, in which case ErrDetach is returned. Keys which turn out
+// not to complete the sequence are written to dst.
+func copyDetachable(dst io.Writer, src io.Reader, keys []byte) (written int64, err error) {
+	buf := make([]byte, 32*1024)
+	for {
+		nr, er := src.Read(buf)
+		if nr > 0 {
+			preserveBuf := []byte{}
+			for i, key := range keys {
+				preserveBuf = append(preserveBuf, buf[0:nr]...)
+				if nr != 1 || buf[0] != key {
+					break
+				}
+				if i == len(keys)-1 {
+					return 0, ErrDetach
+				}
+				nr, er = src.Read(buf)
+			}
+
+			var nw int
+			var ew error
+			if len(preserveBuf) > 0 {
+				nw, ew = dst.Write(preserveBuf)
+				nr = len(preserveBuf)
+			} else {
+				nw, ew = dst.Write(buf[0:nr])
+			}
+			if nw > 0 {
+				written += int64(nw)
+			}
+			if ew != nil {
+				err = ew
+
+				break
+			}
+			if nr != nw {
+				err = io.ErrShortWrite
+
+				break
+			}
+		}
+		if er != nil {
+			if !errors.Is(er, io.EOF) {
+				err = er
+			}
+
+			break
+		}
+	}
+
+	return written, err
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// ExecContainerConfig is the configuration for calling the ExecContainer
+// method.
+type ExecContainerConfig struct {
+	// ID is the container identifier.
+	ID string
+
+	// Command is a slice of command line arguments.
+	Command []string
+
+	// Terminal specifies if a tty should be used.
+	Terminal bool
+
+	// ExecSession is an optional identifier for the exec session. The server
+	// generates a new one if it is empty.
+	ExecSession string
+
+	// LogDrivers is a slice of selected log drivers for the output of the
+	// command, which is not logged if it is empty.
+	LogDrivers []LogDriver
+}
+
+// ExecContainerResponse is the response of the ExecContainer method.
+type ExecContainerResponse struct {
+	// ExecSession is the identifier of the exec session, which can be used
+	// to attach to it or to resize its terminal.
+	ExecSession string
+
+	// PID is the process ID of the executed command.
+	PID uint32
+}
+
+// ExecContainer can be used to start a command within a running container
+// without waiting for it to finish. The standard streams of the command are
+// available by attaching to the returned exec session via AttachContainer.
+func (c *ConmonClient) ExecContainer(ctx context.Context, cfg *ExecContainerConfig) (*ExecContainerResponse, error) {
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.containerID(ctx, cfg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	execSession, err := c.execSessionID(cfg.ExecSession)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("ExecContainer")()
+	diag := c.diagnoseRPC("ExecContainer")
+	future, free := client.ExecContainer(ctx, func(p proto.Conmon_execContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := stringSliceToTextList(cfg.Command, req.NewCommand); err != nil {
+			return err
+		}
+
+		req.SetTerminal(cfg.Terminal)
+
+		if err := req.SetExecSessionId(execSession); err != nil {
+			return fmt.Errorf("set exec session ID: %w", err)
+		}
+
+		if err := c.initLogDrivers(req.NewLogDrivers, cfg.LogDrivers); err != nil {
+			return fmt.Errorf("init log drivers: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", quotaError(err))
+	}
+
+	resp, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	sessionID, err := resp.ExecSessionId()
+	if err != nil {
+		return nil, fmt.Errorf("get exec session ID: %w", err)
+	}
+
+	return &ExecContainerResponse{
+		ExecSession: sessionID,
+		PID:         resp.Pid(),
+	}, nil
+}
@@ -0,0 +1,364 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultExitReconcileTimeout is the default time to wait for the server to
+// report the exit of a container without an exit file.
+const defaultExitReconcileTimeout = 5 * time.Second
+
+var (
+	errExitDirEmpty  = errors.New("exit dir cannot be empty")
+	errOnExitNil     = errors.New("exit callback cannot be nil")
+	errExitFileEmpty = errors.New("exit file is empty")
+)
+
+// ExitWatcherConfig is the configuration for calling the WatchExits method.
+type ExitWatcherConfig struct {
+	// ExitDir is the directory the server writes the exit files of the
+	// containers to, see CreateContainerConfig.ExitPaths. The name of an
+	// exit file is the ID of its container.
+	ExitDir string
+
+	// OnExit is called once for every exited container. The calls are not
+	// concurrent.
+	OnExit func(*ContainerExit)
+
+	// Track are the IDs of the containers which are expected to write an
+	// exit file, see ExitWatcher.Track.
+	Track []string
+
+	// ReconcileTimeout is the time to wait for the server to report the
+	// exit of a tracked container without an exit file. Defaults to five
+	// seconds.
+	ReconcileTimeout time.Duration
+}
+
+// ContainerExit describes the exit of a container.
+type ContainerExit struct {
+	// ID of the container.
+	ID string
+
+	// ExitCode of the container process.
+	ExitCode int32
+
+	// ExitedAt is the modification time of the exit file, or the time the
+	// server noticed the exit if the exit has been reconciled.
+	ExitedAt time.Time
+
+	// Reconciled is true if the exit has been reported by the server
+	// because the exit file has been missing.
+	Reconciled bool
+}
+
+// ExitWatcher delivers the exit codes of the containers written to the exit
+// dir of the server.
+type ExitWatcher struct {
+	client   *ConmonClient
+	cfg      ExitWatcherConfig
+	notifier dirNotifier
+
+	mu        sync.Mutex
+	tracked   map[string]struct{}
+	delivered map[string]struct{}
+
+	// deliverMu serializes the calls of the exit callback.
+	deliverMu sync.Mutex
+
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// dirNotifier reports changed files of a directory.
+type dirNotifier interface {
+	// Events returns the names of the changed files. An empty name
+	// indicates that events got lost and the directory has to be scanned.
+	Events() <-chan string
+
+	// Close stops the notifier and closes the events channel.
+	Close() error
+}
+
+// WatchExits starts watching the exit dir for the exit files of containers.
+// The exit files which already exist are delivered first, afterwards the
+// exits of the tracked containers without an exit file are reconciled with
+// the server. The watcher stops if the context is done or Close is called.
+func (c *ConmonClient) WatchExits(ctx context.Context, cfg *ExitWatcherConfig) (*ExitWatcher, error) {
+	if cfg.ExitDir == "" {
+		return nil, errExitDirEmpty
+	}
+	if cfg.OnExit == nil {
+		return nil, errOnExitNil
+	}
+
+	w := &ExitWatcher{
+		client:    c,
+		cfg:       *cfg,
+		tracked:   make(map[string]struct{}),
+		delivered: make(map[string]struct{}),
+		done:      make(chan struct{}),
+	}
+	if w.cfg.ReconcileTimeout == 0 {
+		w.cfg.ReconcileTimeout = defaultExitReconcileTimeout
+	}
+	for _, id := range cfg.Track {
+		w.tracked[id] = struct{}{}
+	}
+
+	// The notifier is started before scanning to not miss any exit file
+	// written in between.
+	notifier, err := watchDir(cfg.ExitDir)
+	if err != nil {
+		return nil, fmt.Errorf("watch exit dir: %w", err)
+	}
+	w.notifier = notifier
+
+	if err := w.scan(); err != nil {
+		if closeErr := notifier.Close(); closeErr != nil {
+			c.logger.Errorf("Unable to close exit dir notifier: %v", closeErr)
+		}
+
+		return nil, err
+	}
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		if err := w.Reconcile(ctx); err != nil {
+			c.logger.Errorf("Unable to reconcile container exits: %v", err)
+		}
+	}()
+
+	w.wg.Add(1)
+	go w.run(ctx)
+
+	return w, nil
+}
+
+// Track adds a container which is expected to write an exit file, which
+// allows reconciling its exit with the server if the file is missing. An
+// exit file which exists already gets delivered right away.
+func (w *ExitWatcher) Track(id string) {
+	w.mu.Lock()
+	w.tracked[id] = struct{}{}
+	w.mu.Unlock()
+
+	select {
+	case <-w.done:
+		return
+	default:
+	}
+
+	// The exit file could have been written before tracking the container,
+	// for example after Forget.
+	if exit, err := w.readExitFile(id); err == nil {
+		w.deliver(exit)
+	}
+}
+
+// Forget stops tracking the container. Its exit gets delivered again if a
+// new exit file is written.
+func (w *ExitWatcher) Forget(id string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.tracked, id)
+	delete(w.delivered, id)
+}
+
+// Close stops the watcher and waits for the pending callbacks.
+func (w *ExitWatcher) Close() error {
+	var err error
+	w.closeOnce.Do(func() {
+		close(w.done)
+		err = w.notifier.Close()
+	})
+	w.wg.Wait()
+
+	return err
+}
+
+// Reconcile asks the server for the exits of the tracked containers which
+// have no exit file. Containers which are still running are skipped.
+func (w *ExitWatcher) Reconcile(ctx context.Context) error {
+	missing := w.missing()
+	if len(missing) == 0 {
+		return nil
+	}
+
+	running, err := w.client.ListContainers(ctx)
+	if err != nil {
+		return fmt.Errorf("list containers: %w", err)
+	}
+	isRunning := make(map[string]bool, len(running))
+	for i := range running {
+		isRunning[running[i].ID] = true
+	}
+
+	for _, id := range missing {
+		if isRunning[id] {
+			continue
+		}
+
+		// The exit file could have been written since listing the missing
+		// ones.
+		if exit, err := w.readExitFile(id); err == nil {
+			w.deliver(exit)
+
+			continue
+		}
+
+		waitCtx, cancel := context.WithTimeout(ctx, w.cfg.ReconcileTimeout)
+		result, err := w.client.WaitContainer(waitCtx, id)
+		cancel()
+		if err != nil {
+			w.client.logger.Warnf("Unable to reconcile exit of container %s: %v", id, err)
+
+			continue
+		}
+
+		w.deliver(&ContainerExit{
+			ID:         id,
+			ExitCode:   result.ExitCode,
+			ExitedAt:   result.ExitedAt,
+			Reconciled: true,
+		})
+	}
+
+	return nil
+}
+
+// missing returns the tracked containers whose exit has not been delivered.
+func (w *ExitWatcher) missing() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	missing := []string{}
+	for id := range w.tracked {
+		if _, ok := w.delivered[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+
+	return missing
+}
+
+// run handles the events of the notifier until the watcher stops.
+func (w *ExitWatcher) run(ctx context.Context) {
+	defer w.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := w.notifier.Close(); err != nil {
+				w.client.logger.Errorf("Unable to close exit dir notifier: %v", err)
+			}
+
+			return
+		case <-w.done:
+			return
+		case name, ok := <-w.notifier.Events():
+			if !ok {
+				return
+			}
+
+			if name == "" {
+				if err := w.scan(); err != nil {
+					w.client.logger.Errorf("Unable to scan exit dir: %v", err)
+				}
+
+				continue
+			}
+
+			exit, err := w.readExitFile(name)
+			if err != nil {
+				w.client.logger.Warnf("Unable to read exit file of container %s: %v", name, err)
+
+				continue
+			}
+			w.deliver(exit)
+		}
+	}
+}
+
+// scan delivers the exits of all exit files in the exit dir. Files which
+// cannot be parsed are skipped because they may still be written.
+func (w *ExitWatcher) scan() error {
+	entries, err := os.ReadDir(w.cfg.ExitDir)
+	if err != nil {
+		return fmt.Errorf("read exit dir: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		exit, err := w.readExitFile(entry.Name())
+		if err != nil {
+			w.client.logger.Debugf("Skipping exit file of container %s: %v", entry.Name(), err)
+
+			continue
+		}
+		w.deliver(exit)
+	}
+
+	return nil
+}
+
+// readExitFile parses the exit file of the container.
+func (w *ExitWatcher) readExitFile(id string) (*ContainerExit, error) {
+	path := filepath.Join(w.cfg.ExitDir, id)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read exit file: %w", err)
+	}
+
+	trimmed := strings.TrimSpace(string(content))
+	if trimmed == "" {
+		return nil, errExitFileEmpty
+	}
+
+	code, err := strconv.ParseInt(trimmed, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("parse exit code: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("stat exit file: %w", err)
+	}
+
+	return &ContainerExit{
+		ID:       id,
+		ExitCode: int32(code),
+		ExitedAt: info.ModTime(),
+	}, nil
+}
+
+// deliver calls the exit callback if the exit of the container has not been
+// delivered yet.
+func (w *ExitWatcher) deliver(exit *ContainerExit) {
+	w.mu.Lock()
+	if _, ok := w.delivered[exit.ID]; ok {
+		w.mu.Unlock()
+
+		return
+	}
+	w.delivered[exit.ID] = struct{}{}
+	w.mu.Unlock()
+
+	w.deliverMu.Lock()
+	defer w.deliverMu.Unlock()
+	w.cfg.OnExit(exit)
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// inotifyNotifier reports the files written to a directory via inotify.
+type inotifyNotifier struct {
+	file      *os.File
+	events    chan string
+	done      chan struct{}
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// watchDir starts watching the directory for written files.
+func watchDir(dir string) (dirNotifier, error) {
+	// The non-blocking descriptor is handled by the runtime poller, which
+	// allows closing the file to abort pending reads.
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, fmt.Errorf("init inotify: %w", err)
+	}
+
+	if _, err := unix.InotifyAddWatch(fd, dir, unix.IN_CLOSE_WRITE|unix.IN_MOVED_TO); err != nil {
+		unix.Close(fd)
+
+		return nil, fmt.Errorf("add inotify watch for %s: %w", dir, err)
+	}
+
+	n := &inotifyNotifier{
+		file:   os.NewFile(uintptr(fd), "inotify"),
+		events: make(chan string),
+		done:   make(chan struct{}),
+	}
+	go n.read()
+
+	return n, nil
+}
+
+func (n *inotifyNotifier) Events() <-chan string {
+	return n.events
+}
+
+func (n *inotifyNotifier) Close() error {
+	n.closeOnce.Do(func() {
+		close(n.done)
+		if err := n.file.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+			n.closeErr = err
+		}
+	})
+
+	return n.closeErr
+}
+
+// read forwards the names of the inotify events until the notifier gets
+// closed.
+func (n *inotifyNotifier) read() {
+	defer close(n.events)
+
+	buf := make([]byte, 64*(unix.SizeofInotifyEvent+unix.NAME_MAX+1))
+	for {
+		size, err := n.file.Read(buf)
+		if err != nil {
+			return
+		}
+
+		for offset := 0; offset+unix.SizeofInotifyEvent <= size; {
+			event := (*unix.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameStart := offset + unix.SizeofInotifyEvent
+			offset = nameStart + int(event.Len)
+
+			name := ""
+			switch {
+			case event.Mask&unix.IN_Q_OVERFLOW != 0:
+				// An empty name requests scanning the directory.
+			case event.Mask&unix.IN_ISDIR != 0, event.Len == 0:
+				continue
+			default:
+				name = unix.ByteSliceToString(buf[nameStart:offset])
+			}
+
+			select {
+			case n.events <- name:
+			case <-n.done:
+				return
+			}
+		}
+	}
+}
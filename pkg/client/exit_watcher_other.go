@@ -0,0 +1,62 @@
+//go:build !linux
+
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// exitDirPollInterval is the interval for scanning the exit dir on
+// platforms without inotify.
+const exitDirPollInterval = time.Second
+
+// pollNotifier requests scanning a directory periodically.
+type pollNotifier struct {
+	events    chan string
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// watchDir starts scanning the directory periodically.
+func watchDir(string) (dirNotifier, error) {
+	n := &pollNotifier{
+		events: make(chan string),
+		done:   make(chan struct{}),
+	}
+	go n.poll()
+
+	return n, nil
+}
+
+func (n *pollNotifier) Events() <-chan string {
+	return n.events
+}
+
+func (n *pollNotifier) Close() error {
+	n.closeOnce.Do(func() { close(n.done) })
+
+	return nil
+}
+
+// poll sends a scan request every interval until the notifier gets closed.
+func (n *pollNotifier) poll() {
+	defer close(n.events)
+
+	ticker := time.NewTicker(exitDirPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-n.done:
+			return
+		}
+
+		select {
+		case n.events <- "":
+		case <-n.done:
+			return
+		}
+	}
+}
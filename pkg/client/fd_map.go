@@ -0,0 +1,110 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// firstMappedFD is the lowest number of a mapped file descriptor, the ones
+// below are the standard streams.
+const firstMappedFD = 3
+
+// FDKind is the kind of a mapped file descriptor.
+type FDKind int
+
+const (
+	// FDKindGeneric is a file descriptor without special meaning.
+	FDKindGeneric FDKind = iota
+
+	// FDKindListenSocket is a socket for systemd socket activation, which is
+	// counted by LISTEN_FDS and named by LISTEN_FDNAMES. The listen sockets
+	// have to be mapped to the numbers 3 and onwards, before all generic
+	// file descriptors.
+	FDKindListenSocket
+)
+
+// FDMapping is a file descriptor sent using RemoteFDs to be passed into a
+// process.
+type FDMapping struct {
+	// FD is the remote file descriptor.
+	FD RemoteFD
+
+	// Kind of the file descriptor.
+	Kind FDKind
+
+	// Name of listen sockets, which is passed via LISTEN_FDNAMES.
+	Name string
+}
+
+// FDMap maps the numbers of file descriptors in a process, which are at
+// least 3, to the file descriptors passed at them. The server passes
+// /dev/null at the numbers between the mapped ones. The runtime has to
+// support the --preserve-fds option, and to pass LISTEN_FDS and
+// LISTEN_FDNAMES into the process for listen sockets like runc does.
+type FDMap map[int]FDMapping
+
+// SendFDMap sends the local file descriptors of the map, which maps the
+// numbers in the process to local file descriptors, and returns the generic
+// mappings of their remote counterparts.
+func (r *RemoteFDs) SendFDMap(local map[int]int) (FDMap, error) {
+	targets := make([]int, 0, len(local))
+	for target := range local {
+		targets = append(targets, target)
+	}
+	sort.Ints(targets)
+
+	fds := make([]int, 0, len(targets))
+	for _, target := range targets {
+		fds = append(fds, local[target])
+	}
+
+	remote, err := r.Send(fds...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(FDMap, len(targets))
+	for i, target := range targets {
+		res[target] = FDMapping{FD: remote[i]}
+	}
+
+	return res, nil
+}
+
+// setFDMap sets the mappings of the request, ordered by their number.
+func setFDMap(m FDMap, newFunc func(int32) (proto.Conmon_FdMapping_List, error)) error {
+	if len(m) == 0 {
+		return nil
+	}
+
+	targets := make([]int, 0, len(m))
+	for target := range m {
+		if target < firstMappedFD {
+			return fmt.Errorf("%w: fd number %d is below %d", errInvalidValue, target, firstMappedFD)
+		}
+		targets = append(targets, target)
+	}
+	sort.Ints(targets)
+
+	list, err := newFunc(int32(len(targets)))
+	if err != nil {
+		return fmt.Errorf("create fd mappings: %w", err)
+	}
+
+	for i, target := range targets {
+		mapping := m[target]
+		item := list.At(i)
+		item.SetSlot(uint64(mapping.FD))
+		item.SetTarget(uint32(target))
+		if mapping.Kind == FDKindListenSocket {
+			item.SetKind(proto.Conmon_FdMapping_Kind_listenSocket)
+			if err := item.SetName(mapping.Name); err != nil {
+				return fmt.Errorf("set fd name: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// FlushLogs returns after all output of the container produced up to the
+// call has been written to its log drivers and synchronized to disk. This
+// allows reading the log files right after an exec or attach session ended.
+// The server waits up to 10 seconds for pending output. Output which is still
+// being processed by a log filter is not waited for.
+func (c *ConmonClient) FlushLogs(ctx context.Context, id string) error {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("FlushLogs")()
+	diag := c.diagnoseRPC("FlushLogs")
+	future, free := client.FlushLogs(ctx, func(p proto.Conmon_flushLogs_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	if _, err := result.Response(); err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	return nil
+}
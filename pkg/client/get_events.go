@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// getEventsTimeout is the maximum time GetEvents waits for new events.
+const getEventsTimeout = 30 * time.Second
+
+// EventCursor is the position after the last event returned by GetEvents.
+// The zero value starts with the oldest event retained by the server.
+type EventCursor uint64
+
+// EventBatch is a batch of container events returned by GetEvents.
+type EventBatch struct {
+	// Events of the containers of the tenant of the client, oldest first.
+	Events []ContainerEvent
+
+	// Next is the cursor for retrieving the events following the batch.
+	Next EventCursor
+
+	// Missed is the number of events after the cursor which have been
+	// dropped by the server before they got polled. It includes the events
+	// of other tenants.
+	Missed uint64
+}
+
+// GetEvents retrieves up to max container events of the tenant of the client
+// after the cursor, or all retained events if max is zero. If there are no
+// such events yet, it waits for them for up to 30 seconds or until the
+// context is done, in which case the batch may be empty. The server retains
+// a bounded number of events, the ones dropped before they got polled are
+// counted by EventBatch.Missed. Exit and OOM events are always retained,
+// while paused and resumed events are only detected while events are
+// watched or polled.
+func (c *ConmonClient) GetEvents(ctx context.Context, cursor EventCursor, max int) (*EventBatch, error) {
+	if max < 0 {
+		return nil, fmt.Errorf("%w: negative max events %d", errInvalidValue, max)
+	}
+
+	timeout := getEventsTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		// Leave some time to deliver the response before the deadline.
+		if remaining := time.Until(deadline) * 9 / 10; remaining < timeout {
+			timeout = remaining
+		}
+	}
+	if timeout < 0 {
+		timeout = 0
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	// Resolving the future does not honor the context, closing the
+	// connection aborts the call instead.
+	pollDone := make(chan struct{})
+	defer close(pollDone)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-pollDone:
+		}
+	}()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("GetEvents")()
+	diag := c.diagnoseRPC("GetEvents")
+	future, free := client.GetEvents(ctx, func(p proto.Conmon_getEvents_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		req.SetCursor(uint64(cursor))
+		req.SetMax(uint32(max))
+		req.SetTimeoutMs(uint64(timeout.Milliseconds()))
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("get events: %w", ctx.Err())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	events, err := response.Events()
+	if err != nil {
+		return nil, fmt.Errorf("get events: %w", err)
+	}
+
+	batch := &EventBatch{
+		Events: make([]ContainerEvent, 0, events.Len()),
+		Next:   EventCursor(response.NextCursor()),
+		Missed: response.Missed(),
+	}
+	for i := 0; i < events.Len(); i++ {
+		event, err := containerEventFromProto(events.At(i))
+		if err != nil {
+			return nil, err
+		}
+		batch.Events = append(batch.Events, event)
+	}
+
+	return batch, nil
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// HealthStatus is the result of HealthCheck.
+type HealthStatus struct {
+	// Latency is the round-trip time of the health check RPC, which does not
+	// include establishing the connection.
+	Latency time.Duration
+
+	// OpenFDs is the number of open file descriptors of the server process.
+	OpenFDs uint64
+
+	// MemoryRSSBytes is the resident set size of the server process.
+	MemoryRSSBytes uint64
+
+	// Containers is the number of containers monitored by the server for
+	// the tenant of the client.
+	Containers uint32
+}
+
+// HealthCheck pings the server and reports its resource usage, which is
+// meant for readiness checks and for restarting unhealthy servers. It fails
+// if the server does not respond before the context is done.
+func (c *ConmonClient) HealthCheck(ctx context.Context) (*HealthStatus, error) {
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("HealthCheck")()
+	diag := c.diagnoseRPC("HealthCheck")
+
+	start := time.Now()
+	future, free := client.HealthCheck(ctx, nil)
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+	latency := time.Since(start)
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	return &HealthStatus{
+		Latency:        latency,
+		OpenFDs:        response.OpenFds(),
+		MemoryRSSBytes: response.MemoryRssBytes(),
+		Containers:     response.Containers(),
+	}, nil
+}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// IDKind is the kind of an ID validated by an IDValidator.
+type IDKind string
+
+const (
+	// IDKindContainer is the ID of a container.
+	IDKindContainer IDKind = "container"
+
+	// IDKindExecSession is the ID of an exec session.
+	IDKindExecSession IDKind = "exec session"
+)
+
+// ErrInvalidID is the error matched by every InvalidIDError.
+var ErrInvalidID = errors.New("invalid ID")
+
+// InvalidIDError is returned if an ID got rejected by the IDValidator of the
+// client, before any request is sent to the server.
+type InvalidIDError struct {
+	// Kind of the rejected ID.
+	Kind IDKind
+
+	// ID is the rejected ID.
+	ID string
+
+	// Reason why the ID got rejected.
+	Reason string
+}
+
+// Error returns the error message including the reason.
+func (e *InvalidIDError) Error() string {
+	return fmt.Sprintf("invalid %s ID %q: %s", e.Kind, e.ID, e.Reason)
+}
+
+// Is returns true if the target is ErrInvalidID.
+func (e *InvalidIDError) Is(target error) bool {
+	return target == ErrInvalidID
+}
+
+// IDValidator validates and normalizes the container and exec session IDs
+// provided to the client. It can be set in the ConmonServerConfig to restrict
+// the IDs of untrusted callers.
+type IDValidator interface {
+	// ValidateID returns the normalized ID, or an error if it is invalid.
+	ValidateID(kind IDKind, id string) (string, error)
+}
+
+// IDValidatorFunc is an adapter to use a function as IDValidator.
+type IDValidatorFunc func(kind IDKind, id string) (string, error)
+
+// ValidateID calls the function.
+func (f IDValidatorFunc) ValidateID(kind IDKind, id string) (string, error) {
+	return f(kind, id)
+}
+
+// IDRules is an IDValidator with configurable rules. IDs which are empty,
+// contain a slash or a NUL byte, or are "." or ".." are always rejected,
+// because the server uses them within paths.
+type IDRules struct {
+	// MinLength is the minimum length of an ID in bytes.
+	MinLength int
+
+	// MaxLength is the maximum length of an ID in bytes. The length is not
+	// limited if it is zero.
+	MaxLength int
+
+	// Charset contains all characters allowed in an ID. All characters are
+	// allowed if it is empty.
+	Charset string
+
+	// LowercaseHex only allows hexadecimal IDs, which get normalized to
+	// lower case.
+	LowercaseHex bool
+}
+
+// ValidateID validates the ID using the rules.
+func (r *IDRules) ValidateID(kind IDKind, id string) (string, error) {
+	invalid := func(format string, args ...any) error {
+		return &InvalidIDError{Kind: kind, ID: id, Reason: fmt.Sprintf(format, args...)}
+	}
+
+	switch {
+	case id == "":
+		return "", invalid("empty")
+
+	case id == "." || id == "..":
+		return "", invalid("reserved name")
+
+	case strings.ContainsAny(id, "/\x00"):
+		return "", invalid("contains a slash or NUL byte")
+
+	case len(id) < r.MinLength:
+		return "", invalid("shorter than %d bytes", r.MinLength)
+
+	case r.MaxLength > 0 && len(id) > r.MaxLength:
+		return "", invalid("longer than %d bytes", r.MaxLength)
+	}
+
+	if r.LowercaseHex {
+		id = strings.ToLower(id)
+		if i := strings.IndexFunc(id, func(c rune) bool {
+			return !('0' <= c && c <= '9' || 'a' <= c && c <= 'f')
+		}); i >= 0 {
+			return "", invalid("not hexadecimal at offset %d", i)
+		}
+	}
+
+	if r.Charset != "" {
+		if i := strings.IndexFunc(id, func(c rune) bool {
+			return !strings.ContainsRune(r.Charset, c)
+		}); i >= 0 {
+			return "", invalid("character not allowed at offset %d", i)
+		}
+	}
+
+	return id, nil
+}
+
+// validateID validates the ID using the validator of the client. Errors of
+// custom validators are converted into an InvalidIDError.
+func (c *ConmonClient) validateID(kind IDKind, id string) (string, error) {
+	normalized, err := c.idValidator.ValidateID(kind, id)
+	if err == nil {
+		return normalized, nil
+	}
+
+	if errors.Is(err, ErrInvalidID) {
+		return "", err
+	}
+
+	return "", &InvalidIDError{Kind: kind, ID: id, Reason: err.Error()}
+}
+
+// containerID resolves, validates and normalizes the ID of an existing
+// container.
+func (c *ConmonClient) containerID(ctx context.Context, id string) (string, error) {
+	if c.idResolver != nil && id != "" {
+		resolved, err := c.idResolver.ResolveID(ctx, c, id)
+		if err != nil {
+			return "", fmt.Errorf("resolve container %q: %w", id, err)
+		}
+		id = resolved
+	}
+
+	return c.newContainerID(id)
+}
+
+// newContainerID validates and normalizes the ID of a container, which is
+// not resolved because it may not exist yet.
+func (c *ConmonClient) newContainerID(id string) (string, error) {
+	return c.validateID(IDKindContainer, id)
+}
+
+// execSessionID validates and normalizes an exec session ID, which may be
+// empty if no exec session is referenced.
+func (c *ConmonClient) execSessionID(id string) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+
+	return c.validateID(IDKindExecSession, id)
+}
+
+// randomID returns a random hex encoded ID with 64 characters.
+func randomID() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("read random bytes: %v", err))
+	}
+
+	return hex.EncodeToString(b)
+}
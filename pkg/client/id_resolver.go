@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrAmbiguousID is the error matched by every AmbiguousIDError.
+var ErrAmbiguousID = errors.New("ambiguous container reference")
+
+// AmbiguousIDError is returned if a container reference resolves to more
+// than one container.
+type AmbiguousIDError struct {
+	// Reference is the ambiguous reference.
+	Reference string
+
+	// Matches are the IDs of the matching containers.
+	Matches []string
+}
+
+// Error returns the error message including the matches.
+func (e *AmbiguousIDError) Error() string {
+	return fmt.Sprintf("container reference %q is ambiguous, matches: %s", e.Reference, strings.Join(e.Matches, ", "))
+}
+
+// Is returns true if the target is ErrAmbiguousID.
+func (e *AmbiguousIDError) Is(target error) bool {
+	return target == ErrAmbiguousID
+}
+
+// ContainerLister lists the containers of the server, which is implemented
+// by *ConmonClient.
+type ContainerLister interface {
+	ListContainers(ctx context.Context, filter ...LabelSelector) ([]ContainerInfo, error)
+}
+
+// IDResolver resolves the container references provided to the client, like
+// short IDs or names, into container IDs. It can be set in the
+// ConmonServerConfig and gets used by all methods referencing existing
+// containers, before the IDValidator.
+type IDResolver interface {
+	// ResolveID returns the ID of the referenced container, using the
+	// containers of the server.
+	ResolveID(ctx context.Context, containers ContainerLister, reference string) (string, error)
+}
+
+// IDResolverFunc is an adapter to use a function as IDResolver.
+type IDResolverFunc func(ctx context.Context, containers ContainerLister, reference string) (string, error)
+
+// ResolveID calls the function.
+func (f IDResolverFunc) ResolveID(ctx context.Context, containers ContainerLister, reference string) (string, error) {
+	return f(ctx, containers, reference)
+}
+
+// ContainerRegistryResolver is an IDResolver backed by the containers of the
+// server, like the docker and podman CLIs. A reference resolves to the
+// container with that ID, or else to the container with that name, or else to
+// the container whose ID starts with it. References which match no container
+// are used unchanged, which leaves reporting unknown containers to the
+// server.
+type ContainerRegistryResolver struct {
+	// NameLabel is the label holding the names of the containers, see
+	// SetLabels. Names are not resolved if it is empty.
+	NameLabel string
+
+	// MinPrefixLength is the minimum length of a reference to be resolved
+	// as ID prefix. Defaults to 1.
+	MinPrefixLength int
+}
+
+// ResolveID resolves the reference using the containers of the server.
+func (r *ContainerRegistryResolver) ResolveID(
+	ctx context.Context, containers ContainerLister, reference string,
+) (string, error) {
+	if reference == "" {
+		return reference, nil
+	}
+
+	list, err := containers.ListContainers(ctx)
+	if err != nil {
+		return "", fmt.Errorf("list containers: %w", err)
+	}
+
+	var names, prefixes []string
+	for i := range list {
+		id := list[i].ID
+		if id == reference {
+			return id, nil
+		}
+
+		if r.NameLabel != "" && list[i].Labels[r.NameLabel] == reference {
+			names = append(names, id)
+		}
+
+		if len(reference) >= r.MinPrefixLength && strings.HasPrefix(id, reference) {
+			prefixes = append(prefixes, id)
+		}
+	}
+
+	for _, matches := range [][]string{names, prefixes} {
+		switch len(matches) {
+		case 0:
+			continue
+		case 1:
+			return matches[0], nil
+		default:
+			sort.Strings(matches)
+
+			return "", &AmbiguousIDError{Reference: reference, Matches: matches}
+		}
+	}
+
+	return reference, nil
+}
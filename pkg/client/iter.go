@@ -0,0 +1,114 @@
+//go:build go1.23
+
+package client
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// EventSeq provides iterator access to a stream of events as an alternative
+// to the channel based APIs. The error which terminated the stream can be
+// retrieved via Err after the iteration has been finished.
+type EventSeq[T any] struct {
+	stream *eventStream[T]
+	err    error
+	ctxErr func() error
+	cancel context.CancelFunc
+}
+
+func newEventSeq[T any](
+	ctx context.Context, watch func(context.Context) (*eventStream[T], error),
+) *EventSeq[T] {
+	ctxErr := ctx.Err
+	ctx, cancel := context.WithCancel(ctx)
+
+	stream, err := watch(ctx)
+	if err != nil {
+		cancel()
+	}
+
+	return &EventSeq[T]{
+		stream: stream,
+		err:    err,
+		ctxErr: ctxErr,
+		cancel: cancel,
+	}
+}
+
+// All returns an iterator over all events of the stream. The stream gets
+// closed if the iteration stops, which means that it can be only iterated
+// once.
+func (e *EventSeq[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if e.err != nil {
+			return
+		}
+		defer e.cancel()
+
+		for event := range e.stream.events {
+			if !yield(event) {
+				return
+			}
+		}
+	}
+}
+
+// Err returns the error which terminated the stream, for example if the
+// stream could not be created, the server failed or the connection broke.
+// The error of the context is returned if it is done. Err returns nil if the
+// stream has been stopped regularly by the server, via Close or if the
+// iteration stopped early.
+func (e *EventSeq[T]) Err() error {
+	if e.err != nil {
+		return e.err
+	}
+
+	if err := e.stream.Err(); err != nil {
+		return err
+	}
+
+	return e.ctxErr()
+}
+
+// Close stops the stream. It is safe to call Close multiple times and
+// concurrently to the iteration, which stops afterwards.
+func (e *EventSeq[T]) Close() {
+	e.cancel()
+}
+
+// MountEvents is the iterator variant of WatchMounts.
+func (c *ConmonClient) MountEvents(ctx context.Context, id string) *EventSeq[MountEvent] {
+	return newEventSeq(ctx, func(ctx context.Context) (*eventStream[MountEvent], error) {
+		return c.watchMounts(ctx, id)
+	})
+}
+
+// QuotaEvents is the iterator variant of WatchQuota.
+func (c *ConmonClient) QuotaEvents(ctx context.Context, cfg *WatchQuotaConfig) *EventSeq[QuotaEvent] {
+	return newEventSeq(ctx, func(ctx context.Context) (*eventStream[QuotaEvent], error) {
+		return c.watchQuota(ctx, cfg)
+	})
+}
+
+// StatsEvents is the iterator variant of StreamStats.
+func (c *ConmonClient) StatsEvents(ctx context.Context, id string, interval time.Duration) *EventSeq[ContainerStats] {
+	return newEventSeq(ctx, func(ctx context.Context) (*eventStream[ContainerStats], error) {
+		return c.streamStats(ctx, id, interval)
+	})
+}
+
+// ContainerEvents is the iterator variant of WatchContainerEvents.
+func (c *ConmonClient) ContainerEvents(ctx context.Context, id string) *EventSeq[ContainerEvent] {
+	return newEventSeq(ctx, func(ctx context.Context) (*eventStream[ContainerEvent], error) {
+		return c.watchContainerEvents(ctx, id)
+	})
+}
+
+// LogEntries is the iterator variant of ReadLogs.
+func (c *ConmonClient) LogEntries(ctx context.Context, id string, opts *ReadLogsOptions) *EventSeq[LogEntry] {
+	return newEventSeq(ctx, func(ctx context.Context) (*eventStream[LogEntry], error) {
+		return c.readLogs(ctx, id, opts)
+	})
+}
@@ -0,0 +1,112 @@
+//go:build go1.23
+
+package client_test
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/containers/conmon-rs/pkg/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EventSeq", func() {
+	var tr *testRunner
+	var sut *client.ConmonClient
+
+	AfterEach(func() {
+		Expect(tr.rr.RunCommand("delete", "-f", tr.ctrID)).To(BeNil())
+		Expect(os.RemoveAll(tr.tmpDir)).To(BeNil())
+		if sut != nil {
+			Expect(sut.Shutdown()).To(BeNil())
+		}
+	})
+
+	It("should iterate over quota events", func() {
+		tr = newTestRunner()
+		tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+		MustDirInTempDir(tr.tmpRootfs, "data")
+		sut = tr.configGivenEnv()
+		tr.createContainer(sut, false)
+		tr.startContainer(sut)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		seq := sut.QuotaEvents(ctx, &client.WatchQuotaConfig{
+			ID:         tr.ctrID,
+			Path:       "/data",
+			Thresholds: []uint64{0},
+			Interval:   time.Second,
+		})
+
+		events := 0
+		seq.All()(func(event client.QuotaEvent) bool {
+			Expect(event.Path).To(Equal("/data"))
+			Expect(event.Exceeded).To(BeTrue())
+			events++
+
+			return false
+		})
+		Expect(events).To(Equal(1))
+		Expect(seq.Err()).To(BeNil())
+	})
+
+	It("should iterate over container events", func() {
+		tr = newTestRunner()
+		tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sh", "-c", "exit 3"}, nil)
+		sut = tr.configGivenEnv()
+		tr.createContainer(sut, false)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		seq := sut.ContainerEvents(ctx, tr.ctrID)
+		defer seq.Close()
+		tr.startContainer(sut)
+
+		var exitCode int32
+		seq.All()(func(event client.ContainerEvent) bool {
+			if event.Type != client.ContainerEventTypeExited {
+				return true
+			}
+			exitCode = event.ExitCode
+
+			return false
+		})
+		Expect(exitCode).To(BeEquivalentTo(3))
+		Expect(seq.Err()).To(BeNil())
+	})
+
+	It("should stop the iteration on Close", func() {
+		tr = newTestRunner()
+		tr.createRuntimeConfigWithProcessArgs(false, []string{"/busybox", "sleep", "20"}, nil)
+		sut = tr.configGivenEnv()
+		tr.createContainer(sut, false)
+		tr.startContainer(sut)
+
+		seq := sut.MountEvents(context.Background(), tr.ctrID)
+		time.AfterFunc(time.Second, seq.Close)
+
+		seq.All()(func(client.MountEvent) bool {
+			Fail("unexpected event")
+
+			return false
+		})
+		Expect(seq.Err()).To(BeNil())
+	})
+
+	It("should return the error if the stream cannot be created", func() {
+		tr = newTestRunner()
+		tr.createRuntimeConfig(false)
+		sut = tr.configGivenEnv()
+
+		seq := sut.QuotaEvents(context.Background(), &client.WatchQuotaConfig{ID: tr.ctrID})
+		seq.All()(func(client.QuotaEvent) bool {
+			Fail("unexpected event")
+
+			return false
+		})
+		Expect(seq.Err()).NotTo(BeNil())
+	})
+})
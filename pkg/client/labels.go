@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// LabelSelector selects containers by their labels. A container matches if
+// it has all labels of the selector with the same values. An empty selector
+// matches all containers.
+type LabelSelector map[string]string
+
+// SetLabels replaces all labels of a container, which allows storing small
+// metadata like the owner or a job ID with the server. The labels are kept
+// as long as the server supervises the container and can be used to
+// rediscover containers via ListContainers.
+func (c *ConmonClient) SetLabels(ctx context.Context, id string, labels map[string]string) error {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("SetLabels")()
+	diag := c.diagnoseRPC("SetLabels")
+	future, free := client.SetLabels(ctx, func(p proto.Conmon_setLabels_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		list, err := req.NewLabels(int32(len(labels)))
+		if err != nil {
+			return fmt.Errorf("create labels: %w", err)
+		}
+
+		if err := initLabels(list, labels); err != nil {
+			return err
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	return responseError(response)
+}
+
+// GetLabels returns the labels of a container.
+func (c *ConmonClient) GetLabels(ctx context.Context, id string) (map[string]string, error) {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("GetLabels")()
+	diag := c.diagnoseRPC("GetLabels")
+	future, free := client.GetLabels(ctx, func(p proto.Conmon_getLabels_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	list, err := response.Labels()
+	if err != nil {
+		return nil, fmt.Errorf("get labels: %w", err)
+	}
+
+	return labelsFromList(list)
+}
+
+func initLabels(list proto.Conmon_Label_List, labels map[string]string) error {
+	i := 0
+	for key, value := range labels {
+		label := list.At(i)
+		if err := label.SetKey(key); err != nil {
+			return fmt.Errorf("set label key: %w", err)
+		}
+		if err := label.SetValue(value); err != nil {
+			return fmt.Errorf("set label value: %w", err)
+		}
+		i++
+	}
+
+	return nil
+}
+
+func labelsFromList(list proto.Conmon_Label_List) (map[string]string, error) {
+	labels := make(map[string]string, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		label := list.At(i)
+
+		key, err := label.Key()
+		if err != nil {
+			return nil, fmt.Errorf("get label key: %w", err)
+		}
+
+		value, err := label.Value()
+		if err != nil {
+			return nil, fmt.Errorf("get label value: %w", err)
+		}
+
+		labels[key] = value
+	}
+
+	return labels, nil
+}
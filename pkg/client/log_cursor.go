@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// ErrInvalidWhence is returned by LogCursor.SeekOffset if the whence is not
+// one of io.SeekStart, io.SeekCurrent or io.SeekEnd.
+var ErrInvalidWhence = errors.New("invalid whence")
+
+// LogEntry is a single entry of a container CRI log file.
+type LogEntry struct {
+	// Offset is the position of the entry in the log file.
+	Offset uint64
+
+	// Timestamp is the time when the entry has been logged. It is zero if
+	// the entry could not be parsed.
+	Timestamp time.Time
+
+	// Stream is the name of the stream, like "stdout" or "stderr".
+	Stream string
+
+	// Partial indicates that the entry does not end with a newline.
+	Partial bool
+
+	// Message is the logged data without the trailing newline.
+	Message []byte
+}
+
+// LogCursor is a position in the CRI log file of a container, which can be
+// used to page through the log in both directions without reading it from
+// the start. Offsets are byte positions in the log file and get aligned to
+// the next entry by the server. The cursor does not follow log rotations.
+type LogCursor struct {
+	client *ConmonClient
+	id     string
+	path   string
+	offset uint64
+}
+
+// OpenLogCursor opens a cursor at the start of the log file of the first
+// CRI log driver of a container.
+func (c *ConmonClient) OpenLogCursor(ctx context.Context, id string) (*LogCursor, error) {
+	return c.OpenLogCursorForPath(ctx, id, "")
+}
+
+// OpenLogCursorForPath opens a cursor at the start of the log file of the CRI
+// log driver with the provided path.
+func (c *ConmonClient) OpenLogCursorForPath(ctx context.Context, id, path string) (*LogCursor, error) {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := &LogCursor{client: c, id: id, path: path}
+
+	// Ensure that the log exists.
+	if _, _, err := cursor.read(ctx, 0, 0, false); err != nil {
+		return nil, err
+	}
+
+	return cursor, nil
+}
+
+// Offset returns the current offset of the cursor.
+func (l *LogCursor) Offset() uint64 {
+	return l.offset
+}
+
+// SeekOffset moves the cursor to the offset relative to whence, which is one
+// of io.SeekStart, io.SeekCurrent or io.SeekEnd, and returns the new offset.
+// Offsets before the start of the log are moved to the start, offsets after
+// its end do not return any entries.
+func (l *LogCursor) SeekOffset(ctx context.Context, offset int64, whence int) (uint64, error) {
+	var base int64
+
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		base = int64(l.offset)
+	case io.SeekEnd:
+		_, size, err := l.read(ctx, 0, 0, false)
+		if err != nil {
+			return l.offset, err
+		}
+		base = int64(size)
+	default:
+		return l.offset, fmt.Errorf("%w: %d", ErrInvalidWhence, whence)
+	}
+
+	target := base + offset
+	if target < 0 {
+		target = 0
+	}
+	l.offset = uint64(target)
+
+	return l.offset, nil
+}
+
+// SeekTime moves the cursor to the first entry logged at or after the
+// provided time.
+func (l *LogCursor) SeekTime(ctx context.Context, t time.Time) error {
+	conn, err := l.client.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := l.client.bootstrap(ctx, conn)
+	defer l.client.metrics.observeRPC("SeekLogContainer")()
+	diag := l.client.diagnoseRPC("SeekLogContainer")
+	future, free := client.SeekLogContainer(ctx, func(p proto.Conmon_seekLogContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(l.id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := req.SetPath(l.path); err != nil {
+			return fmt.Errorf("set path: %w", err)
+		}
+
+		req.SetTimestamp(t.UnixNano())
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	l.offset = response.Offset()
+
+	return nil
+}
+
+// Next reads up to n entries after the cursor and moves the cursor behind
+// them. If n is negative, up to -n entries before the cursor are read and the
+// cursor is moved to the first of them. The entries are always returned in
+// log order, no entries are returned at the start or end of the log.
+func (l *LogCursor) Next(ctx context.Context, n int) ([]LogEntry, error) {
+	backward := n < 0
+	if backward {
+		n = -n
+	}
+
+	entries, _, err := l.read(ctx, uint32(n), l.offset, backward)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// read reads count entries from the offset, updates the cursor offset if
+// entries have been requested and returns the entries and the log size.
+func (l *LogCursor) read(
+	ctx context.Context, count uint32, offset uint64, backward bool,
+) (entries []LogEntry, size uint64, err error) {
+	conn, err := l.client.newRPCConn(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := l.client.bootstrap(ctx, conn)
+	defer l.client.metrics.observeRPC("ReadLogContainer")()
+	diag := l.client.diagnoseRPC("ReadLogContainer")
+	future, free := client.ReadLogContainer(ctx, func(p proto.Conmon_readLogContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(l.id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := req.SetPath(l.path); err != nil {
+			return fmt.Errorf("set path: %w", err)
+		}
+
+		req.SetOffset(offset)
+		req.SetCount(count)
+		req.SetBackward(backward)
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, 0, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, 0, fmt.Errorf("set response: %w", err)
+	}
+
+	list, err := response.Entries()
+	if err != nil {
+		return nil, 0, fmt.Errorf("get entries: %w", err)
+	}
+
+	entries = make([]LogEntry, 0, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		entry, err := logEntryFromProto(list.At(i))
+		if err != nil {
+			return nil, 0, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if count > 0 {
+		l.offset = response.Offset()
+	}
+
+	return entries, response.Size(), nil
+}
+
+func logEntryFromProto(entry proto.Conmon_LogEntry) (LogEntry, error) {
+	stream, err := entry.Stream()
+	if err != nil {
+		return LogEntry{}, fmt.Errorf("get stream: %w", err)
+	}
+
+	message, err := entry.Message()
+	if err != nil {
+		return LogEntry{}, fmt.Errorf("get message: %w", err)
+	}
+
+	var timestamp time.Time
+	if entry.Timestamp() != 0 {
+		timestamp = time.Unix(0, entry.Timestamp())
+	}
+
+	return LogEntry{
+		Offset:    entry.Offset(),
+		Timestamp: timestamp,
+		Stream:    stream,
+		Partial:   entry.Partial(),
+		Message:   append([]byte{}, message...),
+	}, nil
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// LogFilterRestartPolicy specifies what happens if a log filter exits.
+type LogFilterRestartPolicy int
+
+const (
+	// LogFilterRestartPolicyNever drops the container output after the
+	// filter exited.
+	LogFilterRestartPolicyNever LogFilterRestartPolicy = iota
+
+	// LogFilterRestartPolicyOnFailure restarts the filter if it exited with a
+	// non-zero exit code.
+	LogFilterRestartPolicyOnFailure
+
+	// LogFilterRestartPolicyAlways restarts the filter whenever it exited.
+	LogFilterRestartPolicyAlways
+)
+
+// LogFilter is an external binary the container output gets passed through
+// before being written to the log drivers, for example to mask or enrich
+// log lines. The server runs a filter process per container output stream,
+// which reads the output from its stdin and writes the filtered output to
+// its stdout. The output gets dropped while the filter is not running,
+// because logging it unfiltered could leak masked data.
+type LogFilter struct {
+	// Path is the path to the filter binary.
+	Path string
+
+	// Args are the arguments passed to the filter binary.
+	Args []string
+
+	// RestartPolicy is applied if the filter exits.
+	RestartPolicy LogFilterRestartPolicy
+
+	// MaxRestarts is the maximum number of filter restarts, which is
+	// unlimited if zero.
+	MaxRestarts uint32
+}
+
+// LogFilterHealth is the health of the log filter of a container.
+type LogFilterHealth struct {
+	// Running indicates that the filter processes are running.
+	Running bool
+
+	// Restarts is the number of filter restarts.
+	Restarts uint32
+
+	// LastExitCode is the exit code of the most recently exited filter
+	// process, or -1 if no filter process exited yet.
+	LastExitCode int32
+
+	// DroppedBytes is the amount of container output dropped because the
+	// filter was not running.
+	DroppedBytes uint64
+}
+
+func initLogFilter(req proto.Conmon_CreateContainerRequest, filter *LogFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	f, err := req.NewLogFilter()
+	if err != nil {
+		return fmt.Errorf("create log filter: %w", err)
+	}
+
+	if err := f.SetPath(filter.Path); err != nil {
+		return fmt.Errorf("set path: %w", err)
+	}
+
+	if err := stringSliceToTextList(filter.Args, f.NewArgs); err != nil {
+		return fmt.Errorf("convert args string slice to text list: %w", err)
+	}
+
+	switch filter.RestartPolicy {
+	case LogFilterRestartPolicyNever:
+		f.SetRestartPolicy(proto.Conmon_LogFilter_RestartPolicy_never)
+	case LogFilterRestartPolicyOnFailure:
+		f.SetRestartPolicy(proto.Conmon_LogFilter_RestartPolicy_onFailure)
+	case LogFilterRestartPolicyAlways:
+		f.SetRestartPolicy(proto.Conmon_LogFilter_RestartPolicy_always)
+	}
+
+	f.SetMaxRestarts(filter.MaxRestarts)
+
+	return nil
+}
+
+func logFilterHealthFromProto(health proto.Conmon_LogFilterHealth) *LogFilterHealth {
+	if !health.Configured() {
+		return nil
+	}
+
+	return &LogFilterHealth{
+		Running:      health.Running(),
+		Restarts:     health.Restarts(),
+		LastExitCode: health.LastExitCode(),
+		DroppedBytes: health.DroppedBytes(),
+	}
+}
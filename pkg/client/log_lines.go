@@ -0,0 +1,190 @@
+package client
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultLogContinuationMarker is appended to the messages of lines which
+// have been split because they exceeded the MaxLineLength of the
+// LogLineOptions, if no other marker is configured.
+const DefaultLogContinuationMarker = " [continued]"
+
+// LogLineOptions specifies how the entries of a CRI log are assembled into
+// lines.
+type LogLineOptions struct {
+	// MaxLineLength caps the length of the message of reassembled lines in
+	// bytes. Longer lines are split into multiple lines, all but the last of
+	// them marked as continued. Zero does not limit the length.
+	MaxLineLength int
+
+	// ContinuationMarker is appended to the message of continued lines.
+	// DefaultLogContinuationMarker is used if it is nil, an empty marker
+	// only sets LogLine.Continued.
+	ContinuationMarker []byte
+
+	// Raw disables reassembling partial entries, every entry becomes a line
+	// of its own like it has been logged.
+	Raw bool
+
+	// IncludeRecords adds the raw entries every line got assembled from to
+	// the line.
+	IncludeRecords bool
+}
+
+// LogLine is a line of a container log, which may have been logged as
+// multiple partial entries.
+type LogLine struct {
+	// Offset is the position of the first entry of the line in the log file.
+	Offset uint64
+
+	// Timestamp is the time when the first entry of the line has been
+	// logged.
+	Timestamp time.Time
+
+	// Stream is the name of the stream, like "stdout" or "stderr".
+	Stream string
+
+	// Message is the reassembled data without the trailing newline. It
+	// ends with the ContinuationMarker if the line is continued.
+	Message []byte
+
+	// Continued indicates that the line exceeded the MaxLineLength and
+	// continues in the next line of the stream.
+	Continued bool
+
+	// Partial indicates that the line does not end with a newline, which is
+	// the case for lines at the end of the log and for raw partial entries.
+	Partial bool
+
+	// Records are the entries the line got assembled from, if
+	// IncludeRecords is set.
+	Records []LogEntry
+}
+
+// LogLineAssembler assembles log entries into lines like the kubelet, which
+// merges the partial entries of a stream until its next full entry.
+type LogLineAssembler struct {
+	opts    LogLineOptions
+	marker  []byte
+	pending map[string]*LogLine
+}
+
+// NewLogLineAssembler creates a new LogLineAssembler, using the default
+// options if opts is nil.
+func NewLogLineAssembler(opts *LogLineOptions) *LogLineAssembler {
+	a := &LogLineAssembler{pending: map[string]*LogLine{}}
+	if opts != nil {
+		a.opts = *opts
+	}
+
+	a.marker = a.opts.ContinuationMarker
+	if a.marker == nil {
+		a.marker = []byte(DefaultLogContinuationMarker)
+	}
+
+	return a
+}
+
+// Add adds the entry and returns the lines which got complete by it.
+func (a *LogLineAssembler) Add(entry *LogEntry) []LogLine {
+	if a.opts.Raw {
+		line := a.newLine(entry)
+		line.Partial = entry.Partial
+
+		return a.split(line)
+	}
+
+	line, ok := a.pending[entry.Stream]
+	if !ok {
+		line = a.newLine(entry)
+		a.pending[entry.Stream] = line
+	} else {
+		line.Message = append(line.Message, entry.Message...)
+		if a.opts.IncludeRecords {
+			line.Records = append(line.Records, *entry)
+		}
+	}
+
+	if entry.Partial {
+		// Emit the complete pieces of long lines early, which keeps the
+		// memory bounded.
+		lines := a.split(line)
+		last := lines[len(lines)-1]
+		a.pending[entry.Stream] = &last
+
+		return lines[:len(lines)-1]
+	}
+
+	delete(a.pending, entry.Stream)
+
+	return a.split(line)
+}
+
+// Flush returns the lines which are not complete yet, for example at the
+// end of the log. They are marked as partial.
+func (a *LogLineAssembler) Flush() []LogLine {
+	lines := make([]LogLine, 0, len(a.pending))
+	for stream, line := range a.pending {
+		line.Partial = true
+		lines = append(lines, *line)
+		delete(a.pending, stream)
+	}
+
+	return lines
+}
+
+func (a *LogLineAssembler) newLine(entry *LogEntry) *LogLine {
+	line := &LogLine{
+		Offset:    entry.Offset,
+		Timestamp: entry.Timestamp,
+		Stream:    entry.Stream,
+		Message:   append([]byte{}, entry.Message...),
+	}
+
+	if a.opts.IncludeRecords {
+		line.Records = []LogEntry{*entry}
+	}
+
+	return line
+}
+
+// split splits the line into lines not exceeding the MaxLineLength. The
+// records are kept with the first line.
+func (a *LogLineAssembler) split(line *LogLine) []LogLine {
+	max := a.opts.MaxLineLength
+	if max <= 0 || len(line.Message) <= max {
+		return []LogLine{*line}
+	}
+
+	var lines []LogLine
+	message := line.Message
+	records := line.Records
+	for len(message) > max {
+		end := max
+		// Do not split within a UTF-8 sequence if possible.
+		for end > 0 && !utf8.RuneStart(message[end]) {
+			end--
+		}
+		if end == 0 {
+			end = max
+		}
+
+		piece := *line
+		piece.Message = append(append([]byte{}, message[:end]...), a.marker...)
+		piece.Continued = true
+		piece.Partial = false
+		piece.Records = records
+		lines = append(lines, piece)
+
+		message = message[end:]
+		records = nil
+	}
+
+	rest := *line
+	rest.Message = message
+	rest.Records = records
+	lines = append(lines, rest)
+
+	return lines
+}
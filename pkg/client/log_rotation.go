@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// RotateLogContainerConfig is the configuration for calling the
+// RotateLogContainer method.
+type RotateLogContainerConfig struct {
+	// ID is the container identifier.
+	ID string
+
+	// ExecSession rotates the log drivers of the exec session instead of the
+	// container if set.
+	ExecSession string
+
+	// Path rotates only the log driver of the path if set, otherwise all log
+	// drivers get rotated.
+	Path string
+}
+
+// RotateLogContainer can be used to force the rotation of the configured
+// container log drivers according to their MaxFiles and Compress settings,
+// independently of their MaxSize.
+func (c *ConmonClient) RotateLogContainer(ctx context.Context, cfg *RotateLogContainerConfig) error {
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return err
+	}
+
+	id, err := c.containerID(ctx, cfg.ID)
+	if err != nil {
+		return err
+	}
+
+	execSession, err := c.execSessionID(cfg.ExecSession)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("RotateLogContainer")()
+	diag := c.diagnoseRPC("RotateLogContainer")
+
+	future, free := client.RotateLogContainer(ctx, func(p proto.Conmon_rotateLogContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := req.SetExecSessionId(execSession); err != nil {
+			return fmt.Errorf("set exec session ID: %w", err)
+		}
+
+		if err := req.SetPath(cfg.Path); err != nil {
+			return fmt.Errorf("set path: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	if _, err := result.Response(); err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultRPCLatencyBuckets are the upper bounds of the RPC latency histogram
+// buckets in seconds, which are used if NewMetrics gets called without any.
+var DefaultRPCLatencyBuckets = []float64{
+	0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10,
+}
+
+// Metrics collects the metrics of all clients it is configured for. The
+// package does not depend on Prometheus, the MetricsCollector of the module
+// github.com/containers/conmon-rs/interop exports the Snapshot as
+// prometheus.Collector.
+type Metrics struct {
+	buckets []float64
+
+	mu         sync.Mutex
+	rpcLatency map[string]*histogram
+
+	attach               attachStats
+	activeAttachSessions int64
+	reconnects           uint64
+	connectRetries       uint64
+}
+
+// NewMetrics creates a new Metrics instance. The RPC latencies are recorded
+// in the buckets, which are the DefaultRPCLatencyBuckets if none provided.
+func NewMetrics(buckets ...float64) *Metrics {
+	if len(buckets) == 0 {
+		buckets = DefaultRPCLatencyBuckets
+	}
+
+	sorted := append([]float64{}, buckets...)
+	sort.Float64s(sorted)
+
+	return &Metrics{
+		buckets:    sorted,
+		rpcLatency: map[string]*histogram{},
+	}
+}
+
+// Histogram is the snapshot of a histogram.
+type Histogram struct {
+	// Count is the number of observations.
+	Count uint64
+
+	// Sum of all observed values.
+	Sum float64
+
+	// Buckets are the cumulative counts of observations per upper bound.
+	Buckets map[float64]uint64
+}
+
+// MetricsSnapshot contains the values of the Metrics at a point in time.
+type MetricsSnapshot struct {
+	// RPCLatency contains the latencies of the RPCs in seconds per method,
+	// for example "CreateContainer".
+	RPCLatency map[string]Histogram
+
+	// AttachBytesIn is the number of bytes copied from the standard input
+	// of attach sessions to the containers.
+	AttachBytesIn uint64
+
+	// AttachBytesOut is the number of bytes copied from the containers to
+	// the standard output and error of attach sessions.
+	AttachBytesOut uint64
+
+	// AttachBytesDropped is the number of output bytes of attach sessions
+	// discarded because the OutputBufferSize was exhausted.
+	AttachBytesDropped uint64
+
+	// ActiveAttachSessions is the number of running attach sessions.
+	ActiveAttachSessions int64
+
+	// Reconnects is the number of successful calls to Reconnect.
+	Reconnects uint64
+
+	// ConnectRetries is the number of retried connection attempts.
+	ConnectRetries uint64
+}
+
+// Snapshot returns the current values of the metrics.
+func (m *Metrics) Snapshot() *MetricsSnapshot {
+	m.mu.Lock()
+	rpcLatency := make(map[string]Histogram, len(m.rpcLatency))
+	for method, h := range m.rpcLatency {
+		rpcLatency[method] = h.snapshot(m.buckets)
+	}
+	m.mu.Unlock()
+
+	return &MetricsSnapshot{
+		RPCLatency:           rpcLatency,
+		AttachBytesIn:        atomic.LoadUint64(&m.attach.bytesIn),
+		AttachBytesOut:       atomic.LoadUint64(&m.attach.bytesOut),
+		AttachBytesDropped:   atomic.LoadUint64(&m.attach.bytesDropped),
+		ActiveAttachSessions: atomic.LoadInt64(&m.activeAttachSessions),
+		Reconnects:           atomic.LoadUint64(&m.reconnects),
+		ConnectRetries:       atomic.LoadUint64(&m.connectRetries),
+	}
+}
+
+// histogram records observations in buckets. It is guarded by the mutex of
+// the Metrics.
+type histogram struct {
+	count  uint64
+	sum    float64
+	counts []uint64
+}
+
+func (h *histogram) observe(buckets []float64, value float64) {
+	h.count++
+	h.sum += value
+	if i := sort.SearchFloat64s(buckets, value); i < len(buckets) {
+		h.counts[i]++
+	}
+}
+
+func (h *histogram) snapshot(buckets []float64) Histogram {
+	snapshot := Histogram{
+		Count:   h.count,
+		Sum:     h.sum,
+		Buckets: make(map[float64]uint64, len(buckets)),
+	}
+
+	var cumulative uint64
+	for i, bound := range buckets {
+		cumulative += h.counts[i]
+		snapshot.Buckets[bound] = cumulative
+	}
+
+	return snapshot
+}
+
+// observeRPC starts measuring the latency of an RPC. The returned function
+// records it and is intended to be deferred. It does nothing if the metrics
+// are nil.
+func (m *Metrics) observeRPC(method string) func() {
+	if m == nil {
+		return func() {}
+	}
+
+	start := time.Now()
+
+	return func() {
+		latency := time.Since(start).Seconds()
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		h, ok := m.rpcLatency[method]
+		if !ok {
+			h = &histogram{counts: make([]uint64, len(m.buckets))}
+			m.rpcLatency[method] = h
+		}
+		h.observe(m.buckets, latency)
+	}
+}
+
+// startAttachSession counts the bytes transferred by the streams of an attach
+// session while it is active. The returned function ends the session.
+func (m *Metrics) startAttachSession(streams AttachStreams) (AttachStreams, func()) {
+	if m == nil {
+		return streams, func() {}
+	}
+
+	atomic.AddInt64(&m.activeAttachSessions, 1)
+
+	return m.attach.countStreams(streams), func() {
+		atomic.AddInt64(&m.activeAttachSessions, -1)
+	}
+}
+
+// countReconnect counts a successful reconnect.
+func (m *Metrics) countReconnect() {
+	if m != nil {
+		atomic.AddUint64(&m.reconnects, 1)
+	}
+}
+
+// countConnectRetry counts a retried connection attempt.
+func (m *Metrics) countConnectRetry() {
+	if m != nil {
+		atomic.AddUint64(&m.connectRetries, 1)
+	}
+}
@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// MountEventType specifies the type of a mount event.
+type MountEventType int
+
+const (
+	// MountEventTypeMount indicates that a new mount appeared.
+	MountEventTypeMount MountEventType = iota
+
+	// MountEventTypeUnmount indicates that a mount disappeared.
+	MountEventTypeUnmount
+)
+
+// MountEvent is a single mount or unmount event inside the mount namespace
+// of a container.
+type MountEvent struct {
+	// Type is the type of the event.
+	Type MountEventType
+
+	// MountPoint is the path of the mount inside the container.
+	MountPoint string
+
+	// Source is the source of the mount, for example a device.
+	Source string
+
+	// FSType is the file system type of the mount.
+	FSType string
+
+	// Options are the per mount options.
+	Options string
+}
+
+// WatchMounts can be used to stream mount and unmount events of the mount
+// namespace of a running container. The returned channel gets closed if the
+// context is done, the container exits or the connection to the server
+// breaks.
+func (c *ConmonClient) WatchMounts(ctx context.Context, id string) (<-chan MountEvent, error) {
+	watcher, err := c.watchMounts(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return watcher.events, nil
+}
+
+func (c *ConmonClient) watchMounts(ctx context.Context, id string) (*eventStream[MountEvent], error) {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newDedicatedRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("WatchMounts")()
+	diag := c.diagnoseRPC("WatchMounts")
+
+	watcher := mountWatcher{newEventStream[MountEvent]()}
+	future, free := client.WatchMounts(ctx, func(p proto.Conmon_watchMounts_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := req.SetWatcher(proto.Conmon_MountWatcher_ServerToClient(watcher, nil)); err != nil {
+			return fmt.Errorf("set watcher: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	if _, err := result.Response(); err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	watcher.serve(ctx, conn, c.logger)
+
+	return watcher.eventStream, nil
+}
+
+// mountWatcher is the local implementation of the MountWatcher interface.
+type mountWatcher struct {
+	*eventStream[MountEvent]
+}
+
+// Event is called by the server for every mount event.
+func (m mountWatcher) Event(ctx context.Context, call proto.Conmon_MountWatcher_event) error {
+	event, err := call.Args().Event()
+	if err != nil {
+		return fmt.Errorf("get event: %w", err)
+	}
+
+	mountEvent := MountEvent{Type: MountEventTypeMount}
+	if event.Type() == proto.Conmon_MountEvent_Type_unmount {
+		mountEvent.Type = MountEventTypeUnmount
+	}
+
+	if mountEvent.MountPoint, err = event.MountPoint(); err != nil {
+		return fmt.Errorf("get mount point: %w", err)
+	}
+
+	if mountEvent.Source, err = event.Source(); err != nil {
+		return fmt.Errorf("get source: %w", err)
+	}
+
+	if mountEvent.FSType, err = event.FsType(); err != nil {
+		return fmt.Errorf("get file system type: %w", err)
+	}
+
+	if mountEvent.Options, err = event.Options(); err != nil {
+		return fmt.Errorf("get options: %w", err)
+	}
+
+	return m.send(ctx, mountEvent)
+}
+
+// Done is called by the server before it releases the watcher.
+func (m mountWatcher) Done(ctx context.Context, call proto.Conmon_MountWatcher_done) error {
+	msg, err := call.Args().Error()
+	if err != nil {
+		return fmt.Errorf("get error: %w", err)
+	}
+	m.stop(msg)
+
+	return nil
+}
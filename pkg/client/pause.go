@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+var (
+	// ErrContainerAlreadyPaused is returned by PauseContainer if the
+	// container is already paused.
+	ErrContainerAlreadyPaused = errors.New("container is already paused")
+
+	// ErrContainerNotPaused is returned by UnpauseContainer if the container
+	// is not paused.
+	ErrContainerNotPaused = errors.New("container is not paused")
+)
+
+// The parts of the error messages of the server, which have to be kept in
+// sync with `conmon-rs/server/src/rpc.rs`.
+const (
+	alreadyPausedMessage = " is already paused"
+	notPausedMessage     = " is not paused"
+)
+
+// pauseStateError is returned if the container is not in the state required
+// for pausing or unpausing it. It matches the sentinel error of the state.
+type pauseStateError struct {
+	target error
+	err    error
+}
+
+// Error returns the error message of the server.
+func (e *pauseStateError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying RPC error.
+func (e *pauseStateError) Unwrap() error {
+	return e.err
+}
+
+// Is returns true if the target is the sentinel error of the state.
+func (e *pauseStateError) Is(target error) bool {
+	return target == e.target
+}
+
+// pauseError converts err into a pauseStateError if the server rejected the
+// request because of the state of the container.
+func pauseError(err error, message string, target error) error {
+	if !strings.Contains(err.Error(), message) {
+		return err
+	}
+
+	return &pauseStateError{target: target, err: err}
+}
+
+// PauseContainer freezes all processes of a running container using its
+// cgroup freezer. The returned error matches ErrContainerAlreadyPaused if the
+// container is already paused.
+func (c *ConmonClient) PauseContainer(ctx context.Context, id string) error {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("PauseContainer")()
+	diag := c.diagnoseRPC("PauseContainer")
+	future, free := client.PauseContainer(ctx, func(p proto.Conmon_pauseContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	if err := responseError(response); err != nil {
+		return pauseError(err, alreadyPausedMessage, ErrContainerAlreadyPaused)
+	}
+
+	return nil
+}
+
+// UnpauseContainer thaws all processes of a paused container. The returned
+// error matches ErrContainerNotPaused if the container is not paused.
+func (c *ConmonClient) UnpauseContainer(ctx context.Context, id string) error {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("UnpauseContainer")()
+	diag := c.diagnoseRPC("UnpauseContainer")
+	future, free := client.UnpauseContainer(ctx, func(p proto.Conmon_unpauseContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	if err := responseError(response); err != nil {
+		return pauseError(err, notPausedMessage, ErrContainerNotPaused)
+	}
+
+	return nil
+}
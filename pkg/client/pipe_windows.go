@@ -0,0 +1,237 @@
+//go:build windows
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// pipeBusyBackoff is the time between attempts to open a named pipe whose
+// instances are all busy.
+const pipeBusyBackoff = 10 * time.Millisecond
+
+// dialPipe opens the named pipe at the path for overlapped IO, waiting until
+// an instance is available or the context is done.
+func dialPipe(ctx context.Context, path string) (net.Conn, error) {
+	name, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, fmt.Errorf("convert pipe path %s: %w", path, err)
+	}
+
+	for {
+		handle, err := windows.CreateFile(
+			name,
+			windows.GENERIC_READ|windows.GENERIC_WRITE,
+			0,
+			nil,
+			windows.OPEN_EXISTING,
+			windows.FILE_FLAG_OVERLAPPED,
+			0,
+		)
+		if err == nil {
+			return &pipeConn{handle: handle, addr: pipeAddr(path)}, nil
+		}
+
+		if !errors.Is(err, windows.ERROR_PIPE_BUSY) {
+			return nil, fmt.Errorf("open named pipe %s: %w", path, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("open named pipe %s: %w", path, ctx.Err())
+		case <-time.After(pipeBusyBackoff):
+		}
+	}
+}
+
+// pipeAddr is the address of a named pipe.
+type pipeAddr string
+
+// Network returns "npipe".
+func (pipeAddr) Network() string {
+	return schemeNpipe
+}
+
+// String returns the pipe path.
+func (p pipeAddr) String() string {
+	return string(p)
+}
+
+// pipeConn is a connection via a named pipe opened for overlapped IO, which
+// allows reading and writing concurrently.
+type pipeConn struct {
+	handle windows.Handle
+	addr   pipeAddr
+
+	closed    int32
+	closeOnce sync.Once
+	closeErr  error
+
+	mu            sync.Mutex
+	readDeadline  time.Time
+	writeDeadline time.Time
+}
+
+// pipeOp is a read or write operation on a handle.
+type pipeOp func(windows.Handle, []byte, *uint32, *windows.Overlapped) error
+
+// Read reads from the pipe, returning io.EOF once the server closed it.
+func (p *pipeConn) Read(b []byte) (int, error) {
+	n, err := p.do(b, windows.ReadFile, p.deadline(true))
+	if errors.Is(err, windows.ERROR_BROKEN_PIPE) || errors.Is(err, windows.ERROR_PIPE_NOT_CONNECTED) {
+		return n, io.EOF
+	}
+	if errors.Is(err, windows.ERROR_MORE_DATA) {
+		// The rest of a message is returned by the next read.
+		return n, nil
+	}
+
+	// nolint:wrapcheck // keep the net.Conn semantics
+	return n, err
+}
+
+// Write writes all of b to the pipe.
+func (p *pipeConn) Write(b []byte) (int, error) {
+	written := 0
+	for written < len(b) {
+		n, err := p.do(b[written:], windows.WriteFile, p.deadline(false))
+		written += n
+		if err != nil {
+			return written, err
+		}
+		if n == 0 {
+			return written, io.ErrShortWrite
+		}
+	}
+
+	return written, nil
+}
+
+// do runs the operation and waits for its completion, cancelling it once the
+// deadline expires.
+func (p *pipeConn) do(b []byte, op pipeOp, deadline time.Time) (int, error) {
+	if atomic.LoadInt32(&p.closed) == 1 {
+		return 0, net.ErrClosed
+	}
+
+	if !deadline.IsZero() && !time.Now().Before(deadline) {
+		return 0, os.ErrDeadlineExceeded
+	}
+
+	event, err := windows.CreateEvent(nil, 1, 0, nil)
+	if err != nil {
+		return 0, fmt.Errorf("create event: %w", err)
+	}
+	defer windows.CloseHandle(event)
+
+	overlapped := &windows.Overlapped{HEvent: event}
+	var done uint32
+	if err := op(p.handle, b, &done, overlapped); err != nil && !errors.Is(err, windows.ERROR_IO_PENDING) {
+		return int(done), p.opError(err)
+	}
+
+	if !deadline.IsZero() {
+		timer := time.AfterFunc(time.Until(deadline), func() {
+			_ = windows.CancelIoEx(p.handle, overlapped)
+		})
+		defer timer.Stop()
+	}
+
+	// The buffer is in use until the operation completed, also if it got
+	// cancelled.
+	if err := windows.GetOverlappedResult(p.handle, overlapped, &done, true); err != nil {
+		if errors.Is(err, windows.ERROR_OPERATION_ABORTED) && atomic.LoadInt32(&p.closed) == 0 {
+			return int(done), os.ErrDeadlineExceeded
+		}
+
+		return int(done), p.opError(err)
+	}
+
+	return int(done), nil
+}
+
+// opError returns net.ErrClosed for operations failing because the
+// connection got closed.
+func (p *pipeConn) opError(err error) error {
+	if atomic.LoadInt32(&p.closed) == 1 {
+		return net.ErrClosed
+	}
+
+	// nolint:wrapcheck // keep the net.Conn semantics
+	return err
+}
+
+// Close cancels all pending operations and closes the pipe.
+func (p *pipeConn) Close() error {
+	p.closeOnce.Do(func() {
+		atomic.StoreInt32(&p.closed, 1)
+		_ = windows.CancelIoEx(p.handle, nil)
+		if err := windows.CloseHandle(p.handle); err != nil {
+			p.closeErr = fmt.Errorf("close named pipe: %w", err)
+		}
+	})
+
+	return p.closeErr
+}
+
+// LocalAddr returns the address of the pipe.
+func (p *pipeConn) LocalAddr() net.Addr {
+	return p.addr
+}
+
+// RemoteAddr returns the address of the pipe.
+func (p *pipeConn) RemoteAddr() net.Addr {
+	return p.addr
+}
+
+// SetDeadline sets the read and write deadlines.
+func (p *pipeConn) SetDeadline(t time.Time) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.readDeadline = t
+	p.writeDeadline = t
+
+	return nil
+}
+
+// SetReadDeadline sets the deadline of future reads.
+func (p *pipeConn) SetReadDeadline(t time.Time) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.readDeadline = t
+
+	return nil
+}
+
+// SetWriteDeadline sets the deadline of future writes.
+func (p *pipeConn) SetWriteDeadline(t time.Time) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.writeDeadline = t
+
+	return nil
+}
+
+func (p *pipeConn) deadline(read bool) time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if read {
+		return p.readDeadline
+	}
+
+	return p.writeDeadline
+}
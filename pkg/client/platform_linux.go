@@ -0,0 +1,82 @@
+//go:build linux
+
+package client
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+)
+
+// DialLongSocket is a wrapper around net.DialUnix.
+// Its purpose is to allow for an arbitrarily long socket.
+// It does so by opening the parent directory of path, and using the
+// `/proc/self/fd` entry of that parent (which is a symlink to the actual parent)
+// to construct the path to the socket.
+// It assumes a valid path, as well as a file name that doesn't exceed the unix max socket length.
+func DialLongSocket(network, path string) (*net.UnixConn, error) {
+	parent := filepath.Dir(path)
+	f, err := os.Open(parent)
+	if err != nil {
+		return nil, fmt.Errorf("open socket parent: %w", err)
+	}
+	defer f.Close()
+
+	socketName := filepath.Base(path)
+
+	const procSelfFDPath = "/proc/self/fd"
+	socketPath := filepath.Join(procSelfFDPath, strconv.Itoa(int(f.Fd())), socketName)
+
+	conn, err := net.DialUnix(network, nil, &net.UnixAddr{
+		Name: socketPath, Net: network,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("dial unix socket: %w", err)
+	}
+
+	return conn, nil
+}
+
+// dialVsock connects to the vsock port of the context ID, for example the
+// one of a virtual machine.
+func dialVsock(cid, port uint32) (net.Conn, error) {
+	fd, err := unix.Socket(unix.AF_VSOCK, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("create vsock socket: %w", err)
+	}
+
+	if err := unix.Connect(fd, &unix.SockaddrVM{CID: cid, Port: port}); err != nil {
+		unix.Close(fd)
+
+		return nil, fmt.Errorf("connect vsock %d:%d: %w", cid, port, err)
+	}
+
+	file := os.NewFile(uintptr(fd), fmt.Sprintf("vsock:%d:%d", cid, port))
+	defer file.Close()
+
+	conn, err := net.FileConn(file)
+	if err != nil {
+		return nil, fmt.Errorf("create vsock connection: %w", err)
+	}
+
+	return conn, nil
+}
+
+// cgroupVersionOf returns the version of the cgroup hierarchy mounted at the
+// root.
+func cgroupVersionOf(root string) (CgroupVersion, error) {
+	var st unix.Statfs_t
+	if err := unix.Statfs(root, &st); err != nil {
+		return CgroupVersionAuto, fmt.Errorf("stat %s: %w", root, err)
+	}
+
+	if st.Type == unix.CGROUP2_SUPER_MAGIC {
+		return CgroupVersionV2, nil
+	}
+
+	return CgroupVersionV1, nil
+}
@@ -0,0 +1,30 @@
+//go:build !linux && !windows
+
+package client
+
+import (
+	"fmt"
+	"net"
+)
+
+// DialLongSocket is a wrapper around net.DialUnix. The length of the path is
+// limited by the platform, because it lacks /proc/self/fd.
+func DialLongSocket(network, path string) (*net.UnixConn, error) {
+	conn, err := net.DialUnix(network, nil, &net.UnixAddr{Name: path, Net: network})
+	if err != nil {
+		return nil, fmt.Errorf("dial unix socket: %w", err)
+	}
+
+	return conn, nil
+}
+
+// dialVsock is only supported on Linux.
+func dialVsock(cid, port uint32) (net.Conn, error) {
+	return nil, fmt.Errorf("%w: vsock %d:%d", errUnsupportedPlatform, cid, port)
+}
+
+// cgroupVersionOf is only supported on Linux, the cgroup version has to be
+// specified.
+func cgroupVersionOf(root string) (CgroupVersion, error) {
+	return CgroupVersionAuto, fmt.Errorf("%w: detect cgroup version of %s", errUnsupportedPlatform, root)
+}
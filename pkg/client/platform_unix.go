@@ -0,0 +1,46 @@
+//go:build !windows
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"syscall"
+)
+
+// localSockets dials unix domain sockets.
+var localSockets socketDialer = unixSocketDialer{}
+
+// transientDialErrors are the errors of dialing a server socket which is
+// temporarily unavailable.
+var transientDialErrors = []error{syscall.ENOENT, syscall.ECONNREFUSED, syscall.EAGAIN}
+
+// unixSocketDialer connects to unix domain sockets via DialLongSocket.
+type unixSocketDialer struct{}
+
+// DialSocket connects to the socket at the address.
+func (unixSocketDialer) DialSocket(_ context.Context, network, address string) (net.Conn, error) {
+	if network == schemeNpipe {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedNetwork, network)
+	}
+
+	return DialLongSocket(network, address)
+}
+
+// unixRights encodes the file descriptors into a socket control message.
+func unixRights(fds ...int) ([]byte, error) {
+	return syscall.UnixRights(fds...), nil
+}
+
+// serverSysProcAttr returns the process attributes of the server, which runs
+// in a process group of its own.
+func serverSysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setpgid: true}
+}
+
+// signalProcess sends the signal to the process.
+func signalProcess(pid int, signal syscall.Signal) error {
+	// nolint:wrapcheck // wrapped by the callers
+	return syscall.Kill(pid, signal)
+}
@@ -0,0 +1,87 @@
+//go:build windows
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// pipePrefix is the prefix of the paths of local named pipes.
+const pipePrefix = `\\.\pipe\`
+
+// localSockets dials named pipes and unix domain sockets.
+var localSockets socketDialer = pipeSocketDialer{}
+
+// transientDialErrors are the errors of dialing a server socket or pipe which
+// is temporarily unavailable.
+var transientDialErrors = []error{
+	windows.ERROR_FILE_NOT_FOUND,
+	windows.ERROR_PIPE_BUSY,
+	windows.WSAECONNREFUSED,
+}
+
+// pipeSocketDialer connects to named pipes, and to unix domain sockets as
+// far as they are supported by Windows, which excludes "unixpacket".
+type pipeSocketDialer struct{}
+
+// DialSocket connects to the pipe or socket at the address. Addresses of
+// local named pipes are dialed as pipes on every network except
+// "unixpacket", which allows relaying the unix sockets of the server via
+// pipes.
+func (pipeSocketDialer) DialSocket(ctx context.Context, network, address string) (net.Conn, error) {
+	if network == "unixpacket" {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedNetwork, network)
+	}
+
+	if network == schemeNpipe || strings.HasPrefix(address, pipePrefix) {
+		return dialPipe(ctx, address)
+	}
+
+	return DialLongSocket(network, address)
+}
+
+// DialLongSocket is a wrapper around net.DialUnix. The length of the path is
+// not limited on Windows.
+func DialLongSocket(network, path string) (*net.UnixConn, error) {
+	conn, err := net.DialUnix(network, nil, &net.UnixAddr{Name: path, Net: network})
+	if err != nil {
+		return nil, fmt.Errorf("dial unix socket: %w", err)
+	}
+
+	return conn, nil
+}
+
+// dialVsock is not supported on Windows, whose Hyper-V sockets are not
+// compatible.
+func dialVsock(cid, port uint32) (net.Conn, error) {
+	return nil, fmt.Errorf("%w: vsock %d:%d", errUnsupportedPlatform, cid, port)
+}
+
+// unixRights is not supported on Windows, which cannot send file
+// descriptors.
+func unixRights(...int) ([]byte, error) {
+	return nil, fmt.Errorf("%w: sending file descriptors", errUnsupportedPlatform)
+}
+
+// serverSysProcAttr returns the process attributes of the server.
+func serverSysProcAttr() *syscall.SysProcAttr {
+	return nil
+}
+
+// signalProcess is not supported on Windows. The server runs on Linux and
+// has to be reached via ServerAddress, which does not allow signaling it.
+func signalProcess(pid int, signal syscall.Signal) error {
+	return fmt.Errorf("%w: send %v to PID %d", errUnsupportedPlatform, signal, pid)
+}
+
+// cgroupVersionOf is not supported on Windows, the cgroup version has to be
+// specified.
+func cgroupVersionOf(root string) (CgroupVersion, error) {
+	return CgroupVersionAuto, fmt.Errorf("%w: detect cgroup version of %s", errUnsupportedPlatform, root)
+}
@@ -0,0 +1,294 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"strings"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+var (
+	// ErrAddressFamilyUnsupported is returned if the server does not support
+	// the PortForwardConfig.Family or PortForwardConfig.Host.
+	ErrAddressFamilyUnsupported = errors.New("server does not support selecting the port forward address")
+
+	errStreamNil            = errors.New("stream cannot be nil")
+	errAddressFamilyInvalid = errors.New("host does not match the address family")
+)
+
+// AddressFamily is the IP address family used to reach a port inside of a
+// container.
+type AddressFamily int
+
+const (
+	// AddressFamilyAny tries IPv4 first and falls back to IPv6, which makes
+	// ports of IPv6 only containers reachable as well.
+	AddressFamilyAny AddressFamily = iota
+
+	// AddressFamilyIPv4 only uses IPv4.
+	AddressFamilyIPv4
+
+	// AddressFamilyIPv6 only uses IPv6.
+	AddressFamilyIPv6
+)
+
+// String returns the name of the address family.
+func (f AddressFamily) String() string {
+	switch f {
+	case AddressFamilyAny:
+		return "any"
+	case AddressFamilyIPv4:
+		return "ipv4"
+	case AddressFamilyIPv6:
+		return "ipv6"
+	}
+
+	return fmt.Sprintf("AddressFamily(%d)", int(f))
+}
+
+func (f AddressFamily) toProto() (proto.Conmon_AddressFamily, error) {
+	switch f {
+	case AddressFamilyAny:
+		return proto.Conmon_AddressFamily_any, nil
+	case AddressFamilyIPv4:
+		return proto.Conmon_AddressFamily_ipv4, nil
+	case AddressFamilyIPv6:
+		return proto.Conmon_AddressFamily_ipv6, nil
+	}
+
+	return 0, fmt.Errorf("invalid address family: %d", int(f))
+}
+
+func addressFamilyFromProto(family proto.Conmon_AddressFamily) AddressFamily {
+	if family == proto.Conmon_AddressFamily_ipv6 {
+		return AddressFamilyIPv6
+	}
+
+	return AddressFamilyIPv4
+}
+
+// PortForwardInfo describes the connection of a port forward inside of the
+// container.
+type PortForwardInfo struct {
+	// Address is the address the server connected to, for example
+	// 127.0.0.1:8080 or [::1]:8080.
+	Address netip.AddrPort
+
+	// Family is the address family of the connection.
+	Family AddressFamily
+}
+
+// PortForwardConfig is the configuration for calling the PortForwardContainer
+// method.
+type PortForwardConfig struct {
+	// ID of the container.
+	ID string
+
+	// Port on the loopback interface inside the network namespace of the
+	// container, or on Host if set.
+	Port uint16
+
+	// Family selects the address family used to connect to the port, which
+	// defaults to AddressFamilyAny.
+	Family AddressFamily
+
+	// Host is an IPv4 or IPv6 address inside the network namespace of the
+	// container, for example an address of a pod network interface. It
+	// defaults to the loopback address of the Family and has to match it.
+	Host string
+
+	// Path of the socket used to transfer the traffic, which gets removed
+	// after the connection has been established.
+	SocketPath string
+
+	// Stream is the connection of the caller, which gets forwarded to the
+	// port inside of the container.
+	Stream io.ReadWriter
+
+	// OnConnected is called with the address the server connected to,
+	// before any traffic gets forwarded.
+	OnConnected func(*PortForwardInfo)
+}
+
+// PortForwardContainer streams TCP traffic between the provided stream and a
+// port inside the network namespace of a running container. The method
+// blocks until the container closes the connection or the context is done.
+func (c *ConmonClient) PortForwardContainer(ctx context.Context, cfg *PortForwardConfig) error {
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Stream == nil {
+		return errStreamNil
+	}
+
+	if err := validateForwardHost(cfg.Family, cfg.Host); err != nil {
+		return err
+	}
+
+	info, err := c.portForwardContainer(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.OnConnected != nil {
+		cfg.OnConnected(info)
+	}
+
+	dialed, err := c.dialContext(ctx, "unix", cfg.SocketPath)
+	if err != nil {
+		return fmt.Errorf("failed to connect to port forward socket: %v: %w", cfg.SocketPath, err)
+	}
+	conn := halfClose(dialed)
+	defer conn.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	go func() {
+		if _, err := io.Copy(conn, cfg.Stream); err != nil {
+			c.logger.Debugf("Unable to copy stream to port forward connection: %v", err)
+		}
+		// Forward the end of the stream to the container.
+		if err := conn.CloseWrite(); err != nil {
+			c.logger.Debugf("Unable to close port forward connection for writing: %v", err)
+		}
+	}()
+
+	if _, err := io.Copy(cfg.Stream, conn); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("forward port: %w", ctx.Err())
+		}
+
+		return fmt.Errorf("forward port: %w", err)
+	}
+
+	return nil
+}
+
+// validateForwardHost checks that the host is an IP address of the family.
+func validateForwardHost(family AddressFamily, host string) error {
+	if _, err := family.toProto(); err != nil {
+		return err
+	}
+
+	if host == "" {
+		return nil
+	}
+
+	addr, err := netip.ParseAddr(strings.Trim(host, "[]"))
+	if err != nil {
+		return fmt.Errorf("parse port forward host: %w", err)
+	}
+
+	if (family == AddressFamilyIPv4 && !addr.Is4()) || (family == AddressFamilyIPv6 && !addr.Is6()) {
+		return fmt.Errorf("%w: %s is not %s", errAddressFamilyInvalid, host, family)
+	}
+
+	return nil
+}
+
+// portForwardContainer requests the port forward socket from the server.
+func (c *ConmonClient) portForwardContainer(
+	ctx context.Context, cfg *PortForwardConfig,
+) (*PortForwardInfo, error) {
+	id, err := c.containerID(ctx, cfg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	family, err := cfg.Family.toProto()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("PortForwardContainer")()
+	diag := c.diagnoseRPC("PortForwardContainer")
+	future, free := client.PortForwardContainer(ctx, func(p proto.Conmon_portForwardContainer_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		req.SetPort(cfg.Port)
+
+		if err := req.SetSocketPath(cfg.SocketPath); err != nil {
+			return fmt.Errorf("set socket path: %w", err)
+		}
+
+		req.SetFamily(family)
+
+		if err := req.SetHost(cfg.Host); err != nil {
+			return fmt.Errorf("set host: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	address, err := response.Address()
+	if err != nil {
+		return nil, fmt.Errorf("get address: %w", err)
+	}
+
+	if address == "" {
+		// Older servers always connect to 127.0.0.1.
+		if cfg.Family == AddressFamilyIPv6 || cfg.Host != "" {
+			return nil, ErrAddressFamilyUnsupported
+		}
+
+		return &PortForwardInfo{
+			Address: netip.AddrPortFrom(netip.AddrFrom4([4]byte{127, 0, 0, 1}), cfg.Port),
+			Family:  AddressFamilyIPv4,
+		}, nil
+	}
+
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("parse address: %w", err)
+	}
+
+	return &PortForwardInfo{
+		Address: addrPort,
+		Family:  addressFamilyFromProto(response.Family()),
+	}, nil
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+var errThresholdsEmpty = errors.New("at least one threshold must be specified")
+
+// WatchQuotaConfig is the configuration for calling the WatchQuota method.
+type WatchQuotaConfig struct {
+	// ID is the container identifier.
+	ID string
+
+	// Path is the absolute path inside of the container to be watched, for
+	// example an emptyDir volume mount.
+	Path string
+
+	// Thresholds is a slice of usage thresholds in bytes.
+	Thresholds []uint64
+
+	// Interval specifies how often the usage gets measured by the server.
+	// The server default is used if the interval is zero.
+	Interval time.Duration
+}
+
+// QuotaEvent is fired every time the usage of a watched path crosses a
+// threshold.
+type QuotaEvent struct {
+	// Path is the watched path inside of the container.
+	Path string
+
+	// Threshold is the crossed threshold in bytes.
+	Threshold uint64
+
+	// Usage is the measured usage in bytes.
+	Usage uint64
+
+	// Exceeded is true if the usage crossed the threshold upwards and false
+	// if it fell below the threshold again.
+	Exceeded bool
+}
+
+// WatchQuota can be used to register usage thresholds for a path inside of a
+// running container. The returned channel gets closed if the context is done,
+// the container exits or the connection to the server breaks.
+func (c *ConmonClient) WatchQuota(ctx context.Context, cfg *WatchQuotaConfig) (<-chan QuotaEvent, error) {
+	watcher, err := c.watchQuota(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return watcher.events, nil
+}
+
+func (c *ConmonClient) watchQuota(ctx context.Context, cfg *WatchQuotaConfig) (*eventStream[QuotaEvent], error) {
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.containerID(ctx, cfg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cfg.Thresholds) == 0 {
+		return nil, errThresholdsEmpty
+	}
+
+	conn, err := c.newDedicatedRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("WatchQuota")()
+	diag := c.diagnoseRPC("WatchQuota")
+
+	watcher := quotaWatcher{newEventStream[QuotaEvent]()}
+	future, free := client.WatchQuota(ctx, func(p proto.Conmon_watchQuota_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := req.SetPath(cfg.Path); err != nil {
+			return fmt.Errorf("set path: %w", err)
+		}
+
+		thresholds, err := req.NewThresholds(int32(len(cfg.Thresholds)))
+		if err != nil {
+			return fmt.Errorf("create thresholds: %w", err)
+		}
+		for i, threshold := range cfg.Thresholds {
+			thresholds.At(i).SetBytes(threshold)
+		}
+
+		req.SetIntervalSec(durationSeconds(cfg.Interval))
+
+		if err := req.SetWatcher(proto.Conmon_QuotaWatcher_ServerToClient(watcher, nil)); err != nil {
+			return fmt.Errorf("set watcher: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	if _, err := result.Response(); err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	watcher.serve(ctx, conn, c.logger)
+
+	return watcher.eventStream, nil
+}
+
+// quotaWatcher is the local implementation of the QuotaWatcher interface.
+type quotaWatcher struct {
+	*eventStream[QuotaEvent]
+}
+
+// Event is called by the server every time a threshold is crossed.
+func (q quotaWatcher) Event(ctx context.Context, call proto.Conmon_QuotaWatcher_event) error {
+	event, err := call.Args().Event()
+	if err != nil {
+		return fmt.Errorf("get event: %w", err)
+	}
+
+	path, err := event.Path()
+	if err != nil {
+		return fmt.Errorf("get path: %w", err)
+	}
+
+	return q.send(ctx, QuotaEvent{
+		Path:      path,
+		Threshold: event.ThresholdBytes(),
+		Usage:     event.UsageBytes(),
+		Exceeded:  event.Exceeded(),
+	})
+}
+
+// Done is called by the server before it releases the watcher.
+func (q quotaWatcher) Done(ctx context.Context, call proto.Conmon_QuotaWatcher_done) error {
+	msg, err := call.Args().Error()
+	if err != nil {
+		return fmt.Errorf("get error: %w", err)
+	}
+	q.stop(msg)
+
+	return nil
+}
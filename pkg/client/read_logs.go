@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+const (
+	// defaultLogPollInterval is the interval for checking for new entries
+	// when following a log if none is configured.
+	defaultLogPollInterval = 250 * time.Millisecond
+
+	// logReadBatchSize is the number of entries requested at once.
+	logReadBatchSize = 128
+)
+
+// ReadLogsOptions are the options of ReadLogs.
+type ReadLogsOptions struct {
+	// Path of the CRI log driver to read, the first one is used if empty.
+	Path string
+
+	// Follow keeps streaming new entries until the context is done or the
+	// container exited and all of its entries have been streamed.
+	Follow bool
+
+	// TailLines limits the existing entries to the last ones, new entries
+	// are streamed regardless when following. Every partial entry counts
+	// as a line. Zero streams all entries.
+	TailLines int
+
+	// Since skips the entries logged before the time, if not zero.
+	Since time.Time
+
+	// Until stops the stream at the first entry logged after the time, if
+	// not zero.
+	Until time.Time
+
+	// PollInterval is the interval for checking for new entries when
+	// following the log. Defaults to 250 milliseconds.
+	PollInterval time.Duration
+}
+
+// ReadLogs streams the entries of the CRI log of a container, which reads
+// the log via the server and does not require access to the log files. The
+// returned channel gets closed once all requested entries have been streamed,
+// the context is done or reading the log failed. Log rotations are not
+// followed.
+func (c *ConmonClient) ReadLogs(ctx context.Context, id string, opts *ReadLogsOptions) (<-chan LogEntry, error) {
+	stream, err := c.readLogs(ctx, id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return stream.events, nil
+}
+
+func (c *ConmonClient) readLogs(
+	ctx context.Context, id string, opts *ReadLogsOptions,
+) (*eventStream[LogEntry], error) {
+	if opts == nil {
+		opts = &ReadLogsOptions{}
+	}
+
+	cursor, err := c.OpenLogCursorForPath(ctx, id, opts.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !opts.Since.IsZero() {
+		if err := cursor.SeekTime(ctx, opts.Since); err != nil {
+			return nil, err
+		}
+	}
+
+	var tail []LogEntry
+	if opts.TailLines > 0 {
+		if tail, err = readLogTail(ctx, cursor, opts); err != nil {
+			return nil, err
+		}
+	}
+
+	// The exit of the container ends following the log.
+	waitCtx, cancelWait := context.WithCancel(ctx)
+	exited := make(chan struct{})
+	if opts.Follow {
+		go func() {
+			defer close(exited)
+			if _, err := c.WaitContainer(waitCtx, id); err != nil && waitCtx.Err() == nil {
+				c.logger.Debugf("Unable to wait for container %s: %v", id, err)
+			}
+		}()
+	}
+
+	stream := newEventStream[LogEntry]()
+	go func() {
+		defer stream.close()
+		defer cancelWait()
+
+		reader := logReader{stream: stream, opts: opts}
+		if !reader.send(ctx, tail) {
+			return
+		}
+
+		for {
+			// Entries written before the exit may be pending.
+			done := !opts.Follow || isClosed(exited)
+
+			entries, err := cursor.Next(ctx, logReadBatchSize)
+			if err != nil {
+				if ctx.Err() == nil {
+					stream.fail(err)
+				}
+
+				return
+			}
+
+			if !reader.send(ctx, entries) {
+				return
+			}
+
+			if len(entries) == logReadBatchSize {
+				continue
+			}
+
+			if done || (!opts.Until.IsZero() && time.Now().After(opts.Until)) {
+				return
+			}
+
+			interval := opts.PollInterval
+			if interval <= 0 {
+				interval = defaultLogPollInterval
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-stream.done:
+				return
+			case <-exited:
+			case <-time.After(interval):
+			}
+		}
+	}()
+
+	return stream, nil
+}
+
+// readLogTail reads the last TailLines entries after the cursor and moves
+// the cursor behind them.
+func readLogTail(ctx context.Context, cursor *LogCursor, opts *ReadLogsOptions) ([]LogEntry, error) {
+	start := cursor.Offset()
+
+	end, err := cursor.SeekOffset(ctx, 0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := cursor.Next(ctx, -opts.TailLines)
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip the entries before the cursor, which are logged before Since.
+	for len(entries) > 0 && entries[0].Offset < start {
+		entries = entries[1:]
+	}
+
+	if _, err := cursor.SeekOffset(ctx, int64(end), io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// logReader sends the entries within the time range of the options.
+type logReader struct {
+	stream *eventStream[LogEntry]
+	opts   *ReadLogsOptions
+}
+
+// send sends the entries and returns false if the stream is done, either
+// because it got closed or an entry after Until has been reached.
+func (r *logReader) send(ctx context.Context, entries []LogEntry) bool {
+	for i := range entries {
+		entry := entries[i]
+		if !r.opts.Until.IsZero() && entry.Timestamp.After(r.opts.Until) {
+			return false
+		}
+
+		if err := r.stream.send(ctx, entry); err != nil {
+			return false
+		}
+	}
+
+	select {
+	case <-r.stream.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// isClosed returns true if the channel is closed.
+func isClosed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
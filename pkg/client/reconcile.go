@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+var (
+	errSpecConfigNil   = errors.New("container spec config cannot be nil")
+	errSpecIDDuplicate = errors.New("duplicate container ID in desired state")
+)
+
+// ContainerSpec is the desired state of a single container for Reconcile.
+type ContainerSpec struct {
+	// Config is used to create the container if it is not supervised by the
+	// server. The ID of the config identifies the container.
+	Config *CreateContainerConfig
+}
+
+// ReconcileConfig is the configuration for calling the ReconcileWithConfig
+// method.
+type ReconcileConfig struct {
+	// Desired is the set of containers which should be supervised.
+	Desired []ContainerSpec
+
+	// StopTimeout is the duration to wait for undesired containers to exit
+	// after sending SIGTERM, before they get killed using SIGKILL.
+	StopTimeout time.Duration
+}
+
+// ReconcileReport contains the changes done by Reconcile. All container IDs
+// are sorted.
+type ReconcileReport struct {
+	// Created are the IDs of the newly created containers.
+	Created []string
+
+	// Stopped are the IDs of the containers which have been stopped, the
+	// server stops supervising them after they exited.
+	Stopped []string
+
+	// Unchanged are the IDs of the desired containers which have already
+	// been supervised.
+	Unchanged []string
+
+	// Failed contains the errors of the containers which could not be
+	// created or stopped by their IDs.
+	Failed map[string]error
+}
+
+// Reconcile compares the desired set of containers with the containers
+// supervised by the server for the tenant of the client. Missing containers
+// get created, while containers which are not desired get stopped. The
+// returned error contains all container failures, the report is available
+// even if some of the changes failed.
+func (c *ConmonClient) Reconcile(ctx context.Context, desired []ContainerSpec) (*ReconcileReport, error) {
+	return c.ReconcileWithConfig(ctx, &ReconcileConfig{Desired: desired})
+}
+
+// ReconcileWithConfig is Reconcile with additional configuration.
+func (c *ConmonClient) ReconcileWithConfig(ctx context.Context, cfg *ReconcileConfig) (*ReconcileReport, error) {
+	desired := make(map[string]*CreateContainerConfig, len(cfg.Desired))
+	for _, spec := range cfg.Desired {
+		if spec.Config == nil {
+			return nil, errSpecConfigNil
+		}
+
+		// The IDs get compared with the normalized ones of the server.
+		id, err := c.newContainerID(spec.Config.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := desired[id]; ok {
+			return nil, fmt.Errorf("%w: %s", errSpecIDDuplicate, id)
+		}
+
+		desired[id] = spec.Config
+	}
+
+	actual, err := c.ListContainers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+
+	report := &ReconcileReport{Failed: make(map[string]error)}
+	var result *multierror.Error
+
+	// Stop containers first to free resources of the tenant.
+	for _, container := range actual {
+		if _, ok := desired[container.ID]; ok {
+			report.Unchanged = append(report.Unchanged, container.ID)
+			delete(desired, container.ID)
+
+			continue
+		}
+
+		if err := c.StopContainer(ctx, &StopContainerConfig{
+			ID:      container.ID,
+			Timeout: cfg.StopTimeout,
+		}); err != nil {
+			report.Failed[container.ID] = err
+			result = multierror.Append(result, fmt.Errorf("stop container %s: %w", container.ID, err))
+
+			continue
+		}
+
+		report.Stopped = append(report.Stopped, container.ID)
+	}
+
+	ids := make([]string, 0, len(desired))
+	for id := range desired {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if _, err := c.CreateContainer(ctx, desired[id]); err != nil {
+			report.Failed[id] = err
+			result = multierror.Append(result, fmt.Errorf("create container %s: %w", id, err))
+
+			continue
+		}
+
+		report.Created = append(report.Created, id)
+	}
+
+	sort.Strings(report.Stopped)
+	sort.Strings(report.Unchanged)
+
+	return report, result.ErrorOrNil()
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"capnproto.org/go/capnp/v3/rpc"
+)
+
+const (
+	// defaultConnectBackoff is the initial delay between connection retries
+	// if ConnectBackoff is not set.
+	defaultConnectBackoff = 100 * time.Millisecond
+
+	// maxConnectBackoff is the maximum delay between connection retries.
+	maxConnectBackoff = 5 * time.Second
+)
+
+// configureRetries applies the connection retry settings of the server
+// config to the client.
+func (c *ConmonClient) configureRetries(config *ConmonServerConfig) {
+	c.connectRetries = config.ConnectRetries
+	c.connectBackoff = config.ConnectBackoff
+	if c.connectBackoff <= 0 {
+		c.connectBackoff = defaultConnectBackoff
+	}
+}
+
+// dial connects to the server socket, retrying transient failures with an
+// exponential backoff until the retries are exhausted or the context is done.
+func (c *ConmonClient) dial(ctx context.Context) (*rpc.Conn, error) {
+	backoff := c.connectBackoff
+	for retry := uint(0); ; retry++ {
+		socketConn, err := c.dialServer(ctx)
+		if err == nil {
+			return rpc.NewConn(rpc.NewStreamTransport(socketConn), nil), nil
+		}
+
+		if retry >= c.connectRetries || !isTransientDialError(err) {
+			return nil, fmt.Errorf("dial long socket: %w", err)
+		}
+
+		c.logger.Debugf("Unable to connect to server, retrying in %v: %v", backoff, err)
+		c.metrics.countConnectRetry()
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("dial long socket: %w", ctx.Err())
+		case <-time.After(backoff):
+		}
+
+		if backoff *= 2; backoff > maxConnectBackoff {
+			backoff = maxConnectBackoff
+		}
+	}
+}
+
+// isTransientDialError returns true if the server socket is unavailable,
+// for example because the server is being restarted.
+func isTransientDialError(err error) bool {
+	for _, transient := range transientDialErrors {
+		if errors.Is(err, transient) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reconnect re-establishes the connection to the server, retrying as
+// configured via ConnectRetries, and re-validates the server PID. The PID
+// returned by PID gets updated if the server has been restarted in the
+// meantime, which can be detected by comparing it before and after calling
+// Reconnect.
+func (c *ConmonClient) Reconnect(ctx context.Context) error {
+	// Wait for the server socket to become available again.
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	conn.Close()
+
+	// The persistent connections are likely broken if the server restarted.
+	if err := c.CloseConnections(); err != nil {
+		c.logger.Debugf("Unable to close connections: %v", err)
+	}
+
+	resp, err := c.Version(ctx)
+	if err != nil {
+		return fmt.Errorf("validate server: %w", err)
+	}
+
+	if err := c.negotiateVersion(resp); err != nil {
+		return fmt.Errorf("negotiate version: %w", err)
+	}
+
+	if pid := c.PID(); pid != resp.ProcessID {
+		c.logger.Warnf("Server PID changed from %d to %d", pid, resp.ProcessID)
+		atomic.StoreUint32(&c.serverPID, resp.ProcessID)
+	}
+
+	c.metrics.countReconnect()
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"path/filepath"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+const (
+	fdSocketName = "conmon-fd.sock"
+
+	// maxFDs is the maximum number of file descriptors which can be sent at
+	// once, which is SCM_MAX_FD on Linux.
+	maxFDs = 253
+
+	// slotSize is the size of a RemoteFD in the server response.
+	slotSize = 8
+)
+
+var errTooManyFDs = errors.New("too many file descriptors")
+
+// RemoteFD is a file descriptor which has been sent to the server. It can be
+// referenced in requests like CreateContainer.
+type RemoteFD uint64
+
+// RemoteFDs is a connection to the server for sending file descriptors. The
+// server keeps the sent file descriptors until they are used by a request
+// and closes the unused ones when the connection gets closed, which means
+// that the connection has to be kept open until the requests using them
+// returned.
+type RemoteFDs struct {
+	mu   sync.Mutex
+	conn *net.UnixConn
+}
+
+// NewRemoteFDs connects to the server for sending file descriptors.
+func (c *ConmonClient) NewRemoteFDs() (*RemoteFDs, error) {
+	conn, err := DialLongSocket("unix", c.fdSocket())
+	if err != nil {
+		return nil, fmt.Errorf("dial fd socket: %w", err)
+	}
+
+	return &RemoteFDs{conn: conn}, nil
+}
+
+// Send sends the file descriptors to the server and returns their remote
+// counterparts in the same order. The file descriptors are duplicated by the
+// server and can be closed by the caller afterwards.
+func (r *RemoteFDs) Send(fds ...int) ([]RemoteFD, error) {
+	if len(fds) == 0 {
+		return []RemoteFD{}, nil
+	}
+
+	if len(fds) > maxFDs {
+		return nil, fmt.Errorf("%w: %d exceeds the maximum of %d", errTooManyFDs, len(fds), maxFDs)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rights, err := unixRights(fds...)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := r.conn.WriteMsgUnix([]byte{0}, rights, nil); err != nil {
+		return nil, fmt.Errorf("send file descriptors: %w", err)
+	}
+
+	buf := make([]byte, len(fds)*slotSize)
+	if _, err := io.ReadFull(r.conn, buf); err != nil {
+		return nil, fmt.Errorf("read remote file descriptors: %w", err)
+	}
+
+	remoteFDs := make([]RemoteFD, 0, len(fds))
+	for i := 0; i < len(buf); i += slotSize {
+		remoteFDs = append(remoteFDs, RemoteFD(binary.LittleEndian.Uint64(buf[i:])))
+	}
+
+	return remoteFDs, nil
+}
+
+// Close closes the connection to the server, which closes all sent file
+// descriptors which have not been used yet.
+func (r *RemoteFDs) Close() error {
+	if err := r.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		return fmt.Errorf("close fd socket: %w", err)
+	}
+
+	return nil
+}
+
+func remoteFDSliceToUInt64List(src []RemoteFD, newFunc func(int32) (capnp.UInt64List, error)) error {
+	l := int32(len(src))
+	if l == 0 {
+		return nil
+	}
+	list, err := newFunc(l)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(src); i++ {
+		list.Set(i, uint64(src[i]))
+	}
+
+	return nil
+}
+
+func (c *ConmonClient) fdSocket() string {
+	return filepath.Join(c.runDir, fdSocketName)
+}
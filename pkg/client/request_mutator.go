@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// RequestMutator modifies the configuration of a request before it gets
+// validated and sent to the server, which allows injecting defaults like log
+// drivers, the cgroup manager or annotations in a single place. The req is a
+// pointer to a copy of the configuration passed to the client method, for
+// example a *CreateContainerConfig for CreateContainer. The copy is shallow,
+// which means that maps and slices have to be replaced instead of modified to
+// keep the configuration of the caller unchanged. Returning an error aborts
+// the request.
+type RequestMutator func(ctx context.Context, req interface{}) error
+
+// mutateRequest applies the RequestMutator of the client to a copy of cfg.
+func mutateRequest[T any](ctx context.Context, c *ConmonClient, cfg *T) (*T, error) {
+	if c.requestMutator == nil || cfg == nil {
+		return cfg, nil
+	}
+
+	mutated := *cfg
+	if err := c.requestMutator(ctx, &mutated); err != nil {
+		return nil, fmt.Errorf("mutate request: %w", err)
+	}
+
+	return &mutated, nil
+}
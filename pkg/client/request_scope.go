@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// cancelRequestTimeout is the time to cancel a request on the server after
+// its context is done.
+const cancelRequestTimeout = 5 * time.Second
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context which assigns the ID to the cancellable
+// requests made with it, which are CreateContainer, RestoreContainer and
+// ExecSyncContainer. The ID allows cancelling the request via CancelRequest,
+// also from another client of the same tenant. It has to be unique among the
+// in-flight requests of the tenant. Requests get a random ID if the context
+// does not assign one.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID assigned by WithRequestID.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+
+	return id, ok
+}
+
+// requestID returns the ID of a cancellable request made with the context.
+func requestID(ctx context.Context) string {
+	if id, ok := RequestIDFromContext(ctx); ok && id != "" {
+		return id
+	}
+
+	return randomID()[:16]
+}
+
+// initRequestScope sets the ID and the deadline of the context in the scope
+// of a request, which makes the server abort the request once the deadline
+// expires.
+func initRequestScope(
+	ctx context.Context, id string, newScope func() (proto.Conmon_RequestScope, error),
+) error {
+	scope, err := newScope()
+	if err != nil {
+		return fmt.Errorf("create request scope: %w", err)
+	}
+
+	if err := scope.SetRequestId(id); err != nil {
+		return fmt.Errorf("set request ID: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		scope.SetDeadlineUnixNano(deadline.UnixNano())
+	}
+
+	return nil
+}
+
+// cancelOnDone cancels the request on the server if the context is done
+// before the returned function gets called, because the server would
+// otherwise continue the request until its deadline, if any.
+func (c *ConmonClient) cancelOnDone(ctx context.Context, id string) (stop func()) {
+	done := make(chan struct{})
+
+	go func() {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+		}
+
+		// nolint:contextcheck // the context of the request is done already
+		cancelCtx, cancel := context.WithTimeout(context.Background(), cancelRequestTimeout)
+		defer cancel()
+
+		if _, err := c.CancelRequest(cancelCtx, id); err != nil {
+			c.logger.Debugf("Unable to cancel request %s: %v", id, err)
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+// CancelRequest cancels the in-flight request with the ID assigned via
+// WithRequestID, which fails with ErrCancelled. The server aborts the work
+// of the request, for example by killing a hanging runtime, and cleans up
+// after it. It returns false if no such request is in flight.
+func (c *ConmonClient) CancelRequest(ctx context.Context, requestID string) (bool, error) {
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("CancelRequest")()
+	diag := c.diagnoseRPC("CancelRequest")
+
+	future, free := client.CancelRequest(ctx, func(p proto.Conmon_cancelRequest_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetRequestId(requestID); err != nil {
+			return fmt.Errorf("set request ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return false, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return false, fmt.Errorf("set response: %w", err)
+	}
+
+	return response.Cancelled(), nil
+}
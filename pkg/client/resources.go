@@ -0,0 +1,390 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/containers/conmon-rs/internal/proto"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// cgroupRoot is the mount point of the cgroup file systems.
+const cgroupRoot = "/sys/fs/cgroup"
+
+var (
+	errSwapWithoutLimit     = errors.New("memory swap requires a memory limit")
+	errSwapBelowLimit       = errors.New("memory swap must not be lower than the memory limit")
+	errUnifiedOnCgroupV1    = errors.New("unified resources require cgroup v2")
+	errUnknownCgroupVersion = errors.New("unknown cgroup version")
+)
+
+// CgroupVersion is the version of the cgroup hierarchy of the host.
+type CgroupVersion int
+
+const (
+	// CgroupVersionAuto detects the version of the host the client runs on,
+	// which is the host of the server.
+	CgroupVersionAuto CgroupVersion = iota
+
+	// CgroupVersionV1 are the per controller hierarchies of cgroup v1.
+	CgroupVersionV1
+
+	// CgroupVersionV2 is the unified hierarchy of cgroup v2.
+	CgroupVersionV2
+)
+
+// CgroupValue is a value of a cgroup interface file.
+type CgroupValue struct {
+	// Controller is the cgroup v1 controller of the file, empty for cgroup
+	// v2.
+	Controller string
+
+	// File is the name of the interface file, like memory.max.
+	File string
+
+	// Value is written to the file.
+	Value string
+}
+
+var (
+	detectedCgroupVersion    CgroupVersion
+	detectedCgroupVersionErr error
+	detectCgroupVersionOnce  sync.Once
+)
+
+// detectCgroupVersion returns the cgroup version of the host.
+func detectCgroupVersion() (CgroupVersion, error) {
+	detectCgroupVersionOnce.Do(func() {
+		detectedCgroupVersion, detectedCgroupVersionErr = cgroupVersionOf(cgroupRoot)
+	})
+
+	return detectedCgroupVersion, detectedCgroupVersionErr
+}
+
+// CgroupValues translates the resources into the values of the cgroup
+// interface files of the version, matching the conversions of the OCI
+// runtimes. Unset resources are skipped.
+func CgroupValues(resources *specs.LinuxResources, version CgroupVersion) ([]CgroupValue, error) {
+	if version == CgroupVersionAuto {
+		detected, err := detectCgroupVersion()
+		if err != nil {
+			return nil, err
+		}
+		version = detected
+	}
+
+	if resources == nil {
+		return nil, nil
+	}
+
+	switch version {
+	case CgroupVersionV1:
+		return cgroupValuesV1(resources)
+	case CgroupVersionV2:
+		return cgroupValuesV2(resources)
+	case CgroupVersionAuto:
+		fallthrough
+	default:
+		return nil, fmt.Errorf("%w: %d", errUnknownCgroupVersion, version)
+	}
+}
+
+func cgroupValuesV1(resources *specs.LinuxResources) ([]CgroupValue, error) {
+	if len(resources.Unified) > 0 {
+		return nil, errUnifiedOnCgroupV1
+	}
+
+	values := []CgroupValue{}
+	add := func(controller, file, value string) {
+		values = append(values, CgroupValue{Controller: controller, File: file, Value: value})
+	}
+
+	if cpu := resources.CPU; cpu != nil {
+		if cpu.Shares != nil && *cpu.Shares != 0 {
+			add("cpu", "cpu.shares", strconv.FormatUint(*cpu.Shares, 10))
+		}
+		if cpu.Period != nil && *cpu.Period != 0 {
+			add("cpu", "cpu.cfs_period_us", strconv.FormatUint(*cpu.Period, 10))
+		}
+		if cpu.Quota != nil && *cpu.Quota != 0 {
+			add("cpu", "cpu.cfs_quota_us", strconv.FormatInt(*cpu.Quota, 10))
+		}
+		if cpu.Cpus != "" {
+			add("cpuset", "cpuset.cpus", cpu.Cpus)
+		}
+		if cpu.Mems != "" {
+			add("cpuset", "cpuset.mems", cpu.Mems)
+		}
+	}
+
+	if memory := resources.Memory; memory != nil {
+		// The server retries writes which fail because of the order of the
+		// memory and swap limits.
+		if memory.Limit != nil && *memory.Limit != 0 {
+			add("memory", "memory.limit_in_bytes", strconv.FormatInt(*memory.Limit, 10))
+		}
+		if memory.Swap != nil && *memory.Swap != 0 {
+			add("memory", "memory.memsw.limit_in_bytes", strconv.FormatInt(*memory.Swap, 10))
+		}
+		if memory.Reservation != nil && *memory.Reservation != 0 {
+			add("memory", "memory.soft_limit_in_bytes", strconv.FormatInt(*memory.Reservation, 10))
+		}
+		if memory.Swappiness != nil {
+			add("memory", "memory.swappiness", strconv.FormatUint(*memory.Swappiness, 10))
+		}
+	}
+
+	if resources.Pids != nil {
+		add("pids", "pids.max", pidsLimit(resources.Pids.Limit))
+	}
+
+	for _, limit := range resources.HugepageLimits {
+		add("hugetlb", "hugetlb."+limit.Pagesize+".limit_in_bytes", strconv.FormatUint(limit.Limit, 10))
+	}
+
+	return values, nil
+}
+
+func cgroupValuesV2(resources *specs.LinuxResources) ([]CgroupValue, error) {
+	values := []CgroupValue{}
+	add := func(file, value string) {
+		values = append(values, CgroupValue{File: file, Value: value})
+	}
+
+	if cpu := resources.CPU; cpu != nil {
+		if cpu.Shares != nil && *cpu.Shares != 0 {
+			add("cpu.weight", strconv.FormatUint(cpuSharesToWeight(*cpu.Shares), 10))
+		}
+		if value := cpuMax(cpu); value != "" {
+			add("cpu.max", value)
+		}
+		if cpu.Cpus != "" {
+			add("cpuset.cpus", cpu.Cpus)
+		}
+		if cpu.Mems != "" {
+			add("cpuset.mems", cpu.Mems)
+		}
+	}
+
+	if memory := resources.Memory; memory != nil {
+		if memory.Limit != nil && *memory.Limit != 0 {
+			add("memory.max", memoryLimit(*memory.Limit))
+		}
+		if memory.Swap != nil && *memory.Swap != 0 {
+			swap, err := memorySwapV2(*memory.Swap, memory.Limit)
+			if err != nil {
+				return nil, err
+			}
+			add("memory.swap.max", swap)
+		}
+		if memory.Reservation != nil && *memory.Reservation != 0 {
+			add("memory.low", strconv.FormatInt(*memory.Reservation, 10))
+		}
+	}
+
+	if resources.Pids != nil {
+		add("pids.max", pidsLimit(resources.Pids.Limit))
+	}
+
+	for _, limit := range resources.HugepageLimits {
+		add("hugetlb."+limit.Pagesize+".max", strconv.FormatUint(limit.Limit, 10))
+	}
+
+	files := make([]string, 0, len(resources.Unified))
+	for file := range resources.Unified {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		add(file, resources.Unified[file])
+	}
+
+	return values, nil
+}
+
+// cpuSharesToWeight converts the cgroup v1 CPU shares from [2-262144] to the
+// cgroup v2 CPU weight from [1-10000].
+func cpuSharesToWeight(shares uint64) uint64 {
+	const (
+		minShares = 2
+		maxShares = 262144
+		maxWeight = 10000
+	)
+
+	if shares < minShares {
+		shares = minShares
+	}
+	if shares > maxShares {
+		shares = maxShares
+	}
+
+	return 1 + ((shares-minShares)*(maxWeight-1))/(maxShares-minShares)
+}
+
+// cpuMax returns the cgroup v2 cpu.max value of the CFS quota and period,
+// empty if neither is set.
+func cpuMax(cpu *specs.LinuxCPU) string {
+	var quota int64
+	var period uint64
+	if cpu.Quota != nil {
+		quota = *cpu.Quota
+	}
+	if cpu.Period != nil {
+		period = *cpu.Period
+	}
+
+	if quota == 0 && period == 0 {
+		return ""
+	}
+
+	value := "max"
+	if quota > 0 {
+		value = strconv.FormatInt(quota, 10)
+	}
+	if period != 0 {
+		value += " " + strconv.FormatUint(period, 10)
+	}
+
+	return value
+}
+
+// memoryLimit returns the cgroup v2 value of a memory limit, where negative
+// limits are unlimited.
+func memoryLimit(limit int64) string {
+	if limit < 0 {
+		return "max"
+	}
+
+	return strconv.FormatInt(limit, 10)
+}
+
+// memorySwapV2 converts the combined memory and swap limit of cgroup v1 into
+// the swap only limit of cgroup v2.
+func memorySwapV2(swap int64, limit *int64) (string, error) {
+	if swap < 0 {
+		return "max", nil
+	}
+
+	if limit == nil || *limit == 0 {
+		return "", errSwapWithoutLimit
+	}
+
+	if *limit < 0 {
+		return "max", nil
+	}
+
+	if swap < *limit {
+		return "", errSwapBelowLimit
+	}
+
+	return strconv.FormatInt(swap-*limit, 10), nil
+}
+
+// pidsLimit returns the value of a PIDs limit, where zero and negative limits
+// are unlimited.
+func pidsLimit(limit int64) string {
+	if limit <= 0 {
+		return "max"
+	}
+
+	return strconv.FormatInt(limit, 10)
+}
+
+// UpdateContainerResources changes the cgroup resources of a running
+// container like CRI UpdateContainerResources. The resources get translated
+// into the cgroup values of the host, see CgroupValues, which the server
+// writes to the cgroups of the container. Unset resources are not changed.
+func (c *ConmonClient) UpdateContainerResources(
+	ctx context.Context, id string, resources *specs.LinuxResources,
+) error {
+	values, err := CgroupValues(resources, CgroupVersionAuto)
+	if err != nil {
+		return err
+	}
+
+	return c.UpdateContainerCgroupValues(ctx, id, values)
+}
+
+// UpdateContainerCgroupValues writes the values to the cgroups of a running
+// container in order. Writes which fail get retried once after all others,
+// which resolves the ordering constraints between limits. The cgroup core
+// files, like cgroup.procs, cannot be written.
+func (c *ConmonClient) UpdateContainerCgroupValues(ctx context.Context, id string, values []CgroupValue) error {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("UpdateContainerResources")()
+	diag := c.diagnoseRPC("UpdateContainerResources")
+	future, free := client.UpdateContainerResources(ctx, func(p proto.Conmon_updateContainerResources_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := initCgroupValues(req, values); err != nil {
+			return err
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	return responseError(response)
+}
+
+func initCgroupValues(req proto.Conmon_UpdateContainerResourcesRequest, values []CgroupValue) error {
+	list, err := req.NewValues(int32(len(values)))
+	if err != nil {
+		return fmt.Errorf("create values: %w", err)
+	}
+
+	for i, value := range values {
+		v := list.At(i)
+		if err := v.SetController(value.Controller); err != nil {
+			return fmt.Errorf("set controller: %w", err)
+		}
+
+		if err := v.SetFile(value.File); err != nil {
+			return fmt.Errorf("set file: %w", err)
+		}
+
+		if err := v.SetValue(value.Value); err != nil {
+			return fmt.Errorf("set value: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+
+	"capnproto.org/go/capnp/v3"
+)
+
+// RPCDiagnostics describes the Cap'n Proto messages exchanged by a single
+// RPC, for example to identify oversized requests.
+type RPCDiagnostics struct {
+	// Method is the name of the client method, for example
+	// "CreateContainer".
+	Method string
+
+	// Request describes the message of the call. It is empty if the call
+	// has no parameters or they could not be built.
+	Request MessageStats
+
+	// Response describes the message of the return. It is empty if the RPC
+	// failed.
+	Response MessageStats
+
+	// Err is the error of the RPC, if any. Errors reported by the server in
+	// the structured error field of a response are not included.
+	Err error
+}
+
+// MessageStats describes a Cap'n Proto message including the RPC protocol
+// envelope, which is the size on the wire without TLS.
+type MessageStats struct {
+	// EncodedBytes is the size of the message in the stream encoding.
+	EncodedBytes uint64
+
+	// Segments is the number of segments of the message.
+	Segments int64
+
+	// AllocBytes is the size of the buffers allocated for the segments,
+	// which exceeds the used bytes by the unused capacity.
+	AllocBytes uint64
+}
+
+// RPCInterceptor observes the RPCs of the client. It can be set in the
+// ConmonServerConfig.
+type RPCInterceptor interface {
+	// InterceptRPC gets called with the diagnostics of every RPC once its
+	// response arrived, before it gets decoded. It must not block.
+	InterceptRPC(ctx context.Context, diagnostics *RPCDiagnostics)
+}
+
+// RPCInterceptorFunc is an adapter to use a function as RPCInterceptor.
+type RPCInterceptorFunc func(ctx context.Context, diagnostics *RPCDiagnostics)
+
+// InterceptRPC calls the function.
+func (f RPCInterceptorFunc) InterceptRPC(ctx context.Context, diagnostics *RPCDiagnostics) {
+	f(ctx, diagnostics)
+}
+
+// capnpStruct is a struct within a Cap'n Proto message, which is implemented
+// by all generated types.
+type capnpStruct interface {
+	Segment() *capnp.Segment
+}
+
+// rpcDiagnosis records the diagnostics of an RPC. All methods do nothing if
+// it is nil, which is the case if the client has no RPCInterceptor.
+type rpcDiagnosis struct {
+	interceptor RPCInterceptor
+	diagnostics RPCDiagnostics
+}
+
+// diagnoseRPC starts recording the diagnostics of an RPC.
+func (c *ConmonClient) diagnoseRPC(method string) *rpcDiagnosis {
+	if c.rpcInterceptor == nil {
+		return nil
+	}
+
+	return &rpcDiagnosis{
+		interceptor: c.rpcInterceptor,
+		diagnostics: RPCDiagnostics{Method: method},
+	}
+}
+
+// request records the message of the call once its parameters are built.
+func (d *rpcDiagnosis) request(params capnpStruct) {
+	if d != nil {
+		d.diagnostics.Request = messageStats(params)
+	}
+}
+
+// report records the message of the return and passes the diagnostics to
+// the interceptor.
+func (d *rpcDiagnosis) report(ctx context.Context, results capnpStruct, err error) {
+	if d == nil {
+		return
+	}
+
+	d.diagnostics.Err = err
+	if err == nil {
+		d.diagnostics.Response = messageStats(results)
+	}
+
+	d.interceptor.InterceptRPC(ctx, &d.diagnostics)
+}
+
+// messageStats describes the message containing the struct.
+func messageStats(s capnpStruct) MessageStats {
+	seg := s.Segment()
+	if seg == nil || seg.Message() == nil {
+		return MessageStats{}
+	}
+
+	msg := seg.Message()
+	stats := MessageStats{Segments: msg.NumSegments()}
+
+	// The stream encoding starts with the segment count and sizes, padded to
+	// a multiple of eight bytes.
+	const wordSize = 8
+	header := uint64(4 * (stats.Segments + 1))
+	stats.EncodedBytes = (header + wordSize - 1) / wordSize * wordSize
+
+	for i := int64(0); i < stats.Segments; i++ {
+		seg, err := msg.Segment(capnp.SegmentID(i))
+		if err != nil {
+			break
+		}
+
+		data := seg.Data()
+		stats.EncodedBytes += uint64(len(data))
+		stats.AllocBytes += uint64(cap(data))
+	}
+
+	return stats
+}
@@ -0,0 +1,256 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+var (
+	// ErrContainerNotFound is matched by every RPCError of kind
+	// ErrorKindNotFound.
+	ErrContainerNotFound = errors.New("container not found")
+
+	// ErrAlreadyExists is matched by every RPCError of kind
+	// ErrorKindAlreadyExists.
+	ErrAlreadyExists = errors.New("container already exists")
+
+	// ErrRuntimeFailure is matched by every RPCError of kind
+	// ErrorKindRuntimeFailure.
+	ErrRuntimeFailure = errors.New("runtime failure")
+
+	// ErrTimeout is matched by every RPCError of kind ErrorKindTimeout.
+	ErrTimeout = errors.New("operation timed out")
+
+	// ErrCancelled is matched by every RPCError of kind ErrorKindCancelled.
+	ErrCancelled = errors.New("request cancelled")
+
+	// ErrOperationInProgress is matched by every RPCError of kind
+	// ErrorKindOperationInProgress.
+	ErrOperationInProgress = errors.New("operation in progress")
+
+	// ErrTTYMismatch is matched by every RPCError of kind
+	// ErrorKindTerminalMismatch.
+	ErrTTYMismatch = errors.New("terminal mismatch")
+)
+
+// ErrorKind specifies the kind of an RPCError.
+type ErrorKind int
+
+const (
+	// ErrorKindUnknown indicates that the server did not classify the error.
+	ErrorKindUnknown ErrorKind = iota
+
+	// ErrorKindNotFound indicates that the container or exec session does
+	// not exist.
+	ErrorKindNotFound
+
+	// ErrorKindAlreadyExists indicates that the container already exists.
+	ErrorKindAlreadyExists
+
+	// ErrorKindRuntimeFailure indicates that the OCI runtime failed.
+	ErrorKindRuntimeFailure
+
+	// ErrorKindTimeout indicates that the operation did not complete in time.
+	ErrorKindTimeout
+
+	// ErrorKindCancelled indicates that the request got cancelled by the
+	// client or its deadline, see CancelRequest.
+	ErrorKindCancelled
+
+	// ErrorKindOperationInProgress indicates that the container is locked by
+	// a conflicting operation, see ConmonServerConfig.OperationLocks.
+	ErrorKindOperationInProgress
+
+	// ErrorKindTerminalMismatch indicates that the terminal of the container
+	// or exec session differs from the request, see AttachConfig.StrictTTY.
+	ErrorKindTerminalMismatch
+)
+
+// String returns the name of the error kind.
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorKindUnknown:
+		return "unknown"
+	case ErrorKindNotFound:
+		return "notFound"
+	case ErrorKindAlreadyExists:
+		return "alreadyExists"
+	case ErrorKindRuntimeFailure:
+		return "runtimeFailure"
+	case ErrorKindTimeout:
+		return "timeout"
+	case ErrorKindCancelled:
+		return "cancelled"
+	case ErrorKindOperationInProgress:
+		return "operationInProgress"
+	case ErrorKindTerminalMismatch:
+		return "terminalMismatch"
+	}
+
+	return fmt.Sprintf("unknown(%d)", int(k))
+}
+
+// RejectionReason is the machine readable reason of a runtime failure.
+type RejectionReason int
+
+const (
+	// RejectionReasonUnspecified indicates that the failure is not known.
+	RejectionReasonUnspecified RejectionReason = iota
+
+	// RejectionReasonCgroupControllerNotDelegated indicates that a cgroup
+	// controller is not delegated to the user of the server.
+	RejectionReasonCgroupControllerNotDelegated
+
+	// RejectionReasonIDMappingUnavailable indicates that the user namespace
+	// ID mappings cannot be set up.
+	RejectionReasonIDMappingUnavailable
+
+	// RejectionReasonExecutableNotFound indicates that the process of the
+	// container cannot be executed.
+	RejectionReasonExecutableNotFound
+
+	// RejectionReasonBundleInvalid indicates that the bundle does not
+	// contain a valid configuration.
+	RejectionReasonBundleInvalid
+
+	// RejectionReasonPermissionDenied indicates that the runtime lacks the
+	// permission for an operation.
+	RejectionReasonPermissionDenied
+)
+
+// String returns the name of the rejection reason.
+func (r RejectionReason) String() string {
+	switch r {
+	case RejectionReasonUnspecified:
+		return "unspecified"
+	case RejectionReasonCgroupControllerNotDelegated:
+		return "cgroupControllerNotDelegated"
+	case RejectionReasonIDMappingUnavailable:
+		return "idMappingUnavailable"
+	case RejectionReasonExecutableNotFound:
+		return "executableNotFound"
+	case RejectionReasonBundleInvalid:
+		return "bundleInvalid"
+	case RejectionReasonPermissionDenied:
+		return "permissionDenied"
+	}
+
+	return fmt.Sprintf("unknown(%d)", int(r))
+}
+
+// RPCError is returned if the server reported a failed request in the
+// structured error field of the response. It matches the sentinel error of
+// its kind, for example ErrContainerNotFound.
+type RPCError struct {
+	// Kind of the error.
+	Kind ErrorKind
+
+	// Message is the error message of the server.
+	Message string
+
+	// RuntimeStderr is the error output of the runtime, only set for errors
+	// of kind ErrorKindRuntimeFailure if it has been captured.
+	RuntimeStderr string
+
+	// Reason is the classified runtime failure, only set for errors of kind
+	// ErrorKindRuntimeFailure if the server recognized the failure.
+	Reason RejectionReason
+
+	// Hint is the human readable remediation of the runtime failure, set
+	// together with the Reason.
+	Hint string
+
+	// RuntimeLog is the log of the runtime including its debug messages,
+	// only set for errors of kind ErrorKindRuntimeFailure if RuntimeDebug
+	// has been requested. It is limited to the last 64 KiB.
+	RuntimeLog string
+
+	// Operation is the conflicting operation holding the lock of the
+	// container, like "stop" or "exec", only set for errors of kind
+	// ErrorKindOperationInProgress.
+	Operation string
+}
+
+// Error returns the error message of the server.
+func (e *RPCError) Error() string {
+	return e.Message
+}
+
+// Is returns true if the target is the sentinel error of the kind.
+func (e *RPCError) Is(target error) bool {
+	switch e.Kind {
+	case ErrorKindNotFound:
+		return target == ErrContainerNotFound
+	case ErrorKindAlreadyExists:
+		return target == ErrAlreadyExists
+	case ErrorKindRuntimeFailure:
+		return target == ErrRuntimeFailure
+	case ErrorKindTimeout:
+		return target == ErrTimeout
+	case ErrorKindCancelled:
+		return target == ErrCancelled
+	case ErrorKindOperationInProgress:
+		return target == ErrOperationInProgress
+	case ErrorKindTerminalMismatch:
+		return target == ErrTTYMismatch
+	case ErrorKindUnknown:
+	}
+
+	return false
+}
+
+// errorResponse is a response with the structured error field.
+type errorResponse interface {
+	HasError() bool
+	Error() (proto.Conmon_ErrorInfo, error)
+}
+
+// responseError decodes the structured error field of the response, which
+// is nil if the request succeeded.
+func responseError(response errorResponse) error {
+	if !response.HasError() {
+		return nil
+	}
+
+	info, err := response.Error()
+	if err != nil {
+		return fmt.Errorf("get error: %w", err)
+	}
+
+	message, err := info.Message()
+	if err != nil {
+		return fmt.Errorf("get error message: %w", err)
+	}
+
+	stderr, err := info.RuntimeStderr()
+	if err != nil {
+		return fmt.Errorf("get runtime stderr: %w", err)
+	}
+
+	hint, err := info.Hint()
+	if err != nil {
+		return fmt.Errorf("get hint: %w", err)
+	}
+
+	runtimeLog, err := info.RuntimeLog()
+	if err != nil {
+		return fmt.Errorf("get runtime log: %w", err)
+	}
+
+	operation, err := info.Operation()
+	if err != nil {
+		return fmt.Errorf("get operation: %w", err)
+	}
+
+	return &RPCError{
+		Kind:          ErrorKind(info.Kind()),
+		Message:       message,
+		RuntimeStderr: stderr,
+		Reason:        RejectionReason(info.Reason()),
+		Hint:          hint,
+		RuntimeLog:    runtimeLog,
+		Operation:     operation,
+	}
+}
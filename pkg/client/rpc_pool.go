@@ -0,0 +1,187 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"capnproto.org/go/capnp/v3"
+	"capnproto.org/go/capnp/v3/rpc"
+	"github.com/hashicorp/go-multierror"
+)
+
+// rpcConn is the connection of a single call, which is either dialed for the
+// call or shared via the connection pool. Closing it releases the
+// capabilities bootstrapped for the call and closes the connection unless it
+// is shared.
+type rpcConn struct {
+	*rpc.Conn
+	shared bool
+
+	mu      sync.Mutex
+	release []capnp.ReleaseFunc
+}
+
+// onClose registers a function which gets called on Close. The functions
+// get called in the reverse order of their registration.
+func (r *rpcConn) onClose(release capnp.ReleaseFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.release = append(r.release, release)
+}
+
+// Close releases the capabilities of the call and closes the connection if
+// it is not shared.
+func (r *rpcConn) Close() error {
+	r.mu.Lock()
+	release := r.release
+	r.release = nil
+	r.mu.Unlock()
+
+	for i := len(release) - 1; i >= 0; i-- {
+		release[i]()
+	}
+
+	if r.shared {
+		return nil
+	}
+
+	// nolint:wrapcheck // keep the io.Closer semantics
+	return r.Conn.Close()
+}
+
+// rpcPool holds persistent connections to the server, which are shared
+// between calls in turn. Concurrent calls are multiplexed over a connection.
+type rpcPool struct {
+	mu    sync.Mutex
+	conns []*rpc.Conn
+	next  int
+}
+
+func newRPCPool(size int) *rpcPool {
+	if size <= 0 {
+		return nil
+	}
+
+	return &rpcPool{conns: make([]*rpc.Conn, size)}
+}
+
+// get returns the next connection of the pool, which gets dialed if it is
+// not established yet or broke. The pool is not locked while dialing, to not
+// block the calls using the other connections.
+func (p *rpcPool) get(ctx context.Context, dial func(context.Context) (*rpc.Conn, error)) (*rpc.Conn, error) {
+	p.mu.Lock()
+	i := p.next
+	p.next = (p.next + 1) % len(p.conns)
+	conn := p.established(i)
+	p.mu.Unlock()
+
+	if conn != nil {
+		return conn, nil
+	}
+
+	conn, err := dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Another call may have established the connection in the meantime.
+	if established := p.established(i); established != nil {
+		conn.Close()
+
+		return established, nil
+	}
+	p.conns[i] = conn
+
+	return conn, nil
+}
+
+// established returns the connection at the index if it is usable, the mutex
+// has to be held. Broken connections get closed and removed.
+func (p *rpcPool) established(i int) *rpc.Conn {
+	conn := p.conns[i]
+	if conn == nil {
+		return nil
+	}
+
+	select {
+	case <-conn.Done():
+		conn.Close()
+		p.conns[i] = nil
+
+		return nil
+	default:
+		return conn
+	}
+}
+
+// close closes all connections of the pool, which get dialed again on their
+// next use.
+func (p *rpcPool) close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var result *multierror.Error
+	for i, conn := range p.conns {
+		if conn == nil {
+			continue
+		}
+
+		if err := conn.Close(); err != nil {
+			result = multierror.Append(result, err)
+		}
+		p.conns[i] = nil
+	}
+
+	return result.ErrorOrNil()
+}
+
+// newRPCConn returns a connection for a single call. It is shared via the
+// connection pool if the client has one.
+func (c *ConmonClient) newRPCConn(ctx context.Context) (*rpcConn, error) {
+	if c.rpcPool == nil {
+		return c.newDedicatedRPCConn(ctx)
+	}
+
+	if err := c.state.checkOpen(); err != nil {
+		return nil, err
+	}
+
+	conn, err := c.rpcPool.get(ctx, c.dial)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcConn{Conn: conn, shared: true}, nil
+}
+
+// newDedicatedRPCConn dials a connection for a single call, which is
+// required by calls exporting capabilities to the server. Closing the
+// connection lets the server know that the capabilities are gone.
+func (c *ConmonClient) newDedicatedRPCConn(ctx context.Context) (*rpcConn, error) {
+	if err := c.state.checkOpen(); err != nil {
+		return nil, err
+	}
+
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcConn{Conn: conn}, nil
+}
+
+// CloseConnections closes the persistent connections of the client to the
+// server, which get established again by the next call. It is a no-op if the
+// client has no RPCConnectionPoolSize configured. The connections are shared
+// with all clients returned by WithTenant.
+func (c *ConmonClient) CloseConnections() error {
+	if c.rpcPool == nil {
+		return nil
+	}
+
+	return c.rpcPool.close()
+}
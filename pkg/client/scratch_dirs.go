@@ -0,0 +1,128 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// scratchDirsProtocolVersion is the first protocol version of servers
+// supporting scratch directories.
+const scratchDirsProtocolVersion uint32 = 2
+
+var (
+	// ErrScratchDirsUnsupported is returned if the server does not support
+	// CreateContainerConfig.ScratchDirs.
+	ErrScratchDirsUnsupported = errors.New("server does not support scratch directories")
+
+	errScratchDirName = errors.New("invalid scratch dir name")
+	errScratchDirSize = errors.New("scratch dir size has to be positive")
+	errScratchDirDest = errors.New("scratch dir destination has to be absolute")
+)
+
+// ScratchDir is a size limited tmpfs directory, which the server creates for
+// a container and removes once the container exits. Mounting the tmpfs
+// requires CAP_SYS_ADMIN, so rootless servers fail to create the container
+// unless they run within their own user and mount namespace.
+type ScratchDir struct {
+	// Name of the directory within the "conmon-scratch" directory of the
+	// bundle, which has to be unique per container.
+	Name string
+
+	// SizeBytes is the size limit of the tmpfs.
+	SizeBytes uint64
+
+	// Destination is the absolute path of the bind mount inside the
+	// container. The server passes a copy of the config.json of the bundle
+	// including the mount to the runtime, so the bundle itself stays
+	// untouched. The directory does not get mounted into the container if
+	// it is empty.
+	Destination string
+}
+
+// validateScratchDirs checks the scratch directories and whether the server
+// supports them, before anything gets created.
+func (c *ConmonClient) validateScratchDirs(dirs []ScratchDir) error {
+	if len(dirs) == 0 {
+		return nil
+	}
+
+	if c.NegotiatedProtocolVersion() < scratchDirsProtocolVersion {
+		return ErrScratchDirsUnsupported
+	}
+
+	names := make(map[string]struct{}, len(dirs))
+	for _, dir := range dirs {
+		if dir.Name == "" || dir.Name == "." || dir.Name == ".." || strings.Contains(dir.Name, "/") {
+			return fmt.Errorf("%w: %q", errScratchDirName, dir.Name)
+		}
+		if _, ok := names[dir.Name]; ok {
+			return fmt.Errorf("%w: duplicate %q", errScratchDirName, dir.Name)
+		}
+		names[dir.Name] = struct{}{}
+
+		if dir.SizeBytes == 0 {
+			return fmt.Errorf("%w: %s", errScratchDirSize, dir.Name)
+		}
+
+		if dir.Destination != "" && !path.IsAbs(dir.Destination) {
+			return fmt.Errorf("%w: %s", errScratchDirDest, dir.Name)
+		}
+	}
+
+	return nil
+}
+
+// setScratchDirs adds the scratch directories to the request.
+func setScratchDirs(dirs []ScratchDir, newList func(int32) (proto.Conmon_ScratchDir_List, error)) error {
+	if len(dirs) == 0 {
+		return nil
+	}
+
+	list, err := newList(int32(len(dirs)))
+	if err != nil {
+		return fmt.Errorf("create scratch dirs: %w", err)
+	}
+
+	for i, dir := range dirs {
+		item := list.At(i)
+		if err := item.SetName(dir.Name); err != nil {
+			return fmt.Errorf("set scratch dir name: %w", err)
+		}
+		item.SetSizeBytes(dir.SizeBytes)
+		if err := item.SetDestination(dir.Destination); err != nil {
+			return fmt.Errorf("set scratch dir destination: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// scratchDirPaths maps the names of the scratch directories to the host
+// paths returned by the server.
+func scratchDirPaths(
+	dirs []ScratchDir, response proto.Conmon_CreateContainerResponse,
+) (map[string]string, error) {
+	paths, err := response.ScratchDirPaths()
+	if err != nil {
+		return nil, fmt.Errorf("get scratch dir paths: %w", err)
+	}
+
+	if paths.Len() != len(dirs) {
+		return nil, fmt.Errorf("%w: got %d paths for %d dirs", ErrScratchDirsUnsupported, paths.Len(), len(dirs))
+	}
+
+	res := make(map[string]string, len(dirs))
+	for i, dir := range dirs {
+		path, err := paths.At(i)
+		if err != nil {
+			return nil, fmt.Errorf("get scratch dir path: %w", err)
+		}
+		res[dir.Name] = path
+	}
+
+	return res, nil
+}
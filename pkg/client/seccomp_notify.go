@@ -0,0 +1,249 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// SeccompNotifyAction specifies how a syscall continues after a seccomp
+// notification has been handled.
+type SeccompNotifyAction int
+
+const (
+	// SeccompNotifyActionError fails the syscall with the Errno of the
+	// response, or EPERM if it is zero.
+	SeccompNotifyActionError SeccompNotifyAction = iota
+
+	// SeccompNotifyActionContinue lets the kernel execute the syscall. It
+	// must not be used to enforce security policies, because the arguments
+	// of the syscall may change before it gets executed.
+	SeccompNotifyActionContinue
+
+	// SeccompNotifyActionValue skips the syscall and returns the Value of the
+	// response as its result.
+	SeccompNotifyActionValue
+)
+
+// SeccompNotification is a syscall of a container which triggered the notify
+// action of its seccomp profile.
+type SeccompNotification struct {
+	// ID is the kernel identifier of the notification.
+	ID uint64
+
+	// PID is the process ID of the syscall, as seen from the server.
+	PID uint32
+
+	// Syscall is the number of the syscall.
+	Syscall int32
+
+	// Arch is the AUDIT_ARCH_* value of the syscall.
+	Arch uint32
+
+	// InstructionPointer is the address of the syscall instruction.
+	InstructionPointer uint64
+
+	// Args are the raw arguments of the syscall.
+	Args [6]uint64
+}
+
+// SeccompNotifyResponse is the decision about a syscall.
+type SeccompNotifyResponse struct {
+	// Action specifies how the syscall continues. The zero value fails the
+	// syscall.
+	Action SeccompNotifyAction
+
+	// Errno is returned by the syscall for SeccompNotifyActionError.
+	Errno syscall.Errno
+
+	// Value is returned by the syscall for SeccompNotifyActionValue.
+	Value int64
+}
+
+// SeccompNotifyHandler decides about the syscalls of a container which
+// triggered the notify action of its seccomp profile. The process blocks
+// until the handler returns, which has to happen within 10 seconds. The
+// syscall fails with EPERM if the handler returns an error or a nil
+// response.
+type SeccompNotifyHandler interface {
+	HandleSeccompNotification(context.Context, *SeccompNotification) (*SeccompNotifyResponse, error)
+}
+
+// SeccompNotifyHandlerFunc is an adapter to use functions as
+// SeccompNotifyHandler.
+type SeccompNotifyHandlerFunc func(context.Context, *SeccompNotification) (*SeccompNotifyResponse, error)
+
+// HandleSeccompNotification calls f(ctx, notification).
+func (f SeccompNotifyHandlerFunc) HandleSeccompNotification(
+	ctx context.Context, notification *SeccompNotification,
+) (*SeccompNotifyResponse, error) {
+	return f(ctx, notification)
+}
+
+// SeccompNotifyConfig is the seccomp notification configuration of a new
+// container.
+type SeccompNotifyConfig struct {
+	// ListenerPath is the socket the runtime sends the seccomp notify fd to,
+	// which has to match linux.seccomp.listenerPath of the container config.
+	// The server binds the socket while the container gets created.
+	ListenerPath string
+
+	// Handler gets registered once the container has been created, if not
+	// nil, and is served until the container exits or the client gets
+	// closed. The syscalls fail with EPERM while no handler is registered.
+	Handler SeccompNotifyHandler
+}
+
+// ServeSeccompNotify registers the handler for the seccomp notifications of
+// a container, which has been created with a SeccompNotifyConfig. It replaces
+// the previous handler of the container. The handler is served until the
+// context is done, the container exits or another handler gets registered.
+func (c *ConmonClient) ServeSeccompNotify(ctx context.Context, id string, handler SeccompNotifyHandler) error {
+	_, err := c.serveSeccompNotify(ctx, id, handler)
+
+	return err
+}
+
+func (c *ConmonClient) serveSeccompNotify(
+	ctx context.Context, id string, handler SeccompNotifyHandler,
+) (*eventStream[struct{}], error) {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newDedicatedRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("ServeSeccompNotify")()
+	diag := c.diagnoseRPC("ServeSeccompNotify")
+
+	server := seccompNotifyHandler{newEventStream[struct{}](), handler, c}
+	future, free := client.ServeSeccompNotify(ctx, func(p proto.Conmon_serveSeccompNotify_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := req.SetHandler(proto.Conmon_SeccompNotifyHandler_ServerToClient(server, nil)); err != nil {
+			return fmt.Errorf("set handler: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	if err := responseError(response); err != nil {
+		conn.Close()
+
+		return nil, err
+	}
+
+	server.serve(ctx, conn, c.logger)
+
+	return server.eventStream, nil
+}
+
+// seccompNotifyHandler is the local implementation of the
+// SeccompNotifyHandler interface.
+type seccompNotifyHandler struct {
+	*eventStream[struct{}]
+	handler SeccompNotifyHandler
+	client  *ConmonClient
+}
+
+// Handle is called by the server for every notification.
+func (s seccompNotifyHandler) Handle(ctx context.Context, call proto.Conmon_SeccompNotifyHandler_handle) error {
+	n, err := call.Args().Notification()
+	if err != nil {
+		return fmt.Errorf("get notification: %w", err)
+	}
+
+	args, err := n.Args()
+	if err != nil {
+		return fmt.Errorf("get args: %w", err)
+	}
+
+	notification := &SeccompNotification{
+		ID:                 n.Id(),
+		PID:                n.Pid(),
+		Syscall:            n.Syscall(),
+		Arch:               n.Arch(),
+		InstructionPointer: n.InstructionPointer(),
+	}
+	for i := 0; i < args.Len() && i < len(notification.Args); i++ {
+		notification.Args[i] = args.At(i)
+	}
+
+	resp, err := s.handler.HandleSeccompNotification(ctx, notification)
+	if err != nil {
+		s.client.logger.Errorf("Unable to handle seccomp notification %d: %v", notification.ID, err)
+	}
+	if err != nil || resp == nil {
+		resp = &SeccompNotifyResponse{Action: SeccompNotifyActionError, Errno: syscall.EPERM}
+	}
+
+	results, err := call.AllocResults()
+	if err != nil {
+		return fmt.Errorf("allocate results: %w", err)
+	}
+
+	response, err := results.NewResponse()
+	if err != nil {
+		return fmt.Errorf("create response: %w", err)
+	}
+
+	switch resp.Action {
+	case SeccompNotifyActionContinue:
+		response.SetAction(proto.Conmon_SeccompNotificationResponse_Action_continueSyscall)
+	case SeccompNotifyActionValue:
+		response.SetAction(proto.Conmon_SeccompNotificationResponse_Action_returnValue)
+		response.SetValue(resp.Value)
+	case SeccompNotifyActionError:
+		fallthrough
+	default:
+		response.SetAction(proto.Conmon_SeccompNotificationResponse_Action_returnError)
+		response.SetErrno(int32(resp.Errno))
+	}
+
+	return nil
+}
+
+// Done is called by the server before it releases the handler.
+func (s seccompNotifyHandler) Done(ctx context.Context, call proto.Conmon_SeccompNotifyHandler_done) error {
+	msg, err := call.Args().Error()
+	if err != nil {
+		return fmt.Errorf("get error: %w", err)
+	}
+	s.stop(msg)
+
+	return nil
+}
@@ -0,0 +1,266 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+var errSelfTestEcho = errors.New("fixture did not echo the standard input")
+
+// SelfTestStatus is the result of a single check of SelfTest.
+type SelfTestStatus int
+
+const (
+	// SelfTestPassed indicates that the check succeeded.
+	SelfTestPassed SelfTestStatus = iota
+
+	// SelfTestFailed indicates that the check failed.
+	SelfTestFailed
+
+	// SelfTestSkipped indicates that the check did not run, for example
+	// because no fixture has been configured or a previous check failed.
+	SelfTestSkipped
+)
+
+// String returns the name of the status.
+func (s SelfTestStatus) String() string {
+	switch s {
+	case SelfTestPassed:
+		return "passed"
+	case SelfTestFailed:
+		return "failed"
+	case SelfTestSkipped:
+		return "skipped"
+	}
+
+	return fmt.Sprintf("SelfTestStatus(%d)", int(s))
+}
+
+// SelfTestConfig is the configuration of SelfTest.
+type SelfTestConfig struct {
+	// Fixture is the container used to check the container RPCs, which are
+	// skipped if it is nil.
+	Fixture *SelfTestFixture
+}
+
+// SelfTestFixture is a container which echoes its standard input to its
+// standard output without a terminal, for example by running `cat`.
+type SelfTestFixture struct {
+	// BundlePath is the path of the OCI bundle of the container.
+	BundlePath string
+
+	// Start starts the created container with the provided ID, usually by
+	// running the start command of the OCI runtime.
+	Start func(ctx context.Context, id string) error
+
+	// Cleanup removes the container with the provided ID after the self
+	// test, usually by running the delete command of the OCI runtime.
+	Cleanup func(ctx context.Context, id string) error
+}
+
+// SelfTestCheck is the result of a single check.
+type SelfTestCheck struct {
+	// Name of the check, which is the name of the exercised method.
+	Name string
+
+	// Status of the check.
+	Status SelfTestStatus
+
+	// Duration of the check.
+	Duration time.Duration
+
+	// Err is the error of a failed check.
+	Err error
+}
+
+// SelfTestReport is the compatibility report of SelfTest.
+type SelfTestReport struct {
+	// ServerVersion is the version of the server, if it could be retrieved.
+	ServerVersion *VersionResponse
+
+	// ClientProtocolVersion is the protocol version of the client.
+	ClientProtocolVersion uint32
+
+	// NegotiatedProtocolVersion is the protocol version used to communicate
+	// with the server.
+	NegotiatedProtocolVersion uint32
+
+	// Checks are the results of all checks in the order they ran.
+	Checks []SelfTestCheck
+}
+
+// Compatible returns true if no check failed.
+func (r *SelfTestReport) Compatible() bool {
+	for i := range r.Checks {
+		if r.Checks[i].Status == SelfTestFailed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SelfTest exercises a representative set of RPCs against the server and
+// reports which of them work, which can be used as preflight check before
+// rolling out a new combination of the client and server. The container
+// RPCs use the fixture of the config and are skipped without it.
+func (c *ConmonClient) SelfTest(ctx context.Context, cfg *SelfTestConfig) *SelfTestReport {
+	if cfg == nil {
+		cfg = &SelfTestConfig{}
+	}
+
+	report := &SelfTestReport{ClientProtocolVersion: ProtocolVersion}
+	run := func(name string, skip bool, check func() error) bool {
+		result := SelfTestCheck{Name: name, Status: SelfTestSkipped}
+		if !skip {
+			start := time.Now()
+			result.Err = check()
+			result.Duration = time.Since(start)
+			result.Status = SelfTestPassed
+			if result.Err != nil {
+				result.Status = SelfTestFailed
+			}
+		}
+		report.Checks = append(report.Checks, result)
+
+		return result.Status == SelfTestPassed
+	}
+
+	run("Version", false, func() (err error) {
+		report.ServerVersion, err = c.Version(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := c.negotiateVersion(report.ServerVersion); err != nil {
+			return err
+		}
+		report.NegotiatedProtocolVersion = c.NegotiatedProtocolVersion()
+
+		return nil
+	})
+
+	run("ListContainers", false, func() error {
+		_, err := c.ListContainers(ctx)
+
+		return err
+	})
+
+	run("TenantQuota", false, func() error {
+		_, err := c.TenantQuota(ctx)
+
+		return err
+	})
+
+	c.selfTestFixture(ctx, cfg.Fixture, run)
+
+	return report
+}
+
+// selfTestFixture runs the checks which require the fixture container.
+func (c *ConmonClient) selfTestFixture(
+	ctx context.Context, fixture *SelfTestFixture, run func(string, bool, func() error) bool,
+) {
+	skip := fixture == nil
+	id := "conmon-selftest-" + randomID()[:12]
+
+	created := run("CreateContainer", skip, func() error {
+		_, err := c.CreateContainer(ctx, &CreateContainerConfig{
+			ID:         id,
+			BundlePath: fixture.BundlePath,
+		})
+
+		return err
+	})
+
+	if created && fixture.Cleanup != nil {
+		defer func() {
+			if err := fixture.Cleanup(ctx, id); err != nil {
+				c.logger.Errorf("Unable to clean up self test container %s: %v", id, err)
+			}
+		}()
+	}
+
+	socketDir, err := os.MkdirTemp("", "conmon-selftest-")
+	if err != nil {
+		run("AttachContainer", !created, func() error {
+			return fmt.Errorf("create attach socket directory: %w", err)
+		})
+
+		return
+	}
+	defer os.RemoveAll(socketDir)
+
+	stdin, stdinWrite := io.Pipe()
+	defer stdinWrite.Close()
+	stdoutRead, stdout := io.Pipe()
+	attachDone := make(chan error, 1)
+
+	attached := run("AttachContainer", !created, func() error {
+		go func() {
+			attachDone <- c.AttachContainer(ctx, &AttachConfig{
+				ID:         id,
+				SocketPath: filepath.Join(socketDir, "attach"),
+				Streams: AttachStreams{
+					Stdin:  &In{stdin},
+					Stdout: &Out{stdout},
+				},
+				PreAttachFunc: func() error {
+					if fixture.Start == nil {
+						return nil
+					}
+
+					return fixture.Start(ctx, id)
+				},
+			})
+			stdout.Close()
+		}()
+
+		token := randomID() + "\n"
+		if _, err := io.WriteString(stdinWrite, token); err != nil {
+			return fmt.Errorf("write standard input: %w", err)
+		}
+
+		line, err := bufio.NewReader(stdoutRead).ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("%w: %v", errSelfTestEcho, err)
+		}
+
+		if line != token {
+			return fmt.Errorf("%w: got %q", errSelfTestEcho, line)
+		}
+
+		return nil
+	})
+
+	run("ContainerStats", !attached, func() error {
+		_, err := c.ContainerStats(ctx, id)
+
+		return err
+	})
+
+	run("WaitContainer", !attached, func() error {
+		// Closing the standard input stops the fixture.
+		stdinWrite.Close()
+		if err := <-attachDone; err != nil {
+			return err
+		}
+
+		_, err := c.WaitContainer(ctx, id)
+
+		return err
+	})
+
+	if created && !attached {
+		if err := c.KillContainer(ctx, id, syscall.SIGKILL, true); err != nil {
+			c.logger.Debugf("Unable to kill self test container %s: %v", id, err)
+		}
+	}
+}
@@ -0,0 +1,414 @@
+package client
+
+import (
+	"io"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// defaultSLOInterval is the default interval of SLO summaries.
+	defaultSLOInterval = time.Minute
+
+	// sloMaxSamples is the number of latency samples kept per interval for
+	// calculating the percentiles. Further samples replace random ones.
+	sloMaxSamples = 10000
+)
+
+// DefaultSLOLatencyBuckets are the upper bounds of the attach latency
+// histogram buckets in seconds, which are used if SLOReporterConfig has none.
+var DefaultSLOLatencyBuckets = []float64{
+	0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1,
+}
+
+// DefaultSLOThroughputBuckets are the upper bounds of the attach throughput
+// histogram buckets in bytes per second, which are used if SLOReporterConfig
+// has none.
+var DefaultSLOThroughputBuckets = []float64{
+	1 << 10, 1 << 12, 1 << 14, 1 << 16, 1 << 18, 1 << 20, 1 << 22, 1 << 24,
+}
+
+// SLOSessionKind is the kind of an attach session reported by the
+// SLOReporter.
+type SLOSessionKind string
+
+const (
+	// SLOSessionKindAttach is an attach session of a container.
+	SLOSessionKindAttach SLOSessionKind = "attach"
+
+	// SLOSessionKindExec is an attach session of an exec session.
+	SLOSessionKindExec SLOSessionKind = "exec"
+)
+
+// SLOReporterConfig is the configuration of an SLOReporter.
+type SLOReporterConfig struct {
+	// Interval of the summaries passed to OnSummary, one minute if zero.
+	Interval time.Duration
+
+	// OnSummary is called once per interval and session kind with activity
+	// in the interval. No summaries are created if nil.
+	OnSummary func(*SLOSummary)
+
+	// LatencyBuckets are the upper bounds of the latency histogram buckets
+	// in seconds, DefaultSLOLatencyBuckets if empty.
+	LatencyBuckets []float64
+
+	// ThroughputBuckets are the upper bounds of the throughput histogram
+	// buckets in bytes per second, DefaultSLOThroughputBuckets if empty.
+	ThroughputBuckets []float64
+}
+
+// SLOSummary is the summary of the attach sessions of a kind within an
+// interval.
+type SLOSummary struct {
+	// Kind of the summarized sessions.
+	Kind SLOSessionKind
+
+	// Start of the interval.
+	Start time.Time
+
+	// End of the interval.
+	End time.Time
+
+	// Sessions is the number of sessions which ended in the interval.
+	Sessions uint64
+
+	// LatencySamples is the number of latencies measured in the interval.
+	LatencySamples uint64
+
+	// LatencyP50 is the median latency from the standard input to the
+	// following output.
+	LatencyP50 time.Duration
+
+	// LatencyP90 is the 90th percentile of the latency.
+	LatencyP90 time.Duration
+
+	// LatencyP99 is the 99th percentile of the latency.
+	LatencyP99 time.Duration
+
+	// BytesIn is the number of standard input bytes of the interval.
+	BytesIn uint64
+
+	// BytesOut is the number of output bytes of the interval.
+	BytesOut uint64
+
+	// Throughput is the output of all sessions in bytes per second of the
+	// interval.
+	Throughput float64
+}
+
+// SLOSnapshot contains the histograms of an SLOReporter at a point in time.
+// It can be converted into a Prometheus histogram family with the session
+// kind as label like the MetricsSnapshot.
+type SLOSnapshot struct {
+	// Latency contains the latencies in seconds from the standard input of
+	// a session to the following output per session kind.
+	Latency map[SLOSessionKind]Histogram
+
+	// Throughput contains the average output throughput of the ended
+	// sessions in bytes per second per session kind.
+	Throughput map[SLOSessionKind]Histogram
+}
+
+// SLOReporter aggregates the latency and throughput of the attach sessions
+// of the clients it is configured for, see ConmonServerConfig.SLOReporter.
+// The latency is the time from reading the standard input of a session until
+// writing the next output, which is the echo latency of interactive
+// sessions.
+type SLOReporter struct {
+	interval          time.Duration
+	onSummary         func(*SLOSummary)
+	latencyBuckets    []float64
+	throughputBuckets []float64
+
+	mu         sync.Mutex
+	latency    map[SLOSessionKind]*histogram
+	throughput map[SLOSessionKind]*histogram
+	windows    map[SLOSessionKind]*sloWindow
+	start      time.Time
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// sloWindow collects the values of a session kind within an interval. It is
+// guarded by the mutex of the SLOReporter.
+type sloWindow struct {
+	sessions  uint64
+	samples   uint64
+	latencies []time.Duration
+	bytesIn   uint64
+	bytesOut  uint64
+}
+
+// NewSLOReporter creates a new SLOReporter, which has to be closed to stop
+// the summaries.
+func NewSLOReporter(cfg *SLOReporterConfig) *SLOReporter {
+	r := &SLOReporter{
+		interval:          cfg.Interval,
+		onSummary:         cfg.OnSummary,
+		latencyBuckets:    sortedBuckets(cfg.LatencyBuckets, DefaultSLOLatencyBuckets),
+		throughputBuckets: sortedBuckets(cfg.ThroughputBuckets, DefaultSLOThroughputBuckets),
+		latency:           map[SLOSessionKind]*histogram{},
+		throughput:        map[SLOSessionKind]*histogram{},
+		windows:           map[SLOSessionKind]*sloWindow{},
+		start:             time.Now(),
+		done:              make(chan struct{}),
+	}
+	if r.interval <= 0 {
+		r.interval = defaultSLOInterval
+	}
+
+	if r.onSummary != nil {
+		go r.report()
+	}
+
+	return r
+}
+
+func sortedBuckets(buckets, defaults []float64) []float64 {
+	if len(buckets) == 0 {
+		buckets = defaults
+	}
+
+	sorted := append([]float64{}, buckets...)
+	sort.Float64s(sorted)
+
+	return sorted
+}
+
+// Close stops the summaries. The sessions are still recorded afterwards.
+func (r *SLOReporter) Close() {
+	r.closeOnce.Do(func() { close(r.done) })
+}
+
+// Snapshot returns the current histograms of the reporter.
+func (r *SLOReporter) Snapshot() *SLOSnapshot {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snapshot := &SLOSnapshot{
+		Latency:    make(map[SLOSessionKind]Histogram, len(r.latency)),
+		Throughput: make(map[SLOSessionKind]Histogram, len(r.throughput)),
+	}
+	for kind, h := range r.latency {
+		snapshot.Latency[kind] = h.snapshot(r.latencyBuckets)
+	}
+	for kind, h := range r.throughput {
+		snapshot.Throughput[kind] = h.snapshot(r.throughputBuckets)
+	}
+
+	return snapshot
+}
+
+// report passes the summaries to the callback every interval until the
+// reporter gets closed.
+func (r *SLOReporter) report() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-r.done:
+			return
+		}
+
+		for _, summary := range r.summarize(time.Now()) {
+			r.onSummary(summary)
+		}
+	}
+}
+
+// summarize ends the current interval and returns the summaries of the
+// session kinds with activity.
+func (r *SLOReporter) summarize(now time.Time) []*SLOSummary {
+	r.mu.Lock()
+	windows, start := r.windows, r.start
+	r.windows, r.start = map[SLOSessionKind]*sloWindow{}, now
+	r.mu.Unlock()
+
+	summaries := make([]*SLOSummary, 0, len(windows))
+	for kind, w := range windows {
+		summary := &SLOSummary{
+			Kind:           kind,
+			Start:          start,
+			End:            now,
+			Sessions:       w.sessions,
+			LatencySamples: w.samples,
+			BytesIn:        w.bytesIn,
+			BytesOut:       w.bytesOut,
+		}
+		if seconds := now.Sub(start).Seconds(); seconds > 0 {
+			summary.Throughput = float64(w.bytesOut) / seconds
+		}
+
+		sort.Slice(w.latencies, func(i, j int) bool { return w.latencies[i] < w.latencies[j] })
+		summary.LatencyP50 = percentile(w.latencies, 50)
+		summary.LatencyP90 = percentile(w.latencies, 90)
+		summary.LatencyP99 = percentile(w.latencies, 99)
+
+		summaries = append(summaries, summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].Kind < summaries[j].Kind })
+
+	return summaries
+}
+
+// percentile returns the nearest rank percentile of the sorted values.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := (len(sorted)*p + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+// window returns the window of the session kind. The mutex has to be held.
+func (r *SLOReporter) window(kind SLOSessionKind) *sloWindow {
+	w, ok := r.windows[kind]
+	if !ok {
+		w = &sloWindow{}
+		r.windows[kind] = w
+	}
+
+	return w
+}
+
+func (r *SLOReporter) observeLatency(kind SLOSessionKind, latency time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h, ok := r.latency[kind]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(r.latencyBuckets))}
+		r.latency[kind] = h
+	}
+	h.observe(r.latencyBuckets, latency.Seconds())
+
+	w := r.window(kind)
+	w.samples++
+	if len(w.latencies) < sloMaxSamples {
+		w.latencies = append(w.latencies, latency)
+	} else if i := rand.Int63n(int64(w.samples)); i < sloMaxSamples { // nolint:gosec // sampling only
+		w.latencies[i] = latency
+	}
+}
+
+func (r *SLOReporter) countBytes(kind SLOSessionKind, in, out uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	w := r.window(kind)
+	w.bytesIn += in
+	w.bytesOut += out
+}
+
+func (r *SLOReporter) endSession(kind SLOSessionKind, bytesOut uint64, duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.window(kind).sessions++
+
+	if duration <= 0 {
+		return
+	}
+
+	h, ok := r.throughput[kind]
+	if !ok {
+		h = &histogram{counts: make([]uint64, len(r.throughputBuckets))}
+		r.throughput[kind] = h
+	}
+	h.observe(r.throughputBuckets, float64(bytesOut)/duration.Seconds())
+}
+
+// startSession measures the streams of an attach session of the kind. The
+// returned function ends the session. It does nothing if the reporter is
+// nil.
+func (r *SLOReporter) startSession(kind SLOSessionKind, streams AttachStreams) (AttachStreams, func()) {
+	if r == nil {
+		return streams, func() {}
+	}
+
+	s := &sloSession{reporter: r, kind: kind, start: time.Now()}
+	if streams.Stdin != nil {
+		streams.Stdin = &In{sloReader{streams.Stdin.Reader, s}}
+	}
+
+	if streams.Stdout != nil {
+		streams.Stdout = &Out{sloWriteCloser{streams.Stdout.WriteCloser, s}}
+	}
+
+	if streams.Stderr != nil {
+		streams.Stderr = &Out{sloWriteCloser{streams.Stderr.WriteCloser, s}}
+	}
+
+	return streams, func() {
+		r.endSession(kind, atomic.LoadUint64(&s.bytesOut), time.Since(s.start))
+	}
+}
+
+// sloSession measures a single attach session.
+type sloSession struct {
+	reporter *SLOReporter
+	kind     SLOSessionKind
+	start    time.Time
+	bytesOut uint64
+
+	// pending is the time of the first standard input which has not been
+	// followed by output yet, zero if there is none.
+	pending int64
+}
+
+func (s *sloSession) input(n int) {
+	atomic.CompareAndSwapInt64(&s.pending, 0, time.Now().UnixNano())
+	s.reporter.countBytes(s.kind, uint64(n), 0)
+}
+
+func (s *sloSession) output(n int) {
+	atomic.AddUint64(&s.bytesOut, uint64(n))
+	if pending := atomic.SwapInt64(&s.pending, 0); pending != 0 {
+		s.reporter.observeLatency(s.kind, time.Since(time.Unix(0, pending)))
+	}
+	s.reporter.countBytes(s.kind, 0, uint64(n))
+}
+
+// sloReader measures the standard input of a session.
+type sloReader struct {
+	io.Reader
+	session *sloSession
+}
+
+func (s sloReader) Read(p []byte) (int, error) {
+	n, err := s.Reader.Read(p)
+	if n > 0 {
+		s.session.input(n)
+	}
+
+	// nolint:wrapcheck // keep the io.Reader semantics
+	return n, err
+}
+
+// sloWriteCloser measures the output of a session.
+type sloWriteCloser struct {
+	io.WriteCloser
+	session *sloSession
+}
+
+func (s sloWriteCloser) Write(p []byte) (int, error) {
+	n, err := s.WriteCloser.Write(p)
+	if n > 0 {
+		s.session.output(n)
+	}
+
+	// nolint:wrapcheck // keep the io.Writer semantics
+	return n, err
+}
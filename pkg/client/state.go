@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// StateInfo describes the persisted state of the server, see
+// ConmonServerConfig.StateBackend.
+type StateInfo struct {
+	// Backend is the state backend, like StateBackendFile.
+	Backend string
+
+	// Path is the file or database of the backend, empty for
+	// StateBackendMemory.
+	Path string
+
+	// Entries is the number of records of all tenants.
+	Entries uint64
+
+	// TenantEntries is the number of records of the tenant of the client.
+	TenantEntries uint64
+
+	// SizeBytes is the size of the persisted state, zero for
+	// StateBackendMemory.
+	SizeBytes uint64
+
+	// WritesSinceCompaction is the number of writes since the state got
+	// compacted the last time.
+	WritesSinceCompaction uint64
+
+	// CompactedAt is the time the state got compacted the last time, zero if
+	// it has not been compacted since the server started.
+	CompactedAt time.Time
+}
+
+// StateCompaction is the result of CompactState.
+type StateCompaction struct {
+	// SizeBefore is the size of the persisted state before the compaction.
+	SizeBefore uint64
+
+	// SizeAfter is the size of the persisted state after the compaction.
+	SizeAfter uint64
+
+	// Entries is the number of records of all tenants kept by the
+	// compaction.
+	Entries uint64
+}
+
+// InspectState retrieves information about the persisted state of the
+// server.
+func (c *ConmonClient) InspectState(ctx context.Context) (*StateInfo, error) {
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("InspectState")()
+	diag := c.diagnoseRPC("InspectState")
+	future, free := client.InspectState(ctx, func(p proto.Conmon_inspectState_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	info, err := response.Info()
+	if err != nil {
+		return nil, fmt.Errorf("get info: %w", err)
+	}
+
+	path, err := info.Path()
+	if err != nil {
+		return nil, fmt.Errorf("get path: %w", err)
+	}
+
+	res := &StateInfo{
+		Backend:               stateBackendFromProto(info.Backend()),
+		Path:                  path,
+		Entries:               info.Entries(),
+		TenantEntries:         info.TenantEntries(),
+		SizeBytes:             info.SizeBytes(),
+		WritesSinceCompaction: info.WritesSinceCompaction(),
+	}
+	if info.CompactedAt() > 0 {
+		res.CompactedAt = time.Unix(0, int64(info.CompactedAt()))
+	}
+
+	return res, nil
+}
+
+// CompactState reclaims the space of overwritten and deleted records of the
+// persisted state of the server, which affects all tenants. The server
+// compacts a StateBackendFile automatically as well, while a
+// StateBackendSqlite gets only compacted on request.
+func (c *ConmonClient) CompactState(ctx context.Context) (*StateCompaction, error) {
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("CompactState")()
+	diag := c.diagnoseRPC("CompactState")
+	future, free := client.CompactState(ctx, func(p proto.Conmon_compactState_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	return &StateCompaction{
+		SizeBefore: response.SizeBefore(),
+		SizeAfter:  response.SizeAfter(),
+		Entries:    response.Entries(),
+	}, nil
+}
+
+func stateBackendFromProto(backend proto.Conmon_StateInfo_Backend) string {
+	switch backend {
+	case proto.Conmon_StateInfo_Backend_memory:
+		return StateBackendMemory
+	case proto.Conmon_StateInfo_Backend_file:
+		return StateBackendFile
+	case proto.Conmon_StateInfo_Backend_sqlite:
+		return StateBackendSqlite
+	}
+
+	return backend.String()
+}
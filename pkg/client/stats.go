@@ -0,0 +1,404 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// defaultStatsInterval is the default interval of StreamStats.
+const defaultStatsInterval = 10 * time.Second
+
+// ContainerStats are the resource usage statistics of a container.
+type ContainerStats struct {
+	// Timestamp is the time when the statistics have been collected.
+	Timestamp time.Time
+
+	// CPU contains the CPU statistics.
+	CPU CPUStats
+
+	// Memory contains the memory statistics.
+	Memory MemoryStats
+
+	// BlockIO contains the block IO statistics.
+	BlockIO BlockIOStats
+
+	// PIDs contains the process statistics.
+	PIDs PIDsStats
+
+	// LogFilter contains the health of the log filter, which is nil if no
+	// filter is configured for the container.
+	LogFilter *LogFilterHealth
+
+	// Network contains the statistics of the network namespace of the
+	// container.
+	Network NetworkStats
+}
+
+// CPUStats are the CPU statistics of a container.
+type CPUStats struct {
+	// UsageNanos is the total consumed CPU time in nanoseconds.
+	UsageNanos uint64
+
+	// UserNanos is the CPU time consumed in user mode in nanoseconds.
+	UserNanos uint64
+
+	// SystemNanos is the CPU time consumed in kernel mode in nanoseconds.
+	SystemNanos uint64
+}
+
+// MemoryStats are the memory statistics of a container.
+type MemoryStats struct {
+	// UsageBytes is the current memory usage.
+	UsageBytes uint64
+
+	// LimitBytes is the memory limit, zero if unlimited.
+	LimitBytes uint64
+}
+
+// BlockIOStats are the block IO statistics of a container.
+type BlockIOStats struct {
+	// ReadBytes is the number of bytes read from block devices.
+	ReadBytes uint64
+
+	// WriteBytes is the number of bytes written to block devices.
+	WriteBytes uint64
+
+	// ReadOps is the number of read operations on block devices.
+	ReadOps uint64
+
+	// WriteOps is the number of write operations on block devices.
+	WriteOps uint64
+}
+
+// PIDsStats are the process statistics of a container.
+type PIDsStats struct {
+	// Current is the current number of processes.
+	Current uint64
+
+	// Limit is the maximum number of processes, zero if unlimited.
+	Limit uint64
+}
+
+// NetworkStats are the network statistics of a container, split by
+// interface and address family.
+type NetworkStats struct {
+	// Interfaces contains the counters of the interfaces, excluding the
+	// loopback interface.
+	Interfaces []NetworkInterfaceStats
+
+	// IPv4 contains the IPv4 counters of the network namespace.
+	IPv4 IPStats
+
+	// IPv6 contains the IPv6 counters of the network namespace.
+	IPv6 IPStats
+}
+
+// NetworkInterfaceStats are the counters of a network interface, which
+// include the traffic of all address families.
+type NetworkInterfaceStats struct {
+	// Name is the name of the interface, for example eth0.
+	Name string
+
+	// RxBytes is the number of received bytes.
+	RxBytes uint64
+
+	// RxPackets is the number of received packets.
+	RxPackets uint64
+
+	// RxErrors is the number of receive errors.
+	RxErrors uint64
+
+	// RxDropped is the number of dropped received packets.
+	RxDropped uint64
+
+	// TxBytes is the number of transmitted bytes.
+	TxBytes uint64
+
+	// TxPackets is the number of transmitted packets.
+	TxPackets uint64
+
+	// TxErrors is the number of transmit errors.
+	TxErrors uint64
+
+	// TxDropped is the number of dropped packets to transmit.
+	TxDropped uint64
+}
+
+// IPStats are the counters of a single address family.
+type IPStats struct {
+	// Available is false if the address family is disabled in the network
+	// namespace of the container, in which case all counters are zero.
+	Available bool
+
+	// InPackets is the number of received datagrams.
+	InPackets uint64
+
+	// OutPackets is the number of sent datagrams.
+	OutPackets uint64
+
+	// InOctets is the number of received bytes.
+	InOctets uint64
+
+	// OutOctets is the number of sent bytes.
+	OutOctets uint64
+
+	// InDiscards is the number of discarded received datagrams.
+	InDiscards uint64
+
+	// OutDiscards is the number of discarded datagrams to send.
+	OutDiscards uint64
+}
+
+// ContainerStats retrieves the resource usage statistics of a running
+// container from its cgroup. Clients with a StatsCacheMaxStaleness serve them
+// from the cache if they are fresh enough.
+func (c *ConmonClient) ContainerStats(ctx context.Context, id string) (*ContainerStats, error) {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.statsCache != nil {
+		return c.statsCache.get(ctx, c, id)
+	}
+
+	return c.containerStats(ctx, id)
+}
+
+// containerStats retrieves the statistics of the container with the resolved
+// ID from the server.
+func (c *ConmonClient) containerStats(ctx context.Context, id string) (*ContainerStats, error) {
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("ContainerStats")()
+	diag := c.diagnoseRPC("ContainerStats")
+	future, free := client.ContainerStats(ctx, func(p proto.Conmon_containerStats_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	stats, err := response.Stats()
+	if err != nil {
+		return nil, fmt.Errorf("get stats: %w", err)
+	}
+
+	return statsFromProto(stats)
+}
+
+func statsFromProto(stats proto.Conmon_ContainerStats) (*ContainerStats, error) {
+	cpu, err := stats.Cpu()
+	if err != nil {
+		return nil, fmt.Errorf("get CPU stats: %w", err)
+	}
+
+	memory, err := stats.Memory()
+	if err != nil {
+		return nil, fmt.Errorf("get memory stats: %w", err)
+	}
+
+	blockIO, err := stats.BlockIo()
+	if err != nil {
+		return nil, fmt.Errorf("get block IO stats: %w", err)
+	}
+
+	pids, err := stats.Pids()
+	if err != nil {
+		return nil, fmt.Errorf("get PIDs stats: %w", err)
+	}
+
+	logFilter, err := stats.LogFilter()
+	if err != nil {
+		return nil, fmt.Errorf("get log filter health: %w", err)
+	}
+
+	network, err := stats.Network()
+	if err != nil {
+		return nil, fmt.Errorf("get network stats: %w", err)
+	}
+
+	networkStats, err := networkStatsFromProto(network)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerStats{
+		Timestamp: time.Unix(0, int64(stats.Timestamp())),
+		CPU: CPUStats{
+			UsageNanos:  cpu.UsageNanos(),
+			UserNanos:   cpu.UserNanos(),
+			SystemNanos: cpu.SystemNanos(),
+		},
+		Memory: MemoryStats{
+			UsageBytes: memory.UsageBytes(),
+			LimitBytes: memory.LimitBytes(),
+		},
+		BlockIO: BlockIOStats{
+			ReadBytes:  blockIO.ReadBytes(),
+			WriteBytes: blockIO.WriteBytes(),
+			ReadOps:    blockIO.ReadOps(),
+			WriteOps:   blockIO.WriteOps(),
+		},
+		PIDs: PIDsStats{
+			Current: pids.Current(),
+			Limit:   pids.Limit(),
+		},
+		LogFilter: logFilterHealthFromProto(logFilter),
+		Network:   *networkStats,
+	}, nil
+}
+
+func networkStatsFromProto(network proto.Conmon_NetworkStats) (*NetworkStats, error) {
+	interfaces, err := network.Interfaces()
+	if err != nil {
+		return nil, fmt.Errorf("get network interfaces: %w", err)
+	}
+
+	ipv4, err := network.Ipv4()
+	if err != nil {
+		return nil, fmt.Errorf("get IPv4 stats: %w", err)
+	}
+
+	ipv6, err := network.Ipv6()
+	if err != nil {
+		return nil, fmt.Errorf("get IPv6 stats: %w", err)
+	}
+
+	stats := &NetworkStats{
+		IPv4: ipStatsFromProto(ipv4),
+		IPv6: ipStatsFromProto(ipv6),
+	}
+
+	for i := 0; i < interfaces.Len(); i++ {
+		iface := interfaces.At(i)
+
+		name, err := iface.Name()
+		if err != nil {
+			return nil, fmt.Errorf("get network interface name: %w", err)
+		}
+
+		stats.Interfaces = append(stats.Interfaces, NetworkInterfaceStats{
+			Name:      name,
+			RxBytes:   iface.RxBytes(),
+			RxPackets: iface.RxPackets(),
+			RxErrors:  iface.RxErrors(),
+			RxDropped: iface.RxDropped(),
+			TxBytes:   iface.TxBytes(),
+			TxPackets: iface.TxPackets(),
+			TxErrors:  iface.TxErrors(),
+			TxDropped: iface.TxDropped(),
+		})
+	}
+
+	return stats, nil
+}
+
+func ipStatsFromProto(ip proto.Conmon_IpStats) IPStats {
+	return IPStats{
+		Available:   ip.Available(),
+		InPackets:   ip.InPackets(),
+		OutPackets:  ip.OutPackets(),
+		InOctets:    ip.InOctets(),
+		OutOctets:   ip.OutOctets(),
+		InDiscards:  ip.InDiscards(),
+		OutDiscards: ip.OutDiscards(),
+	}
+}
+
+// StreamStats retrieves the statistics of a running container every
+// interval, which defaults to 10 seconds if zero. The first statistics are
+// sent immediately. The returned channel gets closed if the context is done
+// or the statistics cannot be retrieved any more, for example because the
+// container exited.
+func (c *ConmonClient) StreamStats(
+	ctx context.Context, id string, interval time.Duration,
+) (<-chan ContainerStats, error) {
+	stream, err := c.streamStats(ctx, id, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	return stream.events, nil
+}
+
+func (c *ConmonClient) streamStats(
+	ctx context.Context, id string, interval time.Duration,
+) (*eventStream[ContainerStats], error) {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if interval <= 0 {
+		interval = defaultStatsInterval
+	}
+
+	// Fail early if the container is not available.
+	stats, err := c.containerStats(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := newEventStream[ContainerStats]()
+	go func() {
+		defer stream.close()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := stream.send(ctx, *stats); err != nil {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-stream.done:
+				return
+			case <-ticker.C:
+			}
+
+			if stats, err = c.containerStats(ctx, id); err != nil {
+				if ctx.Err() == nil {
+					stream.fail(err)
+				}
+
+				return
+			}
+		}
+	}()
+
+	return stream, nil
+}
@@ -0,0 +1,229 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// statsCacheIdleRefreshes is the number of refreshes of a cached container,
+// which has not been requested in the meantime, before it gets evicted.
+const statsCacheIdleRefreshes = 10
+
+// statsCache keeps the statistics of the requested containers fresh in the
+// background, see ConmonServerConfig.StatsCacheMaxStaleness. It is shared
+// with the clients returned by WithTenant.
+type statsCache struct {
+	maxStaleness time.Duration
+
+	mu      sync.Mutex
+	entries map[statsCacheKey]*statsCacheEntry
+	started bool
+	stop    chan struct{}
+	stopped sync.Once
+}
+
+// statsCacheKey identifies a cached container, whose IDs are only unique per
+// tenant.
+type statsCacheKey struct {
+	tenant string
+	id     string
+}
+
+// statsCacheEntry are the cached statistics of a container.
+type statsCacheEntry struct {
+	client   *ConmonClient
+	stats    *ContainerStats
+	fetched  time.Time
+	lastUsed time.Time
+}
+
+// newStatsCache creates a cache, which is nil if the maxStaleness is not
+// positive.
+func newStatsCache(maxStaleness time.Duration) *statsCache {
+	if maxStaleness <= 0 {
+		return nil
+	}
+
+	return &statsCache{
+		maxStaleness: maxStaleness,
+		entries:      make(map[statsCacheKey]*statsCacheEntry),
+		stop:         make(chan struct{}),
+	}
+}
+
+// get returns the cached statistics of the container if they are fresh
+// enough, otherwise they get retrieved and cached.
+func (s *statsCache) get(ctx context.Context, c *ConmonClient, id string) (*ContainerStats, error) {
+	key := statsCacheKey{tenant: c.tenant, id: id}
+	now := time.Now()
+
+	s.mu.Lock()
+	if entry, ok := s.entries[key]; ok {
+		entry.lastUsed = now
+		if now.Sub(entry.fetched) <= s.maxStaleness {
+			stats := entry.stats
+			s.mu.Unlock()
+
+			return stats, nil
+		}
+	}
+	s.mu.Unlock()
+
+	stats, err := c.containerStats(ctx, id)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		delete(s.entries, key)
+
+		return nil, err
+	}
+
+	s.entries[key] = &statsCacheEntry{
+		client:   c,
+		stats:    stats,
+		fetched:  time.Now(),
+		lastUsed: now,
+	}
+	s.start()
+
+	return stats, nil
+}
+
+// start starts refreshing the cache, the mutex has to be held.
+func (s *statsCache) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+
+	interval := s.maxStaleness / 2
+	if interval <= 0 {
+		interval = s.maxStaleness
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+			}
+
+			s.refresh(interval)
+		}
+	}()
+}
+
+// refresh retrieves the statistics of all cached containers, which have been
+// requested recently. Containers whose statistics are not available any more,
+// for example because they exited, get evicted.
+func (s *statsCache) refresh(interval time.Duration) {
+	now := time.Now()
+	idle := interval * statsCacheIdleRefreshes
+
+	s.mu.Lock()
+	entries := make(map[statsCacheKey]*statsCacheEntry, len(s.entries))
+	for key, entry := range s.entries {
+		if now.Sub(entry.lastUsed) > idle {
+			delete(s.entries, key)
+
+			continue
+		}
+		entries[key] = entry
+	}
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), interval)
+	defer cancel()
+
+	slots := make(chan struct{}, DefaultBatchConcurrency)
+	var wg sync.WaitGroup
+
+	for key, entry := range entries {
+		slots <- struct{}{}
+		wg.Add(1)
+
+		go func(key statsCacheKey, entry *statsCacheEntry) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			stats, err := entry.client.containerStats(ctx, key.id)
+
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			// The entry may have been replaced or evicted in the meantime.
+			if s.entries[key] != entry {
+				return
+			}
+
+			switch {
+			case err == nil:
+				entry.stats = stats
+				entry.fetched = time.Now()
+			case ctx.Err() == nil:
+				delete(s.entries, key)
+			}
+		}(key, entry)
+	}
+
+	wg.Wait()
+}
+
+// close stops refreshing the cache.
+func (s *statsCache) close() {
+	if s == nil {
+		return
+	}
+
+	s.stopped.Do(func() { close(s.stop) })
+}
+
+// ListContainerStats retrieves the statistics of the running containers,
+// which are served from the cache of a client with a StatsCacheMaxStaleness
+// if possible. The statistics of the remaining containers are retrieved
+// concurrently. It returns the statistics of all available containers by ID,
+// together with the combined error of the unavailable ones.
+func (c *ConmonClient) ListContainerStats(
+	ctx context.Context, ids ...string,
+) (map[string]*ContainerStats, error) {
+	var mu sync.Mutex
+	res := make(map[string]*ContainerStats, len(ids))
+
+	calls := make([]BatchCall, 0, len(ids))
+	for _, id := range ids {
+		id := id
+		calls = append(calls, func(ctx context.Context, c *ConmonClient) error {
+			stats, err := c.ContainerStats(ctx, id)
+			if err != nil {
+				return fmt.Errorf("%s: %w", id, err)
+			}
+
+			mu.Lock()
+			res[id] = stats
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	var result *multierror.Error
+	for _, err := range c.Batch(ctx, nil, calls...).Errors {
+		if err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return res, result.ErrorOrNil()
+}
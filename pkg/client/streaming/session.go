@@ -0,0 +1,267 @@
+package streaming
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/containers/conmon-rs/pkg/client"
+	"golang.org/x/net/websocket"
+)
+
+// The channels of the remote command protocol, which are the first byte of
+// every message.
+const (
+	channelStdin byte = iota
+	channelStdout
+	channelStderr
+	channelError
+	channelResize
+
+	// channelClose signals that the stream of the channel in the payload
+	// got closed, which is supported since ProtocolV5.
+	channelClose byte = 255
+)
+
+var errInvalidMessage = errors.New("invalid message")
+
+// session is a single attach or exec session of a WebSocket connection.
+type session struct {
+	ctx      context.Context
+	ws       *websocket.Conn
+	opts     *Options
+	protocol string
+
+	writeMu sync.Mutex
+}
+
+func newSession(ctx context.Context, ws *websocket.Conn, opts *Options) *session {
+	protocol := ProtocolChannel
+	if len(ws.Config().Protocol) > 0 {
+		protocol = ws.Config().Protocol[0]
+	}
+
+	return &session{ctx: ctx, ws: ws, opts: opts, protocol: protocol}
+}
+
+// base64 returns true if the protocol uses base64 encoded text frames.
+func (s *session) base64() bool {
+	return s.protocol == ProtocolV4Base64 || s.protocol == ProtocolBase64
+}
+
+// statusJSON returns true if the protocol reports the result of the session
+// as JSON status.
+func (s *session) statusJSON() bool {
+	return s.protocol == ProtocolV5 || s.protocol == ProtocolV4 || s.protocol == ProtocolV4Base64
+}
+
+// run attaches the streams of the connection and reports the result.
+func (s *session) run(attach func(context.Context, *client.AttachConfig) error) {
+	defer s.ws.Close()
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	var stdinReader *io.PipeReader
+	var stdinWriter *io.PipeWriter
+	cfg := &client.AttachConfig{Tty: s.opts.TTY}
+
+	if s.opts.Stdin {
+		stdinReader, stdinWriter = io.Pipe()
+		cfg.Streams.Stdin = &client.In{Reader: stdinReader}
+		cfg.StopAfterStdinEOF = !s.opts.Stdout && !s.opts.Stderr
+	}
+
+	if s.opts.Stdout {
+		cfg.Streams.Stdout = &client.Out{WriteCloser: &channelWriter{s: s, channel: channelStdout}}
+	}
+
+	if s.opts.Stderr {
+		cfg.Streams.Stderr = &client.Out{WriteCloser: &channelWriter{s: s, channel: channelStderr}}
+	}
+
+	if s.opts.TTY {
+		cfg.Resize = make(chan client.TerminalSize, 1)
+	}
+
+	// Closing the connection ends the session.
+	go func() {
+		s.readLoop(ctx, stdinWriter, cfg.Resize)
+		cancel()
+	}()
+
+	err := attach(ctx, cfg)
+	if stdinReader != nil {
+		stdinReader.Close()
+	}
+
+	// The status cannot be reported if the connection is already closed.
+	_ = s.writeStatus(err)
+}
+
+// readLoop dispatches the messages of the client until the connection gets
+// closed.
+func (s *session) readLoop(ctx context.Context, stdin *io.PipeWriter, resize chan client.TerminalSize) {
+	if resize != nil {
+		defer close(resize)
+	}
+
+	for {
+		var message []byte
+		if err := websocket.Message.Receive(s.ws, &message); err != nil {
+			if stdin != nil {
+				stdin.CloseWithError(err)
+			}
+
+			return
+		}
+
+		channel, payload, err := s.decode(message)
+		if err != nil {
+			continue
+		}
+
+		switch channel {
+		case channelStdin:
+			if stdin != nil && len(payload) > 0 {
+				if _, err := stdin.Write(payload); err != nil {
+					stdin = nil
+				}
+			}
+
+		case channelResize:
+			size, err := decodeResize(payload)
+			if err != nil || resize == nil {
+				continue
+			}
+
+			select {
+			case resize <- size:
+			case <-ctx.Done():
+				return
+			}
+
+		case channelClose:
+			if s.protocol == ProtocolV5 && len(payload) > 0 && payload[0] == channelStdin && stdin != nil {
+				stdin.Close()
+				stdin = nil
+			}
+		}
+	}
+}
+
+// decode returns the channel and payload of a message.
+func (s *session) decode(message []byte) (byte, []byte, error) {
+	if len(message) == 0 {
+		return 0, nil, errInvalidMessage
+	}
+
+	if !s.base64() {
+		return message[0], message[1:], nil
+	}
+
+	if message[0] < '0' || message[0] > '9' {
+		return 0, nil, fmt.Errorf("%w: channel %q", errInvalidMessage, message[0])
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(string(message[1:]))
+	if err != nil {
+		return 0, nil, fmt.Errorf("decode base64: %w", err)
+	}
+
+	return message[0] - '0', payload, nil
+}
+
+// write sends the data on the channel.
+func (s *session) write(channel byte, data []byte) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	var message interface{} = append([]byte{channel}, data...)
+	if s.base64() {
+		message = string('0'+channel) + base64.StdEncoding.EncodeToString(data)
+	}
+
+	if err := websocket.Message.Send(s.ws, message); err != nil {
+		return fmt.Errorf("send message: %w", err)
+	}
+
+	return nil
+}
+
+// status is the subset of the Kubernetes metav1.Status used for reporting
+// the result of a session.
+type status struct {
+	Metadata struct{} `json:"metadata"`
+	Status   string   `json:"status"`
+	Message  string   `json:"message,omitempty"`
+	Reason   string   `json:"reason,omitempty"`
+	Code     int      `json:"code,omitempty"`
+}
+
+// writeStatus reports the result of the session on the error channel.
+func (s *session) writeStatus(err error) error {
+	if !s.statusJSON() {
+		if err == nil {
+			return nil
+		}
+
+		return s.write(channelError, []byte(err.Error()))
+	}
+
+	st := status{Status: "Success"}
+	if err != nil {
+		const internalServerError = 500
+		st = status{
+			Status:  "Failure",
+			Message: err.Error(),
+			Reason:  "InternalError",
+			Code:    internalServerError,
+		}
+	}
+
+	data, err := json.Marshal(&st)
+	if err != nil {
+		return fmt.Errorf("marshal status: %w", err)
+	}
+
+	return s.write(channelError, data)
+}
+
+// decodeResize parses the terminal size of a resize message.
+func decodeResize(payload []byte) (client.TerminalSize, error) {
+	var size struct {
+		Width  uint16
+		Height uint16
+	}
+	if err := json.Unmarshal(payload, &size); err != nil {
+		return client.TerminalSize{}, fmt.Errorf("unmarshal terminal size: %w", err)
+	}
+
+	return client.TerminalSize{Width: size.Width, Height: size.Height}, nil
+}
+
+// channelWriter writes to a channel of the session.
+type channelWriter struct {
+	s       *session
+	channel byte
+}
+
+// Write sends p on the channel.
+func (w *channelWriter) Write(p []byte) (int, error) {
+	if err := w.s.write(w.channel, p); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close does nothing, because the output channels are closed together with
+// the connection.
+func (w *channelWriter) Close() error {
+	return nil
+}
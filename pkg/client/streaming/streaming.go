@@ -0,0 +1,237 @@
+// Package streaming serves the Kubernetes remote command protocol for attach
+// and exec requests on top of the conmon-rs client, which allows using it
+// behind the streaming server of the kubelet. Only the WebSocket transport of
+// the protocol is supported, SPDY clients are rejected during the handshake.
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/containers/conmon-rs/pkg/client"
+	"golang.org/x/net/websocket"
+)
+
+// The supported WebSocket sub protocols of the remote command protocol.
+const (
+	// ProtocolV5 adds a signal for closing the standard input to
+	// ProtocolV4.
+	ProtocolV5 = "v5.channel.k8s.io"
+
+	// ProtocolV4 reports the result of a session as JSON status on the error
+	// channel.
+	ProtocolV4 = "v4.channel.k8s.io"
+
+	// ProtocolV4Base64 is ProtocolV4 using base64 encoded text frames.
+	ProtocolV4Base64 = "v4.base64.channel.k8s.io"
+
+	// ProtocolBase64 is ProtocolChannel using base64 encoded text frames.
+	ProtocolBase64 = "base64.channel.k8s.io"
+
+	// ProtocolChannel reports failures of a session as plain text on the
+	// error channel. It is used if the client does not request a protocol.
+	ProtocolChannel = "channel.k8s.io"
+)
+
+// SupportedProtocols are the supported sub protocols in the order of
+// preference.
+var SupportedProtocols = []string{
+	ProtocolV5, ProtocolV4, ProtocolV4Base64, ProtocolBase64, ProtocolChannel,
+}
+
+var (
+	errUnsupportedProtocol = errors.New("none of the requested protocols is supported")
+	errNoStreams           = errors.New("at least one of stdin, stdout and stderr is required")
+	errTTYStderr           = errors.New("stderr cannot be used together with tty")
+	errNoCommand           = errors.New("command is required")
+)
+
+// Client is the part of the client.ConmonClient used by the Server.
+type Client interface {
+	AttachContainer(ctx context.Context, cfg *client.AttachConfig) error
+	ExecContainer(ctx context.Context, cfg *client.ExecContainerConfig) (*client.ExecContainerResponse, error)
+}
+
+// Options are the streams requested by the caller, which correspond to the
+// query parameters of Kubernetes attach and exec requests.
+type Options struct {
+	// Stdin forwards the standard input of the caller.
+	Stdin bool
+
+	// Stdout forwards the standard output to the caller.
+	Stdout bool
+
+	// Stderr forwards the standard error to the caller. It cannot be used
+	// together with TTY.
+	Stderr bool
+
+	// TTY indicates that the process uses a terminal, which can be resized
+	// by the caller.
+	TTY bool
+}
+
+// OptionsFromRequest parses the "stdin", "stdout", "stderr" and "tty" query
+// parameters of a Kubernetes attach or exec request.
+func OptionsFromRequest(r *http.Request) (*Options, error) {
+	query := r.URL.Query()
+	opts := &Options{}
+
+	for name, value := range map[string]*bool{
+		"stdin":  &opts.Stdin,
+		"stdout": &opts.Stdout,
+		"stderr": &opts.Stderr,
+		"tty":    &opts.TTY,
+	} {
+		if query.Get(name) == "" {
+			continue
+		}
+
+		parsed, err := strconv.ParseBool(query.Get(name))
+		if err != nil {
+			return nil, fmt.Errorf("parse query parameter %s: %w", name, err)
+		}
+		*value = parsed
+	}
+
+	return opts, opts.validate()
+}
+
+// CommandFromRequest returns the "command" query parameters of a Kubernetes
+// exec request.
+func CommandFromRequest(r *http.Request) []string {
+	return r.URL.Query()["command"]
+}
+
+func (o *Options) validate() error {
+	if !o.Stdin && !o.Stdout && !o.Stderr {
+		return errNoStreams
+	}
+
+	if o.TTY && o.Stderr {
+		return errTTYStderr
+	}
+
+	return nil
+}
+
+// AttachRequest is the request to attach to a container.
+type AttachRequest struct {
+	// ID of the container.
+	ID string
+
+	// SocketPath is the path of the attach socket of the container.
+	SocketPath string
+
+	// Options are the requested streams.
+	Options Options
+}
+
+// ExecRequest is the request to execute a command within a container.
+type ExecRequest struct {
+	// ID of the container.
+	ID string
+
+	// SocketPath is the path of the attach socket of the exec session.
+	SocketPath string
+
+	// Command is the command to execute.
+	Command []string
+
+	// Options are the requested streams.
+	Options Options
+}
+
+// Server serves attach and exec requests of Kubernetes clients.
+type Server struct {
+	client Client
+}
+
+// NewServer creates a new Server using the client, which is usually a
+// *client.ConmonClient.
+func NewServer(c Client) *Server {
+	return &Server{client: c}
+}
+
+// ServeAttach upgrades the request to a WebSocket connection and attaches it
+// to the container. The result of the session is reported on the error
+// channel of the protocol.
+func (s *Server) ServeAttach(w http.ResponseWriter, r *http.Request, req *AttachRequest) {
+	s.serve(w, r, &req.Options, func(ctx context.Context, cfg *client.AttachConfig) error {
+		cfg.ID = req.ID
+		cfg.SocketPath = req.SocketPath
+
+		return s.client.AttachContainer(ctx, cfg)
+	})
+}
+
+// ServeExec upgrades the request to a WebSocket connection, starts the
+// command and attaches the connection to it. The result of the session is
+// reported on the error channel of the protocol. The server does not retain
+// the exit codes of exec sessions, which is why a session gets reported as
+// successful if its output ends.
+func (s *Server) ServeExec(w http.ResponseWriter, r *http.Request, req *ExecRequest) {
+	s.serve(w, r, &req.Options, func(ctx context.Context, cfg *client.AttachConfig) error {
+		if len(req.Command) == 0 {
+			return errNoCommand
+		}
+
+		resp, err := s.client.ExecContainer(ctx, &client.ExecContainerConfig{
+			ID:       req.ID,
+			Command:  req.Command,
+			Terminal: req.Options.TTY,
+		})
+		if err != nil {
+			return fmt.Errorf("exec container: %w", err)
+		}
+
+		cfg.ID = req.ID
+		cfg.SocketPath = req.SocketPath
+		cfg.ExecSession = resp.ExecSession
+
+		return s.client.AttachContainer(ctx, cfg)
+	})
+}
+
+// serve runs the session using the WebSocket connection of the request.
+func (s *Server) serve(
+	w http.ResponseWriter,
+	r *http.Request,
+	opts *Options,
+	run func(context.Context, *client.AttachConfig) error,
+) {
+	if err := opts.validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	server := websocket.Server{
+		Handshake: handshake,
+		Handler: func(ws *websocket.Conn) {
+			newSession(r.Context(), ws, opts).run(run)
+		},
+	}
+	server.ServeHTTP(w, r)
+}
+
+// handshake selects the most preferred protocol requested by the client.
+func handshake(cfg *websocket.Config, _ *http.Request) error {
+	if len(cfg.Protocol) == 0 {
+		return nil
+	}
+
+	for _, supported := range SupportedProtocols {
+		for _, requested := range cfg.Protocol {
+			if requested == supported {
+				cfg.Protocol = []string{supported}
+
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: %v", errUnsupportedProtocol, cfg.Protocol)
+}
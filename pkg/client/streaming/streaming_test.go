@@ -0,0 +1,165 @@
+package streaming_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/containers/conmon-rs/pkg/client"
+	"github.com/containers/conmon-rs/pkg/client/streaming"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/websocket"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		fake   *fakeClient
+		server *httptest.Server
+	)
+
+	BeforeEach(func() {
+		fake = newFakeClient()
+		sut := streaming.NewServer(fake)
+		mux := http.NewServeMux()
+		mux.HandleFunc("/attach", func(w http.ResponseWriter, r *http.Request) {
+			opts, err := streaming.OptionsFromRequest(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+
+				return
+			}
+			sut.ServeAttach(w, r, &streaming.AttachRequest{ID: "ctr", SocketPath: "/attach", Options: *opts})
+		})
+		mux.HandleFunc("/exec", func(w http.ResponseWriter, r *http.Request) {
+			opts, err := streaming.OptionsFromRequest(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+
+				return
+			}
+			sut.ServeExec(w, r, &streaming.ExecRequest{
+				ID:         "ctr",
+				SocketPath: "/attach",
+				Command:    streaming.CommandFromRequest(r),
+				Options:    *opts,
+			})
+		})
+		server = httptest.NewServer(mux)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should attach the standard streams", func() {
+		ws, err := dial(server.URL+"/attach?stdin=1&stdout=1&stderr=1", streaming.ProtocolV4)
+		Expect(err).To(BeNil())
+		defer ws.Close()
+		Expect(ws.Config().Protocol).To(Equal([]string{streaming.ProtocolV4}))
+
+		Expect(websocket.Message.Send(ws, append([]byte{0}, "hello\n"...))).To(BeNil())
+
+		channel, payload := receive(ws)
+		Expect(channel).To(BeEquivalentTo(1))
+		Expect(payload).To(Equal("stdout: hello\n"))
+
+		channel, payload = receive(ws)
+		Expect(channel).To(BeEquivalentTo(2))
+		Expect(payload).To(Equal("stderr\n"))
+
+		channel, payload = receive(ws)
+		Expect(channel).To(BeEquivalentTo(3))
+		Expect(payload).To(MatchJSON(`{"metadata":{},"status":"Success"}`))
+
+		Expect(fake.attachCfg.ID).To(Equal("ctr"))
+		Expect(fake.attachCfg.SocketPath).To(Equal("/attach"))
+	})
+
+	It("should use base64 encoded frames", func() {
+		ws, err := dial(server.URL+"/attach?stdin=true&stdout=true", streaming.ProtocolV4Base64)
+		Expect(err).To(BeNil())
+		defer ws.Close()
+
+		Expect(websocket.Message.Send(ws, "0"+base64.StdEncoding.EncodeToString([]byte("hello\n")))).To(BeNil())
+
+		channel, payload := receive(ws)
+		Expect(channel).To(BeEquivalentTo(1))
+		Expect(payload).To(Equal("stdout: hello\n"))
+	})
+
+	It("should prefer the latest protocol", func() {
+		ws, err := dial(server.URL+"/attach?stderr=1", streaming.ProtocolChannel, streaming.ProtocolV5)
+		Expect(err).To(BeNil())
+		defer ws.Close()
+		Expect(ws.Config().Protocol).To(Equal([]string{streaming.ProtocolV5}))
+	})
+
+	It("should report failures", func() {
+		fake.attachErr = errors.New("attach failed")
+		ws, err := dial(server.URL+"/attach?stderr=1", streaming.ProtocolV4)
+		Expect(err).To(BeNil())
+		defer ws.Close()
+
+		channel, _ := receive(ws)
+		Expect(channel).To(BeEquivalentTo(2))
+
+		channel, payload := receive(ws)
+		Expect(channel).To(BeEquivalentTo(3))
+		Expect(payload).To(ContainSubstring(`"status":"Failure"`))
+		Expect(payload).To(ContainSubstring("attach failed"))
+	})
+
+	It("should report failures as text for the channel protocol", func() {
+		fake.attachErr = errors.New("attach failed")
+		ws, err := dial(server.URL+"/attach?stderr=1", streaming.ProtocolChannel)
+		Expect(err).To(BeNil())
+		defer ws.Close()
+
+		receive(ws)
+		channel, payload := receive(ws)
+		Expect(channel).To(BeEquivalentTo(3))
+		Expect(payload).To(Equal("attach failed"))
+	})
+
+	It("should resize the terminal", func() {
+		ws, err := dial(server.URL+"/attach?stdin=1&stdout=1&tty=1", streaming.ProtocolV5)
+		Expect(err).To(BeNil())
+		defer ws.Close()
+
+		Expect(websocket.Message.Send(ws, append([]byte{4}, `{"Width":80,"Height":24}`...))).To(BeNil())
+		Eventually(fake.resizes).Should(Receive(Equal(client.TerminalSize{Width: 80, Height: 24})))
+
+		Expect(websocket.Message.Send(ws, append([]byte{0}, "hello\n"...))).To(BeNil())
+		channel, _ := receive(ws)
+		Expect(channel).To(BeEquivalentTo(1))
+		Expect(fake.attachCfg.Tty).To(BeTrue())
+	})
+
+	It("should execute commands", func() {
+		ws, err := dial(server.URL+"/exec?stderr=1&command=sh&command=-c&command=true", streaming.ProtocolV4)
+		Expect(err).To(BeNil())
+		defer ws.Close()
+
+		receive(ws)
+		channel, payload := receive(ws)
+		Expect(channel).To(BeEquivalentTo(3))
+		Expect(payload).To(MatchJSON(`{"metadata":{},"status":"Success"}`))
+
+		Expect(fake.execCfg.Command).To(Equal([]string{"sh", "-c", "true"}))
+		Expect(fake.attachCfg.ExecSession).To(Equal("session"))
+	})
+
+	It("should reject unsupported protocols", func() {
+		_, err := dial(server.URL+"/attach?stdout=1", "v3.channel.k8s.io")
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("should reject invalid options", func() {
+		for _, query := range []string{"", "?stdin=invalid", "?stderr=1&tty=1"} {
+			_, err := dial(server.URL+"/attach"+query, streaming.ProtocolV4)
+			Expect(err).NotTo(BeNil(), query)
+		}
+	})
+})
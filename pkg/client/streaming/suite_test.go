@@ -0,0 +1,101 @@
+package streaming_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/containers/conmon-rs/pkg/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/websocket"
+)
+
+// TestStreaming runs the created specs.
+func TestStreaming(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Streaming")
+}
+
+// fakeClient echoes a line of the standard input to the standard output and
+// writes a line to the standard error.
+type fakeClient struct {
+	attachErr  error
+	attachCfg  *client.AttachConfig
+	execCfg    *client.ExecContainerConfig
+	resizes    chan client.TerminalSize
+	execResult *client.ExecContainerResponse
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		resizes:    make(chan client.TerminalSize, 10),
+		execResult: &client.ExecContainerResponse{ExecSession: "session", PID: 1},
+	}
+}
+
+func (f *fakeClient) AttachContainer(_ context.Context, cfg *client.AttachConfig) error {
+	f.attachCfg = cfg
+
+	if cfg.Resize != nil {
+		go func() {
+			for size := range cfg.Resize {
+				f.resizes <- size
+			}
+		}()
+	}
+
+	if cfg.Streams.Stdin != nil && cfg.Streams.Stdout != nil {
+		line, err := bufio.NewReader(cfg.Streams.Stdin).ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		if _, err := cfg.Streams.Stdout.Write([]byte("stdout: " + line)); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Streams.Stderr != nil {
+		if _, err := cfg.Streams.Stderr.Write([]byte("stderr\n")); err != nil {
+			return err
+		}
+	}
+
+	return f.attachErr
+}
+
+func (f *fakeClient) ExecContainer(
+	_ context.Context, cfg *client.ExecContainerConfig,
+) (*client.ExecContainerResponse, error) {
+	f.execCfg = cfg
+
+	return f.execResult, nil
+}
+
+// dial connects to the test server using the protocols.
+func dial(url string, protocols ...string) (*websocket.Conn, error) {
+	cfg, err := websocket.NewConfig("ws"+strings.TrimPrefix(url, "http"), "http://localhost")
+	Expect(err).To(BeNil())
+	cfg.Protocol = protocols
+
+	return websocket.DialConfig(cfg)
+}
+
+// receive returns the channel and payload of the next message.
+func receive(ws *websocket.Conn) (byte, string) {
+	var message []byte
+	Expect(websocket.Message.Receive(ws, &message)).To(BeNil())
+	Expect(message).NotTo(BeEmpty())
+
+	if ws.Config().Protocol[0] == "v4.base64.channel.k8s.io" {
+		payload, err := base64.StdEncoding.DecodeString(string(message[1:]))
+		Expect(err).To(BeNil())
+
+		return message[0] - '0', string(payload)
+	}
+
+	return message[0], string(message[1:])
+}
@@ -15,6 +15,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -374,3 +376,64 @@ func testAttach(stdinWrite io.Writer, stdoutRead, stderrRead io.Reader) {
 		Expect(line).To(ContainSubstring("Hello world"))
 	}()
 }
+
+// upperWriter converts all written data to upper case.
+type upperWriter struct {
+	io.Writer
+}
+
+func (u upperWriter) Write(p []byte) (int, error) {
+	return u.Writer.Write(bytes.ToUpper(p))
+}
+
+// slowWriter simulates a slow consumer by delaying every write.
+type slowWriter struct {
+	delay time.Duration
+	n     int64
+}
+
+func (s *slowWriter) Write(p []byte) (int, error) {
+	time.Sleep(s.delay)
+	atomic.AddInt64(&s.n, int64(len(p)))
+
+	return len(p), nil
+}
+
+func (s *slowWriter) Close() error {
+	return nil
+}
+
+func (s *slowWriter) written() int64 {
+	return atomic.LoadInt64(&s.n)
+}
+
+// recordingTracer records the ended spans.
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []string
+}
+
+func (r *recordingTracer) Tracer(string) client.Tracer {
+	return r
+}
+
+func (r *recordingTracer) Start(ctx context.Context, name string) (context.Context, func(error)) {
+	return ctx, func(err error) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.spans = append(r.spans, fmt.Sprintf("%s: %v", name, err))
+	}
+}
+
+func (r *recordingTracer) ended() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string{}, r.spans...)
+}
+
+type fakeContainerLister []client.ContainerInfo
+
+func (f fakeContainerLister) ListContainers(context.Context, ...client.LabelSelector) ([]client.ContainerInfo, error) {
+	return f, nil
+}
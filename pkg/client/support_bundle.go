@@ -0,0 +1,209 @@
+package client
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// supportBundleState is the server state which is only available via the
+// support bundle RPC.
+type supportBundleState struct {
+	config string
+	logs   []string
+	events []ContainerEvent
+}
+
+// supportBundleEvent is the serialized form of a ContainerEvent.
+type supportBundleEvent struct {
+	Type      string    `json:"type"`
+	ID        string    `json:"id"`
+	ExitCode  int32     `json:"exitCode"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// supportBundleStats are the serialized statistics of a single container.
+type supportBundleStats struct {
+	Stats *ContainerStats `json:"stats,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// CollectSupportBundle writes a gzip compressed tar archive to w, which
+// contains the version information and configuration of the server, the
+// supervised containers including their statistics, the most recent
+// container events and the most recent server log lines. The bundle is only
+// available to the default tenant.
+func (c *ConmonClient) CollectSupportBundle(ctx context.Context, w io.Writer) error {
+	version, err := c.Version(ctx)
+	if err != nil {
+		return fmt.Errorf("get version: %w", err)
+	}
+
+	state, err := c.supportBundle(ctx)
+	if err != nil {
+		return err
+	}
+
+	containers, err := c.ListContainers(ctx)
+	if err != nil {
+		return fmt.Errorf("list containers: %w", err)
+	}
+
+	// Containers may exit while collecting, which should not fail the bundle.
+	stats := make(map[string]supportBundleStats, len(containers))
+	for _, container := range containers {
+		containerStats, err := c.ContainerStats(ctx, container.ID)
+		if err != nil {
+			stats[container.ID] = supportBundleStats{Error: err.Error()}
+
+			continue
+		}
+		stats[container.ID] = supportBundleStats{Stats: containerStats}
+	}
+
+	events := make([]supportBundleEvent, 0, len(state.events))
+	for _, event := range state.events {
+		events = append(events, supportBundleEvent{
+			Type:      event.Type.String(),
+			ID:        event.ID,
+			ExitCode:  event.ExitCode,
+			Timestamp: event.Timestamp,
+		})
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	now := time.Now()
+
+	for _, file := range []struct {
+		name string
+		data any
+	}{
+		{"version.json", version},
+		{"containers.json", containers},
+		{"stats.json", stats},
+		{"events.json", events},
+	} {
+		data, err := json.MarshalIndent(file.data, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal %s: %w", file.name, err)
+		}
+
+		if err := writeTarFile(tarWriter, file.name, data, now); err != nil {
+			return err
+		}
+	}
+
+	if err := writeTarFile(tarWriter, "config.txt", []byte(state.config), now); err != nil {
+		return err
+	}
+
+	logs := strings.Join(state.logs, "\n") + "\n"
+	if err := writeTarFile(tarWriter, "server.log", []byte(logs), now); err != nil {
+		return err
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("close tar writer: %w", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return fmt.Errorf("close gzip writer: %w", err)
+	}
+
+	return nil
+}
+
+func writeTarFile(tarWriter *tar.Writer, name string, data []byte, modTime time.Time) error {
+	if err := tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}); err != nil {
+		return fmt.Errorf("write header of %s: %w", name, err)
+	}
+
+	if _, err := tarWriter.Write(data); err != nil {
+		return fmt.Errorf("write %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func (c *ConmonClient) supportBundle(ctx context.Context) (*supportBundleState, error) {
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("SupportBundle")()
+	diag := c.diagnoseRPC("SupportBundle")
+	future, free := client.SupportBundle(ctx, func(p proto.Conmon_supportBundle_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	state := &supportBundleState{}
+	if state.config, err = response.Config(); err != nil {
+		return nil, fmt.Errorf("get config: %w", err)
+	}
+
+	logs, err := response.Logs()
+	if err != nil {
+		return nil, fmt.Errorf("get logs: %w", err)
+	}
+
+	for i := 0; i < logs.Len(); i++ {
+		line, err := logs.At(i)
+		if err != nil {
+			return nil, fmt.Errorf("get log line: %w", err)
+		}
+		state.logs = append(state.logs, line)
+	}
+
+	events, err := response.Events()
+	if err != nil {
+		return nil, fmt.Errorf("get events: %w", err)
+	}
+
+	for i := 0; i < events.Len(); i++ {
+		event, err := containerEventFromProto(events.At(i))
+		if err != nil {
+			return nil, err
+		}
+		state.events = append(state.events, event)
+	}
+
+	return state, nil
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// SysctlRejectionReason specifies why a sysctl got rejected by the server.
+type SysctlRejectionReason int
+
+const (
+	// SysctlRejectionReasonInvalidKey indicates that the key is not a valid
+	// sysctl name.
+	SysctlRejectionReasonInvalidKey SysctlRejectionReason = iota
+
+	// SysctlRejectionReasonNotAllowed indicates that the key is not part of
+	// the namespaced sysctl allowlist.
+	SysctlRejectionReasonNotAllowed
+
+	// SysctlRejectionReasonInvalidValue indicates that the value contains
+	// invalid characters.
+	SysctlRejectionReasonInvalidValue
+
+	// SysctlRejectionReasonHostNamespace indicates that the container shares
+	// the namespace of the sysctl with the host.
+	SysctlRejectionReasonHostNamespace
+
+	// SysctlRejectionReasonUnknown indicates a rejection reason which is not
+	// known by the client.
+	SysctlRejectionReasonUnknown
+)
+
+// String returns the string representation of the rejection reason.
+func (s SysctlRejectionReason) String() string {
+	switch s {
+	case SysctlRejectionReasonInvalidKey:
+		return "invalid key"
+	case SysctlRejectionReasonNotAllowed:
+		return "not allowed"
+	case SysctlRejectionReasonInvalidValue:
+		return "invalid value"
+	case SysctlRejectionReasonHostNamespace:
+		return "host namespace"
+	case SysctlRejectionReasonUnknown:
+	}
+
+	return "unknown"
+}
+
+// SysctlRejectedError is returned by UpdateSysctls if the server rejected at
+// least one of the provided sysctls. None of the sysctls are applied in that
+// case.
+type SysctlRejectedError struct {
+	// Rejections maps the rejected sysctl keys to their rejection reason.
+	Rejections map[string]SysctlRejectionReason
+}
+
+// Error returns the string representation of the error.
+func (s *SysctlRejectedError) Error() string {
+	keys := make([]string, 0, len(s.Rejections))
+	for key := range s.Rejections {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	rejections := make([]string, 0, len(keys))
+	for _, key := range keys {
+		rejections = append(rejections, fmt.Sprintf("%s (%s)", key, s.Rejections[key]))
+	}
+
+	return "sysctls rejected: " + strings.Join(rejections, ", ")
+}
+
+// UpdateSysctls can be used to apply namespaced sysctls to a running
+// container. The server validates every sysctl against its allowlist and
+// returns a *SysctlRejectedError if any of them got rejected.
+func (c *ConmonClient) UpdateSysctls(ctx context.Context, id string, sysctls map[string]string) error {
+	id, err := c.containerID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("UpdateSysctls")()
+	diag := c.diagnoseRPC("UpdateSysctls")
+
+	future, free := client.UpdateSysctls(ctx, func(p proto.Conmon_updateSysctls_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := initSysctls(&req, sysctls); err != nil {
+			return fmt.Errorf("init sysctls: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	rejections, err := response.Rejections()
+	if err != nil {
+		return fmt.Errorf("get rejections: %w", err)
+	}
+
+	if rejections.Len() == 0 {
+		return nil
+	}
+
+	rejectedErr := &SysctlRejectedError{Rejections: make(map[string]SysctlRejectionReason)}
+	for i := 0; i < rejections.Len(); i++ {
+		rejection := rejections.At(i)
+		key, err := rejection.Key()
+		if err != nil {
+			return fmt.Errorf("get rejection key: %w", err)
+		}
+
+		switch rejection.Reason() {
+		case proto.Conmon_SysctlRejection_Reason_invalidKey:
+			rejectedErr.Rejections[key] = SysctlRejectionReasonInvalidKey
+		case proto.Conmon_SysctlRejection_Reason_notAllowed:
+			rejectedErr.Rejections[key] = SysctlRejectionReasonNotAllowed
+		case proto.Conmon_SysctlRejection_Reason_invalidValue:
+			rejectedErr.Rejections[key] = SysctlRejectionReasonInvalidValue
+		case proto.Conmon_SysctlRejection_Reason_hostNamespace:
+			rejectedErr.Rejections[key] = SysctlRejectionReasonHostNamespace
+		default:
+			rejectedErr.Rejections[key] = SysctlRejectionReasonUnknown
+		}
+	}
+
+	return rejectedErr
+}
+
+func initSysctls(req *proto.Conmon_UpdateSysctlsRequest, sysctls map[string]string) error {
+	newSysctls, err := req.NewSysctls(int32(len(sysctls)))
+	if err != nil {
+		return fmt.Errorf("create sysctls: %w", err)
+	}
+
+	i := 0
+	for key, value := range sysctls {
+		n := newSysctls.At(i)
+		if err := n.SetKey(key); err != nil {
+			return fmt.Errorf("set sysctl key: %w", err)
+		}
+		if err := n.SetValue(value); err != nil {
+			return fmt.Errorf("set sysctl value: %w", err)
+		}
+		i++
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// QuotaResource is a resource which can be limited per tenant.
+type QuotaResource string
+
+const (
+	// QuotaResourceContainers is the number of containers of a tenant.
+	QuotaResourceContainers QuotaResource = "containers"
+
+	// QuotaResourceExecSessions is the number of exec sessions of a tenant.
+	QuotaResourceExecSessions QuotaResource = "execSessions"
+
+	// QuotaResourceLogBytes is the number of container log bytes written for
+	// a tenant.
+	QuotaResourceLogBytes QuotaResource = "logBytes"
+)
+
+// ErrQuotaExceeded is the error matched by every QuotaExceededError.
+var ErrQuotaExceeded = errors.New("tenant quota exceeded")
+
+// quotaExceededRegexp matches the error message of the server, which has to
+// be kept in sync with `conmon-rs/server/src/tenant_quota.rs`.
+var quotaExceededRegexp = regexp.MustCompile(`tenant quota exceeded for (\w+): limit of (\d+) reached`)
+
+// QuotaExceededError is returned if a request would exceed a quota of the
+// tenant.
+type QuotaExceededError struct {
+	// Resource is the exhausted resource.
+	Resource QuotaResource
+
+	// Limit is the configured limit of the resource.
+	Limit uint64
+
+	err error
+}
+
+// Error returns the error message of the server.
+func (e *QuotaExceededError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying RPC error.
+func (e *QuotaExceededError) Unwrap() error {
+	return e.err
+}
+
+// Is returns true if the target is ErrQuotaExceeded.
+func (e *QuotaExceededError) Is(target error) bool {
+	return target == ErrQuotaExceeded
+}
+
+// quotaError converts err into a QuotaExceededError if the server rejected
+// the request because of an exhausted quota.
+func quotaError(err error) error {
+	matches := quotaExceededRegexp.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return err
+	}
+
+	limit, parseErr := strconv.ParseUint(matches[2], 10, 64)
+	if parseErr != nil {
+		return err
+	}
+
+	return &QuotaExceededError{
+		Resource: QuotaResource(matches[1]),
+		Limit:    limit,
+		err:      err,
+	}
+}
+
+// QuotaUsage is the usage of a single tenant resource.
+type QuotaUsage struct {
+	// Used is the currently used amount of the resource.
+	Used uint64
+
+	// Limit is the configured limit of the resource, zero if unlimited.
+	Limit uint64
+}
+
+// TenantQuota contains the quota limits and resource usage of a tenant.
+type TenantQuota struct {
+	// Containers is the number of containers.
+	Containers QuotaUsage
+
+	// ExecSessions is the number of exec sessions.
+	ExecSessions QuotaUsage
+
+	// LogBytes is the number of written container log bytes.
+	LogBytes QuotaUsage
+}
+
+// TenantQuota retrieves the quota limits and resource usage of the tenant
+// of the client, see WithTenant. The limits are configured per server in
+// ConmonServerConfig.
+func (c *ConmonClient) TenantQuota(ctx context.Context) (*TenantQuota, error) {
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("TenantQuota")()
+	diag := c.diagnoseRPC("TenantQuota")
+	future, free := client.TenantQuota(ctx, func(p proto.Conmon_tenantQuota_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	containers, err := response.Containers()
+	if err != nil {
+		return nil, fmt.Errorf("get containers: %w", err)
+	}
+
+	execSessions, err := response.ExecSessions()
+	if err != nil {
+		return nil, fmt.Errorf("get exec sessions: %w", err)
+	}
+
+	logBytes, err := response.LogBytes()
+	if err != nil {
+		return nil, fmt.Errorf("get log bytes: %w", err)
+	}
+
+	return &TenantQuota{
+		Containers:   QuotaUsage{Used: containers.Used(), Limit: containers.Limit()},
+		ExecSessions: QuotaUsage{Used: execSessions.Used(), Limit: execSessions.Limit()},
+		LogBytes:     QuotaUsage{Used: logBytes.Used(), Limit: logBytes.Limit()},
+	}, nil
+}
@@ -0,0 +1,24 @@
+package client
+
+// TerminalSize is the size of a terminal in characters.
+type TerminalSize struct {
+	Width  uint16
+	Height uint16
+}
+
+// handleResizing spawns a goroutine calling resizeFunc for every non-empty
+// size received from the resize channel, until the channel gets closed.
+func handleResizing(resize <-chan TerminalSize, resizeFunc func(size TerminalSize)) {
+	if resize == nil {
+		return
+	}
+
+	go func() {
+		for size := range resize {
+			if size.Height < 1 || size.Width < 1 {
+				continue
+			}
+			resizeFunc(size)
+		}
+	}()
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// Tombstone is the record of a removed container, which is kept by the
+// server for ConmonServerConfig.TombstoneRetention.
+type Tombstone struct {
+	// ID of the container.
+	ID string
+
+	// PID of the container process.
+	PID uint32
+
+	// ExitCode of the container process.
+	ExitCode int32
+
+	// OOMKilled is true if the container got killed because it ran out of
+	// memory.
+	OOMKilled bool
+
+	// TimedOut is true if the container got killed because of its timeout.
+	TimedOut bool
+
+	// ExitedAt is the time the server noticed the exit.
+	ExitedAt time.Time
+
+	// RemovedAt is the time the server stopped monitoring the container.
+	RemovedAt time.Time
+
+	// Stats are the last statistics retrieved via ContainerStats while the
+	// container was running, nil if they have never been retrieved.
+	Stats *ContainerStats
+
+	// LogPaths are the log files of the container.
+	LogPaths []string
+}
+
+// GetTombstone retrieves the tombstone of a removed container. The returned
+// error matches ErrContainerNotFound if the server has no tombstone of the
+// container, because it has not been removed, its tombstone expired or
+// tombstones are disabled.
+func (c *ConmonClient) GetTombstone(ctx context.Context, id string) (*Tombstone, error) {
+	// The ID is not resolved because removed containers are not known
+	// anymore.
+	id, err := c.newContainerID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("GetTombstone")()
+	diag := c.diagnoseRPC("GetTombstone")
+	future, free := client.GetTombstone(ctx, func(p proto.Conmon_getTombstone_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return nil, fmt.Errorf("create result: %w", err)
+	}
+
+	response, err := result.Response()
+	if err != nil {
+		return nil, fmt.Errorf("set response: %w", err)
+	}
+
+	if err := responseError(response); err != nil {
+		return nil, err
+	}
+
+	tombstone, err := response.Tombstone()
+	if err != nil {
+		return nil, fmt.Errorf("get tombstone: %w", err)
+	}
+
+	return tombstoneFromProto(tombstone)
+}
+
+func tombstoneFromProto(tombstone proto.Conmon_Tombstone) (*Tombstone, error) {
+	id, err := tombstone.Id()
+	if err != nil {
+		return nil, fmt.Errorf("get ID: %w", err)
+	}
+
+	logPaths, err := tombstone.LogPaths()
+	if err != nil {
+		return nil, fmt.Errorf("get log paths: %w", err)
+	}
+
+	res := &Tombstone{
+		ID:        id,
+		PID:       tombstone.Pid(),
+		ExitCode:  tombstone.ExitCode(),
+		OOMKilled: tombstone.OomKilled(),
+		TimedOut:  tombstone.TimedOut(),
+		ExitedAt:  time.Unix(0, int64(tombstone.ExitedAt())),
+		RemovedAt: time.Unix(0, int64(tombstone.RemovedAt())),
+		LogPaths:  make([]string, 0, logPaths.Len()),
+	}
+
+	for i := 0; i < logPaths.Len(); i++ {
+		logPath, err := logPaths.At(i)
+		if err != nil {
+			return nil, fmt.Errorf("get log path: %w", err)
+		}
+		res.LogPaths = append(res.LogPaths, logPath)
+	}
+
+	if tombstone.HasStats() {
+		stats, err := tombstone.Stats()
+		if err != nil {
+			return nil, fmt.Errorf("get stats: %w", err)
+		}
+
+		res.Stats, err = statsFromProto(stats)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
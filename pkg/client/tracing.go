@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// tracerName is the instrumentation name of the tracer of the client.
+const tracerName = "github.com/containers/conmon-rs/pkg/client"
+
+// TracerProvider provides the Tracer of the client. It mirrors the
+// OpenTelemetry TracerProvider, which can be used by a small adapter.
+type TracerProvider interface {
+	// Tracer returns the tracer of the instrumentation name.
+	Tracer(name string) Tracer
+}
+
+// Tracer starts the spans of the client operations.
+type Tracer interface {
+	// Start starts a span as child of the span in ctx, if any. The returned
+	// context contains the new span, which is ended by calling the returned
+	// function with the error of the operation.
+	Start(ctx context.Context, name string) (context.Context, func(err error))
+}
+
+// TraceContext is the W3C trace context of a span.
+type TraceContext struct {
+	// Traceparent identifies the trace and the span of the caller.
+	Traceparent string
+
+	// Tracestate contains vendor specific trace information.
+	Tracestate string
+}
+
+// TracePropagator extracts the trace context from ctx, which gets injected
+// into the requests to link the spans of the server with the trace of the
+// caller. With OpenTelemetry, it injects ctx into a propagation.MapCarrier
+// using the TraceContext propagator and returns its fields.
+type TracePropagator func(ctx context.Context) TraceContext
+
+// noopTracer is the Tracer used if no TracerProvider is configured.
+type noopTracer struct{}
+
+// Start returns ctx and a function doing nothing.
+func (noopTracer) Start(ctx context.Context, _ string) (context.Context, func(error)) {
+	return ctx, func(error) {}
+}
+
+// startSpan starts a span of the client operation. The returned function
+// ends it with the error referenced by errp, which allows deferring it.
+func (c *ConmonClient) startSpan(ctx context.Context, name string) (context.Context, func(errp *error)) {
+	ctx, end := c.tracer.Start(ctx, name)
+
+	return ctx, func(errp *error) {
+		end(*errp)
+	}
+}
+
+// initTraceContext injects the trace context of ctx into a request, if a
+// TracePropagator is configured.
+func (c *ConmonClient) initTraceContext(
+	ctx context.Context, newTraceContext func() (proto.Conmon_TraceContext, error),
+) error {
+	if c.tracePropagator == nil {
+		return nil
+	}
+
+	traceContext := c.tracePropagator(ctx)
+	if traceContext.Traceparent == "" {
+		return nil
+	}
+
+	req, err := newTraceContext()
+	if err != nil {
+		return fmt.Errorf("create trace context: %w", err)
+	}
+
+	if err := req.SetTraceparent(traceContext.Traceparent); err != nil {
+		return fmt.Errorf("set traceparent: %w", err)
+	}
+
+	if err := req.SetTracestate(traceContext.Tracestate); err != nil {
+		return fmt.Errorf("set tracestate: %w", err)
+	}
+
+	return nil
+}
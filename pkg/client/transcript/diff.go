@@ -0,0 +1,227 @@
+package transcript
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrMismatch is matched by the error of a Report with mismatches.
+var ErrMismatch = errors.New("transcripts differ")
+
+// MismatchKind is the kind of a difference between two transcripts.
+type MismatchKind int
+
+const (
+	// MismatchChanged indicates a line which differs between the
+	// transcripts.
+	MismatchChanged MismatchKind = iota
+
+	// MismatchMissing indicates a line of the expected transcript which is
+	// missing in the actual one.
+	MismatchMissing
+
+	// MismatchUnexpected indicates a line of the actual transcript which is
+	// missing in the expected one.
+	MismatchUnexpected
+)
+
+// String returns the name of the kind.
+func (k MismatchKind) String() string {
+	switch k {
+	case MismatchChanged:
+		return "changed"
+	case MismatchMissing:
+		return "missing"
+	case MismatchUnexpected:
+		return "unexpected"
+	}
+
+	return fmt.Sprintf("MismatchKind(%d)", int(k))
+}
+
+// Mismatch is a difference of a single line between two transcripts.
+type Mismatch struct {
+	// Kind of the difference.
+	Kind MismatchKind
+
+	// Stream containing the line.
+	Stream Stream
+
+	// WantLine is the 1-based line number within the stream of the expected
+	// transcript, zero for unexpected lines.
+	WantLine int
+
+	// GotLine is the 1-based line number within the stream of the actual
+	// transcript, zero for missing lines.
+	GotLine int
+
+	// Want is the expected line, empty for unexpected lines.
+	Want string
+
+	// Got is the actual line, empty for missing lines.
+	Got string
+}
+
+// String describes the mismatch.
+func (m *Mismatch) String() string {
+	switch m.Kind {
+	case MismatchMissing:
+		return fmt.Sprintf("%s line %d missing: %q", m.Stream, m.WantLine, m.Want)
+	case MismatchUnexpected:
+		return fmt.Sprintf("%s line %d unexpected: %q", m.Stream, m.GotLine, m.Got)
+	}
+
+	return fmt.Sprintf("%s line %d changed: want %q, got %q", m.Stream, m.WantLine, m.Want, m.Got)
+}
+
+// Report is the result of comparing two transcripts.
+type Report struct {
+	// Mismatches of the input followed by the ones of the output, each in
+	// the order of the lines.
+	Mismatches []Mismatch
+}
+
+// Equal returns whether the transcripts match.
+func (r *Report) Equal() bool {
+	return len(r.Mismatches) == 0
+}
+
+// String describes all mismatches, one per line.
+func (r *Report) String() string {
+	lines := make([]string, 0, len(r.Mismatches))
+	for i := range r.Mismatches {
+		lines = append(lines, r.Mismatches[i].String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Err returns an error matching ErrMismatch, which describes the mismatches,
+// or nil if the transcripts match.
+func (r *Report) Err() error {
+	if r.Equal() {
+		return nil
+	}
+
+	return fmt.Errorf("%w:\n%s", ErrMismatch, r)
+}
+
+// Diff compares the expected and the actual transcript line by line, per
+// stream. The interleaving of input and output is not compared, because it
+// depends on the timing of a session. The streams of both transcripts get
+// normalized with the options before comparing them.
+func Diff(want, got *Transcript, opts *NormalizeOptions) *Report {
+	if opts == nil {
+		opts = &NormalizeOptions{}
+	}
+
+	report := &Report{}
+	for _, stream := range []Stream{StreamInput, StreamOutput} {
+		if opts.IgnoreInput && stream == StreamInput {
+			continue
+		}
+
+		report.Mismatches = append(report.Mismatches, diffLines(
+			stream,
+			splitLines(opts.normalize(want.Text(stream))),
+			splitLines(opts.normalize(got.Text(stream))),
+		)...)
+	}
+
+	return report
+}
+
+// DiffFiles compares the asciicast recordings at the paths, for example a
+// golden file with the recording of a test.
+func DiffFiles(wantPath, gotPath string, opts *NormalizeOptions) (*Report, error) {
+	want, err := Load(wantPath)
+	if err != nil {
+		return nil, fmt.Errorf("load expected transcript: %w", err)
+	}
+
+	got, err := Load(gotPath)
+	if err != nil {
+		return nil, fmt.Errorf("load actual transcript: %w", err)
+	}
+
+	return Diff(want, got, opts), nil
+}
+
+// splitLines splits the text into lines without their endings. A missing
+// final line ending does not add an empty line.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffLines reports the lines which are not part of the longest common
+// subsequence of the expected and actual lines. Lines at the same position,
+// which are both not part of it, are reported as changed line.
+func diffLines(stream Stream, want, got []string) []Mismatch {
+	// common[i][j] is the length of the longest common subsequence of
+	// want[i:] and got[j:].
+	common := make([][]int, len(want)+1)
+	for i := range common {
+		common[i] = make([]int, len(got)+1)
+	}
+
+	for i := len(want) - 1; i >= 0; i-- {
+		for j := len(got) - 1; j >= 0; j-- {
+			switch {
+			case want[i] == got[j]:
+				common[i][j] = common[i+1][j+1] + 1
+			case common[i+1][j] >= common[i][j+1]:
+				common[i][j] = common[i+1][j]
+			default:
+				common[i][j] = common[i][j+1]
+			}
+		}
+	}
+
+	var res []Mismatch
+	i, j := 0, 0
+	for i < len(want) || j < len(got) {
+		switch {
+		case i < len(want) && j < len(got) && want[i] == got[j]:
+			i++
+			j++
+
+		case i < len(want) && j < len(got) && common[i][j] == common[i+1][j+1]:
+			// Neither line is part of the common subsequence.
+			res = append(res, Mismatch{
+				Kind:     MismatchChanged,
+				Stream:   stream,
+				WantLine: i + 1,
+				GotLine:  j + 1,
+				Want:     want[i],
+				Got:      got[j],
+			})
+			i++
+			j++
+
+		case j == len(got) || (i < len(want) && common[i+1][j] >= common[i][j+1]):
+			res = append(res, Mismatch{
+				Kind:     MismatchMissing,
+				Stream:   stream,
+				WantLine: i + 1,
+				Want:     want[i],
+			})
+			i++
+
+		default:
+			res = append(res, Mismatch{
+				Kind:    MismatchUnexpected,
+				Stream:  stream,
+				GotLine: j + 1,
+				Got:     got[j],
+			})
+			j++
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,110 @@
+package transcript
+
+import (
+	"regexp"
+	"strings"
+)
+
+// TimestampPlaceholder replaces the timestamps within the transferred data
+// during normalization.
+const TimestampPlaceholder = "<timestamp>"
+
+var (
+	// ansiPattern matches the CSI, OSC and two byte escape sequences of
+	// terminals.
+	ansiPattern = regexp.MustCompile(
+		`\x1b\[[0-?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)|\x1b[@-Z\\-_]`,
+	)
+
+	// timestampPattern matches RFC 3339 like timestamps, as written by date
+	// or log prefixes.
+	timestampPattern = regexp.MustCompile(
+		`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?(?:Z|[+-]\d{2}:?\d{2})?`,
+	)
+)
+
+// Replacement replaces the matches of a pattern within the transferred data,
+// for example to mask IDs or host names.
+type Replacement struct {
+	// Pattern to be replaced.
+	Pattern *regexp.Regexp
+
+	// Replace is the replacement, which may refer to submatches like
+	// regexp.Regexp.ReplaceAllString.
+	Replace string
+}
+
+// NormalizeOptions configure the normalization of transcripts. The zero value
+// removes all details known to vary between runs.
+type NormalizeOptions struct {
+	// KeepANSI keeps the escape sequences of terminals, like colors and
+	// cursor movements.
+	KeepANSI bool
+
+	// KeepCarriageReturns keeps the carriage returns of line endings, which
+	// terminals add to every line.
+	KeepCarriageReturns bool
+
+	// KeepTimestamps keeps the timestamps within the transferred data
+	// instead of replacing them by the TimestampPlaceholder.
+	KeepTimestamps bool
+
+	// IgnoreInput removes the standard input, for example because it gets
+	// echoed by terminals anyway.
+	IgnoreInput bool
+
+	// Replacements are applied in order after the other normalizations.
+	Replacements []Replacement
+}
+
+// Normalize returns a copy of the transcript without the varying details of
+// the options. Consecutive events of the same stream get merged, because the
+// chunking of the transfers depends on the timing of a session, which also
+// normalizes escape sequences and timestamps split between transfers.
+func (t *Transcript) Normalize(opts *NormalizeOptions) *Transcript {
+	if opts == nil {
+		opts = &NormalizeOptions{}
+	}
+
+	res := &Transcript{}
+	for _, event := range t.Events {
+		if opts.IgnoreInput && event.Stream == StreamInput {
+			continue
+		}
+
+		if last := len(res.Events) - 1; last >= 0 && res.Events[last].Stream == event.Stream {
+			res.Events[last].Data += event.Data
+
+			continue
+		}
+
+		res.Events = append(res.Events, event)
+	}
+
+	for i := range res.Events {
+		res.Events[i].Data = opts.normalize(res.Events[i].Data)
+	}
+
+	return res
+}
+
+// normalize applies the options to the data of an event.
+func (o *NormalizeOptions) normalize(data string) string {
+	if !o.KeepANSI {
+		data = ansiPattern.ReplaceAllString(data, "")
+	}
+
+	if !o.KeepCarriageReturns {
+		data = strings.ReplaceAll(data, "\r\n", "\n")
+	}
+
+	if !o.KeepTimestamps {
+		data = timestampPattern.ReplaceAllString(data, TimestampPlaceholder)
+	}
+
+	for _, r := range o.Replacements {
+		data = r.Pattern.ReplaceAllString(data, r.Replace)
+	}
+
+	return data
+}
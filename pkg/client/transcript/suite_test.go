@@ -0,0 +1,14 @@
+package transcript_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// TestTranscript runs the created specs.
+func TestTranscript(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Transcript")
+}
@@ -0,0 +1,220 @@
+// Package transcript compares attach sessions recorded via
+// client.AttachRecording, which allows golden-file testing of interactive
+// containers. Recordings get normalized before comparing them, which removes
+// the timing, terminal escape sequences and other details varying between
+// runs, and their differences are reported line by line per stream.
+package transcript
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Stream is a recorded stream of an attach session, which matches the event
+// types of asciicast recordings.
+type Stream string
+
+const (
+	// StreamInput is the standard input of the session.
+	StreamInput Stream = "i"
+
+	// StreamOutput is the standard output and error of the session.
+	StreamOutput Stream = "o"
+)
+
+// String returns the name of the stream.
+func (s Stream) String() string {
+	switch s {
+	case StreamInput:
+		return "input"
+	case StreamOutput:
+		return "output"
+	}
+
+	return fmt.Sprintf("Stream(%q)", string(s))
+}
+
+var (
+	errHeader = errors.New("invalid asciicast header")
+	errEvent  = errors.New("invalid recording event")
+	errTiming = errors.New("invalid recording timing")
+)
+
+// Event is a single transfer of a session.
+type Event struct {
+	// Stream of the transfer.
+	Stream Stream
+
+	// Data transferred, which is not necessarily valid UTF-8 for raw
+	// recordings.
+	Data string
+}
+
+// Transcript is the sequence of transfers of a recorded session, without
+// their timing.
+type Transcript struct {
+	// Events in the order of their transfer.
+	Events []Event
+}
+
+// Load reads an asciicast recording from the path.
+func Load(path string) (*Transcript, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open recording: %w", err)
+	}
+	defer f.Close()
+
+	return Parse(f)
+}
+
+// Parse reads an asciicast v2 recording. Events of other streams than
+// StreamInput and StreamOutput, like resizes, are skipped.
+func Parse(r io.Reader) (*Transcript, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<24)
+
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("read recording: %w", err)
+		}
+
+		return nil, fmt.Errorf("%w: empty recording", errHeader)
+	}
+
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return nil, fmt.Errorf("parse asciicast header: %w", err)
+	}
+	if header.Version != 2 {
+		return nil, fmt.Errorf("%w: unsupported version %d", errHeader, header.Version)
+	}
+
+	t := &Transcript{}
+	for line := 2; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		if err := t.parseEvent(scanner.Bytes()); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read recording: %w", err)
+	}
+
+	return t, nil
+}
+
+// LoadRaw reads a raw recording from the path and its timing file from the
+// timingPath, which defaults to the path with the suffix ".timing".
+func LoadRaw(path, timingPath string) (*Transcript, error) {
+	if timingPath == "" {
+		timingPath = path + ".timing"
+	}
+
+	data, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open recording: %w", err)
+	}
+	defer data.Close()
+
+	timing, err := os.Open(timingPath)
+	if err != nil {
+		return nil, fmt.Errorf("open recording timing: %w", err)
+	}
+	defer timing.Close()
+
+	return ParseRaw(data, timing)
+}
+
+// ParseRaw reads a raw recording in the advanced format of script(1), which
+// consists of the transferred bytes and a timing file with a
+// "<stream> <delay> <length>" line per event.
+func ParseRaw(data, timing io.Reader) (*Transcript, error) {
+	t := &Transcript{}
+	scanner := bufio.NewScanner(timing)
+
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("%w: line %d", errTiming, line)
+		}
+
+		length, err := strconv.Atoi(fields[2])
+		if err != nil || length < 0 {
+			return nil, fmt.Errorf("%w: line %d: length %q", errTiming, line, fields[2])
+		}
+
+		buf := make([]byte, length)
+		if _, err := io.ReadFull(data, buf); err != nil {
+			return nil, fmt.Errorf("read recording at timing line %d: %w", line, err)
+		}
+
+		t.append(Stream(fields[0]), string(buf))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read recording timing: %w", err)
+	}
+
+	return t, nil
+}
+
+// parseEvent parses an asciicast event line of the form
+// [time, stream, data].
+func (t *Transcript) parseEvent(line []byte) error {
+	var fields []json.RawMessage
+	if err := json.Unmarshal(line, &fields); err != nil {
+		return fmt.Errorf("parse recording event: %w", err)
+	}
+	if len(fields) != 3 {
+		return fmt.Errorf("%w: %d fields", errEvent, len(fields))
+	}
+
+	var stream, data string
+	if err := json.Unmarshal(fields[1], &stream); err != nil {
+		return fmt.Errorf("parse recording event stream: %w", err)
+	}
+	if err := json.Unmarshal(fields[2], &data); err != nil {
+		return fmt.Errorf("parse recording event data: %w", err)
+	}
+
+	t.append(Stream(stream), data)
+
+	return nil
+}
+
+func (t *Transcript) append(stream Stream, data string) {
+	if stream != StreamInput && stream != StreamOutput {
+		return
+	}
+
+	t.Events = append(t.Events, Event{Stream: stream, Data: data})
+}
+
+// Text returns the concatenated data of the stream.
+func (t *Transcript) Text(stream Stream) string {
+	var b strings.Builder
+	for _, event := range t.Events {
+		if event.Stream == stream {
+			b.WriteString(event.Data)
+		}
+	}
+
+	return b.String()
+}
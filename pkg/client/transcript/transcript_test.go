@@ -0,0 +1,156 @@
+package transcript_test
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/containers/conmon-rs/pkg/client/transcript"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const header = `{"version":2,"width":80,"height":24,"timestamp":1650000000}` + "\n"
+
+func parse(events ...string) *transcript.Transcript {
+	t, err := transcript.Parse(strings.NewReader(header + strings.Join(events, "\n")))
+	Expect(err).To(BeNil())
+
+	return t
+}
+
+var _ = Describe("Transcript", func() {
+	Describe("Parse", func() {
+		It("should parse asciicast recordings", func() {
+			t := parse(
+				`[0.1, "i", "ls\r"]`,
+				`[0.2, "r", "100x50"]`,
+				`[0.3, "o", "file\r\n"]`,
+			)
+			Expect(t.Events).To(Equal([]transcript.Event{
+				{Stream: transcript.StreamInput, Data: "ls\r"},
+				{Stream: transcript.StreamOutput, Data: "file\r\n"},
+			}))
+		})
+
+		It("should fail on invalid recordings", func() {
+			_, err := transcript.Parse(strings.NewReader(""))
+			Expect(err).NotTo(BeNil())
+
+			_, err = transcript.Parse(strings.NewReader(`{"version":1}`))
+			Expect(err).NotTo(BeNil())
+
+			_, err = transcript.Parse(strings.NewReader(header + `[0.1, "o"]`))
+			Expect(err).To(MatchError(ContainSubstring("line 2")))
+		})
+
+		It("should parse raw recordings", func() {
+			t, err := transcript.ParseRaw(
+				strings.NewReader("lsfile\n"),
+				strings.NewReader("i 0.100000 2\no 0.200000 5\n"),
+			)
+			Expect(err).To(BeNil())
+			Expect(t.Text(transcript.StreamInput)).To(Equal("ls"))
+			Expect(t.Text(transcript.StreamOutput)).To(Equal("file\n"))
+
+			_, err = transcript.ParseRaw(strings.NewReader("ls"), strings.NewReader("i 0.1 5\n"))
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("Normalize", func() {
+		It("should remove the varying details", func() {
+			t := parse(
+				`[0.1, "o", "\u001b[1;32mok\u001b"]`,
+				`[0.2, "o", "[0m 2022-04-15T10:00:00.123Z\r\n"]`,
+				`[0.3, "i", "exit\r"]`,
+			).Normalize(nil)
+			Expect(t.Events).To(Equal([]transcript.Event{
+				{Stream: transcript.StreamOutput, Data: "ok <timestamp>\n"},
+				{Stream: transcript.StreamInput, Data: "exit\r"},
+			}))
+		})
+
+		It("should apply the options", func() {
+			t := parse(
+				`[0.1, "o", "\u001b[1mid abc123\u001b[0m\r\n"]`,
+				`[0.3, "i", "exit\r"]`,
+			).Normalize(&transcript.NormalizeOptions{
+				KeepANSI:            true,
+				KeepCarriageReturns: true,
+				IgnoreInput:         true,
+				Replacements: []transcript.Replacement{
+					{Pattern: regexp.MustCompile(`id \w+`), Replace: "id <id>"},
+				},
+			})
+			Expect(t.Events).To(Equal([]transcript.Event{
+				{Stream: transcript.StreamOutput, Data: "\u001b[1mid <id>\u001b[0m\r\n"},
+			}))
+		})
+	})
+
+	Describe("Diff", func() {
+		It("should match differently chunked sessions", func() {
+			want := parse(`[0.1, "i", "echo hi\r"]`, `[0.2, "o", "hi\r\n"]`, `[0.3, "o", "$ "]`)
+			got := parse(`[1.1, "o", "h"]`, `[1.2, "i", "echo "]`, `[1.3, "i", "hi\r"]`, `[1.4, "o", "i\r\n$ "]`)
+
+			report := transcript.Diff(want, got, nil)
+			Expect(report.Equal()).To(BeTrue())
+			Expect(report.Err()).To(BeNil())
+		})
+
+		It("should report the mismatches", func() {
+			want := parse(`[0.1, "o", "a\nb\nc\nd\n"]`)
+			got := parse(`[0.1, "o", "a\nx\nc\nd\ne\n"]`, `[0.2, "i", "q"]`)
+
+			report := transcript.Diff(want, got, nil)
+			Expect(report.Mismatches).To(Equal([]transcript.Mismatch{
+				{
+					Kind:    transcript.MismatchUnexpected,
+					Stream:  transcript.StreamInput,
+					GotLine: 1,
+					Got:     "q",
+				},
+				{
+					Kind:     transcript.MismatchChanged,
+					Stream:   transcript.StreamOutput,
+					WantLine: 2,
+					GotLine:  2,
+					Want:     "b",
+					Got:      "x",
+				},
+				{
+					Kind:    transcript.MismatchUnexpected,
+					Stream:  transcript.StreamOutput,
+					GotLine: 5,
+					Got:     "e",
+				},
+			}))
+			Expect(report.Err()).To(MatchError(transcript.ErrMismatch))
+			Expect(report.String()).To(ContainSubstring(`output line 2 changed: want "b", got "x"`))
+		})
+
+		It("should report missing lines", func() {
+			report := transcript.Diff(parse(`[0.1, "o", "a\nb\n"]`), parse(`[0.1, "o", "b\n"]`), nil)
+			Expect(report.Mismatches).To(HaveLen(1))
+			Expect(report.Mismatches[0].Kind).To(Equal(transcript.MismatchMissing))
+			Expect(report.Mismatches[0].WantLine).To(Equal(1))
+		})
+
+		It("should compare golden files", func() {
+			dir := GinkgoT().TempDir()
+			golden := filepath.Join(dir, "golden.cast")
+			recording := filepath.Join(dir, "session.cast")
+			Expect(os.WriteFile(golden, []byte(header+`[0.1, "o", "ready\n"]`), 0o600)).To(BeNil())
+			Expect(os.WriteFile(recording, []byte(header+`[2.5, "o", "\u001b[0mready\r\n"]`), 0o600)).To(BeNil())
+
+			report, err := transcript.DiffFiles(golden, recording, nil)
+			Expect(err).To(BeNil())
+			Expect(report.Equal()).To(BeTrue())
+
+			_, err = transcript.DiffFiles(filepath.Join(dir, "missing.cast"), recording, nil)
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
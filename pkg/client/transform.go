@@ -0,0 +1,97 @@
+package client
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// StreamTransform is a set of transformations applied to the streams of an
+// attach session, for example compression, encryption, redaction or metrics.
+// Every field is optional. The wrapped writers are owned by the caller and
+// cannot be closed by the transformations.
+type StreamTransform struct {
+	// Stdin wraps the standard input before it gets sent to the container.
+	Stdin func(io.Reader) io.Reader
+
+	// Stdout wraps the writer which receives the standard output of the
+	// container. The returned writer gets closed after the session ends if
+	// it implements io.Closer.
+	Stdout func(io.Writer) io.Writer
+
+	// Stderr wraps the writer which receives the standard error of the
+	// container. The returned writer gets closed after the session ends if
+	// it implements io.Closer.
+	Stderr func(io.Writer) io.Writer
+}
+
+// WithStreamTransform adds a transformation to the attach streams. The first
+// added transformation is the outermost one, which means that it is the
+// closest to the streams of the caller.
+func (a *AttachConfig) WithStreamTransform(transform StreamTransform) *AttachConfig {
+	a.Transforms = append(a.Transforms, transform)
+
+	return a
+}
+
+// transformStreams applies all transformations to the streams and returns
+// them together with a function to close the transformed writers.
+func (a *AttachConfig) transformStreams() (streams AttachStreams, closeFn func() error) {
+	streams = a.Streams
+	var closers []io.Closer
+
+	for _, transform := range a.Transforms {
+		if streams.Stdin != nil && transform.Stdin != nil {
+			streams.Stdin = &In{transform.Stdin(streams.Stdin.Reader)}
+		}
+
+		if streams.Stdout != nil && transform.Stdout != nil {
+			streams.Stdout = transformOut(streams.Stdout, transform.Stdout, &closers)
+		}
+
+		if streams.Stderr != nil && transform.Stderr != nil {
+			streams.Stderr = transformOut(streams.Stderr, transform.Stderr, &closers)
+		}
+	}
+
+	return streams, func() error {
+		var result *multierror.Error
+		// Close the innermost writers first to flush their data to the outer
+		// ones. All writers get closed, even if some of them fail.
+		for i := len(closers) - 1; i >= 0; i-- {
+			if err := closers[i].Close(); err != nil {
+				result = multierror.Append(result, fmt.Errorf("close transformed stream: %w", err))
+			}
+		}
+
+		return result.ErrorOrNil()
+	}
+}
+
+func transformOut(out *Out, transform func(io.Writer) io.Writer, closers *[]io.Closer) *Out {
+	// Hide the Close method of the wrapped writer, otherwise a transformation
+	// embedding it would close the writer of the caller.
+	writer := transform(writerOnly{out.WriteCloser})
+	if closer, ok := writer.(io.WriteCloser); ok {
+		*closers = append(*closers, closer)
+
+		return &Out{closer}
+	}
+
+	return &Out{nopWriteCloser{writer}}
+}
+
+// writerOnly exposes only the Write method of the embedded writer.
+type writerOnly struct {
+	io.Writer
+}
+
+// nopWriteCloser is an io.WriteCloser with a no-op Close method.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,220 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// The schemes of the ServerAddress.
+const (
+	schemeUnix  = "unix"
+	schemeTCP   = "tcp"
+	schemeVsock = "vsock"
+	schemeNpipe = "npipe"
+)
+
+var (
+	errInvalidServerAddress = errors.New("invalid server address")
+	errRemoteServer         = errors.New("server is not managed by the client")
+	errUnsupportedNetwork   = errors.New("network is not supported on this platform")
+	errUnsupportedPlatform  = errors.New("not supported on this platform")
+)
+
+// socketDialer connects to the local sockets of the server, which is
+// implemented per platform. The network is "unix", "unixpacket" for sockets
+// preserving the message boundaries, or "npipe" for Windows named pipes.
+type socketDialer interface {
+	DialSocket(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// serverAddress is the parsed ServerAddress of a remote server.
+type serverAddress struct {
+	scheme string
+
+	// path is the socket path of the unix scheme, or the pipe path of the
+	// npipe scheme.
+	path string
+
+	// host is the host and port of the tcp scheme.
+	host string
+
+	// cid and port are the address of the vsock scheme.
+	cid  uint32
+	port uint32
+}
+
+// parseServerAddress parses an address of the format "unix:///path",
+// "tcp://host:port", "vsock://cid:port" or "npipe:////./pipe/name".
+func parseServerAddress(address string) (*serverAddress, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidServerAddress, err)
+	}
+
+	switch u.Scheme {
+	case schemeUnix:
+		if u.Path == "" {
+			return nil, fmt.Errorf("%w: no socket path in %q", errInvalidServerAddress, address)
+		}
+
+		return &serverAddress{scheme: schemeUnix, path: u.Path}, nil
+
+	case schemeNpipe:
+		if !strings.HasPrefix(u.Path, "//") {
+			return nil, fmt.Errorf("%w: no pipe path in %q", errInvalidServerAddress, address)
+		}
+
+		return &serverAddress{scheme: schemeNpipe, path: strings.ReplaceAll(u.Path, "/", `\`)}, nil
+
+	case schemeTCP:
+		if _, _, err := net.SplitHostPort(u.Host); err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidServerAddress, err)
+		}
+
+		return &serverAddress{scheme: schemeTCP, host: u.Host}, nil
+
+	case schemeVsock:
+		cid, port, err := net.SplitHostPort(u.Host)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidServerAddress, err)
+		}
+
+		cidNum, err := strconv.ParseUint(cid, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: vsock CID %q: %v", errInvalidServerAddress, cid, err)
+		}
+
+		portNum, err := strconv.ParseUint(port, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%w: vsock port %q: %v", errInvalidServerAddress, port, err)
+		}
+
+		return &serverAddress{scheme: schemeVsock, cid: uint32(cidNum), port: uint32(portNum)}, nil
+	}
+
+	return nil, fmt.Errorf("%w: unsupported scheme %q", errInvalidServerAddress, u.Scheme)
+}
+
+// DialerFunc connects to the address on the named network like
+// net.Dialer.DialContext, for example through an SSH tunnel or a SOCKS proxy.
+// The network is "unix" for the server, attach multiplexing and port forward
+// sockets, "unixpacket" for attach sockets, which requires preserving the
+// message boundaries, and "tcp", "vsock" or "npipe" for the ServerAddress.
+// The address of "vsock" is "cid:port", the one of "npipe" is the pipe path
+// like `\\.\pipe\conmon-rs`. Connections should implement CloseRead and
+// CloseWrite like *net.UnixConn, otherwise the end of the standard input of
+// attach sessions and the forwarded stream cannot be propagated.
+type DialerFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+// dialServer connects to the server socket, which is the one within the run
+// directory if no ServerAddress is configured.
+func (c *ConmonClient) dialServer(ctx context.Context) (net.Conn, error) {
+	if c.address == nil {
+		return c.dialContext(ctx, "unix", c.socket())
+	}
+
+	switch c.address.scheme {
+	case schemeTCP:
+		conn, err := c.dialContext(ctx, "tcp", c.address.host)
+		if err != nil || c.tlsConfig == nil {
+			return conn, err
+		}
+
+		config := c.tlsConfig
+		if config.ServerName == "" {
+			host, _, err := net.SplitHostPort(c.address.host)
+			if err != nil {
+				conn.Close()
+
+				return nil, fmt.Errorf("%w: %v", errInvalidServerAddress, err)
+			}
+			config = config.Clone()
+			config.ServerName = host
+		}
+
+		tlsConn := tls.Client(conn, config)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+
+			return nil, fmt.Errorf("TLS handshake: %w", err)
+		}
+
+		return tlsConn, nil
+
+	case schemeVsock:
+		if c.dialer != nil {
+			return c.dialContext(ctx, schemeVsock, fmt.Sprintf("%d:%d", c.address.cid, c.address.port))
+		}
+
+		return dialVsock(c.address.cid, c.address.port)
+	}
+
+	if c.address.scheme == schemeNpipe {
+		return c.dialContext(ctx, schemeNpipe, c.address.path)
+	}
+
+	return c.dialContext(ctx, "unix", c.address.path)
+}
+
+// dialContext connects via the DialerFunc of the client, or directly if none
+// is configured.
+func (c *ConmonClient) dialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if c.dialer != nil {
+		conn, err := c.dialer(ctx, network, address)
+		if err != nil {
+			return nil, fmt.Errorf("dial %s %s: %w", network, address, err)
+		}
+
+		return conn, nil
+	}
+
+	if network == "unix" || network == "unixpacket" || network == schemeNpipe {
+		return localSockets.DialSocket(ctx, network, address)
+	}
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, network, address)
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", network, err)
+	}
+
+	return conn, nil
+}
+
+// halfCloseConn is a connection which can be closed for reading and writing
+// separately.
+type halfCloseConn interface {
+	net.Conn
+	CloseRead() error
+	CloseWrite() error
+}
+
+// halfClose returns conn if it supports half closing, otherwise a wrapper
+// which ignores closing a single direction.
+func halfClose(conn net.Conn) halfCloseConn {
+	if conn, ok := conn.(halfCloseConn); ok {
+		return conn
+	}
+
+	return noHalfCloseConn{conn}
+}
+
+// noHalfCloseConn ignores closing a single direction of a connection.
+type noHalfCloseConn struct {
+	net.Conn
+}
+
+// CloseRead does nothing.
+func (noHalfCloseConn) CloseRead() error {
+	return nil
+}
+
+// CloseWrite does nothing.
+func (noHalfCloseConn) CloseWrite() error {
+	return nil
+}
@@ -0,0 +1,302 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// verifyIOPathLines is the number of lines of the payload of
+	// VerifyIOPath, which spans multiple attach packets.
+	verifyIOPathLines = 256
+
+	// verifyIOPathTimeout limits VerifyIOPath if the context has no
+	// deadline.
+	verifyIOPathTimeout = 30 * time.Second
+
+	// verifyIOPathLogInterval is the interval for checking the log of the
+	// command for the payload.
+	verifyIOPathLogInterval = 50 * time.Millisecond
+)
+
+// ErrIOPathMismatch is returned by VerifyIOPath if the payload did not
+// arrive unchanged at the attach session or the log.
+var ErrIOPathMismatch = errors.New("IO path mismatch")
+
+// VerifyIOPathOptions are the options of VerifyIOPath.
+type VerifyIOPathOptions struct {
+	// Command is executed in the container to echo its standard input to
+	// its standard output. Defaults to `cat`.
+	Command []string
+}
+
+// IOPathReport is the result of VerifyIOPath.
+type IOPathReport struct {
+	// ExecSession is the exec session running the echo command.
+	ExecSession string
+
+	// LogPath is the CRI log of the exec session, which gets removed once
+	// the verification finished.
+	LogPath string
+
+	// Bytes is the size of the payload written to the standard input.
+	Bytes uint64
+
+	// Checksum is the SHA-256 checksum of the payload.
+	Checksum string
+
+	// EchoedBytes is the size of the output received via the attach
+	// session.
+	EchoedBytes uint64
+
+	// EchoChecksum is the SHA-256 checksum of the output received via the
+	// attach session.
+	EchoChecksum string
+
+	// LoggedBytes is the size of the output found in the log.
+	LoggedBytes uint64
+
+	// LogChecksum is the SHA-256 checksum of the output found in the log.
+	LogChecksum string
+
+	// FirstByte is the time from writing the payload until the first byte of
+	// the output arrived.
+	FirstByte time.Duration
+
+	// RoundTrip is the time from writing the payload until the complete
+	// output arrived via the attach session.
+	RoundTrip time.Duration
+
+	// LogLatency is the time from writing the payload until the complete
+	// output has been found in the log.
+	LogLatency time.Duration
+}
+
+// Echoed returns true if the attach session received the payload unchanged.
+func (r *IOPathReport) Echoed() bool {
+	return r.EchoChecksum == r.Checksum
+}
+
+// Logged returns true if the log contains the payload unchanged.
+func (r *IOPathReport) Logged() bool {
+	return r.LogChecksum == r.Checksum
+}
+
+// VerifyIOPath checks the IO path of a running container end to end, which
+// helps to diagnose missing output. It executes the echo command of the
+// options in the container with a CRI log in the ServerRunDir, and writes a
+// random payload to its standard input via an attach session. The output
+// received via the attach session and the one found in the log are compared
+// with the payload using their checksums. The returned report contains the
+// checksums and the timing, also if the verification failed with an error
+// matching ErrIOPathMismatch. If the context has no deadline, the
+// verification is limited to 30 seconds.
+func (c *ConmonClient) VerifyIOPath(ctx context.Context, id string, opts *VerifyIOPathOptions) (*IOPathReport, error) {
+	command := []string{"cat"}
+	if opts != nil && len(opts.Command) > 0 {
+		command = opts.Command
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, verifyIOPathTimeout)
+		defer cancel()
+	}
+
+	dir, err := os.MkdirTemp(c.runDir, "verify-io-")
+	if err != nil {
+		return nil, fmt.Errorf("create directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	report := &IOPathReport{LogPath: filepath.Join(dir, "log")}
+
+	exec, err := c.ExecContainer(ctx, &ExecContainerConfig{
+		ID:      id,
+		Command: command,
+		LogDrivers: []LogDriver{{
+			Type: LogDriverTypeContainerRuntimeInterface,
+			Path: report.LogPath,
+		}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("execute echo command: %w", err)
+	}
+	report.ExecSession = exec.ExecSession
+
+	payload := verifyIOPathPayload()
+	report.Bytes = uint64(len(payload))
+	report.Checksum = checksum(payload)
+
+	stdin, stdinWrite := io.Pipe()
+	stdoutRead, stdout := io.Pipe()
+	attachDone := make(chan error, 1)
+	go func() {
+		attachDone <- c.AttachContainer(ctx, &AttachConfig{
+			ID:          id,
+			ExecSession: exec.ExecSession,
+			SocketPath:  filepath.Join(dir, "attach"),
+			Streams: AttachStreams{
+				Stdin:  &In{stdin},
+				Stdout: &Out{stdout},
+			},
+		})
+		stdout.Close()
+	}()
+
+	echoDone := make(chan []byte, 1)
+	start := time.Now()
+	go func() {
+		echoDone <- report.readEcho(stdoutRead, start)
+	}()
+
+	go func() {
+		// The command exits once its standard input gets closed.
+		_, err := stdinWrite.Write(payload)
+		stdinWrite.CloseWithError(err)
+	}()
+
+	var echo []byte
+	select {
+	case echo = <-echoDone:
+	case <-ctx.Done():
+		stdoutRead.Close()
+		echo = <-echoDone
+	}
+
+	attachErr := <-attachDone
+	// Unblock writing the payload if the command did not read all of it.
+	stdin.Close()
+	if attachErr != nil {
+		return report, fmt.Errorf("attach to echo command: %w", attachErr)
+	}
+
+	report.EchoedBytes = uint64(len(echo))
+	report.EchoChecksum = checksum(echo)
+
+	logged, err := report.readLog(ctx, start)
+	if err != nil {
+		return report, err
+	}
+	report.LoggedBytes = uint64(len(logged))
+	report.LogChecksum = checksum(logged)
+
+	if !report.Echoed() {
+		return report, fmt.Errorf(
+			"%w: attach session received %d of %d bytes with checksum %s",
+			ErrIOPathMismatch, report.EchoedBytes, report.Bytes, report.EchoChecksum,
+		)
+	}
+
+	if !report.Logged() {
+		return report, fmt.Errorf(
+			"%w: log contains %d of %d bytes with checksum %s",
+			ErrIOPathMismatch, report.LoggedBytes, report.Bytes, report.LogChecksum,
+		)
+	}
+
+	return report, nil
+}
+
+// readEcho reads the output of the attach session until it ends and records
+// the timing relative to the start.
+func (r *IOPathReport) readEcho(reader io.Reader, start time.Time) []byte {
+	var echo []byte
+	buf := make([]byte, attachPacketBufSize)
+
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if len(echo) == 0 {
+				r.FirstByte = time.Since(start)
+			}
+			echo = append(echo, buf[:n]...)
+			r.RoundTrip = time.Since(start)
+		}
+
+		if err != nil {
+			return echo
+		}
+	}
+}
+
+// readLog reads the output from the CRI log until it has the size of the
+// payload or the context is done, which is not an error to report the
+// partial output.
+func (r *IOPathReport) readLog(ctx context.Context, start time.Time) ([]byte, error) {
+	for {
+		logged, err := readCRILogOutput(r.LogPath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("read log: %w", err)
+		}
+
+		if uint64(len(logged)) >= r.Bytes {
+			r.LogLatency = time.Since(start)
+
+			return logged, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return logged, nil
+		case <-time.After(verifyIOPathLogInterval):
+		}
+	}
+}
+
+// readCRILogOutput reassembles the output logged to the CRI log file.
+func readCRILogOutput(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var output []byte
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, len(content)+1)
+
+	for scanner.Scan() {
+		// Each entry is "<timestamp> <stream> <P|F> <message>".
+		fields := bytes.SplitN(scanner.Bytes(), []byte{' '}, 4)
+		if len(fields) < 3 {
+			continue
+		}
+
+		if len(fields) == 4 {
+			output = append(output, fields[3]...)
+		}
+
+		if string(fields[2]) == "F" {
+			output = append(output, '\n')
+		}
+	}
+
+	return output, scanner.Err()
+}
+
+// verifyIOPathPayload creates random lines, which are logged as full
+// entries.
+func verifyIOPathPayload() []byte {
+	var payload []byte
+	for i := 0; i < verifyIOPathLines; i++ {
+		payload = append(payload, randomID()...)
+		payload = append(payload, '\n')
+	}
+
+	return payload
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Sync with `conmon-rs/server/src/version.rs`.
+const (
+	// ProtocolVersion is the version of the RPC protocol spoken by the
+	// client.
+	ProtocolVersion uint32 = 2
+
+	// minServerProtocolVersion is the oldest protocol version of servers
+	// supported by the client. Servers without protocol version report zero.
+	minServerProtocolVersion uint32 = 1
+)
+
+// IncompatibleVersionError is returned on connect if the server and the
+// client do not support the protocol version of each other.
+type IncompatibleVersionError struct {
+	// ServerVersion is the version string of the server.
+	ServerVersion string
+
+	// ServerProtocolVersion is the protocol version of the server.
+	ServerProtocolVersion uint32
+
+	// MinClientProtocolVersion is the oldest protocol version of clients
+	// supported by the server.
+	MinClientProtocolVersion uint32
+
+	// ClientProtocolVersion is the protocol version of the client.
+	ClientProtocolVersion uint32
+
+	// MinServerProtocolVersion is the oldest protocol version of servers
+	// supported by the client.
+	MinServerProtocolVersion uint32
+}
+
+// Error returns the string representation of the error.
+func (e *IncompatibleVersionError) Error() string {
+	if e.ServerProtocolVersion < e.MinServerProtocolVersion {
+		return fmt.Sprintf(
+			"server %s speaks protocol version %d, but the client requires at least version %d",
+			e.ServerVersion, e.ServerProtocolVersion, e.MinServerProtocolVersion,
+		)
+	}
+
+	return fmt.Sprintf(
+		"server %s requires at least protocol version %d, but the client speaks version %d",
+		e.ServerVersion, e.MinClientProtocolVersion, e.ClientProtocolVersion,
+	)
+}
+
+// negotiateVersion verifies that the server supports the protocol of the
+// client and the other way around. The negotiated protocol version is the
+// lower one of the client and the server.
+func (c *ConmonClient) negotiateVersion(resp *VersionResponse) error {
+	if resp.ProtocolVersion < minServerProtocolVersion || resp.MinClientProtocolVersion > ProtocolVersion {
+		return &IncompatibleVersionError{
+			ServerVersion:            resp.Version,
+			ServerProtocolVersion:    resp.ProtocolVersion,
+			MinClientProtocolVersion: resp.MinClientProtocolVersion,
+			ClientProtocolVersion:    ProtocolVersion,
+			MinServerProtocolVersion: minServerProtocolVersion,
+		}
+	}
+
+	version := ProtocolVersion
+	if resp.ProtocolVersion < version {
+		version = resp.ProtocolVersion
+	}
+	atomic.StoreUint32(&c.protocolVersion, version)
+
+	return nil
+}
+
+// NegotiatedProtocolVersion returns the protocol version used to communicate
+// with the server, which is the lower one of the client and the server.
+func (c *ConmonClient) NegotiatedProtocolVersion() uint32 {
+	return atomic.LoadUint32(&c.protocolVersion)
+}
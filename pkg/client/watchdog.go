@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containers/conmon-rs/internal/proto"
+)
+
+// WatchdogPolicy specifies what happens if a watchdog expires.
+type WatchdogPolicy int
+
+const (
+	// WatchdogPolicyEvent only publishes a ContainerEventTypeWatchdogExpired
+	// event.
+	WatchdogPolicyEvent WatchdogPolicy = iota
+
+	// WatchdogPolicyStop publishes a ContainerEventTypeWatchdogExpired event
+	// and stops the container.
+	WatchdogPolicyStop
+)
+
+// SetWatchdogConfig is the configuration for calling the SetWatchdog method.
+type SetWatchdogConfig struct {
+	// ID of the container.
+	ID string
+
+	// Timeout is the duration after which the watchdog expires if there has
+	// been no heartbeat. The watchdog gets disarmed if it is zero.
+	Timeout time.Duration
+
+	// Policy is applied if the watchdog expires.
+	Policy WatchdogPolicy
+}
+
+// SetWatchdog arms the watchdog of a running container, which requires
+// periodic heartbeats by calling Heartbeat. The policy of the watchdog gets
+// applied if no heartbeat has been received within the timeout, afterwards
+// it expires again only after the next heartbeat. A previously armed
+// watchdog of the container gets replaced.
+func (c *ConmonClient) SetWatchdog(ctx context.Context, cfg *SetWatchdogConfig) error {
+	cfg, err := mutateRequest(ctx, c, cfg)
+	if err != nil {
+		return err
+	}
+
+	id, err := c.containerID(ctx, cfg.ID)
+	if err != nil {
+		return err
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("SetWatchdog")()
+	diag := c.diagnoseRPC("SetWatchdog")
+	future, free := client.SetWatchdog(ctx, func(p proto.Conmon_setWatchdog_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := req.SetId(id); err != nil {
+			return fmt.Errorf("set ID: %w", err)
+		}
+
+		req.SetTimeoutSec(durationSeconds(cfg.Timeout))
+
+		switch cfg.Policy {
+		case WatchdogPolicyEvent:
+			req.SetPolicy(proto.Conmon_SetWatchdogRequest_Policy_event)
+		case WatchdogPolicyStop:
+			req.SetPolicy(proto.Conmon_SetWatchdogRequest_Policy_stop)
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	if _, err := result.Response(); err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	return nil
+}
+
+// Heartbeat resets the watchdogs of the containers with the provided IDs,
+// or all watchdogs of the tenant of the client if no IDs are provided.
+func (c *ConmonClient) Heartbeat(ctx context.Context, ids ...string) error {
+	normalized := make([]string, 0, len(ids))
+	for _, id := range ids {
+		id, err := c.containerID(ctx, id)
+		if err != nil {
+			return err
+		}
+		normalized = append(normalized, id)
+	}
+
+	conn, err := c.newRPCConn(ctx)
+	if err != nil {
+		return fmt.Errorf("create RPC connection: %w", err)
+	}
+	defer conn.Close()
+
+	client := c.bootstrap(ctx, conn)
+	defer c.metrics.observeRPC("Heartbeat")()
+	diag := c.diagnoseRPC("Heartbeat")
+	future, free := client.Heartbeat(ctx, func(p proto.Conmon_heartbeat_Params) error {
+		req, err := p.NewRequest()
+		if err != nil {
+			return fmt.Errorf("create request: %w", err)
+		}
+
+		if err := stringSliceToTextList(normalized, req.NewIds); err != nil {
+			return err
+		}
+
+		if err := p.SetRequest(req); err != nil {
+			return fmt.Errorf("set request: %w", err)
+		}
+		diag.request(p)
+
+		return nil
+	})
+	defer free()
+
+	result, err := future.Struct()
+	diag.report(ctx, result, err)
+	if err != nil {
+		return fmt.Errorf("create result: %w", err)
+	}
+
+	if _, err := result.Response(); err != nil {
+		return fmt.Errorf("set response: %w", err)
+	}
+
+	return nil
+}
+
+// RunHeartbeats calls Heartbeat for all watchdogs of the tenant of the
+// client in the provided interval until the context is done. Failed
+// heartbeats are logged and retried in the next interval.
+func (c *ConmonClient) RunHeartbeats(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Heartbeat(ctx); err != nil {
+			c.logger.Errorf("Unable to send watchdog heartbeat: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// eventStream is the common part of the local watcher implementations, which
+// forwards the events received from the server to a channel.
+type eventStream[T any] struct {
+	events   chan T
+	done     chan struct{}
+	doneOnce sync.Once
+	mu       sync.Mutex
+	closed   bool
+	errMu    sync.Mutex
+	err      error
+}
+
+// errConnectionClosed is the error of a stream if the connection to the
+// server broke before the watcher has been released.
+var errConnectionClosed = errors.New("connection to server closed")
+
+func newEventStream[T any]() *eventStream[T] {
+	return &eventStream[T]{
+		events: make(chan T),
+		done:   make(chan struct{}),
+	}
+}
+
+// send forwards an event to the channel until the stream is done.
+func (e *eventStream[T]) send(ctx context.Context, event T) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return nil
+	}
+
+	select {
+	case e.events <- event:
+	case <-e.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+// Shutdown is called if the server releases the watcher.
+func (e *eventStream[T]) Shutdown() {
+	e.doneOnce.Do(func() { close(e.done) })
+}
+
+// fail stops the stream with the provided error. Only the first error is
+// kept.
+func (e *eventStream[T]) fail(err error) {
+	e.errMu.Lock()
+	if e.err == nil {
+		e.err = err
+	}
+	e.errMu.Unlock()
+	e.Shutdown()
+}
+
+// Err returns the error which stopped the stream, if any.
+func (e *eventStream[T]) Err() error {
+	e.errMu.Lock()
+	defer e.errMu.Unlock()
+
+	return e.err
+}
+
+// stop handles the done call of the server, which contains the error message
+// if the watcher failed.
+func (e *eventStream[T]) stop(msg string) {
+	if msg != "" {
+		e.fail(errors.New(msg))
+
+		return
+	}
+	e.Shutdown()
+}
+
+func (e *eventStream[T]) close() {
+	e.Shutdown()
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.closed {
+		e.closed = true
+		close(e.events)
+	}
+}
+
+// serve keeps the RPC connection open until either the context is done, the
+// server released the watcher or the connection broke. The events channel
+// gets closed afterwards.
+func (e *eventStream[T]) serve(ctx context.Context, conn *rpcConn, logger *logrus.Logger) {
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-e.done:
+		case <-conn.Done():
+			e.fail(errConnectionClosed)
+		}
+		e.close()
+		if err := conn.Close(); err != nil {
+			logger.Errorf("Unable to close connection: %v", err)
+		}
+	}()
+}
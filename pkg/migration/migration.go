@@ -0,0 +1,377 @@
+// Package migration moves running containers between two conmon-rs servers.
+// The container gets checkpointed on the source, its checkpoint image gets
+// streamed through the client and the container gets restored from it on the
+// destination, which requires CRIU on both nodes but no shared storage.
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/containers/conmon-rs/pkg/client"
+	"github.com/hashicorp/go-multierror"
+)
+
+// defaultRollbackTimeout is the time a rollback may take, which does not use
+// the context of the migration because it may be the cause of the failure.
+const defaultRollbackTimeout = 2 * time.Minute
+
+var (
+	// ErrRollbackFailed is part of the error of a failed migration if the
+	// source container could not be brought back.
+	ErrRollbackFailed = errors.New("migration rollback failed")
+
+	errMissingArgument = errors.New("source, destination and config are required")
+)
+
+// Client is the part of the conmon-rs client used on both sides of a
+// migration, which is implemented by *client.ConmonClient.
+type Client interface {
+	CheckpointContainerStream(context.Context, *client.CheckpointContainerConfig) (io.ReadCloser, error)
+	UploadCheckpointImage(ctx context.Context, imagePath string, archive io.Reader) (*client.CheckpointImage, error)
+	RestoreContainer(context.Context, *client.RestoreContainerConfig) (*client.RestoreContainerResponse, error)
+	KillContainer(ctx context.Context, id string, signal syscall.Signal, all bool) error
+}
+
+// Stage is a step of a migration.
+type Stage int
+
+const (
+	// StageCheckpoint checkpoints the container on the source and buffers
+	// the checkpoint image locally.
+	StageCheckpoint Stage = iota
+
+	// StageTransfer uploads the checkpoint image to the destination.
+	StageTransfer
+
+	// StageRestore restores the container on the destination.
+	StageRestore
+
+	// StageRollback brings back the source container after a failure.
+	StageRollback
+
+	// StageDone indicates that the container runs on the destination.
+	StageDone
+)
+
+// String returns the name of the stage.
+func (s Stage) String() string {
+	switch s {
+	case StageCheckpoint:
+		return "checkpoint"
+	case StageTransfer:
+		return "transfer"
+	case StageRestore:
+		return "restore"
+	case StageRollback:
+		return "rollback"
+	case StageDone:
+		return "done"
+	default:
+		return fmt.Sprintf("Stage(%d)", int(s))
+	}
+}
+
+// Progress is a progress update of a migration.
+type Progress struct {
+	// Stage is the current stage.
+	Stage Stage
+
+	// Bytes is the number of checkpoint image bytes received from the source
+	// in the checkpoint stage, or sent to a server in the transfer and
+	// rollback stages.
+	Bytes int64
+
+	// TotalBytes is the size of the checkpoint image archive, which is known
+	// after the checkpoint stage.
+	TotalBytes int64
+}
+
+// Config is the configuration of a migration.
+type Config struct {
+	// ID is the identifier of the container on the source.
+	ID string
+
+	// Options are the checkpoint options used on both sides. The ImagePath
+	// is ignored, because the servers use temporary image directories.
+	Options client.CheckpointOptions
+
+	// Destination is the configuration of the restored container on the
+	// destination. Its ID defaults to the ID of the source container.
+	Destination client.CreateContainerConfig
+
+	// LeaveRunning keeps the source container running until it has been
+	// restored on the destination, where it gets killed. This reduces the
+	// downtime of a failed migration, but changes of the container after the
+	// checkpoint are lost.
+	LeaveRunning bool
+
+	// Rollback restores the source container from the checkpoint image if
+	// the migration fails after the source container has been stopped. The
+	// source container stays stopped on failures if it is nil.
+	Rollback *Rollback
+
+	// TempDir is the local directory the checkpoint image gets buffered in,
+	// the default directory for temporary files if empty.
+	TempDir string
+
+	// Progress gets called with progress updates, if not nil.
+	Progress func(Progress)
+}
+
+// Rollback is the configuration for restoring the source container.
+type Rollback struct {
+	// Prepare gets called before restoring the source container, if not
+	// nil. It has to remove the runtime state of the stopped container.
+	Prepare func(context.Context) error
+
+	// Container is the configuration of the restored source container.
+	Container client.CreateContainerConfig
+}
+
+// Result is the result of a successful migration.
+type Result struct {
+	// PID is the process identifier of the container on the destination.
+	PID uint32
+}
+
+// Migrate moves the container from the source to the destination. The error
+// contains ErrRollbackFailed if the migration failed and the source container
+// could not be brought back.
+func Migrate(ctx context.Context, source, destination Client, cfg *Config) (*Result, error) {
+	if source == nil || destination == nil || cfg == nil {
+		return nil, errMissingArgument
+	}
+
+	m := &migration{source: source, destination: destination, cfg: cfg}
+	image, err := m.checkpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		image.Close()
+		os.Remove(image.Name())
+	}()
+
+	pid, err := m.restore(ctx, image)
+	if err != nil {
+		return nil, m.rollback(image, err)
+	}
+
+	if cfg.LeaveRunning {
+		if err := source.KillContainer(ctx, cfg.ID, syscall.SIGKILL, true); err != nil {
+			err = fmt.Errorf("kill source container: %w", err)
+
+			return nil, m.rollbackDestination(err)
+		}
+	}
+
+	m.report(StageDone, 0)
+
+	return &Result{PID: pid}, nil
+}
+
+// migration is the state of a single migration.
+type migration struct {
+	source      Client
+	destination Client
+	cfg         *Config
+	totalBytes  int64
+}
+
+// checkpoint buffers the checkpoint image of the source container in a
+// temporary file.
+func (m *migration) checkpoint(ctx context.Context) (*os.File, error) {
+	m.report(StageCheckpoint, 0)
+
+	image, err := os.CreateTemp(m.cfg.TempDir, "checkpoint-*.tar")
+	if err != nil {
+		return nil, fmt.Errorf("create checkpoint image buffer: %w", err)
+	}
+
+	stream, err := m.source.CheckpointContainerStream(ctx, &client.CheckpointContainerConfig{
+		ID:           m.cfg.ID,
+		Options:      m.options(""),
+		LeaveRunning: m.cfg.LeaveRunning,
+	})
+	if err == nil {
+		m.totalBytes, err = io.Copy(&progressWriter{Writer: image, m: m, stage: StageCheckpoint}, stream)
+		stream.Close()
+	}
+	if err != nil {
+		image.Close()
+		os.Remove(image.Name())
+
+		return nil, fmt.Errorf("checkpoint source container: %w", err)
+	}
+
+	return image, nil
+}
+
+// restore uploads the checkpoint image to the destination and restores the
+// container from it.
+func (m *migration) restore(ctx context.Context, image *os.File) (uint32, error) {
+	m.report(StageTransfer, 0)
+
+	uploaded, err := m.upload(ctx, m.destination, image, StageTransfer)
+	if err != nil {
+		return 0, fmt.Errorf("transfer checkpoint image: %w", err)
+	}
+	defer uploaded.Close()
+
+	m.report(StageRestore, m.totalBytes)
+
+	cfg := m.cfg.Destination
+	if cfg.ID == "" {
+		cfg.ID = m.cfg.ID
+	}
+
+	resp, err := m.destination.RestoreContainer(ctx, &client.RestoreContainerConfig{
+		CreateContainerConfig: cfg,
+		Options:               m.options(uploaded.Path),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("restore destination container: %w", err)
+	}
+
+	return resp.PID, nil
+}
+
+// rollback brings back the source container after the migration failed with
+// the cause, if it has been stopped by the checkpoint.
+func (m *migration) rollback(image *os.File, cause error) error {
+	if m.cfg.LeaveRunning || m.cfg.Rollback == nil {
+		return cause
+	}
+
+	m.report(StageRollback, 0)
+
+	// nolint:contextcheck // the context of the migration may be done
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRollbackTimeout)
+	defer cancel()
+
+	if err := m.restoreSource(ctx, image); err != nil {
+		return multierror.Append(cause, fmt.Errorf("%w: %v", ErrRollbackFailed, err))
+	}
+
+	return cause
+}
+
+func (m *migration) restoreSource(ctx context.Context, image *os.File) error {
+	if m.cfg.Rollback.Prepare != nil {
+		if err := m.cfg.Rollback.Prepare(ctx); err != nil {
+			return fmt.Errorf("prepare source container: %w", err)
+		}
+	}
+
+	uploaded, err := m.upload(ctx, m.source, image, StageRollback)
+	if err != nil {
+		return fmt.Errorf("upload checkpoint image: %w", err)
+	}
+	defer uploaded.Close()
+
+	cfg := m.cfg.Rollback.Container
+	if cfg.ID == "" {
+		cfg.ID = m.cfg.ID
+	}
+
+	if _, err := m.source.RestoreContainer(ctx, &client.RestoreContainerConfig{
+		CreateContainerConfig: cfg,
+		Options:               m.options(uploaded.Path),
+	}); err != nil {
+		return fmt.Errorf("restore source container: %w", err)
+	}
+
+	return nil
+}
+
+// rollbackDestination kills the restored container on the destination, so
+// that it does not run twice.
+func (m *migration) rollbackDestination(cause error) error {
+	m.report(StageRollback, 0)
+
+	// nolint:contextcheck // the context of the migration may be done
+	ctx, cancel := context.WithTimeout(context.Background(), defaultRollbackTimeout)
+	defer cancel()
+
+	id := m.cfg.Destination.ID
+	if id == "" {
+		id = m.cfg.ID
+	}
+
+	if err := m.destination.KillContainer(ctx, id, syscall.SIGKILL, true); err != nil {
+		err = fmt.Errorf("%w: kill destination container: %v", ErrRollbackFailed, err)
+
+		return multierror.Append(cause, err)
+	}
+
+	return cause
+}
+
+// upload uploads the buffered checkpoint image to a temporary image
+// directory of the server.
+func (m *migration) upload(
+	ctx context.Context, c Client, image *os.File, stage Stage,
+) (*client.CheckpointImage, error) {
+	if _, err := image.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("rewind checkpoint image buffer: %w", err)
+	}
+
+	// nolint:wrapcheck // wrapped by the callers
+	return c.UploadCheckpointImage(ctx, "", &progressReader{Reader: image, m: m, stage: stage})
+}
+
+// options returns the checkpoint options for the image path.
+func (m *migration) options(imagePath string) client.CheckpointOptions {
+	options := m.cfg.Options
+	options.ImagePath = imagePath
+
+	return options
+}
+
+func (m *migration) report(stage Stage, bytes int64) {
+	if m.cfg.Progress != nil {
+		m.cfg.Progress(Progress{Stage: stage, Bytes: bytes, TotalBytes: m.totalBytes})
+	}
+}
+
+// progressWriter reports the bytes written to it.
+type progressWriter struct {
+	io.Writer
+	m     *migration
+	stage Stage
+	bytes int64
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.Writer.Write(b)
+	p.bytes += int64(n)
+	p.m.report(p.stage, p.bytes)
+
+	// nolint:wrapcheck // keep the io.Writer semantics
+	return n, err
+}
+
+// progressReader reports the bytes read from it.
+type progressReader struct {
+	io.Reader
+	m     *migration
+	stage Stage
+	bytes int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.Reader.Read(b)
+	if n > 0 {
+		p.bytes += int64(n)
+		p.m.report(p.stage, p.bytes)
+	}
+
+	// nolint:wrapcheck // keep the io.Reader semantics
+	return n, err
+}
@@ -0,0 +1,110 @@
+package migration_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/containers/conmon-rs/pkg/client"
+	"github.com/containers/conmon-rs/pkg/migration"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Migrate", func() {
+	var (
+		source      *fakeClient
+		destination *fakeClient
+		stages      []migration.Stage
+		cfg         *migration.Config
+	)
+
+	BeforeEach(func() {
+		source = newFakeClient()
+		destination = newFakeClient()
+		stages = nil
+		cfg = &migration.Config{
+			ID:      "ctr",
+			Options: client.CheckpointOptions{ImagePath: "/ignored", TCPEstablished: true},
+			Progress: func(p migration.Progress) {
+				if len(stages) == 0 || stages[len(stages)-1] != p.Stage {
+					stages = append(stages, p.Stage)
+				}
+			},
+		}
+	})
+
+	It("should restore the container on the destination", func() {
+		result, err := migration.Migrate(context.Background(), source, destination, cfg)
+		Expect(err).To(BeNil())
+		Expect(result.PID).To(BeEquivalentTo(42))
+
+		Expect(destination.uploaded).To(Equal([][]byte{source.image}))
+		Expect(destination.restored).To(HaveLen(1))
+		Expect(destination.restored[0].ID).To(Equal("ctr"))
+		Expect(destination.restored[0].Options).To(Equal(client.CheckpointOptions{
+			ImagePath:      "/image",
+			TCPEstablished: true,
+		}))
+		Expect(source.restored).To(BeEmpty())
+		Expect(source.killed).To(BeEmpty())
+		Expect(stages).To(Equal([]migration.Stage{
+			migration.StageCheckpoint,
+			migration.StageTransfer,
+			migration.StageRestore,
+			migration.StageDone,
+		}))
+	})
+
+	It("should restore the source container on failure", func() {
+		destination.restoreErr = errFake
+		prepared := false
+		cfg.Rollback = &migration.Rollback{
+			Prepare: func(context.Context) error {
+				prepared = true
+
+				return nil
+			},
+		}
+
+		_, err := migration.Migrate(context.Background(), source, destination, cfg)
+		Expect(errors.Is(err, errFake)).To(BeTrue())
+		Expect(errors.Is(err, migration.ErrRollbackFailed)).To(BeFalse())
+		Expect(prepared).To(BeTrue())
+		Expect(source.uploaded).To(Equal([][]byte{source.image}))
+		Expect(source.restored).To(HaveLen(1))
+		Expect(source.restored[0].ID).To(Equal("ctr"))
+		Expect(stages).To(ContainElement(migration.StageRollback))
+	})
+
+	It("should report a failed rollback", func() {
+		destination.restoreErr = errFake
+		source.restoreErr = errFake
+		cfg.Rollback = &migration.Rollback{}
+
+		_, err := migration.Migrate(context.Background(), source, destination, cfg)
+		Expect(errors.Is(err, migration.ErrRollbackFailed)).To(BeTrue())
+	})
+
+	It("should kill the running source after the restore", func() {
+		cfg.LeaveRunning = true
+
+		_, err := migration.Migrate(context.Background(), source, destination, cfg)
+		Expect(err).To(BeNil())
+		Expect(source.killed).To(Equal([]string{"ctr"}))
+		Expect(destination.killed).To(BeEmpty())
+	})
+
+	It("should kill the destination if the source cannot be killed", func() {
+		cfg.LeaveRunning = true
+		source.killErr = errFake
+
+		_, err := migration.Migrate(context.Background(), source, destination, cfg)
+		Expect(errors.Is(err, errFake)).To(BeTrue())
+		Expect(destination.killed).To(Equal([]string{"ctr"}))
+	})
+
+	It("should fail without clients", func() {
+		_, err := migration.Migrate(context.Background(), nil, destination, cfg)
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,84 @@
+package migration_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"syscall"
+	"testing"
+
+	"github.com/containers/conmon-rs/pkg/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// TestMigration runs the created specs.
+func TestMigration(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Migration")
+}
+
+var errFake = errors.New("fake failure")
+
+// fakeClient checkpoints containers to a fixed image and records the calls.
+type fakeClient struct {
+	image      []byte
+	uploaded   [][]byte
+	restored   []*client.RestoreContainerConfig
+	killed     []string
+	restoreErr error
+	killErr    error
+}
+
+func newFakeClient() *fakeClient {
+	var buf bytes.Buffer
+	archive := tar.NewWriter(&buf)
+	Expect(archive.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     "inventory.img",
+		Mode:     0o600,
+		Size:     9,
+	})).To(BeNil())
+	_, err := archive.Write([]byte("inventory"))
+	Expect(err).To(BeNil())
+	Expect(archive.Close()).To(BeNil())
+
+	return &fakeClient{image: buf.Bytes()}
+}
+
+func (f *fakeClient) CheckpointContainerStream(
+	context.Context, *client.CheckpointContainerConfig,
+) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(f.image)), nil
+}
+
+func (f *fakeClient) UploadCheckpointImage(
+	_ context.Context, _ string, archive io.Reader,
+) (*client.CheckpointImage, error) {
+	data, err := io.ReadAll(archive)
+	if err != nil {
+		return nil, err
+	}
+	f.uploaded = append(f.uploaded, data)
+
+	return &client.CheckpointImage{Path: "/image"}, nil
+}
+
+func (f *fakeClient) RestoreContainer(
+	_ context.Context, cfg *client.RestoreContainerConfig,
+) (*client.RestoreContainerResponse, error) {
+	f.restored = append(f.restored, cfg)
+	if f.restoreErr != nil {
+		return nil, f.restoreErr
+	}
+
+	return &client.RestoreContainerResponse{PID: 42}, nil
+}
+
+func (f *fakeClient) KillContainer(_ context.Context, id string, _ syscall.Signal, _ bool) error {
+	f.killed = append(f.killed, id)
+
+	return f.killErr
+}